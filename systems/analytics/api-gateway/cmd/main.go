@@ -20,6 +20,7 @@ import (
 
 	ccmd "github.com/ukama/ukama/systems/common/cmd"
 	"github.com/ukama/ukama/systems/common/rest/client/auth"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
 )
 
 var svcConf = pkg.NewConfig()
@@ -34,7 +35,8 @@ func main() {
 	metrics.StartMetricsServer(&svcConf.Metrics)
 
 	r := rest.NewRouter(clientSet, rest.NewRouterConfig(svcConf),
-		auth.NewAuthClient(svcConf.Auth.AuthServerUrl, client.WithDebug(svcConf.DebugMode)).AuthenticateUser)
+		creg.NewAuthFunc(pkg.SystemName, svcConf.Auth,
+			auth.NewAuthClient(svcConf.Auth.AuthServerUrl, client.WithDebug(svcConf.DebugMode)).AuthenticateUser))
 
 	r.Run()
 }
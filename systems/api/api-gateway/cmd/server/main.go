@@ -38,7 +38,8 @@ func main() {
 	nodeClient := client.NewNodeClientSet(creg.NewNodeClient(svcConf.Http.RegistryHost))
 
 	router := rest.NewRouter(networkClient, packageClient, simClient, nodeClient, rest.NewRouterConfig(svcConf),
-		auth.NewAuthClient(svcConf.Auth.AuthServerUrl, cclient.WithDebug(svcConf.DebugMode)).AuthenticateUser)
+		creg.NewAuthFunc(pkg.SystemName, svcConf.Auth,
+			auth.NewAuthClient(svcConf.Auth.AuthServerUrl, cclient.WithDebug(svcConf.DebugMode)).AuthenticateUser))
 	router.Run()
 }
 
@@ -19,6 +19,10 @@ import (
 	"github.com/ukama/ukama/systems/auth/api-gateway/pkg/rest"
 	ccmd "github.com/ukama/ukama/systems/common/cmd"
 	"github.com/ukama/ukama/systems/common/config"
+	"github.com/ukama/ukama/systems/common/rest/client/nucleus"
+
+	cclient "github.com/ukama/ukama/systems/common/rest/client"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
 )
 
 var svcConf = pkg.NewConfig(pkg.SystemName)
@@ -28,7 +32,10 @@ func main() {
 	initConfig()
 	logrus.Infof("Starting %s", pkg.ServiceName)
 	am := client.NewAuthManager(svcConf.Auth.AuthServerUrl, 3*time.Second, svcConf.Auth.KetoUrl)
-	cs := rest.NewClientsSet(am)
+	sa := nucleus.NewServiceAccountClient(svcConf.Http.NucleusClient, cclient.WithDebug(svcConf.DebugMode))
+	/* api keys are authorized with the role bindings of their account */
+	az := creg.NewAuthorizer(pkg.SystemName, svcConf.Auth)
+	cs := rest.NewClientsSet(am, sa, az)
 	r := rest.NewRouter(cs, rest.NewRouterConfig(svcConf, svcConf.AuthKey))
 	r.Run()
 }
@@ -21,6 +21,12 @@ type Config struct {
 	R                 *rest.RestClient
 	Auth              *config.Auth
 	AuthKey           string `default:""`
+	Http              HttpServices
+}
+
+type HttpServices struct {
+	/* internal listener of the nucleus api-gateway, validates the api keys of service accounts */
+	NucleusClient string `default:"http://api-gateway-nucleus:8081"`
 }
 
 func NewConfig(name string) *Config {
@@ -22,6 +22,8 @@ import (
 	oc "github.com/ory/client-go"
 	"github.com/ukama/ukama/systems/common/config"
 	"github.com/ukama/ukama/systems/common/rest"
+	"github.com/ukama/ukama/systems/common/rest/client/nucleus"
+	"github.com/ukama/ukama/systems/common/roles"
 	"github.com/wI2L/fizz"
 	"github.com/wI2L/fizz/openapi"
 )
@@ -51,11 +53,15 @@ type AuthManager interface {
 
 type Clients struct {
 	au AuthManager
+	sa nucleus.ServiceAccountClient
+	az *roles.Authorizer
 }
 
-func NewClientsSet(a AuthManager) *Clients {
+func NewClientsSet(a AuthManager, sa nucleus.ServiceAccountClient, az *roles.Authorizer) *Clients {
 	c := &Clients{}
 	c.au = a
+	c.sa = sa
+	c.az = az
 	return c
 }
 
@@ -151,6 +157,10 @@ func (p *Router) getUserInfo(c *gin.Context, req *OptReqHeader) (*GetUserInfo, e
 }
 
 func (p *Router) authenticate(c *gin.Context, req *OptReqHeader) error {
+	if key := pkg.GetApiKeyStr(c); key != "" {
+		return p.authenticateApiKey(c, req.OrgId, key)
+	}
+
 	st, err := pkg.SessionType(c, SESSION_KEY)
 	if err != nil {
 		return err
@@ -204,6 +214,48 @@ func (p *Router) authenticate(c *gin.Context, req *OptReqHeader) error {
 	}
 	logrus.Infof("user %s is %s in %s", user.Id, user.Role, orgId)
 
+	if claimed, _ := pkg.GetMemberDetails(c); claimed != "" && claimed != user.Id {
+		return fmt.Errorf("session does not belong to user %s", claimed)
+	}
+	/* gateways authorize the request for the user of the session */
+	c.Header(roles.UserIdHeader, user.Id)
+
+	return nil
+}
+
+// authenticateApiKey authenticates automation as the service account of orgId
+// the key belongs to, and authorizes the request with the role bindings of
+// the account since it has no role of its own. Keys are refused while role
+// based authorization is off.
+func (p *Router) authenticateApiKey(c *gin.Context, orgId, key string) error {
+	if p.client.sa == nil || p.client.az == nil {
+		return errors.New("api keys are not supported")
+	}
+	if orgId == "" {
+		return errors.New("api keys need an org")
+	}
+
+	system, method, path, err := pkg.GetMetaHeaderValues(c.Request.Header.Get("meta"))
+	if err != nil {
+		return err
+	}
+
+	info, err := p.client.sa.ValidateApiKey(key, orgId, method, path)
+	if err != nil {
+		return errors.New("invalid api key")
+	}
+	logrus.Infof("service account %s (%s) authenticated with key %s", info.Name, info.AccountId, info.KeyId)
+
+	if claimed, _ := pkg.GetMemberDetails(c); claimed != "" && claimed != info.AccountId {
+		return fmt.Errorf("api key does not belong to %s", claimed)
+	}
+
+	err = p.client.az.Authorize(info.AccountId, roles.PathPermission(system, method, path), roles.PathResource(path))
+	if err != nil {
+		return err
+	}
+	c.Header(roles.UserIdHeader, info.AccountId)
+
 	return nil
 }
 
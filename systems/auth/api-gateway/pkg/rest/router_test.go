@@ -11,6 +11,7 @@ package rest
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -21,7 +22,11 @@ import (
 	"github.com/ory/client-go"
 	mauth "github.com/ukama/ukama/systems/auth/api-gateway/mocks"
 	cconfig "github.com/ukama/ukama/systems/common/config"
+	cmocks "github.com/ukama/ukama/systems/common/mocks"
 	"github.com/ukama/ukama/systems/common/rest"
+	"github.com/ukama/ukama/systems/common/rest/client/nucleus"
+	"github.com/ukama/ukama/systems/common/rest/client/registry"
+	"github.com/ukama/ukama/systems/common/roles"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -198,3 +203,94 @@ func TestAuthenticate(t *testing.T) {
 
 	assert.Equal(t, 200, w.Code)
 }
+
+func TestAuthenticate_ApiKey(t *testing.T) {
+	const orgId = "8c2e5d0b-1f7a-4c3e-9b6d-2a4f8e1c7b35"
+
+	authorizer := func(grants ...registry.MemberGrant) (*roles.Authorizer, *cmocks.MemberClient) {
+		members := &cmocks.MemberClient{}
+		members.On("GetAccess", "account-1").Return(&registry.MemberAccess{Grants: grants}, nil)
+		return roles.NewAuthorizer("auth", registry.NewMemberGrants(members)), members
+	}
+	apiKeyRequest := func(orgId string) *http.Request {
+		req, _ := http.NewRequest("GET", "/v1/auth", nil)
+		req.Header.Set("X-Api-Key", "uk_0011223344556677_secret")
+		req.Header.Set("Org-id", orgId)
+		req.Header.Set("Meta", "billing, GET, /v1/bills")
+		return req
+	}
+
+	t.Run("ValidKey", func(t *testing.T) {
+		cma := &mauth.AuthManager{}
+		sa := &cmocks.ServiceAccountClient{}
+		az, members := authorizer(registry.MemberGrant{Role: "billing", Permissions: []string{"billing.*.read"}, ScopeKind: "org"})
+		w := httptest.NewRecorder()
+
+		sa.On("ValidateApiKey", "uk_0011223344556677_secret", orgId, "GET", "/v1/bills").
+			Return(&nucleus.ApiKeyInfo{AccountId: "account-1", KeyId: "key-1", Name: "billing-sync"}, nil).Once()
+
+		r := NewRouter(NewClientsSet(cma, sa, az), routerConfig).f.Engine()
+		r.ServeHTTP(w, apiKeyRequest(orgId))
+
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, "account-1", w.Header().Get("User-id"))
+		sa.AssertExpectations(t)
+		members.AssertExpectations(t)
+		cma.AssertNotCalled(t, "ValidateSession", mock.Anything, mock.Anything)
+	})
+
+	t.Run("NotBound", func(t *testing.T) {
+		sa := &cmocks.ServiceAccountClient{}
+		az, _ := authorizer(registry.MemberGrant{Role: "viewer", Permissions: []string{"registry.*.read"}, ScopeKind: "org"})
+		w := httptest.NewRecorder()
+
+		sa.On("ValidateApiKey", mock.Anything, orgId, "GET", "/v1/bills").
+			Return(&nucleus.ApiKeyInfo{AccountId: "account-1", KeyId: "key-1", Name: "billing-sync"}, nil).Once()
+
+		r := NewRouter(NewClientsSet(&mauth.AuthManager{}, sa, az), routerConfig).f.Engine()
+		r.ServeHTTP(w, apiKeyRequest(orgId))
+
+		assert.NotEqual(t, 200, w.Code)
+		assert.Empty(t, w.Header().Get("User-id"))
+	})
+
+	t.Run("InvalidKey", func(t *testing.T) {
+		sa := &cmocks.ServiceAccountClient{}
+		az, members := authorizer()
+		w := httptest.NewRecorder()
+
+		sa.On("ValidateApiKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("rest api POST failure")).Once()
+
+		r := NewRouter(NewClientsSet(&mauth.AuthManager{}, sa, az), routerConfig).f.Engine()
+		r.ServeHTTP(w, apiKeyRequest(orgId))
+
+		assert.NotEqual(t, 200, w.Code)
+		assert.Empty(t, w.Header().Get("User-id"))
+		members.AssertNotCalled(t, "GetAccess", mock.Anything)
+	})
+
+	t.Run("NoOrg", func(t *testing.T) {
+		sa := &cmocks.ServiceAccountClient{}
+		az, _ := authorizer()
+		w := httptest.NewRecorder()
+
+		r := NewRouter(NewClientsSet(&mauth.AuthManager{}, sa, az), routerConfig).f.Engine()
+		r.ServeHTTP(w, apiKeyRequest(""))
+
+		assert.NotEqual(t, 200, w.Code)
+		sa.AssertNotCalled(t, "ValidateApiKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("NoRoleBasedAuthorization", func(t *testing.T) {
+		sa := &cmocks.ServiceAccountClient{}
+		w := httptest.NewRecorder()
+
+		r := NewRouter(NewClientsSet(&mauth.AuthManager{}, sa, nil), routerConfig).f.Engine()
+		r.ServeHTTP(w, apiKeyRequest(orgId))
+
+		assert.NotEqual(t, 200, w.Code)
+		assert.Empty(t, w.Header().Get("User-id"))
+		sa.AssertNotCalled(t, "ValidateApiKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -179,6 +179,11 @@ func GetTokenStr(c *gin.Context) string {
 	return token
 }
 
+// GetApiKeyStr returns the API key a service account authenticates with
+func GetApiKeyStr(c *gin.Context) string {
+	return c.Request.Header.Get("X-Api-Key")
+}
+
 func GetMemberDetails(c *gin.Context) (string, string) {
 	userId := c.Request.Header.Get("User-id")
 	orgId := c.Request.Header.Get("Org-id")
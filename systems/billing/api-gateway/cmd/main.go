@@ -20,6 +20,7 @@ import (
 
 	pkg "github.com/ukama/ukama/systems/billing/api-gateway/pkg"
 	ccmd "github.com/ukama/ukama/systems/common/cmd"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
 )
 
 var svcConf = pkg.NewConfig()
@@ -33,7 +34,8 @@ func main() {
 	metrics.StartMetricsServer(&svcConf.Metrics)
 
 	r := rest.NewRouter(clientSet, rest.NewRouterConfig(svcConf),
-		auth.NewAuthClient(svcConf.Auth.AuthServerUrl, client.WithDebug(svcConf.DebugMode)).AuthenticateUser)
+		creg.NewAuthFunc(pkg.SystemName, svcConf.Auth,
+			auth.NewAuthClient(svcConf.Auth.AuthServerUrl, client.WithDebug(svcConf.DebugMode)).AuthenticateUser))
 
 	r.Run()
 }
@@ -46,6 +46,7 @@ func NewConfig(name string) *Config {
 			Timeout: 5 * time.Second,
 			ListenerRoutes: []string{
 				"event.cloud.local.{{ .Org}}.payments.processor.payment.success",
+				"event.cloud.local.{{ .Org}}.registry.member.datarequest.create",
 			},
 		},
 	}
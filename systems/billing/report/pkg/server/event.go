@@ -10,6 +10,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -17,6 +18,8 @@ import (
 	"github.com/ukama/ukama/systems/billing/report/pkg"
 	"github.com/ukama/ukama/systems/billing/report/pkg/db"
 	"github.com/ukama/ukama/systems/common/msgbus"
+	"github.com/ukama/ukama/systems/common/privacy"
+	"github.com/ukama/ukama/systems/common/ukama"
 
 	log "github.com/sirupsen/logrus"
 	mb "github.com/ukama/ukama/systems/common/msgBusServiceClient"
@@ -63,6 +66,17 @@ func (r *ReportEventServer) EventNotification(ctx context.Context, e *epb.Event)
 			return nil, err
 		}
 
+	case msgbus.PrepareRoute(r.orgName, "event.cloud.local.{{ .Org}}.registry.member.datarequest.create"):
+		msg, err := epb.UnmarshalEventDataRequest(e.Msg, "EventDataRequest")
+		if err != nil {
+			return nil, err
+		}
+
+		err = r.handleDataRequestCreateEvent(e.RoutingKey, msg)
+		if err != nil {
+			return nil, err
+		}
+
 	default:
 		log.Errorf("No handler routing key %s", e.RoutingKey)
 	}
@@ -79,6 +93,65 @@ func (r *ReportEventServer) handlePaymentSuccessEvent(key string, msg *epb.Payme
 	return err
 }
 
+// customerPersonalData are the fields of the customer of a raw report that
+// erasure redacts. Amounts, taxes and currency stay for accounting.
+var customerPersonalData = []string{"name", "email", "address_line1", "address_line2", "city", "state",
+	"zipcode", "legal_name", "legal_number", "phone", "logo_url", "url"}
+
+// handleDataRequestCreateEvent exports the reports of the org or of a
+// subscriber. Reports are financial records, so erasure only redacts the
+// customer details they embed.
+func (r *ReportEventServer) handleDataRequestCreateEvent(key string, req *epb.EventDataRequest) error {
+	log.Infof("Keys %s and Proto is: %+v", key, req)
+
+	ownerId := ""
+	if req.SubjectKind == privacy.SubjectSubscriber {
+		ownerId = req.SubjectId
+	}
+
+	var doc privacy.Document
+
+	reports, err := r.reportRepo.List(ownerId, ukama.OwnerTypeUnknown, "", ukama.ReportTypeUnknown, false, 0, false)
+	if err != nil {
+		err = fmt.Errorf("failed to list reports: %w", err)
+	} else if req.Kind == privacy.KindErase {
+		err = r.redactReports(reports)
+	} else {
+		doc = privacy.Document{}
+		err = doc.Add("reports", reports)
+	}
+
+	res := privacy.NewResult(pkg.SystemName+"."+pkg.ServiceName, req, doc, err)
+	if req.Kind == privacy.KindErase && res.Success {
+		res.Note = fmt.Sprintf("%d reports retained with customer details redacted", len(reports))
+	}
+
+	route := r.baseRoutingKey.SetAction("complete").SetObject("datarequest").MustBuild()
+
+	err = r.msgBus.PublishRequest(route, res)
+	if err != nil {
+		log.Errorf("Failed to publish message %+v with key %+v. Errors %s", res, route, err.Error())
+	}
+
+	return err
+}
+
+func (r *ReportEventServer) redactReports(reports []db.Report) error {
+	for _, rp := range reports {
+		raw, err := privacy.RedactJson(rp.RawReport, "customer", customerPersonalData...)
+		if err != nil {
+			return fmt.Errorf("failed to redact report %s: %w", rp.Id, err)
+		}
+
+		err = r.reportRepo.Update(&db.Report{Id: rp.Id, RawReport: raw}, nil)
+		if err != nil {
+			return fmt.Errorf("failed to update report %s: %w", rp.Id, err)
+		}
+	}
+
+	return nil
+}
+
 func unmarshalPaymentSuccess(msg *anypb.Any) (*epb.Payment, error) {
 	p := &epb.Payment{}
 
@@ -11,6 +11,7 @@ package server_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -298,3 +299,64 @@ func TestReportEventServer_HandlePaymentSuccessEvent(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestReportEventServer_HandleDataRequestCreateEvent(t *testing.T) {
+	routingKey := msgbus.PrepareRoute(OrgName, "event.cloud.local.{{ .Org}}.registry.member.datarequest.create")
+	subscriberId := uuid.NewV4()
+	reportId := uuid.NewV4()
+
+	rawReport := datatypes.JSON(`{"customer":{"name":"Jane","email":"jane@example.com","currency":"USD"},` +
+		`"fees_amount_cents":1500}`)
+
+	event := func(kind string) *epb.Event {
+		anyE, err := anypb.New(&epb.EventDataRequest{
+			Id:          uuid.NewV4().String(),
+			Kind:        kind,
+			SubjectKind: "subscriber",
+			SubjectId:   subscriberId.String(),
+		})
+		assert.NoError(t, err)
+
+		return &epb.Event{RoutingKey: routingKey, Msg: anyE}
+	}
+
+	t.Run("Export", func(t *testing.T) {
+		reportRepo := &mocks.ReportRepo{}
+		msgbusClient := &cmocks.MsgBusServiceClient{}
+
+		reportRepo.On("List", subscriberId.String(), mock.Anything, "", mock.Anything, false, uint32(0), false).
+			Return([]db.Report{{Id: reportId, OwnerId: subscriberId, RawReport: rawReport}}, nil).Once()
+		msgbusClient.On("PublishRequest", mock.Anything, mock.MatchedBy(func(r *epb.EventDataRequestResult) bool {
+			return r.Success && r.Service == "billing.report" && len(r.Data) > 0
+		})).Return(nil).Once()
+
+		s := server.NewReportEventServer(OrgName, OrgId, reportRepo, msgbusClient)
+		_, err := s.EventNotification(context.TODO(), event("export"))
+
+		assert.NoError(t, err)
+		msgbusClient.AssertExpectations(t)
+	})
+
+	t.Run("EraseRedactsCustomer", func(t *testing.T) {
+		reportRepo := &mocks.ReportRepo{}
+		msgbusClient := &cmocks.MsgBusServiceClient{}
+
+		reportRepo.On("List", subscriberId.String(), mock.Anything, "", mock.Anything, false, uint32(0), false).
+			Return([]db.Report{{Id: reportId, OwnerId: subscriberId, RawReport: rawReport}}, nil).Once()
+		reportRepo.On("Update", mock.MatchedBy(func(r *db.Report) bool {
+			raw := string(r.RawReport)
+			return r.Id == reportId && !strings.Contains(raw, "Jane") && !strings.Contains(raw, "jane@example.com") &&
+				strings.Contains(raw, `"currency":"USD"`) && strings.Contains(raw, `"fees_amount_cents":1500`)
+		}), mock.Anything).Return(nil).Once()
+		msgbusClient.On("PublishRequest", mock.Anything, mock.MatchedBy(func(r *epb.EventDataRequestResult) bool {
+			return r.Success && len(r.Data) == 0
+		})).Return(nil).Once()
+
+		s := server.NewReportEventServer(OrgName, OrgId, reportRepo, msgbusClient)
+		_, err := s.EventNotification(context.TODO(), event("erase"))
+
+		assert.NoError(t, err)
+		reportRepo.AssertExpectations(t)
+		msgbusClient.AssertExpectations(t)
+	})
+}
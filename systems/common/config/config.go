@@ -142,6 +142,11 @@ type Auth struct {
 	AuthAPIGW      string `default:"http://localhost:8080"`
 	BypassAuthMode bool   `default:"false"`
 	KetoUrl        string `default:"http://localhost:4466"`
+	// AuthorizationUrl is the internal listener of the registry api-gateway,
+	// e.g. http://api-gateway-registry:8081, serving the role grants of
+	// members on its /authz routes. Role based authorization is off while it
+	// is empty.
+	AuthorizationUrl string
 }
 
 // LoadConfig loads configuration into `config` object
@@ -247,6 +252,7 @@ func LoadAuthHostConfig(name string) *Auth {
 	apigwUrl := "_API_GW_URL"
 	bypassAuthMode := "BYPASS_AUTH_MODE"
 	ketoUrl := "_KETO_URL"
+	authorizationUrl := "_AUTHORIZATION_URL"
 
 	val, present := os.LookupEnv(strings.ToUpper(name + ketoUrl))
 	if present {
@@ -285,6 +291,13 @@ func LoadAuthHostConfig(name string) *Auth {
 		s.BypassAuthMode = boolValue
 	}
 
+	val, present = os.LookupEnv(strings.ToUpper(name + authorizationUrl))
+	if present {
+		s.AuthorizationUrl = val
+	} else {
+		logrus.Infof("%s authorization url env not found, role based authorization is off", name)
+	}
+
 	return s
 }
 
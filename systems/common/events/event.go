@@ -73,6 +73,14 @@ const (
 	EventOperationFailed
 	EventSiteDelete
 	EventReceiptGenerate
+	EventHealthAlarmRaise
+	EventHealthAlarmClear
+	EventNodeStateFlapping
+	EventSiteDecommission
+	EventSiteRelocate
+	EventInviteExpire
+	EventDataRequestCreate
+	EventDataRequestComplete
 )
 
 var EventRoutingKey = [...]string{
@@ -127,6 +135,14 @@ var EventRoutingKey = [...]string{
 	EventOperationCompleted:  "event.cloud.global.{{ .Org}}.operation.manager.operation.completed",
 	EventOperationFailed:     "event.cloud.global.{{ .Org}}.operation.manager.operation.failed",
 	EventSiteDelete:          "event.cloud.local.{{ .Org}}.registry.site.site.delete",
+	EventHealthAlarmRaise:    "event.cloud.local.{{ .Org}}.node.health.alarm.raise",
+	EventHealthAlarmClear:    "event.cloud.local.{{ .Org}}.node.health.alarm.clear",
+	EventNodeStateFlapping:   "event.cloud.local.{{ .Org}}.node.state.node.flapping",
+	EventSiteDecommission:    "event.cloud.local.{{ .Org}}.registry.site.site.decommission",
+	EventSiteRelocate:        "event.cloud.local.{{ .Org}}.registry.site.site.relocate",
+	EventInviteExpire:        "event.cloud.local.{{ .Org}}.registry.invitation.invitation.expire",
+	EventDataRequestCreate:   "event.cloud.local.{{ .Org}}.registry.member.datarequest.create",
+	EventDataRequestComplete: "event.cloud.local.{{ .Org}}.registry.member.datarequest.complete",
 }
 
 var EventToEventConfig = map[EventId]EventConfig{
@@ -538,4 +554,68 @@ var EventToEventConfig = map[EventId]EventConfig{
 		Scope:       notif.SCOPE_SITE,
 		Type:        TypeDefault,
 	},
+	EventHealthAlarmRaise: {
+		Key:         EventHealthAlarmRaise,
+		Name:        "EventHealthAlarmRaise",
+		Title:       "Node Alarm Raised",
+		Description: "Node Alarm Raised",
+		Scope:       notif.SCOPE_NETWORK,
+		Type:        notif.TYPE_WARNING,
+	},
+	EventHealthAlarmClear: {
+		Key:         EventHealthAlarmClear,
+		Name:        "EventHealthAlarmClear",
+		Title:       "Node Alarm Cleared",
+		Description: "Node Alarm Cleared",
+		Scope:       notif.SCOPE_NETWORK,
+		Type:        TypeDefault,
+	},
+	EventNodeStateFlapping: {
+		Key:         EventNodeStateFlapping,
+		Name:        "EventNodeStateFlapping",
+		Title:       "Node Flapping",
+		Description: "Node Flapping",
+		Scope:       notif.SCOPE_NODE,
+		Type:        notif.TYPE_WARNING,
+	},
+	EventSiteDecommission: {
+		Key:         EventSiteDecommission,
+		Name:        "EventDecommissionSite",
+		Title:       "Site Decommissioned",
+		Description: "Site Decommissioned",
+		Scope:       notif.SCOPE_SITE,
+		Type:        TypeDefault,
+	},
+	EventSiteRelocate: {
+		Key:         EventSiteRelocate,
+		Name:        "EventRelocateSite",
+		Title:       "Site Relocated",
+		Description: "Site Relocated",
+		Scope:       notif.SCOPE_SITE,
+		Type:        TypeDefault,
+	},
+	EventInviteExpire: {
+		Key:         EventInviteExpire,
+		Name:        "EventInviteExpire",
+		Title:       "Invite Expired",
+		Description: "Invite Expired",
+		Scope:       notif.SCOPE_ORG,
+		Type:        TypeDefault,
+	},
+	EventDataRequestCreate: {
+		Key:         EventDataRequestCreate,
+		Name:        "EventDataRequestCreate",
+		Title:       "Data Request Created",
+		Description: "Data Request Created",
+		Scope:       notif.SCOPE_ORG,
+		Type:        TypeDefault,
+	},
+	EventDataRequestComplete: {
+		Key:         EventDataRequestComplete,
+		Name:        "EventDataRequestComplete",
+		Title:       "Data Request Completed",
+		Description: "Data Request Completed",
+		Scope:       notif.SCOPE_ORG,
+		Type:        TypeDefault,
+	},
 }
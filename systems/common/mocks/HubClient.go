@@ -12,6 +12,36 @@ type HubClient struct {
 	mock.Mock
 }
 
+// GetDelta provides a mock function with given fields: name, artifactType, fromVersion, toVersion
+func (_m *HubClient) GetDelta(name string, artifactType string, fromVersion string, toVersion string) (*hub.Delta, error) {
+	ret := _m.Called(name, artifactType, fromVersion, toVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelta")
+	}
+
+	var r0 *hub.Delta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*hub.Delta, error)); ok {
+		return rf(name, artifactType, fromVersion, toVersion)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *hub.Delta); ok {
+		r0 = rf(name, artifactType, fromVersion, toVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hub.Delta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(name, artifactType, fromVersion, toVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListApps provides a mock function with given fields: artifactType
 func (_m *HubClient) ListApps(artifactType string) ([]string, error) {
 	ret := _m.Called(artifactType)
@@ -72,6 +72,36 @@ func (_m *InitClient) GetSystemFromHost(host string, org *string) (*initclient.S
 	return r0, r1
 }
 
+// SendHeartbeat provides a mock function with given fields: org, system, hb
+func (_m *InitClient) SendHeartbeat(org string, system string, hb *initclient.SystemHeartbeat) (*initclient.SystemDrainInfo, error) {
+	ret := _m.Called(org, system, hb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendHeartbeat")
+	}
+
+	var r0 *initclient.SystemDrainInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, *initclient.SystemHeartbeat) (*initclient.SystemDrainInfo, error)); ok {
+		return rf(org, system, hb)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, *initclient.SystemHeartbeat) *initclient.SystemDrainInfo); ok {
+		r0 = rf(org, system, hb)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*initclient.SystemDrainInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, *initclient.SystemHeartbeat) error); ok {
+		r1 = rf(org, system, hb)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewInitClient creates a new instance of InitClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewInitClient(t interface {
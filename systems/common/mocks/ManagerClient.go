@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	time "time"
+
 	mock "github.com/stretchr/testify/mock"
 	operation "github.com/ukama/ukama/systems/common/rest/client/operation"
 )
@@ -192,6 +194,24 @@ func (_m *ManagerClient) Start(_a0 operation.StartRequest) (*operation.StartResp
 	return r0, r1
 }
 
+// Watch provides a mock function with given fields: id, timeout, fn
+func (_m *ManagerClient) Watch(id string, timeout time.Duration, fn func(*operation.WatchEvent) bool) error {
+	ret := _m.Called(id, timeout, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Watch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, time.Duration, func(*operation.WatchEvent) bool) error); ok {
+		r0 = rf(id, timeout, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewManagerClient creates a new instance of ManagerClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewManagerClient(t interface {
@@ -42,6 +42,84 @@ func (_m *MemberClient) AddMember(uuid string) (*registry.MemberInfoResponse, er
 	return r0, r1
 }
 
+// AddMemberWithRole provides a mock function with given fields: uuid, role
+func (_m *MemberClient) AddMemberWithRole(uuid string, role string) (*registry.MemberInfoResponse, error) {
+	ret := _m.Called(uuid, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMemberWithRole")
+	}
+
+	var r0 *registry.MemberInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*registry.MemberInfoResponse, error)); ok {
+		return rf(uuid, role)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *registry.MemberInfoResponse); ok {
+		r0 = rf(uuid, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*registry.MemberInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(uuid, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddRoleBinding provides a mock function with given fields: userId, binding
+func (_m *MemberClient) AddRoleBinding(userId string, binding registry.MemberBinding) error {
+	ret := _m.Called(userId, binding)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRoleBinding")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, registry.MemberBinding) error); ok {
+		r0 = rf(userId, binding)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAccess provides a mock function with given fields: userId
+func (_m *MemberClient) GetAccess(userId string) (*registry.MemberAccess, error) {
+	ret := _m.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccess")
+	}
+
+	var r0 *registry.MemberAccess
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*registry.MemberAccess, error)); ok {
+		return rf(userId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *registry.MemberAccess); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*registry.MemberAccess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByUserId provides a mock function with given fields: Id
 func (_m *MemberClient) GetByUserId(Id string) (*registry.MemberInfoResponse, error) {
 	ret := _m.Called(Id)
@@ -72,6 +72,24 @@ func (_m *NodeFactoryClient) List(nodeType string, orgName string, isProvisioned
 	return r0, r1
 }
 
+// VerifyClaim provides a mock function with given fields: id, claimCode
+func (_m *NodeFactoryClient) VerifyClaim(id string, claimCode string) error {
+	ret := _m.Called(id, claimCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyClaim")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(id, claimCode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewNodeFactoryClient creates a new instance of NodeFactoryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNodeFactoryClient(t interface {
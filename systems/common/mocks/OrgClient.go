@@ -60,6 +60,36 @@ func (_m *OrgClient) Get(name string) (*nucleus.OrgInfo, error) {
 	return r0, r1
 }
 
+// GetByUser provides a mock function with given fields: userId
+func (_m *OrgClient) GetByUser(userId string) (*nucleus.UserOrgs, error) {
+	ret := _m.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUser")
+	}
+
+	var r0 *nucleus.UserOrgs
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*nucleus.UserOrgs, error)); ok {
+		return rf(userId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *nucleus.UserOrgs); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*nucleus.UserOrgs)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveUser provides a mock function with given fields: orgId, userId
 func (_m *OrgClient) RemoveUser(orgId string, userId string) error {
 	ret := _m.Called(orgId, userId)
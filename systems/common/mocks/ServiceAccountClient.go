@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	nucleus "github.com/ukama/ukama/systems/common/rest/client/nucleus"
+)
+
+// ServiceAccountClient is an autogenerated mock type for the ServiceAccountClient type
+type ServiceAccountClient struct {
+	mock.Mock
+}
+
+// ValidateApiKey provides a mock function with given fields: key, orgId, method, path
+func (_m *ServiceAccountClient) ValidateApiKey(key string, orgId string, method string, path string) (*nucleus.ApiKeyInfo, error) {
+	ret := _m.Called(key, orgId, method, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateApiKey")
+	}
+
+	var r0 *nucleus.ApiKeyInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*nucleus.ApiKeyInfo, error)); ok {
+		return rf(key, orgId, method, path)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *nucleus.ApiKeyInfo); ok {
+		r0 = rf(key, orgId, method, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*nucleus.ApiKeyInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(key, orgId, method, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewServiceAccountClient creates a new instance of ServiceAccountClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceAccountClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceAccountClient {
+	mock := &ServiceAccountClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
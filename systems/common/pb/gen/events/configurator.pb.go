@@ -0,0 +1,425 @@
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2026-present, Ukama Inc.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        v6.32.1
+// source: events/configurator.proto
+
+package events
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConfigValidationFailedEvent is emitted when a config store commit is
+// rejected because one or more changed files failed schema validation.
+// Nothing from the commit is pushed to nodes.
+type ConfigValidationFailedEvent struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Commit        string                  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	Files         []*ConfigFileValidation `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigValidationFailedEvent) Reset() {
+	*x = ConfigValidationFailedEvent{}
+	mi := &file_events_configurator_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigValidationFailedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigValidationFailedEvent) ProtoMessage() {}
+
+func (x *ConfigValidationFailedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_configurator_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigValidationFailedEvent.ProtoReflect.Descriptor instead.
+func (*ConfigValidationFailedEvent) Descriptor() ([]byte, []int) {
+	return file_events_configurator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfigValidationFailedEvent) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *ConfigValidationFailedEvent) GetFiles() []*ConfigFileValidation {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type ConfigFileValidation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	App           string                 `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
+	Schema        string                 `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	Errors        []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigFileValidation) Reset() {
+	*x = ConfigFileValidation{}
+	mi := &file_events_configurator_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigFileValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigFileValidation) ProtoMessage() {}
+
+func (x *ConfigFileValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_events_configurator_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigFileValidation.ProtoReflect.Descriptor instead.
+func (*ConfigFileValidation) Descriptor() ([]byte, []int) {
+	return file_events_configurator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConfigFileValidation) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *ConfigFileValidation) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *ConfigFileValidation) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *ConfigFileValidation) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// NodeConfigReportEvent is sent by a node with the commit it runs and the
+// sha256 of each config file on disk, so hand edits on the node show up.
+type NodeConfigReportEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	Commit        string                 `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Files         []*ConfigFileHash      `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeConfigReportEvent) Reset() {
+	*x = NodeConfigReportEvent{}
+	mi := &file_events_configurator_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeConfigReportEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeConfigReportEvent) ProtoMessage() {}
+
+func (x *NodeConfigReportEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_configurator_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeConfigReportEvent.ProtoReflect.Descriptor instead.
+func (*NodeConfigReportEvent) Descriptor() ([]byte, []int) {
+	return file_events_configurator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NodeConfigReportEvent) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeConfigReportEvent) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *NodeConfigReportEvent) GetFiles() []*ConfigFileHash {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type ConfigFileHash struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	App           string                 `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Hash          string                 `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigFileHash) Reset() {
+	*x = ConfigFileHash{}
+	mi := &file_events_configurator_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigFileHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigFileHash) ProtoMessage() {}
+
+func (x *ConfigFileHash) ProtoReflect() protoreflect.Message {
+	mi := &file_events_configurator_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigFileHash.ProtoReflect.Descriptor instead.
+func (*ConfigFileHash) Descriptor() ([]byte, []int) {
+	return file_events_configurator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ConfigFileHash) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *ConfigFileHash) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ConfigFileHash) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+// NodeConfigDriftEvent is emitted when a node's reported config first
+// diverges from the version the configurator expects it to run.
+type NodeConfigDriftEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NodeId         string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	ExpectedCommit string                 `protobuf:"bytes,2,opt,name=expectedCommit,proto3" json:"expectedCommit,omitempty"`
+	ReportedCommit string                 `protobuf:"bytes,3,opt,name=reportedCommit,proto3" json:"reportedCommit,omitempty"`
+	DriftedFiles   []string               `protobuf:"bytes,4,rep,name=driftedFiles,proto3" json:"driftedFiles,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NodeConfigDriftEvent) Reset() {
+	*x = NodeConfigDriftEvent{}
+	mi := &file_events_configurator_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeConfigDriftEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeConfigDriftEvent) ProtoMessage() {}
+
+func (x *NodeConfigDriftEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_configurator_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeConfigDriftEvent.ProtoReflect.Descriptor instead.
+func (*NodeConfigDriftEvent) Descriptor() ([]byte, []int) {
+	return file_events_configurator_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NodeConfigDriftEvent) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeConfigDriftEvent) GetExpectedCommit() string {
+	if x != nil {
+		return x.ExpectedCommit
+	}
+	return ""
+}
+
+func (x *NodeConfigDriftEvent) GetReportedCommit() string {
+	if x != nil {
+		return x.ReportedCommit
+	}
+	return ""
+}
+
+func (x *NodeConfigDriftEvent) GetDriftedFiles() []string {
+	if x != nil {
+		return x.DriftedFiles
+	}
+	return nil
+}
+
+var File_events_configurator_proto protoreflect.FileDescriptor
+
+const file_events_configurator_proto_rawDesc = "" +
+	"\n" +
+	"\x19events/configurator.proto\x12\x0fukama.events.v1\"r\n" +
+	"\x1bConfigValidationFailedEvent\x12\x16\n" +
+	"\x06commit\x18\x01 \x01(\tR\x06commit\x12;\n" +
+	"\x05files\x18\x02 \x03(\v2%.ukama.events.v1.ConfigFileValidationR\x05files\"l\n" +
+	"\x14ConfigFileValidation\x12\x12\n" +
+	"\x04file\x18\x01 \x01(\tR\x04file\x12\x10\n" +
+	"\x03app\x18\x02 \x01(\tR\x03app\x12\x16\n" +
+	"\x06schema\x18\x03 \x01(\tR\x06schema\x12\x16\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\"~\n" +
+	"\x15NodeConfigReportEvent\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06commit\x18\x02 \x01(\tR\x06commit\x125\n" +
+	"\x05files\x18\x03 \x03(\v2\x1f.ukama.events.v1.ConfigFileHashR\x05files\"R\n" +
+	"\x0eConfigFileHash\x12\x10\n" +
+	"\x03app\x18\x01 \x01(\tR\x03app\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\"\xa2\x01\n" +
+	"\x14NodeConfigDriftEvent\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12&\n" +
+	"\x0eexpectedCommit\x18\x02 \x01(\tR\x0eexpectedCommit\x12&\n" +
+	"\x0ereportedCommit\x18\x03 \x01(\tR\x0ereportedCommit\x12\"\n" +
+	"\fdriftedFiles\x18\x04 \x03(\tR\fdriftedFilesB5Z3github.com/ukama/ukama/systems/common/pb/gen/eventsb\x06proto3"
+
+var (
+	file_events_configurator_proto_rawDescOnce sync.Once
+	file_events_configurator_proto_rawDescData []byte
+)
+
+func file_events_configurator_proto_rawDescGZIP() []byte {
+	file_events_configurator_proto_rawDescOnce.Do(func() {
+		file_events_configurator_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_configurator_proto_rawDesc), len(file_events_configurator_proto_rawDesc)))
+	})
+	return file_events_configurator_proto_rawDescData
+}
+
+var file_events_configurator_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_events_configurator_proto_goTypes = []any{
+	(*ConfigValidationFailedEvent)(nil), // 0: ukama.events.v1.ConfigValidationFailedEvent
+	(*ConfigFileValidation)(nil),        // 1: ukama.events.v1.ConfigFileValidation
+	(*NodeConfigReportEvent)(nil),       // 2: ukama.events.v1.NodeConfigReportEvent
+	(*ConfigFileHash)(nil),              // 3: ukama.events.v1.ConfigFileHash
+	(*NodeConfigDriftEvent)(nil),        // 4: ukama.events.v1.NodeConfigDriftEvent
+}
+var file_events_configurator_proto_depIdxs = []int32{
+	1, // 0: ukama.events.v1.ConfigValidationFailedEvent.files:type_name -> ukama.events.v1.ConfigFileValidation
+	3, // 1: ukama.events.v1.NodeConfigReportEvent.files:type_name -> ukama.events.v1.ConfigFileHash
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_events_configurator_proto_init() }
+func file_events_configurator_proto_init() {
+	if File_events_configurator_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_configurator_proto_rawDesc), len(file_events_configurator_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_configurator_proto_goTypes,
+		DependencyIndexes: file_events_configurator_proto_depIdxs,
+		MessageInfos:      file_events_configurator_proto_msgTypes,
+	}.Build()
+	File_events_configurator_proto = out.File
+	file_events_configurator_proto_goTypes = nil
+	file_events_configurator_proto_depIdxs = nil
+}
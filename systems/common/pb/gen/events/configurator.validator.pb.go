@@ -0,0 +1,46 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: events/configurator.proto
+
+package events
+
+import (
+	fmt "fmt"
+	math "math"
+	proto "github.com/golang/protobuf/proto"
+	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+func (this *ConfigValidationFailedEvent) Validate() error {
+	for _, item := range this.Files {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Files", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ConfigFileValidation) Validate() error {
+	return nil
+}
+func (this *NodeConfigReportEvent) Validate() error {
+	for _, item := range this.Files {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Files", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ConfigFileHash) Validate() error {
+	return nil
+}
+func (this *NodeConfigDriftEvent) Validate() error {
+	return nil
+}
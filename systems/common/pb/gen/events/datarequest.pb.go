@@ -0,0 +1,375 @@
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2026-present, Ukama Inc.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        v6.32.1
+// source: events/datarequest.proto
+
+package events
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventDataRequest asks every service holding data of the subject to export
+// or erase it.
+type EventDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	SubjectKind   string                 `protobuf:"bytes,3,opt,name=subjectKind,json=subject_kind,proto3" json:"subjectKind,omitempty"`
+	SubjectId     string                 `protobuf:"bytes,4,opt,name=subjectId,json=subject_id,proto3" json:"subjectId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventDataRequest) Reset() {
+	*x = EventDataRequest{}
+	mi := &file_events_datarequest_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDataRequest) ProtoMessage() {}
+
+func (x *EventDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_events_datarequest_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventDataRequest.ProtoReflect.Descriptor instead.
+func (*EventDataRequest) Descriptor() ([]byte, []int) {
+	return file_events_datarequest_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventDataRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventDataRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *EventDataRequest) GetSubjectKind() string {
+	if x != nil {
+		return x.SubjectKind
+	}
+	return ""
+}
+
+func (x *EventDataRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+// EventDataRequestResult is the part a service took in a data request.
+type EventDataRequestResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=requestId,json=request_id,proto3" json:"requestId,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	SubjectKind   string                 `protobuf:"bytes,3,opt,name=subjectKind,json=subject_kind,proto3" json:"subjectKind,omitempty"`
+	SubjectId     string                 `protobuf:"bytes,4,opt,name=subjectId,json=subject_id,proto3" json:"subjectId,omitempty"`
+	Service       string                 `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
+	Success       bool                   `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	Note          string                 `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	Data          []byte                 `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	Imsis         []string               `protobuf:"bytes,10,rep,name=imsis,proto3" json:"imsis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventDataRequestResult) Reset() {
+	*x = EventDataRequestResult{}
+	mi := &file_events_datarequest_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventDataRequestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDataRequestResult) ProtoMessage() {}
+
+func (x *EventDataRequestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_events_datarequest_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventDataRequestResult.ProtoReflect.Descriptor instead.
+func (*EventDataRequestResult) Descriptor() ([]byte, []int) {
+	return file_events_datarequest_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventDataRequestResult) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *EventDataRequestResult) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *EventDataRequestResult) GetSubjectKind() string {
+	if x != nil {
+		return x.SubjectKind
+	}
+	return ""
+}
+
+func (x *EventDataRequestResult) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *EventDataRequestResult) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *EventDataRequestResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EventDataRequestResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EventDataRequestResult) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *EventDataRequestResult) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *EventDataRequestResult) GetImsis() []string {
+	if x != nil {
+		return x.Imsis
+	}
+	return nil
+}
+
+type EventDataRequestCompleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	SubjectKind   string                 `protobuf:"bytes,3,opt,name=subjectKind,json=subject_kind,proto3" json:"subjectKind,omitempty"`
+	SubjectId     string                 `protobuf:"bytes,4,opt,name=subjectId,json=subject_id,proto3" json:"subjectId,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventDataRequestCompleted) Reset() {
+	*x = EventDataRequestCompleted{}
+	mi := &file_events_datarequest_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventDataRequestCompleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDataRequestCompleted) ProtoMessage() {}
+
+func (x *EventDataRequestCompleted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_datarequest_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventDataRequestCompleted.ProtoReflect.Descriptor instead.
+func (*EventDataRequestCompleted) Descriptor() ([]byte, []int) {
+	return file_events_datarequest_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventDataRequestCompleted) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventDataRequestCompleted) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *EventDataRequestCompleted) GetSubjectKind() string {
+	if x != nil {
+		return x.SubjectKind
+	}
+	return ""
+}
+
+func (x *EventDataRequestCompleted) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *EventDataRequestCompleted) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+var File_events_datarequest_proto protoreflect.FileDescriptor
+
+const file_events_datarequest_proto_rawDesc = "" +
+	"\n" +
+	"\x18events/datarequest.proto\x12\x0fukama.events.v1\"x\n" +
+	"\x10EventDataRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12!\n" +
+	"\vsubjectKind\x18\x03 \x01(\tR\fsubject_kind\x12\x1d\n" +
+	"\tsubjectId\x18\x04 \x01(\tR\n" +
+	"subject_id\"\x95\x02\n" +
+	"\x16EventDataRequestResult\x12\x1d\n" +
+	"\trequestId\x18\x01 \x01(\tR\n" +
+	"request_id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12!\n" +
+	"\vsubjectKind\x18\x03 \x01(\tR\fsubject_kind\x12\x1d\n" +
+	"\tsubjectId\x18\x04 \x01(\tR\n" +
+	"subject_id\x12\x18\n" +
+	"\aservice\x18\x05 \x01(\tR\aservice\x12\x18\n" +
+	"\asuccess\x18\x06 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12\x12\n" +
+	"\x04note\x18\b \x01(\tR\x04note\x12\x12\n" +
+	"\x04data\x18\t \x01(\fR\x04data\x12\x14\n" +
+	"\x05imsis\x18\n" +
+	" \x03(\tR\x05imsis\"\x99\x01\n" +
+	"\x19EventDataRequestCompleted\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12!\n" +
+	"\vsubjectKind\x18\x03 \x01(\tR\fsubject_kind\x12\x1d\n" +
+	"\tsubjectId\x18\x04 \x01(\tR\n" +
+	"subject_id\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06statusB5Z3github.com/ukama/ukama/systems/common/pb/gen/eventsb\x06proto3"
+
+var (
+	file_events_datarequest_proto_rawDescOnce sync.Once
+	file_events_datarequest_proto_rawDescData []byte
+)
+
+func file_events_datarequest_proto_rawDescGZIP() []byte {
+	file_events_datarequest_proto_rawDescOnce.Do(func() {
+		file_events_datarequest_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_datarequest_proto_rawDesc), len(file_events_datarequest_proto_rawDesc)))
+	})
+	return file_events_datarequest_proto_rawDescData
+}
+
+var file_events_datarequest_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_events_datarequest_proto_goTypes = []any{
+	(*EventDataRequest)(nil),          // 0: ukama.events.v1.EventDataRequest
+	(*EventDataRequestResult)(nil),    // 1: ukama.events.v1.EventDataRequestResult
+	(*EventDataRequestCompleted)(nil), // 2: ukama.events.v1.EventDataRequestCompleted
+}
+var file_events_datarequest_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_events_datarequest_proto_init() }
+func file_events_datarequest_proto_init() {
+	if File_events_datarequest_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_datarequest_proto_rawDesc), len(file_events_datarequest_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_datarequest_proto_goTypes,
+		DependencyIndexes: file_events_datarequest_proto_depIdxs,
+		MessageInfos:      file_events_datarequest_proto_msgTypes,
+	}.Build()
+	File_events_datarequest_proto = out.File
+	file_events_datarequest_proto_goTypes = nil
+	file_events_datarequest_proto_depIdxs = nil
+}
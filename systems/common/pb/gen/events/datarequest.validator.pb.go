@@ -0,0 +1,25 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: events/datarequest.proto
+
+package events
+
+import (
+	fmt "fmt"
+	math "math"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+func (this *EventDataRequest) Validate() error {
+	return nil
+}
+func (this *EventDataRequestResult) Validate() error {
+	return nil
+}
+func (this *EventDataRequestCompleted) Validate() error {
+	return nil
+}
@@ -7,7 +7,7 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
+// 	protoc-gen-go v1.36.12
 // 	protoc        v6.32.1
 // source: events/health.proto
 
@@ -18,6 +18,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -28,25 +29,22 @@ const (
 )
 
 type HealthReportEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	NodeType      string                 `protobuf:"bytes,3,opt,name=nodeType,proto3" json:"nodeType,omitempty"`
+	SchemaVersion string                 `protobuf:"bytes,4,opt,name=schemaVersion,proto3" json:"schemaVersion,omitempty"`
+	ReportedAt    string                 `protobuf:"bytes,5,opt,name=reportedAt,proto3" json:"reportedAt,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	NodeId        string `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	NodeType      string `protobuf:"bytes,3,opt,name=nodeType,proto3" json:"nodeType,omitempty"`
-	SchemaVersion string `protobuf:"bytes,4,opt,name=schemaVersion,proto3" json:"schemaVersion,omitempty"`
-	ReportedAt    string `protobuf:"bytes,5,opt,name=reportedAt,proto3" json:"reportedAt,omitempty"`
-	Payload       []byte `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HealthReportEvent) Reset() {
 	*x = HealthReportEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_events_health_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_events_health_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *HealthReportEvent) String() string {
@@ -57,7 +55,7 @@ func (*HealthReportEvent) ProtoMessage() {}
 
 func (x *HealthReportEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_events_health_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -118,21 +116,18 @@ func (x *HealthReportEvent) GetPayload() []byte {
 // (by name@version) changes, so subscribers can reconcile without
 // listening to the periodic health-report firehose.
 type HealthAppsChangedEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	NodeType      string                 `protobuf:"bytes,2,opt,name=nodeType,proto3" json:"nodeType,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId   string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	NodeType string `protobuf:"bytes,2,opt,name=nodeType,proto3" json:"nodeType,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HealthAppsChangedEvent) Reset() {
 	*x = HealthAppsChangedEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_events_health_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_events_health_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *HealthAppsChangedEvent) String() string {
@@ -143,7 +138,7 @@ func (*HealthAppsChangedEvent) ProtoMessage() {}
 
 func (x *HealthAppsChangedEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_events_health_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -172,50 +167,189 @@ func (x *HealthAppsChangedEvent) GetNodeType() string {
 	return ""
 }
 
-var File_events_health_proto protoreflect.FileDescriptor
+// HealthAlarmEvent is emitted when a node alarm is raised or cleared. Alarms
+// correlate the typed events of a node's health reports by type and source.
+type HealthAlarmEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlarmId       string                 `protobuf:"bytes,1,opt,name=alarmId,proto3" json:"alarmId,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	NodeType      string                 `protobuf:"bytes,3,opt,name=nodeType,proto3" json:"nodeType,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	Severity      string                 `protobuf:"bytes,6,opt,name=severity,proto3" json:"severity,omitempty"`
+	State         string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	Count         uint32                 `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
+	Value         float64                `protobuf:"fixed64,9,opt,name=value,proto3" json:"value,omitempty"`
+	Message       string                 `protobuf:"bytes,10,opt,name=message,proto3" json:"message,omitempty"`
+	RaisedAt      int64                  `protobuf:"varint,11,opt,name=raisedAt,proto3" json:"raisedAt,omitempty"`
+	ClearedAt     int64                  `protobuf:"varint,12,opt,name=clearedAt,proto3" json:"clearedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthAlarmEvent) Reset() {
+	*x = HealthAlarmEvent{}
+	mi := &file_events_health_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthAlarmEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthAlarmEvent) ProtoMessage() {}
+
+func (x *HealthAlarmEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_health_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthAlarmEvent.ProtoReflect.Descriptor instead.
+func (*HealthAlarmEvent) Descriptor() ([]byte, []int) {
+	return file_events_health_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HealthAlarmEvent) GetAlarmId() string {
+	if x != nil {
+		return x.AlarmId
+	}
+	return ""
+}
+
+func (x *HealthAlarmEvent) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *HealthAlarmEvent) GetNodeType() string {
+	if x != nil {
+		return x.NodeType
+	}
+	return ""
+}
 
-var file_events_health_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f,
-	0x64, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x24, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x22, 0x4c, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x41, 0x70, 0x70, 0x73, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f,
-	0x64, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x35,
-	0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x6b, 0x61,
-	0x6d, 0x61, 0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *HealthAlarmEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
+func (x *HealthAlarmEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *HealthAlarmEvent) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *HealthAlarmEvent) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *HealthAlarmEvent) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *HealthAlarmEvent) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *HealthAlarmEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HealthAlarmEvent) GetRaisedAt() int64 {
+	if x != nil {
+		return x.RaisedAt
+	}
+	return 0
+}
+
+func (x *HealthAlarmEvent) GetClearedAt() int64 {
+	if x != nil {
+		return x.ClearedAt
+	}
+	return 0
+}
+
+var File_events_health_proto protoreflect.FileDescriptor
+
+const file_events_health_proto_rawDesc = "" +
+	"\n" +
+	"\x13events/health.proto\x12\x0fukama.events.v1\"\xb7\x01\n" +
+	"\x11HealthReportEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12\x1a\n" +
+	"\bnodeType\x18\x03 \x01(\tR\bnodeType\x12$\n" +
+	"\rschemaVersion\x18\x04 \x01(\tR\rschemaVersion\x12\x1e\n" +
+	"\n" +
+	"reportedAt\x18\x05 \x01(\tR\n" +
+	"reportedAt\x12\x18\n" +
+	"\apayload\x18\x06 \x01(\fR\apayload\"L\n" +
+	"\x16HealthAppsChangedEvent\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12\x1a\n" +
+	"\bnodeType\x18\x02 \x01(\tR\bnodeType\"\xbe\x02\n" +
+	"\x10HealthAlarmEvent\x12\x18\n" +
+	"\aalarmId\x18\x01 \x01(\tR\aalarmId\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12\x1a\n" +
+	"\bnodeType\x18\x03 \x01(\tR\bnodeType\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12\x1a\n" +
+	"\bseverity\x18\x06 \x01(\tR\bseverity\x12\x14\n" +
+	"\x05state\x18\a \x01(\tR\x05state\x12\x14\n" +
+	"\x05count\x18\b \x01(\rR\x05count\x12\x14\n" +
+	"\x05value\x18\t \x01(\x01R\x05value\x12\x18\n" +
+	"\amessage\x18\n" +
+	" \x01(\tR\amessage\x12\x1a\n" +
+	"\braisedAt\x18\v \x01(\x03R\braisedAt\x12\x1c\n" +
+	"\tclearedAt\x18\f \x01(\x03R\tclearedAtB5Z3github.com/ukama/ukama/systems/common/pb/gen/eventsb\x06proto3"
+
 var (
 	file_events_health_proto_rawDescOnce sync.Once
-	file_events_health_proto_rawDescData = file_events_health_proto_rawDesc
+	file_events_health_proto_rawDescData []byte
 )
 
 func file_events_health_proto_rawDescGZIP() []byte {
 	file_events_health_proto_rawDescOnce.Do(func() {
-		file_events_health_proto_rawDescData = protoimpl.X.CompressGZIP(file_events_health_proto_rawDescData)
+		file_events_health_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_health_proto_rawDesc), len(file_events_health_proto_rawDesc)))
 	})
 	return file_events_health_proto_rawDescData
 }
 
-var file_events_health_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_events_health_proto_goTypes = []interface{}{
+var file_events_health_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_events_health_proto_goTypes = []any{
 	(*HealthReportEvent)(nil),      // 0: ukama.events.v1.HealthReportEvent
 	(*HealthAppsChangedEvent)(nil), // 1: ukama.events.v1.HealthAppsChangedEvent
+	(*HealthAlarmEvent)(nil),       // 2: ukama.events.v1.HealthAlarmEvent
 }
 var file_events_health_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -230,39 +364,13 @@ func file_events_health_proto_init() {
 	if File_events_health_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_events_health_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthReportEvent); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_events_health_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthAppsChangedEvent); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_events_health_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_health_proto_rawDesc), len(file_events_health_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -271,7 +379,6 @@ func file_events_health_proto_init() {
 		MessageInfos:      file_events_health_proto_msgTypes,
 	}.Build()
 	File_events_health_proto = out.File
-	file_events_health_proto_rawDesc = nil
 	file_events_health_proto_goTypes = nil
 	file_events_health_proto_depIdxs = nil
 }
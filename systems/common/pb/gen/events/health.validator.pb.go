@@ -20,3 +20,6 @@ func (this *HealthReportEvent) Validate() error {
 func (this *HealthAppsChangedEvent) Validate() error {
 	return nil
 }
+func (this *HealthAlarmEvent) Validate() error {
+	return nil
+}
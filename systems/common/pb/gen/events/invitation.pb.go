@@ -7,8 +7,8 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
-// 	protoc        v5.28.2
+// 	protoc-gen-go v1.36.12
+// 	protoc        v6.32.1
 // source: events/invitation.proto
 
 package events
@@ -19,6 +19,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -29,29 +30,26 @@ const (
 )
 
 type EventInvitationCreated struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Link          string                 `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Role          ukama.RoleType         `protobuf:"varint,5,opt,name=role,proto3,enum=ukama.common.v1.RoleType" json:"role,omitempty"`
+	Status        ukama.InvitationStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ukama.common.v1.InvitationStatus" json:"status,omitempty"`
+	UserId        string                 `protobuf:"bytes,7,opt,name=userId,json=user_id,proto3" json:"userId,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,8,opt,name=expiresAt,json=expires_at,proto3" json:"expiresAt,omitempty"`
+	OrgName       string                 `protobuf:"bytes,9,opt,name=orgName,json=org_name,proto3" json:"orgName,omitempty"`
+	OwnerName     string                 `protobuf:"bytes,10,opt,name=ownerName,json=owner_name,proto3" json:"ownerName,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Link      string                 `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
-	Email     string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Name      string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Role      ukama.RoleType         `protobuf:"varint,5,opt,name=role,proto3,enum=ukama.common.v1.RoleType" json:"role,omitempty"`
-	Status    ukama.InvitationStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ukama.common.v1.InvitationStatus" json:"status,omitempty"`
-	UserId    string                 `protobuf:"bytes,7,opt,name=userId,json=user_id,proto3" json:"userId,omitempty"`
-	ExpiresAt string                 `protobuf:"bytes,8,opt,name=expiresAt,json=expires_at,proto3" json:"expiresAt,omitempty"`
-	OrgName   string                 `protobuf:"bytes,9,opt,name=orgName,json=org_name,proto3" json:"orgName,omitempty"`
-	OwnerName string                 `protobuf:"bytes,10,opt,name=ownerName,json=owner_name,proto3" json:"ownerName,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EventInvitationCreated) Reset() {
 	*x = EventInvitationCreated{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_events_invitation_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_events_invitation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *EventInvitationCreated) String() string {
@@ -62,7 +60,7 @@ func (*EventInvitationCreated) ProtoMessage() {}
 
 func (x *EventInvitationCreated) ProtoReflect() protoreflect.Message {
 	mi := &file_events_invitation_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -148,24 +146,21 @@ func (x *EventInvitationCreated) GetOwnerName() string {
 }
 
 type EventInvitationDeleted struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Role          ukama.RoleType         `protobuf:"varint,4,opt,name=role,proto3,enum=ukama.common.v1.RoleType" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=userId,json=user_id,proto3" json:"userId,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id     string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email  string         `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Name   string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Role   ukama.RoleType `protobuf:"varint,4,opt,name=role,proto3,enum=ukama.common.v1.RoleType" json:"role,omitempty"`
-	UserId string         `protobuf:"bytes,5,opt,name=userId,json=user_id,proto3" json:"userId,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EventInvitationDeleted) Reset() {
 	*x = EventInvitationDeleted{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_events_invitation_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_events_invitation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *EventInvitationDeleted) String() string {
@@ -176,7 +171,7 @@ func (*EventInvitationDeleted) ProtoMessage() {}
 
 func (x *EventInvitationDeleted) ProtoReflect() protoreflect.Message {
 	mi := &file_events_invitation_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -227,27 +222,25 @@ func (x *EventInvitationDeleted) GetUserId() string {
 }
 
 type EventInvitationUpdated struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Link          string                 `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Role          ukama.RoleType         `protobuf:"varint,5,opt,name=role,proto3,enum=ukama.common.v1.RoleType" json:"role,omitempty"`
+	Status        ukama.InvitationStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ukama.common.v1.InvitationStatus" json:"status,omitempty"`
+	UserId        string                 `protobuf:"bytes,7,opt,name=userId,json=user_id,proto3" json:"userId,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,8,opt,name=expiresAt,json=expires_at,proto3" json:"expiresAt,omitempty"`
+	Scopes        []*InvitationScope     `protobuf:"bytes,9,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Link      string                 `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
-	Email     string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Name      string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Role      ukama.RoleType         `protobuf:"varint,5,opt,name=role,proto3,enum=ukama.common.v1.RoleType" json:"role,omitempty"`
-	Status    ukama.InvitationStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ukama.common.v1.InvitationStatus" json:"status,omitempty"`
-	UserId    string                 `protobuf:"bytes,7,opt,name=userId,json=user_id,proto3" json:"userId,omitempty"`
-	ExpiresAt string                 `protobuf:"bytes,8,opt,name=expiresAt,json=expires_at,proto3" json:"expiresAt,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EventInvitationUpdated) Reset() {
 	*x = EventInvitationUpdated{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_events_invitation_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_events_invitation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *EventInvitationUpdated) String() string {
@@ -258,7 +251,7 @@ func (*EventInvitationUpdated) ProtoMessage() {}
 
 func (x *EventInvitationUpdated) ProtoReflect() protoreflect.Message {
 	mi := &file_events_invitation_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -329,99 +322,243 @@ func (x *EventInvitationUpdated) GetExpiresAt() string {
 	return ""
 }
 
-var File_events_invitation_proto protoreflect.FileDescriptor
+func (x *EventInvitationUpdated) GetScopes() []*InvitationScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// role the invited user gets on a network or site instead of the whole org
+type InvitationScope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	ScopeKind     string                 `protobuf:"bytes,2,opt,name=scopeKind,json=scope_kind,proto3" json:"scopeKind,omitempty"`
+	ScopeId       string                 `protobuf:"bytes,3,opt,name=scopeId,json=scope_id,proto3" json:"scopeId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvitationScope) Reset() {
+	*x = InvitationScope{}
+	mi := &file_events_invitation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvitationScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationScope) ProtoMessage() {}
+
+func (x *InvitationScope) ProtoReflect() protoreflect.Message {
+	mi := &file_events_invitation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationScope.ProtoReflect.Descriptor instead.
+func (*InvitationScope) Descriptor() ([]byte, []int) {
+	return file_events_invitation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InvitationScope) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *InvitationScope) GetScopeKind() string {
+	if x != nil {
+		return x.ScopeKind
+	}
+	return ""
+}
+
+func (x *InvitationScope) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
 
-var file_events_invitation_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x75, 0x6b, 0x61, 0x6d, 0x61,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x75, 0x6b, 0x61, 0x6d,
-	0x61, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x75,
-	0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x02, 0x0a,
-	0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x72, 0x6f, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x17, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x67, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x9a, 0x01, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x88,
-	0x02, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
-	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x17, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x75, 0x6b,
-	0x61, 0x6d, 0x61, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type EventInvitationExpired struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Role          ukama.RoleType         `protobuf:"varint,4,opt,name=role,proto3,enum=ukama.common.v1.RoleType" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=userId,json=user_id,proto3" json:"userId,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expiresAt,json=expires_at,proto3" json:"expiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventInvitationExpired) Reset() {
+	*x = EventInvitationExpired{}
+	mi := &file_events_invitation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventInvitationExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventInvitationExpired) ProtoMessage() {}
+
+func (x *EventInvitationExpired) ProtoReflect() protoreflect.Message {
+	mi := &file_events_invitation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventInvitationExpired.ProtoReflect.Descriptor instead.
+func (*EventInvitationExpired) Descriptor() ([]byte, []int) {
+	return file_events_invitation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventInvitationExpired) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventInvitationExpired) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
 }
 
+func (x *EventInvitationExpired) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventInvitationExpired) GetRole() ukama.RoleType {
+	if x != nil {
+		return x.Role
+	}
+	return ukama.RoleType(0)
+}
+
+func (x *EventInvitationExpired) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EventInvitationExpired) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+var File_events_invitation_proto protoreflect.FileDescriptor
+
+const file_events_invitation_proto_rawDesc = "" +
+	"\n" +
+	"\x17events/invitation.proto\x12\x0fukama.events.v1\x1a\x11ukama/roles.proto\x1a\x1dukama/invitation-status.proto\"\xc2\x02\n" +
+	"\x16EventInvitationCreated\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04link\x18\x02 \x01(\tR\x04link\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12-\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x19.ukama.common.v1.RoleTypeR\x04role\x129\n" +
+	"\x06status\x18\x06 \x01(\x0e2!.ukama.common.v1.InvitationStatusR\x06status\x12\x17\n" +
+	"\x06userId\x18\a \x01(\tR\auser_id\x12\x1d\n" +
+	"\texpiresAt\x18\b \x01(\tR\n" +
+	"expires_at\x12\x19\n" +
+	"\aorgName\x18\t \x01(\tR\borg_name\x12\x1d\n" +
+	"\townerName\x18\n" +
+	" \x01(\tR\n" +
+	"owner_name\"\x9a\x01\n" +
+	"\x16EventInvitationDeleted\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12-\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x19.ukama.common.v1.RoleTypeR\x04role\x12\x17\n" +
+	"\x06userId\x18\x05 \x01(\tR\auser_id\"\xc2\x02\n" +
+	"\x16EventInvitationUpdated\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04link\x18\x02 \x01(\tR\x04link\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12-\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x19.ukama.common.v1.RoleTypeR\x04role\x129\n" +
+	"\x06status\x18\x06 \x01(\x0e2!.ukama.common.v1.InvitationStatusR\x06status\x12\x17\n" +
+	"\x06userId\x18\a \x01(\tR\auser_id\x12\x1d\n" +
+	"\texpiresAt\x18\b \x01(\tR\n" +
+	"expires_at\x128\n" +
+	"\x06scopes\x18\t \x03(\v2 .ukama.events.v1.InvitationScopeR\x06scopes\"_\n" +
+	"\x0fInvitationScope\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1d\n" +
+	"\tscopeKind\x18\x02 \x01(\tR\n" +
+	"scope_kind\x12\x19\n" +
+	"\ascopeId\x18\x03 \x01(\tR\bscope_id\"\xb9\x01\n" +
+	"\x16EventInvitationExpired\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12-\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x19.ukama.common.v1.RoleTypeR\x04role\x12\x17\n" +
+	"\x06userId\x18\x05 \x01(\tR\auser_id\x12\x1d\n" +
+	"\texpiresAt\x18\x06 \x01(\tR\n" +
+	"expires_atB5Z3github.com/ukama/ukama/systems/common/pb/gen/eventsb\x06proto3"
+
 var (
 	file_events_invitation_proto_rawDescOnce sync.Once
-	file_events_invitation_proto_rawDescData = file_events_invitation_proto_rawDesc
+	file_events_invitation_proto_rawDescData []byte
 )
 
 func file_events_invitation_proto_rawDescGZIP() []byte {
 	file_events_invitation_proto_rawDescOnce.Do(func() {
-		file_events_invitation_proto_rawDescData = protoimpl.X.CompressGZIP(file_events_invitation_proto_rawDescData)
+		file_events_invitation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_invitation_proto_rawDesc), len(file_events_invitation_proto_rawDesc)))
 	})
 	return file_events_invitation_proto_rawDescData
 }
 
-var file_events_invitation_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
-var file_events_invitation_proto_goTypes = []interface{}{
+var file_events_invitation_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_events_invitation_proto_goTypes = []any{
 	(*EventInvitationCreated)(nil), // 0: ukama.events.v1.EventInvitationCreated
 	(*EventInvitationDeleted)(nil), // 1: ukama.events.v1.EventInvitationDeleted
 	(*EventInvitationUpdated)(nil), // 2: ukama.events.v1.EventInvitationUpdated
-	(ukama.RoleType)(0),            // 3: ukama.common.v1.RoleType
-	(ukama.InvitationStatus)(0),    // 4: ukama.common.v1.InvitationStatus
+	(*InvitationScope)(nil),        // 3: ukama.events.v1.InvitationScope
+	(*EventInvitationExpired)(nil), // 4: ukama.events.v1.EventInvitationExpired
+	(ukama.RoleType)(0),            // 5: ukama.common.v1.RoleType
+	(ukama.InvitationStatus)(0),    // 6: ukama.common.v1.InvitationStatus
 }
 var file_events_invitation_proto_depIdxs = []int32{
-	3, // 0: ukama.events.v1.EventInvitationCreated.role:type_name -> ukama.common.v1.RoleType
-	4, // 1: ukama.events.v1.EventInvitationCreated.status:type_name -> ukama.common.v1.InvitationStatus
-	3, // 2: ukama.events.v1.EventInvitationDeleted.role:type_name -> ukama.common.v1.RoleType
-	3, // 3: ukama.events.v1.EventInvitationUpdated.role:type_name -> ukama.common.v1.RoleType
-	4, // 4: ukama.events.v1.EventInvitationUpdated.status:type_name -> ukama.common.v1.InvitationStatus
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	5, // 0: ukama.events.v1.EventInvitationCreated.role:type_name -> ukama.common.v1.RoleType
+	6, // 1: ukama.events.v1.EventInvitationCreated.status:type_name -> ukama.common.v1.InvitationStatus
+	5, // 2: ukama.events.v1.EventInvitationDeleted.role:type_name -> ukama.common.v1.RoleType
+	5, // 3: ukama.events.v1.EventInvitationUpdated.role:type_name -> ukama.common.v1.RoleType
+	6, // 4: ukama.events.v1.EventInvitationUpdated.status:type_name -> ukama.common.v1.InvitationStatus
+	3, // 5: ukama.events.v1.EventInvitationUpdated.scopes:type_name -> ukama.events.v1.InvitationScope
+	5, // 6: ukama.events.v1.EventInvitationExpired.role:type_name -> ukama.common.v1.RoleType
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_events_invitation_proto_init() }
@@ -429,51 +566,13 @@ func file_events_invitation_proto_init() {
 	if File_events_invitation_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_events_invitation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventInvitationCreated); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_events_invitation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventInvitationDeleted); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_events_invitation_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventInvitationUpdated); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_events_invitation_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_invitation_proto_rawDesc), len(file_events_invitation_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -482,7 +581,6 @@ func file_events_invitation_proto_init() {
 		MessageInfos:      file_events_invitation_proto_msgTypes,
 	}.Build()
 	File_events_invitation_proto = out.File
-	file_events_invitation_proto_rawDesc = nil
 	file_events_invitation_proto_goTypes = nil
 	file_events_invitation_proto_depIdxs = nil
 }
@@ -8,6 +8,7 @@ import (
 	math "math"
 	proto "github.com/golang/protobuf/proto"
 	_ "github.com/ukama/ukama/systems/common/pb/gen/ukama"
+	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -22,5 +23,18 @@ func (this *EventInvitationDeleted) Validate() error {
 	return nil
 }
 func (this *EventInvitationUpdated) Validate() error {
+	for _, item := range this.Scopes {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Scopes", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *InvitationScope) Validate() error {
+	return nil
+}
+func (this *EventInvitationExpired) Validate() error {
 	return nil
 }
@@ -7,8 +7,8 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
-// 	protoc        v3.14.0
+// 	protoc-gen-go v1.36.12
+// 	protoc        v6.32.1
 // source: events/nodestate.proto
 
 package events
@@ -19,6 +19,7 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -29,24 +30,21 @@ const (
 )
 
 type NodeStateChangeEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Substate      string                 `protobuf:"bytes,4,opt,name=substate,proto3" json:"substate,omitempty"`
+	Events        []string               `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId    string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	State     string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
-	Substate  string                 `protobuf:"bytes,4,opt,name=substate,proto3" json:"substate,omitempty"`
-	Events    []string               `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NodeStateChangeEvent) Reset() {
 	*x = NodeStateChangeEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_events_nodestate_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_events_nodestate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *NodeStateChangeEvent) String() string {
@@ -57,7 +55,7 @@ func (*NodeStateChangeEvent) ProtoMessage() {}
 
 func (x *NodeStateChangeEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_events_nodestate_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -108,21 +106,18 @@ func (x *NodeStateChangeEvent) GetTimestamp() *timestamppb.Timestamp {
 }
 
 type EnforceNodeStateEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	Event         string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId string `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	Event  string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EnforceNodeStateEvent) Reset() {
 	*x = EnforceNodeStateEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_events_nodestate_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_events_nodestate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *EnforceNodeStateEvent) String() string {
@@ -133,7 +128,7 @@ func (*EnforceNodeStateEvent) ProtoMessage() {}
 
 func (x *EnforceNodeStateEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_events_nodestate_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -162,61 +157,133 @@ func (x *EnforceNodeStateEvent) GetEvent() string {
 	return ""
 }
 
-var File_events_nodestate_proto protoreflect.FileDescriptor
+// NodeStateFlappingEvent is emitted when a node changes state at least
+// `transitions` times within `windowSec`.
+type NodeStateFlappingEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	Transitions   uint32                 `protobuf:"varint,2,opt,name=transitions,proto3" json:"transitions,omitempty"`
+	WindowSec     uint32                 `protobuf:"varint,3,opt,name=windowSec,proto3" json:"windowSec,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeStateFlappingEvent) Reset() {
+	*x = NodeStateFlappingEvent{}
+	mi := &file_events_nodestate_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeStateFlappingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_events_nodestate_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb2, 0x01, 0x0a, 0x14, 0x4e,
-	0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
-	0x45, 0x0a, 0x15, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61,
-	0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
-	0x70, 0x62, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (*NodeStateFlappingEvent) ProtoMessage() {}
+
+func (x *NodeStateFlappingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_nodestate_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
+// Deprecated: Use NodeStateFlappingEvent.ProtoReflect.Descriptor instead.
+func (*NodeStateFlappingEvent) Descriptor() ([]byte, []int) {
+	return file_events_nodestate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NodeStateFlappingEvent) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeStateFlappingEvent) GetTransitions() uint32 {
+	if x != nil {
+		return x.Transitions
+	}
+	return 0
+}
+
+func (x *NodeStateFlappingEvent) GetWindowSec() uint32 {
+	if x != nil {
+		return x.WindowSec
+	}
+	return 0
+}
+
+func (x *NodeStateFlappingEvent) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *NodeStateFlappingEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+var File_events_nodestate_proto protoreflect.FileDescriptor
+
+const file_events_nodestate_proto_rawDesc = "" +
+	"\n" +
+	"\x16events/nodestate.proto\x12\x0fukama.events.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb2\x01\n" +
+	"\x14NodeStateChangeEvent\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x1a\n" +
+	"\bsubstate\x18\x04 \x01(\tR\bsubstate\x12\x16\n" +
+	"\x06events\x18\x06 \x03(\tR\x06events\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"E\n" +
+	"\x15EnforceNodeStateEvent\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12\x14\n" +
+	"\x05event\x18\x03 \x01(\tR\x05event\"\xdc\x01\n" +
+	"\x16NodeStateFlappingEvent\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12 \n" +
+	"\vtransitions\x18\x02 \x01(\rR\vtransitions\x12\x1c\n" +
+	"\twindowSec\x18\x03 \x01(\rR\twindowSec\x120\n" +
+	"\x05since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestampB5Z3github.com/ukama/ukama/systems/common/pb/gen/eventsb\x06proto3"
+
 var (
 	file_events_nodestate_proto_rawDescOnce sync.Once
-	file_events_nodestate_proto_rawDescData = file_events_nodestate_proto_rawDesc
+	file_events_nodestate_proto_rawDescData []byte
 )
 
 func file_events_nodestate_proto_rawDescGZIP() []byte {
 	file_events_nodestate_proto_rawDescOnce.Do(func() {
-		file_events_nodestate_proto_rawDescData = protoimpl.X.CompressGZIP(file_events_nodestate_proto_rawDescData)
+		file_events_nodestate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_nodestate_proto_rawDesc), len(file_events_nodestate_proto_rawDesc)))
 	})
 	return file_events_nodestate_proto_rawDescData
 }
 
-var file_events_nodestate_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_events_nodestate_proto_goTypes = []interface{}{
-	(*NodeStateChangeEvent)(nil),  // 0: ukama.events.v1.NodeStateChangeEvent
-	(*EnforceNodeStateEvent)(nil), // 1: ukama.events.v1.EnforceNodeStateEvent
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+var file_events_nodestate_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_events_nodestate_proto_goTypes = []any{
+	(*NodeStateChangeEvent)(nil),   // 0: ukama.events.v1.NodeStateChangeEvent
+	(*EnforceNodeStateEvent)(nil),  // 1: ukama.events.v1.EnforceNodeStateEvent
+	(*NodeStateFlappingEvent)(nil), // 2: ukama.events.v1.NodeStateFlappingEvent
+	(*timestamppb.Timestamp)(nil),  // 3: google.protobuf.Timestamp
 }
 var file_events_nodestate_proto_depIdxs = []int32{
-	2, // 0: ukama.events.v1.NodeStateChangeEvent.timestamp:type_name -> google.protobuf.Timestamp
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: ukama.events.v1.NodeStateChangeEvent.timestamp:type_name -> google.protobuf.Timestamp
+	3, // 1: ukama.events.v1.NodeStateFlappingEvent.since:type_name -> google.protobuf.Timestamp
+	3, // 2: ukama.events.v1.NodeStateFlappingEvent.timestamp:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_events_nodestate_proto_init() }
@@ -224,39 +291,13 @@ func file_events_nodestate_proto_init() {
 	if File_events_nodestate_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_events_nodestate_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeStateChangeEvent); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_events_nodestate_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnforceNodeStateEvent); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_events_nodestate_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_nodestate_proto_rawDesc), len(file_events_nodestate_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -265,7 +306,6 @@ func file_events_nodestate_proto_init() {
 		MessageInfos:      file_events_nodestate_proto_msgTypes,
 	}.Build()
 	File_events_nodestate_proto = out.File
-	file_events_nodestate_proto_rawDesc = nil
 	file_events_nodestate_proto_goTypes = nil
 	file_events_nodestate_proto_depIdxs = nil
 }
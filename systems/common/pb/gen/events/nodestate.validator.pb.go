@@ -27,3 +27,16 @@ func (this *NodeStateChangeEvent) Validate() error {
 func (this *EnforceNodeStateEvent) Validate() error {
 	return nil
 }
+func (this *NodeStateFlappingEvent) Validate() error {
+	if this.Since != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Since); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Since", err)
+		}
+	}
+	if this.Timestamp != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Timestamp); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Timestamp", err)
+		}
+	}
+	return nil
+}
@@ -7,7 +7,7 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
+// 	protoc-gen-go v1.36.12
 // 	protoc        v6.32.1
 // source: events/site.proto
 
@@ -19,6 +19,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -29,30 +30,27 @@ const (
 )
 
 type EventAddSite struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	NetworkId     string                 `protobuf:"bytes,3,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
+	BackhaulId    string                 `protobuf:"bytes,4,opt,name=backhaulId,json=backhaul_id,proto3" json:"backhaulId,omitempty"`
+	PowerId       string                 `protobuf:"bytes,5,opt,name=powerId,json=power_id,proto3" json:"powerId,omitempty"`
+	AccessId      string                 `protobuf:"bytes,6,opt,name=accessId,json=access_id,proto3" json:"accessId,omitempty"`
+	SwitchId      string                 `protobuf:"bytes,7,opt,name=switchId,json=switch_id,proto3" json:"switchId,omitempty"`
+	IsDeactivated bool                   `protobuf:"varint,8,opt,name=isDeactivated,json=is_deactivated,proto3" json:"isDeactivated,omitempty"`
+	Latitude      string                 `protobuf:"bytes,9,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     string                 `protobuf:"bytes,10,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	InstallDate   string                 `protobuf:"bytes,11,opt,name=installDate,json=install_date,proto3" json:"installDate,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SiteId        string `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	NetworkId     string `protobuf:"bytes,3,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
-	BackhaulId    string `protobuf:"bytes,4,opt,name=backhaulId,json=backhaul_id,proto3" json:"backhaulId,omitempty"`
-	PowerId       string `protobuf:"bytes,5,opt,name=powerId,json=power_id,proto3" json:"powerId,omitempty"`
-	AccessId      string `protobuf:"bytes,6,opt,name=accessId,json=access_id,proto3" json:"accessId,omitempty"`
-	SwitchId      string `protobuf:"bytes,7,opt,name=switchId,json=switch_id,proto3" json:"switchId,omitempty"`
-	IsDeactivated bool   `protobuf:"varint,8,opt,name=isDeactivated,json=is_deactivated,proto3" json:"isDeactivated,omitempty"`
-	Latitude      string `protobuf:"bytes,9,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude     string `protobuf:"bytes,10,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	InstallDate   string `protobuf:"bytes,11,opt,name=installDate,json=install_date,proto3" json:"installDate,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EventAddSite) Reset() {
 	*x = EventAddSite{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_events_site_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_events_site_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *EventAddSite) String() string {
@@ -63,7 +61,7 @@ func (*EventAddSite) ProtoMessage() {}
 
 func (x *EventAddSite) ProtoReflect() protoreflect.Message {
 	mi := &file_events_site_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -156,30 +154,27 @@ func (x *EventAddSite) GetInstallDate() string {
 }
 
 type EventUpdateSite struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	BackhaulId    string                 `protobuf:"bytes,4,opt,name=backhaulId,json=backhaul_id,proto3" json:"backhaulId,omitempty"`
+	PowerId       string                 `protobuf:"bytes,5,opt,name=powerId,json=power_id,proto3" json:"powerId,omitempty"`
+	AccessId      string                 `protobuf:"bytes,6,opt,name=accessId,json=access_id,proto3" json:"accessId,omitempty"`
+	SwitchId      string                 `protobuf:"bytes,7,opt,name=switchId,json=switch_id,proto3" json:"switchId,omitempty"`
+	IsDeactivated bool                   `protobuf:"varint,8,opt,name=isDeactivated,json=is_deactivated,proto3" json:"isDeactivated,omitempty"`
+	Latitude      string                 `protobuf:"bytes,9,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     string                 `protobuf:"bytes,10,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	NetworkId     string                 `protobuf:"bytes,11,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
+	InstallDate   string                 `protobuf:"bytes,12,opt,name=installDate,json=install_date,proto3" json:"installDate,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SiteId        string `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
-	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	BackhaulId    string `protobuf:"bytes,4,opt,name=backhaulId,json=backhaul_id,proto3" json:"backhaulId,omitempty"`
-	PowerId       string `protobuf:"bytes,5,opt,name=powerId,json=power_id,proto3" json:"powerId,omitempty"`
-	AccessId      string `protobuf:"bytes,6,opt,name=accessId,json=access_id,proto3" json:"accessId,omitempty"`
-	SwitchId      string `protobuf:"bytes,7,opt,name=switchId,json=switch_id,proto3" json:"switchId,omitempty"`
-	IsDeactivated bool   `protobuf:"varint,8,opt,name=isDeactivated,json=is_deactivated,proto3" json:"isDeactivated,omitempty"`
-	Latitude      string `protobuf:"bytes,9,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude     string `protobuf:"bytes,10,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	NetworkId     string `protobuf:"bytes,11,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
-	InstallDate   string `protobuf:"bytes,12,opt,name=installDate,json=install_date,proto3" json:"installDate,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EventUpdateSite) Reset() {
 	*x = EventUpdateSite{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_events_site_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_events_site_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *EventUpdateSite) String() string {
@@ -190,7 +185,7 @@ func (*EventUpdateSite) ProtoMessage() {}
 
 func (x *EventUpdateSite) ProtoReflect() protoreflect.Message {
 	mi := &file_events_site_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -283,21 +278,18 @@ func (x *EventUpdateSite) GetInstallDate() string {
 }
 
 type EventDeleteSite struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
+	NetworkId     string                 `protobuf:"bytes,2,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SiteId    string `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
-	NetworkId string `protobuf:"bytes,2,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EventDeleteSite) Reset() {
 	*x = EventDeleteSite{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_events_site_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_events_site_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *EventDeleteSite) String() string {
@@ -308,7 +300,7 @@ func (*EventDeleteSite) ProtoMessage() {}
 
 func (x *EventDeleteSite) ProtoReflect() protoreflect.Message {
 	mi := &file_events_site_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -337,93 +329,288 @@ func (x *EventDeleteSite) GetNetworkId() string {
 	return ""
 }
 
-var File_events_site_proto protoreflect.FileDescriptor
+// The site is kept for history; its nodes and components were released
+type EventDecommissionSite struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	SiteId               string                 `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
+	NetworkId            string                 `protobuf:"bytes,2,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
+	Reason               string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReleasedNodeIds      []string               `protobuf:"bytes,4,rep,name=releasedNodeIds,json=released_node_ids,proto3" json:"releasedNodeIds,omitempty"`
+	ReleasedComponentIds []string               `protobuf:"bytes,5,rep,name=releasedComponentIds,json=released_component_ids,proto3" json:"releasedComponentIds,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *EventDecommissionSite) Reset() {
+	*x = EventDecommissionSite{}
+	mi := &file_events_site_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventDecommissionSite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDecommissionSite) ProtoMessage() {}
+
+func (x *EventDecommissionSite) ProtoReflect() protoreflect.Message {
+	mi := &file_events_site_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventDecommissionSite.ProtoReflect.Descriptor instead.
+func (*EventDecommissionSite) Descriptor() ([]byte, []int) {
+	return file_events_site_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventDecommissionSite) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *EventDecommissionSite) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *EventDecommissionSite) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EventDecommissionSite) GetReleasedNodeIds() []string {
+	if x != nil {
+		return x.ReleasedNodeIds
+	}
+	return nil
+}
 
-var file_events_site_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x76, 0x31, 0x1a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96, 0x03, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41,
-	0x64, 0x64, 0x53, 0x69, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01,
-	0x04, 0x52, 0x07, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28,
-	0x0a, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x0a, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b,
-	0x68, 0x61, 0x75, 0x6c, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf,
-	0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x68, 0x61, 0x75,
-	0x6c, 0x5f, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x07, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04,
-	0x52, 0x08, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x08, 0x61, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf,
-	0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f,
-	0x69, 0x64, 0x12, 0x26, 0x0a, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52,
-	0x09, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0d, 0x69, 0x73,
-	0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0e, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x22, 0x99,
-	0x03, 0x0a, 0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69,
-	0x74, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x07, 0x73,
-	0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x62, 0x61,
-	0x63, 0x6b, 0x68, 0x61, 0x75, 0x6c, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
-	0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x68,
-	0x61, 0x75, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x07, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90,
-	0x01, 0x04, 0x52, 0x08, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x08,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
-	0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x5f, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01,
-	0x04, 0x52, 0x09, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0d,
-	0x69, 0x73, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x28, 0x0a,
-	0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x0a, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x22, 0x5f, 0x0a, 0x0f, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x69, 0x74, 0x65, 0x12, 0x22, 0x0a,
-	0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2,
-	0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x07, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x69,
-	0x64, 0x12, 0x28, 0x0a, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52,
-	0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x42, 0x35, 0x5a, 0x33, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f,
-	0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *EventDecommissionSite) GetReleasedComponentIds() []string {
+	if x != nil {
+		return x.ReleasedComponentIds
+	}
+	return nil
 }
 
+type EventRelocateSite struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	SiteId             string                 `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
+	NetworkId          string                 `protobuf:"bytes,2,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
+	Reason             string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Latitude           string                 `protobuf:"bytes,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude          string                 `protobuf:"bytes,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Location           string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	BackhaulId         string                 `protobuf:"bytes,7,opt,name=backhaulId,json=backhaul_id,proto3" json:"backhaulId,omitempty"`
+	PreviousLatitude   string                 `protobuf:"bytes,8,opt,name=previousLatitude,json=previous_latitude,proto3" json:"previousLatitude,omitempty"`
+	PreviousLongitude  string                 `protobuf:"bytes,9,opt,name=previousLongitude,json=previous_longitude,proto3" json:"previousLongitude,omitempty"`
+	PreviousLocation   string                 `protobuf:"bytes,10,opt,name=previousLocation,json=previous_location,proto3" json:"previousLocation,omitempty"`
+	PreviousBackhaulId string                 `protobuf:"bytes,11,opt,name=previousBackhaulId,json=previous_backhaul_id,proto3" json:"previousBackhaulId,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *EventRelocateSite) Reset() {
+	*x = EventRelocateSite{}
+	mi := &file_events_site_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventRelocateSite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRelocateSite) ProtoMessage() {}
+
+func (x *EventRelocateSite) ProtoReflect() protoreflect.Message {
+	mi := &file_events_site_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRelocateSite.ProtoReflect.Descriptor instead.
+func (*EventRelocateSite) Descriptor() ([]byte, []int) {
+	return file_events_site_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventRelocateSite) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *EventRelocateSite) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *EventRelocateSite) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EventRelocateSite) GetLatitude() string {
+	if x != nil {
+		return x.Latitude
+	}
+	return ""
+}
+
+func (x *EventRelocateSite) GetLongitude() string {
+	if x != nil {
+		return x.Longitude
+	}
+	return ""
+}
+
+func (x *EventRelocateSite) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *EventRelocateSite) GetBackhaulId() string {
+	if x != nil {
+		return x.BackhaulId
+	}
+	return ""
+}
+
+func (x *EventRelocateSite) GetPreviousLatitude() string {
+	if x != nil {
+		return x.PreviousLatitude
+	}
+	return ""
+}
+
+func (x *EventRelocateSite) GetPreviousLongitude() string {
+	if x != nil {
+		return x.PreviousLongitude
+	}
+	return ""
+}
+
+func (x *EventRelocateSite) GetPreviousLocation() string {
+	if x != nil {
+		return x.PreviousLocation
+	}
+	return ""
+}
+
+func (x *EventRelocateSite) GetPreviousBackhaulId() string {
+	if x != nil {
+		return x.PreviousBackhaulId
+	}
+	return ""
+}
+
+var File_events_site_proto protoreflect.FileDescriptor
+
+const file_events_site_proto_rawDesc = "" +
+	"\n" +
+	"\x11events/site.proto\x12\x0fukama.events.v1\x1a\x0fvalidator.proto\"\x96\x03\n" +
+	"\fEventAddSite\x12\"\n" +
+	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12(\n" +
+	"\tnetworkId\x18\x03 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\n" +
+	"network_id\x12*\n" +
+	"\n" +
+	"backhaulId\x18\x04 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\vbackhaul_id\x12$\n" +
+	"\apowerId\x18\x05 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\bpower_id\x12&\n" +
+	"\baccessId\x18\x06 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\taccess_id\x12&\n" +
+	"\bswitchId\x18\a \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\tswitch_id\x12%\n" +
+	"\risDeactivated\x18\b \x01(\bR\x0eis_deactivated\x12\x1a\n" +
+	"\blatitude\x18\t \x01(\tR\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\n" +
+	" \x01(\tR\tlongitude\x12!\n" +
+	"\vinstallDate\x18\v \x01(\tR\finstall_date\"\x99\x03\n" +
+	"\x0fEventUpdateSite\x12\"\n" +
+	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12*\n" +
+	"\n" +
+	"backhaulId\x18\x04 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\vbackhaul_id\x12$\n" +
+	"\apowerId\x18\x05 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\bpower_id\x12&\n" +
+	"\baccessId\x18\x06 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\taccess_id\x12&\n" +
+	"\bswitchId\x18\a \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\tswitch_id\x12%\n" +
+	"\risDeactivated\x18\b \x01(\bR\x0eis_deactivated\x12\x1a\n" +
+	"\blatitude\x18\t \x01(\tR\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\n" +
+	" \x01(\tR\tlongitude\x12(\n" +
+	"\tnetworkId\x18\v \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\n" +
+	"network_id\x12!\n" +
+	"\vinstallDate\x18\f \x01(\tR\finstall_date\"_\n" +
+	"\x0fEventDeleteSite\x12\"\n" +
+	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\x12(\n" +
+	"\tnetworkId\x18\x02 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\n" +
+	"network_id\"\xdf\x01\n" +
+	"\x15EventDecommissionSite\x12\"\n" +
+	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\x12(\n" +
+	"\tnetworkId\x18\x02 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\n" +
+	"network_id\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12*\n" +
+	"\x0freleasedNodeIds\x18\x04 \x03(\tR\x11released_node_ids\x124\n" +
+	"\x14releasedComponentIds\x18\x05 \x03(\tR\x16released_component_ids\"\xab\x03\n" +
+	"\x11EventRelocateSite\x12\"\n" +
+	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\x12(\n" +
+	"\tnetworkId\x18\x02 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\n" +
+	"network_id\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\tR\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\tR\tlongitude\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12\x1f\n" +
+	"\n" +
+	"backhaulId\x18\a \x01(\tR\vbackhaul_id\x12+\n" +
+	"\x10previousLatitude\x18\b \x01(\tR\x11previous_latitude\x12-\n" +
+	"\x11previousLongitude\x18\t \x01(\tR\x12previous_longitude\x12+\n" +
+	"\x10previousLocation\x18\n" +
+	" \x01(\tR\x11previous_location\x120\n" +
+	"\x12previousBackhaulId\x18\v \x01(\tR\x14previous_backhaul_idB5Z3github.com/ukama/ukama/systems/common/pb/gen/eventsb\x06proto3"
+
 var (
 	file_events_site_proto_rawDescOnce sync.Once
-	file_events_site_proto_rawDescData = file_events_site_proto_rawDesc
+	file_events_site_proto_rawDescData []byte
 )
 
 func file_events_site_proto_rawDescGZIP() []byte {
 	file_events_site_proto_rawDescOnce.Do(func() {
-		file_events_site_proto_rawDescData = protoimpl.X.CompressGZIP(file_events_site_proto_rawDescData)
+		file_events_site_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_site_proto_rawDesc), len(file_events_site_proto_rawDesc)))
 	})
 	return file_events_site_proto_rawDescData
 }
 
-var file_events_site_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
-var file_events_site_proto_goTypes = []interface{}{
-	(*EventAddSite)(nil),    // 0: ukama.events.v1.EventAddSite
-	(*EventUpdateSite)(nil), // 1: ukama.events.v1.EventUpdateSite
-	(*EventDeleteSite)(nil), // 2: ukama.events.v1.EventDeleteSite
+var file_events_site_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_events_site_proto_goTypes = []any{
+	(*EventAddSite)(nil),          // 0: ukama.events.v1.EventAddSite
+	(*EventUpdateSite)(nil),       // 1: ukama.events.v1.EventUpdateSite
+	(*EventDeleteSite)(nil),       // 2: ukama.events.v1.EventDeleteSite
+	(*EventDecommissionSite)(nil), // 3: ukama.events.v1.EventDecommissionSite
+	(*EventRelocateSite)(nil),     // 4: ukama.events.v1.EventRelocateSite
 }
 var file_events_site_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -438,51 +625,13 @@ func file_events_site_proto_init() {
 	if File_events_site_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_events_site_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventAddSite); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_events_site_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventUpdateSite); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_events_site_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventDeleteSite); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_events_site_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_site_proto_rawDesc), len(file_events_site_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -491,7 +640,6 @@ func file_events_site_proto_init() {
 		MessageInfos:      file_events_site_proto_msgTypes,
 	}.Build()
 	File_events_site_proto = out.File
-	file_events_site_proto_rawDesc = nil
 	file_events_site_proto_goTypes = nil
 	file_events_site_proto_depIdxs = nil
 }
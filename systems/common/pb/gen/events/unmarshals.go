@@ -593,6 +593,16 @@ func UnmarshalFeeItem(msg *anypb.Any, emsg string) (*FeeItem, error) {
 	return p, nil
 }
 
+func UnmarshalHealthAlarmEvent(msg *anypb.Any, emsg string) (*HealthAlarmEvent, error) {
+	p := &HealthAlarmEvent{}
+	err := anypb.UnmarshalTo(msg, p, proto.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true})
+	if err != nil {
+		log.Errorf("%s : %+v. Error %s.", emsg, msg, err.Error())
+		return nil, err
+	}
+	return p, nil
+}
+
 func UnmarshalHealthAppsChangedEvent(msg *anypb.Any, emsg string) (*HealthAppsChangedEvent, error) {
 	p := &HealthAppsChangedEvent{}
 	err := anypb.UnmarshalTo(msg, p, proto.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true})
@@ -733,6 +743,16 @@ func UnmarshalNodeStateChangeEvent(msg *anypb.Any, emsg string) (*NodeStateChang
 	return p, nil
 }
 
+func UnmarshalNodeStateFlappingEvent(msg *anypb.Any, emsg string) (*NodeStateFlappingEvent, error) {
+	p := &NodeStateFlappingEvent{}
+	err := anypb.UnmarshalTo(msg, p, proto.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true})
+	if err != nil {
+		log.Errorf("%s : %+v. Error %s.", emsg, msg, err.Error())
+		return nil, err
+	}
+	return p, nil
+}
+
 func UnmarshalNodeStateUpdatedEvent(msg *anypb.Any, emsg string) (*NodeStateUpdatedEvent, error) {
 	p := &NodeStateUpdatedEvent{}
 	err := anypb.UnmarshalTo(msg, p, proto.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true})
@@ -1003,3 +1023,63 @@ func UnmarshalWebhookDeletedEvent(msg *anypb.Any, emsg string) (*WebhookDeletedE
 	return p, nil
 }
 
+
+func UnmarshalEventDecommissionSite(msg *anypb.Any, emsg string) (*EventDecommissionSite, error) {
+	p := &EventDecommissionSite{}
+	err := anypb.UnmarshalTo(msg, p, proto.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true})
+	if err != nil {
+		log.Errorf("%s : %+v. Error %s.", emsg, msg, err.Error())
+		return nil, err
+	}
+	return p, nil
+}
+
+func UnmarshalEventRelocateSite(msg *anypb.Any, emsg string) (*EventRelocateSite, error) {
+	p := &EventRelocateSite{}
+	err := anypb.UnmarshalTo(msg, p, proto.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true})
+	if err != nil {
+		log.Errorf("%s : %+v. Error %s.", emsg, msg, err.Error())
+		return nil, err
+	}
+	return p, nil
+}
+
+func UnmarshalEventInvitationExpired(msg *anypb.Any, emsg string) (*EventInvitationExpired, error) {
+	p := &EventInvitationExpired{}
+	err := anypb.UnmarshalTo(msg, p, proto.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true})
+	if err != nil {
+		log.Errorf("%s : %+v. Error %s.", emsg, msg, err.Error())
+		return nil, err
+	}
+	return p, nil
+}
+
+func UnmarshalEventDataRequest(msg *anypb.Any, emsg string) (*EventDataRequest, error) {
+	p := &EventDataRequest{}
+	err := anypb.UnmarshalTo(msg, p, proto.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true})
+	if err != nil {
+		log.Errorf("%s : %+v. Error %s.", emsg, msg, err.Error())
+		return nil, err
+	}
+	return p, nil
+}
+
+func UnmarshalEventDataRequestResult(msg *anypb.Any, emsg string) (*EventDataRequestResult, error) {
+	p := &EventDataRequestResult{}
+	err := anypb.UnmarshalTo(msg, p, proto.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true})
+	if err != nil {
+		log.Errorf("%s : %+v. Error %s.", emsg, msg, err.Error())
+		return nil, err
+	}
+	return p, nil
+}
+
+func UnmarshalEventDataRequestCompleted(msg *anypb.Any, emsg string) (*EventDataRequestCompleted, error) {
+	p := &EventDataRequestCompleted{}
+	err := anypb.UnmarshalTo(msg, p, proto.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true})
+	if err != nil {
+		log.Errorf("%s : %+v. Error %s.", emsg, msg, err.Error())
+		return nil, err
+	}
+	return p, nil
+}
@@ -7,8 +7,8 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
-// 	protoc        v3.14.0
+// 	protoc-gen-go v1.36.12
+// 	protoc        v6.32.1
 // source: ukama/invitation-status.proto
 
 package ukama
@@ -18,6 +18,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -33,6 +34,7 @@ const (
 	InvitationStatus_INVITE_PENDING  InvitationStatus = 0
 	InvitationStatus_INVITE_ACCEPTED InvitationStatus = 1
 	InvitationStatus_INVITE_DECLINED InvitationStatus = 2
+	InvitationStatus_INVITE_EXPIRED  InvitationStatus = 3
 )
 
 // Enum value maps for InvitationStatus.
@@ -41,11 +43,13 @@ var (
 		0: "INVITE_PENDING",
 		1: "INVITE_ACCEPTED",
 		2: "INVITE_DECLINED",
+		3: "INVITE_EXPIRED",
 	}
 	InvitationStatus_value = map[string]int32{
 		"INVITE_PENDING":  0,
 		"INVITE_ACCEPTED": 1,
 		"INVITE_DECLINED": 2,
+		"INVITE_EXPIRED":  3,
 	}
 )
 
@@ -78,35 +82,29 @@ func (InvitationStatus) EnumDescriptor() ([]byte, []int) {
 
 var File_ukama_invitation_status_proto protoreflect.FileDescriptor
 
-var file_ukama_invitation_status_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x0f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2a, 0x50, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x50,
-	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x56, 0x49,
-	0x54, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a,
-	0x0f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44,
-	0x10, 0x02, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x2f, 0x67,
-	0x65, 0x6e, 0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
+const file_ukama_invitation_status_proto_rawDesc = "" +
+	"\n" +
+	"\x1dukama/invitation-status.proto\x12\x0fukama.common.v1*d\n" +
+	"\x10InvitationStatus\x12\x12\n" +
+	"\x0eINVITE_PENDING\x10\x00\x12\x13\n" +
+	"\x0fINVITE_ACCEPTED\x10\x01\x12\x13\n" +
+	"\x0fINVITE_DECLINED\x10\x02\x12\x12\n" +
+	"\x0eINVITE_EXPIRED\x10\x03B4Z2github.com/ukama/ukama/systems/common/pb/gen/ukamab\x06proto3"
 
 var (
 	file_ukama_invitation_status_proto_rawDescOnce sync.Once
-	file_ukama_invitation_status_proto_rawDescData = file_ukama_invitation_status_proto_rawDesc
+	file_ukama_invitation_status_proto_rawDescData []byte
 )
 
 func file_ukama_invitation_status_proto_rawDescGZIP() []byte {
 	file_ukama_invitation_status_proto_rawDescOnce.Do(func() {
-		file_ukama_invitation_status_proto_rawDescData = protoimpl.X.CompressGZIP(file_ukama_invitation_status_proto_rawDescData)
+		file_ukama_invitation_status_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ukama_invitation_status_proto_rawDesc), len(file_ukama_invitation_status_proto_rawDesc)))
 	})
 	return file_ukama_invitation_status_proto_rawDescData
 }
 
 var file_ukama_invitation_status_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ukama_invitation_status_proto_goTypes = []interface{}{
+var file_ukama_invitation_status_proto_goTypes = []any{
 	(InvitationStatus)(0), // 0: ukama.common.v1.InvitationStatus
 }
 var file_ukama_invitation_status_proto_depIdxs = []int32{
@@ -126,7 +124,7 @@ func file_ukama_invitation_status_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_ukama_invitation_status_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ukama_invitation_status_proto_rawDesc), len(file_ukama_invitation_status_proto_rawDesc)),
 			NumEnums:      1,
 			NumMessages:   0,
 			NumExtensions: 0,
@@ -137,7 +135,6 @@ func file_ukama_invitation_status_proto_init() {
 		EnumInfos:         file_ukama_invitation_status_proto_enumTypes,
 	}.Build()
 	File_ukama_invitation_status_proto = out.File
-	file_ukama_invitation_status_proto_rawDesc = nil
 	file_ukama_invitation_status_proto_goTypes = nil
 	file_ukama_invitation_status_proto_depIdxs = nil
 }
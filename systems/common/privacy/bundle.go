@@ -0,0 +1,122 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package privacy
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+const (
+	FormatJson = "json"
+	FormatCsv  = "csv"
+)
+
+func ParseDocument(data []byte) (Document, error) {
+	doc := Document{}
+	if len(data) == 0 {
+		return doc, nil
+	}
+
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid export document: %w", err)
+	}
+
+	return doc, nil
+}
+
+// BundleJson joins the documents of the services into one JSON object keyed
+// by service.
+func BundleJson(parts map[string]Document) ([]byte, error) {
+	return json.MarshalIndent(parts, "", "  ")
+}
+
+// BundleCsv writes every table of every service as <service>/<table>.csv in
+// a zip archive. Columns are the union of the record keys, sorted, and
+// nested values are written as JSON.
+func BundleCsv(parts map[string]Document) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+
+	for _, service := range sortedKeys(parts) {
+		doc := parts[service]
+		for _, table := range sortedKeys(doc) {
+			f, err := zw.Create(service + "/" + table + ".csv")
+			if err != nil {
+				return nil, err
+			}
+
+			if err := writeCsv(f, doc[table]); err != nil {
+				return nil, fmt.Errorf("failed to write %s/%s: %w", service, table, err)
+			}
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeCsv(f interface{ Write([]byte) (int, error) }, rows []map[string]interface{}) error {
+	cols := map[string]bool{}
+	for _, row := range rows {
+		for k := range row {
+			cols[k] = true
+		}
+	}
+	header := sortedKeys(cols)
+
+	w := csv.NewWriter(f)
+	if err := w.Write(header); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		record := make([]string, len(header))
+		for i, col := range header {
+			record[i] = csvValue(row[col])
+		}
+
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+func csvValue(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case map[string]interface{}, []interface{}:
+		b, _ := json.Marshal(t)
+		return string(b)
+	default:
+		return fmt.Sprint(t)
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
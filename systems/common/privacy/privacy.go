@@ -0,0 +1,89 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+// Package privacy holds what the services taking part in data requests
+// share: the kinds of request, the export document each service returns and
+// the helpers used to anonymise personal data on erasure.
+package privacy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+)
+
+const (
+	KindExport = "export"
+	KindErase  = "erase"
+
+	SubjectOrg        = "org"
+	SubjectSubscriber = "subscriber"
+)
+
+// Erased replaces personal data that is kept for its relations, such as the
+// name of a subscriber with invoices.
+const Erased = "erased"
+
+// Document is the export of a service, its records by table.
+type Document map[string][]map[string]interface{}
+
+// Add adds records, any value marshalling to a JSON object or array of
+// objects, to a table of the document.
+func (d Document) Add(table string, records interface{}) error {
+	b, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+
+	rows := []map[string]interface{}{}
+	if err := json.Unmarshal(b, &rows); err != nil {
+		row := map[string]interface{}{}
+		if err := json.Unmarshal(b, &row); err != nil {
+			return fmt.Errorf("records of %s are not objects: %w", table, err)
+		}
+		rows = append(rows, row)
+	}
+
+	d[table] = append(d[table], rows...)
+	return nil
+}
+
+// ErasedEmail is a unique address standing for an erased one, for columns
+// that must stay unique.
+func ErasedEmail(id string) string {
+	return Erased + "+" + id + "@erased.invalid"
+}
+
+// NewResult is the answer of a service to a data request. The export
+// document, if any, is set as data and a failure as error.
+func NewResult(service string, req *epb.EventDataRequest, doc Document, err error) *epb.EventDataRequestResult {
+	res := &epb.EventDataRequestResult{
+		RequestId:   req.Id,
+		Kind:        req.Kind,
+		SubjectKind: req.SubjectKind,
+		SubjectId:   req.SubjectId,
+		Service:     service,
+		Success:     err == nil,
+	}
+
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+
+	if doc != nil {
+		res.Data, err = json.Marshal(doc)
+		if err != nil {
+			res.Success = false
+			res.Error = err.Error()
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,106 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package privacy
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+)
+
+type subscriber struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+func TestDocument_Add(t *testing.T) {
+	doc := Document{}
+
+	require.NoError(t, doc.Add("subscribers", []subscriber{{Id: "1", Name: "Jane"}}))
+	require.NoError(t, doc.Add("subscribers", subscriber{Id: "2", Name: "John"}))
+
+	assert.Len(t, doc["subscribers"], 2)
+	assert.Equal(t, "John", doc["subscribers"][1]["name"])
+
+	assert.Error(t, doc.Add("subscribers", "not an object"))
+}
+
+func TestNewResult(t *testing.T) {
+	req := &epb.EventDataRequest{Id: "r1", Kind: KindExport, SubjectKind: SubjectOrg, SubjectId: "org"}
+
+	t.Run("Export", func(t *testing.T) {
+		doc := Document{}
+		require.NoError(t, doc.Add("subscribers", []subscriber{{Id: "1"}}))
+
+		res := NewResult("subscriber.registry", req, doc, nil)
+		assert.True(t, res.Success)
+		assert.Equal(t, "r1", res.RequestId)
+
+		parsed, err := ParseDocument(res.Data)
+		require.NoError(t, err)
+		assert.Len(t, parsed["subscribers"], 1)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		res := NewResult("subscriber.registry", req, nil, errors.New("db down"))
+		assert.False(t, res.Success)
+		assert.Equal(t, "db down", res.Error)
+		assert.Empty(t, res.Data)
+	})
+}
+
+func TestBundleCsv(t *testing.T) {
+	doc := Document{}
+	require.NoError(t, doc.Add("subscribers", []map[string]interface{}{
+		{"id": "1", "name": "Jane"},
+		{"id": "2", "email": "john@example.com", "tags": []string{"a"}},
+	}))
+
+	b, err := BundleCsv(map[string]Document{"subscriber.registry": doc})
+	require.NoError(t, err)
+
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	require.NoError(t, err)
+	require.Len(t, zr.File, 1)
+	assert.Equal(t, "subscriber.registry/subscribers.csv", zr.File[0].Name)
+
+	f, err := zr.File[0].Open()
+	require.NoError(t, err)
+	content, err := io.ReadAll(f)
+	require.NoError(t, err)
+
+	assert.Equal(t, "email,id,name,tags\n,1,Jane,\njohn@example.com,2,,\"[\"\"a\"\"]\"\n", string(content))
+}
+
+func TestRedactJson(t *testing.T) {
+	doc := []byte(`{"customer":{"name":"Jane","email":"jane@example.com","id":"c1"},` +
+		`"fees":[{"name":"Data","amount":10}],"total":10}`)
+
+	b, err := RedactJson(doc, "customer", "name", "email")
+	require.NoError(t, err)
+
+	v := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(b, &v))
+
+	customer := v["customer"].(map[string]interface{})
+	assert.Equal(t, Erased, customer["name"])
+	assert.Equal(t, Erased, customer["email"])
+	assert.Equal(t, "c1", customer["id"])
+	assert.Equal(t, "Data", v["fees"].([]interface{})[0].(map[string]interface{})["name"])
+	assert.Equal(t, float64(10), v["total"])
+}
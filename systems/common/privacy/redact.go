@@ -0,0 +1,49 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package privacy
+
+import "encoding/json"
+
+// RedactJson replaces with Erased the given keys of every object found under
+// the key object, at any depth of the JSON document. It leaves the rest of
+// the document, such as the amounts of an invoice, as is.
+func RedactJson(doc []byte, object string, keys ...string) ([]byte, error) {
+	var v interface{}
+	if err := json.Unmarshal(doc, &v); err != nil {
+		return nil, err
+	}
+
+	redact := map[string]bool{}
+	for _, k := range keys {
+		redact[k] = true
+	}
+
+	return json.Marshal(redactValue(v, object, redact, false))
+}
+
+func redactValue(v interface{}, object string, keys map[string]bool, inside bool) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			if inside && keys[k] {
+				if val != nil {
+					t[k] = Erased
+				}
+				continue
+			}
+			t[k] = redactValue(val, object, keys, inside || k == object)
+		}
+	case []interface{}:
+		for i := range t {
+			t[i] = redactValue(t[i], object, keys, inside)
+		}
+	}
+
+	return v
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/ukama/ukama/systems/common/rest/client"
+	"github.com/ukama/ukama/systems/common/roles"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -60,12 +61,19 @@ func (a *authClient) AuthenticateUser(c *gin.Context, u string) error {
 	a.R.C.Header = c.Request.Header
 	a.R.C = a.R.C.SetCookieJar(a.Jar)
 
-	_, err := a.R.Get(a.u.ResolveReference(&url.URL{Path: AuthEndpoint}).String())
+	resp, err := a.R.Get(a.u.ResolveReference(&url.URL{Path: AuthEndpoint}).String())
 	if err != nil {
 		log.Errorf("AuthenticateUser failure. error: %v", err)
 
 		return fmt.Errorf("authenticateUser failure: %w", err)
 	}
 
+	/* only the user the session belongs to is trusted from here on */
+	if id := resp.Header().Get(roles.UserIdHeader); id != "" {
+		c.Request.Header.Set(roles.UserIdHeader, id)
+	} else {
+		c.Request.Header.Del(roles.UserIdHeader)
+	}
+
 	return nil
 }
@@ -43,7 +43,7 @@ func TestAuthClient_AuthenticateUser(t *testing.T) {
 				Body: io.NopCloser(bytes.NewBufferString("")),
 
 				// Must be set to non-nil value or it panics
-				Header: make(http.Header),
+				Header: http.Header{"User-Id": []string{"session-user"}},
 			}
 		}
 
@@ -53,9 +53,11 @@ func TestAuthClient_AuthenticateUser(t *testing.T) {
 		// so that the test stays a unit test e.g, no server/auth call.
 		testAuthClient.R.C.SetTransport(client.RoundTripFunc(mockTransport))
 
+		ginContext.Request.Header.Set("User-id", "claimed-user")
 		err := testAuthClient.AuthenticateUser(ginContext, auth.AuthEndpoint)
 
 		assert.NoError(tt, err)
+		assert.Equal(tt, "session-user", ginContext.Request.Header.Get("User-id"))
 	})
 
 	t.Run("AuthNotFound", func(tt *testing.T) {
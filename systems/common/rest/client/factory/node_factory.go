@@ -10,7 +10,9 @@ package factory
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strconv"
 	"time"
@@ -22,6 +24,10 @@ import (
 
 const NodeFactoryEndpoint = "/v1/nodefactory"
 
+// ErrInvalidClaimCode is returned when the factory rejects the claim code of
+// a node.
+var ErrInvalidClaimCode = errors.New("invalid claim code")
+
 type NodeFactoryInfo struct {
 	Id            string    `json:"id,omitempty"`
 	Type          string    `json:"type,omitempty"`
@@ -38,9 +44,14 @@ type Node struct {
 	Node NodeFactoryInfo `json:"node"`
 }
 
+type ClaimRequest struct {
+	ClaimCode string `json:"claimCode"`
+}
+
 type NodeFactoryClient interface {
 	Get(Id string) (*Node, error)
 	List(nodeType string, orgName string, isProvisioned bool) (*Nodes, error)
+	VerifyClaim(id string, claimCode string) error
 }
 
 type nodeFactoryClient struct {
@@ -105,3 +116,28 @@ func (s *nodeFactoryClient) List(nodeType string, orgName string, isProvisioned
 
 	return &nodes, nil
 }
+
+// VerifyClaim checks the claim code printed on a node with the factory. The
+// code never leaves the factory, only its verdict does.
+func (s *nodeFactoryClient) VerifyClaim(id string, claimCode string) error {
+	log.Debugf("Verifying claim code of node %s with factory", id)
+
+	b, err := json.Marshal(&ClaimRequest{ClaimCode: claimCode})
+	if err != nil {
+		return fmt.Errorf("request marshal error. error: %w", err)
+	}
+
+	_, err = s.R.Post(s.u.String()+NodeFactoryEndpoint+"/node/"+id+"/claim", b)
+	if err != nil {
+		var es *client.ErrorStatus
+		if errors.As(err, &es) && es.StatusCode == http.StatusForbidden {
+			return ErrInvalidClaimCode
+		}
+
+		log.Errorf("VerifyClaim failure. error: %s", err.Error())
+
+		return fmt.Errorf("verifyClaim failure: %w", err)
+	}
+
+	return nil
+}
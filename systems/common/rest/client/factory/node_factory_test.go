@@ -11,6 +11,7 @@ package factory_test
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"os"
@@ -251,3 +252,61 @@ func TestNodeFactoryClient_List(t *testing.T) {
 		assert.Nil(tt, result)
 	})
 }
+
+func TestNodeFactoryClient_VerifyClaim(t *testing.T) {
+	claimTransport := func(tt *testing.T, code int) client.RoundTripFunc {
+		return func(req *http.Request) *http.Response {
+			expectedURL := testFactoryHost + factory.NodeFactoryEndpoint + "/node/" + testNodeId + "/claim"
+			assert.Equal(tt, expectedURL, req.URL.String())
+			assert.Equal(tt, "POST", req.Method)
+
+			body, _ := io.ReadAll(req.Body)
+			assert.JSONEq(tt, `{"claimCode":"ABCD-1234"}`, string(body))
+
+			return &http.Response{
+				StatusCode: code,
+				Status:     http.StatusText(code),
+				Body:       io.NopCloser(bytes.NewBufferString(`{}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}
+		}
+	}
+
+	t.Run("Valid", func(tt *testing.T) {
+		testClient := factory.NewNodeFactoryClient(testFactoryHost)
+		testClient.R.C.SetTransport(claimTransport(tt, http.StatusOK))
+
+		err := testClient.VerifyClaim(testNodeId, "ABCD-1234")
+
+		assert.NoError(tt, err)
+	})
+
+	t.Run("Invalid", func(tt *testing.T) {
+		testClient := factory.NewNodeFactoryClient(testFactoryHost)
+		testClient.R.C.SetTransport(claimTransport(tt, http.StatusForbidden))
+
+		err := testClient.VerifyClaim(testNodeId, "ABCD-1234")
+
+		assert.True(tt, errors.Is(err, factory.ErrInvalidClaimCode))
+	})
+
+	t.Run("NotServed", func(tt *testing.T) {
+		testClient := factory.NewNodeFactoryClient(testFactoryHost)
+		testClient.R.C.SetTransport(claimTransport(tt, http.StatusNotFound))
+
+		err := testClient.VerifyClaim(testNodeId, "ABCD-1234")
+
+		assert.Error(tt, err)
+		assert.False(tt, errors.Is(err, factory.ErrInvalidClaimCode))
+	})
+
+	t.Run("FactoryDown", func(tt *testing.T) {
+		testClient := factory.NewNodeFactoryClient(testFactoryHost)
+		testClient.R.C.SetTransport(claimTransport(tt, http.StatusInternalServerError))
+
+		err := testClient.VerifyClaim(testNodeId, "ABCD-1234")
+
+		assert.Error(tt, err)
+		assert.False(tt, errors.Is(err, factory.ErrInvalidClaimCode))
+	})
+}
@@ -34,10 +34,23 @@ type Release struct {
 	Chunked   bool
 }
 
+// Delta is the download cost of updating an artifact from one version to another.
+// An empty FromVersion means a fresh install.
+type Delta struct {
+	Name        string
+	Type        string
+	FromVersion string
+	ToVersion   string
+	TotalBytes  int64
+	NewChunks   uint32
+	NewBytes    int64
+}
+
 type HubClient interface {
 	ListApps(artifactType string) ([]string, error)
 	ListVersions(name, artifactType string) ([]Release, error)
 	VersionExists(name, artifactType, version string) (bool, error)
+	GetDelta(name, artifactType, fromVersion, toVersion string) (*Delta, error)
 }
 
 type hubClient struct {
@@ -133,6 +146,44 @@ func (c *hubClient) VersionExists(name, artifactType, version string) (bool, err
 	return false, nil
 }
 
+// GetDelta returns how many chunks and bytes a node holding fromVersion must
+// download to get toVersion. An empty fromVersion is a fresh install.
+func (c *hubClient) GetDelta(name, artifactType, fromVersion, toVersion string) (*Delta, error) {
+	q := url.Values{}
+	if fromVersion != "" {
+		q.Set("from", fromVersion)
+	}
+	q.Set("to", toVersion)
+
+	resp, err := c.R.Get(c.u.String() + HubEndpoint + "/" + url.PathEscape(artifactType) + "/" +
+		url.PathEscape(name) + "/delta?" + q.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("hub get delta failure: %w", err)
+	}
+
+	// uint64 fields are serialized as JSON strings by the api-gateway.
+	var body struct {
+		From       string          `json:"from"`
+		To         string          `json:"to"`
+		TotalBytes json.RawMessage `json:"total_bytes"`
+		NewChunks  uint32          `json:"new_chunks"`
+		NewBytes   json.RawMessage `json:"new_bytes"`
+	}
+	if err := json.Unmarshal(resp.Body(), &body); err != nil {
+		return nil, fmt.Errorf("hub get delta deserialization failure: %w", err)
+	}
+
+	return &Delta{
+		Name:        name,
+		Type:        artifactType,
+		FromVersion: body.From,
+		ToVersion:   body.To,
+		TotalBytes:  parseFlexibleInt64(body.TotalBytes),
+		NewChunks:   body.NewChunks,
+		NewBytes:    parseFlexibleInt64(body.NewBytes),
+	}, nil
+}
+
 // isNotFound reports whether the wrapped rest error carries a 404 status.
 func isNotFound(err error) bool {
 	var es *client.ErrorStatus
@@ -152,3 +152,34 @@ func TestHubClient_VersionExists(t *testing.T) {
 		assert.False(tt, ok)
 	})
 }
+
+func TestHubClient_GetDelta(t *testing.T) {
+	t.Run("Found", func(tt *testing.T) {
+		body := `{"name":"example","type":"app","from":"1.0.0","to":"1.1.0",` +
+			`"total_chunks":10,"total_bytes":"1000","new_chunks":2,"new_bytes":"200"}`
+
+		c := hub.NewHubClient(baseURL)
+		c.R.C.SetTransport(respondWith(http.StatusOK, body, func(req *http.Request) {
+			assert.Equal(tt, baseURL+hub.HubEndpoint+"/app/example/delta?from=1.0.0&to=1.1.0", req.URL.String())
+		}))
+
+		d, err := c.GetDelta("example", "app", "1.0.0", "1.1.0")
+
+		assert.NoError(tt, err)
+		assert.Equal(tt, "1.0.0", d.FromVersion)
+		assert.Equal(tt, "1.1.0", d.ToVersion)
+		assert.Equal(tt, int64(1000), d.TotalBytes)
+		assert.Equal(tt, uint32(2), d.NewChunks)
+		assert.Equal(tt, int64(200), d.NewBytes)
+	})
+
+	t.Run("NotFound", func(tt *testing.T) {
+		c := hub.NewHubClient(baseURL)
+		c.R.C.SetTransport(respondWith(http.StatusNotFound, `{"error":"not found"}`, nil))
+
+		d, err := c.GetDelta("missing", "app", "", "1.0.0")
+
+		assert.Error(tt, err)
+		assert.Nil(tt, d)
+	})
+}
@@ -0,0 +1,103 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2023-present, Ukama Inc.
+ */
+
+package initclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultHeartbeatInterval keeps a system well within the lookup
+	// heartbeat timeout, so that it is not reported as stale.
+	DefaultHeartbeatInterval = 30 * time.Second
+
+	// HealthOk is the health lookup records for a system gateway that is up.
+	HealthOk uint32 = 100
+)
+
+type SystemHeartbeat struct {
+	Version      string `json:"version"`
+	ApiGwHealth  uint32 `json:"apiGwHealth"`
+	NodeGwHealth uint32 `json:"nodeGwHealth"`
+}
+
+type SystemDrainInfo struct {
+	Draining  bool   `json:"draining"`
+	DrainIp   string `json:"drainIp"`
+	DrainPort int32  `json:"drainPort"`
+	DrainUrl  string `json:"drainUrl"`
+}
+
+func (i *initClient) SendHeartbeat(org, system string, hb *SystemHeartbeat) (*SystemDrainInfo, error) {
+	log.Debugf("Sending heartbeat of system %q of org %q", system, org)
+
+	b, err := json.Marshal(hb)
+	if err != nil {
+		return nil, fmt.Errorf("request marshal error. error: %w", err)
+	}
+
+	resp, err := i.R.Post(i.u.String()+InitApiEndpoint+"/"+org+"/systems/"+system+"/heartbeat", b)
+	if err != nil {
+		return nil, fmt.Errorf("system heartbeat failure: %w", err)
+	}
+
+	drain := SystemDrainInfo{}
+
+	err = json.Unmarshal(resp.Body(), &drain)
+	if err != nil {
+		return nil, fmt.Errorf("system drain info deserialization failure: %w", err)
+	}
+
+	return &drain, nil
+}
+
+// StartHeartbeat reports the version of the system to lookup every interval
+// until stop is closed, or for the life of the process when stop is nil.
+// Failures are only logged: a missed heartbeat marks the system stale in
+// lookup, which is what the inventory is for.
+func StartHeartbeat(ic InitClient, org, system, version string, interval time.Duration, stop <-chan struct{}) {
+	hb := &SystemHeartbeat{
+		Version:      version,
+		ApiGwHealth:  HealthOk,
+		NodeGwHealth: HealthOk,
+	}
+
+	send := func() {
+		drain, err := ic.SendHeartbeat(org, system, hb)
+		if err != nil {
+			log.Warnf("Failed to send heartbeat of system %s: %v", system, err)
+
+			return
+		}
+
+		if drain.Draining {
+			log.Infof("System %s of org %s is drained to %s:%d", system, org, drain.DrainIp, drain.DrainPort)
+		}
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		send()
+
+		for {
+			select {
+			case <-ticker.C:
+				send()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
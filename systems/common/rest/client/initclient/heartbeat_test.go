@@ -0,0 +1,104 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2023-present, Ukama Inc.
+ */
+
+package initclient_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/tj/assert"
+
+	"github.com/ukama/ukama/systems/common/rest/client"
+	"github.com/ukama/ukama/systems/common/rest/client/initclient"
+
+	cmocks "github.com/ukama/ukama/systems/common/mocks"
+)
+
+func TestInitClient_SendHeartbeat(t *testing.T) {
+	t.Run("Draining", func(tt *testing.T) {
+		mockTransport := func(req *http.Request) *http.Response {
+			assert.Equal(tt, http.MethodPost, req.Method)
+			assert.Equal(tt, req.URL.String(), initclient.InitApiEndpoint+"/"+orgName+"/systems/"+systemName+"/heartbeat")
+
+			hb := initclient.SystemHeartbeat{}
+			assert.NoError(tt, json.NewDecoder(req.Body).Decode(&hb))
+			assert.Equal(tt, "v1.0.0", hb.Version)
+
+			resp := `{"draining": true, "drainIp": "10.0.0.2", "drainPort": 9091}`
+
+			return &http.Response{
+				StatusCode: 200,
+				Status:     "200 OK",
+				Body:       io.NopCloser(bytes.NewBufferString(resp)),
+				Header:     make(http.Header),
+			}
+		}
+
+		testInitClient := initclient.NewInitClient("")
+
+		testInitClient.R.C.SetTransport(client.RoundTripFunc(mockTransport))
+
+		d, err := testInitClient.SendHeartbeat(orgName, systemName, &initclient.SystemHeartbeat{Version: "v1.0.0"})
+
+		assert.NoError(tt, err)
+		assert.True(tt, d.Draining)
+		assert.Equal(tt, "10.0.0.2", d.DrainIp)
+		assert.Equal(tt, int32(9091), d.DrainPort)
+	})
+
+	t.Run("SystemNotFound", func(tt *testing.T) {
+		mockTransport := func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: 404,
+				Status:     "404 NOT FOUND",
+				Body:       io.NopCloser(bytes.NewBufferString(`{"error":"not found"}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}
+		}
+
+		testInitClient := initclient.NewInitClient("")
+
+		testInitClient.R.C.SetTransport(client.RoundTripFunc(mockTransport))
+
+		d, err := testInitClient.SendHeartbeat(orgName, systemName, &initclient.SystemHeartbeat{})
+
+		assert.Error(tt, err)
+		assert.Nil(tt, d)
+	})
+}
+
+func TestStartHeartbeat(t *testing.T) {
+	ic := &cmocks.InitClient{}
+	sent := make(chan struct{}, 1)
+
+	ic.On("SendHeartbeat", orgName, systemName, mock.MatchedBy(func(hb *initclient.SystemHeartbeat) bool {
+		return hb.Version == "v1.0.0" && hb.ApiGwHealth == initclient.HealthOk
+	})).Return(&initclient.SystemDrainInfo{}, nil).Run(func(mock.Arguments) {
+		select {
+		case sent <- struct{}{}:
+		default:
+		}
+	})
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	initclient.StartHeartbeat(ic, orgName, systemName, "v1.0.0", time.Hour, stop)
+
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		t.Fatal("heartbeat not sent on start")
+	}
+}
@@ -52,6 +52,7 @@ type SystemLookupReq struct {
 type InitClient interface {
 	GetSystem(org, system string) (*SystemIPInfo, error)
 	GetSystemFromHost(host string, org *string) (*SystemIPInfo, error)
+	SendHeartbeat(org, system string, hb *SystemHeartbeat) (*SystemDrainInfo, error)
 }
 
 type initClient struct {
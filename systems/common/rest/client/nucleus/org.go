@@ -34,8 +34,26 @@ type Org struct {
 	OrgInfo *OrgInfo `json:"org"`
 }
 
+// UserOrgs are the orgs a user owns and the ones they are a member of
+type UserOrgs struct {
+	User     string    `json:"user"`
+	OwnerOf  []OrgInfo `json:"owner_of"`
+	MemberOf []OrgInfo `json:"member_of"`
+}
+
+// Has reports whether the user owns or is a member of the org named name
+func (u *UserOrgs) Has(name string) bool {
+	for _, o := range append(u.OwnerOf, u.MemberOf...) {
+		if o.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 type OrgClient interface {
 	Get(name string) (*OrgInfo, error)
+	GetByUser(userId string) (*UserOrgs, error)
 	AddUser(orgId string, userId string) error
 	RemoveUser(orgId string, userId string) error
 }
@@ -82,6 +100,28 @@ func (o *orgClient) Get(name string) (*OrgInfo, error) {
 	return org.OrgInfo, nil
 }
 
+func (o *orgClient) GetByUser(userId string) (*UserOrgs, error) {
+	log.Debugf("Getting orgs of user: %v", userId)
+
+	orgs := UserOrgs{}
+
+	resp, err := o.R.Get(o.u.String() + OrgEndpoint + "?user_uuid=" + url.QueryEscape(userId))
+	if err != nil {
+		log.Errorf("GetByUser failure. error: %s", err.Error())
+
+		return nil, fmt.Errorf("GetByUser failure: %w", err)
+	}
+
+	err = json.Unmarshal(resp.Body(), &orgs)
+	if err != nil {
+		log.Tracef("Failed to deserialize user orgs. Error message is: %s", err.Error())
+
+		return nil, fmt.Errorf("user orgs deserialization failure: %w", err)
+	}
+
+	return &orgs, nil
+}
+
 func (o *orgClient) AddUser(orgId string, userId string) error {
 	log.Debugf("Adding user %q to org %q", userId, orgId)
 
@@ -121,6 +121,48 @@ func TestOrgClient_Get(t *testing.T) {
 	})
 }
 
+func TestOrgClient_GetByUser(t *testing.T) {
+	t.Run("OrgsFound", func(tt *testing.T) {
+		mockTransport := func(req *http.Request) *http.Response {
+			assert.Equal(tt, req.URL.String(), nucleus.OrgEndpoint+"?user_uuid="+testUuid)
+
+			orgs := `{"user": "` + testUuid + `", "owner_of": [{"name": "owned-org"}],
+				"member_of": [{"name": "member-org"}]}`
+
+			return &http.Response{
+				StatusCode: 200,
+				Status:     "200 OK",
+				Body:       io.NopCloser(bytes.NewBufferString(orgs)),
+				Header:     make(http.Header),
+			}
+		}
+
+		testOrgClient := nucleus.NewOrgClient("")
+		testOrgClient.R.C.SetTransport(RoundTripFunc(mockTransport))
+
+		o, err := testOrgClient.GetByUser(testUuid)
+
+		assert.NoError(tt, err)
+		assert.True(tt, o.Has("owned-org"))
+		assert.True(tt, o.Has("member-org"))
+		assert.False(tt, o.Has("other-org"))
+	})
+
+	t.Run("RequestFailure", func(tt *testing.T) {
+		mockTransport := func(req *http.Request) *http.Response {
+			return nil
+		}
+
+		testOrgClient := nucleus.NewOrgClient("")
+		testOrgClient.R.C.SetTransport(RoundTripFunc(mockTransport))
+
+		o, err := testOrgClient.GetByUser(testUuid)
+
+		assert.Error(tt, err)
+		assert.Nil(tt, o)
+	})
+}
+
 func TestOrgClient_AddUser(t *testing.T) {
 	t.Run("OrgAndUserFound", func(tt *testing.T) {
 		mockTransport := func(req *http.Request) *http.Response {
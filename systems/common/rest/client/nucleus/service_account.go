@@ -0,0 +1,89 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package nucleus
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/ukama/ukama/systems/common/rest/client"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ServiceAccountEndpoint is served by the internal listener of the nucleus
+// api-gateway, since keys are validated to authenticate a request.
+const ServiceAccountEndpoint = "/authz/v1/service-accounts"
+
+type ApiKeyInfo struct {
+	AccountId string `json:"account_id"`
+	KeyId     string `json:"key_id"`
+	Name      string `json:"name"`
+}
+
+type validateApiKeyRequest struct {
+	Key    string `json:"key"`
+	OrgId  string `json:"org_id"`
+	Method string `json:"method"`
+	Path   string `json:"path"`
+}
+
+type ServiceAccountClient interface {
+	// ValidateApiKey resolves a key of a service account of orgId to the
+	// account and audits the request it was presented with
+	ValidateApiKey(key, orgId, method, path string) (*ApiKeyInfo, error)
+}
+
+type serviceAccountClient struct {
+	u *url.URL
+	R *client.Resty
+}
+
+func NewServiceAccountClient(h string, options ...client.Option) *serviceAccountClient {
+	u, err := url.Parse(h)
+
+	if err != nil {
+		log.Fatalf("Can't parse %s url. Error: %v", h, err)
+	}
+
+	return &serviceAccountClient{
+		u: u,
+		R: client.NewResty(options...),
+	}
+}
+
+func (s *serviceAccountClient) ValidateApiKey(key, orgId, method, path string) (*ApiKeyInfo, error) {
+	b, err := json.Marshal(validateApiKeyRequest{Key: key, OrgId: orgId, Method: method, Path: path})
+	if err != nil {
+		return nil, fmt.Errorf("request marshal error: %w", err)
+	}
+
+	resp, err := s.R.Post(s.u.String()+ServiceAccountEndpoint+"/keys/validate", b)
+	if err != nil {
+		log.Errorf("ValidateApiKey failure. error: %s", err.Error())
+
+		return nil, fmt.Errorf("ValidateApiKey failure: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("ValidateApiKey failure: unexpected status %d", resp.StatusCode())
+	}
+
+	info := ApiKeyInfo{}
+	err = json.Unmarshal(resp.Body(), &info)
+	if err != nil {
+		log.Tracef("Failed to deserialize api key info. Error message is: %s", err.Error())
+
+		return nil, fmt.Errorf("api key info deserialization failure: %w", err)
+	}
+
+	return &info, nil
+}
@@ -0,0 +1,67 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package nucleus_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/tj/assert"
+
+	"github.com/ukama/ukama/systems/common/rest/client/nucleus"
+)
+
+func TestServiceAccountClient_ValidateApiKey(t *testing.T) {
+	t.Run("KeyValid", func(tt *testing.T) {
+		mockTransport := func(req *http.Request) *http.Response {
+			assert.Equal(tt, req.URL.String(), nucleus.ServiceAccountEndpoint+"/keys/validate")
+
+			body, _ := io.ReadAll(req.Body)
+			assert.Equal(tt, `{"key":"uk_00_secret","org_id":"`+testUuid+`","method":"GET","path":"/v1/bills"}`, string(body))
+
+			info := `{"account_id": "` + testUuid + `", "key_id": "` + testUuid + `", "name": "billing-sync"}`
+
+			return &http.Response{
+				StatusCode: 200,
+				Status:     "200 OK",
+				Body:       io.NopCloser(bytes.NewBufferString(info)),
+				Header:     make(http.Header),
+			}
+		}
+
+		testClient := nucleus.NewServiceAccountClient("")
+		testClient.R.C.SetTransport(RoundTripFunc(mockTransport))
+
+		k, err := testClient.ValidateApiKey("uk_00_secret", testUuid, "GET", "/v1/bills")
+
+		assert.NoError(tt, err)
+		assert.Equal(tt, testUuid, k.AccountId)
+	})
+
+	t.Run("KeyInvalid", func(tt *testing.T) {
+		mockTransport := func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: 401,
+				Status:     "401 UNAUTHORIZED",
+				Body:       io.NopCloser(bytes.NewBufferString(`{"message":"invalid api key"}`)),
+				Header:     make(http.Header),
+			}
+		}
+
+		testClient := nucleus.NewServiceAccountClient("")
+		testClient.R.C.SetTransport(RoundTripFunc(mockTransport))
+
+		k, err := testClient.ValidateApiKey("uk_00_bad", testUuid, "GET", "/v1/bills")
+
+		assert.Error(tt, err)
+		assert.Nil(tt, k)
+	})
+}
@@ -9,9 +9,14 @@
 package operation
 
 import (
+	"bufio"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -28,6 +33,7 @@ const (
 	StatusFailed    = "FAILED"
 	StatusTimeout   = "TIMEOUT"
 	StatusCancelled = "CANCELLED"
+	StatusQueued    = "QUEUED"
 )
 
 type OperationInfo struct {
@@ -44,6 +50,8 @@ type OperationInfo struct {
 	StartedAt      time.Time `json:"started_at,omitempty"`
 	TerminalAt     time.Time `json:"terminal_at,omitempty"`
 	CreatedAt      time.Time `json:"created_at"`
+	Priority       int32     `json:"priority,omitempty"`
+	Preemptible    bool      `json:"preemptible,omitempty"`
 }
 
 type StartRequest struct {
@@ -53,17 +61,32 @@ type StartRequest struct {
 	RequestedBy    string `json:"requested_by,omitempty"`
 	IdempotencyKey string `json:"idempotency_key,omitempty"`
 	LeaseSeconds   uint32 `json:"lease_seconds,omitempty"`
+	// Queue behind the holder instead of failing, optionally blocking up to
+	// WaitSeconds for the lock. Preempt cancels a lower priority holder that
+	// has not started yet or was started Preemptible.
+	Queue       bool   `json:"queue,omitempty"`
+	Priority    int32  `json:"priority,omitempty"`
+	WaitSeconds uint32 `json:"wait_seconds,omitempty"`
+	Preempt     bool   `json:"preempt,omitempty"`
+	Preemptible bool   `json:"preemptible,omitempty"`
 }
 
 type StartResponse struct {
 	Operation            *OperationInfo `json:"operation,omitempty"`
 	ConflictingOperation *OperationInfo `json:"conflicting_operation,omitempty"`
+	QueuePosition        uint32         `json:"queue_position,omitempty"`
 }
 
 type GetResponse struct {
 	Operation *OperationInfo `json:"operation,omitempty"`
 }
 
+// WatchEvent is sent on the watch stream each time the operation changes
+type WatchEvent struct {
+	Operation     *OperationInfo `json:"operation"`
+	QueuePosition uint32         `json:"queue_position,omitempty"`
+}
+
 type MarkRunningRequest struct {
 	FencingToken uint64 `json:"fencing_token"`
 }
@@ -85,8 +108,14 @@ type ManagerClient interface {
 	MarkRunning(id string, fencingToken uint64) (*OperationInfo, error)
 	ForceUnlock(id, actor, reason string) (*OperationInfo, error)
 	Complete(id, actor, reason string) (*OperationInfo, error)
+	Watch(id string, timeout time.Duration, fn func(*WatchEvent) bool) error
 }
 
+var (
+	ErrLockWaitTimeout = errors.New("operation still queued for the resource lock")
+	ErrNotAcquired     = errors.New("operation did not acquire the resource lock")
+)
+
 type managerClient struct {
 	u *url.URL
 	R *client.Resty
@@ -188,3 +217,87 @@ func (m *managerClient) Complete(id, actor, reason string) (*OperationInfo, erro
 	}
 	return out.Operation, nil
 }
+
+// Watch calls fn for each change of the operation until fn returns false, the
+// operation is terminal or timeout elapses, which returns context.DeadlineExceeded.
+func (m *managerClient) Watch(id string, timeout time.Duration, fn func(*WatchEvent) bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	resp, err := m.R.C.R().SetContext(ctx).SetDoNotParseResponse(true).
+		Get(m.u.String() + OperationsEndpoint + "/" + id + "/watch")
+	if err != nil {
+		return fmt.Errorf("WatchOperation failure: %w", err)
+	}
+	body := resp.RawBody()
+	defer body.Close()
+
+	if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("WatchOperation failure: status %d", resp.StatusCode())
+	}
+
+	event := ""
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if name, ok := strings.CutPrefix(line, "event:"); ok {
+			event = name
+			continue
+		}
+		data, ok := strings.CutPrefix(line, "data:")
+		if !ok {
+			continue
+		}
+
+		if event != "operation" {
+			return fmt.Errorf("WatchOperation failure: %s", data)
+		}
+		out := &WatchEvent{}
+		if uerr := json.Unmarshal([]byte(data), out); uerr != nil {
+			return fmt.Errorf("WatchOperation deserialize: %w", uerr)
+		}
+		if !fn(out) {
+			return nil
+		}
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return scanner.Err()
+}
+
+// AwaitLock returns op once it holds the lock of its resource. An operation
+// still queued is watched up to timeout and withdrawn by actor if it does not
+// get the lock by then, so it never runs after its caller gave up on it.
+func AwaitLock(m ManagerClient, op *OperationInfo, timeout time.Duration, actor string) (*OperationInfo, error) {
+	if op == nil {
+		return nil, ErrNotAcquired
+	}
+	if op.Status != StatusQueued {
+		return op, nil
+	}
+
+	last := op
+	err := m.Watch(op.Id, timeout, func(e *WatchEvent) bool {
+		if e.Operation != nil {
+			last = e.Operation
+		}
+		return last.Status == StatusQueued
+	})
+
+	switch {
+	case last.Status == StatusPending || last.Status == StatusRunning:
+		return last, nil
+	case last.Status != StatusQueued:
+		return nil, fmt.Errorf("%w: operation %s is %s", ErrNotAcquired, op.Id, last.Status)
+	}
+
+	if _, uerr := m.ForceUnlock(op.Id, actor, "lock wait timed out"); uerr != nil {
+		log.Errorf("Failed to withdraw queued operation %s: %v", op.Id, uerr)
+	}
+	if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		return nil, fmt.Errorf("%w: %v", ErrLockWaitTimeout, err)
+	}
+	return nil, ErrLockWaitTimeout
+}
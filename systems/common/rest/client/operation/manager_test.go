@@ -10,9 +10,11 @@ package operation_test
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/tj/assert"
 
@@ -528,3 +530,98 @@ func TestManagerClient_ForceUnlock(t *testing.T) {
 		assert.Nil(tt, op)
 	})
 }
+
+func TestManagerClient_Watch(t *testing.T) {
+	stream := "event:operation\ndata:{\"operation\":{\"id\":\"" + testOperationId + "\",\"status\":\"QUEUED\"},\"queue_position\":1}\n\n" +
+		"event:operation\ndata:{\"operation\":{\"id\":\"" + testOperationId + "\",\"status\":\"PENDING\",\"fencing_token\":3}}\n\n"
+
+	watchTransport := func(tt *testing.T, body string) client.RoundTripFunc {
+		return func(req *http.Request) *http.Response {
+			assert.Equal(tt, req.URL.String(), operation.OperationsEndpoint+"/"+testOperationId+"/watch")
+			assert.Equal(tt, "GET", req.Method)
+
+			return &http.Response{
+				StatusCode: 200,
+				Status:     "200 OK",
+				Body:       io.NopCloser(bytes.NewBufferString(body)),
+				Header:     make(http.Header),
+			}
+		}
+	}
+
+	t.Run("OperationUpdates", func(tt *testing.T) {
+		testManagerClient := operation.NewManagerClient("")
+		testManagerClient.R.C.SetTransport(watchTransport(tt, stream))
+
+		var events []*operation.WatchEvent
+		err := testManagerClient.Watch(testOperationId, time.Second, func(e *operation.WatchEvent) bool {
+			events = append(events, e)
+			return true
+		})
+
+		assert.NoError(tt, err)
+		assert.Len(tt, events, 2)
+		assert.Equal(tt, uint32(1), events[0].QueuePosition)
+		assert.Equal(tt, operation.StatusPending, events[1].Operation.Status)
+	})
+
+	t.Run("StreamError", func(tt *testing.T) {
+		testManagerClient := operation.NewManagerClient("")
+		testManagerClient.R.C.SetTransport(watchTransport(tt, "event:error\ndata:{\"error\":\"not found\"}\n\n"))
+
+		err := testManagerClient.Watch(testOperationId, time.Second, func(e *operation.WatchEvent) bool {
+			return true
+		})
+
+		assert.Error(tt, err)
+	})
+
+	t.Run("AwaitLockAcquired", func(tt *testing.T) {
+		testManagerClient := operation.NewManagerClient("")
+		testManagerClient.R.C.SetTransport(watchTransport(tt, stream))
+
+		op, err := operation.AwaitLock(testManagerClient, &operation.OperationInfo{Id: testOperationId,
+			Status: operation.StatusQueued}, time.Second, "test")
+
+		assert.NoError(tt, err)
+		assert.Equal(tt, uint64(3), op.FencingToken)
+	})
+
+	t.Run("AwaitLockPreempted", func(tt *testing.T) {
+		testManagerClient := operation.NewManagerClient("")
+		testManagerClient.R.C.SetTransport(watchTransport(tt,
+			"event:operation\ndata:{\"operation\":{\"id\":\""+testOperationId+"\",\"status\":\"CANCELLED\"}}\n\n"))
+
+		op, err := operation.AwaitLock(testManagerClient, &operation.OperationInfo{Id: testOperationId,
+			Status: operation.StatusQueued}, time.Second, "test")
+
+		assert.True(tt, errors.Is(err, operation.ErrNotAcquired))
+		assert.Nil(tt, op)
+	})
+
+	t.Run("AwaitLockTimeout", func(tt *testing.T) {
+		withdrawn := false
+		testManagerClient := operation.NewManagerClient("")
+		testManagerClient.R.C.SetTransport(client.RoundTripFunc(func(req *http.Request) *http.Response {
+			body := "event:operation\ndata:{\"operation\":{\"id\":\"" + testOperationId + "\",\"status\":\"QUEUED\"}}\n\n"
+			if req.Method == "DELETE" {
+				withdrawn = true
+				body = `{"operation":{"id":"` + testOperationId + `","status":"CANCELLED"}}`
+			}
+
+			return &http.Response{
+				StatusCode: 200,
+				Status:     "200 OK",
+				Body:       io.NopCloser(bytes.NewBufferString(body)),
+				Header:     make(http.Header),
+			}
+		}))
+
+		op, err := operation.AwaitLock(testManagerClient, &operation.OperationInfo{Id: testOperationId,
+			Status: operation.StatusQueued}, time.Second, "test")
+
+		assert.True(tt, errors.Is(err, operation.ErrLockWaitTimeout))
+		assert.Nil(tt, op)
+		assert.True(tt, withdrawn)
+	})
+}
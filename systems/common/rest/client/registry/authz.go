@@ -0,0 +1,117 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package registry
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/ukama/ukama/systems/common/config"
+	"github.com/ukama/ukama/systems/common/roles"
+)
+
+// AuthzPrefix is where the internal listener of the registry api-gateway
+// serves the lookups of the authorization.
+const AuthzPrefix = "/authz"
+
+// NewAuthFunc adds role based authorization to the auth function of an
+// api-gateway, with grants and locations served by the registry at
+// conf.AuthorizationUrl. authenticate is returned as is when that is unset.
+func NewAuthFunc(system string, conf *config.Auth, authenticate func(*gin.Context, string) error,
+	opts ...roles.Option) func(*gin.Context, string) error {
+	a := NewAuthorizer(system, conf, opts...)
+	if a == nil {
+		return authenticate
+	}
+	return a.AuthFunc(authenticate)
+}
+
+// NewAuthorizer authorizes with the grants and locations served by the
+// registry at conf.AuthorizationUrl, or is nil when that is unset.
+func NewAuthorizer(system string, conf *config.Auth, opts ...roles.Option) *roles.Authorizer {
+	if conf == nil || conf.AuthorizationUrl == "" {
+		return nil
+	}
+
+	authzUrl := strings.TrimSuffix(conf.AuthorizationUrl, "/") + AuthzPrefix
+
+	locator := NewLocator(NewSiteClient(authzUrl), NewNodeClient(authzUrl))
+	grants := NewMemberGrants(NewMemberClient(authzUrl))
+
+	return roles.NewAuthorizer(system, grants, append([]roles.Option{roles.WithLocator(locator)}, opts...)...)
+}
+
+type memberGrants struct {
+	members MemberClient
+}
+
+// NewMemberGrants serves the grants of members from the registry.
+func NewMemberGrants(members MemberClient) roles.GrantSource {
+	return &memberGrants{members: members}
+}
+
+func (m *memberGrants) Grants(userId string) ([]roles.Grant, error) {
+	access, err := m.members.GetAccess(userId)
+	if err != nil {
+		return nil, err
+	}
+	return GrantsFromAccess(access), nil
+}
+
+func GrantsFromAccess(access *MemberAccess) []roles.Grant {
+	grants := make([]roles.Grant, 0, len(access.Grants))
+	for _, g := range access.Grants {
+		perms := make([]roles.Permission, 0, len(g.Permissions))
+		for _, p := range g.Permissions {
+			perms = append(perms, roles.Permission(p))
+		}
+		grants = append(grants, roles.Grant{
+			Role:        g.Role,
+			Permissions: perms,
+			Scope:       roles.Scope{Kind: roles.ScopeKind(g.ScopeKind), Id: g.ScopeId},
+		})
+	}
+	return grants
+}
+
+type locator struct {
+	sites SiteClient
+	nodes NodeClient
+}
+
+// NewLocator finds the site of a node and the network of a site in the
+// registry.
+func NewLocator(sites SiteClient, nodes NodeClient) roles.Locator {
+	return &locator{sites: sites, nodes: nodes}
+}
+
+// Locate resolves the parents of r from its most specific id, replacing any
+// site or network already set on it.
+func (l *locator) Locate(r *roles.Resource) error {
+	if r.NodeId != "" {
+		node, err := l.nodes.Get(r.NodeId)
+		if err != nil {
+			return err
+		}
+		r.SiteId = node.Site.SiteId
+		r.NetworkId = node.Site.NetworkId
+		if r.SiteId == "" || r.NetworkId != "" {
+			return nil
+		}
+	}
+	if r.SiteId != "" {
+		site, err := l.sites.Get(r.SiteId)
+		if err != nil {
+			return err
+		}
+		r.NetworkId = site.NetworkId
+	}
+	return nil
+}
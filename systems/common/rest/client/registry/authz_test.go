@@ -0,0 +1,65 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package registry_test
+
+import (
+	"testing"
+
+	"github.com/tj/assert"
+
+	"github.com/ukama/ukama/systems/common/mocks"
+	"github.com/ukama/ukama/systems/common/rest/client/registry"
+	"github.com/ukama/ukama/systems/common/roles"
+)
+
+const (
+	testSiteId    = "5a3f2d40-3f76-4b0e-8f11-2d4cbb0a9c11"
+	testNetworkId = "0f2c8c4e-70ab-4c43-9d8e-4f1cbd3c1a7e"
+	otherUuid     = "e0b5c7a2-9d8c-4a36-8a8f-1b4c2a6f7d33"
+)
+
+func TestLocator_Locate(t *testing.T) {
+	t.Run("NodeReplacesCallerParents", func(tt *testing.T) {
+		nodes := &mocks.NodeClient{}
+		sites := &mocks.SiteClient{}
+		nodes.On("Get", testNodeId).Return(&registry.NodeInfo{Id: testNodeId,
+			Site: registry.NodeSiteInfo{SiteId: testSiteId, NetworkId: testNetworkId}}, nil).Once()
+
+		r := roles.Resource{NodeId: testNodeId, SiteId: otherUuid, NetworkId: otherUuid}
+		assert.NoError(tt, registry.NewLocator(sites, nodes).Locate(&r))
+
+		assert.Equal(tt, roles.Resource{NodeId: testNodeId, SiteId: testSiteId, NetworkId: testNetworkId}, r)
+		nodes.AssertExpectations(tt)
+		sites.AssertExpectations(tt)
+	})
+
+	t.Run("SiteReplacesCallerNetwork", func(tt *testing.T) {
+		nodes := &mocks.NodeClient{}
+		sites := &mocks.SiteClient{}
+		sites.On("Get", testSiteId).Return(&registry.SiteInfo{Id: testSiteId, NetworkId: testNetworkId}, nil).Once()
+
+		r := roles.Resource{SiteId: testSiteId, NetworkId: otherUuid}
+		assert.NoError(tt, registry.NewLocator(sites, nodes).Locate(&r))
+
+		assert.Equal(tt, roles.Resource{SiteId: testSiteId, NetworkId: testNetworkId}, r)
+		sites.AssertExpectations(tt)
+	})
+
+	t.Run("UnassignedNode", func(tt *testing.T) {
+		nodes := &mocks.NodeClient{}
+		sites := &mocks.SiteClient{}
+		nodes.On("Get", testNodeId).Return(&registry.NodeInfo{Id: testNodeId}, nil).Once()
+
+		r := roles.Resource{NodeId: testNodeId, SiteId: otherUuid}
+		assert.NoError(tt, registry.NewLocator(sites, nodes).Locate(&r))
+
+		assert.Equal(tt, roles.Resource{NodeId: testNodeId}, r)
+		sites.AssertExpectations(tt)
+	})
+}
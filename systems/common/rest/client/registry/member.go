@@ -11,6 +11,7 @@ package registry
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"time"
 
@@ -39,9 +40,30 @@ type MemberInfoResponse struct {
 	Member MemberInfo `json:"member"`
 }
 
+// MemberGrant is a role a member holds on the org, a network or a site
+type MemberGrant struct {
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions"`
+	ScopeKind   string   `json:"scope_kind"`
+	ScopeId     string   `json:"scope_id,omitempty"`
+}
+
+type MemberAccess struct {
+	Grants []MemberGrant `json:"grants"`
+}
+
+type MemberBinding struct {
+	Role      string `json:"role"`
+	ScopeKind string `json:"scope_kind"`
+	ScopeId   string `json:"scope_id,omitempty"`
+}
+
 type MemberClient interface {
 	GetByUserId(Id string) (*MemberInfoResponse, error)
 	AddMember(uuid string) (*MemberInfoResponse, error)
+	AddMemberWithRole(uuid string, role string) (*MemberInfoResponse, error)
+	AddRoleBinding(userId string, binding MemberBinding) error
+	GetAccess(userId string) (*MemberAccess, error)
 }
 
 type memberClient struct {
@@ -86,13 +108,17 @@ func (m *memberClient) GetByUserId(id string) (*MemberInfoResponse, error) {
 }
 
 func (m *memberClient) AddMember(uuid string) (*MemberInfoResponse, error) {
+	return m.AddMemberWithRole(uuid, ukama.RoleType_ROLE_USER.String())
+}
+
+func (m *memberClient) AddMemberWithRole(uuid string, role string) (*MemberInfoResponse, error) {
 
 	log.Debugf("Adding member: %v", uuid)
 
 	memberRes := MemberInfoResponse{}
 	req := OrgMember{
 		UserUuid: uuid,
-		Role:     ukama.RoleType_ROLE_USER.String(),
+		Role:     role,
 	}
 
 	b, err := json.Marshal(req)
@@ -115,3 +141,47 @@ func (m *memberClient) AddMember(uuid string) (*MemberInfoResponse, error) {
 
 	return &memberRes, nil
 }
+
+func (m *memberClient) AddRoleBinding(userId string, binding MemberBinding) error {
+	log.Debugf("Binding role %s to member: %v", binding.Role, userId)
+
+	b, err := json.Marshal(binding)
+	if err != nil {
+		return fmt.Errorf("request marshal error: %w", err)
+	}
+
+	_, err = m.R.Post(m.u.String()+MemberEndpoint+"/user/"+userId+"/bindings", b)
+	if err != nil {
+		log.Errorf("AddRoleBinding failure. error: %s", err.Error())
+
+		return fmt.Errorf("AddRoleBinding failure: %w", err)
+	}
+
+	return nil
+}
+
+func (m *memberClient) GetAccess(userId string) (*MemberAccess, error) {
+	log.Debugf("Getting access of member: %v", userId)
+
+	access := MemberAccess{}
+
+	resp, err := m.R.Get(m.u.String() + MemberEndpoint + "/user/" + userId + "/access")
+	if err != nil {
+		log.Errorf("GetAccess failure. error: %s", err.Error())
+
+		return nil, fmt.Errorf("GetAccess failure: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("GetAccess failure: unexpected status %d", resp.StatusCode())
+	}
+
+	err = json.Unmarshal(resp.Body(), &access)
+	if err != nil {
+		log.Tracef("Failed to deserialize member access. Error message is: %s", err.Error())
+
+		return nil, fmt.Errorf("member access deserialization failure: %w", err)
+	}
+
+	return &access, nil
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/ukama/ukama/systems/common/rest/client"
 	"github.com/ukama/ukama/systems/common/rest/client/registry"
+	"github.com/ukama/ukama/systems/common/roles"
 )
 
 func TestMemberClient_GetByUserId(t *testing.T) {
@@ -223,3 +224,54 @@ func TestMemberClient_AddMember(t *testing.T) {
 		assert.Nil(tt, n)
 	})
 }
+
+func TestMemberClient_GetAccess(t *testing.T) {
+	mockTransport := func(req *http.Request) *http.Response {
+		assert.Equal(t, req.URL.String(), registry.MemberEndpoint+"/user/"+testUuid+"/access")
+
+		access := `{"grants":[{"role":"users","permissions":["*.*.read"],"scope_kind":"org"},
+			{"role":"field_tech","permissions":["node.nodes.*"],"scope_kind":"site","scope_id":"` + testUuid + `"}]}`
+
+		return &http.Response{
+			StatusCode: 200,
+			Status:     "200 OK",
+			Body:       io.NopCloser(bytes.NewBufferString(access)),
+			Header:     make(http.Header),
+		}
+	}
+
+	testMemberClient := registry.NewMemberClient("")
+	testMemberClient.R.C.SetTransport(client.RoundTripFunc(mockTransport))
+
+	grants, err := registry.NewMemberGrants(testMemberClient).Grants(testUuid)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(grants))
+	assert.Equal(t, roles.Scope{Kind: roles.ScopeSite, Id: testUuid}, grants[1].Scope)
+	assert.True(t, grants[1].Allows("node.nodes.write", roles.Resource{SiteId: testUuid}))
+	assert.False(t, grants[1].Allows("node.nodes.write", roles.Resource{}))
+}
+
+func TestMemberClient_AddRoleBinding(t *testing.T) {
+	mockTransport := func(req *http.Request) *http.Response {
+		assert.Equal(t, req.URL.String(), registry.MemberEndpoint+"/user/"+testUuid+"/bindings")
+		assert.Equal(t, http.MethodPost, req.Method)
+
+		body, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"role":"billing","scope_kind":"org"}`, string(body))
+
+		return &http.Response{
+			StatusCode: 201,
+			Status:     "201 CREATED",
+			Body:       io.NopCloser(bytes.NewBufferString(`{"binding":{"role":"billing"}}`)),
+			Header:     make(http.Header),
+		}
+	}
+
+	testMemberClient := registry.NewMemberClient("")
+	testMemberClient.R.C.SetTransport(client.RoundTripFunc(mockTransport))
+
+	err := testMemberClient.AddRoleBinding(testUuid, registry.MemberBinding{Role: "billing", ScopeKind: "org"})
+
+	assert.NoError(t, err)
+}
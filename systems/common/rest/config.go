@@ -15,7 +15,8 @@ import (
 // Use this if you don't use github.com/num30/config
 func DefaultHTTPConfig() HttpConfig {
 	return HttpConfig{
-		Port: 8080,
+		Port:         8080,
+		InternalPort: 8081,
 		Cors: cors.Config{
 			AllowOrigins: []string{"http://localhost", "https://localhost", "*"},
 		},
@@ -23,6 +24,9 @@ func DefaultHTTPConfig() HttpConfig {
 }
 
 type HttpConfig struct {
-	Port int         `default:"8080"`
-	Cors cors.Config `default:"{\"AllowOrigins\": [\"http://localhost\", \"https://localhost\", \"*\"]}"`
+	Port int `default:"8080"`
+	// InternalPort serves the routes only other services call. It must not
+	// be exposed by the ingress.
+	InternalPort int         `default:"8081"`
+	Cors         cors.Config `default:"{\"AllowOrigins\": [\"http://localhost\", \"https://localhost\", \"*\"]}"`
 }
@@ -30,6 +30,7 @@ func Test_DefaultValues(t *testing.T) {
 	err := reader.Read(testConf)
 	if assert.NoError(t, err) {
 		assert.Equal(t, "http://localhost", testConf.Conf.Cors.AllowOrigins[0])
+		assert.Equal(t, 8081, testConf.Conf.InternalPort)
 	}
 }
 
@@ -98,6 +98,33 @@ func NewFizzRouter(httpConfig *HttpConfig, srvName string, srvVersion string, is
 	return f
 }
 
+// NewInternalFizzRouter serves the routes that only other services call,
+// without CORS nor API docs. Run it with RunInternal.
+func NewInternalFizzRouter(srvName string, srvVersion string) *fizz.Fizz {
+	g := gin.New()
+	g.Use(gin.Logger(), gin.Recovery())
+
+	tonic.SetErrorHook(errorHook)
+	tonic.SetRenderHook(renderHook, jsonContentType[0])
+
+	f := fizz.NewFromEngine(g)
+	f.GET("/ping", nil, tonic.Handler(func(c *gin.Context) (*PingResponse, error) {
+		return &PingResponse{Message: "pong", Service: fmt.Sprintf("%s@%s", srvName, srvVersion)}, nil
+	}, http.StatusOK))
+
+	return f
+}
+
+// RunInternal serves f on the internal port of httpConfig in the background.
+func RunInternal(f *fizz.Fizz, httpConfig *HttpConfig) {
+	go func() {
+		logrus.Info("Listening internally on port ", httpConfig.InternalPort)
+		if err := f.Engine().Run(fmt.Sprint(":", httpConfig.InternalPort)); err != nil {
+			panic(err)
+		}
+	}()
+}
+
 func errorHook(c *gin.Context, e error) (int, interface{}) {
 	if e == nil {
 		logrus.Errorf("This error means that something is broken but it's no clear what. Usually something bad with serialization")
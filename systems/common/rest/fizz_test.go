@@ -13,6 +13,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	grpcGate "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -82,3 +83,17 @@ func Test_errorHook(t *testing.T) {
 		})
 	}
 }
+
+func TestNewInternalFizzRouter(t *testing.T) {
+	f := NewInternalFizzRouter("test", "0.0.1")
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/ping", nil)
+	f.Engine().ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodGet, "/openapi.json", nil)
+	f.Engine().ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code, "the internal router has no API docs")
+}
@@ -0,0 +1,137 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package roles
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrForbidden = errors.New("forbidden")
+
+const DefaultGrantsTTL = 30 * time.Second
+
+// GrantSource returns the grants a user holds in the org.
+type GrantSource interface {
+	Grants(userId string) ([]Grant, error)
+}
+
+// Locator resolves the site and network of a resource from its node or site,
+// so that network and site bindings apply to requests naming only a child.
+type Locator interface {
+	Locate(r *Resource) error
+}
+
+type Authorizer struct {
+	system  string
+	grants  GrantSource
+	locator Locator
+	ttl     time.Duration
+	routes  map[string]Permission
+
+	mu    sync.Mutex
+	cache map[string]cachedGrants
+}
+
+type cachedGrants struct {
+	grants  []Grant
+	expires time.Time
+}
+
+type Option func(*Authorizer)
+
+func WithLocator(l Locator) Option {
+	return func(a *Authorizer) { a.locator = l }
+}
+
+// WithGrantsTTL sets how long the grants of a user are cached. Changes to
+// roles and bindings take up to that long to apply.
+func WithGrantsTTL(ttl time.Duration) Option {
+	return func(a *Authorizer) { a.ttl = ttl }
+}
+
+// WithRoute names the permission of a route instead of deriving it, for
+// routes such as node restarts that deserve their own permission.
+func WithRoute(method, path string, p Permission) Option {
+	return func(a *Authorizer) { a.routes[method+" "+path] = p }
+}
+
+func NewAuthorizer(system string, grants GrantSource, opts ...Option) *Authorizer {
+	a := &Authorizer{
+		system: system,
+		grants: grants,
+		ttl:    DefaultGrantsTTL,
+		routes: map[string]Permission{},
+		cache:  map[string]cachedGrants{},
+	}
+	for _, o := range opts {
+		o(a)
+	}
+	return a
+}
+
+// Authorize returns nil if userId holds p on r through any of their grants,
+// or an error wrapping ErrForbidden. Only the most specific id of r is
+// trusted, its parents are resolved by the Locator.
+func (a *Authorizer) Authorize(userId string, p Permission, r Resource) error {
+	if userId == "" {
+		return fmt.Errorf("%w: no user", ErrForbidden)
+	}
+	grants, err := a.userGrants(userId)
+	if err != nil {
+		return err
+	}
+
+	r = r.Leaf()
+	if allows(grants, p, r) {
+		return nil
+	}
+	if a.locator != nil && (r.NodeId != "" || r.SiteId != "") {
+		if err := a.locator.Locate(&r); err != nil {
+			log.Warnf("Failed to locate %+v for authorization: %v", r, err)
+		} else if allows(grants, p, r) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: user %s lacks %s", ErrForbidden, userId, p)
+}
+
+func allows(grants []Grant, p Permission, r Resource) bool {
+	for _, g := range grants {
+		if g.Allows(p, r) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Authorizer) userGrants(userId string) ([]Grant, error) {
+	now := time.Now()
+
+	a.mu.Lock()
+	c, ok := a.cache[userId]
+	a.mu.Unlock()
+	if ok && now.Before(c.expires) {
+		return c.grants, nil
+	}
+
+	grants, err := a.grants.Grants(userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grants of user %s: %w", userId, err)
+	}
+
+	a.mu.Lock()
+	a.cache[userId] = cachedGrants{grants: grants, expires: now.Add(a.ttl)}
+	a.mu.Unlock()
+	return grants, nil
+}
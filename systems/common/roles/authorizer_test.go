@@ -0,0 +1,175 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package roles
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	techId    = "8d6bc4d6-5cd7-4b1f-a6c4-8c1d3fba4b1a"
+	networkId = "0f2c8c4e-70ab-4c43-9d8e-4f1cbd3c1a7e"
+	siteX     = "5a3f2d40-3f76-4b0e-8f11-2d4cbb0a9c11"
+	siteY     = "e0b5c7a2-9d8c-4a36-8a8f-1b4c2a6f7d33"
+)
+
+type fakeGrants map[string][]Grant
+
+func (f fakeGrants) Grants(userId string) ([]Grant, error) {
+	g, ok := f[userId]
+	if !ok {
+		return nil, errors.New("unknown user")
+	}
+	return g, nil
+}
+
+type fakeLocator map[string]string
+
+func (f fakeLocator) Locate(r *Resource) error {
+	if r.NodeId != "" {
+		r.SiteId = f[r.NodeId]
+	}
+	if r.SiteId != "" {
+		r.NetworkId = f[r.SiteId]
+	}
+	return nil
+}
+
+func fieldTech() fakeGrants {
+	return fakeGrants{techId: {{
+		Role:        "field_tech",
+		Permissions: []Permission{"registry.*.read", "node.nodes.*"},
+		Scope:       Scope{Kind: ScopeSite, Id: siteX},
+	}}}
+}
+
+func TestPermission_Grants(t *testing.T) {
+	assert.True(t, PermissionAll.Grants("registry.sites.write"))
+	assert.True(t, Permission("registry.*.read").Grants("registry.sites.read"))
+	assert.True(t, Permission("*.*.read").Grants("node.nodes.read"))
+	assert.False(t, Permission("registry.*.read").Grants("registry.sites.write"))
+	assert.False(t, Permission("registry.sites").Grants("registry.sites.read"))
+
+	assert.NoError(t, Permission("node.nodes.*").Validate())
+	assert.Error(t, Permission("node.nodes").Validate())
+	assert.Error(t, Permission("node.no*des.read").Validate())
+	assert.Error(t, Permission("Node.nodes.read").Validate())
+}
+
+func TestAuthorizer_SiteScope(t *testing.T) {
+	a := NewAuthorizer("registry", fieldTech())
+
+	assert.NoError(t, a.Authorize(techId, "registry.sites.read", Resource{SiteId: siteX}))
+	assert.ErrorIs(t, a.Authorize(techId, "registry.sites.read", Resource{SiteId: siteY}), ErrForbidden)
+	assert.ErrorIs(t, a.Authorize(techId, "registry.sites.write", Resource{SiteId: siteX}), ErrForbidden)
+	assert.ErrorIs(t, a.Authorize(techId, "registry.sites.read", Resource{}), ErrForbidden,
+		"listing the whole org needs an org grant")
+	assert.ErrorIs(t, a.Authorize("", "registry.sites.read", Resource{SiteId: siteX}), ErrForbidden)
+	assert.Error(t, a.Authorize("someone-else", "registry.sites.read", Resource{SiteId: siteX}))
+}
+
+func TestAuthorizer_LocatesNodes(t *testing.T) {
+	grants := fieldTech()
+	grants[techId] = append(grants[techId], Grant{
+		Role:        "network_viewer",
+		Permissions: []Permission{"*.*.read"},
+		Scope:       Scope{Kind: ScopeNetwork, Id: networkId},
+	})
+	a := NewAuthorizer("node", grants, WithLocator(fakeLocator{
+		"uk-sa0001-tnode-a1-0001": siteX,
+		"uk-sa0001-tnode-a1-0002": siteY,
+		siteX:                     networkId,
+		siteY:                     networkId,
+	}))
+
+	assert.NoError(t, a.Authorize(techId, "node.nodes.write", Resource{NodeId: "uk-sa0001-tnode-a1-0001"}))
+	assert.ErrorIs(t, a.Authorize(techId, "node.nodes.write", Resource{NodeId: "uk-sa0001-tnode-a1-0002"}), ErrForbidden)
+	assert.NoError(t, a.Authorize(techId, "node.nodes.read", Resource{NodeId: "uk-sa0001-tnode-a1-0002"}),
+		"the network grant covers the node's site")
+}
+
+func TestAuthorizer_IgnoresCallerParents(t *testing.T) {
+	a := NewAuthorizer("node", fieldTech(), WithLocator(fakeLocator{
+		"uk-sa0001-tnode-a1-0002": siteY,
+		siteY:                     networkId,
+	}))
+
+	assert.ErrorIs(t, a.Authorize(techId, "node.nodes.write",
+		Resource{NodeId: "uk-sa0001-tnode-a1-0002", SiteId: siteX}), ErrForbidden,
+		"the node's own site is used, not the one sent with it")
+
+	a = NewAuthorizer("node", fieldTech())
+	assert.ErrorIs(t, a.Authorize(techId, "node.nodes.write",
+		Resource{NodeId: "uk-sa0001-tnode-a1-0002", SiteId: siteX}), ErrForbidden,
+		"without a locator a node can't be placed in a site")
+}
+
+func TestRequestResource(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var got Resource
+
+	r := gin.New()
+	r.GET("/v1/nodes/:node_id", func(c *gin.Context) { got = RequestResource(c) })
+	r.GET("/v1/sites", func(c *gin.Context) { got = RequestResource(c) })
+
+	req, _ := http.NewRequest(http.MethodGet, "/v1/nodes/uk-sa0001-tnode-a1-0002?site_id="+siteX+"&network_id="+networkId, nil)
+	r.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, Resource{NodeId: "uk-sa0001-tnode-a1-0002"}, got)
+
+	req, _ = http.NewRequest(http.MethodGet, "/v1/sites?site_id="+siteX+"&network_id="+networkId, nil)
+	r.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, Resource{SiteId: siteX}, got)
+}
+
+func TestAuthorizer_RoutePermission(t *testing.T) {
+	a := NewAuthorizer("node", nil, WithRoute(http.MethodPost, "/v1/nodes/:node_id/restart", "node.nodes.control"))
+
+	assert.Equal(t, Permission("node.nodes.read"), a.RoutePermission(http.MethodGet, "/v1/nodes/:node_id"))
+	assert.Equal(t, Permission("node.sites.write"), a.RoutePermission(http.MethodPut, "/v1/sites/:site_id/coverage"))
+	assert.Equal(t, Permission("node.nodes.control"), a.RoutePermission(http.MethodPost, "/v1/nodes/:node_id/restart"))
+	assert.Equal(t, Permission("node.api.read"), a.RoutePermission(http.MethodGet, "/v1/:id"))
+	assert.Equal(t, Permission("registry.sites.write"), PathPermission("registry", http.MethodPost, "/v1/sites/"+siteX+"/relocate"))
+}
+
+func TestPathResource(t *testing.T) {
+	assert.Equal(t, Resource{NodeId: "uk-sa0001-tnode-a1-0001"},
+		PathResource("/v1/networks/"+networkId+"/sites/"+siteX+"/nodes/uk-sa0001-tnode-a1-0001"))
+	assert.Equal(t, Resource{SiteId: siteX}, PathResource("/v1/sites/"+siteX))
+	assert.Equal(t, Resource{NetworkId: networkId}, PathResource("/v1/networks/"+networkId+"/sites"))
+	assert.Equal(t, Resource{}, PathResource("/v1/members"))
+}
+
+func TestAuthorizer_AuthFunc(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	a := NewAuthorizer("registry", fieldTech())
+	authenticated := func(*gin.Context, string) error { return nil }
+
+	r := gin.New()
+	r.GET("/v1/sites/:site_id", func(c *gin.Context) {
+		if err := a.AuthFunc(authenticated)(c, ""); err != nil {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	for site, code := range map[string]int{siteX: http.StatusOK, siteY: http.StatusUnauthorized} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/v1/sites/"+site, nil)
+		req.Header.Set(UserIdHeader, techId)
+		r.ServeHTTP(w, req)
+		assert.Equal(t, code, w.Code, site)
+	}
+}
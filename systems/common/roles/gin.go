@@ -0,0 +1,108 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package roles
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UserIdHeader carries the id of the authenticated user. The auth client
+// sets it from the auth gateway's answer.
+const UserIdHeader = "User-id"
+
+var versionSegment = regexp.MustCompile(`^v[0-9]+$`)
+
+// AuthFunc wraps the auth function of an api-gateway so that requests are
+// authorized once authenticated.
+func (a *Authorizer) AuthFunc(authenticate func(*gin.Context, string) error) func(*gin.Context, string) error {
+	return func(c *gin.Context, u string) error {
+		if err := authenticate(c, u); err != nil {
+			return err
+		}
+		return a.Authorize(c.Request.Header.Get(UserIdHeader),
+			a.RoutePermission(c.Request.Method, c.FullPath()), RequestResource(c))
+	}
+}
+
+// RoutePermission is the permission needed for a route, the one set with
+// WithRoute or else PathPermission.
+func (a *Authorizer) RoutePermission(method, path string) Permission {
+	if p, ok := a.routes[method+" "+path]; ok {
+		return p
+	}
+	return PathPermission(a.system, method, path)
+}
+
+// PathPermission is <system>.<resource>.<action>, the resource being the first
+// path segment after the version and the action read for GET and HEAD,
+// write otherwise.
+func PathPermission(system, method, path string) Permission {
+	resource := "api"
+	for _, seg := range strings.Split(path, "/") {
+		if seg == "" || versionSegment.MatchString(seg) {
+			continue
+		}
+		if !strings.HasPrefix(seg, ":") && !strings.HasPrefix(seg, "*") {
+			resource = seg
+		}
+		break
+	}
+
+	action := ActionWrite
+	if method == http.MethodGet || method == http.MethodHead {
+		action = ActionRead
+	}
+	return NewPermission(system, resource, action)
+}
+
+// RequestResource takes the most specific of the node, site and network of a
+// request from its path parameters, falling back to the query. Parent ids
+// sent alongside it are dropped, the Locator resolves them.
+func RequestResource(c *gin.Context) Resource {
+	get := func(names ...string) string {
+		for _, n := range names {
+			if v := c.Param(n); v != "" {
+				return v
+			}
+		}
+		for _, n := range names {
+			if v := c.Query(n); v != "" {
+				return v
+			}
+		}
+		return ""
+	}
+	return Resource{
+		NetworkId: get("network_id", "net_id"),
+		SiteId:    get("site_id"),
+		NodeId:    get("node_id"),
+	}.Leaf()
+}
+
+// PathResource takes the most specific of the node, site and network a
+// request path names, from the segment after nodes, sites or networks.
+func PathResource(path string) Resource {
+	var r Resource
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i+1 < len(segs); i++ {
+		switch segs[i] {
+		case "nodes":
+			r.NodeId = segs[i+1]
+		case "sites":
+			r.SiteId = segs[i+1]
+		case "networks":
+			r.NetworkId = segs[i+1]
+		}
+	}
+	return r.Leaf()
+}
@@ -0,0 +1,102 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package roles
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Permission names an action on an API resource as
+// "<system>.<resource>.<action>", such as "registry.sites.write". A role may
+// use "*" for any part, and "*" alone grants everything.
+type Permission string
+
+const (
+	ActionRead  = "read"
+	ActionWrite = "write"
+
+	PermissionAll Permission = "*"
+)
+
+func NewPermission(system, resource, action string) Permission {
+	return Permission(strings.ToLower(system + "." + resource + "." + action))
+}
+
+// Grants reports whether p, held through a role, covers the requested
+// permission req.
+func (p Permission) Grants(req Permission) bool {
+	if p == PermissionAll {
+		return true
+	}
+	have := strings.Split(string(p), ".")
+	want := strings.Split(string(req), ".")
+	if len(have) != len(want) {
+		return false
+	}
+	for i := range have {
+		if have[i] != "*" && have[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (p Permission) Validate() error {
+	if p == PermissionAll {
+		return nil
+	}
+	parts := strings.Split(string(p), ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("invalid permission %q, expected <system>.<resource>.<action>", p)
+	}
+	for _, part := range parts {
+		if part == "" || strings.Trim(part, "abcdefghijklmnopqrstuvwxyz0123456789-_*") != "" ||
+			(strings.Contains(part, "*") && part != "*") {
+			return fmt.Errorf("invalid permission %q", p)
+		}
+	}
+	return nil
+}
+
+// BuiltinRoles are the permissions of the fixed org roles. Every member holds
+// their org role on the whole org, in addition to any role bindings.
+var BuiltinRoles = map[RoleType][]Permission{
+	TYPE_OWNER: {PermissionAll},
+	TYPE_ADMIN: {PermissionAll},
+	TYPE_NETWORK_OWNER: {"*.*.read", "registry.*.*", "node.*.*", "subscriber.*.*",
+		"dataplan.*.*", "notification.*.*"},
+	TYPE_VENDOR:     {"*.*.read", "inventory.*.*"},
+	TYPE_USERS:      {"*.*.read"},
+	TYPE_SUBSCRIBER: {"subscriber.*.read", "notification.*.read"},
+}
+
+var roleNames = map[RoleType]string{
+	TYPE_OWNER:         "owner",
+	TYPE_ADMIN:         "admin",
+	TYPE_NETWORK_OWNER: "network_owner",
+	TYPE_VENDOR:        "vendor",
+	TYPE_USERS:         "users",
+	TYPE_SUBSCRIBER:    "subscriber",
+}
+
+// RoleName is the name a role binding uses for a built-in role.
+func RoleName(r RoleType) string {
+	return roleNames[r]
+}
+
+// BuiltinRole returns the built-in role called name, if there is one.
+func BuiltinRole(name string) (RoleType, bool) {
+	for r, n := range roleNames {
+		if n == name {
+			return r, true
+		}
+	}
+	return TYPE_INVALID, false
+}
@@ -10,6 +10,7 @@ package validation
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/coreos/go-semver/semver"
 )
@@ -36,7 +37,16 @@ func CompareVersions(version1 string, version2 string) (int, error) {
 	return c, nil // 1 if version1 is greater than version2, 0 if they are equal, -1 if version1 is less than version2
 }
 
+// IsVersionMismatch reports whether two versions differ. A leading v is
+// ignored and semantic versions compare by precedence, so v1.2.0 matches 1.2.0.
 func IsVersionMismatch(version1 string, version2 string) bool {
-	return version1 != version2
+	v1 := strings.TrimPrefix(version1, "v")
+	v2 := strings.TrimPrefix(version2, "v")
+
+	if c, err := CompareVersions(v1, v2); err == nil {
+		return c != 0
+	}
+
+	return v1 != v2
 }
 
@@ -199,3 +199,49 @@ func TestCompareVersions(t *testing.T) {
 		})
 	}
 }
+
+func TestIsVersionMismatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		version1 string
+		version2 string
+		want     bool
+	}{
+		{
+			name:     "same version",
+			version1: "1.2.0",
+			version2: "1.2.0",
+			want:     false,
+		},
+		{
+			name:     "leading v ignored",
+			version1: "v1.2.0",
+			version2: "1.2.0",
+			want:     false,
+		},
+		{
+			name:     "different version",
+			version1: "v1.2.0",
+			version2: "1.2.1",
+			want:     true,
+		},
+		{
+			name:     "same non semver tag",
+			version1: "latest",
+			version2: "latest",
+			want:     false,
+		},
+		{
+			name:     "different non semver tag",
+			version1: "latest",
+			version2: "1.2.0",
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsVersionMismatch(tt.version1, tt.version2))
+		})
+	}
+}
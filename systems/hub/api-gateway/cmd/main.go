@@ -19,6 +19,7 @@ import (
 	"github.com/ukama/ukama/systems/hub/api-gateway/pkg"
 
 	ccmd "github.com/ukama/ukama/systems/common/cmd"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
 	server "github.com/ukama/ukama/systems/hub/api-gateway/pkg/rest"
 )
 
@@ -32,7 +33,8 @@ func main() {
 
 	metrics.StartMetricsServer(&svcConf.Metrics)
 	r := server.NewRouter(clientSet, server.NewRouterConfig(svcConf),
-		auth.NewAuthClient(svcConf.Auth.AuthServerUrl, client.WithDebug(svcConf.DebugMode)).AuthenticateUser)
+		creg.NewAuthFunc(pkg.SystemName, svcConf.Auth,
+			auth.NewAuthClient(svcConf.Auth.AuthServerUrl, client.WithDebug(svcConf.DebugMode)).AuthenticateUser))
 	r.Run()
 }
 
@@ -42,6 +42,36 @@ func (_m *chunker) CreateChunk(in *gen.CreateChunkRequest) (*gen.CreateChunkResp
 	return r0, r1
 }
 
+// GetDeltaStats provides a mock function with given fields: in
+func (_m *chunker) GetDeltaStats(in *gen.GetDeltaStatsRequest) (*gen.GetDeltaStatsResponse, error) {
+	ret := _m.Called(in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeltaStats")
+	}
+
+	var r0 *gen.GetDeltaStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.GetDeltaStatsRequest) (*gen.GetDeltaStatsResponse, error)); ok {
+		return rf(in)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.GetDeltaStatsRequest) *gen.GetDeltaStatsResponse); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetDeltaStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.GetDeltaStatsRequest) error); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // newChunker creates a new instance of chunker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newChunker(t interface {
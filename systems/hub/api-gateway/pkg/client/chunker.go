@@ -68,3 +68,10 @@ func (c *Chunker) CreateChunk(in *pb.CreateChunkRequest) (*pb.CreateChunkRespons
 
 	return c.client.CreateChunk(ctx, in)
 }
+
+func (c *Chunker) GetDeltaStats(in *pb.GetDeltaStatsRequest) (*pb.GetDeltaStatsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.GetDeltaStats(ctx, in)
+}
@@ -29,3 +29,10 @@ type ArtifactListRequest struct {
 	ArtifactType string `path:"type" validate:"required"`
 	Latest       bool   `query:"latest"`
 }
+
+type ArtifactDeltaRequest struct {
+	Name         string `path:"name" validate:"required"`
+	ArtifactType string `path:"type" validate:"eq=app|eq=cert|eq=config"`
+	From         string `query:"from"`
+	To           string `query:"to" validate:"required"`
+}
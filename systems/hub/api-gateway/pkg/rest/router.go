@@ -69,6 +69,7 @@ type artifactManager interface {
 
 type chunker interface {
 	CreateChunk(in *dpb.CreateChunkRequest) (*dpb.CreateChunkResponse, error)
+	GetDeltaStats(in *dpb.GetDeltaStatsRequest) (*dpb.GetDeltaStatsResponse, error)
 }
 
 func NewClientsSet(endpoints *pkg.GrpcEndpoints) *Clients {
@@ -146,6 +147,7 @@ func (r *Router) init(f func(*gin.Context, string) error) {
 	auth.Use()
 	{
 		artifact := auth.Group("/hub", "Artifact store", "Artifact operations")
+		artifact.GET("/:type/:name/delta", formatDoc("Get update delta", "Get the chunks and bytes a node downloads to update between two versions"), tonic.Handler(r.artifactDeltaHandler, http.StatusOK))
 		artifact.GET("/:type/:name/:filename", formatDoc("Get Artifact contents", "Get artifact contents or its index files"), tonic.Handler(r.artifactGetHandler, http.StatusOK))
 		artifact.PUT("/:type/:name/:version", formatDocWithFileUpload("Upload artifact", "Upload a gzip-compressed artifact (.tar.gz) via multipart form or raw body"),
 			tonic.Handler(r.artifactPutHandler, http.StatusCreated))
@@ -286,6 +288,35 @@ func (r *Router) artifactListVersionsHandler(c *gin.Context, req *ArtifactVersio
 	})
 }
 
+func (r *Router) artifactDeltaHandler(c *gin.Context, req *ArtifactDeltaRequest) (*dpb.GetDeltaStatsResponse, error) {
+	log.Infof("Getting delta for %s of type %s from %s to %s", req.Name, req.ArtifactType, req.From, req.To)
+
+	if req.From != "" {
+		if _, err := r.parseVersion(req.From); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := r.parseVersion(req.To); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.clients.c.GetDeltaStats(&dpb.GetDeltaStatsRequest{
+		Name:        req.Name,
+		Type:        req.ArtifactType,
+		FromVersion: req.From,
+		ToVersion:   req.To,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, rest.HttpError{HttpCode: http.StatusNotFound, Message: status.Convert(err).Message()}
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 func (r *Router) listArtifactsHandler(c *gin.Context, req *ArtifactListRequest) (*apb.ListArtifactResponse, error) {
 	log.Infof("Getting list of artifacts of type %s (latest=%v)", req.ArtifactType, req.Latest)
 
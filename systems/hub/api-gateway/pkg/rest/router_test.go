@@ -32,6 +32,7 @@ import (
 	cconfig "github.com/ukama/ukama/systems/common/config"
 	mbmocks "github.com/ukama/ukama/systems/common/mocks"
 	apb "github.com/ukama/ukama/systems/hub/artifactmanager/pb/gen"
+	dpb "github.com/ukama/ukama/systems/hub/distributor/pb/gen"
 	amocks "github.com/ukama/ukama/systems/hub/artifactmanager/pb/gen/mocks"
 	dmocks "github.com/ukama/ukama/systems/hub/distributor/pb/gen/mocks"
 )
@@ -270,6 +271,71 @@ func Test_RouterListVesrions(t *testing.T) {
 	assert.Contains(t, w.Body.String(), appName)
 }
 
+func Test_RouterGetDelta(t *testing.T) {
+	// arrange
+	appName := "test-app"
+	appType := "app"
+
+	ch := &dmocks.ChunkerServiceClient{}
+	am := &amocks.ArtifactServiceClient{}
+
+	w := httptest.NewRecorder()
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/v1/hub/%s/%s/delta?from=0.0.1&to=0.0.2", appType, appName), nil)
+
+	ch.On("GetDeltaStats", mock.Anything, &dpb.GetDeltaStatsRequest{
+		Name:        appName,
+		Type:        appType,
+		FromVersion: "0.0.1",
+		ToVersion:   "0.0.2",
+	}).Return(&dpb.GetDeltaStatsResponse{
+		Name:        appName,
+		Type:        appType,
+		FromVersion: "0.0.1",
+		ToVersion:   "0.0.2",
+		TotalChunks: 10,
+		TotalBytes:  1000,
+		NewChunks:   2,
+		NewBytes:    200,
+	}, nil)
+
+	r := NewRouter(&Clients{
+		a: client.NewArtifactManagerFromClient(am),
+		c: client.NewChunkerFromClient(ch),
+	}, routerConfig, nil).f.Engine()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	assert.Equal(t, 200, w.Code)
+	assert.Contains(t, w.Body.String(), "new_bytes")
+	assert.Contains(t, w.Body.String(), "\"200\"")
+	ch.AssertExpectations(t)
+}
+
+func Test_RouterGetDeltaInvalidVersion(t *testing.T) {
+	// arrange
+	ch := &dmocks.ChunkerServiceClient{}
+	am := &amocks.ArtifactServiceClient{}
+
+	w := httptest.NewRecorder()
+
+	req, _ := http.NewRequest("GET", "/v1/hub/app/test-app/delta?to=latest", nil)
+
+	r := NewRouter(&Clients{
+		a: client.NewArtifactManagerFromClient(am),
+		c: client.NewChunkerFromClient(ch),
+	}, routerConfig, nil).f.Engine()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	ch.AssertNotCalled(t, "GetDeltaStats", mock.Anything, mock.Anything)
+}
+
 func Test_RouterListArtifacts(t *testing.T) {
 	// arrange
 	appType := "app"
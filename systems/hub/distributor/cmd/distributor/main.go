@@ -20,8 +20,10 @@ import (
 	ugrpc "github.com/ukama/ukama/systems/common/grpc"
 	"github.com/ukama/ukama/systems/common/metrics"
 	"github.com/ukama/ukama/systems/common/uuid"
+	mc "github.com/ukama/ukama/systems/hub/artifactmanager/pkg"
 	"github.com/ukama/ukama/systems/hub/distributor/cmd/version"
 	"github.com/ukama/ukama/systems/hub/distributor/pkg"
+	"github.com/ukama/ukama/systems/hub/distributor/pkg/delta"
 	"github.com/ukama/ukama/systems/hub/distributor/pkg/distribution"
 	"github.com/ukama/ukama/systems/hub/distributor/pkg/server"
 
@@ -98,8 +100,21 @@ func startChunkRequestServer() *ugrpc.UkamaGrpcServer {
 		serviceConfig.MsgClient.ListenerRoutes)
 	log.Debugf("MessageBus Client is %+v", mbClient)
 
+	/* Delta stats read the chunk indexes stored by artifact manager */
+	artifactStore := mc.NewMinioWrapper(&mc.MinioConfig{
+		TimeoutSecond:         serviceConfig.Storage.TimeoutSecond,
+		Endpoint:              serviceConfig.Storage.Endpoint,
+		AccessKey:             serviceConfig.Storage.AccessKey,
+		SecretKey:             serviceConfig.Storage.SecretKey,
+		BucketSuffix:          serviceConfig.Storage.BucketSuffix,
+		Region:                serviceConfig.Storage.Region,
+		SkipBucketCreation:    true,
+		ArtifactTypeBucketMap: serviceConfig.Storage.ArtifactTypeBucketMap,
+	})
+	deltaCalc := delta.NewCalculator(delta.NewStoreIndexReader(artifactStore))
+
 	chunkerServer := server.NewChunkerServer(orgId, serviceConfig.OrgName, serviceConfig,
-		mbClient, serviceConfig.PushGateway)
+		mbClient, serviceConfig.PushGateway, deltaCalc)
 
 	log.Debugf("Distribution server is %+v and config %+v", chunkerServer, serviceConfig.Grpc)
 
@@ -149,6 +149,190 @@ func (x *CreateChunkResponse) GetSize() int64 {
 	return 0
 }
 
+type GetDeltaStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,json=name,proto3" json:"Name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=Type,json=type,proto3" json:"Type,omitempty"`
+	FromVersion   string                 `protobuf:"bytes,3,opt,name=FromVersion,json=from,proto3" json:"FromVersion,omitempty"`
+	ToVersion     string                 `protobuf:"bytes,4,opt,name=ToVersion,json=to,proto3" json:"ToVersion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeltaStatsRequest) Reset() {
+	*x = GetDeltaStatsRequest{}
+	mi := &file_distributor_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeltaStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeltaStatsRequest) ProtoMessage() {}
+
+func (x *GetDeltaStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_distributor_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeltaStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetDeltaStatsRequest) Descriptor() ([]byte, []int) {
+	return file_distributor_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDeltaStatsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetDeltaStatsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetDeltaStatsRequest) GetFromVersion() string {
+	if x != nil {
+		return x.FromVersion
+	}
+	return ""
+}
+
+func (x *GetDeltaStatsRequest) GetToVersion() string {
+	if x != nil {
+		return x.ToVersion
+	}
+	return ""
+}
+
+type GetDeltaStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	FromVersion   string                 `protobuf:"bytes,3,opt,name=fromVersion,json=from,proto3" json:"fromVersion,omitempty"`
+	ToVersion     string                 `protobuf:"bytes,4,opt,name=toVersion,json=to,proto3" json:"toVersion,omitempty"`
+	TotalChunks   uint32                 `protobuf:"varint,5,opt,name=totalChunks,json=total_chunks,proto3" json:"totalChunks,omitempty"`
+	TotalBytes    uint64                 `protobuf:"varint,6,opt,name=totalBytes,json=total_bytes,proto3" json:"totalBytes,omitempty"`
+	NewChunks     uint32                 `protobuf:"varint,7,opt,name=newChunks,json=new_chunks,proto3" json:"newChunks,omitempty"`
+	NewBytes      uint64                 `protobuf:"varint,8,opt,name=newBytes,json=new_bytes,proto3" json:"newBytes,omitempty"`
+	ReusedChunks  uint32                 `protobuf:"varint,9,opt,name=reusedChunks,json=reused_chunks,proto3" json:"reusedChunks,omitempty"`
+	ReusedBytes   uint64                 `protobuf:"varint,10,opt,name=reusedBytes,json=reused_bytes,proto3" json:"reusedBytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeltaStatsResponse) Reset() {
+	*x = GetDeltaStatsResponse{}
+	mi := &file_distributor_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeltaStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeltaStatsResponse) ProtoMessage() {}
+
+func (x *GetDeltaStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_distributor_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeltaStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetDeltaStatsResponse) Descriptor() ([]byte, []int) {
+	return file_distributor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDeltaStatsResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetDeltaStatsResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetDeltaStatsResponse) GetFromVersion() string {
+	if x != nil {
+		return x.FromVersion
+	}
+	return ""
+}
+
+func (x *GetDeltaStatsResponse) GetToVersion() string {
+	if x != nil {
+		return x.ToVersion
+	}
+	return ""
+}
+
+func (x *GetDeltaStatsResponse) GetTotalChunks() uint32 {
+	if x != nil {
+		return x.TotalChunks
+	}
+	return 0
+}
+
+func (x *GetDeltaStatsResponse) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *GetDeltaStatsResponse) GetNewChunks() uint32 {
+	if x != nil {
+		return x.NewChunks
+	}
+	return 0
+}
+
+func (x *GetDeltaStatsResponse) GetNewBytes() uint64 {
+	if x != nil {
+		return x.NewBytes
+	}
+	return 0
+}
+
+func (x *GetDeltaStatsResponse) GetReusedChunks() uint32 {
+	if x != nil {
+		return x.ReusedChunks
+	}
+	return 0
+}
+
+func (x *GetDeltaStatsResponse) GetReusedBytes() uint64 {
+	if x != nil {
+		return x.ReusedBytes
+	}
+	return 0
+}
+
 var File_distributor_proto protoreflect.FileDescriptor
 
 const file_distributor_proto_rawDesc = "" +
@@ -161,9 +345,29 @@ const file_distributor_proto_rawDesc = "" +
 	"\x05Store\x18\x04 \x01(\tR\blocation\"?\n" +
 	"\x13CreateChunkResponse\x12\x14\n" +
 	"\x05index\x18\x01 \x01(\fR\x05index\x12\x12\n" +
-	"\x04size\x18\x02 \x01(\x03R\x04size2|\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\"\x88\x01\n" +
+	"\x14GetDeltaStatsRequest\x12\x1a\n" +
+	"\x04Name\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x04name\x12\x1a\n" +
+	"\x04Type\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x04type\x12\x19\n" +
+	"\vFromVersion\x18\x03 \x01(\tR\x04from\x12\x1d\n" +
+	"\tToVersion\x18\x04 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x02to\"\xb9\x02\n" +
+	"\x15GetDeltaStatsResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x19\n" +
+	"\vfromVersion\x18\x03 \x01(\tR\x04from\x12\x15\n" +
+	"\ttoVersion\x18\x04 \x01(\tR\x02to\x12!\n" +
+	"\vtotalChunks\x18\x05 \x01(\rR\ftotal_chunks\x12\x1f\n" +
+	"\n" +
+	"totalBytes\x18\x06 \x01(\x04R\vtotal_bytes\x12\x1d\n" +
+	"\tnewChunks\x18\a \x01(\rR\n" +
+	"new_chunks\x12\x1b\n" +
+	"\bnewBytes\x18\b \x01(\x04R\tnew_bytes\x12#\n" +
+	"\freusedChunks\x18\t \x01(\rR\rreused_chunks\x12!\n" +
+	"\vreusedBytes\x18\n" +
+	" \x01(\x04R\freused_bytes2\xee\x01\n" +
 	"\x0eChunkerService\x12j\n" +
-	"\vCreateChunk\x12,.ukama.hub.distributor.v1.CreateChunkRequest\x1a-.ukama.hub.distributor.v1.CreateChunkResponseB7Z5github.com/ukama/ukama/systems/hub/distributor/pb/genb\x06proto3"
+	"\vCreateChunk\x12,.ukama.hub.distributor.v1.CreateChunkRequest\x1a-.ukama.hub.distributor.v1.CreateChunkResponse\x12p\n" +
+	"\rGetDeltaStats\x12..ukama.hub.distributor.v1.GetDeltaStatsRequest\x1a/.ukama.hub.distributor.v1.GetDeltaStatsResponseB7Z5github.com/ukama/ukama/systems/hub/distributor/pb/genb\x06proto3"
 
 var (
 	file_distributor_proto_rawDescOnce sync.Once
@@ -177,16 +381,20 @@ func file_distributor_proto_rawDescGZIP() []byte {
 	return file_distributor_proto_rawDescData
 }
 
-var file_distributor_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_distributor_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_distributor_proto_goTypes = []any{
-	(*CreateChunkRequest)(nil),  // 0: ukama.hub.distributor.v1.CreateChunkRequest
-	(*CreateChunkResponse)(nil), // 1: ukama.hub.distributor.v1.CreateChunkResponse
+	(*CreateChunkRequest)(nil),    // 0: ukama.hub.distributor.v1.CreateChunkRequest
+	(*CreateChunkResponse)(nil),   // 1: ukama.hub.distributor.v1.CreateChunkResponse
+	(*GetDeltaStatsRequest)(nil),  // 2: ukama.hub.distributor.v1.GetDeltaStatsRequest
+	(*GetDeltaStatsResponse)(nil), // 3: ukama.hub.distributor.v1.GetDeltaStatsResponse
 }
 var file_distributor_proto_depIdxs = []int32{
 	0, // 0: ukama.hub.distributor.v1.ChunkerService.CreateChunk:input_type -> ukama.hub.distributor.v1.CreateChunkRequest
-	1, // 1: ukama.hub.distributor.v1.ChunkerService.CreateChunk:output_type -> ukama.hub.distributor.v1.CreateChunkResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	2, // 1: ukama.hub.distributor.v1.ChunkerService.GetDeltaStats:input_type -> ukama.hub.distributor.v1.GetDeltaStatsRequest
+	1, // 2: ukama.hub.distributor.v1.ChunkerService.CreateChunk:output_type -> ukama.hub.distributor.v1.CreateChunkResponse
+	3, // 3: ukama.hub.distributor.v1.ChunkerService.GetDeltaStats:output_type -> ukama.hub.distributor.v1.GetDeltaStatsResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -203,7 +411,7 @@ func file_distributor_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_distributor_proto_rawDesc), len(file_distributor_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
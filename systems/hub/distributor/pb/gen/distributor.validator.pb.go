@@ -28,3 +28,18 @@ func (this *CreateChunkRequest) Validate() error {
 func (this *CreateChunkResponse) Validate() error {
 	return nil
 }
+func (this *GetDeltaStatsRequest) Validate() error {
+	if this.Name == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Name", fmt.Errorf(`value '%v' must not be an empty string`, this.Name))
+	}
+	if this.Type == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Type", fmt.Errorf(`value '%v' must not be an empty string`, this.Type))
+	}
+	if this.ToVersion == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("ToVersion", fmt.Errorf(`value '%v' must not be an empty string`, this.ToVersion))
+	}
+	return nil
+}
+func (this *GetDeltaStatsResponse) Validate() error {
+	return nil
+}
@@ -26,7 +26,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ChunkerService_CreateChunk_FullMethodName = "/ukama.hub.distributor.v1.ChunkerService/CreateChunk"
+	ChunkerService_CreateChunk_FullMethodName   = "/ukama.hub.distributor.v1.ChunkerService/CreateChunk"
+	ChunkerService_GetDeltaStats_FullMethodName = "/ukama.hub.distributor.v1.ChunkerService/GetDeltaStats"
 )
 
 // ChunkerServiceClient is the client API for ChunkerService service.
@@ -34,6 +35,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ChunkerServiceClient interface {
 	CreateChunk(ctx context.Context, in *CreateChunkRequest, opts ...grpc.CallOption) (*CreateChunkResponse, error)
+	GetDeltaStats(ctx context.Context, in *GetDeltaStatsRequest, opts ...grpc.CallOption) (*GetDeltaStatsResponse, error)
 }
 
 type chunkerServiceClient struct {
@@ -54,11 +56,22 @@ func (c *chunkerServiceClient) CreateChunk(ctx context.Context, in *CreateChunkR
 	return out, nil
 }
 
+func (c *chunkerServiceClient) GetDeltaStats(ctx context.Context, in *GetDeltaStatsRequest, opts ...grpc.CallOption) (*GetDeltaStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeltaStatsResponse)
+	err := c.cc.Invoke(ctx, ChunkerService_GetDeltaStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChunkerServiceServer is the server API for ChunkerService service.
 // All implementations must embed UnimplementedChunkerServiceServer
 // for forward compatibility.
 type ChunkerServiceServer interface {
 	CreateChunk(context.Context, *CreateChunkRequest) (*CreateChunkResponse, error)
+	GetDeltaStats(context.Context, *GetDeltaStatsRequest) (*GetDeltaStatsResponse, error)
 	mustEmbedUnimplementedChunkerServiceServer()
 }
 
@@ -72,6 +85,9 @@ type UnimplementedChunkerServiceServer struct{}
 func (UnimplementedChunkerServiceServer) CreateChunk(context.Context, *CreateChunkRequest) (*CreateChunkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateChunk not implemented")
 }
+func (UnimplementedChunkerServiceServer) GetDeltaStats(context.Context, *GetDeltaStatsRequest) (*GetDeltaStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeltaStats not implemented")
+}
 func (UnimplementedChunkerServiceServer) mustEmbedUnimplementedChunkerServiceServer() {}
 func (UnimplementedChunkerServiceServer) testEmbeddedByValue()                        {}
 
@@ -111,6 +127,24 @@ func _ChunkerService_CreateChunk_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChunkerService_GetDeltaStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeltaStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChunkerServiceServer).GetDeltaStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChunkerService_GetDeltaStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChunkerServiceServer).GetDeltaStats(ctx, req.(*GetDeltaStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChunkerService_ServiceDesc is the grpc.ServiceDesc for ChunkerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -122,6 +156,10 @@ var ChunkerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateChunk",
 			Handler:    _ChunkerService_CreateChunk_Handler,
 		},
+		{
+			MethodName: "GetDeltaStats",
+			Handler:    _ChunkerService_GetDeltaStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "distributor.proto",
@@ -53,6 +53,43 @@ func (_m *ChunkerServiceClient) CreateChunk(ctx context.Context, in *gen.CreateC
 	return r0, r1
 }
 
+// GetDeltaStats provides a mock function with given fields: ctx, in, opts
+func (_m *ChunkerServiceClient) GetDeltaStats(ctx context.Context, in *gen.GetDeltaStatsRequest, opts ...grpc.CallOption) (*gen.GetDeltaStatsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeltaStats")
+	}
+
+	var r0 *gen.GetDeltaStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDeltaStatsRequest, ...grpc.CallOption) (*gen.GetDeltaStatsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDeltaStatsRequest, ...grpc.CallOption) *gen.GetDeltaStatsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetDeltaStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetDeltaStatsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewChunkerServiceClient creates a new instance of ChunkerServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewChunkerServiceClient(t interface {
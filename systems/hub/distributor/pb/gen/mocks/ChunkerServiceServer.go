@@ -44,6 +44,36 @@ func (_m *ChunkerServiceServer) CreateChunk(_a0 context.Context, _a1 *gen.Create
 	return r0, r1
 }
 
+// GetDeltaStats provides a mock function with given fields: _a0, _a1
+func (_m *ChunkerServiceServer) GetDeltaStats(_a0 context.Context, _a1 *gen.GetDeltaStatsRequest) (*gen.GetDeltaStatsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeltaStats")
+	}
+
+	var r0 *gen.GetDeltaStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDeltaStatsRequest) (*gen.GetDeltaStatsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDeltaStatsRequest) *gen.GetDeltaStatsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetDeltaStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetDeltaStatsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // mustEmbedUnimplementedChunkerServiceServer provides a mock function with no fields
 func (_m *ChunkerServiceServer) mustEmbedUnimplementedChunkerServiceServer() {
 	_m.Called()
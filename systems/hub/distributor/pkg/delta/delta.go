@@ -0,0 +1,169 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package delta
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Masterminds/semver/v3"
+
+	casync "github.com/folbricht/desync"
+	log "github.com/sirupsen/logrus"
+	mc "github.com/ukama/ukama/systems/hub/artifactmanager/pkg"
+)
+
+/* Download cost from FromVersion to ToVersion, sizes are uncompressed chunk sizes from the index */
+type Stats struct {
+	Name         string
+	Type         string
+	FromVersion  string
+	ToVersion    string
+	TotalChunks  uint32
+	TotalBytes   uint64
+	NewChunks    uint32
+	NewBytes     uint64
+	ReusedChunks uint32
+	ReusedBytes  uint64
+}
+
+/* Compute the delta between two indexes. A chunk of "to" missing in "from" is downloaded once. */
+func Compute(from *casync.Index, to casync.Index) Stats {
+	have := map[casync.ChunkID]struct{}{}
+	if from != nil {
+		for _, c := range from.Chunks {
+			have[c.ID] = struct{}{}
+		}
+	}
+
+	var st Stats
+	seen := map[casync.ChunkID]struct{}{}
+	for _, c := range to.Chunks {
+		st.TotalChunks++
+		st.TotalBytes += c.Size
+
+		if _, ok := seen[c.ID]; ok {
+			st.ReusedChunks++
+			st.ReusedBytes += c.Size
+			continue
+		}
+		seen[c.ID] = struct{}{}
+
+		if _, ok := have[c.ID]; ok {
+			st.ReusedChunks++
+			st.ReusedBytes += c.Size
+		} else {
+			st.NewChunks++
+			st.NewBytes += c.Size
+		}
+	}
+
+	return st
+}
+
+/* IndexReader provides the casync index of an already chunked artifact */
+type IndexReader interface {
+	ReadIndex(ctx context.Context, name string, aType string, version *semver.Version) (*casync.Index, error)
+}
+
+type storeIndexReader struct {
+	store mc.Storage
+}
+
+/* NewStoreIndexReader reads the index files artifact manager keeps next to the artifacts */
+func NewStoreIndexReader(store mc.Storage) *storeIndexReader {
+	return &storeIndexReader{
+		store: store,
+	}
+}
+
+func (r *storeIndexReader) ReadIndex(ctx context.Context, name string, aType string, version *semver.Version) (*casync.Index, error) {
+	rd, err := r.store.GetFile(ctx, name, aType, version, mc.ChunkIndexExtension)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := rd.Close(); cerr != nil {
+			log.Errorf("Failed to close index reader: %v", cerr)
+		}
+	}()
+
+	idx, err := casync.IndexFromReader(rd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse index of %s version %s: %w", name, version.String(), err)
+	}
+
+	return &idx, nil
+}
+
+/* Calculator computes delta stats and caches them as published artifacts are immutable */
+type Calculator struct {
+	reader IndexReader
+	mutex  sync.RWMutex
+	cache  map[string]Stats
+}
+
+func NewCalculator(reader IndexReader) *Calculator {
+	return &Calculator{
+		reader: reader,
+		cache:  map[string]Stats{},
+	}
+}
+
+func cacheKey(name, aType string, from *semver.Version, to *semver.Version) string {
+	f := ""
+	if from != nil {
+		f = from.String()
+	}
+
+	return aType + "/" + name + "/" + f + "/" + to.String()
+}
+
+/* Stats for updating from "from" to "to". A nil "from" means a fresh install. */
+func (c *Calculator) Stats(ctx context.Context, name string, aType string, from *semver.Version, to *semver.Version) (Stats, error) {
+	key := cacheKey(name, aType, from, to)
+
+	c.mutex.RLock()
+	st, ok := c.cache[key]
+	c.mutex.RUnlock()
+	if ok {
+		return st, nil
+	}
+
+	toIdx, err := c.reader.ReadIndex(ctx, name, aType, to)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	var fromIdx *casync.Index
+	if from != nil {
+		fromIdx, err = c.reader.ReadIndex(ctx, name, aType, from)
+		if err != nil {
+			return Stats{}, err
+		}
+	}
+
+	st = Compute(fromIdx, *toIdx)
+	st.Name = name
+	st.Type = aType
+	st.ToVersion = to.String()
+	if from != nil {
+		st.FromVersion = from.String()
+	}
+
+	log.Debugf("Delta for %s %s -> %s: %d/%d chunks, %d/%d bytes new", name, st.FromVersion, st.ToVersion,
+		st.NewChunks, st.TotalChunks, st.NewBytes, st.TotalBytes)
+
+	c.mutex.Lock()
+	c.cache[key] = st
+	c.mutex.Unlock()
+
+	return st, nil
+}
@@ -0,0 +1,93 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package delta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/stretchr/testify/assert"
+
+	casync "github.com/folbricht/desync"
+)
+
+func chunk(id byte, size uint64) casync.IndexChunk {
+	return casync.IndexChunk{ID: casync.ChunkID{id}, Size: size}
+}
+
+type fakeReader struct {
+	indexes map[string]*casync.Index
+	reads   int
+}
+
+func (f *fakeReader) ReadIndex(ctx context.Context, name string, aType string, version *semver.Version) (*casync.Index, error) {
+	f.reads++
+	idx, ok := f.indexes[version.String()]
+	if !ok {
+		return nil, fmt.Errorf("index for %s not found", version.String())
+	}
+
+	return idx, nil
+}
+
+/* Only chunks missing in the old index are downloaded */
+func Test_Compute(t *testing.T) {
+	from := casync.Index{Chunks: []casync.IndexChunk{chunk(1, 100), chunk(2, 200)}}
+	to := casync.Index{Chunks: []casync.IndexChunk{chunk(1, 100), chunk(3, 300), chunk(3, 300)}}
+
+	st := Compute(&from, to)
+
+	assert.Equal(t, uint32(3), st.TotalChunks)
+	assert.Equal(t, uint64(700), st.TotalBytes)
+	assert.Equal(t, uint32(1), st.NewChunks)
+	assert.Equal(t, uint64(300), st.NewBytes)
+	assert.Equal(t, uint32(2), st.ReusedChunks)
+	assert.Equal(t, uint64(400), st.ReusedBytes)
+}
+
+/* Fresh install downloads every distinct chunk */
+func Test_ComputeFreshInstall(t *testing.T) {
+	to := casync.Index{Chunks: []casync.IndexChunk{chunk(1, 100), chunk(2, 200)}}
+
+	st := Compute(nil, to)
+
+	assert.Equal(t, uint32(2), st.NewChunks)
+	assert.Equal(t, uint64(300), st.NewBytes)
+	assert.Equal(t, uint32(0), st.ReusedChunks)
+}
+
+/* Stats are cached per version pair */
+func Test_CalculatorStats(t *testing.T) {
+	r := &fakeReader{indexes: map[string]*casync.Index{
+		"1.0.0": {Chunks: []casync.IndexChunk{chunk(1, 10)}},
+		"1.1.0": {Chunks: []casync.IndexChunk{chunk(1, 10), chunk(2, 20)}},
+	}}
+	c := NewCalculator(r)
+
+	st, err := c.Stats(context.TODO(), "app", "app", semver.MustParse("1.0.0"), semver.MustParse("1.1.0"))
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.0", st.FromVersion)
+	assert.Equal(t, "1.1.0", st.ToVersion)
+	assert.Equal(t, uint64(20), st.NewBytes)
+
+	_, err = c.Stats(context.TODO(), "app", "app", semver.MustParse("1.0.0"), semver.MustParse("1.1.0"))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, r.reads)
+}
+
+/* Missing index is reported */
+func Test_CalculatorStatsMissingIndex(t *testing.T) {
+	c := NewCalculator(&fakeReader{indexes: map[string]*casync.Index{}})
+
+	_, err := c.Stats(context.TODO(), "app", "app", nil, semver.MustParse("2.0.0"))
+
+	assert.Contains(t, err.Error(), "not found")
+}
@@ -21,6 +21,7 @@ import (
 	pb "github.com/ukama/ukama/systems/hub/distributor/pb/gen"
 	"github.com/ukama/ukama/systems/hub/distributor/pkg"
 	"github.com/ukama/ukama/systems/hub/distributor/pkg/chunk"
+	"github.com/ukama/ukama/systems/hub/distributor/pkg/delta"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -37,10 +38,11 @@ type ChunkerServer struct {
 	OrgName        string
 	Store          pkg.StoreConfig
 	ChunkConfig    pkg.ChunkConfig
+	delta          *delta.Calculator
 }
 
 func NewChunkerServer(orgId uuid.UUID, orgName string, config *pkg.Config,
-	msgBus mb.MsgBusServiceClient, pushGateway string) *ChunkerServer {
+	msgBus mb.MsgBusServiceClient, pushGateway string, deltaCalc *delta.Calculator) *ChunkerServer {
 
 	rotuingKey := msgbus.NewRoutingKeyBuilder().SetCloudSource().SetGlobalScope().SetSystem(pkg.SystemName).SetOrgName(orgName).SetService(pkg.ServiceName)
 
@@ -52,6 +54,7 @@ func NewChunkerServer(orgId uuid.UUID, orgName string, config *pkg.Config,
 		pushGateway:    pushGateway,
 		Store:          config.Distribution.StoreCfg,
 		ChunkConfig:    config.Distribution.Chunk,
+		delta:          deltaCalc,
 		// castore:        s,
 		// converters:     c,
 	}
@@ -115,3 +118,41 @@ func (s *ChunkerServer) CreateChunk(ctx context.Context, in *pb.CreateChunkReque
 		Size:  bSize,
 	}, nil
 }
+
+func (s *ChunkerServer) GetDeltaStats(ctx context.Context, in *pb.GetDeltaStatsRequest) (*pb.GetDeltaStatsResponse, error) {
+	var from *semver.Version
+
+	to, err := s.parseVersion(in.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	/* Empty from version means a fresh install of the artifact */
+	if in.FromVersion != "" {
+		from, err = s.parseVersion(in.FromVersion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Debugf("Handling delta stats request %+v.", in)
+
+	st, err := s.delta.Stats(ctx, in.Name, in.Type, from, to)
+	if err != nil {
+		log.Errorf("Error while computing delta for %s %s -> %s: %s", in.Name, in.FromVersion, in.ToVersion, err.Error())
+		return nil, status.Error(codes.NotFound, "Error while reading chunk index:"+err.Error())
+	}
+
+	return &pb.GetDeltaStatsResponse{
+		Name:         st.Name,
+		Type:         st.Type,
+		FromVersion:  st.FromVersion,
+		ToVersion:    st.ToVersion,
+		TotalChunks:  st.TotalChunks,
+		TotalBytes:   st.TotalBytes,
+		NewChunks:    st.NewChunks,
+		NewBytes:     st.NewBytes,
+		ReusedChunks: st.ReusedChunks,
+		ReusedBytes:  st.ReusedBytes,
+	}, nil
+}
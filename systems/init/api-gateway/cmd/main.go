@@ -20,6 +20,7 @@ import (
 	"github.com/ukama/ukama/systems/init/api-gateway/pkg/rest"
 
 	ccmd "github.com/ukama/ukama/systems/common/cmd"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
 )
 
 var svcConf = pkg.NewConfig()
@@ -28,11 +29,12 @@ func main() {
 	ccmd.ProcessVersionArgument(pkg.ServiceName, os.Args, version.Version)
 	initConfig()
 
-	clientSet := rest.NewClientsSet(&svcConf.Services)
+	clientSet := rest.NewClientsSet(&svcConf.Services, &svcConf.Http)
 	metrics.StartMetricsServer(&svcConf.Metrics)
 
 	r := rest.NewRouter(clientSet, rest.NewRouterConfig(svcConf),
-		auth.NewAuthClient(svcConf.Auth.AuthServerUrl, client.WithDebug(svcConf.DebugMode)).AuthenticateUser)
+		creg.NewAuthFunc(pkg.SystemName, svcConf.Auth,
+			auth.NewAuthClient(svcConf.Auth.AuthServerUrl, client.WithDebug(svcConf.DebugMode)).AuthenticateUser))
 	r.Run()
 }
 
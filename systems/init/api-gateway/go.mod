@@ -8,6 +8,8 @@ replace github.com/ukama/ukama/systems/init/api-gateway => ./
 
 replace github.com/ukama/ukama/systems/init/lookup => ../lookup
 
+replace github.com/ukama/ukama/systems/init/ca => ../ca
+
 require (
 	github.com/gin-contrib/cors v1.7.7
 	github.com/gin-gonic/gin v1.12.0
@@ -17,6 +19,7 @@ require (
 	github.com/sirupsen/logrus v1.10.1
 	github.com/stretchr/testify v1.12.0
 	github.com/ukama/ukama/systems/common v0.0.0-00010101000000-000000000000
+	github.com/ukama/ukama/systems/init/ca v0.0.0-00010101000000-000000000000
 	github.com/ukama/ukama/systems/init/lookup v0.0.0-00010101000000-000000000000
 	github.com/wI2L/fizz v0.23.0
 	google.golang.org/grpc v1.83.1
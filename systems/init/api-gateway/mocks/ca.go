@@ -0,0 +1,177 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	gen "github.com/ukama/ukama/systems/init/ca/pb/gen"
+)
+
+// ca is an autogenerated mock type for the ca type
+type ca struct {
+	mock.Mock
+}
+
+// GetRevocationList provides a mock function with given fields: req
+func (_m *ca) GetRevocationList(req *gen.GetRevocationListRequest) (*gen.GetRevocationListResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevocationList")
+	}
+
+	var r0 *gen.GetRevocationListResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.GetRevocationListRequest) (*gen.GetRevocationListResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.GetRevocationListRequest) *gen.GetRevocationListResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetRevocationListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.GetRevocationListRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCertificates provides a mock function with given fields: req
+func (_m *ca) ListCertificates(req *gen.ListCertificatesRequest) (*gen.ListCertificatesResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCertificates")
+	}
+
+	var r0 *gen.ListCertificatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.ListCertificatesRequest) (*gen.ListCertificatesResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.ListCertificatesRequest) *gen.ListCertificatesResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListCertificatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.ListCertificatesRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeCertificate provides a mock function with given fields: req
+func (_m *ca) RevokeCertificate(req *gen.RevokeCertificateRequest) (*gen.RevokeCertificateResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeCertificate")
+	}
+
+	var r0 *gen.RevokeCertificateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.RevokeCertificateRequest) (*gen.RevokeCertificateResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.RevokeCertificateRequest) *gen.RevokeCertificateResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RevokeCertificateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.RevokeCertificateRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeNodeCertificates provides a mock function with given fields: req
+func (_m *ca) RevokeNodeCertificates(req *gen.RevokeNodeCertificatesRequest) (*gen.RevokeNodeCertificatesResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeNodeCertificates")
+	}
+
+	var r0 *gen.RevokeNodeCertificatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.RevokeNodeCertificatesRequest) (*gen.RevokeNodeCertificatesResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.RevokeNodeCertificatesRequest) *gen.RevokeNodeCertificatesResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RevokeNodeCertificatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.RevokeNodeCertificatesRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyNode provides a mock function with given fields: req
+func (_m *ca) VerifyNode(req *gen.VerifyNodeRequest) (*gen.VerifyNodeResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyNode")
+	}
+
+	var r0 *gen.VerifyNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.VerifyNodeRequest) (*gen.VerifyNodeResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.VerifyNodeRequest) *gen.VerifyNodeResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.VerifyNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.VerifyNodeRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// newCa creates a new instance of ca. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newCa(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ca {
+	mock := &ca{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
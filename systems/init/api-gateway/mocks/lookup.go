@@ -12,6 +12,36 @@ type lookup struct {
 	mock.Mock
 }
 
+// AcceptNode provides a mock function with given fields: req
+func (_m *lookup) AcceptNode(req *gen.AcceptNodeRequest) (*gen.AcceptNodeResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptNode")
+	}
+
+	var r0 *gen.AcceptNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.AcceptNodeRequest) (*gen.AcceptNodeResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.AcceptNodeRequest) *gen.AcceptNodeResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.AcceptNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.AcceptNodeRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddNodeForOrg provides a mock function with given fields: req
 func (_m *lookup) AddNodeForOrg(req *gen.AddNodeRequest) (*gen.AddNodeResponse, error) {
 	ret := _m.Called(req)
@@ -102,6 +132,66 @@ func (_m *lookup) AddSystemForOrg(req *gen.AddSystemRequest) (*gen.AddSystemResp
 	return r0, r1
 }
 
+// CancelNodeRelease provides a mock function with given fields: req
+func (_m *lookup) CancelNodeRelease(req *gen.CancelNodeReleaseRequest) (*gen.CancelNodeReleaseResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelNodeRelease")
+	}
+
+	var r0 *gen.CancelNodeReleaseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.CancelNodeReleaseRequest) (*gen.CancelNodeReleaseResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.CancelNodeReleaseRequest) *gen.CancelNodeReleaseResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CancelNodeReleaseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.CancelNodeReleaseRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimNode provides a mock function with given fields: req
+func (_m *lookup) ClaimNode(req *gen.ClaimNodeRequest) (*gen.ClaimNodeResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimNode")
+	}
+
+	var r0 *gen.ClaimNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.ClaimNodeRequest) (*gen.ClaimNodeResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.ClaimNodeRequest) *gen.ClaimNodeResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ClaimNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.ClaimNodeRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteNodeForOrg provides a mock function with given fields: req
 func (_m *lookup) DeleteNodeForOrg(req *gen.DeleteNodeRequest) (*gen.DeleteNodeResponse, error) {
 	ret := _m.Called(req)
@@ -162,6 +252,36 @@ func (_m *lookup) DeleteSystemForOrg(req *gen.DeleteSystemRequest) (*gen.DeleteS
 	return r0, r1
 }
 
+// DrainSystemForOrg provides a mock function with given fields: req
+func (_m *lookup) DrainSystemForOrg(req *gen.DrainSystemRequest) (*gen.DrainSystemResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DrainSystemForOrg")
+	}
+
+	var r0 *gen.DrainSystemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.DrainSystemRequest) (*gen.DrainSystemResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.DrainSystemRequest) *gen.DrainSystemResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DrainSystemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.DrainSystemRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNodeForOrg provides a mock function with given fields: req
 func (_m *lookup) GetNodeForOrg(req *gen.GetNodeForOrgRequest) (*gen.GetNodeResponse, error) {
 	ret := _m.Called(req)
@@ -282,6 +402,126 @@ func (_m *lookup) GetSystemForOrg(req *gen.GetSystemRequest) (*gen.GetSystemResp
 	return r0, r1
 }
 
+// GetSystems provides a mock function with given fields: req
+func (_m *lookup) GetSystems(req *gen.GetSystemsRequest) (*gen.GetSystemsResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSystems")
+	}
+
+	var r0 *gen.GetSystemsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.GetSystemsRequest) (*gen.GetSystemsResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.GetSystemsRequest) *gen.GetSystemsResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetSystemsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.GetSystemsRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReleaseNode provides a mock function with given fields: req
+func (_m *lookup) ReleaseNode(req *gen.ReleaseNodeRequest) (*gen.ReleaseNodeResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseNode")
+	}
+
+	var r0 *gen.ReleaseNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.ReleaseNodeRequest) (*gen.ReleaseNodeResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.ReleaseNodeRequest) *gen.ReleaseNodeResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ReleaseNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.ReleaseNodeRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResumeSystemForOrg provides a mock function with given fields: req
+func (_m *lookup) ResumeSystemForOrg(req *gen.ResumeSystemRequest) (*gen.ResumeSystemResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeSystemForOrg")
+	}
+
+	var r0 *gen.ResumeSystemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.ResumeSystemRequest) (*gen.ResumeSystemResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.ResumeSystemRequest) *gen.ResumeSystemResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ResumeSystemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.ResumeSystemRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemHeartbeat provides a mock function with given fields: req
+func (_m *lookup) SystemHeartbeat(req *gen.SystemHeartbeatRequest) (*gen.SystemHeartbeatResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SystemHeartbeat")
+	}
+
+	var r0 *gen.SystemHeartbeatResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.SystemHeartbeatRequest) (*gen.SystemHeartbeatResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.SystemHeartbeatRequest) *gen.SystemHeartbeatResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.SystemHeartbeatResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.SystemHeartbeatRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateOrg provides a mock function with given fields: req
 func (_m *lookup) UpdateOrg(req *gen.UpdateOrgRequest) (*gen.UpdateOrgResponse, error) {
 	ret := _m.Called(req)
@@ -0,0 +1,95 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	log "github.com/sirupsen/logrus"
+	pb "github.com/ukama/ukama/systems/init/ca/pb/gen"
+)
+
+type Ca struct {
+	conn    *grpc.ClientConn
+	timeout time.Duration
+	client  pb.CaServiceClient
+	host    string
+}
+
+func NewCa(host string, timeout time.Duration) *Ca {
+	conn, err := grpc.NewClient(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to CA Service: %v", err)
+	}
+
+	client := pb.NewCaServiceClient(conn)
+
+	return &Ca{
+		conn:    conn,
+		client:  client,
+		timeout: timeout,
+		host:    host,
+	}
+}
+
+func NewCaFromClient(caClient pb.CaServiceClient) *Ca {
+	return &Ca{
+		host:    "localhost",
+		timeout: 1 * time.Second,
+		conn:    nil,
+		client:  caClient,
+	}
+}
+
+func (c *Ca) Close() {
+	if c.conn != nil {
+		if err := c.conn.Close(); err != nil {
+			log.Warnf("Failed to gracefully close CA Service connection :%v", err)
+		}
+	}
+}
+
+func (c *Ca) ListCertificates(req *pb.ListCertificatesRequest) (*pb.ListCertificatesResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.ListCertificates(ctx, req)
+}
+
+func (c *Ca) GetRevocationList(req *pb.GetRevocationListRequest) (*pb.GetRevocationListResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.GetRevocationList(ctx, req)
+}
+
+func (c *Ca) RevokeCertificate(req *pb.RevokeCertificateRequest) (*pb.RevokeCertificateResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.RevokeCertificate(ctx, req)
+}
+
+func (c *Ca) RevokeNodeCertificates(req *pb.RevokeNodeCertificatesRequest) (*pb.RevokeNodeCertificatesResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.RevokeNodeCertificates(ctx, req)
+}
+
+func (c *Ca) VerifyNode(req *pb.VerifyNodeRequest) (*pb.VerifyNodeResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.VerifyNode(ctx, req)
+}
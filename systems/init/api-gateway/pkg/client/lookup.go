@@ -136,3 +136,59 @@ func (l *Lookup) DeleteSystemForOrg(req *pb.DeleteSystemRequest) (*pb.DeleteSyst
 
 	return l.client.DeleteSystemForOrg(ctx, req)
 }
+
+func (l *Lookup) SystemHeartbeat(req *pb.SystemHeartbeatRequest) (*pb.SystemHeartbeatResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), l.timeout)
+	defer cancel()
+
+	return l.client.SystemHeartbeat(ctx, req)
+}
+
+func (l *Lookup) GetSystems(req *pb.GetSystemsRequest) (*pb.GetSystemsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), l.timeout)
+	defer cancel()
+
+	return l.client.GetSystems(ctx, req)
+}
+
+func (l *Lookup) DrainSystemForOrg(req *pb.DrainSystemRequest) (*pb.DrainSystemResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), l.timeout)
+	defer cancel()
+
+	return l.client.DrainSystemForOrg(ctx, req)
+}
+
+func (l *Lookup) ResumeSystemForOrg(req *pb.ResumeSystemRequest) (*pb.ResumeSystemResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), l.timeout)
+	defer cancel()
+
+	return l.client.ResumeSystemForOrg(ctx, req)
+}
+
+func (l *Lookup) ClaimNode(req *pb.ClaimNodeRequest) (*pb.ClaimNodeResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), l.timeout)
+	defer cancel()
+
+	return l.client.ClaimNode(ctx, req)
+}
+
+func (l *Lookup) ReleaseNode(req *pb.ReleaseNodeRequest) (*pb.ReleaseNodeResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), l.timeout)
+	defer cancel()
+
+	return l.client.ReleaseNode(ctx, req)
+}
+
+func (l *Lookup) AcceptNode(req *pb.AcceptNodeRequest) (*pb.AcceptNodeResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), l.timeout)
+	defer cancel()
+
+	return l.client.AcceptNode(ctx, req)
+}
+
+func (l *Lookup) CancelNodeRelease(req *pb.CancelNodeReleaseRequest) (*pb.CancelNodeReleaseResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), l.timeout)
+	defer cancel()
+
+	return l.client.CancelNodeRelease(ctx, req)
+}
@@ -33,6 +33,7 @@ type Kratos struct {
 type GrpcEndpoints struct {
 	Timeout time.Duration
 	Lookup  string
+	Ca      string
 }
 
 // ServiceDescriptions holds a human-readable description per gRPC service,
@@ -41,11 +42,14 @@ type GrpcEndpoints struct {
 // (DESCRIPTIONS_<SERVICE>) without a code change.
 type ServiceDescriptions struct {
 	Lookup string
+	Ca     string
 }
 
 type HttpEndpoints struct {
 	Timeout     time.Duration
 	NodeMetrics string
+	/* resolves the orgs of a user to check who may release their nodes */
+	Nucleus string
 }
 
 func NewConfig() *Config {
@@ -61,13 +65,16 @@ func NewConfig() *Config {
 		Services: GrpcEndpoints{
 			Timeout: 3 * time.Second,
 			Lookup:  "lookup:9090",
+			Ca:      "ca:9090",
 		},
 		Descriptions: ServiceDescriptions{
 			Lookup: "System lookup: resolving organizations, systems and nodes to their endpoints",
+			Ca:     "Node certificates: issuing, rotating and revoking the mesh credentials of the nodes",
 		},
 		Http: HttpEndpoints{
 			Timeout:     3 * time.Second,
 			NodeMetrics: "http://localhost",
+			Nucleus:     "http://api-gateway-nucleus:8080",
 		},
 		Server: rest.HttpConfig{
 			Port: 8080,
@@ -41,6 +41,31 @@ type GetNodeRequest struct {
 	NodeId  string `path:"node" validate:"required"`
 }
 
+type ClaimNodeRequest struct {
+	OrgName   string `path:"org" validate:"required"`
+	NodeId    string `path:"node" validate:"required"`
+	ClaimCode string `json:"claimCode" validate:"required"`
+}
+
+type ReleaseNodeRequest struct {
+	OrgName    string `path:"org" validate:"required"`
+	NodeId     string `path:"node" validate:"required"`
+	ToOrgName  string `json:"toOrgName"`
+	ReleasedBy string `json:"releasedBy"`
+}
+
+type CancelNodeReleaseRequest struct {
+	OrgName string `path:"org" validate:"required"`
+	NodeId  string `path:"node" validate:"required"`
+}
+
+type AcceptNodeRequest struct {
+	OrgName    string `path:"org" validate:"required"`
+	NodeId     string `path:"node" validate:"required"`
+	ClaimCode  string `json:"claimCode" validate:"required"`
+	AcceptedBy string `json:"acceptedBy"`
+}
+
 type AddSystemRequest struct {
 	OrgName     string `path:"org" validate:"required"`
 	SysName     string `path:"system" validate:"required"`
@@ -71,3 +96,51 @@ type DeleteSystemRequest struct {
 	OrgName string `path:"org" validate:"required"`
 	SysName string `path:"system" validate:"required"`
 }
+
+type GetSystemsRequest struct {
+	OrgName string `form:"org" query:"org"`
+}
+
+type SystemHeartbeatRequest struct {
+	OrgName      string `path:"org" validate:"required"`
+	SysName      string `path:"system" validate:"required"`
+	Version      string `json:"version"`
+	ApiGwHealth  uint32 `json:"apiGwHealth"`
+	NodeGwHealth uint32 `json:"nodeGwHealth"`
+}
+
+type DrainSystemRequest struct {
+	OrgName   string `path:"org" validate:"required"`
+	SysName   string `path:"system" validate:"required"`
+	DrainIp   string `json:"drainIp" validate:"required"`
+	DrainPort int32  `json:"drainPort"`
+	DrainUrl  string `json:"drainUrl"`
+}
+
+type ResumeSystemRequest struct {
+	OrgName string `path:"org" validate:"required"`
+	SysName string `path:"system" validate:"required"`
+}
+
+type ListCertificatesRequest struct {
+	NodeId            string `form:"nodeId" query:"nodeId"`
+	ExpiresWithinDays uint32 `form:"expiresWithinDays" query:"expiresWithinDays"`
+	IncludeRevoked    bool   `form:"includeRevoked" query:"includeRevoked" default:"false"`
+}
+
+type GetRevocationListRequest struct{}
+
+type RevokeCertificateRequest struct {
+	Serial string `path:"serial" validate:"required"`
+	Reason string `form:"reason" query:"reason"`
+}
+
+type RevokeNodeCertificatesRequest struct {
+	NodeId string `path:"node" validate:"required"`
+	Reason string `form:"reason" query:"reason"`
+}
+
+type VerifyNodeRequest struct {
+	NodeId string `path:"node" validate:"required"`
+	Serial string `form:"serial" query:"serial"`
+}
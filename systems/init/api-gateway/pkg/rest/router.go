@@ -20,19 +20,23 @@ import (
 
 	"github.com/ukama/ukama/systems/common/config"
 	"github.com/ukama/ukama/systems/common/rest"
+	"github.com/ukama/ukama/systems/common/rest/client/nucleus"
+	"github.com/ukama/ukama/systems/common/roles"
 	"github.com/ukama/ukama/systems/init/api-gateway/cmd/version"
 	"github.com/ukama/ukama/systems/init/api-gateway/pkg"
 	"github.com/ukama/ukama/systems/init/api-gateway/pkg/client"
 
+	capb "github.com/ukama/ukama/systems/init/ca/pb/gen"
 	pb "github.com/ukama/ukama/systems/init/lookup/pb/gen"
 )
 
 const ORG_URL_PARAMETER = "org"
 
 type Router struct {
-	f       *fizz.Fizz
-	clients *Clients
-	config  *RouterConfig
+	f        *fizz.Fizz
+	internal *fizz.Fizz
+	clients  *Clients
+	config   *RouterConfig
 }
 
 type RouterConfig struct {
@@ -46,7 +50,9 @@ type RouterConfig struct {
 }
 
 type Clients struct {
-	l lookup
+	l  lookup
+	ca ca
+	o  nucleus.OrgClient
 }
 
 type lookup interface {
@@ -57,15 +63,33 @@ type lookup interface {
 	AddNodeForOrg(req *pb.AddNodeRequest) (*pb.AddNodeResponse, error)
 	GetNodeForOrg(req *pb.GetNodeForOrgRequest) (*pb.GetNodeResponse, error)
 	DeleteNodeForOrg(req *pb.DeleteNodeRequest) (*pb.DeleteNodeResponse, error)
+	ClaimNode(req *pb.ClaimNodeRequest) (*pb.ClaimNodeResponse, error)
+	ReleaseNode(req *pb.ReleaseNodeRequest) (*pb.ReleaseNodeResponse, error)
+	AcceptNode(req *pb.AcceptNodeRequest) (*pb.AcceptNodeResponse, error)
+	CancelNodeRelease(req *pb.CancelNodeReleaseRequest) (*pb.CancelNodeReleaseResponse, error)
 	AddSystemForOrg(req *pb.AddSystemRequest) (*pb.AddSystemResponse, error)
 	UpdateSystemForOrg(req *pb.UpdateSystemRequest) (*pb.UpdateSystemResponse, error)
 	GetSystemForOrg(req *pb.GetSystemRequest) (*pb.GetSystemResponse, error)
 	DeleteSystemForOrg(req *pb.DeleteSystemRequest) (*pb.DeleteSystemResponse, error)
+	SystemHeartbeat(req *pb.SystemHeartbeatRequest) (*pb.SystemHeartbeatResponse, error)
+	GetSystems(req *pb.GetSystemsRequest) (*pb.GetSystemsResponse, error)
+	DrainSystemForOrg(req *pb.DrainSystemRequest) (*pb.DrainSystemResponse, error)
+	ResumeSystemForOrg(req *pb.ResumeSystemRequest) (*pb.ResumeSystemResponse, error)
 }
 
-func NewClientsSet(endpoints *pkg.GrpcEndpoints) *Clients {
+type ca interface {
+	ListCertificates(req *capb.ListCertificatesRequest) (*capb.ListCertificatesResponse, error)
+	GetRevocationList(req *capb.GetRevocationListRequest) (*capb.GetRevocationListResponse, error)
+	RevokeCertificate(req *capb.RevokeCertificateRequest) (*capb.RevokeCertificateResponse, error)
+	RevokeNodeCertificates(req *capb.RevokeNodeCertificatesRequest) (*capb.RevokeNodeCertificatesResponse, error)
+	VerifyNode(req *capb.VerifyNodeRequest) (*capb.VerifyNodeResponse, error)
+}
+
+func NewClientsSet(endpoints *pkg.GrpcEndpoints, httpEndpoints *pkg.HttpEndpoints) *Clients {
 	c := &Clients{}
 	c.l = client.Newlookup(endpoints.Lookup, endpoints.Timeout)
+	c.ca = client.NewCa(endpoints.Ca, endpoints.Timeout)
+	c.o = nucleus.NewOrgClient(httpEndpoints.Nucleus)
 	return c
 }
 
@@ -96,6 +120,8 @@ func NewRouterConfig(svcConf *pkg.Config) *RouterConfig {
 }
 
 func (rt *Router) Run() {
+	rest.RunInternal(rt.internal, rt.config.serverConf)
+
 	logrus.Info("Listening on port ", rt.config.serverConf.Port)
 	err := rt.f.Engine().Run(fmt.Sprint(":", rt.config.serverConf.Port))
 	if err != nil {
@@ -114,9 +140,17 @@ func (r *Router) init(f func(*gin.Context, string) error) {
 	if r.config.grpcEndpoints != nil {
 		rest.RegisterStatusEndpoint(r.f, pkg.SystemName, map[string]rest.StatusTarget{
 			"lookup": {Host: r.config.grpcEndpoints.Lookup, Description: desc.Lookup},
+			"ca":     {Host: r.config.grpcEndpoints.Ca, Description: desc.Ca},
 		}, r.config.grpcEndpoints.Timeout)
 	}
 
+	// Checked by the mesh when a node connects, without credentials of its
+	// own, so these are not authenticated and only served on the internal listener.
+	r.internal = rest.NewInternalFizzRouter(pkg.SystemName, version.Version)
+	authz := r.internal.Group("/authz/v1/certs", "Certificate checks", "Revocation checks of the mesh")
+	authz.GET("/crl", formatDoc("Get Revocation List", "PEM encoded CRL of the revoked and superseded certificates not yet expired"), tonic.Handler(r.getRevocationListHandler, http.StatusOK))
+	authz.GET("/nodes/:node/verify", formatDoc("Verify Node Certificate", "Checks the certificate a node presented is its latest one"), tonic.Handler(r.getNodeVerifyHandler, http.StatusOK))
+
 	auth := r.f.Group("/v1", "API gateway", "Init system version v1", func(ctx *gin.Context) {
 		if r.config.auth.BypassAuthMode {
 			logrus.Info("Bypassing auth")
@@ -134,6 +168,9 @@ func (r *Router) init(f func(*gin.Context, string) error) {
 		o := auth.Group("/orgs", "Orgs", "looking for orgs credentials")
 		o.GET("", formatDoc("Get Orgs name", ""), tonic.Handler(r.getOrgsHandler, http.StatusOK))
 
+		inventory := auth.Group("/systems", "Systems", "Systems of all orgs with their health")
+		inventory.GET("", formatDoc("Get Systems inventory", "Version, last heartbeat and status of the systems of every org, or of the given org"), tonic.Handler(r.getSystemsHandler, http.StatusOK))
+
 		const org = "/orgs/" + ":" + ORG_URL_PARAMETER
 		orgs := auth.Group(org, "Orgs", "looking for orgs credentials")
 		orgs.GET("", formatDoc("Get Org by name", ""), tonic.Handler(r.getOrgHandler, http.StatusOK))
@@ -144,12 +181,24 @@ func (r *Router) init(f func(*gin.Context, string) error) {
 		nodes.GET("/:node", formatDoc("Get Orgs credential for Node", ""), tonic.Handler(r.getNodeHandler, http.StatusOK))
 		nodes.PUT("/:node", formatDoc("Add Node to Org", ""), tonic.Handler(r.putNodeHandler, http.StatusCreated))
 		nodes.DELETE("/:node", formatDoc("Delete Node from Org", ""), tonic.Handler(r.deleteNodeHandler, http.StatusOK))
+		nodes.POST("/:node/claim", formatDoc("Claim Node", "Adds an unowned node to the org using the claim code printed by the factory"), tonic.Handler(r.postNodeClaimHandler, http.StatusCreated))
+		nodes.POST("/:node/release", formatDoc("Release Node", "Releases a node of the org so that another org can accept it"), tonic.Handler(r.postNodeReleaseHandler, http.StatusCreated))
+		nodes.DELETE("/:node/release", formatDoc("Cancel Node Release", "Takes back a node release not yet accepted by another org"), tonic.Handler(r.deleteNodeReleaseHandler, http.StatusOK))
+		nodes.POST("/:node/accept", formatDoc("Accept Node", "Moves a released node to the org using the node claim code"), tonic.Handler(r.postNodeAcceptHandler, http.StatusOK))
 
 		systems := orgs.Group("/systems", "Systems", "Orgs System credentials")
 		systems.GET("/:system", formatDoc("Get System credential for Org", ""), tonic.Handler(r.getSystemHandler, http.StatusOK))
 		systems.PUT("/:system", formatDoc("Add or Update System credential for Org", ""), tonic.Handler(r.putSystemHandler, http.StatusCreated))
 		systems.DELETE("/:system", formatDoc("Delete System credential for Org", ""), tonic.Handler(r.deleteSystemHandler, http.StatusOK))
 		systems.PATCH("/:system", formatDoc("Update System Credential", ""), tonic.Handler(r.patchSystemHandler, http.StatusOK))
+		systems.POST("/:system/heartbeat", formatDoc("System Heartbeat", "Reports the version and health of a system"), tonic.Handler(r.postSystemHeartbeatHandler, http.StatusOK))
+		systems.POST("/:system/drain", formatDoc("Drain System", "Redirects the nodes of the org to another system endpoint"), tonic.Handler(r.postSystemDrainHandler, http.StatusOK))
+		systems.DELETE("/:system/drain", formatDoc("Resume System", "Stops redirecting the nodes of the org"), tonic.Handler(r.deleteSystemDrainHandler, http.StatusOK))
+
+		certs := auth.Group("/certs", "Certificates", "Mesh certificates of the nodes")
+		certs.GET("", formatDoc("List Certificates", "Certificates sorted by expiry, optionally only those expiring within the given number of days"), tonic.Handler(r.getCertificatesHandler, http.StatusOK))
+		certs.DELETE("/:serial", formatDoc("Revoke Certificate", ""), tonic.Handler(r.deleteCertificateHandler, http.StatusOK))
+		certs.DELETE("/nodes/:node", formatDoc("Revoke Node Certificates", "Revokes every certificate issued to the node"), tonic.Handler(r.deleteNodeCertificatesHandler, http.StatusOK))
 	}
 }
 
@@ -211,6 +260,77 @@ func (r *Router) deleteNodeHandler(c *gin.Context, req *DeleteNodeRequest) (*pb.
 	})
 }
 
+func (r *Router) postNodeClaimHandler(c *gin.Context, req *ClaimNodeRequest) (*pb.ClaimNodeResponse, error) {
+	return r.clients.l.ClaimNode(&pb.ClaimNodeRequest{
+		OrgName:   req.OrgName,
+		NodeId:    req.NodeId,
+		ClaimCode: req.ClaimCode,
+	})
+}
+
+func (r *Router) postNodeReleaseHandler(c *gin.Context, req *ReleaseNodeRequest) (*pb.ReleaseNodeResponse, error) {
+	if err := r.checkOrgMember(c, req.OrgName); err != nil {
+		return nil, err
+	}
+
+	releasedBy := req.ReleasedBy
+	if userId := c.Request.Header.Get(roles.UserIdHeader); userId != "" {
+		releasedBy = userId
+	}
+
+	return r.clients.l.ReleaseNode(&pb.ReleaseNodeRequest{
+		OrgName:    req.OrgName,
+		NodeId:     req.NodeId,
+		ToOrgName:  req.ToOrgName,
+		ReleasedBy: releasedBy,
+	})
+}
+
+func (r *Router) deleteNodeReleaseHandler(c *gin.Context, req *CancelNodeReleaseRequest) (*pb.CancelNodeReleaseResponse, error) {
+	if err := r.checkOrgMember(c, req.OrgName); err != nil {
+		return nil, err
+	}
+
+	return r.clients.l.CancelNodeRelease(&pb.CancelNodeReleaseRequest{
+		OrgName: req.OrgName,
+		NodeId:  req.NodeId,
+	})
+}
+
+// checkOrgMember refuses requests on the nodes of an org from users who
+// neither own it nor are a member of it. The org is only named by the path.
+func (r *Router) checkOrgMember(c *gin.Context, org string) error {
+	if r.config.auth.BypassAuthMode {
+		return nil
+	}
+
+	userId := c.Request.Header.Get(roles.UserIdHeader)
+	if userId == "" {
+		return rest.HttpError{HttpCode: http.StatusForbidden, Message: "no authenticated user"}
+	}
+
+	orgs, err := r.clients.o.GetByUser(userId)
+	if err != nil {
+		logrus.Errorf("Failed to get orgs of user %s: %v", userId, err)
+
+		return rest.HttpError{HttpCode: http.StatusServiceUnavailable, Message: "failed to check org membership"}
+	}
+	if !orgs.Has(org) {
+		return rest.HttpError{HttpCode: http.StatusForbidden,
+			Message: fmt.Sprintf("user %s is not a member of org %s", userId, org)}
+	}
+	return nil
+}
+
+func (r *Router) postNodeAcceptHandler(c *gin.Context, req *AcceptNodeRequest) (*pb.AcceptNodeResponse, error) {
+	return r.clients.l.AcceptNode(&pb.AcceptNodeRequest{
+		OrgName:    req.OrgName,
+		NodeId:     req.NodeId,
+		ClaimCode:  req.ClaimCode,
+		AcceptedBy: req.AcceptedBy,
+	})
+}
+
 func (r *Router) putSystemHandler(c *gin.Context, req *AddSystemRequest) (*pb.AddSystemResponse, error) {
 	return r.clients.l.AddSystemForOrg(&pb.AddSystemRequest{
 		OrgName:     req.OrgName,
@@ -252,3 +372,69 @@ func (r *Router) deleteSystemHandler(c *gin.Context, req *DeleteSystemRequest) (
 		SystemName: req.SysName,
 	})
 }
+
+func (r *Router) getSystemsHandler(c *gin.Context, req *GetSystemsRequest) (*pb.GetSystemsResponse, error) {
+	return r.clients.l.GetSystems(&pb.GetSystemsRequest{
+		OrgName: req.OrgName,
+	})
+}
+
+func (r *Router) postSystemHeartbeatHandler(c *gin.Context, req *SystemHeartbeatRequest) (*pb.SystemHeartbeatResponse, error) {
+	return r.clients.l.SystemHeartbeat(&pb.SystemHeartbeatRequest{
+		OrgName:      req.OrgName,
+		SystemName:   req.SysName,
+		Version:      req.Version,
+		ApiGwHealth:  req.ApiGwHealth,
+		NodeGwHealth: req.NodeGwHealth,
+	})
+}
+
+func (r *Router) postSystemDrainHandler(c *gin.Context, req *DrainSystemRequest) (*pb.DrainSystemResponse, error) {
+	return r.clients.l.DrainSystemForOrg(&pb.DrainSystemRequest{
+		OrgName:    req.OrgName,
+		SystemName: req.SysName,
+		DrainIp:    req.DrainIp,
+		DrainPort:  req.DrainPort,
+		DrainUrl:   req.DrainUrl,
+	})
+}
+
+func (r *Router) deleteSystemDrainHandler(c *gin.Context, req *ResumeSystemRequest) (*pb.ResumeSystemResponse, error) {
+	return r.clients.l.ResumeSystemForOrg(&pb.ResumeSystemRequest{
+		OrgName:    req.OrgName,
+		SystemName: req.SysName,
+	})
+}
+
+func (r *Router) getCertificatesHandler(c *gin.Context, req *ListCertificatesRequest) (*capb.ListCertificatesResponse, error) {
+	return r.clients.ca.ListCertificates(&capb.ListCertificatesRequest{
+		NodeId:            req.NodeId,
+		ExpiresWithinDays: req.ExpiresWithinDays,
+		IncludeRevoked:    req.IncludeRevoked,
+	})
+}
+
+func (r *Router) getRevocationListHandler(c *gin.Context, req *GetRevocationListRequest) (*capb.GetRevocationListResponse, error) {
+	return r.clients.ca.GetRevocationList(&capb.GetRevocationListRequest{})
+}
+
+func (r *Router) deleteCertificateHandler(c *gin.Context, req *RevokeCertificateRequest) (*capb.RevokeCertificateResponse, error) {
+	return r.clients.ca.RevokeCertificate(&capb.RevokeCertificateRequest{
+		Serial: req.Serial,
+		Reason: req.Reason,
+	})
+}
+
+func (r *Router) deleteNodeCertificatesHandler(c *gin.Context, req *RevokeNodeCertificatesRequest) (*capb.RevokeNodeCertificatesResponse, error) {
+	return r.clients.ca.RevokeNodeCertificates(&capb.RevokeNodeCertificatesRequest{
+		NodeId: req.NodeId,
+		Reason: req.Reason,
+	})
+}
+
+func (r *Router) getNodeVerifyHandler(c *gin.Context, req *VerifyNodeRequest) (*capb.VerifyNodeResponse, error) {
+	return r.clients.ca.VerifyNode(&capb.VerifyNodeRequest{
+		NodeId: req.NodeId,
+		Serial: req.Serial,
+	})
+}
@@ -32,6 +32,10 @@ import (
 
 	cconfig "github.com/ukama/ukama/systems/common/config"
 	cmocks "github.com/ukama/ukama/systems/common/mocks"
+	"github.com/ukama/ukama/systems/common/rest/client/nucleus"
+	"github.com/ukama/ukama/systems/common/roles"
+	capb "github.com/ukama/ukama/systems/init/ca/pb/gen"
+	camocks "github.com/ukama/ukama/systems/init/ca/pb/gen/mocks"
 	pb "github.com/ukama/ukama/systems/init/lookup/pb/gen"
 	lmocks "github.com/ukama/ukama/systems/init/lookup/pb/gen/mocks"
 )
@@ -61,7 +65,7 @@ func init() {
 	testClientSet = NewClientsSet(&pkg.GrpcEndpoints{
 		Timeout: 1 * time.Second,
 		Lookup:  "localhost:8080",
-	})
+	}, &pkg.HttpEndpoints{})
 }
 
 func TestRouter_PingRoute(t *testing.T) {
@@ -432,3 +436,379 @@ func TestRouter_DeleteSystem(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 	m.AssertExpectations(t)
 }
+
+func TestRouter_GetSystems(t *testing.T) {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/systems?org=org-name", nil)
+
+	m := &lmocks.LookupServiceClient{}
+
+	m.On("GetSystems", mock.Anything, &pb.GetSystemsRequest{OrgName: "org-name"}).Return(&pb.GetSystemsResponse{
+		Systems: []*pb.SystemInfo{
+			{SystemName: "sys", OrgName: "org-name", Version: "v1.0.0", Status: "up"},
+		},
+	}, nil)
+
+	arc := &cmocks.AuthClient{}
+	arc.On("AuthenticateUser", mock.Anything, mock.Anything).Return(nil)
+
+	r := NewRouter(&Clients{
+		l: client.NewLookupFromClient(m),
+	}, routerConfig, arc.AuthenticateUser).f.Engine()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "v1.0.0")
+	m.AssertExpectations(t)
+}
+
+func TestRouter_SystemHeartbeat(t *testing.T) {
+	sys := "sys"
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/v1/orgs/org-name/systems/"+sys+"/heartbeat",
+		strings.NewReader(`{ "version":"v1.0.0", "apiGwHealth":100, "nodeGwHealth":90}`))
+
+	m := &lmocks.LookupServiceClient{}
+
+	hbReq := &pb.SystemHeartbeatRequest{
+		OrgName:      "org-name",
+		SystemName:   sys,
+		Version:      "v1.0.0",
+		ApiGwHealth:  100,
+		NodeGwHealth: 90,
+	}
+
+	m.On("SystemHeartbeat", mock.Anything, hbReq).Return(&pb.SystemHeartbeatResponse{}, nil)
+
+	arc := &cmocks.AuthClient{}
+	arc.On("AuthenticateUser", mock.Anything, mock.Anything).Return(nil)
+
+	r := NewRouter(&Clients{
+		l: client.NewLookupFromClient(m),
+	}, routerConfig, arc.AuthenticateUser).f.Engine()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	m.AssertExpectations(t)
+}
+
+func TestRouter_DrainSystem(t *testing.T) {
+	sys := "messaging"
+	m := &lmocks.LookupServiceClient{}
+
+	arc := &cmocks.AuthClient{}
+	arc.On("AuthenticateUser", mock.Anything, mock.Anything).Return(nil)
+
+	r := NewRouter(&Clients{
+		l: client.NewLookupFromClient(m),
+	}, routerConfig, arc.AuthenticateUser).f.Engine()
+
+	t.Run("Drain", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/v1/orgs/org-name/systems/"+sys+"/drain",
+			strings.NewReader(`{ "drainIp":"10.0.0.2", "drainPort":443}`))
+
+		drainReq := &pb.DrainSystemRequest{
+			OrgName:    "org-name",
+			SystemName: sys,
+			DrainIp:    "10.0.0.2",
+			DrainPort:  443,
+		}
+
+		m.On("DrainSystemForOrg", mock.Anything, drainReq).Return(&pb.DrainSystemResponse{
+			System: &pb.SystemInfo{SystemName: sys, Draining: true},
+		}, nil).Once()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("DrainMissingIp", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/v1/orgs/org-name/systems/"+sys+"/drain",
+			strings.NewReader(`{ "drainPort":443}`))
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/v1/orgs/org-name/systems/"+sys+"/drain", nil)
+
+		m.On("ResumeSystemForOrg", mock.Anything, &pb.ResumeSystemRequest{
+			OrgName:    "org-name",
+			SystemName: sys,
+		}).Return(&pb.ResumeSystemResponse{
+			System: &pb.SystemInfo{SystemName: sys},
+		}, nil).Once()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	m.AssertExpectations(t)
+}
+
+func TestRouter_NodeTransfer(t *testing.T) {
+	const ownerId = "0b7c3a52-6d1e-4f08-9a3b-5c2e7d4f1a90"
+	const strangerId = "e4a91f3c-2b7d-4c65-8e0a-9d3f6b1c2e47"
+
+	nodeId := ukama.NewVirtualNodeId("homenode").String()
+	m := &lmocks.LookupServiceClient{}
+	o := &cmocks.OrgClient{}
+	o.On("GetByUser", ownerId).Return(&nucleus.UserOrgs{User: ownerId,
+		OwnerOf: []nucleus.OrgInfo{{Name: "org-name"}}}, nil)
+	o.On("GetByUser", strangerId).Return(&nucleus.UserOrgs{User: strangerId,
+		MemberOf: []nucleus.OrgInfo{{Name: "other-org"}}}, nil)
+
+	arc := &cmocks.AuthClient{}
+	arc.On("AuthenticateUser", mock.Anything, mock.Anything).Return(nil)
+
+	r := NewRouter(&Clients{
+		l: client.NewLookupFromClient(m),
+		o: o,
+	}, routerConfig, arc.AuthenticateUser).f.Engine()
+
+	t.Run("Claim", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/v1/orgs/org-name/nodes/"+nodeId+"/claim",
+			strings.NewReader(`{ "claimCode":"ABCD-1234"}`))
+
+		m.On("ClaimNode", mock.Anything, &pb.ClaimNodeRequest{
+			OrgName:   "org-name",
+			NodeId:    nodeId,
+			ClaimCode: "ABCD-1234",
+		}).Return(&pb.ClaimNodeResponse{NodeId: nodeId, OrgName: "org-name"}, nil).Once()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("ClaimMissingCode", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/v1/orgs/org-name/nodes/"+nodeId+"/claim",
+			strings.NewReader(`{}`))
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Release", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/v1/orgs/org-name/nodes/"+nodeId+"/release",
+			strings.NewReader(`{ "toOrgName":"other-org", "releasedBy":"someone-else"}`))
+		req.Header.Set(roles.UserIdHeader, ownerId)
+
+		m.On("ReleaseNode", mock.Anything, &pb.ReleaseNodeRequest{
+			OrgName:    "org-name",
+			NodeId:     nodeId,
+			ToOrgName:  "other-org",
+			ReleasedBy: ownerId,
+		}).Return(&pb.ReleaseNodeResponse{
+			Transfer: &pb.NodeTransfer{NodeId: nodeId, Status: "pending"},
+		}, nil).Once()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), "pending")
+	})
+
+	t.Run("ReleaseNotMember", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/v1/orgs/org-name/nodes/"+nodeId+"/release",
+			strings.NewReader(`{ "toOrgName":"other-org"}`))
+		req.Header.Set(roles.UserIdHeader, strangerId)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("ReleaseNoUser", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/v1/orgs/org-name/nodes/"+nodeId+"/release",
+			strings.NewReader(`{ "toOrgName":"other-org"}`))
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("CancelReleaseNotMember", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/v1/orgs/org-name/nodes/"+nodeId+"/release", nil)
+		req.Header.Set(roles.UserIdHeader, strangerId)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("CancelRelease", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/v1/orgs/org-name/nodes/"+nodeId+"/release", nil)
+		req.Header.Set(roles.UserIdHeader, ownerId)
+
+		m.On("CancelNodeRelease", mock.Anything, &pb.CancelNodeReleaseRequest{
+			OrgName: "org-name",
+			NodeId:  nodeId,
+		}).Return(&pb.CancelNodeReleaseResponse{
+			Transfer: &pb.NodeTransfer{NodeId: nodeId, Status: "cancelled"},
+		}, nil).Once()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("AcceptInvalidCode", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/v1/orgs/other-org/nodes/"+nodeId+"/accept",
+			strings.NewReader(`{ "claimCode":"WRONG", "acceptedBy":"buyer"}`))
+
+		m.On("AcceptNode", mock.Anything, &pb.AcceptNodeRequest{
+			OrgName:    "other-org",
+			NodeId:     nodeId,
+			ClaimCode:  "WRONG",
+			AcceptedBy: "buyer",
+		}).Return(nil, status.Error(codes.PermissionDenied, "invalid claim code")).Once()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	m.AssertExpectations(t)
+}
+
+func TestRouter_Certificates(t *testing.T) {
+	nodeId := ukama.NewVirtualNodeId("homenode").String()
+	m := &camocks.CaServiceClient{}
+
+	arc := &cmocks.AuthClient{}
+	arc.On("AuthenticateUser", mock.Anything, mock.Anything).Return(nil)
+
+	rt := NewRouter(&Clients{
+		ca: client.NewCaFromClient(m),
+	}, routerConfig, arc.AuthenticateUser)
+	r := rt.f.Engine()
+	ir := rt.internal.Engine()
+
+	t.Run("ListExpiring", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/v1/certs?expiresWithinDays=30", nil)
+
+		m.On("ListCertificates", mock.Anything, &capb.ListCertificatesRequest{
+			ExpiresWithinDays: 30,
+		}).Return(&capb.ListCertificatesResponse{
+			Certificates: []*capb.Certificate{{Serial: "0a1b", NodeId: nodeId, Status: "active"}},
+		}, nil).Once()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "0a1b")
+	})
+
+	t.Run("RevocationList", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/authz/v1/certs/crl", nil)
+
+		m.On("GetRevocationList", mock.Anything, &capb.GetRevocationListRequest{}).
+			Return(&capb.GetRevocationListResponse{CrlPem: "crl"}, nil).Once()
+
+		ir.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("RevocationListNotPublic", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/authz/v1/certs/crl", nil)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Revoke", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/v1/certs/0a1b?reason=compromised", nil)
+
+		m.On("RevokeCertificate", mock.Anything, &capb.RevokeCertificateRequest{
+			Serial: "0a1b",
+			Reason: "compromised",
+		}).Return(&capb.RevokeCertificateResponse{
+			Certificate: &capb.Certificate{Serial: "0a1b", Status: "revoked"},
+		}, nil).Once()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("RevokeNode", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/v1/certs/nodes/"+nodeId, nil)
+
+		m.On("RevokeNodeCertificates", mock.Anything, &capb.RevokeNodeCertificatesRequest{
+			NodeId: nodeId,
+		}).Return(&capb.RevokeNodeCertificatesResponse{}, nil).Once()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("VerifyRevoked", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/authz/v1/certs/nodes/"+nodeId+"/verify?serial=0a1b", nil)
+
+		m.On("VerifyNode", mock.Anything, &capb.VerifyNodeRequest{NodeId: nodeId, Serial: "0a1b"}).
+			Return(nil, status.Error(codes.PermissionDenied, "node certificates are revoked or expired")).Once()
+
+		ir.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("VerifyWithoutCredentials", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/authz/v1/certs/nodes/"+nodeId+"/verify", nil)
+
+		m.On("VerifyNode", mock.Anything, &capb.VerifyNodeRequest{NodeId: nodeId}).
+			Return(&capb.VerifyNodeResponse{NodeId: nodeId}, nil).Once()
+
+		unauth := &cmocks.AuthClient{}
+		NewRouter(&Clients{
+			ca: client.NewCaFromClient(m),
+		}, routerConfig, unauth.AuthenticateUser).internal.Engine().ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		unauth.AssertNotCalled(t, "AuthenticateUser", mock.Anything, mock.Anything)
+	})
+
+	t.Run("VerifyNotPublic", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/authz/v1/certs/nodes/"+nodeId+"/verify?serial=0a1b", nil)
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	m.AssertExpectations(t)
+}
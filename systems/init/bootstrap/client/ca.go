@@ -0,0 +1,49 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package client
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	log "github.com/sirupsen/logrus"
+	pb "github.com/ukama/ukama/systems/init/ca/pb/gen"
+)
+
+type CaClientProvider interface {
+	GetClient() (pb.CaServiceClient, error)
+}
+
+type caClientProvider struct {
+	caService pb.CaServiceClient
+	caHost    string
+}
+
+func NewCaClientProvider(caHost string) CaClientProvider {
+	return &caClientProvider{caHost: caHost}
+}
+
+func (c *caClientProvider) GetClient() (pb.CaServiceClient, error) {
+	if c.caService == nil {
+		log.Infoln("Connecting to CA service ", c.caHost)
+
+		conn, err := grpc.NewClient(c.caHost, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Errorf("Failed to connect to CA service %s. Error: %v", c.caHost, err)
+
+			return nil, fmt.Errorf("failed to connect to remote CA service: %w", err)
+		}
+
+		c.caService = pb.NewCaServiceClient(conn)
+	}
+
+	return c.caService, nil
+}
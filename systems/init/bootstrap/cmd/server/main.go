@@ -103,7 +103,7 @@ func runGrpcServer() {
 	log.Debugf("MessageBus Client is %+v", mbClient)
 
 	bootstrapServer := server.NewBootstrapServer(mbClient, svcConf.DebugMode,
-		provider.NewLookupClientProvider(svcConf.Lookup, svcConf.Timeout), factoryClient, nnsClient, svcConf, messagingSys.Certificate)
+		provider.NewLookupClientProvider(svcConf.Lookup, svcConf.Timeout), provider.NewCaClientProvider(svcConf.Ca), factoryClient, nnsClient, svcConf, messagingSys.Certificate)
 
 	grpcServer := ugrpc.NewGrpcServer(*svcConf.Grpc, func(s *grpc.Server) {
 		pb.RegisterBootstrapServiceServer(s, bootstrapServer)
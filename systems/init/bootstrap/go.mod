@@ -6,14 +6,18 @@ replace github.com/ukama/ukama/systems/common => ../../common
 
 replace github.com/ukama/ukama/systems/init/lookup => ../../init/lookup
 
+replace github.com/ukama/ukama/systems/init/ca => ../../init/ca
+
 replace github.com/ukama/ukama/systems/init/bootstrap => ./
 
 require (
 	github.com/golang/protobuf v1.5.4
+	github.com/mwitkow/go-proto-validators v0.3.2
 	github.com/num30/config v0.1.3
 	github.com/sirupsen/logrus v1.10.1
 	github.com/stretchr/testify v1.12.0
 	github.com/ukama/ukama/systems/common v0.0.0-00010101000000-000000000000
+	github.com/ukama/ukama/systems/init/ca v0.0.0-00010101000000-000000000000
 	github.com/ukama/ukama/systems/init/lookup v0.0.0-00010101000000-000000000000
 	google.golang.org/grpc v1.83.1
 	google.golang.org/protobuf v1.36.12
@@ -82,7 +86,6 @@ require (
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
 	github.com/modern-go/reflect2 v1.0.3-0.20250322232337-35a7c28c31ee // indirect
 	github.com/munnerz/goautoneg v0.0.0-20191010083416-a7dc8b61c822 // indirect
-	github.com/mwitkow/go-proto-validators v0.3.2 // indirect
 	github.com/pelletier/go-toml/v2 v2.2.4 // indirect
 	github.com/penglongli/gin-metrics v0.1.10 // indirect
 	github.com/pkg/errors v0.9.1 // indirect
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	gen "github.com/ukama/ukama/systems/init/ca/pb/gen"
+)
+
+// CaClientProvider is an autogenerated mock type for the CaClientProvider type
+type CaClientProvider struct {
+	mock.Mock
+}
+
+// GetClient provides a mock function with no fields
+func (_m *CaClientProvider) GetClient() (gen.CaServiceClient, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClient")
+	}
+
+	var r0 gen.CaServiceClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (gen.CaServiceClient, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() gen.CaServiceClient); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gen.CaServiceClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCaClientProvider creates a new instance of CaClientProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCaClientProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CaClientProvider {
+	mock := &CaClientProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -7,7 +7,7 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.7
+// 	protoc-gen-go v1.36.12
 // 	protoc        v6.32.1
 // source: bootstrap.proto
 
@@ -16,6 +16,7 @@ package gen
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -29,8 +30,10 @@ const (
 )
 
 type GetNodeCredentialsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// PEM encoded CSR for the node certificate, a key is generated when empty
+	Csr           string `protobuf:"bytes,2,opt,name=csr,proto3" json:"csr,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -72,12 +75,25 @@ func (x *GetNodeCredentialsRequest) GetId() string {
 	return ""
 }
 
+func (x *GetNodeCredentialsRequest) GetCsr() string {
+	if x != nil {
+		return x.Csr
+	}
+	return ""
+}
+
 type GetNodeCredentialsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
-	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
-	Certificate   string                 `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgName               string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	Ip                    string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	Certificate           string                 `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	NodeCertificate       string                 `protobuf:"bytes,5,opt,name=nodeCertificate,proto3" json:"nodeCertificate,omitempty"`
+	NodeKey               string                 `protobuf:"bytes,6,opt,name=nodeKey,proto3" json:"nodeKey,omitempty"`
+	CaCertificate         string                 `protobuf:"bytes,7,opt,name=caCertificate,proto3" json:"caCertificate,omitempty"`
+	NodeCertificateExpiry *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=nodeCertificateExpiry,proto3" json:"nodeCertificateExpiry,omitempty"`
+	// Port of the messaging endpoint, 0 when nodes use their default port
+	Port          int32 `protobuf:"varint,9,opt,name=port,proto3" json:"port,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -140,18 +156,59 @@ func (x *GetNodeCredentialsResponse) GetCertificate() string {
 	return ""
 }
 
+func (x *GetNodeCredentialsResponse) GetNodeCertificate() string {
+	if x != nil {
+		return x.NodeCertificate
+	}
+	return ""
+}
+
+func (x *GetNodeCredentialsResponse) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+func (x *GetNodeCredentialsResponse) GetCaCertificate() string {
+	if x != nil {
+		return x.CaCertificate
+	}
+	return ""
+}
+
+func (x *GetNodeCredentialsResponse) GetNodeCertificateExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NodeCertificateExpiry
+	}
+	return nil
+}
+
+func (x *GetNodeCredentialsResponse) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
 var File_bootstrap_proto protoreflect.FileDescriptor
 
 const file_bootstrap_proto_rawDesc = "" +
 	"\n" +
-	"\x0fbootstrap.proto\x12\x12ukama.bootstrap.v1\"+\n" +
+	"\x0fbootstrap.proto\x12\x12ukama.bootstrap.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"=\n" +
 	"\x19GetNodeCredentialsRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"x\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03csr\x18\x02 \x01(\tR\x03csr\"\xc8\x02\n" +
 	"\x1aGetNodeCredentialsResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\aorgName\x18\x02 \x01(\tR\aorgName\x12\x0e\n" +
 	"\x02ip\x18\x03 \x01(\tR\x02ip\x12 \n" +
-	"\vcertificate\x18\x04 \x01(\tR\vcertificate2\x87\x01\n" +
+	"\vcertificate\x18\x04 \x01(\tR\vcertificate\x12(\n" +
+	"\x0fnodeCertificate\x18\x05 \x01(\tR\x0fnodeCertificate\x12\x18\n" +
+	"\anodeKey\x18\x06 \x01(\tR\anodeKey\x12$\n" +
+	"\rcaCertificate\x18\a \x01(\tR\rcaCertificate\x12P\n" +
+	"\x15nodeCertificateExpiry\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x15nodeCertificateExpiry\x12\x12\n" +
+	"\x04port\x18\t \x01(\x05R\x04port2\x87\x01\n" +
 	"\x10BootstrapService\x12s\n" +
 	"\x12GetNodeCredentials\x12-.ukama.bootstrap.v1.GetNodeCredentialsRequest\x1a..ukama.bootstrap.v1.GetNodeCredentialsResponseB6Z4github.com/ukama/ukama/systems/init/bootstrap/pb/genb\x06proto3"
 
@@ -171,15 +228,17 @@ var file_bootstrap_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_bootstrap_proto_goTypes = []any{
 	(*GetNodeCredentialsRequest)(nil),  // 0: ukama.bootstrap.v1.GetNodeCredentialsRequest
 	(*GetNodeCredentialsResponse)(nil), // 1: ukama.bootstrap.v1.GetNodeCredentialsResponse
+	(*timestamppb.Timestamp)(nil),      // 2: google.protobuf.Timestamp
 }
 var file_bootstrap_proto_depIdxs = []int32{
-	0, // 0: ukama.bootstrap.v1.BootstrapService.GetNodeCredentials:input_type -> ukama.bootstrap.v1.GetNodeCredentialsRequest
-	1, // 1: ukama.bootstrap.v1.BootstrapService.GetNodeCredentials:output_type -> ukama.bootstrap.v1.GetNodeCredentialsResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: ukama.bootstrap.v1.GetNodeCredentialsResponse.nodeCertificateExpiry:type_name -> google.protobuf.Timestamp
+	0, // 1: ukama.bootstrap.v1.BootstrapService.GetNodeCredentials:input_type -> ukama.bootstrap.v1.GetNodeCredentialsRequest
+	1, // 2: ukama.bootstrap.v1.BootstrapService.GetNodeCredentials:output_type -> ukama.bootstrap.v1.GetNodeCredentialsResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_bootstrap_proto_init() }
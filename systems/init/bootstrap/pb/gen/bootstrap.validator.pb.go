@@ -7,6 +7,8 @@ import (
 	fmt "fmt"
 	math "math"
 	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -18,5 +20,10 @@ func (this *GetNodeCredentialsRequest) Validate() error {
 	return nil
 }
 func (this *GetNodeCredentialsResponse) Validate() error {
+	if this.NodeCertificateExpiry != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NodeCertificateExpiry); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NodeCertificateExpiry", err)
+		}
+	}
 	return nil
 }
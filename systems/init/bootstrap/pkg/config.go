@@ -25,6 +25,7 @@ type Config struct {
 	MsgClient        *uconf.MsgClient `default:"{}"`
 	OrgName          string           `default:"ukama"`
 	Lookup           string           `default:"lookup:9090"`
+	Ca               string           `default:"ca:9090"`
 	DNSMap           []OrgDNS         `mapstructure:"-"`
 	MeshNamespace    string           `default:"messaging"`
 	Http             HttpServices
@@ -51,6 +52,7 @@ func NewConfig(name string) *Config {
 			ListenerRoutes: []string{},
 		},
 		Lookup: "lookup:9090",
+		Ca:     "ca:9090",
 		DNSMap: []OrgDNS{
 			{
 				OrgName: "ukama",
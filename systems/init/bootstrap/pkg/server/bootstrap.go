@@ -22,7 +22,9 @@ import (
 
 	messaging "github.com/ukama/ukama/systems/common/rest/client/messaging"
 	pb "github.com/ukama/ukama/systems/init/bootstrap/pb/gen"
+	capb "github.com/ukama/ukama/systems/init/ca/pb/gen"
 	"github.com/ukama/ukama/systems/init/bootstrap/pkg"
+	lpb "github.com/ukama/ukama/systems/init/lookup/pb/gen"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/client-go/kubernetes"
@@ -50,6 +52,7 @@ type BootstrapServer struct {
 	msgbus              mb.MsgBusServiceClient
 	debug               bool
 	lookupClient        client.LookupClientProvider
+	caClient            client.CaClientProvider
 	factoryClient       factory.NodeFactoryClient
 	dnsMap              map[string]string
 	clientSet 			kubernetes.Interface
@@ -59,7 +62,7 @@ type BootstrapServer struct {
 }
  
 // NewBootstrapServerWithDeps creates a BootstrapServer; testDeps is nil in production.
-func NewBootstrapServerWithDeps(msgBus mb.MsgBusServiceClient, debug bool, lookupClient client.LookupClientProvider, factoryClient factory.NodeFactoryClient, nnsClient messaging.NnsClient, serverConfig *BootstrapServerConfig, testDeps *BootstrapTestDeps) *BootstrapServer {
+func NewBootstrapServerWithDeps(msgBus mb.MsgBusServiceClient, debug bool, lookupClient client.LookupClientProvider, caClient client.CaClientProvider, factoryClient factory.NodeFactoryClient, nnsClient messaging.NnsClient, serverConfig *BootstrapServerConfig, testDeps *BootstrapTestDeps) *BootstrapServer {
 	var cs kubernetes.Interface
 	var dns map[string]string
 	if testDeps != nil && testDeps.ClientSet != nil {
@@ -89,6 +92,7 @@ func NewBootstrapServerWithDeps(msgBus mb.MsgBusServiceClient, debug bool, looku
 		msgbus:              msgBus,
 		debug:               debug,
 		lookupClient:        lookupClient,
+		caClient:            caClient,
 		factoryClient:       factoryClient,
 		nnsClient:           nnsClient,
 		dnsMap:              dns,
@@ -97,8 +101,8 @@ func NewBootstrapServerWithDeps(msgBus mb.MsgBusServiceClient, debug bool, looku
 	}
 }
 
-func NewBootstrapServer(msgBus mb.MsgBusServiceClient, debug bool, lookupClient client.LookupClientProvider, factoryClient factory.NodeFactoryClient, nnsClient messaging.NnsClient, config *pkg.Config, messagingCert string) *BootstrapServer {
-	return NewBootstrapServerWithDeps(msgBus, debug, lookupClient, factoryClient, nnsClient, &BootstrapServerConfig{Config: config, MessagingCert: messagingCert}, nil)
+func NewBootstrapServer(msgBus mb.MsgBusServiceClient, debug bool, lookupClient client.LookupClientProvider, caClient client.CaClientProvider, factoryClient factory.NodeFactoryClient, nnsClient messaging.NnsClient, config *pkg.Config, messagingCert string) *BootstrapServer {
+	return NewBootstrapServerWithDeps(msgBus, debug, lookupClient, caClient, factoryClient, nnsClient, &BootstrapServerConfig{Config: config, MessagingCert: messagingCert}, nil)
 }
  
 func (s *BootstrapServer) GetNodeCredentials(ctx context.Context, req *pb.GetNodeCredentialsRequest) (*pb.GetNodeCredentialsResponse, error) {
@@ -108,72 +112,212 @@ func (s *BootstrapServer) GetNodeCredentials(ctx context.Context, req *pb.GetNod
 		return nil, err
 	}
 
-	/* TODO: Uncomment this validation when init-lookup is ready for node lookup */
-
-	// lookup, err := s.lookupClient.GetClient()
-	// if err != nil {
-	// 	log.Errorf("Failed to get node from lookup: %v", err)
-	// 	return nil, err
-	// }
-
-	// lookupNode, err := lookup.GetNode(ctx, &lpb.GetNodeRequest{NodeId: req.Id})
-	// if err != nil {
-	// 	log.Errorf("Failed to get node from lookup: %v", err)
-	// 	return nil, err
-	// }
+	orgName, previousOrg, err := s.nodeOrg(ctx, node.Node.Id, node.Node.OrgName)
+	if err != nil {
+		return nil, err
+	}
 
-	if node.Node.OrgName == "" {
+	if orgName == "" {
 		log.Errorf("Node org name is empty")
 		return nil, status.Errorf(codes.FailedPrecondition, "Node is not provisioned in any org")
 	}
  
-	dns := s.dnsMap[node.Node.OrgName]
+	// Credentials of the org the node was transferred from are revoked before
+	// the new certificate is issued, which must not be revoked along.
+	if previousOrg != "" {
+		if err := s.revokeCredentials(ctx, node.Node.Id, orgName, previousOrg); err != nil {
+			return nil, err
+		}
+	}
+
+	ip, port, err := s.messagingEndpoint(ctx, orgName)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := s.issueCertificate(ctx, node.Node.Id, req.Csr)
+	if err != nil {
+		return nil, err
+	}
+
+	nd :=utils.NodeMeshInfo{NodeId: node.Node.Id, MeshPodIp: "0.0.0.0", MeshPodPort: 8082}
+
+	// The mesh endpoint known to NNS belongs to the org the node was
+	// transferred from, so it must not be handed out again.
+	if previousOrg == "" {
+		meshInfo, err := s.nnsClient.GetMesh(node.Node.Id)
+		if err != nil && !strings.Contains(err.Error(), "node not found") {
+			log.Errorf("Failed to get mesh info for node %s: %v", node.Node.Id, err)
+			return nil, status.Errorf(codes.Internal, "Failed to get mesh info: %v", err)
+		}
+
+		if meshInfo != nil && meshInfo.MeshIp != "" {
+			log.Infof("Mesh info is found for node %s, mesh ip: %s", node.Node.Id, meshInfo.MeshIp)
+			nd.MeshPodIp = meshInfo.MeshIp
+			nd.MeshPodPort = int32(meshInfo.MeshPort)
+		}
+	}
+
+	if err := utils.SpawnReplica(ctx, nd, s.config, s.clientSet); err != nil {
+		log.Warnf("Failed to spawn mesh replica for node %s: %v", node.Node.Id, err)
+	}
+
+	return s.credentials(node.Node.Id, orgName, ip, port, cert), nil
+}
+
+// messagingEndpoint returns the IPv4 address of the messaging system of the
+// org, or the endpoint it is drained to. The port is only known for a drained
+// endpoint, nodes use their default one otherwise.
+func (s *BootstrapServer) messagingEndpoint(ctx context.Context, orgName string) (string, int32, error) {
+	if ip, port := s.drainTarget(ctx, orgName); ip != "" {
+		log.Infof("Messaging of org %s is draining, redirecting nodes to %s:%d", orgName, ip, port)
+
+		return ip, port, nil
+	}
+
+	dns := s.dnsMap[orgName]
 	if dns == "" {
-		log.Errorf("DNS is not found for org %s", node.Node.OrgName)
-		return nil, status.Errorf(codes.NotFound, "DNS is not found for org %s", node.Node.OrgName)
+		log.Errorf("DNS is not found for org %s", orgName)
+		return "", 0, status.Errorf(codes.NotFound, "DNS is not found for org %s", orgName)
 	}
-	
+
 	ips, err := net.LookupIP(dns)
 	if err != nil {
 		log.Errorf("Could not get IPs: %v", err)
-		return nil, err
+		return "", 0, err
 	}
-	
-	var ip string
+
 	for _, ipAddr := range ips {
 		if ipv4 := ipAddr.To4(); ipv4 != nil {
-			ip = ipv4.String()
-			break
+			return ipv4.String(), 0, nil
 		}
 	}
 
-	if ip == "" {
-		log.Errorf("No IPv4 address found for DNS %s", dns)
-		return nil, status.Errorf(codes.NotFound, "No IPv4 address found for DNS %s", dns)
+	log.Errorf("No IPv4 address found for DNS %s", dns)
+
+	return "", 0, status.Errorf(codes.NotFound, "No IPv4 address found for DNS %s", dns)
+}
+
+func (s *BootstrapServer) credentials(nodeId, orgName, ip string, port int32, cert *capb.IssueCertificateResponse) *pb.GetNodeCredentialsResponse {
+	return &pb.GetNodeCredentialsResponse{
+		Id:                    nodeId,
+		OrgName:               orgName,
+		Ip:                    ip,
+		Port:                  port,
+		Certificate:           s.messagingCert,
+		NodeCertificate:       cert.CertificatePem,
+		NodeKey:               cert.PrivateKeyPem,
+		CaCertificate:         cert.CaCertificatePem,
+		NodeCertificateExpiry: cert.Certificate.GetNotAfter(),
 	}
- 
-	meshInfo, err := s.nnsClient.GetMesh(node.Node.Id)
-	if err != nil && !strings.Contains(err.Error(), "node not found") {
-		log.Errorf("Failed to get mesh info for node %s: %v", node.Node.Id, err)
-		return nil, status.Errorf(codes.Internal, "Failed to get mesh info: %v", err)
+}
+
+// issueCertificate gets the node a new mesh certificate. Nodes bootstrap again
+// to rotate it, so every call issues a fresh one.
+func (s *BootstrapServer) issueCertificate(ctx context.Context, nodeId string, csr string) (*capb.IssueCertificateResponse, error) {
+	ca, err := s.caClient.GetClient()
+	if err != nil {
+		log.Errorf("Failed to get CA client: %v", err)
+		return nil, status.Errorf(codes.Unavailable, "failed to get CA client: %v", err)
 	}
-	
-	nd :=utils.NodeMeshInfo{NodeId: node.Node.Id, MeshPodIp: "0.0.0.0", MeshPodPort: 8082}
-	if meshInfo != nil && meshInfo.MeshIp != "" {
-		log.Infof("Mesh info is found for node %s, mesh ip: %s", node.Node.Id, meshInfo.MeshIp)
-		nd.MeshPodIp = meshInfo.MeshIp
-		nd.MeshPodPort = int32(meshInfo.MeshPort)
+
+	cert, err := ca.IssueCertificate(ctx, &capb.IssueCertificateRequest{NodeId: nodeId, Csr: csr})
+	if err != nil {
+		log.Errorf("Failed to issue certificate for node %s: %v", nodeId, err)
+
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, err
+		}
+
+		return nil, status.Errorf(codes.Unavailable, "failed to issue node certificate: %v", err)
 	}
 
-	if err := utils.SpawnReplica(ctx, nd, s.config, s.clientSet); err != nil {
-		log.Warnf("Failed to spawn mesh replica for node %s: %v", node.Node.Id, err)
+	return cert, nil
+}
+
+// nodeOrg resolves the org a node currently belongs to. Lookup owns node
+// membership once a node is claimed, so the factory org is only used for nodes
+// lookup does not know about yet. It also returns the org the node was
+// transferred from while that org's credentials are not revoked.
+func (s *BootstrapServer) nodeOrg(ctx context.Context, nodeId string, factoryOrg string) (string, string, error) {
+	lookup, err := s.lookupClient.GetClient()
+	if err != nil {
+		log.Errorf("Failed to get lookup client: %v", err)
+		return "", "", status.Errorf(codes.Unavailable, "failed to get lookup client: %v", err)
 	}
 
-	return &pb.GetNodeCredentialsResponse{
-		Id:          node.Node.Id,
-		OrgName:     node.Node.OrgName,
-		Ip:          ip,
-		Certificate: s.messagingCert,
-	}, nil
+	lookupNode, err := lookup.GetNode(ctx, &lpb.GetNodeRequest{NodeId: nodeId})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return factoryOrg, "", nil
+		}
+
+		log.Errorf("Failed to get node %s from lookup: %v", nodeId, err)
+		return "", "", status.Errorf(codes.Unavailable, "failed to get node from lookup: %v", err)
+	}
+
+	if lookupNode.TransferPending {
+		log.Warnf("Node %s is released by org %s and pending transfer", nodeId, lookupNode.OrgName)
+		return "", "", status.Errorf(codes.PermissionDenied, "node %s is pending transfer to another org", nodeId)
+	}
+
+	return lookupNode.OrgName, lookupNode.PreviousOrgName, nil
+}
+
+// revokeCredentials revokes the mesh certificates the node got while it
+// belonged to the org it was transferred from, and tells that org to drop the
+// node. Lookup keeps the previous org until the event is handled, so a failed
+// publish is retried on the node's next bootstrap.
+func (s *BootstrapServer) revokeCredentials(ctx context.Context, nodeId, orgName, previousOrg string) error {
+	ca, err := s.caClient.GetClient()
+	if err != nil {
+		log.Errorf("Failed to get CA client: %v", err)
+		return status.Errorf(codes.Unavailable, "failed to get CA client: %v", err)
+	}
+
+	_, err = ca.RevokeNodeCertificates(ctx, &capb.RevokeNodeCertificatesRequest{
+		NodeId: nodeId,
+		Reason: "node transferred from org " + previousOrg,
+	})
+	if err != nil {
+		log.Errorf("Failed to revoke certificates of node %s: %v", nodeId, err)
+		return status.Errorf(codes.Unavailable, "failed to revoke node certificates: %v", err)
+	}
+
+	route := s.bootstrapRoutingKey.SetAction("revoke").SetObject("node").MustBuild()
+
+	msg := &lpb.NodeCredentialsRevoked{
+		NodeId:          nodeId,
+		OrgName:         orgName,
+		PreviousOrgName: previousOrg,
+	}
+
+	if err := s.msgbus.PublishRequest(route, msg); err != nil {
+		log.Errorf("Failed to publish message %+v with key %+v. Errors %s", msg, route, err.Error())
+	}
+
+	return nil
 }
 
+// drainTarget returns the endpoint the messaging system of an org is drained
+// to, if any. Lookup being unreachable must not stop nodes from bootstrapping,
+// so errors only mean there is no redirection.
+func (s *BootstrapServer) drainTarget(ctx context.Context, orgName string) (string, int32) {
+	lookup, err := s.lookupClient.GetClient()
+	if err != nil {
+		log.Warnf("Failed to get lookup client: %v", err)
+		return "", 0
+	}
+
+	sys, err := lookup.GetSystemForOrg(ctx, &lpb.GetSystemRequest{SystemName: MessagingSystem, OrgName: orgName})
+	if err != nil {
+		log.Warnf("Failed to get %s system of org %s from lookup: %v", MessagingSystem, orgName, err)
+		return "", 0
+	}
+
+	if !sys.Draining {
+		return "", 0
+	}
+
+	return sys.DrainIp, sys.DrainPort
+}
@@ -14,24 +14,40 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	mbmocks "github.com/ukama/ukama/systems/common/mocks"
 	"github.com/ukama/ukama/systems/common/rest/client/factory"
 	messaging "github.com/ukama/ukama/systems/common/rest/client/messaging"
 	"github.com/ukama/ukama/systems/init/bootstrap/mocks"
 	pb "github.com/ukama/ukama/systems/init/bootstrap/pb/gen"
 	"github.com/ukama/ukama/systems/init/bootstrap/pkg"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/client-go/kubernetes/fake"
+
+	capb "github.com/ukama/ukama/systems/init/ca/pb/gen"
+	camocks "github.com/ukama/ukama/systems/init/ca/pb/gen/mocks"
+	lpb "github.com/ukama/ukama/systems/init/lookup/pb/gen"
+	lmocks "github.com/ukama/ukama/systems/init/lookup/pb/gen/mocks"
 )
 
 const (
-	testOrgName    = "test-org"
-	testNodeID123  = "test-node-123"
-	testNodeID456  = "test-node-456"
-	testNodeID789  = "test-node-789"
-	testNodeID303  = "test-node-303"
-	unknownOrgName = "unknown-org"
+	testOrgName     = "test-org"
+	testNodeID123   = "test-node-123"
+	testNodeID456   = "test-node-456"
+	testNodeID789   = "test-node-789"
+	testNodeID303   = "test-node-303"
+	unknownOrgName  = "unknown-org"
+	previousOrgName = "previous-org"
 )
 
+var testIssued = &capb.IssueCertificateResponse{
+	Certificate:      &capb.Certificate{Serial: "0a1b", NodeId: testNodeID123},
+	CertificatePem:   "node-certificate",
+	PrivateKeyPem:    "node-key",
+	CaCertificatePem: "ca-certificate",
+}
+
 var testConfig = &pkg.Config{
 	OrgName:       testOrgName,
 	MeshNamespace: "messaging",
@@ -42,40 +58,185 @@ func TestGetNodeCredentials(t *testing.T) {
 	tests := []struct {
 		name           string
 		nodeID         string
-		setupMocks     func(*mbmocks.NodeFactoryClient, *mocks.LookupClientProvider, *mbmocks.MsgBusServiceClient, *mbmocks.NnsClient)
+		setupMocks     func(*mbmocks.NodeFactoryClient, *lmocks.LookupServiceClient, *camocks.CaServiceClient, *mbmocks.MsgBusServiceClient, *mbmocks.NnsClient)
 		expectedResult *pb.GetNodeCredentialsResponse
 		expectedError  error
 	}{
 		{
 			name:   "Success - Node with org name and DNS resolves",
 			nodeID: testNodeID123,
-			setupMocks: func(factoryMock *mbmocks.NodeFactoryClient, lookupMock *mocks.LookupClientProvider, msgBusMock *mbmocks.MsgBusServiceClient, nnsMock *mbmocks.NnsClient) {
+			setupMocks: func(factoryMock *mbmocks.NodeFactoryClient, lookupClient *lmocks.LookupServiceClient, caClient *camocks.CaServiceClient, msgBusMock *mbmocks.MsgBusServiceClient, nnsMock *mbmocks.NnsClient) {
 				factoryMock.On("Get", testNodeID123).Return(&factory.Node{
 					Node: factory.NodeFactoryInfo{
 						Id:      testNodeID123,
 						OrgName: testOrgName,
 					},
 				}, nil)
+				lookupNode(lookupClient, testNodeID123, nil, status.Error(codes.NotFound, "node not found"))
+				lookupSystem(lookupClient, testOrgName, nil, status.Error(codes.NotFound, "org not found"))
+				issueCertificate(caClient, testNodeID123, nil)
 				nnsMock.On("GetMesh", testNodeID123).Return((*messaging.MeshInfo)(nil), nil)
 			},
 			expectedResult: &pb.GetNodeCredentialsResponse{
-				Id:          "test-node-123",
-				OrgName:     "test-org",
-				Ip:          "127.0.0.1",
-				Certificate: "test-certificate-data",
+				Id:              "test-node-123",
+				OrgName:         "test-org",
+				Ip:              "127.0.0.1",
+				Certificate:     "test-certificate-data",
+				NodeCertificate: "node-certificate",
+				NodeKey:         "node-key",
+				CaCertificate:   "ca-certificate",
 			},
 			expectedError: nil,
 		},
+		{
+			name:   "Success - Messaging of org is draining",
+			nodeID: testNodeID123,
+			setupMocks: func(factoryMock *mbmocks.NodeFactoryClient, lookupClient *lmocks.LookupServiceClient, caClient *camocks.CaServiceClient, msgBusMock *mbmocks.MsgBusServiceClient, nnsMock *mbmocks.NnsClient) {
+				factoryMock.On("Get", testNodeID123).Return(&factory.Node{
+					Node: factory.NodeFactoryInfo{
+						Id:      testNodeID123,
+						OrgName: testOrgName,
+					},
+				}, nil)
+				lookupNode(lookupClient, testNodeID123, &lpb.GetNodeResponse{NodeId: testNodeID123, OrgName: testOrgName}, nil)
+				lookupSystem(lookupClient, testOrgName, &lpb.GetSystemResponse{
+					SystemName: MessagingSystem,
+					Draining:   true,
+					DrainIp:    "10.0.0.2",
+					DrainPort:  9092,
+				}, nil)
+				issueCertificate(caClient, testNodeID123, nil)
+				nnsMock.On("GetMesh", testNodeID123).Return((*messaging.MeshInfo)(nil), nil)
+			},
+			expectedResult: &pb.GetNodeCredentialsResponse{
+				Id:              "test-node-123",
+				OrgName:         "test-org",
+				Ip:              "10.0.0.2",
+				Port:            9092,
+				Certificate:     "test-certificate-data",
+				NodeCertificate: "node-certificate",
+				NodeKey:         "node-key",
+				CaCertificate:   "ca-certificate",
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "Success - Node transferred from another org",
+			nodeID: testNodeID123,
+			setupMocks: func(factoryMock *mbmocks.NodeFactoryClient, lookupClient *lmocks.LookupServiceClient, caClient *camocks.CaServiceClient, msgBusMock *mbmocks.MsgBusServiceClient, nnsMock *mbmocks.NnsClient) {
+				factoryMock.On("Get", testNodeID123).Return(&factory.Node{
+					Node: factory.NodeFactoryInfo{
+						Id:      testNodeID123,
+						OrgName: previousOrgName,
+					},
+				}, nil)
+				lookupNode(lookupClient, testNodeID123, &lpb.GetNodeResponse{
+					NodeId:          testNodeID123,
+					OrgName:         testOrgName,
+					PreviousOrgName: previousOrgName,
+				}, nil)
+				lookupSystem(lookupClient, testOrgName, nil, status.Error(codes.NotFound, "org not found"))
+				revokeCertificates(caClient, testNodeID123, nil)
+				msgBusMock.On("PublishRequest", "event.cloud.local.testorg.init.bootstrap.node.revoke", &lpb.NodeCredentialsRevoked{
+					NodeId:          testNodeID123,
+					OrgName:         testOrgName,
+					PreviousOrgName: previousOrgName,
+				}).Return(nil)
+				issueCertificate(caClient, testNodeID123, nil)
+			},
+			expectedResult: &pb.GetNodeCredentialsResponse{
+				Id:              "test-node-123",
+				OrgName:         "test-org",
+				Ip:              "127.0.0.1",
+				Certificate:     "test-certificate-data",
+				NodeCertificate: "node-certificate",
+				NodeKey:         "node-key",
+				CaCertificate:   "ca-certificate",
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "Error - Certificates of previous org not revoked",
+			nodeID: testNodeID123,
+			setupMocks: func(factoryMock *mbmocks.NodeFactoryClient, lookupClient *lmocks.LookupServiceClient, caClient *camocks.CaServiceClient, msgBusMock *mbmocks.MsgBusServiceClient, nnsMock *mbmocks.NnsClient) {
+				factoryMock.On("Get", testNodeID123).Return(&factory.Node{
+					Node: factory.NodeFactoryInfo{
+						Id:      testNodeID123,
+						OrgName: previousOrgName,
+					},
+				}, nil)
+				lookupNode(lookupClient, testNodeID123, &lpb.GetNodeResponse{
+					NodeId:          testNodeID123,
+					OrgName:         testOrgName,
+					PreviousOrgName: previousOrgName,
+				}, nil)
+				revokeCertificates(caClient, testNodeID123, status.Error(codes.Internal, "db error"))
+			},
+			expectedResult: nil,
+			expectedError:  errors.New("rpc error: code = Unavailable desc = failed to revoke node certificates: rpc error: code = Internal desc = db error"),
+		},
+		{
+			name:   "Error - Node pending transfer",
+			nodeID: testNodeID123,
+			setupMocks: func(factoryMock *mbmocks.NodeFactoryClient, lookupClient *lmocks.LookupServiceClient, caClient *camocks.CaServiceClient, msgBusMock *mbmocks.MsgBusServiceClient, nnsMock *mbmocks.NnsClient) {
+				factoryMock.On("Get", testNodeID123).Return(&factory.Node{
+					Node: factory.NodeFactoryInfo{
+						Id:      testNodeID123,
+						OrgName: testOrgName,
+					},
+				}, nil)
+				lookupNode(lookupClient, testNodeID123, &lpb.GetNodeResponse{
+					NodeId:          testNodeID123,
+					OrgName:         testOrgName,
+					TransferPending: true,
+				}, nil)
+			},
+			expectedResult: nil,
+			expectedError:  errors.New("rpc error: code = PermissionDenied desc = node " + testNodeID123 + " is pending transfer to another org"),
+		},
+		{
+			name:   "Error - Lookup fails",
+			nodeID: testNodeID123,
+			setupMocks: func(factoryMock *mbmocks.NodeFactoryClient, lookupClient *lmocks.LookupServiceClient, caClient *camocks.CaServiceClient, msgBusMock *mbmocks.MsgBusServiceClient, nnsMock *mbmocks.NnsClient) {
+				factoryMock.On("Get", testNodeID123).Return(&factory.Node{
+					Node: factory.NodeFactoryInfo{
+						Id:      testNodeID123,
+						OrgName: testOrgName,
+					},
+				}, nil)
+				lookupNode(lookupClient, testNodeID123, nil, status.Error(codes.Internal, "db error"))
+			},
+			expectedResult: nil,
+			expectedError:  errors.New("rpc error: code = Unavailable desc = failed to get node from lookup: rpc error: code = Internal desc = db error"),
+		},
+		{
+			name:   "Error - CA fails",
+			nodeID: testNodeID123,
+			setupMocks: func(factoryMock *mbmocks.NodeFactoryClient, lookupClient *lmocks.LookupServiceClient, caClient *camocks.CaServiceClient, msgBusMock *mbmocks.MsgBusServiceClient, nnsMock *mbmocks.NnsClient) {
+				factoryMock.On("Get", testNodeID123).Return(&factory.Node{
+					Node: factory.NodeFactoryInfo{
+						Id:      testNodeID123,
+						OrgName: testOrgName,
+					},
+				}, nil)
+				lookupNode(lookupClient, testNodeID123, nil, status.Error(codes.NotFound, "node not found"))
+				lookupSystem(lookupClient, testOrgName, nil, status.Error(codes.NotFound, "org not found"))
+				issueCertificate(caClient, testNodeID123, status.Error(codes.Internal, "db error"))
+			},
+			expectedResult: nil,
+			expectedError:  errors.New("rpc error: code = Unavailable desc = failed to issue node certificate: rpc error: code = Internal desc = db error"),
+		},
 		{
 			name:   "Error - Node without org name",
 			nodeID: testNodeID456,
-			setupMocks: func(factoryMock *mbmocks.NodeFactoryClient, lookupMock *mocks.LookupClientProvider, msgBusMock *mbmocks.MsgBusServiceClient, nnsMock *mbmocks.NnsClient) {
+			setupMocks: func(factoryMock *mbmocks.NodeFactoryClient, lookupClient *lmocks.LookupServiceClient, caClient *camocks.CaServiceClient, msgBusMock *mbmocks.MsgBusServiceClient, nnsMock *mbmocks.NnsClient) {
 				factoryMock.On("Get", testNodeID456).Return(&factory.Node{
 					Node: factory.NodeFactoryInfo{
 						Id:      testNodeID456,
 						OrgName: "",
 					},
 				}, nil)
+				lookupNode(lookupClient, testNodeID456, nil, status.Error(codes.NotFound, "node not found"))
 			},
 			expectedResult: nil,
 			expectedError:  errors.New("rpc error: code = FailedPrecondition desc = Node is not provisioned in any org"),
@@ -83,7 +244,7 @@ func TestGetNodeCredentials(t *testing.T) {
 		{
 			name:   "Error - Factory client fails",
 			nodeID: testNodeID789,
-			setupMocks: func(factoryMock *mbmocks.NodeFactoryClient, lookupMock *mocks.LookupClientProvider, msgBusMock *mbmocks.MsgBusServiceClient, nnsMock *mbmocks.NnsClient) {
+			setupMocks: func(factoryMock *mbmocks.NodeFactoryClient, lookupClient *lmocks.LookupServiceClient, caClient *camocks.CaServiceClient, msgBusMock *mbmocks.MsgBusServiceClient, nnsMock *mbmocks.NnsClient) {
 				factoryMock.On("Get", testNodeID789).Return(nil, errors.New("factory service unavailable"))
 			},
 			expectedResult: nil,
@@ -92,16 +253,18 @@ func TestGetNodeCredentials(t *testing.T) {
 		{
 			name:   "Error - DNS not found in map",
 			nodeID: testNodeID303,
-			setupMocks: func(factoryMock *mbmocks.NodeFactoryClient, lookupMock *mocks.LookupClientProvider, msgBusMock *mbmocks.MsgBusServiceClient, nnsMock *mbmocks.NnsClient) {
+			setupMocks: func(factoryMock *mbmocks.NodeFactoryClient, lookupClient *lmocks.LookupServiceClient, caClient *camocks.CaServiceClient, msgBusMock *mbmocks.MsgBusServiceClient, nnsMock *mbmocks.NnsClient) {
 				factoryMock.On("Get", testNodeID303).Return(&factory.Node{
 					Node: factory.NodeFactoryInfo{
 						Id:      testNodeID303,
 						OrgName: unknownOrgName,
 					},
 				}, nil)
+				lookupNode(lookupClient, testNodeID303, nil, status.Error(codes.NotFound, "node not found"))
+				lookupSystem(lookupClient, unknownOrgName, nil, status.Error(codes.NotFound, "org not found"))
 			},
 			expectedResult: nil,
-			expectedError:  errors.New("rpc error: code = NotFound desc = DNS is not found for org "+unknownOrgName),
+			expectedError:  errors.New("rpc error: code = NotFound desc = DNS is not found for org " + unknownOrgName),
 		},
 	}
 
@@ -111,13 +274,18 @@ func TestGetNodeCredentials(t *testing.T) {
 			lookupMock := mocks.NewLookupClientProvider(t)
 			msgBusMock := mbmocks.NewMsgBusServiceClient(t)
 			nnsMock := mbmocks.NewNnsClient(t)
+			lookupClient := &lmocks.LookupServiceClient{}
+			lookupMock.On("GetClient").Return(lookupClient, nil).Maybe()
+			caMock := mocks.NewCaClientProvider(t)
+			caClient := &camocks.CaServiceClient{}
+			caMock.On("GetClient").Return(caClient, nil).Maybe()
 
-			tt.setupMocks(factoryMock, lookupMock, msgBusMock, nnsMock)
+			tt.setupMocks(factoryMock, lookupClient, caClient, msgBusMock, nnsMock)
 
 			dnsMap := map[string]string{testOrgName: "localhost"}
 			serverConfig := &BootstrapServerConfig{Config: testConfig, MessagingCert: "test-certificate-data"}
 			testDeps := &BootstrapTestDeps{ClientSet: fake.NewSimpleClientset(), DNSMap: dnsMap}
-			server := NewBootstrapServerWithDeps(msgBusMock, false, lookupMock, factoryMock, nnsMock, serverConfig, testDeps)
+			server := NewBootstrapServerWithDeps(msgBusMock, false, lookupMock, caMock, factoryMock, nnsMock, serverConfig, testDeps)
 
 			req := &pb.GetNodeCredentialsRequest{
 				Id: tt.nodeID,
@@ -136,10 +304,47 @@ func TestGetNodeCredentials(t *testing.T) {
 				assert.Equal(t, tt.expectedResult.OrgName, result.OrgName)
 				assert.Equal(t, tt.expectedResult.Ip, result.Ip)
 				assert.Equal(t, tt.expectedResult.Certificate, result.Certificate)
+				assert.Equal(t, tt.expectedResult.NodeCertificate, result.NodeCertificate)
+				assert.Equal(t, tt.expectedResult.NodeKey, result.NodeKey)
+				assert.Equal(t, tt.expectedResult.CaCertificate, result.CaCertificate)
 			}
 
 			factoryMock.AssertExpectations(t)
 			nnsMock.AssertExpectations(t)
+			lookupClient.AssertExpectations(t)
+			caClient.AssertExpectations(t)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func lookupSystem(lookupClient *lmocks.LookupServiceClient, orgName string, resp *lpb.GetSystemResponse, err error) {
+	lookupClient.On("GetSystemForOrg", mock.Anything, &lpb.GetSystemRequest{
+		SystemName: MessagingSystem,
+		OrgName:    orgName,
+	}).Return(resp, err)
+}
+
+func lookupNode(lookupClient *lmocks.LookupServiceClient, nodeId string, resp *lpb.GetNodeResponse, err error) {
+	lookupClient.On("GetNode", mock.Anything, &lpb.GetNodeRequest{NodeId: nodeId}).Return(resp, err)
+}
+
+func issueCertificate(caClient *camocks.CaServiceClient, nodeId string, err error) {
+	var resp *capb.IssueCertificateResponse
+	if err == nil {
+		resp = testIssued
+	}
+
+	caClient.On("IssueCertificate", mock.Anything, &capb.IssueCertificateRequest{NodeId: nodeId}).Return(resp, err)
+}
+
+func revokeCertificates(caClient *camocks.CaServiceClient, nodeId string, err error) {
+	var resp *capb.RevokeNodeCertificatesResponse
+	if err == nil {
+		resp = &capb.RevokeNodeCertificatesResponse{}
+	}
+
+	caClient.On("RevokeNodeCertificates", mock.Anything, &capb.RevokeNodeCertificatesRequest{
+		NodeId: nodeId,
+		Reason: "node transferred from org " + previousOrgName,
+	}).Return(resp, err)
+}
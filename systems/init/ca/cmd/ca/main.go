@@ -0,0 +1,179 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/num30/config"
+	"github.com/ukama/ukama/systems/common/metrics"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/init/ca/cmd/version"
+	"github.com/ukama/ukama/systems/init/ca/internal"
+	"github.com/ukama/ukama/systems/init/ca/internal/authority"
+	"github.com/ukama/ukama/systems/init/ca/internal/db"
+	"github.com/ukama/ukama/systems/init/ca/internal/server"
+	"gopkg.in/yaml.v3"
+
+	log "github.com/sirupsen/logrus"
+	ccmd "github.com/ukama/ukama/systems/common/cmd"
+	ugrpc "github.com/ukama/ukama/systems/common/grpc"
+	mb "github.com/ukama/ukama/systems/common/msgBusServiceClient"
+	"github.com/ukama/ukama/systems/common/sql"
+	generated "github.com/ukama/ukama/systems/init/ca/pb/gen"
+	"google.golang.org/grpc"
+)
+
+var serviceConfig = internal.NewConfig(internal.ServiceName)
+
+func main() {
+	ccmd.ProcessVersionArgument("ca", os.Args, version.Version)
+
+	/* Log level */
+	log.SetLevel(log.TraceLevel)
+	log.Infof("Starting the ca service")
+
+	initConfig()
+
+	metrics.StartMetricsServer(serviceConfig.Metrics)
+
+	db := initDb()
+
+	runGrpcServer(db, initAuthority())
+
+	log.Infof("Exiting service %s", internal.ServiceName)
+}
+
+func initDb() sql.Db {
+	log.Infof("Initializing Database")
+	d := sql.NewDb(serviceConfig.DB, serviceConfig.DebugMode)
+	err := d.Init(&db.Certificate{})
+	if err != nil {
+		log.Fatalf("Database initialization failed. Error: %v", err)
+	}
+
+	return d
+}
+
+func initConfig() {
+	log.Infof("Initializing config")
+
+	err := config.NewConfReader(internal.ServiceName).Read(serviceConfig)
+	if err != nil {
+		log.Fatal("Error reading config ", err)
+	} else if serviceConfig.DebugMode {
+		b, err := yaml.Marshal(serviceConfig)
+		if err != nil {
+			log.Infof("Config:\n%s", string(b))
+		}
+	}
+
+	log.Debugf("\nService: %s DB Config: %+v Service: %+v MsgClient Config %+v", internal.ServiceName, serviceConfig.DB, serviceConfig.Service, serviceConfig.MsgClient)
+
+	internal.IsDebugMode = serviceConfig.DebugMode
+}
+
+func initAuthority() *authority.Authority {
+	a, err := authority.LoadAuthority(serviceConfig.Ca.CertFile, serviceConfig.Ca.KeyFile, serviceConfig.Ca.Validity)
+	if err == nil {
+		return a
+	}
+
+	if !serviceConfig.DebugMode {
+		log.Fatalf("Failed to load CA. Error: %v", err)
+	}
+
+	/* Certificates issued by it do not survive a restart */
+	log.Warnf("Failed to load CA, using a self signed one. Error: %v", err)
+
+	a, err = authority.NewSelfSignedAuthority(serviceConfig.OrgName+" node CA", 10*365*24*time.Hour, serviceConfig.Ca.Validity)
+	if err != nil {
+		log.Fatalf("Failed to create self signed CA. Error: %v", err)
+	}
+
+	return a
+}
+
+func runGrpcServer(d sql.Db, a *authority.Authority) {
+
+	instanceId := os.Getenv("POD_NAME")
+	if instanceId == "" {
+		/* used on local machines */
+		inst := uuid.NewV4()
+		instanceId = inst.String()
+	}
+
+	mbClient := mb.NewMsgBusClient(serviceConfig.MsgClient.Timeout, serviceConfig.OrgName, internal.SystemName,
+		internal.ServiceName, instanceId, serviceConfig.Queue.Uri,
+		serviceConfig.Service.Uri, serviceConfig.MsgClient.Host, serviceConfig.MsgClient.Exchange,
+		serviceConfig.MsgClient.ListenQueue, serviceConfig.MsgClient.PublishQueue,
+		serviceConfig.MsgClient.RetryCount,
+		serviceConfig.MsgClient.ListenerRoutes)
+
+	log.Debugf("MessageBus Client is %+v", mbClient)
+
+	srv := server.NewCaServer(db.NewCertRepo(d), a, mbClient, serviceConfig.OrgName, serviceConfig.Ca.CrlValidity,
+		server.RotationConfig{
+			RenewBefore: serviceConfig.Rotation.RenewBefore,
+			RetryAfter:  serviceConfig.Rotation.RetryAfter,
+			Path:        serviceConfig.Rotation.Path,
+		})
+
+	grpcServer := ugrpc.NewGrpcServer(*serviceConfig.Grpc, func(s *grpc.Server) {
+		generated.RegisterCaServiceServer(s, srv)
+	})
+
+	grpcServer.RegisterDependency("db", true, ugrpc.DBCheck(d))
+	grpcServer.RegisterDependency("msgclient", true, ugrpc.MsgClientCheck(serviceConfig.MsgClient.Host))
+
+	go grpcServer.StartServer()
+
+	go msgBusListener(mbClient)
+
+	go rotationLoop(srv)
+
+	waitForExit()
+}
+
+func rotationLoop(srv *server.CaServer) {
+	ticker := time.NewTicker(serviceConfig.Rotation.Interval)
+	defer ticker.Stop()
+
+	for {
+		srv.RotateExpiring()
+		<-ticker.C
+	}
+}
+
+func msgBusListener(m mb.MsgBusServiceClient) {
+
+	if err := m.Register(); err != nil {
+		log.Fatalf("Failed to register to Message Client Service. Error %s", err.Error())
+	}
+
+	if err := m.Start(); err != nil {
+		log.Fatalf("Failed to start to Message Client Service routine for service %s. Error %s", internal.ServiceName, err.Error())
+	}
+}
+
+func waitForExit() {
+	sigs := make(chan os.Signal, 1)
+	done := make(chan bool, 1)
+	go func() {
+
+		sig := <-sigs
+		log.Info(sig)
+		done <- true
+	}()
+
+	log.Debug("awaiting terminate/interrrupt signal")
+	<-done
+	log.Infof("exiting service %s", internal.ServiceName)
+}
@@ -0,0 +1,292 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package authority
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+)
+
+const (
+	pemCertificate = "CERTIFICATE"
+	pemCsr         = "CERTIFICATE REQUEST"
+	pemCrl         = "X509 CRL"
+	pemEcKey       = "EC PRIVATE KEY"
+
+	// Tolerated clock drift between the cloud and the nodes
+	clockSkew = 5 * time.Minute
+)
+
+var (
+	ErrInvalidCsr     = errors.New("invalid certificate signing request")
+	ErrNodeIdMismatch = errors.New("certificate signing request is not for the node")
+)
+
+// Authority signs the certificates the nodes use to join the mesh. Each
+// certificate carries the node id as its common name.
+type Authority struct {
+	cert     *x509.Certificate
+	certPem  string
+	key      crypto.Signer
+	validity time.Duration
+}
+
+// Issued is a node certificate. KeyPem is only set when the key was
+// generated by the authority.
+type Issued struct {
+	Serial    string
+	NodeId    string
+	Pem       string
+	KeyPem    string
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+// Revoked is an entry of the revocation list.
+type Revoked struct {
+	Serial    string
+	RevokedAt time.Time
+}
+
+func NewAuthority(certPem, keyPem []byte, validity time.Duration) (*Authority, error) {
+	block, _ := pem.Decode(certPem)
+	if block == nil || block.Type != pemCertificate {
+		return nil, errors.New("no CA certificate found")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CA certificate: %w", err)
+	}
+
+	if !cert.IsCA {
+		return nil, errors.New("certificate is not a CA")
+	}
+
+	key, err := parseKey(keyPem)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Authority{
+		cert:     cert,
+		certPem:  string(pem.EncodeToMemory(&pem.Block{Type: pemCertificate, Bytes: cert.Raw})),
+		key:      key,
+		validity: validity,
+	}, nil
+}
+
+func LoadAuthority(certFile, keyFile string, validity time.Duration) (*Authority, error) {
+	certPem, err := os.ReadFile(certFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+	}
+
+	keyPem, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA key: %w", err)
+	}
+
+	return NewAuthority(certPem, keyPem, validity)
+}
+
+// NewSelfSignedAuthority creates a throwaway CA, for local setups and tests.
+func NewSelfSignedAuthority(name string, caValidity, validity time.Duration) (*Authority, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	serial, err := newSerial()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             now.Add(-clockSkew),
+		NotAfter:              now.Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		return nil, err
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAuthority(pem.EncodeToMemory(&pem.Block{Type: pemCertificate, Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: pemEcKey, Bytes: keyDer}), validity)
+}
+
+func (a *Authority) CertificatePem() string {
+	return a.certPem
+}
+
+// Issue signs a certificate for the node. The public key is taken from the
+// CSR when one is given, otherwise a key pair is generated for the node.
+func (a *Authority) Issue(nodeId string, csrPem string) (*Issued, error) {
+	var pub crypto.PublicKey
+	issued := &Issued{NodeId: nodeId}
+
+	if csrPem != "" {
+		csr, err := parseCsr(csrPem)
+		if err != nil {
+			return nil, err
+		}
+
+		if csr.Subject.CommonName != "" && csr.Subject.CommonName != nodeId {
+			return nil, ErrNodeIdMismatch
+		}
+
+		pub = csr.PublicKey
+	} else {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+
+		keyDer, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+
+		pub = key.Public()
+		issued.KeyPem = string(pem.EncodeToMemory(&pem.Block{Type: pemEcKey, Bytes: keyDer}))
+	}
+
+	serial, err := newSerial()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: nodeId},
+		NotBefore:    now.Add(-clockSkew),
+		NotAfter:     now.Add(a.validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, a.cert, pub, a.key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign certificate for node %s: %w", nodeId, err)
+	}
+
+	issued.Serial = SerialString(serial)
+	issued.Pem = string(pem.EncodeToMemory(&pem.Block{Type: pemCertificate, Bytes: der}))
+	issued.NotBefore = tmpl.NotBefore
+	issued.NotAfter = tmpl.NotAfter
+
+	return issued, nil
+}
+
+// RevocationList returns the PEM encoded CRL signed by the authority.
+func (a *Authority) RevocationList(revoked []Revoked, now time.Time, nextUpdate time.Time) (string, error) {
+	entries := make([]x509.RevocationListEntry, 0, len(revoked))
+
+	for _, r := range revoked {
+		serial, ok := new(big.Int).SetString(r.Serial, 16)
+		if !ok {
+			return "", fmt.Errorf("invalid serial %q", r.Serial)
+		}
+
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   serial,
+			RevocationTime: r.RevokedAt,
+		})
+	}
+
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		RevokedCertificateEntries: entries,
+		Number:                    big.NewInt(now.Unix()),
+		ThisUpdate:                now,
+		NextUpdate:                nextUpdate,
+	}, a.cert, a.key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign revocation list: %w", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemCrl, Bytes: der})), nil
+}
+
+func SerialString(serial *big.Int) string {
+	return hex.EncodeToString(serial.Bytes())
+}
+
+func newSerial() (*big.Int, error) {
+	// 128 bits, positive as required by RFC 5280
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 127))
+}
+
+func parseCsr(csrPem string) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode([]byte(csrPem))
+	if block == nil || block.Type != pemCsr {
+		return nil, ErrInvalidCsr
+	}
+
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCsr, err)
+	}
+
+	if err := csr.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCsr, err)
+	}
+
+	return csr, nil
+}
+
+func parseKey(keyPem []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(keyPem)
+	if block == nil {
+		return nil, errors.New("no CA key found")
+	}
+
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CA key: %w", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("CA key can not sign")
+	}
+
+	return signer, nil
+}
@@ -0,0 +1,129 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package authority_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ukama/ukama/systems/init/ca/internal/authority"
+)
+
+const testNodeId = "uk-sa2341-hnode-v0-a1a0"
+
+func newAuthority(t *testing.T) (*authority.Authority, *x509.CertPool) {
+	a, err := authority.NewSelfSignedAuthority("test-ca", time.Hour, 10*time.Minute)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM([]byte(a.CertificatePem())))
+
+	return a, pool
+}
+
+func parse(t *testing.T, certPem string) *x509.Certificate {
+	block, _ := pem.Decode([]byte(certPem))
+	require.NotNil(t, block)
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+
+	return cert
+}
+
+func csr(t *testing.T, cn string) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: cn},
+	}, key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+}
+
+func TestAuthority_Issue(t *testing.T) {
+	a, pool := newAuthority(t)
+
+	t.Run("GeneratedKey", func(t *testing.T) {
+		issued, err := a.Issue(testNodeId, "")
+		require.NoError(t, err)
+
+		cert := parse(t, issued.Pem)
+		assert.Equal(t, testNodeId, cert.Subject.CommonName)
+		assert.Equal(t, issued.Serial, authority.SerialString(cert.SerialNumber))
+		assert.NotEmpty(t, issued.KeyPem)
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), issued.NotAfter, time.Minute)
+
+		_, err = cert.Verify(x509.VerifyOptions{Roots: pool, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Csr", func(t *testing.T) {
+		issued, err := a.Issue(testNodeId, csr(t, testNodeId))
+		require.NoError(t, err)
+
+		assert.Empty(t, issued.KeyPem)
+		assert.Equal(t, testNodeId, parse(t, issued.Pem).Subject.CommonName)
+	})
+
+	t.Run("CsrForAnotherNode", func(t *testing.T) {
+		_, err := a.Issue(testNodeId, csr(t, "uk-sa2341-hnode-v0-ffff"))
+
+		assert.True(t, errors.Is(err, authority.ErrNodeIdMismatch))
+	})
+
+	t.Run("InvalidCsr", func(t *testing.T) {
+		_, err := a.Issue(testNodeId, "not a csr")
+
+		assert.True(t, errors.Is(err, authority.ErrInvalidCsr))
+	})
+}
+
+func TestAuthority_RevocationList(t *testing.T) {
+	a, _ := newAuthority(t)
+
+	issued, err := a.Issue(testNodeId, "")
+	require.NoError(t, err)
+
+	now := time.Now()
+	crlPem, err := a.RevocationList([]authority.Revoked{{Serial: issued.Serial, RevokedAt: now}}, now, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	block, _ := pem.Decode([]byte(crlPem))
+	require.NotNil(t, block)
+
+	crl, err := x509.ParseRevocationList(block.Bytes)
+	require.NoError(t, err)
+
+	assert.NoError(t, crl.CheckSignatureFrom(parse(t, a.CertificatePem())))
+	require.Len(t, crl.RevokedCertificateEntries, 1)
+	assert.Equal(t, issued.Serial, authority.SerialString(crl.RevokedCertificateEntries[0].SerialNumber))
+}
+
+func TestNewAuthority_NotCa(t *testing.T) {
+	a, _ := newAuthority(t)
+
+	issued, err := a.Issue(testNodeId, "")
+	require.NoError(t, err)
+
+	_, err = authority.NewAuthority([]byte(issued.Pem), []byte(issued.KeyPem), time.Hour)
+	assert.Error(t, err)
+}
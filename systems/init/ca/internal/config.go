@@ -0,0 +1,61 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package internal
+
+import (
+	"time"
+
+	uconf "github.com/ukama/ukama/systems/common/config"
+)
+
+type Config struct {
+	uconf.BaseConfig `mapstructure:",squash"`
+	DB               *uconf.Database  `default:"{}"`
+	Grpc             *uconf.Grpc      `default:"{}"`
+	Queue            *uconf.Queue     `default:"{}"`
+	Metrics          *uconf.Metrics   `default:"{}"`
+	MsgClient        *uconf.MsgClient `default:"{}"`
+	Service          *uconf.Service
+	OrgName          string
+	Ca               CaConfig
+	Rotation         Rotation
+}
+
+type CaConfig struct {
+	CertFile string `default:"/etc/ca/ca.crt"`
+	KeyFile  string `default:"/etc/ca/ca.key"`
+	// Node certificates lifetime
+	Validity time.Duration `default:"720h"`
+	// How often the revocation list is published
+	CrlValidity time.Duration `default:"24h"`
+}
+
+type Rotation struct {
+	// Node certificates expiring within RenewBefore are rotated
+	RenewBefore time.Duration `default:"168h"`
+	// How often expiring certificates are looked for
+	Interval time.Duration `default:"1h"`
+	// Rotation is asked again if the node did not renew within RetryAfter
+	RetryAfter time.Duration `default:"12h"`
+	// Node endpoint the rotation request is sent to through the node-feeder
+	Path string `default:"/bootstrap/v1/rotate"`
+}
+
+func NewConfig(name string) *Config {
+	return &Config{
+		DB: &uconf.Database{
+			DbName: name,
+		},
+		Service: uconf.LoadServiceHostConfig(name),
+		MsgClient: &uconf.MsgClient{
+			Timeout:        5 * time.Second,
+			ListenerRoutes: []string{},
+		},
+	}
+}
@@ -0,0 +1,170 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"time"
+
+	"github.com/ukama/ukama/systems/common/sql"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"gorm.io/gorm"
+)
+
+type CertRepo interface {
+	Add(cert *Certificate) error
+	GetBySerial(serial string) (*Certificate, error)
+	List(nodeId string, expiresBefore *time.Time, includeRevoked bool) ([]Certificate, error)
+	ListRevoked(notExpiredAt time.Time) ([]Certificate, error)
+	ListToRotate(expiresBefore time.Time, requestedBefore time.Time) ([]Certificate, error)
+	Revoke(serial string, reason string) error
+	RevokeNode(nodeId string, reason string) (int64, error)
+	SetRotationRequested(id uuid.UUID, at time.Time) error
+}
+
+type certRepo struct {
+	Db sql.Db
+}
+
+func NewCertRepo(db sql.Db) *certRepo {
+	return &certRepo{
+		Db: db,
+	}
+}
+
+// Add stores a newly issued certificate, superseding the active certificate
+// of the node.
+func (r *certRepo) Add(cert *Certificate) error {
+	return r.Db.GetGormDb().Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Certificate{}).
+			Where("node_id = ? AND status = ?", cert.NodeId, CertActive).
+			Updates(map[string]interface{}{
+				"status":            CertSuperseded,
+				"revoked_at":        time.Now(),
+				"revocation_reason": RevocationSuperseded,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		return tx.Create(cert).Error
+	})
+}
+
+func (r *certRepo) GetBySerial(serial string) (*Certificate, error) {
+	var cert Certificate
+
+	result := r.Db.GetGormDb().Where("serial = ?", serial).First(&cert)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &cert, nil
+}
+
+// List returns certificates ordered by expiry, the soonest first.
+func (r *certRepo) List(nodeId string, expiresBefore *time.Time, includeRevoked bool) ([]Certificate, error) {
+	var certs []Certificate
+
+	tx := r.Db.GetGormDb()
+
+	if nodeId != "" {
+		tx = tx.Where("node_id = ?", nodeId)
+	}
+
+	if expiresBefore != nil {
+		tx = tx.Where("not_after < ?", *expiresBefore)
+	}
+
+	if !includeRevoked {
+		tx = tx.Where("status <> ?", CertRevoked)
+	}
+
+	result := tx.Order("not_after").Find(&certs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return certs, nil
+}
+
+// ListRevoked returns the revoked and superseded certificates that would
+// still be valid, the ones the revocation list has to carry.
+func (r *certRepo) ListRevoked(notExpiredAt time.Time) ([]Certificate, error) {
+	var certs []Certificate
+
+	result := r.Db.GetGormDb().Where("status IN ? AND not_after > ?", []CertStatus{CertSuperseded, CertRevoked}, notExpiredAt).
+		Order("revoked_at").Find(&certs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return certs, nil
+}
+
+// ListToRotate returns the active certificates expiring before the given time
+// whose rotation was never asked, or not since requestedBefore.
+func (r *certRepo) ListToRotate(expiresBefore time.Time, requestedBefore time.Time) ([]Certificate, error) {
+	var certs []Certificate
+
+	result := r.Db.GetGormDb().
+		Where("status = ? AND not_after < ?", CertActive, expiresBefore).
+		Where("rotation_requested_at IS NULL OR rotation_requested_at < ?", requestedBefore).
+		Order("not_after").Find(&certs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return certs, nil
+}
+
+func (r *certRepo) Revoke(serial string, reason string) error {
+	result := r.Db.GetGormDb().Model(&Certificate{}).
+		Where("serial = ? AND status <> ?", serial, CertRevoked).
+		Updates(map[string]interface{}{
+			"status":            CertRevoked,
+			"revoked_at":        time.Now(),
+			"revocation_reason": reason,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// RevokeNode revokes every certificate of the node and returns how many were.
+func (r *certRepo) RevokeNode(nodeId string, reason string) (int64, error) {
+	result := r.Db.GetGormDb().Model(&Certificate{}).
+		Where("node_id = ? AND status <> ?", nodeId, CertRevoked).
+		Updates(map[string]interface{}{
+			"status":            CertRevoked,
+			"revoked_at":        time.Now(),
+			"revocation_reason": reason,
+		})
+
+	return result.RowsAffected, result.Error
+}
+
+func (r *certRepo) SetRotationRequested(id uuid.UUID, at time.Time) error {
+	result := r.Db.GetGormDb().Model(&Certificate{}).Where("id = ?", id).
+		Update("rotation_requested_at", at)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
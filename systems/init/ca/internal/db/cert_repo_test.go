@@ -0,0 +1,174 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db_test
+
+import (
+	"log"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/ukama/ukama/systems/common/uuid"
+
+	int_db "github.com/ukama/ukama/systems/init/ca/internal/db"
+)
+
+const testNodeId = "uk-sa2341-hnode-v0-a1a0"
+
+type UkamaDbMock struct {
+	GormDb *gorm.DB
+}
+
+func (u UkamaDbMock) Init(model ...interface{}) error {
+	panic("implement me")
+}
+
+func (u UkamaDbMock) Connect() error {
+	panic("implement me")
+}
+
+func (u UkamaDbMock) GetGormDb() *gorm.DB {
+	return u.GormDb
+}
+
+func (u UkamaDbMock) InitDB() error {
+	return nil
+}
+
+func (u UkamaDbMock) ExecuteInTransaction(dbOperation func(tx *gorm.DB) *gorm.DB, nestedFuncs ...func() error) error {
+	log.Fatal("implement me")
+	return nil
+}
+
+func (u UkamaDbMock) ExecuteInTransaction2(dbOperation func(tx *gorm.DB) *gorm.DB, nestedFuncs ...func(tx *gorm.DB) error) (err error) {
+	log.Fatal("implement me")
+	return nil
+}
+
+func newRepo(t *testing.T) (sqlmock.Sqlmock, int_db.CertRepo) {
+	db, mock, err := sqlmock.New() // mock sql.DB
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		DSN:                  "sqlmock_db_0",
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+	gdb, err := gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(t, err)
+
+	return mock, int_db.NewCertRepo(&UkamaDbMock{
+		GormDb: gdb,
+	})
+}
+
+func Test_certRepo_Add(t *testing.T) {
+	// Arrange
+	mock, r := newRepo(t)
+	now := time.Now()
+
+	cert := &int_db.Certificate{
+		Id:        uuid.NewV4(),
+		Serial:    "0a1b",
+		NodeId:    testNodeId,
+		NotBefore: now,
+		NotAfter:  now.Add(time.Hour),
+		Pem:       "pem",
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "certificates" SET "revocation_reason"`)).
+		WithArgs(int_db.RevocationSuperseded, sqlmock.AnyArg(), int_db.CertSuperseded, sqlmock.AnyArg(), testNodeId, int_db.CertActive).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "certificates"`)).
+		WithArgs(cert.Id, cert.Serial, cert.NodeId, cert.Status, cert.NotBefore, cert.NotAfter, cert.Pem,
+			nil, "", nil, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Act
+	err := r.Add(cert)
+
+	// Assert
+	assert.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func Test_certRepo_Revoke(t *testing.T) {
+	t.Run("Revoked", func(t *testing.T) {
+		// Arrange
+		mock, r := newRepo(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "certificates"`)).
+			WithArgs("compromised", sqlmock.AnyArg(), int_db.CertRevoked, sqlmock.AnyArg(), "0a1b", int_db.CertRevoked).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		// Act
+		err := r.Revoke("0a1b", "compromised")
+
+		// Assert
+		assert.NoError(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		// Arrange
+		mock, r := newRepo(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "certificates"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		// Act
+		err := r.Revoke("0a1b", "compromised")
+
+		// Assert
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err)
+	})
+}
+
+func Test_certRepo_ListToRotate(t *testing.T) {
+	// Arrange
+	mock, r := newRepo(t)
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{"id", "serial", "node_id", "status", "not_after"}).
+		AddRow(uuid.NewV4(), "0a1b", testNodeId, int_db.CertActive, now.Add(time.Hour))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "certificates" WHERE (status = $1 AND not_after < $2) AND (rotation_requested_at IS NULL OR rotation_requested_at < $3) ORDER BY not_after`)).
+		WithArgs(int_db.CertActive, now.Add(24*time.Hour), now.Add(-time.Hour)).
+		WillReturnRows(rows)
+
+	// Act
+	certs, err := r.ListToRotate(now.Add(24*time.Hour), now.Add(-time.Hour))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, certs, 1)
+	assert.Equal(t, "0a1b", certs[0].Serial)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,56 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"time"
+
+	"github.com/ukama/ukama/systems/common/uuid"
+)
+
+type CertStatus uint8
+
+const (
+	CertActive CertStatus = iota
+	// Replaced by a newer certificate of the node, carried by the
+	// revocation list so that only the latest one is accepted
+	CertSuperseded
+	CertRevoked
+)
+
+func (s CertStatus) String() string {
+	switch s {
+	case CertActive:
+		return "active"
+	case CertSuperseded:
+		return "superseded"
+	case CertRevoked:
+		return "revoked"
+	default:
+		return "unknown"
+	}
+}
+
+// RevocationSuperseded is the revocation reason of superseded certificates
+const RevocationSuperseded = "superseded"
+
+type Certificate struct {
+	Id                  uuid.UUID  `gorm:"primaryKey;type:uuid"`
+	Serial              string     `gorm:"not null;uniqueIndex"`
+	NodeId              string     `gorm:"type:string;size:23;not null;index"`
+	Status              CertStatus `gorm:"type:uint;not null;default:0"`
+	NotBefore           time.Time  `gorm:"not null"`
+	NotAfter            time.Time  `gorm:"not null;index"`
+	Pem                 string     `gorm:"not null"`
+	RevokedAt           *time.Time
+	RevocationReason    string
+	RotationRequestedAt *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
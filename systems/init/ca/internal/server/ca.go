@@ -0,0 +1,446 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/ukama/ukama/systems/common/grpc"
+	mb "github.com/ukama/ukama/systems/common/msgBusServiceClient"
+	"github.com/ukama/ukama/systems/common/msgbus"
+	"github.com/ukama/ukama/systems/common/ukama"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/init/ca/internal"
+	"github.com/ukama/ukama/systems/init/ca/internal/authority"
+	"github.com/ukama/ukama/systems/init/ca/internal/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	pb "github.com/ukama/ukama/systems/init/ca/pb/gen"
+)
+
+const (
+	CertStatusExpired = "expired"
+
+	day = 24 * time.Hour
+)
+
+type RotationConfig struct {
+	// Certificates expiring within RenewBefore are rotated
+	RenewBefore time.Duration
+	// Rotation is asked again if the node did not renew within RetryAfter
+	RetryAfter time.Duration
+	// Node endpoint the rotation request is sent to
+	Path string
+}
+
+type CaServer struct {
+	certRepo       db.CertRepo
+	authority      *authority.Authority
+	msgbus         mb.MsgBusServiceClient
+	baseRoutingKey msgbus.RoutingKeyBuilder
+	orgName        string
+	crlValidity    time.Duration
+	rotation       RotationConfig
+	pb.UnimplementedCaServiceServer
+}
+
+type rotationRequest struct {
+	NodeId   string    `json:"nodeId"`
+	Serial   string    `json:"serial"`
+	NotAfter time.Time `json:"notAfter"`
+}
+
+func NewCaServer(certRepo db.CertRepo, authority *authority.Authority, msgBus mb.MsgBusServiceClient, orgName string,
+	crlValidity time.Duration, rotation RotationConfig) *CaServer {
+	return &CaServer{
+		certRepo:       certRepo,
+		authority:      authority,
+		msgbus:         msgBus,
+		orgName:        orgName,
+		crlValidity:    crlValidity,
+		rotation:       rotation,
+		baseRoutingKey: msgbus.NewRoutingKeyBuilder().SetCloudSource().SetSystem(internal.SystemName).SetOrgName(orgName).SetService(internal.ServiceName),
+	}
+}
+
+func (c *CaServer) IssueCertificate(ctx context.Context, req *pb.IssueCertificateRequest) (*pb.IssueCertificateResponse, error) {
+	log.Infof("Issuing certificate for node %s", req.GetNodeId())
+
+	nodeId, err := ukama.ValidateNodeId(req.NodeId)
+	if err != nil {
+		return nil, invalidNodeIdError(req.NodeId, err)
+	}
+
+	issued, err := c.authority.Issue(nodeId.StringLowercase(), req.Csr)
+	if err != nil {
+		if errors.Is(err, authority.ErrInvalidCsr) || errors.Is(err, authority.ErrNodeIdMismatch) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		log.Errorf("Failed to issue certificate for node %s: %v", req.NodeId, err)
+
+		return nil, status.Errorf(codes.Internal, "failed to issue certificate: %v", err)
+	}
+
+	cert := &db.Certificate{
+		Id:        uuid.NewV4(),
+		Serial:    issued.Serial,
+		NodeId:    issued.NodeId,
+		Status:    db.CertActive,
+		NotBefore: issued.NotBefore,
+		NotAfter:  issued.NotAfter,
+		Pem:       issued.Pem,
+	}
+
+	err = c.certRepo.Add(cert)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "certificate")
+	}
+
+	resp := certToPb(cert, time.Now())
+	c.publishEvent("issue", resp)
+
+	return &pb.IssueCertificateResponse{
+		Certificate:      resp,
+		CertificatePem:   issued.Pem,
+		PrivateKeyPem:    issued.KeyPem,
+		CaCertificatePem: c.authority.CertificatePem(),
+	}, nil
+}
+
+func (c *CaServer) RevokeCertificate(ctx context.Context, req *pb.RevokeCertificateRequest) (*pb.RevokeCertificateResponse, error) {
+	log.Infof("Revoking certificate %s", req.GetSerial())
+
+	cert, err := c.certRepo.GetBySerial(req.Serial)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "certificate")
+	}
+
+	if cert.Status != db.CertRevoked {
+		err = c.certRepo.Revoke(req.Serial, req.Reason)
+		if err != nil {
+			return nil, grpc.SqlErrorToGrpc(err, "certificate")
+		}
+
+		cert, err = c.certRepo.GetBySerial(req.Serial)
+		if err != nil {
+			return nil, grpc.SqlErrorToGrpc(err, "certificate")
+		}
+
+		c.publishEvent("revoke", certToPb(cert, time.Now()))
+	}
+
+	return &pb.RevokeCertificateResponse{
+		Certificate: certToPb(cert, time.Now()),
+	}, nil
+}
+
+func (c *CaServer) RevokeNodeCertificates(ctx context.Context, req *pb.RevokeNodeCertificatesRequest) (*pb.RevokeNodeCertificatesResponse, error) {
+	log.Infof("Revoking certificates of node %s", req.GetNodeId())
+
+	nodeId, err := ukama.ValidateNodeId(req.NodeId)
+	if err != nil {
+		return nil, invalidNodeIdError(req.NodeId, err)
+	}
+
+	valid, err := c.certRepo.List(nodeId.StringLowercase(), nil, false)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "certificate")
+	}
+
+	_, err = c.certRepo.RevokeNode(nodeId.StringLowercase(), req.Reason)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "certificate")
+	}
+
+	certs, err := c.certRepo.List(nodeId.StringLowercase(), nil, true)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "certificate")
+	}
+
+	now := time.Now()
+	resp := &pb.RevokeNodeCertificatesResponse{
+		Certificates: certsToPb(certs, now),
+	}
+
+	revoked := make(map[string]bool, len(valid))
+	for _, cert := range valid {
+		revoked[cert.Serial] = true
+	}
+
+	for i := range certs {
+		if revoked[certs[i].Serial] {
+			c.publishEvent("revoke", certToPb(&certs[i], now))
+		}
+	}
+
+	return resp, nil
+}
+
+// VerifyNode succeeds if the certificate the node presented is its latest one
+// and is not expired. Without a serial, the node must hold such a certificate.
+// Nodes that never had one are reported as not found so that the mesh can
+// tell them apart from revoked ones.
+func (c *CaServer) VerifyNode(ctx context.Context, req *pb.VerifyNodeRequest) (*pb.VerifyNodeResponse, error) {
+	nodeId, err := ukama.ValidateNodeId(req.NodeId)
+	if err != nil {
+		return nil, invalidNodeIdError(req.NodeId, err)
+	}
+
+	if req.Serial != "" {
+		return c.verifySerial(nodeId.StringLowercase(), strings.ToLower(req.Serial))
+	}
+
+	certs, err := c.certRepo.List(nodeId.StringLowercase(), nil, true)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "certificate")
+	}
+
+	if len(certs) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no certificate issued for node %s", req.NodeId)
+	}
+
+	now := time.Now()
+
+	/* Sorted by expiry, so the last valid one lasts the longest */
+	for i := len(certs) - 1; i >= 0; i-- {
+		if certs[i].Status == db.CertActive && certs[i].NotAfter.After(now) {
+			return &pb.VerifyNodeResponse{
+				NodeId:      nodeId.StringLowercase(),
+				Certificate: certToPb(&certs[i], now),
+			}, nil
+		}
+	}
+
+	log.Warnf("Node %s has no valid certificate", req.NodeId)
+
+	return nil, status.Errorf(codes.PermissionDenied, "certificates of node %s are revoked or expired", req.NodeId)
+}
+
+func (c *CaServer) verifySerial(nodeId string, serial string) (*pb.VerifyNodeResponse, error) {
+	cert, err := c.certRepo.GetBySerial(serial)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Warnf("Node %s presented certificate %s not issued by the CA", nodeId, serial)
+
+			return nil, status.Errorf(codes.PermissionDenied, "certificate %s is not issued for node %s", serial, nodeId)
+		}
+
+		return nil, grpc.SqlErrorToGrpc(err, "certificate")
+	}
+
+	if cert.NodeId != nodeId {
+		log.Warnf("Node %s presented certificate %s of node %s", nodeId, serial, cert.NodeId)
+
+		return nil, status.Errorf(codes.PermissionDenied, "certificate %s is not issued for node %s", serial, nodeId)
+	}
+
+	now := time.Now()
+
+	if cert.Status != db.CertActive || !cert.NotAfter.After(now) {
+		log.Warnf("Certificate %s of node %s is %s", serial, nodeId, certStatus(cert, now))
+
+		return nil, status.Errorf(codes.PermissionDenied, "certificate %s of node %s is %s", serial, nodeId, certStatus(cert, now))
+	}
+
+	return &pb.VerifyNodeResponse{
+		NodeId:      nodeId,
+		Certificate: certToPb(cert, now),
+	}, nil
+}
+
+func (c *CaServer) GetRevocationList(ctx context.Context, req *pb.GetRevocationListRequest) (*pb.GetRevocationListResponse, error) {
+	now := time.Now()
+
+	certs, err := c.certRepo.ListRevoked(now)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "certificate")
+	}
+
+	revoked := make([]authority.Revoked, 0, len(certs))
+	for _, cert := range certs {
+		r := authority.Revoked{Serial: cert.Serial, RevokedAt: cert.UpdatedAt}
+		if cert.RevokedAt != nil {
+			r.RevokedAt = *cert.RevokedAt
+		}
+
+		revoked = append(revoked, r)
+	}
+
+	nextUpdate := now.Add(c.crlValidity)
+
+	crl, err := c.authority.RevocationList(revoked, now, nextUpdate)
+	if err != nil {
+		log.Errorf("Failed to create revocation list: %v", err)
+
+		return nil, status.Errorf(codes.Internal, "failed to create revocation list: %v", err)
+	}
+
+	return &pb.GetRevocationListResponse{
+		CrlPem:       crl,
+		Certificates: certsToPb(certs, now),
+		NextUpdate:   timestamppb.New(nextUpdate),
+	}, nil
+}
+
+func (c *CaServer) ListCertificates(ctx context.Context, req *pb.ListCertificatesRequest) (*pb.ListCertificatesResponse, error) {
+	nodeId := ""
+
+	if req.NodeId != "" {
+		id, err := ukama.ValidateNodeId(req.NodeId)
+		if err != nil {
+			return nil, invalidNodeIdError(req.NodeId, err)
+		}
+
+		nodeId = id.StringLowercase()
+	}
+
+	now := time.Now()
+
+	var expiresBefore *time.Time
+	if req.ExpiresWithinDays > 0 {
+		t := now.Add(time.Duration(req.ExpiresWithinDays) * day)
+		expiresBefore = &t
+	}
+
+	certs, err := c.certRepo.List(nodeId, expiresBefore, req.IncludeRevoked)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "certificate")
+	}
+
+	return &pb.ListCertificatesResponse{
+		Certificates: certsToPb(certs, now),
+	}, nil
+}
+
+// RotateExpiring asks the nodes whose certificate expires soon to renew it
+// through the node-feeder. Nodes renew by bootstrapping again, which issues a
+// new certificate and supersedes the expiring one.
+func (c *CaServer) RotateExpiring() {
+	now := time.Now()
+
+	certs, err := c.certRepo.ListToRotate(now.Add(c.rotation.RenewBefore), now.Add(-c.rotation.RetryAfter))
+	if err != nil {
+		log.Errorf("Failed to get certificates to rotate: %v", err)
+
+		return
+	}
+
+	for i := range certs {
+		cert := &certs[i]
+
+		if cert.NotAfter.Before(now) {
+			log.Warnf("Certificate %s of node %s expired on %s", cert.Serial, cert.NodeId, cert.NotAfter)
+		} else {
+			log.Infof("Certificate %s of node %s expires on %s, asking for rotation", cert.Serial, cert.NodeId, cert.NotAfter)
+		}
+
+		err = c.requestRotation(cert)
+		if err != nil {
+			log.Errorf("Failed to request rotation of certificate %s of node %s: %v", cert.Serial, cert.NodeId, err)
+
+			continue
+		}
+
+		err = c.certRepo.SetRotationRequested(cert.Id, now)
+		if err != nil {
+			log.Errorf("Failed to record rotation request of certificate %s: %v", cert.Serial, err)
+
+			continue
+		}
+
+		cert.RotationRequestedAt = &now
+		c.publishEvent("rotate", certToPb(cert, now))
+	}
+}
+
+func (c *CaServer) requestRotation(cert *db.Certificate) error {
+	body, err := json.Marshal(rotationRequest{
+		NodeId:   cert.NodeId,
+		Serial:   cert.Serial,
+		NotAfter: cert.NotAfter,
+	})
+	if err != nil {
+		return err
+	}
+
+	route := "request.cloud.local" + "." + c.orgName + "." + internal.SystemName + "." + internal.ServiceName + "." + "nodefeeder" + "." + "publish"
+	msg := &epb.NodeFeederMessage{
+		Target:     c.orgName + "." + "." + "." + cert.NodeId,
+		HttpMethod: http.MethodPost,
+		Path:       c.rotation.Path,
+		Msg:        body,
+		NodeId:     cert.NodeId,
+	}
+
+	return c.msgbus.PublishRequest(route, msg)
+}
+
+func (c *CaServer) publishEvent(action string, msg proto.Message) {
+	route := c.baseRoutingKey.SetAction(action).SetObject("certificate").MustBuild()
+
+	err := c.msgbus.PublishRequest(route, msg)
+	if err != nil {
+		log.Errorf("Failed to publish message %+v with key %+v. Errors %s", msg, route, err.Error())
+	}
+}
+
+func invalidNodeIdError(nodeId string, err error) error {
+	return status.Errorf(codes.InvalidArgument, "invalid node id %s. Error %s", nodeId, err.Error())
+}
+
+func certStatus(cert *db.Certificate, now time.Time) string {
+	if cert.Status != db.CertRevoked && !cert.NotAfter.After(now) {
+		return CertStatusExpired
+	}
+
+	return cert.Status.String()
+}
+
+func certToPb(cert *db.Certificate, now time.Time) *pb.Certificate {
+	c := &pb.Certificate{
+		Serial:           cert.Serial,
+		NodeId:           cert.NodeId,
+		Status:           certStatus(cert, now),
+		NotBefore:        timestamppb.New(cert.NotBefore),
+		NotAfter:         timestamppb.New(cert.NotAfter),
+		RevocationReason: cert.RevocationReason,
+	}
+
+	if cert.RevokedAt != nil {
+		c.RevokedAt = timestamppb.New(*cert.RevokedAt)
+	}
+
+	if cert.RotationRequestedAt != nil {
+		c.RotationRequestedAt = timestamppb.New(*cert.RotationRequestedAt)
+	}
+
+	return c
+}
+
+func certsToPb(certs []db.Certificate, now time.Time) []*pb.Certificate {
+	res := make([]*pb.Certificate, 0, len(certs))
+
+	for i := range certs {
+		res = append(res, certToPb(&certs[i], now))
+	}
+
+	return res
+}
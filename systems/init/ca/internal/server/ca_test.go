@@ -0,0 +1,309 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	cmocks "github.com/ukama/ukama/systems/common/mocks"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/init/ca/internal/authority"
+	"github.com/ukama/ukama/systems/init/ca/internal/db"
+	"github.com/ukama/ukama/systems/init/ca/mocks"
+
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	pb "github.com/ukama/ukama/systems/init/ca/pb/gen"
+)
+
+const (
+	orgName    = "test-org"
+	testNodeId = "uk-sa2341-hnode-v0-a1a0"
+)
+
+var rotation = RotationConfig{
+	RenewBefore: 7 * day,
+	RetryAfter:  12 * time.Hour,
+	Path:        "/bootstrap/v1/rotate",
+}
+
+func newServer(t *testing.T) (*CaServer, *mocks.CertRepo, *cmocks.MsgBusServiceClient) {
+	a, err := authority.NewSelfSignedAuthority("test-ca", time.Hour, 30*time.Minute)
+	require.NoError(t, err)
+
+	certRepo := &mocks.CertRepo{}
+	msgbus := &cmocks.MsgBusServiceClient{}
+
+	return NewCaServer(certRepo, a, msgbus, orgName, time.Hour, rotation), certRepo, msgbus
+}
+
+func TestCaServer_IssueCertificate(t *testing.T) {
+	t.Run("Issued", func(t *testing.T) {
+		s, certRepo, msgbus := newServer(t)
+
+		certRepo.On("Add", mock.MatchedBy(func(c *db.Certificate) bool {
+			return c.NodeId == testNodeId && c.Status == db.CertActive && c.Serial != "" && c.Pem != ""
+		})).Return(nil).Once()
+		msgbus.On("PublishRequest", "event.cloud.local.testorg.init.ca.certificate.issue",
+			mock.AnythingOfType("*gen.Certificate")).Return(nil).Once()
+
+		resp, err := s.IssueCertificate(context.TODO(), &pb.IssueCertificateRequest{NodeId: testNodeId})
+
+		assert.NoError(t, err)
+		assert.Equal(t, testNodeId, resp.Certificate.NodeId)
+		assert.Equal(t, "active", resp.Certificate.Status)
+		assert.NotEmpty(t, resp.CertificatePem)
+		assert.NotEmpty(t, resp.PrivateKeyPem)
+		assert.NotEmpty(t, resp.CaCertificatePem)
+		certRepo.AssertExpectations(t)
+		msgbus.AssertExpectations(t)
+	})
+
+	t.Run("InvalidCsr", func(t *testing.T) {
+		s, certRepo, _ := newServer(t)
+
+		_, err := s.IssueCertificate(context.TODO(), &pb.IssueCertificateRequest{NodeId: testNodeId, Csr: "junk"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		certRepo.AssertNotCalled(t, "Add", mock.Anything)
+	})
+
+	t.Run("InvalidNodeId", func(t *testing.T) {
+		s, _, _ := newServer(t)
+
+		_, err := s.IssueCertificate(context.TODO(), &pb.IssueCertificateRequest{NodeId: "node"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestCaServer_RevokeCertificate(t *testing.T) {
+	t.Run("Revoked", func(t *testing.T) {
+		s, certRepo, msgbus := newServer(t)
+		now := time.Now()
+
+		certRepo.On("GetBySerial", "0a1b").Return(&db.Certificate{Serial: "0a1b", NodeId: testNodeId, NotAfter: now.Add(time.Hour)}, nil).Once()
+		certRepo.On("Revoke", "0a1b", "compromised").Return(nil).Once()
+		certRepo.On("GetBySerial", "0a1b").Return(&db.Certificate{Serial: "0a1b", NodeId: testNodeId, NotAfter: now.Add(time.Hour),
+			Status: db.CertRevoked, RevokedAt: &now, RevocationReason: "compromised"}, nil).Once()
+		msgbus.On("PublishRequest", "event.cloud.local.testorg.init.ca.certificate.revoke",
+			mock.AnythingOfType("*gen.Certificate")).Return(nil).Once()
+
+		resp, err := s.RevokeCertificate(context.TODO(), &pb.RevokeCertificateRequest{Serial: "0a1b", Reason: "compromised"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "revoked", resp.Certificate.Status)
+		assert.Equal(t, "compromised", resp.Certificate.RevocationReason)
+		certRepo.AssertExpectations(t)
+		msgbus.AssertExpectations(t)
+	})
+
+	t.Run("AlreadyRevoked", func(t *testing.T) {
+		s, certRepo, msgbus := newServer(t)
+
+		certRepo.On("GetBySerial", "0a1b").Return(&db.Certificate{Serial: "0a1b", Status: db.CertRevoked}, nil).Once()
+
+		resp, err := s.RevokeCertificate(context.TODO(), &pb.RevokeCertificateRequest{Serial: "0a1b"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "revoked", resp.Certificate.Status)
+		certRepo.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything)
+		msgbus.AssertNotCalled(t, "PublishRequest", mock.Anything, mock.Anything)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		s, certRepo, _ := newServer(t)
+
+		certRepo.On("GetBySerial", "0a1b").Return(nil, gorm.ErrRecordNotFound).Once()
+
+		_, err := s.RevokeCertificate(context.TODO(), &pb.RevokeCertificateRequest{Serial: "0a1b"})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestCaServer_VerifyNode(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Valid", func(t *testing.T) {
+		s, certRepo, _ := newServer(t)
+
+		certRepo.On("List", testNodeId, (*time.Time)(nil), true).Return([]db.Certificate{
+			{Serial: "01", NodeId: testNodeId, Status: db.CertSuperseded, NotAfter: now.Add(time.Hour)},
+			{Serial: "02", NodeId: testNodeId, Status: db.CertActive, NotAfter: now.Add(2 * time.Hour)},
+		}, nil).Once()
+
+		resp, err := s.VerifyNode(context.TODO(), &pb.VerifyNodeRequest{NodeId: testNodeId})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "02", resp.Certificate.Serial)
+	})
+
+	t.Run("RevokedOrExpired", func(t *testing.T) {
+		s, certRepo, _ := newServer(t)
+
+		certRepo.On("List", testNodeId, (*time.Time)(nil), true).Return([]db.Certificate{
+			{Serial: "01", NodeId: testNodeId, Status: db.CertSuperseded, NotAfter: now.Add(-time.Hour)},
+			{Serial: "02", NodeId: testNodeId, Status: db.CertRevoked, NotAfter: now.Add(time.Hour)},
+		}, nil).Once()
+
+		_, err := s.VerifyNode(context.TODO(), &pb.VerifyNodeRequest{NodeId: testNodeId})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("SupersededOnly", func(t *testing.T) {
+		s, certRepo, _ := newServer(t)
+
+		certRepo.On("List", testNodeId, (*time.Time)(nil), true).Return([]db.Certificate{
+			{Serial: "01", NodeId: testNodeId, Status: db.CertSuperseded, NotAfter: now.Add(time.Hour)},
+		}, nil).Once()
+
+		_, err := s.VerifyNode(context.TODO(), &pb.VerifyNodeRequest{NodeId: testNodeId})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("PresentedLatest", func(t *testing.T) {
+		s, certRepo, _ := newServer(t)
+
+		certRepo.On("GetBySerial", "0a1b").Return(&db.Certificate{
+			Serial: "0a1b", NodeId: testNodeId, Status: db.CertActive, NotAfter: now.Add(time.Hour),
+		}, nil).Once()
+
+		resp, err := s.VerifyNode(context.TODO(), &pb.VerifyNodeRequest{NodeId: testNodeId, Serial: "0A1B"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "0a1b", resp.Certificate.Serial)
+	})
+
+	t.Run("PresentedSuperseded", func(t *testing.T) {
+		s, certRepo, _ := newServer(t)
+
+		certRepo.On("GetBySerial", "0a1b").Return(&db.Certificate{
+			Serial: "0a1b", NodeId: testNodeId, Status: db.CertSuperseded, NotAfter: now.Add(time.Hour),
+		}, nil).Once()
+
+		_, err := s.VerifyNode(context.TODO(), &pb.VerifyNodeRequest{NodeId: testNodeId, Serial: "0a1b"})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("PresentedOtherNode", func(t *testing.T) {
+		s, certRepo, _ := newServer(t)
+
+		certRepo.On("GetBySerial", "0a1b").Return(&db.Certificate{
+			Serial: "0a1b", NodeId: "uk-sa9999-hnode-a1-0000", Status: db.CertActive, NotAfter: now.Add(time.Hour),
+		}, nil).Once()
+
+		_, err := s.VerifyNode(context.TODO(), &pb.VerifyNodeRequest{NodeId: testNodeId, Serial: "0a1b"})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("PresentedUnknown", func(t *testing.T) {
+		s, certRepo, _ := newServer(t)
+
+		certRepo.On("GetBySerial", "0a1b").Return(nil, gorm.ErrRecordNotFound).Once()
+
+		_, err := s.VerifyNode(context.TODO(), &pb.VerifyNodeRequest{NodeId: testNodeId, Serial: "0a1b"})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("NeverIssued", func(t *testing.T) {
+		s, certRepo, _ := newServer(t)
+
+		certRepo.On("List", testNodeId, (*time.Time)(nil), true).Return([]db.Certificate{}, nil).Once()
+
+		_, err := s.VerifyNode(context.TODO(), &pb.VerifyNodeRequest{NodeId: testNodeId})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestCaServer_GetRevocationList(t *testing.T) {
+	s, certRepo, _ := newServer(t)
+	now := time.Now()
+
+	certRepo.On("ListRevoked", mock.AnythingOfType("time.Time")).Return([]db.Certificate{
+		{Serial: "0a1b", NodeId: testNodeId, Status: db.CertRevoked, RevokedAt: &now, NotAfter: now.Add(time.Hour)},
+	}, nil).Once()
+
+	resp, err := s.GetRevocationList(context.TODO(), &pb.GetRevocationListRequest{})
+
+	assert.NoError(t, err)
+	assert.Contains(t, resp.CrlPem, "X509 CRL")
+	assert.Len(t, resp.Certificates, 1)
+	assert.True(t, resp.NextUpdate.AsTime().After(now))
+}
+
+func TestCaServer_ListCertificates(t *testing.T) {
+	s, certRepo, _ := newServer(t)
+	now := time.Now()
+
+	certRepo.On("List", "", mock.MatchedBy(func(before *time.Time) bool {
+		return before != nil && before.Sub(now) > 29*day && before.Sub(now) <= 30*day+time.Minute
+	}), false).Return([]db.Certificate{
+		{Serial: "01", NodeId: testNodeId, Status: db.CertActive, NotAfter: now.Add(-time.Hour)},
+		{Serial: "02", NodeId: testNodeId, Status: db.CertActive, NotAfter: now.Add(day)},
+	}, nil).Once()
+
+	resp, err := s.ListCertificates(context.TODO(), &pb.ListCertificatesRequest{ExpiresWithinDays: 30})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Certificates, 2)
+	assert.Equal(t, CertStatusExpired, resp.Certificates[0].Status)
+	assert.Equal(t, "active", resp.Certificates[1].Status)
+	certRepo.AssertExpectations(t)
+}
+
+func TestCaServer_RotateExpiring(t *testing.T) {
+	s, certRepo, msgbus := newServer(t)
+	now := time.Now()
+
+	cert := db.Certificate{Id: uuid.NewV4(), Serial: "0a1b", NodeId: testNodeId, Status: db.CertActive, NotAfter: now.Add(day)}
+	failing := db.Certificate{Id: uuid.NewV4(), Serial: "0c1d", NodeId: "uk-sa2341-hnode-v0-ffff", Status: db.CertActive, NotAfter: now.Add(2 * day)}
+
+	certRepo.On("ListToRotate", mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+		Return([]db.Certificate{cert, failing}, nil).Once()
+
+	msgbus.On("PublishRequest", "request.cloud.local.test-org.Init.ca.nodefeeder.publish",
+		mock.MatchedBy(func(m *epb.NodeFeederMessage) bool {
+			var body rotationRequest
+
+			return m.NodeId == testNodeId && m.Target == "test-org..."+testNodeId &&
+				m.Path == rotation.Path && m.HttpMethod == "POST" &&
+				json.Unmarshal(m.Msg, &body) == nil && body.Serial == cert.Serial
+		})).Return(nil).Once()
+	msgbus.On("PublishRequest", "request.cloud.local.test-org.Init.ca.nodefeeder.publish",
+		mock.MatchedBy(func(m *epb.NodeFeederMessage) bool {
+			return m.NodeId == failing.NodeId
+		})).Return(status.Error(codes.Unavailable, "msgclient down")).Once()
+	certRepo.On("SetRotationRequested", cert.Id, mock.AnythingOfType("time.Time")).Return(nil).Once()
+	msgbus.On("PublishRequest", "event.cloud.local.testorg.init.ca.certificate.rotate",
+		mock.MatchedBy(func(c *pb.Certificate) bool {
+			return c.Serial == cert.Serial && c.RotationRequestedAt != nil
+		})).Return(nil).Once()
+
+	s.RotateExpiring()
+
+	certRepo.AssertExpectations(t)
+	msgbus.AssertExpectations(t)
+	certRepo.AssertNotCalled(t, "SetRotationRequested", failing.Id, mock.Anything)
+}
@@ -0,0 +1,233 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	db "github.com/ukama/ukama/systems/init/ca/internal/db"
+
+	time "time"
+
+	uuid "github.com/ukama/ukama/systems/common/uuid"
+)
+
+// CertRepo is an autogenerated mock type for the CertRepo type
+type CertRepo struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: cert
+func (_m *CertRepo) Add(cert *db.Certificate) error {
+	ret := _m.Called(cert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.Certificate) error); ok {
+		r0 = rf(cert)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetBySerial provides a mock function with given fields: serial
+func (_m *CertRepo) GetBySerial(serial string) (*db.Certificate, error) {
+	ret := _m.Called(serial)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBySerial")
+	}
+
+	var r0 *db.Certificate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*db.Certificate, error)); ok {
+		return rf(serial)
+	}
+	if rf, ok := ret.Get(0).(func(string) *db.Certificate); ok {
+		r0 = rf(serial)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Certificate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(serial)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: nodeId, expiresBefore, includeRevoked
+func (_m *CertRepo) List(nodeId string, expiresBefore *time.Time, includeRevoked bool) ([]db.Certificate, error) {
+	ret := _m.Called(nodeId, expiresBefore, includeRevoked)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []db.Certificate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *time.Time, bool) ([]db.Certificate, error)); ok {
+		return rf(nodeId, expiresBefore, includeRevoked)
+	}
+	if rf, ok := ret.Get(0).(func(string, *time.Time, bool) []db.Certificate); ok {
+		r0 = rf(nodeId, expiresBefore, includeRevoked)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Certificate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *time.Time, bool) error); ok {
+		r1 = rf(nodeId, expiresBefore, includeRevoked)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRevoked provides a mock function with given fields: notExpiredAt
+func (_m *CertRepo) ListRevoked(notExpiredAt time.Time) ([]db.Certificate, error) {
+	ret := _m.Called(notExpiredAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRevoked")
+	}
+
+	var r0 []db.Certificate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]db.Certificate, error)); ok {
+		return rf(notExpiredAt)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []db.Certificate); ok {
+		r0 = rf(notExpiredAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Certificate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(notExpiredAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListToRotate provides a mock function with given fields: expiresBefore, requestedBefore
+func (_m *CertRepo) ListToRotate(expiresBefore time.Time, requestedBefore time.Time) ([]db.Certificate, error) {
+	ret := _m.Called(expiresBefore, requestedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListToRotate")
+	}
+
+	var r0 []db.Certificate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time) ([]db.Certificate, error)); ok {
+		return rf(expiresBefore, requestedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time) []db.Certificate); ok {
+		r0 = rf(expiresBefore, requestedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Certificate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, time.Time) error); ok {
+		r1 = rf(expiresBefore, requestedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Revoke provides a mock function with given fields: serial, reason
+func (_m *CertRepo) Revoke(serial string, reason string) error {
+	ret := _m.Called(serial, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(serial, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeNode provides a mock function with given fields: nodeId, reason
+func (_m *CertRepo) RevokeNode(nodeId string, reason string) (int64, error) {
+	ret := _m.Called(nodeId, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeNode")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (int64, error)); ok {
+		return rf(nodeId, reason)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) int64); ok {
+		r0 = rf(nodeId, reason)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(nodeId, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetRotationRequested provides a mock function with given fields: id, at
+func (_m *CertRepo) SetRotationRequested(id uuid.UUID, at time.Time) error {
+	ret := _m.Called(id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRotationRequested")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) error); ok {
+		r0 = rf(id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCertRepo creates a new instance of CertRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCertRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CertRepo {
+	mock := &CertRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,894 @@
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2026-present, Ukama Inc.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        v6.32.1
+// source: ca.proto
+
+package gen
+
+import (
+	_ "github.com/mwitkow/go-proto-validators"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Certificate struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Serial              string                 `protobuf:"bytes,1,opt,name=serial,proto3" json:"serial,omitempty"`
+	NodeId              string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	Status              string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	NotBefore           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=notBefore,proto3" json:"notBefore,omitempty"`
+	NotAfter            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=notAfter,proto3" json:"notAfter,omitempty"`
+	RevokedAt           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=revokedAt,proto3" json:"revokedAt,omitempty"`
+	RevocationReason    string                 `protobuf:"bytes,7,opt,name=revocationReason,proto3" json:"revocationReason,omitempty"`
+	RotationRequestedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=rotationRequestedAt,proto3" json:"rotationRequestedAt,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Certificate) Reset() {
+	*x = Certificate{}
+	mi := &file_ca_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Certificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Certificate) ProtoMessage() {}
+
+func (x *Certificate) ProtoReflect() protoreflect.Message {
+	mi := &file_ca_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Certificate.ProtoReflect.Descriptor instead.
+func (*Certificate) Descriptor() ([]byte, []int) {
+	return file_ca_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Certificate) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *Certificate) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *Certificate) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Certificate) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *Certificate) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+func (x *Certificate) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *Certificate) GetRevocationReason() string {
+	if x != nil {
+		return x.RevocationReason
+	}
+	return ""
+}
+
+func (x *Certificate) GetRotationRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RotationRequestedAt
+	}
+	return nil
+}
+
+type IssueCertificateRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NodeId string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	// PEM encoded CSR. A key is generated for the node when empty
+	Csr           string `protobuf:"bytes,2,opt,name=csr,proto3" json:"csr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueCertificateRequest) Reset() {
+	*x = IssueCertificateRequest{}
+	mi := &file_ca_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCertificateRequest) ProtoMessage() {}
+
+func (x *IssueCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ca_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCertificateRequest.ProtoReflect.Descriptor instead.
+func (*IssueCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_ca_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IssueCertificateRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *IssueCertificateRequest) GetCsr() string {
+	if x != nil {
+		return x.Csr
+	}
+	return ""
+}
+
+type IssueCertificateResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Certificate      *Certificate           `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	CertificatePem   string                 `protobuf:"bytes,2,opt,name=certificatePem,proto3" json:"certificatePem,omitempty"`
+	PrivateKeyPem    string                 `protobuf:"bytes,3,opt,name=privateKeyPem,proto3" json:"privateKeyPem,omitempty"`
+	CaCertificatePem string                 `protobuf:"bytes,4,opt,name=caCertificatePem,proto3" json:"caCertificatePem,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *IssueCertificateResponse) Reset() {
+	*x = IssueCertificateResponse{}
+	mi := &file_ca_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCertificateResponse) ProtoMessage() {}
+
+func (x *IssueCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ca_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCertificateResponse.ProtoReflect.Descriptor instead.
+func (*IssueCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_ca_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IssueCertificateResponse) GetCertificate() *Certificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *IssueCertificateResponse) GetCertificatePem() string {
+	if x != nil {
+		return x.CertificatePem
+	}
+	return ""
+}
+
+func (x *IssueCertificateResponse) GetPrivateKeyPem() string {
+	if x != nil {
+		return x.PrivateKeyPem
+	}
+	return ""
+}
+
+func (x *IssueCertificateResponse) GetCaCertificatePem() string {
+	if x != nil {
+		return x.CaCertificatePem
+	}
+	return ""
+}
+
+type RevokeCertificateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Serial        string                 `protobuf:"bytes,1,opt,name=serial,proto3" json:"serial,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCertificateRequest) Reset() {
+	*x = RevokeCertificateRequest{}
+	mi := &file_ca_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCertificateRequest) ProtoMessage() {}
+
+func (x *RevokeCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ca_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCertificateRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_ca_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RevokeCertificateRequest) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *RevokeCertificateRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RevokeCertificateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificate   *Certificate           `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCertificateResponse) Reset() {
+	*x = RevokeCertificateResponse{}
+	mi := &file_ca_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCertificateResponse) ProtoMessage() {}
+
+func (x *RevokeCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ca_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCertificateResponse.ProtoReflect.Descriptor instead.
+func (*RevokeCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_ca_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RevokeCertificateResponse) GetCertificate() *Certificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+type RevokeNodeCertificatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeNodeCertificatesRequest) Reset() {
+	*x = RevokeNodeCertificatesRequest{}
+	mi := &file_ca_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeNodeCertificatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeNodeCertificatesRequest) ProtoMessage() {}
+
+func (x *RevokeNodeCertificatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ca_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeNodeCertificatesRequest.ProtoReflect.Descriptor instead.
+func (*RevokeNodeCertificatesRequest) Descriptor() ([]byte, []int) {
+	return file_ca_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RevokeNodeCertificatesRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *RevokeNodeCertificatesRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RevokeNodeCertificatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificates  []*Certificate         `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeNodeCertificatesResponse) Reset() {
+	*x = RevokeNodeCertificatesResponse{}
+	mi := &file_ca_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeNodeCertificatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeNodeCertificatesResponse) ProtoMessage() {}
+
+func (x *RevokeNodeCertificatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ca_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeNodeCertificatesResponse.ProtoReflect.Descriptor instead.
+func (*RevokeNodeCertificatesResponse) Descriptor() ([]byte, []int) {
+	return file_ca_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RevokeNodeCertificatesResponse) GetCertificates() []*Certificate {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+type VerifyNodeRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NodeId string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	// serial of the certificate the node presented, only that one is checked when set
+	Serial        string `protobuf:"bytes,2,opt,name=serial,proto3" json:"serial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyNodeRequest) Reset() {
+	*x = VerifyNodeRequest{}
+	mi := &file_ca_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyNodeRequest) ProtoMessage() {}
+
+func (x *VerifyNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ca_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyNodeRequest.ProtoReflect.Descriptor instead.
+func (*VerifyNodeRequest) Descriptor() ([]byte, []int) {
+	return file_ca_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VerifyNodeRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *VerifyNodeRequest) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+type VerifyNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	Certificate   *Certificate           `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyNodeResponse) Reset() {
+	*x = VerifyNodeResponse{}
+	mi := &file_ca_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyNodeResponse) ProtoMessage() {}
+
+func (x *VerifyNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ca_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyNodeResponse.ProtoReflect.Descriptor instead.
+func (*VerifyNodeResponse) Descriptor() ([]byte, []int) {
+	return file_ca_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VerifyNodeResponse) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *VerifyNodeResponse) GetCertificate() *Certificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+type GetRevocationListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevocationListRequest) Reset() {
+	*x = GetRevocationListRequest{}
+	mi := &file_ca_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevocationListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevocationListRequest) ProtoMessage() {}
+
+func (x *GetRevocationListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ca_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevocationListRequest.ProtoReflect.Descriptor instead.
+func (*GetRevocationListRequest) Descriptor() ([]byte, []int) {
+	return file_ca_proto_rawDescGZIP(), []int{9}
+}
+
+type GetRevocationListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CrlPem        string                 `protobuf:"bytes,1,opt,name=crlPem,proto3" json:"crlPem,omitempty"`
+	Certificates  []*Certificate         `protobuf:"bytes,2,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	NextUpdate    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=nextUpdate,proto3" json:"nextUpdate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevocationListResponse) Reset() {
+	*x = GetRevocationListResponse{}
+	mi := &file_ca_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevocationListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevocationListResponse) ProtoMessage() {}
+
+func (x *GetRevocationListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ca_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevocationListResponse.ProtoReflect.Descriptor instead.
+func (*GetRevocationListResponse) Descriptor() ([]byte, []int) {
+	return file_ca_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetRevocationListResponse) GetCrlPem() string {
+	if x != nil {
+		return x.CrlPem
+	}
+	return ""
+}
+
+func (x *GetRevocationListResponse) GetCertificates() []*Certificate {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+func (x *GetRevocationListResponse) GetNextUpdate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextUpdate
+	}
+	return nil
+}
+
+type ListCertificatesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NodeId string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	// Only certificates expiring within that many days when set
+	ExpiresWithinDays uint32 `protobuf:"varint,2,opt,name=expiresWithinDays,proto3" json:"expiresWithinDays,omitempty"`
+	IncludeRevoked    bool   `protobuf:"varint,3,opt,name=includeRevoked,proto3" json:"includeRevoked,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListCertificatesRequest) Reset() {
+	*x = ListCertificatesRequest{}
+	mi := &file_ca_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCertificatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCertificatesRequest) ProtoMessage() {}
+
+func (x *ListCertificatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ca_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCertificatesRequest.ProtoReflect.Descriptor instead.
+func (*ListCertificatesRequest) Descriptor() ([]byte, []int) {
+	return file_ca_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListCertificatesRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ListCertificatesRequest) GetExpiresWithinDays() uint32 {
+	if x != nil {
+		return x.ExpiresWithinDays
+	}
+	return 0
+}
+
+func (x *ListCertificatesRequest) GetIncludeRevoked() bool {
+	if x != nil {
+		return x.IncludeRevoked
+	}
+	return false
+}
+
+type ListCertificatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificates  []*Certificate         `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCertificatesResponse) Reset() {
+	*x = ListCertificatesResponse{}
+	mi := &file_ca_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCertificatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCertificatesResponse) ProtoMessage() {}
+
+func (x *ListCertificatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ca_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCertificatesResponse.ProtoReflect.Descriptor instead.
+func (*ListCertificatesResponse) Descriptor() ([]byte, []int) {
+	return file_ca_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListCertificatesResponse) GetCertificates() []*Certificate {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+var File_ca_proto protoreflect.FileDescriptor
+
+const file_ca_proto_rawDesc = "" +
+	"\n" +
+	"\bca.proto\x12\vukama.ca.v1\x1a\x0fvalidator.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfb\x02\n" +
+	"\vCertificate\x12\x16\n" +
+	"\x06serial\x18\x01 \x01(\tR\x06serial\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x128\n" +
+	"\tnotBefore\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tnotBefore\x126\n" +
+	"\bnotAfter\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bnotAfter\x128\n" +
+	"\trevokedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x12*\n" +
+	"\x10revocationReason\x18\a \x01(\tR\x10revocationReason\x12L\n" +
+	"\x13rotationRequestedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x13rotationRequestedAt\"K\n" +
+	"\x17IssueCertificateRequest\x12\x1e\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\x12\x10\n" +
+	"\x03csr\x18\x02 \x01(\tR\x03csr\"\xd0\x01\n" +
+	"\x18IssueCertificateResponse\x12:\n" +
+	"\vcertificate\x18\x01 \x01(\v2\x18.ukama.ca.v1.CertificateR\vcertificate\x12&\n" +
+	"\x0ecertificatePem\x18\x02 \x01(\tR\x0ecertificatePem\x12$\n" +
+	"\rprivateKeyPem\x18\x03 \x01(\tR\rprivateKeyPem\x12*\n" +
+	"\x10caCertificatePem\x18\x04 \x01(\tR\x10caCertificatePem\"R\n" +
+	"\x18RevokeCertificateRequest\x12\x1e\n" +
+	"\x06serial\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06serial\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"W\n" +
+	"\x19RevokeCertificateResponse\x12:\n" +
+	"\vcertificate\x18\x01 \x01(\v2\x18.ukama.ca.v1.CertificateR\vcertificate\"W\n" +
+	"\x1dRevokeNodeCertificatesRequest\x12\x1e\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"^\n" +
+	"\x1eRevokeNodeCertificatesResponse\x12<\n" +
+	"\fcertificates\x18\x01 \x03(\v2\x18.ukama.ca.v1.CertificateR\fcertificates\"K\n" +
+	"\x11VerifyNodeRequest\x12\x1e\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\x12\x16\n" +
+	"\x06serial\x18\x02 \x01(\tR\x06serial\"h\n" +
+	"\x12VerifyNodeResponse\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12:\n" +
+	"\vcertificate\x18\x02 \x01(\v2\x18.ukama.ca.v1.CertificateR\vcertificate\"\x1a\n" +
+	"\x18GetRevocationListRequest\"\xad\x01\n" +
+	"\x19GetRevocationListResponse\x12\x16\n" +
+	"\x06crlPem\x18\x01 \x01(\tR\x06crlPem\x12<\n" +
+	"\fcertificates\x18\x02 \x03(\v2\x18.ukama.ca.v1.CertificateR\fcertificates\x12:\n" +
+	"\n" +
+	"nextUpdate\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"nextUpdate\"\x87\x01\n" +
+	"\x17ListCertificatesRequest\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12,\n" +
+	"\x11expiresWithinDays\x18\x02 \x01(\rR\x11expiresWithinDays\x12&\n" +
+	"\x0eincludeRevoked\x18\x03 \x01(\bR\x0eincludeRevoked\"X\n" +
+	"\x18ListCertificatesResponse\x12<\n" +
+	"\fcertificates\x18\x01 \x03(\v2\x18.ukama.ca.v1.CertificateR\fcertificates2\xd7\x04\n" +
+	"\tCaService\x12_\n" +
+	"\x10IssueCertificate\x12$.ukama.ca.v1.IssueCertificateRequest\x1a%.ukama.ca.v1.IssueCertificateResponse\x12b\n" +
+	"\x11RevokeCertificate\x12%.ukama.ca.v1.RevokeCertificateRequest\x1a&.ukama.ca.v1.RevokeCertificateResponse\x12q\n" +
+	"\x16RevokeNodeCertificates\x12*.ukama.ca.v1.RevokeNodeCertificatesRequest\x1a+.ukama.ca.v1.RevokeNodeCertificatesResponse\x12M\n" +
+	"\n" +
+	"VerifyNode\x12\x1e.ukama.ca.v1.VerifyNodeRequest\x1a\x1f.ukama.ca.v1.VerifyNodeResponse\x12b\n" +
+	"\x11GetRevocationList\x12%.ukama.ca.v1.GetRevocationListRequest\x1a&.ukama.ca.v1.GetRevocationListResponse\x12_\n" +
+	"\x10ListCertificates\x12$.ukama.ca.v1.ListCertificatesRequest\x1a%.ukama.ca.v1.ListCertificatesResponseB\bZ\x06pb/genb\x06proto3"
+
+var (
+	file_ca_proto_rawDescOnce sync.Once
+	file_ca_proto_rawDescData []byte
+)
+
+func file_ca_proto_rawDescGZIP() []byte {
+	file_ca_proto_rawDescOnce.Do(func() {
+		file_ca_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ca_proto_rawDesc), len(file_ca_proto_rawDesc)))
+	})
+	return file_ca_proto_rawDescData
+}
+
+var file_ca_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_ca_proto_goTypes = []any{
+	(*Certificate)(nil),                    // 0: ukama.ca.v1.Certificate
+	(*IssueCertificateRequest)(nil),        // 1: ukama.ca.v1.IssueCertificateRequest
+	(*IssueCertificateResponse)(nil),       // 2: ukama.ca.v1.IssueCertificateResponse
+	(*RevokeCertificateRequest)(nil),       // 3: ukama.ca.v1.RevokeCertificateRequest
+	(*RevokeCertificateResponse)(nil),      // 4: ukama.ca.v1.RevokeCertificateResponse
+	(*RevokeNodeCertificatesRequest)(nil),  // 5: ukama.ca.v1.RevokeNodeCertificatesRequest
+	(*RevokeNodeCertificatesResponse)(nil), // 6: ukama.ca.v1.RevokeNodeCertificatesResponse
+	(*VerifyNodeRequest)(nil),              // 7: ukama.ca.v1.VerifyNodeRequest
+	(*VerifyNodeResponse)(nil),             // 8: ukama.ca.v1.VerifyNodeResponse
+	(*GetRevocationListRequest)(nil),       // 9: ukama.ca.v1.GetRevocationListRequest
+	(*GetRevocationListResponse)(nil),      // 10: ukama.ca.v1.GetRevocationListResponse
+	(*ListCertificatesRequest)(nil),        // 11: ukama.ca.v1.ListCertificatesRequest
+	(*ListCertificatesResponse)(nil),       // 12: ukama.ca.v1.ListCertificatesResponse
+	(*timestamppb.Timestamp)(nil),          // 13: google.protobuf.Timestamp
+}
+var file_ca_proto_depIdxs = []int32{
+	13, // 0: ukama.ca.v1.Certificate.notBefore:type_name -> google.protobuf.Timestamp
+	13, // 1: ukama.ca.v1.Certificate.notAfter:type_name -> google.protobuf.Timestamp
+	13, // 2: ukama.ca.v1.Certificate.revokedAt:type_name -> google.protobuf.Timestamp
+	13, // 3: ukama.ca.v1.Certificate.rotationRequestedAt:type_name -> google.protobuf.Timestamp
+	0,  // 4: ukama.ca.v1.IssueCertificateResponse.certificate:type_name -> ukama.ca.v1.Certificate
+	0,  // 5: ukama.ca.v1.RevokeCertificateResponse.certificate:type_name -> ukama.ca.v1.Certificate
+	0,  // 6: ukama.ca.v1.RevokeNodeCertificatesResponse.certificates:type_name -> ukama.ca.v1.Certificate
+	0,  // 7: ukama.ca.v1.VerifyNodeResponse.certificate:type_name -> ukama.ca.v1.Certificate
+	0,  // 8: ukama.ca.v1.GetRevocationListResponse.certificates:type_name -> ukama.ca.v1.Certificate
+	13, // 9: ukama.ca.v1.GetRevocationListResponse.nextUpdate:type_name -> google.protobuf.Timestamp
+	0,  // 10: ukama.ca.v1.ListCertificatesResponse.certificates:type_name -> ukama.ca.v1.Certificate
+	1,  // 11: ukama.ca.v1.CaService.IssueCertificate:input_type -> ukama.ca.v1.IssueCertificateRequest
+	3,  // 12: ukama.ca.v1.CaService.RevokeCertificate:input_type -> ukama.ca.v1.RevokeCertificateRequest
+	5,  // 13: ukama.ca.v1.CaService.RevokeNodeCertificates:input_type -> ukama.ca.v1.RevokeNodeCertificatesRequest
+	7,  // 14: ukama.ca.v1.CaService.VerifyNode:input_type -> ukama.ca.v1.VerifyNodeRequest
+	9,  // 15: ukama.ca.v1.CaService.GetRevocationList:input_type -> ukama.ca.v1.GetRevocationListRequest
+	11, // 16: ukama.ca.v1.CaService.ListCertificates:input_type -> ukama.ca.v1.ListCertificatesRequest
+	2,  // 17: ukama.ca.v1.CaService.IssueCertificate:output_type -> ukama.ca.v1.IssueCertificateResponse
+	4,  // 18: ukama.ca.v1.CaService.RevokeCertificate:output_type -> ukama.ca.v1.RevokeCertificateResponse
+	6,  // 19: ukama.ca.v1.CaService.RevokeNodeCertificates:output_type -> ukama.ca.v1.RevokeNodeCertificatesResponse
+	8,  // 20: ukama.ca.v1.CaService.VerifyNode:output_type -> ukama.ca.v1.VerifyNodeResponse
+	10, // 21: ukama.ca.v1.CaService.GetRevocationList:output_type -> ukama.ca.v1.GetRevocationListResponse
+	12, // 22: ukama.ca.v1.CaService.ListCertificates:output_type -> ukama.ca.v1.ListCertificatesResponse
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_ca_proto_init() }
+func file_ca_proto_init() {
+	if File_ca_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ca_proto_rawDesc), len(file_ca_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ca_proto_goTypes,
+		DependencyIndexes: file_ca_proto_depIdxs,
+		MessageInfos:      file_ca_proto_msgTypes,
+	}.Build()
+	File_ca_proto = out.File
+	file_ca_proto_goTypes = nil
+	file_ca_proto_depIdxs = nil
+}
@@ -0,0 +1,131 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ca.proto
+
+package gen
+
+import (
+	fmt "fmt"
+	math "math"
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/mwitkow/go-proto-validators"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+func (this *Certificate) Validate() error {
+	if this.NotBefore != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NotBefore); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NotBefore", err)
+		}
+	}
+	if this.NotAfter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NotAfter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NotAfter", err)
+		}
+	}
+	if this.RevokedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.RevokedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("RevokedAt", err)
+		}
+	}
+	if this.RotationRequestedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.RotationRequestedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("RotationRequestedAt", err)
+		}
+	}
+	return nil
+}
+func (this *IssueCertificateRequest) Validate() error {
+	if this.NodeId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("NodeId", fmt.Errorf(`value '%v' must not be an empty string`, this.NodeId))
+	}
+	return nil
+}
+func (this *IssueCertificateResponse) Validate() error {
+	if this.Certificate != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Certificate); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Certificate", err)
+		}
+	}
+	return nil
+}
+func (this *RevokeCertificateRequest) Validate() error {
+	if this.Serial == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Serial", fmt.Errorf(`value '%v' must not be an empty string`, this.Serial))
+	}
+	return nil
+}
+func (this *RevokeCertificateResponse) Validate() error {
+	if this.Certificate != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Certificate); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Certificate", err)
+		}
+	}
+	return nil
+}
+func (this *RevokeNodeCertificatesRequest) Validate() error {
+	if this.NodeId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("NodeId", fmt.Errorf(`value '%v' must not be an empty string`, this.NodeId))
+	}
+	return nil
+}
+func (this *RevokeNodeCertificatesResponse) Validate() error {
+	for _, item := range this.Certificates {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Certificates", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *VerifyNodeRequest) Validate() error {
+	if this.NodeId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("NodeId", fmt.Errorf(`value '%v' must not be an empty string`, this.NodeId))
+	}
+	return nil
+}
+func (this *VerifyNodeResponse) Validate() error {
+	if this.Certificate != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Certificate); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Certificate", err)
+		}
+	}
+	return nil
+}
+func (this *GetRevocationListRequest) Validate() error {
+	return nil
+}
+func (this *GetRevocationListResponse) Validate() error {
+	for _, item := range this.Certificates {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Certificates", err)
+			}
+		}
+	}
+	if this.NextUpdate != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NextUpdate); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NextUpdate", err)
+		}
+	}
+	return nil
+}
+func (this *ListCertificatesRequest) Validate() error {
+	return nil
+}
+func (this *ListCertificatesResponse) Validate() error {
+	for _, item := range this.Certificates {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Certificates", err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,324 @@
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2026-present, Ukama Inc.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
+// source: ca.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CaService_IssueCertificate_FullMethodName       = "/ukama.ca.v1.CaService/IssueCertificate"
+	CaService_RevokeCertificate_FullMethodName      = "/ukama.ca.v1.CaService/RevokeCertificate"
+	CaService_RevokeNodeCertificates_FullMethodName = "/ukama.ca.v1.CaService/RevokeNodeCertificates"
+	CaService_VerifyNode_FullMethodName             = "/ukama.ca.v1.CaService/VerifyNode"
+	CaService_GetRevocationList_FullMethodName      = "/ukama.ca.v1.CaService/GetRevocationList"
+	CaService_ListCertificates_FullMethodName       = "/ukama.ca.v1.CaService/ListCertificates"
+)
+
+// CaServiceClient is the client API for CaService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CaServiceClient interface {
+	// Issues a certificate bound to the node id, used by bootstrap
+	IssueCertificate(ctx context.Context, in *IssueCertificateRequest, opts ...grpc.CallOption) (*IssueCertificateResponse, error)
+	RevokeCertificate(ctx context.Context, in *RevokeCertificateRequest, opts ...grpc.CallOption) (*RevokeCertificateResponse, error)
+	RevokeNodeCertificates(ctx context.Context, in *RevokeNodeCertificatesRequest, opts ...grpc.CallOption) (*RevokeNodeCertificatesResponse, error)
+	// Checked by the mesh when a node connects
+	VerifyNode(ctx context.Context, in *VerifyNodeRequest, opts ...grpc.CallOption) (*VerifyNodeResponse, error)
+	GetRevocationList(ctx context.Context, in *GetRevocationListRequest, opts ...grpc.CallOption) (*GetRevocationListResponse, error)
+	// Certificates sorted by expiry
+	ListCertificates(ctx context.Context, in *ListCertificatesRequest, opts ...grpc.CallOption) (*ListCertificatesResponse, error)
+}
+
+type caServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCaServiceClient(cc grpc.ClientConnInterface) CaServiceClient {
+	return &caServiceClient{cc}
+}
+
+func (c *caServiceClient) IssueCertificate(ctx context.Context, in *IssueCertificateRequest, opts ...grpc.CallOption) (*IssueCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueCertificateResponse)
+	err := c.cc.Invoke(ctx, CaService_IssueCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caServiceClient) RevokeCertificate(ctx context.Context, in *RevokeCertificateRequest, opts ...grpc.CallOption) (*RevokeCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeCertificateResponse)
+	err := c.cc.Invoke(ctx, CaService_RevokeCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caServiceClient) RevokeNodeCertificates(ctx context.Context, in *RevokeNodeCertificatesRequest, opts ...grpc.CallOption) (*RevokeNodeCertificatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeNodeCertificatesResponse)
+	err := c.cc.Invoke(ctx, CaService_RevokeNodeCertificates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caServiceClient) VerifyNode(ctx context.Context, in *VerifyNodeRequest, opts ...grpc.CallOption) (*VerifyNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyNodeResponse)
+	err := c.cc.Invoke(ctx, CaService_VerifyNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caServiceClient) GetRevocationList(ctx context.Context, in *GetRevocationListRequest, opts ...grpc.CallOption) (*GetRevocationListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRevocationListResponse)
+	err := c.cc.Invoke(ctx, CaService_GetRevocationList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caServiceClient) ListCertificates(ctx context.Context, in *ListCertificatesRequest, opts ...grpc.CallOption) (*ListCertificatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCertificatesResponse)
+	err := c.cc.Invoke(ctx, CaService_ListCertificates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CaServiceServer is the server API for CaService service.
+// All implementations must embed UnimplementedCaServiceServer
+// for forward compatibility.
+type CaServiceServer interface {
+	// Issues a certificate bound to the node id, used by bootstrap
+	IssueCertificate(context.Context, *IssueCertificateRequest) (*IssueCertificateResponse, error)
+	RevokeCertificate(context.Context, *RevokeCertificateRequest) (*RevokeCertificateResponse, error)
+	RevokeNodeCertificates(context.Context, *RevokeNodeCertificatesRequest) (*RevokeNodeCertificatesResponse, error)
+	// Checked by the mesh when a node connects
+	VerifyNode(context.Context, *VerifyNodeRequest) (*VerifyNodeResponse, error)
+	GetRevocationList(context.Context, *GetRevocationListRequest) (*GetRevocationListResponse, error)
+	// Certificates sorted by expiry
+	ListCertificates(context.Context, *ListCertificatesRequest) (*ListCertificatesResponse, error)
+	mustEmbedUnimplementedCaServiceServer()
+}
+
+// UnimplementedCaServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCaServiceServer struct{}
+
+func (UnimplementedCaServiceServer) IssueCertificate(context.Context, *IssueCertificateRequest) (*IssueCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueCertificate not implemented")
+}
+func (UnimplementedCaServiceServer) RevokeCertificate(context.Context, *RevokeCertificateRequest) (*RevokeCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCertificate not implemented")
+}
+func (UnimplementedCaServiceServer) RevokeNodeCertificates(context.Context, *RevokeNodeCertificatesRequest) (*RevokeNodeCertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeNodeCertificates not implemented")
+}
+func (UnimplementedCaServiceServer) VerifyNode(context.Context, *VerifyNodeRequest) (*VerifyNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyNode not implemented")
+}
+func (UnimplementedCaServiceServer) GetRevocationList(context.Context, *GetRevocationListRequest) (*GetRevocationListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevocationList not implemented")
+}
+func (UnimplementedCaServiceServer) ListCertificates(context.Context, *ListCertificatesRequest) (*ListCertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCertificates not implemented")
+}
+func (UnimplementedCaServiceServer) mustEmbedUnimplementedCaServiceServer() {}
+func (UnimplementedCaServiceServer) testEmbeddedByValue()                   {}
+
+// UnsafeCaServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CaServiceServer will
+// result in compilation errors.
+type UnsafeCaServiceServer interface {
+	mustEmbedUnimplementedCaServiceServer()
+}
+
+func RegisterCaServiceServer(s grpc.ServiceRegistrar, srv CaServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCaServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CaService_ServiceDesc, srv)
+}
+
+func _CaService_IssueCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaServiceServer).IssueCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaService_IssueCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaServiceServer).IssueCertificate(ctx, req.(*IssueCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaService_RevokeCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaServiceServer).RevokeCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaService_RevokeCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaServiceServer).RevokeCertificate(ctx, req.(*RevokeCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaService_RevokeNodeCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeNodeCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaServiceServer).RevokeNodeCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaService_RevokeNodeCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaServiceServer).RevokeNodeCertificates(ctx, req.(*RevokeNodeCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaService_VerifyNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaServiceServer).VerifyNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaService_VerifyNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaServiceServer).VerifyNode(ctx, req.(*VerifyNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaService_GetRevocationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevocationListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaServiceServer).GetRevocationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaService_GetRevocationList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaServiceServer).GetRevocationList(ctx, req.(*GetRevocationListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaService_ListCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaServiceServer).ListCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaService_ListCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaServiceServer).ListCertificates(ctx, req.(*ListCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CaService_ServiceDesc is the grpc.ServiceDesc for CaService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CaService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ukama.ca.v1.CaService",
+	HandlerType: (*CaServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IssueCertificate",
+			Handler:    _CaService_IssueCertificate_Handler,
+		},
+		{
+			MethodName: "RevokeCertificate",
+			Handler:    _CaService_RevokeCertificate_Handler,
+		},
+		{
+			MethodName: "RevokeNodeCertificates",
+			Handler:    _CaService_RevokeNodeCertificates_Handler,
+		},
+		{
+			MethodName: "VerifyNode",
+			Handler:    _CaService_VerifyNode_Handler,
+		},
+		{
+			MethodName: "GetRevocationList",
+			Handler:    _CaService_GetRevocationList_Handler,
+		},
+		{
+			MethodName: "ListCertificates",
+			Handler:    _CaService_ListCertificates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ca.proto",
+}
@@ -0,0 +1,253 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gen "github.com/ukama/ukama/systems/init/ca/pb/gen"
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CaServiceClient is an autogenerated mock type for the CaServiceClient type
+type CaServiceClient struct {
+	mock.Mock
+}
+
+// GetRevocationList provides a mock function with given fields: ctx, in, opts
+func (_m *CaServiceClient) GetRevocationList(ctx context.Context, in *gen.GetRevocationListRequest, opts ...grpc.CallOption) (*gen.GetRevocationListResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevocationList")
+	}
+
+	var r0 *gen.GetRevocationListResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetRevocationListRequest, ...grpc.CallOption) (*gen.GetRevocationListResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetRevocationListRequest, ...grpc.CallOption) *gen.GetRevocationListResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetRevocationListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetRevocationListRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IssueCertificate provides a mock function with given fields: ctx, in, opts
+func (_m *CaServiceClient) IssueCertificate(ctx context.Context, in *gen.IssueCertificateRequest, opts ...grpc.CallOption) (*gen.IssueCertificateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueCertificate")
+	}
+
+	var r0 *gen.IssueCertificateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.IssueCertificateRequest, ...grpc.CallOption) (*gen.IssueCertificateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.IssueCertificateRequest, ...grpc.CallOption) *gen.IssueCertificateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.IssueCertificateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.IssueCertificateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCertificates provides a mock function with given fields: ctx, in, opts
+func (_m *CaServiceClient) ListCertificates(ctx context.Context, in *gen.ListCertificatesRequest, opts ...grpc.CallOption) (*gen.ListCertificatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCertificates")
+	}
+
+	var r0 *gen.ListCertificatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListCertificatesRequest, ...grpc.CallOption) (*gen.ListCertificatesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListCertificatesRequest, ...grpc.CallOption) *gen.ListCertificatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListCertificatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListCertificatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeCertificate provides a mock function with given fields: ctx, in, opts
+func (_m *CaServiceClient) RevokeCertificate(ctx context.Context, in *gen.RevokeCertificateRequest, opts ...grpc.CallOption) (*gen.RevokeCertificateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeCertificate")
+	}
+
+	var r0 *gen.RevokeCertificateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeCertificateRequest, ...grpc.CallOption) (*gen.RevokeCertificateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeCertificateRequest, ...grpc.CallOption) *gen.RevokeCertificateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RevokeCertificateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RevokeCertificateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeNodeCertificates provides a mock function with given fields: ctx, in, opts
+func (_m *CaServiceClient) RevokeNodeCertificates(ctx context.Context, in *gen.RevokeNodeCertificatesRequest, opts ...grpc.CallOption) (*gen.RevokeNodeCertificatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeNodeCertificates")
+	}
+
+	var r0 *gen.RevokeNodeCertificatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeNodeCertificatesRequest, ...grpc.CallOption) (*gen.RevokeNodeCertificatesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeNodeCertificatesRequest, ...grpc.CallOption) *gen.RevokeNodeCertificatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RevokeNodeCertificatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RevokeNodeCertificatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyNode provides a mock function with given fields: ctx, in, opts
+func (_m *CaServiceClient) VerifyNode(ctx context.Context, in *gen.VerifyNodeRequest, opts ...grpc.CallOption) (*gen.VerifyNodeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyNode")
+	}
+
+	var r0 *gen.VerifyNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.VerifyNodeRequest, ...grpc.CallOption) (*gen.VerifyNodeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.VerifyNodeRequest, ...grpc.CallOption) *gen.VerifyNodeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.VerifyNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.VerifyNodeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCaServiceClient creates a new instance of CaServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCaServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CaServiceClient {
+	mock := &CaServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,214 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	gen "github.com/ukama/ukama/systems/init/ca/pb/gen"
+)
+
+// CaServiceServer is an autogenerated mock type for the CaServiceServer type
+type CaServiceServer struct {
+	mock.Mock
+}
+
+// GetRevocationList provides a mock function with given fields: _a0, _a1
+func (_m *CaServiceServer) GetRevocationList(_a0 context.Context, _a1 *gen.GetRevocationListRequest) (*gen.GetRevocationListResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevocationList")
+	}
+
+	var r0 *gen.GetRevocationListResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetRevocationListRequest) (*gen.GetRevocationListResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetRevocationListRequest) *gen.GetRevocationListResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetRevocationListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetRevocationListRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IssueCertificate provides a mock function with given fields: _a0, _a1
+func (_m *CaServiceServer) IssueCertificate(_a0 context.Context, _a1 *gen.IssueCertificateRequest) (*gen.IssueCertificateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueCertificate")
+	}
+
+	var r0 *gen.IssueCertificateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.IssueCertificateRequest) (*gen.IssueCertificateResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.IssueCertificateRequest) *gen.IssueCertificateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.IssueCertificateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.IssueCertificateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCertificates provides a mock function with given fields: _a0, _a1
+func (_m *CaServiceServer) ListCertificates(_a0 context.Context, _a1 *gen.ListCertificatesRequest) (*gen.ListCertificatesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCertificates")
+	}
+
+	var r0 *gen.ListCertificatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListCertificatesRequest) (*gen.ListCertificatesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListCertificatesRequest) *gen.ListCertificatesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListCertificatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListCertificatesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeCertificate provides a mock function with given fields: _a0, _a1
+func (_m *CaServiceServer) RevokeCertificate(_a0 context.Context, _a1 *gen.RevokeCertificateRequest) (*gen.RevokeCertificateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeCertificate")
+	}
+
+	var r0 *gen.RevokeCertificateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeCertificateRequest) (*gen.RevokeCertificateResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeCertificateRequest) *gen.RevokeCertificateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RevokeCertificateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RevokeCertificateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeNodeCertificates provides a mock function with given fields: _a0, _a1
+func (_m *CaServiceServer) RevokeNodeCertificates(_a0 context.Context, _a1 *gen.RevokeNodeCertificatesRequest) (*gen.RevokeNodeCertificatesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeNodeCertificates")
+	}
+
+	var r0 *gen.RevokeNodeCertificatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeNodeCertificatesRequest) (*gen.RevokeNodeCertificatesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeNodeCertificatesRequest) *gen.RevokeNodeCertificatesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RevokeNodeCertificatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RevokeNodeCertificatesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyNode provides a mock function with given fields: _a0, _a1
+func (_m *CaServiceServer) VerifyNode(_a0 context.Context, _a1 *gen.VerifyNodeRequest) (*gen.VerifyNodeResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyNode")
+	}
+
+	var r0 *gen.VerifyNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.VerifyNodeRequest) (*gen.VerifyNodeResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.VerifyNodeRequest) *gen.VerifyNodeResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.VerifyNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.VerifyNodeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mustEmbedUnimplementedCaServiceServer provides a mock function with no fields
+func (_m *CaServiceServer) mustEmbedUnimplementedCaServiceServer() {
+	_m.Called()
+}
+
+// NewCaServiceServer creates a new instance of CaServiceServer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCaServiceServer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CaServiceServer {
+	mock := &CaServiceServer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,29 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// UnsafeCaServiceServer is an autogenerated mock type for the UnsafeCaServiceServer type
+type UnsafeCaServiceServer struct {
+	mock.Mock
+}
+
+// mustEmbedUnimplementedCaServiceServer provides a mock function with no fields
+func (_m *UnsafeCaServiceServer) mustEmbedUnimplementedCaServiceServer() {
+	_m.Called()
+}
+
+// NewUnsafeCaServiceServer creates a new instance of UnsafeCaServiceServer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUnsafeCaServiceServer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UnsafeCaServiceServer {
+	mock := &UnsafeCaServiceServer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/jackc/pgtype"
 	"github.com/num30/config"
 	"github.com/ukama/ukama/systems/common/metrics"
+	"github.com/ukama/ukama/systems/common/rest/client"
+	"github.com/ukama/ukama/systems/common/rest/client/factory"
 	"github.com/ukama/ukama/systems/common/uuid"
 	"github.com/ukama/ukama/systems/init/lookup/cmd/version"
 	"github.com/ukama/ukama/systems/init/lookup/internal"
@@ -57,7 +59,7 @@ func main() {
 func initDb() sql.Db {
 	log.Infof("Initializing Database")
 	d := sql.NewDb(serviceConfig.DB, serviceConfig.DebugMode)
-	err := d.Init(&db.Org{}, &db.Node{}, &db.System{})
+	err := d.Init(&db.Org{}, &db.Node{}, &db.System{}, &db.NodeTransfer{})
 	if err != nil {
 		log.Fatalf("Database initialization failed. Error: %v", err)
 	}
@@ -106,7 +108,9 @@ func runGrpcServer(d sql.Db) {
 	log.Debugf("MessageBus Client is %+v", mbClient)
 
 	grpcServer := ugrpc.NewGrpcServer(*serviceConfig.Grpc, func(s *grpc.Server) {
-		srv := server.NewLookupServer(db.NewNodeRepo(d), db.NewOrgRepo(d), db.NewSystemRepo(d), mbClient, serviceConfig.OrgName)
+		srv := server.NewLookupServer(db.NewNodeRepo(d), db.NewOrgRepo(d), db.NewSystemRepo(d), db.NewNodeTransferRepo(d),
+			factory.NewNodeFactoryClient(serviceConfig.Http.FactoryClient, client.WithDebug(serviceConfig.DebugMode)),
+			mbClient, serviceConfig.OrgName, serviceConfig.HeartbeatTimeout)
 		nSrv := server.NewLookupEventServer(serviceConfig.OrgName, db.NewNodeRepo(d), db.NewOrgRepo(d), db.NewSystemRepo(d))
 		generated.RegisterLookupServiceServer(s, srv)
 		egenerated.RegisterEventNotificationServiceServer(s, nSrv)
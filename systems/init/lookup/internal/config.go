@@ -21,10 +21,16 @@ type Config struct {
 	Queue            *uconf.Queue     `default:"{}"`
 	Metrics          *uconf.Metrics   `default:"{}"`
 	Timeout          time.Duration    `default:"3s"`
+	HeartbeatTimeout time.Duration    `default:"2m"`
 	MsgClient        *uconf.MsgClient `default:"{}"`
 	Service          *uconf.Service
 	OrgName          string
 	OrgId            string
+	Http             HttpServices
+}
+
+type HttpServices struct {
+	FactoryClient string `default:"api-gateway-factory:8080"`
 }
 
 func NewConfig(name string) *Config {
@@ -36,7 +42,8 @@ func NewConfig(name string) *Config {
 		MsgClient: &uconf.MsgClient{
 			Timeout: 5 * time.Second,
 			ListenerRoutes: []string{"event.cloud.local.{{ .Org}}.init.lookup.organization.create",
-				"event.cloud.global.{{ .Org}}.messaging.mesh.ip.update"},
+				"event.cloud.global.{{ .Org}}.messaging.mesh.ip.update",
+				"event.cloud.local.{{ .Org}}.init.bootstrap.node.revoke"},
 		},
 	}
 }
@@ -9,6 +9,8 @@
 package db
 
 import (
+	"time"
+
 	"github.com/jackc/pgtype"
 	"github.com/ukama/ukama/systems/common/uuid"
 	"gorm.io/gorm"
@@ -19,6 +21,8 @@ type Node struct {
 	NodeID string `gorm:"type:string;uniqueIndex:node_id_idx_case_insensetive,expression:lower(node_id);size:23;not null"`
 	OrgID  uint
 	Org    Org
+	/* Org the node was transferred from, until its credentials are revoked */
+	PreviousOrg string
 }
 
 type Org struct {
@@ -45,4 +49,47 @@ type System struct {
 	OrgID       uint `gorm:"type:string;index:sys_idx,unique,composite:sys_idx;not null"`
 	Org         Org
 	ApiGwHealth uint32 `gorm:"default:100"`
+	Version     string
+	LastSeen    *time.Time
+	Draining    bool        `gorm:"default:false"`
+	DrainIp     pgtype.Inet `gorm:"type:inet"`
+	DrainPort   int32
+	DrainUrl    string
+}
+
+type TransferStatus uint8
+
+const (
+	TransferPending TransferStatus = iota
+	TransferAccepted
+	TransferCancelled
+)
+
+func (s TransferStatus) String() string {
+	switch s {
+	case TransferPending:
+		return "pending"
+	case TransferAccepted:
+		return "accepted"
+	case TransferCancelled:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
+
+// NodeTransfer records a node released by its org, and who took it over.
+// A node has at most one pending transfer.
+type NodeTransfer struct {
+	Id          uuid.UUID      `gorm:"primaryKey;type:uuid"`
+	NodeID      string         `gorm:"type:string;size:23;not null;index;uniqueIndex:node_transfer_pending_idx,where:status = 0"`
+	FromOrgID   uint           `gorm:"not null"`
+	FromOrg     Org
+	ToOrgName   string
+	Status      TransferStatus `gorm:"type:uint;not null;default:0"`
+	ReleasedBy  string
+	AcceptedBy  string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt *time.Time
 }
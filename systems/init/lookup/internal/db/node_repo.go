@@ -20,6 +20,7 @@ type NodeRepo interface {
 	AddOrUpdate(node *Node) error
 	Get(nodeId ukama.NodeID) (*Node, error)
 	Delete(nodeId ukama.NodeID) error
+	ClearPreviousOrg(nodeId ukama.NodeID) error
 }
 
 type nodeRepo struct {
@@ -59,3 +60,9 @@ func (r *nodeRepo) Delete(nodeId ukama.NodeID) error {
 
 	return fmt.Errorf("%s node missing", nodeId.String())
 }
+
+func (r *nodeRepo) ClearPreviousOrg(nodeId ukama.NodeID) error {
+	result := r.Db.GetGormDb().Model(&Node{}).Where("node_id = ?", nodeId.StringLowercase()).Update("previous_org", "")
+
+	return result.Error
+}
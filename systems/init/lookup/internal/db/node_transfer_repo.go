@@ -0,0 +1,114 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"time"
+
+	"github.com/ukama/ukama/systems/common/sql"
+	"github.com/ukama/ukama/systems/common/ukama"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"gorm.io/gorm"
+)
+
+type NodeTransferRepo interface {
+	Add(transfer *NodeTransfer) error
+	GetPending(nodeId ukama.NodeID) (*NodeTransfer, error)
+	Accept(transfer *NodeTransfer, to *Org, acceptedBy string) error
+	Cancel(id uuid.UUID) error
+}
+
+type nodeTransferRepo struct {
+	Db sql.Db
+}
+
+func NewNodeTransferRepo(db sql.Db) *nodeTransferRepo {
+	return &nodeTransferRepo{
+		Db: db,
+	}
+}
+
+func (r *nodeTransferRepo) Add(transfer *NodeTransfer) error {
+	return r.Db.GetGormDb().Create(transfer).Error
+}
+
+func (r *nodeTransferRepo) GetPending(nodeId ukama.NodeID) (*NodeTransfer, error) {
+	var transfer NodeTransfer
+
+	result := r.Db.GetGormDb().Preload("FromOrg").
+		Where("node_id = ? AND status = ?", nodeId.StringLowercase(), TransferPending).
+		First(&transfer)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &transfer, nil
+}
+
+// Accept moves the node to the accepting org and closes the transfer, keeping
+// the previous org on the node until its credentials are revoked.
+func (r *nodeTransferRepo) Accept(transfer *NodeTransfer, to *Org, acceptedBy string) error {
+	now := time.Now()
+
+	return r.Db.GetGormDb().Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&NodeTransfer{}).
+			Where("id = ? AND status = ?", transfer.Id, TransferPending).
+			Updates(map[string]interface{}{
+				"status":       TransferAccepted,
+				"to_org_name":  to.Name,
+				"accepted_by":  acceptedBy,
+				"completed_at": now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		result = tx.Model(&Node{}).Where("node_id = ?", transfer.NodeID).
+			Updates(map[string]interface{}{
+				"org_id":       to.ID,
+				"previous_org": transfer.FromOrg.Name,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		transfer.Status = TransferAccepted
+		transfer.ToOrgName = to.Name
+		transfer.AcceptedBy = acceptedBy
+		transfer.CompletedAt = &now
+
+		return nil
+	})
+}
+
+func (r *nodeTransferRepo) Cancel(id uuid.UUID) error {
+	result := r.Db.GetGormDb().Model(&NodeTransfer{}).
+		Where("id = ? AND status = ?", id, TransferPending).
+		Updates(map[string]interface{}{
+			"status":       TransferCancelled,
+			"completed_at": time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
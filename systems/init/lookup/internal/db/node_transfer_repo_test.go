@@ -0,0 +1,100 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/ukama/ukama/systems/common/uuid"
+
+	int_db "github.com/ukama/ukama/systems/init/lookup/internal/db"
+)
+
+func Test_nodeTransferRepo_Accept(t *testing.T) {
+	transfer := &int_db.NodeTransfer{
+		Id:        uuid.NewV4(),
+		NodeID:    "uk-sa2643-hnode-v0-2470",
+		FromOrgID: 1,
+		FromOrg:   int_db.Org{Name: "from-org"},
+		Status:    int_db.TransferPending,
+	}
+	to := &int_db.Org{Model: gorm.Model{ID: 2}, Name: "to-org"}
+
+	setup := func(t *testing.T) (sqlmock.Sqlmock, int_db.NodeTransferRepo) {
+		db, mock, err := sqlmock.New() // mock sql.DB
+		assert.NoError(t, err)
+
+		dialector := postgres.New(postgres.Config{
+			DSN:                  "sqlmock_db_0",
+			DriverName:           "postgres",
+			Conn:                 db,
+			PreferSimpleProtocol: true,
+		})
+		gdb, err := gorm.Open(dialector, &gorm.Config{})
+		assert.NoError(t, err)
+
+		return mock, int_db.NewNodeTransferRepo(&UkamaDbMock{
+			GormDb: gdb,
+		})
+	}
+
+	t.Run("Accepted", func(t *testing.T) {
+		// Arrange
+		mock, r := setup(t)
+		tr := *transfer
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "node_transfers"`)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), tr.Id, int_db.TransferPending).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "nodes"`)).
+			WithArgs(to.ID, tr.FromOrg.Name, sqlmock.AnyArg(), tr.NodeID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		// Act
+		err := r.Accept(&tr, to, "buyer")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int_db.TransferAccepted, tr.Status)
+		assert.Equal(t, to.Name, tr.ToOrgName)
+		assert.NotNil(t, tr.CompletedAt)
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err)
+	})
+
+	t.Run("NotPending", func(t *testing.T) {
+		// Arrange
+		mock, r := setup(t)
+		tr := *transfer
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "node_transfers"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		// Act
+		err := r.Accept(&tr, to, "buyer")
+
+		// Assert
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.Equal(t, int_db.TransferPending, tr.Status)
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err)
+	})
+}
@@ -11,8 +11,11 @@ package db
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/jackc/pgtype"
 	"github.com/ukama/ukama/systems/common/sql"
+	"gorm.io/gorm"
 )
 
 type SystemRepo interface {
@@ -20,6 +23,9 @@ type SystemRepo interface {
 	Update(sys *System, org uint) error
 	Delete(sys string, org uint) error
 	GetByName(sys string, org uint) (*System, error)
+	List(org uint) ([]System, error)
+	Heartbeat(sys string, org uint, version string, apiGwHealth, nodeGwHealth uint32) error
+	SetDrain(sys string, org uint, draining bool, ip pgtype.Inet, port int32, url string) error
 }
 
 type systemRepo struct {
@@ -78,3 +84,51 @@ func (s *systemRepo) GetByName(sys string, id uint) (*System, error) {
 	}
 	return system, nil
 }
+
+// List returns the systems of an org, or of every org when org is 0.
+func (s *systemRepo) List(org uint) ([]System, error) {
+	var systems []System
+
+	tx := s.Db.GetGormDb().Preload("Org")
+	if org != 0 {
+		tx = tx.Where("org_id = ?", org)
+	}
+
+	result := tx.Order("org_id, name").Find(&systems)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return systems, nil
+}
+
+func (s *systemRepo) Heartbeat(sys string, org uint, version string, apiGwHealth, nodeGwHealth uint32) error {
+	return s.updateColumns(sys, org, map[string]interface{}{
+		"version":        version,
+		"last_seen":      time.Now(),
+		"api_gw_health":  apiGwHealth,
+		"node_gw_health": nodeGwHealth,
+	})
+}
+
+func (s *systemRepo) SetDrain(sys string, org uint, draining bool, ip pgtype.Inet, port int32, url string) error {
+	return s.updateColumns(sys, org, map[string]interface{}{
+		"draining":   draining,
+		"drain_ip":   ip,
+		"drain_port": port,
+		"drain_url":  url,
+	})
+}
+
+func (s *systemRepo) updateColumns(sys string, org uint, columns map[string]interface{}) error {
+	result := s.Db.GetGormDb().Model(&System{}).Where("name = ? and org_id = ?", strings.ToLower(sys), org).Updates(columns)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
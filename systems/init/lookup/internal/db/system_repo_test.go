@@ -243,3 +243,55 @@ func Test_systemRepo_Update(t *testing.T) {
 	})
 
 }
+
+func Test_systemRepo_Heartbeat(t *testing.T) {
+	const name = "sys"
+	const orgId = uint(15)
+
+	openRepo := func(t *testing.T) (int_db.SystemRepo, sqlmock.Sqlmock) {
+		db, mock, err := sqlmock.New() // mock sql.DB
+		assert.NoError(t, err)
+
+		dialector := postgres.New(postgres.Config{
+			DSN:                  "sqlmock_db_0",
+			DriverName:           "postgres",
+			Conn:                 db,
+			PreferSimpleProtocol: true,
+		})
+		gdb, err := gorm.Open(dialector, &gorm.Config{})
+		assert.NoError(t, err)
+
+		return int_db.NewSystemRepo(&UkamaDbMock{
+			GormDb: gdb,
+		}), mock
+	}
+
+	t.Run("SystemExist", func(t *testing.T) {
+		r, mock := openRepo(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "systems" SET`)).
+			WithArgs(uint32(100), sqlmock.AnyArg(), uint32(90), "v1.0.0", sqlmock.AnyArg(), name, orgId).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := r.Heartbeat(name, orgId, "v1.0.0", 100, 90)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SystemMissing", func(t *testing.T) {
+		r, mock := openRepo(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "systems" SET`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := r.Heartbeat(name, orgId, "v1.0.0", 100, 90)
+
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
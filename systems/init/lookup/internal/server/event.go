@@ -15,6 +15,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/ukama/ukama/systems/common/msgbus"
 	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	"github.com/ukama/ukama/systems/common/ukama"
 	"github.com/ukama/ukama/systems/init/lookup/internal/db"
 	pb "github.com/ukama/ukama/systems/init/lookup/pb/gen"
 	"google.golang.org/grpc/codes"
@@ -65,6 +66,19 @@ func (l *LookupEventServer) EventNotification(ctx context.Context, e *epb.Event)
 			return nil, err
 		}
 
+	case msgbus.PrepareRoute(l.orgName, "event.cloud.local.{{ .Org}}.init.bootstrap.node.revoke"):
+		msg := &pb.NodeCredentialsRevoked{}
+		err := anypb.UnmarshalTo(e.Msg, msg, proto.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true})
+		if err != nil {
+			log.Errorf("Failed to Unmarshal NodeCredentialsRevoked message with : %+v. Error %s.", e.Msg, err.Error())
+			return nil, err
+		}
+
+		err = l.handleEventNodeCredentialsRevoked(e.RoutingKey, msg)
+		if err != nil {
+			return nil, err
+		}
+
 	default:
 		log.Errorf("No handler routing key %s", e.RoutingKey)
 	}
@@ -125,3 +139,29 @@ func (l *LookupEventServer) handleEventOrgIPUpdateEvent(key string, msg *epb.Org
 	}
 	return nil
 }
+
+// handleEventNodeCredentialsRevoked forgets the previous org of a transferred
+// node once bootstrap stopped handing out its credentials, unless the node
+// moved again meanwhile.
+func (l *LookupEventServer) handleEventNodeCredentialsRevoked(key string, msg *pb.NodeCredentialsRevoked) error {
+	log.Infof("Keys %s and Proto is: %+v", key, msg)
+
+	nodeId, err := ukama.ValidateNodeId(msg.NodeId)
+	if err != nil {
+		log.Errorf("Invalid node id %s. Error %s", msg.NodeId, err.Error())
+		return err
+	}
+
+	node, err := l.nodeRepo.Get(nodeId)
+	if err != nil {
+		log.Errorf("Node %s not found. Error %s", msg.NodeId, err.Error())
+		return err
+	}
+
+	if node.PreviousOrg != msg.PreviousOrgName {
+		log.Infof("Node %s previous org is now %q, keeping it", msg.NodeId, node.PreviousOrg)
+		return nil
+	}
+
+	return l.nodeRepo.ClearPreviousOrg(nodeId)
+}
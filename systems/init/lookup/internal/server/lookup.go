@@ -12,12 +12,14 @@ import (
 	"context"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgtype"
 	log "github.com/sirupsen/logrus"
 	"github.com/ukama/ukama/systems/common/grpc"
 	mb "github.com/ukama/ukama/systems/common/msgBusServiceClient"
 	"github.com/ukama/ukama/systems/common/msgbus"
+	"github.com/ukama/ukama/systems/common/rest/client/factory"
 	"github.com/ukama/ukama/systems/common/ukama"
 	"github.com/ukama/ukama/systems/common/uuid"
 	"github.com/ukama/ukama/systems/init/lookup/internal"
@@ -25,27 +27,44 @@ import (
 	pb "github.com/ukama/ukama/systems/init/lookup/pb/gen"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
 	PREFIX_NODE_GW = "-node-gw"
 	defaultIP      = "0.0.0.0"
 )
+
+/* System status as seen from the heartbeats */
+const (
+	SystemStatusUnknown  = "unknown"
+	SystemStatusUp       = "up"
+	SystemStatusStale    = "stale"
+	SystemStatusDraining = "draining"
+)
+
 type LookupServer struct {
 	systemRepo     db.SystemRepo
 	orgRepo        db.OrgRepo
 	nodeRepo       db.NodeRepo
+	transferRepo   db.NodeTransferRepo
+	factoryClient  factory.NodeFactoryClient
 	msgbus         mb.MsgBusServiceClient
 	baseRoutingKey msgbus.RoutingKeyBuilder
+	staleAfter     time.Duration
 	pb.UnimplementedLookupServiceServer
 }
 
-func NewLookupServer(nodeRepo db.NodeRepo, orgRepo db.OrgRepo, systemRepo db.SystemRepo, msgBus mb.MsgBusServiceClient, orgName string) *LookupServer {
+func NewLookupServer(nodeRepo db.NodeRepo, orgRepo db.OrgRepo, systemRepo db.SystemRepo, transferRepo db.NodeTransferRepo,
+	factoryClient factory.NodeFactoryClient, msgBus mb.MsgBusServiceClient, orgName string, staleAfter time.Duration) *LookupServer {
 	return &LookupServer{
 		nodeRepo:       nodeRepo,
 		orgRepo:        orgRepo,
 		systemRepo:     systemRepo,
+		transferRepo:   transferRepo,
+		factoryClient:  factoryClient,
 		msgbus:         msgBus,
+		staleAfter:     staleAfter,
 		baseRoutingKey: msgbus.NewRoutingKeyBuilder().SetCloudSource().SetSystem(internal.SystemName).SetOrgName(orgName).SetService(internal.ServiceName),
 	}
 }
@@ -235,14 +254,7 @@ func (l *LookupServer) GetNode(ctx context.Context, req *pb.GetNodeRequest) (*pb
 		return nil, grpc.SqlErrorToGrpc(err, "node")
 	}
 
-	resp, err := &pb.GetNodeResponse{
-		NodeId:      node.NodeID,
-		OrgName:     node.Org.Name,
-		Certificate: node.Org.Certificate,
-		Ip:          node.Org.Ip.IPNet.String(),
-	}, nil
-
-	return resp, err
+	return l.nodeResponse(nodeId, node)
 }
 
 func (l *LookupServer) GetNodeForOrg(ctx context.Context, req *pb.GetNodeForOrgRequest) (*pb.GetNodeResponse, error) {
@@ -263,12 +275,7 @@ func (l *LookupServer) GetNodeForOrg(ctx context.Context, req *pb.GetNodeForOrgR
 		return nil, grpc.SqlErrorToGrpc(err, "node")
 	}
 
-	return &pb.GetNodeResponse{
-		NodeId:      node.NodeID,
-		OrgName:     node.Org.Name,
-		Certificate: node.Org.Certificate,
-		Ip:          node.Org.Ip.IPNet.String(),
-	}, nil
+	return l.nodeResponse(nodeId, node)
 }
 
 func (l *LookupServer) DeleteNodeForOrg(ctx context.Context, req *pb.DeleteNodeRequest) (*pb.DeleteNodeResponse, error) {
@@ -361,6 +368,12 @@ func (l *LookupServer) GetSystemForOrg(ctx context.Context, req *pb.GetSystemReq
 		ApiGwUrl:    system.ApiGwUrl,
 		NodeGwIp:    system.NodeGwIp.IPNet.IP.String(),
 		NodeGwPort:  system.NodeGwPort,
+		Version:     system.Version,
+		LastSeen:    lastSeen(system),
+		Draining:    system.Draining,
+		DrainIp:     inetToString(system.DrainIp),
+		DrainPort:   system.DrainPort,
+		DrainUrl:    system.DrainUrl,
 	}, nil
 
 }
@@ -549,6 +562,173 @@ func (l *LookupServer) DeleteSystemForOrg(ctx context.Context, req *pb.DeleteSys
 	return &pb.DeleteSystemResponse{}, nil
 }
 
+func (l *LookupServer) SystemHeartbeat(ctx context.Context, req *pb.SystemHeartbeatRequest) (*pb.SystemHeartbeatResponse, error) {
+	log.Debugf("Heartbeat from system %s of org %s", req.GetSystemName(), req.GetOrgName())
+
+	org, err := l.orgRepo.GetByName(req.OrgName)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "org")
+	}
+
+	err = l.systemRepo.Heartbeat(req.SystemName, org.ID, req.Version, req.ApiGwHealth, req.NodeGwHealth)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "system")
+	}
+
+	system, err := l.getSystem(req.SystemName, org.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SystemHeartbeatResponse{
+		Draining:  system.Draining,
+		DrainIp:   inetToString(system.DrainIp),
+		DrainPort: system.DrainPort,
+		DrainUrl:  system.DrainUrl,
+	}, nil
+}
+
+func (l *LookupServer) GetSystems(ctx context.Context, req *pb.GetSystemsRequest) (*pb.GetSystemsResponse, error) {
+	log.Infof("Getting systems for org %q", req.GetOrgName())
+
+	var orgId uint
+	if req.OrgName != "" {
+		org, err := l.orgRepo.GetByName(req.OrgName)
+		if err != nil {
+			return nil, grpc.SqlErrorToGrpc(err, "org")
+		}
+		orgId = org.ID
+	}
+
+	systems, err := l.systemRepo.List(orgId)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "systems")
+	}
+
+	resp := &pb.GetSystemsResponse{
+		Systems: make([]*pb.SystemInfo, 0, len(systems)),
+	}
+
+	for i := range systems {
+		resp.Systems = append(resp.Systems, l.systemInfo(&systems[i], systems[i].Org.Name))
+	}
+
+	return resp, nil
+}
+
+// DrainSystemForOrg points the nodes of an org to another endpoint of the
+// system, which bootstrap hands out until the system is resumed. This is how
+// an org is moved to another cluster.
+func (l *LookupServer) DrainSystemForOrg(ctx context.Context, req *pb.DrainSystemRequest) (*pb.DrainSystemResponse, error) {
+	log.Infof("Draining system %s of org %s to %s", req.GetSystemName(), req.GetOrgName(), req.GetDrainIp())
+
+	var drainIp pgtype.Inet
+	err := drainIp.Set(req.DrainIp)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid drain ip for system %s. Error %s", req.SystemName, err.Error())
+	}
+
+	info, err := l.setDrain(req.SystemName, req.OrgName, true, drainIp, req.DrainPort, req.DrainUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	route := l.baseRoutingKey.SetAction("drain").SetObject("system").SetGlobalScope().MustBuild()
+	err = l.msgbus.PublishRequest(route, req)
+	if err != nil {
+		log.Errorf("Failed to publish message %+v with key %+v. Errors %s", req, route, err.Error())
+	}
+
+	return &pb.DrainSystemResponse{
+		System: info,
+	}, nil
+}
+
+func (l *LookupServer) ResumeSystemForOrg(ctx context.Context, req *pb.ResumeSystemRequest) (*pb.ResumeSystemResponse, error) {
+	log.Infof("Resuming system %s of org %s", req.GetSystemName(), req.GetOrgName())
+
+	info, err := l.setDrain(req.SystemName, req.OrgName, false, pgtype.Inet{Status: pgtype.Null}, 0, "")
+	if err != nil {
+		return nil, err
+	}
+
+	route := l.baseRoutingKey.SetAction("resume").SetObject("system").SetGlobalScope().MustBuild()
+	err = l.msgbus.PublishRequest(route, req)
+	if err != nil {
+		log.Errorf("Failed to publish message %+v with key %+v. Errors %s", req, route, err.Error())
+	}
+
+	return &pb.ResumeSystemResponse{
+		System: info,
+	}, nil
+}
+
+func (l *LookupServer) setDrain(sysName, orgName string, draining bool, ip pgtype.Inet, port int32, url string) (*pb.SystemInfo, error) {
+	org, err := l.orgRepo.GetByName(orgName)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "org")
+	}
+
+	err = l.systemRepo.SetDrain(sysName, org.ID, draining, ip, port, url)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "system")
+	}
+
+	system, err := l.getSystem(sysName, org.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.systemInfo(system, org.Name), nil
+}
+
+func (l *LookupServer) systemInfo(system *db.System, orgName string) *pb.SystemInfo {
+	return &pb.SystemInfo{
+		SystemName:   system.Name,
+		SystemId:     system.Uuid,
+		OrgName:      orgName,
+		Version:      system.Version,
+		Status:       l.systemStatus(system),
+		LastSeen:     lastSeen(system),
+		ApiGwHealth:  system.ApiGwHealth,
+		NodeGwHealth: system.NodeGwHealth,
+		ApiGwUrl:     system.ApiGwUrl,
+		Draining:     system.Draining,
+		DrainIp:      inetToString(system.DrainIp),
+		DrainPort:    system.DrainPort,
+		DrainUrl:     system.DrainUrl,
+	}
+}
+
+func (l *LookupServer) systemStatus(system *db.System) string {
+	switch {
+	case system.Draining:
+		return SystemStatusDraining
+	case system.LastSeen == nil:
+		return SystemStatusUnknown
+	case time.Since(*system.LastSeen) > l.staleAfter:
+		return SystemStatusStale
+	default:
+		return SystemStatusUp
+	}
+}
+
+func lastSeen(system *db.System) *timestamppb.Timestamp {
+	if system.LastSeen == nil {
+		return nil
+	}
+
+	return timestamppb.New(*system.LastSeen)
+}
+
+func inetToString(ip pgtype.Inet) string {
+	if ip.Status != pgtype.Present || ip.IPNet == nil {
+		return ""
+	}
+
+	return ip.IPNet.IP.String()
+}
+
 func invalidNodeIdError(nodeId string, err error) error {
 	return status.Errorf(codes.InvalidArgument, "invalid node id %s. Error %s", nodeId, err.Error())
 }
@@ -12,6 +12,7 @@ import (
 	"context"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgtype"
 	mbmocks "github.com/ukama/ukama/systems/common/mocks"
@@ -53,7 +54,7 @@ func TestLookupServer_AddOrg(t *testing.T) {
 	orgRepo.On("GetByName", org.Name).Return(org, nil).Once()
 	msgbusClient.On("PublishRequest", mock.Anything, porg).Return(nil).Once()
 
-	s := NewLookupServer(nil, orgRepo, nil, msgbusClient, orgName)
+	s := NewLookupServer(nil, orgRepo, nil, nil, nil, msgbusClient, orgName, time.Minute)
 	_, err = s.AddOrg(context.TODO(), porg)
 
 	assert.NoError(t, err)
@@ -82,7 +83,7 @@ func TestLookupServer_UpdateOrg(t *testing.T) {
 	orgRepo.On("GetByName", org.Name).Return(org, nil).Once()
 	msgbusClient.On("PublishRequest", mock.Anything, porg).Return(nil).Once()
 
-	s := NewLookupServer(nil, orgRepo, nil, msgbusClient, orgName)
+	s := NewLookupServer(nil, orgRepo, nil, nil, nil, msgbusClient, orgName, time.Minute)
 	_, err = s.UpdateOrg(context.TODO(), porg)
 
 	assert.NoError(t, err)
@@ -106,7 +107,7 @@ func TestLookupServer_GetOrg(t *testing.T) {
 
 	orgRepo.On("GetByName", org.Name).Return(org, nil).Once()
 
-	s := NewLookupServer(nil, orgRepo, nil, msgbusClient, orgName)
+	s := NewLookupServer(nil, orgRepo, nil, nil, nil, msgbusClient, orgName, time.Minute)
 	resp, err := s.GetOrg(context.TODO(), &pb.GetOrgRequest{OrgName: "ukama"})
 
 	assert.NoError(t, err)
@@ -129,7 +130,7 @@ func TestLookupServer_GetOrgsName(t *testing.T) {
 
 	orgRepo.On("GetAll").Return(org, nil).Once()
 
-	s := NewLookupServer(nil, orgRepo, nil, msgbusClient, orgName)
+	s := NewLookupServer(nil, orgRepo, nil, nil, nil, msgbusClient, orgName, time.Minute)
 	resp, err := s.GetOrgs(context.TODO(), &pb.GetOrgsRequest{})
 
 	assert.NoError(t, err)
@@ -166,7 +167,7 @@ func TestLookupServer_AddNodeForOrg(t *testing.T) {
 	nodeRepo.On("Get", testNodeId).Return(node, nil).Once()
 	msgbusClient.On("PublishRequest", mock.Anything, pnode).Return(nil).Once()
 
-	s := NewLookupServer(nodeRepo, orgRepo, nil, msgbusClient, orgName)
+	s := NewLookupServer(nodeRepo, orgRepo, nil, nil, nil, msgbusClient, orgName, time.Minute)
 	_, err = s.AddNodeForOrg(context.TODO(), pnode)
 
 	assert.NoError(t, err)
@@ -176,6 +177,7 @@ func TestLookupServer_AddNodeForOrg(t *testing.T) {
 func TestLookupServer_GetNode(t *testing.T) {
 	orgRepo := &mocks.OrgRepo{}
 	nodeRepo := &mocks.NodeRepo{}
+	transferRepo := &mocks.NodeTransferRepo{}
 	msgbusClient := &mbmocks.MsgBusServiceClient{}
 
 	nodeStr := testNodeId.StringLowercase()
@@ -197,8 +199,9 @@ func TestLookupServer_GetNode(t *testing.T) {
 	}
 
 	nodeRepo.On("Get", testNodeId).Return(node, nil).Once()
+	transferRepo.On("GetPending", testNodeId).Return(nil, gorm.ErrRecordNotFound).Once()
 
-	s := NewLookupServer(nodeRepo, orgRepo, nil, msgbusClient, orgName)
+	s := NewLookupServer(nodeRepo, orgRepo, nil, transferRepo, nil, msgbusClient, orgName, time.Minute)
 	resp, err := s.GetNode(context.TODO(), &pb.GetNodeRequest{NodeId: nodeStr})
 
 	assert.NoError(t, err)
@@ -209,6 +212,7 @@ func TestLookupServer_GetNode(t *testing.T) {
 func TestLookupServer_GetNodeForOrg(t *testing.T) {
 	orgRepo := &mocks.OrgRepo{}
 	nodeRepo := &mocks.NodeRepo{}
+	transferRepo := &mocks.NodeTransferRepo{}
 	msgbusClient := &mbmocks.MsgBusServiceClient{}
 
 	nodeStr := testNodeId.StringLowercase()
@@ -231,8 +235,9 @@ func TestLookupServer_GetNodeForOrg(t *testing.T) {
 
 	orgRepo.On("GetByName", org.Name).Return(org, nil).Once()
 	nodeRepo.On("Get", testNodeId).Return(node, nil).Once()
+	transferRepo.On("GetPending", testNodeId).Return(nil, gorm.ErrRecordNotFound).Once()
 
-	s := NewLookupServer(nodeRepo, orgRepo, nil, msgbusClient, orgName)
+	s := NewLookupServer(nodeRepo, orgRepo, nil, transferRepo, nil, msgbusClient, orgName, time.Minute)
 	resp, err := s.GetNodeForOrg(context.TODO(), &pb.GetNodeForOrgRequest{NodeId: nodeStr, OrgName: "ukama"})
 
 	assert.NoError(t, err)
@@ -264,7 +269,7 @@ func TestLookupServer_DeleteNodeForOrg(t *testing.T) {
 	nodeRepo.On("Delete", testNodeId).Return(nil).Once()
 	msgbusClient.On("PublishRequest", mock.Anything, pnode).Return(nil).Once()
 
-	s := NewLookupServer(nodeRepo, orgRepo, nil, msgbusClient, orgName)
+	s := NewLookupServer(nodeRepo, orgRepo, nil, nil, nil, msgbusClient, orgName, time.Minute)
 	_, err = s.DeleteNodeForOrg(context.TODO(), pnode)
 
 	assert.NoError(t, err)
@@ -309,7 +314,7 @@ func TestLookupServer_GetSystemForOrg(t *testing.T) {
 	orgRepo.On("GetByName", org.Name).Return(org, nil).Once()
 	systemRepo.On("GetByName", system.Name, org.ID).Return(system, nil).Once()
 
-	s := NewLookupServer(nil, orgRepo, systemRepo, msgbusClient, orgName)
+	s := NewLookupServer(nil, orgRepo, systemRepo, nil, nil, msgbusClient, orgName, time.Minute)
 	resp, err := s.GetSystemForOrg(context.TODO(), &pb.GetSystemRequest{SystemName: system.Name, OrgName: "ukama"})
 
 	assert.NoError(t, err)
@@ -375,7 +380,7 @@ func TestLookupServer_UpdateSystemForOrg(t *testing.T) {
 	systemRepo.On("GetByName", system.Name, org.ID).Return(system, nil).Once()
 	msgbusClient.On("PublishRequest", mock.Anything, psys).Return(nil).Once()
 
-	s := NewLookupServer(nil, orgRepo, systemRepo, msgbusClient, orgName)
+	s := NewLookupServer(nil, orgRepo, systemRepo, nil, nil, msgbusClient, orgName, time.Minute)
 	_, err = s.UpdateSystemForOrg(context.TODO(), psys)
 
 	assert.NoError(t, err)
@@ -418,10 +423,161 @@ func TestLookupServer_DeleteSystemForOrg(t *testing.T) {
 	systemRepo.On("Delete", system.Name, org.ID).Return(nil).Once()
 	msgbusClient.On("PublishRequest", mock.Anything, psys).Return(nil).Once()
 
-	s := NewLookupServer(nil, orgRepo, systemRepo, msgbusClient, orgName)
+	s := NewLookupServer(nil, orgRepo, systemRepo, nil, nil, msgbusClient, orgName, time.Minute)
 	_, err = s.DeleteSystemForOrg(context.TODO(), psys)
 
 	assert.NoError(t, err)
 	orgRepo.AssertExpectations(t)
 
 }
+
+func TestLookupServer_SystemHeartbeat(t *testing.T) {
+	orgRepo := &mocks.OrgRepo{}
+	systemRepo := &mocks.SystemRepo{}
+
+	org := &db.Org{Model: gorm.Model{ID: 1}, Name: "ukama"}
+
+	var drainIp pgtype.Inet
+	assert.NoError(t, drainIp.Set("10.0.0.2"))
+
+	system := &db.System{
+		Name:      "messaging",
+		Draining:  true,
+		DrainIp:   drainIp,
+		DrainPort: 443,
+	}
+
+	orgRepo.On("GetByName", org.Name).Return(org, nil).Once()
+	systemRepo.On("Heartbeat", system.Name, org.ID, "v1.2.0", uint32(100), uint32(90)).Return(nil).Once()
+	systemRepo.On("GetByName", system.Name, org.ID).Return(system, nil).Once()
+
+	s := NewLookupServer(nil, orgRepo, systemRepo, nil, nil, nil, orgName, time.Minute)
+	resp, err := s.SystemHeartbeat(context.TODO(), &pb.SystemHeartbeatRequest{
+		SystemName:   system.Name,
+		OrgName:      org.Name,
+		Version:      "v1.2.0",
+		ApiGwHealth:  100,
+		NodeGwHealth: 90,
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Draining)
+	assert.Equal(t, "10.0.0.2", resp.DrainIp)
+	assert.Equal(t, int32(443), resp.DrainPort)
+	orgRepo.AssertExpectations(t)
+	systemRepo.AssertExpectations(t)
+}
+
+func TestLookupServer_GetSystems(t *testing.T) {
+	systemRepo := &mocks.SystemRepo{}
+
+	recent := time.Now().Add(-10 * time.Second)
+	old := time.Now().Add(-time.Hour)
+
+	systemRepo.On("List", uint(0)).Return([]db.System{
+		{Name: "registry", Version: "v1.0.0", LastSeen: &recent, Org: db.Org{Name: "ukama"}},
+		{Name: "subscriber", LastSeen: &old, Org: db.Org{Name: "ukama"}},
+		{Name: "billing", Org: db.Org{Name: "other"}},
+		{Name: "messaging", LastSeen: &recent, Draining: true, Org: db.Org{Name: "other"}},
+	}, nil).Once()
+
+	s := NewLookupServer(nil, nil, systemRepo, nil, nil, nil, orgName, time.Minute)
+	resp, err := s.GetSystems(context.TODO(), &pb.GetSystemsRequest{})
+
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Systems, 4) {
+		assert.Equal(t, SystemStatusUp, resp.Systems[0].Status)
+		assert.Equal(t, "v1.0.0", resp.Systems[0].Version)
+		assert.NotNil(t, resp.Systems[0].LastSeen)
+		assert.Equal(t, SystemStatusStale, resp.Systems[1].Status)
+		assert.Equal(t, SystemStatusUnknown, resp.Systems[2].Status)
+		assert.Nil(t, resp.Systems[2].LastSeen)
+		assert.Equal(t, "other", resp.Systems[2].OrgName)
+		assert.Equal(t, SystemStatusDraining, resp.Systems[3].Status)
+	}
+	systemRepo.AssertExpectations(t)
+}
+
+func TestLookupServer_DrainSystemForOrg(t *testing.T) {
+	org := &db.Org{Model: gorm.Model{ID: 1}, Name: "ukama"}
+
+	t.Run("Drain", func(t *testing.T) {
+		orgRepo := &mocks.OrgRepo{}
+		systemRepo := &mocks.SystemRepo{}
+		msgbusClient := &mbmocks.MsgBusServiceClient{}
+
+		var drainIp pgtype.Inet
+		assert.NoError(t, drainIp.Set("10.0.0.2"))
+
+		req := &pb.DrainSystemRequest{SystemName: "messaging", OrgName: org.Name, DrainIp: "10.0.0.2", DrainPort: 443}
+
+		orgRepo.On("GetByName", org.Name).Return(org, nil).Once()
+		systemRepo.On("SetDrain", req.SystemName, org.ID, true, mock.MatchedBy(func(ip pgtype.Inet) bool {
+			return ip.Status == pgtype.Present && ip.IPNet.IP.String() == "10.0.0.2"
+		}), int32(443), "").Return(nil).Once()
+		systemRepo.On("GetByName", req.SystemName, org.ID).Return(&db.System{
+			Name: req.SystemName, Draining: true, DrainIp: drainIp, DrainPort: 443,
+		}, nil).Once()
+		msgbusClient.On("PublishRequest", "event.cloud.global.testorg.init.lookup.system.drain", req).Return(nil).Once()
+
+		s := NewLookupServer(nil, orgRepo, systemRepo, nil, nil, msgbusClient, orgName, time.Minute)
+		resp, err := s.DrainSystemForOrg(context.TODO(), req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, SystemStatusDraining, resp.System.Status)
+		assert.Equal(t, "10.0.0.2", resp.System.DrainIp)
+		systemRepo.AssertExpectations(t)
+		msgbusClient.AssertExpectations(t)
+	})
+
+	t.Run("InvalidIp", func(t *testing.T) {
+		s := NewLookupServer(nil, nil, nil, nil, nil, nil, orgName, time.Minute)
+		_, err := s.DrainSystemForOrg(context.TODO(), &pb.DrainSystemRequest{
+			SystemName: "messaging", OrgName: org.Name, DrainIp: "not-an-ip",
+		})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("SystemNotFound", func(t *testing.T) {
+		orgRepo := &mocks.OrgRepo{}
+		systemRepo := &mocks.SystemRepo{}
+
+		orgRepo.On("GetByName", org.Name).Return(org, nil).Once()
+		systemRepo.On("SetDrain", "unknown", org.ID, true, mock.Anything, int32(0), "").Return(gorm.ErrRecordNotFound).Once()
+
+		s := NewLookupServer(nil, orgRepo, systemRepo, nil, nil, nil, orgName, time.Minute)
+		_, err := s.DrainSystemForOrg(context.TODO(), &pb.DrainSystemRequest{
+			SystemName: "unknown", OrgName: org.Name, DrainIp: "10.0.0.2",
+		})
+
+		assert.Error(t, err)
+		systemRepo.AssertExpectations(t)
+	})
+}
+
+func TestLookupServer_ResumeSystemForOrg(t *testing.T) {
+	orgRepo := &mocks.OrgRepo{}
+	systemRepo := &mocks.SystemRepo{}
+	msgbusClient := &mbmocks.MsgBusServiceClient{}
+
+	org := &db.Org{Model: gorm.Model{ID: 1}, Name: "ukama"}
+	now := time.Now()
+	req := &pb.ResumeSystemRequest{SystemName: "messaging", OrgName: org.Name}
+
+	orgRepo.On("GetByName", org.Name).Return(org, nil).Once()
+	systemRepo.On("SetDrain", req.SystemName, org.ID, false, mock.MatchedBy(func(ip pgtype.Inet) bool {
+		return ip.Status == pgtype.Null
+	}), int32(0), "").Return(nil).Once()
+	systemRepo.On("GetByName", req.SystemName, org.ID).Return(&db.System{Name: req.SystemName, LastSeen: &now}, nil).Once()
+	msgbusClient.On("PublishRequest", "event.cloud.global.testorg.init.lookup.system.resume", req).Return(nil).Once()
+
+	s := NewLookupServer(nil, orgRepo, systemRepo, nil, nil, msgbusClient, orgName, time.Minute)
+	resp, err := s.ResumeSystemForOrg(context.TODO(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, SystemStatusUp, resp.System.Status)
+	assert.False(t, resp.System.Draining)
+	systemRepo.AssertExpectations(t)
+	msgbusClient.AssertExpectations(t)
+}
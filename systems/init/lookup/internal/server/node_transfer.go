@@ -0,0 +1,297 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/ukama/ukama/systems/common/grpc"
+	"github.com/ukama/ukama/systems/common/rest/client/factory"
+	"github.com/ukama/ukama/systems/common/ukama"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/init/lookup/internal/db"
+	pb "github.com/ukama/ukama/systems/init/lookup/pb/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// ClaimNode binds a node nobody owns to an org, given the claim code printed
+// on it by the factory.
+func (l *LookupServer) ClaimNode(ctx context.Context, req *pb.ClaimNodeRequest) (*pb.ClaimNodeResponse, error) {
+	log.Infof("Claiming node %s for org %s", req.GetNodeId(), req.GetOrgName())
+
+	nodeId, err := ukama.ValidateNodeId(req.NodeId)
+	if err != nil {
+		return nil, invalidNodeIdError(req.NodeId, err)
+	}
+
+	org, err := l.getOrg(req.OrgName)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := l.nodeRepo.Get(nodeId)
+	if err == nil {
+		if node.OrgID == org.ID {
+			return nil, status.Errorf(codes.AlreadyExists, "node %s already belongs to org %s", req.NodeId, req.OrgName)
+		}
+
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"node %s belongs to another org and has to be released by it", req.NodeId)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, grpc.SqlErrorToGrpc(err, "node")
+	}
+
+	err = l.verifyClaim(nodeId, req.ClaimCode)
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.nodeRepo.AddOrUpdate(&db.Node{NodeID: nodeId.StringLowercase(), OrgID: org.ID})
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "node")
+	}
+
+	resp := &pb.ClaimNodeResponse{
+		NodeId:  nodeId.StringLowercase(),
+		OrgName: org.Name,
+	}
+
+	l.publishNodeEvent("claim", "node", resp)
+
+	return resp, nil
+}
+
+// ReleaseNode lets the owner of a node hand it over. The node stays with its
+// org until another org, or the one named in the request, accepts it.
+func (l *LookupServer) ReleaseNode(ctx context.Context, req *pb.ReleaseNodeRequest) (*pb.ReleaseNodeResponse, error) {
+	log.Infof("Releasing node %s of org %s to %q", req.GetNodeId(), req.GetOrgName(), req.GetToOrgName())
+
+	nodeId, err := ukama.ValidateNodeId(req.NodeId)
+	if err != nil {
+		return nil, invalidNodeIdError(req.NodeId, err)
+	}
+
+	org, err := l.getOrg(req.OrgName)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := l.nodeRepo.Get(nodeId)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "node")
+	}
+
+	if node.OrgID != org.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "node %s does not belong to org %s", req.NodeId, req.OrgName)
+	}
+
+	if req.ToOrgName != "" {
+		if req.ToOrgName == org.Name {
+			return nil, status.Errorf(codes.InvalidArgument, "node %s can't be released to its own org", req.NodeId)
+		}
+
+		if _, err := l.getOrg(req.ToOrgName); err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = l.transferRepo.GetPending(nodeId)
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "node %s is already released", req.NodeId)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, grpc.SqlErrorToGrpc(err, "transfer")
+	}
+
+	transfer := &db.NodeTransfer{
+		Id:         uuid.NewV4(),
+		NodeID:     node.NodeID,
+		FromOrgID:  org.ID,
+		FromOrg:    *org,
+		ToOrgName:  req.ToOrgName,
+		Status:     db.TransferPending,
+		ReleasedBy: req.ReleasedBy,
+	}
+
+	err = l.transferRepo.Add(transfer)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "transfer")
+	}
+
+	resp := &pb.ReleaseNodeResponse{
+		Transfer: nodeTransferToPb(transfer),
+	}
+
+	l.publishNodeEvent("create", "transfer", resp.Transfer)
+
+	return resp, nil
+}
+
+// AcceptNode completes the transfer of a released node. Being handed the
+// node is proven with its claim code, as for a first claim.
+func (l *LookupServer) AcceptNode(ctx context.Context, req *pb.AcceptNodeRequest) (*pb.AcceptNodeResponse, error) {
+	log.Infof("Accepting node %s for org %s", req.GetNodeId(), req.GetOrgName())
+
+	nodeId, err := ukama.ValidateNodeId(req.NodeId)
+	if err != nil {
+		return nil, invalidNodeIdError(req.NodeId, err)
+	}
+
+	org, err := l.getOrg(req.OrgName)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := l.getPendingTransfer(nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if transfer.FromOrgID == org.ID {
+		return nil, status.Errorf(codes.FailedPrecondition, "node %s already belongs to org %s", req.NodeId, req.OrgName)
+	}
+
+	if transfer.ToOrgName != "" && transfer.ToOrgName != org.Name {
+		return nil, status.Errorf(codes.PermissionDenied, "node %s is not released to org %s", req.NodeId, req.OrgName)
+	}
+
+	err = l.verifyClaim(nodeId, req.ClaimCode)
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.transferRepo.Accept(transfer, org, req.AcceptedBy)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "transfer")
+	}
+
+	resp := &pb.AcceptNodeResponse{
+		Transfer: nodeTransferToPb(transfer),
+	}
+
+	l.publishNodeEvent("accept", "transfer", resp.Transfer)
+
+	return resp, nil
+}
+
+func (l *LookupServer) CancelNodeRelease(ctx context.Context, req *pb.CancelNodeReleaseRequest) (*pb.CancelNodeReleaseResponse, error) {
+	log.Infof("Cancelling release of node %s by org %s", req.GetNodeId(), req.GetOrgName())
+
+	nodeId, err := ukama.ValidateNodeId(req.NodeId)
+	if err != nil {
+		return nil, invalidNodeIdError(req.NodeId, err)
+	}
+
+	org, err := l.getOrg(req.OrgName)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := l.getPendingTransfer(nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if transfer.FromOrgID != org.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "node %s is not released by org %s", req.NodeId, req.OrgName)
+	}
+
+	err = l.transferRepo.Cancel(transfer.Id)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "transfer")
+	}
+
+	transfer.Status = db.TransferCancelled
+
+	resp := &pb.CancelNodeReleaseResponse{
+		Transfer: nodeTransferToPb(transfer),
+	}
+
+	l.publishNodeEvent("cancel", "transfer", resp.Transfer)
+
+	return resp, nil
+}
+
+func (l *LookupServer) nodeResponse(nodeId ukama.NodeID, node *db.Node) (*pb.GetNodeResponse, error) {
+	resp := &pb.GetNodeResponse{
+		NodeId:          node.NodeID,
+		OrgName:         node.Org.Name,
+		Certificate:     node.Org.Certificate,
+		Ip:              node.Org.Ip.IPNet.String(),
+		PreviousOrgName: node.PreviousOrg,
+	}
+
+	_, err := l.transferRepo.GetPending(nodeId)
+	if err == nil {
+		resp.TransferPending = true
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, grpc.SqlErrorToGrpc(err, "transfer")
+	}
+
+	return resp, nil
+}
+
+func (l *LookupServer) getPendingTransfer(nodeId ukama.NodeID) (*db.NodeTransfer, error) {
+	transfer, err := l.transferRepo.GetPending(nodeId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "node %s is not released", nodeId.String())
+		}
+
+		return nil, grpc.SqlErrorToGrpc(err, "transfer")
+	}
+
+	return transfer, nil
+}
+
+func (l *LookupServer) verifyClaim(nodeId ukama.NodeID, code string) error {
+	err := l.factoryClient.VerifyClaim(nodeId.String(), code)
+	if err != nil {
+		if errors.Is(err, factory.ErrInvalidClaimCode) {
+			return status.Errorf(codes.PermissionDenied, "invalid claim code for node %s", nodeId.String())
+		}
+
+		return status.Errorf(codes.Unavailable, "failed to verify claim code of node %s. Error %s", nodeId.String(), err.Error())
+	}
+
+	return nil
+}
+
+func (l *LookupServer) publishNodeEvent(action, object string, msg proto.Message) {
+	route := l.baseRoutingKey.SetAction(action).SetObject(object).MustBuild()
+
+	err := l.msgbus.PublishRequest(route, msg)
+	if err != nil {
+		log.Errorf("Failed to publish message %+v with key %+v. Errors %s", msg, route, err.Error())
+	}
+}
+
+func nodeTransferToPb(t *db.NodeTransfer) *pb.NodeTransfer {
+	transfer := &pb.NodeTransfer{
+		Id:          t.Id.String(),
+		NodeId:      t.NodeID,
+		FromOrgName: t.FromOrg.Name,
+		ToOrgName:   t.ToOrgName,
+		Status:      t.Status.String(),
+		ReleasedBy:  t.ReleasedBy,
+		AcceptedBy:  t.AcceptedBy,
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+	}
+
+	if t.CompletedAt != nil {
+		transfer.CompletedAt = timestamppb.New(*t.CompletedAt)
+	}
+
+	return transfer
+}
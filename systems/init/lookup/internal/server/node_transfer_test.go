@@ -0,0 +1,341 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"gorm.io/gorm"
+
+	cmocks "github.com/ukama/ukama/systems/common/mocks"
+	"github.com/ukama/ukama/systems/common/rest/client/factory"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/init/lookup/internal/db"
+	"github.com/ukama/ukama/systems/init/lookup/mocks"
+
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	pb "github.com/ukama/ukama/systems/init/lookup/pb/gen"
+)
+
+const testClaimCode = "ABCD-1234"
+
+var (
+	fromOrg = &db.Org{Model: gorm.Model{ID: 1}, Name: "from-org"}
+	toOrg   = &db.Org{Model: gorm.Model{ID: 2}, Name: "to-org"}
+)
+
+type transferTest struct {
+	nodeRepo     *mocks.NodeRepo
+	orgRepo      *mocks.OrgRepo
+	transferRepo *mocks.NodeTransferRepo
+	factory      *cmocks.NodeFactoryClient
+	msgbus       *cmocks.MsgBusServiceClient
+	s            *LookupServer
+}
+
+func newTransferTest() *transferTest {
+	tt := &transferTest{
+		nodeRepo:     &mocks.NodeRepo{},
+		orgRepo:      &mocks.OrgRepo{},
+		transferRepo: &mocks.NodeTransferRepo{},
+		factory:      &cmocks.NodeFactoryClient{},
+		msgbus:       &cmocks.MsgBusServiceClient{},
+	}
+
+	tt.s = NewLookupServer(tt.nodeRepo, tt.orgRepo, nil, tt.transferRepo, tt.factory, tt.msgbus, orgName, time.Minute)
+
+	return tt
+}
+
+func (tt *transferTest) assertExpectations(t *testing.T) {
+	tt.nodeRepo.AssertExpectations(t)
+	tt.orgRepo.AssertExpectations(t)
+	tt.transferRepo.AssertExpectations(t)
+	tt.factory.AssertExpectations(t)
+	tt.msgbus.AssertExpectations(t)
+}
+
+func TestLookupServer_ClaimNode(t *testing.T) {
+	nodeStr := testNodeId.StringLowercase()
+	req := &pb.ClaimNodeRequest{NodeId: nodeStr, OrgName: toOrg.Name, ClaimCode: testClaimCode}
+
+	t.Run("Claimed", func(t *testing.T) {
+		tt := newTransferTest()
+
+		tt.orgRepo.On("GetByName", toOrg.Name).Return(toOrg, nil).Once()
+		tt.nodeRepo.On("Get", testNodeId).Return(nil, gorm.ErrRecordNotFound).Once()
+		tt.factory.On("VerifyClaim", testNodeId.String(), testClaimCode).Return(nil).Once()
+		tt.nodeRepo.On("AddOrUpdate", &db.Node{NodeID: nodeStr, OrgID: toOrg.ID}).Return(nil).Once()
+		tt.msgbus.On("PublishRequest", "event.cloud.local.testorg.init.lookup.node.claim",
+			&pb.ClaimNodeResponse{NodeId: nodeStr, OrgName: toOrg.Name}).Return(nil).Once()
+
+		resp, err := tt.s.ClaimNode(context.TODO(), req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, toOrg.Name, resp.OrgName)
+		tt.assertExpectations(t)
+	})
+
+	t.Run("InvalidClaimCode", func(t *testing.T) {
+		tt := newTransferTest()
+
+		tt.orgRepo.On("GetByName", toOrg.Name).Return(toOrg, nil).Once()
+		tt.nodeRepo.On("Get", testNodeId).Return(nil, gorm.ErrRecordNotFound).Once()
+		tt.factory.On("VerifyClaim", testNodeId.String(), testClaimCode).Return(factory.ErrInvalidClaimCode).Once()
+
+		_, err := tt.s.ClaimNode(context.TODO(), req)
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		tt.assertExpectations(t)
+	})
+
+	t.Run("FactoryUnavailable", func(t *testing.T) {
+		tt := newTransferTest()
+
+		tt.orgRepo.On("GetByName", toOrg.Name).Return(toOrg, nil).Once()
+		tt.nodeRepo.On("Get", testNodeId).Return(nil, gorm.ErrRecordNotFound).Once()
+		tt.factory.On("VerifyClaim", testNodeId.String(), testClaimCode).Return(errors.New("connection refused")).Once()
+
+		_, err := tt.s.ClaimNode(context.TODO(), req)
+
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("OwnedByAnotherOrg", func(t *testing.T) {
+		tt := newTransferTest()
+
+		tt.orgRepo.On("GetByName", toOrg.Name).Return(toOrg, nil).Once()
+		tt.nodeRepo.On("Get", testNodeId).Return(&db.Node{NodeID: nodeStr, OrgID: fromOrg.ID}, nil).Once()
+
+		_, err := tt.s.ClaimNode(context.TODO(), req)
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		tt.factory.AssertNotCalled(t, "VerifyClaim", mock.Anything, mock.Anything)
+	})
+}
+
+func TestLookupServer_ReleaseNode(t *testing.T) {
+	nodeStr := testNodeId.StringLowercase()
+
+	t.Run("Released", func(t *testing.T) {
+		tt := newTransferTest()
+
+		tt.orgRepo.On("GetByName", fromOrg.Name).Return(fromOrg, nil).Once()
+		tt.orgRepo.On("GetByName", toOrg.Name).Return(toOrg, nil).Once()
+		tt.nodeRepo.On("Get", testNodeId).Return(&db.Node{NodeID: nodeStr, OrgID: fromOrg.ID}, nil).Once()
+		tt.transferRepo.On("GetPending", testNodeId).Return(nil, gorm.ErrRecordNotFound).Once()
+		tt.transferRepo.On("Add", mock.MatchedBy(func(tr *db.NodeTransfer) bool {
+			return tr.NodeID == nodeStr && tr.FromOrgID == fromOrg.ID && tr.ToOrgName == toOrg.Name &&
+				tr.Status == db.TransferPending && tr.ReleasedBy == "owner"
+		})).Return(nil).Once()
+		tt.msgbus.On("PublishRequest", "event.cloud.local.testorg.init.lookup.transfer.create",
+			mock.AnythingOfType("*gen.NodeTransfer")).Return(nil).Once()
+
+		resp, err := tt.s.ReleaseNode(context.TODO(), &pb.ReleaseNodeRequest{
+			NodeId: nodeStr, OrgName: fromOrg.Name, ToOrgName: toOrg.Name, ReleasedBy: "owner",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "pending", resp.Transfer.Status)
+		assert.Equal(t, fromOrg.Name, resp.Transfer.FromOrgName)
+		tt.assertExpectations(t)
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		tt := newTransferTest()
+
+		tt.orgRepo.On("GetByName", toOrg.Name).Return(toOrg, nil).Once()
+		tt.nodeRepo.On("Get", testNodeId).Return(&db.Node{NodeID: nodeStr, OrgID: fromOrg.ID}, nil).Once()
+
+		_, err := tt.s.ReleaseNode(context.TODO(), &pb.ReleaseNodeRequest{NodeId: nodeStr, OrgName: toOrg.Name})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		tt.assertExpectations(t)
+	})
+
+	t.Run("AlreadyReleased", func(t *testing.T) {
+		tt := newTransferTest()
+
+		tt.orgRepo.On("GetByName", fromOrg.Name).Return(fromOrg, nil).Once()
+		tt.nodeRepo.On("Get", testNodeId).Return(&db.Node{NodeID: nodeStr, OrgID: fromOrg.ID}, nil).Once()
+		tt.transferRepo.On("GetPending", testNodeId).Return(&db.NodeTransfer{}, nil).Once()
+
+		_, err := tt.s.ReleaseNode(context.TODO(), &pb.ReleaseNodeRequest{NodeId: nodeStr, OrgName: fromOrg.Name})
+
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		tt.assertExpectations(t)
+	})
+}
+
+func TestLookupServer_AcceptNode(t *testing.T) {
+	nodeStr := testNodeId.StringLowercase()
+	req := &pb.AcceptNodeRequest{NodeId: nodeStr, OrgName: toOrg.Name, ClaimCode: testClaimCode, AcceptedBy: "buyer"}
+
+	pending := func(to string) *db.NodeTransfer {
+		return &db.NodeTransfer{
+			Id:        uuid.NewV4(),
+			NodeID:    nodeStr,
+			FromOrgID: fromOrg.ID,
+			FromOrg:   *fromOrg,
+			ToOrgName: to,
+			Status:    db.TransferPending,
+		}
+	}
+
+	t.Run("Accepted", func(t *testing.T) {
+		tt := newTransferTest()
+		transfer := pending("")
+
+		tt.orgRepo.On("GetByName", toOrg.Name).Return(toOrg, nil).Once()
+		tt.transferRepo.On("GetPending", testNodeId).Return(transfer, nil).Once()
+		tt.factory.On("VerifyClaim", testNodeId.String(), testClaimCode).Return(nil).Once()
+		tt.transferRepo.On("Accept", transfer, toOrg, "buyer").Return(nil).Run(func(args mock.Arguments) {
+			tr := args.Get(0).(*db.NodeTransfer)
+			tr.Status = db.TransferAccepted
+			tr.ToOrgName = toOrg.Name
+			tr.AcceptedBy = "buyer"
+		}).Once()
+		tt.msgbus.On("PublishRequest", "event.cloud.local.testorg.init.lookup.transfer.accept",
+			mock.AnythingOfType("*gen.NodeTransfer")).Return(nil).Once()
+
+		resp, err := tt.s.AcceptNode(context.TODO(), req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "accepted", resp.Transfer.Status)
+		assert.Equal(t, toOrg.Name, resp.Transfer.ToOrgName)
+		tt.assertExpectations(t)
+	})
+
+	t.Run("ReleasedToAnotherOrg", func(t *testing.T) {
+		tt := newTransferTest()
+
+		tt.orgRepo.On("GetByName", toOrg.Name).Return(toOrg, nil).Once()
+		tt.transferRepo.On("GetPending", testNodeId).Return(pending("other-org"), nil).Once()
+
+		_, err := tt.s.AcceptNode(context.TODO(), req)
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		tt.assertExpectations(t)
+	})
+
+	t.Run("NotReleased", func(t *testing.T) {
+		tt := newTransferTest()
+
+		tt.orgRepo.On("GetByName", toOrg.Name).Return(toOrg, nil).Once()
+		tt.transferRepo.On("GetPending", testNodeId).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		_, err := tt.s.AcceptNode(context.TODO(), req)
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		tt.assertExpectations(t)
+	})
+
+	t.Run("InvalidClaimCode", func(t *testing.T) {
+		tt := newTransferTest()
+
+		tt.orgRepo.On("GetByName", toOrg.Name).Return(toOrg, nil).Once()
+		tt.transferRepo.On("GetPending", testNodeId).Return(pending(toOrg.Name), nil).Once()
+		tt.factory.On("VerifyClaim", testNodeId.String(), testClaimCode).Return(factory.ErrInvalidClaimCode).Once()
+
+		_, err := tt.s.AcceptNode(context.TODO(), req)
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		tt.assertExpectations(t)
+	})
+}
+
+func TestLookupServer_CancelNodeRelease(t *testing.T) {
+	nodeStr := testNodeId.StringLowercase()
+	transfer := &db.NodeTransfer{Id: uuid.NewV4(), NodeID: nodeStr, FromOrgID: fromOrg.ID, FromOrg: *fromOrg}
+
+	t.Run("Cancelled", func(t *testing.T) {
+		tt := newTransferTest()
+
+		tt.orgRepo.On("GetByName", fromOrg.Name).Return(fromOrg, nil).Once()
+		tt.transferRepo.On("GetPending", testNodeId).Return(transfer, nil).Once()
+		tt.transferRepo.On("Cancel", transfer.Id).Return(nil).Once()
+		tt.msgbus.On("PublishRequest", "event.cloud.local.testorg.init.lookup.transfer.cancel",
+			mock.AnythingOfType("*gen.NodeTransfer")).Return(nil).Once()
+
+		resp, err := tt.s.CancelNodeRelease(context.TODO(), &pb.CancelNodeReleaseRequest{NodeId: nodeStr, OrgName: fromOrg.Name})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "cancelled", resp.Transfer.Status)
+		tt.assertExpectations(t)
+	})
+
+	t.Run("NotReleasingOrg", func(t *testing.T) {
+		tt := newTransferTest()
+
+		tt.orgRepo.On("GetByName", toOrg.Name).Return(toOrg, nil).Once()
+		tt.transferRepo.On("GetPending", testNodeId).Return(transfer, nil).Once()
+
+		_, err := tt.s.CancelNodeRelease(context.TODO(), &pb.CancelNodeReleaseRequest{NodeId: nodeStr, OrgName: toOrg.Name})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		tt.assertExpectations(t)
+	})
+}
+
+func TestLookupServer_GetNodeTransferState(t *testing.T) {
+	nodeStr := testNodeId.StringLowercase()
+	tt := newTransferTest()
+
+	tt.nodeRepo.On("Get", testNodeId).Return(&db.Node{NodeID: nodeStr, OrgID: toOrg.ID, Org: *toOrg, PreviousOrg: fromOrg.Name}, nil).Once()
+	tt.transferRepo.On("GetPending", testNodeId).Return(&db.NodeTransfer{}, nil).Once()
+
+	resp, err := tt.s.GetNode(context.TODO(), &pb.GetNodeRequest{NodeId: nodeStr})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.TransferPending)
+	assert.Equal(t, fromOrg.Name, resp.PreviousOrgName)
+}
+
+func TestLookupEventServer_NodeCredentialsRevoked(t *testing.T) {
+	nodeStr := testNodeId.StringLowercase()
+	route := "event.cloud.local.testorg.init.bootstrap.node.revoke"
+
+	revoked := func(t *testing.T, previous string) *epb.Event {
+		msg, err := anypb.New(&pb.NodeCredentialsRevoked{NodeId: nodeStr, OrgName: toOrg.Name, PreviousOrgName: previous})
+		assert.NoError(t, err)
+
+		return &epb.Event{RoutingKey: route, Msg: msg}
+	}
+
+	t.Run("Cleared", func(t *testing.T) {
+		nodeRepo := &mocks.NodeRepo{}
+		nodeRepo.On("Get", testNodeId).Return(&db.Node{NodeID: nodeStr, PreviousOrg: fromOrg.Name}, nil).Once()
+		nodeRepo.On("ClearPreviousOrg", testNodeId).Return(nil).Once()
+
+		s := NewLookupEventServer(orgName, nodeRepo, nil, nil)
+		_, err := s.EventNotification(context.TODO(), revoked(t, fromOrg.Name))
+
+		assert.NoError(t, err)
+		nodeRepo.AssertExpectations(t)
+	})
+
+	t.Run("MovedAgain", func(t *testing.T) {
+		nodeRepo := &mocks.NodeRepo{}
+		nodeRepo.On("Get", testNodeId).Return(&db.Node{NodeID: nodeStr, PreviousOrg: "other-org"}, nil).Once()
+
+		s := NewLookupEventServer(orgName, nodeRepo, nil, nil)
+		_, err := s.EventNotification(context.TODO(), revoked(t, fromOrg.Name))
+
+		assert.NoError(t, err)
+		nodeRepo.AssertNotCalled(t, "ClearPreviousOrg", mock.Anything)
+	})
+}
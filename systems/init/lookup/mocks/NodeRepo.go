@@ -32,6 +32,24 @@ func (_m *NodeRepo) AddOrUpdate(node *db.Node) error {
 	return r0
 }
 
+// ClearPreviousOrg provides a mock function with given fields: nodeId
+func (_m *NodeRepo) ClearPreviousOrg(nodeId ukama.NodeID) error {
+	ret := _m.Called(nodeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearPreviousOrg")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(ukama.NodeID) error); ok {
+		r0 = rf(nodeId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Delete provides a mock function with given fields: nodeId
 func (_m *NodeRepo) Delete(nodeId ukama.NodeID) error {
 	ret := _m.Called(nodeId)
@@ -0,0 +1,115 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	db "github.com/ukama/ukama/systems/init/lookup/internal/db"
+
+	ukama "github.com/ukama/ukama/systems/common/ukama"
+
+	uuid "github.com/ukama/ukama/systems/common/uuid"
+)
+
+// NodeTransferRepo is an autogenerated mock type for the NodeTransferRepo type
+type NodeTransferRepo struct {
+	mock.Mock
+}
+
+// Accept provides a mock function with given fields: transfer, to, acceptedBy
+func (_m *NodeTransferRepo) Accept(transfer *db.NodeTransfer, to *db.Org, acceptedBy string) error {
+	ret := _m.Called(transfer, to, acceptedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Accept")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.NodeTransfer, *db.Org, string) error); ok {
+		r0 = rf(transfer, to, acceptedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Add provides a mock function with given fields: transfer
+func (_m *NodeTransferRepo) Add(transfer *db.NodeTransfer) error {
+	ret := _m.Called(transfer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.NodeTransfer) error); ok {
+		r0 = rf(transfer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Cancel provides a mock function with given fields: id
+func (_m *NodeTransferRepo) Cancel(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetPending provides a mock function with given fields: nodeId
+func (_m *NodeTransferRepo) GetPending(nodeId ukama.NodeID) (*db.NodeTransfer, error) {
+	ret := _m.Called(nodeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPending")
+	}
+
+	var r0 *db.NodeTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(ukama.NodeID) (*db.NodeTransfer, error)); ok {
+		return rf(nodeId)
+	}
+	if rf, ok := ret.Get(0).(func(ukama.NodeID) *db.NodeTransfer); ok {
+		r0 = rf(nodeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.NodeTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(ukama.NodeID) error); ok {
+		r1 = rf(nodeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewNodeTransferRepo creates a new instance of NodeTransferRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNodeTransferRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NodeTransferRepo {
+	mock := &NodeTransferRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -5,6 +5,8 @@ package mocks
 import (
 	mock "github.com/stretchr/testify/mock"
 	db "github.com/ukama/ukama/systems/init/lookup/internal/db"
+
+	pgtype "github.com/jackc/pgtype"
 )
 
 // SystemRepo is an autogenerated mock type for the SystemRepo type
@@ -78,6 +80,72 @@ func (_m *SystemRepo) GetByName(sys string, org uint) (*db.System, error) {
 	return r0, r1
 }
 
+// Heartbeat provides a mock function with given fields: sys, org, version, apiGwHealth, nodeGwHealth
+func (_m *SystemRepo) Heartbeat(sys string, org uint, version string, apiGwHealth uint32, nodeGwHealth uint32) error {
+	ret := _m.Called(sys, org, version, apiGwHealth, nodeGwHealth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Heartbeat")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint, string, uint32, uint32) error); ok {
+		r0 = rf(sys, org, version, apiGwHealth, nodeGwHealth)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: org
+func (_m *SystemRepo) List(org uint) ([]db.System, error) {
+	ret := _m.Called(org)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []db.System
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]db.System, error)); ok {
+		return rf(org)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []db.System); ok {
+		r0 = rf(org)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.System)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(org)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetDrain provides a mock function with given fields: sys, org, draining, ip, port, url
+func (_m *SystemRepo) SetDrain(sys string, org uint, draining bool, ip pgtype.Inet, port int32, url string) error {
+	ret := _m.Called(sys, org, draining, ip, port, url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDrain")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint, bool, pgtype.Inet, int32, string) error); ok {
+		r0 = rf(sys, org, draining, ip, port, url)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: sys, org
 func (_m *SystemRepo) Update(sys *db.System, org uint) error {
 	ret := _m.Called(sys, org)
@@ -7,7 +7,7 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
+// 	protoc-gen-go v1.36.12
 // 	protoc        v6.32.1
 // source: lookup.proto
 
@@ -17,8 +17,10 @@ import (
 	_ "github.com/mwitkow/go-proto-validators"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -29,23 +31,20 @@ const (
 )
 
 type AddOrgRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgName       string                 `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	OrgId         string                 `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	Certificate   string                 `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OrgName     string `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
-	OrgId       string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
-	Certificate string `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
-	Ip          string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddOrgRequest) Reset() {
 	*x = AddOrgRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *AddOrgRequest) String() string {
@@ -56,7 +55,7 @@ func (*AddOrgRequest) ProtoMessage() {}
 
 func (x *AddOrgRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -100,23 +99,20 @@ func (x *AddOrgRequest) GetIp() string {
 }
 
 type AddOrgResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgName       string                 `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	OrgId         string                 `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	Certificate   string                 `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OrgName     string `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
-	OrgId       string `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
-	Certificate string `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
-	Ip          string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddOrgResponse) Reset() {
 	*x = AddOrgResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *AddOrgResponse) String() string {
@@ -127,7 +123,7 @@ func (*AddOrgResponse) ProtoMessage() {}
 
 func (x *AddOrgResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -171,22 +167,19 @@ func (x *AddOrgResponse) GetIp() string {
 }
 
 type UpdateOrgRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgName       string                 `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	Certificate   string                 `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OrgName     string `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
-	Certificate string `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
-	Ip          string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateOrgRequest) Reset() {
 	*x = UpdateOrgRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *UpdateOrgRequest) String() string {
@@ -197,7 +190,7 @@ func (*UpdateOrgRequest) ProtoMessage() {}
 
 func (x *UpdateOrgRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -234,22 +227,19 @@ func (x *UpdateOrgRequest) GetIp() string {
 }
 
 type UpdateOrgResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgName       string                 `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	Certificate   string                 `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OrgName     string `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
-	Certificate string `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
-	Ip          string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateOrgResponse) Reset() {
 	*x = UpdateOrgResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *UpdateOrgResponse) String() string {
@@ -260,7 +250,7 @@ func (*UpdateOrgResponse) ProtoMessage() {}
 
 func (x *UpdateOrgResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -297,20 +287,17 @@ func (x *UpdateOrgResponse) GetIp() string {
 }
 
 type GetOrgRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgName       string                 `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OrgName string `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetOrgRequest) Reset() {
 	*x = GetOrgRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetOrgRequest) String() string {
@@ -321,7 +308,7 @@ func (*GetOrgRequest) ProtoMessage() {}
 
 func (x *GetOrgRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -344,22 +331,19 @@ func (x *GetOrgRequest) GetOrgName() string {
 }
 
 type GetOrgResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgName       string                 `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	Certificate   string                 `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OrgName     string `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
-	Certificate string `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
-	Ip          string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetOrgResponse) Reset() {
 	*x = GetOrgResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetOrgResponse) String() string {
@@ -370,7 +354,7 @@ func (*GetOrgResponse) ProtoMessage() {}
 
 func (x *GetOrgResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -407,20 +391,17 @@ func (x *GetOrgResponse) GetIp() string {
 }
 
 type OrgName struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OrgName) Reset() {
 	*x = OrgName{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *OrgName) String() string {
@@ -431,7 +412,7 @@ func (*OrgName) ProtoMessage() {}
 
 func (x *OrgName) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -454,18 +435,16 @@ func (x *OrgName) GetName() string {
 }
 
 type GetOrgsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetOrgsRequest) Reset() {
 	*x = GetOrgsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetOrgsRequest) String() string {
@@ -476,7 +455,7 @@ func (*GetOrgsRequest) ProtoMessage() {}
 
 func (x *GetOrgsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -492,20 +471,17 @@ func (*GetOrgsRequest) Descriptor() ([]byte, []int) {
 }
 
 type GetOrgsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orgs          []*OrgName             `protobuf:"bytes,1,rep,name=orgs,proto3" json:"orgs,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Orgs []*OrgName `protobuf:"bytes,1,rep,name=orgs,proto3" json:"orgs,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetOrgsResponse) Reset() {
 	*x = GetOrgsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetOrgsResponse) String() string {
@@ -516,7 +492,7 @@ func (*GetOrgsResponse) ProtoMessage() {}
 
 func (x *GetOrgsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -539,21 +515,18 @@ func (x *GetOrgsResponse) GetOrgs() []*OrgName {
 }
 
 type AddNodeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId  string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	OrgName string `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddNodeRequest) Reset() {
 	*x = AddNodeRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *AddNodeRequest) String() string {
@@ -564,7 +537,7 @@ func (*AddNodeRequest) ProtoMessage() {}
 
 func (x *AddNodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -594,21 +567,18 @@ func (x *AddNodeRequest) GetOrgName() string {
 }
 
 type AddNodeResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId  string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	OrgName string `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddNodeResponse) Reset() {
 	*x = AddNodeResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *AddNodeResponse) String() string {
@@ -619,7 +589,7 @@ func (*AddNodeResponse) ProtoMessage() {}
 
 func (x *AddNodeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -649,21 +619,18 @@ func (x *AddNodeResponse) GetOrgName() string {
 }
 
 type GetNodeForOrgRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId  string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	OrgName string `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetNodeForOrgRequest) Reset() {
 	*x = GetNodeForOrgRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetNodeForOrgRequest) String() string {
@@ -674,7 +641,7 @@ func (*GetNodeForOrgRequest) ProtoMessage() {}
 
 func (x *GetNodeForOrgRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -704,23 +671,22 @@ func (x *GetNodeForOrgRequest) GetOrgName() string {
 }
 
 type GetNodeResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	NodeId      string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	OrgName     string `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
-	Certificate string `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
-	Ip          string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NodeId          string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	OrgName         string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	Certificate     string                 `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	Ip              string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	TransferPending bool                   `protobuf:"varint,5,opt,name=transferPending,proto3" json:"transferPending,omitempty"`
+	PreviousOrgName string                 `protobuf:"bytes,6,opt,name=previousOrgName,proto3" json:"previousOrgName,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetNodeResponse) Reset() {
 	*x = GetNodeResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetNodeResponse) String() string {
@@ -731,7 +697,7 @@ func (*GetNodeResponse) ProtoMessage() {}
 
 func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -774,21 +740,32 @@ func (x *GetNodeResponse) GetIp() string {
 	return ""
 }
 
+func (x *GetNodeResponse) GetTransferPending() bool {
+	if x != nil {
+		return x.TransferPending
+	}
+	return false
+}
+
+func (x *GetNodeResponse) GetPreviousOrgName() string {
+	if x != nil {
+		return x.PreviousOrgName
+	}
+	return ""
+}
+
 type GetNodeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetNodeRequest) Reset() {
 	*x = GetNodeRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetNodeRequest) String() string {
@@ -799,7 +776,7 @@ func (*GetNodeRequest) ProtoMessage() {}
 
 func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -822,21 +799,18 @@ func (x *GetNodeRequest) GetNodeId() string {
 }
 
 type DeleteNodeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId  string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	OrgName string `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteNodeRequest) Reset() {
 	*x = DeleteNodeRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DeleteNodeRequest) String() string {
@@ -847,7 +821,7 @@ func (*DeleteNodeRequest) ProtoMessage() {}
 
 func (x *DeleteNodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -877,18 +851,16 @@ func (x *DeleteNodeRequest) GetOrgName() string {
 }
 
 type DeleteNodeResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteNodeResponse) Reset() {
 	*x = DeleteNodeResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DeleteNodeResponse) String() string {
@@ -899,7 +871,7 @@ func (*DeleteNodeResponse) ProtoMessage() {}
 
 func (x *DeleteNodeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -915,22 +887,19 @@ func (*DeleteNodeResponse) Descriptor() ([]byte, []int) {
 }
 
 type GetSystemRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemName    string                 `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	OrgId         string                 `protobuf:"bytes,3,opt,name=orgId,proto3" json:"orgId,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SystemName string `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
-	OrgName    string `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
-	OrgId      string `protobuf:"bytes,3,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetSystemRequest) Reset() {
 	*x = GetSystemRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetSystemRequest) String() string {
@@ -941,7 +910,7 @@ func (*GetSystemRequest) ProtoMessage() {}
 
 func (x *GetSystemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -978,29 +947,32 @@ func (x *GetSystemRequest) GetOrgId() string {
 }
 
 type GetSystemResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemName    string                 `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
+	SystemId      string                 `protobuf:"bytes,2,opt,name=systemId,proto3" json:"systemId,omitempty"`
+	OrgName       string                 `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	Certificate   string                 `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	ApiGwIp       string                 `protobuf:"bytes,5,opt,name=apiGwIp,proto3" json:"apiGwIp,omitempty"`
+	ApiGwPort     int32                  `protobuf:"varint,6,opt,name=apiGwPort,proto3" json:"apiGwPort,omitempty"`
+	ApiGwHealth   uint32                 `protobuf:"varint,7,opt,name=apiGwHealth,proto3" json:"apiGwHealth,omitempty"`
+	ApiGwUrl      string                 `protobuf:"bytes,8,opt,name=apiGwUrl,proto3" json:"apiGwUrl,omitempty"`
+	NodeGwIp      string                 `protobuf:"bytes,9,opt,name=nodeGwIp,proto3" json:"nodeGwIp,omitempty"`
+	NodeGwPort    int32                  `protobuf:"varint,10,opt,name=nodeGwPort,proto3" json:"nodeGwPort,omitempty"`
+	Version       string                 `protobuf:"bytes,11,opt,name=version,proto3" json:"version,omitempty"`
+	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	Draining      bool                   `protobuf:"varint,13,opt,name=draining,proto3" json:"draining,omitempty"`
+	DrainIp       string                 `protobuf:"bytes,14,opt,name=drainIp,proto3" json:"drainIp,omitempty"`
+	DrainPort     int32                  `protobuf:"varint,15,opt,name=drainPort,proto3" json:"drainPort,omitempty"`
+	DrainUrl      string                 `protobuf:"bytes,16,opt,name=drainUrl,proto3" json:"drainUrl,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SystemName  string `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
-	SystemId    string `protobuf:"bytes,2,opt,name=systemId,proto3" json:"systemId,omitempty"`
-	OrgName     string `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName,omitempty"`
-	Certificate string `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
-	ApiGwIp     string `protobuf:"bytes,5,opt,name=apiGwIp,proto3" json:"apiGwIp,omitempty"`
-	ApiGwPort   int32  `protobuf:"varint,6,opt,name=apiGwPort,proto3" json:"apiGwPort,omitempty"`
-	ApiGwHealth uint32 `protobuf:"varint,7,opt,name=apiGwHealth,proto3" json:"apiGwHealth,omitempty"`
-	ApiGwUrl    string `protobuf:"bytes,8,opt,name=apiGwUrl,proto3" json:"apiGwUrl,omitempty"`
-	NodeGwIp    string `protobuf:"bytes,9,opt,name=nodeGwIp,proto3" json:"nodeGwIp,omitempty"`
-	NodeGwPort  int32  `protobuf:"varint,10,opt,name=nodeGwPort,proto3" json:"nodeGwPort,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetSystemResponse) Reset() {
 	*x = GetSystemResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetSystemResponse) String() string {
@@ -1011,7 +983,7 @@ func (*GetSystemResponse) ProtoMessage() {}
 
 func (x *GetSystemResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1096,28 +1068,67 @@ func (x *GetSystemResponse) GetNodeGwPort() int32 {
 	return 0
 }
 
+func (x *GetSystemResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetSystemResponse) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *GetSystemResponse) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *GetSystemResponse) GetDrainIp() string {
+	if x != nil {
+		return x.DrainIp
+	}
+	return ""
+}
+
+func (x *GetSystemResponse) GetDrainPort() int32 {
+	if x != nil {
+		return x.DrainPort
+	}
+	return 0
+}
+
+func (x *GetSystemResponse) GetDrainUrl() string {
+	if x != nil {
+		return x.DrainUrl
+	}
+	return ""
+}
+
 type AddSystemRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemName    string                 `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	Certificate   string                 `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	ApiGwIp       string                 `protobuf:"bytes,4,opt,name=apiGwIp,proto3" json:"apiGwIp,omitempty"`
+	ApiGwPort     int32                  `protobuf:"varint,5,opt,name=apiGwPort,proto3" json:"apiGwPort,omitempty"`
+	ApiGwUrl      string                 `protobuf:"bytes,6,opt,name=apiGwUrl,proto3" json:"apiGwUrl,omitempty"`
+	NodeGwIp      string                 `protobuf:"bytes,7,opt,name=nodeGwIp,proto3" json:"nodeGwIp,omitempty"`
+	NodeGwPort    int32                  `protobuf:"varint,8,opt,name=nodeGwPort,proto3" json:"nodeGwPort,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SystemName  string `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
-	OrgName     string `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
-	Certificate string `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
-	ApiGwIp     string `protobuf:"bytes,4,opt,name=apiGwIp,proto3" json:"apiGwIp,omitempty"`
-	ApiGwPort   int32  `protobuf:"varint,5,opt,name=apiGwPort,proto3" json:"apiGwPort,omitempty"`
-	ApiGwUrl    string `protobuf:"bytes,6,opt,name=apiGwUrl,proto3" json:"apiGwUrl,omitempty"`
-	NodeGwIp    string `protobuf:"bytes,7,opt,name=nodeGwIp,proto3" json:"nodeGwIp,omitempty"`
-	NodeGwPort  int32  `protobuf:"varint,8,opt,name=nodeGwPort,proto3" json:"nodeGwPort,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddSystemRequest) Reset() {
 	*x = AddSystemRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *AddSystemRequest) String() string {
@@ -1128,7 +1139,7 @@ func (*AddSystemRequest) ProtoMessage() {}
 
 func (x *AddSystemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1200,28 +1211,25 @@ func (x *AddSystemRequest) GetNodeGwPort() int32 {
 }
 
 type AddSystemResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemName    string                 `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
+	SystemId      string                 `protobuf:"bytes,2,opt,name=systemId,proto3" json:"systemId,omitempty"`
+	OrgName       string                 `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	Certificate   string                 `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	ApiGwIp       string                 `protobuf:"bytes,5,opt,name=apiGwIp,proto3" json:"apiGwIp,omitempty"`
+	ApiGwPort     int32                  `protobuf:"varint,6,opt,name=apiGwPort,proto3" json:"apiGwPort,omitempty"`
+	ApiGwUrl      string                 `protobuf:"bytes,7,opt,name=apiGwUrl,proto3" json:"apiGwUrl,omitempty"`
+	NodeGwIp      string                 `protobuf:"bytes,8,opt,name=nodeGwIp,proto3" json:"nodeGwIp,omitempty"`
+	NodeGwPort    int32                  `protobuf:"varint,9,opt,name=nodeGwPort,proto3" json:"nodeGwPort,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SystemName  string `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
-	SystemId    string `protobuf:"bytes,2,opt,name=systemId,proto3" json:"systemId,omitempty"`
-	OrgName     string `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName,omitempty"`
-	Certificate string `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
-	ApiGwIp     string `protobuf:"bytes,5,opt,name=apiGwIp,proto3" json:"apiGwIp,omitempty"`
-	ApiGwPort   int32  `protobuf:"varint,6,opt,name=apiGwPort,proto3" json:"apiGwPort,omitempty"`
-	ApiGwUrl    string `protobuf:"bytes,7,opt,name=apiGwUrl,proto3" json:"apiGwUrl,omitempty"`
-	NodeGwIp    string `protobuf:"bytes,8,opt,name=nodeGwIp,proto3" json:"nodeGwIp,omitempty"`
-	NodeGwPort  int32  `protobuf:"varint,9,opt,name=nodeGwPort,proto3" json:"nodeGwPort,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddSystemResponse) Reset() {
 	*x = AddSystemResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *AddSystemResponse) String() string {
@@ -1232,7 +1240,7 @@ func (*AddSystemResponse) ProtoMessage() {}
 
 func (x *AddSystemResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1311,26 +1319,23 @@ func (x *AddSystemResponse) GetNodeGwPort() int32 {
 }
 
 type UpdateSystemRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemName    string                 `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	Certificate   string                 `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	ApiGwIp       string                 `protobuf:"bytes,4,opt,name=apiGwIp,proto3" json:"apiGwIp,omitempty"`
+	ApiGwPort     int32                  `protobuf:"varint,5,opt,name=apiGwPort,proto3" json:"apiGwPort,omitempty"`
+	NodeGwIp      string                 `protobuf:"bytes,6,opt,name=nodeGwIp,proto3" json:"nodeGwIp,omitempty"`
+	NodeGwPort    int32                  `protobuf:"varint,7,opt,name=nodeGwPort,proto3" json:"nodeGwPort,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SystemName  string `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
-	OrgName     string `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
-	Certificate string `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
-	ApiGwIp     string `protobuf:"bytes,4,opt,name=apiGwIp,proto3" json:"apiGwIp,omitempty"`
-	ApiGwPort   int32  `protobuf:"varint,5,opt,name=apiGwPort,proto3" json:"apiGwPort,omitempty"`
-	NodeGwIp    string `protobuf:"bytes,6,opt,name=nodeGwIp,proto3" json:"nodeGwIp,omitempty"`
-	NodeGwPort  int32  `protobuf:"varint,7,opt,name=nodeGwPort,proto3" json:"nodeGwPort,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateSystemRequest) Reset() {
 	*x = UpdateSystemRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *UpdateSystemRequest) String() string {
@@ -1341,7 +1346,7 @@ func (*UpdateSystemRequest) ProtoMessage() {}
 
 func (x *UpdateSystemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1406,28 +1411,25 @@ func (x *UpdateSystemRequest) GetNodeGwPort() int32 {
 }
 
 type UpdateSystemResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemName    string                 `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
+	SystemId      string                 `protobuf:"bytes,2,opt,name=systemId,proto3" json:"systemId,omitempty"`
+	OrgName       string                 `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	Certificate   string                 `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	ApiGwIp       string                 `protobuf:"bytes,5,opt,name=apiGwIp,proto3" json:"apiGwIp,omitempty"`
+	ApiGwPort     int32                  `protobuf:"varint,6,opt,name=apiGwPort,proto3" json:"apiGwPort,omitempty"`
+	ApiGwUrl      string                 `protobuf:"bytes,7,opt,name=apiGwUrl,proto3" json:"apiGwUrl,omitempty"`
+	NodeGwIp      string                 `protobuf:"bytes,8,opt,name=nodeGwIp,proto3" json:"nodeGwIp,omitempty"`
+	NodeGwPort    int32                  `protobuf:"varint,9,opt,name=nodeGwPort,proto3" json:"nodeGwPort,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SystemName  string `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
-	SystemId    string `protobuf:"bytes,2,opt,name=systemId,proto3" json:"systemId,omitempty"`
-	OrgName     string `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName,omitempty"`
-	Certificate string `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
-	ApiGwIp     string `protobuf:"bytes,5,opt,name=apiGwIp,proto3" json:"apiGwIp,omitempty"`
-	ApiGwPort   int32  `protobuf:"varint,6,opt,name=apiGwPort,proto3" json:"apiGwPort,omitempty"`
-	ApiGwUrl    string `protobuf:"bytes,7,opt,name=apiGwUrl,proto3" json:"apiGwUrl,omitempty"`
-	NodeGwIp    string `protobuf:"bytes,8,opt,name=nodeGwIp,proto3" json:"nodeGwIp,omitempty"`
-	NodeGwPort  int32  `protobuf:"varint,9,opt,name=nodeGwPort,proto3" json:"nodeGwPort,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateSystemResponse) Reset() {
 	*x = UpdateSystemResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *UpdateSystemResponse) String() string {
@@ -1438,7 +1440,7 @@ func (*UpdateSystemResponse) ProtoMessage() {}
 
 func (x *UpdateSystemResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1517,21 +1519,18 @@ func (x *UpdateSystemResponse) GetNodeGwPort() int32 {
 }
 
 type DeleteSystemRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemName    string                 `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SystemName string `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
-	OrgName    string `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteSystemRequest) Reset() {
 	*x = DeleteSystemRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DeleteSystemRequest) String() string {
@@ -1542,7 +1541,7 @@ func (*DeleteSystemRequest) ProtoMessage() {}
 
 func (x *DeleteSystemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1572,18 +1571,16 @@ func (x *DeleteSystemRequest) GetOrgName() string {
 }
 
 type DeleteSystemResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteSystemResponse) Reset() {
 	*x = DeleteSystemResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lookup_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_lookup_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DeleteSystemResponse) String() string {
@@ -1594,7 +1591,7 @@ func (*DeleteSystemResponse) ProtoMessage() {}
 
 func (x *DeleteSystemResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lookup_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1609,639 +1606,1593 @@ func (*DeleteSystemResponse) Descriptor() ([]byte, []int) {
 	return file_lookup_proto_rawDescGZIP(), []int{23}
 }
 
-var File_lookup_proto protoreflect.FileDescriptor
+type SystemHeartbeatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemName    string                 `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	ApiGwHealth   uint32                 `protobuf:"varint,4,opt,name=apiGwHealth,proto3" json:"apiGwHealth,omitempty"`
+	NodeGwHealth  uint32                 `protobuf:"varint,5,opt,name=nodeGwHealth,proto3" json:"nodeGwHealth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var file_lookup_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
-	0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x1a,
-	0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x8c, 0x01, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x07, 0x6f, 0x72, 0x67,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x05,
-	0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
-	0x58, 0x01, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22,
-	0x72, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f,
-	0x72, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x70, 0x22, 0x66, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01,
-	0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x67, 0x0a, 0x11, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x20, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x70, 0x22, 0x31, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x07,
-	0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x64, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x72,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x6f, 0x72, 0x67,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
-	0x58, 0x01, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63,
-	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x25, 0x0a,
-	0x07, 0x4f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x6f, 0x72, 0x67,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e,
-	0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x67, 0x4e, 0x61, 0x6d,
-	0x65, 0x52, 0x04, 0x6f, 0x72, 0x67, 0x73, 0x22, 0x4a, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x6e, 0x6f, 0x64,
-	0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58,
-	0x01, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x67,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x06,
-	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x58, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x6f, 0x72, 0x4f, 0x72,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01,
-	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58,
-	0x01, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x75, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
-	0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x70, 0x22, 0x30, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x06, 0x6e, 0x6f, 0x64,
-	0x65, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01,
-	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58,
-	0x01, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x73, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01,
-	0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
-	0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xe2, 0xdf, 0x1f, 0x03, 0x90, 0x01, 0x04, 0x52, 0x05,
-	0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0xbd, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x47, 0x77, 0x49, 0x70, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x69, 0x47, 0x77, 0x49, 0x70, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x70, 0x69, 0x47, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x61, 0x70, 0x69, 0x47, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61,
-	0x70, 0x69, 0x47, 0x77, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0b, 0x61, 0x70, 0x69, 0x47, 0x77, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1a, 0x0a,
-	0x08, 0x61, 0x70, 0x69, 0x47, 0x77, 0x55, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x61, 0x70, 0x69, 0x47, 0x77, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64,
-	0x65, 0x47, 0x77, 0x49, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64,
-	0x65, 0x47, 0x77, 0x49, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x77, 0x50,
-	0x6f, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x47,
-	0x77, 0x50, 0x6f, 0x72, 0x74, 0x22, 0xa6, 0x02, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
-	0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x07, 0x6f, 0x72, 0x67,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58,
-	0x01, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x20,
-	0x0a, 0x07, 0x61, 0x70, 0x69, 0x47, 0x77, 0x49, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x07, 0x61, 0x70, 0x69, 0x47, 0x77, 0x49, 0x70,
-	0x12, 0x24, 0x0a, 0x09, 0x61, 0x70, 0x69, 0x47, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x09, 0x61, 0x70, 0x69,
-	0x47, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x69, 0x47, 0x77, 0x55,
-	0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x69, 0x47, 0x77, 0x55,
-	0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x77, 0x49, 0x70, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x77, 0x49, 0x70, 0x12, 0x1e,
-	0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x9b,
-	0x02, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x70, 0x69, 0x47, 0x77, 0x49, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x70, 0x69, 0x47, 0x77, 0x49, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x69, 0x47, 0x77, 0x50,
-	0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x70, 0x69, 0x47, 0x77,
-	0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x69, 0x47, 0x77, 0x55, 0x72, 0x6c,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x69, 0x47, 0x77, 0x55, 0x72, 0x6c,
-	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x77, 0x49, 0x70, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x77, 0x49, 0x70, 0x12, 0x1e, 0x0a, 0x0a,
-	0x6e, 0x6f, 0x64, 0x65, 0x47, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x22, 0xf5, 0x01, 0x0a,
-	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01,
-	0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x07,
-	0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2,
-	0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x47, 0x77, 0x49, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x70, 0x69, 0x47, 0x77, 0x49, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70,
-	0x69, 0x47, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61,
-	0x70, 0x69, 0x47, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65,
-	0x47, 0x77, 0x49, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65,
-	0x47, 0x77, 0x49, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x77, 0x50, 0x6f,
-	0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x77,
-	0x50, 0x6f, 0x72, 0x74, 0x22, 0x9e, 0x02, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x67,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x47, 0x77, 0x49, 0x70,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x69, 0x47, 0x77, 0x49, 0x70, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x70, 0x69, 0x47, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x61, 0x70, 0x69, 0x47, 0x77, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x61, 0x70, 0x69, 0x47, 0x77, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x61, 0x70, 0x69, 0x47, 0x77, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64,
-	0x65, 0x47, 0x77, 0x49, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64,
-	0x65, 0x47, 0x77, 0x49, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x77, 0x50,
-	0x6f, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x47,
-	0x77, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x5f, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a,
-	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x07, 0x6f,
-	0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x9a,
-	0x08, 0x0a, 0x0d, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x49, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x67, 0x12, 0x1e, 0x2e, 0x75, 0x6b, 0x61,
-	0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
-	0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x6b, 0x61,
-	0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
-	0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x09, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x12, 0x21, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61,
-	0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75, 0x6b,
-	0x61, 0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x49, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x12, 0x1e, 0x2e, 0x75, 0x6b, 0x61, 0x6d,
-	0x61, 0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f,
-	0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x6b, 0x61, 0x6d,
-	0x61, 0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f,
-	0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x07, 0x47, 0x65,
-	0x74, 0x4f, 0x72, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6c, 0x6f,
-	0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6c,
-	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4e,
-	0x6f, 0x64, 0x65, 0x12, 0x1f, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f, 0x6b,
-	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f,
-	0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64,
-	0x65, 0x46, 0x6f, 0x72, 0x4f, 0x72, 0x67, 0x12, 0x1f, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e,
-	0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61,
-	0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x6f, 0x72, 0x4f, 0x72, 0x67, 0x12, 0x25, 0x2e, 0x75, 0x6b,
-	0x61, 0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x6f, 0x72, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f,
-	0x64, 0x65, 0x46, 0x6f, 0x72, 0x4f, 0x72, 0x67, 0x12, 0x22, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61,
-	0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75,
-	0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x46, 0x6f,
-	0x72, 0x4f, 0x72, 0x67, 0x12, 0x21, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f,
-	0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e,
-	0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x41,
-	0x64, 0x64, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x46, 0x6f, 0x72, 0x4f, 0x72, 0x67, 0x12, 0x21,
-	0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x22, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x46, 0x6f, 0x72, 0x4f, 0x72, 0x67, 0x12, 0x24, 0x2e, 0x75, 0x6b,
-	0x61, 0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x46, 0x6f, 0x72, 0x4f, 0x72, 0x67, 0x12, 0x24,
-	0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6c, 0x6f, 0x6f,
-	0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x08, 0x5a, 0x06, 0x70,
-	0x62, 0x2f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *SystemHeartbeatRequest) Reset() {
+	*x = SystemHeartbeatRequest{}
+	mi := &file_lookup_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-var (
-	file_lookup_proto_rawDescOnce sync.Once
-	file_lookup_proto_rawDescData = file_lookup_proto_rawDesc
-)
+func (x *SystemHeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_lookup_proto_rawDescGZIP() []byte {
-	file_lookup_proto_rawDescOnce.Do(func() {
-		file_lookup_proto_rawDescData = protoimpl.X.CompressGZIP(file_lookup_proto_rawDescData)
-	})
-	return file_lookup_proto_rawDescData
+func (*SystemHeartbeatRequest) ProtoMessage() {}
+
+func (x *SystemHeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_lookup_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
-var file_lookup_proto_goTypes = []interface{}{
-	(*AddOrgRequest)(nil),        // 0: ukama.lookup.v1.AddOrgRequest
-	(*AddOrgResponse)(nil),       // 1: ukama.lookup.v1.AddOrgResponse
-	(*UpdateOrgRequest)(nil),     // 2: ukama.lookup.v1.UpdateOrgRequest
-	(*UpdateOrgResponse)(nil),    // 3: ukama.lookup.v1.UpdateOrgResponse
-	(*GetOrgRequest)(nil),        // 4: ukama.lookup.v1.GetOrgRequest
-	(*GetOrgResponse)(nil),       // 5: ukama.lookup.v1.GetOrgResponse
-	(*OrgName)(nil),              // 6: ukama.lookup.v1.OrgName
-	(*GetOrgsRequest)(nil),       // 7: ukama.lookup.v1.GetOrgsRequest
-	(*GetOrgsResponse)(nil),      // 8: ukama.lookup.v1.GetOrgsResponse
-	(*AddNodeRequest)(nil),       // 9: ukama.lookup.v1.AddNodeRequest
-	(*AddNodeResponse)(nil),      // 10: ukama.lookup.v1.AddNodeResponse
-	(*GetNodeForOrgRequest)(nil), // 11: ukama.lookup.v1.GetNodeForOrgRequest
-	(*GetNodeResponse)(nil),      // 12: ukama.lookup.v1.GetNodeResponse
-	(*GetNodeRequest)(nil),       // 13: ukama.lookup.v1.GetNodeRequest
-	(*DeleteNodeRequest)(nil),    // 14: ukama.lookup.v1.DeleteNodeRequest
-	(*DeleteNodeResponse)(nil),   // 15: ukama.lookup.v1.DeleteNodeResponse
-	(*GetSystemRequest)(nil),     // 16: ukama.lookup.v1.GetSystemRequest
-	(*GetSystemResponse)(nil),    // 17: ukama.lookup.v1.GetSystemResponse
-	(*AddSystemRequest)(nil),     // 18: ukama.lookup.v1.AddSystemRequest
-	(*AddSystemResponse)(nil),    // 19: ukama.lookup.v1.AddSystemResponse
-	(*UpdateSystemRequest)(nil),  // 20: ukama.lookup.v1.UpdateSystemRequest
-	(*UpdateSystemResponse)(nil), // 21: ukama.lookup.v1.UpdateSystemResponse
-	(*DeleteSystemRequest)(nil),  // 22: ukama.lookup.v1.DeleteSystemRequest
-	(*DeleteSystemResponse)(nil), // 23: ukama.lookup.v1.DeleteSystemResponse
+// Deprecated: Use SystemHeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*SystemHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{24}
 }
-var file_lookup_proto_depIdxs = []int32{
-	6,  // 0: ukama.lookup.v1.GetOrgsResponse.orgs:type_name -> ukama.lookup.v1.OrgName
-	0,  // 1: ukama.lookup.v1.LookupService.AddOrg:input_type -> ukama.lookup.v1.AddOrgRequest
-	2,  // 2: ukama.lookup.v1.LookupService.UpdateOrg:input_type -> ukama.lookup.v1.UpdateOrgRequest
-	4,  // 3: ukama.lookup.v1.LookupService.GetOrg:input_type -> ukama.lookup.v1.GetOrgRequest
-	7,  // 4: ukama.lookup.v1.LookupService.GetOrgs:input_type -> ukama.lookup.v1.GetOrgsRequest
-	13, // 5: ukama.lookup.v1.LookupService.GetNode:input_type -> ukama.lookup.v1.GetNodeRequest
-	9,  // 6: ukama.lookup.v1.LookupService.AddNodeForOrg:input_type -> ukama.lookup.v1.AddNodeRequest
-	11, // 7: ukama.lookup.v1.LookupService.GetNodeForOrg:input_type -> ukama.lookup.v1.GetNodeForOrgRequest
-	14, // 8: ukama.lookup.v1.LookupService.DeleteNodeForOrg:input_type -> ukama.lookup.v1.DeleteNodeRequest
-	16, // 9: ukama.lookup.v1.LookupService.GetSystemForOrg:input_type -> ukama.lookup.v1.GetSystemRequest
-	18, // 10: ukama.lookup.v1.LookupService.AddSystemForOrg:input_type -> ukama.lookup.v1.AddSystemRequest
-	20, // 11: ukama.lookup.v1.LookupService.UpdateSystemForOrg:input_type -> ukama.lookup.v1.UpdateSystemRequest
-	22, // 12: ukama.lookup.v1.LookupService.DeleteSystemForOrg:input_type -> ukama.lookup.v1.DeleteSystemRequest
-	1,  // 13: ukama.lookup.v1.LookupService.AddOrg:output_type -> ukama.lookup.v1.AddOrgResponse
-	3,  // 14: ukama.lookup.v1.LookupService.UpdateOrg:output_type -> ukama.lookup.v1.UpdateOrgResponse
-	5,  // 15: ukama.lookup.v1.LookupService.GetOrg:output_type -> ukama.lookup.v1.GetOrgResponse
-	8,  // 16: ukama.lookup.v1.LookupService.GetOrgs:output_type -> ukama.lookup.v1.GetOrgsResponse
-	12, // 17: ukama.lookup.v1.LookupService.GetNode:output_type -> ukama.lookup.v1.GetNodeResponse
-	10, // 18: ukama.lookup.v1.LookupService.AddNodeForOrg:output_type -> ukama.lookup.v1.AddNodeResponse
-	12, // 19: ukama.lookup.v1.LookupService.GetNodeForOrg:output_type -> ukama.lookup.v1.GetNodeResponse
-	15, // 20: ukama.lookup.v1.LookupService.DeleteNodeForOrg:output_type -> ukama.lookup.v1.DeleteNodeResponse
-	17, // 21: ukama.lookup.v1.LookupService.GetSystemForOrg:output_type -> ukama.lookup.v1.GetSystemResponse
-	19, // 22: ukama.lookup.v1.LookupService.AddSystemForOrg:output_type -> ukama.lookup.v1.AddSystemResponse
-	21, // 23: ukama.lookup.v1.LookupService.UpdateSystemForOrg:output_type -> ukama.lookup.v1.UpdateSystemResponse
-	23, // 24: ukama.lookup.v1.LookupService.DeleteSystemForOrg:output_type -> ukama.lookup.v1.DeleteSystemResponse
-	13, // [13:25] is the sub-list for method output_type
-	1,  // [1:13] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+
+func (x *SystemHeartbeatRequest) GetSystemName() string {
+	if x != nil {
+		return x.SystemName
+	}
+	return ""
 }
 
-func init() { file_lookup_proto_init() }
-func file_lookup_proto_init() {
-	if File_lookup_proto != nil {
-		return
+func (x *SystemHeartbeatRequest) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_lookup_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddOrgRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddOrgResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateOrgRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateOrgResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrgRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrgResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrgName); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrgsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrgsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddNodeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddNodeResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNodeForOrgRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNodeResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNodeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteNodeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteNodeResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSystemRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSystemResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_lookup_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddSystemRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *SystemHeartbeatRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SystemHeartbeatRequest) GetApiGwHealth() uint32 {
+	if x != nil {
+		return x.ApiGwHealth
+	}
+	return 0
+}
+
+func (x *SystemHeartbeatRequest) GetNodeGwHealth() uint32 {
+	if x != nil {
+		return x.NodeGwHealth
+	}
+	return 0
+}
+
+type SystemHeartbeatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Draining      bool                   `protobuf:"varint,1,opt,name=draining,proto3" json:"draining,omitempty"`
+	DrainIp       string                 `protobuf:"bytes,2,opt,name=drainIp,proto3" json:"drainIp,omitempty"`
+	DrainPort     int32                  `protobuf:"varint,3,opt,name=drainPort,proto3" json:"drainPort,omitempty"`
+	DrainUrl      string                 `protobuf:"bytes,4,opt,name=drainUrl,proto3" json:"drainUrl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemHeartbeatResponse) Reset() {
+	*x = SystemHeartbeatResponse{}
+	mi := &file_lookup_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemHeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemHeartbeatResponse) ProtoMessage() {}
+
+func (x *SystemHeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemHeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*SystemHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SystemHeartbeatResponse) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *SystemHeartbeatResponse) GetDrainIp() string {
+	if x != nil {
+		return x.DrainIp
+	}
+	return ""
+}
+
+func (x *SystemHeartbeatResponse) GetDrainPort() int32 {
+	if x != nil {
+		return x.DrainPort
+	}
+	return 0
+}
+
+func (x *SystemHeartbeatResponse) GetDrainUrl() string {
+	if x != nil {
+		return x.DrainUrl
+	}
+	return ""
+}
+
+type GetSystemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgName       string                 `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemsRequest) Reset() {
+	*x = GetSystemsRequest{}
+	mi := &file_lookup_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemsRequest) ProtoMessage() {}
+
+func (x *GetSystemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemsRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemsRequest) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetSystemsRequest) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+type SystemInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemName    string                 `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
+	SystemId      string                 `protobuf:"bytes,2,opt,name=systemId,proto3" json:"systemId,omitempty"`
+	OrgName       string                 `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	ApiGwHealth   uint32                 `protobuf:"varint,7,opt,name=apiGwHealth,proto3" json:"apiGwHealth,omitempty"`
+	NodeGwHealth  uint32                 `protobuf:"varint,8,opt,name=nodeGwHealth,proto3" json:"nodeGwHealth,omitempty"`
+	ApiGwUrl      string                 `protobuf:"bytes,9,opt,name=apiGwUrl,proto3" json:"apiGwUrl,omitempty"`
+	Draining      bool                   `protobuf:"varint,10,opt,name=draining,proto3" json:"draining,omitempty"`
+	DrainIp       string                 `protobuf:"bytes,11,opt,name=drainIp,proto3" json:"drainIp,omitempty"`
+	DrainPort     int32                  `protobuf:"varint,12,opt,name=drainPort,proto3" json:"drainPort,omitempty"`
+	DrainUrl      string                 `protobuf:"bytes,13,opt,name=drainUrl,proto3" json:"drainUrl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemInfo) Reset() {
+	*x = SystemInfo{}
+	mi := &file_lookup_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemInfo) ProtoMessage() {}
+
+func (x *SystemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemInfo.ProtoReflect.Descriptor instead.
+func (*SystemInfo) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SystemInfo) GetSystemName() string {
+	if x != nil {
+		return x.SystemName
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetSystemId() string {
+	if x != nil {
+		return x.SystemId
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *SystemInfo) GetApiGwHealth() uint32 {
+	if x != nil {
+		return x.ApiGwHealth
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetNodeGwHealth() uint32 {
+	if x != nil {
+		return x.NodeGwHealth
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetApiGwUrl() string {
+	if x != nil {
+		return x.ApiGwUrl
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *SystemInfo) GetDrainIp() string {
+	if x != nil {
+		return x.DrainIp
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetDrainPort() int32 {
+	if x != nil {
+		return x.DrainPort
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetDrainUrl() string {
+	if x != nil {
+		return x.DrainUrl
+	}
+	return ""
+}
+
+type GetSystemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Systems       []*SystemInfo          `protobuf:"bytes,1,rep,name=systems,proto3" json:"systems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemsResponse) Reset() {
+	*x = GetSystemsResponse{}
+	mi := &file_lookup_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemsResponse) ProtoMessage() {}
+
+func (x *GetSystemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemsResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemsResponse) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetSystemsResponse) GetSystems() []*SystemInfo {
+	if x != nil {
+		return x.Systems
+	}
+	return nil
+}
+
+type DrainSystemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemName    string                 `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	DrainIp       string                 `protobuf:"bytes,3,opt,name=drainIp,proto3" json:"drainIp,omitempty"`
+	DrainPort     int32                  `protobuf:"varint,4,opt,name=drainPort,proto3" json:"drainPort,omitempty"`
+	DrainUrl      string                 `protobuf:"bytes,5,opt,name=drainUrl,proto3" json:"drainUrl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrainSystemRequest) Reset() {
+	*x = DrainSystemRequest{}
+	mi := &file_lookup_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainSystemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainSystemRequest) ProtoMessage() {}
+
+func (x *DrainSystemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainSystemRequest.ProtoReflect.Descriptor instead.
+func (*DrainSystemRequest) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DrainSystemRequest) GetSystemName() string {
+	if x != nil {
+		return x.SystemName
+	}
+	return ""
+}
+
+func (x *DrainSystemRequest) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *DrainSystemRequest) GetDrainIp() string {
+	if x != nil {
+		return x.DrainIp
+	}
+	return ""
+}
+
+func (x *DrainSystemRequest) GetDrainPort() int32 {
+	if x != nil {
+		return x.DrainPort
+	}
+	return 0
+}
+
+func (x *DrainSystemRequest) GetDrainUrl() string {
+	if x != nil {
+		return x.DrainUrl
+	}
+	return ""
+}
+
+type DrainSystemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	System        *SystemInfo            `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrainSystemResponse) Reset() {
+	*x = DrainSystemResponse{}
+	mi := &file_lookup_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainSystemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainSystemResponse) ProtoMessage() {}
+
+func (x *DrainSystemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainSystemResponse.ProtoReflect.Descriptor instead.
+func (*DrainSystemResponse) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DrainSystemResponse) GetSystem() *SystemInfo {
+	if x != nil {
+		return x.System
+	}
+	return nil
+}
+
+type ResumeSystemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemName    string                 `protobuf:"bytes,1,opt,name=systemName,proto3" json:"systemName,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSystemRequest) Reset() {
+	*x = ResumeSystemRequest{}
+	mi := &file_lookup_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSystemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSystemRequest) ProtoMessage() {}
+
+func (x *ResumeSystemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSystemRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSystemRequest) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ResumeSystemRequest) GetSystemName() string {
+	if x != nil {
+		return x.SystemName
+	}
+	return ""
+}
+
+func (x *ResumeSystemRequest) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+type ResumeSystemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	System        *SystemInfo            `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSystemResponse) Reset() {
+	*x = ResumeSystemResponse{}
+	mi := &file_lookup_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSystemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSystemResponse) ProtoMessage() {}
+
+func (x *ResumeSystemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSystemResponse.ProtoReflect.Descriptor instead.
+func (*ResumeSystemResponse) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ResumeSystemResponse) GetSystem() *SystemInfo {
+	if x != nil {
+		return x.System
+	}
+	return nil
+}
+
+type ClaimNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	ClaimCode     string                 `protobuf:"bytes,3,opt,name=claimCode,proto3" json:"claimCode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimNodeRequest) Reset() {
+	*x = ClaimNodeRequest{}
+	mi := &file_lookup_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimNodeRequest) ProtoMessage() {}
+
+func (x *ClaimNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimNodeRequest.ProtoReflect.Descriptor instead.
+func (*ClaimNodeRequest) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ClaimNodeRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ClaimNodeRequest) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *ClaimNodeRequest) GetClaimCode() string {
+	if x != nil {
+		return x.ClaimCode
+	}
+	return ""
+}
+
+type ClaimNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimNodeResponse) Reset() {
+	*x = ClaimNodeResponse{}
+	mi := &file_lookup_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimNodeResponse) ProtoMessage() {}
+
+func (x *ClaimNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimNodeResponse.ProtoReflect.Descriptor instead.
+func (*ClaimNodeResponse) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ClaimNodeResponse) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ClaimNodeResponse) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+type NodeTransfer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	FromOrgName   string                 `protobuf:"bytes,3,opt,name=fromOrgName,proto3" json:"fromOrgName,omitempty"`
+	ToOrgName     string                 `protobuf:"bytes,4,opt,name=toOrgName,proto3" json:"toOrgName,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ReleasedBy    string                 `protobuf:"bytes,6,opt,name=releasedBy,proto3" json:"releasedBy,omitempty"`
+	AcceptedBy    string                 `protobuf:"bytes,7,opt,name=acceptedBy,proto3" json:"acceptedBy,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completedAt,proto3" json:"completedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeTransfer) Reset() {
+	*x = NodeTransfer{}
+	mi := &file_lookup_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeTransfer) ProtoMessage() {}
+
+func (x *NodeTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeTransfer.ProtoReflect.Descriptor instead.
+func (*NodeTransfer) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *NodeTransfer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NodeTransfer) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeTransfer) GetFromOrgName() string {
+	if x != nil {
+		return x.FromOrgName
+	}
+	return ""
+}
+
+func (x *NodeTransfer) GetToOrgName() string {
+	if x != nil {
+		return x.ToOrgName
+	}
+	return ""
+}
+
+func (x *NodeTransfer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NodeTransfer) GetReleasedBy() string {
+	if x != nil {
+		return x.ReleasedBy
+	}
+	return ""
+}
+
+func (x *NodeTransfer) GetAcceptedBy() string {
+	if x != nil {
+		return x.AcceptedBy
+	}
+	return ""
+}
+
+func (x *NodeTransfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NodeTransfer) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type ReleaseNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	ToOrgName     string                 `protobuf:"bytes,3,opt,name=toOrgName,proto3" json:"toOrgName,omitempty"`
+	ReleasedBy    string                 `protobuf:"bytes,4,opt,name=releasedBy,proto3" json:"releasedBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseNodeRequest) Reset() {
+	*x = ReleaseNodeRequest{}
+	mi := &file_lookup_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseNodeRequest) ProtoMessage() {}
+
+func (x *ReleaseNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseNodeRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseNodeRequest) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReleaseNodeRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ReleaseNodeRequest) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *ReleaseNodeRequest) GetToOrgName() string {
+	if x != nil {
+		return x.ToOrgName
+	}
+	return ""
+}
+
+func (x *ReleaseNodeRequest) GetReleasedBy() string {
+	if x != nil {
+		return x.ReleasedBy
+	}
+	return ""
+}
+
+type ReleaseNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *NodeTransfer          `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseNodeResponse) Reset() {
+	*x = ReleaseNodeResponse{}
+	mi := &file_lookup_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseNodeResponse) ProtoMessage() {}
+
+func (x *ReleaseNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_lookup_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddSystemResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseNodeResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseNodeResponse) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReleaseNodeResponse) GetTransfer() *NodeTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type AcceptNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	ClaimCode     string                 `protobuf:"bytes,3,opt,name=claimCode,proto3" json:"claimCode,omitempty"`
+	AcceptedBy    string                 `protobuf:"bytes,4,opt,name=acceptedBy,proto3" json:"acceptedBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptNodeRequest) Reset() {
+	*x = AcceptNodeRequest{}
+	mi := &file_lookup_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptNodeRequest) ProtoMessage() {}
+
+func (x *AcceptNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_lookup_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSystemRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptNodeRequest.ProtoReflect.Descriptor instead.
+func (*AcceptNodeRequest) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AcceptNodeRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *AcceptNodeRequest) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *AcceptNodeRequest) GetClaimCode() string {
+	if x != nil {
+		return x.ClaimCode
+	}
+	return ""
+}
+
+func (x *AcceptNodeRequest) GetAcceptedBy() string {
+	if x != nil {
+		return x.AcceptedBy
+	}
+	return ""
+}
+
+type AcceptNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *NodeTransfer          `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptNodeResponse) Reset() {
+	*x = AcceptNodeResponse{}
+	mi := &file_lookup_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptNodeResponse) ProtoMessage() {}
+
+func (x *AcceptNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_lookup_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSystemResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptNodeResponse.ProtoReflect.Descriptor instead.
+func (*AcceptNodeResponse) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AcceptNodeResponse) GetTransfer() *NodeTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type CancelNodeReleaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	OrgName       string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelNodeReleaseRequest) Reset() {
+	*x = CancelNodeReleaseRequest{}
+	mi := &file_lookup_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelNodeReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelNodeReleaseRequest) ProtoMessage() {}
+
+func (x *CancelNodeReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_lookup_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSystemRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelNodeReleaseRequest.ProtoReflect.Descriptor instead.
+func (*CancelNodeReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CancelNodeReleaseRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CancelNodeReleaseRequest) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+type CancelNodeReleaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *NodeTransfer          `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelNodeReleaseResponse) Reset() {
+	*x = CancelNodeReleaseResponse{}
+	mi := &file_lookup_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelNodeReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelNodeReleaseResponse) ProtoMessage() {}
+
+func (x *CancelNodeReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_lookup_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSystemResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelNodeReleaseResponse.ProtoReflect.Descriptor instead.
+func (*CancelNodeReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CancelNodeReleaseResponse) GetTransfer() *NodeTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+// Published by bootstrap once a transferred node no longer gets the
+// credentials of its previous org
+type NodeCredentialsRevoked struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NodeId          string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	OrgName         string                 `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	PreviousOrgName string                 `protobuf:"bytes,3,opt,name=previousOrgName,proto3" json:"previousOrgName,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NodeCredentialsRevoked) Reset() {
+	*x = NodeCredentialsRevoked{}
+	mi := &file_lookup_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeCredentialsRevoked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeCredentialsRevoked) ProtoMessage() {}
+
+func (x *NodeCredentialsRevoked) ProtoReflect() protoreflect.Message {
+	mi := &file_lookup_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeCredentialsRevoked.ProtoReflect.Descriptor instead.
+func (*NodeCredentialsRevoked) Descriptor() ([]byte, []int) {
+	return file_lookup_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *NodeCredentialsRevoked) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeCredentialsRevoked) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *NodeCredentialsRevoked) GetPreviousOrgName() string {
+	if x != nil {
+		return x.PreviousOrgName
+	}
+	return ""
+}
+
+var File_lookup_proto protoreflect.FileDescriptor
+
+const file_lookup_proto_rawDesc = "" +
+	"\n" +
+	"\flookup.proto\x12\x0fukama.lookup.v1\x1a\x0fvalidator.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8c\x01\n" +
+	"\rAddOrgRequest\x12 \n" +
+	"\aorgName\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\x12\x1f\n" +
+	"\x05orgId\x18\x02 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x05orgId\x12(\n" +
+	"\vcertificate\x18\x03 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\vcertificate\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\"r\n" +
+	"\x0eAddOrgResponse\x12\x18\n" +
+	"\aorgName\x18\x01 \x01(\tR\aorgName\x12\x14\n" +
+	"\x05orgId\x18\x02 \x01(\tR\x05orgId\x12 \n" +
+	"\vcertificate\x18\x03 \x01(\tR\vcertificate\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\"f\n" +
+	"\x10UpdateOrgRequest\x12 \n" +
+	"\aorgName\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\x12 \n" +
+	"\vcertificate\x18\x02 \x01(\tR\vcertificate\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\"g\n" +
+	"\x11UpdateOrgResponse\x12 \n" +
+	"\aorgName\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\x12 \n" +
+	"\vcertificate\x18\x02 \x01(\tR\vcertificate\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\"1\n" +
+	"\rGetOrgRequest\x12 \n" +
+	"\aorgName\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\"d\n" +
+	"\x0eGetOrgResponse\x12 \n" +
+	"\aorgName\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\x12 \n" +
+	"\vcertificate\x18\x02 \x01(\tR\vcertificate\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\"%\n" +
+	"\aOrgName\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x04name\"\x10\n" +
+	"\x0eGetOrgsRequest\"?\n" +
+	"\x0fGetOrgsResponse\x12,\n" +
+	"\x04orgs\x18\x01 \x03(\v2\x18.ukama.lookup.v1.OrgNameR\x04orgs\"J\n" +
+	"\x0eAddNodeRequest\x12\x1e\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\x12\x18\n" +
+	"\aorgName\x18\x02 \x01(\tR\aorgName\"K\n" +
+	"\x0fAddNodeResponse\x12\x1e\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\x12\x18\n" +
+	"\aorgName\x18\x02 \x01(\tR\aorgName\"X\n" +
+	"\x14GetNodeForOrgRequest\x12\x1e\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\x12 \n" +
+	"\aorgName\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\"\xc9\x01\n" +
+	"\x0fGetNodeResponse\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12\x18\n" +
+	"\aorgName\x18\x02 \x01(\tR\aorgName\x12 \n" +
+	"\vcertificate\x18\x03 \x01(\tR\vcertificate\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12(\n" +
+	"\x0ftransferPending\x18\x05 \x01(\bR\x0ftransferPending\x12(\n" +
+	"\x0fpreviousOrgName\x18\x06 \x01(\tR\x0fpreviousOrgName\"0\n" +
+	"\x0eGetNodeRequest\x12\x1e\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\"U\n" +
+	"\x11DeleteNodeRequest\x12\x1e\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\x12 \n" +
+	"\aorgName\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\"\x14\n" +
+	"\x12DeleteNodeResponse\"s\n" +
+	"\x10GetSystemRequest\x12&\n" +
+	"\n" +
+	"systemName\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\n" +
+	"systemName\x12\x18\n" +
+	"\aorgName\x18\x02 \x01(\tR\aorgName\x12\x1d\n" +
+	"\x05orgId\x18\x03 \x01(\tB\a\xe2\xdf\x1f\x03\x90\x01\x04R\x05orgId\"\xff\x03\n" +
+	"\x11GetSystemResponse\x12\x1e\n" +
+	"\n" +
+	"systemName\x18\x01 \x01(\tR\n" +
+	"systemName\x12\x1a\n" +
+	"\bsystemId\x18\x02 \x01(\tR\bsystemId\x12\x18\n" +
+	"\aorgName\x18\x03 \x01(\tR\aorgName\x12 \n" +
+	"\vcertificate\x18\x04 \x01(\tR\vcertificate\x12\x18\n" +
+	"\aapiGwIp\x18\x05 \x01(\tR\aapiGwIp\x12\x1c\n" +
+	"\tapiGwPort\x18\x06 \x01(\x05R\tapiGwPort\x12 \n" +
+	"\vapiGwHealth\x18\a \x01(\rR\vapiGwHealth\x12\x1a\n" +
+	"\bapiGwUrl\x18\b \x01(\tR\bapiGwUrl\x12\x1a\n" +
+	"\bnodeGwIp\x18\t \x01(\tR\bnodeGwIp\x12\x1e\n" +
+	"\n" +
+	"nodeGwPort\x18\n" +
+	" \x01(\x05R\n" +
+	"nodeGwPort\x12\x18\n" +
+	"\aversion\x18\v \x01(\tR\aversion\x126\n" +
+	"\blastSeen\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\x12\x1a\n" +
+	"\bdraining\x18\r \x01(\bR\bdraining\x12\x18\n" +
+	"\adrainIp\x18\x0e \x01(\tR\adrainIp\x12\x1c\n" +
+	"\tdrainPort\x18\x0f \x01(\x05R\tdrainPort\x12\x1a\n" +
+	"\bdrainUrl\x18\x10 \x01(\tR\bdrainUrl\"\xa6\x02\n" +
+	"\x10AddSystemRequest\x12&\n" +
+	"\n" +
+	"systemName\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\n" +
+	"systemName\x12 \n" +
+	"\aorgName\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\x12(\n" +
+	"\vcertificate\x18\x03 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\vcertificate\x12 \n" +
+	"\aapiGwIp\x18\x04 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aapiGwIp\x12$\n" +
+	"\tapiGwPort\x18\x05 \x01(\x05B\x06\xe2\xdf\x1f\x02X\x01R\tapiGwPort\x12\x1a\n" +
+	"\bapiGwUrl\x18\x06 \x01(\tR\bapiGwUrl\x12\x1a\n" +
+	"\bnodeGwIp\x18\a \x01(\tR\bnodeGwIp\x12\x1e\n" +
+	"\n" +
+	"nodeGwPort\x18\b \x01(\x05R\n" +
+	"nodeGwPort\"\x9b\x02\n" +
+	"\x11AddSystemResponse\x12\x1e\n" +
+	"\n" +
+	"systemName\x18\x01 \x01(\tR\n" +
+	"systemName\x12\x1a\n" +
+	"\bsystemId\x18\x02 \x01(\tR\bsystemId\x12\x18\n" +
+	"\aorgName\x18\x03 \x01(\tR\aorgName\x12 \n" +
+	"\vcertificate\x18\x04 \x01(\tR\vcertificate\x12\x18\n" +
+	"\aapiGwIp\x18\x05 \x01(\tR\aapiGwIp\x12\x1c\n" +
+	"\tapiGwPort\x18\x06 \x01(\x05R\tapiGwPort\x12\x1a\n" +
+	"\bapiGwUrl\x18\a \x01(\tR\bapiGwUrl\x12\x1a\n" +
+	"\bnodeGwIp\x18\b \x01(\tR\bnodeGwIp\x12\x1e\n" +
+	"\n" +
+	"nodeGwPort\x18\t \x01(\x05R\n" +
+	"nodeGwPort\"\xf5\x01\n" +
+	"\x13UpdateSystemRequest\x12&\n" +
+	"\n" +
+	"systemName\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\n" +
+	"systemName\x12 \n" +
+	"\aorgName\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\x12 \n" +
+	"\vcertificate\x18\x03 \x01(\tR\vcertificate\x12\x18\n" +
+	"\aapiGwIp\x18\x04 \x01(\tR\aapiGwIp\x12\x1c\n" +
+	"\tapiGwPort\x18\x05 \x01(\x05R\tapiGwPort\x12\x1a\n" +
+	"\bnodeGwIp\x18\x06 \x01(\tR\bnodeGwIp\x12\x1e\n" +
+	"\n" +
+	"nodeGwPort\x18\a \x01(\x05R\n" +
+	"nodeGwPort\"\x9e\x02\n" +
+	"\x14UpdateSystemResponse\x12\x1e\n" +
+	"\n" +
+	"systemName\x18\x01 \x01(\tR\n" +
+	"systemName\x12\x1a\n" +
+	"\bsystemId\x18\x02 \x01(\tR\bsystemId\x12\x18\n" +
+	"\aorgName\x18\x03 \x01(\tR\aorgName\x12 \n" +
+	"\vcertificate\x18\x04 \x01(\tR\vcertificate\x12\x18\n" +
+	"\aapiGwIp\x18\x05 \x01(\tR\aapiGwIp\x12\x1c\n" +
+	"\tapiGwPort\x18\x06 \x01(\x05R\tapiGwPort\x12\x1a\n" +
+	"\bapiGwUrl\x18\a \x01(\tR\bapiGwUrl\x12\x1a\n" +
+	"\bnodeGwIp\x18\b \x01(\tR\bnodeGwIp\x12\x1e\n" +
+	"\n" +
+	"nodeGwPort\x18\t \x01(\x05R\n" +
+	"nodeGwPort\"_\n" +
+	"\x13DeleteSystemRequest\x12&\n" +
+	"\n" +
+	"systemName\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\n" +
+	"systemName\x12 \n" +
+	"\aorgName\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\"\x16\n" +
+	"\x14DeleteSystemResponse\"\xc2\x01\n" +
+	"\x16SystemHeartbeatRequest\x12&\n" +
+	"\n" +
+	"systemName\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\n" +
+	"systemName\x12 \n" +
+	"\aorgName\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12 \n" +
+	"\vapiGwHealth\x18\x04 \x01(\rR\vapiGwHealth\x12\"\n" +
+	"\fnodeGwHealth\x18\x05 \x01(\rR\fnodeGwHealth\"\x89\x01\n" +
+	"\x17SystemHeartbeatResponse\x12\x1a\n" +
+	"\bdraining\x18\x01 \x01(\bR\bdraining\x12\x18\n" +
+	"\adrainIp\x18\x02 \x01(\tR\adrainIp\x12\x1c\n" +
+	"\tdrainPort\x18\x03 \x01(\x05R\tdrainPort\x12\x1a\n" +
+	"\bdrainUrl\x18\x04 \x01(\tR\bdrainUrl\"-\n" +
+	"\x11GetSystemsRequest\x12\x18\n" +
+	"\aorgName\x18\x01 \x01(\tR\aorgName\"\x9e\x03\n" +
+	"\n" +
+	"SystemInfo\x12\x1e\n" +
+	"\n" +
+	"systemName\x18\x01 \x01(\tR\n" +
+	"systemName\x12\x1a\n" +
+	"\bsystemId\x18\x02 \x01(\tR\bsystemId\x12\x18\n" +
+	"\aorgName\x18\x03 \x01(\tR\aorgName\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x126\n" +
+	"\blastSeen\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\x12 \n" +
+	"\vapiGwHealth\x18\a \x01(\rR\vapiGwHealth\x12\"\n" +
+	"\fnodeGwHealth\x18\b \x01(\rR\fnodeGwHealth\x12\x1a\n" +
+	"\bapiGwUrl\x18\t \x01(\tR\bapiGwUrl\x12\x1a\n" +
+	"\bdraining\x18\n" +
+	" \x01(\bR\bdraining\x12\x18\n" +
+	"\adrainIp\x18\v \x01(\tR\adrainIp\x12\x1c\n" +
+	"\tdrainPort\x18\f \x01(\x05R\tdrainPort\x12\x1a\n" +
+	"\bdrainUrl\x18\r \x01(\tR\bdrainUrl\"K\n" +
+	"\x12GetSystemsResponse\x125\n" +
+	"\asystems\x18\x01 \x03(\v2\x1b.ukama.lookup.v1.SystemInfoR\asystems\"\xba\x01\n" +
+	"\x12DrainSystemRequest\x12&\n" +
+	"\n" +
+	"systemName\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\n" +
+	"systemName\x12 \n" +
+	"\aorgName\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\x12 \n" +
+	"\adrainIp\x18\x03 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\adrainIp\x12\x1c\n" +
+	"\tdrainPort\x18\x04 \x01(\x05R\tdrainPort\x12\x1a\n" +
+	"\bdrainUrl\x18\x05 \x01(\tR\bdrainUrl\"J\n" +
+	"\x13DrainSystemResponse\x123\n" +
+	"\x06system\x18\x01 \x01(\v2\x1b.ukama.lookup.v1.SystemInfoR\x06system\"_\n" +
+	"\x13ResumeSystemRequest\x12&\n" +
+	"\n" +
+	"systemName\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\n" +
+	"systemName\x12 \n" +
+	"\aorgName\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\"K\n" +
+	"\x14ResumeSystemResponse\x123\n" +
+	"\x06system\x18\x01 \x01(\v2\x1b.ukama.lookup.v1.SystemInfoR\x06system\"z\n" +
+	"\x10ClaimNodeRequest\x12\x1e\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\x12 \n" +
+	"\aorgName\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\x12$\n" +
+	"\tclaimCode\x18\x03 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\tclaimCode\"E\n" +
+	"\x11ClaimNodeResponse\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12\x18\n" +
+	"\aorgName\x18\x02 \x01(\tR\aorgName\"\xc6\x02\n" +
+	"\fNodeTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12 \n" +
+	"\vfromOrgName\x18\x03 \x01(\tR\vfromOrgName\x12\x1c\n" +
+	"\ttoOrgName\x18\x04 \x01(\tR\ttoOrgName\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"releasedBy\x18\x06 \x01(\tR\n" +
+	"releasedBy\x12\x1e\n" +
+	"\n" +
+	"acceptedBy\x18\a \x01(\tR\n" +
+	"acceptedBy\x128\n" +
+	"\tcreatedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\vcompletedAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\x94\x01\n" +
+	"\x12ReleaseNodeRequest\x12\x1e\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\x12 \n" +
+	"\aorgName\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\x12\x1c\n" +
+	"\ttoOrgName\x18\x03 \x01(\tR\ttoOrgName\x12\x1e\n" +
+	"\n" +
+	"releasedBy\x18\x04 \x01(\tR\n" +
+	"releasedBy\"P\n" +
+	"\x13ReleaseNodeResponse\x129\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1d.ukama.lookup.v1.NodeTransferR\btransfer\"\x9b\x01\n" +
+	"\x11AcceptNodeRequest\x12\x1e\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\x12 \n" +
+	"\aorgName\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\x12$\n" +
+	"\tclaimCode\x18\x03 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\tclaimCode\x12\x1e\n" +
+	"\n" +
+	"acceptedBy\x18\x04 \x01(\tR\n" +
+	"acceptedBy\"O\n" +
+	"\x12AcceptNodeResponse\x129\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1d.ukama.lookup.v1.NodeTransferR\btransfer\"\\\n" +
+	"\x18CancelNodeReleaseRequest\x12\x1e\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\x12 \n" +
+	"\aorgName\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\aorgName\"V\n" +
+	"\x19CancelNodeReleaseResponse\x129\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1d.ukama.lookup.v1.NodeTransferR\btransfer\"t\n" +
+	"\x16NodeCredentialsRevoked\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12\x18\n" +
+	"\aorgName\x18\x02 \x01(\tR\aorgName\x12(\n" +
+	"\x0fpreviousOrgName\x18\x03 \x01(\tR\x0fpreviousOrgName2\x8b\x0e\n" +
+	"\rLookupService\x12I\n" +
+	"\x06AddOrg\x12\x1e.ukama.lookup.v1.AddOrgRequest\x1a\x1f.ukama.lookup.v1.AddOrgResponse\x12R\n" +
+	"\tUpdateOrg\x12!.ukama.lookup.v1.UpdateOrgRequest\x1a\".ukama.lookup.v1.UpdateOrgResponse\x12I\n" +
+	"\x06GetOrg\x12\x1e.ukama.lookup.v1.GetOrgRequest\x1a\x1f.ukama.lookup.v1.GetOrgResponse\x12L\n" +
+	"\aGetOrgs\x12\x1f.ukama.lookup.v1.GetOrgsRequest\x1a .ukama.lookup.v1.GetOrgsResponse\x12L\n" +
+	"\aGetNode\x12\x1f.ukama.lookup.v1.GetNodeRequest\x1a .ukama.lookup.v1.GetNodeResponse\x12R\n" +
+	"\rAddNodeForOrg\x12\x1f.ukama.lookup.v1.AddNodeRequest\x1a .ukama.lookup.v1.AddNodeResponse\x12X\n" +
+	"\rGetNodeForOrg\x12%.ukama.lookup.v1.GetNodeForOrgRequest\x1a .ukama.lookup.v1.GetNodeResponse\x12[\n" +
+	"\x10DeleteNodeForOrg\x12\".ukama.lookup.v1.DeleteNodeRequest\x1a#.ukama.lookup.v1.DeleteNodeResponse\x12R\n" +
+	"\tClaimNode\x12!.ukama.lookup.v1.ClaimNodeRequest\x1a\".ukama.lookup.v1.ClaimNodeResponse\x12X\n" +
+	"\vReleaseNode\x12#.ukama.lookup.v1.ReleaseNodeRequest\x1a$.ukama.lookup.v1.ReleaseNodeResponse\x12U\n" +
+	"\n" +
+	"AcceptNode\x12\".ukama.lookup.v1.AcceptNodeRequest\x1a#.ukama.lookup.v1.AcceptNodeResponse\x12j\n" +
+	"\x11CancelNodeRelease\x12).ukama.lookup.v1.CancelNodeReleaseRequest\x1a*.ukama.lookup.v1.CancelNodeReleaseResponse\x12X\n" +
+	"\x0fGetSystemForOrg\x12!.ukama.lookup.v1.GetSystemRequest\x1a\".ukama.lookup.v1.GetSystemResponse\x12X\n" +
+	"\x0fAddSystemForOrg\x12!.ukama.lookup.v1.AddSystemRequest\x1a\".ukama.lookup.v1.AddSystemResponse\x12a\n" +
+	"\x12UpdateSystemForOrg\x12$.ukama.lookup.v1.UpdateSystemRequest\x1a%.ukama.lookup.v1.UpdateSystemResponse\x12a\n" +
+	"\x12DeleteSystemForOrg\x12$.ukama.lookup.v1.DeleteSystemRequest\x1a%.ukama.lookup.v1.DeleteSystemResponse\x12d\n" +
+	"\x0fSystemHeartbeat\x12'.ukama.lookup.v1.SystemHeartbeatRequest\x1a(.ukama.lookup.v1.SystemHeartbeatResponse\x12U\n" +
+	"\n" +
+	"GetSystems\x12\".ukama.lookup.v1.GetSystemsRequest\x1a#.ukama.lookup.v1.GetSystemsResponse\x12^\n" +
+	"\x11DrainSystemForOrg\x12#.ukama.lookup.v1.DrainSystemRequest\x1a$.ukama.lookup.v1.DrainSystemResponse\x12a\n" +
+	"\x12ResumeSystemForOrg\x12$.ukama.lookup.v1.ResumeSystemRequest\x1a%.ukama.lookup.v1.ResumeSystemResponseB\bZ\x06pb/genb\x06proto3"
+
+var (
+	file_lookup_proto_rawDescOnce sync.Once
+	file_lookup_proto_rawDescData []byte
+)
+
+func file_lookup_proto_rawDescGZIP() []byte {
+	file_lookup_proto_rawDescOnce.Do(func() {
+		file_lookup_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lookup_proto_rawDesc), len(file_lookup_proto_rawDesc)))
+	})
+	return file_lookup_proto_rawDescData
+}
+
+var file_lookup_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_lookup_proto_goTypes = []any{
+	(*AddOrgRequest)(nil),             // 0: ukama.lookup.v1.AddOrgRequest
+	(*AddOrgResponse)(nil),            // 1: ukama.lookup.v1.AddOrgResponse
+	(*UpdateOrgRequest)(nil),          // 2: ukama.lookup.v1.UpdateOrgRequest
+	(*UpdateOrgResponse)(nil),         // 3: ukama.lookup.v1.UpdateOrgResponse
+	(*GetOrgRequest)(nil),             // 4: ukama.lookup.v1.GetOrgRequest
+	(*GetOrgResponse)(nil),            // 5: ukama.lookup.v1.GetOrgResponse
+	(*OrgName)(nil),                   // 6: ukama.lookup.v1.OrgName
+	(*GetOrgsRequest)(nil),            // 7: ukama.lookup.v1.GetOrgsRequest
+	(*GetOrgsResponse)(nil),           // 8: ukama.lookup.v1.GetOrgsResponse
+	(*AddNodeRequest)(nil),            // 9: ukama.lookup.v1.AddNodeRequest
+	(*AddNodeResponse)(nil),           // 10: ukama.lookup.v1.AddNodeResponse
+	(*GetNodeForOrgRequest)(nil),      // 11: ukama.lookup.v1.GetNodeForOrgRequest
+	(*GetNodeResponse)(nil),           // 12: ukama.lookup.v1.GetNodeResponse
+	(*GetNodeRequest)(nil),            // 13: ukama.lookup.v1.GetNodeRequest
+	(*DeleteNodeRequest)(nil),         // 14: ukama.lookup.v1.DeleteNodeRequest
+	(*DeleteNodeResponse)(nil),        // 15: ukama.lookup.v1.DeleteNodeResponse
+	(*GetSystemRequest)(nil),          // 16: ukama.lookup.v1.GetSystemRequest
+	(*GetSystemResponse)(nil),         // 17: ukama.lookup.v1.GetSystemResponse
+	(*AddSystemRequest)(nil),          // 18: ukama.lookup.v1.AddSystemRequest
+	(*AddSystemResponse)(nil),         // 19: ukama.lookup.v1.AddSystemResponse
+	(*UpdateSystemRequest)(nil),       // 20: ukama.lookup.v1.UpdateSystemRequest
+	(*UpdateSystemResponse)(nil),      // 21: ukama.lookup.v1.UpdateSystemResponse
+	(*DeleteSystemRequest)(nil),       // 22: ukama.lookup.v1.DeleteSystemRequest
+	(*DeleteSystemResponse)(nil),      // 23: ukama.lookup.v1.DeleteSystemResponse
+	(*SystemHeartbeatRequest)(nil),    // 24: ukama.lookup.v1.SystemHeartbeatRequest
+	(*SystemHeartbeatResponse)(nil),   // 25: ukama.lookup.v1.SystemHeartbeatResponse
+	(*GetSystemsRequest)(nil),         // 26: ukama.lookup.v1.GetSystemsRequest
+	(*SystemInfo)(nil),                // 27: ukama.lookup.v1.SystemInfo
+	(*GetSystemsResponse)(nil),        // 28: ukama.lookup.v1.GetSystemsResponse
+	(*DrainSystemRequest)(nil),        // 29: ukama.lookup.v1.DrainSystemRequest
+	(*DrainSystemResponse)(nil),       // 30: ukama.lookup.v1.DrainSystemResponse
+	(*ResumeSystemRequest)(nil),       // 31: ukama.lookup.v1.ResumeSystemRequest
+	(*ResumeSystemResponse)(nil),      // 32: ukama.lookup.v1.ResumeSystemResponse
+	(*ClaimNodeRequest)(nil),          // 33: ukama.lookup.v1.ClaimNodeRequest
+	(*ClaimNodeResponse)(nil),         // 34: ukama.lookup.v1.ClaimNodeResponse
+	(*NodeTransfer)(nil),              // 35: ukama.lookup.v1.NodeTransfer
+	(*ReleaseNodeRequest)(nil),        // 36: ukama.lookup.v1.ReleaseNodeRequest
+	(*ReleaseNodeResponse)(nil),       // 37: ukama.lookup.v1.ReleaseNodeResponse
+	(*AcceptNodeRequest)(nil),         // 38: ukama.lookup.v1.AcceptNodeRequest
+	(*AcceptNodeResponse)(nil),        // 39: ukama.lookup.v1.AcceptNodeResponse
+	(*CancelNodeReleaseRequest)(nil),  // 40: ukama.lookup.v1.CancelNodeReleaseRequest
+	(*CancelNodeReleaseResponse)(nil), // 41: ukama.lookup.v1.CancelNodeReleaseResponse
+	(*NodeCredentialsRevoked)(nil),    // 42: ukama.lookup.v1.NodeCredentialsRevoked
+	(*timestamppb.Timestamp)(nil),     // 43: google.protobuf.Timestamp
+}
+var file_lookup_proto_depIdxs = []int32{
+	6,  // 0: ukama.lookup.v1.GetOrgsResponse.orgs:type_name -> ukama.lookup.v1.OrgName
+	43, // 1: ukama.lookup.v1.GetSystemResponse.lastSeen:type_name -> google.protobuf.Timestamp
+	43, // 2: ukama.lookup.v1.SystemInfo.lastSeen:type_name -> google.protobuf.Timestamp
+	27, // 3: ukama.lookup.v1.GetSystemsResponse.systems:type_name -> ukama.lookup.v1.SystemInfo
+	27, // 4: ukama.lookup.v1.DrainSystemResponse.system:type_name -> ukama.lookup.v1.SystemInfo
+	27, // 5: ukama.lookup.v1.ResumeSystemResponse.system:type_name -> ukama.lookup.v1.SystemInfo
+	43, // 6: ukama.lookup.v1.NodeTransfer.createdAt:type_name -> google.protobuf.Timestamp
+	43, // 7: ukama.lookup.v1.NodeTransfer.completedAt:type_name -> google.protobuf.Timestamp
+	35, // 8: ukama.lookup.v1.ReleaseNodeResponse.transfer:type_name -> ukama.lookup.v1.NodeTransfer
+	35, // 9: ukama.lookup.v1.AcceptNodeResponse.transfer:type_name -> ukama.lookup.v1.NodeTransfer
+	35, // 10: ukama.lookup.v1.CancelNodeReleaseResponse.transfer:type_name -> ukama.lookup.v1.NodeTransfer
+	0,  // 11: ukama.lookup.v1.LookupService.AddOrg:input_type -> ukama.lookup.v1.AddOrgRequest
+	2,  // 12: ukama.lookup.v1.LookupService.UpdateOrg:input_type -> ukama.lookup.v1.UpdateOrgRequest
+	4,  // 13: ukama.lookup.v1.LookupService.GetOrg:input_type -> ukama.lookup.v1.GetOrgRequest
+	7,  // 14: ukama.lookup.v1.LookupService.GetOrgs:input_type -> ukama.lookup.v1.GetOrgsRequest
+	13, // 15: ukama.lookup.v1.LookupService.GetNode:input_type -> ukama.lookup.v1.GetNodeRequest
+	9,  // 16: ukama.lookup.v1.LookupService.AddNodeForOrg:input_type -> ukama.lookup.v1.AddNodeRequest
+	11, // 17: ukama.lookup.v1.LookupService.GetNodeForOrg:input_type -> ukama.lookup.v1.GetNodeForOrgRequest
+	14, // 18: ukama.lookup.v1.LookupService.DeleteNodeForOrg:input_type -> ukama.lookup.v1.DeleteNodeRequest
+	33, // 19: ukama.lookup.v1.LookupService.ClaimNode:input_type -> ukama.lookup.v1.ClaimNodeRequest
+	36, // 20: ukama.lookup.v1.LookupService.ReleaseNode:input_type -> ukama.lookup.v1.ReleaseNodeRequest
+	38, // 21: ukama.lookup.v1.LookupService.AcceptNode:input_type -> ukama.lookup.v1.AcceptNodeRequest
+	40, // 22: ukama.lookup.v1.LookupService.CancelNodeRelease:input_type -> ukama.lookup.v1.CancelNodeReleaseRequest
+	16, // 23: ukama.lookup.v1.LookupService.GetSystemForOrg:input_type -> ukama.lookup.v1.GetSystemRequest
+	18, // 24: ukama.lookup.v1.LookupService.AddSystemForOrg:input_type -> ukama.lookup.v1.AddSystemRequest
+	20, // 25: ukama.lookup.v1.LookupService.UpdateSystemForOrg:input_type -> ukama.lookup.v1.UpdateSystemRequest
+	22, // 26: ukama.lookup.v1.LookupService.DeleteSystemForOrg:input_type -> ukama.lookup.v1.DeleteSystemRequest
+	24, // 27: ukama.lookup.v1.LookupService.SystemHeartbeat:input_type -> ukama.lookup.v1.SystemHeartbeatRequest
+	26, // 28: ukama.lookup.v1.LookupService.GetSystems:input_type -> ukama.lookup.v1.GetSystemsRequest
+	29, // 29: ukama.lookup.v1.LookupService.DrainSystemForOrg:input_type -> ukama.lookup.v1.DrainSystemRequest
+	31, // 30: ukama.lookup.v1.LookupService.ResumeSystemForOrg:input_type -> ukama.lookup.v1.ResumeSystemRequest
+	1,  // 31: ukama.lookup.v1.LookupService.AddOrg:output_type -> ukama.lookup.v1.AddOrgResponse
+	3,  // 32: ukama.lookup.v1.LookupService.UpdateOrg:output_type -> ukama.lookup.v1.UpdateOrgResponse
+	5,  // 33: ukama.lookup.v1.LookupService.GetOrg:output_type -> ukama.lookup.v1.GetOrgResponse
+	8,  // 34: ukama.lookup.v1.LookupService.GetOrgs:output_type -> ukama.lookup.v1.GetOrgsResponse
+	12, // 35: ukama.lookup.v1.LookupService.GetNode:output_type -> ukama.lookup.v1.GetNodeResponse
+	10, // 36: ukama.lookup.v1.LookupService.AddNodeForOrg:output_type -> ukama.lookup.v1.AddNodeResponse
+	12, // 37: ukama.lookup.v1.LookupService.GetNodeForOrg:output_type -> ukama.lookup.v1.GetNodeResponse
+	15, // 38: ukama.lookup.v1.LookupService.DeleteNodeForOrg:output_type -> ukama.lookup.v1.DeleteNodeResponse
+	34, // 39: ukama.lookup.v1.LookupService.ClaimNode:output_type -> ukama.lookup.v1.ClaimNodeResponse
+	37, // 40: ukama.lookup.v1.LookupService.ReleaseNode:output_type -> ukama.lookup.v1.ReleaseNodeResponse
+	39, // 41: ukama.lookup.v1.LookupService.AcceptNode:output_type -> ukama.lookup.v1.AcceptNodeResponse
+	41, // 42: ukama.lookup.v1.LookupService.CancelNodeRelease:output_type -> ukama.lookup.v1.CancelNodeReleaseResponse
+	17, // 43: ukama.lookup.v1.LookupService.GetSystemForOrg:output_type -> ukama.lookup.v1.GetSystemResponse
+	19, // 44: ukama.lookup.v1.LookupService.AddSystemForOrg:output_type -> ukama.lookup.v1.AddSystemResponse
+	21, // 45: ukama.lookup.v1.LookupService.UpdateSystemForOrg:output_type -> ukama.lookup.v1.UpdateSystemResponse
+	23, // 46: ukama.lookup.v1.LookupService.DeleteSystemForOrg:output_type -> ukama.lookup.v1.DeleteSystemResponse
+	25, // 47: ukama.lookup.v1.LookupService.SystemHeartbeat:output_type -> ukama.lookup.v1.SystemHeartbeatResponse
+	28, // 48: ukama.lookup.v1.LookupService.GetSystems:output_type -> ukama.lookup.v1.GetSystemsResponse
+	30, // 49: ukama.lookup.v1.LookupService.DrainSystemForOrg:output_type -> ukama.lookup.v1.DrainSystemResponse
+	32, // 50: ukama.lookup.v1.LookupService.ResumeSystemForOrg:output_type -> ukama.lookup.v1.ResumeSystemResponse
+	31, // [31:51] is the sub-list for method output_type
+	11, // [11:31] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_lookup_proto_init() }
+func file_lookup_proto_init() {
+	if File_lookup_proto != nil {
+		return
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_lookup_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lookup_proto_rawDesc), len(file_lookup_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2250,7 +3201,6 @@ func file_lookup_proto_init() {
 		MessageInfos:      file_lookup_proto_msgTypes,
 	}.Build()
 	File_lookup_proto = out.File
-	file_lookup_proto_rawDesc = nil
 	file_lookup_proto_goTypes = nil
 	file_lookup_proto_depIdxs = nil
 }
@@ -8,6 +8,7 @@ import (
 	math "math"
 	proto "github.com/golang/protobuf/proto"
 	_ "github.com/mwitkow/go-proto-validators"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	regexp "regexp"
 	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
 )
@@ -135,6 +136,11 @@ func (this *GetSystemRequest) Validate() error {
 	return nil
 }
 func (this *GetSystemResponse) Validate() error {
+	if this.LastSeen != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LastSeen); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LastSeen", err)
+		}
+	}
 	return nil
 }
 func (this *AddSystemRequest) Validate() error {
@@ -179,3 +185,158 @@ func (this *DeleteSystemRequest) Validate() error {
 func (this *DeleteSystemResponse) Validate() error {
 	return nil
 }
+func (this *SystemHeartbeatRequest) Validate() error {
+	if this.SystemName == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SystemName", fmt.Errorf(`value '%v' must not be an empty string`, this.SystemName))
+	}
+	if this.OrgName == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("OrgName", fmt.Errorf(`value '%v' must not be an empty string`, this.OrgName))
+	}
+	return nil
+}
+func (this *SystemHeartbeatResponse) Validate() error {
+	return nil
+}
+func (this *GetSystemsRequest) Validate() error {
+	return nil
+}
+func (this *SystemInfo) Validate() error {
+	if this.LastSeen != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LastSeen); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LastSeen", err)
+		}
+	}
+	return nil
+}
+func (this *GetSystemsResponse) Validate() error {
+	for _, item := range this.Systems {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Systems", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *DrainSystemRequest) Validate() error {
+	if this.SystemName == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SystemName", fmt.Errorf(`value '%v' must not be an empty string`, this.SystemName))
+	}
+	if this.OrgName == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("OrgName", fmt.Errorf(`value '%v' must not be an empty string`, this.OrgName))
+	}
+	if this.DrainIp == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("DrainIp", fmt.Errorf(`value '%v' must not be an empty string`, this.DrainIp))
+	}
+	return nil
+}
+func (this *DrainSystemResponse) Validate() error {
+	if this.System != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.System); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("System", err)
+		}
+	}
+	return nil
+}
+func (this *ResumeSystemRequest) Validate() error {
+	if this.SystemName == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SystemName", fmt.Errorf(`value '%v' must not be an empty string`, this.SystemName))
+	}
+	if this.OrgName == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("OrgName", fmt.Errorf(`value '%v' must not be an empty string`, this.OrgName))
+	}
+	return nil
+}
+func (this *ResumeSystemResponse) Validate() error {
+	if this.System != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.System); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("System", err)
+		}
+	}
+	return nil
+}
+func (this *ClaimNodeRequest) Validate() error {
+	if this.NodeId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("NodeId", fmt.Errorf(`value '%v' must not be an empty string`, this.NodeId))
+	}
+	if this.OrgName == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("OrgName", fmt.Errorf(`value '%v' must not be an empty string`, this.OrgName))
+	}
+	if this.ClaimCode == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("ClaimCode", fmt.Errorf(`value '%v' must not be an empty string`, this.ClaimCode))
+	}
+	return nil
+}
+func (this *ClaimNodeResponse) Validate() error {
+	return nil
+}
+func (this *NodeTransfer) Validate() error {
+	if this.CreatedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+		}
+	}
+	if this.CompletedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CompletedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CompletedAt", err)
+		}
+	}
+	return nil
+}
+func (this *ReleaseNodeRequest) Validate() error {
+	if this.NodeId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("NodeId", fmt.Errorf(`value '%v' must not be an empty string`, this.NodeId))
+	}
+	if this.OrgName == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("OrgName", fmt.Errorf(`value '%v' must not be an empty string`, this.OrgName))
+	}
+	return nil
+}
+func (this *ReleaseNodeResponse) Validate() error {
+	if this.Transfer != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Transfer); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Transfer", err)
+		}
+	}
+	return nil
+}
+func (this *AcceptNodeRequest) Validate() error {
+	if this.NodeId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("NodeId", fmt.Errorf(`value '%v' must not be an empty string`, this.NodeId))
+	}
+	if this.OrgName == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("OrgName", fmt.Errorf(`value '%v' must not be an empty string`, this.OrgName))
+	}
+	if this.ClaimCode == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("ClaimCode", fmt.Errorf(`value '%v' must not be an empty string`, this.ClaimCode))
+	}
+	return nil
+}
+func (this *AcceptNodeResponse) Validate() error {
+	if this.Transfer != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Transfer); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Transfer", err)
+		}
+	}
+	return nil
+}
+func (this *CancelNodeReleaseRequest) Validate() error {
+	if this.NodeId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("NodeId", fmt.Errorf(`value '%v' must not be an empty string`, this.NodeId))
+	}
+	if this.OrgName == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("OrgName", fmt.Errorf(`value '%v' must not be an empty string`, this.OrgName))
+	}
+	return nil
+}
+func (this *CancelNodeReleaseResponse) Validate() error {
+	if this.Transfer != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Transfer); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Transfer", err)
+		}
+	}
+	return nil
+}
+func (this *NodeCredentialsRevoked) Validate() error {
+	return nil
+}
@@ -1,6 +1,13 @@
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2023-present, Ukama Inc.
+
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.2.0
+// - protoc-gen-go-grpc v1.5.1
 // - protoc             v6.32.1
 // source: lookup.proto
 
@@ -15,8 +22,31 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.32.0 or later.
-const _ = grpc.SupportPackageIsVersion7
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LookupService_AddOrg_FullMethodName             = "/ukama.lookup.v1.LookupService/AddOrg"
+	LookupService_UpdateOrg_FullMethodName          = "/ukama.lookup.v1.LookupService/UpdateOrg"
+	LookupService_GetOrg_FullMethodName             = "/ukama.lookup.v1.LookupService/GetOrg"
+	LookupService_GetOrgs_FullMethodName            = "/ukama.lookup.v1.LookupService/GetOrgs"
+	LookupService_GetNode_FullMethodName            = "/ukama.lookup.v1.LookupService/GetNode"
+	LookupService_AddNodeForOrg_FullMethodName      = "/ukama.lookup.v1.LookupService/AddNodeForOrg"
+	LookupService_GetNodeForOrg_FullMethodName      = "/ukama.lookup.v1.LookupService/GetNodeForOrg"
+	LookupService_DeleteNodeForOrg_FullMethodName   = "/ukama.lookup.v1.LookupService/DeleteNodeForOrg"
+	LookupService_ClaimNode_FullMethodName          = "/ukama.lookup.v1.LookupService/ClaimNode"
+	LookupService_ReleaseNode_FullMethodName        = "/ukama.lookup.v1.LookupService/ReleaseNode"
+	LookupService_AcceptNode_FullMethodName         = "/ukama.lookup.v1.LookupService/AcceptNode"
+	LookupService_CancelNodeRelease_FullMethodName  = "/ukama.lookup.v1.LookupService/CancelNodeRelease"
+	LookupService_GetSystemForOrg_FullMethodName    = "/ukama.lookup.v1.LookupService/GetSystemForOrg"
+	LookupService_AddSystemForOrg_FullMethodName    = "/ukama.lookup.v1.LookupService/AddSystemForOrg"
+	LookupService_UpdateSystemForOrg_FullMethodName = "/ukama.lookup.v1.LookupService/UpdateSystemForOrg"
+	LookupService_DeleteSystemForOrg_FullMethodName = "/ukama.lookup.v1.LookupService/DeleteSystemForOrg"
+	LookupService_SystemHeartbeat_FullMethodName    = "/ukama.lookup.v1.LookupService/SystemHeartbeat"
+	LookupService_GetSystems_FullMethodName         = "/ukama.lookup.v1.LookupService/GetSystems"
+	LookupService_DrainSystemForOrg_FullMethodName  = "/ukama.lookup.v1.LookupService/DrainSystemForOrg"
+	LookupService_ResumeSystemForOrg_FullMethodName = "/ukama.lookup.v1.LookupService/ResumeSystemForOrg"
+)
 
 // LookupServiceClient is the client API for LookupService service.
 //
@@ -33,11 +63,22 @@ type LookupServiceClient interface {
 	AddNodeForOrg(ctx context.Context, in *AddNodeRequest, opts ...grpc.CallOption) (*AddNodeResponse, error)
 	GetNodeForOrg(ctx context.Context, in *GetNodeForOrgRequest, opts ...grpc.CallOption) (*GetNodeResponse, error)
 	DeleteNodeForOrg(ctx context.Context, in *DeleteNodeRequest, opts ...grpc.CallOption) (*DeleteNodeResponse, error)
+	// Node ownership: claim with the factory code, or release and accept
+	ClaimNode(ctx context.Context, in *ClaimNodeRequest, opts ...grpc.CallOption) (*ClaimNodeResponse, error)
+	ReleaseNode(ctx context.Context, in *ReleaseNodeRequest, opts ...grpc.CallOption) (*ReleaseNodeResponse, error)
+	AcceptNode(ctx context.Context, in *AcceptNodeRequest, opts ...grpc.CallOption) (*AcceptNodeResponse, error)
+	CancelNodeRelease(ctx context.Context, in *CancelNodeReleaseRequest, opts ...grpc.CallOption) (*CancelNodeReleaseResponse, error)
 	// System
 	GetSystemForOrg(ctx context.Context, in *GetSystemRequest, opts ...grpc.CallOption) (*GetSystemResponse, error)
 	AddSystemForOrg(ctx context.Context, in *AddSystemRequest, opts ...grpc.CallOption) (*AddSystemResponse, error)
 	UpdateSystemForOrg(ctx context.Context, in *UpdateSystemRequest, opts ...grpc.CallOption) (*UpdateSystemResponse, error)
 	DeleteSystemForOrg(ctx context.Context, in *DeleteSystemRequest, opts ...grpc.CallOption) (*DeleteSystemResponse, error)
+	// System health and inventory
+	SystemHeartbeat(ctx context.Context, in *SystemHeartbeatRequest, opts ...grpc.CallOption) (*SystemHeartbeatResponse, error)
+	GetSystems(ctx context.Context, in *GetSystemsRequest, opts ...grpc.CallOption) (*GetSystemsResponse, error)
+	// Moving an org's system to another endpoint
+	DrainSystemForOrg(ctx context.Context, in *DrainSystemRequest, opts ...grpc.CallOption) (*DrainSystemResponse, error)
+	ResumeSystemForOrg(ctx context.Context, in *ResumeSystemRequest, opts ...grpc.CallOption) (*ResumeSystemResponse, error)
 }
 
 type lookupServiceClient struct {
@@ -49,8 +90,9 @@ func NewLookupServiceClient(cc grpc.ClientConnInterface) LookupServiceClient {
 }
 
 func (c *lookupServiceClient) AddOrg(ctx context.Context, in *AddOrgRequest, opts ...grpc.CallOption) (*AddOrgResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddOrgResponse)
-	err := c.cc.Invoke(ctx, "/ukama.lookup.v1.LookupService/AddOrg", in, out, opts...)
+	err := c.cc.Invoke(ctx, LookupService_AddOrg_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -58,8 +100,9 @@ func (c *lookupServiceClient) AddOrg(ctx context.Context, in *AddOrgRequest, opt
 }
 
 func (c *lookupServiceClient) UpdateOrg(ctx context.Context, in *UpdateOrgRequest, opts ...grpc.CallOption) (*UpdateOrgResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateOrgResponse)
-	err := c.cc.Invoke(ctx, "/ukama.lookup.v1.LookupService/UpdateOrg", in, out, opts...)
+	err := c.cc.Invoke(ctx, LookupService_UpdateOrg_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -67,8 +110,9 @@ func (c *lookupServiceClient) UpdateOrg(ctx context.Context, in *UpdateOrgReques
 }
 
 func (c *lookupServiceClient) GetOrg(ctx context.Context, in *GetOrgRequest, opts ...grpc.CallOption) (*GetOrgResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetOrgResponse)
-	err := c.cc.Invoke(ctx, "/ukama.lookup.v1.LookupService/GetOrg", in, out, opts...)
+	err := c.cc.Invoke(ctx, LookupService_GetOrg_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -76,8 +120,9 @@ func (c *lookupServiceClient) GetOrg(ctx context.Context, in *GetOrgRequest, opt
 }
 
 func (c *lookupServiceClient) GetOrgs(ctx context.Context, in *GetOrgsRequest, opts ...grpc.CallOption) (*GetOrgsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetOrgsResponse)
-	err := c.cc.Invoke(ctx, "/ukama.lookup.v1.LookupService/GetOrgs", in, out, opts...)
+	err := c.cc.Invoke(ctx, LookupService_GetOrgs_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -85,8 +130,9 @@ func (c *lookupServiceClient) GetOrgs(ctx context.Context, in *GetOrgsRequest, o
 }
 
 func (c *lookupServiceClient) GetNode(ctx context.Context, in *GetNodeRequest, opts ...grpc.CallOption) (*GetNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetNodeResponse)
-	err := c.cc.Invoke(ctx, "/ukama.lookup.v1.LookupService/GetNode", in, out, opts...)
+	err := c.cc.Invoke(ctx, LookupService_GetNode_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -94,8 +140,9 @@ func (c *lookupServiceClient) GetNode(ctx context.Context, in *GetNodeRequest, o
 }
 
 func (c *lookupServiceClient) AddNodeForOrg(ctx context.Context, in *AddNodeRequest, opts ...grpc.CallOption) (*AddNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddNodeResponse)
-	err := c.cc.Invoke(ctx, "/ukama.lookup.v1.LookupService/AddNodeForOrg", in, out, opts...)
+	err := c.cc.Invoke(ctx, LookupService_AddNodeForOrg_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -103,8 +150,9 @@ func (c *lookupServiceClient) AddNodeForOrg(ctx context.Context, in *AddNodeRequ
 }
 
 func (c *lookupServiceClient) GetNodeForOrg(ctx context.Context, in *GetNodeForOrgRequest, opts ...grpc.CallOption) (*GetNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetNodeResponse)
-	err := c.cc.Invoke(ctx, "/ukama.lookup.v1.LookupService/GetNodeForOrg", in, out, opts...)
+	err := c.cc.Invoke(ctx, LookupService_GetNodeForOrg_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -112,8 +160,49 @@ func (c *lookupServiceClient) GetNodeForOrg(ctx context.Context, in *GetNodeForO
 }
 
 func (c *lookupServiceClient) DeleteNodeForOrg(ctx context.Context, in *DeleteNodeRequest, opts ...grpc.CallOption) (*DeleteNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteNodeResponse)
-	err := c.cc.Invoke(ctx, "/ukama.lookup.v1.LookupService/DeleteNodeForOrg", in, out, opts...)
+	err := c.cc.Invoke(ctx, LookupService_DeleteNodeForOrg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lookupServiceClient) ClaimNode(ctx context.Context, in *ClaimNodeRequest, opts ...grpc.CallOption) (*ClaimNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimNodeResponse)
+	err := c.cc.Invoke(ctx, LookupService_ClaimNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lookupServiceClient) ReleaseNode(ctx context.Context, in *ReleaseNodeRequest, opts ...grpc.CallOption) (*ReleaseNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseNodeResponse)
+	err := c.cc.Invoke(ctx, LookupService_ReleaseNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lookupServiceClient) AcceptNode(ctx context.Context, in *AcceptNodeRequest, opts ...grpc.CallOption) (*AcceptNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptNodeResponse)
+	err := c.cc.Invoke(ctx, LookupService_AcceptNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lookupServiceClient) CancelNodeRelease(ctx context.Context, in *CancelNodeReleaseRequest, opts ...grpc.CallOption) (*CancelNodeReleaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelNodeReleaseResponse)
+	err := c.cc.Invoke(ctx, LookupService_CancelNodeRelease_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -121,8 +210,9 @@ func (c *lookupServiceClient) DeleteNodeForOrg(ctx context.Context, in *DeleteNo
 }
 
 func (c *lookupServiceClient) GetSystemForOrg(ctx context.Context, in *GetSystemRequest, opts ...grpc.CallOption) (*GetSystemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSystemResponse)
-	err := c.cc.Invoke(ctx, "/ukama.lookup.v1.LookupService/GetSystemForOrg", in, out, opts...)
+	err := c.cc.Invoke(ctx, LookupService_GetSystemForOrg_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -130,8 +220,9 @@ func (c *lookupServiceClient) GetSystemForOrg(ctx context.Context, in *GetSystem
 }
 
 func (c *lookupServiceClient) AddSystemForOrg(ctx context.Context, in *AddSystemRequest, opts ...grpc.CallOption) (*AddSystemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddSystemResponse)
-	err := c.cc.Invoke(ctx, "/ukama.lookup.v1.LookupService/AddSystemForOrg", in, out, opts...)
+	err := c.cc.Invoke(ctx, LookupService_AddSystemForOrg_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -139,8 +230,9 @@ func (c *lookupServiceClient) AddSystemForOrg(ctx context.Context, in *AddSystem
 }
 
 func (c *lookupServiceClient) UpdateSystemForOrg(ctx context.Context, in *UpdateSystemRequest, opts ...grpc.CallOption) (*UpdateSystemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateSystemResponse)
-	err := c.cc.Invoke(ctx, "/ukama.lookup.v1.LookupService/UpdateSystemForOrg", in, out, opts...)
+	err := c.cc.Invoke(ctx, LookupService_UpdateSystemForOrg_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -148,8 +240,49 @@ func (c *lookupServiceClient) UpdateSystemForOrg(ctx context.Context, in *Update
 }
 
 func (c *lookupServiceClient) DeleteSystemForOrg(ctx context.Context, in *DeleteSystemRequest, opts ...grpc.CallOption) (*DeleteSystemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteSystemResponse)
-	err := c.cc.Invoke(ctx, "/ukama.lookup.v1.LookupService/DeleteSystemForOrg", in, out, opts...)
+	err := c.cc.Invoke(ctx, LookupService_DeleteSystemForOrg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lookupServiceClient) SystemHeartbeat(ctx context.Context, in *SystemHeartbeatRequest, opts ...grpc.CallOption) (*SystemHeartbeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SystemHeartbeatResponse)
+	err := c.cc.Invoke(ctx, LookupService_SystemHeartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lookupServiceClient) GetSystems(ctx context.Context, in *GetSystemsRequest, opts ...grpc.CallOption) (*GetSystemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSystemsResponse)
+	err := c.cc.Invoke(ctx, LookupService_GetSystems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lookupServiceClient) DrainSystemForOrg(ctx context.Context, in *DrainSystemRequest, opts ...grpc.CallOption) (*DrainSystemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrainSystemResponse)
+	err := c.cc.Invoke(ctx, LookupService_DrainSystemForOrg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lookupServiceClient) ResumeSystemForOrg(ctx context.Context, in *ResumeSystemRequest, opts ...grpc.CallOption) (*ResumeSystemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeSystemResponse)
+	err := c.cc.Invoke(ctx, LookupService_ResumeSystemForOrg_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +291,7 @@ func (c *lookupServiceClient) DeleteSystemForOrg(ctx context.Context, in *Delete
 
 // LookupServiceServer is the server API for LookupService service.
 // All implementations must embed UnimplementedLookupServiceServer
-// for forward compatibility
+// for forward compatibility.
 type LookupServiceServer interface {
 	// Orgs
 	AddOrg(context.Context, *AddOrgRequest) (*AddOrgResponse, error)
@@ -171,17 +304,31 @@ type LookupServiceServer interface {
 	AddNodeForOrg(context.Context, *AddNodeRequest) (*AddNodeResponse, error)
 	GetNodeForOrg(context.Context, *GetNodeForOrgRequest) (*GetNodeResponse, error)
 	DeleteNodeForOrg(context.Context, *DeleteNodeRequest) (*DeleteNodeResponse, error)
+	// Node ownership: claim with the factory code, or release and accept
+	ClaimNode(context.Context, *ClaimNodeRequest) (*ClaimNodeResponse, error)
+	ReleaseNode(context.Context, *ReleaseNodeRequest) (*ReleaseNodeResponse, error)
+	AcceptNode(context.Context, *AcceptNodeRequest) (*AcceptNodeResponse, error)
+	CancelNodeRelease(context.Context, *CancelNodeReleaseRequest) (*CancelNodeReleaseResponse, error)
 	// System
 	GetSystemForOrg(context.Context, *GetSystemRequest) (*GetSystemResponse, error)
 	AddSystemForOrg(context.Context, *AddSystemRequest) (*AddSystemResponse, error)
 	UpdateSystemForOrg(context.Context, *UpdateSystemRequest) (*UpdateSystemResponse, error)
 	DeleteSystemForOrg(context.Context, *DeleteSystemRequest) (*DeleteSystemResponse, error)
+	// System health and inventory
+	SystemHeartbeat(context.Context, *SystemHeartbeatRequest) (*SystemHeartbeatResponse, error)
+	GetSystems(context.Context, *GetSystemsRequest) (*GetSystemsResponse, error)
+	// Moving an org's system to another endpoint
+	DrainSystemForOrg(context.Context, *DrainSystemRequest) (*DrainSystemResponse, error)
+	ResumeSystemForOrg(context.Context, *ResumeSystemRequest) (*ResumeSystemResponse, error)
 	mustEmbedUnimplementedLookupServiceServer()
 }
 
-// UnimplementedLookupServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedLookupServiceServer struct {
-}
+// UnimplementedLookupServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLookupServiceServer struct{}
 
 func (UnimplementedLookupServiceServer) AddOrg(context.Context, *AddOrgRequest) (*AddOrgResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddOrg not implemented")
@@ -207,6 +354,18 @@ func (UnimplementedLookupServiceServer) GetNodeForOrg(context.Context, *GetNodeF
 func (UnimplementedLookupServiceServer) DeleteNodeForOrg(context.Context, *DeleteNodeRequest) (*DeleteNodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteNodeForOrg not implemented")
 }
+func (UnimplementedLookupServiceServer) ClaimNode(context.Context, *ClaimNodeRequest) (*ClaimNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimNode not implemented")
+}
+func (UnimplementedLookupServiceServer) ReleaseNode(context.Context, *ReleaseNodeRequest) (*ReleaseNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseNode not implemented")
+}
+func (UnimplementedLookupServiceServer) AcceptNode(context.Context, *AcceptNodeRequest) (*AcceptNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptNode not implemented")
+}
+func (UnimplementedLookupServiceServer) CancelNodeRelease(context.Context, *CancelNodeReleaseRequest) (*CancelNodeReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelNodeRelease not implemented")
+}
 func (UnimplementedLookupServiceServer) GetSystemForOrg(context.Context, *GetSystemRequest) (*GetSystemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSystemForOrg not implemented")
 }
@@ -219,7 +378,20 @@ func (UnimplementedLookupServiceServer) UpdateSystemForOrg(context.Context, *Upd
 func (UnimplementedLookupServiceServer) DeleteSystemForOrg(context.Context, *DeleteSystemRequest) (*DeleteSystemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSystemForOrg not implemented")
 }
+func (UnimplementedLookupServiceServer) SystemHeartbeat(context.Context, *SystemHeartbeatRequest) (*SystemHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemHeartbeat not implemented")
+}
+func (UnimplementedLookupServiceServer) GetSystems(context.Context, *GetSystemsRequest) (*GetSystemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystems not implemented")
+}
+func (UnimplementedLookupServiceServer) DrainSystemForOrg(context.Context, *DrainSystemRequest) (*DrainSystemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainSystemForOrg not implemented")
+}
+func (UnimplementedLookupServiceServer) ResumeSystemForOrg(context.Context, *ResumeSystemRequest) (*ResumeSystemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSystemForOrg not implemented")
+}
 func (UnimplementedLookupServiceServer) mustEmbedUnimplementedLookupServiceServer() {}
+func (UnimplementedLookupServiceServer) testEmbeddedByValue()                       {}
 
 // UnsafeLookupServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to LookupServiceServer will
@@ -229,6 +401,13 @@ type UnsafeLookupServiceServer interface {
 }
 
 func RegisterLookupServiceServer(s grpc.ServiceRegistrar, srv LookupServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLookupServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
 	s.RegisterService(&LookupService_ServiceDesc, srv)
 }
 
@@ -242,7 +421,7 @@ func _LookupService_AddOrg_Handler(srv interface{}, ctx context.Context, dec fun
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.lookup.v1.LookupService/AddOrg",
+		FullMethod: LookupService_AddOrg_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(LookupServiceServer).AddOrg(ctx, req.(*AddOrgRequest))
@@ -260,7 +439,7 @@ func _LookupService_UpdateOrg_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.lookup.v1.LookupService/UpdateOrg",
+		FullMethod: LookupService_UpdateOrg_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(LookupServiceServer).UpdateOrg(ctx, req.(*UpdateOrgRequest))
@@ -278,7 +457,7 @@ func _LookupService_GetOrg_Handler(srv interface{}, ctx context.Context, dec fun
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.lookup.v1.LookupService/GetOrg",
+		FullMethod: LookupService_GetOrg_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(LookupServiceServer).GetOrg(ctx, req.(*GetOrgRequest))
@@ -296,7 +475,7 @@ func _LookupService_GetOrgs_Handler(srv interface{}, ctx context.Context, dec fu
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.lookup.v1.LookupService/GetOrgs",
+		FullMethod: LookupService_GetOrgs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(LookupServiceServer).GetOrgs(ctx, req.(*GetOrgsRequest))
@@ -314,7 +493,7 @@ func _LookupService_GetNode_Handler(srv interface{}, ctx context.Context, dec fu
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.lookup.v1.LookupService/GetNode",
+		FullMethod: LookupService_GetNode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(LookupServiceServer).GetNode(ctx, req.(*GetNodeRequest))
@@ -332,7 +511,7 @@ func _LookupService_AddNodeForOrg_Handler(srv interface{}, ctx context.Context,
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.lookup.v1.LookupService/AddNodeForOrg",
+		FullMethod: LookupService_AddNodeForOrg_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(LookupServiceServer).AddNodeForOrg(ctx, req.(*AddNodeRequest))
@@ -350,7 +529,7 @@ func _LookupService_GetNodeForOrg_Handler(srv interface{}, ctx context.Context,
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.lookup.v1.LookupService/GetNodeForOrg",
+		FullMethod: LookupService_GetNodeForOrg_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(LookupServiceServer).GetNodeForOrg(ctx, req.(*GetNodeForOrgRequest))
@@ -368,7 +547,7 @@ func _LookupService_DeleteNodeForOrg_Handler(srv interface{}, ctx context.Contex
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.lookup.v1.LookupService/DeleteNodeForOrg",
+		FullMethod: LookupService_DeleteNodeForOrg_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(LookupServiceServer).DeleteNodeForOrg(ctx, req.(*DeleteNodeRequest))
@@ -376,6 +555,78 @@ func _LookupService_DeleteNodeForOrg_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LookupService_ClaimNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LookupServiceServer).ClaimNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LookupService_ClaimNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookupServiceServer).ClaimNode(ctx, req.(*ClaimNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LookupService_ReleaseNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LookupServiceServer).ReleaseNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LookupService_ReleaseNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookupServiceServer).ReleaseNode(ctx, req.(*ReleaseNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LookupService_AcceptNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LookupServiceServer).AcceptNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LookupService_AcceptNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookupServiceServer).AcceptNode(ctx, req.(*AcceptNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LookupService_CancelNodeRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelNodeReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LookupServiceServer).CancelNodeRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LookupService_CancelNodeRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookupServiceServer).CancelNodeRelease(ctx, req.(*CancelNodeReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LookupService_GetSystemForOrg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSystemRequest)
 	if err := dec(in); err != nil {
@@ -386,7 +637,7 @@ func _LookupService_GetSystemForOrg_Handler(srv interface{}, ctx context.Context
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.lookup.v1.LookupService/GetSystemForOrg",
+		FullMethod: LookupService_GetSystemForOrg_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(LookupServiceServer).GetSystemForOrg(ctx, req.(*GetSystemRequest))
@@ -404,7 +655,7 @@ func _LookupService_AddSystemForOrg_Handler(srv interface{}, ctx context.Context
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.lookup.v1.LookupService/AddSystemForOrg",
+		FullMethod: LookupService_AddSystemForOrg_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(LookupServiceServer).AddSystemForOrg(ctx, req.(*AddSystemRequest))
@@ -422,7 +673,7 @@ func _LookupService_UpdateSystemForOrg_Handler(srv interface{}, ctx context.Cont
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.lookup.v1.LookupService/UpdateSystemForOrg",
+		FullMethod: LookupService_UpdateSystemForOrg_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(LookupServiceServer).UpdateSystemForOrg(ctx, req.(*UpdateSystemRequest))
@@ -440,7 +691,7 @@ func _LookupService_DeleteSystemForOrg_Handler(srv interface{}, ctx context.Cont
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.lookup.v1.LookupService/DeleteSystemForOrg",
+		FullMethod: LookupService_DeleteSystemForOrg_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(LookupServiceServer).DeleteSystemForOrg(ctx, req.(*DeleteSystemRequest))
@@ -448,6 +699,78 @@ func _LookupService_DeleteSystemForOrg_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LookupService_SystemHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LookupServiceServer).SystemHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LookupService_SystemHeartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookupServiceServer).SystemHeartbeat(ctx, req.(*SystemHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LookupService_GetSystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LookupServiceServer).GetSystems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LookupService_GetSystems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookupServiceServer).GetSystems(ctx, req.(*GetSystemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LookupService_DrainSystemForOrg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainSystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LookupServiceServer).DrainSystemForOrg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LookupService_DrainSystemForOrg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookupServiceServer).DrainSystemForOrg(ctx, req.(*DrainSystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LookupService_ResumeSystemForOrg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LookupServiceServer).ResumeSystemForOrg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LookupService_ResumeSystemForOrg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookupServiceServer).ResumeSystemForOrg(ctx, req.(*ResumeSystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LookupService_ServiceDesc is the grpc.ServiceDesc for LookupService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -487,6 +810,22 @@ var LookupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteNodeForOrg",
 			Handler:    _LookupService_DeleteNodeForOrg_Handler,
 		},
+		{
+			MethodName: "ClaimNode",
+			Handler:    _LookupService_ClaimNode_Handler,
+		},
+		{
+			MethodName: "ReleaseNode",
+			Handler:    _LookupService_ReleaseNode_Handler,
+		},
+		{
+			MethodName: "AcceptNode",
+			Handler:    _LookupService_AcceptNode_Handler,
+		},
+		{
+			MethodName: "CancelNodeRelease",
+			Handler:    _LookupService_CancelNodeRelease_Handler,
+		},
 		{
 			MethodName: "GetSystemForOrg",
 			Handler:    _LookupService_GetSystemForOrg_Handler,
@@ -503,6 +842,22 @@ var LookupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSystemForOrg",
 			Handler:    _LookupService_DeleteSystemForOrg_Handler,
 		},
+		{
+			MethodName: "SystemHeartbeat",
+			Handler:    _LookupService_SystemHeartbeat_Handler,
+		},
+		{
+			MethodName: "GetSystems",
+			Handler:    _LookupService_GetSystems_Handler,
+		},
+		{
+			MethodName: "DrainSystemForOrg",
+			Handler:    _LookupService_DrainSystemForOrg_Handler,
+		},
+		{
+			MethodName: "ResumeSystemForOrg",
+			Handler:    _LookupService_ResumeSystemForOrg_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lookup.proto",
@@ -16,6 +16,43 @@ type LookupServiceClient struct {
 	mock.Mock
 }
 
+// AcceptNode provides a mock function with given fields: ctx, in, opts
+func (_m *LookupServiceClient) AcceptNode(ctx context.Context, in *gen.AcceptNodeRequest, opts ...grpc.CallOption) (*gen.AcceptNodeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptNode")
+	}
+
+	var r0 *gen.AcceptNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AcceptNodeRequest, ...grpc.CallOption) (*gen.AcceptNodeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AcceptNodeRequest, ...grpc.CallOption) *gen.AcceptNodeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.AcceptNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AcceptNodeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddNodeForOrg provides a mock function with given fields: ctx, in, opts
 func (_m *LookupServiceClient) AddNodeForOrg(ctx context.Context, in *gen.AddNodeRequest, opts ...grpc.CallOption) (*gen.AddNodeResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -127,6 +164,80 @@ func (_m *LookupServiceClient) AddSystemForOrg(ctx context.Context, in *gen.AddS
 	return r0, r1
 }
 
+// CancelNodeRelease provides a mock function with given fields: ctx, in, opts
+func (_m *LookupServiceClient) CancelNodeRelease(ctx context.Context, in *gen.CancelNodeReleaseRequest, opts ...grpc.CallOption) (*gen.CancelNodeReleaseResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelNodeRelease")
+	}
+
+	var r0 *gen.CancelNodeReleaseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CancelNodeReleaseRequest, ...grpc.CallOption) (*gen.CancelNodeReleaseResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CancelNodeReleaseRequest, ...grpc.CallOption) *gen.CancelNodeReleaseResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CancelNodeReleaseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.CancelNodeReleaseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimNode provides a mock function with given fields: ctx, in, opts
+func (_m *LookupServiceClient) ClaimNode(ctx context.Context, in *gen.ClaimNodeRequest, opts ...grpc.CallOption) (*gen.ClaimNodeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimNode")
+	}
+
+	var r0 *gen.ClaimNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ClaimNodeRequest, ...grpc.CallOption) (*gen.ClaimNodeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ClaimNodeRequest, ...grpc.CallOption) *gen.ClaimNodeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ClaimNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ClaimNodeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteNodeForOrg provides a mock function with given fields: ctx, in, opts
 func (_m *LookupServiceClient) DeleteNodeForOrg(ctx context.Context, in *gen.DeleteNodeRequest, opts ...grpc.CallOption) (*gen.DeleteNodeResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -201,6 +312,43 @@ func (_m *LookupServiceClient) DeleteSystemForOrg(ctx context.Context, in *gen.D
 	return r0, r1
 }
 
+// DrainSystemForOrg provides a mock function with given fields: ctx, in, opts
+func (_m *LookupServiceClient) DrainSystemForOrg(ctx context.Context, in *gen.DrainSystemRequest, opts ...grpc.CallOption) (*gen.DrainSystemResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DrainSystemForOrg")
+	}
+
+	var r0 *gen.DrainSystemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DrainSystemRequest, ...grpc.CallOption) (*gen.DrainSystemResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DrainSystemRequest, ...grpc.CallOption) *gen.DrainSystemResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DrainSystemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DrainSystemRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNode provides a mock function with given fields: ctx, in, opts
 func (_m *LookupServiceClient) GetNode(ctx context.Context, in *gen.GetNodeRequest, opts ...grpc.CallOption) (*gen.GetNodeResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -386,6 +534,154 @@ func (_m *LookupServiceClient) GetSystemForOrg(ctx context.Context, in *gen.GetS
 	return r0, r1
 }
 
+// GetSystems provides a mock function with given fields: ctx, in, opts
+func (_m *LookupServiceClient) GetSystems(ctx context.Context, in *gen.GetSystemsRequest, opts ...grpc.CallOption) (*gen.GetSystemsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSystems")
+	}
+
+	var r0 *gen.GetSystemsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetSystemsRequest, ...grpc.CallOption) (*gen.GetSystemsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetSystemsRequest, ...grpc.CallOption) *gen.GetSystemsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetSystemsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetSystemsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReleaseNode provides a mock function with given fields: ctx, in, opts
+func (_m *LookupServiceClient) ReleaseNode(ctx context.Context, in *gen.ReleaseNodeRequest, opts ...grpc.CallOption) (*gen.ReleaseNodeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseNode")
+	}
+
+	var r0 *gen.ReleaseNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ReleaseNodeRequest, ...grpc.CallOption) (*gen.ReleaseNodeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ReleaseNodeRequest, ...grpc.CallOption) *gen.ReleaseNodeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ReleaseNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ReleaseNodeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResumeSystemForOrg provides a mock function with given fields: ctx, in, opts
+func (_m *LookupServiceClient) ResumeSystemForOrg(ctx context.Context, in *gen.ResumeSystemRequest, opts ...grpc.CallOption) (*gen.ResumeSystemResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeSystemForOrg")
+	}
+
+	var r0 *gen.ResumeSystemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ResumeSystemRequest, ...grpc.CallOption) (*gen.ResumeSystemResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ResumeSystemRequest, ...grpc.CallOption) *gen.ResumeSystemResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ResumeSystemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ResumeSystemRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemHeartbeat provides a mock function with given fields: ctx, in, opts
+func (_m *LookupServiceClient) SystemHeartbeat(ctx context.Context, in *gen.SystemHeartbeatRequest, opts ...grpc.CallOption) (*gen.SystemHeartbeatResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SystemHeartbeat")
+	}
+
+	var r0 *gen.SystemHeartbeatResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SystemHeartbeatRequest, ...grpc.CallOption) (*gen.SystemHeartbeatResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SystemHeartbeatRequest, ...grpc.CallOption) *gen.SystemHeartbeatResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.SystemHeartbeatResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.SystemHeartbeatRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateOrg provides a mock function with given fields: ctx, in, opts
 func (_m *LookupServiceClient) UpdateOrg(ctx context.Context, in *gen.UpdateOrgRequest, opts ...grpc.CallOption) (*gen.UpdateOrgResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -14,6 +14,36 @@ type LookupServiceServer struct {
 	mock.Mock
 }
 
+// AcceptNode provides a mock function with given fields: _a0, _a1
+func (_m *LookupServiceServer) AcceptNode(_a0 context.Context, _a1 *gen.AcceptNodeRequest) (*gen.AcceptNodeResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptNode")
+	}
+
+	var r0 *gen.AcceptNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AcceptNodeRequest) (*gen.AcceptNodeResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AcceptNodeRequest) *gen.AcceptNodeResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.AcceptNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AcceptNodeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddNodeForOrg provides a mock function with given fields: _a0, _a1
 func (_m *LookupServiceServer) AddNodeForOrg(_a0 context.Context, _a1 *gen.AddNodeRequest) (*gen.AddNodeResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -104,6 +134,66 @@ func (_m *LookupServiceServer) AddSystemForOrg(_a0 context.Context, _a1 *gen.Add
 	return r0, r1
 }
 
+// CancelNodeRelease provides a mock function with given fields: _a0, _a1
+func (_m *LookupServiceServer) CancelNodeRelease(_a0 context.Context, _a1 *gen.CancelNodeReleaseRequest) (*gen.CancelNodeReleaseResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelNodeRelease")
+	}
+
+	var r0 *gen.CancelNodeReleaseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CancelNodeReleaseRequest) (*gen.CancelNodeReleaseResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CancelNodeReleaseRequest) *gen.CancelNodeReleaseResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CancelNodeReleaseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.CancelNodeReleaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimNode provides a mock function with given fields: _a0, _a1
+func (_m *LookupServiceServer) ClaimNode(_a0 context.Context, _a1 *gen.ClaimNodeRequest) (*gen.ClaimNodeResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimNode")
+	}
+
+	var r0 *gen.ClaimNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ClaimNodeRequest) (*gen.ClaimNodeResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ClaimNodeRequest) *gen.ClaimNodeResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ClaimNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ClaimNodeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteNodeForOrg provides a mock function with given fields: _a0, _a1
 func (_m *LookupServiceServer) DeleteNodeForOrg(_a0 context.Context, _a1 *gen.DeleteNodeRequest) (*gen.DeleteNodeResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -164,6 +254,36 @@ func (_m *LookupServiceServer) DeleteSystemForOrg(_a0 context.Context, _a1 *gen.
 	return r0, r1
 }
 
+// DrainSystemForOrg provides a mock function with given fields: _a0, _a1
+func (_m *LookupServiceServer) DrainSystemForOrg(_a0 context.Context, _a1 *gen.DrainSystemRequest) (*gen.DrainSystemResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DrainSystemForOrg")
+	}
+
+	var r0 *gen.DrainSystemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DrainSystemRequest) (*gen.DrainSystemResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DrainSystemRequest) *gen.DrainSystemResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DrainSystemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DrainSystemRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNode provides a mock function with given fields: _a0, _a1
 func (_m *LookupServiceServer) GetNode(_a0 context.Context, _a1 *gen.GetNodeRequest) (*gen.GetNodeResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -314,6 +434,126 @@ func (_m *LookupServiceServer) GetSystemForOrg(_a0 context.Context, _a1 *gen.Get
 	return r0, r1
 }
 
+// GetSystems provides a mock function with given fields: _a0, _a1
+func (_m *LookupServiceServer) GetSystems(_a0 context.Context, _a1 *gen.GetSystemsRequest) (*gen.GetSystemsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSystems")
+	}
+
+	var r0 *gen.GetSystemsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetSystemsRequest) (*gen.GetSystemsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetSystemsRequest) *gen.GetSystemsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetSystemsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetSystemsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReleaseNode provides a mock function with given fields: _a0, _a1
+func (_m *LookupServiceServer) ReleaseNode(_a0 context.Context, _a1 *gen.ReleaseNodeRequest) (*gen.ReleaseNodeResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseNode")
+	}
+
+	var r0 *gen.ReleaseNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ReleaseNodeRequest) (*gen.ReleaseNodeResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ReleaseNodeRequest) *gen.ReleaseNodeResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ReleaseNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ReleaseNodeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResumeSystemForOrg provides a mock function with given fields: _a0, _a1
+func (_m *LookupServiceServer) ResumeSystemForOrg(_a0 context.Context, _a1 *gen.ResumeSystemRequest) (*gen.ResumeSystemResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeSystemForOrg")
+	}
+
+	var r0 *gen.ResumeSystemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ResumeSystemRequest) (*gen.ResumeSystemResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ResumeSystemRequest) *gen.ResumeSystemResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ResumeSystemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ResumeSystemRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemHeartbeat provides a mock function with given fields: _a0, _a1
+func (_m *LookupServiceServer) SystemHeartbeat(_a0 context.Context, _a1 *gen.SystemHeartbeatRequest) (*gen.SystemHeartbeatResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SystemHeartbeat")
+	}
+
+	var r0 *gen.SystemHeartbeatResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SystemHeartbeatRequest) (*gen.SystemHeartbeatResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SystemHeartbeatRequest) *gen.SystemHeartbeatResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.SystemHeartbeatResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.SystemHeartbeatRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateOrg provides a mock function with given fields: _a0, _a1
 func (_m *LookupServiceServer) UpdateOrg(_a0 context.Context, _a1 *gen.UpdateOrgRequest) (*gen.UpdateOrgResponse, error) {
 	ret := _m.Called(_a0, _a1)
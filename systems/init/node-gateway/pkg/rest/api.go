@@ -12,6 +12,11 @@ type GetNodeRequest struct {
 	NodeId string `example:"{{NodeId}}" path:"nodeId" validate:"required"`
 }
 
+type PostNodeRequest struct {
+	NodeId string `example:"{{NodeId}}" path:"nodeId" validate:"required"`
+	Csr    string `json:"csr" validate:"required"`
+}
+
 type ReflectorPingRequest struct {}
 
 type ReflectorGetRequest struct {
@@ -109,6 +109,7 @@ func (r *Router) init() {
 
 	nodes := v1.Group("nodes", "Nodes", "Looking for nodes credentials")
 	nodes.GET("/:nodeId", formatDoc("Get Nodes Credentials", ""), tonic.Handler(r.getNodeHandler, http.StatusOK))
+	nodes.POST("/:nodeId", formatDoc("Get Nodes Credentials For CSR", "Node certificate is signed for the given CSR, no key is returned"), tonic.Handler(r.postNodeHandler, http.StatusOK))
 
 	reflector := v1.Group("reflector", "Reflector", "Reflector service")
 	reflector.GET("/ping", formatDoc("Ping Reflector", ""), tonic.Handler(r.reflectorPingHandler, http.StatusOK))
@@ -130,6 +131,13 @@ func (r *Router) getNodeHandler(c *gin.Context, req *GetNodeRequest) (*pb.GetNod
 	})
 }
 
+func (r *Router) postNodeHandler(c *gin.Context, req *PostNodeRequest) (*pb.GetNodeCredentialsResponse, error) {
+	return r.clients.Bootstrap.GetNodeCredentials(&pb.GetNodeCredentialsRequest{
+		Id:  req.NodeId,
+		Csr: req.Csr,
+	})
+}
+
 func (r *Router) reflectorPingHandler(c *gin.Context, req *ReflectorPingRequest) (*rpb.PingResponse, error) {
 	return r.clients.Reflector.Ping(&rpb.PingRequest{})
 }
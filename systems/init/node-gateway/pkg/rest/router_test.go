@@ -11,6 +11,7 @@ package rest
 import (
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -86,3 +87,26 @@ func TestRouter_GetNodeCredentials_Success(t *testing.T) {
 	assert.Contains(t, w.Body.String(), "test-certificate-data")
 	mockBootstrap.AssertExpectations(t)
 }
+
+func TestRouter_PostNodeCredentials_Csr(t *testing.T) {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/v1/nodes/test-node-id", strings.NewReader(`{"csr":"test-csr"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	mockBootstrap := &mocks.BootstrapEP{}
+	mockBootstrap.On("GetNodeCredentials", &gen.GetNodeCredentialsRequest{
+		Id:  "test-node-id",
+		Csr: "test-csr",
+	}).Return(&gen.GetNodeCredentialsResponse{
+		Id:              "test-node-id",
+		NodeCertificate: "test-node-certificate",
+	}, nil)
+
+	r := NewRouter(&Clients{Bootstrap: mockBootstrap}, routerConfig).f.Engine()
+
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "test-node-certificate")
+	mockBootstrap.AssertExpectations(t)
+}
@@ -42,6 +42,36 @@ func (_m *nns) DeleteRequest(req *gen.DeleteRequest) (*gen.DeleteResponse, error
 	return r0, r1
 }
 
+// GetHistoryRequest provides a mock function with given fields: req
+func (_m *nns) GetHistoryRequest(req *gen.GetHistoryRequest) (*gen.GetHistoryResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistoryRequest")
+	}
+
+	var r0 *gen.GetHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.GetHistoryRequest) (*gen.GetHistoryResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.GetHistoryRequest) *gen.GetHistoryResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.GetHistoryRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMeshRequest provides a mock function with given fields: req
 func (_m *nns) GetMeshRequest(req *gen.GetMeshRequest) (*gen.GetMeshResponse, error) {
 	ret := _m.Called(req)
@@ -106,3 +106,10 @@ func (n *Nns) ListRequest(req *pb.ListRequest) (*pb.ListResponse, error) {
 
 	return n.client.List(ctx, req)
 }
+
+func (n *Nns) GetHistoryRequest(req *pb.GetHistoryRequest) (*pb.GetHistoryResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), n.timeout)
+	defer cancel()
+
+	return n.client.GetHistory(ctx, req)
+}
@@ -407,3 +407,30 @@ func TestNns_ListRequest(t *testing.T) {
 		mockClient.AssertExpectations(t)
 	})
 }
+
+func TestNns_GetHistoryRequest(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockClient := &nnmocks.NnsClient{}
+		nns := NewNnsFromClient(mockClient)
+
+		req := &pb.GetHistoryRequest{
+			NodeId: testNodeId,
+		}
+
+		expectedResponse := &pb.GetHistoryResponse{
+			NodeId: testNodeId,
+			History: []*pb.NodeHistoryEntry{
+				{Action: "added", NodeIp: testNodeIp, NodePort: testNodePort},
+			},
+		}
+
+		mockClient.On("GetHistory", mock.Anything, req, mock.Anything).
+			Return(expectedResponse, nil)
+
+		response, err := nns.GetHistoryRequest(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResponse, response)
+		mockClient.AssertExpectations(t)
+	})
+}
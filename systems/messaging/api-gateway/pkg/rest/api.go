@@ -12,6 +12,10 @@ type GetNodeRequest struct {
 	NodeId string `json:"node_id" path:"node_id" validate:"required"`
 }
 
+type GetHistoryRequest struct {
+	NodeId string `json:"node_id" path:"node_id" validate:"required"`
+}
+
 type GetMeshRequest struct{
 	NodeId string `json:"node_id" path:"node_id" validate:"required"`
 }
@@ -58,6 +58,7 @@ type nns interface {
 	UpdateNodeRequest(req *pb.UpdateNodeRequest) (*pb.UpdateNodeResponse, error)
 	DeleteRequest(req *pb.DeleteRequest) (*pb.DeleteResponse, error)
 	ListRequest(req *pb.ListRequest) (*pb.ListResponse, error)
+	GetHistoryRequest(req *pb.GetHistoryRequest) (*pb.GetHistoryResponse, error)
 }
 
 func NewClientsSet(endpoints *pkg.GrpcEndpoints) *Clients {
@@ -140,6 +141,7 @@ func (r *Router) init(f func(*gin.Context, string) error) {
 		nns.PUT("/mesh/:node_id", formatDoc("Update mesh", ""), tonic.Handler(r.updateMeshHandler, http.StatusOK))
 		nns.DELETE("/node/:node_id", formatDoc("Remove node from dns", ""), tonic.Handler(r.deleteHandler, http.StatusOK))
 		nns.GET("/list", formatDoc("Get all nodes", ""), tonic.Handler(r.listHandler, http.StatusOK))
+		nns.GET("/node/:node_id/history", formatDoc("Get node history", "Changes of the node and mesh addresses of the node, oldest first"), tonic.Handler(r.getHistoryHandler, http.StatusOK))
 
 		// prom := auth.Group("/prometheus", "Prometheus target", "Target discovery endpoint")
 		// prom.GET("", formatDoc("Get target to scrape", ""), tonic.Handler(r.prometheusHandler, http.StatusOK))
@@ -250,6 +252,12 @@ func (r *Router) getNodeHandler(c *gin.Context, req *GetNodeRequest) (*pb.GetNod
 	})
 }
 
+func (r *Router) getHistoryHandler(c *gin.Context, req *GetHistoryRequest) (*pb.GetHistoryResponse, error) {
+	return r.clients.n.GetHistoryRequest(&pb.GetHistoryRequest{
+		NodeId: req.NodeId,
+	})
+}
+
 func (r *Router) getMeshHandler(c *gin.Context, req *GetMeshRequest) (*pb.GetMeshResponse, error) {
 	return r.clients.n.GetMeshRequest(&pb.GetMeshRequest{
 		NodeId: req.NodeId,
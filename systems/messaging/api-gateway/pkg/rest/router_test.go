@@ -356,3 +356,39 @@ func TestRouter_ListNodes(t *testing.T) {
 	assert.Contains(t, w.Body.String(), testNodeIp)
 	n.AssertExpectations(t)
 }
+
+func TestRouter_GetNodeHistory(t *testing.T) {
+	nodeId := testNodeId
+	w := httptest.NewRecorder()
+	hreq, _ := http.NewRequest("GET", "/v1/nns/node/"+nodeId+"/history", nil)
+
+	n := &nnmocks.NnsClient{}
+	arc := &cmocks.AuthClient{}
+
+	pReq := &pb.GetHistoryRequest{
+		NodeId: nodeId,
+	}
+
+	pResp := &pb.GetHistoryResponse{
+		NodeId: nodeId,
+		History: []*pb.NodeHistoryEntry{
+			{Action: "changed", NodeIp: testNodeIp, NodePort: testNodePort},
+		},
+	}
+
+	arc.On("AuthenticateUser", mock.Anything, mock.Anything).Return(nil)
+	n.On("GetHistory", mock.Anything, pReq, mock.Anything).Return(pResp, nil)
+
+	r := NewRouter(&Clients{
+		n: client.NewNnsFromClient(n),
+	}, routerConfig, arc.AuthenticateUser).f.Engine()
+
+	// act
+	r.ServeHTTP(w, hreq)
+
+	// assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "changed")
+	assert.Contains(t, w.Body.String(), testNodeIp)
+	n.AssertExpectations(t)
+}
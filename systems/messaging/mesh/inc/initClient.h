@@ -33,6 +33,7 @@ typedef struct systemInfo_ {
 int get_systemInfo_from_initClient(char *systemName,
                                    char **systemHost,
                                    int *systemPort);
+int verify_node_certificate(char *nodeID, char *serial);
 void free_system_info(SystemInfo *systemInfo);
 
 #endif /* NODE_INFO_H */
@@ -23,6 +23,10 @@
 #define EP_STATUS     "/v1/status"
 #define EP_FORWARD    "*"
 
+/* Certificate of the node, URL encoded PEM, as forwarded by the ingress
+ * terminating TLS (auth-tls-pass-certificate-to-upstream). */
+#define HEADER_CLIENT_CERT "ssl-client-cert"
+
 #define MESH_CLIENT_AGENT "Mesh-client"
 #define MESH_CLIENT_VERSION "0.0.1"
 
@@ -65,16 +69,19 @@
 #define ENV_AMQP_PASSWORD    "ENV_AMQP_PASSWORD"
 #define ENV_INIT_SYSTEM_ADDR "ENV_INIT_SYSTEM_ADDR"
 #define ENV_INIT_SYSTEM_PORT "ENV_INIT_SYSTEM_PORT"
+#define ENV_INIT_AUTHZ_PORT  "ENV_INIT_AUTHZ_PORT"
 #define ENV_MESH_CERT_FILE   "ENV_MESH_CERT_FILE"
 #define ENV_MESH_KEY_FILE    "ENV_MESH_KEY_FILE"
 #define ENV_MESH_LOG_LEVEL   "ENV_MESH_LOG_LEVEL"
 #define ENV_SYSTEM_ORG       "ENV_SYSTEM_ORG"
 #define ENV_SYSTEM_ORG_ID    "ENV_SYSTEM_ORG_ID"
 #define ENV_BINDING_IP       "ENV_BINDING_IP"
+#define ENV_MESH_LEGACY_NODES "ENV_MESH_LEGACY_NODES"
 
 #define DEFAULT_MESH_AMQP_EXCHANGE "amq.topic"
 #define DEFAULT_MESH_CERT_FILE     "certs/test.cert"
 #define DEFAULT_MESH_KEY_FILE      "certs/server.key"
+#define DEFAULT_INIT_AUTHZ_PORT    "8081"
 
 #define SERVICE_INVENTORY "inventory"
 
@@ -8,6 +8,8 @@
 
 #include <ulfius.h>
 #include <string.h>
+#include <strings.h>
+#include <gnutls/x509.h>
 #include <jansson.h>
 #include <pthread.h>
 
@@ -26,6 +28,7 @@
 #include "u_amqp.h"
 #include "httpStatus.h"
 #include "config.h"
+#include "initClient.h"
 
 #include "version.h"
 
@@ -43,6 +46,92 @@ extern void  websocket_onclose(const URequest *request, WSManager *manager,
 /* network.c */
 extern int start_forward_service(Config *config, UInst **forwardInst);
 
+/*
+ * Serial of the certificate the node presented, in hex as the CA has it.
+ * It comes from the TLS session when the mesh terminates TLS, otherwise from
+ * the certificate the ingress forwards. NULL if there is none.
+ */
+static char *presented_cert_serial(const URequest *request) {
+
+    int  imported = FALSE;
+    char *pem = NULL, *decoded = NULL, *serial = NULL;
+    unsigned char buf[64];
+    size_t len = sizeof(buf), i = 0, off = 0;
+    gnutls_x509_crt_t cert = NULL;
+    gnutls_datum_t data;
+
+    if (request->client_cert != NULL) {
+        cert = request->client_cert;
+    } else {
+        pem = (char *)u_map_get(request->map_header, HEADER_CLIENT_CERT);
+        if (pem == NULL) return NULL;
+
+        decoded = ulfius_url_decode(pem);
+        if (decoded == NULL) return NULL;
+
+        if (gnutls_x509_crt_init(&cert) != GNUTLS_E_SUCCESS) goto done;
+        imported = TRUE;
+
+        data.data = (unsigned char *)decoded;
+        data.size = strlen(decoded);
+
+        if (gnutls_x509_crt_import(cert, &data,
+                                   GNUTLS_X509_FMT_PEM) != GNUTLS_E_SUCCESS) {
+            log_error("Unable to parse the certificate presented by node");
+            goto done;
+        }
+    }
+
+    if (gnutls_x509_crt_get_serial(cert, buf, &len) != GNUTLS_E_SUCCESS) {
+        log_error("Unable to get serial of the certificate presented by node");
+        goto done;
+    }
+
+    /* DER keeps a leading zero for positive serials, the CA does not. */
+    while (off < len - 1 && buf[off] == 0) off++;
+
+    serial = (char *)calloc(2 * (len - off) + 1, sizeof(char));
+    if (serial == NULL) goto done;
+
+    for (i = off; i < len; i++) {
+        sprintf(serial + 2 * (i - off), "%02x", buf[i]);
+    }
+
+done:
+    if (imported) gnutls_x509_crt_deinit(cert);
+    if (decoded)  o_free(decoded);
+
+    return serial;
+}
+
+/*
+ * Nodes bootstrapped before the CA have no certificate yet. They are only let
+ * in when listed in ENV_MESH_LEGACY_NODES (comma separated node IDs).
+ */
+static int is_legacy_node(char *nodeID) {
+
+    int  ret = FALSE;
+    char *list = NULL, *copy = NULL, *token = NULL, *save = NULL;
+
+    list = getenv(ENV_MESH_LEGACY_NODES);
+    if (list == NULL || list[0] == '\0') return FALSE;
+
+    copy = strdup(list);
+    if (copy == NULL) return FALSE;
+
+    for (token = strtok_r(copy, ", ", &save); token != NULL;
+         token = strtok_r(NULL, ", ", &save)) {
+        if (strcasecmp(token, nodeID) == 0) {
+            ret = TRUE;
+            break;
+        }
+    }
+
+    free(copy);
+
+    return ret;
+}
+
 /*
  * Ulfius main callback function, send AMQP msg and calls the websocket
  * manager and closes.
@@ -50,7 +139,7 @@ extern int start_forward_service(Config *config, UInst **forwardInst);
 int callback_websocket(const URequest *request, UResponse *response,
                        void *data) {
 	int ret, forwardPort;
-	char *nodeID = NULL;
+	char *nodeID = NULL, *serial = NULL;
 	Config *config = NULL;
     MapItem *map = NULL;
     char ip[INET_ADDRSTRLEN]={0};
@@ -73,6 +162,20 @@ int callback_websocket(const URequest *request, UResponse *response,
         return U_CALLBACK_ERROR;
     }
 
+    serial = presented_cert_serial(request);
+    if (serial == NULL) {
+        if (is_legacy_node(nodeID) == FALSE) {
+            log_error("Node presented no certificate. Rejecting. %s", nodeID);
+            return U_CALLBACK_ERROR;
+        }
+        log_debug("Legacy node without certificate allowed: %s", nodeID);
+    } else if (verify_node_certificate(nodeID, serial) == FALSE) {
+        log_error("Node certificate revoked or expired. Rejecting. %s", nodeID);
+        free(serial);
+        return U_CALLBACK_ERROR;
+    }
+    free(serial);
+
     map = is_existing_item(NodesTable, nodeID);
     if (map != NULL) {
         ulfius_stop_framework(map->forwardInst);
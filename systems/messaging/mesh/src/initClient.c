@@ -9,6 +9,9 @@
 #include <stdio.h>
 #include <stdlib.h>
 #include <string.h>
+#include <strings.h>
+#include <time.h>
+#include <pthread.h>
 #include <jansson.h>
 #include <curl/curl.h>
 
@@ -259,6 +262,183 @@ done:
     return ret;
 }
 
+/*
+ * Revocation list of the CA of the init system, kept until its nextUpdate.
+ * Nodes connect from several threads, so it is only read under crlMutex.
+ */
+static json_t          *crlCache      = NULL;
+static time_t          crlNextUpdate  = 0;
+static pthread_mutex_t crlMutex       = PTHREAD_MUTEX_INITIALIZER;
+
+static char *init_authz_port(void) {
+
+    char *port = getenv(ENV_INIT_AUTHZ_PORT);
+
+    if (port == NULL || port[0] == '\0') {
+        return DEFAULT_INIT_AUTHZ_PORT;
+    }
+
+    return port;
+}
+
+/*
+ * Fetch the revocation list from the internal listener of the init system:
+ * http://{init}:{authz_port}/authz/v1/certs/crl
+ *
+ * The list is cached until the nextUpdate the CA announced. Returns FALSE if
+ * there is no current list, the caller must hold crlMutex.
+ */
+static int refresh_revocation_list(char *initSystemHost) {
+
+    int    ret   = FALSE;
+    char   *url  = NULL;
+    json_t *json = NULL, *next = NULL;
+    time_t now   = time(NULL);
+
+    struct Response response;
+    memset(&response, 0, sizeof(response));
+
+    if (crlCache != NULL && now < crlNextUpdate) {
+        return TRUE;
+    }
+
+    url = (char *)calloc(MAX_URL_LEN, sizeof(char));
+    if (url == NULL) {
+        log_error("Out of memory allocating revocation list URL");
+        return FALSE;
+    }
+
+    snprintf(url, MAX_URL_LEN, "http://%s:%s/authz/v1/certs/crl",
+             initSystemHost, init_authz_port());
+
+    if (send_request_to_initClient(url, &response) != HttpStatus_OK) {
+        log_error("Unable to get revocation list (url=%s)", url);
+        goto done;
+    }
+
+    json = json_loads(response.buffer, JSON_DECODE_ANY, NULL);
+    if (json == NULL || !json_is_array(json_object_get(json, "certificates"))) {
+        log_error("Can not load revocation list into JSON object");
+        json_decref(json);
+        goto done;
+    }
+
+    /* No nextUpdate means the list is only good for this check. */
+    next = json_object_get(json_object_get(json, "nextUpdate"), "seconds");
+
+    json_decref(crlCache);
+    crlCache      = json;
+    crlNextUpdate = json_is_integer(next) ? (time_t)json_integer_value(next) : 0;
+
+    log_debug("Revocation list refreshed, next update at %ld",
+              (long)crlNextUpdate);
+    ret = TRUE;
+
+done:
+    free(url);
+    if (response.buffer) free(response.buffer);
+
+    return ret;
+}
+
+/*
+ * Look for the serial in the revocation list of the CA. The list carries
+ * revoked and superseded certificates. A list that can not be read counts as
+ * revoked, so a node is never let in without the check.
+ */
+static int is_serial_revoked(char *initSystemHost, char *serial) {
+
+    int    ret   = TRUE;
+    size_t index = 0;
+    json_t *cert = NULL, *jSerial = NULL;
+
+    pthread_mutex_lock(&crlMutex);
+
+    if (refresh_revocation_list(initSystemHost) == FALSE) {
+        goto done;
+    }
+
+    ret = FALSE;
+    json_array_foreach(json_object_get(crlCache, "certificates"), index, cert) {
+        jSerial = json_object_get(cert, "serial");
+        if (json_is_string(jSerial) &&
+            strcasecmp(json_string_value(jSerial), serial) == 0) {
+            ret = TRUE;
+            break;
+        }
+    }
+
+done:
+    pthread_mutex_unlock(&crlMutex);
+
+    return ret;
+}
+
+/*
+ * Check the certificate the node presented with the CA of the init system.
+ * Its serial must not be on the revocation list and must be the latest
+ * certificate of the node:
+ *      http://{init}:{authz_port}/authz/v1/certs/nodes/{node_id}/verify?serial={serial}
+ *
+ * Only a 200 lets the node in. A missing serial, a CA that can not be reached
+ * or that fails and a node without issued certificate (404) are all rejected.
+ */
+int verify_node_certificate(char *nodeID, char *serial) {
+
+    int  ret  = FALSE;
+    long code = 0;
+    char *url = NULL;
+    char *initSystemHost = NULL;
+
+    struct Response response;
+    memset(&response, 0, sizeof(response));
+
+    if (nodeID == NULL || nodeID[0] == '\0' ||
+        serial == NULL || serial[0] == '\0') {
+        return FALSE;
+    }
+
+    initSystemHost = getenv(ENV_INIT_SYSTEM_ADDR);
+    if (initSystemHost == NULL) {
+        log_error("Missing env var %s, unable to check certificate",
+                  ENV_INIT_SYSTEM_ADDR);
+        return FALSE;
+    }
+
+    if (is_serial_revoked(initSystemHost, serial)) {
+        log_error("Certificate %s of nodeID=%s is revoked or the revocation"
+                  " list is unavailable", serial, nodeID);
+        return FALSE;
+    }
+
+    url = (char *)calloc(MAX_URL_LEN, sizeof(char));
+    if (url == NULL) {
+        log_error("Out of memory allocating certificate verify URL");
+        return FALSE;
+    }
+
+    snprintf(url, MAX_URL_LEN,
+             "http://%s:%s/authz/v1/certs/nodes/%s/verify?serial=%s",
+             initSystemHost, init_authz_port(), nodeID, serial);
+
+    code = send_request_to_initClient(url, &response);
+
+    if (code == HttpStatus_OK) {
+        log_debug("Certificate verify OK for nodeID=%s", nodeID);
+        ret = TRUE;
+    } else if (code == HttpStatus_NotFound) {
+        log_error("No certificate issued for nodeID=%s", nodeID);
+    } else {
+        log_error("Unable to verify certificate for nodeID=%s (url=%s code=%ld)",
+                  nodeID, url, code);
+    }
+
+    free(url);
+    if (response.buffer) free(response.buffer);
+
+    return ret;
+}
+
 void free_system_info(SystemInfo *systemInfo) {
 
 	if (systemInfo == NULL) return;
@@ -10,6 +10,7 @@ package main
 
 import (
 	"os"
+	"time"
 
 	"github.com/num30/config"
 	"google.golang.org/grpc"
@@ -105,9 +106,24 @@ func runGrpcServer(nns *pkg.Nns) {
 
 	go msgBusListener(mbClient)
 
+	go evictionLoop(server.NewEvictor(nns, mbClient, serviceConfig.OrgName, serviceConfig.Lease.Ttl))
+
+	ic.StartHeartbeat(ic.NewInitClient(serviceConfig.Http.InitClient, client.WithDebug(serviceConfig.DebugMode)),
+		serviceConfig.OrgName, pkg.SystemName, version.Version, ic.DefaultHeartbeatInterval, nil)
+
 	grpcServer.StartServer()
 }
 
+func evictionLoop(e *server.Evictor) {
+	ticker := time.NewTicker(serviceConfig.Lease.EvictionInterval)
+	defer ticker.Stop()
+
+	for {
+		<-ticker.C
+		e.EvictStale()
+	}
+}
+
 func msgBusListener(m mb.MsgBusServiceClient) {
 
 	if err := m.Register(); err != nil {
@@ -0,0 +1,61 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	pkg "github.com/ukama/ukama/systems/messaging/nns/pkg"
+
+	time "time"
+)
+
+// NnsEvicter is an autogenerated mock type for the NnsEvicter type
+type NnsEvicter struct {
+	mock.Mock
+}
+
+// Evict provides a mock function with given fields: ctx, seenBefore
+func (_m *NnsEvicter) Evict(ctx context.Context, seenBefore time.Time) ([]pkg.NodeMeshMap, error) {
+	ret := _m.Called(ctx, seenBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Evict")
+	}
+
+	var r0 []pkg.NodeMeshMap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]pkg.NodeMeshMap, error)); ok {
+		return rf(ctx, seenBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []pkg.NodeMeshMap); ok {
+		r0 = rf(ctx, seenBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkg.NodeMeshMap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, seenBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewNnsEvicter creates a new instance of NnsEvicter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNnsEvicter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NnsEvicter {
+	mock := &NnsEvicter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
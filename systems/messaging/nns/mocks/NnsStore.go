@@ -110,6 +110,54 @@ func (_m *NnsStore) GetAll(ctx context.Context) ([]pkg.NodeMeshMap, error) {
 	return r0, r1
 }
 
+// History provides a mock function with given fields: ctx, nodeId
+func (_m *NnsStore) History(ctx context.Context, nodeId string) ([]pkg.NodeHistory, error) {
+	ret := _m.Called(ctx, nodeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for History")
+	}
+
+	var r0 []pkg.NodeHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]pkg.NodeHistory, error)); ok {
+		return rf(ctx, nodeId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []pkg.NodeHistory); ok {
+		r0 = rf(ctx, nodeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkg.NodeHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, nodeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetOffline provides a mock function with given fields: ctx, nodeId
+func (_m *NnsStore) SetOffline(ctx context.Context, nodeId string) error {
+	ret := _m.Called(ctx, nodeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOffline")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, nodeId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateNode provides a mock function with given fields: ctx, nodeId, nodeIp, nodePort
 func (_m *NnsStore) UpdateNode(ctx context.Context, nodeId string, nodeIp string, nodePort int32) error {
 	ret := _m.Called(ctx, nodeId, nodeIp, nodePort)
@@ -53,6 +53,43 @@ func (_m *NnsClient) Delete(ctx context.Context, in *gen.DeleteRequest, opts ...
 	return r0, r1
 }
 
+// GetHistory provides a mock function with given fields: ctx, in, opts
+func (_m *NnsClient) GetHistory(ctx context.Context, in *gen.GetHistoryRequest, opts ...grpc.CallOption) (*gen.GetHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 *gen.GetHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetHistoryRequest, ...grpc.CallOption) (*gen.GetHistoryResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetHistoryRequest, ...grpc.CallOption) *gen.GetHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMesh provides a mock function with given fields: ctx, in, opts
 func (_m *NnsClient) GetMesh(ctx context.Context, in *gen.GetMeshRequest, opts ...grpc.CallOption) (*gen.GetMeshResponse, error) {
 	_va := make([]interface{}, len(opts))
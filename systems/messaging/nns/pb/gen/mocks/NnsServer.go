@@ -44,6 +44,36 @@ func (_m *NnsServer) Delete(_a0 context.Context, _a1 *gen.DeleteRequest) (*gen.D
 	return r0, r1
 }
 
+// GetHistory provides a mock function with given fields: _a0, _a1
+func (_m *NnsServer) GetHistory(_a0 context.Context, _a1 *gen.GetHistoryRequest) (*gen.GetHistoryResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 *gen.GetHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetHistoryRequest) (*gen.GetHistoryResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetHistoryRequest) *gen.GetHistoryResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetHistoryRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMesh provides a mock function with given fields: _a0, _a1
 func (_m *NnsServer) GetMesh(_a0 context.Context, _a1 *gen.GetMeshRequest) (*gen.GetMeshResponse, error) {
 	ret := _m.Called(_a0, _a1)
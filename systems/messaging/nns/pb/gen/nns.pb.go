@@ -7,20 +7,20 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.7
+// 	protoc-gen-go v1.36.12
 // 	protoc        v6.32.1
 // source: nns.proto
 
 package gen
 
 import (
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-
 	_ "github.com/mwitkow/go-proto-validators"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -649,6 +649,8 @@ type NodeMeshInfo struct {
 	Site          string                 `protobuf:"bytes,7,opt,name=site,proto3" json:"site,omitempty"`
 	MeshIp        string                 `protobuf:"bytes,8,opt,name=meshIp,proto3" json:"meshIp,omitempty"`
 	MeshHostName  string                 `protobuf:"bytes,9,opt,name=meshHostName,proto3" json:"meshHostName,omitempty"`
+	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	Online        bool                   `protobuf:"varint,11,opt,name=online,proto3" json:"online,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -746,6 +748,20 @@ func (x *NodeMeshInfo) GetMeshHostName() string {
 	return ""
 }
 
+func (x *NodeMeshInfo) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *NodeMeshInfo) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
 type ListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -826,11 +842,191 @@ func (x *ListResponse) GetList() []*NodeMeshInfo {
 	return nil
 }
 
+type GetHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryRequest) Reset() {
+	*x = GetHistoryRequest{}
+	mi := &file_nns_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryRequest) ProtoMessage() {}
+
+func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nns_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_nns_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetHistoryRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type NodeHistoryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	NodeIp        string                 `protobuf:"bytes,3,opt,name=nodeIp,proto3" json:"nodeIp,omitempty"`
+	NodePort      int32                  `protobuf:"varint,4,opt,name=nodePort,proto3" json:"nodePort,omitempty"`
+	MeshIp        string                 `protobuf:"bytes,5,opt,name=meshIp,proto3" json:"meshIp,omitempty"`
+	MeshPort      int32                  `protobuf:"varint,6,opt,name=meshPort,proto3" json:"meshPort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeHistoryEntry) Reset() {
+	*x = NodeHistoryEntry{}
+	mi := &file_nns_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeHistoryEntry) ProtoMessage() {}
+
+func (x *NodeHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_nns_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeHistoryEntry.ProtoReflect.Descriptor instead.
+func (*NodeHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_nns_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *NodeHistoryEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *NodeHistoryEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *NodeHistoryEntry) GetNodeIp() string {
+	if x != nil {
+		return x.NodeIp
+	}
+	return ""
+}
+
+func (x *NodeHistoryEntry) GetNodePort() int32 {
+	if x != nil {
+		return x.NodePort
+	}
+	return 0
+}
+
+func (x *NodeHistoryEntry) GetMeshIp() string {
+	if x != nil {
+		return x.MeshIp
+	}
+	return ""
+}
+
+func (x *NodeHistoryEntry) GetMeshPort() int32 {
+	if x != nil {
+		return x.MeshPort
+	}
+	return 0
+}
+
+type GetHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	History       []*NodeHistoryEntry    `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryResponse) Reset() {
+	*x = GetHistoryResponse{}
+	mi := &file_nns_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryResponse) ProtoMessage() {}
+
+func (x *GetHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nns_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_nns_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetHistoryResponse) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *GetHistoryResponse) GetHistory() []*NodeHistoryEntry {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
 var File_nns_proto protoreflect.FileDescriptor
 
 const file_nns_proto_rawDesc = "" +
 	"\n" +
-	"\tnns.proto\x12\x16ukama.messaging.nns.v1\x1a\x0fvalidator.proto\"0\n" +
+	"\tnns.proto\x12\x16ukama.messaging.nns.v1\x1a\x0fvalidator.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"0\n" +
 	"\x0eGetNodeRequest\x12\x1e\n" +
 	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\"]\n" +
 	"\x0fGetNodeResponse\x12\x16\n" +
@@ -867,7 +1063,7 @@ const file_nns_proto_rawDesc = "" +
 	"\x12UpdateNodeResponse\"/\n" +
 	"\rDeleteRequest\x12\x1e\n" +
 	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\"\x10\n" +
-	"\x0eDeleteResponse\"\xee\x02\n" +
+	"\x0eDeleteResponse\"\xbe\x03\n" +
 	"\fNodeMeshInfo\x12\x1e\n" +
 	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\x12\x89\x01\n" +
 	"\x06nodeIp\x18\x02 \x01(\tBq\xe2\xdf\x1fm\n" +
@@ -878,10 +1074,25 @@ const file_nns_proto_rawDesc = "" +
 	"\anetwork\x18\x06 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04site\x18\a \x01(\tR\x04site\x12\x16\n" +
 	"\x06meshIp\x18\b \x01(\tR\x06meshIp\x12\"\n" +
-	"\fmeshHostName\x18\t \x01(\tR\fmeshHostName\"\r\n" +
+	"\fmeshHostName\x18\t \x01(\tR\fmeshHostName\x126\n" +
+	"\blastSeen\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\x12\x16\n" +
+	"\x06online\x18\v \x01(\bR\x06online\"\r\n" +
 	"\vListRequest\"H\n" +
 	"\fListResponse\x128\n" +
-	"\x04list\x18\x01 \x03(\v2$.ukama.messaging.nns.v1.NodeMeshInfoR\x04list2\x83\x05\n" +
+	"\x04list\x18\x01 \x03(\v2$.ukama.messaging.nns.v1.NodeMeshInfoR\x04list\"3\n" +
+	"\x11GetHistoryRequest\x12\x1e\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\"\xc2\x01\n" +
+	"\x10NodeHistoryEntry\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x16\n" +
+	"\x06nodeIp\x18\x03 \x01(\tR\x06nodeIp\x12\x1a\n" +
+	"\bnodePort\x18\x04 \x01(\x05R\bnodePort\x12\x16\n" +
+	"\x06meshIp\x18\x05 \x01(\tR\x06meshIp\x12\x1a\n" +
+	"\bmeshPort\x18\x06 \x01(\x05R\bmeshPort\"p\n" +
+	"\x12GetHistoryResponse\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12B\n" +
+	"\ahistory\x18\x02 \x03(\v2(.ukama.messaging.nns.v1.NodeHistoryEntryR\ahistory2\xe8\x05\n" +
 	"\x03Nns\x12Z\n" +
 	"\aGetNode\x12&.ukama.messaging.nns.v1.GetNodeRequest\x1a'.ukama.messaging.nns.v1.GetNodeResponse\x12Z\n" +
 	"\aGetMesh\x12&.ukama.messaging.nns.v1.GetMeshRequest\x1a'.ukama.messaging.nns.v1.GetMeshResponse\x12N\n" +
@@ -891,7 +1102,9 @@ const file_nns_proto_rawDesc = "" +
 	"\n" +
 	"UpdateNode\x12).ukama.messaging.nns.v1.UpdateNodeRequest\x1a*.ukama.messaging.nns.v1.UpdateNodeResponse\x12W\n" +
 	"\x06Delete\x12%.ukama.messaging.nns.v1.DeleteRequest\x1a&.ukama.messaging.nns.v1.DeleteResponse\x12Q\n" +
-	"\x04List\x12#.ukama.messaging.nns.v1.ListRequest\x1a$.ukama.messaging.nns.v1.ListResponseB5Z3github.com/ukama/ukama/systems/messaging/nns/pb/genb\x06proto3"
+	"\x04List\x12#.ukama.messaging.nns.v1.ListRequest\x1a$.ukama.messaging.nns.v1.ListResponse\x12c\n" +
+	"\n" +
+	"GetHistory\x12).ukama.messaging.nns.v1.GetHistoryRequest\x1a*.ukama.messaging.nns.v1.GetHistoryResponseB5Z3github.com/ukama/ukama/systems/messaging/nns/pb/genb\x06proto3"
 
 var (
 	file_nns_proto_rawDescOnce sync.Once
@@ -905,45 +1118,54 @@ func file_nns_proto_rawDescGZIP() []byte {
 	return file_nns_proto_rawDescData
 }
 
-var file_nns_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_nns_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_nns_proto_goTypes = []any{
-	(*GetNodeRequest)(nil),     // 0: ukama.messaging.nns.v1.GetNodeRequest
-	(*GetNodeResponse)(nil),    // 1: ukama.messaging.nns.v1.GetNodeResponse
-	(*GetMeshRequest)(nil),     // 2: ukama.messaging.nns.v1.GetMeshRequest
-	(*GetMeshResponse)(nil),    // 3: ukama.messaging.nns.v1.GetMeshResponse
-	(*SetRequest)(nil),         // 4: ukama.messaging.nns.v1.SetRequest
-	(*SetResponse)(nil),        // 5: ukama.messaging.nns.v1.SetResponse
-	(*UpdateMeshRequest)(nil),  // 6: ukama.messaging.nns.v1.UpdateMeshRequest
-	(*UpdateMeshResponse)(nil), // 7: ukama.messaging.nns.v1.UpdateMeshResponse
-	(*UpdateNodeRequest)(nil),  // 8: ukama.messaging.nns.v1.UpdateNodeRequest
-	(*UpdateNodeResponse)(nil), // 9: ukama.messaging.nns.v1.UpdateNodeResponse
-	(*DeleteRequest)(nil),      // 10: ukama.messaging.nns.v1.DeleteRequest
-	(*DeleteResponse)(nil),     // 11: ukama.messaging.nns.v1.DeleteResponse
-	(*NodeMeshInfo)(nil),       // 12: ukama.messaging.nns.v1.NodeMeshInfo
-	(*ListRequest)(nil),        // 13: ukama.messaging.nns.v1.ListRequest
-	(*ListResponse)(nil),       // 14: ukama.messaging.nns.v1.ListResponse
+	(*GetNodeRequest)(nil),        // 0: ukama.messaging.nns.v1.GetNodeRequest
+	(*GetNodeResponse)(nil),       // 1: ukama.messaging.nns.v1.GetNodeResponse
+	(*GetMeshRequest)(nil),        // 2: ukama.messaging.nns.v1.GetMeshRequest
+	(*GetMeshResponse)(nil),       // 3: ukama.messaging.nns.v1.GetMeshResponse
+	(*SetRequest)(nil),            // 4: ukama.messaging.nns.v1.SetRequest
+	(*SetResponse)(nil),           // 5: ukama.messaging.nns.v1.SetResponse
+	(*UpdateMeshRequest)(nil),     // 6: ukama.messaging.nns.v1.UpdateMeshRequest
+	(*UpdateMeshResponse)(nil),    // 7: ukama.messaging.nns.v1.UpdateMeshResponse
+	(*UpdateNodeRequest)(nil),     // 8: ukama.messaging.nns.v1.UpdateNodeRequest
+	(*UpdateNodeResponse)(nil),    // 9: ukama.messaging.nns.v1.UpdateNodeResponse
+	(*DeleteRequest)(nil),         // 10: ukama.messaging.nns.v1.DeleteRequest
+	(*DeleteResponse)(nil),        // 11: ukama.messaging.nns.v1.DeleteResponse
+	(*NodeMeshInfo)(nil),          // 12: ukama.messaging.nns.v1.NodeMeshInfo
+	(*ListRequest)(nil),           // 13: ukama.messaging.nns.v1.ListRequest
+	(*ListResponse)(nil),          // 14: ukama.messaging.nns.v1.ListResponse
+	(*GetHistoryRequest)(nil),     // 15: ukama.messaging.nns.v1.GetHistoryRequest
+	(*NodeHistoryEntry)(nil),      // 16: ukama.messaging.nns.v1.NodeHistoryEntry
+	(*GetHistoryResponse)(nil),    // 17: ukama.messaging.nns.v1.GetHistoryResponse
+	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
 }
 var file_nns_proto_depIdxs = []int32{
-	12, // 0: ukama.messaging.nns.v1.ListResponse.list:type_name -> ukama.messaging.nns.v1.NodeMeshInfo
-	0,  // 1: ukama.messaging.nns.v1.Nns.GetNode:input_type -> ukama.messaging.nns.v1.GetNodeRequest
-	2,  // 2: ukama.messaging.nns.v1.Nns.GetMesh:input_type -> ukama.messaging.nns.v1.GetMeshRequest
-	4,  // 3: ukama.messaging.nns.v1.Nns.Set:input_type -> ukama.messaging.nns.v1.SetRequest
-	6,  // 4: ukama.messaging.nns.v1.Nns.UpdateMesh:input_type -> ukama.messaging.nns.v1.UpdateMeshRequest
-	8,  // 5: ukama.messaging.nns.v1.Nns.UpdateNode:input_type -> ukama.messaging.nns.v1.UpdateNodeRequest
-	10, // 6: ukama.messaging.nns.v1.Nns.Delete:input_type -> ukama.messaging.nns.v1.DeleteRequest
-	13, // 7: ukama.messaging.nns.v1.Nns.List:input_type -> ukama.messaging.nns.v1.ListRequest
-	1,  // 8: ukama.messaging.nns.v1.Nns.GetNode:output_type -> ukama.messaging.nns.v1.GetNodeResponse
-	3,  // 9: ukama.messaging.nns.v1.Nns.GetMesh:output_type -> ukama.messaging.nns.v1.GetMeshResponse
-	5,  // 10: ukama.messaging.nns.v1.Nns.Set:output_type -> ukama.messaging.nns.v1.SetResponse
-	7,  // 11: ukama.messaging.nns.v1.Nns.UpdateMesh:output_type -> ukama.messaging.nns.v1.UpdateMeshResponse
-	9,  // 12: ukama.messaging.nns.v1.Nns.UpdateNode:output_type -> ukama.messaging.nns.v1.UpdateNodeResponse
-	11, // 13: ukama.messaging.nns.v1.Nns.Delete:output_type -> ukama.messaging.nns.v1.DeleteResponse
-	14, // 14: ukama.messaging.nns.v1.Nns.List:output_type -> ukama.messaging.nns.v1.ListResponse
-	8,  // [8:15] is the sub-list for method output_type
-	1,  // [1:8] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	18, // 0: ukama.messaging.nns.v1.NodeMeshInfo.lastSeen:type_name -> google.protobuf.Timestamp
+	12, // 1: ukama.messaging.nns.v1.ListResponse.list:type_name -> ukama.messaging.nns.v1.NodeMeshInfo
+	18, // 2: ukama.messaging.nns.v1.NodeHistoryEntry.time:type_name -> google.protobuf.Timestamp
+	16, // 3: ukama.messaging.nns.v1.GetHistoryResponse.history:type_name -> ukama.messaging.nns.v1.NodeHistoryEntry
+	0,  // 4: ukama.messaging.nns.v1.Nns.GetNode:input_type -> ukama.messaging.nns.v1.GetNodeRequest
+	2,  // 5: ukama.messaging.nns.v1.Nns.GetMesh:input_type -> ukama.messaging.nns.v1.GetMeshRequest
+	4,  // 6: ukama.messaging.nns.v1.Nns.Set:input_type -> ukama.messaging.nns.v1.SetRequest
+	6,  // 7: ukama.messaging.nns.v1.Nns.UpdateMesh:input_type -> ukama.messaging.nns.v1.UpdateMeshRequest
+	8,  // 8: ukama.messaging.nns.v1.Nns.UpdateNode:input_type -> ukama.messaging.nns.v1.UpdateNodeRequest
+	10, // 9: ukama.messaging.nns.v1.Nns.Delete:input_type -> ukama.messaging.nns.v1.DeleteRequest
+	13, // 10: ukama.messaging.nns.v1.Nns.List:input_type -> ukama.messaging.nns.v1.ListRequest
+	15, // 11: ukama.messaging.nns.v1.Nns.GetHistory:input_type -> ukama.messaging.nns.v1.GetHistoryRequest
+	1,  // 12: ukama.messaging.nns.v1.Nns.GetNode:output_type -> ukama.messaging.nns.v1.GetNodeResponse
+	3,  // 13: ukama.messaging.nns.v1.Nns.GetMesh:output_type -> ukama.messaging.nns.v1.GetMeshResponse
+	5,  // 14: ukama.messaging.nns.v1.Nns.Set:output_type -> ukama.messaging.nns.v1.SetResponse
+	7,  // 15: ukama.messaging.nns.v1.Nns.UpdateMesh:output_type -> ukama.messaging.nns.v1.UpdateMeshResponse
+	9,  // 16: ukama.messaging.nns.v1.Nns.UpdateNode:output_type -> ukama.messaging.nns.v1.UpdateNodeResponse
+	11, // 17: ukama.messaging.nns.v1.Nns.Delete:output_type -> ukama.messaging.nns.v1.DeleteResponse
+	14, // 18: ukama.messaging.nns.v1.Nns.List:output_type -> ukama.messaging.nns.v1.ListResponse
+	17, // 19: ukama.messaging.nns.v1.Nns.GetHistory:output_type -> ukama.messaging.nns.v1.GetHistoryResponse
+	12, // [12:20] is the sub-list for method output_type
+	4,  // [4:12] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_nns_proto_init() }
@@ -957,7 +1179,7 @@ func file_nns_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nns_proto_rawDesc), len(file_nns_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -8,6 +8,7 @@ import (
 	math "math"
 	proto "github.com/golang/protobuf/proto"
 	_ "github.com/mwitkow/go-proto-validators"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	regexp "regexp"
 	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
 )
@@ -106,6 +107,11 @@ func (this *NodeMeshInfo) Validate() error {
 	if this.NodeIp == "" {
 		return github_com_mwitkow_go_proto_validators.FieldError("NodeIp", fmt.Errorf(`value '%v' must not be an empty string`, this.NodeIp))
 	}
+	if this.LastSeen != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LastSeen); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LastSeen", err)
+		}
+	}
 	return nil
 }
 func (this *ListRequest) Validate() error {
@@ -121,3 +127,27 @@ func (this *ListResponse) Validate() error {
 	}
 	return nil
 }
+func (this *GetHistoryRequest) Validate() error {
+	if this.NodeId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("NodeId", fmt.Errorf(`value '%v' must not be an empty string`, this.NodeId))
+	}
+	return nil
+}
+func (this *NodeHistoryEntry) Validate() error {
+	if this.Time != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Time); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Time", err)
+		}
+	}
+	return nil
+}
+func (this *GetHistoryResponse) Validate() error {
+	for _, item := range this.History {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("History", err)
+			}
+		}
+	}
+	return nil
+}
@@ -33,6 +33,7 @@ const (
 	Nns_UpdateNode_FullMethodName = "/ukama.messaging.nns.v1.Nns/UpdateNode"
 	Nns_Delete_FullMethodName     = "/ukama.messaging.nns.v1.Nns/Delete"
 	Nns_List_FullMethodName       = "/ukama.messaging.nns.v1.Nns/List"
+	Nns_GetHistory_FullMethodName = "/ukama.messaging.nns.v1.Nns/GetHistory"
 )
 
 // NnsClient is the client API for Nns service.
@@ -46,6 +47,7 @@ type NnsClient interface {
 	UpdateNode(ctx context.Context, in *UpdateNodeRequest, opts ...grpc.CallOption) (*UpdateNodeResponse, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error)
 }
 
 type nnsClient struct {
@@ -126,6 +128,16 @@ func (c *nnsClient) List(ctx context.Context, in *ListRequest, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *nnsClient) GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHistoryResponse)
+	err := c.cc.Invoke(ctx, Nns_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NnsServer is the server API for Nns service.
 // All implementations must embed UnimplementedNnsServer
 // for forward compatibility.
@@ -137,6 +149,7 @@ type NnsServer interface {
 	UpdateNode(context.Context, *UpdateNodeRequest) (*UpdateNodeResponse, error)
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
 	List(context.Context, *ListRequest) (*ListResponse, error)
+	GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error)
 	mustEmbedUnimplementedNnsServer()
 }
 
@@ -168,6 +181,9 @@ func (UnimplementedNnsServer) Delete(context.Context, *DeleteRequest) (*DeleteRe
 func (UnimplementedNnsServer) List(context.Context, *ListRequest) (*ListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedNnsServer) GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
 func (UnimplementedNnsServer) mustEmbedUnimplementedNnsServer() {}
 func (UnimplementedNnsServer) testEmbeddedByValue()             {}
 
@@ -315,6 +331,24 @@ func _Nns_List_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nns_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NnsServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nns_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NnsServer).GetHistory(ctx, req.(*GetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Nns_ServiceDesc is the grpc.ServiceDesc for Nns service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -350,6 +384,10 @@ var Nns_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Nns_List_Handler,
 		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _Nns_GetHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nns.proto",
@@ -29,12 +29,19 @@ type Config struct {
 	EtcdHost          string
 	OrgName           string
 	NodeMetricsPort   int
+	Lease             *LeaseConfig
+	HistoryLimit      int
 }
 
 type HttpServices struct {
 	InitClient string `default:"api-gateway-init:8080"`
 }
 
+type LeaseConfig struct {
+	Ttl              time.Duration // offline nodes not seen for that long are evicted
+	EvictionInterval time.Duration
+}
+
 type DnsConfig struct {
 	NodeDomain string // nodes domain like : ukama.node or mesh.node
 }
@@ -44,6 +51,11 @@ func NewConfig(name string) *Config {
 		EtcdHost:          "localhost:2379",
 		DialTimeoutSecond: 5 * time.Second,
 		NodeMetricsPort:   10250,
+		HistoryLimit:      50,
+		Lease: &LeaseConfig{
+			Ttl:              72 * time.Hour,
+			EvictionInterval: 10 * time.Minute,
+		},
 		Dns: &DnsConfig{
 			NodeDomain: "node.mesh",
 		},
@@ -10,9 +10,11 @@ package pkg
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/ukama/ukama/systems/messaging/nns/pkg/metrics"
@@ -21,9 +23,28 @@ import (
 
 var separator = "|"
 
+var ErrNodeNotFound = errors.New("node not found")
+
+const (
+	meshPrefix    = "mesh"
+	historyPrefix = "history"
+)
+
+// Actions recorded in the history of a node
+const (
+	HistoryAdded   = "added"
+	HistoryChanged = "changed"
+	HistoryOnline  = "online"
+	HistoryOffline = "offline"
+	HistoryDeleted = "deleted"
+	HistoryEvicted = "evicted"
+)
+
 type Nns struct {
-	etcd    *clientv3.Client
-	orgName string
+	etcd         *clientv3.Client
+	orgName      string
+	leaseTtl     time.Duration
+	historyLimit int
 }
 
 type NnsReader interface {
@@ -40,10 +61,18 @@ func NewNns(config *Config) *Nns {
 		log.Fatalf("Cannot connect to etcd: %v", err)
 	}
 
-	return &Nns{
-		etcd:    client,
-		orgName: config.OrgName,
+	nns := &Nns{
+		etcd:         client,
+		orgName:      config.OrgName,
+		historyLimit: config.HistoryLimit,
 	}
+
+	if config.Lease != nil && config.Lease.Ttl > 0 {
+		// etcd drops offline nodes on its own if they are not evicted in time
+		nns.leaseTtl = config.Lease.Ttl + config.Lease.EvictionInterval
+	}
+
+	return nns
 }
 
 type NodeMeshMap struct {
@@ -56,15 +85,32 @@ type NodeMeshMap struct {
 	NodeId       string
 	NodeIp       string
 	NodePort     int32
+	LastSeen     time.Time
+	Online       bool
+}
+
+// NodeHistory is a change of the addresses or of the state of a node
+type NodeHistory struct {
+	Time     time.Time
+	Action   string
+	NodeIp   string
+	NodePort int32
+	MeshIp   string
+	MeshPort int32
 }
 
 func (o *NodeMeshMap) string() string {
-	return fmt.Sprintf("%s%s%s%s%s%s%s%s%s%s%d%s%s%s%s%s%d", o.Org, separator, o.Network, separator, o.Site, separator, o.MeshIp, separator, o.MeshHostName, separator, o.MeshPort, separator, o.NodeId, separator, o.NodeIp, separator, o.NodePort)
+	lastSeen := int64(0)
+	if !o.LastSeen.IsZero() {
+		lastSeen = o.LastSeen.Unix()
+	}
+
+	return fmt.Sprintf("%s%s%s%s%s%s%s%s%s%s%d%s%s%s%s%s%d%s%d%s%t", o.Org, separator, o.Network, separator, o.Site, separator, o.MeshIp, separator, o.MeshHostName, separator, o.MeshPort, separator, o.NodeId, separator, o.NodeIp, separator, o.NodePort, separator, lastSeen, separator, o.Online)
 }
 
 func (o *NodeMeshMap) parse(value string) error {
 	parts := strings.Split(value, separator)
-	if len(parts) != 9 {
+	if len(parts) != 9 && len(parts) != 11 {
 		return fmt.Errorf("invalid org net string: %s", value)
 	}
 
@@ -86,51 +132,190 @@ func (o *NodeMeshMap) parse(value string) error {
 	}
 	o.NodePort = int32(nodePort)
 
+	if len(parts) == 9 {
+		// Stored before nodes were tracked, never evicted until updated
+		o.Online = true
+		return nil
+	}
+
+	lastSeen, err := strconv.ParseInt(parts[9], 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse last seen: %v", err)
+	}
+	if lastSeen > 0 {
+		o.LastSeen = time.Unix(lastSeen, 0)
+	}
+
+	o.Online, err = strconv.ParseBool(parts[10])
+	if err != nil {
+		return fmt.Errorf("failed to parse online state: %v", err)
+	}
+
+	return nil
+}
+
+func (h *NodeHistory) string() string {
+	return fmt.Sprintf("%d%s%s%s%s%s%d%s%s%s%d", h.Time.UnixNano(), separator, h.Action, separator, h.NodeIp, separator, h.NodePort, separator, h.MeshIp, separator, h.MeshPort)
+}
+
+func (h *NodeHistory) parse(value string) error {
+	parts := strings.Split(value, separator)
+	if len(parts) != 6 {
+		return fmt.Errorf("invalid history string: %s", value)
+	}
+
+	at, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse history time: %v", err)
+	}
+	h.Time = time.Unix(0, at)
+	h.Action = parts[1]
+	h.NodeIp = parts[2]
+	nodePort, err := strconv.ParseInt(parts[3], 10, 32)
+	if err != nil {
+		return fmt.Errorf("failed to parse node port: %v", err)
+	}
+	h.NodePort = int32(nodePort)
+	h.MeshIp = parts[4]
+	meshPort, err := strconv.ParseInt(parts[5], 10, 32)
+	if err != nil {
+		return fmt.Errorf("failed to parse mesh port: %v", err)
+	}
+	h.MeshPort = int32(meshPort)
+
 	return nil
 }
 
 func  constructKey(nodeId string) string {
-	return "mesh" + separator + nodeId
+	return meshPrefix + separator + nodeId
+}
+
+func constructHistoryPrefix(nodeId string) string {
+	return historyPrefix + separator + nodeId + separator
+}
+
+// historyAction tells what changed between two versions of a node entry, or
+// an empty string when only the last seen time did.
+func historyAction(prev *NodeMeshMap, obj *NodeMeshMap) string {
+	switch {
+	case prev == nil:
+		return HistoryAdded
+	case prev.NodeIp != obj.NodeIp || prev.NodePort != obj.NodePort ||
+		prev.MeshIp != obj.MeshIp || prev.MeshPort != obj.MeshPort:
+		return HistoryChanged
+	case !prev.Online && obj.Online:
+		return HistoryOnline
+	case prev.Online && !obj.Online:
+		return HistoryOffline
+	}
+
+	return ""
+}
+
+func historyOp(obj NodeMeshMap, action string) clientv3.Op {
+	h := NodeHistory{
+		Time:     time.Now(),
+		Action:   action,
+		NodeIp:   obj.NodeIp,
+		NodePort: obj.NodePort,
+		MeshIp:   obj.MeshIp,
+		MeshPort: obj.MeshPort,
+	}
+
+	return clientv3.OpPut(fmt.Sprintf("%s%020d", constructHistoryPrefix(obj.NodeId), h.Time.UnixNano()), h.string())
 }
 
 func constructKeyAndValue(obj NodeMeshMap) (string, string) {
 	return constructKey(obj.NodeId), obj.string()
 }
 
-func (n *Nns) Add(ctx context.Context, obj NodeMeshMap) error {
+// put stores the node entry, along with a history record when its addresses
+// or its state changed. Offline nodes are stored with a lease.
+func (n *Nns) put(ctx context.Context, obj NodeMeshMap, prev *NodeMeshMap) error {
 	key, value := constructKeyAndValue(obj)
-	_, err := n.etcd.Put(ctx, key, value)
+
+	opts := []clientv3.OpOption{}
+	if !obj.Online && n.leaseTtl > 0 {
+		lease, err := n.etcd.Grant(ctx, int64(n.leaseTtl.Seconds()))
+		if err != nil {
+			return fmt.Errorf("failed to grant lease for %s. Error: %v", key, err)
+		}
+		opts = append(opts, clientv3.WithLease(lease.ID))
+	}
+
+	ops := []clientv3.Op{clientv3.OpPut(key, value, opts...)}
+
+	action := historyAction(prev, &obj)
+	if action != "" {
+		ops = append(ops, historyOp(obj, action))
+	}
+
+	_, err := n.etcd.Txn(ctx).Then(ops...).Commit()
 	if err != nil {
-		return fmt.Errorf("failed to add record %s with value %s. Error: %v", key, value, err)
+		return fmt.Errorf("failed to store record %s with value %s. Error: %v", key, value, err)
 	}
-	log.Infof("Added node %s with value %s to etcd", key, value)
+
+	if action != "" {
+		n.trimHistory(ctx, obj.NodeId)
+	}
+
 	return nil
 }
 
-func (n *Nns) Get(ctx context.Context, nodeId string) (*NodeMeshMap, error) {
+// get returns nil when the node is not stored
+func (n *Nns) get(ctx context.Context, nodeId string) (*NodeMeshMap, error) {
 	key := constructKey(nodeId)
 	val, err := n.etcd.Get(ctx, key)
 	if err != nil {
-		metrics.RecordIpRequestFailureMetric()
 		return nil, fmt.Errorf("failed to get record from db. Error: %v", err)
 	}
 	if len(val.Kvs) == 0 {
-		metrics.RecordIpRequestFailureMetric()
-		return nil, fmt.Errorf("node not found: %s", key)
+		return nil, nil
 	}
 	nodeMesh := NodeMeshMap{}
 	err = nodeMesh.parse(string(val.Kvs[0].Value))
 	if err != nil {
-		metrics.RecordIpRequestFailureMetric()
 		return nil, fmt.Errorf("failed to parse stored node mesh map  for %s. Error: %v", val.Kvs[0].Key, err)
 	}
-	log.Infof("Got node %s from etcd", key)
-	metrics.RecordIpRequestSuccessMetric()
 	return &nodeMesh, nil
 }
 
+func (n *Nns) Add(ctx context.Context, obj NodeMeshMap) error {
+	prev, err := n.get(ctx, obj.NodeId)
+	if err != nil {
+		return err
+	}
+
+	if obj.LastSeen.IsZero() {
+		obj.LastSeen = time.Now()
+	}
+
+	key, value := constructKeyAndValue(obj)
+	err = n.put(ctx, obj, prev)
+	if err != nil {
+		return fmt.Errorf("failed to add record %s with value %s. Error: %v", key, value, err)
+	}
+	log.Infof("Added node %s with value %s to etcd", key, value)
+	return nil
+}
+
+func (n *Nns) Get(ctx context.Context, nodeId string) (*NodeMeshMap, error) {
+	nodeMesh, err := n.get(ctx, nodeId)
+	if err != nil {
+		metrics.RecordIpRequestFailureMetric()
+		return nil, err
+	}
+	if nodeMesh == nil {
+		metrics.RecordIpRequestFailureMetric()
+		return nil, fmt.Errorf("%w: %s", ErrNodeNotFound, constructKey(nodeId))
+	}
+	log.Infof("Got node %s from etcd", constructKey(nodeId))
+	metrics.RecordIpRequestSuccessMetric()
+	return nodeMesh, nil
+}
+
 func (n *Nns) GetAll(ctx context.Context) ([]NodeMeshMap, error) {
-	vals, err := n.etcd.Get(ctx, "", clientv3.WithPrefix())
+	vals, err := n.etcd.Get(ctx, meshPrefix+separator, clientv3.WithPrefix())
 	if err != nil {
 		metrics.RecordIpRequestFailureMetric()
 		return nil, fmt.Errorf("failed to get record from db. Error: %v", err)
@@ -167,7 +352,18 @@ func (n *Nns) DeleteAll(ctx context.Context) error {
 
 func (n *Nns) Delete(ctx context.Context, nodeId string) error {
 	key := constructKey(nodeId)
-	_, err := n.etcd.Delete(ctx, key)
+
+	prev, err := n.get(ctx, nodeId)
+	if err != nil {
+		return fmt.Errorf("failed to delete node %s from etcd. Error: %v", nodeId, err)
+	}
+
+	ops := []clientv3.Op{clientv3.OpDelete(key)}
+	if prev != nil {
+		ops = append(ops, historyOp(*prev, HistoryDeleted))
+	}
+
+	_, err = n.etcd.Txn(ctx).Then(ops...).Commit()
 	if err != nil {
 		return fmt.Errorf("failed to delete node %s from etcd. Error: %v", nodeId, err)
 	}
@@ -175,6 +371,99 @@ func (n *Nns) Delete(ctx context.Context, nodeId string) error {
 	return nil
 }
 
+// SetOffline starts the countdown after which the node gets evicted
+func (n *Nns) SetOffline(ctx context.Context, nodeId string) error {
+	item, err := n.Get(ctx, nodeId)
+	if err != nil {
+		return fmt.Errorf("failed to get node record. Error: %w", err)
+	}
+
+	obj := *item
+	obj.Online = false
+	obj.LastSeen = time.Now()
+
+	err = n.put(ctx, obj, item)
+	if err != nil {
+		return fmt.Errorf("failed to set node %s offline. Error: %v", nodeId, err)
+	}
+	log.Infof("Node %s is offline", nodeId)
+	return nil
+}
+
+// Evict removes the offline nodes not seen since seenBefore and returns them.
+// A node updated in the meantime is left alone.
+func (n *Nns) Evict(ctx context.Context, seenBefore time.Time) ([]NodeMeshMap, error) {
+	items, err := n.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	evicted := make([]NodeMeshMap, 0)
+	for _, item := range items {
+		if item.Online || item.LastSeen.IsZero() || !item.LastSeen.Before(seenBefore) {
+			continue
+		}
+
+		key, value := constructKeyAndValue(item)
+		resp, err := n.etcd.Txn(ctx).
+			If(clientv3.Compare(clientv3.Value(key), "=", value)).
+			Then(clientv3.OpDelete(key), historyOp(item, HistoryEvicted)).
+			Commit()
+		if err != nil {
+			return evicted, fmt.Errorf("failed to evict node %s. Error: %v", item.NodeId, err)
+		}
+
+		if !resp.Succeeded {
+			log.Infof("Node %s changed while being evicted. Skipping", item.NodeId)
+			continue
+		}
+
+		log.Infof("Evicted node %s last seen at %s", item.NodeId, item.LastSeen)
+		evicted = append(evicted, item)
+	}
+
+	return evicted, nil
+}
+
+// History returns the changes of the node, oldest first
+func (n *Nns) History(ctx context.Context, nodeId string) ([]NodeHistory, error) {
+	vals, err := n.etcd.Get(ctx, constructHistoryPrefix(nodeId), clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history of node %s. Error: %v", nodeId, err)
+	}
+
+	history := make([]NodeHistory, 0, len(vals.Kvs))
+	for _, val := range vals.Kvs {
+		h := NodeHistory{}
+		err = h.parse(string(val.Value))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse stored history for %s. Error: %v", val.Key, err)
+		}
+		history = append(history, h)
+	}
+
+	return history, nil
+}
+
+// trimHistory keeps the latest historyLimit records of the node
+func (n *Nns) trimHistory(ctx context.Context, nodeId string) {
+	if n.historyLimit <= 0 {
+		return
+	}
+
+	vals, err := n.etcd.Get(ctx, constructHistoryPrefix(nodeId), clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil || len(vals.Kvs) <= n.historyLimit {
+		return
+	}
+
+	from := string(vals.Kvs[0].Key)
+	to := string(vals.Kvs[len(vals.Kvs)-n.historyLimit].Key)
+	_, err = n.etcd.Delete(ctx, from, clientv3.WithRange(to))
+	if err != nil {
+		log.Warnf("Failed to trim history of node %s. Error: %v", nodeId, err)
+	}
+}
+
 func (n *Nns) UpdateNodeMesh(ctx context.Context, nodeId string, ip string, port int32) error {
 	item, err := n.Get(ctx, nodeId)
 	if err != nil {
@@ -191,9 +480,10 @@ func (n *Nns) UpdateNodeMesh(ctx context.Context, nodeId string, ip string, port
 		Network:      item.Network,
 		NodePort:     item.NodePort,
 		MeshHostName: item.MeshHostName,
+		LastSeen:     time.Now(),
+		Online:       item.Online,
 	}
-	key, value := constructKeyAndValue(obj)
-	_, err = n.etcd.Put(ctx, key, value)
+	err = n.put(ctx, obj, item)
 	if err != nil {
 		return fmt.Errorf("failed to update mesh IP and port for %s. Error: %v", obj.NodeId, err)
 	}
@@ -218,9 +508,10 @@ func (n *Nns) UpdateNode(ctx context.Context, nodeId string, nodeIp string, node
 		Org:          item.Org,
 		Network:      item.Network,
 		Site:         item.Site,
+		LastSeen:     time.Now(),
+		Online:       item.Online,
 	}
-	key, value := constructKeyAndValue(obj)
-	_, err = n.etcd.Put(ctx, key, value)
+	err = n.put(ctx, obj, item)
 	if err != nil {
 		return fmt.Errorf("failed to update node IP and port for %s. Error: %v", obj.NodeId, err)
 	}
@@ -0,0 +1,72 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package pkg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeMeshMap_Parse(t *testing.T) {
+	t.Run("RoundTrip", func(t *testing.T) {
+		obj := NodeMeshMap{
+			Org:          "org",
+			Network:      "net",
+			Site:         "site",
+			MeshIp:       "10.0.0.1",
+			MeshHostName: "mesh",
+			MeshPort:     8082,
+			NodeId:       "uk-sa2203-hnode-a1-0a16",
+			NodeIp:       "192.168.0.1",
+			NodePort:     3000,
+			LastSeen:     time.Unix(1700000000, 0),
+		}
+
+		got := NodeMeshMap{}
+		require.NoError(t, got.parse(obj.string()))
+		assert.Equal(t, obj.NodeId, got.NodeId)
+		assert.True(t, obj.LastSeen.Equal(got.LastSeen))
+		assert.False(t, got.Online)
+	})
+
+	t.Run("Legacy", func(t *testing.T) {
+		got := NodeMeshMap{}
+		require.NoError(t, got.parse("org|net|site|10.0.0.1|mesh|8082|uk-sa2203-hnode-a1-0a16|192.168.0.1|3000"))
+		assert.Equal(t, int32(3000), got.NodePort)
+		assert.True(t, got.LastSeen.IsZero())
+		assert.True(t, got.Online)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		got := NodeMeshMap{}
+		assert.Error(t, got.parse("org|net"))
+	})
+}
+
+func TestHistoryAction(t *testing.T) {
+	node := NodeMeshMap{NodeIp: "192.168.0.1", NodePort: 3000, MeshIp: "10.0.0.1", MeshPort: 8082, Online: true}
+
+	moved := node
+	moved.NodeIp = "192.168.0.2"
+
+	offline := node
+	offline.Online = false
+
+	seen := node
+	seen.LastSeen = time.Now()
+
+	assert.Equal(t, HistoryAdded, historyAction(nil, &node))
+	assert.Equal(t, HistoryChanged, historyAction(&node, &moved))
+	assert.Equal(t, HistoryOffline, historyAction(&node, &offline))
+	assert.Equal(t, HistoryOnline, historyAction(&offline, &node))
+	assert.Empty(t, historyAction(&node, &seen))
+}
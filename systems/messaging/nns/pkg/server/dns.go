@@ -12,6 +12,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 
 	"github.com/coredns/coredns/pb"
@@ -22,6 +23,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// SRV records of the mesh are queried as _mesh._tcp.<node id>.<node domain>
+const meshSrvPrefix = "_mesh._tcp."
+
 type DnsServer struct {
 	nns    pkg.NnsReader
 	config *pkg.DnsConfig
@@ -57,8 +61,43 @@ func (d *DnsServer) Query(ctx context.Context, p *pb.DnsPacket) (*pb.DnsPacket,
 				A:   ip})
 		case dns.TypeAAAA:
 			return nil, status.Error(codes.NotFound, "No AAAA record found")
+		case dns.TypeSRV:
+			if !strings.HasPrefix(strings.ToLower(q.Name), meshSrvPrefix) {
+				return nil, status.Error(codes.NotFound, "only "+meshSrvPrefix+" SRV records are served")
+			}
+			nodeName := q.Name[len(meshSrvPrefix):]
+			node, err := d.resolveNode(ctx, nodeName)
+			if err != nil {
+				return nil, err
+			}
+			// the mesh host name may not be resolvable outside of the cluster
+			target := dns.Fqdn(nodeName)
+			if node.MeshHostName != "" {
+				target = dns.Fqdn(node.MeshHostName)
+			}
+			r.Answer = append(r.Answer, &dns.SRV{
+				Hdr:    hdr,
+				Port:   uint16(node.MeshPort),
+				Target: target,
+			})
+		case dns.TypeTXT:
+			node, err := d.resolveNode(ctx, q.Name)
+			if err != nil {
+				return nil, err
+			}
+			r.Answer = append(r.Answer, &dns.TXT{
+				Hdr: hdr,
+				Txt: []string{
+					"org=" + node.Org,
+					"network=" + node.Network,
+					"site=" + node.Site,
+					"meshHost=" + node.MeshHostName,
+					"meshPort=" + strconv.Itoa(int(node.MeshPort)),
+					"nodePort=" + strconv.Itoa(int(node.NodePort)),
+				},
+			})
 		default:
-			return nil, fmt.Errorf("only A and AAAA supported, got qtype=%d", q.Qtype)
+			return nil, fmt.Errorf("only A, AAAA, SRV and TXT supported, got qtype=%d", q.Qtype)
 		}
 	}
 
@@ -74,6 +113,19 @@ func (d *DnsServer) Query(ctx context.Context, p *pb.DnsPacket) (*pb.DnsPacket,
 }
 
 func (d *DnsServer) resolveIp(ctx context.Context, name string) (net.IP, error) {
+	node, err := d.resolveNode(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	ip := net.ParseIP(node.NodeIp)
+	if ip == nil {
+		log.Errorf("failed to parse ip %q from DB", node.NodeIp)
+		return nil, fmt.Errorf("invalid ip %q stored for %s", node.NodeIp, name)
+	}
+	return ip, nil
+}
+
+func (d *DnsServer) resolveNode(ctx context.Context, name string) (*pkg.NodeMeshMap, error) {
 	baseDomain := strings.ToLower(d.config.NodeDomain) + "."
 	nodeId := strings.ToLower(name)
 
@@ -101,10 +153,5 @@ func (d *DnsServer) resolveIp(ctx context.Context, name string) (net.IP, error)
 		log.Errorf("failed to get node: %v", err)
 		return nil, err
 	}
-	ip := net.ParseIP(ndIpResp.NodeIp)
-	if ip == nil {
-		log.Errorf("failed to parse ip from DB: %v", err)
-		return nil, err
-	}
-	return ip, nil
+	return ndIpResp, nil
 }
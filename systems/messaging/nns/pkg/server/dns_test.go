@@ -120,3 +120,65 @@ func TestDnsServer_Query(t *testing.T) {
 		})
 	}
 }
+
+func TestDnsServer_QueryMeshRecords(t *testing.T) {
+	config := &pkg.DnsConfig{NodeDomain: "test.node"}
+	const nodeId = "uk-sa2203-hnode-a1-0a16"
+
+	nns := &mocks.NnsReader{}
+	nns.On("Get", mock.Anything, nodeId).Return(&pkg.NodeMeshMap{
+		NodeId:       nodeId,
+		NodeIp:       "192.168.0.1",
+		NodePort:     3000,
+		MeshHostName: "mesh",
+		MeshPort:     8082,
+		Org:          "test-org",
+		Network:      "net",
+		Site:         "site",
+	}, nil)
+
+	d := NewDnsServer(nns, config)
+
+	query := func(name string, qtype uint16) (*dns.Msg, error) {
+		m := new(dns.Msg)
+		m.SetQuestion(name, qtype)
+		b, err := m.Pack()
+		if err != nil {
+			return nil, err
+		}
+
+		got, err := d.Query(context.TODO(), &pb.DnsPacket{Msg: b})
+		if err != nil {
+			return nil, err
+		}
+
+		resp := new(dns.Msg)
+		return resp, resp.Unpack(got.Msg)
+	}
+
+	t.Run("Srv", func(t *testing.T) {
+		resp, err := query("_mesh._tcp."+nodeId+".test.node.", dns.TypeSRV)
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Answer, 1)
+		r := resp.Answer[0].(*dns.SRV)
+		assert.Equal(t, uint16(8082), r.Port)
+		assert.Equal(t, "mesh.", r.Target)
+	})
+
+	t.Run("SrvUnknownService", func(t *testing.T) {
+		_, err := query("_http._tcp."+nodeId+".test.node.", dns.TypeSRV)
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Txt", func(t *testing.T) {
+		resp, err := query(nodeId+".test.node.", dns.TypeTXT)
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Answer, 1)
+		r := resp.Answer[0].(*dns.TXT)
+		assert.Contains(t, r.Txt, "org=test-org")
+		assert.Contains(t, r.Txt, "meshPort=8082")
+	})
+}
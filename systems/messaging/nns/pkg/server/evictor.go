@@ -0,0 +1,60 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	mb "github.com/ukama/ukama/systems/common/msgBusServiceClient"
+	"github.com/ukama/ukama/systems/common/msgbus"
+	"github.com/ukama/ukama/systems/messaging/nns/pkg"
+)
+
+// NnsEvicter removes the stale node entries (implemented by *pkg.Nns).
+type NnsEvicter interface {
+	Evict(ctx context.Context, seenBefore time.Time) ([]pkg.NodeMeshMap, error)
+}
+
+// Evictor drops the nodes that have been offline for longer than the TTL and
+// publishes an event for each of them.
+type Evictor struct {
+	nns            NnsEvicter
+	msgbus         mb.MsgBusServiceClient
+	baseRoutingKey msgbus.RoutingKeyBuilder
+	ttl            time.Duration
+}
+
+func NewEvictor(nns NnsEvicter, msgBus mb.MsgBusServiceClient, orgName string, ttl time.Duration) *Evictor {
+	return &Evictor{
+		nns:            nns,
+		msgbus:         msgBus,
+		baseRoutingKey: msgbus.NewRoutingKeyBuilder().SetCloudSource().SetSystem(pkg.SystemName).SetOrgName(orgName).SetService(pkg.ServiceName),
+		ttl:            ttl,
+	}
+}
+
+func (e *Evictor) EvictStale() {
+	nodes, err := e.nns.Evict(context.Background(), time.Now().Add(-e.ttl))
+	if err != nil {
+		// nodes evicted before the failure still get their event
+		log.Errorf("Failed to evict stale nodes. Error: %v", err)
+	}
+
+	route := e.baseRoutingKey.SetAction("evict").SetObject("node").MustBuild()
+	for _, node := range nodes {
+		log.Warnf("Node %s evicted, offline since %s", node.NodeId, node.LastSeen)
+
+		err = e.msgbus.PublishRequest(route, parseNodeMeshMap(node))
+		if err != nil {
+			log.Errorf("Failed to publish message %+v with key %+v. Errors %s", node, route, err.Error())
+		}
+	}
+}
@@ -0,0 +1,56 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	cmocks "github.com/ukama/ukama/systems/common/mocks"
+	"github.com/ukama/ukama/systems/messaging/nns/mocks"
+	pb "github.com/ukama/ukama/systems/messaging/nns/pb/gen"
+	"github.com/ukama/ukama/systems/messaging/nns/pkg"
+)
+
+func TestEvictor_EvictStale(t *testing.T) {
+	const route = "event.cloud.local.testorg.messaging.nns.node.evict"
+
+	t.Run("PublishesEvictedNodes", func(t *testing.T) {
+		nns := &mocks.NnsEvicter{}
+		msgbus := &cmocks.MsgBusServiceClient{}
+
+		nns.On("Evict", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+			return time.Until(before) < -71*time.Hour
+		})).Return([]pkg.NodeMeshMap{{NodeId: testValidNodeID, LastSeen: time.Now().Add(-73 * time.Hour)}}, nil).Once()
+		msgbus.On("PublishRequest", route, mock.MatchedBy(func(n *pb.NodeMeshInfo) bool {
+			return n.NodeId == testValidNodeID && !n.Online
+		})).Return(nil).Once()
+
+		NewEvictor(nns, msgbus, "test-org", 72*time.Hour).EvictStale()
+
+		nns.AssertExpectations(t)
+		msgbus.AssertExpectations(t)
+	})
+
+	t.Run("PartialFailure", func(t *testing.T) {
+		nns := &mocks.NnsEvicter{}
+		msgbus := &cmocks.MsgBusServiceClient{}
+
+		nns.On("Evict", mock.Anything, mock.Anything).
+			Return([]pkg.NodeMeshMap{{NodeId: testValidNodeID}}, errors.New("etcd down")).Once()
+		msgbus.On("PublishRequest", route, mock.Anything).Return(nil).Once()
+
+		NewEvictor(nns, msgbus, "test-org", time.Hour).EvictStale()
+
+		msgbus.AssertExpectations(t)
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/ukama/ukama/systems/messaging/nns/pkg"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // NnsStore is the persistence layer used by NnsServer (implemented by *pkg.Nns).
@@ -27,6 +28,8 @@ type NnsStore interface {
 	Delete(ctx context.Context, nodeId string) error
 	UpdateNodeMesh(ctx context.Context, nodeId string, ip string, port int32) error
 	UpdateNode(ctx context.Context, nodeId string, nodeIp string, nodePort int32) error
+	SetOffline(ctx context.Context, nodeId string) error
+	History(ctx context.Context, nodeId string) ([]pkg.NodeHistory, error)
 }
 
 type NnsServer struct {
@@ -90,6 +93,7 @@ func (n *NnsServer) Set(c context.Context, req *pb.SetRequest) (*pb.SetResponse,
 		Site:         req.GetSite(),
 		MeshHostName: req.GetMeshHostName(),
 		MeshIp:       req.GetMeshIp(),
+		Online:       true,
 	}
 	log.Infof("Adding node %+v", obj)
 	err := n.nns.Add(c, obj)
@@ -143,7 +147,38 @@ func (n *NnsServer) List(c context.Context, req *pb.ListRequest) (*pb.ListRespon
 	return resp, nil
 }
 
+func (n *NnsServer) GetHistory(c context.Context, req *pb.GetHistoryRequest) (*pb.GetHistoryResponse, error) {
+	log.Infof("Getting history of node %s", req.GetNodeId())
+	if _, err := ukama.ValidateNodeId(req.GetNodeId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	history, err := n.nns.History(c, req.GetNodeId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetHistoryResponse{
+		NodeId:  req.GetNodeId(),
+		History: make([]*pb.NodeHistoryEntry, 0, len(history)),
+	}
+	for _, h := range history {
+		resp.History = append(resp.History, &pb.NodeHistoryEntry{
+			Time:     timestamppb.New(h.Time),
+			Action:   h.Action,
+			NodeIp:   h.NodeIp,
+			NodePort: h.NodePort,
+			MeshIp:   h.MeshIp,
+			MeshPort: h.MeshPort,
+		})
+	}
+	return resp, nil
+}
+
 func parseNodeMeshMap(item pkg.NodeMeshMap) *pb.NodeMeshInfo {
+	var lastSeen *timestamppb.Timestamp
+	if !item.LastSeen.IsZero() {
+		lastSeen = timestamppb.New(item.LastSeen)
+	}
+
 	return &pb.NodeMeshInfo{
 		NodeId:       item.NodeId,
 		NodeIp:       item.NodeIp,
@@ -154,6 +189,8 @@ func parseNodeMeshMap(item pkg.NodeMeshMap) *pb.NodeMeshInfo {
 		Site:         item.Site,
 		MeshIp:       item.MeshIp,
 		MeshHostName: item.MeshHostName,
+		LastSeen:     lastSeen,
+		Online:       item.Online,
 	}
 }
 
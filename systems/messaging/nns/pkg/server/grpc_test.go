@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,6 +34,8 @@ type fakeNnsStore struct {
 	delete     func(ctx context.Context, nodeId string) error
 	updateMesh func(ctx context.Context, nodeId string, ip string, port int32) error
 	updateNode func(ctx context.Context, nodeId string, nodeIp string, nodePort int32) error
+	setOffline func(ctx context.Context, nodeId string) error
+	history    func(ctx context.Context, nodeId string) ([]pkg.NodeHistory, error)
 }
 
 func (f *fakeNnsStore) Get(ctx context.Context, nodeId string) (*pkg.NodeMeshMap, error) {
@@ -77,6 +80,20 @@ func (f *fakeNnsStore) UpdateNode(ctx context.Context, nodeId string, nodeIp str
 	return errFakeNotImplemented
 }
 
+func (f *fakeNnsStore) SetOffline(ctx context.Context, nodeId string) error {
+	if f.setOffline != nil {
+		return f.setOffline(ctx, nodeId)
+	}
+	return errFakeNotImplemented
+}
+
+func (f *fakeNnsStore) History(ctx context.Context, nodeId string) ([]pkg.NodeHistory, error) {
+	if f.history != nil {
+		return f.history(ctx, nodeId)
+	}
+	return nil, errFakeNotImplemented
+}
+
 func testConfig() *pkg.Config {
 	return &pkg.Config{OrgName: "test-org"}
 }
@@ -196,6 +213,7 @@ func TestNnsServerSet(t *testing.T) {
 		assert.Equal(t, "site-b", got.Site)
 		assert.Equal(t, "mesh.host", got.MeshHostName)
 		assert.Equal(t, "2.2.2.2", got.MeshIp)
+		assert.True(t, got.Online)
 	})
 }
 
@@ -331,3 +349,34 @@ func TestNnsServerList(t *testing.T) {
 		assert.Equal(t, "o", resp.List[0].Org)
 	})
 }
+
+func TestNnsServerGetHistory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invalidNodeId", func(t *testing.T) {
+		srv := NewNnsServer(&fakeNnsStore{}, testConfig(), testDns())
+		_, err := srv.GetHistory(ctx, &pb.GetHistoryRequest{NodeId: "x"})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("success", func(t *testing.T) {
+		at := time.Now()
+		fake := &fakeNnsStore{
+			history: func(_ context.Context, nodeId string) ([]pkg.NodeHistory, error) {
+				return []pkg.NodeHistory{
+					{Time: at.Add(-time.Hour), Action: pkg.HistoryAdded, NodeIp: "1.1.1.1", NodePort: 11},
+					{Time: at, Action: pkg.HistoryChanged, NodeIp: "1.1.1.2", NodePort: 11},
+				}, nil
+			},
+		}
+		srv := NewNnsServer(fake, testConfig(), testDns())
+		resp, err := srv.GetHistory(ctx, &pb.GetHistoryRequest{NodeId: testValidNodeID})
+		require.NoError(t, err)
+		assert.Equal(t, testValidNodeID, resp.NodeId)
+		require.Len(t, resp.History, 2)
+		assert.Equal(t, pkg.HistoryChanged, resp.History[1].Action)
+		assert.Equal(t, "1.1.1.2", resp.History[1].NodeIp)
+		assert.True(t, resp.History[1].Time.AsTime().Equal(at))
+	})
+}
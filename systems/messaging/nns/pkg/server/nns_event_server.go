@@ -10,6 +10,7 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -124,15 +125,6 @@ func (l *NnsEventServer) handleNodeOnlineEvent(key string, msg *epb.NodeOnlineEv
 		nodeInfo.Site.NetworkId = ""
 	}
 
-	node, err := l.Nns.nns.Get(context.Background(), msg.GetNodeId())
-	if err == nil && node != nil {
-		err = l.Nns.nns.Delete(context.Background(), msg.GetNodeId())
-		if err != nil {
-			log.Errorf("failed to delete node %s. Error %v", msg.GetNodeId(), err)
-			return err
-		}
-	}
-
 	_, err = l.Nns.Set(context.Background(), &pb.SetRequest{
 		NodeId:       msg.GetNodeId(),
 		NodeIp:       msg.GetNodeIp(),
@@ -167,6 +159,17 @@ func (l *NnsEventServer) unmarshalNodeOfflineEvent(msg *anypb.Any) (*epb.NodeOff
 
 func (l *NnsEventServer) handleNodeOfflineEvent(key string, msg *epb.NodeOfflineEvent) error {
 	log.Infof("Keys %s and Proto is: %+v", key, msg)
+
+	err := l.Nns.nns.SetOffline(context.Background(), msg.GetNodeId())
+	if errors.Is(err, pkg.ErrNodeNotFound) {
+		log.Warnf("Node %s going offline is not known to nns", msg.GetNodeId())
+		return nil
+	}
+	if err != nil {
+		log.Errorf("failed to set node %s offline. Error %v", msg.GetNodeId(), err)
+		return err
+	}
+
 	return nil
 }
 
@@ -209,6 +212,8 @@ func (l *NnsEventServer) handleNodeAssignedEvent(key string, msg *epb.NodeAssign
 		Org:          l.orgName,
 		Network:      msg.Network,
 		Site:         msg.Site,
+		LastSeen:     orgNet.LastSeen,
+		Online:       orgNet.Online,
 	}
 
 	err = l.Nns.nns.Add(context.Background(), obj)
@@ -239,6 +244,8 @@ func (l *NnsEventServer) handleNodeReleaseEvent(key string, msg *epb.NodeRelease
 		Org:          l.orgName,
 		Network:      msg.Network,
 		Site:         msg.Site,
+		LastSeen:     orgNet.LastSeen,
+		Online:       orgNet.Online,
 	}
 
 	err = l.Nns.nns.Add(context.Background(), obj)
@@ -0,0 +1,64 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2023-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	"github.com/ukama/ukama/systems/messaging/nns/pkg"
+)
+
+const offlineRoute = "event.cloud.local.test-org.messaging.mesh.node.offline"
+
+func TestNnsEventServer_HandleNodeOfflineEvent(t *testing.T) {
+	t.Run("setsOffline", func(t *testing.T) {
+		var offline string
+		fake := &fakeNnsStore{
+			setOffline: func(_ context.Context, nodeId string) error {
+				offline = nodeId
+				return nil
+			},
+		}
+		s := NewNnsEventServer("test-org", nil, NewNnsServer(fake, testConfig(), testDns()), "test-org")
+
+		err := s.handleNodeOfflineEvent(offlineRoute, &epb.NodeOfflineEvent{NodeId: testValidNodeID})
+		assert.NoError(t, err)
+		assert.Equal(t, testValidNodeID, offline)
+	})
+
+	t.Run("unknownNode", func(t *testing.T) {
+		fake := &fakeNnsStore{
+			setOffline: func(_ context.Context, nodeId string) error {
+				return fmt.Errorf("failed to get node record. Error: %w", pkg.ErrNodeNotFound)
+			},
+		}
+		s := NewNnsEventServer("test-org", nil, NewNnsServer(fake, testConfig(), testDns()), "test-org")
+
+		err := s.handleNodeOfflineEvent(offlineRoute, &epb.NodeOfflineEvent{NodeId: testValidNodeID})
+		assert.NoError(t, err)
+	})
+
+	t.Run("storeFailure", func(t *testing.T) {
+		fake := &fakeNnsStore{
+			setOffline: func(_ context.Context, nodeId string) error {
+				return errors.New("etcd unavailable")
+			},
+		}
+		s := NewNnsEventServer("test-org", nil, NewNnsServer(fake, testConfig(), testDns()), "test-org")
+
+		err := s.handleNodeOfflineEvent(offlineRoute, &epb.NodeOfflineEvent{NodeId: testValidNodeID})
+		assert.Error(t, err)
+	})
+}
@@ -110,6 +110,9 @@ func runGrpcServer() {
 		go msgBusListener(mbClient)
 	}
 
+	ic.StartHeartbeat(ic.NewInitClient(serviceConfig.Http.InitClient, client.WithDebug(serviceConfig.DebugMode)),
+		serviceConfig.OrgName, pkg.SystemName, version.Version, ic.DefaultHeartbeatInterval, nil)
+
 	rpcServer.StartServer()
 }
 
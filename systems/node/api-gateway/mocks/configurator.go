@@ -102,6 +102,216 @@ func (_m *configurator) GetConfigVersion(nodeId string) (*gen.ConfigVersionRespo
 	return r0, r1
 }
 
+// GetDriftReport provides a mock function with given fields: nodeId, driftedOnly
+func (_m *configurator) GetDriftReport(nodeId string, driftedOnly bool) (*gen.DriftReportResponse, error) {
+	ret := _m.Called(nodeId, driftedOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDriftReport")
+	}
+
+	var r0 *gen.DriftReportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, bool) (*gen.DriftReportResponse, error)); ok {
+		return rf(nodeId, driftedOnly)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool) *gen.DriftReportResponse); ok {
+		r0 = rf(nodeId, driftedOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DriftReportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(nodeId, driftedOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPromotions provides a mock function with given fields: env
+func (_m *configurator) ListPromotions(env string) (*gen.ListPromotionsResponse, error) {
+	ret := _m.Called(env)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPromotions")
+	}
+
+	var r0 *gen.ListPromotionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*gen.ListPromotionsResponse, error)); ok {
+		return rf(env)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gen.ListPromotionsResponse); ok {
+		r0 = rf(env)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListPromotionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(env)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreviewConfig provides a mock function with given fields: nodeId, ref
+func (_m *configurator) PreviewConfig(nodeId string, ref string) (*gen.PreviewConfigResponse, error) {
+	ret := _m.Called(nodeId, ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewConfig")
+	}
+
+	var r0 *gen.PreviewConfigResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*gen.PreviewConfigResponse, error)); ok {
+		return rf(nodeId, ref)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *gen.PreviewConfigResponse); ok {
+		r0 = rf(nodeId, ref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PreviewConfigResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(nodeId, ref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Promote provides a mock function with given fields: env, ref, requestedBy
+func (_m *configurator) Promote(env string, ref string, requestedBy string) (*gen.PromoteResponse, error) {
+	ret := _m.Called(env, ref, requestedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Promote")
+	}
+
+	var r0 *gen.PromoteResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*gen.PromoteResponse, error)); ok {
+		return rf(env, ref, requestedBy)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *gen.PromoteResponse); ok {
+		r0 = rf(env, ref, requestedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PromoteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(env, ref, requestedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Rollback provides a mock function with given fields: env, nodeId, requestedBy
+func (_m *configurator) Rollback(env string, nodeId string, requestedBy string) (*gen.RollbackResponse, error) {
+	ret := _m.Called(env, nodeId, requestedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 *gen.RollbackResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*gen.RollbackResponse, error)); ok {
+		return rf(env, nodeId, requestedBy)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *gen.RollbackResponse); ok {
+		r0 = rf(env, nodeId, requestedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RollbackResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(env, nodeId, requestedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetNodeEnvironment provides a mock function with given fields: nodeId, env
+func (_m *configurator) SetNodeEnvironment(nodeId string, env string) (*gen.SetNodeEnvironmentResponse, error) {
+	ret := _m.Called(nodeId, env)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetNodeEnvironment")
+	}
+
+	var r0 *gen.SetNodeEnvironmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*gen.SetNodeEnvironmentResponse, error)); ok {
+		return rf(nodeId, env)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *gen.SetNodeEnvironmentResponse); ok {
+		r0 = rf(nodeId, env)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.SetNodeEnvironmentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(nodeId, env)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Validate provides a mock function with given fields: ref
+func (_m *configurator) Validate(ref string) (*gen.ValidateResponse, error) {
+	ret := _m.Called(ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Validate")
+	}
+
+	var r0 *gen.ValidateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*gen.ValidateResponse, error)); ok {
+		return rf(ref)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gen.ValidateResponse); ok {
+		r0 = rf(ref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ValidateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(ref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // newConfigurator creates a new instance of configurator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newConfigurator(t interface {
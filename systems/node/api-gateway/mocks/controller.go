@@ -5,6 +5,8 @@ package mocks
 import (
 	mock "github.com/stretchr/testify/mock"
 	gen "github.com/ukama/ukama/systems/node/controller/pb/gen"
+
+	time "time"
 )
 
 // controller is an autogenerated mock type for the controller type
@@ -12,6 +14,96 @@ type controller struct {
 	mock.Mock
 }
 
+// CancelCommandPlan provides a mock function with given fields: planId, requestedBy
+func (_m *controller) CancelCommandPlan(planId string, requestedBy string) (*gen.CancelCommandPlanResponse, error) {
+	ret := _m.Called(planId, requestedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelCommandPlan")
+	}
+
+	var r0 *gen.CancelCommandPlanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*gen.CancelCommandPlanResponse, error)); ok {
+		return rf(planId, requestedBy)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *gen.CancelCommandPlanResponse); ok {
+		r0 = rf(planId, requestedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CancelCommandPlanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(planId, requestedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCommandPlan provides a mock function with given fields: planId
+func (_m *controller) GetCommandPlan(planId string) (*gen.GetCommandPlanResponse, error) {
+	ret := _m.Called(planId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommandPlan")
+	}
+
+	var r0 *gen.GetCommandPlanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*gen.GetCommandPlanResponse, error)); ok {
+		return rf(planId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gen.GetCommandPlanResponse); ok {
+		r0 = rf(planId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetCommandPlanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(planId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCommandAudit provides a mock function with given fields: nodeId, requestedBy, command, from, to, limit
+func (_m *controller) ListCommandAudit(nodeId string, requestedBy string, command string, from *time.Time, to *time.Time, limit uint32) (*gen.ListCommandAuditResponse, error) {
+	ret := _m.Called(nodeId, requestedBy, command, from, to, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCommandAudit")
+	}
+
+	var r0 *gen.ListCommandAuditResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, *time.Time, *time.Time, uint32) (*gen.ListCommandAuditResponse, error)); ok {
+		return rf(nodeId, requestedBy, command, from, to, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, *time.Time, *time.Time, uint32) *gen.ListCommandAuditResponse); ok {
+		r0 = rf(nodeId, requestedBy, command, from, to, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListCommandAuditResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, *time.Time, *time.Time, uint32) error); ok {
+		r1 = rf(nodeId, requestedBy, command, from, to, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PingNode provides a mock function with given fields: nodeId
 func (_m *controller) PingNode(nodeId string) (*gen.PingNodeResponse, error) {
 	ret := _m.Called(nodeId)
@@ -42,9 +134,9 @@ func (_m *controller) PingNode(nodeId string) (*gen.PingNodeResponse, error) {
 	return r0, r1
 }
 
-// RestartNode provides a mock function with given fields: nodeId
-func (_m *controller) RestartNode(nodeId string) (*gen.RestartNodeResponse, error) {
-	ret := _m.Called(nodeId)
+// RestartNode provides a mock function with given fields: nodeId, requestedBy
+func (_m *controller) RestartNode(nodeId string, requestedBy string) (*gen.RestartNodeResponse, error) {
+	ret := _m.Called(nodeId, requestedBy)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RestartNode")
@@ -52,19 +144,19 @@ func (_m *controller) RestartNode(nodeId string) (*gen.RestartNodeResponse, erro
 
 	var r0 *gen.RestartNodeResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*gen.RestartNodeResponse, error)); ok {
-		return rf(nodeId)
+	if rf, ok := ret.Get(0).(func(string, string) (*gen.RestartNodeResponse, error)); ok {
+		return rf(nodeId, requestedBy)
 	}
-	if rf, ok := ret.Get(0).(func(string) *gen.RestartNodeResponse); ok {
-		r0 = rf(nodeId)
+	if rf, ok := ret.Get(0).(func(string, string) *gen.RestartNodeResponse); ok {
+		r0 = rf(nodeId, requestedBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gen.RestartNodeResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(nodeId)
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(nodeId, requestedBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -72,9 +164,39 @@ func (_m *controller) RestartNode(nodeId string) (*gen.RestartNodeResponse, erro
 	return r0, r1
 }
 
-// ToggleRadio provides a mock function with given fields: nodeId, state
-func (_m *controller) ToggleRadio(nodeId string, state string) (*gen.ToggleRadioResponse, error) {
-	ret := _m.Called(nodeId, state)
+// RunCommandPlan provides a mock function with given fields: target, steps, abortOnFailure, requestedBy
+func (_m *controller) RunCommandPlan(target *gen.PlanTarget, steps []*gen.PlanStep, abortOnFailure bool, requestedBy string) (*gen.RunCommandPlanResponse, error) {
+	ret := _m.Called(target, steps, abortOnFailure, requestedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunCommandPlan")
+	}
+
+	var r0 *gen.RunCommandPlanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gen.PlanTarget, []*gen.PlanStep, bool, string) (*gen.RunCommandPlanResponse, error)); ok {
+		return rf(target, steps, abortOnFailure, requestedBy)
+	}
+	if rf, ok := ret.Get(0).(func(*gen.PlanTarget, []*gen.PlanStep, bool, string) *gen.RunCommandPlanResponse); ok {
+		r0 = rf(target, steps, abortOnFailure, requestedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RunCommandPlanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gen.PlanTarget, []*gen.PlanStep, bool, string) error); ok {
+		r1 = rf(target, steps, abortOnFailure, requestedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ToggleRadio provides a mock function with given fields: nodeId, state, requestedBy
+func (_m *controller) ToggleRadio(nodeId string, state string, requestedBy string) (*gen.ToggleRadioResponse, error) {
+	ret := _m.Called(nodeId, state, requestedBy)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ToggleRadio")
@@ -82,19 +204,19 @@ func (_m *controller) ToggleRadio(nodeId string, state string) (*gen.ToggleRadio
 
 	var r0 *gen.ToggleRadioResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (*gen.ToggleRadioResponse, error)); ok {
-		return rf(nodeId, state)
+	if rf, ok := ret.Get(0).(func(string, string, string) (*gen.ToggleRadioResponse, error)); ok {
+		return rf(nodeId, state, requestedBy)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) *gen.ToggleRadioResponse); ok {
-		r0 = rf(nodeId, state)
+	if rf, ok := ret.Get(0).(func(string, string, string) *gen.ToggleRadioResponse); ok {
+		r0 = rf(nodeId, state, requestedBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gen.ToggleRadioResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(nodeId, state)
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(nodeId, state, requestedBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -102,9 +224,9 @@ func (_m *controller) ToggleRadio(nodeId string, state string) (*gen.ToggleRadio
 	return r0, r1
 }
 
-// ToggleService provides a mock function with given fields: nodeId, state
-func (_m *controller) ToggleService(nodeId string, state string) (*gen.ToggleServiceResponse, error) {
-	ret := _m.Called(nodeId, state)
+// ToggleService provides a mock function with given fields: nodeId, state, requestedBy
+func (_m *controller) ToggleService(nodeId string, state string, requestedBy string) (*gen.ToggleServiceResponse, error) {
+	ret := _m.Called(nodeId, state, requestedBy)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ToggleService")
@@ -112,19 +234,19 @@ func (_m *controller) ToggleService(nodeId string, state string) (*gen.ToggleSer
 
 	var r0 *gen.ToggleServiceResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (*gen.ToggleServiceResponse, error)); ok {
-		return rf(nodeId, state)
+	if rf, ok := ret.Get(0).(func(string, string, string) (*gen.ToggleServiceResponse, error)); ok {
+		return rf(nodeId, state, requestedBy)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) *gen.ToggleServiceResponse); ok {
-		r0 = rf(nodeId, state)
+	if rf, ok := ret.Get(0).(func(string, string, string) *gen.ToggleServiceResponse); ok {
+		r0 = rf(nodeId, state, requestedBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gen.ToggleServiceResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(nodeId, state)
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(nodeId, state, requestedBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -132,9 +254,9 @@ func (_m *controller) ToggleService(nodeId string, state string) (*gen.ToggleSer
 	return r0, r1
 }
 
-// ToggleSwitchPort provides a mock function with given fields: status, port, nodeId
-func (_m *controller) ToggleSwitchPort(status bool, port int32, nodeId string) (*gen.ToggleSwitchPortResponse, error) {
-	ret := _m.Called(status, port, nodeId)
+// ToggleSwitchPort provides a mock function with given fields: status, port, nodeId, requestedBy
+func (_m *controller) ToggleSwitchPort(status bool, port int32, nodeId string, requestedBy string) (*gen.ToggleSwitchPortResponse, error) {
+	ret := _m.Called(status, port, nodeId, requestedBy)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ToggleSwitchPort")
@@ -142,19 +264,19 @@ func (_m *controller) ToggleSwitchPort(status bool, port int32, nodeId string) (
 
 	var r0 *gen.ToggleSwitchPortResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(bool, int32, string) (*gen.ToggleSwitchPortResponse, error)); ok {
-		return rf(status, port, nodeId)
+	if rf, ok := ret.Get(0).(func(bool, int32, string, string) (*gen.ToggleSwitchPortResponse, error)); ok {
+		return rf(status, port, nodeId, requestedBy)
 	}
-	if rf, ok := ret.Get(0).(func(bool, int32, string) *gen.ToggleSwitchPortResponse); ok {
-		r0 = rf(status, port, nodeId)
+	if rf, ok := ret.Get(0).(func(bool, int32, string, string) *gen.ToggleSwitchPortResponse); ok {
+		r0 = rf(status, port, nodeId, requestedBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gen.ToggleSwitchPortResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(bool, int32, string) error); ok {
-		r1 = rf(status, port, nodeId)
+	if rf, ok := ret.Get(1).(func(bool, int32, string, string) error); ok {
+		r1 = rf(status, port, nodeId, requestedBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5,6 +5,8 @@ package mocks
 import (
 	mock "github.com/stretchr/testify/mock"
 	gen "github.com/ukama/ukama/systems/node/site-controller/pb/gen"
+
+	time "time"
 )
 
 // siteController is an autogenerated mock type for the siteController type
@@ -12,6 +14,36 @@ type siteController struct {
 	mock.Mock
 }
 
+// AddSchedule provides a mock function with given fields: siteID, schedule
+func (_m *siteController) AddSchedule(siteID string, schedule *gen.Schedule) (*gen.AddScheduleResponse, error) {
+	ret := _m.Called(siteID, schedule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSchedule")
+	}
+
+	var r0 *gen.AddScheduleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *gen.Schedule) (*gen.AddScheduleResponse, error)); ok {
+		return rf(siteID, schedule)
+	}
+	if rf, ok := ret.Get(0).(func(string, *gen.Schedule) *gen.AddScheduleResponse); ok {
+		r0 = rf(siteID, schedule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.AddScheduleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *gen.Schedule) error); ok {
+		r1 = rf(siteID, schedule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ApplySwitchPolicy provides a mock function with given fields: siteID
 func (_m *siteController) ApplySwitchPolicy(siteID string) (*gen.ApplySwitchPolicyResponse, error) {
 	ret := _m.Called(siteID)
@@ -42,6 +74,36 @@ func (_m *siteController) ApplySwitchPolicy(siteID string) (*gen.ApplySwitchPoli
 	return r0, r1
 }
 
+// DeleteSchedule provides a mock function with given fields: siteID, scheduleID
+func (_m *siteController) DeleteSchedule(siteID string, scheduleID string) (*gen.DeleteScheduleResponse, error) {
+	ret := _m.Called(siteID, scheduleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSchedule")
+	}
+
+	var r0 *gen.DeleteScheduleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*gen.DeleteScheduleResponse, error)); ok {
+		return rf(siteID, scheduleID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *gen.DeleteScheduleResponse); ok {
+		r0 = rf(siteID, scheduleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DeleteScheduleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(siteID, scheduleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPortMap provides a mock function with given fields: siteID
 func (_m *siteController) GetPortMap(siteID string) (*gen.GetPortMapResponse, error) {
 	ret := _m.Called(siteID)
@@ -72,6 +134,36 @@ func (_m *siteController) GetPortMap(siteID string) (*gen.GetPortMapResponse, er
 	return r0, r1
 }
 
+// GetPowerPolicy provides a mock function with given fields: siteID
+func (_m *siteController) GetPowerPolicy(siteID string) (*gen.GetPowerPolicyResponse, error) {
+	ret := _m.Called(siteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPowerPolicy")
+	}
+
+	var r0 *gen.GetPowerPolicyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*gen.GetPowerPolicyResponse, error)); ok {
+		return rf(siteID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gen.GetPowerPolicyResponse); ok {
+		r0 = rf(siteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetPowerPolicyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(siteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSiteState provides a mock function with given fields: siteID
 func (_m *siteController) GetSiteState(siteID string) (*gen.GetSiteStateResponse, error) {
 	ret := _m.Called(siteID)
@@ -102,6 +194,96 @@ func (_m *siteController) GetSiteState(siteID string) (*gen.GetSiteStateResponse
 	return r0, r1
 }
 
+// ListAuditLog provides a mock function with given fields: siteID, nodeID, requestedBy, command, from, to, limit
+func (_m *siteController) ListAuditLog(siteID string, nodeID string, requestedBy string, command string, from *time.Time, to *time.Time, limit uint32) (*gen.ListAuditLogResponse, error) {
+	ret := _m.Called(siteID, nodeID, requestedBy, command, from, to, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditLog")
+	}
+
+	var r0 *gen.ListAuditLogResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, *time.Time, *time.Time, uint32) (*gen.ListAuditLogResponse, error)); ok {
+		return rf(siteID, nodeID, requestedBy, command, from, to, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, *time.Time, *time.Time, uint32) *gen.ListAuditLogResponse); ok {
+		r0 = rf(siteID, nodeID, requestedBy, command, from, to, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListAuditLogResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, *time.Time, *time.Time, uint32) error); ok {
+		r1 = rf(siteID, nodeID, requestedBy, command, from, to, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListScheduleTransitions provides a mock function with given fields: siteID, from, to, limit
+func (_m *siteController) ListScheduleTransitions(siteID string, from *time.Time, to *time.Time, limit uint32) (*gen.ListScheduleTransitionsResponse, error) {
+	ret := _m.Called(siteID, from, to, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListScheduleTransitions")
+	}
+
+	var r0 *gen.ListScheduleTransitionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *time.Time, *time.Time, uint32) (*gen.ListScheduleTransitionsResponse, error)); ok {
+		return rf(siteID, from, to, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, *time.Time, *time.Time, uint32) *gen.ListScheduleTransitionsResponse); ok {
+		r0 = rf(siteID, from, to, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListScheduleTransitionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *time.Time, *time.Time, uint32) error); ok {
+		r1 = rf(siteID, from, to, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSchedules provides a mock function with given fields: siteID
+func (_m *siteController) ListSchedules(siteID string) (*gen.ListSchedulesResponse, error) {
+	ret := _m.Called(siteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSchedules")
+	}
+
+	var r0 *gen.ListSchedulesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*gen.ListSchedulesResponse, error)); ok {
+		return rf(siteID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gen.ListSchedulesResponse); ok {
+		r0 = rf(siteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListSchedulesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(siteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PowerCycleNode provides a mock function with given fields: siteID, role, reason, requestedBy
 func (_m *siteController) PowerCycleNode(siteID string, role string, reason string, requestedBy string) (*gen.PowerCycleNodeResponse, error) {
 	ret := _m.Called(siteID, role, reason, requestedBy)
@@ -132,9 +314,9 @@ func (_m *siteController) PowerCycleNode(siteID string, role string, reason stri
 	return r0, r1
 }
 
-// RestartSite provides a mock function with given fields: siteID
-func (_m *siteController) RestartSite(siteID string) (*gen.RestartSiteResponse, error) {
-	ret := _m.Called(siteID)
+// RestartSite provides a mock function with given fields: siteID, requestedBy
+func (_m *siteController) RestartSite(siteID string, requestedBy string) (*gen.RestartSiteResponse, error) {
+	ret := _m.Called(siteID, requestedBy)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RestartSite")
@@ -142,19 +324,49 @@ func (_m *siteController) RestartSite(siteID string) (*gen.RestartSiteResponse,
 
 	var r0 *gen.RestartSiteResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*gen.RestartSiteResponse, error)); ok {
-		return rf(siteID)
+	if rf, ok := ret.Get(0).(func(string, string) (*gen.RestartSiteResponse, error)); ok {
+		return rf(siteID, requestedBy)
 	}
-	if rf, ok := ret.Get(0).(func(string) *gen.RestartSiteResponse); ok {
-		r0 = rf(siteID)
+	if rf, ok := ret.Get(0).(func(string, string) *gen.RestartSiteResponse); ok {
+		r0 = rf(siteID, requestedBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gen.RestartSiteResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(siteID)
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(siteID, requestedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetPowerPolicy provides a mock function with given fields: siteID, policy
+func (_m *siteController) SetPowerPolicy(siteID string, policy *gen.PowerPolicy) (*gen.SetPowerPolicyResponse, error) {
+	ret := _m.Called(siteID, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPowerPolicy")
+	}
+
+	var r0 *gen.SetPowerPolicyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *gen.PowerPolicy) (*gen.SetPowerPolicyResponse, error)); ok {
+		return rf(siteID, policy)
+	}
+	if rf, ok := ret.Get(0).(func(string, *gen.PowerPolicy) *gen.SetPowerPolicyResponse); ok {
+		r0 = rf(siteID, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.SetPowerPolicyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *gen.PowerPolicy) error); ok {
+		r1 = rf(siteID, policy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -162,9 +374,9 @@ func (_m *siteController) RestartSite(siteID string) (*gen.RestartSiteResponse,
 	return r0, r1
 }
 
-// SetRadio provides a mock function with given fields: siteID, state
-func (_m *siteController) SetRadio(siteID string, state string) (*gen.SetRadioResponse, error) {
-	ret := _m.Called(siteID, state)
+// SetRadio provides a mock function with given fields: siteID, state, requestedBy
+func (_m *siteController) SetRadio(siteID string, state string, requestedBy string) (*gen.SetRadioResponse, error) {
+	ret := _m.Called(siteID, state, requestedBy)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SetRadio")
@@ -172,19 +384,19 @@ func (_m *siteController) SetRadio(siteID string, state string) (*gen.SetRadioRe
 
 	var r0 *gen.SetRadioResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (*gen.SetRadioResponse, error)); ok {
-		return rf(siteID, state)
+	if rf, ok := ret.Get(0).(func(string, string, string) (*gen.SetRadioResponse, error)); ok {
+		return rf(siteID, state, requestedBy)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) *gen.SetRadioResponse); ok {
-		r0 = rf(siteID, state)
+	if rf, ok := ret.Get(0).(func(string, string, string) *gen.SetRadioResponse); ok {
+		r0 = rf(siteID, state, requestedBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gen.SetRadioResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(siteID, state)
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(siteID, state, requestedBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -192,9 +404,9 @@ func (_m *siteController) SetRadio(siteID string, state string) (*gen.SetRadioRe
 	return r0, r1
 }
 
-// SetService provides a mock function with given fields: siteID, state
-func (_m *siteController) SetService(siteID string, state string) (*gen.SetServiceResponse, error) {
-	ret := _m.Called(siteID, state)
+// SetService provides a mock function with given fields: siteID, state, requestedBy
+func (_m *siteController) SetService(siteID string, state string, requestedBy string) (*gen.SetServiceResponse, error) {
+	ret := _m.Called(siteID, state, requestedBy)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SetService")
@@ -202,19 +414,19 @@ func (_m *siteController) SetService(siteID string, state string) (*gen.SetServi
 
 	var r0 *gen.SetServiceResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (*gen.SetServiceResponse, error)); ok {
-		return rf(siteID, state)
+	if rf, ok := ret.Get(0).(func(string, string, string) (*gen.SetServiceResponse, error)); ok {
+		return rf(siteID, state, requestedBy)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) *gen.SetServiceResponse); ok {
-		r0 = rf(siteID, state)
+	if rf, ok := ret.Get(0).(func(string, string, string) *gen.SetServiceResponse); ok {
+		r0 = rf(siteID, state, requestedBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gen.SetServiceResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(siteID, state)
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(siteID, state, requestedBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -252,9 +464,9 @@ func (_m *siteController) SetSite(siteID string, state string, reason string, re
 	return r0, r1
 }
 
-// ToggleInternetSwitch provides a mock function with given fields: siteID, status, port
-func (_m *siteController) ToggleInternetSwitch(siteID string, status bool, port int32) (*gen.ToggleInternetSwitchResponse, error) {
-	ret := _m.Called(siteID, status, port)
+// ToggleInternetSwitch provides a mock function with given fields: siteID, status, port, requestedBy
+func (_m *siteController) ToggleInternetSwitch(siteID string, status bool, port int32, requestedBy string) (*gen.ToggleInternetSwitchResponse, error) {
+	ret := _m.Called(siteID, status, port, requestedBy)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ToggleInternetSwitch")
@@ -262,19 +474,19 @@ func (_m *siteController) ToggleInternetSwitch(siteID string, status bool, port
 
 	var r0 *gen.ToggleInternetSwitchResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, bool, int32) (*gen.ToggleInternetSwitchResponse, error)); ok {
-		return rf(siteID, status, port)
+	if rf, ok := ret.Get(0).(func(string, bool, int32, string) (*gen.ToggleInternetSwitchResponse, error)); ok {
+		return rf(siteID, status, port, requestedBy)
 	}
-	if rf, ok := ret.Get(0).(func(string, bool, int32) *gen.ToggleInternetSwitchResponse); ok {
-		r0 = rf(siteID, status, port)
+	if rf, ok := ret.Get(0).(func(string, bool, int32, string) *gen.ToggleInternetSwitchResponse); ok {
+		r0 = rf(siteID, status, port, requestedBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gen.ToggleInternetSwitchResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, bool, int32) error); ok {
-		r1 = rf(siteID, status, port)
+	if rf, ok := ret.Get(1).(func(string, bool, int32, string) error); ok {
+		r1 = rf(siteID, status, port, requestedBy)
 	} else {
 		r1 = ret.Error(1)
 	}
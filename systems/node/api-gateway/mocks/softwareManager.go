@@ -102,6 +102,36 @@ func (_m *softwareManager) ListSoftware(nodeId string, status string, appName st
 	return r0, r1
 }
 
+// PlanRollout provides a mock function with given fields: name, version, atype, nodeIds, budgetBytes
+func (_m *softwareManager) PlanRollout(name string, version string, atype string, nodeIds []string, budgetBytes uint64) (*gen.PlanRolloutResponse, error) {
+	ret := _m.Called(name, version, atype, nodeIds, budgetBytes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanRollout")
+	}
+
+	var r0 *gen.PlanRolloutResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, []string, uint64) (*gen.PlanRolloutResponse, error)); ok {
+		return rf(name, version, atype, nodeIds, budgetBytes)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, []string, uint64) *gen.PlanRolloutResponse); ok {
+		r0 = rf(name, version, atype, nodeIds, budgetBytes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PlanRolloutResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, []string, uint64) error); ok {
+		r1 = rf(name, version, atype, nodeIds, budgetBytes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PromoteRelease provides a mock function with given fields: name, version, atype
 func (_m *softwareManager) PromoteRelease(name string, version string, atype string) (*gen.PromoteReleaseResponse, error) {
 	ret := _m.Called(name, version, atype)
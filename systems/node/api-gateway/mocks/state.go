@@ -5,6 +5,8 @@ package mocks
 import (
 	mock "github.com/stretchr/testify/mock"
 	gen "github.com/ukama/ukama/systems/node/state/pb/gen"
+
+	time "time"
 )
 
 // state is an autogenerated mock type for the state type
@@ -42,6 +44,66 @@ func (_m *state) EnforeTransition(nodeId string, event string) (*gen.EnforceStat
 	return r0, r1
 }
 
+// GetNodeStateAnalytics provides a mock function with given fields: nodeId, from, to, flapWindowSec, flapThreshold
+func (_m *state) GetNodeStateAnalytics(nodeId string, from *time.Time, to *time.Time, flapWindowSec uint32, flapThreshold uint32) (*gen.GetNodeStateAnalyticsResponse, error) {
+	ret := _m.Called(nodeId, from, to, flapWindowSec, flapThreshold)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNodeStateAnalytics")
+	}
+
+	var r0 *gen.GetNodeStateAnalyticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *time.Time, *time.Time, uint32, uint32) (*gen.GetNodeStateAnalyticsResponse, error)); ok {
+		return rf(nodeId, from, to, flapWindowSec, flapThreshold)
+	}
+	if rf, ok := ret.Get(0).(func(string, *time.Time, *time.Time, uint32, uint32) *gen.GetNodeStateAnalyticsResponse); ok {
+		r0 = rf(nodeId, from, to, flapWindowSec, flapThreshold)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetNodeStateAnalyticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *time.Time, *time.Time, uint32, uint32) error); ok {
+		r1 = rf(nodeId, from, to, flapWindowSec, flapThreshold)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSiteStateAnalytics provides a mock function with given fields: siteId, from, to, flapWindowSec, flapThreshold
+func (_m *state) GetSiteStateAnalytics(siteId string, from *time.Time, to *time.Time, flapWindowSec uint32, flapThreshold uint32) (*gen.GetSiteStateAnalyticsResponse, error) {
+	ret := _m.Called(siteId, from, to, flapWindowSec, flapThreshold)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSiteStateAnalytics")
+	}
+
+	var r0 *gen.GetSiteStateAnalyticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *time.Time, *time.Time, uint32, uint32) (*gen.GetSiteStateAnalyticsResponse, error)); ok {
+		return rf(siteId, from, to, flapWindowSec, flapThreshold)
+	}
+	if rf, ok := ret.Get(0).(func(string, *time.Time, *time.Time, uint32, uint32) *gen.GetSiteStateAnalyticsResponse); ok {
+		r0 = rf(siteId, from, to, flapWindowSec, flapThreshold)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetSiteStateAnalyticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *time.Time, *time.Time, uint32, uint32) error); ok {
+		r1 = rf(siteId, from, to, flapWindowSec, flapThreshold)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStates provides a mock function with given fields: nodeId
 func (_m *state) GetStates(nodeId string) (*gen.GetStatesResponse, error) {
 	ret := _m.Called(nodeId)
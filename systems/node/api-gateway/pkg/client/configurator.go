@@ -81,3 +81,52 @@ func (c *Configurator) GetConfigVersion(nodeId string) (*pb.ConfigVersionRespons
 
 	return c.client.GetConfigVersion(ctx, &pb.ConfigVersionRequest{NodeId: nodeId})
 }
+
+func (c *Configurator) Promote(env string, ref string, requestedBy string) (*pb.PromoteResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.Promote(ctx, &pb.PromoteRequest{Environment: env, Ref: ref, RequestedBy: requestedBy})
+}
+
+func (c *Configurator) Rollback(env string, nodeId string, requestedBy string) (*pb.RollbackResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.Rollback(ctx, &pb.RollbackRequest{Environment: env, NodeId: nodeId, RequestedBy: requestedBy})
+}
+
+func (c *Configurator) SetNodeEnvironment(nodeId string, env string) (*pb.SetNodeEnvironmentResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.SetNodeEnvironment(ctx, &pb.SetNodeEnvironmentRequest{NodeId: nodeId, Environment: env})
+}
+
+func (c *Configurator) ListPromotions(env string) (*pb.ListPromotionsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.ListPromotions(ctx, &pb.ListPromotionsRequest{Environment: env})
+}
+
+func (c *Configurator) Validate(ref string) (*pb.ValidateResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.Validate(ctx, &pb.ValidateRequest{Ref: ref})
+}
+
+func (c *Configurator) GetDriftReport(nodeId string, driftedOnly bool) (*pb.DriftReportResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.GetDriftReport(ctx, &pb.DriftReportRequest{NodeId: nodeId, DriftedOnly: driftedOnly})
+}
+
+func (c *Configurator) PreviewConfig(nodeId string, ref string) (*pb.PreviewConfigResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.PreviewConfig(ctx, &pb.PreviewConfigRequest{NodeId: nodeId, Ref: ref})
+}
@@ -17,6 +17,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	pb "github.com/ukama/ukama/systems/node/controller/pb/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Controller struct {
@@ -58,32 +59,32 @@ func (c *Controller) Close() {
 	}
 }
 
-func (c *Controller) RestartNode(nodeId string) (*pb.RestartNodeResponse, error) {
+func (c *Controller) RestartNode(nodeId string, requestedBy string) (*pb.RestartNodeResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 
-	return c.client.RestartNode(ctx, &pb.RestartNodeRequest{NodeId: nodeId})
+	return c.client.RestartNode(ctx, &pb.RestartNodeRequest{NodeId: nodeId, RequestedBy: requestedBy})
 }
 
-func (c *Controller) ToggleSwitchPort(status bool, port int32, nodeId string) (*pb.ToggleSwitchPortResponse, error) {
+func (c *Controller) ToggleSwitchPort(status bool, port int32, nodeId string, requestedBy string) (*pb.ToggleSwitchPortResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 
-	return c.client.ToggleSwitchPort(ctx, &pb.ToggleSwitchPortRequest{Status: status, Port: port, NodeId: nodeId})
+	return c.client.ToggleSwitchPort(ctx, &pb.ToggleSwitchPortRequest{Status: status, Port: port, NodeId: nodeId, RequestedBy: requestedBy})
 }
 
-func (c *Controller) ToggleRadio(nodeId string, state string) (*pb.ToggleRadioResponse, error) {
+func (c *Controller) ToggleRadio(nodeId string, state string, requestedBy string) (*pb.ToggleRadioResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 
-	return c.client.ToggleRadio(ctx, &pb.ToggleRadioRequest{NodeId: nodeId, State: state})
+	return c.client.ToggleRadio(ctx, &pb.ToggleRadioRequest{NodeId: nodeId, State: state, RequestedBy: requestedBy})
 }
 
-func (c *Controller) ToggleService(nodeId string, state string) (*pb.ToggleServiceResponse, error) {
+func (c *Controller) ToggleService(nodeId string, state string, requestedBy string) (*pb.ToggleServiceResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 
-	return c.client.ToggleService(ctx, &pb.ToggleServiceRequest{NodeId: nodeId, State: state})
+	return c.client.ToggleService(ctx, &pb.ToggleServiceRequest{NodeId: nodeId, State: state, RequestedBy: requestedBy})
 }
 
 func (c *Controller) PingNode(nodeId string) (*pb.PingNodeResponse, error) {
@@ -92,3 +93,38 @@ func (c *Controller) PingNode(nodeId string) (*pb.PingNodeResponse, error) {
 
 	return c.client.PingNode(ctx, &pb.PingNodeRequest{NodeId: nodeId})
 }
+
+func (c *Controller) ListCommandAudit(nodeId, requestedBy, command string, from, to *time.Time, limit uint32) (*pb.ListCommandAuditResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	req := &pb.ListCommandAuditRequest{NodeId: nodeId, RequestedBy: requestedBy, Command: command, Limit: limit}
+	if from != nil {
+		req.From = timestamppb.New(*from)
+	}
+	if to != nil {
+		req.To = timestamppb.New(*to)
+	}
+	return c.client.ListCommandAudit(ctx, req)
+}
+
+func (c *Controller) RunCommandPlan(target *pb.PlanTarget, steps []*pb.PlanStep, abortOnFailure bool, requestedBy string) (*pb.RunCommandPlanResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.RunCommandPlan(ctx, &pb.RunCommandPlanRequest{Target: target, Steps: steps, AbortOnFailure: abortOnFailure, RequestedBy: requestedBy})
+}
+
+func (c *Controller) GetCommandPlan(planId string) (*pb.GetCommandPlanResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.GetCommandPlan(ctx, &pb.GetCommandPlanRequest{PlanId: planId})
+}
+
+func (c *Controller) CancelCommandPlan(planId, requestedBy string) (*pb.CancelCommandPlanResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.CancelCommandPlan(ctx, &pb.CancelCommandPlanRequest{PlanId: planId, RequestedBy: requestedBy})
+}
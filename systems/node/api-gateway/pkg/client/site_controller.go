@@ -8,6 +8,7 @@ import (
 	pb "github.com/ukama/ukama/systems/node/site-controller/pb/gen"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type SiteController struct {
@@ -34,16 +35,16 @@ func (s *SiteController) SetSite(siteID, state, reason, requestedBy string) (*pb
 	return s.client.SetSite(ctx, &pb.SetSiteRequest{SiteId: siteID, State: state, Reason: reason, RequestedBy: requestedBy})
 }
 
-func (s *SiteController) SetService(siteID, state string) (*pb.SetServiceResponse, error) {
+func (s *SiteController) SetService(siteID, state, requestedBy string) (*pb.SetServiceResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
 	defer cancel()
-	return s.client.SetService(ctx, &pb.SetServiceRequest{SiteId: siteID, State: state})
+	return s.client.SetService(ctx, &pb.SetServiceRequest{SiteId: siteID, State: state, RequestedBy: requestedBy})
 }
 
-func (s *SiteController) SetRadio(siteID, state string) (*pb.SetRadioResponse, error) {
+func (s *SiteController) SetRadio(siteID, state, requestedBy string) (*pb.SetRadioResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
 	defer cancel()
-	return s.client.SetRadio(ctx, &pb.SetRadioRequest{SiteId: siteID, State: state})
+	return s.client.SetRadio(ctx, &pb.SetRadioRequest{SiteId: siteID, State: state, RequestedBy: requestedBy})
 }
 
 func (s *SiteController) GetSiteState(siteID string) (*pb.GetSiteStateResponse, error) {
@@ -76,14 +77,70 @@ func (s *SiteController) PowerCycleNode(siteID, role, reason, requestedBy string
 	return s.client.PowerCycleNode(ctx, &pb.PowerCycleNodeRequest{SiteId: siteID, Role: role, Reason: reason, RequestedBy: requestedBy})
 }
 
-func (s *SiteController) RestartSite(siteID string) (*pb.RestartSiteResponse, error) {
+func (s *SiteController) RestartSite(siteID, requestedBy string) (*pb.RestartSiteResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
 	defer cancel()
-	return s.client.RestartSite(ctx, &pb.RestartSiteRequest{SiteId: siteID})
+	return s.client.RestartSite(ctx, &pb.RestartSiteRequest{SiteId: siteID, RequestedBy: requestedBy})
 }
 
-func (s *SiteController) ToggleInternetSwitch(siteID string, status bool, port int32) (*pb.ToggleInternetSwitchResponse, error) {
+func (s *SiteController) ToggleInternetSwitch(siteID string, status bool, port int32, requestedBy string) (*pb.ToggleInternetSwitchResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
 	defer cancel()
-	return s.client.ToggleInternetSwitch(ctx, &pb.ToggleInternetSwitchRequest{SiteId: siteID, Status: status, Port: port})
+	return s.client.ToggleInternetSwitch(ctx, &pb.ToggleInternetSwitchRequest{SiteId: siteID, Status: status, Port: port, RequestedBy: requestedBy})
+}
+
+func (s *SiteController) SetPowerPolicy(siteID string, policy *pb.PowerPolicy) (*pb.SetPowerPolicyResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	return s.client.SetPowerPolicy(ctx, &pb.SetPowerPolicyRequest{SiteId: siteID, Policy: policy})
+}
+
+func (s *SiteController) GetPowerPolicy(siteID string) (*pb.GetPowerPolicyResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	return s.client.GetPowerPolicy(ctx, &pb.GetPowerPolicyRequest{SiteId: siteID})
+}
+
+func (s *SiteController) AddSchedule(siteID string, schedule *pb.Schedule) (*pb.AddScheduleResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	return s.client.AddSchedule(ctx, &pb.AddScheduleRequest{SiteId: siteID, Schedule: schedule})
+}
+
+func (s *SiteController) ListSchedules(siteID string) (*pb.ListSchedulesResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	return s.client.ListSchedules(ctx, &pb.ListSchedulesRequest{SiteId: siteID})
+}
+
+func (s *SiteController) DeleteSchedule(siteID string, scheduleID string) (*pb.DeleteScheduleResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	return s.client.DeleteSchedule(ctx, &pb.DeleteScheduleRequest{SiteId: siteID, ScheduleId: scheduleID})
+}
+
+func (s *SiteController) ListScheduleTransitions(siteID string, from, to *time.Time, limit uint32) (*pb.ListScheduleTransitionsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	req := &pb.ListScheduleTransitionsRequest{SiteId: siteID, Limit: limit}
+	if from != nil {
+		req.From = timestamppb.New(*from)
+	}
+	if to != nil {
+		req.To = timestamppb.New(*to)
+	}
+	return s.client.ListScheduleTransitions(ctx, req)
+}
+
+func (s *SiteController) ListAuditLog(siteID, nodeID, requestedBy, command string, from, to *time.Time, limit uint32) (*pb.ListAuditLogResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	req := &pb.ListAuditLogRequest{SiteId: siteID, NodeId: nodeID, RequestedBy: requestedBy, Command: command, Limit: limit}
+	if from != nil {
+		req.From = timestamppb.New(*from)
+	}
+	if to != nil {
+		req.To = timestamppb.New(*to)
+	}
+	return s.client.ListAuditLog(ctx, req)
 }
@@ -98,3 +98,10 @@ func (s *SoftwareManager) GetReleaseCatalog(name string, atype string) (*pb.GetR
 	return s.client.GetReleaseCatalog(ctx, &pb.GetReleaseCatalogRequest{
 		Name: name, Type: atype})
 }
+
+func (s *SoftwareManager) PlanRollout(name string, version string, atype string, nodeIds []string, budgetBytes uint64) (*pb.PlanRolloutResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	return s.client.PlanRollout(ctx, &pb.PlanRolloutRequest{
+		Name: name, Version: version, Type: atype, NodeIds: nodeIds, BudgetBytes: budgetBytes})
+}
@@ -17,6 +17,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	pb "github.com/ukama/ukama/systems/node/state/pb/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type State struct {
@@ -88,3 +89,36 @@ func (s *State) EnforeTransition(nodeId string, event string) (*pb.EnforceStateT
 		Event:  event,
 	})
 }
+
+func (s *State) GetNodeStateAnalytics(nodeId string, from, to *time.Time, flapWindowSec, flapThreshold uint32) (*pb.GetNodeStateAnalyticsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	return s.client.GetNodeStateAnalytics(ctx, &pb.GetNodeStateAnalyticsRequest{
+		NodeId:        nodeId,
+		From:          optionalTimestamp(from),
+		To:            optionalTimestamp(to),
+		FlapWindowSec: flapWindowSec,
+		FlapThreshold: flapThreshold,
+	})
+}
+
+func (s *State) GetSiteStateAnalytics(siteId string, from, to *time.Time, flapWindowSec, flapThreshold uint32) (*pb.GetSiteStateAnalyticsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	return s.client.GetSiteStateAnalytics(ctx, &pb.GetSiteStateAnalyticsRequest{
+		SiteId:        siteId,
+		From:          optionalTimestamp(from),
+		To:            optionalTimestamp(to),
+		FlapWindowSec: flapWindowSec,
+		FlapThreshold: flapThreshold,
+	})
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -8,24 +8,69 @@
 
 package rest
 
+import "time"
+
 type PingNodeRequest struct {
 	NodeId string `json:"node_id" validate:"required" example:"{{NodeId}}" path:"node_id"`
 }
 
 type RestartNodeRequest struct {
-	NodeId string `json:"node_id" validate:"required" example:"{{NodeId}}" path:"node_id"`
+	NodeId      string `json:"node_id" validate:"required" example:"{{NodeId}}" path:"node_id"`
+	RequestedBy string `json:"requestedBy"`
 }
 
 type ToggleSwitchPortRequest struct {
-	NodeId string `json:"node_id" validate:"required" example:"{{NodeId}}" path:"node_id"`
-	Status bool   `json:"status"`
-	Port   int32  `json:"port" validate:"required"`
+	NodeId      string `json:"node_id" validate:"required" example:"{{NodeId}}" path:"node_id"`
+	Status      bool   `json:"status"`
+	Port        int32  `json:"port" validate:"required"`
+	RequestedBy string `json:"requestedBy"`
 }
 
 type ToggleStateRequest struct {
-	NodeId string `json:"node_id" validate:"required" example:"{{NodeId}}" path:"node_id"`
-	State  string `json:"state" path:"state" validate:"required,oneof=on off"`
+	NodeId      string `json:"node_id" validate:"required" example:"{{NodeId}}" path:"node_id"`
+	State       string `json:"state" path:"state" validate:"required,oneof=on off"`
+	RequestedBy string `json:"requestedBy"`
+}
+
+type CommandAuditRequest struct {
+	NodeId      string `json:"node_id" query:"node_id"`
+	RequestedBy string `json:"requested_by" query:"requested_by"`
+	Command     string `json:"command" query:"command"`
+	From        string `json:"from" query:"from"` // RFC3339
+	To          string `json:"to" query:"to"`     // RFC3339
+	Limit       uint32 `json:"limit" query:"limit"`
+}
+
+// CommandPlanStep mirrors a controller plan step; steps run in order.
+type CommandPlanStep struct {
+	Name               string   `json:"name"`
+	Action             string   `json:"action" validate:"required,oneof=RESTART RADIO SERVICE"`
+	NodeTypes          []string `json:"node_types" example:"anode"`
+	State              string   `json:"state"` // on/off for RADIO and SERVICE
+	Parallelism        uint32   `json:"parallelism"`
+	WaitOnline         bool     `json:"wait_online"`
+	WaitTimeoutSeconds uint32   `json:"wait_timeout_seconds"`
+}
+
+type RunCommandPlanRequest struct {
+	SiteId         string            `json:"site_id"`
+	NetworkId      string            `json:"network_id"`
+	NodeIds        []string          `json:"node_ids"`
+	NodeTypes      []string          `json:"node_types"`
+	Steps          []CommandPlanStep `json:"steps"` // empty reboots the target in dependency order
+	AbortOnFailure bool              `json:"abort_on_failure"`
+	RequestedBy    string            `json:"requested_by"`
+}
+
+type CommandPlanRequest struct {
+	PlanId string `json:"plan_id" validate:"required" path:"plan_id"`
 }
+
+type CancelCommandPlanRequest struct {
+	PlanId      string `json:"plan_id" validate:"required" path:"plan_id"`
+	RequestedBy string `json:"requested_by"`
+}
+
 type GetStatesRequest struct {
 	NodeId string `json:"node_id" validate:"required" example:"{{NodeId}}" path:"node_id"`
 }
@@ -37,6 +82,22 @@ type GetStatesHistoryRequest struct {
 	StartTime  string `json:"start_time" query:"start_time"`
 	EndTime    string `json:"end_time" query:"end_time"`
 }
+type StateAnalyticsRequest struct {
+	NodeId        string `json:"node_id" validate:"required" example:"{{NodeId}}" path:"node_id"`
+	From          string `json:"from" query:"from"` // RFC3339, defaults to 7 days before to
+	To            string `json:"to" query:"to"`     // RFC3339, defaults to now
+	FlapWindowSec uint32 `json:"flap_window_sec" query:"flap_window_sec"`
+	FlapThreshold uint32 `json:"flap_threshold" query:"flap_threshold"`
+}
+
+type SiteStateAnalyticsRequest struct {
+	SiteId        string `json:"site_id" validate:"required" path:"site_id"`
+	From          string `json:"from" query:"from"` // RFC3339, defaults to 7 days before to
+	To            string `json:"to" query:"to"`     // RFC3339, defaults to now
+	FlapWindowSec uint32 `json:"flap_window_sec" query:"flap_window_sec"`
+	FlapThreshold uint32 `json:"flap_threshold" query:"flap_threshold"`
+}
+
 type EnforceStateTransitionRequest struct {
 	NodeId string `json:"node_id" validate:"required" example:"{{NodeId}}" path:"node_id"`
 	Event  string `json:"event" validate:"required" example:"{{Event}}" path:"event"`
@@ -49,6 +110,45 @@ type GetConfigVersionRequest struct {
 	NodeId string `json:"node_id" path:"node_id" validate:"required"`
 }
 
+type ValidateConfigRequest struct {
+	Ref string `json:"ref" query:"ref" example:"main" validate:"required"`
+}
+
+type PreviewConfigRequest struct {
+	NodeId string `json:"node_id" path:"node_id" validate:"required"`
+	Ref    string `json:"ref" query:"ref" example:"main"`
+}
+
+type GetDriftReportRequest struct {
+	NodeId      string `json:"node_id" query:"node_id"`
+	DriftedOnly bool   `json:"drifted_only" query:"drifted_only"`
+}
+
+type PromoteConfigRequest struct {
+	Environment string `json:"environment" path:"environment" example:"staging" validate:"required"`
+	Ref         string `json:"ref" example:"release-1.2" validate:"required"`
+	RequestedBy string `json:"requested_by"`
+}
+
+type RollbackEnvironmentRequest struct {
+	Environment string `json:"environment" path:"environment" example:"production" validate:"required"`
+	RequestedBy string `json:"requested_by"`
+}
+
+type RollbackNodeRequest struct {
+	NodeId      string `json:"node_id" path:"node_id" validate:"required"`
+	RequestedBy string `json:"requested_by"`
+}
+
+type SetNodeEnvironmentRequest struct {
+	NodeId      string `json:"node_id" path:"node_id" validate:"required"`
+	Environment string `json:"environment" example:"staging" validate:"required"`
+}
+
+type ListPromotionsRequest struct {
+	Environment string `json:"environment" path:"environment" validate:"required"`
+}
+
 type UpdateSoftwareRequest struct {
 	Name   string `json:"name" validate:"required" path:"name"`
 	Tag    string `json:"tag" validate:"required" path:"tag"`
@@ -63,6 +163,14 @@ type PromoteReleaseRequest struct {
 	Type    string `json:"type" query:"type"`
 }
 
+type PlanRolloutRequest struct {
+	Name        string `json:"name" validate:"required" path:"name"`
+	Version     string `json:"version" query:"version"`
+	Type        string `json:"type" query:"type"`
+	NodeIds     string `json:"node_ids" query:"node_ids"`
+	BudgetBytes uint64 `json:"budget_bytes" query:"budget_bytes"`
+}
+
 type GetReleaseCatalogRequest struct {
 	Name string `json:"name" query:"name"`
 	Type string `json:"type" query:"type"`
@@ -81,8 +189,14 @@ type SiteActionRequest struct {
 }
 
 type SiteToggleActionRequest struct {
-	SiteId string `json:"site_id" validate:"required" path:"site_id"`
-	State  string `json:"state" path:"state" validate:"required,oneof=on off"`
+	SiteId      string `json:"site_id" validate:"required" path:"site_id"`
+	State       string `json:"state" path:"state" validate:"required,oneof=on off"`
+	RequestedBy string `json:"requestedBy"`
+}
+
+type RestartSiteRequest struct {
+	SiteId      string `json:"site_id" validate:"required" path:"site_id"`
+	RequestedBy string `json:"requestedBy"`
 }
 
 type SiteStateRequest struct {
@@ -111,8 +225,59 @@ type PowerCycleNodeRequest struct {
 	RequestedBy string `json:"requestedBy"`
 }
 
-type ToggleInternetSwitchRequest struct {
+type SitePowerPolicyRequest struct {
+	SiteId                string  `json:"site_id" validate:"required" path:"site_id"`
+	Enabled               bool    `json:"enabled"`
+	BatteryCapacityAh     float64 `json:"battery_capacity_ah"`
+	ShedPortsSocPct       int32   `json:"shed_ports_soc_pct"`
+	ShedPortsRuntimeMin   int32   `json:"shed_ports_runtime_min"`
+	ReduceRadioSocPct     int32   `json:"reduce_radio_soc_pct"`
+	ReduceRadioRuntimeMin int32   `json:"reduce_radio_runtime_min"`
+	ReducedRadioPowerPct  int32   `json:"reduced_radio_power_pct"`
+	RadioOffSocPct        int32   `json:"radio_off_soc_pct"`
+	RadioOffRuntimeMin    int32   `json:"radio_off_runtime_min"`
+	RestoreMarginPct      int32   `json:"restore_margin_pct"`
+}
+
+type SiteScheduleRequest struct {
+	SiteId      string     `json:"site_id" validate:"required" path:"site_id"`
+	Name        string     `json:"name"`
+	Target      string     `json:"target" validate:"required,oneof=service radio"`
+	State       string     `json:"state" validate:"required,oneof=on off"`
+	Cron        string     `json:"cron" example:"0 1 * * *"`
+	DurationSec int64      `json:"duration_sec"`
+	StartAt     *time.Time `json:"start_at"`
+	EndAt       *time.Time `json:"end_at"`
+	Timezone    string     `json:"timezone" example:"Africa/Kampala"`
+	Priority    int32      `json:"priority"`
+	CreatedBy   string     `json:"created_by"`
+}
+
+type DeleteSiteScheduleRequest struct {
+	SiteId     string `json:"site_id" validate:"required" path:"site_id"`
+	ScheduleId string `json:"schedule_id" validate:"required" path:"schedule_id"`
+}
+
+type SiteScheduleTransitionsRequest struct {
 	SiteId string `json:"site_id" validate:"required" path:"site_id"`
-	Status bool   `json:"status"`
-	Port   int32  `json:"port" validate:"required"`
+	From   string `json:"from" query:"from"` // RFC3339, defaults to now
+	To     string `json:"to" query:"to"`     // RFC3339, defaults to from + 7 days
+	Limit  uint32 `json:"limit" query:"limit"`
+}
+
+type ToggleInternetSwitchRequest struct {
+	SiteId      string `json:"site_id" validate:"required" path:"site_id"`
+	Status      bool   `json:"status"`
+	Port        int32  `json:"port" validate:"required"`
+	RequestedBy string `json:"requestedBy"`
+}
+
+type SiteAuditLogRequest struct {
+	SiteId      string `json:"site_id" query:"site_id"`
+	NodeId      string `json:"node_id" query:"node_id"`
+	RequestedBy string `json:"requested_by" query:"requested_by"`
+	Command     string `json:"command" query:"command"`
+	From        string `json:"from" query:"from"` // RFC3339
+	To          string `json:"to" query:"to"`     // RFC3339
+	Limit       uint32 `json:"limit" query:"limit"`
 }
@@ -13,6 +13,8 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/loopfz/gadgeto/tonic"
@@ -31,6 +33,7 @@ import (
 	sitepb "github.com/ukama/ukama/systems/node/site-controller/pb/gen"
 	spb "github.com/ukama/ukama/systems/node/software/pb/gen"
 	nspb "github.com/ukama/ukama/systems/node/state/pb/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Router struct {
@@ -61,32 +64,52 @@ type state interface {
 	GetStates(nodeId string) (*nspb.GetStatesResponse, error)
 	GetStatesHistory(nodeId string, pageSize int32, pageNumber int32, startTime, endTime string) (*nspb.GetStatesHistoryResponse, error)
 	EnforeTransition(nodeId string, event string) (*nspb.EnforceStateTransitionResponse, error)
+	GetNodeStateAnalytics(nodeId string, from, to *time.Time, flapWindowSec, flapThreshold uint32) (*nspb.GetNodeStateAnalyticsResponse, error)
+	GetSiteStateAnalytics(siteId string, from, to *time.Time, flapWindowSec, flapThreshold uint32) (*nspb.GetSiteStateAnalyticsResponse, error)
 }
 type controller interface {
-	RestartNode(nodeId string) (*contPb.RestartNodeResponse, error)
-	ToggleSwitchPort(status bool, port int32, nodeId string) (*contPb.ToggleSwitchPortResponse, error)
+	RestartNode(nodeId string, requestedBy string) (*contPb.RestartNodeResponse, error)
+	ToggleSwitchPort(status bool, port int32, nodeId string, requestedBy string) (*contPb.ToggleSwitchPortResponse, error)
 	PingNode(nodeId string) (*contPb.PingNodeResponse, error)
-	ToggleRadio(nodeId string, state string) (*contPb.ToggleRadioResponse, error)
-	ToggleService(nodeId string, state string) (*contPb.ToggleServiceResponse, error)
+	ToggleRadio(nodeId string, state string, requestedBy string) (*contPb.ToggleRadioResponse, error)
+	ToggleService(nodeId string, state string, requestedBy string) (*contPb.ToggleServiceResponse, error)
+	ListCommandAudit(nodeId, requestedBy, command string, from, to *time.Time, limit uint32) (*contPb.ListCommandAuditResponse, error)
+	RunCommandPlan(target *contPb.PlanTarget, steps []*contPb.PlanStep, abortOnFailure bool, requestedBy string) (*contPb.RunCommandPlanResponse, error)
+	GetCommandPlan(planId string) (*contPb.GetCommandPlanResponse, error)
+	CancelCommandPlan(planId, requestedBy string) (*contPb.CancelCommandPlanResponse, error)
 }
 
 type siteController interface {
 	SetSite(siteID, state, reason, requestedBy string) (*sitepb.SetSiteResponse, error)
-	SetService(siteID, state string) (*sitepb.SetServiceResponse, error)
-	SetRadio(siteID, state string) (*sitepb.SetRadioResponse, error)
+	SetService(siteID, state, requestedBy string) (*sitepb.SetServiceResponse, error)
+	SetRadio(siteID, state, requestedBy string) (*sitepb.SetRadioResponse, error)
 	GetSiteState(siteID string) (*sitepb.GetSiteStateResponse, error)
 	UpsertPortMap(siteID, cnodeID string, ports []*sitepb.PortMapEntry) (*sitepb.UpsertPortMapResponse, error)
 	GetPortMap(siteID string) (*sitepb.GetPortMapResponse, error)
 	ApplySwitchPolicy(siteID string) (*sitepb.ApplySwitchPolicyResponse, error)
 	PowerCycleNode(siteID, role, reason, requestedBy string) (*sitepb.PowerCycleNodeResponse, error)
-	RestartSite(siteID string) (*sitepb.RestartSiteResponse, error)
-	ToggleInternetSwitch(siteID string, status bool, port int32) (*sitepb.ToggleInternetSwitchResponse, error)
+	RestartSite(siteID, requestedBy string) (*sitepb.RestartSiteResponse, error)
+	ToggleInternetSwitch(siteID string, status bool, port int32, requestedBy string) (*sitepb.ToggleInternetSwitchResponse, error)
+	SetPowerPolicy(siteID string, policy *sitepb.PowerPolicy) (*sitepb.SetPowerPolicyResponse, error)
+	GetPowerPolicy(siteID string) (*sitepb.GetPowerPolicyResponse, error)
+	AddSchedule(siteID string, schedule *sitepb.Schedule) (*sitepb.AddScheduleResponse, error)
+	ListSchedules(siteID string) (*sitepb.ListSchedulesResponse, error)
+	DeleteSchedule(siteID string, scheduleID string) (*sitepb.DeleteScheduleResponse, error)
+	ListScheduleTransitions(siteID string, from, to *time.Time, limit uint32) (*sitepb.ListScheduleTransitionsResponse, error)
+	ListAuditLog(siteID, nodeID, requestedBy, command string, from, to *time.Time, limit uint32) (*sitepb.ListAuditLogResponse, error)
 }
 
 type configurator interface {
 	ConfigEvent(b []byte) (*cfgPb.ConfigStoreEventResponse, error)
 	ApplyConfig(commit string) (*cfgPb.ApplyConfigResponse, error)
 	GetConfigVersion(nodeId string) (*cfgPb.ConfigVersionResponse, error)
+	Promote(env string, ref string, requestedBy string) (*cfgPb.PromoteResponse, error)
+	Rollback(env string, nodeId string, requestedBy string) (*cfgPb.RollbackResponse, error)
+	SetNodeEnvironment(nodeId string, env string) (*cfgPb.SetNodeEnvironmentResponse, error)
+	ListPromotions(env string) (*cfgPb.ListPromotionsResponse, error)
+	Validate(ref string) (*cfgPb.ValidateResponse, error)
+	GetDriftReport(nodeId string, driftedOnly bool) (*cfgPb.DriftReportResponse, error)
+	PreviewConfig(nodeId string, ref string) (*cfgPb.PreviewConfigResponse, error)
 }
 
 type softwareManager interface {
@@ -95,6 +118,7 @@ type softwareManager interface {
 	UpdateSoftware(nodeId string, name string, tag string) (*spb.UpdateSoftwareResponse, error)
 	PromoteRelease(name string, version string, atype string) (*spb.PromoteReleaseResponse, error)
 	GetReleaseCatalog(name string, atype string) (*spb.GetReleaseCatalogResponse, error)
+	PlanRollout(name string, version string, atype string, nodeIds []string, budgetBytes uint64) (*spb.PlanRolloutResponse, error)
 }
 
 func NewClientsSet(endpoints *pkg.GrpcEndpoints) *Clients {
@@ -181,9 +205,14 @@ func (r *Router) init(f func(*gin.Context, string) error) {
 		controller.POST("/nodes/:node_id/radio/:state", formatDoc("Toggle radio", "Toggle radio"), tonic.Handler(r.postToggleNodeRadioHandler, http.StatusOK))
 		controller.POST("/nodes/:node_id/service/:state", formatDoc("Toggle service", "Toggle service"), tonic.Handler(r.postToggleNodeServiceHandler, http.StatusOK))
 		controller.GET("/nodes/:node_id/ping", formatDoc("Ping a node", "Ping a node"), tonic.Handler(r.getPingNodeHandler, http.StatusAccepted))
+		controller.GET("/audit", formatDoc("List command audit", "List node commands with who requested them and the outcome"), tonic.Handler(r.getCommandAuditHandler, http.StatusOK))
+		controller.POST("/plans", formatDoc("Run command plan", "Run an ordered multi-node command plan on a site, network or set of nodes as one operation"), tonic.Handler(r.postCommandPlanHandler, http.StatusAccepted))
+		controller.GET("/plans/:plan_id", formatDoc("Get command plan", "Get the progress of a command plan per step and node"), tonic.Handler(r.getCommandPlanHandler, http.StatusOK))
+		controller.POST("/plans/:plan_id/cancel", formatDoc("Cancel command plan", "Stop a command plan from starting further node actions"), tonic.Handler(r.postCancelCommandPlanHandler, http.StatusOK))
 
 		const sites = "/sites"
 		siteS := auth.Group(sites, "Site Controller", "Operations on sites")
+		siteS.GET("/audit", formatDoc("List site audit log", "List site intents, commands and reconcile outcomes"), tonic.Handler(r.getSiteAuditLogHandler, http.StatusOK))
 		siteS.POST("/:site_id/:state", formatDoc("Turn site on/off", "Make site customer-serving/non-serving"), tonic.Handler(r.toggleSiteStateHandler, http.StatusOK))
 		siteS.POST("/:site_id/service/:state", formatDoc("Turn site service on/off", "Start/Stop digital cellular service"), tonic.Handler(r.postToggleServiceHandler, http.StatusOK))
 		siteS.POST("/:site_id/radio/:state", formatDoc("Turn site radio on/off", "Enable/Disable RF chain"), tonic.Handler(r.postToggleRadioHandler, http.StatusOK))
@@ -193,6 +222,12 @@ func (r *Router) init(f func(*gin.Context, string) error) {
 		siteS.POST("/:site_id/switch-policy", formatDoc("Apply switch policy", "Generate and push switch.d policy"), tonic.Handler(r.postApplySwitchPolicyHandler, http.StatusOK))
 		siteS.POST("/:site_id/nodes/:role/power-cycle", formatDoc("Power-cycle site node", "Power-cycle a site node through CNode switch.d"), tonic.Handler(r.postPowerCycleNodeHandler, http.StatusOK))
 		siteS.POST("/:site_id/internet-port", formatDoc("Toggle site internet port", "Turn the site internet switch port on/off"), tonic.Handler(r.postToggleInternetSwitchHandler, http.StatusOK))
+		siteS.GET("/:site_id/power-policy", formatDoc("Get site power policy", "Get load-shedding thresholds and the level in effect"), tonic.Handler(r.getSitePowerPolicyHandler, http.StatusOK))
+		siteS.PUT("/:site_id/power-policy", formatDoc("Update site power policy", "Update load-shedding thresholds on battery charge and runtime"), tonic.Handler(r.putSitePowerPolicyHandler, http.StatusOK))
+		siteS.GET("/:site_id/schedules", formatDoc("List site schedules", "List service/radio schedules of the site"), tonic.Handler(r.getSiteSchedulesHandler, http.StatusOK))
+		siteS.POST("/:site_id/schedules", formatDoc("Add site schedule", "Add a cron or one-off service/radio schedule"), tonic.Handler(r.postSiteScheduleHandler, http.StatusCreated))
+		siteS.DELETE("/:site_id/schedules/:schedule_id", formatDoc("Delete site schedule", "Delete a schedule, ending its running window"), tonic.Handler(r.deleteSiteScheduleHandler, http.StatusOK))
+		siteS.GET("/:site_id/schedules/transitions", formatDoc("List schedule transitions", "List upcoming schedule window starts and ends"), tonic.Handler(r.getSiteScheduleTransitionsHandler, http.StatusOK))
 		siteS.POST("/:site_id/restart", formatDoc("Restart site", "Restart the site"), tonic.Handler(r.postRestartSiteHandler, http.StatusOK))
 
 		const cfg = "/configurator"
@@ -200,12 +235,21 @@ func (r *Router) init(f func(*gin.Context, string) error) {
 		cfgS.POST("/config", formatDoc("Event in config store", "push event has happened in config store"), tonic.Handler(r.postConfigEventHandler, http.StatusAccepted))
 		cfgS.POST("/config/apply/:commit", formatDoc("Apply config version ", "Updated nodes to version"), tonic.Handler(r.postConfigApplyVersionHandler, http.StatusAccepted))
 		cfgS.GET("/config/node/:node_id", formatDoc("Current ruunning config", "Read the cuurrent running version and status"), tonic.Handler(r.getRunningConfigVersionHandler, http.StatusOK))
+		cfgS.GET("/config/validate", formatDoc("Validate config", "Validate configs of a config store branch, tag or commit against their schemas"), tonic.Handler(r.getValidateConfigHandler, http.StatusOK))
+		cfgS.GET("/config/node/:node_id/preview", formatDoc("Preview node config", "Render config templates of a node at a branch, tag or commit"), tonic.Handler(r.getPreviewConfigHandler, http.StatusOK))
+		cfgS.GET("/drift", formatDoc("Config drift report", "Compare configs reported by nodes with the expected ones"), tonic.Handler(r.getDriftReportHandler, http.StatusOK))
+		cfgS.POST("/environments/:environment/promote", formatDoc("Promote config", "Promote a config store branch, tag or commit to an environment"), tonic.Handler(r.postPromoteConfigHandler, http.StatusOK))
+		cfgS.POST("/environments/:environment/rollback", formatDoc("Rollback config", "Roll back every node of an environment to its previous promotion"), tonic.Handler(r.postRollbackEnvironmentHandler, http.StatusOK))
+		cfgS.GET("/environments/:environment/promotions", formatDoc("List promotions", "List promotions and rollbacks of an environment"), tonic.Handler(r.getPromotionsHandler, http.StatusOK))
+		cfgS.POST("/config/node/:node_id/rollback", formatDoc("Rollback node config", "Roll back a node to its last known-good config"), tonic.Handler(r.postRollbackNodeHandler, http.StatusOK))
+		cfgS.PUT("/config/node/:node_id/environment", formatDoc("Set node environment", "Assign a node to an environment"), tonic.Handler(r.putNodeEnvironmentHandler, http.StatusOK))
 
 		const soft = "/software"
 		softS := auth.Group(soft, "Software manager", "Operations on software")
 		softS.GET("/apps", formatDoc("List apps", "List apps"), tonic.Handler(r.getListAppsHandler, http.StatusOK))
 		softS.GET("", formatDoc("List software", "List software"), tonic.Handler(r.getListSoftwareHandler, http.StatusOK))
 		softS.GET("/releases", formatDoc("Release catalog", "List published releases and the desired version per app"), tonic.Handler(r.getReleaseCatalogHandler, http.StatusOK))
+		softS.GET("/rollout/:name", formatDoc("Plan rollout", "Order lagging nodes by download size and mark those fitting a byte budget"), tonic.Handler(r.getPlanRolloutHandler, http.StatusOK))
 		softS.POST("/update/:name/:tag/:node_id", formatDoc("Update software", "Update software"), tonic.Handler(r.postUpdateSoftwareHandler, http.StatusOK))
 		softS.POST("/promote/:name/:version", formatDoc("Promote release", "Mark a version already in the Hub as the desired release"), tonic.Handler(r.postPromoteReleaseHandler, http.StatusOK))
 
@@ -213,6 +257,8 @@ func (r *Router) init(f func(*gin.Context, string) error) {
 		stateS := auth.Group(state, "State", "Operations on state")
 		stateS.POST("/:node_id", formatDoc("Get states", "Get states"), tonic.Handler(r.getStatesHandler, http.StatusOK))
 		stateS.GET("/:node_id/history", formatDoc("Get state history", "Get state history"), tonic.Handler(r.getStatesHistoryHandler, http.StatusOK))
+		stateS.GET("/:node_id/analytics", formatDoc("Get node state analytics", "Time in state, MTBF, MTTR and flapping of a node over a time range"), tonic.Handler(r.getNodeStateAnalyticsHandler, http.StatusOK))
+		stateS.GET("/sites/:site_id/analytics", formatDoc("Get site state analytics", "Time in state, MTBF, MTTR and flapping of the nodes of a site over a time range"), tonic.Handler(r.getSiteStateAnalyticsHandler, http.StatusOK))
 		stateS.POST("/:node_id/enforce/:event", formatDoc("Enforce state transition", "Enforce state transition"), tonic.Handler(r.enforceStateTransitionHandler, http.StatusOK))
 
 	}
@@ -223,19 +269,62 @@ func (r *Router) getPingNodeHandler(c *gin.Context, req *PingNodeRequest) (*cont
 }
 
 func (r *Router) postRestartNodeHandler(c *gin.Context, req *RestartNodeRequest) (*contPb.RestartNodeResponse, error) {
-	return r.clients.Controller.RestartNode(req.NodeId)
+	return r.clients.Controller.RestartNode(req.NodeId, req.RequestedBy)
 }
 
 func (r *Router) postToggleSwitchPortHandler(c *gin.Context, req *ToggleSwitchPortRequest) (*contPb.ToggleSwitchPortResponse, error) {
-	return r.clients.Controller.ToggleSwitchPort(req.Status, req.Port, req.NodeId)
+	return r.clients.Controller.ToggleSwitchPort(req.Status, req.Port, req.NodeId, req.RequestedBy)
 }
 
 func (r *Router) postToggleNodeRadioHandler(c *gin.Context, req *ToggleStateRequest) (*contPb.ToggleRadioResponse, error) {
-	return r.clients.Controller.ToggleRadio(req.NodeId, req.State)
+	return r.clients.Controller.ToggleRadio(req.NodeId, req.State, req.RequestedBy)
 }
 
 func (r *Router) postToggleNodeServiceHandler(c *gin.Context, req *ToggleStateRequest) (*contPb.ToggleServiceResponse, error) {
-	return r.clients.Controller.ToggleService(req.NodeId, req.State)
+	return r.clients.Controller.ToggleService(req.NodeId, req.State, req.RequestedBy)
+}
+
+func (r *Router) getCommandAuditHandler(c *gin.Context, req *CommandAuditRequest) (*contPb.ListCommandAuditResponse, error) {
+	from, err := parseOptionalTime("from", req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseOptionalTime("to", req.To)
+	if err != nil {
+		return nil, err
+	}
+	return r.clients.Controller.ListCommandAudit(req.NodeId, req.RequestedBy, req.Command, from, to, req.Limit)
+}
+
+func (r *Router) postCommandPlanHandler(c *gin.Context, req *RunCommandPlanRequest) (*contPb.RunCommandPlanResponse, error) {
+	target := &contPb.PlanTarget{
+		SiteId:    req.SiteId,
+		NetworkId: req.NetworkId,
+		NodeIds:   req.NodeIds,
+		NodeTypes: req.NodeTypes,
+	}
+
+	steps := make([]*contPb.PlanStep, 0, len(req.Steps))
+	for _, s := range req.Steps {
+		steps = append(steps, &contPb.PlanStep{
+			Name:               s.Name,
+			Action:             s.Action,
+			NodeTypes:          s.NodeTypes,
+			State:              s.State,
+			Parallelism:        s.Parallelism,
+			WaitOnline:         s.WaitOnline,
+			WaitTimeoutSeconds: s.WaitTimeoutSeconds,
+		})
+	}
+	return r.clients.Controller.RunCommandPlan(target, steps, req.AbortOnFailure, req.RequestedBy)
+}
+
+func (r *Router) getCommandPlanHandler(c *gin.Context, req *CommandPlanRequest) (*contPb.GetCommandPlanResponse, error) {
+	return r.clients.Controller.GetCommandPlan(req.PlanId)
+}
+
+func (r *Router) postCancelCommandPlanHandler(c *gin.Context, req *CancelCommandPlanRequest) (*contPb.CancelCommandPlanResponse, error) {
+	return r.clients.Controller.CancelCommandPlan(req.PlanId, req.RequestedBy)
 }
 
 func (r *Router) getListAppsHandler(c *gin.Context, req *ListAppsRequest) (*spb.GetAppListResponse, error) {
@@ -254,6 +343,15 @@ func (r *Router) postPromoteReleaseHandler(c *gin.Context, req *PromoteReleaseRe
 	return r.clients.SoftwareManager.PromoteRelease(req.Name, req.Version, req.Type)
 }
 
+func (r *Router) getPlanRolloutHandler(c *gin.Context, req *PlanRolloutRequest) (*spb.PlanRolloutResponse, error) {
+	var nodeIds []string
+	if req.NodeIds != "" {
+		nodeIds = strings.Split(req.NodeIds, ",")
+	}
+
+	return r.clients.SoftwareManager.PlanRollout(req.Name, req.Version, req.Type, nodeIds, req.BudgetBytes)
+}
+
 func (r *Router) getReleaseCatalogHandler(c *gin.Context, req *GetReleaseCatalogRequest) (*spb.GetReleaseCatalogResponse, error) {
 	return r.clients.SoftwareManager.GetReleaseCatalog(req.Name, req.Type)
 }
@@ -305,6 +403,46 @@ func (r *Router) getRunningConfigVersionHandler(c *gin.Context, req *GetConfigVe
 	return cfg, nil
 }
 
+func (r *Router) getValidateConfigHandler(c *gin.Context, req *ValidateConfigRequest) (*cfgPb.ValidateResponse, error) {
+	log.Infof("Received validate config with %+v", req)
+
+	return r.clients.Configurator.Validate(req.Ref)
+}
+
+func (r *Router) getPreviewConfigHandler(c *gin.Context, req *PreviewConfigRequest) (*cfgPb.PreviewConfigResponse, error) {
+	return r.clients.Configurator.PreviewConfig(req.NodeId, req.Ref)
+}
+
+func (r *Router) getDriftReportHandler(c *gin.Context, req *GetDriftReportRequest) (*cfgPb.DriftReportResponse, error) {
+	return r.clients.Configurator.GetDriftReport(req.NodeId, req.DriftedOnly)
+}
+
+func (r *Router) postPromoteConfigHandler(c *gin.Context, req *PromoteConfigRequest) (*cfgPb.PromoteResponse, error) {
+	log.Infof("Received promote config with %+v", req)
+
+	return r.clients.Configurator.Promote(req.Environment, req.Ref, req.RequestedBy)
+}
+
+func (r *Router) postRollbackEnvironmentHandler(c *gin.Context, req *RollbackEnvironmentRequest) (*cfgPb.RollbackResponse, error) {
+	log.Infof("Received rollback config with %+v", req)
+
+	return r.clients.Configurator.Rollback(req.Environment, "", req.RequestedBy)
+}
+
+func (r *Router) postRollbackNodeHandler(c *gin.Context, req *RollbackNodeRequest) (*cfgPb.RollbackResponse, error) {
+	log.Infof("Received rollback node config with %+v", req)
+
+	return r.clients.Configurator.Rollback("", req.NodeId, req.RequestedBy)
+}
+
+func (r *Router) putNodeEnvironmentHandler(c *gin.Context, req *SetNodeEnvironmentRequest) (*cfgPb.SetNodeEnvironmentResponse, error) {
+	return r.clients.Configurator.SetNodeEnvironment(req.NodeId, req.Environment)
+}
+
+func (r *Router) getPromotionsHandler(c *gin.Context, req *ListPromotionsRequest) (*cfgPb.ListPromotionsResponse, error) {
+	return r.clients.Configurator.ListPromotions(req.Environment)
+}
+
 func (r *Router) getStatesHistoryHandler(c *gin.Context, req *GetStatesHistoryRequest) (*nspb.GetStatesHistoryResponse, error) {
 	nodeId := c.Param("node_id")
 
@@ -328,8 +466,8 @@ func (r *Router) getStatesHistoryHandler(c *gin.Context, req *GetStatesHistoryRe
 	return r.clients.State.GetStatesHistory(nodeId, int32(pageSize), int32(pageNumber), startTime, endTime)
 }
 
-func (r *Router) postRestartSiteHandler(c *gin.Context, req *SiteStateRequest) (*sitepb.RestartSiteResponse, error) {
-	return r.clients.SiteController.RestartSite(req.SiteId)
+func (r *Router) postRestartSiteHandler(c *gin.Context, req *RestartSiteRequest) (*sitepb.RestartSiteResponse, error) {
+	return r.clients.SiteController.RestartSite(req.SiteId, req.RequestedBy)
 }
 
 func (r *Router) toggleSiteStateHandler(c *gin.Context, req *SiteActionRequest) (*sitepb.SetSiteResponse, error) {
@@ -337,11 +475,11 @@ func (r *Router) toggleSiteStateHandler(c *gin.Context, req *SiteActionRequest)
 }
 
 func (r *Router) postToggleServiceHandler(c *gin.Context, req *SiteToggleActionRequest) (*sitepb.SetServiceResponse, error) {
-	return r.clients.SiteController.SetService(req.SiteId, req.State)
+	return r.clients.SiteController.SetService(req.SiteId, req.State, req.RequestedBy)
 }
 
 func (r *Router) postToggleRadioHandler(c *gin.Context, req *SiteToggleActionRequest) (*sitepb.SetRadioResponse, error) {
-	return r.clients.SiteController.SetRadio(req.SiteId, req.State)
+	return r.clients.SiteController.SetRadio(req.SiteId, req.State, req.RequestedBy)
 }
 
 func (r *Router) getSiteStateHandler(c *gin.Context, req *SiteStateRequest) (*sitepb.GetSiteStateResponse, error) {
@@ -368,8 +506,114 @@ func (r *Router) postPowerCycleNodeHandler(c *gin.Context, req *PowerCycleNodeRe
 	return r.clients.SiteController.PowerCycleNode(req.SiteId, req.Role, req.Reason, req.RequestedBy)
 }
 
+func (r *Router) getSitePowerPolicyHandler(c *gin.Context, req *SiteStateRequest) (*sitepb.GetPowerPolicyResponse, error) {
+	return r.clients.SiteController.GetPowerPolicy(req.SiteId)
+}
+
+func (r *Router) putSitePowerPolicyHandler(c *gin.Context, req *SitePowerPolicyRequest) (*sitepb.SetPowerPolicyResponse, error) {
+	return r.clients.SiteController.SetPowerPolicy(req.SiteId, &sitepb.PowerPolicy{
+		Enabled:               req.Enabled,
+		BatteryCapacityAh:     req.BatteryCapacityAh,
+		ShedPortsSocPct:       req.ShedPortsSocPct,
+		ShedPortsRuntimeMin:   req.ShedPortsRuntimeMin,
+		ReduceRadioSocPct:     req.ReduceRadioSocPct,
+		ReduceRadioRuntimeMin: req.ReduceRadioRuntimeMin,
+		ReducedRadioPowerPct:  req.ReducedRadioPowerPct,
+		RadioOffSocPct:        req.RadioOffSocPct,
+		RadioOffRuntimeMin:    req.RadioOffRuntimeMin,
+		RestoreMarginPct:      req.RestoreMarginPct,
+	})
+}
+
+func (r *Router) getSiteSchedulesHandler(c *gin.Context, req *SiteStateRequest) (*sitepb.ListSchedulesResponse, error) {
+	return r.clients.SiteController.ListSchedules(req.SiteId)
+}
+
+func (r *Router) postSiteScheduleHandler(c *gin.Context, req *SiteScheduleRequest) (*sitepb.AddScheduleResponse, error) {
+	schedule := &sitepb.Schedule{
+		Name:        req.Name,
+		Target:      req.Target,
+		State:       req.State,
+		Cron:        req.Cron,
+		DurationSec: req.DurationSec,
+		Timezone:    req.Timezone,
+		Priority:    req.Priority,
+		CreatedBy:   req.CreatedBy,
+	}
+	if req.StartAt != nil {
+		schedule.StartAt = timestamppb.New(*req.StartAt)
+	}
+	if req.EndAt != nil {
+		schedule.EndAt = timestamppb.New(*req.EndAt)
+	}
+	return r.clients.SiteController.AddSchedule(req.SiteId, schedule)
+}
+
+func (r *Router) deleteSiteScheduleHandler(c *gin.Context, req *DeleteSiteScheduleRequest) (*sitepb.DeleteScheduleResponse, error) {
+	return r.clients.SiteController.DeleteSchedule(req.SiteId, req.ScheduleId)
+}
+
+func (r *Router) getSiteScheduleTransitionsHandler(c *gin.Context, req *SiteScheduleTransitionsRequest) (*sitepb.ListScheduleTransitionsResponse, error) {
+	from, err := parseOptionalTime("from", req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseOptionalTime("to", req.To)
+	if err != nil {
+		return nil, err
+	}
+	return r.clients.SiteController.ListScheduleTransitions(req.SiteId, from, to, req.Limit)
+}
+
+func parseOptionalTime(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, rest.HttpError{HttpCode: http.StatusBadRequest, Message: fmt.Sprintf("invalid %s: %s", name, err.Error())}
+	}
+	return &t, nil
+}
+
 func (r *Router) postToggleInternetSwitchHandler(c *gin.Context, req *ToggleInternetSwitchRequest) (*sitepb.ToggleInternetSwitchResponse, error) {
-	return r.clients.SiteController.ToggleInternetSwitch(req.SiteId, req.Status, req.Port)
+	return r.clients.SiteController.ToggleInternetSwitch(req.SiteId, req.Status, req.Port, req.RequestedBy)
+}
+
+func (r *Router) getSiteAuditLogHandler(c *gin.Context, req *SiteAuditLogRequest) (*sitepb.ListAuditLogResponse, error) {
+	from, err := parseOptionalTime("from", req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseOptionalTime("to", req.To)
+	if err != nil {
+		return nil, err
+	}
+	return r.clients.SiteController.ListAuditLog(req.SiteId, req.NodeId, req.RequestedBy, req.Command, from, to, req.Limit)
+}
+
+func (r *Router) getNodeStateAnalyticsHandler(c *gin.Context, req *StateAnalyticsRequest) (*nspb.GetNodeStateAnalyticsResponse, error) {
+	from, err := parseOptionalTime("from", req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseOptionalTime("to", req.To)
+	if err != nil {
+		return nil, err
+	}
+	return r.clients.State.GetNodeStateAnalytics(req.NodeId, from, to, req.FlapWindowSec, req.FlapThreshold)
+}
+
+func (r *Router) getSiteStateAnalyticsHandler(c *gin.Context, req *SiteStateAnalyticsRequest) (*nspb.GetSiteStateAnalyticsResponse, error) {
+	from, err := parseOptionalTime("from", req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseOptionalTime("to", req.To)
+	if err != nil {
+		return nil, err
+	}
+	return r.clients.State.GetSiteStateAnalytics(req.SiteId, from, to, req.FlapWindowSec, req.FlapThreshold)
 }
 
 func (r *Router) enforceStateTransitionHandler(c *gin.Context, req *EnforceStateTransitionRequest) (*nspb.EnforceStateTransitionResponse, error) {
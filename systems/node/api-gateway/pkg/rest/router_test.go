@@ -11,6 +11,7 @@ package rest
 import (
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -97,3 +98,73 @@ func TestRestartNode(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 	c.AssertExpectations(t)
 }
+
+func TestListCommandAudit(t *testing.T) {
+	// arrange
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/controller/audit?requested_by=ops&from=2026-03-01T00:00:00Z&limit=20", nil)
+	arc := &cmmocks.AuthClient{}
+	c := &nmocks.ControllerServiceClient{}
+
+	arc.On("AuthenticateUser", mock.Anything, mock.Anything).Return(nil)
+
+	c.On("ListCommandAudit", mock.Anything, mock.MatchedBy(func(r *cpb.ListCommandAuditRequest) bool {
+		return r.RequestedBy == "ops" && r.Limit == 20 && r.From.AsTime().Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) && r.To == nil
+	})).Return(&cpb.ListCommandAuditResponse{}, nil).Once()
+
+	r := NewRouter(&Clients{
+		Controller: client.NewControllerFromClient(c),
+	}, routerConfig, arc.AuthenticateUser).f.Engine()
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	c.AssertExpectations(t)
+}
+
+func TestListCommandAudit_InvalidTime(t *testing.T) {
+	// arrange
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/controller/audit?to=yesterday", nil)
+	arc := &cmmocks.AuthClient{}
+	c := &nmocks.ControllerServiceClient{}
+
+	arc.On("AuthenticateUser", mock.Anything, mock.Anything).Return(nil)
+
+	r := NewRouter(&Clients{
+		Controller: client.NewControllerFromClient(c),
+	}, routerConfig, arc.AuthenticateUser).f.Engine()
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	c.AssertNotCalled(t, "ListCommandAudit", mock.Anything, mock.Anything)
+}
+
+func TestRunCommandPlan(t *testing.T) {
+	// arrange
+	w := httptest.NewRecorder()
+	body := `{"site_id":"s1","abort_on_failure":true,"steps":[{"action":"RESTART","node_types":["anode"],"wait_online":true},{"action":"RESTART","node_types":["tnode"],"parallelism":1}]}`
+	req, _ := http.NewRequest("POST", "/v1/controller/plans", strings.NewReader(body))
+	arc := &cmmocks.AuthClient{}
+	c := &nmocks.ControllerServiceClient{}
+
+	arc.On("AuthenticateUser", mock.Anything, mock.Anything).Return(nil)
+
+	c.On("RunCommandPlan", mock.Anything, mock.MatchedBy(func(r *cpb.RunCommandPlanRequest) bool {
+		return r.Target.SiteId == "s1" && r.AbortOnFailure && len(r.Steps) == 2 &&
+			r.Steps[0].WaitOnline && r.Steps[1].NodeTypes[0] == "tnode" && r.Steps[1].Parallelism == 1
+	})).Return(&cpb.RunCommandPlanResponse{Plan: &cpb.CommandPlan{Id: "p1"}}, nil).Once()
+
+	r := NewRouter(&Clients{
+		Controller: client.NewControllerFromClient(c),
+	}, routerConfig, arc.AuthenticateUser).f.Engine()
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	c.AssertExpectations(t)
+}
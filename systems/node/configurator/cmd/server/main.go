@@ -24,6 +24,7 @@ import (
 	"github.com/ukama/ukama/systems/node/configurator/pkg/db"
 	"github.com/ukama/ukama/systems/node/configurator/pkg/providers"
 	"github.com/ukama/ukama/systems/node/configurator/pkg/server"
+	"github.com/ukama/ukama/systems/node/configurator/pkg/validator"
 
 	log "github.com/sirupsen/logrus"
 	ccmd "github.com/ukama/ukama/systems/common/cmd"
@@ -53,7 +54,7 @@ func main() {
 func initDb() sql.Db {
 	log.Infof("Initializing Database")
 	d := sql.NewDb(serviceConfig.DB, serviceConfig.DebugMode)
-	err := d.Init(&db.Configuration{}, &db.Commit{})
+	err := d.Init(&db.Configuration{}, &db.Commit{}, &db.Promotion{}, &db.ExpectedConfig{}, &db.NodeDrift{})
 	if err != nil {
 		log.Fatalf("Database initialization failed. Error: %v", err)
 	}
@@ -105,12 +106,14 @@ func runGrpcServer(gormdb sql.Db) {
 		log.Fatalf("Failed to create a config store client. Error %s", err.Error())
 	}
 	configStore := configstore.NewConfigStore(mbClient, cnet, csite, cnode, db.NewConfigRepo(gormdb),
-		db.NewCommitRepo(gormdb), serviceConfig.OrgName, s, serviceConfig.Timeout)
+		db.NewCommitRepo(gormdb), db.NewDriftRepo(gormdb), db.NewPromotionRepo(gormdb), serviceConfig.OrgName, s, serviceConfig.Timeout,
+		validator.NewValidator(serviceConfig.Validation.SchemaDir, serviceConfig.Validation.Strict))
 
 	opMgr := cfgclient.NewOperationManager(serviceConfig.Operation.ManagerHost, serviceConfig.Operation.Timeout)
 
-	configuratorServer := server.NewConfiguratorServer(mbClient, db.NewConfigRepo(gormdb), db.NewCommitRepo(gormdb), configStore,
-		serviceConfig.OrgName, pkg.IsDebugMode, opMgr, serviceConfig.Operation.LeaseSecs)
+	configuratorServer := server.NewConfiguratorServer(mbClient, db.NewConfigRepo(gormdb), db.NewCommitRepo(gormdb), db.NewPromotionRepo(gormdb), db.NewDriftRepo(gormdb), configStore,
+		serviceConfig.OrgName, pkg.IsDebugMode, opMgr, serviceConfig.Operation.LeaseSecs,
+		serviceConfig.Drift, serviceConfig.Pushgateway)
 
 	configuratorEventServer := server.NewConfiguratorEventServer(serviceConfig.OrgName, configuratorServer)
 
@@ -14,7 +14,9 @@ require (
 	github.com/DATA-DOG/go-sqlmock v1.5.2
 	github.com/go-git/go-git/v5 v5.19.2
 	github.com/golang/protobuf v1.5.4
+	github.com/mwitkow/go-proto-validators v0.3.2
 	github.com/num30/config v0.1.3
+	github.com/santhosh-tekuri/jsonschema/v5 v5.3.1
 	github.com/sirupsen/logrus v1.10.1
 	github.com/stretchr/testify v1.12.0
 	github.com/tj/assert v0.0.3
@@ -87,7 +89,6 @@ require (
 	github.com/mitchellh/mapstructure v1.5.0 // indirect
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
 	github.com/modern-go/reflect2 v1.0.2 // indirect
-	github.com/mwitkow/go-proto-validators v0.3.2 // indirect
 	github.com/pelletier/go-toml/v2 v2.2.4 // indirect
 	github.com/penglongli/gin-metrics v0.1.10 // indirect
 	github.com/pjbgf/sha1cd v0.6.0 // indirect
@@ -30,6 +30,24 @@ func (_m *CommitRepo) Add(hash string) error {
 	return r0
 }
 
+// AddPinned provides a mock function with given fields: hash
+func (_m *CommitRepo) AddPinned(hash string) error {
+	ret := _m.Called(hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPinned")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(hash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: hash
 func (_m *CommitRepo) Get(hash string) (*db.Commit, error) {
 	ret := _m.Called(hash)
@@ -108,6 +108,36 @@ func (_m *ConfigRepo) GetAll() ([]db.Configuration, error) {
 	return r0, r1
 }
 
+// ListByEnvironment provides a mock function with given fields: env
+func (_m *ConfigRepo) ListByEnvironment(env string) ([]db.Configuration, error) {
+	ret := _m.Called(env)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByEnvironment")
+	}
+
+	var r0 []db.Configuration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]db.Configuration, error)); ok {
+		return rf(env)
+	}
+	if rf, ok := ret.Get(0).(func(string) []db.Configuration); ok {
+		r0 = rf(env)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Configuration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(env)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateCommitState provides a mock function with given fields: nodeid, state
 func (_m *ConfigRepo) UpdateCommitState(nodeid string, state db.CommitState) error {
 	ret := _m.Called(nodeid, state)
@@ -144,6 +174,24 @@ func (_m *ConfigRepo) UpdateCurrentCommit(c db.Configuration, state *db.CommitSt
 	return r0
 }
 
+// UpdateEnvironment provides a mock function with given fields: nodeid, env
+func (_m *ConfigRepo) UpdateEnvironment(nodeid string, env string) error {
+	ret := _m.Called(nodeid, env)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEnvironment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(nodeid, env)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateLastCommit provides a mock function with given fields: c, state
 func (_m *ConfigRepo) UpdateLastCommit(c db.Configuration, state *db.CommitState) error {
 	ret := _m.Called(c, state)
@@ -6,6 +6,9 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+	render "github.com/ukama/ukama/systems/node/configurator/pkg/render"
+
+	validator "github.com/ukama/ukama/systems/node/configurator/pkg/validator"
 )
 
 // ConfigStoreProvider is an autogenerated mock type for the ConfigStoreProvider type
@@ -67,6 +70,108 @@ func (_m *ConfigStoreProvider) HandleConfigStoreEvent(ctx context.Context) error
 	return r0
 }
 
+// PreviewNodeConfig provides a mock function with given fields: ctx, ref, nodeId
+func (_m *ConfigStoreProvider) PreviewNodeConfig(ctx context.Context, ref string, nodeId string) (string, []render.RenderedFile, error) {
+	ret := _m.Called(ctx, ref, nodeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewNodeConfig")
+	}
+
+	var r0 string
+	var r1 []render.RenderedFile
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, []render.RenderedFile, error)); ok {
+		return rf(ctx, ref, nodeId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, ref, nodeId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) []render.RenderedFile); ok {
+		r1 = rf(ctx, ref, nodeId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]render.RenderedFile)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, ref, nodeId)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ResolveConfigRef provides a mock function with given fields: ctx, ref
+func (_m *ConfigStoreProvider) ResolveConfigRef(ctx context.Context, ref string) (string, error) {
+	ret := _m.Called(ctx, ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveConfigRef")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, ref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, ref)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateConfigRef provides a mock function with given fields: ctx, ref
+func (_m *ConfigStoreProvider) ValidateConfigRef(ctx context.Context, ref string) (string, *validator.Report, error) {
+	ret := _m.Called(ctx, ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateConfigRef")
+	}
+
+	var r0 string
+	var r1 *validator.Report
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, *validator.Report, error)); ok {
+		return rf(ctx, ref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, ref)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *validator.Report); ok {
+		r1 = rf(ctx, ref)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*validator.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, ref)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // NewConfigStoreProvider creates a new instance of ConfigStoreProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewConfigStoreProvider(t interface {
@@ -0,0 +1,199 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	db "github.com/ukama/ukama/systems/node/configurator/pkg/db"
+)
+
+// DriftRepo is an autogenerated mock type for the DriftRepo type
+type DriftRepo struct {
+	mock.Mock
+}
+
+// CountDrifted provides a mock function with no fields
+func (_m *DriftRepo) CountDrifted() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountDrifted")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteExpected provides a mock function with given fields: nodeId, app, fileName
+func (_m *DriftRepo) DeleteExpected(nodeId string, app string, fileName string) error {
+	ret := _m.Called(nodeId, app, fileName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpected")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(nodeId, app, fileName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: nodeId
+func (_m *DriftRepo) Get(nodeId string) (*db.NodeDrift, error) {
+	ret := _m.Called(nodeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *db.NodeDrift
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*db.NodeDrift, error)); ok {
+		return rf(nodeId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *db.NodeDrift); ok {
+		r0 = rf(nodeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.NodeDrift)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(nodeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: driftedOnly
+func (_m *DriftRepo) List(driftedOnly bool) ([]db.NodeDrift, error) {
+	ret := _m.Called(driftedOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []db.NodeDrift
+	var r1 error
+	if rf, ok := ret.Get(0).(func(bool) ([]db.NodeDrift, error)); ok {
+		return rf(driftedOnly)
+	}
+	if rf, ok := ret.Get(0).(func(bool) []db.NodeDrift); ok {
+		r0 = rf(driftedOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.NodeDrift)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(bool) error); ok {
+		r1 = rf(driftedOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExpected provides a mock function with given fields: nodeId
+func (_m *DriftRepo) ListExpected(nodeId string) ([]db.ExpectedConfig, error) {
+	ret := _m.Called(nodeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExpected")
+	}
+
+	var r0 []db.ExpectedConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]db.ExpectedConfig, error)); ok {
+		return rf(nodeId)
+	}
+	if rf, ok := ret.Get(0).(func(string) []db.ExpectedConfig); ok {
+		r0 = rf(nodeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ExpectedConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(nodeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetExpected provides a mock function with given fields: e
+func (_m *DriftRepo) SetExpected(e *db.ExpectedConfig) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetExpected")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.ExpectedConfig) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Upsert provides a mock function with given fields: d
+func (_m *DriftRepo) Upsert(d *db.NodeDrift) error {
+	ret := _m.Called(d)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.NodeDrift) error); ok {
+		r0 = rf(d)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewDriftRepo creates a new instance of DriftRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDriftRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DriftRepo {
+	mock := &DriftRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
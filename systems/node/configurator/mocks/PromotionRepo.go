@@ -0,0 +1,105 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	db "github.com/ukama/ukama/systems/node/configurator/pkg/db"
+)
+
+// PromotionRepo is an autogenerated mock type for the PromotionRepo type
+type PromotionRepo struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: p
+func (_m *PromotionRepo) Add(p *db.Promotion) error {
+	ret := _m.Called(p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.Promotion) error); ok {
+		r0 = rf(p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetLatest provides a mock function with given fields: env
+func (_m *PromotionRepo) GetLatest(env string) (*db.Promotion, error) {
+	ret := _m.Called(env)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatest")
+	}
+
+	var r0 *db.Promotion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*db.Promotion, error)); ok {
+		return rf(env)
+	}
+	if rf, ok := ret.Get(0).(func(string) *db.Promotion); ok {
+		r0 = rf(env)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Promotion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(env)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: env
+func (_m *PromotionRepo) List(env string) ([]db.Promotion, error) {
+	ret := _m.Called(env)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []db.Promotion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]db.Promotion, error)); ok {
+		return rf(env)
+	}
+	if rf, ok := ret.Get(0).(func(string) []db.Promotion); ok {
+		r0 = rf(env)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Promotion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(env)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPromotionRepo creates a new instance of PromotionRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPromotionRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PromotionRepo {
+	mock := &PromotionRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
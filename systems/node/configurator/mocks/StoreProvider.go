@@ -85,6 +85,34 @@ func (_m *StoreProvider) GetRemoteConfigVersion(dir string, version string) erro
 	return r0
 }
 
+// ResolveRef provides a mock function with given fields: dir, ref
+func (_m *StoreProvider) ResolveRef(dir string, ref string) (string, error) {
+	ret := _m.Called(dir, ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveRef")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return rf(dir, ref)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(dir, ref)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(dir, ref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewStoreProvider creates a new instance of StoreProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewStoreProvider(t interface {
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.11
-// 	protoc        v7.34.1
+// 	protoc-gen-go v1.36.12
+// 	protoc        v6.32.1
 // source: configurator.proto
 
 package gen
@@ -226,31 +226,1153 @@ func (x *ConfigVersionRequest) GetNodeId() string {
 }
 
 type ConfigVersionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NodeId         string                 `protobuf:"bytes,1,opt,name=NodeId,proto3" json:"NodeId,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
+	Commit         string                 `protobuf:"bytes,3,opt,name=Commit,proto3" json:"Commit,omitempty"`
+	LastStatus     string                 `protobuf:"bytes,4,opt,name=LastStatus,proto3" json:"LastStatus,omitempty"`
+	LastCommit     string                 `protobuf:"bytes,5,opt,name=LastCommit,proto3" json:"LastCommit,omitempty"`
+	Environment    string                 `protobuf:"bytes,6,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	PromotedCommit string                 `protobuf:"bytes,7,opt,name=PromotedCommit,proto3" json:"PromotedCommit,omitempty"`
+	InSync         bool                   `protobuf:"varint,8,opt,name=InSync,proto3" json:"InSync,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConfigVersionResponse) Reset() {
+	*x = ConfigVersionResponse{}
+	mi := &file_configurator_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigVersionResponse) ProtoMessage() {}
+
+func (x *ConfigVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigVersionResponse.ProtoReflect.Descriptor instead.
+func (*ConfigVersionResponse) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConfigVersionResponse) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ConfigVersionResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ConfigVersionResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *ConfigVersionResponse) GetLastStatus() string {
+	if x != nil {
+		return x.LastStatus
+	}
+	return ""
+}
+
+func (x *ConfigVersionResponse) GetLastCommit() string {
+	if x != nil {
+		return x.LastCommit
+	}
+	return ""
+}
+
+func (x *ConfigVersionResponse) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *ConfigVersionResponse) GetPromotedCommit() string {
+	if x != nil {
+		return x.PromotedCommit
+	}
+	return ""
+}
+
+func (x *ConfigVersionResponse) GetInSync() bool {
+	if x != nil {
+		return x.InSync
+	}
+	return false
+}
+
+// Promote a branch, tag or commit of the config store to an environment
+type PromoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Environment   string                 `protobuf:"bytes,1,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	Ref           string                 `protobuf:"bytes,2,opt,name=Ref,proto3" json:"Ref,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=RequestedBy,proto3" json:"RequestedBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteRequest) Reset() {
+	*x = PromoteRequest{}
+	mi := &file_configurator_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteRequest) ProtoMessage() {}
+
+func (x *PromoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteRequest.ProtoReflect.Descriptor instead.
+func (*PromoteRequest) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PromoteRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *PromoteRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *PromoteRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type PromoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Environment   string                 `protobuf:"bytes,1,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	Commit        string                 `protobuf:"bytes,2,opt,name=Commit,proto3" json:"Commit,omitempty"`
+	Nodes         []string               `protobuf:"bytes,3,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	FailedNodes   []string               `protobuf:"bytes,4,rep,name=FailedNodes,proto3" json:"FailedNodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteResponse) Reset() {
+	*x = PromoteResponse{}
+	mi := &file_configurator_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteResponse) ProtoMessage() {}
+
+func (x *PromoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteResponse.ProtoReflect.Descriptor instead.
+func (*PromoteResponse) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PromoteResponse) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *PromoteResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *PromoteResponse) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *PromoteResponse) GetFailedNodes() []string {
+	if x != nil {
+		return x.FailedNodes
+	}
+	return nil
+}
+
+// Re-push the last successfully applied commit to nodes which failed or never acked
+type RollbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Environment   string                 `protobuf:"bytes,1,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=NodeId,proto3" json:"NodeId,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=RequestedBy,proto3" json:"RequestedBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackRequest) Reset() {
+	*x = RollbackRequest{}
+	mi := &file_configurator_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackRequest) ProtoMessage() {}
+
+func (x *RollbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackRequest.ProtoReflect.Descriptor instead.
+func (*RollbackRequest) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RollbackRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *RollbackRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *RollbackRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type RollbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*NodeRollback        `protobuf:"bytes,1,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackResponse) Reset() {
+	*x = RollbackResponse{}
+	mi := &file_configurator_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackResponse) ProtoMessage() {}
+
+func (x *RollbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackResponse.ProtoReflect.Descriptor instead.
+func (*RollbackResponse) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RollbackResponse) GetNodes() []*NodeRollback {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type NodeRollback struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=NodeId,proto3" json:"NodeId,omitempty"`
+	FromCommit    string                 `protobuf:"bytes,2,opt,name=FromCommit,proto3" json:"FromCommit,omitempty"`
+	ToCommit      string                 `protobuf:"bytes,3,opt,name=ToCommit,proto3" json:"ToCommit,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeRollback) Reset() {
+	*x = NodeRollback{}
+	mi := &file_configurator_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeRollback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeRollback) ProtoMessage() {}
+
+func (x *NodeRollback) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeRollback.ProtoReflect.Descriptor instead.
+func (*NodeRollback) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NodeRollback) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeRollback) GetFromCommit() string {
+	if x != nil {
+		return x.FromCommit
+	}
+	return ""
+}
+
+func (x *NodeRollback) GetToCommit() string {
+	if x != nil {
+		return x.ToCommit
+	}
+	return ""
+}
+
+func (x *NodeRollback) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SetNodeEnvironmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=NodeId,proto3" json:"NodeId,omitempty"`
+	Environment   string                 `protobuf:"bytes,2,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNodeEnvironmentRequest) Reset() {
+	*x = SetNodeEnvironmentRequest{}
+	mi := &file_configurator_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNodeEnvironmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNodeEnvironmentRequest) ProtoMessage() {}
+
+func (x *SetNodeEnvironmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNodeEnvironmentRequest.ProtoReflect.Descriptor instead.
+func (*SetNodeEnvironmentRequest) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetNodeEnvironmentRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *SetNodeEnvironmentRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+type SetNodeEnvironmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNodeEnvironmentResponse) Reset() {
+	*x = SetNodeEnvironmentResponse{}
+	mi := &file_configurator_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNodeEnvironmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNodeEnvironmentResponse) ProtoMessage() {}
+
+func (x *SetNodeEnvironmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNodeEnvironmentResponse.ProtoReflect.Descriptor instead.
+func (*SetNodeEnvironmentResponse) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{12}
+}
+
+type ListPromotionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Environment   string                 `protobuf:"bytes,1,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsRequest) Reset() {
+	*x = ListPromotionsRequest{}
+	mi := &file_configurator_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsRequest) ProtoMessage() {}
+
+func (x *ListPromotionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromotionsRequest) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListPromotionsRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+type ListPromotionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotions    []*Promotion           `protobuf:"bytes,1,rep,name=Promotions,proto3" json:"Promotions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsResponse) Reset() {
+	*x = ListPromotionsResponse{}
+	mi := &file_configurator_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsResponse) ProtoMessage() {}
+
+func (x *ListPromotionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromotionsResponse) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListPromotionsResponse) GetPromotions() []*Promotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+type Promotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Environment   string                 `protobuf:"bytes,1,opt,name=Environment,proto3" json:"Environment,omitempty"`
+	Ref           string                 `protobuf:"bytes,2,opt,name=Ref,proto3" json:"Ref,omitempty"`
+	Commit        string                 `protobuf:"bytes,3,opt,name=Commit,proto3" json:"Commit,omitempty"`
+	PromotedBy    string                 `protobuf:"bytes,4,opt,name=PromotedBy,proto3" json:"PromotedBy,omitempty"`
+	Rollback      bool                   `protobuf:"varint,5,opt,name=Rollback,proto3" json:"Rollback,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	mi := &file_configurator_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promotion) ProtoMessage() {}
+
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Promotion) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Promotion) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *Promotion) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *Promotion) GetPromotedBy() string {
+	if x != nil {
+		return x.PromotedBy
+	}
+	return ""
+}
+
+func (x *Promotion) GetRollback() bool {
+	if x != nil {
+		return x.Rollback
+	}
+	return false
+}
+
+func (x *Promotion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Validate all configs of a branch, tag or commit against their schemas, nothing is pushed to nodes
+type ValidateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ref           string                 `protobuf:"bytes,1,opt,name=Ref,proto3" json:"Ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	mi := &file_configurator_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ValidateRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+type ValidateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Commit        string                 `protobuf:"bytes,1,opt,name=Commit,proto3" json:"Commit,omitempty"`
+	Valid         bool                   `protobuf:"varint,2,opt,name=Valid,proto3" json:"Valid,omitempty"`
+	Checked       uint32                 `protobuf:"varint,3,opt,name=Checked,proto3" json:"Checked,omitempty"`
+	Failed        []*FileValidation      `protobuf:"bytes,4,rep,name=Failed,proto3" json:"Failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	mi := &file_configurator_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ValidateResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateResponse) GetChecked() uint32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *ValidateResponse) GetFailed() []*FileValidation {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+type FileValidation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          string                 `protobuf:"bytes,1,opt,name=File,proto3" json:"File,omitempty"`
+	App           string                 `protobuf:"bytes,2,opt,name=App,proto3" json:"App,omitempty"`
+	Schema        string                 `protobuf:"bytes,3,opt,name=Schema,proto3" json:"Schema,omitempty"`
+	Errors        []string               `protobuf:"bytes,4,rep,name=Errors,proto3" json:"Errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileValidation) Reset() {
+	*x = FileValidation{}
+	mi := &file_configurator_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileValidation) ProtoMessage() {}
+
+func (x *FileValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileValidation.ProtoReflect.Descriptor instead.
+func (*FileValidation) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FileValidation) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *FileValidation) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *FileValidation) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *FileValidation) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// Compare what nodes report running with what the configurator expects them to run
+type DriftReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=NodeId,proto3" json:"NodeId,omitempty"`
+	DriftedOnly   bool                   `protobuf:"varint,2,opt,name=DriftedOnly,proto3" json:"DriftedOnly,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriftReportRequest) Reset() {
+	*x = DriftReportRequest{}
+	mi := &file_configurator_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriftReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftReportRequest) ProtoMessage() {}
+
+func (x *DriftReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftReportRequest.ProtoReflect.Descriptor instead.
+func (*DriftReportRequest) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DriftReportRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *DriftReportRequest) GetDriftedOnly() bool {
+	if x != nil {
+		return x.DriftedOnly
+	}
+	return false
+}
+
+type DriftReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*NodeDrift           `protobuf:"bytes,1,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	Drifted       uint32                 `protobuf:"varint,2,opt,name=Drifted,proto3" json:"Drifted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriftReportResponse) Reset() {
+	*x = DriftReportResponse{}
+	mi := &file_configurator_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriftReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftReportResponse) ProtoMessage() {}
+
+func (x *DriftReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftReportResponse.ProtoReflect.Descriptor instead.
+func (*DriftReportResponse) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DriftReportResponse) GetNodes() []*NodeDrift {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *DriftReportResponse) GetDrifted() uint32 {
+	if x != nil {
+		return x.Drifted
+	}
+	return 0
+}
+
+type NodeDrift struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NodeId         string                 `protobuf:"bytes,1,opt,name=NodeId,proto3" json:"NodeId,omitempty"`
+	ExpectedCommit string                 `protobuf:"bytes,2,opt,name=ExpectedCommit,proto3" json:"ExpectedCommit,omitempty"`
+	ReportedCommit string                 `protobuf:"bytes,3,opt,name=ReportedCommit,proto3" json:"ReportedCommit,omitempty"`
+	Drifted        bool                   `protobuf:"varint,4,opt,name=Drifted,proto3" json:"Drifted,omitempty"`
+	Files          []*FileDrift           `protobuf:"bytes,5,rep,name=Files,proto3" json:"Files,omitempty"`
+	ReportedAt     string                 `protobuf:"bytes,6,opt,name=ReportedAt,proto3" json:"ReportedAt,omitempty"`
+	DetectedAt     string                 `protobuf:"bytes,7,opt,name=DetectedAt,proto3" json:"DetectedAt,omitempty"`
+	ReconciledAt   string                 `protobuf:"bytes,8,opt,name=ReconciledAt,proto3" json:"ReconciledAt,omitempty"`
+	ReconcileCount uint32                 `protobuf:"varint,9,opt,name=ReconcileCount,proto3" json:"ReconcileCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NodeDrift) Reset() {
+	*x = NodeDrift{}
+	mi := &file_configurator_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeDrift) ProtoMessage() {}
+
+func (x *NodeDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeDrift.ProtoReflect.Descriptor instead.
+func (*NodeDrift) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NodeDrift) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeDrift) GetExpectedCommit() string {
+	if x != nil {
+		return x.ExpectedCommit
+	}
+	return ""
+}
+
+func (x *NodeDrift) GetReportedCommit() string {
+	if x != nil {
+		return x.ReportedCommit
+	}
+	return ""
+}
+
+func (x *NodeDrift) GetDrifted() bool {
+	if x != nil {
+		return x.Drifted
+	}
+	return false
+}
+
+func (x *NodeDrift) GetFiles() []*FileDrift {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *NodeDrift) GetReportedAt() string {
+	if x != nil {
+		return x.ReportedAt
+	}
+	return ""
+}
+
+func (x *NodeDrift) GetDetectedAt() string {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return ""
+}
+
+func (x *NodeDrift) GetReconciledAt() string {
+	if x != nil {
+		return x.ReconciledAt
+	}
+	return ""
+}
+
+func (x *NodeDrift) GetReconcileCount() uint32 {
+	if x != nil {
+		return x.ReconcileCount
+	}
+	return 0
+}
+
+type FileDrift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	App           string                 `protobuf:"bytes,1,opt,name=App,proto3" json:"App,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=FileName,proto3" json:"FileName,omitempty"`
+	ExpectedHash  string                 `protobuf:"bytes,3,opt,name=ExpectedHash,proto3" json:"ExpectedHash,omitempty"`
+	ReportedHash  string                 `protobuf:"bytes,4,opt,name=ReportedHash,proto3" json:"ReportedHash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileDrift) Reset() {
+	*x = FileDrift{}
+	mi := &file_configurator_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileDrift) ProtoMessage() {}
+
+func (x *FileDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileDrift.ProtoReflect.Descriptor instead.
+func (*FileDrift) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FileDrift) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *FileDrift) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *FileDrift) GetExpectedHash() string {
+	if x != nil {
+		return x.ExpectedHash
+	}
+	return ""
+}
+
+func (x *FileDrift) GetReportedHash() string {
+	if x != nil {
+		return x.ReportedHash
+	}
+	return ""
+}
+
+// Render config templates of a node at a branch, tag or commit (latest if empty)
+type PreviewConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	NodeId        string                 `protobuf:"bytes,1,opt,name=NodeId,proto3" json:"NodeId,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
-	Commit        string                 `protobuf:"bytes,3,opt,name=Commit,proto3" json:"Commit,omitempty"`
-	LastStatus    string                 `protobuf:"bytes,4,opt,name=LastStatus,proto3" json:"LastStatus,omitempty"`
-	LastCommit    string                 `protobuf:"bytes,5,opt,name=LastCommit,proto3" json:"LastCommit,omitempty"`
+	Ref           string                 `protobuf:"bytes,2,opt,name=Ref,proto3" json:"Ref,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfigVersionResponse) Reset() {
-	*x = ConfigVersionResponse{}
-	mi := &file_configurator_proto_msgTypes[5]
+func (x *PreviewConfigRequest) Reset() {
+	*x = PreviewConfigRequest{}
+	mi := &file_configurator_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfigVersionResponse) String() string {
+func (x *PreviewConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigVersionResponse) ProtoMessage() {}
+func (*PreviewConfigRequest) ProtoMessage() {}
 
-func (x *ConfigVersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_configurator_proto_msgTypes[5]
+func (x *PreviewConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,46 +1383,169 @@ func (x *ConfigVersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigVersionResponse.ProtoReflect.Descriptor instead.
-func (*ConfigVersionResponse) Descriptor() ([]byte, []int) {
-	return file_configurator_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PreviewConfigRequest.ProtoReflect.Descriptor instead.
+func (*PreviewConfigRequest) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ConfigVersionResponse) GetNodeId() string {
+func (x *PreviewConfigRequest) GetNodeId() string {
 	if x != nil {
 		return x.NodeId
 	}
 	return ""
 }
 
-func (x *ConfigVersionResponse) GetStatus() string {
+func (x *PreviewConfigRequest) GetRef() string {
 	if x != nil {
-		return x.Status
+		return x.Ref
 	}
 	return ""
 }
 
-func (x *ConfigVersionResponse) GetCommit() string {
+type PreviewConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=NodeId,proto3" json:"NodeId,omitempty"`
+	Commit        string                 `protobuf:"bytes,2,opt,name=Commit,proto3" json:"Commit,omitempty"`
+	Files         []*RenderedConfig      `protobuf:"bytes,3,rep,name=Files,proto3" json:"Files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewConfigResponse) Reset() {
+	*x = PreviewConfigResponse{}
+	mi := &file_configurator_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewConfigResponse) ProtoMessage() {}
+
+func (x *PreviewConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewConfigResponse.ProtoReflect.Descriptor instead.
+func (*PreviewConfigResponse) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PreviewConfigResponse) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *PreviewConfigResponse) GetCommit() string {
 	if x != nil {
 		return x.Commit
 	}
 	return ""
 }
 
-func (x *ConfigVersionResponse) GetLastStatus() string {
+func (x *PreviewConfigResponse) GetFiles() []*RenderedConfig {
 	if x != nil {
-		return x.LastStatus
+		return x.Files
+	}
+	return nil
+}
+
+type RenderedConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	App           string                 `protobuf:"bytes,2,opt,name=App,proto3" json:"App,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=FileName,proto3" json:"FileName,omitempty"`
+	Template      string                 `protobuf:"bytes,4,opt,name=Template,proto3" json:"Template,omitempty"`
+	Data          []byte                 `protobuf:"bytes,5,opt,name=Data,proto3" json:"Data,omitempty"`
+	Overridden    bool                   `protobuf:"varint,6,opt,name=Overridden,proto3" json:"Overridden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderedConfig) Reset() {
+	*x = RenderedConfig{}
+	mi := &file_configurator_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderedConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderedConfig) ProtoMessage() {}
+
+func (x *RenderedConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_configurator_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderedConfig.ProtoReflect.Descriptor instead.
+func (*RenderedConfig) Descriptor() ([]byte, []int) {
+	return file_configurator_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RenderedConfig) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
 	return ""
 }
 
-func (x *ConfigVersionResponse) GetLastCommit() string {
+func (x *RenderedConfig) GetApp() string {
 	if x != nil {
-		return x.LastCommit
+		return x.App
+	}
+	return ""
+}
+
+func (x *RenderedConfig) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *RenderedConfig) GetTemplate() string {
+	if x != nil {
+		return x.Template
 	}
 	return ""
 }
 
+func (x *RenderedConfig) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RenderedConfig) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
 var File_configurator_proto protoreflect.FileDescriptor
 
 const file_configurator_proto_rawDesc = "" +
@@ -313,7 +1558,7 @@ const file_configurator_proto_rawDesc = "" +
 	"\x04Hash\x18\x01 \x01(\tR\x04Hash\"\x15\n" +
 	"\x13ApplyConfigResponse\".\n" +
 	"\x14ConfigVersionRequest\x12\x16\n" +
-	"\x06NodeId\x18\x01 \x01(\tR\x06NodeId\"\x9f\x01\n" +
+	"\x06NodeId\x18\x01 \x01(\tR\x06NodeId\"\x81\x02\n" +
 	"\x15ConfigVersionResponse\x12\x16\n" +
 	"\x06NodeId\x18\x01 \x01(\tR\x06NodeId\x12\x16\n" +
 	"\x06Status\x18\x02 \x01(\tR\x06Status\x12\x16\n" +
@@ -323,11 +1568,115 @@ const file_configurator_proto_rawDesc = "" +
 	"LastStatus\x12\x1e\n" +
 	"\n" +
 	"LastCommit\x18\x05 \x01(\tR\n" +
-	"LastCommit2\xf1\x02\n" +
+	"LastCommit\x12 \n" +
+	"\vEnvironment\x18\x06 \x01(\tR\vEnvironment\x12&\n" +
+	"\x0ePromotedCommit\x18\a \x01(\tR\x0ePromotedCommit\x12\x16\n" +
+	"\x06InSync\x18\b \x01(\bR\x06InSync\"f\n" +
+	"\x0ePromoteRequest\x12 \n" +
+	"\vEnvironment\x18\x01 \x01(\tR\vEnvironment\x12\x10\n" +
+	"\x03Ref\x18\x02 \x01(\tR\x03Ref\x12 \n" +
+	"\vRequestedBy\x18\x03 \x01(\tR\vRequestedBy\"\x83\x01\n" +
+	"\x0fPromoteResponse\x12 \n" +
+	"\vEnvironment\x18\x01 \x01(\tR\vEnvironment\x12\x16\n" +
+	"\x06Commit\x18\x02 \x01(\tR\x06Commit\x12\x14\n" +
+	"\x05Nodes\x18\x03 \x03(\tR\x05Nodes\x12 \n" +
+	"\vFailedNodes\x18\x04 \x03(\tR\vFailedNodes\"m\n" +
+	"\x0fRollbackRequest\x12 \n" +
+	"\vEnvironment\x18\x01 \x01(\tR\vEnvironment\x12\x16\n" +
+	"\x06NodeId\x18\x02 \x01(\tR\x06NodeId\x12 \n" +
+	"\vRequestedBy\x18\x03 \x01(\tR\vRequestedBy\"R\n" +
+	"\x10RollbackResponse\x12>\n" +
+	"\x05Nodes\x18\x01 \x03(\v2(.ukama.node.configurator.v1.NodeRollbackR\x05Nodes\"x\n" +
+	"\fNodeRollback\x12\x16\n" +
+	"\x06NodeId\x18\x01 \x01(\tR\x06NodeId\x12\x1e\n" +
+	"\n" +
+	"FromCommit\x18\x02 \x01(\tR\n" +
+	"FromCommit\x12\x1a\n" +
+	"\bToCommit\x18\x03 \x01(\tR\bToCommit\x12\x14\n" +
+	"\x05Error\x18\x04 \x01(\tR\x05Error\"U\n" +
+	"\x19SetNodeEnvironmentRequest\x12\x16\n" +
+	"\x06NodeId\x18\x01 \x01(\tR\x06NodeId\x12 \n" +
+	"\vEnvironment\x18\x02 \x01(\tR\vEnvironment\"\x1c\n" +
+	"\x1aSetNodeEnvironmentResponse\"9\n" +
+	"\x15ListPromotionsRequest\x12 \n" +
+	"\vEnvironment\x18\x01 \x01(\tR\vEnvironment\"_\n" +
+	"\x16ListPromotionsResponse\x12E\n" +
+	"\n" +
+	"Promotions\x18\x01 \x03(\v2%.ukama.node.configurator.v1.PromotionR\n" +
+	"Promotions\"\xb1\x01\n" +
+	"\tPromotion\x12 \n" +
+	"\vEnvironment\x18\x01 \x01(\tR\vEnvironment\x12\x10\n" +
+	"\x03Ref\x18\x02 \x01(\tR\x03Ref\x12\x16\n" +
+	"\x06Commit\x18\x03 \x01(\tR\x06Commit\x12\x1e\n" +
+	"\n" +
+	"PromotedBy\x18\x04 \x01(\tR\n" +
+	"PromotedBy\x12\x1a\n" +
+	"\bRollback\x18\x05 \x01(\bR\bRollback\x12\x1c\n" +
+	"\tCreatedAt\x18\x06 \x01(\tR\tCreatedAt\"#\n" +
+	"\x0fValidateRequest\x12\x10\n" +
+	"\x03Ref\x18\x01 \x01(\tR\x03Ref\"\x9e\x01\n" +
+	"\x10ValidateResponse\x12\x16\n" +
+	"\x06Commit\x18\x01 \x01(\tR\x06Commit\x12\x14\n" +
+	"\x05Valid\x18\x02 \x01(\bR\x05Valid\x12\x18\n" +
+	"\aChecked\x18\x03 \x01(\rR\aChecked\x12B\n" +
+	"\x06Failed\x18\x04 \x03(\v2*.ukama.node.configurator.v1.FileValidationR\x06Failed\"f\n" +
+	"\x0eFileValidation\x12\x12\n" +
+	"\x04File\x18\x01 \x01(\tR\x04File\x12\x10\n" +
+	"\x03App\x18\x02 \x01(\tR\x03App\x12\x16\n" +
+	"\x06Schema\x18\x03 \x01(\tR\x06Schema\x12\x16\n" +
+	"\x06Errors\x18\x04 \x03(\tR\x06Errors\"N\n" +
+	"\x12DriftReportRequest\x12\x16\n" +
+	"\x06NodeId\x18\x01 \x01(\tR\x06NodeId\x12 \n" +
+	"\vDriftedOnly\x18\x02 \x01(\bR\vDriftedOnly\"l\n" +
+	"\x13DriftReportResponse\x12;\n" +
+	"\x05Nodes\x18\x01 \x03(\v2%.ukama.node.configurator.v1.NodeDriftR\x05Nodes\x12\x18\n" +
+	"\aDrifted\x18\x02 \x01(\rR\aDrifted\"\xd6\x02\n" +
+	"\tNodeDrift\x12\x16\n" +
+	"\x06NodeId\x18\x01 \x01(\tR\x06NodeId\x12&\n" +
+	"\x0eExpectedCommit\x18\x02 \x01(\tR\x0eExpectedCommit\x12&\n" +
+	"\x0eReportedCommit\x18\x03 \x01(\tR\x0eReportedCommit\x12\x18\n" +
+	"\aDrifted\x18\x04 \x01(\bR\aDrifted\x12;\n" +
+	"\x05Files\x18\x05 \x03(\v2%.ukama.node.configurator.v1.FileDriftR\x05Files\x12\x1e\n" +
+	"\n" +
+	"ReportedAt\x18\x06 \x01(\tR\n" +
+	"ReportedAt\x12\x1e\n" +
+	"\n" +
+	"DetectedAt\x18\a \x01(\tR\n" +
+	"DetectedAt\x12\"\n" +
+	"\fReconciledAt\x18\b \x01(\tR\fReconciledAt\x12&\n" +
+	"\x0eReconcileCount\x18\t \x01(\rR\x0eReconcileCount\"\x81\x01\n" +
+	"\tFileDrift\x12\x10\n" +
+	"\x03App\x18\x01 \x01(\tR\x03App\x12\x1a\n" +
+	"\bFileName\x18\x02 \x01(\tR\bFileName\x12\"\n" +
+	"\fExpectedHash\x18\x03 \x01(\tR\fExpectedHash\x12\"\n" +
+	"\fReportedHash\x18\x04 \x01(\tR\fReportedHash\"@\n" +
+	"\x14PreviewConfigRequest\x12\x16\n" +
+	"\x06NodeId\x18\x01 \x01(\tR\x06NodeId\x12\x10\n" +
+	"\x03Ref\x18\x02 \x01(\tR\x03Ref\"\x89\x01\n" +
+	"\x15PreviewConfigResponse\x12\x16\n" +
+	"\x06NodeId\x18\x01 \x01(\tR\x06NodeId\x12\x16\n" +
+	"\x06Commit\x18\x02 \x01(\tR\x06Commit\x12@\n" +
+	"\x05Files\x18\x03 \x03(\v2*.ukama.node.configurator.v1.RenderedConfigR\x05Files\"\xa2\x01\n" +
+	"\x0eRenderedConfig\x12\x12\n" +
+	"\x04Path\x18\x01 \x01(\tR\x04Path\x12\x10\n" +
+	"\x03App\x18\x02 \x01(\tR\x03App\x12\x1a\n" +
+	"\bFileName\x18\x03 \x01(\tR\bFileName\x12\x1a\n" +
+	"\bTemplate\x18\x04 \x01(\tR\bTemplate\x12\x12\n" +
+	"\x04Data\x18\x05 \x01(\fR\x04Data\x12\x1e\n" +
+	"\n" +
+	"Overridden\x18\x06 \x01(\bR\n" +
+	"Overridden2\x8b\t\n" +
 	"\x13ConfiguratorService\x12q\n" +
 	"\vConfigEvent\x12,.ukama.node.configurator.v1.ConfigStoreEvent\x1a4.ukama.node.configurator.v1.ConfigStoreEventResponse\x12n\n" +
 	"\vApplyConfig\x12..ukama.node.configurator.v1.ApplyConfigRequest\x1a/.ukama.node.configurator.v1.ApplyConfigResponse\x12w\n" +
-	"\x10GetConfigVersion\x120.ukama.node.configurator.v1.ConfigVersionRequest\x1a1.ukama.node.configurator.v1.ConfigVersionResponseB9Z7github.com/ukama/ukama/systems/node/configurator/pb/genb\x06proto3"
+	"\x10GetConfigVersion\x120.ukama.node.configurator.v1.ConfigVersionRequest\x1a1.ukama.node.configurator.v1.ConfigVersionResponse\x12b\n" +
+	"\aPromote\x12*.ukama.node.configurator.v1.PromoteRequest\x1a+.ukama.node.configurator.v1.PromoteResponse\x12e\n" +
+	"\bRollback\x12+.ukama.node.configurator.v1.RollbackRequest\x1a,.ukama.node.configurator.v1.RollbackResponse\x12\x83\x01\n" +
+	"\x12SetNodeEnvironment\x125.ukama.node.configurator.v1.SetNodeEnvironmentRequest\x1a6.ukama.node.configurator.v1.SetNodeEnvironmentResponse\x12w\n" +
+	"\x0eListPromotions\x121.ukama.node.configurator.v1.ListPromotionsRequest\x1a2.ukama.node.configurator.v1.ListPromotionsResponse\x12e\n" +
+	"\bValidate\x12+.ukama.node.configurator.v1.ValidateRequest\x1a,.ukama.node.configurator.v1.ValidateResponse\x12q\n" +
+	"\x0eGetDriftReport\x12..ukama.node.configurator.v1.DriftReportRequest\x1a/.ukama.node.configurator.v1.DriftReportResponse\x12t\n" +
+	"\rPreviewConfig\x120.ukama.node.configurator.v1.PreviewConfigRequest\x1a1.ukama.node.configurator.v1.PreviewConfigResponseB9Z7github.com/ukama/ukama/systems/node/configurator/pb/genb\x06proto3"
 
 var (
 	file_configurator_proto_rawDescOnce sync.Once
@@ -341,27 +1690,67 @@ func file_configurator_proto_rawDescGZIP() []byte {
 	return file_configurator_proto_rawDescData
 }
 
-var file_configurator_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_configurator_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_configurator_proto_goTypes = []any{
-	(*ConfigStoreEvent)(nil),         // 0: ukama.node.configurator.v1.ConfigStoreEvent
-	(*ConfigStoreEventResponse)(nil), // 1: ukama.node.configurator.v1.ConfigStoreEventResponse
-	(*ApplyConfigRequest)(nil),       // 2: ukama.node.configurator.v1.ApplyConfigRequest
-	(*ApplyConfigResponse)(nil),      // 3: ukama.node.configurator.v1.ApplyConfigResponse
-	(*ConfigVersionRequest)(nil),     // 4: ukama.node.configurator.v1.ConfigVersionRequest
-	(*ConfigVersionResponse)(nil),    // 5: ukama.node.configurator.v1.ConfigVersionResponse
+	(*ConfigStoreEvent)(nil),           // 0: ukama.node.configurator.v1.ConfigStoreEvent
+	(*ConfigStoreEventResponse)(nil),   // 1: ukama.node.configurator.v1.ConfigStoreEventResponse
+	(*ApplyConfigRequest)(nil),         // 2: ukama.node.configurator.v1.ApplyConfigRequest
+	(*ApplyConfigResponse)(nil),        // 3: ukama.node.configurator.v1.ApplyConfigResponse
+	(*ConfigVersionRequest)(nil),       // 4: ukama.node.configurator.v1.ConfigVersionRequest
+	(*ConfigVersionResponse)(nil),      // 5: ukama.node.configurator.v1.ConfigVersionResponse
+	(*PromoteRequest)(nil),             // 6: ukama.node.configurator.v1.PromoteRequest
+	(*PromoteResponse)(nil),            // 7: ukama.node.configurator.v1.PromoteResponse
+	(*RollbackRequest)(nil),            // 8: ukama.node.configurator.v1.RollbackRequest
+	(*RollbackResponse)(nil),           // 9: ukama.node.configurator.v1.RollbackResponse
+	(*NodeRollback)(nil),               // 10: ukama.node.configurator.v1.NodeRollback
+	(*SetNodeEnvironmentRequest)(nil),  // 11: ukama.node.configurator.v1.SetNodeEnvironmentRequest
+	(*SetNodeEnvironmentResponse)(nil), // 12: ukama.node.configurator.v1.SetNodeEnvironmentResponse
+	(*ListPromotionsRequest)(nil),      // 13: ukama.node.configurator.v1.ListPromotionsRequest
+	(*ListPromotionsResponse)(nil),     // 14: ukama.node.configurator.v1.ListPromotionsResponse
+	(*Promotion)(nil),                  // 15: ukama.node.configurator.v1.Promotion
+	(*ValidateRequest)(nil),            // 16: ukama.node.configurator.v1.ValidateRequest
+	(*ValidateResponse)(nil),           // 17: ukama.node.configurator.v1.ValidateResponse
+	(*FileValidation)(nil),             // 18: ukama.node.configurator.v1.FileValidation
+	(*DriftReportRequest)(nil),         // 19: ukama.node.configurator.v1.DriftReportRequest
+	(*DriftReportResponse)(nil),        // 20: ukama.node.configurator.v1.DriftReportResponse
+	(*NodeDrift)(nil),                  // 21: ukama.node.configurator.v1.NodeDrift
+	(*FileDrift)(nil),                  // 22: ukama.node.configurator.v1.FileDrift
+	(*PreviewConfigRequest)(nil),       // 23: ukama.node.configurator.v1.PreviewConfigRequest
+	(*PreviewConfigResponse)(nil),      // 24: ukama.node.configurator.v1.PreviewConfigResponse
+	(*RenderedConfig)(nil),             // 25: ukama.node.configurator.v1.RenderedConfig
 }
 var file_configurator_proto_depIdxs = []int32{
-	0, // 0: ukama.node.configurator.v1.ConfiguratorService.ConfigEvent:input_type -> ukama.node.configurator.v1.ConfigStoreEvent
-	2, // 1: ukama.node.configurator.v1.ConfiguratorService.ApplyConfig:input_type -> ukama.node.configurator.v1.ApplyConfigRequest
-	4, // 2: ukama.node.configurator.v1.ConfiguratorService.GetConfigVersion:input_type -> ukama.node.configurator.v1.ConfigVersionRequest
-	1, // 3: ukama.node.configurator.v1.ConfiguratorService.ConfigEvent:output_type -> ukama.node.configurator.v1.ConfigStoreEventResponse
-	3, // 4: ukama.node.configurator.v1.ConfiguratorService.ApplyConfig:output_type -> ukama.node.configurator.v1.ApplyConfigResponse
-	5, // 5: ukama.node.configurator.v1.ConfiguratorService.GetConfigVersion:output_type -> ukama.node.configurator.v1.ConfigVersionResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	10, // 0: ukama.node.configurator.v1.RollbackResponse.Nodes:type_name -> ukama.node.configurator.v1.NodeRollback
+	15, // 1: ukama.node.configurator.v1.ListPromotionsResponse.Promotions:type_name -> ukama.node.configurator.v1.Promotion
+	18, // 2: ukama.node.configurator.v1.ValidateResponse.Failed:type_name -> ukama.node.configurator.v1.FileValidation
+	21, // 3: ukama.node.configurator.v1.DriftReportResponse.Nodes:type_name -> ukama.node.configurator.v1.NodeDrift
+	22, // 4: ukama.node.configurator.v1.NodeDrift.Files:type_name -> ukama.node.configurator.v1.FileDrift
+	25, // 5: ukama.node.configurator.v1.PreviewConfigResponse.Files:type_name -> ukama.node.configurator.v1.RenderedConfig
+	0,  // 6: ukama.node.configurator.v1.ConfiguratorService.ConfigEvent:input_type -> ukama.node.configurator.v1.ConfigStoreEvent
+	2,  // 7: ukama.node.configurator.v1.ConfiguratorService.ApplyConfig:input_type -> ukama.node.configurator.v1.ApplyConfigRequest
+	4,  // 8: ukama.node.configurator.v1.ConfiguratorService.GetConfigVersion:input_type -> ukama.node.configurator.v1.ConfigVersionRequest
+	6,  // 9: ukama.node.configurator.v1.ConfiguratorService.Promote:input_type -> ukama.node.configurator.v1.PromoteRequest
+	8,  // 10: ukama.node.configurator.v1.ConfiguratorService.Rollback:input_type -> ukama.node.configurator.v1.RollbackRequest
+	11, // 11: ukama.node.configurator.v1.ConfiguratorService.SetNodeEnvironment:input_type -> ukama.node.configurator.v1.SetNodeEnvironmentRequest
+	13, // 12: ukama.node.configurator.v1.ConfiguratorService.ListPromotions:input_type -> ukama.node.configurator.v1.ListPromotionsRequest
+	16, // 13: ukama.node.configurator.v1.ConfiguratorService.Validate:input_type -> ukama.node.configurator.v1.ValidateRequest
+	19, // 14: ukama.node.configurator.v1.ConfiguratorService.GetDriftReport:input_type -> ukama.node.configurator.v1.DriftReportRequest
+	23, // 15: ukama.node.configurator.v1.ConfiguratorService.PreviewConfig:input_type -> ukama.node.configurator.v1.PreviewConfigRequest
+	1,  // 16: ukama.node.configurator.v1.ConfiguratorService.ConfigEvent:output_type -> ukama.node.configurator.v1.ConfigStoreEventResponse
+	3,  // 17: ukama.node.configurator.v1.ConfiguratorService.ApplyConfig:output_type -> ukama.node.configurator.v1.ApplyConfigResponse
+	5,  // 18: ukama.node.configurator.v1.ConfiguratorService.GetConfigVersion:output_type -> ukama.node.configurator.v1.ConfigVersionResponse
+	7,  // 19: ukama.node.configurator.v1.ConfiguratorService.Promote:output_type -> ukama.node.configurator.v1.PromoteResponse
+	9,  // 20: ukama.node.configurator.v1.ConfiguratorService.Rollback:output_type -> ukama.node.configurator.v1.RollbackResponse
+	12, // 21: ukama.node.configurator.v1.ConfiguratorService.SetNodeEnvironment:output_type -> ukama.node.configurator.v1.SetNodeEnvironmentResponse
+	14, // 22: ukama.node.configurator.v1.ConfiguratorService.ListPromotions:output_type -> ukama.node.configurator.v1.ListPromotionsResponse
+	17, // 23: ukama.node.configurator.v1.ConfiguratorService.Validate:output_type -> ukama.node.configurator.v1.ValidateResponse
+	20, // 24: ukama.node.configurator.v1.ConfiguratorService.GetDriftReport:output_type -> ukama.node.configurator.v1.DriftReportResponse
+	24, // 25: ukama.node.configurator.v1.ConfiguratorService.PreviewConfig:output_type -> ukama.node.configurator.v1.PreviewConfigResponse
+	16, // [16:26] is the sub-list for method output_type
+	6,  // [6:16] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_configurator_proto_init() }
@@ -375,7 +1764,7 @@ func file_configurator_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_configurator_proto_rawDesc), len(file_configurator_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	math "math"
 	proto "github.com/golang/protobuf/proto"
+	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -32,3 +33,105 @@ func (this *ConfigVersionRequest) Validate() error {
 func (this *ConfigVersionResponse) Validate() error {
 	return nil
 }
+func (this *PromoteRequest) Validate() error {
+	return nil
+}
+func (this *PromoteResponse) Validate() error {
+	return nil
+}
+func (this *RollbackRequest) Validate() error {
+	return nil
+}
+func (this *RollbackResponse) Validate() error {
+	for _, item := range this.Nodes {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Nodes", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *NodeRollback) Validate() error {
+	return nil
+}
+func (this *SetNodeEnvironmentRequest) Validate() error {
+	return nil
+}
+func (this *SetNodeEnvironmentResponse) Validate() error {
+	return nil
+}
+func (this *ListPromotionsRequest) Validate() error {
+	return nil
+}
+func (this *ListPromotionsResponse) Validate() error {
+	for _, item := range this.Promotions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Promotions", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *Promotion) Validate() error {
+	return nil
+}
+func (this *ValidateRequest) Validate() error {
+	return nil
+}
+func (this *ValidateResponse) Validate() error {
+	for _, item := range this.Failed {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Failed", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *FileValidation) Validate() error {
+	return nil
+}
+func (this *DriftReportRequest) Validate() error {
+	return nil
+}
+func (this *DriftReportResponse) Validate() error {
+	for _, item := range this.Nodes {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Nodes", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *NodeDrift) Validate() error {
+	for _, item := range this.Files {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Files", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *FileDrift) Validate() error {
+	return nil
+}
+func (this *PreviewConfigRequest) Validate() error {
+	return nil
+}
+func (this *PreviewConfigResponse) Validate() error {
+	for _, item := range this.Files {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Files", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *RenderedConfig) Validate() error {
+	return nil
+}
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.6.1
-// - protoc             v7.34.1
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
 // source: configurator.proto
 
 package gen
@@ -19,9 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ConfiguratorService_ConfigEvent_FullMethodName      = "/ukama.node.configurator.v1.ConfiguratorService/ConfigEvent"
-	ConfiguratorService_ApplyConfig_FullMethodName      = "/ukama.node.configurator.v1.ConfiguratorService/ApplyConfig"
-	ConfiguratorService_GetConfigVersion_FullMethodName = "/ukama.node.configurator.v1.ConfiguratorService/GetConfigVersion"
+	ConfiguratorService_ConfigEvent_FullMethodName        = "/ukama.node.configurator.v1.ConfiguratorService/ConfigEvent"
+	ConfiguratorService_ApplyConfig_FullMethodName        = "/ukama.node.configurator.v1.ConfiguratorService/ApplyConfig"
+	ConfiguratorService_GetConfigVersion_FullMethodName   = "/ukama.node.configurator.v1.ConfiguratorService/GetConfigVersion"
+	ConfiguratorService_Promote_FullMethodName            = "/ukama.node.configurator.v1.ConfiguratorService/Promote"
+	ConfiguratorService_Rollback_FullMethodName           = "/ukama.node.configurator.v1.ConfiguratorService/Rollback"
+	ConfiguratorService_SetNodeEnvironment_FullMethodName = "/ukama.node.configurator.v1.ConfiguratorService/SetNodeEnvironment"
+	ConfiguratorService_ListPromotions_FullMethodName     = "/ukama.node.configurator.v1.ConfiguratorService/ListPromotions"
+	ConfiguratorService_Validate_FullMethodName           = "/ukama.node.configurator.v1.ConfiguratorService/Validate"
+	ConfiguratorService_GetDriftReport_FullMethodName     = "/ukama.node.configurator.v1.ConfiguratorService/GetDriftReport"
+	ConfiguratorService_PreviewConfig_FullMethodName      = "/ukama.node.configurator.v1.ConfiguratorService/PreviewConfig"
 )
 
 // ConfiguratorServiceClient is the client API for ConfiguratorService service.
@@ -33,6 +40,13 @@ type ConfiguratorServiceClient interface {
 	ConfigEvent(ctx context.Context, in *ConfigStoreEvent, opts ...grpc.CallOption) (*ConfigStoreEventResponse, error)
 	ApplyConfig(ctx context.Context, in *ApplyConfigRequest, opts ...grpc.CallOption) (*ApplyConfigResponse, error)
 	GetConfigVersion(ctx context.Context, in *ConfigVersionRequest, opts ...grpc.CallOption) (*ConfigVersionResponse, error)
+	Promote(ctx context.Context, in *PromoteRequest, opts ...grpc.CallOption) (*PromoteResponse, error)
+	Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error)
+	SetNodeEnvironment(ctx context.Context, in *SetNodeEnvironmentRequest, opts ...grpc.CallOption) (*SetNodeEnvironmentResponse, error)
+	ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error)
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+	GetDriftReport(ctx context.Context, in *DriftReportRequest, opts ...grpc.CallOption) (*DriftReportResponse, error)
+	PreviewConfig(ctx context.Context, in *PreviewConfigRequest, opts ...grpc.CallOption) (*PreviewConfigResponse, error)
 }
 
 type configuratorServiceClient struct {
@@ -73,6 +87,76 @@ func (c *configuratorServiceClient) GetConfigVersion(ctx context.Context, in *Co
 	return out, nil
 }
 
+func (c *configuratorServiceClient) Promote(ctx context.Context, in *PromoteRequest, opts ...grpc.CallOption) (*PromoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoteResponse)
+	err := c.cc.Invoke(ctx, ConfiguratorService_Promote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configuratorServiceClient) Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackResponse)
+	err := c.cc.Invoke(ctx, ConfiguratorService_Rollback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configuratorServiceClient) SetNodeEnvironment(ctx context.Context, in *SetNodeEnvironmentRequest, opts ...grpc.CallOption) (*SetNodeEnvironmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNodeEnvironmentResponse)
+	err := c.cc.Invoke(ctx, ConfiguratorService_SetNodeEnvironment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configuratorServiceClient) ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromotionsResponse)
+	err := c.cc.Invoke(ctx, ConfiguratorService_ListPromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configuratorServiceClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, ConfiguratorService_Validate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configuratorServiceClient) GetDriftReport(ctx context.Context, in *DriftReportRequest, opts ...grpc.CallOption) (*DriftReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriftReportResponse)
+	err := c.cc.Invoke(ctx, ConfiguratorService_GetDriftReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configuratorServiceClient) PreviewConfig(ctx context.Context, in *PreviewConfigRequest, opts ...grpc.CallOption) (*PreviewConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewConfigResponse)
+	err := c.cc.Invoke(ctx, ConfiguratorService_PreviewConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConfiguratorServiceServer is the server API for ConfiguratorService service.
 // All implementations must embed UnimplementedConfiguratorServiceServer
 // for forward compatibility.
@@ -82,6 +166,13 @@ type ConfiguratorServiceServer interface {
 	ConfigEvent(context.Context, *ConfigStoreEvent) (*ConfigStoreEventResponse, error)
 	ApplyConfig(context.Context, *ApplyConfigRequest) (*ApplyConfigResponse, error)
 	GetConfigVersion(context.Context, *ConfigVersionRequest) (*ConfigVersionResponse, error)
+	Promote(context.Context, *PromoteRequest) (*PromoteResponse, error)
+	Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error)
+	SetNodeEnvironment(context.Context, *SetNodeEnvironmentRequest) (*SetNodeEnvironmentResponse, error)
+	ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error)
+	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	GetDriftReport(context.Context, *DriftReportRequest) (*DriftReportResponse, error)
+	PreviewConfig(context.Context, *PreviewConfigRequest) (*PreviewConfigResponse, error)
 	mustEmbedUnimplementedConfiguratorServiceServer()
 }
 
@@ -93,13 +184,34 @@ type ConfiguratorServiceServer interface {
 type UnimplementedConfiguratorServiceServer struct{}
 
 func (UnimplementedConfiguratorServiceServer) ConfigEvent(context.Context, *ConfigStoreEvent) (*ConfigStoreEventResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ConfigEvent not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigEvent not implemented")
 }
 func (UnimplementedConfiguratorServiceServer) ApplyConfig(context.Context, *ApplyConfigRequest) (*ApplyConfigResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ApplyConfig not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyConfig not implemented")
 }
 func (UnimplementedConfiguratorServiceServer) GetConfigVersion(context.Context, *ConfigVersionRequest) (*ConfigVersionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetConfigVersion not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigVersion not implemented")
+}
+func (UnimplementedConfiguratorServiceServer) Promote(context.Context, *PromoteRequest) (*PromoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Promote not implemented")
+}
+func (UnimplementedConfiguratorServiceServer) Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
+func (UnimplementedConfiguratorServiceServer) SetNodeEnvironment(context.Context, *SetNodeEnvironmentRequest) (*SetNodeEnvironmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNodeEnvironment not implemented")
+}
+func (UnimplementedConfiguratorServiceServer) ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromotions not implemented")
+}
+func (UnimplementedConfiguratorServiceServer) Validate(context.Context, *ValidateRequest) (*ValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedConfiguratorServiceServer) GetDriftReport(context.Context, *DriftReportRequest) (*DriftReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriftReport not implemented")
+}
+func (UnimplementedConfiguratorServiceServer) PreviewConfig(context.Context, *PreviewConfigRequest) (*PreviewConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewConfig not implemented")
 }
 func (UnimplementedConfiguratorServiceServer) mustEmbedUnimplementedConfiguratorServiceServer() {}
 func (UnimplementedConfiguratorServiceServer) testEmbeddedByValue()                             {}
@@ -112,7 +224,7 @@ type UnsafeConfiguratorServiceServer interface {
 }
 
 func RegisterConfiguratorServiceServer(s grpc.ServiceRegistrar, srv ConfiguratorServiceServer) {
-	// If the following call panics, it indicates UnimplementedConfiguratorServiceServer was
+	// If the following call pancis, it indicates UnimplementedConfiguratorServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
@@ -176,6 +288,132 @@ func _ConfiguratorService_GetConfigVersion_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConfiguratorService_Promote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfiguratorServiceServer).Promote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfiguratorService_Promote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfiguratorServiceServer).Promote(ctx, req.(*PromoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfiguratorService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfiguratorServiceServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfiguratorService_Rollback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfiguratorServiceServer).Rollback(ctx, req.(*RollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfiguratorService_SetNodeEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNodeEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfiguratorServiceServer).SetNodeEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfiguratorService_SetNodeEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfiguratorServiceServer).SetNodeEnvironment(ctx, req.(*SetNodeEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfiguratorService_ListPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromotionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfiguratorServiceServer).ListPromotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfiguratorService_ListPromotions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfiguratorServiceServer).ListPromotions(ctx, req.(*ListPromotionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfiguratorService_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfiguratorServiceServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfiguratorService_Validate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfiguratorServiceServer).Validate(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfiguratorService_GetDriftReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriftReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfiguratorServiceServer).GetDriftReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfiguratorService_GetDriftReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfiguratorServiceServer).GetDriftReport(ctx, req.(*DriftReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfiguratorService_PreviewConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfiguratorServiceServer).PreviewConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfiguratorService_PreviewConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfiguratorServiceServer).PreviewConfig(ctx, req.(*PreviewConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ConfiguratorService_ServiceDesc is the grpc.ServiceDesc for ConfiguratorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -195,6 +433,34 @@ var ConfiguratorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfigVersion",
 			Handler:    _ConfiguratorService_GetConfigVersion_Handler,
 		},
+		{
+			MethodName: "Promote",
+			Handler:    _ConfiguratorService_Promote_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _ConfiguratorService_Rollback_Handler,
+		},
+		{
+			MethodName: "SetNodeEnvironment",
+			Handler:    _ConfiguratorService_SetNodeEnvironment_Handler,
+		},
+		{
+			MethodName: "ListPromotions",
+			Handler:    _ConfiguratorService_ListPromotions_Handler,
+		},
+		{
+			MethodName: "Validate",
+			Handler:    _ConfiguratorService_Validate_Handler,
+		},
+		{
+			MethodName: "GetDriftReport",
+			Handler:    _ConfiguratorService_GetDriftReport_Handler,
+		},
+		{
+			MethodName: "PreviewConfig",
+			Handler:    _ConfiguratorService_PreviewConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "configurator.proto",
@@ -127,6 +127,265 @@ func (_m *ConfiguratorServiceClient) GetConfigVersion(ctx context.Context, in *g
 	return r0, r1
 }
 
+// GetDriftReport provides a mock function with given fields: ctx, in, opts
+func (_m *ConfiguratorServiceClient) GetDriftReport(ctx context.Context, in *gen.DriftReportRequest, opts ...grpc.CallOption) (*gen.DriftReportResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDriftReport")
+	}
+
+	var r0 *gen.DriftReportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DriftReportRequest, ...grpc.CallOption) (*gen.DriftReportResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DriftReportRequest, ...grpc.CallOption) *gen.DriftReportResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DriftReportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DriftReportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPromotions provides a mock function with given fields: ctx, in, opts
+func (_m *ConfiguratorServiceClient) ListPromotions(ctx context.Context, in *gen.ListPromotionsRequest, opts ...grpc.CallOption) (*gen.ListPromotionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPromotions")
+	}
+
+	var r0 *gen.ListPromotionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPromotionsRequest, ...grpc.CallOption) (*gen.ListPromotionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPromotionsRequest, ...grpc.CallOption) *gen.ListPromotionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListPromotionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListPromotionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreviewConfig provides a mock function with given fields: ctx, in, opts
+func (_m *ConfiguratorServiceClient) PreviewConfig(ctx context.Context, in *gen.PreviewConfigRequest, opts ...grpc.CallOption) (*gen.PreviewConfigResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewConfig")
+	}
+
+	var r0 *gen.PreviewConfigResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.PreviewConfigRequest, ...grpc.CallOption) (*gen.PreviewConfigResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.PreviewConfigRequest, ...grpc.CallOption) *gen.PreviewConfigResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PreviewConfigResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.PreviewConfigRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Promote provides a mock function with given fields: ctx, in, opts
+func (_m *ConfiguratorServiceClient) Promote(ctx context.Context, in *gen.PromoteRequest, opts ...grpc.CallOption) (*gen.PromoteResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Promote")
+	}
+
+	var r0 *gen.PromoteResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.PromoteRequest, ...grpc.CallOption) (*gen.PromoteResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.PromoteRequest, ...grpc.CallOption) *gen.PromoteResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PromoteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.PromoteRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Rollback provides a mock function with given fields: ctx, in, opts
+func (_m *ConfiguratorServiceClient) Rollback(ctx context.Context, in *gen.RollbackRequest, opts ...grpc.CallOption) (*gen.RollbackResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 *gen.RollbackResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RollbackRequest, ...grpc.CallOption) (*gen.RollbackResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RollbackRequest, ...grpc.CallOption) *gen.RollbackResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RollbackResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RollbackRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetNodeEnvironment provides a mock function with given fields: ctx, in, opts
+func (_m *ConfiguratorServiceClient) SetNodeEnvironment(ctx context.Context, in *gen.SetNodeEnvironmentRequest, opts ...grpc.CallOption) (*gen.SetNodeEnvironmentResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetNodeEnvironment")
+	}
+
+	var r0 *gen.SetNodeEnvironmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetNodeEnvironmentRequest, ...grpc.CallOption) (*gen.SetNodeEnvironmentResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetNodeEnvironmentRequest, ...grpc.CallOption) *gen.SetNodeEnvironmentResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.SetNodeEnvironmentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.SetNodeEnvironmentRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Validate provides a mock function with given fields: ctx, in, opts
+func (_m *ConfiguratorServiceClient) Validate(ctx context.Context, in *gen.ValidateRequest, opts ...grpc.CallOption) (*gen.ValidateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Validate")
+	}
+
+	var r0 *gen.ValidateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ValidateRequest, ...grpc.CallOption) (*gen.ValidateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ValidateRequest, ...grpc.CallOption) *gen.ValidateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ValidateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ValidateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewConfiguratorServiceClient creates a new instance of ConfiguratorServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewConfiguratorServiceClient(t interface {
@@ -104,6 +104,216 @@ func (_m *ConfiguratorServiceServer) GetConfigVersion(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
+// GetDriftReport provides a mock function with given fields: _a0, _a1
+func (_m *ConfiguratorServiceServer) GetDriftReport(_a0 context.Context, _a1 *gen.DriftReportRequest) (*gen.DriftReportResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDriftReport")
+	}
+
+	var r0 *gen.DriftReportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DriftReportRequest) (*gen.DriftReportResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DriftReportRequest) *gen.DriftReportResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DriftReportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DriftReportRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPromotions provides a mock function with given fields: _a0, _a1
+func (_m *ConfiguratorServiceServer) ListPromotions(_a0 context.Context, _a1 *gen.ListPromotionsRequest) (*gen.ListPromotionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPromotions")
+	}
+
+	var r0 *gen.ListPromotionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPromotionsRequest) (*gen.ListPromotionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListPromotionsRequest) *gen.ListPromotionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListPromotionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListPromotionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreviewConfig provides a mock function with given fields: _a0, _a1
+func (_m *ConfiguratorServiceServer) PreviewConfig(_a0 context.Context, _a1 *gen.PreviewConfigRequest) (*gen.PreviewConfigResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewConfig")
+	}
+
+	var r0 *gen.PreviewConfigResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.PreviewConfigRequest) (*gen.PreviewConfigResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.PreviewConfigRequest) *gen.PreviewConfigResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PreviewConfigResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.PreviewConfigRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Promote provides a mock function with given fields: _a0, _a1
+func (_m *ConfiguratorServiceServer) Promote(_a0 context.Context, _a1 *gen.PromoteRequest) (*gen.PromoteResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Promote")
+	}
+
+	var r0 *gen.PromoteResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.PromoteRequest) (*gen.PromoteResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.PromoteRequest) *gen.PromoteResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PromoteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.PromoteRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Rollback provides a mock function with given fields: _a0, _a1
+func (_m *ConfiguratorServiceServer) Rollback(_a0 context.Context, _a1 *gen.RollbackRequest) (*gen.RollbackResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 *gen.RollbackResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RollbackRequest) (*gen.RollbackResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RollbackRequest) *gen.RollbackResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RollbackResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RollbackRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetNodeEnvironment provides a mock function with given fields: _a0, _a1
+func (_m *ConfiguratorServiceServer) SetNodeEnvironment(_a0 context.Context, _a1 *gen.SetNodeEnvironmentRequest) (*gen.SetNodeEnvironmentResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetNodeEnvironment")
+	}
+
+	var r0 *gen.SetNodeEnvironmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetNodeEnvironmentRequest) (*gen.SetNodeEnvironmentResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetNodeEnvironmentRequest) *gen.SetNodeEnvironmentResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.SetNodeEnvironmentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.SetNodeEnvironmentRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Validate provides a mock function with given fields: _a0, _a1
+func (_m *ConfiguratorServiceServer) Validate(_a0 context.Context, _a1 *gen.ValidateRequest) (*gen.ValidateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Validate")
+	}
+
+	var r0 *gen.ValidateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ValidateRequest) (*gen.ValidateResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ValidateRequest) *gen.ValidateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ValidateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ValidateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // mustEmbedUnimplementedConfiguratorServiceServer provides a mock function with no fields
 func (_m *ConfiguratorServiceServer) mustEmbedUnimplementedConfiguratorServiceServer() {
 	_m.Called()
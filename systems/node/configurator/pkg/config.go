@@ -12,6 +12,12 @@ import (
 	"time"
 
 	uconf "github.com/ukama/ukama/systems/common/config"
+	metric "github.com/ukama/ukama/systems/common/metrics"
+)
+
+const (
+	NumberOfDriftedNodes = "number_of_config_drifted_nodes"
+	GaugeType            = "gauge"
 )
 
 type Config struct {
@@ -29,6 +35,28 @@ type Config struct {
 	AccessToken      string
 	Http             HttpServices
 	Operation        OperationServices
+	Validation       ValidationConfig
+	Drift            DriftConfig
+	Pushgateway      string `default:"http://localhost:9091"`
+}
+
+type ValidationConfig struct {
+	SchemaDir string `default:"schemas"` /* Dir in config store holding per-app JSON schemas */
+	Strict    bool   `default:"false"`   /* Reject config files without a schema */
+}
+
+type DriftConfig struct {
+	AutoReconcile     bool          `default:"false"` /* Re-push expected commit to drifted nodes */
+	ReconcileInterval time.Duration `default:"10m"`   /* Minimum time between two re-pushes to the same node */
+}
+
+var DriftMetrics = []metric.MetricConfig{
+	{
+		Name:   NumberOfDriftedNodes,
+		Type:   GaugeType,
+		Labels: map[string]string{"service": "configurator"},
+		Value:  0,
+	},
 }
 
 type HttpServices struct {
@@ -52,6 +80,7 @@ func NewConfig(name string) *Config {
 			ListenerRoutes: []string{
 				"event.cloud.local.{{ .Org}}.registry.node.node.create", // TBU This event should be generated by node service in registry after successful addition or may be on first successful health update (second feels better option)
 				"event.node.local.{{ .Org}}.messaging.mesh.config.create",
+				"event.node.local.{{ .Org}}.messaging.mesh.config.report",
 			},
 		},
 		Operation: OperationServices{
@@ -59,5 +88,11 @@ func NewConfig(name string) *Config {
 			Timeout:     5 * time.Second,
 			LeaseSecs:   1800,
 		},
+		Validation: ValidationConfig{
+			SchemaDir: "schemas",
+		},
+		Drift: DriftConfig{
+			ReconcileInterval: 10 * time.Minute,
+		},
 	}
 }
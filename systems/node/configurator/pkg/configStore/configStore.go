@@ -10,6 +10,8 @@ package configstore
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -21,12 +23,14 @@ import (
 	"github.com/ukama/ukama/systems/node/configurator/pkg"
 	"github.com/ukama/ukama/systems/node/configurator/pkg/db"
 	"github.com/ukama/ukama/systems/node/configurator/pkg/providers"
+	"github.com/ukama/ukama/systems/node/configurator/pkg/render"
 
 	log "github.com/sirupsen/logrus"
 	mb "github.com/ukama/ukama/systems/common/msgBusServiceClient"
 	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
 	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
 	utils "github.com/ukama/ukama/systems/node/configurator/pkg/utils"
+	"github.com/ukama/ukama/systems/node/configurator/pkg/validator"
 )
 
 type ConfigStore struct {
@@ -36,8 +40,13 @@ type ConfigStore struct {
 	siteClient           creg.SiteClient
 	nodeClient           creg.NodeClient
 	NodeFeederRoutingKey msgbus.RoutingKeyBuilder
+	configRoutingKey     msgbus.RoutingKeyBuilder
+	validator            *validator.Validator
+	renderer             *render.Renderer
 	configRepo           db.ConfigRepo
 	commitRepo           db.CommitRepo
+	driftRepo            db.DriftRepo
+	promotionRepo        db.PromotionRepo
 	OrgName              string
 }
 
@@ -50,6 +59,9 @@ type ConfigStoreProvider interface {
 	HandleConfigStoreEvent(ctx context.Context) error
 	HandleConfigCommitReq(ctx context.Context, rVer string) error
 	HandleConfigCommitReqForNode(ctx context.Context, rVer string, nodeid string) error
+	ResolveConfigRef(ctx context.Context, ref string) (string, error)
+	ValidateConfigRef(ctx context.Context, ref string) (string, *validator.Report, error)
+	PreviewNodeConfig(ctx context.Context, ref string, nodeId string) (string, []render.RenderedFile, error)
 }
 
 const (
@@ -80,18 +92,29 @@ type ConfigMetaData struct {
 const DIR_PREFIX = "/tmp/configstore/"
 const PERM = 0755
 
-func NewConfigStore(msgB mb.MsgBusServiceClient, cnet creg.NetworkClient, csite creg.SiteClient, cnode creg.NodeClient, cfgDb db.ConfigRepo, cmtDb db.CommitRepo, orgName string, s providers.StoreProvider, t time.Duration) *ConfigStore {
+func NewConfigStore(msgB mb.MsgBusServiceClient, cnet creg.NetworkClient, csite creg.SiteClient, cnode creg.NodeClient, cfgDb db.ConfigRepo, cmtDb db.CommitRepo, driftDb db.DriftRepo, promDb db.PromotionRepo, orgName string, s providers.StoreProvider, t time.Duration, v *validator.Validator) *ConfigStore {
+
+	/* Templates need registry data of nodes, sites and networks */
+	var r *render.Renderer
+	if cnet != nil && csite != nil && cnode != nil {
+		r = render.NewRenderer(render.DefaultTemplateDir, orgName, cnet, csite, cnode)
+	}
 
 	return &ConfigStore{
 		Store:                s,
+		renderer:             r,
 		networkClient:        cnet,
 		siteClient:           csite,
 		nodeClient:           cnode,
 		msgbus:               msgB,
 		NodeFeederRoutingKey: msgbus.NewRoutingKeyBuilder().SetRequestType().SetCloudSource().SetSystem(pkg.SystemName).SetOrgName(orgName).SetService(pkg.ServiceName), //Need to have something same to other routes
+		configRoutingKey:     msgbus.NewRoutingKeyBuilder().SetCloudSource().SetSystem(pkg.SystemName).SetOrgName(orgName).SetService(pkg.ServiceName),
+		validator:            v,
 		OrgName:              orgName,
 		configRepo:           cfgDb,
 		commitRepo:           cmtDb,
+		driftRepo:            driftDb,
+		promotionRepo:        promDb,
 	}
 }
 
@@ -133,7 +156,8 @@ func (c *ConfigStore) HandleConfigStoreEvent(ctx context.Context) error {
 		return err
 	}
 
-	if lVer == cVerRec.Hash {
+	/* Rendered configs may still change with registry data when the config store doesn't */
+	if lVer == cVerRec.Hash && (c.renderer == nil || !c.renderer.HasTemplates(dir+providers.LATEST_DIR_NAME)) {
 		log.Infof("HandleConfigStoreEvent remote config and current commit are same %s", cVerRec.Hash)
 		return nil
 	}
@@ -144,8 +168,12 @@ func (c *ConfigStore) HandleConfigStoreEvent(ctx context.Context) error {
 		return err
 	}
 
-	return c.ProcessConfigStoreEvent(files, lVer, dir)
+	err = c.ProcessConfigStoreEvent(c.skipPromotedNodes(files, lVer), lVer, dir)
+	if err != nil {
+		return err
+	}
 
+	return c.commitRepo.Add(lVer)
 }
 
 func (c *ConfigStore) HandleConfigCommitReq(ctx context.Context, rVer string) error {
@@ -196,7 +224,12 @@ func (c *ConfigStore) HandleConfigCommitReq(ctx context.Context, rVer string) er
 		return err
 	}
 
-	return c.ProcessConfigStoreEvent(files, rVer, dir)
+	err = c.ProcessConfigStoreEvent(c.skipPromotedNodes(files, rVer), rVer, dir)
+	if err != nil {
+		return err
+	}
+
+	return c.commitRepo.Add(rVer)
 }
 
 func (c *ConfigStore) HandleConfigCommitReqForNode(ctx context.Context, rVer string, nodeid string) error {
@@ -232,9 +265,170 @@ func (c *ConfigStore) HandleConfigCommitReqForNode(ctx context.Context, rVer str
 	return c.ProcessConfigStoreEvent(files, rVer, dir)
 }
 
+func (c *ConfigStore) ResolveConfigRef(ctx context.Context, ref string) (string, error) {
+	log.Infof("ResolveConfigRef %s", ref)
+
+	dir := DIR_PREFIX + utils.RandomDirName()
+
+	err := utils.CreateDir(dir, PERM)
+	if err != nil {
+		return "", fmt.Errorf("error creating directory: %v", err)
+	}
+
+	defer func() {
+		err := utils.RemoveDir(dir)
+		if err != nil {
+			log.Errorf("error removing directory: %v", err)
+		}
+	}()
+
+	hash, err := c.Store.ResolveRef(dir, ref)
+	if err != nil {
+		log.Errorf("Failed to resolve ref %s: %v", ref, err)
+		return "", err
+	}
+
+	return hash, nil
+}
+
+/*
+ * Nodes of an environment with a promotion stay on the promoted commit, so
+ * changes of the config store only reach them once that commit is promoted.
+ * Nodes of environments without any promotion follow the config store.
+ */
+func (c *ConfigStore) skipPromotedNodes(files []FilesToUpdate, ver string) []FilesToUpdate {
+	if c.promotionRepo == nil {
+		return files
+	}
+
+	pinned := make(map[string]bool)
+	var filesToUpdate []FilesToUpdate
+	for _, f := range files {
+		md, err := ParseConfigStoreFilePath(f.Name)
+		if err != nil {
+			filesToUpdate = append(filesToUpdate, f)
+			continue
+		}
+
+		skip, ok := pinned[md.node]
+		if !ok {
+			skip = c.isPinnedNode(md.node, ver)
+			pinned[md.node] = skip
+		}
+
+		if !skip {
+			filesToUpdate = append(filesToUpdate, f)
+		}
+	}
+
+	return filesToUpdate
+}
+
+func (c *ConfigStore) isPinnedNode(nodeId string, ver string) bool {
+	cfg, err := c.configRepo.Get(nodeId)
+	if err != nil {
+		return false
+	}
+
+	p, err := c.promotionRepo.GetLatest(cfg.Environment)
+	if err != nil {
+		return false
+	}
+
+	if p.Hash != ver {
+		log.Infof("Node %s of environment %s stays on promoted commit %s", nodeId, cfg.Environment, p.Hash)
+		return true
+	}
+
+	return false
+}
+
+/* Validate every config file of a branch, tag or commit without pushing anything to nodes */
+func (c *ConfigStore) ValidateConfigRef(ctx context.Context, ref string) (string, *validator.Report, error) {
+	log.Infof("ValidateConfigRef %s", ref)
+
+	if c.validator == nil {
+		return "", nil, fmt.Errorf("config validation is not enabled")
+	}
+
+	dir := DIR_PREFIX + utils.RandomDirName()
+
+	err := utils.CreateDir(dir, PERM)
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating directory: %v", err)
+	}
+
+	defer func() {
+		err := utils.RemoveDir(dir)
+		if err != nil {
+			log.Errorf("error removing directory: %v", err)
+		}
+	}()
+
+	hash, err := c.Store.ResolveRef(dir, ref)
+	if err != nil {
+		log.Errorf("Failed to resolve ref %s: %v", ref, err)
+		return "", nil, err
+	}
+
+	err = c.Store.GetRemoteConfigVersion(dir, hash)
+	if err != nil {
+		log.Errorf("Failed to get remote configs for %s: %v", hash, err)
+		return "", nil, err
+	}
+
+	root := dir + providers.COMMIT_DIR_NAME + "/"
+
+	if c.renderer != nil {
+		if _, err := c.renderer.RenderAll(root); err != nil {
+			return "", nil, err
+		}
+	}
+
+	files, err := utils.GetFiles(root)
+	if err != nil {
+		return "", nil, err
+	}
+
+	report := &validator.Report{}
+	for _, f := range files {
+		name := strings.TrimPrefix(f, root)
+		if strings.HasPrefix(name, ".git/") || c.validator.IsSchemaFile(name) || !IfFileName(name) {
+			continue
+		}
+		if c.renderer != nil && c.renderer.IsTemplateFile(name) {
+			continue
+		}
+
+		md, err := ParseConfigStoreFilePath(name)
+		if err != nil {
+			report.Files = append(report.Files, validator.FileReport{File: name, Errors: []string{err.Error()}})
+			continue
+		}
+
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return "", nil, err
+		}
+
+		report.Files = append(report.Files, c.validator.ValidateFile(root, name, md.app, data))
+	}
+
+	return hash, report, nil
+}
+
 func (c *ConfigStore) LookingForNodeConfigs(dir string, nodeId string, rVer string) ([]FilesToUpdate, string, error) {
 	log.Infof("Looking for nodeid %s configs", nodeId)
 
+	if c.renderer != nil {
+		rendered, err := c.renderer.RenderNodeInto(dir+providers.COMMIT_DIR_NAME, nodeId)
+		if err != nil {
+			log.Errorf("Failed to render templates for node %s: %v", nodeId, err)
+			return nil, "", err
+		}
+		log.Infof("Rendered %d config files from templates for node %s", len(rendered), nodeId)
+	}
+
 	path, err := utils.FindDir(nodeId, dir+providers.COMMIT_DIR_NAME)
 	if err != nil {
 		log.Errorf("Failed to find nodeid %s config under %s dir", nodeId, dir+providers.COMMIT_DIR_NAME)
@@ -267,10 +461,39 @@ func (c *ConfigStore) LookingForChanges(dir string, cVer string, rVer string) ([
 		return nil, "", err
 	}
 
+	/* Rendered files are not in git, so render both versions and let json diff find the changes */
+	var rendered map[string]bool
+	if c.renderer != nil {
+		files, err := c.renderBoth(dir)
+		if err != nil {
+			return nil, "", err
+		}
+		filesUpdated = appendUnique(filesUpdated, files...)
+
+		rendered = make(map[string]bool, len(files))
+		for _, f := range files {
+			rendered[f] = true
+		}
+	}
+
 	var filesToUpdate []FilesToUpdate
 	cfPrefix := dir + providers.COMMIT_DIR_NAME + "/"
 	lfPrefix := dir + providers.LATEST_DIR_NAME + "/"
+	expected := make(map[string]map[string]string)
 	for _, file := range filesUpdated {
+		/* Both versions are rendered with today's registry data, so what the node got decides */
+		if rendered[file] && c.driftRepo != nil {
+			change, reason, err := c.renderedChange(lfPrefix, file, expected)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if change {
+				filesToUpdate = append(filesToUpdate, FilesToUpdate{Name: file, Reason: reason})
+			}
+			continue
+		}
+
 		_, change, reason, err := utils.JsonDiff(cfPrefix+file, lfPrefix+file)
 		if err != nil {
 			log.Errorf("Failed to get json diff between %s and %s: %v", cfPrefix+file, lfPrefix+file, err)
@@ -286,13 +509,150 @@ func (c *ConfigStore) LookingForChanges(dir string, cVer string, rVer string) ([
 	return filesToUpdate, lfPrefix, nil
 }
 
+/*
+ * Rendered file changed if it is gone from the latest version or if it differs from
+ * the one last pushed to the node, which catches registry changes the git diff can't.
+ * Expected config hashes are cached per node in expected.
+ */
+func (c *ConfigStore) renderedChange(prefix string, file string, expected map[string]map[string]string) (bool, int, error) {
+	md, err := ParseConfigStoreFilePath(file)
+	if err != nil {
+		return false, REASON_UNKNOWN, err
+	}
+
+	data, err := os.ReadFile(prefix + file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return true, REASON_DELETED, nil
+		}
+		return false, REASON_UNKNOWN, err
+	}
+
+	hashes, ok := expected[md.node]
+	if !ok {
+		ec, err := c.driftRepo.ListExpected(md.node)
+		if err != nil {
+			log.Errorf("Failed to get expected configs of node %s: %v", md.node, err)
+			return false, REASON_UNKNOWN, err
+		}
+
+		hashes = make(map[string]string, len(ec))
+		for _, e := range ec {
+			hashes[e.App+"/"+e.FileName] = e.Hash
+		}
+		expected[md.node] = hashes
+	}
+
+	hash, ok := hashes[md.app+"/"+md.fileName]
+	switch {
+	case !ok:
+		return true, REASON_ADDED, nil
+	case hash != ConfigHash(data):
+		return true, REASON_UPDATED, nil
+	}
+
+	return false, REASON_UNKNOWN, nil
+}
+
+func (c *ConfigStore) renderBoth(dir string) ([]string, error) {
+	var all []string
+	for _, d := range []string{providers.COMMIT_DIR_NAME, providers.LATEST_DIR_NAME} {
+		rendered, err := c.renderer.RenderAll(dir + d)
+		if err != nil {
+			log.Errorf("Failed to render templates in %s: %v", dir+d, err)
+			return nil, err
+		}
+		all = appendUnique(all, rendered...)
+	}
+
+	return all, nil
+}
+
+/* Render the node's templates at a branch, tag or commit without pushing anything to the node */
+func (c *ConfigStore) PreviewNodeConfig(ctx context.Context, ref string, nodeId string) (string, []render.RenderedFile, error) {
+	log.Infof("PreviewNodeConfig %s for node %s", ref, nodeId)
+
+	if c.renderer == nil {
+		return "", nil, fmt.Errorf("config templates are not enabled")
+	}
+
+	if ref == "" {
+		ref = "HEAD"
+	}
+
+	dir := DIR_PREFIX + utils.RandomDirName()
+
+	err := utils.CreateDir(dir, PERM)
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating directory: %v", err)
+	}
+
+	defer func() {
+		err := utils.RemoveDir(dir)
+		if err != nil {
+			log.Errorf("error removing directory: %v", err)
+		}
+	}()
+
+	hash, err := c.Store.ResolveRef(dir, ref)
+	if err != nil {
+		log.Errorf("Failed to resolve ref %s: %v", ref, err)
+		return "", nil, err
+	}
+
+	err = c.Store.GetRemoteConfigVersion(dir, hash)
+	if err != nil {
+		log.Errorf("Failed to get remote configs for %s: %v", hash, err)
+		return "", nil, err
+	}
+
+	nc, err := c.renderer.NodeContext(nodeId)
+	if err != nil {
+		return "", nil, err
+	}
+
+	files, err := c.renderer.RenderNode(dir+providers.COMMIT_DIR_NAME, nc)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return hash, files, nil
+}
+
+func appendUnique(list []string, items ...string) []string {
+	seen := make(map[string]bool, len(list))
+	for _, l := range list {
+		seen[l] = true
+	}
+
+	for _, i := range items {
+		if !seen[i] {
+			seen[i] = true
+			list = append(list, i)
+		}
+	}
+
+	return list
+}
+
 func (c *ConfigStore) ProcessConfigStoreEvent(filesToUpdate []FilesToUpdate, rVer string, dir string) error {
 
 	if len(filesToUpdate) > 0 {
 		prepCommit := make(map[string]*ConfigData, len(filesToUpdate)) /* /* Map from file to config app, and real config files data*/
 		prepNodeCommit := make(map[string][]string)                    /* Map from nodeId to config files*/
 		prepMetaData := make(map[string]*ConfigMetaData)
+		report := &validator.Report{}
 		for _, file := range filesToUpdate {
+			/* Schemas live in the config store but are never pushed to nodes */
+			if c.validator != nil && c.validator.IsSchemaFile(file.Name) {
+				continue
+			}
+
+			/* Templates only reach nodes as rendered files */
+			if c.renderer != nil && c.renderer.IsTemplateFile(file.Name) {
+				continue
+			}
+
 			/* Get the meta information about config from the path of the filename
 			  networkABC/site123/uk-sa1000-HNODE-2145/epc/sctp.json
 				Org:ukama
@@ -317,11 +677,22 @@ func (c *ConfigStore) ProcessConfigStoreEvent(filesToUpdate []FilesToUpdate, rVe
 			configToCommit.Reason = file.Reason
 			configToCommit.Version = rVer
 
+			if c.validator != nil && file.Reason != REASON_DELETED {
+				report.Files = append(report.Files, c.validator.ValidateFile(dir, file.Name, cMetaData.app, configToCommit.Data))
+			}
+
 			prepMetaData[file.Name] = cMetaData
 			prepCommit[file.Name] = configToCommit
 			prepNodeCommit[cMetaData.node] = append(prepNodeCommit[cMetaData.node], file.Name)
 		}
 
+		/* A single invalid file rejects the whole commit so nodes never get a partial config */
+		if !report.Valid() {
+			log.Errorf("Rejecting config commit %s. %s", rVer, report.Error())
+			c.publishValidationFailure(rVer, report)
+			return report
+		}
+
 		err := c.CommitConfig(prepCommit, prepNodeCommit, prepMetaData, rVer)
 		if err != nil {
 			return err
@@ -334,6 +705,27 @@ func (c *ConfigStore) ProcessConfigStoreEvent(filesToUpdate []FilesToUpdate, rVe
 	return nil
 }
 
+func (c *ConfigStore) publishValidationFailure(commit string, report *validator.Report) {
+	evt := &epb.ConfigValidationFailedEvent{
+		Commit: commit,
+	}
+
+	for _, f := range report.Failed() {
+		evt.Files = append(evt.Files, &epb.ConfigFileValidation{
+			File:   f.File,
+			App:    f.App,
+			Schema: f.Schema,
+			Errors: f.Errors,
+		})
+	}
+
+	route := c.configRoutingKey.SetAction("reject").SetObject("config").MustBuild()
+	err := c.msgbus.PublishRequest(route, evt)
+	if err != nil {
+		log.Errorf("Failed to publish message %+v with key %+v. Errors %s", evt, route, err.Error())
+	}
+}
+
 /* Parse ukama/networkABC/site123/uk-sa1000-HNODE-2145/epc/sctp.json */
 func ParseConfigStoreFilePath(path string) (*ConfigMetaData, error) {
 
@@ -476,6 +868,8 @@ func (c *ConfigStore) CommitConfig(m map[string]*ConfigData, nodes map[string][]
 			}
 			log.Infof("Published config %s  with timestamp %d on route %s for node %s ", msg, t, route, n)
 
+			c.recordExpectedConfig(n, cd, commit)
+
 			/* Atleast one is success */
 			state = db.Partial
 		}
@@ -498,13 +892,22 @@ func (c *ConfigStore) CommitConfig(m map[string]*ConfigData, nodes map[string][]
 			return err
 		}
 
-		cRec.Commit = db.Commit{Hash: commit}
-
-		/* Get commit */
+		/* Commits only pushed by a promotion or rollback must not become the config store head */
 		cmt, err := c.commitRepo.Get(commit)
-		if err == nil {
-			cRec.Commit = *cmt
+		if err != nil {
+			err = c.commitRepo.AddPinned(commit)
+			if err != nil {
+				log.Errorf("Failed to add commit %s: %v", commit, err)
+				return err
+			}
+
+			cmt, err = c.commitRepo.Get(commit)
+			if err != nil {
+				log.Errorf("Failed to get commit %s: %v", commit, err)
+				return err
+			}
 		}
+		cRec.Commit = *cmt
 
 		err = c.configRepo.UpdateLastCommit(*cRec, &state)
 		if err != nil {
@@ -517,6 +920,37 @@ func (c *ConfigStore) CommitConfig(m map[string]*ConfigData, nodes map[string][]
 	return nil
 }
 
+/* Remember what the node should have on disk so its config reports can be checked for drift */
+func (c *ConfigStore) recordExpectedConfig(nodeId string, cd *ConfigData, commit string) {
+	if c.driftRepo == nil {
+		return
+	}
+
+	var err error
+	if cd.Reason == REASON_DELETED {
+		err = c.driftRepo.DeleteExpected(nodeId, cd.App, cd.FileName)
+	} else {
+		err = c.driftRepo.SetExpected(&db.ExpectedConfig{
+			NodeId:   nodeId,
+			App:      cd.App,
+			FileName: cd.FileName,
+			Hash:     ConfigHash(cd.Data),
+			Commit:   commit,
+		})
+	}
+
+	if err != nil {
+		log.Errorf("Failed to record expected config %s/%s for node %s: %v", cd.App, cd.FileName, nodeId, err)
+	}
+}
+
+/* ConfigHash is the hash nodes are expected to report for a config file */
+func ConfigHash(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
 func (c *ConfigStore) PublishCommitInfo(m *ConfigMetaData, route string, ver string, t uint32, count int) error {
 	m.app = "configd"
 	m.fileName = "version.json"
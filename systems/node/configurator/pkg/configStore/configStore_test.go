@@ -12,6 +12,7 @@ import (
 	"context"
 	"database/sql"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -20,8 +21,11 @@ import (
 	"github.com/tj/assert"
 	"github.com/ukama/ukama/systems/node/configurator/mocks"
 	"github.com/ukama/ukama/systems/node/configurator/pkg/db"
+	"github.com/ukama/ukama/systems/node/configurator/pkg/validator"
 
 	mbmocks "github.com/ukama/ukama/systems/common/mocks"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
 )
 
 const OrgName = "testorg"
@@ -39,7 +43,7 @@ func TestConfigStore_HandleConfigStoreEvent(t *testing.T) {
 	configRepo := &mocks.ConfigRepo{}
 	store := &mocks.StoreProvider{}
 
-	cS := NewConfigStore(msgbusClient, nil, nil, nil, configRepo, commitRepo, OrgName, store, (10 * time.Second))
+	cS := NewConfigStore(msgbusClient, nil, nil, nil, configRepo, commitRepo, nil, nil, OrgName, store, (10 * time.Second), nil)
 	t.Run("SameVersion", func(t *testing.T) {
 		store.On("GetLatestRemoteConfigs", mock.Anything).Return("000", nil).Once()
 		commitRepo.On("GetLatest").Return(&db.Commit{Hash: "000"}, nil).Once()
@@ -54,6 +58,7 @@ func TestConfigStore_HandleConfigStoreEvent(t *testing.T) {
 		commitRepo.On("GetLatest").Return(&db.Commit{Hash: "001"}, nil).Once()
 		store.On("GetRemoteConfigVersion", mock.Anything, mock.Anything).Return(nil).Once()
 		store.On("GetDiff", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		commitRepo.On("Add", "000").Return(nil).Once()
 		err := cS.HandleConfigStoreEvent(context.Background())
 		assert.NoError(t, err)
 		commitRepo.AssertExpectations(t)
@@ -72,7 +77,7 @@ func TestConfigStore_ProcessConfigStoreEvent(t *testing.T) {
 	assert.NoError(t, err)
 	p := strings.Split(path, Service)
 	dir := p[0] + TestData
-	cS := NewConfigStore(msgbusClient, nil, nil, nil, configRepo, commitRepo, OrgName, store, (10 * time.Second))
+	cS := NewConfigStore(msgbusClient, nil, nil, nil, configRepo, commitRepo, nil, nil, OrgName, store, (10 * time.Second), nil)
 
 	t.Run("DifferentVersionWithChanges", func(t *testing.T) {
 		var node string
@@ -91,13 +96,108 @@ func TestConfigStore_ProcessConfigStoreEvent(t *testing.T) {
 		})).Return(&db.Configuration{NodeId: node}, nil)
 
 		msgbusClient.On("PublishRequest", mock.AnythingOfType("string"), mock.Anything).Return(nil)
-		commitRepo.On("Get", mock.AnythingOfType("string"), mock.Anything).Return(nil, sql.ErrNoRows)
+		commitRepo.On("Get", cVer).Return(nil, sql.ErrNoRows).Once()
+		commitRepo.On("AddPinned", cVer).Return(nil).Once()
+		commitRepo.On("Get", cVer).Return(&db.Commit{Hash: cVer, Pinned: true}, nil)
 		configRepo.On("UpdateLastCommit", mock.Anything, mock.MatchedBy(func(a *db.CommitState) bool { return a != nil && *a == db.Published })).Return(nil)
 		files, ldir, err := cS.LookingForChanges(dir, cVer, rVer)
 		assert.NoError(t, err)
 		err = cS.ProcessConfigStoreEvent(files, cVer, ldir)
 		assert.NoError(t, err)
 		configRepo.AssertExpectations(t)
+		commitRepo.AssertExpectations(t)
 	})
 
 }
+
+func TestConfigStore_LookingForRenderedChanges(t *testing.T) {
+	cnet := &mbmocks.NetworkClient{}
+	csite := &mbmocks.SiteClient{}
+	cnode := &mbmocks.NodeClient{}
+	driftRepo := &mocks.DriftRepo{}
+	store := &mocks.StoreProvider{}
+	file1 := "networkABC/siteXYZ/" + testNode1 + "/epc/epc.json"
+
+	/* Template is the same in both versions, only the site was renamed in the registry */
+	dir := t.TempDir()
+	for _, d := range []string{"/commit", "/latest"} {
+		assert.NoError(t, os.MkdirAll(dir+d+"/templates/epc", 0755))
+		assert.NoError(t, os.WriteFile(dir+d+"/templates/epc/epc.json.tmpl", []byte(`{"site": "{{ .Site.Name }}"}`), 0644))
+	}
+
+	cnet.On("Get", "net-1").Return(&creg.NetworkInfo{Id: "net-1", Name: "networkABC"}, nil)
+	csite.On("Get", "site-1").Return(&creg.SiteInfo{Id: "site-1", Name: "siteXYZ", NetworkId: "net-1"}, nil)
+	cnode.On("GetAll").Return(&creg.Nodes{Nodes: []*creg.NodeInfo{
+		{Id: testNode1, Type: "hnode", Site: creg.NodeSiteInfo{SiteId: "site-1", NetworkId: "net-1"}},
+		{Id: testNode2, Type: "hnode", Site: creg.NodeSiteInfo{SiteId: "site-1", NetworkId: "net-1"}},
+	}}, nil)
+	store.On("GetDiff", "000", "001", dir+"/latest").Return(nil, nil).Once()
+	driftRepo.On("ListExpected", testNode1).Return([]db.ExpectedConfig{
+		{NodeId: testNode1, App: "epc", FileName: "epc.json", Hash: ConfigHash([]byte(`{"site": "siteOld"}`))},
+	}, nil).Once()
+	driftRepo.On("ListExpected", testNode2).Return([]db.ExpectedConfig{
+		{NodeId: testNode2, App: "epc", FileName: "epc.json", Hash: ConfigHash([]byte(`{"site": "siteXYZ"}`))},
+	}, nil).Once()
+
+	cS := NewConfigStore(&mbmocks.MsgBusServiceClient{}, cnet, csite, cnode, &mocks.ConfigRepo{}, &mocks.CommitRepo{}, driftRepo, nil,
+		OrgName, store, (10 * time.Second), nil)
+
+	files, _, err := cS.LookingForChanges(dir, "000", "001")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []FilesToUpdate{{Name: file1, Reason: REASON_UPDATED}}, files)
+	driftRepo.AssertExpectations(t)
+}
+
+func TestConfigStore_SkipPromotedNodes(t *testing.T) {
+	configRepo := &mocks.ConfigRepo{}
+	promotionRepo := &mocks.PromotionRepo{}
+	files := []FilesToUpdate{
+		{Name: "networkABC/siteXYZ/uk-000000-hnode-0000/epc/epc.json", Reason: REASON_UPDATED},
+		{Name: "networkABC/siteXYZ/uk-000000-hnode-0000/deviced/deviced.json", Reason: REASON_UPDATED},
+		{Name: "networkABC/siteXYZ/uk-000000-hnode-0001/epc/epc.json", Reason: REASON_UPDATED},
+	}
+
+	cS := NewConfigStore(&mbmocks.MsgBusServiceClient{}, nil, nil, nil, configRepo, &mocks.CommitRepo{}, nil, promotionRepo,
+		OrgName, &mocks.StoreProvider{}, (10 * time.Second), nil)
+
+	configRepo.On("Get", testNode1).Return(&db.Configuration{NodeId: testNode1, Environment: "production"}, nil).Once()
+	configRepo.On("Get", testNode2).Return(&db.Configuration{NodeId: testNode2, Environment: "staging"}, nil).Once()
+	promotionRepo.On("GetLatest", "production").Return(&db.Promotion{Environment: "production", Hash: "0.0.0"}, nil).Once()
+	promotionRepo.On("GetLatest", "staging").Return(nil, sql.ErrNoRows).Once()
+
+	filesToUpdate := cS.skipPromotedNodes(files, "0.0.1")
+
+	assert.Equal(t, []FilesToUpdate{files[2]}, filesToUpdate)
+	configRepo.AssertExpectations(t)
+	promotionRepo.AssertExpectations(t)
+}
+
+func TestConfigStore_ProcessConfigStoreEventValidation(t *testing.T) {
+	msgbusClient := &mbmocks.MsgBusServiceClient{}
+	configRepo := &mocks.ConfigRepo{}
+	file := "networkABC/siteXYZ/uk-000000-hnode-0000/epc/epc.json"
+
+	dir := t.TempDir() + "/"
+	assert.NoError(t, os.MkdirAll(filepath.Dir(dir+file), 0755))
+	assert.NoError(t, os.MkdirAll(dir+"schemas", 0755))
+	assert.NoError(t, os.WriteFile(dir+"schemas/epc.schema.json", []byte(`{"type":"object","required":["config"]}`), 0644))
+	assert.NoError(t, os.WriteFile(dir+file, []byte(`{"name":"epc.json"}`), 0644))
+
+	cS := NewConfigStore(msgbusClient, nil, nil, nil, configRepo, &mocks.CommitRepo{}, nil, nil, OrgName, &mocks.StoreProvider{},
+		(10 * time.Second), validator.NewValidator("schemas", false))
+
+	msgbusClient.On("PublishRequest", mock.MatchedBy(func(r string) bool { return strings.HasSuffix(r, "config.reject") }),
+		mock.MatchedBy(func(e *epb.ConfigValidationFailedEvent) bool {
+			return e.Commit == "0.0.1" && len(e.Files) == 1 && e.Files[0].File == file && e.Files[0].Schema == "schemas/epc.schema.json"
+		})).Return(nil).Once()
+
+	err := cS.ProcessConfigStoreEvent([]FilesToUpdate{
+		{Name: file, Reason: REASON_UPDATED},
+		{Name: "schemas/epc.schema.json", Reason: REASON_ADDED},
+	}, "0.0.1", dir)
+
+	assert.Error(t, err)
+	msgbusClient.AssertExpectations(t)
+	configRepo.AssertNotCalled(t, "Get", mock.Anything)
+}
@@ -9,6 +9,8 @@
 package db
 
 import (
+	"time"
+
 	"github.com/ukama/ukama/systems/common/sql"
 
 	"gorm.io/gorm/clause"
@@ -16,6 +18,7 @@ import (
 
 type CommitRepo interface {
 	Add(hash string) error
+	AddPinned(hash string) error
 	Get(hash string) (*Commit, error)
 	GetAll() ([]Commit, error)
 	GetLatest() (*Commit, error)
@@ -31,11 +34,27 @@ func NewCommitRepo(db sql.Db) CommitRepo {
 	}
 }
 
+/* Add records hash as the config store head, which GetLatest diffs the next store change against */
 func (n *commitRepo) Add(hash string) error {
 	commit := Commit{
 		Hash: hash,
 	}
 
+	r := n.Db.GetGormDb().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "hash"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"pinned": false, "updated_at": time.Now()}),
+	}).Create(&commit)
+
+	return r.Error
+}
+
+/* AddPinned records a commit nodes were pushed to without moving the config store head */
+func (n *commitRepo) AddPinned(hash string) error {
+	commit := Commit{
+		Hash:   hash,
+		Pinned: true,
+	}
+
 	r := n.Db.GetGormDb().Clauses(clause.OnConflict{
 		Columns:   []clause.Column{{Name: "hash"}},
 		DoNothing: true,
@@ -70,7 +89,7 @@ func (n *commitRepo) GetAll() ([]Commit, error) {
 func (n *commitRepo) GetLatest() (*Commit, error) {
 	var commit Commit
 
-	result := n.Db.GetGormDb().Last(&commit)
+	result := n.Db.GetGormDb().Where("pinned = ?", false).Order("updated_at desc").First(&commit)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -200,7 +200,8 @@ func TestCommitRepo_Get(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"hash"}).
 			AddRow(hash0)
 
-		mock.ExpectQuery(`^SELECT.*commits.*`).
+		mock.ExpectQuery(`^SELECT.*commits.*pinned.*ORDER BY updated_at desc`).
+			WithArgs(false, sqlmock.AnyArg()).
 			WillReturnRows(rows)
 
 		dialector := postgres.New(postgres.Config{
@@ -252,7 +253,7 @@ func TestCommitRepo_Add(t *testing.T) {
 		mock.ExpectBegin()
 
 		mock.ExpectQuery(regexp.QuoteMeta(`INSERT`)).
-			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), commit.Hash).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), commit.Hash, false, false, sqlmock.AnyArg()).
 			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 
 		mock.ExpectCommit()
@@ -282,4 +283,48 @@ func TestCommitRepo_Add(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("AddPinnedCommit", func(t *testing.T) {
+		// Arrange
+
+		const hash0 = "6b0a48e3d06ae7708b2257321d17b36bd930f670"
+
+		var db *extsql.DB
+		var err error
+
+		db, mock, err := sqlmock.New() // mock sql.DB
+		assert.NoError(t, err)
+
+		mock.ExpectBegin()
+
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT`)+`.*DO NOTHING`).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), hash0, true).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+		mock.ExpectCommit()
+
+		dialector := postgres.New(postgres.Config{
+			DSN:                  "sqlmock_db_0",
+			DriverName:           "postgres",
+			Conn:                 db,
+			PreferSimpleProtocol: true,
+		})
+		gdb, err := gorm.Open(dialector, &gorm.Config{})
+		assert.NoError(t, err)
+
+		r := int_db.NewCommitRepo(&UkamaDbMock{
+			GormDb: gdb,
+		})
+
+		assert.NoError(t, err)
+
+		// Act
+		err = r.AddPinned(hash0)
+
+		// Assert
+		assert.NoError(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err)
+	})
+
 }
@@ -22,6 +22,8 @@ type ConfigRepo interface {
 	Add(id string) error
 	Get(id string) (*Configuration, error)
 	GetAll() ([]Configuration, error)
+	ListByEnvironment(env string) ([]Configuration, error)
+	UpdateEnvironment(nodeid string, env string) error
 	Delete(id string) error
 	//Update(c Configuration) error
 	UpdateCurrentCommit(c Configuration, state *CommitState) error
@@ -47,10 +49,11 @@ func (n *configRepo) Add(node string) error {
 		},
 	}
 	config := Configuration{
-		NodeId:     node,
-		State:      Default,
-		LastCommit: def,
-		Commit:     def,
+		NodeId:      node,
+		Environment: DefaultEnvironment,
+		State:       Default,
+		LastCommit:  def,
+		Commit:      def,
 	}
 
 	r := n.Db.GetGormDb().Clauses(clause.OnConflict{
@@ -64,7 +67,7 @@ func (n *configRepo) Add(node string) error {
 func (n *configRepo) Get(id string) (*Configuration, error) {
 	var config Configuration
 
-	result := n.Db.GetGormDb().Preload("Commit").First(&config, "node_id=?", strings.ToLower(id))
+	result := n.Db.GetGormDb().Preload("Commit").Preload("LastCommit").First(&config, "node_id=?", strings.ToLower(id))
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -84,6 +87,30 @@ func (n *configRepo) GetAll() ([]Configuration, error) {
 	return configs, nil
 }
 
+func (n *configRepo) ListByEnvironment(env string) ([]Configuration, error) {
+	var configs []Configuration
+
+	result := n.Db.GetGormDb().Preload("Commit").Preload("LastCommit").Where("environment=?", env).Find(&configs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return configs, nil
+}
+
+func (n *configRepo) UpdateEnvironment(nodeid string, env string) error {
+	result := n.Db.GetGormDb().Model(&Configuration{}).Where("node_id=?", strings.ToLower(nodeid)).Update("environment", env)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
 func (n *configRepo) Delete(id string) error {
 	var configs Configuration
 	result := n.Db.GetGormDb().Where("node_id=?", strings.ToLower(id)).Delete(&configs)
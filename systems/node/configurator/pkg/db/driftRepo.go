@@ -0,0 +1,111 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"strings"
+
+	"github.com/ukama/ukama/systems/common/sql"
+	"gorm.io/gorm/clause"
+)
+
+type DriftRepo interface {
+	SetExpected(e *ExpectedConfig) error
+	DeleteExpected(nodeId string, app string, fileName string) error
+	ListExpected(nodeId string) ([]ExpectedConfig, error)
+	Upsert(d *NodeDrift) error
+	Get(nodeId string) (*NodeDrift, error)
+	List(driftedOnly bool) ([]NodeDrift, error)
+	CountDrifted() (int64, error)
+}
+
+type driftRepo struct {
+	Db sql.Db
+}
+
+func NewDriftRepo(db sql.Db) DriftRepo {
+	return &driftRepo{
+		Db: db,
+	}
+}
+
+func (r *driftRepo) SetExpected(e *ExpectedConfig) error {
+	e.NodeId = strings.ToLower(e.NodeId)
+
+	return r.Db.GetGormDb().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "node_id"}, {Name: "app"}, {Name: "file_name"}},
+		Where:     clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_at IS NULL"}}},
+		DoUpdates: clause.AssignmentColumns([]string{"hash", "commit", "updated_at"}),
+	}).Create(e).Error
+}
+
+func (r *driftRepo) DeleteExpected(nodeId string, app string, fileName string) error {
+	return r.Db.GetGormDb().Where("node_id=? AND app=? AND file_name=?", strings.ToLower(nodeId), app, fileName).
+		Delete(&ExpectedConfig{}).Error
+}
+
+func (r *driftRepo) ListExpected(nodeId string) ([]ExpectedConfig, error) {
+	var e []ExpectedConfig
+
+	result := r.Db.GetGormDb().Where("node_id=?", strings.ToLower(nodeId)).Find(&e)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return e, nil
+}
+
+func (r *driftRepo) Upsert(d *NodeDrift) error {
+	d.NodeId = strings.ToLower(d.NodeId)
+
+	return r.Db.GetGormDb().Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "node_id"}},
+		Where:   clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_at IS NULL"}}},
+		DoUpdates: clause.AssignmentColumns([]string{"expected_commit", "reported_commit", "drifted", "files",
+			"reported_at", "detected_at", "reconciled_at", "reconcile_count", "updated_at"}),
+	}).Create(d).Error
+}
+
+func (r *driftRepo) Get(nodeId string) (*NodeDrift, error) {
+	var d NodeDrift
+
+	result := r.Db.GetGormDb().First(&d, "node_id=?", strings.ToLower(nodeId))
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &d, nil
+}
+
+func (r *driftRepo) List(driftedOnly bool) ([]NodeDrift, error) {
+	var d []NodeDrift
+
+	tx := r.Db.GetGormDb().Order("node_id")
+	if driftedOnly {
+		tx = tx.Where("drifted = ?", true)
+	}
+
+	result := tx.Find(&d)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return d, nil
+}
+
+func (r *driftRepo) CountDrifted() (int64, error) {
+	var count int64
+
+	result := r.Db.GetGormDb().Model(&NodeDrift{}).Where("drifted = ?", true).Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return count, nil
+}
@@ -11,18 +11,24 @@ package db
 import (
 	"database/sql/driver"
 	"strings"
+	"time"
 
 	"gorm.io/gorm"
 )
 
 type Commit struct {
 	gorm.Model
-	Hash string `gorm:"type:string;uniqueIndex:idx_hash_id_case_insensitive,not null"`
+	Hash   string `gorm:"type:string;uniqueIndex:idx_hash_id_case_insensitive,not null"`
+	Pinned bool   `gorm:"default:false"` /* Known only through a promotion or rollback, never was the config store head */
 }
 
+/* Environment a node is assigned to when it is first added */
+const DefaultEnvironment = "production"
+
 type Configuration struct {
 	gorm.Model
 	NodeId          string      `gorm:"type:string;uniqueIndex:idx_node_id_case_insensitive,where:deleted_at is null;size:23;not null"`
+	Environment     string      `gorm:"type:string;index;default:production;not null"` /* Environment (staging, production..) whose promotions reach this node */
 	State           CommitState `gorm:"type:uint;not null"`
 	Commit          Commit      `gorm:"foreignKey:CommitId"` /* Should be updated by health event after receiving update from node */
 	CommitId        int
@@ -31,6 +37,47 @@ type Configuration struct {
 	LastCommitState CommitState `gorm:"type:uint;not null"`
 }
 
+/* Promotion records a config store ref (branch, tag or commit) promoted to an environment */
+type Promotion struct {
+	gorm.Model
+	Environment string `gorm:"type:string;index;not null"`
+	Ref         string `gorm:"type:string"`
+	Hash        string `gorm:"type:string;not null"`
+	PromotedBy  string `gorm:"type:string"`
+	Rollback    bool   `gorm:"default:false"`
+}
+
+/* ExpectedConfig is the sha256 of a config file as last pushed to a node */
+type ExpectedConfig struct {
+	gorm.Model
+	NodeId   string `gorm:"type:string;uniqueIndex:idx_expected_node_app_file,where:deleted_at is null;not null"`
+	App      string `gorm:"type:string;uniqueIndex:idx_expected_node_app_file,where:deleted_at is null;not null"`
+	FileName string `gorm:"type:string;uniqueIndex:idx_expected_node_app_file,where:deleted_at is null;not null"`
+	Hash     string `gorm:"type:string;not null"`
+	Commit   string `gorm:"type:string"`
+}
+
+/* NodeDrift is the outcome of comparing the last config report of a node with what it should run */
+type NodeDrift struct {
+	gorm.Model
+	NodeId         string `gorm:"type:string;uniqueIndex:idx_drift_node_id,where:deleted_at is null;not null"`
+	ExpectedCommit string `gorm:"type:string"`
+	ReportedCommit string `gorm:"type:string"`
+	Drifted        bool   `gorm:"index;default:false"`
+	Files          string `gorm:"type:string"` /* JSON encoded []FileDrift */
+	ReportedAt     time.Time
+	DetectedAt     *time.Time /* Since when the node is drifted */
+	ReconciledAt   *time.Time
+	ReconcileCount uint32 `gorm:"default:0"`
+}
+
+type FileDrift struct {
+	App          string `json:"app"`
+	FileName     string `json:"file_name"`
+	ExpectedHash string `json:"expected_hash"`
+	ReportedHash string `json:"reported_hash"`
+}
+
 type CommitState uint8
 
 const (
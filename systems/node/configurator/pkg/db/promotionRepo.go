@@ -0,0 +1,60 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"github.com/ukama/ukama/systems/common/sql"
+)
+
+type PromotionRepo interface {
+	Add(p *Promotion) error
+	GetLatest(env string) (*Promotion, error)
+	List(env string) ([]Promotion, error)
+}
+
+type promotionRepo struct {
+	Db sql.Db
+}
+
+func NewPromotionRepo(db sql.Db) PromotionRepo {
+	return &promotionRepo{
+		Db: db,
+	}
+}
+
+func (n *promotionRepo) Add(p *Promotion) error {
+	return n.Db.GetGormDb().Create(p).Error
+}
+
+func (n *promotionRepo) GetLatest(env string) (*Promotion, error) {
+	var p Promotion
+
+	result := n.Db.GetGormDb().Where("environment=?", env).Last(&p)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &p, nil
+}
+
+func (n *promotionRepo) List(env string) ([]Promotion, error) {
+	var p []Promotion
+
+	tx := n.Db.GetGormDb().Order("id desc")
+	if env != "" {
+		tx = tx.Where("environment=?", env)
+	}
+
+	result := tx.Find(&p)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return p, nil
+}
@@ -24,6 +24,7 @@ type StoreProvider interface {
 	GetLatestRemoteConfigs(dir string) (string, error)
 	GetRemoteConfigVersion(dir string, version string) error
 	GetDiff(prevSha string, curSha string, dir string) ([]string, error)
+	ResolveRef(dir string, ref string) (string, error)
 }
 
 type gitClient struct {
@@ -161,6 +162,37 @@ func (g *gitClient) GetDiff(prevSha string, curSha string, dir string) ([]string
 	return changedFiles, nil
 }
 
+/* Resolve a branch, tag or (short) commit hash of the config store to a commit hash */
+func (g *gitClient) ResolveRef(dirPrefix string, ref string) (string, error) {
+
+	err := utils.CreateDir(dirPrefix+LATEST_DIR_NAME, PERM)
+	if err != nil {
+		return "", fmt.Errorf("error creating directory: %v", err)
+	}
+
+	r, err := git.PlainClone(dirPrefix+LATEST_DIR_NAME, false, &git.CloneOptions{
+		Auth: &http.BasicAuth{
+			Username: g.user,
+			Password: g.pat,
+		},
+		URL:  g.url,
+		Tags: git.AllTags,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error cloning config store from %s : %v", g.url, err)
+	}
+
+	/* Branches other than the default one only exist as remote refs after clone */
+	for _, rev := range []string{ref, "origin/" + ref} {
+		hash, err := r.ResolveRevision(plumbing.Revision(rev))
+		if err == nil {
+			return hash.String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("unable to resolve %s in config store %s", ref, g.url)
+}
+
 func NewStoreClient(url string, user string, pat string, t time.Duration) (*gitClient, error) {
 
 	N := &gitClient{
@@ -0,0 +1,376 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	log "github.com/sirupsen/logrus"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
+)
+
+/*
+ * Templates are kept in the config store next to the hand maintained configs:
+ *   templates/<app>/<file>.json.tmpl                   rendered for every node
+ *   templates/types/<node type>/<app>/<file>.json.tmpl  rendered for nodes of a type, wins over the above
+ *   templates/vars/default.json                        variables for all nodes
+ *   templates/vars/<network>.json                      variables for a network
+ *   templates/vars/<network>/<site>.json               variables for a site (backhaul, band plan..)
+ * A file committed under <network>/<site>/<node>/<app>/ always wins over a rendered one.
+ */
+const (
+	DefaultTemplateDir = "templates"
+	TemplateExt        = ".tmpl"
+	typesDir           = "types"
+	varsDir            = "vars"
+)
+
+/* NodeContext holds the variables a template of a node is rendered with */
+type NodeContext struct {
+	Org     string
+	Network creg.NetworkInfo
+	Site    creg.SiteInfo
+	Node    creg.NodeInfo
+	Vars    map[string]interface{}
+}
+
+/* RenderedFile is a config file rendered for a node, Path is relative to the config store root */
+type RenderedFile struct {
+	Path       string
+	App        string
+	FileName   string
+	Template   string
+	Data       []byte
+	Overridden bool /* A committed file exists for the node, rendered data is not used */
+}
+
+type Renderer struct {
+	templateDir   string
+	org           string
+	networkClient creg.NetworkClient
+	siteClient    creg.SiteClient
+	nodeClient    creg.NodeClient
+}
+
+func NewRenderer(templateDir string, org string, cnet creg.NetworkClient, csite creg.SiteClient, cnode creg.NodeClient) *Renderer {
+	return &Renderer{
+		templateDir:   strings.Trim(templateDir, "/"),
+		org:           org,
+		networkClient: cnet,
+		siteClient:    csite,
+		nodeClient:    cnode,
+	}
+}
+
+/* IsTemplateFile tells if a file path (relative to config store root) belongs to the template dir */
+func (r *Renderer) IsTemplateFile(file string) bool {
+	return strings.HasPrefix(strings.TrimPrefix(file, "/"), r.templateDir+"/")
+}
+
+/* HasTemplates tells if the config store checked out at root uses templates at all */
+func (r *Renderer) HasTemplates(root string) bool {
+	info, err := os.Stat(filepath.Join(root, r.templateDir))
+
+	return err == nil && info.IsDir()
+}
+
+/* NodeContext resolves the registry data of a node, its site and network */
+func (r *Renderer) NodeContext(nodeId string) (*NodeContext, error) {
+	node, err := r.nodeClient.Get(nodeId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node %s: %w", nodeId, err)
+	}
+
+	return r.nodeContext(node, map[string]*creg.SiteInfo{}, map[string]*creg.NetworkInfo{})
+}
+
+func (r *Renderer) nodeContext(node *creg.NodeInfo, sites map[string]*creg.SiteInfo, nets map[string]*creg.NetworkInfo) (*NodeContext, error) {
+	if node.Site.SiteId == "" {
+		return nil, fmt.Errorf("node %s is not on a site", node.Id)
+	}
+
+	site, ok := sites[node.Site.SiteId]
+	if !ok {
+		var err error
+		site, err = r.siteClient.Get(node.Site.SiteId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get site %s of node %s: %w", node.Site.SiteId, node.Id, err)
+		}
+		sites[node.Site.SiteId] = site
+	}
+
+	netId := node.Site.NetworkId
+	if netId == "" {
+		netId = site.NetworkId
+	}
+
+	net, ok := nets[netId]
+	if !ok {
+		var err error
+		net, err = r.networkClient.Get(netId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get network %s of node %s: %w", netId, node.Id, err)
+		}
+		nets[netId] = net
+	}
+
+	return &NodeContext{
+		Org:     r.org,
+		Network: *net,
+		Site:    *site,
+		Node:    *node,
+	}, nil
+}
+
+/* RenderNode renders all templates applying to the node, committed files are marked as overridden */
+func (r *Renderer) RenderNode(root string, nc *NodeContext) ([]RenderedFile, error) {
+	vars, err := r.loadVars(root, nc.Network.Name, nc.Site.Name)
+	if err != nil {
+		return nil, err
+	}
+	nc.Vars = vars
+
+	tmpls, err := r.templatesFor(root, nc.Node.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeDir := filepath.Join(nc.Network.Name, nc.Site.Name, strings.ToLower(nc.Node.Id))
+
+	var files []RenderedFile
+	for _, rel := range sortedKeys(tmpls) {
+		tmplFile := tmpls[rel]
+		app := filepath.Dir(rel)
+		fileName := strings.TrimSuffix(filepath.Base(rel), TemplateExt)
+
+		data, err := renderFile(filepath.Join(root, tmplFile), nc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render %s for node %s: %w", tmplFile, nc.Node.Id, err)
+		}
+
+		f := RenderedFile{
+			Path:     filepath.Join(nodeDir, app, fileName),
+			App:      app,
+			FileName: fileName,
+			Template: tmplFile,
+			Data:     data,
+		}
+
+		if _, err := os.Stat(filepath.Join(root, f.Path)); err == nil {
+			f.Overridden = true
+		}
+
+		files = append(files, f)
+	}
+
+	return files, nil
+}
+
+/* RenderNodeInto renders the node's templates into the checkout at root, returns written paths */
+func (r *Renderer) RenderNodeInto(root string, nodeId string) ([]string, error) {
+	if !r.HasTemplates(root) {
+		return nil, nil
+	}
+
+	nc, err := r.NodeContext(nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := r.RenderNode(root, nc)
+	if err != nil {
+		return nil, err
+	}
+
+	return write(root, files)
+}
+
+/* RenderAll renders templates of every node on a site into the checkout at root, returns written paths */
+func (r *Renderer) RenderAll(root string) ([]string, error) {
+	if !r.HasTemplates(root) {
+		return nil, nil
+	}
+
+	nodes, err := r.nodeClient.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	sites := map[string]*creg.SiteInfo{}
+	nets := map[string]*creg.NetworkInfo{}
+
+	var paths []string
+	for _, n := range nodes.Nodes {
+		if n == nil || n.Site.SiteId == "" {
+			continue
+		}
+
+		nc, err := r.nodeContext(n, sites, nets)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get registry data of node %s: %w", n.Id, err)
+		}
+
+		files, err := r.RenderNode(root, nc)
+		if err != nil {
+			return nil, err
+		}
+
+		written, err := write(root, files)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, written...)
+	}
+
+	return paths, nil
+}
+
+/* Node type specific templates replace the generic template of the same app and file */
+func (r *Renderer) templatesFor(root string, nodeType string) (map[string]string, error) {
+	base := filepath.Join(root, r.templateDir)
+	tmpls := map[string]string{}
+	typed := map[string]string{}
+
+	err := filepath.Walk(base, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, TemplateExt) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(base, path)
+		if err != nil {
+			return err
+		}
+		p := strings.Split(rel, string(filepath.Separator))
+
+		switch {
+		case p[0] == varsDir:
+			return nil
+		case p[0] == typesDir && len(p) == 4:
+			if strings.EqualFold(p[1], nodeType) {
+				typed[filepath.Join(p[2], p[3])] = filepath.Join(r.templateDir, rel)
+			}
+		case len(p) == 2:
+			tmpls[rel] = filepath.Join(r.templateDir, rel)
+		default:
+			log.Warnf("Ignoring template %s, expected <app>/<file>%s", rel, TemplateExt)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range typed {
+		tmpls[k] = v
+	}
+
+	return tmpls, nil
+}
+
+/* Later files win: default, network, site */
+func (r *Renderer) loadVars(root string, network string, site string) (map[string]interface{}, error) {
+	base := filepath.Join(root, r.templateDir, varsDir)
+	vars := map[string]interface{}{}
+
+	for _, f := range []string{"default.json", network + ".json", filepath.Join(network, site+".json")} {
+		data, err := os.ReadFile(filepath.Join(base, f))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		v := map[string]interface{}{}
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, fmt.Errorf("invalid vars file %s: %w", f, err)
+		}
+
+		for k, val := range v {
+			vars[k] = val
+		}
+	}
+
+	return vars, nil
+}
+
+var funcs = template.FuncMap{
+	"toJson": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"default": func(d interface{}, v interface{}) interface{} {
+		if v == nil || v == "" {
+			return d
+		}
+		return v
+	},
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+}
+
+func renderFile(path string, nc *NodeContext) ([]byte, error) {
+	t, err := template.New(filepath.Base(path)).Funcs(funcs).Option("missingkey=error").ParseFiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, nc); err != nil {
+		return nil, err
+	}
+
+	if !json.Valid(buf.Bytes()) {
+		return nil, fmt.Errorf("rendered output is not valid json")
+	}
+
+	return buf.Bytes(), nil
+}
+
+func write(root string, files []RenderedFile) ([]string, error) {
+	var paths []string
+	for _, f := range files {
+		if f.Overridden {
+			continue
+		}
+
+		p := filepath.Join(root, f.Path)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			return nil, err
+		}
+
+		if err := os.WriteFile(p, f.Data, 0644); err != nil {
+			return nil, err
+		}
+		paths = append(paths, f.Path)
+	}
+
+	return paths, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,126 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package render
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmocks "github.com/ukama/ukama/systems/common/mocks"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
+)
+
+const (
+	testOrg   = "testorg"
+	testNode1 = "uk-000000-hnode-0000"
+	testNode2 = "uk-000000-tnode-0001"
+)
+
+func writeFile(t *testing.T, root, rel, data string) {
+	p := filepath.Join(root, rel)
+	require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+	require.NoError(t, os.WriteFile(p, []byte(data), 0644))
+}
+
+func configStore(t *testing.T) string {
+	root := t.TempDir()
+	writeFile(t, root, "templates/epc/epc.json.tmpl",
+		`{"site": "{{ .Site.Name }}", "lat": "{{ .Site.Latitude }}", "band": {{ toJson .Vars.band }}}`)
+	writeFile(t, root, "templates/types/tnode/epc/epc.json.tmpl", `{"type": "{{ .Node.Type }}"}`)
+	writeFile(t, root, "templates/vars/default.json", `{"band": 3}`)
+	writeFile(t, root, "templates/vars/networkABC/siteXYZ.json", `{"band": 41}`)
+
+	return root
+}
+
+func registry() (*cmocks.NetworkClient, *cmocks.SiteClient, *cmocks.NodeClient) {
+	cnet := &cmocks.NetworkClient{}
+	csite := &cmocks.SiteClient{}
+	cnode := &cmocks.NodeClient{}
+
+	cnet.On("Get", "net-1").Return(&creg.NetworkInfo{Id: "net-1", Name: "networkABC"}, nil)
+	csite.On("Get", "site-1").Return(&creg.SiteInfo{Id: "site-1", Name: "siteXYZ", NetworkId: "net-1", Latitude: "37.7"}, nil)
+
+	return cnet, csite, cnode
+}
+
+func TestRenderer_RenderNodeInto(t *testing.T) {
+	root := configStore(t)
+	cnet, csite, cnode := registry()
+	cnode.On("Get", testNode1).Return(&creg.NodeInfo{Id: testNode1, Type: "hnode",
+		Site: creg.NodeSiteInfo{SiteId: "site-1", NetworkId: "net-1"}}, nil).Once()
+
+	r := NewRenderer(DefaultTemplateDir, testOrg, cnet, csite, cnode)
+
+	paths, err := r.RenderNodeInto(root, testNode1)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"networkABC/siteXYZ/uk-000000-hnode-0000/epc/epc.json"}, paths)
+	data, err := os.ReadFile(filepath.Join(root, paths[0]))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"site": "siteXYZ", "lat": "37.7", "band": 41}`, string(data))
+}
+
+func TestRenderer_RenderAll(t *testing.T) {
+	root := configStore(t)
+	writeFile(t, root, "networkABC/siteXYZ/uk-000000-hnode-0000/epc/epc.json", `{"hand": "made"}`)
+	cnet, csite, cnode := registry()
+	cnode.On("GetAll").Return(&creg.Nodes{Nodes: []*creg.NodeInfo{
+		{Id: testNode1, Type: "hnode", Site: creg.NodeSiteInfo{SiteId: "site-1", NetworkId: "net-1"}},
+		{Id: testNode2, Type: "tnode", Site: creg.NodeSiteInfo{SiteId: "site-1", NetworkId: "net-1"}},
+		{Id: "uk-000000-anode-0002", Type: "anode"},
+	}}, nil).Once()
+
+	r := NewRenderer(DefaultTemplateDir, testOrg, cnet, csite, cnode)
+
+	paths, err := r.RenderAll(root)
+
+	require.NoError(t, err)
+	/* Committed file of the first node wins, the second node gets its type specific template */
+	assert.Equal(t, []string{"networkABC/siteXYZ/uk-000000-tnode-0001/epc/epc.json"}, paths)
+	data, err := os.ReadFile(filepath.Join(root, paths[0]))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type": "tnode"}`, string(data))
+	csite.AssertNumberOfCalls(t, "Get", 1)
+}
+
+func TestRenderer_RenderAllRegistryError(t *testing.T) {
+	root := configStore(t)
+	cnet := &cmocks.NetworkClient{}
+	csite := &cmocks.SiteClient{}
+	cnode := &cmocks.NodeClient{}
+	cnode.On("GetAll").Return(&creg.Nodes{Nodes: []*creg.NodeInfo{
+		{Id: testNode1, Type: "hnode", Site: creg.NodeSiteInfo{SiteId: "site-1", NetworkId: "net-1"}},
+	}}, nil).Once()
+	csite.On("Get", "site-1").Return(nil, assert.AnError).Once()
+
+	r := NewRenderer(DefaultTemplateDir, testOrg, cnet, csite, cnode)
+
+	/* A node missing from the output would look like a deleted config */
+	_, err := r.RenderAll(root)
+
+	assert.Error(t, err)
+}
+
+func TestRenderer_InvalidOutput(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "templates/epc/epc.json.tmpl", `{"site": {{ .Site.Name }}}`)
+	cnet, csite, cnode := registry()
+
+	r := NewRenderer(DefaultTemplateDir, testOrg, cnet, csite, cnode)
+	_, err := r.RenderNode(root, &NodeContext{Site: creg.SiteInfo{Name: "siteXYZ"}})
+
+	assert.Error(t, err)
+	assert.True(t, r.IsTemplateFile("templates/epc/epc.json.tmpl"))
+	assert.False(t, r.HasTemplates(t.TempDir()))
+}
@@ -34,11 +34,15 @@ type ConfiguratorServer struct {
 	configStore            configstore.ConfigStoreProvider
 	commitRepo             db.CommitRepo
 	configRepo             db.ConfigRepo
+	promotionRepo          db.PromotionRepo
+	driftRepo              db.DriftRepo
+	drift                  pkg.DriftConfig
+	pushGateway            string
 	opManager              cfgclient.OperationManager
 	opLeaseSecs            uint32
 }
 
-func NewConfiguratorServer(msgBus mb.MsgBusServiceClient, cfgDb db.ConfigRepo, cmtDb db.CommitRepo, configStore configstore.ConfigStoreProvider, orgName string, debug bool, opMgr cfgclient.OperationManager, leaseSecs uint32) *ConfiguratorServer {
+func NewConfiguratorServer(msgBus mb.MsgBusServiceClient, cfgDb db.ConfigRepo, cmtDb db.CommitRepo, promoDb db.PromotionRepo, driftDb db.DriftRepo, configStore configstore.ConfigStoreProvider, orgName string, debug bool, opMgr cfgclient.OperationManager, leaseSecs uint32, drift pkg.DriftConfig, pushGateway string) *ConfiguratorServer {
 
 	log.Infof("Config store created: %+v", configStore)
 	return &ConfiguratorServer{
@@ -49,6 +53,10 @@ func NewConfiguratorServer(msgBus mb.MsgBusServiceClient, cfgDb db.ConfigRepo, c
 		configStore:            configStore,
 		commitRepo:             cmtDb,
 		configRepo:             cfgDb,
+		promotionRepo:          promoDb,
+		driftRepo:              driftDb,
+		drift:                  drift,
+		pushGateway:            pushGateway,
 		opManager:              opMgr,
 		opLeaseSecs:            leaseSecs,
 	}
@@ -109,13 +117,89 @@ func (c *ConfiguratorServer) GetConfigVersion(ctx context.Context, req *pb.Confi
 	cfg, err := c.configRepo.Get(req.NodeId)
 	if err != nil {
 		log.Errorf("Error while reading config for node %s. Error: %s", req.NodeId, err.Error())
+		return nil, status.Errorf(codes.NotFound, "config for node %s not found: %v", req.NodeId, err)
 	}
 
-	return &pb.ConfigVersionResponse{
-		NodeId:     req.NodeId,
-		Status:     cfg.State.String(),
-		Commit:     cfg.Commit.Hash,
-		LastStatus: cfg.LastCommitState.String(),
-		LastCommit: cfg.LastCommit.Hash,
-	}, err
+	resp := &pb.ConfigVersionResponse{
+		NodeId:      req.NodeId,
+		Status:      cfg.State.String(),
+		Commit:      cfg.Commit.Hash,
+		LastStatus:  cfg.LastCommitState.String(),
+		LastCommit:  cfg.LastCommit.Hash,
+		Environment: cfg.Environment,
+	}
+
+	/* Applied version is compared against what was last promoted to the node's environment */
+	if c.promotionRepo != nil && cfg.Environment != "" {
+		p, err := c.promotionRepo.GetLatest(cfg.Environment)
+		if err == nil {
+			resp.PromotedCommit = p.Hash
+			resp.InSync = p.Hash == cfg.Commit.Hash
+		}
+	}
+
+	return resp, nil
+}
+
+func (c *ConfiguratorServer) Validate(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error) {
+	log.Infof("Received a request to validate config %v", req)
+
+	if req.Ref == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "ref is required")
+	}
+
+	hash, report, err := c.configStore.ValidateConfigRef(ctx, req.Ref)
+	if err != nil {
+		log.Errorf("Error while validating config ref %s. Error: %s", req.Ref, err.Error())
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to validate %s: %v", req.Ref, err)
+	}
+
+	resp := &pb.ValidateResponse{
+		Commit:  hash,
+		Valid:   report.Valid(),
+		Checked: uint32(len(report.Files)),
+	}
+
+	for _, f := range report.Failed() {
+		resp.Failed = append(resp.Failed, &pb.FileValidation{
+			File:   f.File,
+			App:    f.App,
+			Schema: f.Schema,
+			Errors: f.Errors,
+		})
+	}
+
+	return resp, nil
+}
+
+func (c *ConfiguratorServer) PreviewConfig(ctx context.Context, req *pb.PreviewConfigRequest) (*pb.PreviewConfigResponse, error) {
+	log.Infof("Received a request to preview config %v", req)
+
+	if req.NodeId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "node id is required")
+	}
+
+	hash, files, err := c.configStore.PreviewNodeConfig(ctx, req.Ref, req.NodeId)
+	if err != nil {
+		log.Errorf("Error while rendering config for node %s. Error: %s", req.NodeId, err.Error())
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to render config for node %s: %v", req.NodeId, err)
+	}
+
+	resp := &pb.PreviewConfigResponse{
+		NodeId: req.NodeId,
+		Commit: hash,
+	}
+
+	for _, f := range files {
+		resp.Files = append(resp.Files, &pb.RenderedConfig{
+			Path:       f.Path,
+			App:        f.App,
+			FileName:   f.FileName,
+			Template:   f.Template,
+			Data:       f.Data,
+			Overridden: f.Overridden,
+		})
+	}
+
+	return resp, nil
 }
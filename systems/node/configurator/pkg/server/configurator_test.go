@@ -32,9 +32,11 @@ func TestConfiguratorServer_ConfigEvent(t *testing.T) {
 	configRepo := &mocks.ConfigRepo{}
 	configStore := &mocks.ConfigStoreProvider{}
 
-	s := NewConfiguratorServer(msgbusClient, configRepo, commitRepo, configStore, testOrgName, pkg.IsDebugMode,
+	s := NewConfiguratorServer(msgbusClient, configRepo, commitRepo, nil, nil, configStore, testOrgName, pkg.IsDebugMode,
 		nil,
 		0,
+		pkg.DriftConfig{},
+		"",
 	)
 
 	configStore.On("HandleConfigStoreEvent", mock.Anything, mock.Anything).Return(nil).Once()
@@ -54,9 +56,11 @@ func TestConfiguratorServer_GetConfigVersion(t *testing.T) {
 	configRepo := &mocks.ConfigRepo{}
 	configStore := &mocks.ConfigStoreProvider{}
 
-	s := NewConfiguratorServer(msgbusClient, configRepo, commitRepo, configStore, testOrgName, pkg.IsDebugMode,
+	s := NewConfiguratorServer(msgbusClient, configRepo, commitRepo, nil, nil, configStore, testOrgName, pkg.IsDebugMode,
 		nil,
 		0,
+		pkg.DriftConfig{},
+		"",
 	)
 
 	configRepo.On("Get", mock.AnythingOfType("string")).Return(&db.Configuration{
@@ -80,9 +84,11 @@ func TestConfiguratorServer_ApplyConfig(t *testing.T) {
 	configRepo := &mocks.ConfigRepo{}
 	configStore := &mocks.ConfigStoreProvider{}
 
-	s := NewConfiguratorServer(msgbusClient, configRepo, commitRepo, configStore, testOrgName, pkg.IsDebugMode,
+	s := NewConfiguratorServer(msgbusClient, configRepo, commitRepo, nil, nil, configStore, testOrgName, pkg.IsDebugMode,
 		nil,
 		0,
+		pkg.DriftConfig{},
+		"",
 	)
 	req := &pb.ApplyConfigRequest{Hash: "4f6e609"}
 	configStore.On("HandleConfigCommitReq", mock.Anything, req.Hash).Return(nil).Once()
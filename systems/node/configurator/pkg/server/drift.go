@@ -0,0 +1,263 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/ukama/ukama/systems/node/configurator/pkg"
+	"github.com/ukama/ukama/systems/node/configurator/pkg/db"
+	"gorm.io/gorm"
+
+	log "github.com/sirupsen/logrus"
+	metric "github.com/ukama/ukama/systems/common/metrics"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	pb "github.com/ukama/ukama/systems/node/configurator/pb/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+ * CheckDrift compares a node's config report with the commit it acked last and the
+ * hashes of the files pushed to it. Drifted nodes are re-pushed their expected commit
+ * when auto reconcile is enabled.
+ */
+func (c *ConfiguratorServer) CheckDrift(ctx context.Context, report *epb.NodeConfigReportEvent) error {
+	nodeId := strings.ToLower(report.NodeId)
+
+	cfg, err := c.configRepo.Get(nodeId)
+	if err != nil {
+		log.Errorf("Error reading node %s from configuration repo.Error: %+v", nodeId, err)
+		return err
+	}
+
+	/* Node may not have applied a commit which is still on its way */
+	inFlight := (cfg.LastCommitState == db.Published || cfg.LastCommitState == db.Partial) &&
+		cfg.LastCommit.Hash != cfg.Commit.Hash
+	if inFlight && report.Commit != cfg.LastCommit.Hash {
+		log.Infof("Skipping drift check of node %s, commit %s is being applied", nodeId, cfg.LastCommit.Hash)
+		return nil
+	}
+
+	expected := cfg.Commit.Hash
+	if inFlight {
+		expected = cfg.LastCommit.Hash
+	}
+
+	expectedFiles, err := c.driftRepo.ListExpected(nodeId)
+	if err != nil {
+		log.Errorf("Error reading expected configs of node %s.Error: %+v", nodeId, err)
+		return err
+	}
+
+	files := fileDrifts(expectedFiles, report.Files)
+	drifted := (report.Commit != "" && expected != "" && report.Commit != expected) || len(files) > 0
+
+	prev, err := c.driftRepo.Get(nodeId)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		prev = &db.NodeDrift{NodeId: nodeId}
+	}
+
+	now := time.Now()
+	d := &db.NodeDrift{
+		NodeId:         nodeId,
+		ExpectedCommit: expected,
+		ReportedCommit: report.Commit,
+		Drifted:        drifted,
+		ReportedAt:     now,
+		ReconciledAt:   prev.ReconciledAt,
+		ReconcileCount: prev.ReconcileCount,
+	}
+
+	if len(files) > 0 {
+		fd, err := json.Marshal(files)
+		if err != nil {
+			return err
+		}
+		d.Files = string(fd)
+	}
+
+	if drifted {
+		d.DetectedAt = prev.DetectedAt
+		if !prev.Drifted || d.DetectedAt == nil {
+			d.DetectedAt = &now
+			log.Warnf("Config drift detected on node %s: expected %s, reported %s, %d files differ",
+				nodeId, expected, report.Commit, len(files))
+			c.publishDrift(d, files)
+		}
+
+		if c.shouldReconcile(d, now) {
+			err = c.configStore.HandleConfigCommitReqForNode(ctx, expected, nodeId)
+			if err != nil {
+				log.Errorf("Failed to reconcile node %s to commit %s. Error: %s", nodeId, expected, err.Error())
+			} else {
+				d.ReconciledAt = &now
+				d.ReconcileCount++
+			}
+		}
+	}
+
+	err = c.driftRepo.Upsert(d)
+	if err != nil {
+		log.Errorf("Failed to store drift of node %s. Error: %s", nodeId, err.Error())
+		return err
+	}
+
+	c.pushDriftMetric()
+
+	return nil
+}
+
+func (c *ConfiguratorServer) GetDriftReport(ctx context.Context, req *pb.DriftReportRequest) (*pb.DriftReportResponse, error) {
+	var drifts []db.NodeDrift
+
+	if req.NodeId != "" {
+		d, err := c.driftRepo.Get(req.NodeId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.NotFound, "no config report from node %s", req.NodeId)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get drift of node %s: %v", req.NodeId, err)
+		}
+		drifts = append(drifts, *d)
+	} else {
+		var err error
+		drifts, err = c.driftRepo.List(req.DriftedOnly)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list drifts: %v", err)
+		}
+	}
+
+	resp := &pb.DriftReportResponse{}
+	for _, d := range drifts {
+		if d.Drifted {
+			resp.Drifted++
+		}
+		resp.Nodes = append(resp.Nodes, nodeDriftToPb(d))
+	}
+
+	return resp, nil
+}
+
+/* Hand edits show up as a file whose hash differs from the pushed one, or which is gone */
+func fileDrifts(expected []db.ExpectedConfig, reported []*epb.ConfigFileHash) []db.FileDrift {
+	if len(reported) == 0 {
+		return nil
+	}
+
+	rm := make(map[string]string, len(reported))
+	for _, f := range reported {
+		rm[f.App+"/"+f.Filename] = strings.ToLower(f.Hash)
+	}
+
+	var files []db.FileDrift
+	for _, e := range expected {
+		h := rm[e.App+"/"+e.FileName]
+		if h != e.Hash {
+			files = append(files, db.FileDrift{
+				App:          e.App,
+				FileName:     e.FileName,
+				ExpectedHash: e.Hash,
+				ReportedHash: h,
+			})
+		}
+	}
+
+	return files
+}
+
+func (c *ConfiguratorServer) shouldReconcile(d *db.NodeDrift, now time.Time) bool {
+	if !c.drift.AutoReconcile || d.ExpectedCommit == "" {
+		return false
+	}
+
+	return d.ReconciledAt == nil || now.Sub(*d.ReconciledAt) >= c.drift.ReconcileInterval
+}
+
+func (c *ConfiguratorServer) publishDrift(d *db.NodeDrift, files []db.FileDrift) {
+	if c.msgbus == nil {
+		return
+	}
+
+	evt := &epb.NodeConfigDriftEvent{
+		NodeId:         d.NodeId,
+		ExpectedCommit: d.ExpectedCommit,
+		ReportedCommit: d.ReportedCommit,
+	}
+	for _, f := range files {
+		evt.DriftedFiles = append(evt.DriftedFiles, f.App+"/"+f.FileName)
+	}
+
+	route := c.configuratorRoutingKey.SetAction("drift").SetObject("node").MustBuild()
+	err := c.msgbus.PublishRequest(route, evt)
+	if err != nil {
+		log.Errorf("Failed to publish message %+v with key %+v. Errors %s", evt, route, err.Error())
+	}
+}
+
+func (c *ConfiguratorServer) pushDriftMetric() {
+	if c.pushGateway == "" {
+		return
+	}
+
+	count, err := c.driftRepo.CountDrifted()
+	if err != nil {
+		log.Errorf("Failed to count drifted nodes: %s", err.Error())
+		return
+	}
+
+	err = metric.CollectAndPushSystemMetrics(c.pushGateway, pkg.DriftMetrics, pkg.NumberOfDriftedNodes,
+		float64(count), nil, pkg.SystemName+"-"+pkg.ServiceName)
+	if err != nil {
+		log.Errorf("Error while pushing drifted nodes metric to pushgateway %s", err.Error())
+	}
+}
+
+func nodeDriftToPb(d db.NodeDrift) *pb.NodeDrift {
+	n := &pb.NodeDrift{
+		NodeId:         d.NodeId,
+		ExpectedCommit: d.ExpectedCommit,
+		ReportedCommit: d.ReportedCommit,
+		Drifted:        d.Drifted,
+		ReportedAt:     d.ReportedAt.Format(time.RFC3339),
+		ReconcileCount: d.ReconcileCount,
+	}
+
+	if d.DetectedAt != nil {
+		n.DetectedAt = d.DetectedAt.Format(time.RFC3339)
+	}
+
+	if d.ReconciledAt != nil {
+		n.ReconciledAt = d.ReconciledAt.Format(time.RFC3339)
+	}
+
+	if d.Files != "" {
+		var files []db.FileDrift
+		if err := json.Unmarshal([]byte(d.Files), &files); err != nil {
+			log.Warnf("Invalid drifted files of node %s: %v", d.NodeId, err)
+		}
+		for _, f := range files {
+			n.Files = append(n.Files, &pb.FileDrift{
+				App:          f.App,
+				FileName:     f.FileName,
+				ExpectedHash: f.ExpectedHash,
+				ReportedHash: f.ReportedHash,
+			})
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,137 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+
+	"github.com/ukama/ukama/systems/node/configurator/mocks"
+	"github.com/ukama/ukama/systems/node/configurator/pkg"
+	"github.com/ukama/ukama/systems/node/configurator/pkg/db"
+
+	mbmocks "github.com/ukama/ukama/systems/common/mocks"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	pb "github.com/ukama/ukama/systems/node/configurator/pb/gen"
+)
+
+func newDriftTestServer(msgbus *mbmocks.MsgBusServiceClient, configRepo *mocks.ConfigRepo, driftRepo *mocks.DriftRepo,
+	configStore *mocks.ConfigStoreProvider, autoReconcile bool) *ConfiguratorServer {
+	return NewConfiguratorServer(msgbus, configRepo, &mocks.CommitRepo{}, nil, driftRepo, configStore,
+		testOrgName, pkg.IsDebugMode, nil, 0,
+		pkg.DriftConfig{AutoReconcile: autoReconcile, ReconcileInterval: 10 * time.Minute}, "")
+}
+
+func TestConfiguratorServer_CheckDrift(t *testing.T) {
+	expected := []db.ExpectedConfig{
+		{NodeId: testNodeA, App: "epc", FileName: "sctp.json", Hash: "aaa"},
+		{NodeId: testNodeA, App: "epc", FileName: "mme.json", Hash: "bbb"},
+	}
+
+	t.Run("HandEditedFile", func(t *testing.T) {
+		msgbus := &mbmocks.MsgBusServiceClient{}
+		configRepo := &mocks.ConfigRepo{}
+		driftRepo := &mocks.DriftRepo{}
+		configStore := &mocks.ConfigStoreProvider{}
+		s := newDriftTestServer(msgbus, configRepo, driftRepo, configStore, true)
+
+		configRepo.On("Get", testNodeA).Return(&db.Configuration{
+			NodeId: testNodeA, Commit: db.Commit{Hash: "c1"}, LastCommit: db.Commit{Hash: "c1"}, LastCommitState: db.Success,
+		}, nil).Once()
+		driftRepo.On("ListExpected", testNodeA).Return(expected, nil).Once()
+		driftRepo.On("Get", testNodeA).Return(nil, gorm.ErrRecordNotFound).Once()
+		msgbus.On("PublishRequest", mock.MatchedBy(func(r string) bool { return len(r) > 0 }),
+			mock.MatchedBy(func(e *epb.NodeConfigDriftEvent) bool {
+				return e.NodeId == testNodeA && len(e.DriftedFiles) == 1 && e.DriftedFiles[0] == "epc/mme.json"
+			})).Return(nil).Once()
+		configStore.On("HandleConfigCommitReqForNode", mock.Anything, "c1", testNodeA).Return(nil).Once()
+		driftRepo.On("Upsert", mock.MatchedBy(func(d *db.NodeDrift) bool {
+			return d.Drifted && d.DetectedAt != nil && d.ReconcileCount == 1 && d.ExpectedCommit == "c1"
+		})).Return(nil).Once()
+
+		err := s.CheckDrift(context.Background(), &epb.NodeConfigReportEvent{
+			NodeId: testNodeA,
+			Commit: "c1",
+			Files: []*epb.ConfigFileHash{
+				{App: "epc", Filename: "sctp.json", Hash: "aaa"},
+				{App: "epc", Filename: "mme.json", Hash: "ccc"},
+			},
+		})
+
+		assert.NoError(t, err)
+		msgbus.AssertExpectations(t)
+		configStore.AssertExpectations(t)
+		driftRepo.AssertExpectations(t)
+	})
+
+	t.Run("InSync", func(t *testing.T) {
+		configRepo := &mocks.ConfigRepo{}
+		driftRepo := &mocks.DriftRepo{}
+		s := newDriftTestServer(&mbmocks.MsgBusServiceClient{}, configRepo, driftRepo, &mocks.ConfigStoreProvider{}, true)
+
+		configRepo.On("Get", testNodeA).Return(&db.Configuration{
+			NodeId: testNodeA, Commit: db.Commit{Hash: "c1"}, LastCommit: db.Commit{Hash: "c1"}, LastCommitState: db.Success,
+		}, nil).Once()
+		driftRepo.On("ListExpected", testNodeA).Return(expected, nil).Once()
+		driftRepo.On("Get", testNodeA).Return(&db.NodeDrift{NodeId: testNodeA, Drifted: true}, nil).Once()
+		driftRepo.On("Upsert", mock.MatchedBy(func(d *db.NodeDrift) bool {
+			return !d.Drifted && d.DetectedAt == nil && d.Files == ""
+		})).Return(nil).Once()
+
+		err := s.CheckDrift(context.Background(), &epb.NodeConfigReportEvent{
+			NodeId: testNodeA,
+			Commit: "c1",
+			Files: []*epb.ConfigFileHash{
+				{App: "epc", Filename: "sctp.json", Hash: "aaa"},
+				{App: "epc", Filename: "mme.json", Hash: "BBB"},
+			},
+		})
+
+		assert.NoError(t, err)
+		driftRepo.AssertExpectations(t)
+	})
+
+	t.Run("CommitBeingApplied", func(t *testing.T) {
+		configRepo := &mocks.ConfigRepo{}
+		driftRepo := &mocks.DriftRepo{}
+		s := newDriftTestServer(&mbmocks.MsgBusServiceClient{}, configRepo, driftRepo, &mocks.ConfigStoreProvider{}, true)
+
+		configRepo.On("Get", testNodeA).Return(&db.Configuration{
+			NodeId: testNodeA, Commit: db.Commit{Hash: "c1"}, LastCommit: db.Commit{Hash: "c2"}, LastCommitState: db.Published,
+		}, nil).Once()
+
+		err := s.CheckDrift(context.Background(), &epb.NodeConfigReportEvent{NodeId: testNodeA, Commit: "c1"})
+
+		assert.NoError(t, err)
+		driftRepo.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+}
+
+func TestConfiguratorServer_GetDriftReport(t *testing.T) {
+	driftRepo := &mocks.DriftRepo{}
+	s := newDriftTestServer(&mbmocks.MsgBusServiceClient{}, &mocks.ConfigRepo{}, driftRepo, &mocks.ConfigStoreProvider{}, false)
+
+	driftRepo.On("List", true).Return([]db.NodeDrift{
+		{NodeId: testNodeA, Drifted: true, ExpectedCommit: "c1", ReportedCommit: "c0",
+			Files: `[{"app":"epc","file_name":"mme.json","expected_hash":"bbb","reported_hash":"ccc"}]`},
+	}, nil).Once()
+
+	resp, err := s.GetDriftReport(context.Background(), &pb.DriftReportRequest{DriftedOnly: true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), resp.Drifted)
+	if assert.Len(t, resp.Nodes, 1) && assert.Len(t, resp.Nodes[0].Files, 1) {
+		assert.Equal(t, "ccc", resp.Nodes[0].Files[0].ReportedHash)
+	}
+}
@@ -60,6 +60,17 @@ func (n *ConfiguratorEventServer) EventNotification(ctx context.Context, e *epb.
 		if err != nil {
 			return nil, err
 		}
+
+	case msgbus.PrepareRoute(n.orgName, "event.node.local.{{ .Org}}.messaging.mesh.config.report"):
+		msg, err := n.unmarshalNodeConfigReportEvent(e.Msg)
+		if err != nil {
+			return nil, err
+		}
+
+		err = n.s.CheckDrift(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
 	default:
 		log.Errorf("No handler routing key %s", e.RoutingKey)
 	}
@@ -101,10 +112,19 @@ func (n *ConfiguratorEventServer) handleRegistryNodeAddEvent(key string, msg *ep
 		return err
 	}
 
+	/* New nodes join the default environment and get its promoted commit if there is one */
+	hash := cfg.Hash
+	if n.s.promotionRepo != nil {
+		p, err := n.s.promotionRepo.GetLatest(db.DefaultEnvironment)
+		if err == nil {
+			hash = p.Hash
+		}
+	}
+
 	/* Pushing latest available config */
-	err = n.s.configStore.HandleConfigCommitReqForNode(context.Background(), cfg.Hash, msg.NodeId)
+	err = n.s.configStore.HandleConfigCommitReqForNode(context.Background(), hash, msg.NodeId)
 	if err != nil {
-		log.Errorf("Error updating node %s to config %s. Error: %+v", msg.NodeId, cfg.Hash, err)
+		log.Errorf("Error updating node %s to config %s. Error: %+v", msg.NodeId, hash, err)
 		return err
 	}
 
@@ -122,6 +142,16 @@ func (n *ConfiguratorEventServer) unmarshalNodeConfigUpdateEvent(msg *anypb.Any)
 	return p, nil
 }
 
+func (n *ConfiguratorEventServer) unmarshalNodeConfigReportEvent(msg *anypb.Any) (*epb.NodeConfigReportEvent, error) {
+	p := &epb.NodeConfigReportEvent{}
+	err := anypb.UnmarshalTo(msg, p, proto.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true})
+	if err != nil {
+		log.Errorf("Failed to Unmarshal NodeConfigReport message with : %+v. Error %s.", msg, err.Error())
+		return nil, err
+	}
+	return p, nil
+}
+
 // so, commenting for compiling.
 func (n *ConfiguratorEventServer) handleNodeConfigUpdateEvent(key string, msg *eCfgPb.NodeConfigUpdateEvent) error {
 	log.Infof("Keys %s and Proto is: %+v", key, msg)
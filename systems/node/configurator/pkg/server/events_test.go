@@ -29,6 +29,40 @@ import (
 var testNode = ukama.NewVirtualNodeId("HomeNode")
 var orgId = uuid.NewV4()
 
+func TestConfiguratorServer_AddNodeOfPromotedEnvironment(t *testing.T) {
+	commitRepo := &mocks.CommitRepo{}
+	configRepo := &mocks.ConfigRepo{}
+	promotionRepo := &mocks.PromotionRepo{}
+	configStore := &mocks.ConfigStoreProvider{}
+
+	s := NewConfiguratorServer(&mbmocks.MsgBusServiceClient{}, configRepo, commitRepo, promotionRepo, nil, configStore, testOrgName,
+		pkg.IsDebugMode, nil, 0, pkg.DriftConfig{}, "")
+
+	eventServer := NewConfiguratorEventServer(testOrgName, s)
+
+	any, err := anypb.New(&epb.NodeCreatedEvent{
+		NodeId: testNode.String(),
+		Name:   "testnode",
+		Type:   "hnode",
+		Org:    orgId.String(),
+	})
+	assert.NoError(t, err)
+
+	configRepo.On("Add", testNode.String()).Return(nil).Once()
+	commitRepo.On("GetLatest").Return(&db.Commit{Hash: "abcdef"}, nil).Once()
+	promotionRepo.On("GetLatest", db.DefaultEnvironment).Return(&db.Promotion{Hash: "123456"}, nil).Once()
+	configStore.On("HandleConfigCommitReqForNode", mock.Anything, "123456", testNode.String()).Return(nil).Once()
+
+	_, err = eventServer.EventNotification(context.Background(), &epb.Event{
+		RoutingKey: "event.cloud.local.testorg.registry.node.node.create",
+		Msg:        any,
+	})
+
+	assert.NoError(t, err)
+	configStore.AssertExpectations(t)
+	promotionRepo.AssertExpectations(t)
+}
+
 func TestConfiguratorServer_EventNotification(t *testing.T) {
 	// Arrange
 	msgbusClient := &mbmocks.MsgBusServiceClient{}
@@ -36,9 +70,11 @@ func TestConfiguratorServer_EventNotification(t *testing.T) {
 	configRepo := &mocks.ConfigRepo{}
 	configStore := &mocks.ConfigStoreProvider{}
 
-	s := NewConfiguratorServer(msgbusClient, configRepo, commitRepo, configStore, testOrgName, pkg.IsDebugMode,
+	s := NewConfiguratorServer(msgbusClient, configRepo, commitRepo, nil, nil, configStore, testOrgName, pkg.IsDebugMode,
 		nil,
 		0,
+		pkg.DriftConfig{},
+		"",
 	)
 
 	eventServer := NewConfiguratorEventServer(testOrgName, s)
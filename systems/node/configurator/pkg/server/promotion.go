@@ -0,0 +1,233 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/ukama/ukama/systems/node/configurator/pkg/db"
+	"gorm.io/gorm"
+
+	log "github.com/sirupsen/logrus"
+	pb "github.com/ukama/ukama/systems/node/configurator/pb/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/* Promote resolves a config store ref and pushes it to every node of the environment */
+func (c *ConfiguratorServer) Promote(ctx context.Context, req *pb.PromoteRequest) (*pb.PromoteResponse, error) {
+	log.Infof("Received a request to promote %s to %s", req.Ref, req.Environment)
+
+	env := strings.ToLower(req.Environment)
+	if env == "" || req.Ref == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "environment and ref are required")
+	}
+
+	hash, err := c.configStore.ResolveConfigRef(ctx, req.Ref)
+	if err != nil {
+		log.Errorf("Error while resolving ref %s. Error: %s", req.Ref, err.Error())
+		return nil, status.Errorf(codes.NotFound, "ref %s not found in config store: %v", req.Ref, err)
+	}
+
+	cfgs, err := c.configRepo.ListByEnvironment(env)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list nodes of environment %s: %v", env, err)
+	}
+
+	err = c.promotionRepo.Add(&db.Promotion{
+		Environment: env,
+		Ref:         req.Ref,
+		Hash:        hash,
+		PromotedBy:  req.RequestedBy,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record promotion: %v", err)
+	}
+
+	resp := &pb.PromoteResponse{
+		Environment: env,
+		Commit:      hash,
+	}
+
+	for _, cfg := range cfgs {
+		if err := c.configStore.HandleConfigCommitReqForNode(ctx, hash, cfg.NodeId); err != nil {
+			log.Errorf("Failed to push commit %s to node %s. Error: %s", hash, cfg.NodeId, err.Error())
+			resp.FailedNodes = append(resp.FailedNodes, cfg.NodeId)
+			continue
+		}
+		resp.Nodes = append(resp.Nodes, cfg.NodeId)
+	}
+
+	return resp, nil
+}
+
+/*
+ * Rollback moves an environment back to its previous promotion and pushes that
+ * commit to every node of it, acked or not. A single node, or an environment
+ * without a previous promotion, gets the last known-good commit (last one acked
+ * by the node) re-pushed if it failed or never acked its latest commit.
+ */
+func (c *ConfiguratorServer) Rollback(ctx context.Context, req *pb.RollbackRequest) (*pb.RollbackResponse, error) {
+	log.Infof("Received a request to rollback %v", req)
+
+	var cfgs []db.Configuration
+	env := strings.ToLower(req.Environment)
+
+	switch {
+	case req.NodeId != "":
+		cfg, err := c.configRepo.Get(req.NodeId)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "config for node %s not found: %v", req.NodeId, err)
+		}
+		cfgs = append(cfgs, *cfg)
+	case env != "":
+		var err error
+		cfgs, err = c.configRepo.ListByEnvironment(env)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list nodes of environment %s: %v", env, err)
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "environment or node id is required")
+	}
+
+	/* Recorded first, so that store changes keep away from the nodes being rolled back */
+	var target string
+	if req.NodeId == "" {
+		target = c.recordEnvironmentRollback(env, req.RequestedBy)
+	}
+
+	resp := &pb.RollbackResponse{}
+	for _, cfg := range cfgs {
+		to := target
+		switch {
+		case to == "":
+			if !needsRollback(cfg) {
+				continue
+			}
+			to = cfg.Commit.Hash
+		case cfg.Commit.Hash == to && cfg.LastCommit.Hash == to:
+			continue
+		}
+
+		nr := &pb.NodeRollback{
+			NodeId:     cfg.NodeId,
+			FromCommit: cfg.LastCommit.Hash,
+			ToCommit:   to,
+		}
+
+		if err := c.configStore.HandleConfigCommitReqForNode(ctx, to, cfg.NodeId); err != nil {
+			log.Errorf("Failed to rollback node %s to %s. Error: %s", cfg.NodeId, to, err.Error())
+			nr.Error = err.Error()
+		}
+
+		resp.Nodes = append(resp.Nodes, nr)
+	}
+
+	return resp, nil
+}
+
+func (c *ConfiguratorServer) SetNodeEnvironment(ctx context.Context, req *pb.SetNodeEnvironmentRequest) (*pb.SetNodeEnvironmentResponse, error) {
+	log.Infof("Received a request to set environment %v", req)
+
+	env := strings.ToLower(req.Environment)
+	if env == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "environment is required")
+	}
+
+	err := c.configRepo.UpdateEnvironment(req.NodeId, env)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "config for node %s not found", req.NodeId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update environment: %v", err)
+	}
+
+	return &pb.SetNodeEnvironmentResponse{}, nil
+}
+
+func (c *ConfiguratorServer) ListPromotions(ctx context.Context, req *pb.ListPromotionsRequest) (*pb.ListPromotionsResponse, error) {
+	promotions, err := c.promotionRepo.List(strings.ToLower(req.Environment))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list promotions: %v", err)
+	}
+
+	resp := &pb.ListPromotionsResponse{}
+	for _, p := range promotions {
+		resp.Promotions = append(resp.Promotions, &pb.Promotion{
+			Environment: p.Environment,
+			Ref:         p.Ref,
+			Commit:      p.Hash,
+			PromotedBy:  p.PromotedBy,
+			Rollback:    p.Rollback,
+			CreatedAt:   p.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return resp, nil
+}
+
+/* Node is rolled back only if its latest commit was not acked and it has a different known-good commit */
+func needsRollback(cfg db.Configuration) bool {
+	switch cfg.LastCommitState {
+	case db.Failed, db.Published, db.Partial:
+	default:
+		return false
+	}
+
+	return cfg.Commit.Hash != "" && cfg.Commit.Hash != cfg.LastCommit.Hash
+}
+
+/*
+ * Environment goes back to the newest promotion with another commit than the
+ * current one, whose commit is returned. Rollbacks, and promotions rolled back
+ * right after they were made, are skipped so that repeated rollbacks keep going
+ * back in history. Nothing is returned when there is no such promotion.
+ */
+func (c *ConfiguratorServer) recordEnvironmentRollback(env string, by string) string {
+	promotions, err := c.promotionRepo.List(env)
+	if err != nil || len(promotions) < 2 {
+		log.Warnf("No previous promotion for environment %s to roll back to", env)
+		return ""
+	}
+
+	current := promotions[0]
+
+	var prev *db.Promotion
+	for i := 1; i < len(promotions); i++ {
+		p := promotions[i]
+		if p.Rollback || promotions[i-1].Rollback || p.Hash == current.Hash {
+			continue
+		}
+
+		prev = &p
+		break
+	}
+
+	if prev == nil {
+		log.Warnf("No previous promotion for environment %s to roll back to", env)
+		return ""
+	}
+
+	err = c.promotionRepo.Add(&db.Promotion{
+		Environment: env,
+		Ref:         prev.Ref,
+		Hash:        prev.Hash,
+		PromotedBy:  by,
+		Rollback:    true,
+	})
+	if err != nil {
+		log.Errorf("Failed to record rollback of environment %s. Error: %s", env, err.Error())
+		return ""
+	}
+
+	return prev.Hash
+}
@@ -0,0 +1,174 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ukama/ukama/systems/node/configurator/mocks"
+	"github.com/ukama/ukama/systems/node/configurator/pkg"
+	"github.com/ukama/ukama/systems/node/configurator/pkg/db"
+
+	mbmocks "github.com/ukama/ukama/systems/common/mocks"
+	pb "github.com/ukama/ukama/systems/node/configurator/pb/gen"
+)
+
+const (
+	testNodeA = "uk-sa2643-hnode-v0-aaaa"
+	testNodeB = "uk-sa2643-hnode-v0-bbbb"
+	testNodeC = "uk-sa2643-hnode-v0-cccc"
+)
+
+func TestConfiguratorServer_Promote(t *testing.T) {
+	configRepo := &mocks.ConfigRepo{}
+	promotionRepo := &mocks.PromotionRepo{}
+	configStore := &mocks.ConfigStoreProvider{}
+
+	s := NewConfiguratorServer(&mbmocks.MsgBusServiceClient{}, configRepo, &mocks.CommitRepo{}, promotionRepo, nil, configStore,
+		testOrgName, pkg.IsDebugMode, nil, 0, pkg.DriftConfig{}, "")
+
+	configStore.On("ResolveConfigRef", mock.Anything, "staging").Return("4f6e609", nil).Once()
+	configRepo.On("ListByEnvironment", "production").Return([]db.Configuration{
+		{NodeId: testNodeA}, {NodeId: testNodeB},
+	}, nil).Once()
+	promotionRepo.On("Add", mock.MatchedBy(func(p *db.Promotion) bool {
+		return p.Environment == "production" && p.Hash == "4f6e609" && !p.Rollback
+	})).Return(nil).Once()
+	configStore.On("HandleConfigCommitReqForNode", mock.Anything, "4f6e609", testNodeA).Return(nil).Once()
+	configStore.On("HandleConfigCommitReqForNode", mock.Anything, "4f6e609", testNodeB).Return(errors.New("no configs")).Once()
+
+	resp, err := s.Promote(context.Background(), &pb.PromoteRequest{Environment: "Production", Ref: "staging"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "4f6e609", resp.Commit)
+	assert.Equal(t, []string{testNodeA}, resp.Nodes)
+	assert.Equal(t, []string{testNodeB}, resp.FailedNodes)
+	configStore.AssertExpectations(t)
+	promotionRepo.AssertExpectations(t)
+}
+
+func TestConfiguratorServer_Rollback(t *testing.T) {
+	configRepo := &mocks.ConfigRepo{}
+	promotionRepo := &mocks.PromotionRepo{}
+	configStore := &mocks.ConfigStoreProvider{}
+
+	s := NewConfiguratorServer(&mbmocks.MsgBusServiceClient{}, configRepo, &mocks.CommitRepo{}, promotionRepo, nil, configStore,
+		testOrgName, pkg.IsDebugMode, nil, 0, pkg.DriftConfig{}, "")
+
+	configRepo.On("ListByEnvironment", "production").Return([]db.Configuration{
+		{NodeId: testNodeA, Commit: db.Commit{Hash: "good"}, LastCommit: db.Commit{Hash: "bad"}, LastCommitState: db.Failed},
+		{NodeId: testNodeB, Commit: db.Commit{Hash: "bad"}, LastCommit: db.Commit{Hash: "bad"}, LastCommitState: db.Success},
+		{NodeId: testNodeC, Commit: db.Commit{Hash: "good"}, LastCommit: db.Commit{Hash: "good"}, LastCommitState: db.Success},
+	}, nil).Once()
+	configStore.On("HandleConfigCommitReqForNode", mock.Anything, "good", testNodeA).Return(nil).Once()
+	configStore.On("HandleConfigCommitReqForNode", mock.Anything, "good", testNodeB).Return(nil).Once()
+	promotionRepo.On("List", "production").Return([]db.Promotion{
+		{Environment: "production", Hash: "bad"}, {Environment: "production", Ref: "v1", Hash: "good"},
+	}, nil).Once()
+	promotionRepo.On("Add", mock.MatchedBy(func(p *db.Promotion) bool {
+		return p.Hash == "good" && p.Rollback
+	})).Return(nil).Once()
+
+	resp, err := s.Rollback(context.Background(), &pb.RollbackRequest{Environment: "production"})
+
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Nodes, 2) {
+		assert.Equal(t, testNodeA, resp.Nodes[0].NodeId)
+		assert.Equal(t, "bad", resp.Nodes[0].FromCommit)
+		assert.Equal(t, "good", resp.Nodes[0].ToCommit)
+		assert.Empty(t, resp.Nodes[0].Error)
+
+		/* acked the bad commit, still rolled back with the environment */
+		assert.Equal(t, testNodeB, resp.Nodes[1].NodeId)
+		assert.Equal(t, "bad", resp.Nodes[1].FromCommit)
+		assert.Equal(t, "good", resp.Nodes[1].ToCommit)
+	}
+	configStore.AssertExpectations(t)
+	promotionRepo.AssertExpectations(t)
+}
+
+func TestConfiguratorServer_RollbackWithoutPreviousPromotion(t *testing.T) {
+	configRepo := &mocks.ConfigRepo{}
+	promotionRepo := &mocks.PromotionRepo{}
+	configStore := &mocks.ConfigStoreProvider{}
+
+	s := NewConfiguratorServer(&mbmocks.MsgBusServiceClient{}, configRepo, &mocks.CommitRepo{}, promotionRepo, nil, configStore,
+		testOrgName, pkg.IsDebugMode, nil, 0, pkg.DriftConfig{}, "")
+
+	configRepo.On("ListByEnvironment", "production").Return([]db.Configuration{
+		{NodeId: testNodeA, Commit: db.Commit{Hash: "good"}, LastCommit: db.Commit{Hash: "bad"}, LastCommitState: db.Failed},
+		{NodeId: testNodeB, Commit: db.Commit{Hash: "bad"}, LastCommit: db.Commit{Hash: "bad"}, LastCommitState: db.Success},
+	}, nil).Once()
+	promotionRepo.On("List", "production").Return([]db.Promotion{
+		{Environment: "production", Hash: "bad"},
+	}, nil).Once()
+	configStore.On("HandleConfigCommitReqForNode", mock.Anything, "good", testNodeA).Return(nil).Once()
+
+	resp, err := s.Rollback(context.Background(), &pb.RollbackRequest{Environment: "production"})
+
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Nodes, 1) {
+		assert.Equal(t, testNodeA, resp.Nodes[0].NodeId)
+		assert.Equal(t, "good", resp.Nodes[0].ToCommit)
+	}
+	configStore.AssertExpectations(t)
+	promotionRepo.AssertExpectations(t)
+}
+
+func TestConfiguratorServer_RollbackAfterRollback(t *testing.T) {
+	configRepo := &mocks.ConfigRepo{}
+	promotionRepo := &mocks.PromotionRepo{}
+	configStore := &mocks.ConfigStoreProvider{}
+
+	s := NewConfiguratorServer(&mbmocks.MsgBusServiceClient{}, configRepo, &mocks.CommitRepo{}, promotionRepo, nil, configStore,
+		testOrgName, pkg.IsDebugMode, nil, 0, pkg.DriftConfig{}, "")
+
+	configRepo.On("ListByEnvironment", "production").Return([]db.Configuration{}, nil).Once()
+	promotionRepo.On("List", "production").Return([]db.Promotion{
+		{Environment: "production", Ref: "v3", Hash: "bad2"},
+		{Environment: "production", Ref: "v1", Hash: "good", Rollback: true},
+		{Environment: "production", Ref: "v2", Hash: "bad"},
+		{Environment: "production", Ref: "v1", Hash: "good"},
+	}, nil).Once()
+	promotionRepo.On("Add", mock.MatchedBy(func(p *db.Promotion) bool {
+		return p.Ref == "v1" && p.Hash == "good" && p.Rollback
+	})).Return(nil).Once()
+
+	_, err := s.Rollback(context.Background(), &pb.RollbackRequest{Environment: "production"})
+
+	assert.NoError(t, err)
+	promotionRepo.AssertExpectations(t)
+}
+
+func TestConfiguratorServer_GetConfigVersionInSync(t *testing.T) {
+	configRepo := &mocks.ConfigRepo{}
+	promotionRepo := &mocks.PromotionRepo{}
+
+	s := NewConfiguratorServer(&mbmocks.MsgBusServiceClient{}, configRepo, &mocks.CommitRepo{}, promotionRepo, nil,
+		&mocks.ConfigStoreProvider{}, testOrgName, pkg.IsDebugMode, nil, 0, pkg.DriftConfig{}, "")
+
+	configRepo.On("Get", testNodeA).Return(&db.Configuration{
+		NodeId:      testNodeA,
+		Environment: "staging",
+		Commit:      db.Commit{Hash: "4f6e609"},
+	}, nil).Once()
+	promotionRepo.On("GetLatest", "staging").Return(&db.Promotion{Hash: "4f6e609"}, nil).Once()
+
+	resp, err := s.GetConfigVersion(context.Background(), &pb.ConfigVersionRequest{NodeId: testNodeA})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "staging", resp.Environment)
+	assert.Equal(t, "4f6e609", resp.PromotedCommit)
+	assert.True(t, resp.InSync)
+}
@@ -0,0 +1,181 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const SchemaExt = ".schema.json"
+
+/*
+ * Validator checks config files against JSON schemas kept in the config store itself:
+ *   <schemaDir>/<app>/<file name>.schema.json   schema for one config file of an app
+ *   <schemaDir>/<app>.schema.json                schema for every config file of an app
+ * so a schema change and the configs depending on it land in the same commit.
+ */
+type Validator struct {
+	schemaDir string
+	strict    bool
+}
+
+/* FileReport is the validation result of one config file */
+type FileReport struct {
+	File   string
+	App    string
+	Schema string
+	Errors []string
+}
+
+func (f FileReport) Valid() bool {
+	return len(f.Errors) == 0
+}
+
+/* Report is the validation result of a set of config files */
+type Report struct {
+	Files []FileReport
+}
+
+func (r *Report) Valid() bool {
+	for _, f := range r.Files {
+		if !f.Valid() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *Report) Failed() []FileReport {
+	var failed []FileReport
+	for _, f := range r.Files {
+		if !f.Valid() {
+			failed = append(failed, f)
+		}
+	}
+
+	return failed
+}
+
+func (r *Report) Error() string {
+	var msgs []string
+	for _, f := range r.Failed() {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", f.File, strings.Join(f.Errors, "; ")))
+	}
+
+	return "config validation failed: " + strings.Join(msgs, ", ")
+}
+
+/* In strict mode a config file without a schema is rejected */
+func NewValidator(schemaDir string, strict bool) *Validator {
+	return &Validator{
+		schemaDir: strings.Trim(schemaDir, "/"),
+		strict:    strict,
+	}
+}
+
+/* IsSchemaFile tells if a file path (relative to config store root) belongs to the schema dir */
+func (v *Validator) IsSchemaFile(file string) bool {
+	return v.schemaDir != "" && strings.HasPrefix(strings.TrimPrefix(file, "/"), v.schemaDir+"/")
+}
+
+/* ValidateFile validates data of a config file of an app, schemas are looked up under root */
+func (v *Validator) ValidateFile(root string, file string, app string, data []byte) FileReport {
+	rep := FileReport{
+		File: file,
+		App:  app,
+	}
+
+	var doc interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		rep.Errors = append(rep.Errors, fmt.Sprintf("invalid json: %s", err.Error()))
+		return rep
+	}
+
+	schemaFile, err := v.findSchema(root, app, filepath.Base(file))
+	if err != nil {
+		if v.strict {
+			rep.Errors = append(rep.Errors, err.Error())
+		} else {
+			log.Warnf("Skipping schema validation of %s: %s", file, err.Error())
+		}
+		return rep
+	}
+	rep.Schema, _ = filepath.Rel(root, schemaFile)
+
+	schemaPath, err := filepath.Abs(schemaFile)
+	if err != nil {
+		rep.Errors = append(rep.Errors, err.Error())
+		return rep
+	}
+
+	schema, err := jsonschema.Compile(schemaPath)
+	if err != nil {
+		rep.Errors = append(rep.Errors, fmt.Sprintf("invalid schema %s: %s", rep.Schema, err.Error()))
+		return rep
+	}
+
+	err = schema.Validate(doc)
+	if err != nil {
+		var ve *jsonschema.ValidationError
+		if errors.As(err, &ve) {
+			rep.Errors = append(rep.Errors, leafErrors(ve)...)
+		} else {
+			rep.Errors = append(rep.Errors, err.Error())
+		}
+	}
+
+	return rep
+}
+
+func (v *Validator) findSchema(root string, app string, fileName string) (string, error) {
+	base := filepath.Join(root, v.schemaDir)
+	candidates := []string{
+		filepath.Join(base, app, strings.TrimSuffix(fileName, filepath.Ext(fileName))+SchemaExt),
+		filepath.Join(base, app+SchemaExt),
+	}
+
+	for _, c := range candidates {
+		if _, err := os.Stat(c); err == nil {
+			return c, nil
+		}
+	}
+
+	return "", fmt.Errorf("no schema for app %s file %s", app, fileName)
+}
+
+/* Only the innermost errors point to what is actually wrong in the file */
+func leafErrors(ve *jsonschema.ValidationError) []string {
+	if len(ve.Causes) == 0 {
+		loc := ve.InstanceLocation
+		if loc == "" {
+			loc = "/"
+		}
+		return []string{fmt.Sprintf("%s: %s", loc, ve.Message)}
+	}
+
+	var errs []string
+	for _, c := range ve.Causes {
+		errs = append(errs, leafErrors(c)...)
+	}
+
+	return errs
+}
@@ -0,0 +1,97 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package validator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const epcSchema = `{
+	"type": "object",
+	"required": ["name", "config"],
+	"properties": {
+		"name": {"type": "string"},
+		"config": {
+			"type": "object",
+			"properties": {"port": {"type": "integer", "minimum": 1, "maximum": 65535}}
+		}
+	}
+}`
+
+const testFile = "networkABC/siteXYZ/uk-000000-hnode-0000/epc/sctp.json"
+
+func schemaRoot(t *testing.T, rel string) string {
+	root := t.TempDir()
+	p := filepath.Join(root, rel)
+	require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+	require.NoError(t, os.WriteFile(p, []byte(epcSchema), 0644))
+
+	return root
+}
+
+func TestValidator_ValidateFile(t *testing.T) {
+	root := schemaRoot(t, "schemas/epc/sctp.schema.json")
+	v := NewValidator("schemas", false)
+
+	t.Run("Valid", func(t *testing.T) {
+		rep := v.ValidateFile(root, testFile, "epc", []byte(`{"name":"sctp","config":{"port":38412}}`))
+
+		assert.True(t, rep.Valid())
+		assert.Equal(t, "schemas/epc/sctp.schema.json", rep.Schema)
+	})
+
+	t.Run("SchemaViolation", func(t *testing.T) {
+		rep := v.ValidateFile(root, testFile, "epc", []byte(`{"name":"sctp","config":{"port":"38412"}}`))
+
+		require.Len(t, rep.Errors, 1)
+		assert.Contains(t, rep.Errors[0], "/config/port")
+	})
+
+	t.Run("MalformedJson", func(t *testing.T) {
+		rep := v.ValidateFile(root, testFile, "epc", []byte(`{"name":"sctp",`))
+
+		require.Len(t, rep.Errors, 1)
+		assert.Contains(t, rep.Errors[0], "invalid json")
+	})
+}
+
+func TestValidator_AppSchema(t *testing.T) {
+	root := schemaRoot(t, "schemas/epc.schema.json")
+
+	rep := NewValidator("schemas", false).ValidateFile(root, testFile, "epc", []byte(`{"config":{}}`))
+
+	assert.False(t, rep.Valid())
+	assert.Equal(t, "schemas/epc.schema.json", rep.Schema)
+}
+
+func TestValidator_MissingSchema(t *testing.T) {
+	root := t.TempDir()
+	data := []byte(`{"name":"sctp"}`)
+
+	assert.True(t, NewValidator("schemas", false).ValidateFile(root, testFile, "epc", data).Valid())
+	assert.False(t, NewValidator("schemas", true).ValidateFile(root, testFile, "epc", data).Valid())
+}
+
+func TestReport(t *testing.T) {
+	r := &Report{Files: []FileReport{
+		{File: "a.json"},
+		{File: "b.json", Errors: []string{"/: missing properties: 'name'"}},
+	}}
+
+	assert.False(t, r.Valid())
+	assert.Len(t, r.Failed(), 1)
+	assert.Contains(t, r.Error(), "b.json")
+	assert.True(t, NewValidator("schemas", false).IsSchemaFile("schemas/epc.schema.json"))
+	assert.False(t, NewValidator("schemas", false).IsSchemaFile("networkABC/schemas/epc.json"))
+}
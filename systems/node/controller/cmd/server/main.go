@@ -66,7 +66,7 @@ func initConfig() {
 func initDb() sql.Db {
 	log.Infof("Initializing Database")
 	d := sql.NewDb(svcConf.DB, svcConf.DebugMode)
-	err := d.Init(&db.NodeLog{})
+	err := d.Init(&db.NodeLog{}, &db.CommandAudit{}, &db.CommandPlan{})
 	if err != nil {
 		log.Fatalf("Database initialization failed. Error: %v", err)
 	}
@@ -107,9 +107,9 @@ func runGrpcServer(gormdb sql.Db) {
 
 	opMon := cclient.NewOperationMonitor(svcConf.Operation.MonitorHost, svcConf.Operation.Timeout)
 
-	contServer := server.NewControllerServer(svcConf.OrgName, db.NewNodeLogRepo(gormdb),
+	contServer := server.NewControllerServer(svcConf.OrgName, db.NewNodeLogRepo(gormdb), db.NewCommandAuditRepo(gormdb), db.NewCommandPlanRepo(gormdb),
 		mbClient, cnet, csite, cnode,
-		opMgr, opMon, svcConf.Operation.LeaseSecs, svcConf.Operation.DeadlineSecs,
+		opMgr, opMon, svcConf.Operation.LeaseSecs, svcConf.Operation.DeadlineSecs, svcConf.Operation.WaitSecs, svcConf.CommandPlan,
 		svcConf.DebugMode)
 	controllerEventServer := server.NewControllerEventServer(svcConf.OrgName, contServer)
 
@@ -0,0 +1,75 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	db "github.com/ukama/ukama/systems/node/controller/pkg/db"
+)
+
+// CommandAuditRepo is an autogenerated mock type for the CommandAuditRepo type
+type CommandAuditRepo struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: entry
+func (_m *CommandAuditRepo) Add(entry *db.CommandAudit) error {
+	ret := _m.Called(entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.CommandAudit) error); ok {
+		r0 = rf(entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: filter
+func (_m *CommandAuditRepo) List(filter db.CommandAuditFilter) ([]db.CommandAudit, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []db.CommandAudit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.CommandAuditFilter) ([]db.CommandAudit, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(db.CommandAuditFilter) []db.CommandAudit); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.CommandAudit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.CommandAuditFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCommandAuditRepo creates a new instance of CommandAuditRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCommandAuditRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CommandAuditRepo {
+	mock := &CommandAuditRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
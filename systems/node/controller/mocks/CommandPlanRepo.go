@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	db "github.com/ukama/ukama/systems/node/controller/pkg/db"
+
+	uuid "github.com/ukama/ukama/systems/common/uuid"
+)
+
+// CommandPlanRepo is an autogenerated mock type for the CommandPlanRepo type
+type CommandPlanRepo struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: p
+func (_m *CommandPlanRepo) Add(p *db.CommandPlan) error {
+	ret := _m.Called(p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.CommandPlan) error); ok {
+		r0 = rf(p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: id
+func (_m *CommandPlanRepo) Get(id uuid.UUID) (*db.CommandPlan, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *db.CommandPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (*db.CommandPlan, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) *db.CommandPlan); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.CommandPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: p
+func (_m *CommandPlanRepo) Update(p *db.CommandPlan) error {
+	ret := _m.Called(p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.CommandPlan) error); ok {
+		r0 = rf(p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCommandPlanRepo creates a new instance of CommandPlanRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCommandPlanRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CommandPlanRepo {
+	mock := &CommandPlanRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
+// 	protoc-gen-go v1.36.12
 // 	protoc        v6.32.1
 // source: controller.proto
 
@@ -10,8 +10,10 @@ import (
 	_ "github.com/mwitkow/go-proto-validators"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -22,23 +24,22 @@ const (
 )
 
 type SendNodeCommandRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,json=node_id,proto3" json:"nodeId,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Body          []byte                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,5,opt,name=requestedBy,json=requested_by,proto3" json:"requestedBy,omitempty"`
+	WaitSeconds   uint32                 `protobuf:"varint,6,opt,name=waitSeconds,json=wait_seconds,proto3" json:"waitSeconds,omitempty"` // wait this long for a busy node, 0 uses the controller default
 	unknownFields protoimpl.UnknownFields
-
-	NodeId string `protobuf:"bytes,1,opt,name=nodeId,json=node_id,proto3" json:"nodeId,omitempty"`
-	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	Path   string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	Body   []byte `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SendNodeCommandRequest) Reset() {
 	*x = SendNodeCommandRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_controller_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_controller_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SendNodeCommandRequest) String() string {
@@ -49,7 +50,7 @@ func (*SendNodeCommandRequest) ProtoMessage() {}
 
 func (x *SendNodeCommandRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_controller_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -92,23 +93,34 @@ func (x *SendNodeCommandRequest) GetBody() []byte {
 	return nil
 }
 
+func (x *SendNodeCommandRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *SendNodeCommandRequest) GetWaitSeconds() uint32 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
 type SendNodeCommandResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operationId,json=operation_id,proto3" json:"operationId,omitempty"`
+	ResourceKey   string                 `protobuf:"bytes,2,opt,name=resourceKey,json=resource_key,proto3" json:"resourceKey,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OperationId string `protobuf:"bytes,1,opt,name=operationId,json=operation_id,proto3" json:"operationId,omitempty"`
-	ResourceKey string `protobuf:"bytes,2,opt,name=resourceKey,json=resource_key,proto3" json:"resourceKey,omitempty"`
-	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SendNodeCommandResponse) Reset() {
 	*x = SendNodeCommandResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_controller_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_controller_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SendNodeCommandResponse) String() string {
@@ -119,7 +131,7 @@ func (*SendNodeCommandResponse) ProtoMessage() {}
 
 func (x *SendNodeCommandResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_controller_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -156,20 +168,17 @@ func (x *SendNodeCommandResponse) GetStatus() string {
 }
 
 type PingNodeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,json=node_id,proto3" json:"nodeId,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId string `protobuf:"bytes,1,opt,name=nodeId,json=node_id,proto3" json:"nodeId,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PingNodeRequest) Reset() {
 	*x = PingNodeRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_controller_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_controller_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *PingNodeRequest) String() string {
@@ -180,7 +189,7 @@ func (*PingNodeRequest) ProtoMessage() {}
 
 func (x *PingNodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_controller_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -203,18 +212,16 @@ func (x *PingNodeRequest) GetNodeId() string {
 }
 
 type PingNodeResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PingNodeResponse) Reset() {
 	*x = PingNodeResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_controller_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_controller_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *PingNodeResponse) String() string {
@@ -225,7 +232,7 @@ func (*PingNodeResponse) ProtoMessage() {}
 
 func (x *PingNodeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_controller_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -241,22 +248,21 @@ func (*PingNodeResponse) Descriptor() ([]byte, []int) {
 }
 
 type ToggleSwitchPortRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,json=node_id,proto3" json:"nodeId,omitempty"`
+	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"` // true for on, false for off
+	Port          int32                  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`     // New field to specify the port number
+	RequestedBy   string                 `protobuf:"bytes,4,opt,name=requestedBy,json=requested_by,proto3" json:"requestedBy,omitempty"`
+	WaitSeconds   uint32                 `protobuf:"varint,5,opt,name=waitSeconds,json=wait_seconds,proto3" json:"waitSeconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId string `protobuf:"bytes,1,opt,name=nodeId,json=node_id,proto3" json:"nodeId,omitempty"`
-	Status bool   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"` // true for on, false for off
-	Port   int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`     // New field to specify the port number
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ToggleSwitchPortRequest) Reset() {
 	*x = ToggleSwitchPortRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_controller_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_controller_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ToggleSwitchPortRequest) String() string {
@@ -267,7 +273,7 @@ func (*ToggleSwitchPortRequest) ProtoMessage() {}
 
 func (x *ToggleSwitchPortRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_controller_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -303,23 +309,34 @@ func (x *ToggleSwitchPortRequest) GetPort() int32 {
 	return 0
 }
 
+func (x *ToggleSwitchPortRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *ToggleSwitchPortRequest) GetWaitSeconds() uint32 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
 type ToggleSwitchPortResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operationId,json=operation_id,proto3" json:"operationId,omitempty"`
+	ResourceKey   string                 `protobuf:"bytes,2,opt,name=resourceKey,json=resource_key,proto3" json:"resourceKey,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OperationId string `protobuf:"bytes,1,opt,name=operationId,json=operation_id,proto3" json:"operationId,omitempty"`
-	ResourceKey string `protobuf:"bytes,2,opt,name=resourceKey,json=resource_key,proto3" json:"resourceKey,omitempty"`
-	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ToggleSwitchPortResponse) Reset() {
 	*x = ToggleSwitchPortResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_controller_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_controller_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ToggleSwitchPortResponse) String() string {
@@ -330,7 +347,7 @@ func (*ToggleSwitchPortResponse) ProtoMessage() {}
 
 func (x *ToggleSwitchPortResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_controller_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -367,21 +384,20 @@ func (x *ToggleSwitchPortResponse) GetStatus() string {
 }
 
 type ToggleRadioRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,json=node_id,proto3" json:"nodeId,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requestedBy,json=requested_by,proto3" json:"requestedBy,omitempty"`
+	WaitSeconds   uint32                 `protobuf:"varint,4,opt,name=waitSeconds,json=wait_seconds,proto3" json:"waitSeconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId string `protobuf:"bytes,1,opt,name=nodeId,json=node_id,proto3" json:"nodeId,omitempty"`
-	State  string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ToggleRadioRequest) Reset() {
 	*x = ToggleRadioRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_controller_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_controller_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ToggleRadioRequest) String() string {
@@ -392,7 +408,7 @@ func (*ToggleRadioRequest) ProtoMessage() {}
 
 func (x *ToggleRadioRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_controller_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -421,23 +437,34 @@ func (x *ToggleRadioRequest) GetState() string {
 	return ""
 }
 
+func (x *ToggleRadioRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *ToggleRadioRequest) GetWaitSeconds() uint32 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
 type ToggleRadioResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operationId,json=operation_id,proto3" json:"operationId,omitempty"`
+	ResourceKey   string                 `protobuf:"bytes,2,opt,name=resourceKey,json=resource_key,proto3" json:"resourceKey,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OperationId string `protobuf:"bytes,1,opt,name=operationId,json=operation_id,proto3" json:"operationId,omitempty"`
-	ResourceKey string `protobuf:"bytes,2,opt,name=resourceKey,json=resource_key,proto3" json:"resourceKey,omitempty"`
-	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ToggleRadioResponse) Reset() {
 	*x = ToggleRadioResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_controller_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_controller_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ToggleRadioResponse) String() string {
@@ -448,7 +475,7 @@ func (*ToggleRadioResponse) ProtoMessage() {}
 
 func (x *ToggleRadioResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_controller_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -485,20 +512,19 @@ func (x *ToggleRadioResponse) GetStatus() string {
 }
 
 type RestartNodeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,json=node_id,proto3" json:"nodeId,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,2,opt,name=requestedBy,json=requested_by,proto3" json:"requestedBy,omitempty"`
+	WaitSeconds   uint32                 `protobuf:"varint,3,opt,name=waitSeconds,json=wait_seconds,proto3" json:"waitSeconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId string `protobuf:"bytes,1,opt,name=nodeId,json=node_id,proto3" json:"nodeId,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RestartNodeRequest) Reset() {
 	*x = RestartNodeRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_controller_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_controller_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RestartNodeRequest) String() string {
@@ -509,7 +535,7 @@ func (*RestartNodeRequest) ProtoMessage() {}
 
 func (x *RestartNodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_controller_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -531,23 +557,34 @@ func (x *RestartNodeRequest) GetNodeId() string {
 	return ""
 }
 
+func (x *RestartNodeRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *RestartNodeRequest) GetWaitSeconds() uint32 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
 type RestartNodeResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operationId,json=operation_id,proto3" json:"operationId,omitempty"`
+	ResourceKey   string                 `protobuf:"bytes,2,opt,name=resourceKey,json=resource_key,proto3" json:"resourceKey,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OperationId string `protobuf:"bytes,1,opt,name=operationId,json=operation_id,proto3" json:"operationId,omitempty"`
-	ResourceKey string `protobuf:"bytes,2,opt,name=resourceKey,json=resource_key,proto3" json:"resourceKey,omitempty"`
-	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RestartNodeResponse) Reset() {
 	*x = RestartNodeResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_controller_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_controller_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RestartNodeResponse) String() string {
@@ -558,7 +595,7 @@ func (*RestartNodeResponse) ProtoMessage() {}
 
 func (x *RestartNodeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_controller_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -595,21 +632,20 @@ func (x *RestartNodeResponse) GetStatus() string {
 }
 
 type ToggleServiceRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,json=node_id,proto3" json:"nodeId,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requestedBy,json=requested_by,proto3" json:"requestedBy,omitempty"`
+	WaitSeconds   uint32                 `protobuf:"varint,4,opt,name=waitSeconds,json=wait_seconds,proto3" json:"waitSeconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId string `protobuf:"bytes,1,opt,name=nodeId,json=node_id,proto3" json:"nodeId,omitempty"`
-	State  string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ToggleServiceRequest) Reset() {
 	*x = ToggleServiceRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_controller_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_controller_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ToggleServiceRequest) String() string {
@@ -620,7 +656,7 @@ func (*ToggleServiceRequest) ProtoMessage() {}
 
 func (x *ToggleServiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_controller_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -649,23 +685,34 @@ func (x *ToggleServiceRequest) GetState() string {
 	return ""
 }
 
+func (x *ToggleServiceRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *ToggleServiceRequest) GetWaitSeconds() uint32 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
 type ToggleServiceResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operationId,json=operation_id,proto3" json:"operationId,omitempty"`
+	ResourceKey   string                 `protobuf:"bytes,2,opt,name=resourceKey,json=resource_key,proto3" json:"resourceKey,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OperationId string `protobuf:"bytes,1,opt,name=operationId,json=operation_id,proto3" json:"operationId,omitempty"`
-	ResourceKey string `protobuf:"bytes,2,opt,name=resourceKey,json=resource_key,proto3" json:"resourceKey,omitempty"`
-	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ToggleServiceResponse) Reset() {
 	*x = ToggleServiceResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_controller_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_controller_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ToggleServiceResponse) String() string {
@@ -676,7 +723,7 @@ func (*ToggleServiceResponse) ProtoMessage() {}
 
 func (x *ToggleServiceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_controller_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -713,20 +760,17 @@ func (x *ToggleServiceResponse) GetStatus() string {
 }
 
 type PublishMsgRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PublishMsgRequest) Reset() {
 	*x = PublishMsgRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_controller_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_controller_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *PublishMsgRequest) String() string {
@@ -737,7 +781,7 @@ func (*PublishMsgRequest) ProtoMessage() {}
 
 func (x *PublishMsgRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_controller_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -759,355 +803,1156 @@ func (x *PublishMsgRequest) GetState() string {
 	return ""
 }
 
-var File_controller_proto protoreflect.FileDescriptor
+type CommandAuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,json=node_id,proto3" json:"nodeId,omitempty"`
+	Command       string                 `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,5,opt,name=requestedBy,json=requested_by,proto3" json:"requestedBy,omitempty"`
+	OperationId   string                 `protobuf:"bytes,6,opt,name=operationId,json=operation_id,proto3" json:"operationId,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,json=created_at,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var file_controller_proto_rawDesc = []byte{
-	0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x18, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x0f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89, 0x01,
-	0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01,
-	0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58,
-	0x01, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x77, 0x0a, 0x17, 0x53, 0x65, 0x6e,
-	0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x32, 0x0a, 0x0f, 0x50, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x07, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x50, 0x69, 0x6e, 0x67, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x0a, 0x17, 0x54, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x07, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f,
-	0x72, 0x74, 0x22, 0x78, 0x0a, 0x18, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
-	0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x12, 0x21, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x53, 0x0a, 0x12,
-	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x22, 0x73, 0x0a, 0x13, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x61, 0x64, 0x69, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x35, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06,
-	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf,
-	0x1f, 0x02, 0x58, 0x01, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x73, 0x0a,
-	0x13, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x55, 0x0a, 0x14, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6e, 0x6f,
-	0x64, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
-	0x58, 0x01, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
-	0x58, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x75, 0x0a, 0x15, 0x54, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x31, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x73, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x32, 0xb3, 0x05, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6a, 0x0a, 0x0b, 0x52, 0x65, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61,
-	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e,
-	0x6f, 0x64, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0b, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52,
-	0x61, 0x64, 0x69, 0x6f, 0x12, 0x2c, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64,
-	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x79, 0x0a, 0x10, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x31, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f,
-	0x64, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x72,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61,
-	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x0d,
-	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x2e,
-	0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
-	0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61,
-	0x0a, 0x08, 0x50, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x2e, 0x75, 0x6b, 0x61,
-	0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f,
-	0x64, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x76, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x30, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64,
-	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e,
-	0x6f, 0x64, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x75, 0x6b,
-	0x61, 0x6d, 0x61, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x6e, 0x6f, 0x64, 0x65,
-	0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x70, 0x62, 0x2f, 0x67,
-	0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *CommandAuditEntry) Reset() {
+	*x = CommandAuditEntry{}
+	mi := &file_controller_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-var (
-	file_controller_proto_rawDescOnce sync.Once
-	file_controller_proto_rawDescData = file_controller_proto_rawDesc
-)
+func (x *CommandAuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_controller_proto_rawDescGZIP() []byte {
-	file_controller_proto_rawDescOnce.Do(func() {
-		file_controller_proto_rawDescData = protoimpl.X.CompressGZIP(file_controller_proto_rawDescData)
-	})
-	return file_controller_proto_rawDescData
+func (*CommandAuditEntry) ProtoMessage() {}
+
+func (x *CommandAuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_controller_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_controller_proto_goTypes = []interface{}{
-	(*SendNodeCommandRequest)(nil),   // 0: ukama.node.controller.v1.SendNodeCommandRequest
-	(*SendNodeCommandResponse)(nil),  // 1: ukama.node.controller.v1.SendNodeCommandResponse
-	(*PingNodeRequest)(nil),          // 2: ukama.node.controller.v1.PingNodeRequest
-	(*PingNodeResponse)(nil),         // 3: ukama.node.controller.v1.PingNodeResponse
-	(*ToggleSwitchPortRequest)(nil),  // 4: ukama.node.controller.v1.ToggleSwitchPortRequest
-	(*ToggleSwitchPortResponse)(nil), // 5: ukama.node.controller.v1.ToggleSwitchPortResponse
-	(*ToggleRadioRequest)(nil),       // 6: ukama.node.controller.v1.ToggleRadioRequest
-	(*ToggleRadioResponse)(nil),      // 7: ukama.node.controller.v1.ToggleRadioResponse
-	(*RestartNodeRequest)(nil),       // 8: ukama.node.controller.v1.RestartNodeRequest
-	(*RestartNodeResponse)(nil),      // 9: ukama.node.controller.v1.RestartNodeResponse
-	(*ToggleServiceRequest)(nil),     // 10: ukama.node.controller.v1.ToggleServiceRequest
-	(*ToggleServiceResponse)(nil),    // 11: ukama.node.controller.v1.ToggleServiceResponse
-	(*PublishMsgRequest)(nil),        // 12: ukama.node.controller.v1.PublishMsgRequest
+// Deprecated: Use CommandAuditEntry.ProtoReflect.Descriptor instead.
+func (*CommandAuditEntry) Descriptor() ([]byte, []int) {
+	return file_controller_proto_rawDescGZIP(), []int{13}
 }
-var file_controller_proto_depIdxs = []int32{
-	8,  // 0: ukama.node.controller.v1.ControllerService.RestartNode:input_type -> ukama.node.controller.v1.RestartNodeRequest
-	6,  // 1: ukama.node.controller.v1.ControllerService.ToggleRadio:input_type -> ukama.node.controller.v1.ToggleRadioRequest
-	4,  // 2: ukama.node.controller.v1.ControllerService.ToggleSwitchPort:input_type -> ukama.node.controller.v1.ToggleSwitchPortRequest
-	10, // 3: ukama.node.controller.v1.ControllerService.ToggleService:input_type -> ukama.node.controller.v1.ToggleServiceRequest
-	2,  // 4: ukama.node.controller.v1.ControllerService.PingNode:input_type -> ukama.node.controller.v1.PingNodeRequest
-	0,  // 5: ukama.node.controller.v1.ControllerService.SendNodeCommand:input_type -> ukama.node.controller.v1.SendNodeCommandRequest
-	9,  // 6: ukama.node.controller.v1.ControllerService.RestartNode:output_type -> ukama.node.controller.v1.RestartNodeResponse
-	7,  // 7: ukama.node.controller.v1.ControllerService.ToggleRadio:output_type -> ukama.node.controller.v1.ToggleRadioResponse
-	5,  // 8: ukama.node.controller.v1.ControllerService.ToggleSwitchPort:output_type -> ukama.node.controller.v1.ToggleSwitchPortResponse
-	11, // 9: ukama.node.controller.v1.ControllerService.ToggleService:output_type -> ukama.node.controller.v1.ToggleServiceResponse
-	3,  // 10: ukama.node.controller.v1.ControllerService.PingNode:output_type -> ukama.node.controller.v1.PingNodeResponse
-	1,  // 11: ukama.node.controller.v1.ControllerService.SendNodeCommand:output_type -> ukama.node.controller.v1.SendNodeCommandResponse
-	6,  // [6:12] is the sub-list for method output_type
-	0,  // [0:6] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+
+func (x *CommandAuditEntry) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
 }
 
-func init() { file_controller_proto_init() }
-func file_controller_proto_init() {
-	if File_controller_proto != nil {
-		return
+func (x *CommandAuditEntry) GetCommand() string {
+	if x != nil {
+		return x.Command
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_controller_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendNodeCommandRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_controller_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendNodeCommandResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_controller_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingNodeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_controller_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingNodeResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_controller_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleSwitchPortRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_controller_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleSwitchPortResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_controller_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleRadioRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_controller_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleRadioResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_controller_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartNodeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_controller_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartNodeResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_controller_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleServiceRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_controller_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleServiceResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_controller_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishMsgRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *CommandAuditEntry) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CommandAuditEntry) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CommandAuditEntry) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *CommandAuditEntry) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *CommandAuditEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CommandAuditEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CommandAuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListCommandAuditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,json=node_id,proto3" json:"nodeId,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,2,opt,name=requestedBy,json=requested_by,proto3" json:"requestedBy,omitempty"`
+	Command       string                 `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         uint32                 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommandAuditRequest) Reset() {
+	*x = ListCommandAuditRequest{}
+	mi := &file_controller_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommandAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommandAuditRequest) ProtoMessage() {}
+
+func (x *ListCommandAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommandAuditRequest.ProtoReflect.Descriptor instead.
+func (*ListCommandAuditRequest) Descriptor() ([]byte, []int) {
+	return file_controller_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListCommandAuditRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ListCommandAuditRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *ListCommandAuditRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ListCommandAuditRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListCommandAuditRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListCommandAuditRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCommandAuditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*CommandAuditEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommandAuditResponse) Reset() {
+	*x = ListCommandAuditResponse{}
+	mi := &file_controller_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommandAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommandAuditResponse) ProtoMessage() {}
+
+func (x *ListCommandAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommandAuditResponse.ProtoReflect.Descriptor instead.
+func (*ListCommandAuditResponse) Descriptor() ([]byte, []int) {
+	return file_controller_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListCommandAuditResponse) GetEntries() []*CommandAuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Exactly one of site, network or node ids selects the nodes. nodeTypes
+// narrows the selection, node type is the only tag the registry keeps.
+type PlanTarget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
+	NetworkId     string                 `protobuf:"bytes,2,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
+	NodeIds       []string               `protobuf:"bytes,3,rep,name=nodeIds,json=node_ids,proto3" json:"nodeIds,omitempty"`
+	NodeTypes     []string               `protobuf:"bytes,4,rep,name=nodeTypes,json=node_types,proto3" json:"nodeTypes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanTarget) Reset() {
+	*x = PlanTarget{}
+	mi := &file_controller_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanTarget) ProtoMessage() {}
+
+func (x *PlanTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanTarget.ProtoReflect.Descriptor instead.
+func (*PlanTarget) Descriptor() ([]byte, []int) {
+	return file_controller_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PlanTarget) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *PlanTarget) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *PlanTarget) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *PlanTarget) GetNodeTypes() []string {
+	if x != nil {
+		return x.NodeTypes
+	}
+	return nil
+}
+
+// Steps run in order. A step acts on the targeted nodes of its node types,
+// all of them when empty, at most parallelism at a time (0 for no limit).
+type PlanStep struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Action             string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // RESTART, RADIO or SERVICE
+	NodeTypes          []string               `protobuf:"bytes,3,rep,name=nodeTypes,json=node_types,proto3" json:"nodeTypes,omitempty"`
+	State              string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"` // on/off for RADIO and SERVICE
+	Parallelism        uint32                 `protobuf:"varint,5,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	WaitOnline         bool                   `protobuf:"varint,6,opt,name=waitOnline,json=wait_online,proto3" json:"waitOnline,omitempty"`
+	WaitTimeoutSeconds uint32                 `protobuf:"varint,7,opt,name=waitTimeoutSeconds,json=wait_timeout_seconds,proto3" json:"waitTimeoutSeconds,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PlanStep) Reset() {
+	*x = PlanStep{}
+	mi := &file_controller_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanStep) ProtoMessage() {}
+
+func (x *PlanStep) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanStep.ProtoReflect.Descriptor instead.
+func (*PlanStep) Descriptor() ([]byte, []int) {
+	return file_controller_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PlanStep) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlanStep) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PlanStep) GetNodeTypes() []string {
+	if x != nil {
+		return x.NodeTypes
+	}
+	return nil
+}
+
+func (x *PlanStep) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PlanStep) GetParallelism() uint32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *PlanStep) GetWaitOnline() bool {
+	if x != nil {
+		return x.WaitOnline
+	}
+	return false
+}
+
+func (x *PlanStep) GetWaitTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.WaitTimeoutSeconds
+	}
+	return 0
+}
+
+type PlanNodeResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Step          uint32                 `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=nodeId,json=node_id,proto3" json:"nodeId,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=startedAt,json=started_at,proto3" json:"startedAt,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=finishedAt,json=finished_at,proto3" json:"finishedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanNodeResult) Reset() {
+	*x = PlanNodeResult{}
+	mi := &file_controller_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanNodeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanNodeResult) ProtoMessage() {}
+
+func (x *PlanNodeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanNodeResult.ProtoReflect.Descriptor instead.
+func (*PlanNodeResult) Descriptor() ([]byte, []int) {
+	return file_controller_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PlanNodeResult) GetStep() uint32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *PlanNodeResult) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *PlanNodeResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PlanNodeResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PlanNodeResult) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *PlanNodeResult) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type CommandPlan struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperationId    string                 `protobuf:"bytes,2,opt,name=operationId,json=operation_id,proto3" json:"operationId,omitempty"`
+	ResourceKey    string                 `protobuf:"bytes,3,opt,name=resourceKey,json=resource_key,proto3" json:"resourceKey,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Error          string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	RequestedBy    string                 `protobuf:"bytes,6,opt,name=requestedBy,json=requested_by,proto3" json:"requestedBy,omitempty"`
+	AbortOnFailure bool                   `protobuf:"varint,7,opt,name=abortOnFailure,json=abort_on_failure,proto3" json:"abortOnFailure,omitempty"`
+	Steps          []*PlanStep            `protobuf:"bytes,8,rep,name=steps,proto3" json:"steps,omitempty"`
+	Results        []*PlanNodeResult      `protobuf:"bytes,9,rep,name=results,proto3" json:"results,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=createdAt,json=created_at,proto3" json:"createdAt,omitempty"`
+	FinishedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=finishedAt,json=finished_at,proto3" json:"finishedAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CommandPlan) Reset() {
+	*x = CommandPlan{}
+	mi := &file_controller_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandPlan) ProtoMessage() {}
+
+func (x *CommandPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandPlan.ProtoReflect.Descriptor instead.
+func (*CommandPlan) Descriptor() ([]byte, []int) {
+	return file_controller_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CommandPlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CommandPlan) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *CommandPlan) GetResourceKey() string {
+	if x != nil {
+		return x.ResourceKey
+	}
+	return ""
+}
+
+func (x *CommandPlan) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CommandPlan) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CommandPlan) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *CommandPlan) GetAbortOnFailure() bool {
+	if x != nil {
+		return x.AbortOnFailure
+	}
+	return false
+}
+
+func (x *CommandPlan) GetSteps() []*PlanStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *CommandPlan) GetResults() []*PlanNodeResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *CommandPlan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CommandPlan) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type RunCommandPlanRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Target *PlanTarget            `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// Without steps the target is rebooted in dependency order: amplifier,
+	// tower, then the remaining nodes, each waiting for its nodes to be online.
+	Steps          []*PlanStep `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	AbortOnFailure bool        `protobuf:"varint,3,opt,name=abortOnFailure,json=abort_on_failure,proto3" json:"abortOnFailure,omitempty"`
+	RequestedBy    string      `protobuf:"bytes,4,opt,name=requestedBy,json=requested_by,proto3" json:"requestedBy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RunCommandPlanRequest) Reset() {
+	*x = RunCommandPlanRequest{}
+	mi := &file_controller_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunCommandPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunCommandPlanRequest) ProtoMessage() {}
+
+func (x *RunCommandPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunCommandPlanRequest.ProtoReflect.Descriptor instead.
+func (*RunCommandPlanRequest) Descriptor() ([]byte, []int) {
+	return file_controller_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RunCommandPlanRequest) GetTarget() *PlanTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *RunCommandPlanRequest) GetSteps() []*PlanStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *RunCommandPlanRequest) GetAbortOnFailure() bool {
+	if x != nil {
+		return x.AbortOnFailure
+	}
+	return false
+}
+
+func (x *RunCommandPlanRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type RunCommandPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *CommandPlan           `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunCommandPlanResponse) Reset() {
+	*x = RunCommandPlanResponse{}
+	mi := &file_controller_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunCommandPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunCommandPlanResponse) ProtoMessage() {}
+
+func (x *RunCommandPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunCommandPlanResponse.ProtoReflect.Descriptor instead.
+func (*RunCommandPlanResponse) Descriptor() ([]byte, []int) {
+	return file_controller_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RunCommandPlanResponse) GetPlan() *CommandPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type GetCommandPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        string                 `protobuf:"bytes,1,opt,name=planId,json=plan_id,proto3" json:"planId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommandPlanRequest) Reset() {
+	*x = GetCommandPlanRequest{}
+	mi := &file_controller_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommandPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommandPlanRequest) ProtoMessage() {}
+
+func (x *GetCommandPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommandPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetCommandPlanRequest) Descriptor() ([]byte, []int) {
+	return file_controller_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetCommandPlanRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+type GetCommandPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *CommandPlan           `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommandPlanResponse) Reset() {
+	*x = GetCommandPlanResponse{}
+	mi := &file_controller_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommandPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommandPlanResponse) ProtoMessage() {}
+
+func (x *GetCommandPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommandPlanResponse.ProtoReflect.Descriptor instead.
+func (*GetCommandPlanResponse) Descriptor() ([]byte, []int) {
+	return file_controller_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCommandPlanResponse) GetPlan() *CommandPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type CancelCommandPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        string                 `protobuf:"bytes,1,opt,name=planId,json=plan_id,proto3" json:"planId,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,2,opt,name=requestedBy,json=requested_by,proto3" json:"requestedBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCommandPlanRequest) Reset() {
+	*x = CancelCommandPlanRequest{}
+	mi := &file_controller_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCommandPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCommandPlanRequest) ProtoMessage() {}
+
+func (x *CancelCommandPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCommandPlanRequest.ProtoReflect.Descriptor instead.
+func (*CancelCommandPlanRequest) Descriptor() ([]byte, []int) {
+	return file_controller_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CancelCommandPlanRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *CancelCommandPlanRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type CancelCommandPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *CommandPlan           `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCommandPlanResponse) Reset() {
+	*x = CancelCommandPlanResponse{}
+	mi := &file_controller_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCommandPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCommandPlanResponse) ProtoMessage() {}
+
+func (x *CancelCommandPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCommandPlanResponse.ProtoReflect.Descriptor instead.
+func (*CancelCommandPlanResponse) Descriptor() ([]byte, []int) {
+	return file_controller_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CancelCommandPlanResponse) GetPlan() *CommandPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+var File_controller_proto protoreflect.FileDescriptor
+
+const file_controller_proto_rawDesc = "" +
+	"\n" +
+	"\x10controller.proto\x12\x18ukama.node.controller.v1\x1a\x0fvalidator.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcf\x01\n" +
+	"\x16SendNodeCommandRequest\x12\x1f\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\anode_id\x12\x1e\n" +
+	"\x06method\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06method\x12\x1a\n" +
+	"\x04path\x18\x03 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x04path\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\fR\x04body\x12!\n" +
+	"\vrequestedBy\x18\x05 \x01(\tR\frequested_by\x12!\n" +
+	"\vwaitSeconds\x18\x06 \x01(\rR\fwait_seconds\"w\n" +
+	"\x17SendNodeCommandResponse\x12!\n" +
+	"\voperationId\x18\x01 \x01(\tR\foperation_id\x12!\n" +
+	"\vresourceKey\x18\x02 \x01(\tR\fresource_key\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"2\n" +
+	"\x0fPingNodeRequest\x12\x1f\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\anode_id\"\x12\n" +
+	"\x10PingNodeResponse\"\xac\x01\n" +
+	"\x17ToggleSwitchPortRequest\x12\x1f\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\anode_id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\bR\x06status\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x12!\n" +
+	"\vrequestedBy\x18\x04 \x01(\tR\frequested_by\x12!\n" +
+	"\vwaitSeconds\x18\x05 \x01(\rR\fwait_seconds\"x\n" +
+	"\x18ToggleSwitchPortResponse\x12!\n" +
+	"\voperationId\x18\x01 \x01(\tR\foperation_id\x12!\n" +
+	"\vresourceKey\x18\x02 \x01(\tR\fresource_key\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"\x99\x01\n" +
+	"\x12ToggleRadioRequest\x12\x1f\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\anode_id\x12\x1c\n" +
+	"\x05state\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x05state\x12!\n" +
+	"\vrequestedBy\x18\x03 \x01(\tR\frequested_by\x12!\n" +
+	"\vwaitSeconds\x18\x04 \x01(\rR\fwait_seconds\"s\n" +
+	"\x13ToggleRadioResponse\x12!\n" +
+	"\voperationId\x18\x01 \x01(\tR\foperation_id\x12!\n" +
+	"\vresourceKey\x18\x02 \x01(\tR\fresource_key\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"{\n" +
+	"\x12RestartNodeRequest\x12\x1f\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\anode_id\x12!\n" +
+	"\vrequestedBy\x18\x02 \x01(\tR\frequested_by\x12!\n" +
+	"\vwaitSeconds\x18\x03 \x01(\rR\fwait_seconds\"s\n" +
+	"\x13RestartNodeResponse\x12!\n" +
+	"\voperationId\x18\x01 \x01(\tR\foperation_id\x12!\n" +
+	"\vresourceKey\x18\x02 \x01(\tR\fresource_key\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"\x9b\x01\n" +
+	"\x14ToggleServiceRequest\x12\x1f\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\anode_id\x12\x1c\n" +
+	"\x05state\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x05state\x12!\n" +
+	"\vrequestedBy\x18\x03 \x01(\tR\frequested_by\x12!\n" +
+	"\vwaitSeconds\x18\x04 \x01(\rR\fwait_seconds\"u\n" +
+	"\x15ToggleServiceResponse\x12!\n" +
+	"\voperationId\x18\x01 \x01(\tR\foperation_id\x12!\n" +
+	"\vresourceKey\x18\x02 \x01(\tR\fresource_key\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"1\n" +
+	"\x11PublishMsgRequest\x12\x1c\n" +
+	"\x05state\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x05state\"\xa1\x02\n" +
+	"\x11CommandAuditEntry\x12\x17\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\anode_id\x12\x18\n" +
+	"\acommand\x18\x02 \x01(\tR\acommand\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12!\n" +
+	"\vrequestedBy\x18\x05 \x01(\tR\frequested_by\x12!\n" +
+	"\voperationId\x18\x06 \x01(\tR\foperation_id\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x129\n" +
+	"\tcreatedAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\"\xe1\x01\n" +
+	"\x17ListCommandAuditRequest\x12\x17\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\anode_id\x12!\n" +
+	"\vrequestedBy\x18\x02 \x01(\tR\frequested_by\x12\x18\n" +
+	"\acommand\x18\x03 \x01(\tR\acommand\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\rR\x05limit\"a\n" +
+	"\x18ListCommandAuditResponse\x12E\n" +
+	"\aentries\x18\x01 \x03(\v2+.ukama.node.controller.v1.CommandAuditEntryR\aentries\"~\n" +
+	"\n" +
+	"PlanTarget\x12\x17\n" +
+	"\x06siteId\x18\x01 \x01(\tR\asite_id\x12\x1d\n" +
+	"\tnetworkId\x18\x02 \x01(\tR\n" +
+	"network_id\x12\x19\n" +
+	"\anodeIds\x18\x03 \x03(\tR\bnode_ids\x12\x1d\n" +
+	"\tnodeTypes\x18\x04 \x03(\tR\n" +
+	"node_types\"\xe0\x01\n" +
+	"\bPlanStep\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1d\n" +
+	"\tnodeTypes\x18\x03 \x03(\tR\n" +
+	"node_types\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12 \n" +
+	"\vparallelism\x18\x05 \x01(\rR\vparallelism\x12\x1f\n" +
+	"\n" +
+	"waitOnline\x18\x06 \x01(\bR\vwait_online\x120\n" +
+	"\x12waitTimeoutSeconds\x18\a \x01(\rR\x14wait_timeout_seconds\"\xe3\x01\n" +
+	"\x0ePlanNodeResult\x12\x12\n" +
+	"\x04step\x18\x01 \x01(\rR\x04step\x12\x17\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\anode_id\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x129\n" +
+	"\tstartedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"started_at\x12;\n" +
+	"\n" +
+	"finishedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vfinished_at\"\xd4\x03\n" +
+	"\vCommandPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\voperationId\x18\x02 \x01(\tR\foperation_id\x12!\n" +
+	"\vresourceKey\x18\x03 \x01(\tR\fresource_key\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12!\n" +
+	"\vrequestedBy\x18\x06 \x01(\tR\frequested_by\x12(\n" +
+	"\x0eabortOnFailure\x18\a \x01(\bR\x10abort_on_failure\x128\n" +
+	"\x05steps\x18\b \x03(\v2\".ukama.node.controller.v1.PlanStepR\x05steps\x12B\n" +
+	"\aresults\x18\t \x03(\v2(.ukama.node.controller.v1.PlanNodeResultR\aresults\x129\n" +
+	"\tcreatedAt\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12;\n" +
+	"\n" +
+	"finishedAt\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vfinished_at\"\xe4\x01\n" +
+	"\x15RunCommandPlanRequest\x12D\n" +
+	"\x06target\x18\x01 \x01(\v2$.ukama.node.controller.v1.PlanTargetB\x06\xe2\xdf\x1f\x02 \x01R\x06target\x128\n" +
+	"\x05steps\x18\x02 \x03(\v2\".ukama.node.controller.v1.PlanStepR\x05steps\x12(\n" +
+	"\x0eabortOnFailure\x18\x03 \x01(\bR\x10abort_on_failure\x12!\n" +
+	"\vrequestedBy\x18\x04 \x01(\tR\frequested_by\"S\n" +
+	"\x16RunCommandPlanResponse\x129\n" +
+	"\x04plan\x18\x01 \x01(\v2%.ukama.node.controller.v1.CommandPlanR\x04plan\";\n" +
+	"\x15GetCommandPlanRequest\x12\"\n" +
+	"\x06planId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\aplan_id\"S\n" +
+	"\x16GetCommandPlanResponse\x129\n" +
+	"\x04plan\x18\x01 \x01(\v2%.ukama.node.controller.v1.CommandPlanR\x04plan\"a\n" +
+	"\x18CancelCommandPlanRequest\x12\"\n" +
+	"\x06planId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\aplan_id\x12!\n" +
+	"\vrequestedBy\x18\x02 \x01(\tR\frequested_by\"V\n" +
+	"\x19CancelCommandPlanResponse\x129\n" +
+	"\x04plan\x18\x01 \x01(\v2%.ukama.node.controller.v1.CommandPlanR\x04plan2\x96\t\n" +
+	"\x11ControllerService\x12j\n" +
+	"\vRestartNode\x12,.ukama.node.controller.v1.RestartNodeRequest\x1a-.ukama.node.controller.v1.RestartNodeResponse\x12j\n" +
+	"\vToggleRadio\x12,.ukama.node.controller.v1.ToggleRadioRequest\x1a-.ukama.node.controller.v1.ToggleRadioResponse\x12y\n" +
+	"\x10ToggleSwitchPort\x121.ukama.node.controller.v1.ToggleSwitchPortRequest\x1a2.ukama.node.controller.v1.ToggleSwitchPortResponse\x12p\n" +
+	"\rToggleService\x12..ukama.node.controller.v1.ToggleServiceRequest\x1a/.ukama.node.controller.v1.ToggleServiceResponse\x12a\n" +
+	"\bPingNode\x12).ukama.node.controller.v1.PingNodeRequest\x1a*.ukama.node.controller.v1.PingNodeResponse\x12v\n" +
+	"\x0fSendNodeCommand\x120.ukama.node.controller.v1.SendNodeCommandRequest\x1a1.ukama.node.controller.v1.SendNodeCommandResponse\x12y\n" +
+	"\x10ListCommandAudit\x121.ukama.node.controller.v1.ListCommandAuditRequest\x1a2.ukama.node.controller.v1.ListCommandAuditResponse\x12s\n" +
+	"\x0eRunCommandPlan\x12/.ukama.node.controller.v1.RunCommandPlanRequest\x1a0.ukama.node.controller.v1.RunCommandPlanResponse\x12s\n" +
+	"\x0eGetCommandPlan\x12/.ukama.node.controller.v1.GetCommandPlanRequest\x1a0.ukama.node.controller.v1.GetCommandPlanResponse\x12|\n" +
+	"\x11CancelCommandPlan\x122.ukama.node.controller.v1.CancelCommandPlanRequest\x1a3.ukama.node.controller.v1.CancelCommandPlanResponseB7Z5github.com/ukama/ukama/systems/node/controller/pb/genb\x06proto3"
+
+var (
+	file_controller_proto_rawDescOnce sync.Once
+	file_controller_proto_rawDescData []byte
+)
+
+func file_controller_proto_rawDescGZIP() []byte {
+	file_controller_proto_rawDescOnce.Do(func() {
+		file_controller_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_controller_proto_rawDesc), len(file_controller_proto_rawDesc)))
+	})
+	return file_controller_proto_rawDescData
+}
+
+var file_controller_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_controller_proto_goTypes = []any{
+	(*SendNodeCommandRequest)(nil),    // 0: ukama.node.controller.v1.SendNodeCommandRequest
+	(*SendNodeCommandResponse)(nil),   // 1: ukama.node.controller.v1.SendNodeCommandResponse
+	(*PingNodeRequest)(nil),           // 2: ukama.node.controller.v1.PingNodeRequest
+	(*PingNodeResponse)(nil),          // 3: ukama.node.controller.v1.PingNodeResponse
+	(*ToggleSwitchPortRequest)(nil),   // 4: ukama.node.controller.v1.ToggleSwitchPortRequest
+	(*ToggleSwitchPortResponse)(nil),  // 5: ukama.node.controller.v1.ToggleSwitchPortResponse
+	(*ToggleRadioRequest)(nil),        // 6: ukama.node.controller.v1.ToggleRadioRequest
+	(*ToggleRadioResponse)(nil),       // 7: ukama.node.controller.v1.ToggleRadioResponse
+	(*RestartNodeRequest)(nil),        // 8: ukama.node.controller.v1.RestartNodeRequest
+	(*RestartNodeResponse)(nil),       // 9: ukama.node.controller.v1.RestartNodeResponse
+	(*ToggleServiceRequest)(nil),      // 10: ukama.node.controller.v1.ToggleServiceRequest
+	(*ToggleServiceResponse)(nil),     // 11: ukama.node.controller.v1.ToggleServiceResponse
+	(*PublishMsgRequest)(nil),         // 12: ukama.node.controller.v1.PublishMsgRequest
+	(*CommandAuditEntry)(nil),         // 13: ukama.node.controller.v1.CommandAuditEntry
+	(*ListCommandAuditRequest)(nil),   // 14: ukama.node.controller.v1.ListCommandAuditRequest
+	(*ListCommandAuditResponse)(nil),  // 15: ukama.node.controller.v1.ListCommandAuditResponse
+	(*PlanTarget)(nil),                // 16: ukama.node.controller.v1.PlanTarget
+	(*PlanStep)(nil),                  // 17: ukama.node.controller.v1.PlanStep
+	(*PlanNodeResult)(nil),            // 18: ukama.node.controller.v1.PlanNodeResult
+	(*CommandPlan)(nil),               // 19: ukama.node.controller.v1.CommandPlan
+	(*RunCommandPlanRequest)(nil),     // 20: ukama.node.controller.v1.RunCommandPlanRequest
+	(*RunCommandPlanResponse)(nil),    // 21: ukama.node.controller.v1.RunCommandPlanResponse
+	(*GetCommandPlanRequest)(nil),     // 22: ukama.node.controller.v1.GetCommandPlanRequest
+	(*GetCommandPlanResponse)(nil),    // 23: ukama.node.controller.v1.GetCommandPlanResponse
+	(*CancelCommandPlanRequest)(nil),  // 24: ukama.node.controller.v1.CancelCommandPlanRequest
+	(*CancelCommandPlanResponse)(nil), // 25: ukama.node.controller.v1.CancelCommandPlanResponse
+	(*timestamppb.Timestamp)(nil),     // 26: google.protobuf.Timestamp
+}
+var file_controller_proto_depIdxs = []int32{
+	26, // 0: ukama.node.controller.v1.CommandAuditEntry.createdAt:type_name -> google.protobuf.Timestamp
+	26, // 1: ukama.node.controller.v1.ListCommandAuditRequest.from:type_name -> google.protobuf.Timestamp
+	26, // 2: ukama.node.controller.v1.ListCommandAuditRequest.to:type_name -> google.protobuf.Timestamp
+	13, // 3: ukama.node.controller.v1.ListCommandAuditResponse.entries:type_name -> ukama.node.controller.v1.CommandAuditEntry
+	26, // 4: ukama.node.controller.v1.PlanNodeResult.startedAt:type_name -> google.protobuf.Timestamp
+	26, // 5: ukama.node.controller.v1.PlanNodeResult.finishedAt:type_name -> google.protobuf.Timestamp
+	17, // 6: ukama.node.controller.v1.CommandPlan.steps:type_name -> ukama.node.controller.v1.PlanStep
+	18, // 7: ukama.node.controller.v1.CommandPlan.results:type_name -> ukama.node.controller.v1.PlanNodeResult
+	26, // 8: ukama.node.controller.v1.CommandPlan.createdAt:type_name -> google.protobuf.Timestamp
+	26, // 9: ukama.node.controller.v1.CommandPlan.finishedAt:type_name -> google.protobuf.Timestamp
+	16, // 10: ukama.node.controller.v1.RunCommandPlanRequest.target:type_name -> ukama.node.controller.v1.PlanTarget
+	17, // 11: ukama.node.controller.v1.RunCommandPlanRequest.steps:type_name -> ukama.node.controller.v1.PlanStep
+	19, // 12: ukama.node.controller.v1.RunCommandPlanResponse.plan:type_name -> ukama.node.controller.v1.CommandPlan
+	19, // 13: ukama.node.controller.v1.GetCommandPlanResponse.plan:type_name -> ukama.node.controller.v1.CommandPlan
+	19, // 14: ukama.node.controller.v1.CancelCommandPlanResponse.plan:type_name -> ukama.node.controller.v1.CommandPlan
+	8,  // 15: ukama.node.controller.v1.ControllerService.RestartNode:input_type -> ukama.node.controller.v1.RestartNodeRequest
+	6,  // 16: ukama.node.controller.v1.ControllerService.ToggleRadio:input_type -> ukama.node.controller.v1.ToggleRadioRequest
+	4,  // 17: ukama.node.controller.v1.ControllerService.ToggleSwitchPort:input_type -> ukama.node.controller.v1.ToggleSwitchPortRequest
+	10, // 18: ukama.node.controller.v1.ControllerService.ToggleService:input_type -> ukama.node.controller.v1.ToggleServiceRequest
+	2,  // 19: ukama.node.controller.v1.ControllerService.PingNode:input_type -> ukama.node.controller.v1.PingNodeRequest
+	0,  // 20: ukama.node.controller.v1.ControllerService.SendNodeCommand:input_type -> ukama.node.controller.v1.SendNodeCommandRequest
+	14, // 21: ukama.node.controller.v1.ControllerService.ListCommandAudit:input_type -> ukama.node.controller.v1.ListCommandAuditRequest
+	20, // 22: ukama.node.controller.v1.ControllerService.RunCommandPlan:input_type -> ukama.node.controller.v1.RunCommandPlanRequest
+	22, // 23: ukama.node.controller.v1.ControllerService.GetCommandPlan:input_type -> ukama.node.controller.v1.GetCommandPlanRequest
+	24, // 24: ukama.node.controller.v1.ControllerService.CancelCommandPlan:input_type -> ukama.node.controller.v1.CancelCommandPlanRequest
+	9,  // 25: ukama.node.controller.v1.ControllerService.RestartNode:output_type -> ukama.node.controller.v1.RestartNodeResponse
+	7,  // 26: ukama.node.controller.v1.ControllerService.ToggleRadio:output_type -> ukama.node.controller.v1.ToggleRadioResponse
+	5,  // 27: ukama.node.controller.v1.ControllerService.ToggleSwitchPort:output_type -> ukama.node.controller.v1.ToggleSwitchPortResponse
+	11, // 28: ukama.node.controller.v1.ControllerService.ToggleService:output_type -> ukama.node.controller.v1.ToggleServiceResponse
+	3,  // 29: ukama.node.controller.v1.ControllerService.PingNode:output_type -> ukama.node.controller.v1.PingNodeResponse
+	1,  // 30: ukama.node.controller.v1.ControllerService.SendNodeCommand:output_type -> ukama.node.controller.v1.SendNodeCommandResponse
+	15, // 31: ukama.node.controller.v1.ControllerService.ListCommandAudit:output_type -> ukama.node.controller.v1.ListCommandAuditResponse
+	21, // 32: ukama.node.controller.v1.ControllerService.RunCommandPlan:output_type -> ukama.node.controller.v1.RunCommandPlanResponse
+	23, // 33: ukama.node.controller.v1.ControllerService.GetCommandPlan:output_type -> ukama.node.controller.v1.GetCommandPlanResponse
+	25, // 34: ukama.node.controller.v1.ControllerService.CancelCommandPlan:output_type -> ukama.node.controller.v1.CancelCommandPlanResponse
+	25, // [25:35] is the sub-list for method output_type
+	15, // [15:25] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_controller_proto_init() }
+func file_controller_proto_init() {
+	if File_controller_proto != nil {
+		return
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_controller_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_controller_proto_rawDesc), len(file_controller_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1116,7 +1961,6 @@ func file_controller_proto_init() {
 		MessageInfos:      file_controller_proto_msgTypes,
 	}.Build()
 	File_controller_proto = out.File
-	file_controller_proto_rawDesc = nil
 	file_controller_proto_goTypes = nil
 	file_controller_proto_depIdxs = nil
 }
@@ -7,7 +7,9 @@ import (
 	fmt "fmt"
 	math "math"
 	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	_ "github.com/mwitkow/go-proto-validators"
+	regexp "regexp"
 	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
 )
 
@@ -88,3 +90,146 @@ func (this *PublishMsgRequest) Validate() error {
 	}
 	return nil
 }
+func (this *CommandAuditEntry) Validate() error {
+	if this.CreatedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+		}
+	}
+	return nil
+}
+func (this *ListCommandAuditRequest) Validate() error {
+	if this.From != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.From); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("From", err)
+		}
+	}
+	if this.To != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.To); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("To", err)
+		}
+	}
+	return nil
+}
+func (this *ListCommandAuditResponse) Validate() error {
+	for _, item := range this.Entries {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Entries", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *PlanTarget) Validate() error {
+	return nil
+}
+func (this *PlanStep) Validate() error {
+	return nil
+}
+func (this *PlanNodeResult) Validate() error {
+	if this.StartedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.StartedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("StartedAt", err)
+		}
+	}
+	if this.FinishedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.FinishedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("FinishedAt", err)
+		}
+	}
+	return nil
+}
+func (this *CommandPlan) Validate() error {
+	for _, item := range this.Steps {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Steps", err)
+			}
+		}
+	}
+	for _, item := range this.Results {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Results", err)
+			}
+		}
+	}
+	if this.CreatedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+		}
+	}
+	if this.FinishedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.FinishedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("FinishedAt", err)
+		}
+	}
+	return nil
+}
+func (this *RunCommandPlanRequest) Validate() error {
+	if nil == this.Target {
+		return github_com_mwitkow_go_proto_validators.FieldError("Target", fmt.Errorf("message must exist"))
+	}
+	if this.Target != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Target); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Target", err)
+		}
+	}
+	for _, item := range this.Steps {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Steps", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *RunCommandPlanResponse) Validate() error {
+	if this.Plan != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Plan); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Plan", err)
+		}
+	}
+	return nil
+}
+
+var _regex_GetCommandPlanRequest_PlanId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *GetCommandPlanRequest) Validate() error {
+	if !_regex_GetCommandPlanRequest_PlanId.MatchString(this.PlanId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PlanId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.PlanId))
+	}
+	if this.PlanId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("PlanId", fmt.Errorf(`value '%v' must not be an empty string`, this.PlanId))
+	}
+	return nil
+}
+func (this *GetCommandPlanResponse) Validate() error {
+	if this.Plan != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Plan); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Plan", err)
+		}
+	}
+	return nil
+}
+
+var _regex_CancelCommandPlanRequest_PlanId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *CancelCommandPlanRequest) Validate() error {
+	if !_regex_CancelCommandPlanRequest_PlanId.MatchString(this.PlanId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PlanId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.PlanId))
+	}
+	if this.PlanId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("PlanId", fmt.Errorf(`value '%v' must not be an empty string`, this.PlanId))
+	}
+	return nil
+}
+func (this *CancelCommandPlanResponse) Validate() error {
+	if this.Plan != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Plan); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Plan", err)
+		}
+	}
+	return nil
+}
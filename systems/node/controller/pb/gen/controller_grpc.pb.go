@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.2.0
+// - protoc-gen-go-grpc v1.5.1
 // - protoc             v6.32.1
 // source: controller.proto
 
@@ -15,12 +15,27 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.32.0 or later.
-const _ = grpc.SupportPackageIsVersion7
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ControllerService_RestartNode_FullMethodName       = "/ukama.node.controller.v1.ControllerService/RestartNode"
+	ControllerService_ToggleRadio_FullMethodName       = "/ukama.node.controller.v1.ControllerService/ToggleRadio"
+	ControllerService_ToggleSwitchPort_FullMethodName  = "/ukama.node.controller.v1.ControllerService/ToggleSwitchPort"
+	ControllerService_ToggleService_FullMethodName     = "/ukama.node.controller.v1.ControllerService/ToggleService"
+	ControllerService_PingNode_FullMethodName          = "/ukama.node.controller.v1.ControllerService/PingNode"
+	ControllerService_SendNodeCommand_FullMethodName   = "/ukama.node.controller.v1.ControllerService/SendNodeCommand"
+	ControllerService_ListCommandAudit_FullMethodName  = "/ukama.node.controller.v1.ControllerService/ListCommandAudit"
+	ControllerService_RunCommandPlan_FullMethodName    = "/ukama.node.controller.v1.ControllerService/RunCommandPlan"
+	ControllerService_GetCommandPlan_FullMethodName    = "/ukama.node.controller.v1.ControllerService/GetCommandPlan"
+	ControllerService_CancelCommandPlan_FullMethodName = "/ukama.node.controller.v1.ControllerService/CancelCommandPlan"
+)
 
 // ControllerServiceClient is the client API for ControllerService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Defines the service for controller operations
 type ControllerServiceClient interface {
 	RestartNode(ctx context.Context, in *RestartNodeRequest, opts ...grpc.CallOption) (*RestartNodeResponse, error)
 	ToggleRadio(ctx context.Context, in *ToggleRadioRequest, opts ...grpc.CallOption) (*ToggleRadioResponse, error)
@@ -28,6 +43,10 @@ type ControllerServiceClient interface {
 	ToggleService(ctx context.Context, in *ToggleServiceRequest, opts ...grpc.CallOption) (*ToggleServiceResponse, error)
 	PingNode(ctx context.Context, in *PingNodeRequest, opts ...grpc.CallOption) (*PingNodeResponse, error)
 	SendNodeCommand(ctx context.Context, in *SendNodeCommandRequest, opts ...grpc.CallOption) (*SendNodeCommandResponse, error)
+	ListCommandAudit(ctx context.Context, in *ListCommandAuditRequest, opts ...grpc.CallOption) (*ListCommandAuditResponse, error)
+	RunCommandPlan(ctx context.Context, in *RunCommandPlanRequest, opts ...grpc.CallOption) (*RunCommandPlanResponse, error)
+	GetCommandPlan(ctx context.Context, in *GetCommandPlanRequest, opts ...grpc.CallOption) (*GetCommandPlanResponse, error)
+	CancelCommandPlan(ctx context.Context, in *CancelCommandPlanRequest, opts ...grpc.CallOption) (*CancelCommandPlanResponse, error)
 }
 
 type controllerServiceClient struct {
@@ -39,8 +58,9 @@ func NewControllerServiceClient(cc grpc.ClientConnInterface) ControllerServiceCl
 }
 
 func (c *controllerServiceClient) RestartNode(ctx context.Context, in *RestartNodeRequest, opts ...grpc.CallOption) (*RestartNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RestartNodeResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.controller.v1.ControllerService/RestartNode", in, out, opts...)
+	err := c.cc.Invoke(ctx, ControllerService_RestartNode_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -48,8 +68,9 @@ func (c *controllerServiceClient) RestartNode(ctx context.Context, in *RestartNo
 }
 
 func (c *controllerServiceClient) ToggleRadio(ctx context.Context, in *ToggleRadioRequest, opts ...grpc.CallOption) (*ToggleRadioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ToggleRadioResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.controller.v1.ControllerService/ToggleRadio", in, out, opts...)
+	err := c.cc.Invoke(ctx, ControllerService_ToggleRadio_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -57,8 +78,9 @@ func (c *controllerServiceClient) ToggleRadio(ctx context.Context, in *ToggleRad
 }
 
 func (c *controllerServiceClient) ToggleSwitchPort(ctx context.Context, in *ToggleSwitchPortRequest, opts ...grpc.CallOption) (*ToggleSwitchPortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ToggleSwitchPortResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.controller.v1.ControllerService/ToggleSwitchPort", in, out, opts...)
+	err := c.cc.Invoke(ctx, ControllerService_ToggleSwitchPort_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -66,8 +88,9 @@ func (c *controllerServiceClient) ToggleSwitchPort(ctx context.Context, in *Togg
 }
 
 func (c *controllerServiceClient) ToggleService(ctx context.Context, in *ToggleServiceRequest, opts ...grpc.CallOption) (*ToggleServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ToggleServiceResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.controller.v1.ControllerService/ToggleService", in, out, opts...)
+	err := c.cc.Invoke(ctx, ControllerService_ToggleService_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -75,8 +98,9 @@ func (c *controllerServiceClient) ToggleService(ctx context.Context, in *ToggleS
 }
 
 func (c *controllerServiceClient) PingNode(ctx context.Context, in *PingNodeRequest, opts ...grpc.CallOption) (*PingNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PingNodeResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.controller.v1.ControllerService/PingNode", in, out, opts...)
+	err := c.cc.Invoke(ctx, ControllerService_PingNode_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -84,8 +108,49 @@ func (c *controllerServiceClient) PingNode(ctx context.Context, in *PingNodeRequ
 }
 
 func (c *controllerServiceClient) SendNodeCommand(ctx context.Context, in *SendNodeCommandRequest, opts ...grpc.CallOption) (*SendNodeCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SendNodeCommandResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.controller.v1.ControllerService/SendNodeCommand", in, out, opts...)
+	err := c.cc.Invoke(ctx, ControllerService_SendNodeCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) ListCommandAudit(ctx context.Context, in *ListCommandAuditRequest, opts ...grpc.CallOption) (*ListCommandAuditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommandAuditResponse)
+	err := c.cc.Invoke(ctx, ControllerService_ListCommandAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) RunCommandPlan(ctx context.Context, in *RunCommandPlanRequest, opts ...grpc.CallOption) (*RunCommandPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunCommandPlanResponse)
+	err := c.cc.Invoke(ctx, ControllerService_RunCommandPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) GetCommandPlan(ctx context.Context, in *GetCommandPlanRequest, opts ...grpc.CallOption) (*GetCommandPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommandPlanResponse)
+	err := c.cc.Invoke(ctx, ControllerService_GetCommandPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerServiceClient) CancelCommandPlan(ctx context.Context, in *CancelCommandPlanRequest, opts ...grpc.CallOption) (*CancelCommandPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelCommandPlanResponse)
+	err := c.cc.Invoke(ctx, ControllerService_CancelCommandPlan_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +159,9 @@ func (c *controllerServiceClient) SendNodeCommand(ctx context.Context, in *SendN
 
 // ControllerServiceServer is the server API for ControllerService service.
 // All implementations must embed UnimplementedControllerServiceServer
-// for forward compatibility
+// for forward compatibility.
+//
+// Defines the service for controller operations
 type ControllerServiceServer interface {
 	RestartNode(context.Context, *RestartNodeRequest) (*RestartNodeResponse, error)
 	ToggleRadio(context.Context, *ToggleRadioRequest) (*ToggleRadioResponse, error)
@@ -102,12 +169,19 @@ type ControllerServiceServer interface {
 	ToggleService(context.Context, *ToggleServiceRequest) (*ToggleServiceResponse, error)
 	PingNode(context.Context, *PingNodeRequest) (*PingNodeResponse, error)
 	SendNodeCommand(context.Context, *SendNodeCommandRequest) (*SendNodeCommandResponse, error)
+	ListCommandAudit(context.Context, *ListCommandAuditRequest) (*ListCommandAuditResponse, error)
+	RunCommandPlan(context.Context, *RunCommandPlanRequest) (*RunCommandPlanResponse, error)
+	GetCommandPlan(context.Context, *GetCommandPlanRequest) (*GetCommandPlanResponse, error)
+	CancelCommandPlan(context.Context, *CancelCommandPlanRequest) (*CancelCommandPlanResponse, error)
 	mustEmbedUnimplementedControllerServiceServer()
 }
 
-// UnimplementedControllerServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedControllerServiceServer struct {
-}
+// UnimplementedControllerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedControllerServiceServer struct{}
 
 func (UnimplementedControllerServiceServer) RestartNode(context.Context, *RestartNodeRequest) (*RestartNodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestartNode not implemented")
@@ -127,7 +201,20 @@ func (UnimplementedControllerServiceServer) PingNode(context.Context, *PingNodeR
 func (UnimplementedControllerServiceServer) SendNodeCommand(context.Context, *SendNodeCommandRequest) (*SendNodeCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendNodeCommand not implemented")
 }
+func (UnimplementedControllerServiceServer) ListCommandAudit(context.Context, *ListCommandAuditRequest) (*ListCommandAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommandAudit not implemented")
+}
+func (UnimplementedControllerServiceServer) RunCommandPlan(context.Context, *RunCommandPlanRequest) (*RunCommandPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunCommandPlan not implemented")
+}
+func (UnimplementedControllerServiceServer) GetCommandPlan(context.Context, *GetCommandPlanRequest) (*GetCommandPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommandPlan not implemented")
+}
+func (UnimplementedControllerServiceServer) CancelCommandPlan(context.Context, *CancelCommandPlanRequest) (*CancelCommandPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCommandPlan not implemented")
+}
 func (UnimplementedControllerServiceServer) mustEmbedUnimplementedControllerServiceServer() {}
+func (UnimplementedControllerServiceServer) testEmbeddedByValue()                           {}
 
 // UnsafeControllerServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ControllerServiceServer will
@@ -137,6 +224,13 @@ type UnsafeControllerServiceServer interface {
 }
 
 func RegisterControllerServiceServer(s grpc.ServiceRegistrar, srv ControllerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedControllerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
 	s.RegisterService(&ControllerService_ServiceDesc, srv)
 }
 
@@ -150,7 +244,7 @@ func _ControllerService_RestartNode_Handler(srv interface{}, ctx context.Context
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.controller.v1.ControllerService/RestartNode",
+		FullMethod: ControllerService_RestartNode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ControllerServiceServer).RestartNode(ctx, req.(*RestartNodeRequest))
@@ -168,7 +262,7 @@ func _ControllerService_ToggleRadio_Handler(srv interface{}, ctx context.Context
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.controller.v1.ControllerService/ToggleRadio",
+		FullMethod: ControllerService_ToggleRadio_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ControllerServiceServer).ToggleRadio(ctx, req.(*ToggleRadioRequest))
@@ -186,7 +280,7 @@ func _ControllerService_ToggleSwitchPort_Handler(srv interface{}, ctx context.Co
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.controller.v1.ControllerService/ToggleSwitchPort",
+		FullMethod: ControllerService_ToggleSwitchPort_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ControllerServiceServer).ToggleSwitchPort(ctx, req.(*ToggleSwitchPortRequest))
@@ -204,7 +298,7 @@ func _ControllerService_ToggleService_Handler(srv interface{}, ctx context.Conte
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.controller.v1.ControllerService/ToggleService",
+		FullMethod: ControllerService_ToggleService_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ControllerServiceServer).ToggleService(ctx, req.(*ToggleServiceRequest))
@@ -222,7 +316,7 @@ func _ControllerService_PingNode_Handler(srv interface{}, ctx context.Context, d
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.controller.v1.ControllerService/PingNode",
+		FullMethod: ControllerService_PingNode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ControllerServiceServer).PingNode(ctx, req.(*PingNodeRequest))
@@ -240,7 +334,7 @@ func _ControllerService_SendNodeCommand_Handler(srv interface{}, ctx context.Con
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.controller.v1.ControllerService/SendNodeCommand",
+		FullMethod: ControllerService_SendNodeCommand_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ControllerServiceServer).SendNodeCommand(ctx, req.(*SendNodeCommandRequest))
@@ -248,6 +342,78 @@ func _ControllerService_SendNodeCommand_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControllerService_ListCommandAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommandAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).ListCommandAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_ListCommandAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).ListCommandAudit(ctx, req.(*ListCommandAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_RunCommandPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunCommandPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).RunCommandPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_RunCommandPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).RunCommandPlan(ctx, req.(*RunCommandPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_GetCommandPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommandPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).GetCommandPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_GetCommandPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).GetCommandPlan(ctx, req.(*GetCommandPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerService_CancelCommandPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCommandPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServiceServer).CancelCommandPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerService_CancelCommandPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServiceServer).CancelCommandPlan(ctx, req.(*CancelCommandPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ControllerService_ServiceDesc is the grpc.ServiceDesc for ControllerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -279,6 +445,22 @@ var ControllerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendNodeCommand",
 			Handler:    _ControllerService_SendNodeCommand_Handler,
 		},
+		{
+			MethodName: "ListCommandAudit",
+			Handler:    _ControllerService_ListCommandAudit_Handler,
+		},
+		{
+			MethodName: "RunCommandPlan",
+			Handler:    _ControllerService_RunCommandPlan_Handler,
+		},
+		{
+			MethodName: "GetCommandPlan",
+			Handler:    _ControllerService_GetCommandPlan_Handler,
+		},
+		{
+			MethodName: "CancelCommandPlan",
+			Handler:    _ControllerService_CancelCommandPlan_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller.proto",
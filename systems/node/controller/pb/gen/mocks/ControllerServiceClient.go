@@ -16,6 +16,117 @@ type ControllerServiceClient struct {
 	mock.Mock
 }
 
+// CancelCommandPlan provides a mock function with given fields: ctx, in, opts
+func (_m *ControllerServiceClient) CancelCommandPlan(ctx context.Context, in *gen.CancelCommandPlanRequest, opts ...grpc.CallOption) (*gen.CancelCommandPlanResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelCommandPlan")
+	}
+
+	var r0 *gen.CancelCommandPlanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CancelCommandPlanRequest, ...grpc.CallOption) (*gen.CancelCommandPlanResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CancelCommandPlanRequest, ...grpc.CallOption) *gen.CancelCommandPlanResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CancelCommandPlanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.CancelCommandPlanRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCommandPlan provides a mock function with given fields: ctx, in, opts
+func (_m *ControllerServiceClient) GetCommandPlan(ctx context.Context, in *gen.GetCommandPlanRequest, opts ...grpc.CallOption) (*gen.GetCommandPlanResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommandPlan")
+	}
+
+	var r0 *gen.GetCommandPlanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetCommandPlanRequest, ...grpc.CallOption) (*gen.GetCommandPlanResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetCommandPlanRequest, ...grpc.CallOption) *gen.GetCommandPlanResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetCommandPlanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetCommandPlanRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCommandAudit provides a mock function with given fields: ctx, in, opts
+func (_m *ControllerServiceClient) ListCommandAudit(ctx context.Context, in *gen.ListCommandAuditRequest, opts ...grpc.CallOption) (*gen.ListCommandAuditResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCommandAudit")
+	}
+
+	var r0 *gen.ListCommandAuditResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListCommandAuditRequest, ...grpc.CallOption) (*gen.ListCommandAuditResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListCommandAuditRequest, ...grpc.CallOption) *gen.ListCommandAuditResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListCommandAuditResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListCommandAuditRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PingNode provides a mock function with given fields: ctx, in, opts
 func (_m *ControllerServiceClient) PingNode(ctx context.Context, in *gen.PingNodeRequest, opts ...grpc.CallOption) (*gen.PingNodeResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -90,6 +201,43 @@ func (_m *ControllerServiceClient) RestartNode(ctx context.Context, in *gen.Rest
 	return r0, r1
 }
 
+// RunCommandPlan provides a mock function with given fields: ctx, in, opts
+func (_m *ControllerServiceClient) RunCommandPlan(ctx context.Context, in *gen.RunCommandPlanRequest, opts ...grpc.CallOption) (*gen.RunCommandPlanResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunCommandPlan")
+	}
+
+	var r0 *gen.RunCommandPlanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RunCommandPlanRequest, ...grpc.CallOption) (*gen.RunCommandPlanResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RunCommandPlanRequest, ...grpc.CallOption) *gen.RunCommandPlanResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RunCommandPlanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RunCommandPlanRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SendNodeCommand provides a mock function with given fields: ctx, in, opts
 func (_m *ControllerServiceClient) SendNodeCommand(ctx context.Context, in *gen.SendNodeCommandRequest, opts ...grpc.CallOption) (*gen.SendNodeCommandResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -14,6 +14,96 @@ type ControllerServiceServer struct {
 	mock.Mock
 }
 
+// CancelCommandPlan provides a mock function with given fields: _a0, _a1
+func (_m *ControllerServiceServer) CancelCommandPlan(_a0 context.Context, _a1 *gen.CancelCommandPlanRequest) (*gen.CancelCommandPlanResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelCommandPlan")
+	}
+
+	var r0 *gen.CancelCommandPlanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CancelCommandPlanRequest) (*gen.CancelCommandPlanResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CancelCommandPlanRequest) *gen.CancelCommandPlanResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CancelCommandPlanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.CancelCommandPlanRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCommandPlan provides a mock function with given fields: _a0, _a1
+func (_m *ControllerServiceServer) GetCommandPlan(_a0 context.Context, _a1 *gen.GetCommandPlanRequest) (*gen.GetCommandPlanResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommandPlan")
+	}
+
+	var r0 *gen.GetCommandPlanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetCommandPlanRequest) (*gen.GetCommandPlanResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetCommandPlanRequest) *gen.GetCommandPlanResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetCommandPlanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetCommandPlanRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCommandAudit provides a mock function with given fields: _a0, _a1
+func (_m *ControllerServiceServer) ListCommandAudit(_a0 context.Context, _a1 *gen.ListCommandAuditRequest) (*gen.ListCommandAuditResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCommandAudit")
+	}
+
+	var r0 *gen.ListCommandAuditResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListCommandAuditRequest) (*gen.ListCommandAuditResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListCommandAuditRequest) *gen.ListCommandAuditResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListCommandAuditResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListCommandAuditRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PingNode provides a mock function with given fields: _a0, _a1
 func (_m *ControllerServiceServer) PingNode(_a0 context.Context, _a1 *gen.PingNodeRequest) (*gen.PingNodeResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -74,6 +164,36 @@ func (_m *ControllerServiceServer) RestartNode(_a0 context.Context, _a1 *gen.Res
 	return r0, r1
 }
 
+// RunCommandPlan provides a mock function with given fields: _a0, _a1
+func (_m *ControllerServiceServer) RunCommandPlan(_a0 context.Context, _a1 *gen.RunCommandPlanRequest) (*gen.RunCommandPlanResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunCommandPlan")
+	}
+
+	var r0 *gen.RunCommandPlanResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RunCommandPlanRequest) (*gen.RunCommandPlanResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RunCommandPlanRequest) *gen.RunCommandPlanResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RunCommandPlanResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RunCommandPlanRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SendNodeCommand provides a mock function with given fields: _a0, _a1
 func (_m *ControllerServiceServer) SendNodeCommand(_a0 context.Context, _a1 *gen.SendNodeCommandRequest) (*gen.SendNodeCommandResponse, error) {
 	ret := _m.Called(_a0, _a1)
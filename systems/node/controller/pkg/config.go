@@ -25,6 +25,7 @@ type Config struct {
 	Service          *uconf.Service
 	Http             HttpServices
 	Operation        OperationServices
+	CommandPlan      CommandPlanConfig
 }
 
 type HttpServices struct {
@@ -37,6 +38,17 @@ type OperationServices struct {
 	Timeout      time.Duration `default:"5s"`
 	LeaseSecs    uint32        `default:"120"`
 	DeadlineSecs uint32        `default:"120"`
+	WaitSecs     uint32        `default:"30"` /* How long an action queues behind a busy node, 0 fails right away */
+}
+
+// CommandPlanConfig bounds multi-node command plans. A plan holds its lease
+// for LeaseSecs; waiting for a node to come back online only starts polling
+// the registry after SettleDelay, so a node still reported online right after
+// a reboot request is not taken as already back.
+type CommandPlanConfig struct {
+	LeaseSecs    uint32        `default:"3600"`
+	PollInterval time.Duration `default:"10s"`
+	SettleDelay  time.Duration `default:"30s"`
 }
 
 func NewConfig(name string) *Config {
@@ -56,6 +68,12 @@ func NewConfig(name string) *Config {
 			Timeout:      5 * time.Second,
 			LeaseSecs:    120,
 			DeadlineSecs: 120,
+			WaitSecs:     30,
+		},
+		CommandPlan: CommandPlanConfig{
+			LeaseSecs:    3600,
+			PollInterval: 10 * time.Second,
+			SettleDelay:  30 * time.Second,
 		},
 	}
 }
@@ -0,0 +1,78 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"time"
+
+	"github.com/ukama/ukama/systems/common/sql"
+)
+
+const defaultAuditLimit = 100
+
+type CommandAuditFilter struct {
+	NodeId      string
+	RequestedBy string
+	Command     string
+	From        time.Time
+	To          time.Time
+	Limit       int
+}
+
+// CommandAuditRepo only appends, entries are never updated or deleted.
+type CommandAuditRepo interface {
+	Add(entry *CommandAudit) error
+	List(filter CommandAuditFilter) ([]CommandAudit, error)
+}
+
+type commandAuditRepo struct {
+	Db sql.Db
+}
+
+func NewCommandAuditRepo(db sql.Db) CommandAuditRepo {
+	return &commandAuditRepo{
+		Db: db,
+	}
+}
+
+func (r *commandAuditRepo) Add(entry *CommandAudit) error {
+	return r.Db.GetGormDb().Create(entry).Error
+}
+
+/* List returns the newest entries first */
+func (r *commandAuditRepo) List(filter CommandAuditFilter) ([]CommandAudit, error) {
+	var entries []CommandAudit
+
+	tx := r.Db.GetGormDb().Model(&CommandAudit{})
+	if filter.NodeId != "" {
+		tx = tx.Where("node_id = ?", filter.NodeId)
+	}
+	if filter.RequestedBy != "" {
+		tx = tx.Where("requested_by = ?", filter.RequestedBy)
+	}
+	if filter.Command != "" {
+		tx = tx.Where("command = ?", filter.Command)
+	}
+	if !filter.From.IsZero() {
+		tx = tx.Where("created_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		tx = tx.Where("created_at < ?", filter.To)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultAuditLimit
+	}
+
+	if err := tx.Order("created_at desc, id desc").Limit(limit).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,63 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/ukama/ukama/systems/common/ukama"
+	int_db "github.com/ukama/ukama/systems/node/controller/pkg/db"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestCommandAuditRepo_List(t *testing.T) {
+	t.Run("Filters by node and time", func(t *testing.T) {
+		// Arrange
+		nid := ukama.NewVirtualNodeId(ukama.NODE_ID_TYPE_HOMENODE)
+		from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+		db, mock, err := sqlmock.New() // mock sql.DB
+		assert.NoError(t, err)
+
+		rows := sqlmock.NewRows([]string{"id", "node_id", "command", "requested_by", "status"}).
+			AddRow(2, nid.String(), "RestartNode", "site-controller", "RUNNING")
+
+		mock.ExpectQuery(`^SELECT.*node_command_audit.*node_id = .*created_at >= .*ORDER BY created_at desc, id desc LIMIT .*`).
+			WithArgs(nid.String(), from, 100).
+			WillReturnRows(rows)
+
+		dialector := postgres.New(postgres.Config{
+			DSN:                  "sqlmock_db_0",
+			DriverName:           "postgres",
+			Conn:                 db,
+			PreferSimpleProtocol: true,
+		})
+		gdb, err := gorm.Open(dialector, &gorm.Config{})
+		assert.NoError(t, err)
+
+		r := int_db.NewCommandAuditRepo(&UkamaDbMock{
+			GormDb: gdb,
+		})
+
+		// Act
+		entries, err := r.List(int_db.CommandAuditFilter{NodeId: nid.String(), From: from})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+		if assert.Len(t, entries, 1) {
+			assert.Equal(t, "RestartNode", entries[0].Command)
+			assert.Equal(t, "site-controller", entries[0].RequestedBy)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"github.com/ukama/ukama/systems/common/sql"
+	"github.com/ukama/ukama/systems/common/uuid"
+)
+
+type CommandPlanRepo interface {
+	Add(p *CommandPlan) error
+	Get(id uuid.UUID) (*CommandPlan, error)
+	Update(p *CommandPlan) error
+}
+
+type commandPlanRepo struct {
+	Db sql.Db
+}
+
+func NewCommandPlanRepo(db sql.Db) CommandPlanRepo {
+	return &commandPlanRepo{
+		Db: db,
+	}
+}
+
+func (r *commandPlanRepo) Add(p *CommandPlan) error {
+	return r.Db.GetGormDb().Create(p).Error
+}
+
+func (r *commandPlanRepo) Get(id uuid.UUID) (*CommandPlan, error) {
+	var p CommandPlan
+	if err := r.Db.GetGormDb().Where("id = ?", id).First(&p).Error; err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+/* Update saves the progress fields, the plan definition is never changed */
+func (r *commandPlanRepo) Update(p *CommandPlan) error {
+	return r.Db.GetGormDb().Model(&CommandPlan{Id: p.Id}).
+		Select("operation_id", "status", "error", "results", "finished_at", "updated_at").
+		Updates(p).Error
+}
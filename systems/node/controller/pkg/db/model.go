@@ -8,9 +8,50 @@
 
 package db
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/node/controller/pkg/plan"
+	"gorm.io/gorm"
+)
 
 type NodeLog struct {
 	gorm.Model
 	NodeId string `gorm:"type:string;uniqueIndex:idx_node_id_case_insensitive,where:deleted_at is null;size:23;not null"`
 }
+
+// CommandAudit is an append-only record of a command sent to a node.
+type CommandAudit struct {
+	ID          uint   `gorm:"primaryKey"`
+	NodeId      string `gorm:"type:string;size:23;not null;index"`
+	Command     string `gorm:"not null;index"`
+	Method      string
+	Path        string
+	RequestedBy string `gorm:"index"`
+	OperationId string
+	Status      string
+	Error       string
+	CreatedAt   time.Time `gorm:"not null;index"`
+}
+
+func (CommandAudit) TableName() string { return "node_command_audit" }
+
+// CommandPlan is a multi-node command run as a single operation. Steps and
+// per-node results are kept as JSON, results are rewritten as the plan runs.
+type CommandPlan struct {
+	Id             uuid.UUID `gorm:"primaryKey;type:uuid"`
+	OperationId    string    `gorm:"index"`
+	ResourceKey    string    `gorm:"not null;index"`
+	RequestedBy    string    `gorm:"index"`
+	AbortOnFailure bool      `gorm:"not null;default:false"`
+	Status         string    `gorm:"not null;index"`
+	Error          string
+	Steps          []plan.Step       `gorm:"type:text;serializer:json"`
+	Results        []plan.NodeResult `gorm:"type:text;serializer:json"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	FinishedAt     *time.Time
+}
+
+func (CommandPlan) TableName() string { return "node_command_plans" }
@@ -0,0 +1,243 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ukama/ukama/systems/common/ukama"
+)
+
+const (
+	StatusPending   = "PENDING"
+	StatusRunning   = "RUNNING"
+	StatusSuccess   = "SUCCESS"
+	StatusFailed    = "FAILED"
+	StatusSkipped   = "SKIPPED"
+	StatusCancelled = "CANCELLED"
+)
+
+const (
+	ActionRestart = "RESTART"
+	ActionRadio   = "RADIO"
+	ActionService = "SERVICE"
+)
+
+const DefaultWaitTimeout = 5 * time.Minute
+
+// Step runs one action on every targeted node of the given types. Steps run
+// one after the other, so ordering between node roles is expressed by
+// putting them in separate steps.
+type Step struct {
+	Name      string   `json:"name"`
+	Action    string   `json:"action"`
+	NodeTypes []string `json:"node_types,omitempty"`
+	// State is the requested on/off state for RADIO and SERVICE
+	State string `json:"state,omitempty"`
+	// Parallelism caps the nodes acted on at once, 0 means all of them
+	Parallelism int           `json:"parallelism"`
+	WaitOnline  bool          `json:"wait_online"`
+	WaitTimeout time.Duration `json:"wait_timeout"`
+}
+
+func (s Step) matches(n Node) bool {
+	if len(s.NodeTypes) == 0 {
+		return true
+	}
+	for _, t := range s.NodeTypes {
+		if strings.EqualFold(t, n.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+type Node struct {
+	Id   string
+	Type string
+}
+
+// NodeResult is the outcome of one step on one node.
+type NodeResult struct {
+	Step       int        `json:"step"`
+	NodeId     string     `json:"node_id"`
+	Status     string     `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+type Plan struct {
+	Steps          []Step
+	Nodes          []Node
+	AbortOnFailure bool
+}
+
+// Executor performs the node side of a plan.
+type Executor interface {
+	Dispatch(ctx context.Context, step Step, nodeId string) error
+	WaitOnline(ctx context.Context, nodeId string, timeout time.Duration) error
+}
+
+// RebootSteps restarts a site in dependency order: amplifiers first, then
+// tower nodes, then everything else, each waiting for its nodes to come
+// back before the next starts.
+func RebootSteps() []Step {
+	return []Step{
+		{Name: "restart amplifier nodes", Action: ActionRestart, NodeTypes: []string{ukama.NODE_ID_TYPE_AMPNODE}, WaitOnline: true},
+		{Name: "restart tower nodes", Action: ActionRestart, NodeTypes: []string{ukama.NODE_ID_TYPE_TOWERNODE}, WaitOnline: true},
+		{Name: "restart remaining nodes", Action: ActionRestart, NodeTypes: []string{ukama.NODE_ID_TYPE_HOMENODE, ukama.NODE_ID_TYPE_CNODE}, WaitOnline: true},
+	}
+}
+
+func Validate(steps []Step) error {
+	if len(steps) == 0 {
+		return fmt.Errorf("plan has no steps")
+	}
+	for i, s := range steps {
+		switch s.Action {
+		case ActionRestart:
+		case ActionRadio, ActionService:
+			if s.State != "on" && s.State != "off" {
+				return fmt.Errorf("step %d: state must be on or off for %s", i, s.Action)
+			}
+		default:
+			return fmt.Errorf("step %d: unsupported action %q", i, s.Action)
+		}
+		if s.Parallelism < 0 {
+			return fmt.Errorf("step %d: parallelism cannot be negative", i)
+		}
+	}
+	return nil
+}
+
+// Expand lists a pending result for every step and node it applies to, in
+// execution order.
+func (p Plan) Expand() []NodeResult {
+	out := []NodeResult{}
+	for i, s := range p.Steps {
+		for _, n := range p.Nodes {
+			if s.matches(n) {
+				out = append(out, NodeResult{Step: i, NodeId: n.Id, Status: StatusPending})
+			}
+		}
+	}
+	return out
+}
+
+// Run executes the plan and returns the final results. progress, if set, is
+// called with a snapshot whenever a result changes. Once a node fails with
+// AbortOnFailure set, or ctx is done, nothing new is started and the
+// remaining results are marked SKIPPED or CANCELLED.
+func Run(ctx context.Context, p Plan, exec Executor, progress func([]NodeResult)) ([]NodeResult, error) {
+	results := p.Expand()
+
+	var mu sync.Mutex
+	update := func(i int, fn func(r *NodeResult)) {
+		mu.Lock()
+		defer mu.Unlock()
+		fn(&results[i])
+		if progress != nil {
+			snap := make([]NodeResult, len(results))
+			copy(snap, results)
+			progress(snap)
+		}
+	}
+
+	failed := false
+	idx := 0
+	for si, step := range p.Steps {
+		start := idx
+		for idx < len(results) && results[idx].Step == si {
+			idx++
+		}
+		if start == idx {
+			continue
+		}
+
+		limit := step.Parallelism
+		if limit <= 0 || limit > idx-start {
+			limit = idx - start
+		}
+		timeout := step.WaitTimeout
+		if timeout <= 0 {
+			timeout = DefaultWaitTimeout
+		}
+
+		sem := make(chan struct{}, limit)
+		var wg sync.WaitGroup
+		var stepFailed bool
+		for i := start; i < idx; i++ {
+			sem <- struct{}{}
+
+			mu.Lock()
+			stop := ctx.Err() != nil || (p.AbortOnFailure && (failed || stepFailed))
+			mu.Unlock()
+			if stop {
+				<-sem
+				break
+			}
+
+			wg.Add(1)
+			go func(i int) {
+				defer func() { <-sem; wg.Done() }()
+
+				now := time.Now().UTC()
+				update(i, func(r *NodeResult) { r.Status = StatusRunning; r.StartedAt = &now })
+
+				err := exec.Dispatch(ctx, step, results[i].NodeId)
+				if err == nil && step.WaitOnline {
+					err = exec.WaitOnline(ctx, results[i].NodeId, timeout)
+				}
+
+				end := time.Now().UTC()
+				update(i, func(r *NodeResult) {
+					r.FinishedAt = &end
+					if err != nil {
+						r.Status = StatusFailed
+						r.Error = err.Error()
+						stepFailed = true
+						return
+					}
+					r.Status = StatusSuccess
+				})
+			}(i)
+		}
+		wg.Wait()
+
+		if stepFailed {
+			failed = true
+		}
+		if ctx.Err() != nil || (p.AbortOnFailure && failed) {
+			break
+		}
+	}
+
+	pending := StatusSkipped
+	if ctx.Err() != nil {
+		pending = StatusCancelled
+	}
+	for i := range results {
+		if results[i].Status == StatusPending {
+			update(i, func(r *NodeResult) { r.Status = pending })
+		}
+	}
+
+	switch {
+	case ctx.Err() != nil:
+		return results, ctx.Err()
+	case failed:
+		return results, fmt.Errorf("plan failed on one or more nodes")
+	}
+	return results, nil
+}
@@ -0,0 +1,136 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeExec struct {
+	mu       sync.Mutex
+	calls    []string
+	fail     map[string]bool
+	inFlight int
+	maxSeen  int
+}
+
+func (f *fakeExec) Dispatch(ctx context.Context, step Step, nodeId string) error {
+	f.mu.Lock()
+	f.calls = append(f.calls, step.Action+":"+nodeId)
+	f.inFlight++
+	if f.inFlight > f.maxSeen {
+		f.maxSeen = f.inFlight
+	}
+	f.mu.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.inFlight--
+	if f.fail[nodeId] {
+		return fmt.Errorf("dispatch to %s failed", nodeId)
+	}
+	return nil
+}
+
+func (f *fakeExec) WaitOnline(ctx context.Context, nodeId string, timeout time.Duration) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, "ONLINE:"+nodeId)
+	return nil
+}
+
+var siteNodes = []Node{
+	{Id: "t1", Type: "tnode"},
+	{Id: "a1", Type: "anode"},
+	{Id: "h1", Type: "hnode"},
+	{Id: "h2", Type: "hnode"},
+}
+
+func TestRun_OrdersStepsByNodeType(t *testing.T) {
+	exec := &fakeExec{}
+
+	results, err := Run(context.Background(), Plan{Steps: RebootSteps(), Nodes: siteNodes}, exec, nil)
+
+	assert.NoError(t, err)
+	assert.Len(t, results, 4)
+	assert.Equal(t, []string{"RESTART:a1", "ONLINE:a1", "RESTART:t1", "ONLINE:t1"}, exec.calls[:4])
+	for _, r := range results {
+		assert.Equal(t, StatusSuccess, r.Status)
+		assert.NotNil(t, r.FinishedAt)
+	}
+}
+
+func TestRun_ParallelismLimit(t *testing.T) {
+	nodes := []Node{}
+	for i := 0; i < 6; i++ {
+		nodes = append(nodes, Node{Id: fmt.Sprintf("h%d", i), Type: "hnode"})
+	}
+	exec := &fakeExec{}
+
+	_, err := Run(context.Background(), Plan{Steps: []Step{{Action: ActionRestart, Parallelism: 2}}, Nodes: nodes}, exec, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, exec.maxSeen)
+}
+
+func TestRun_AbortOnFailure(t *testing.T) {
+	exec := &fakeExec{fail: map[string]bool{"a1": true}}
+
+	var last []NodeResult
+	results, err := Run(context.Background(), Plan{Steps: RebootSteps(), Nodes: siteNodes, AbortOnFailure: true}, exec,
+		func(r []NodeResult) { last = r })
+
+	assert.Error(t, err)
+	assert.Equal(t, StatusFailed, results[0].Status)
+	for _, r := range results[1:] {
+		assert.Equal(t, StatusSkipped, r.Status)
+	}
+	assert.Equal(t, results, last)
+	assert.Equal(t, []string{"RESTART:a1"}, exec.calls)
+}
+
+func TestRun_ContinueOnFailure(t *testing.T) {
+	exec := &fakeExec{fail: map[string]bool{"a1": true}}
+
+	results, err := Run(context.Background(), Plan{Steps: RebootSteps(), Nodes: siteNodes}, exec, nil)
+
+	assert.Error(t, err)
+	assert.Equal(t, StatusFailed, results[0].Status)
+	for _, r := range results[1:] {
+		assert.Equal(t, StatusSuccess, r.Status)
+	}
+}
+
+func TestRun_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := Run(ctx, Plan{Steps: RebootSteps(), Nodes: siteNodes}, &fakeExec{}, nil)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	for _, r := range results {
+		assert.Equal(t, StatusCancelled, r.Status)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate(RebootSteps()))
+	assert.Error(t, Validate(nil))
+	assert.Error(t, Validate([]Step{{Action: "FORMAT"}}))
+	assert.Error(t, Validate([]Step{{Action: ActionRadio, State: "maybe"}}))
+	assert.NoError(t, Validate([]Step{{Action: ActionService, State: "off", Parallelism: 3}}))
+}
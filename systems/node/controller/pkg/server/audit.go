@@ -0,0 +1,98 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ukama/ukama/systems/node/controller/pb/gen"
+	"github.com/ukama/ukama/systems/node/controller/pkg/db"
+)
+
+const (
+	auditStatusFailed = "FAILED"
+	maxAuditLimit     = 1000
+)
+
+type operationResponse interface {
+	GetOperationId() string
+	GetStatus() string
+}
+
+/* audit appends the outcome of a node command. Failing to audit never fails the command */
+func (c *ControllerServer) audit(command, nodeId, method, path, requestedBy string, resp operationResponse, err error) {
+	if c.aRepo == nil {
+		return
+	}
+
+	entry := &db.CommandAudit{
+		NodeId:      nodeId,
+		Command:     command,
+		Method:      method,
+		Path:        path,
+		RequestedBy: requestedBy,
+		OperationId: resp.GetOperationId(),
+		Status:      resp.GetStatus(),
+		CreatedAt:   time.Now().UTC(),
+	}
+	if err != nil {
+		entry.Status = auditStatusFailed
+		entry.Error = err.Error()
+	}
+
+	if aErr := c.aRepo.Add(entry); aErr != nil {
+		log.Errorf("Failed to audit %s for node %s: %v", command, nodeId, aErr)
+	}
+}
+
+func (c *ControllerServer) ListCommandAudit(ctx context.Context, req *pb.ListCommandAuditRequest) (*pb.ListCommandAuditResponse, error) {
+	if req.Limit > maxAuditLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not exceed %d", maxAuditLimit)
+	}
+
+	filter := db.CommandAuditFilter{
+		NodeId:      req.NodeId,
+		RequestedBy: req.RequestedBy,
+		Command:     req.Command,
+		Limit:       int(req.Limit),
+	}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+
+	entries, err := c.aRepo.List(filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list command audit: %v", err)
+	}
+
+	resp := &pb.ListCommandAuditResponse{Entries: make([]*pb.CommandAuditEntry, 0, len(entries))}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &pb.CommandAuditEntry{
+			NodeId:      e.NodeId,
+			Command:     e.Command,
+			Method:      e.Method,
+			Path:        e.Path,
+			RequestedBy: e.RequestedBy,
+			OperationId: e.OperationId,
+			Status:      e.Status,
+			Error:       e.Error,
+			CreatedAt:   timestamppb.New(e.CreatedAt),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,91 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	mbmocks "github.com/ukama/ukama/systems/common/mocks"
+	copr "github.com/ukama/ukama/systems/common/rest/client/operation"
+	"github.com/ukama/ukama/systems/node/controller/mocks"
+	pb "github.com/ukama/ukama/systems/node/controller/pb/gen"
+	"github.com/ukama/ukama/systems/node/controller/pkg"
+	"github.com/ukama/ukama/systems/node/controller/pkg/db"
+	opmonpb "github.com/ukama/ukama/systems/node/operation-monitor/pb/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestControllerServer_AuditsDispatchedCommand(t *testing.T) {
+	msgclientRepo := &mbmocks.MsgBusServiceClient{}
+	conRepo := &mocks.NodeLogRepo{}
+	auditRepo := &mocks.CommandAuditRepo{}
+	opMgr := &mbmocks.ManagerClient{}
+	opMon := &mocks.OperationMonitor{}
+
+	nodeId := "uk-983794-hnode-78-7830"
+
+	conRepo.On("Get", nodeId).Return(&db.NodeLog{NodeId: nodeId}, nil).Once()
+	op := &copr.OperationInfo{Id: "op-restart", FencingToken: 1, ResourceKey: "node:" + nodeId}
+	opMgr.On("Start", mock.Anything).Return(&copr.StartResponse{Operation: op}, nil).Once()
+	opMon.On("Register", mock.Anything).Return(&opmonpb.RegisterIntentResponse{}, nil).Once()
+	opMgr.On("MarkRunning", "op-restart", uint64(1)).Return(&copr.OperationInfo{}, nil).Once()
+	msgclientRepo.On("PublishRequest", mock.Anything, mock.Anything).Return(nil).Once()
+
+	auditRepo.On("Add", mock.MatchedBy(func(e *db.CommandAudit) bool {
+		return e.NodeId == nodeId && e.Command == "RestartNode" && e.Path == "/device/v1/reboot" &&
+			e.RequestedBy == "site-controller" && e.OperationId == "op-restart" && e.Status == "RUNNING" && e.Error == ""
+	})).Return(nil).Once()
+
+	s := NewControllerServer(testOrgName, conRepo, auditRepo, nil, msgclientRepo, nil, nil, nil, opMgr, opMon, 30, 60, 0, pkg.CommandPlanConfig{}, pkg.IsDebugMode)
+
+	_, err := s.RestartNode(context.TODO(), &pb.RestartNodeRequest{NodeId: nodeId, RequestedBy: "site-controller"})
+
+	assert.NoError(t, err)
+	auditRepo.AssertExpectations(t)
+}
+
+func TestControllerServer_AuditsRejectedCommand(t *testing.T) {
+	auditRepo := &mocks.CommandAuditRepo{}
+	auditRepo.On("Add", mock.MatchedBy(func(e *db.CommandAudit) bool {
+		return e.Command == "ToggleService" && e.Status == auditStatusFailed && e.Error != "" && e.RequestedBy == "alice"
+	})).Return(nil).Once()
+
+	s := NewControllerServer(testOrgName, &mocks.NodeLogRepo{}, auditRepo, nil, &mbmocks.MsgBusServiceClient{}, nil, nil, nil, nil, nil, 0, 0, 0, pkg.CommandPlanConfig{}, pkg.IsDebugMode)
+
+	_, err := s.ToggleService(context.TODO(), &pb.ToggleServiceRequest{NodeId: "not-a-node-id", State: "on", RequestedBy: "alice"})
+
+	assert.Error(t, err)
+	auditRepo.AssertExpectations(t)
+}
+
+func TestControllerServer_ListCommandAudit(t *testing.T) {
+	auditRepo := &mocks.CommandAuditRepo{}
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	created := from.Add(time.Hour)
+
+	auditRepo.On("List", db.CommandAuditFilter{RequestedBy: "alice", From: from, Limit: 10}).
+		Return([]db.CommandAudit{{NodeId: "uk-983794-hnode-78-7830", Command: "ToggleRadio", RequestedBy: "alice", CreatedAt: created}}, nil).Once()
+
+	s := NewControllerServer(testOrgName, &mocks.NodeLogRepo{}, auditRepo, nil, &mbmocks.MsgBusServiceClient{}, nil, nil, nil, nil, nil, 0, 0, 0, pkg.CommandPlanConfig{}, pkg.IsDebugMode)
+
+	resp, err := s.ListCommandAudit(context.TODO(), &pb.ListCommandAuditRequest{RequestedBy: "alice", From: timestamppb.New(from), Limit: 10})
+
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Entries, 1) {
+		assert.Equal(t, "ToggleRadio", resp.Entries[0].Command)
+		assert.Equal(t, created, resp.Entries[0].CreatedAt.AsTime())
+	}
+
+	_, err = s.ListCommandAudit(context.TODO(), &pb.ListCommandAuditRequest{Limit: maxAuditLimit + 1})
+	assert.Error(t, err)
+}
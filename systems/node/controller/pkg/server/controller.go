@@ -12,6 +12,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
@@ -48,6 +50,8 @@ var actions = map[string]struct {
 type ControllerServer struct {
 	pb.UnimplementedControllerServiceServer
 	nRepo                db.NodeLogRepo
+	aRepo                db.CommandAuditRepo
+	pRepo                db.CommandPlanRepo
 	nodeFeederRoutingKey msgbus.RoutingKeyBuilder
 	msgbus               mb.MsgBusServiceClient
 	networkClient        creg.NetworkClient
@@ -57,13 +61,19 @@ type ControllerServer struct {
 	opMonitor            cclient.OperationMonitor
 	opLeaseSecs          uint32
 	opDeadlineSecs       uint32
+	opWaitSecs           uint32
+	planConf             pkg.CommandPlanConfig
+	plansMu              sync.Mutex
+	plans                map[string]*runningPlan
 	debug                bool
 	orgName              string
 }
 
-func NewControllerServer(orgName string, nRepo db.NodeLogRepo, msgBus mb.MsgBusServiceClient, cnet creg.NetworkClient, csite creg.SiteClient, cnode creg.NodeClient, opMgr copr.ManagerClient, opMon cclient.OperationMonitor, leaseSecs, deadlineSecs uint32, debug bool) *ControllerServer {
+func NewControllerServer(orgName string, nRepo db.NodeLogRepo, aRepo db.CommandAuditRepo, pRepo db.CommandPlanRepo, msgBus mb.MsgBusServiceClient, cnet creg.NetworkClient, csite creg.SiteClient, cnode creg.NodeClient, opMgr copr.ManagerClient, opMon cclient.OperationMonitor, leaseSecs, deadlineSecs, waitSecs uint32, planConf pkg.CommandPlanConfig, debug bool) *ControllerServer {
 	return &ControllerServer{
 		nRepo:                nRepo,
+		aRepo:                aRepo,
+		pRepo:                pRepo,
 		orgName:              orgName,
 		msgbus:               msgBus,
 		debug:                debug,
@@ -74,11 +84,16 @@ func NewControllerServer(orgName string, nRepo db.NodeLogRepo, msgBus mb.MsgBusS
 		opMonitor:            opMon,
 		opLeaseSecs:          leaseSecs,
 		opDeadlineSecs:       deadlineSecs,
+		opWaitSecs:           waitSecs,
+		planConf:             planConf,
+		plans:                map[string]*runningPlan{},
 		nodeFeederRoutingKey: msgbus.NewRoutingKeyBuilder().SetCloudSource().SetSystem(pkg.SystemName).SetOrgName(orgName).SetService(pkg.ServiceName),
 	}
 }
 
-func (c *ControllerServer) SendNodeCommand(ctx context.Context, req *pb.SendNodeCommandRequest) (*pb.SendNodeCommandResponse, error) {
+func (c *ControllerServer) SendNodeCommand(ctx context.Context, req *pb.SendNodeCommandRequest) (resp *pb.SendNodeCommandResponse, err error) {
+	defer func() { c.audit("SendNodeCommand", req.NodeId, req.Method, req.Path, req.RequestedBy, resp, err) }()
+
 	nId, err := ukama.ValidateNodeId(req.NodeId)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid format of node id. Error %s", err.Error())
@@ -87,7 +102,7 @@ func (c *ControllerServer) SendNodeCommand(ctx context.Context, req *pb.SendNode
 		return nil, status.Errorf(codes.InvalidArgument, "Node has not been registered yet: %s", err.Error())
 	}
 
-	op, err := c.acquireAndRegister("SendNodeCommand", "node:"+nId.String())
+	op, err := c.acquireAndRegister("SendNodeCommand", "node:"+nId.String(), req.WaitSeconds)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +122,9 @@ func (c *ControllerServer) SendNodeCommand(ctx context.Context, req *pb.SendNode
 	return &pb.SendNodeCommandResponse{OperationId: op.Id, ResourceKey: op.ResourceKey, Status: opStatus}, nil
 }
 
-func (c *ControllerServer) RestartNode(ctx context.Context, req *pb.RestartNodeRequest) (*pb.RestartNodeResponse, error) {
+func (c *ControllerServer) RestartNode(ctx context.Context, req *pb.RestartNodeRequest) (resp *pb.RestartNodeResponse, err error) {
+	defer func() { c.audit("RestartNode", req.NodeId, actions["RESTART"].method, actions["RESTART"].path, req.RequestedBy, resp, err) }()
+
 	if req.NodeId == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "node ID cannot be empty")
 	}
@@ -119,7 +136,7 @@ func (c *ControllerServer) RestartNode(ctx context.Context, req *pb.RestartNodeR
 		return nil, status.Errorf(codes.InvalidArgument, "Node has not been registered yet: %s", err.Error())
 	}
 
-	op, err := c.acquireAndRegister("RestartNode", "node:"+nId.String())
+	op, err := c.acquireAndRegister("RestartNode", "node:"+nId.String(), req.WaitSeconds)
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +172,9 @@ func (c *ControllerServer) PingNode(ctx context.Context, req *pb.PingNodeRequest
 	return &pb.PingNodeResponse{}, nil
 }
 
-func (c *ControllerServer) ToggleSwitchPort(ctx context.Context, req *pb.ToggleSwitchPortRequest) (*pb.ToggleSwitchPortResponse, error) {
+func (c *ControllerServer) ToggleSwitchPort(ctx context.Context, req *pb.ToggleSwitchPortRequest) (resp *pb.ToggleSwitchPortResponse, err error) {
+	defer func() { c.audit("ToggleInternetSwitch", req.NodeId, actions["SWITCH"].method, actions["SWITCH"].path, req.RequestedBy, resp, err) }()
+
 	log.Infof("Toggling internet switch for node %v, port %v to %v", req.NodeId, req.Port, req.Status)
 
 	nId, err := ukama.ValidateNodeId(req.NodeId)
@@ -172,7 +191,7 @@ func (c *ControllerServer) ToggleSwitchPort(ctx context.Context, req *pb.ToggleS
 		return nil, err
 	}
 
-	op, err := c.acquireAndRegister("ToggleInternetSwitch", nodeKey(nId.String()))
+	op, err := c.acquireAndRegister("ToggleInternetSwitch", nodeKey(nId.String()), req.WaitSeconds)
 	if err != nil {
 		return nil, err
 	}
@@ -188,7 +207,9 @@ func (c *ControllerServer) ToggleSwitchPort(ctx context.Context, req *pb.ToggleS
 	return &pb.ToggleSwitchPortResponse{OperationId: op.Id, ResourceKey: op.ResourceKey, Status: opStatus}, nil
 }
 
-func (c *ControllerServer) ToggleRadio(ctx context.Context, req *pb.ToggleRadioRequest) (*pb.ToggleRadioResponse, error) {
+func (c *ControllerServer) ToggleRadio(ctx context.Context, req *pb.ToggleRadioRequest) (resp *pb.ToggleRadioResponse, err error) {
+	defer func() { c.audit("ToggleRadio", req.NodeId, actions["RADIO"].method, actions["RADIO"].path, req.RequestedBy, resp, err) }()
+
 	log.Infof("Toggling RADIO on/off for node %v, to %v", req.NodeId, req.State)
 	// TODO: RF toggle will send command to Tnode and Anode both
 	nId, err := ukama.ValidateNodeId(req.NodeId)
@@ -201,7 +222,7 @@ func (c *ControllerServer) ToggleRadio(ctx context.Context, req *pb.ToggleRadioR
 		return nil, err
 	}
 
-	op, err := c.acquireAndRegister("ToggleRadio", nodeKey(nId.String()))
+	op, err := c.acquireAndRegister("ToggleRadio", nodeKey(nId.String()), req.WaitSeconds)
 	if err != nil {
 		return nil, err
 	}
@@ -217,7 +238,9 @@ func (c *ControllerServer) ToggleRadio(ctx context.Context, req *pb.ToggleRadioR
 	return &pb.ToggleRadioResponse{OperationId: op.Id, ResourceKey: op.ResourceKey, Status: opStatus}, nil
 }
 
-func (c *ControllerServer) ToggleService(ctx context.Context, req *pb.ToggleServiceRequest) (*pb.ToggleServiceResponse, error) {
+func (c *ControllerServer) ToggleService(ctx context.Context, req *pb.ToggleServiceRequest) (resp *pb.ToggleServiceResponse, err error) {
+	defer func() { c.audit("ToggleService", req.NodeId, actions["SERVICE"].method, actions["SERVICE"].path, req.RequestedBy, resp, err) }()
+
 	log.Infof("Toggling Node SERVICE on/off for node %v, to %v", req.NodeId, req.State)
 
 	nId, err := ukama.ValidateNodeId(req.NodeId)
@@ -230,7 +253,7 @@ func (c *ControllerServer) ToggleService(ctx context.Context, req *pb.ToggleServ
 		return nil, err
 	}
 
-	op, err := c.acquireAndRegister("ToggleService", nodeKey(nId.String()))
+	op, err := c.acquireAndRegister("ToggleService", nodeKey(nId.String()), req.WaitSeconds)
 	if err != nil {
 		return nil, err
 	}
@@ -250,24 +273,36 @@ func nodeKey(nodeID string) string {
 	return "node:" + nodeID
 }
 
-func (c *ControllerServer) acquireAndRegister(actionType, resourceKey string) (*copr.OperationInfo, error) {
+// acquireAndRegister queues the action behind a busy node for waitSecs, or
+// the controller default when 0, and watches the queued operation until it
+// gets the lock.
+func (c *ControllerServer) acquireAndRegister(actionType, resourceKey string, waitSecs uint32) (*copr.OperationInfo, error) {
 	if c.opManager == nil || c.opMonitor == nil {
 		log.Warnf("%s running without operation manager/monitor for %s", actionType, resourceKey)
 		return nil, fmt.Errorf("operation manager/monitor is not set")
 	}
 
+	if waitSecs == 0 {
+		waitSecs = c.opWaitSecs
+	}
+
 	startResp, err := c.opManager.Start(copr.StartRequest{
 		Type:         actionType,
 		System:       "node",
 		ResourceKey:  resourceKey,
 		RequestedBy:  pkg.ServiceName,
 		LeaseSeconds: c.opLeaseSecs,
+		Queue:        waitSecs > 0,
 	})
 	if err != nil {
 		log.Warnf("%s lock acquire for %s rejected: %v", actionType, resourceKey, err)
 		return nil, err
 	}
-	op := startResp.Operation
+	op, err := copr.AwaitLock(c.opManager, startResp.Operation, time.Duration(waitSecs)*time.Second, pkg.ServiceName)
+	if err != nil {
+		log.Warnf("%s lock acquire for %s failed: %v", actionType, resourceKey, err)
+		return nil, status.Errorf(codes.Aborted, "resource %s busy: %v", resourceKey, err)
+	}
 	if _, err := c.opMonitor.Register(&opmonpb.RegisterIntentRequest{
 		OperationId:     op.Id,
 		ResourceKey:     resourceKey,
@@ -12,6 +12,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -24,6 +25,8 @@ import (
 	pb "github.com/ukama/ukama/systems/node/controller/pb/gen"
 	"github.com/ukama/ukama/systems/node/controller/pkg"
 	opmonpb "github.com/ukama/ukama/systems/node/operation-monitor/pb/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const testOrgName = "test-org"
@@ -54,7 +57,7 @@ func TestControllerServer_RestartNode(t *testing.T) {
 		NodeId:     nodeId,
 	}).Return(nil).Once()
 
-	s := NewControllerServer(testOrgName, conRepo, msgclientRepo, nil, nil, nil, opMgr, opMon, 30, 60, pkg.IsDebugMode)
+	s := NewControllerServer(testOrgName, conRepo, nil, nil, msgclientRepo, nil, nil, nil, opMgr, opMon, 30, 60, 0, pkg.CommandPlanConfig{}, pkg.IsDebugMode)
 
 	resp, err := s.RestartNode(context.TODO(), &pb.RestartNodeRequest{NodeId: nodeId})
 
@@ -95,7 +98,7 @@ func TestControllerServer_ToggleRadio(t *testing.T) {
 		NodeId:     nodeId,
 	}).Return(nil).Once()
 
-	s := NewControllerServer(testOrgName, conRepo, msgclientRepo, nil, nil, nil, opMgr, opMon, 30, 60, pkg.IsDebugMode)
+	s := NewControllerServer(testOrgName, conRepo, nil, nil, msgclientRepo, nil, nil, nil, opMgr, opMon, 30, 60, 0, pkg.CommandPlanConfig{}, pkg.IsDebugMode)
 
 	_, err = s.ToggleRadio(context.TODO(), &pb.ToggleRadioRequest{NodeId: nodeId, State: "on"})
 
@@ -134,7 +137,7 @@ func TestControllerServer_ToggleService(t *testing.T) {
 		NodeId:     nodeId,
 	}).Return(nil).Once()
 
-	s := NewControllerServer(testOrgName, conRepo, msgclientRepo, nil, nil, nil, opMgr, opMon, 30, 60, pkg.IsDebugMode)
+	s := NewControllerServer(testOrgName, conRepo, nil, nil, msgclientRepo, nil, nil, nil, opMgr, opMon, 30, 60, 0, pkg.CommandPlanConfig{}, pkg.IsDebugMode)
 
 	_, err = s.ToggleService(context.TODO(), &pb.ToggleServiceRequest{NodeId: nodeId, State: "on"})
 
@@ -152,9 +155,12 @@ func TestControllerServer_ToggleService_InvalidNodeId(t *testing.T) {
 	s := NewControllerServer(
 		testOrgName,
 		&mocks.NodeLogRepo{},
+		nil,
+		nil,
 		&mbmocks.MsgBusServiceClient{},
 		nil, nil, nil, nil, nil,
-		0, 0,
+		0, 0, 0,
+		pkg.CommandPlanConfig{},
 		pkg.IsDebugMode,
 	)
 
@@ -186,7 +192,7 @@ func TestControllerServer_ToggleSwitchPort_NodeLevelLock(t *testing.T) {
 	opMgr.On("Complete", "op-i", mock.Anything, mock.Anything).Return(&copr.OperationInfo{}, nil).Once()
 	msgclientRepo.On("PublishRequest", mock.Anything, mock.Anything).Return(nil).Once()
 
-	s := NewControllerServer(testOrgName, conRepo, msgclientRepo, nil, siteClient, nil, opMgr, opMon, 30, 60, pkg.IsDebugMode)
+	s := NewControllerServer(testOrgName, conRepo, nil, nil, msgclientRepo, nil, siteClient, nil, opMgr, opMon, 30, 60, 0, pkg.CommandPlanConfig{}, pkg.IsDebugMode)
 
 	resp, err := s.ToggleSwitchPort(context.TODO(), &pb.ToggleSwitchPortRequest{NodeId: nodeId, Status: true, Port: 2})
 
@@ -196,6 +202,58 @@ func TestControllerServer_ToggleSwitchPort_NodeLevelLock(t *testing.T) {
 	siteClient.AssertExpectations(t)
 }
 
+func TestControllerServer_ToggleSwitchPort_QueuesBehindBusyNode(t *testing.T) {
+	msgclientRepo := &mbmocks.MsgBusServiceClient{}
+	opMgr := &mbmocks.ManagerClient{}
+	opMon := &mocks.OperationMonitor{}
+
+	nodeId := "uk-983794-tnode-78-7830"
+	resourceKey := nodeKey(nodeId)
+
+	queued := &copr.OperationInfo{Id: "op-i", ResourceKey: resourceKey, Status: copr.StatusQueued}
+	opMgr.On("Start", mock.MatchedBy(func(req copr.StartRequest) bool {
+		return req.ResourceKey == resourceKey && req.Queue
+	})).Return(&copr.StartResponse{Operation: queued, QueuePosition: 1}, nil).Once()
+	opMgr.On("Watch", "op-i", 45*time.Second, mock.Anything).Run(func(args mock.Arguments) {
+		fn := args.Get(2).(func(*copr.WatchEvent) bool)
+		fn(&copr.WatchEvent{Operation: queued, QueuePosition: 1})
+		fn(&copr.WatchEvent{Operation: &copr.OperationInfo{Id: "op-i", FencingToken: 2, ResourceKey: resourceKey, Status: copr.StatusPending}})
+	}).Return(nil).Once()
+	opMon.On("Register", mock.MatchedBy(func(req *opmonpb.RegisterIntentRequest) bool {
+		return req.FencingToken == 2
+	})).Return(&opmonpb.RegisterIntentResponse{}, nil).Once()
+	opMgr.On("MarkRunning", "op-i", uint64(2)).Return(&copr.OperationInfo{}, nil).Once()
+	opMgr.On("Complete", "op-i", mock.Anything, mock.Anything).Return(&copr.OperationInfo{}, nil).Once()
+	msgclientRepo.On("PublishRequest", mock.Anything, mock.Anything).Return(nil).Once()
+
+	s := NewControllerServer(testOrgName, &mocks.NodeLogRepo{}, nil, nil, msgclientRepo, nil, nil, nil, opMgr, opMon, 30, 60, 10, pkg.CommandPlanConfig{}, pkg.IsDebugMode)
+
+	_, err := s.ToggleSwitchPort(context.TODO(), &pb.ToggleSwitchPortRequest{NodeId: nodeId, Status: true, Port: 2, WaitSeconds: 45})
+
+	assert.NoError(t, err)
+	opMgr.AssertExpectations(t)
+	opMon.AssertExpectations(t)
+}
+
+func TestControllerServer_ToggleSwitchPort_BusyNode(t *testing.T) {
+	opMgr := &mbmocks.ManagerClient{}
+
+	nodeId := "uk-983794-tnode-78-7830"
+	resourceKey := nodeKey(nodeId)
+
+	queued := &copr.OperationInfo{Id: "op-i", ResourceKey: resourceKey, Status: copr.StatusQueued}
+	opMgr.On("Start", mock.Anything).Return(&copr.StartResponse{Operation: queued, QueuePosition: 1}, nil).Once()
+	opMgr.On("Watch", "op-i", 10*time.Second, mock.Anything).Return(context.DeadlineExceeded).Once()
+	opMgr.On("ForceUnlock", "op-i", mock.Anything, mock.Anything).Return(&copr.OperationInfo{}, nil).Once()
+
+	s := NewControllerServer(testOrgName, &mocks.NodeLogRepo{}, nil, nil, &mbmocks.MsgBusServiceClient{}, nil, nil, nil, opMgr, &mocks.OperationMonitor{}, 30, 60, 10, pkg.CommandPlanConfig{}, pkg.IsDebugMode)
+
+	_, err := s.ToggleSwitchPort(context.TODO(), &pb.ToggleSwitchPortRequest{NodeId: nodeId, Status: true, Port: 2})
+
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	opMgr.AssertExpectations(t)
+}
+
 func TestControllerServer_ToggleSwitchPort_PublishFailureFailsOperation(t *testing.T) {
 	msgclientRepo := &mbmocks.MsgBusServiceClient{}
 	conRepo := &mocks.NodeLogRepo{}
@@ -215,7 +273,7 @@ func TestControllerServer_ToggleSwitchPort_PublishFailureFailsOperation(t *testi
 	msgclientRepo.On("PublishRequest", mock.Anything, mock.Anything).Return(assert.AnError).Once()
 	opMgr.On("ForceUnlock", "op-i", mock.Anything, mock.Anything).Return(&copr.OperationInfo{}, nil).Once()
 
-	s := NewControllerServer(testOrgName, conRepo, msgclientRepo, nil, siteClient, nil, opMgr, opMon, 30, 60, pkg.IsDebugMode)
+	s := NewControllerServer(testOrgName, conRepo, nil, nil, msgclientRepo, nil, siteClient, nil, opMgr, opMon, 30, 60, 0, pkg.CommandPlanConfig{}, pkg.IsDebugMode)
 
 	_, err := s.ToggleSwitchPort(context.TODO(), &pb.ToggleSwitchPortRequest{NodeId: nodeId, Status: true, Port: 2})
 
@@ -224,7 +282,7 @@ func TestControllerServer_ToggleSwitchPort_PublishFailureFailsOperation(t *testi
 }
 
 func TestControllerServer_ToggleSwitchPort_Validation(t *testing.T) {
-	s := NewControllerServer(testOrgName, &mocks.NodeLogRepo{}, &mbmocks.MsgBusServiceClient{}, nil, nil, nil, nil, nil, 0, 0, pkg.IsDebugMode)
+	s := NewControllerServer(testOrgName, &mocks.NodeLogRepo{}, nil, nil, &mbmocks.MsgBusServiceClient{}, nil, nil, nil, nil, nil, 0, 0, 0, pkg.CommandPlanConfig{}, pkg.IsDebugMode)
 
 	_, err := s.ToggleSwitchPort(context.TODO(), &pb.ToggleSwitchPortRequest{NodeId: ""})
 	assert.Error(t, err)
@@ -234,7 +292,7 @@ func TestControllerServer_ToggleSwitchPort_Validation(t *testing.T) {
 }
 
 func TestControllerServer_ToggleRadio_InvalidNodeId(t *testing.T) {
-	s := NewControllerServer(testOrgName, &mocks.NodeLogRepo{}, &mbmocks.MsgBusServiceClient{}, nil, nil, nil, nil, nil, 0, 0, pkg.IsDebugMode)
+	s := NewControllerServer(testOrgName, &mocks.NodeLogRepo{}, nil, nil, &mbmocks.MsgBusServiceClient{}, nil, nil, nil, nil, nil, 0, 0, 0, pkg.CommandPlanConfig{}, pkg.IsDebugMode)
 
 	_, err := s.ToggleRadio(context.TODO(), &pb.ToggleRadioRequest{NodeId: "not-a-node-id", State: "on"})
 	assert.Error(t, err)
@@ -272,7 +330,7 @@ func TestControllerServer_ToggleRadio_TowerNode(t *testing.T) {
 		NodeId:     nodeId,
 	}).Return(nil).Once()
 
-	s := NewControllerServer(testOrgName, conRepo, msgclientRepo, nil, nil, nil, opMgr, opMon, 30, 60, pkg.IsDebugMode)
+	s := NewControllerServer(testOrgName, conRepo, nil, nil, msgclientRepo, nil, nil, nil, opMgr, opMon, 30, 60, 0, pkg.CommandPlanConfig{}, pkg.IsDebugMode)
 
 	_, err = s.ToggleRadio(context.TODO(), &pb.ToggleRadioRequest{NodeId: nodeId, State: "on"})
 
@@ -0,0 +1,436 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	copr "github.com/ukama/ukama/systems/common/rest/client/operation"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
+	"github.com/ukama/ukama/systems/common/ukama"
+	"github.com/ukama/ukama/systems/common/uuid"
+	pb "github.com/ukama/ukama/systems/node/controller/pb/gen"
+	"github.com/ukama/ukama/systems/node/controller/pkg"
+	"github.com/ukama/ukama/systems/node/controller/pkg/db"
+	"github.com/ukama/ukama/systems/node/controller/pkg/plan"
+)
+
+const commandPlanAction = "CommandPlan"
+
+/* audit command names of plan actions, shared with the single node RPCs */
+var planCommands = map[string]string{
+	plan.ActionRestart: "RestartNode",
+	plan.ActionRadio:   "ToggleRadio",
+	plan.ActionService: "ToggleService",
+}
+
+type runningPlan struct {
+	cancel      context.CancelFunc
+	cancelledBy string
+}
+
+func (c *ControllerServer) RunCommandPlan(ctx context.Context, req *pb.RunCommandPlanRequest) (*pb.RunCommandPlanResponse, error) {
+	if c.pRepo == nil {
+		return nil, status.Errorf(codes.Unavailable, "command plans are not configured")
+	}
+
+	steps := make([]plan.Step, 0, len(req.Steps))
+	for _, s := range req.Steps {
+		steps = append(steps, plan.Step{
+			Name:        s.Name,
+			Action:      strings.ToUpper(s.Action),
+			NodeTypes:   s.NodeTypes,
+			State:       s.State,
+			Parallelism: int(s.Parallelism),
+			WaitOnline:  s.WaitOnline,
+			WaitTimeout: time.Duration(s.WaitTimeoutSeconds) * time.Second,
+		})
+	}
+	if len(steps) == 0 {
+		steps = plan.RebootSteps()
+	}
+	if err := plan.Validate(steps); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid plan: %v", err)
+	}
+
+	nodes, err := c.resolvePlanNodes(req.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	p := plan.Plan{Steps: steps, Nodes: nodes, AbortOnFailure: req.AbortOnFailure}
+	results := p.Expand()
+	if len(results) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "no plan step applies to the targeted nodes")
+	}
+
+	rec := &db.CommandPlan{
+		Id:             uuid.NewV4(),
+		RequestedBy:    requesterOf(req.RequestedBy),
+		AbortOnFailure: req.AbortOnFailure,
+		Status:         plan.StatusRunning,
+		Steps:          steps,
+		Results:        results,
+	}
+	rec.ResourceKey = planResourceKey(req.Target, rec.Id)
+
+	op, err := c.acquirePlan(rec)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.markRunning(op, commandPlanAction); err != nil {
+		c.failOperation(op, commandPlanAction, fmt.Sprintf("mark running failed: %v", err))
+		return nil, status.Errorf(codes.Internal, "mark running: %v", err)
+	}
+	rec.OperationId = op.Id
+
+	if err := c.pRepo.Add(rec); err != nil {
+		c.failOperation(op, commandPlanAction, fmt.Sprintf("saving plan failed: %v", err))
+		return nil, status.Errorf(codes.Internal, "failed to save command plan: %v", err)
+	}
+
+	runCtx, cancel := context.WithTimeout(context.Background(), time.Duration(c.planConf.LeaseSecs)*time.Second)
+	c.plansMu.Lock()
+	c.plans[rec.Id.String()] = &runningPlan{cancel: cancel}
+	c.plansMu.Unlock()
+
+	log.Infof("Running command plan %s on %s with %d node action(s) for %s", rec.Id, rec.ResourceKey, len(results), rec.RequestedBy)
+
+	snapshot := *rec
+	go c.runPlan(runCtx, cancel, rec, p, op)
+
+	return &pb.RunCommandPlanResponse{Plan: planToPb(&snapshot)}, nil
+}
+
+func (c *ControllerServer) GetCommandPlan(ctx context.Context, req *pb.GetCommandPlanRequest) (*pb.GetCommandPlanResponse, error) {
+	rec, err := c.getPlan(req.PlanId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetCommandPlanResponse{Plan: planToPb(rec)}, nil
+}
+
+// CancelCommandPlan stops a plan from starting further node actions, actions
+// already dispatched are not undone. A plan left running by an instance that
+// went away is closed here directly.
+func (c *ControllerServer) CancelCommandPlan(ctx context.Context, req *pb.CancelCommandPlanRequest) (*pb.CancelCommandPlanResponse, error) {
+	rec, err := c.getPlan(req.PlanId)
+	if err != nil {
+		return nil, err
+	}
+	if rec.Status != plan.StatusRunning {
+		return nil, status.Errorf(codes.FailedPrecondition, "command plan is already %s", rec.Status)
+	}
+
+	by := requesterOf(req.RequestedBy)
+
+	c.plansMu.Lock()
+	running, ok := c.plans[rec.Id.String()]
+	if ok {
+		running.cancelledBy = by
+	}
+	c.plansMu.Unlock()
+
+	if ok {
+		log.Infof("Cancelling command plan %s for %s", rec.Id, by)
+		running.cancel()
+		return &pb.CancelCommandPlanResponse{Plan: planToPb(rec)}, nil
+	}
+
+	log.Warnf("Command plan %s is not running on this instance, closing it for %s", rec.Id, by)
+	for i := range rec.Results {
+		if rec.Results[i].Status == plan.StatusPending || rec.Results[i].Status == plan.StatusRunning {
+			rec.Results[i].Status = plan.StatusCancelled
+		}
+	}
+	c.finishPlan(rec, &copr.OperationInfo{Id: rec.OperationId}, plan.StatusCancelled, "cancelled by "+by)
+
+	return &pb.CancelCommandPlanResponse{Plan: planToPb(rec)}, nil
+}
+
+func (c *ControllerServer) runPlan(ctx context.Context, cancel context.CancelFunc, rec *db.CommandPlan, p plan.Plan, op *copr.OperationInfo) {
+	defer cancel()
+
+	exec := &planExecutor{c: c, operationId: op.Id, requestedBy: rec.RequestedBy}
+	results, err := plan.Run(ctx, p, exec, func(r []plan.NodeResult) {
+		rec.Results = r
+		if uErr := c.pRepo.Update(rec); uErr != nil {
+			log.Errorf("Failed to save progress of command plan %s: %v", rec.Id, uErr)
+		}
+	})
+	rec.Results = results
+
+	c.plansMu.Lock()
+	running := c.plans[rec.Id.String()]
+	delete(c.plans, rec.Id.String())
+	c.plansMu.Unlock()
+
+	switch {
+	case err == nil:
+		c.finishPlan(rec, op, plan.StatusSuccess, "")
+	case errors.Is(err, context.Canceled):
+		c.finishPlan(rec, op, plan.StatusCancelled, "cancelled by "+running.cancelledBy)
+	case errors.Is(err, context.DeadlineExceeded):
+		c.finishPlan(rec, op, plan.StatusFailed, "plan did not finish within its lease")
+	default:
+		c.finishPlan(rec, op, plan.StatusFailed, err.Error())
+	}
+}
+
+/* finishPlan records the outcome and releases the plan operation */
+func (c *ControllerServer) finishPlan(rec *db.CommandPlan, op *copr.OperationInfo, st, reason string) {
+	now := time.Now().UTC()
+	rec.Status = st
+	rec.Error = reason
+	rec.FinishedAt = &now
+	if err := c.pRepo.Update(rec); err != nil {
+		log.Errorf("Failed to save outcome of command plan %s: %v", rec.Id, err)
+	}
+
+	if st == plan.StatusSuccess {
+		c.completeOperation(op, commandPlanAction)
+	} else {
+		c.failOperation(op, commandPlanAction, reason)
+	}
+	log.Infof("Command plan %s finished as %s %s", rec.Id, st, reason)
+}
+
+// acquirePlan takes the single operation covering the whole plan. Its lease
+// spans the plan and no completion intent is registered: the plan releases
+// the operation itself and the lease only matters if this instance dies.
+func (c *ControllerServer) acquirePlan(rec *db.CommandPlan) (*copr.OperationInfo, error) {
+	if c.opManager == nil {
+		return nil, status.Errorf(codes.Unavailable, "operation manager is not set")
+	}
+
+	/* Let the manager hold the request until the resource frees up instead of queueing a plan nobody waits for */
+	startResp, err := c.opManager.Start(copr.StartRequest{
+		Type:           commandPlanAction,
+		System:         "node",
+		ResourceKey:    rec.ResourceKey,
+		RequestedBy:    rec.RequestedBy,
+		IdempotencyKey: rec.Id.String(),
+		LeaseSeconds:   c.planConf.LeaseSecs,
+		WaitSeconds:    c.opWaitSecs,
+	})
+	if err != nil {
+		log.Warnf("%s lock acquire for %s rejected: %v", commandPlanAction, rec.ResourceKey, err)
+		return nil, err
+	}
+	if startResp.Operation == nil {
+		return nil, status.Errorf(codes.Aborted, "resource %s busy", rec.ResourceKey)
+	}
+	return startResp.Operation, nil
+}
+
+func (c *ControllerServer) resolvePlanNodes(t *pb.PlanTarget) ([]plan.Node, error) {
+	selectors := 0
+	for _, set := range []bool{t.SiteId != "", t.NetworkId != "", len(t.NodeIds) > 0} {
+		if set {
+			selectors++
+		}
+	}
+	if selectors != 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "target needs exactly one of site id, network id or node ids")
+	}
+
+	ids := t.NodeIds
+	if len(ids) == 0 {
+		if c.nodeClient == nil {
+			return nil, status.Errorf(codes.Unavailable, "registry client not configured")
+		}
+		resp, err := c.nodeClient.List(creg.ListNodesRequest{SiteId: t.SiteId, NetworkId: t.NetworkId})
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to list target nodes: %v", err)
+		}
+		for _, n := range resp.Nodes {
+			ids = append(ids, n.Id)
+		}
+	}
+
+	nodes := []plan.Node{}
+	for _, id := range ids {
+		nId, err := ukama.ValidateNodeId(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid format of node id %s. Error %s", id, err.Error())
+		}
+		n := plan.Node{Id: nId.String(), Type: nId.GetNodeType()}
+		if matchesType(t.NodeTypes, n.Type) {
+			nodes = append(nodes, n)
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "no nodes match the plan target")
+	}
+	return nodes, nil
+}
+
+func matchesType(types []string, nodeType string) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		if strings.EqualFold(t, nodeType) {
+			return true
+		}
+	}
+	return false
+}
+
+func planResourceKey(t *pb.PlanTarget, planId uuid.UUID) string {
+	switch {
+	case t.SiteId != "":
+		return "site:" + t.SiteId
+	case t.NetworkId != "":
+		return "network:" + t.NetworkId
+	}
+	return "plan:" + planId.String()
+}
+
+func requesterOf(requestedBy string) string {
+	if requestedBy == "" {
+		return pkg.ServiceName
+	}
+	return requestedBy
+}
+
+func (c *ControllerServer) getPlan(planId string) (*db.CommandPlan, error) {
+	if c.pRepo == nil {
+		return nil, status.Errorf(codes.Unavailable, "command plans are not configured")
+	}
+	id, err := uuid.FromString(planId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid plan id: %v", err)
+	}
+	rec, err := c.pRepo.Get(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "command plan %s not found", planId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get command plan: %v", err)
+	}
+	return rec, nil
+}
+
+type planExecutor struct {
+	c           *ControllerServer
+	operationId string
+	requestedBy string
+}
+
+type planDispatch struct {
+	operationId string
+}
+
+func (d planDispatch) GetOperationId() string { return d.operationId }
+func (d planDispatch) GetStatus() string      { return plan.StatusRunning }
+
+func (e *planExecutor) Dispatch(ctx context.Context, step plan.Step, nodeId string) error {
+	a := actions[step.Action]
+
+	body := []byte("")
+	if step.Action != plan.ActionRestart {
+		var err error
+		if body, err = json.Marshal(map[string]string{"state": step.State}); err != nil {
+			return err
+		}
+	}
+
+	err := e.c.publishMessage(e.c.orgName+"..."+nodeId, a.method, a.path, nodeId, body)
+	e.c.audit(planCommands[step.Action], nodeId, a.method, a.path, e.requestedBy, planDispatch{operationId: e.operationId}, err)
+	return err
+}
+
+// WaitOnline polls the registry until the node reports online, starting
+// after the settle delay so a node that has not gone down yet is not taken
+// as already back.
+func (e *planExecutor) WaitOnline(ctx context.Context, nodeId string, timeout time.Duration) error {
+	if e.c.nodeClient == nil {
+		return fmt.Errorf("registry client not configured")
+	}
+
+	wctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	wait := e.c.planConf.SettleDelay
+	for {
+		select {
+		case <-wctx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("node %s not online within %s", nodeId, timeout)
+		case <-time.After(wait):
+		}
+		wait = e.c.planConf.PollInterval
+
+		n, err := e.c.nodeClient.Get(nodeId)
+		if err != nil {
+			log.Warnf("Failed to get connectivity of node %s: %v", nodeId, err)
+			continue
+		}
+		if ukama.ParseNodeConnectivity(n.Status.Connectivity) == ukama.NodeConnectivityOnline {
+			return nil
+		}
+	}
+}
+
+func planToPb(rec *db.CommandPlan) *pb.CommandPlan {
+	out := &pb.CommandPlan{
+		Id:             rec.Id.String(),
+		OperationId:    rec.OperationId,
+		ResourceKey:    rec.ResourceKey,
+		Status:         rec.Status,
+		Error:          rec.Error,
+		RequestedBy:    rec.RequestedBy,
+		AbortOnFailure: rec.AbortOnFailure,
+		Steps:          make([]*pb.PlanStep, 0, len(rec.Steps)),
+		Results:        make([]*pb.PlanNodeResult, 0, len(rec.Results)),
+	}
+	if !rec.CreatedAt.IsZero() {
+		out.CreatedAt = timestamppb.New(rec.CreatedAt)
+	}
+	if rec.FinishedAt != nil {
+		out.FinishedAt = timestamppb.New(*rec.FinishedAt)
+	}
+
+	for _, s := range rec.Steps {
+		out.Steps = append(out.Steps, &pb.PlanStep{
+			Name:               s.Name,
+			Action:             s.Action,
+			NodeTypes:          s.NodeTypes,
+			State:              s.State,
+			Parallelism:        uint32(s.Parallelism),
+			WaitOnline:         s.WaitOnline,
+			WaitTimeoutSeconds: uint32(s.WaitTimeout.Seconds()),
+		})
+	}
+	for _, r := range rec.Results {
+		res := &pb.PlanNodeResult{Step: uint32(r.Step), NodeId: r.NodeId, Status: r.Status, Error: r.Error}
+		if r.StartedAt != nil {
+			res.StartedAt = timestamppb.New(*r.StartedAt)
+		}
+		if r.FinishedAt != nil {
+			res.FinishedAt = timestamppb.New(*r.FinishedAt)
+		}
+		out.Results = append(out.Results, res)
+	}
+	return out
+}
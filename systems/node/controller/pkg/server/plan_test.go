@@ -0,0 +1,168 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	mbmocks "github.com/ukama/ukama/systems/common/mocks"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	copr "github.com/ukama/ukama/systems/common/rest/client/operation"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/node/controller/mocks"
+	pb "github.com/ukama/ukama/systems/node/controller/pb/gen"
+	"github.com/ukama/ukama/systems/node/controller/pkg"
+	"github.com/ukama/ukama/systems/node/controller/pkg/db"
+	"github.com/ukama/ukama/systems/node/controller/pkg/plan"
+)
+
+var testPlanConf = pkg.CommandPlanConfig{LeaseSecs: 60, PollInterval: time.Millisecond, SettleDelay: time.Millisecond}
+
+func TestRunCommandPlan_RebootsSiteInOrder(t *testing.T) {
+	msgclientRepo := &mbmocks.MsgBusServiceClient{}
+	planRepo := &mocks.CommandPlanRepo{}
+	nodeClient := &mbmocks.NodeClient{}
+	opMgr := &mbmocks.ManagerClient{}
+
+	siteId := uuid.NewV4().String()
+	anode := "uk-983794-anode-78-7830"
+	tnode := "uk-983794-tnode-78-7830"
+	hnode := "uk-983794-hnode-78-7830"
+
+	nodeClient.On("List", creg.ListNodesRequest{SiteId: siteId}).Return(&creg.ListNodesResponse{
+		Nodes: []*creg.NodeInfo{{Id: tnode}, {Id: hnode}, {Id: anode}},
+	}, nil).Once()
+	nodeClient.On("Get", mock.Anything).Return(&creg.NodeInfo{Status: creg.NodeStatusInfo{Connectivity: "online"}}, nil)
+
+	op := &copr.OperationInfo{Id: "op-plan", FencingToken: 3, ResourceKey: "site:" + siteId}
+	opMgr.On("Start", mock.MatchedBy(func(req copr.StartRequest) bool {
+		return req.ResourceKey == "site:"+siteId && req.Type == commandPlanAction && req.LeaseSeconds == 60
+	})).Return(&copr.StartResponse{Operation: op}, nil).Once()
+	opMgr.On("MarkRunning", "op-plan", uint64(3)).Return(&copr.OperationInfo{}, nil).Once()
+
+	done := make(chan struct{})
+	opMgr.On("Complete", "op-plan", pkg.ServiceName, mock.Anything).Return(&copr.OperationInfo{}, nil).
+		Run(func(args mock.Arguments) { close(done) }).Once()
+
+	var mu sync.Mutex
+	var order []string
+	msgclientRepo.On("PublishRequest", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, args.Get(1).(*epb.NodeFeederMessage).NodeId)
+	})
+
+	var final db.CommandPlan
+	planRepo.On("Add", mock.Anything).Return(nil).Once()
+	planRepo.On("Update", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		mu.Lock()
+		defer mu.Unlock()
+		final = *args.Get(0).(*db.CommandPlan)
+	})
+
+	s := NewControllerServer(testOrgName, &mocks.NodeLogRepo{}, nil, planRepo, msgclientRepo, nil, nil, nodeClient, opMgr, nil, 30, 60, 0, testPlanConf, pkg.IsDebugMode)
+
+	resp, err := s.RunCommandPlan(context.TODO(), &pb.RunCommandPlanRequest{
+		Target:         &pb.PlanTarget{SiteId: siteId},
+		AbortOnFailure: true,
+		RequestedBy:    "ops@example.com",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "op-plan", resp.Plan.OperationId)
+	assert.Equal(t, plan.StatusRunning, resp.Plan.Status)
+	assert.Len(t, resp.Plan.Steps, 3)
+	assert.Len(t, resp.Plan.Results, 3)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("command plan did not finish")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{anode, tnode, hnode}, order)
+	assert.Equal(t, plan.StatusSuccess, final.Status)
+	assert.NotNil(t, final.FinishedAt)
+	for _, r := range final.Results {
+		assert.Equal(t, plan.StatusSuccess, r.Status)
+	}
+	opMgr.AssertExpectations(t)
+}
+
+func TestRunCommandPlan_Validation(t *testing.T) {
+	s := NewControllerServer(testOrgName, &mocks.NodeLogRepo{}, nil, &mocks.CommandPlanRepo{}, &mbmocks.MsgBusServiceClient{}, nil, nil, nil, nil, nil, 0, 0, 0, testPlanConf, pkg.IsDebugMode)
+
+	_, err := s.RunCommandPlan(context.TODO(), &pb.RunCommandPlanRequest{
+		Target: &pb.PlanTarget{SiteId: "s", NetworkId: "n"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.RunCommandPlan(context.TODO(), &pb.RunCommandPlanRequest{
+		Target: &pb.PlanTarget{NodeIds: []string{"uk-983794-hnode-78-7830"}},
+		Steps:  []*pb.PlanStep{{Action: "RADIO", State: "maybe"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.RunCommandPlan(context.TODO(), &pb.RunCommandPlanRequest{
+		Target: &pb.PlanTarget{NodeIds: []string{"uk-983794-hnode-78-7830"}, NodeTypes: []string{"anode"}},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestCancelCommandPlan_NotRunningOnInstance(t *testing.T) {
+	planRepo := &mocks.CommandPlanRepo{}
+	opMgr := &mbmocks.ManagerClient{}
+
+	id := uuid.NewV4()
+	planRepo.On("Get", id).Return(&db.CommandPlan{
+		Id:          id,
+		OperationId: "op-plan",
+		Status:      plan.StatusRunning,
+		Results: []plan.NodeResult{
+			{NodeId: "uk-983794-anode-78-7830", Status: plan.StatusSuccess},
+			{Step: 1, NodeId: "uk-983794-tnode-78-7830", Status: plan.StatusPending},
+		},
+	}, nil).Once()
+	planRepo.On("Update", mock.Anything).Return(nil).Once()
+	opMgr.On("ForceUnlock", "op-plan", pkg.ServiceName, "cancelled by ops@example.com").Return(&copr.OperationInfo{}, nil).Once()
+
+	s := NewControllerServer(testOrgName, &mocks.NodeLogRepo{}, nil, planRepo, &mbmocks.MsgBusServiceClient{}, nil, nil, nil, opMgr, nil, 0, 0, 0, testPlanConf, pkg.IsDebugMode)
+
+	resp, err := s.CancelCommandPlan(context.TODO(), &pb.CancelCommandPlanRequest{PlanId: id.String(), RequestedBy: "ops@example.com"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, plan.StatusCancelled, resp.Plan.Status)
+	assert.Equal(t, plan.StatusSuccess, resp.Plan.Results[0].Status)
+	assert.Equal(t, plan.StatusCancelled, resp.Plan.Results[1].Status)
+	opMgr.AssertExpectations(t)
+}
+
+func TestGetCommandPlan_NotFound(t *testing.T) {
+	planRepo := &mocks.CommandPlanRepo{}
+	id := uuid.NewV4()
+	planRepo.On("Get", id).Return(nil, gorm.ErrRecordNotFound).Once()
+
+	s := NewControllerServer(testOrgName, &mocks.NodeLogRepo{}, nil, planRepo, &mbmocks.MsgBusServiceClient{}, nil, nil, nil, nil, nil, 0, 0, 0, testPlanConf, pkg.IsDebugMode)
+
+	_, err := s.GetCommandPlan(context.TODO(), &pb.GetCommandPlanRequest{PlanId: id.String()})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
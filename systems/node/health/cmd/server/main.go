@@ -9,6 +9,7 @@
 package main
 
 import (
+	"context"
 	"os"
 
 	"github.com/num30/config"
@@ -61,7 +62,7 @@ func initConfig() {
 func initDb() sql.Db {
 	log.Infof("Initializing Database")
 	d := sql.NewDb(svcConf.DB, svcConf.DebugMode)
-	err := d.Init(&db.Node{}, &db.HealthReport{}, &db.NodeLatestHealth{})
+	err := d.Init(&db.Node{}, &db.HealthReport{}, &db.NodeLatestHealth{}, &db.HealthRollup{}, &db.NodeEvent{}, &db.Alarm{})
 	if err != nil {
 		log.Fatalf("Database initialization failed. Error: %v", err)
 	}
@@ -78,7 +79,8 @@ func runGrpcServer(gormdb sql.Db) {
 	mbClient := mb.NewMsgBusClient(svcConf.MsgClient.Timeout, svcConf.OrgName, pkg.SystemName, pkg.ServiceName, instanceId, svcConf.Queue.Uri, svcConf.Service.Uri, svcConf.MsgClient.Host, svcConf.MsgClient.Exchange, svcConf.MsgClient.ListenQueue, svcConf.MsgClient.PublishQueue, svcConf.MsgClient.RetryCount, svcConf.MsgClient.ListenerRoutes)
 
 	log.Debugf("MessageBus Client is %+v", mbClient)
-	regServer := server.NewHealthServer(svcConf.OrgName, db.NewHealthRepo(gormdb), svcConf.DebugMode, mbClient)
+	regServer := server.NewHealthServer(svcConf.OrgName, db.NewHealthRepo(gormdb), db.NewRollupRepo(gormdb),
+		db.NewEventRepo(gormdb), svcConf.Retention, svcConf.Alarm, svcConf.Pushgateway, svcConf.DebugMode, mbClient)
 
 	grpcServer := ugrpc.NewGrpcServer(*svcConf.Grpc, func(s *grpc.Server) {
 		pb.RegisterHealthServiceServer(s, regServer)
@@ -91,6 +93,8 @@ func runGrpcServer(gormdb sql.Db) {
 
 	go msgBusListener(mbClient)
 
+	go regServer.RunRetention(context.Background())
+
 	waitForExit()
 }
 
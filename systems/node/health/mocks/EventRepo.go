@@ -0,0 +1,183 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	db "github.com/ukama/ukama/systems/node/health/pkg/db"
+
+	time "time"
+)
+
+// EventRepo is an autogenerated mock type for the EventRepo type
+type EventRepo struct {
+	mock.Mock
+}
+
+// ActiveAlarms provides a mock function with given fields: nodeID
+func (_m *EventRepo) ActiveAlarms(nodeID string) ([]*db.Alarm, error) {
+	ret := _m.Called(nodeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActiveAlarms")
+	}
+
+	var r0 []*db.Alarm
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*db.Alarm, error)); ok {
+		return rf(nodeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*db.Alarm); ok {
+		r0 = rf(nodeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*db.Alarm)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(nodeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddEvents provides a mock function with given fields: events
+func (_m *EventRepo) AddEvents(events []*db.NodeEvent) error {
+	ret := _m.Called(events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*db.NodeEvent) error); ok {
+		r0 = rf(events)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteEventsBefore provides a mock function with given fields: t
+func (_m *EventRepo) DeleteEventsBefore(t time.Time) (int64, error) {
+	ret := _m.Called(t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEventsBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) (int64, error)); ok {
+		return rf(t)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) int64); ok {
+		r0 = rf(t)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(t)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAlarms provides a mock function with given fields: nodeID, activeOnly
+func (_m *EventRepo) ListAlarms(nodeID string, activeOnly bool) ([]*db.Alarm, error) {
+	ret := _m.Called(nodeID, activeOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAlarms")
+	}
+
+	var r0 []*db.Alarm
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, bool) ([]*db.Alarm, error)); ok {
+		return rf(nodeID, activeOnly)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool) []*db.Alarm); ok {
+		r0 = rf(nodeID, activeOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*db.Alarm)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(nodeID, activeOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEvents provides a mock function with given fields: nodeID, eventType, from, to
+func (_m *EventRepo) ListEvents(nodeID string, eventType string, from time.Time, to time.Time) ([]*db.NodeEvent, error) {
+	ret := _m.Called(nodeID, eventType, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEvents")
+	}
+
+	var r0 []*db.NodeEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Time) ([]*db.NodeEvent, error)); ok {
+		return rf(nodeID, eventType, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Time) []*db.NodeEvent); ok {
+		r0 = rf(nodeID, eventType, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*db.NodeEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Time, time.Time) error); ok {
+		r1 = rf(nodeID, eventType, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveAlarms provides a mock function with given fields: alarms
+func (_m *EventRepo) SaveAlarms(alarms []*db.Alarm) error {
+	ret := _m.Called(alarms)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveAlarms")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*db.Alarm) error); ok {
+		r0 = rf(alarms)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewEventRepo creates a new instance of EventRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventRepo {
+	mock := &EventRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -16,6 +16,64 @@ type HealthRepo struct {
 	mock.Mock
 }
 
+// DeleteBefore provides a mock function with given fields: t
+func (_m *HealthRepo) DeleteBefore(t time.Time) (int64, error) {
+	ret := _m.Called(t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) (int64, error)); ok {
+		return rf(t)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) int64); ok {
+		r0 = rf(t)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(t)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FirstReportedAt provides a mock function with given fields: from
+func (_m *HealthRepo) FirstReportedAt(from time.Time) (*time.Time, error) {
+	ret := _m.Called(from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FirstReportedAt")
+	}
+
+	var r0 *time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) (*time.Time, error)); ok {
+		return rf(from)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) *time.Time); ok {
+		r0 = rf(from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(from)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: reportID, nodeID, reportedAt, timeframe
 func (_m *HealthRepo) List(reportID string, nodeID string, reportedAt *time.Time, timeframe ukama.FilterTimeframesType) ([]*db.HealthReport, error) {
 	ret := _m.Called(reportID, nodeID, reportedAt, timeframe)
@@ -46,6 +104,36 @@ func (_m *HealthRepo) List(reportID string, nodeID string, reportedAt *time.Time
 	return r0, r1
 }
 
+// ListRange provides a mock function with given fields: nodeID, from, to, limit
+func (_m *HealthRepo) ListRange(nodeID string, from time.Time, to time.Time, limit int) ([]*db.HealthReport, error) {
+	ret := _m.Called(nodeID, from, to, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRange")
+	}
+
+	var r0 []*db.HealthReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time, time.Time, int) ([]*db.HealthReport, error)); ok {
+		return rf(nodeID, from, to, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time, time.Time, int) []*db.HealthReport); ok {
+		r0 = rf(nodeID, from, to, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*db.HealthReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time, time.Time, int) error); ok {
+		r1 = rf(nodeID, from, to, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StoreHealthReport provides a mock function with given fields: report, receivedAt
 func (_m *HealthRepo) StoreHealthReport(report *db.HealthReport, receivedAt time.Time) error {
 	ret := _m.Called(report, receivedAt)
@@ -0,0 +1,165 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	db "github.com/ukama/ukama/systems/node/health/pkg/db"
+
+	time "time"
+)
+
+// RollupRepo is an autogenerated mock type for the RollupRepo type
+type RollupRepo struct {
+	mock.Mock
+}
+
+// DeleteBefore provides a mock function with given fields: resolution, t
+func (_m *RollupRepo) DeleteBefore(resolution string, t time.Time) (int64, error) {
+	ret := _m.Called(resolution, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (int64, error)); ok {
+		return rf(resolution, t)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) int64); ok {
+		r0 = rf(resolution, t)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(resolution, t)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FirstBucket provides a mock function with given fields: resolution, from
+func (_m *RollupRepo) FirstBucket(resolution string, from time.Time) (*time.Time, error) {
+	ret := _m.Called(resolution, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FirstBucket")
+	}
+
+	var r0 *time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (*time.Time, error)); ok {
+		return rf(resolution, from)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) *time.Time); ok {
+		r0 = rf(resolution, from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(resolution, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LatestBucket provides a mock function with given fields: resolution
+func (_m *RollupRepo) LatestBucket(resolution string) (*time.Time, error) {
+	ret := _m.Called(resolution)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestBucket")
+	}
+
+	var r0 *time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*time.Time, error)); ok {
+		return rf(resolution)
+	}
+	if rf, ok := ret.Get(0).(func(string) *time.Time); ok {
+		r0 = rf(resolution)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(resolution)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: nodeID, resolution, from, to
+func (_m *RollupRepo) List(nodeID string, resolution string, from time.Time, to time.Time) ([]*db.HealthRollup, error) {
+	ret := _m.Called(nodeID, resolution, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*db.HealthRollup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Time) ([]*db.HealthRollup, error)); ok {
+		return rf(nodeID, resolution, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Time) []*db.HealthRollup); ok {
+		r0 = rf(nodeID, resolution, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*db.HealthRollup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Time, time.Time) error); ok {
+		r1 = rf(nodeID, resolution, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: rollups
+func (_m *RollupRepo) Upsert(rollups []*db.HealthRollup) error {
+	ret := _m.Called(rollups)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*db.HealthRollup) error); ok {
+		r0 = rf(rollups)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRollupRepo creates a new instance of RollupRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRollupRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RollupRepo {
+	mock := &RollupRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -7,7 +7,7 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
+// 	protoc-gen-go v1.36.12
 // 	protoc        v6.32.1
 // source: health.proto
 
@@ -21,6 +21,7 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -31,22 +32,19 @@ const (
 )
 
 type ListAppsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      string                 `protobuf:"bytes,1,opt,name=reportId,proto3" json:"reportId,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	AppName       string                 `protobuf:"bytes,3,opt,name=appName,proto3" json:"appName,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	ReportId string `protobuf:"bytes,1,opt,name=reportId,proto3" json:"reportId,omitempty"`
-	NodeId   string `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	AppName  string `protobuf:"bytes,3,opt,name=appName,proto3" json:"appName,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListAppsRequest) Reset() {
 	*x = ListAppsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ListAppsRequest) String() string {
@@ -57,7 +55,7 @@ func (*ListAppsRequest) ProtoMessage() {}
 
 func (x *ListAppsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_health_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -94,20 +92,17 @@ func (x *ListAppsRequest) GetAppName() string {
 }
 
 type ListAppsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Apps          []*App                 `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Apps []*App `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListAppsResponse) Reset() {
 	*x = ListAppsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ListAppsResponse) String() string {
@@ -118,7 +113,7 @@ func (*ListAppsResponse) ProtoMessage() {}
 
 func (x *ListAppsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_health_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -141,22 +136,19 @@ func (x *ListAppsResponse) GetApps() []*App {
 }
 
 type ListInterfacesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      string                 `protobuf:"bytes,1,opt,name=reportId,proto3" json:"reportId,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	InterfaceName string                 `protobuf:"bytes,3,opt,name=interfaceName,proto3" json:"interfaceName,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	ReportId      string `protobuf:"bytes,1,opt,name=reportId,proto3" json:"reportId,omitempty"`
-	NodeId        string `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	InterfaceName string `protobuf:"bytes,3,opt,name=interfaceName,proto3" json:"interfaceName,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListInterfacesRequest) Reset() {
 	*x = ListInterfacesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ListInterfacesRequest) String() string {
@@ -167,7 +159,7 @@ func (*ListInterfacesRequest) ProtoMessage() {}
 
 func (x *ListInterfacesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_health_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -204,20 +196,17 @@ func (x *ListInterfacesRequest) GetInterfaceName() string {
 }
 
 type ListInterfacesResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interfaces    *Interface             `protobuf:"bytes,1,opt,name=interfaces,proto3" json:"interfaces,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Interfaces *Interface `protobuf:"bytes,1,opt,name=interfaces,proto3" json:"interfaces,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListInterfacesResponse) Reset() {
 	*x = ListInterfacesResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ListInterfacesResponse) String() string {
@@ -228,7 +217,7 @@ func (*ListInterfacesResponse) ProtoMessage() {}
 
 func (x *ListInterfacesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_health_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -251,23 +240,26 @@ func (x *ListInterfacesResponse) GetInterfaces() *Interface {
 }
 
 type ListReportsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+	state      protoimpl.MessageState     `protogen:"open.v1"`
 	ReportId   string                     `protobuf:"bytes,1,opt,name=reportId,proto3" json:"reportId,omitempty"`
 	NodeId     string                     `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
 	ReportedAt int64                      `protobuf:"varint,3,opt,name=reportedAt,proto3" json:"reportedAt,omitempty"`
 	Timeframe  ukama.FilterTimeframesType `protobuf:"varint,4,opt,name=timeframe,proto3,enum=ukama.common.v1.FilterTimeframesType" json:"timeframe,omitempty"`
+	// Unix seconds, reports with from <= reportedAt < to. Needs nodeId
+	From  int64  `protobuf:"varint,5,opt,name=from,proto3" json:"from,omitempty"`
+	To    int64  `protobuf:"varint,6,opt,name=to,proto3" json:"to,omitempty"`
+	Limit uint32 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Dotted payload paths to return, e.g. interfaces.controller.battery. Whole payload when empty
+	Fields        []string `protobuf:"bytes,8,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListReportsRequest) Reset() {
 	*x = ListReportsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ListReportsRequest) String() string {
@@ -278,7 +270,7 @@ func (*ListReportsRequest) ProtoMessage() {}
 
 func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_health_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -321,21 +313,46 @@ func (x *ListReportsRequest) GetTimeframe() ukama.FilterTimeframesType {
 	return ukama.FilterTimeframesType(0)
 }
 
+func (x *ListReportsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ListReportsRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *ListReportsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListReportsRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 type ListReportsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*HealthReport        `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Reports []*HealthReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListReportsResponse) Reset() {
 	*x = ListReportsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ListReportsResponse) String() string {
@@ -346,7 +363,7 @@ func (*ListReportsResponse) ProtoMessage() {}
 
 func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_health_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -369,21 +386,18 @@ func (x *ListReportsResponse) GetReports() []*HealthReport {
 }
 
 type StoreHealthReportRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId  string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StoreHealthReportRequest) Reset() {
 	*x = StoreHealthReportRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *StoreHealthReportRequest) String() string {
@@ -394,7 +408,7 @@ func (*StoreHealthReportRequest) ProtoMessage() {}
 
 func (x *StoreHealthReportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_health_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -409,72 +423,826 @@ func (*StoreHealthReportRequest) Descriptor() ([]byte, []int) {
 	return file_health_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *StoreHealthReportRequest) GetNodeId() string {
+func (x *StoreHealthReportRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *StoreHealthReportRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type StoreHealthReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      string                 `protobuf:"bytes,1,opt,name=reportId,proto3" json:"reportId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreHealthReportResponse) Reset() {
+	*x = StoreHealthReportResponse{}
+	mi := &file_health_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreHealthReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreHealthReportResponse) ProtoMessage() {}
+
+func (x *StoreHealthReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_health_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreHealthReportResponse.ProtoReflect.Descriptor instead.
+func (*StoreHealthReportResponse) Descriptor() ([]byte, []int) {
+	return file_health_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StoreHealthReportResponse) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+type QueryMetricsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NodeId string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	// Unix seconds, defaults to the last day
+	From int64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	// raw, hour or day. Picked from the retention tiers covering from when empty
+	Resolution string `protobuf:"bytes,4,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	// Metric fields, e.g. controller.battery.socPct. All fields when empty
+	Fields        []string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryMetricsRequest) Reset() {
+	*x = QueryMetricsRequest{}
+	mi := &file_health_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryMetricsRequest) ProtoMessage() {}
+
+func (x *QueryMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_health_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryMetricsRequest.ProtoReflect.Descriptor instead.
+func (*QueryMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_health_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryMetricsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *QueryMetricsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *QueryMetricsRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *QueryMetricsRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *QueryMetricsRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type QueryMetricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	Resolution    string                 `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	Points        []*MetricPoint         `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryMetricsResponse) Reset() {
+	*x = QueryMetricsResponse{}
+	mi := &file_health_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryMetricsResponse) ProtoMessage() {}
+
+func (x *QueryMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_health_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryMetricsResponse.ProtoReflect.Descriptor instead.
+func (*QueryMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_health_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryMetricsResponse) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *QueryMetricsResponse) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *QueryMetricsResponse) GetPoints() []*MetricPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type MetricPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Samples       uint32                 `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"`
+	Values        map[string]*MetricStat `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetricPoint) Reset() {
+	*x = MetricPoint{}
+	mi := &file_health_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricPoint) ProtoMessage() {}
+
+func (x *MetricPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_health_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricPoint.ProtoReflect.Descriptor instead.
+func (*MetricPoint) Descriptor() ([]byte, []int) {
+	return file_health_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MetricPoint) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *MetricPoint) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *MetricPoint) GetValues() map[string]*MetricStat {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type MetricStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Avg           float64                `protobuf:"fixed64,1,opt,name=avg,proto3" json:"avg,omitempty"`
+	Min           float64                `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	Count         uint32                 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetricStat) Reset() {
+	*x = MetricStat{}
+	mi := &file_health_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricStat) ProtoMessage() {}
+
+func (x *MetricStat) ProtoReflect() protoreflect.Message {
+	mi := &file_health_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricStat.ProtoReflect.Descriptor instead.
+func (*MetricStat) Descriptor() ([]byte, []int) {
+	return file_health_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MetricStat) GetAvg() float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+func (x *MetricStat) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *MetricStat) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *MetricStat) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All nodes when empty
+	NodeId string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Unix seconds, defaults to the last day
+	From int64 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64 `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	// Events at least this severe: info, warning, major or critical
+	MinSeverity   string `protobuf:"bytes,5,opt,name=minSeverity,proto3" json:"minSeverity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_health_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_health_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_health_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListEventsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetMinSeverity() string {
+	if x != nil {
+		return x.MinSeverity
+	}
+	return ""
+}
+
+type ListEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*NodeEvent           `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_health_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_health_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_health_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListEventsResponse) GetEvents() []*NodeEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type NodeEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	NodeType      string                 `protobuf:"bytes,3,opt,name=nodeType,proto3" json:"nodeType,omitempty"`
+	ReportId      string                 `protobuf:"bytes,4,opt,name=reportId,proto3" json:"reportId,omitempty"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Severity      string                 `protobuf:"bytes,6,opt,name=severity,proto3" json:"severity,omitempty"`
+	Source        string                 `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+	Value         float64                `protobuf:"fixed64,8,opt,name=value,proto3" json:"value,omitempty"`
+	Message       string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	Cleared       bool                   `protobuf:"varint,10,opt,name=cleared,proto3" json:"cleared,omitempty"`
+	OccurredAt    int64                  `protobuf:"varint,11,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeEvent) Reset() {
+	*x = NodeEvent{}
+	mi := &file_health_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeEvent) ProtoMessage() {}
+
+func (x *NodeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_health_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeEvent.ProtoReflect.Descriptor instead.
+func (*NodeEvent) Descriptor() ([]byte, []int) {
+	return file_health_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NodeEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetNodeType() string {
+	if x != nil {
+		return x.NodeType
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *NodeEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetCleared() bool {
+	if x != nil {
+		return x.Cleared
+	}
+	return false
+}
+
+func (x *NodeEvent) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+type ListAlarmsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All nodes when empty
+	NodeId string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	// Only raised alarms, else raised and cleared ones
+	ActiveOnly    bool `protobuf:"varint,2,opt,name=activeOnly,proto3" json:"activeOnly,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlarmsRequest) Reset() {
+	*x = ListAlarmsRequest{}
+	mi := &file_health_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlarmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlarmsRequest) ProtoMessage() {}
+
+func (x *ListAlarmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_health_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlarmsRequest.ProtoReflect.Descriptor instead.
+func (*ListAlarmsRequest) Descriptor() ([]byte, []int) {
+	return file_health_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListAlarmsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ListAlarmsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListAlarmsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alarms        []*Alarm               `protobuf:"bytes,1,rep,name=alarms,proto3" json:"alarms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlarmsResponse) Reset() {
+	*x = ListAlarmsResponse{}
+	mi := &file_health_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlarmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlarmsResponse) ProtoMessage() {}
+
+func (x *ListAlarmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_health_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlarmsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlarmsResponse) Descriptor() ([]byte, []int) {
+	return file_health_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListAlarmsResponse) GetAlarms() []*Alarm {
+	if x != nil {
+		return x.Alarms
+	}
+	return nil
+}
+
+type Alarm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	NodeType      string                 `protobuf:"bytes,3,opt,name=nodeType,proto3" json:"nodeType,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	Severity      string                 `protobuf:"bytes,6,opt,name=severity,proto3" json:"severity,omitempty"`
+	State         string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	Count         uint32                 `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
+	Value         float64                `protobuf:"fixed64,9,opt,name=value,proto3" json:"value,omitempty"`
+	Message       string                 `protobuf:"bytes,10,opt,name=message,proto3" json:"message,omitempty"`
+	FirstSeenAt   int64                  `protobuf:"varint,11,opt,name=firstSeenAt,proto3" json:"firstSeenAt,omitempty"`
+	LastSeenAt    int64                  `protobuf:"varint,12,opt,name=lastSeenAt,proto3" json:"lastSeenAt,omitempty"`
+	RaisedAt      int64                  `protobuf:"varint,13,opt,name=raisedAt,proto3" json:"raisedAt,omitempty"`
+	ClearedAt     int64                  `protobuf:"varint,14,opt,name=clearedAt,proto3" json:"clearedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alarm) Reset() {
+	*x = Alarm{}
+	mi := &file_health_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alarm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alarm) ProtoMessage() {}
+
+func (x *Alarm) ProtoReflect() protoreflect.Message {
+	mi := &file_health_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alarm.ProtoReflect.Descriptor instead.
+func (*Alarm) Descriptor() ([]byte, []int) {
+	return file_health_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Alarm) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Alarm) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *Alarm) GetNodeType() string {
+	if x != nil {
+		return x.NodeType
+	}
+	return ""
+}
+
+func (x *Alarm) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Alarm) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Alarm) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Alarm) GetState() string {
 	if x != nil {
-		return x.NodeId
+		return x.State
 	}
 	return ""
 }
 
-func (x *StoreHealthReportRequest) GetPayload() []byte {
+func (x *Alarm) GetCount() uint32 {
 	if x != nil {
-		return x.Payload
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type StoreHealthReportResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ReportId string `protobuf:"bytes,1,opt,name=reportId,proto3" json:"reportId,omitempty"`
+func (x *Alarm) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
 }
 
-func (x *StoreHealthReportResponse) Reset() {
-	*x = StoreHealthReportResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Alarm) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
+	return ""
 }
 
-func (x *StoreHealthReportResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Alarm) GetFirstSeenAt() int64 {
+	if x != nil {
+		return x.FirstSeenAt
+	}
+	return 0
 }
 
-func (*StoreHealthReportResponse) ProtoMessage() {}
-
-func (x *StoreHealthReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Alarm) GetLastSeenAt() int64 {
+	if x != nil {
+		return x.LastSeenAt
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use StoreHealthReportResponse.ProtoReflect.Descriptor instead.
-func (*StoreHealthReportResponse) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{7}
+func (x *Alarm) GetRaisedAt() int64 {
+	if x != nil {
+		return x.RaisedAt
+	}
+	return 0
 }
 
-func (x *StoreHealthReportResponse) GetReportId() string {
+func (x *Alarm) GetClearedAt() int64 {
 	if x != nil {
-		return x.ReportId
+		return x.ClearedAt
 	}
-	return ""
+	return 0
 }
 
 type HealthReport struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	NodeId        string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
 	NodeType      string                 `protobuf:"bytes,3,opt,name=nodeType,proto3" json:"nodeType,omitempty"`
@@ -482,15 +1250,15 @@ type HealthReport struct {
 	ReportedAt    int64                  `protobuf:"varint,5,opt,name=reportedAt,proto3" json:"reportedAt,omitempty"`
 	ReceivedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=receivedAt,proto3" json:"receivedAt,omitempty"`
 	Payload       []byte                 `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HealthReport) Reset() {
 	*x = HealthReport{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *HealthReport) String() string {
@@ -500,8 +1268,8 @@ func (x *HealthReport) String() string {
 func (*HealthReport) ProtoMessage() {}
 
 func (x *HealthReport) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[18]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -513,7 +1281,7 @@ func (x *HealthReport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthReport.ProtoReflect.Descriptor instead.
 func (*HealthReport) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{8}
+	return file_health_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *HealthReport) GetId() string {
@@ -566,24 +1334,21 @@ func (x *HealthReport) GetPayload() []byte {
 }
 
 type App struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Tag           string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Resource      *AppResource           `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Name     string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version  string       `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Tag      string       `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
-	Status   string       `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	Resource *AppResource `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *App) Reset() {
 	*x = App{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *App) String() string {
@@ -593,8 +1358,8 @@ func (x *App) String() string {
 func (*App) ProtoMessage() {}
 
 func (x *App) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[19]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -606,7 +1371,7 @@ func (x *App) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use App.ProtoReflect.Descriptor instead.
 func (*App) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{9}
+	return file_health_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *App) GetName() string {
@@ -645,23 +1410,20 @@ func (x *App) GetResource() *AppResource {
 }
 
 type AppResource struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CpuPercent     float32 `protobuf:"fixed32,1,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
-	MemoryRssKb    float32 `protobuf:"fixed32,2,opt,name=memoryRssKb,proto3" json:"memoryRssKb,omitempty"`
-	DiskReadBytes  float32 `protobuf:"fixed32,3,opt,name=diskReadBytes,proto3" json:"diskReadBytes,omitempty"`
-	DiskWriteBytes float32 `protobuf:"fixed32,4,opt,name=diskWriteBytes,proto3" json:"diskWriteBytes,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CpuPercent     float32                `protobuf:"fixed32,1,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
+	MemoryRssKb    float32                `protobuf:"fixed32,2,opt,name=memoryRssKb,proto3" json:"memoryRssKb,omitempty"`
+	DiskReadBytes  float32                `protobuf:"fixed32,3,opt,name=diskReadBytes,proto3" json:"diskReadBytes,omitempty"`
+	DiskWriteBytes float32                `protobuf:"fixed32,4,opt,name=diskWriteBytes,proto3" json:"diskWriteBytes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *AppResource) Reset() {
 	*x = AppResource{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *AppResource) String() string {
@@ -671,8 +1433,8 @@ func (x *AppResource) String() string {
 func (*AppResource) ProtoMessage() {}
 
 func (x *AppResource) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[20]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -684,7 +1446,7 @@ func (x *AppResource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppResource.ProtoReflect.Descriptor instead.
 func (*AppResource) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{10}
+	return file_health_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *AppResource) GetCpuPercent() float32 {
@@ -716,24 +1478,21 @@ func (x *AppResource) GetDiskWriteBytes() float32 {
 }
 
 type SwitchPolicy struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Hash          string                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Ports         []*SwitchPort          `protobuf:"bytes,5,rep,name=ports,proto3" json:"ports,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	State  string        `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	Hash   string        `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	Source string        `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Error  string        `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	Ports  []*SwitchPort `protobuf:"bytes,5,rep,name=ports,proto3" json:"ports,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SwitchPolicy) Reset() {
 	*x = SwitchPolicy{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SwitchPolicy) String() string {
@@ -743,8 +1502,8 @@ func (x *SwitchPolicy) String() string {
 func (*SwitchPolicy) ProtoMessage() {}
 
 func (x *SwitchPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[21]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -756,7 +1515,7 @@ func (x *SwitchPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwitchPolicy.ProtoReflect.Descriptor instead.
 func (*SwitchPolicy) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{11}
+	return file_health_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SwitchPolicy) GetState() string {
@@ -795,29 +1554,26 @@ func (x *SwitchPolicy) GetPorts() []*SwitchPort {
 }
 
 type SwitchPort struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id             int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name           string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Present        bool    `protobuf:"varint,3,opt,name=present,proto3" json:"present,omitempty"`
-	AdminState     string  `protobuf:"bytes,4,opt,name=adminState,proto3" json:"adminState,omitempty"`
-	LinkState      string  `protobuf:"bytes,5,opt,name=linkState,proto3" json:"linkState,omitempty"`
-	PoeState       string  `protobuf:"bytes,6,opt,name=poeState,proto3" json:"poeState,omitempty"`
-	PoeOperational bool    `protobuf:"varint,7,opt,name=poeOperational,proto3" json:"poeOperational,omitempty"`
-	SpeedBps       int64   `protobuf:"varint,8,opt,name=speedBps,proto3" json:"speedBps,omitempty"`
-	PowerWatts     float64 `protobuf:"fixed64,9,opt,name=powerWatts,proto3" json:"powerWatts,omitempty"`
-	Fault          string  `protobuf:"bytes,10,opt,name=fault,proto3" json:"fault,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Present        bool                   `protobuf:"varint,3,opt,name=present,proto3" json:"present,omitempty"`
+	AdminState     string                 `protobuf:"bytes,4,opt,name=adminState,proto3" json:"adminState,omitempty"`
+	LinkState      string                 `protobuf:"bytes,5,opt,name=linkState,proto3" json:"linkState,omitempty"`
+	PoeState       string                 `protobuf:"bytes,6,opt,name=poeState,proto3" json:"poeState,omitempty"`
+	PoeOperational bool                   `protobuf:"varint,7,opt,name=poeOperational,proto3" json:"poeOperational,omitempty"`
+	SpeedBps       int64                  `protobuf:"varint,8,opt,name=speedBps,proto3" json:"speedBps,omitempty"`
+	PowerWatts     float64                `protobuf:"fixed64,9,opt,name=powerWatts,proto3" json:"powerWatts,omitempty"`
+	Fault          string                 `protobuf:"bytes,10,opt,name=fault,proto3" json:"fault,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SwitchPort) Reset() {
 	*x = SwitchPort{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SwitchPort) String() string {
@@ -827,8 +1583,8 @@ func (x *SwitchPort) String() string {
 func (*SwitchPort) ProtoMessage() {}
 
 func (x *SwitchPort) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[22]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -840,7 +1596,7 @@ func (x *SwitchPort) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwitchPort.ProtoReflect.Descriptor instead.
 func (*SwitchPort) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{12}
+	return file_health_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SwitchPort) GetId() int64 {
@@ -914,26 +1670,23 @@ func (x *SwitchPort) GetFault() string {
 }
 
 type Interface struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Cellular      *CellularInterface       `protobuf:"bytes,1,opt,name=cellular,proto3" json:"cellular,omitempty"`
+	Radio         *RadioInterface          `protobuf:"bytes,2,opt,name=radio,proto3" json:"radio,omitempty"`
+	Gps           *GPSInterface            `protobuf:"bytes,3,opt,name=gps,proto3" json:"gps,omitempty"`
+	Backhaul      *BackhaulInterface       `protobuf:"bytes,4,opt,name=backhaul,proto3" json:"backhaul,omitempty"`
+	Fem           *FEMInterface            `protobuf:"bytes,5,opt,name=fem,proto3" json:"fem,omitempty"`
+	Switch        *SwitchInterface         `protobuf:"bytes,6,opt,name=switch,proto3" json:"switch,omitempty"`
+	Controller    *NodeControllerInterface `protobuf:"bytes,7,opt,name=controller,proto3" json:"controller,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Cellular   *CellularInterface       `protobuf:"bytes,1,opt,name=cellular,proto3" json:"cellular,omitempty"`
-	Radio      *RadioInterface          `protobuf:"bytes,2,opt,name=radio,proto3" json:"radio,omitempty"`
-	Gps        *GPSInterface            `protobuf:"bytes,3,opt,name=gps,proto3" json:"gps,omitempty"`
-	Backhaul   *BackhaulInterface       `protobuf:"bytes,4,opt,name=backhaul,proto3" json:"backhaul,omitempty"`
-	Fem        *FEMInterface            `protobuf:"bytes,5,opt,name=fem,proto3" json:"fem,omitempty"`
-	Switch     *SwitchInterface         `protobuf:"bytes,6,opt,name=switch,proto3" json:"switch,omitempty"`
-	Controller *NodeControllerInterface `protobuf:"bytes,7,opt,name=controller,proto3" json:"controller,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Interface) Reset() {
 	*x = Interface{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Interface) String() string {
@@ -943,8 +1696,8 @@ func (x *Interface) String() string {
 func (*Interface) ProtoMessage() {}
 
 func (x *Interface) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[23]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -956,7 +1709,7 @@ func (x *Interface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Interface.ProtoReflect.Descriptor instead.
 func (*Interface) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{13}
+	return file_health_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Interface) GetCellular() *CellularInterface {
@@ -1009,21 +1762,18 @@ func (x *Interface) GetController() *NodeControllerInterface {
 }
 
 type CellularInterface struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Available     bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Available bool   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
-	Error     string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CellularInterface) Reset() {
 	*x = CellularInterface{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CellularInterface) String() string {
@@ -1033,8 +1783,8 @@ func (x *CellularInterface) String() string {
 func (*CellularInterface) ProtoMessage() {}
 
 func (x *CellularInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[24]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1046,7 +1796,7 @@ func (x *CellularInterface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CellularInterface.ProtoReflect.Descriptor instead.
 func (*CellularInterface) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{14}
+	return file_health_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CellularInterface) GetAvailable() bool {
@@ -1064,21 +1814,18 @@ func (x *CellularInterface) GetError() string {
 }
 
 type RadioInterface struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Available     bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Available bool   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
-	State     string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RadioInterface) Reset() {
 	*x = RadioInterface{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RadioInterface) String() string {
@@ -1088,8 +1835,8 @@ func (x *RadioInterface) String() string {
 func (*RadioInterface) ProtoMessage() {}
 
 func (x *RadioInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[25]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1101,7 +1848,7 @@ func (x *RadioInterface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RadioInterface.ProtoReflect.Descriptor instead.
 func (*RadioInterface) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{15}
+	return file_health_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RadioInterface) GetAvailable() bool {
@@ -1119,23 +1866,20 @@ func (x *RadioInterface) GetState() string {
 }
 
 type GPSInterface struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Available     bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	Lock          bool                   `protobuf:"varint,2,opt,name=lock,proto3" json:"lock,omitempty"`
+	Coordinates   string                 `protobuf:"bytes,3,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	Time          string                 `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Available   bool   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
-	Lock        bool   `protobuf:"varint,2,opt,name=lock,proto3" json:"lock,omitempty"`
-	Coordinates string `protobuf:"bytes,3,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
-	Time        string `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GPSInterface) Reset() {
 	*x = GPSInterface{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GPSInterface) String() string {
@@ -1145,8 +1889,8 @@ func (x *GPSInterface) String() string {
 func (*GPSInterface) ProtoMessage() {}
 
 func (x *GPSInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[26]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1158,7 +1902,7 @@ func (x *GPSInterface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GPSInterface.ProtoReflect.Descriptor instead.
 func (*GPSInterface) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{16}
+	return file_health_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GPSInterface) GetAvailable() bool {
@@ -1190,23 +1934,20 @@ func (x *GPSInterface) GetTime() string {
 }
 
 type BackhaulInterface struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Available     bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	LinkGuess     string                 `protobuf:"bytes,3,opt,name=linkGuess,proto3" json:"linkGuess,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Available  bool    `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
-	State      string  `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	LinkGuess  string  `protobuf:"bytes,3,opt,name=linkGuess,proto3" json:"linkGuess,omitempty"`
-	Confidence float64 `protobuf:"fixed64,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BackhaulInterface) Reset() {
 	*x = BackhaulInterface{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BackhaulInterface) String() string {
@@ -1216,8 +1957,8 @@ func (x *BackhaulInterface) String() string {
 func (*BackhaulInterface) ProtoMessage() {}
 
 func (x *BackhaulInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[27]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1229,7 +1970,7 @@ func (x *BackhaulInterface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BackhaulInterface.ProtoReflect.Descriptor instead.
 func (*BackhaulInterface) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{17}
+	return file_health_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *BackhaulInterface) GetAvailable() bool {
@@ -1261,21 +2002,18 @@ func (x *BackhaulInterface) GetConfidence() float64 {
 }
 
 type FEMInterface struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Available     bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	Fems          []*FEMUnit             `protobuf:"bytes,2,rep,name=fems,proto3" json:"fems,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Available bool       `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
-	Fems      []*FEMUnit `protobuf:"bytes,2,rep,name=fems,proto3" json:"fems,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FEMInterface) Reset() {
 	*x = FEMInterface{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *FEMInterface) String() string {
@@ -1285,8 +2023,8 @@ func (x *FEMInterface) String() string {
 func (*FEMInterface) ProtoMessage() {}
 
 func (x *FEMInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[28]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1298,7 +2036,7 @@ func (x *FEMInterface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FEMInterface.ProtoReflect.Descriptor instead.
 func (*FEMInterface) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{18}
+	return file_health_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *FEMInterface) GetAvailable() bool {
@@ -1316,22 +2054,19 @@ func (x *FEMInterface) GetFems() []*FEMUnit {
 }
 
 type FEMUnit struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unit          int32                  `protobuf:"varint,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	Present       bool                   `protobuf:"varint,2,opt,name=present,proto3" json:"present,omitempty"`
+	Gpio          *FEMGPIO               `protobuf:"bytes,3,opt,name=gpio,proto3" json:"gpio,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Unit    int32    `protobuf:"varint,1,opt,name=unit,proto3" json:"unit,omitempty"`
-	Present bool     `protobuf:"varint,2,opt,name=present,proto3" json:"present,omitempty"`
-	Gpio    *FEMGPIO `protobuf:"bytes,3,opt,name=gpio,proto3" json:"gpio,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FEMUnit) Reset() {
 	*x = FEMUnit{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *FEMUnit) String() string {
@@ -1341,8 +2076,8 @@ func (x *FEMUnit) String() string {
 func (*FEMUnit) ProtoMessage() {}
 
 func (x *FEMUnit) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[29]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1354,7 +2089,7 @@ func (x *FEMUnit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FEMUnit.ProtoReflect.Descriptor instead.
 func (*FEMUnit) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{19}
+	return file_health_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *FEMUnit) GetUnit() int32 {
@@ -1379,25 +2114,22 @@ func (x *FEMUnit) GetGpio() *FEMGPIO {
 }
 
 type FEMGPIO struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TxRfEnable    bool                   `protobuf:"varint,1,opt,name=txRfEnable,proto3" json:"txRfEnable,omitempty"`
+	RxRfEnable    bool                   `protobuf:"varint,2,opt,name=rxRfEnable,proto3" json:"rxRfEnable,omitempty"`
+	PaVdsEnable   bool                   `protobuf:"varint,3,opt,name=paVdsEnable,proto3" json:"paVdsEnable,omitempty"`
+	RfPalEnable   bool                   `protobuf:"varint,4,opt,name=rfPalEnable,proto3" json:"rfPalEnable,omitempty"`
+	Vds28VEnable  bool                   `protobuf:"varint,5,opt,name=vds28vEnable,proto3" json:"vds28vEnable,omitempty"`
+	PsuPgood      bool                   `protobuf:"varint,6,opt,name=psuPgood,proto3" json:"psuPgood,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	TxRfEnable   bool `protobuf:"varint,1,opt,name=txRfEnable,proto3" json:"txRfEnable,omitempty"`
-	RxRfEnable   bool `protobuf:"varint,2,opt,name=rxRfEnable,proto3" json:"rxRfEnable,omitempty"`
-	PaVdsEnable  bool `protobuf:"varint,3,opt,name=paVdsEnable,proto3" json:"paVdsEnable,omitempty"`
-	RfPalEnable  bool `protobuf:"varint,4,opt,name=rfPalEnable,proto3" json:"rfPalEnable,omitempty"`
-	Vds28VEnable bool `protobuf:"varint,5,opt,name=vds28vEnable,proto3" json:"vds28vEnable,omitempty"`
-	PsuPgood     bool `protobuf:"varint,6,opt,name=psuPgood,proto3" json:"psuPgood,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FEMGPIO) Reset() {
 	*x = FEMGPIO{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *FEMGPIO) String() string {
@@ -1407,8 +2139,8 @@ func (x *FEMGPIO) String() string {
 func (*FEMGPIO) ProtoMessage() {}
 
 func (x *FEMGPIO) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[30]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1420,7 +2152,7 @@ func (x *FEMGPIO) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FEMGPIO.ProtoReflect.Descriptor instead.
 func (*FEMGPIO) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{20}
+	return file_health_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *FEMGPIO) GetTxRfEnable() bool {
@@ -1466,10 +2198,7 @@ func (x *FEMGPIO) GetPsuPgood() bool {
 }
 
 type SwitchInterface struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+	state           protoimpl.MessageState `protogen:"open.v1"`
 	Available       bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
 	Reachable       bool                   `protobuf:"varint,2,opt,name=reachable,proto3" json:"reachable,omitempty"`
 	State           string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
@@ -1478,15 +2207,15 @@ type SwitchInterface struct {
 	PortCount       int32                  `protobuf:"varint,6,opt,name=portCount,proto3" json:"portCount,omitempty"`
 	Policy          *SwitchInterfacePolicy `protobuf:"bytes,7,opt,name=policy,proto3" json:"policy,omitempty"`
 	Ports           []*SwitchPort          `protobuf:"bytes,8,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *SwitchInterface) Reset() {
 	*x = SwitchInterface{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SwitchInterface) String() string {
@@ -1496,8 +2225,8 @@ func (x *SwitchInterface) String() string {
 func (*SwitchInterface) ProtoMessage() {}
 
 func (x *SwitchInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[31]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1509,7 +2238,7 @@ func (x *SwitchInterface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwitchInterface.ProtoReflect.Descriptor instead.
 func (*SwitchInterface) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{21}
+	return file_health_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SwitchInterface) GetAvailable() bool {
@@ -1569,23 +2298,20 @@ func (x *SwitchInterface) GetPorts() []*SwitchPort {
 }
 
 type SwitchInterfacePolicy struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Hash          string                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	State  string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	Hash   string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Error  string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SwitchInterfacePolicy) Reset() {
 	*x = SwitchInterfacePolicy{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SwitchInterfacePolicy) String() string {
@@ -1595,8 +2321,8 @@ func (x *SwitchInterfacePolicy) String() string {
 func (*SwitchInterfacePolicy) ProtoMessage() {}
 
 func (x *SwitchInterfacePolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[32]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1608,7 +2334,7 @@ func (x *SwitchInterfacePolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwitchInterfacePolicy.ProtoReflect.Descriptor instead.
 func (*SwitchInterfacePolicy) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{22}
+	return file_health_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SwitchInterfacePolicy) GetState() string {
@@ -1640,10 +2366,7 @@ func (x *SwitchInterfacePolicy) GetError() string {
 }
 
 type NodeControllerInterface struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+	state            protoimpl.MessageState    `protogen:"open.v1"`
 	Available        bool                      `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
 	CommOk           bool                      `protobuf:"varint,2,opt,name=commOk,proto3" json:"commOk,omitempty"`
 	ChargeState      string                    `protobuf:"bytes,3,opt,name=chargeState,proto3" json:"chargeState,omitempty"`
@@ -1653,15 +2376,15 @@ type NodeControllerInterface struct {
 	Solar            *ControllerSolarMetrics   `protobuf:"bytes,7,opt,name=solar,proto3" json:"solar,omitempty"`
 	Battery          *ControllerBatteryMetrics `protobuf:"bytes,8,opt,name=battery,proto3" json:"battery,omitempty"`
 	Load             *ControllerLoadMetrics    `protobuf:"bytes,9,opt,name=load,proto3" json:"load,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *NodeControllerInterface) Reset() {
 	*x = NodeControllerInterface{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *NodeControllerInterface) String() string {
@@ -1671,8 +2394,8 @@ func (x *NodeControllerInterface) String() string {
 func (*NodeControllerInterface) ProtoMessage() {}
 
 func (x *NodeControllerInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[33]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1684,7 +2407,7 @@ func (x *NodeControllerInterface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeControllerInterface.ProtoReflect.Descriptor instead.
 func (*NodeControllerInterface) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{23}
+	return file_health_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *NodeControllerInterface) GetAvailable() bool {
@@ -1751,22 +2474,19 @@ func (x *NodeControllerInterface) GetLoad() *ControllerLoadMetrics {
 }
 
 type ControllerSolarMetrics struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VoltageV      float64                `protobuf:"fixed64,1,opt,name=voltageV,proto3" json:"voltageV,omitempty"`
+	CurrentA      float64                `protobuf:"fixed64,2,opt,name=currentA,proto3" json:"currentA,omitempty"`
+	PowerW        float64                `protobuf:"fixed64,3,opt,name=powerW,proto3" json:"powerW,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	VoltageV float64 `protobuf:"fixed64,1,opt,name=voltageV,proto3" json:"voltageV,omitempty"`
-	CurrentA float64 `protobuf:"fixed64,2,opt,name=currentA,proto3" json:"currentA,omitempty"`
-	PowerW   float64 `protobuf:"fixed64,3,opt,name=powerW,proto3" json:"powerW,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ControllerSolarMetrics) Reset() {
 	*x = ControllerSolarMetrics{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ControllerSolarMetrics) String() string {
@@ -1776,8 +2496,8 @@ func (x *ControllerSolarMetrics) String() string {
 func (*ControllerSolarMetrics) ProtoMessage() {}
 
 func (x *ControllerSolarMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[34]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1789,7 +2509,7 @@ func (x *ControllerSolarMetrics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ControllerSolarMetrics.ProtoReflect.Descriptor instead.
 func (*ControllerSolarMetrics) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{24}
+	return file_health_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ControllerSolarMetrics) GetVoltageV() float64 {
@@ -1814,22 +2534,19 @@ func (x *ControllerSolarMetrics) GetPowerW() float64 {
 }
 
 type ControllerBatteryMetrics struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VoltageV      float64                `protobuf:"fixed64,1,opt,name=voltageV,proto3" json:"voltageV,omitempty"`
+	CurrentA      float64                `protobuf:"fixed64,2,opt,name=currentA,proto3" json:"currentA,omitempty"`
+	SocPct        int32                  `protobuf:"varint,3,opt,name=socPct,proto3" json:"socPct,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	VoltageV float64 `protobuf:"fixed64,1,opt,name=voltageV,proto3" json:"voltageV,omitempty"`
-	CurrentA float64 `protobuf:"fixed64,2,opt,name=currentA,proto3" json:"currentA,omitempty"`
-	SocPct   int32   `protobuf:"varint,3,opt,name=socPct,proto3" json:"socPct,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ControllerBatteryMetrics) Reset() {
 	*x = ControllerBatteryMetrics{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ControllerBatteryMetrics) String() string {
@@ -1839,8 +2556,8 @@ func (x *ControllerBatteryMetrics) String() string {
 func (*ControllerBatteryMetrics) ProtoMessage() {}
 
 func (x *ControllerBatteryMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[35]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1852,7 +2569,7 @@ func (x *ControllerBatteryMetrics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ControllerBatteryMetrics.ProtoReflect.Descriptor instead.
 func (*ControllerBatteryMetrics) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{25}
+	return file_health_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ControllerBatteryMetrics) GetVoltageV() float64 {
@@ -1877,21 +2594,18 @@ func (x *ControllerBatteryMetrics) GetSocPct() int32 {
 }
 
 type ControllerLoadMetrics struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OutputOn      bool                   `protobuf:"varint,1,opt,name=outputOn,proto3" json:"outputOn,omitempty"`
+	CurrentA      float64                `protobuf:"fixed64,2,opt,name=currentA,proto3" json:"currentA,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OutputOn bool    `protobuf:"varint,1,opt,name=outputOn,proto3" json:"outputOn,omitempty"`
-	CurrentA float64 `protobuf:"fixed64,2,opt,name=currentA,proto3" json:"currentA,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ControllerLoadMetrics) Reset() {
 	*x = ControllerLoadMetrics{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_health_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_health_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ControllerLoadMetrics) String() string {
@@ -1901,8 +2615,8 @@ func (x *ControllerLoadMetrics) String() string {
 func (*ControllerLoadMetrics) ProtoMessage() {}
 
 func (x *ControllerLoadMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_health_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_health_proto_msgTypes[36]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1914,7 +2628,7 @@ func (x *ControllerLoadMetrics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ControllerLoadMetrics.ProtoReflect.Descriptor instead.
 func (*ControllerLoadMetrics) Descriptor() ([]byte, []int) {
-	return file_health_proto_rawDescGZIP(), []int{26}
+	return file_health_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ControllerLoadMetrics) GetOutputOn() bool {
@@ -1933,325 +2647,270 @@ func (x *ControllerLoadMetrics) GetCurrentA() float64 {
 
 var File_health_proto protoreflect.FileDescriptor
 
-var file_health_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14,
-	0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x2e, 0x76, 0x31, 0x1a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5f, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x41, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70,
-	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x61, 0x70,
-	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61,
-	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x70, 0x70, 0x52, 0x04, 0x61, 0x70, 0x70, 0x73, 0x22, 0x71, 0x0a, 0x15, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x59, 0x0a, 0x16,
-	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6b, 0x61,
-	0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f,
-	0x64, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
-	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x43, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x69,
-	0x6d, 0x65, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
-	0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x54, 0x0a, 0x18,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01,
-	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x22, 0x37, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0xee, 0x01, 0x0a, 0x0c,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f,
-	0x64, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x24, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x9c, 0x01, 0x0a,
-	0x03, 0x41, 0x70, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x0b,
-	0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x0a, 0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x73, 0x73, 0x4b, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x73, 0x73, 0x4b, 0x62, 0x12, 0x24, 0x0a,
-	0x0d, 0x64, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x6b, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x64, 0x69, 0x73,
-	0x6b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0c,
-	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
-	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x9e, 0x02, 0x0a,
-	0x0a, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x6e,
-	0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69,
-	0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x6f, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x6f, 0x65,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x73,
-	0x70, 0x65, 0x65, 0x64, 0x42, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
-	0x70, 0x65, 0x65, 0x64, 0x42, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72,
-	0x57, 0x61, 0x74, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x6f, 0x77,
-	0x65, 0x72, 0x57, 0x61, 0x74, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xcb, 0x03,
-	0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x63,
-	0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x08, 0x63, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72,
-	0x12, 0x3a, 0x0a, 0x05, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x05, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x12, 0x34, 0x0a, 0x03,
-	0x67, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6b, 0x61, 0x6d,
-	0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x50, 0x53, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x03, 0x67,
-	0x70, 0x73, 0x12, 0x43, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x68, 0x61, 0x75, 0x6c, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64,
-	0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b,
-	0x68, 0x61, 0x75, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x08, 0x62,
-	0x61, 0x63, 0x6b, 0x68, 0x61, 0x75, 0x6c, 0x12, 0x34, 0x0a, 0x03, 0x66, 0x65, 0x6d, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64,
-	0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x45, 0x4d, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x03, 0x66, 0x65, 0x6d, 0x12, 0x3d, 0x0a,
-	0x06, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
-	0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x52, 0x06, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x4d, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2d, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52,
-	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x47, 0x0a, 0x11, 0x43,
-	0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x22, 0x44, 0x0a, 0x0e, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x76, 0x0a, 0x0c, 0x47, 0x50,
-	0x53, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x0b,
-	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69,
-	0x6d, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x42, 0x61, 0x63, 0x6b, 0x68, 0x61, 0x75, 0x6c, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x6c, 0x69, 0x6e, 0x6b, 0x47, 0x75, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x47, 0x75, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x5f, 0x0a, 0x0c, 0x46, 0x45,
-	0x4d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x66, 0x65, 0x6d, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e,
-	0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x45,
-	0x4d, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x04, 0x66, 0x65, 0x6d, 0x73, 0x22, 0x6a, 0x0a, 0x07, 0x46,
-	0x45, 0x4d, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
-	0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65,
-	0x73, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x67, 0x70, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
-	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x45, 0x4d, 0x47, 0x50, 0x49,
-	0x4f, 0x52, 0x04, 0x67, 0x70, 0x69, 0x6f, 0x22, 0xcd, 0x01, 0x0a, 0x07, 0x46, 0x45, 0x4d, 0x47,
-	0x50, 0x49, 0x4f, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x78, 0x52, 0x66, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x78, 0x52, 0x66, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x78, 0x52, 0x66, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x78, 0x52, 0x66, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x56, 0x64, 0x73, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x61, 0x56, 0x64, 0x73, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x66, 0x50, 0x61, 0x6c, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x66, 0x50, 0x61,
-	0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x76, 0x64, 0x73, 0x32, 0x38,
-	0x76, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76,
-	0x64, 0x73, 0x32, 0x38, 0x76, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x73, 0x75, 0x50, 0x67, 0x6f, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70,
-	0x73, 0x75, 0x50, 0x67, 0x6f, 0x6f, 0x64, 0x22, 0xbe, 0x02, 0x0a, 0x0f, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61,
-	0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65,
-	0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f,
-	0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
-	0x09, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x06, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x75, 0x6b,
-	0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x12, 0x36, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x72,
-	0x74, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x6f, 0x0a, 0x15, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa0, 0x03, 0x0a, 0x17, 0x4e, 0x6f,
-	0x64, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x4f, 0x6b, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x4f, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x63,
-	0x68, 0x61, 0x72, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6c, 0x61, 0x72, 0x6d,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a,
-	0x05, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x75,
-	0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x6f,
-	0x6c, 0x61, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x05, 0x73, 0x6f, 0x6c, 0x61,
-	0x72, 0x12, 0x48, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
-	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x52, 0x07, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12, 0x3f, 0x0a, 0x04, 0x6c,
-	0x6f, 0x61, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x75, 0x6b, 0x61, 0x6d,
-	0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x64, 0x4d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x68, 0x0a, 0x16,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x6f, 0x6c, 0x61, 0x72, 0x4d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67,
-	0x65, 0x56, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67,
-	0x65, 0x56, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x22, 0x6a, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x56, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x56, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f,
-	0x63, 0x50, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x6f, 0x63, 0x50,
-	0x63, 0x74, 0x22, 0x4f, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x4f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x4f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x41, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x41, 0x32, 0xb1, 0x03, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64,
-	0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
-	0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x08, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x70, 0x70, 0x73, 0x12, 0x25, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f,
-	0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75,
-	0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e,
-	0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
-	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x74, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2e, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e,
-	0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e,
-	0x6f, 0x64, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x75, 0x6b, 0x61, 0x6d,
-	0x61, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2f, 0x70, 0x62, 0x2f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
-}
+const file_health_proto_rawDesc = "" +
+	"\n" +
+	"\fhealth.proto\x12\x14ukama.node.health.v1\x1a\x0fvalidator.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1dukama/filter_timeframes.proto\"_\n" +
+	"\x0fListAppsRequest\x12\x1a\n" +
+	"\breportId\x18\x01 \x01(\tR\breportId\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12\x18\n" +
+	"\aappName\x18\x03 \x01(\tR\aappName\"A\n" +
+	"\x10ListAppsResponse\x12-\n" +
+	"\x04apps\x18\x01 \x03(\v2\x19.ukama.node.health.v1.AppR\x04apps\"q\n" +
+	"\x15ListInterfacesRequest\x12\x1a\n" +
+	"\breportId\x18\x01 \x01(\tR\breportId\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12$\n" +
+	"\rinterfaceName\x18\x03 \x01(\tR\rinterfaceName\"Y\n" +
+	"\x16ListInterfacesResponse\x12?\n" +
+	"\n" +
+	"interfaces\x18\x01 \x01(\v2\x1f.ukama.node.health.v1.InterfaceR\n" +
+	"interfaces\"\xff\x01\n" +
+	"\x12ListReportsRequest\x12\x1a\n" +
+	"\breportId\x18\x01 \x01(\tR\breportId\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12\x1e\n" +
+	"\n" +
+	"reportedAt\x18\x03 \x01(\x03R\n" +
+	"reportedAt\x12C\n" +
+	"\ttimeframe\x18\x04 \x01(\x0e2%.ukama.common.v1.FilterTimeframesTypeR\ttimeframe\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x06 \x01(\x03R\x02to\x12\x14\n" +
+	"\x05limit\x18\a \x01(\rR\x05limit\x12\x16\n" +
+	"\x06fields\x18\b \x03(\tR\x06fields\"S\n" +
+	"\x13ListReportsResponse\x12<\n" +
+	"\areports\x18\x01 \x03(\v2\".ukama.node.health.v1.HealthReportR\areports\"T\n" +
+	"\x18StoreHealthReportRequest\x12\x1e\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload\"7\n" +
+	"\x19StoreHealthReportResponse\x12\x1a\n" +
+	"\breportId\x18\x01 \x01(\tR\breportId\"\x91\x01\n" +
+	"\x13QueryMetricsRequest\x12\x1e\n" +
+	"\x06nodeId\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06nodeId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x04 \x01(\tR\n" +
+	"resolution\x12\x16\n" +
+	"\x06fields\x18\x05 \x03(\tR\x06fields\"\x89\x01\n" +
+	"\x14QueryMetricsResponse\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x02 \x01(\tR\n" +
+	"resolution\x129\n" +
+	"\x06points\x18\x03 \x03(\v2!.ukama.node.health.v1.MetricPointR\x06points\"\xe9\x01\n" +
+	"\vMetricPoint\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12\x18\n" +
+	"\asamples\x18\x02 \x01(\rR\asamples\x12E\n" +
+	"\x06values\x18\x03 \x03(\v2-.ukama.node.health.v1.MetricPoint.ValuesEntryR\x06values\x1a[\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x126\n" +
+	"\x05value\x18\x02 \x01(\v2 .ukama.node.health.v1.MetricStatR\x05value:\x028\x01\"X\n" +
+	"\n" +
+	"MetricStat\x12\x10\n" +
+	"\x03avg\x18\x01 \x01(\x01R\x03avg\x12\x10\n" +
+	"\x03min\x18\x02 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x03 \x01(\x01R\x03max\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\rR\x05count\"\x85\x01\n" +
+	"\x11ListEventsRequest\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\x03R\x02to\x12 \n" +
+	"\vminSeverity\x18\x05 \x01(\tR\vminSeverity\"M\n" +
+	"\x12ListEventsResponse\x127\n" +
+	"\x06events\x18\x01 \x03(\v2\x1f.ukama.node.health.v1.NodeEventR\x06events\"\x9d\x02\n" +
+	"\tNodeEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12\x1a\n" +
+	"\bnodeType\x18\x03 \x01(\tR\bnodeType\x12\x1a\n" +
+	"\breportId\x18\x04 \x01(\tR\breportId\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x1a\n" +
+	"\bseverity\x18\x06 \x01(\tR\bseverity\x12\x16\n" +
+	"\x06source\x18\a \x01(\tR\x06source\x12\x14\n" +
+	"\x05value\x18\b \x01(\x01R\x05value\x12\x18\n" +
+	"\amessage\x18\t \x01(\tR\amessage\x12\x18\n" +
+	"\acleared\x18\n" +
+	" \x01(\bR\acleared\x12\x1e\n" +
+	"\n" +
+	"occurredAt\x18\v \x01(\x03R\n" +
+	"occurredAt\"K\n" +
+	"\x11ListAlarmsRequest\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12\x1e\n" +
+	"\n" +
+	"activeOnly\x18\x02 \x01(\bR\n" +
+	"activeOnly\"I\n" +
+	"\x12ListAlarmsResponse\x123\n" +
+	"\x06alarms\x18\x01 \x03(\v2\x1b.ukama.node.health.v1.AlarmR\x06alarms\"\xeb\x02\n" +
+	"\x05Alarm\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12\x1a\n" +
+	"\bnodeType\x18\x03 \x01(\tR\bnodeType\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12\x1a\n" +
+	"\bseverity\x18\x06 \x01(\tR\bseverity\x12\x14\n" +
+	"\x05state\x18\a \x01(\tR\x05state\x12\x14\n" +
+	"\x05count\x18\b \x01(\rR\x05count\x12\x14\n" +
+	"\x05value\x18\t \x01(\x01R\x05value\x12\x18\n" +
+	"\amessage\x18\n" +
+	" \x01(\tR\amessage\x12 \n" +
+	"\vfirstSeenAt\x18\v \x01(\x03R\vfirstSeenAt\x12\x1e\n" +
+	"\n" +
+	"lastSeenAt\x18\f \x01(\x03R\n" +
+	"lastSeenAt\x12\x1a\n" +
+	"\braisedAt\x18\r \x01(\x03R\braisedAt\x12\x1c\n" +
+	"\tclearedAt\x18\x0e \x01(\x03R\tclearedAt\"\xee\x01\n" +
+	"\fHealthReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12\x1a\n" +
+	"\bnodeType\x18\x03 \x01(\tR\bnodeType\x12$\n" +
+	"\rschemaVersion\x18\x04 \x01(\tR\rschemaVersion\x12\x1e\n" +
+	"\n" +
+	"reportedAt\x18\x05 \x01(\x03R\n" +
+	"reportedAt\x12:\n" +
+	"\n" +
+	"receivedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\x12\x18\n" +
+	"\apayload\x18\a \x01(\fR\apayload\"\x9c\x01\n" +
+	"\x03App\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x10\n" +
+	"\x03tag\x18\x03 \x01(\tR\x03tag\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12=\n" +
+	"\bresource\x18\x05 \x01(\v2!.ukama.node.health.v1.AppResourceR\bresource\"\x9d\x01\n" +
+	"\vAppResource\x12\x1e\n" +
+	"\n" +
+	"cpuPercent\x18\x01 \x01(\x02R\n" +
+	"cpuPercent\x12 \n" +
+	"\vmemoryRssKb\x18\x02 \x01(\x02R\vmemoryRssKb\x12$\n" +
+	"\rdiskReadBytes\x18\x03 \x01(\x02R\rdiskReadBytes\x12&\n" +
+	"\x0ediskWriteBytes\x18\x04 \x01(\x02R\x0ediskWriteBytes\"\x9e\x01\n" +
+	"\fSwitchPolicy\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x126\n" +
+	"\x05ports\x18\x05 \x03(\v2 .ukama.node.health.v1.SwitchPortR\x05ports\"\x9e\x02\n" +
+	"\n" +
+	"SwitchPort\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\apresent\x18\x03 \x01(\bR\apresent\x12\x1e\n" +
+	"\n" +
+	"adminState\x18\x04 \x01(\tR\n" +
+	"adminState\x12\x1c\n" +
+	"\tlinkState\x18\x05 \x01(\tR\tlinkState\x12\x1a\n" +
+	"\bpoeState\x18\x06 \x01(\tR\bpoeState\x12&\n" +
+	"\x0epoeOperational\x18\a \x01(\bR\x0epoeOperational\x12\x1a\n" +
+	"\bspeedBps\x18\b \x01(\x03R\bspeedBps\x12\x1e\n" +
+	"\n" +
+	"powerWatts\x18\t \x01(\x01R\n" +
+	"powerWatts\x12\x14\n" +
+	"\x05fault\x18\n" +
+	" \x01(\tR\x05fault\"\xcb\x03\n" +
+	"\tInterface\x12C\n" +
+	"\bcellular\x18\x01 \x01(\v2'.ukama.node.health.v1.CellularInterfaceR\bcellular\x12:\n" +
+	"\x05radio\x18\x02 \x01(\v2$.ukama.node.health.v1.RadioInterfaceR\x05radio\x124\n" +
+	"\x03gps\x18\x03 \x01(\v2\".ukama.node.health.v1.GPSInterfaceR\x03gps\x12C\n" +
+	"\bbackhaul\x18\x04 \x01(\v2'.ukama.node.health.v1.BackhaulInterfaceR\bbackhaul\x124\n" +
+	"\x03fem\x18\x05 \x01(\v2\".ukama.node.health.v1.FEMInterfaceR\x03fem\x12=\n" +
+	"\x06switch\x18\x06 \x01(\v2%.ukama.node.health.v1.SwitchInterfaceR\x06switch\x12M\n" +
+	"\n" +
+	"controller\x18\a \x01(\v2-.ukama.node.health.v1.NodeControllerInterfaceR\n" +
+	"controller\"G\n" +
+	"\x11CellularInterface\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"D\n" +
+	"\x0eRadioInterface\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"v\n" +
+	"\fGPSInterface\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x12\x12\n" +
+	"\x04lock\x18\x02 \x01(\bR\x04lock\x12 \n" +
+	"\vcoordinates\x18\x03 \x01(\tR\vcoordinates\x12\x12\n" +
+	"\x04time\x18\x04 \x01(\tR\x04time\"\x85\x01\n" +
+	"\x11BackhaulInterface\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1c\n" +
+	"\tlinkGuess\x18\x03 \x01(\tR\tlinkGuess\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\x01R\n" +
+	"confidence\"_\n" +
+	"\fFEMInterface\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x121\n" +
+	"\x04fems\x18\x02 \x03(\v2\x1d.ukama.node.health.v1.FEMUnitR\x04fems\"j\n" +
+	"\aFEMUnit\x12\x12\n" +
+	"\x04unit\x18\x01 \x01(\x05R\x04unit\x12\x18\n" +
+	"\apresent\x18\x02 \x01(\bR\apresent\x121\n" +
+	"\x04gpio\x18\x03 \x01(\v2\x1d.ukama.node.health.v1.FEMGPIOR\x04gpio\"\xcd\x01\n" +
+	"\aFEMGPIO\x12\x1e\n" +
+	"\n" +
+	"txRfEnable\x18\x01 \x01(\bR\n" +
+	"txRfEnable\x12\x1e\n" +
+	"\n" +
+	"rxRfEnable\x18\x02 \x01(\bR\n" +
+	"rxRfEnable\x12 \n" +
+	"\vpaVdsEnable\x18\x03 \x01(\bR\vpaVdsEnable\x12 \n" +
+	"\vrfPalEnable\x18\x04 \x01(\bR\vrfPalEnable\x12\"\n" +
+	"\fvds28vEnable\x18\x05 \x01(\bR\fvds28vEnable\x12\x1a\n" +
+	"\bpsuPgood\x18\x06 \x01(\bR\bpsuPgood\"\xbe\x02\n" +
+	"\x0fSwitchInterface\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x12\x1c\n" +
+	"\treachable\x18\x02 \x01(\bR\treachable\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x14\n" +
+	"\x05model\x18\x04 \x01(\tR\x05model\x12(\n" +
+	"\x0fsoftwareVersion\x18\x05 \x01(\tR\x0fsoftwareVersion\x12\x1c\n" +
+	"\tportCount\x18\x06 \x01(\x05R\tportCount\x12C\n" +
+	"\x06policy\x18\a \x01(\v2+.ukama.node.health.v1.SwitchInterfacePolicyR\x06policy\x126\n" +
+	"\x05ports\x18\b \x03(\v2 .ukama.node.health.v1.SwitchPortR\x05ports\"o\n" +
+	"\x15SwitchInterfacePolicy\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xa0\x03\n" +
+	"\x17NodeControllerInterface\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x12\x16\n" +
+	"\x06commOk\x18\x02 \x01(\bR\x06commOk\x12 \n" +
+	"\vchargeState\x18\x03 \x01(\tR\vchargeState\x12\x1c\n" +
+	"\terrorCode\x18\x04 \x01(\x05R\terrorCode\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12*\n" +
+	"\x10activeAlarmCount\x18\x06 \x01(\x05R\x10activeAlarmCount\x12B\n" +
+	"\x05solar\x18\a \x01(\v2,.ukama.node.health.v1.ControllerSolarMetricsR\x05solar\x12H\n" +
+	"\abattery\x18\b \x01(\v2..ukama.node.health.v1.ControllerBatteryMetricsR\abattery\x12?\n" +
+	"\x04load\x18\t \x01(\v2+.ukama.node.health.v1.ControllerLoadMetricsR\x04load\"h\n" +
+	"\x16ControllerSolarMetrics\x12\x1a\n" +
+	"\bvoltageV\x18\x01 \x01(\x01R\bvoltageV\x12\x1a\n" +
+	"\bcurrentA\x18\x02 \x01(\x01R\bcurrentA\x12\x16\n" +
+	"\x06powerW\x18\x03 \x01(\x01R\x06powerW\"j\n" +
+	"\x18ControllerBatteryMetrics\x12\x1a\n" +
+	"\bvoltageV\x18\x01 \x01(\x01R\bvoltageV\x12\x1a\n" +
+	"\bcurrentA\x18\x02 \x01(\x01R\bcurrentA\x12\x16\n" +
+	"\x06socPct\x18\x03 \x01(\x05R\x06socPct\"O\n" +
+	"\x15ControllerLoadMetrics\x12\x1a\n" +
+	"\boutputOn\x18\x01 \x01(\bR\boutputOn\x12\x1a\n" +
+	"\bcurrentA\x18\x02 \x01(\x01R\bcurrentA2\xda\x05\n" +
+	"\rHealthService\x12b\n" +
+	"\vListReports\x12(.ukama.node.health.v1.ListReportsRequest\x1a).ukama.node.health.v1.ListReportsResponse\x12Y\n" +
+	"\bListApps\x12%.ukama.node.health.v1.ListAppsRequest\x1a&.ukama.node.health.v1.ListAppsResponse\x12k\n" +
+	"\x0eListInterfaces\x12+.ukama.node.health.v1.ListInterfacesRequest\x1a,.ukama.node.health.v1.ListInterfacesResponse\x12t\n" +
+	"\x11StoreHealthReport\x12..ukama.node.health.v1.StoreHealthReportRequest\x1a/.ukama.node.health.v1.StoreHealthReportResponse\x12e\n" +
+	"\fQueryMetrics\x12).ukama.node.health.v1.QueryMetricsRequest\x1a*.ukama.node.health.v1.QueryMetricsResponse\x12_\n" +
+	"\n" +
+	"ListEvents\x12'.ukama.node.health.v1.ListEventsRequest\x1a(.ukama.node.health.v1.ListEventsResponse\x12_\n" +
+	"\n" +
+	"ListAlarms\x12'.ukama.node.health.v1.ListAlarmsRequest\x1a(.ukama.node.health.v1.ListAlarmsResponseB3Z1github.com/ukama/ukama/systems/node/health/pb/genb\x06proto3"
 
 var (
 	file_health_proto_rawDescOnce sync.Once
-	file_health_proto_rawDescData = file_health_proto_rawDesc
+	file_health_proto_rawDescData []byte
 )
 
 func file_health_proto_rawDescGZIP() []byte {
 	file_health_proto_rawDescOnce.Do(func() {
-		file_health_proto_rawDescData = protoimpl.X.CompressGZIP(file_health_proto_rawDescData)
+		file_health_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_health_proto_rawDesc), len(file_health_proto_rawDesc)))
 	})
 	return file_health_proto_rawDescData
 }
 
-var file_health_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
-var file_health_proto_goTypes = []interface{}{
+var file_health_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_health_proto_goTypes = []any{
 	(*ListAppsRequest)(nil),           // 0: ukama.node.health.v1.ListAppsRequest
 	(*ListAppsResponse)(nil),          // 1: ukama.node.health.v1.ListAppsResponse
 	(*ListInterfacesRequest)(nil),     // 2: ukama.node.health.v1.ListInterfacesRequest
@@ -2260,63 +2919,85 @@ var file_health_proto_goTypes = []interface{}{
 	(*ListReportsResponse)(nil),       // 5: ukama.node.health.v1.ListReportsResponse
 	(*StoreHealthReportRequest)(nil),  // 6: ukama.node.health.v1.StoreHealthReportRequest
 	(*StoreHealthReportResponse)(nil), // 7: ukama.node.health.v1.StoreHealthReportResponse
-	(*HealthReport)(nil),              // 8: ukama.node.health.v1.HealthReport
-	(*App)(nil),                       // 9: ukama.node.health.v1.App
-	(*AppResource)(nil),               // 10: ukama.node.health.v1.AppResource
-	(*SwitchPolicy)(nil),              // 11: ukama.node.health.v1.SwitchPolicy
-	(*SwitchPort)(nil),                // 12: ukama.node.health.v1.SwitchPort
-	(*Interface)(nil),                 // 13: ukama.node.health.v1.Interface
-	(*CellularInterface)(nil),         // 14: ukama.node.health.v1.CellularInterface
-	(*RadioInterface)(nil),            // 15: ukama.node.health.v1.RadioInterface
-	(*GPSInterface)(nil),              // 16: ukama.node.health.v1.GPSInterface
-	(*BackhaulInterface)(nil),         // 17: ukama.node.health.v1.BackhaulInterface
-	(*FEMInterface)(nil),              // 18: ukama.node.health.v1.FEMInterface
-	(*FEMUnit)(nil),                   // 19: ukama.node.health.v1.FEMUnit
-	(*FEMGPIO)(nil),                   // 20: ukama.node.health.v1.FEMGPIO
-	(*SwitchInterface)(nil),           // 21: ukama.node.health.v1.SwitchInterface
-	(*SwitchInterfacePolicy)(nil),     // 22: ukama.node.health.v1.SwitchInterfacePolicy
-	(*NodeControllerInterface)(nil),   // 23: ukama.node.health.v1.NodeControllerInterface
-	(*ControllerSolarMetrics)(nil),    // 24: ukama.node.health.v1.ControllerSolarMetrics
-	(*ControllerBatteryMetrics)(nil),  // 25: ukama.node.health.v1.ControllerBatteryMetrics
-	(*ControllerLoadMetrics)(nil),     // 26: ukama.node.health.v1.ControllerLoadMetrics
-	(ukama.FilterTimeframesType)(0),   // 27: ukama.common.v1.FilterTimeframesType
-	(*timestamppb.Timestamp)(nil),     // 28: google.protobuf.Timestamp
+	(*QueryMetricsRequest)(nil),       // 8: ukama.node.health.v1.QueryMetricsRequest
+	(*QueryMetricsResponse)(nil),      // 9: ukama.node.health.v1.QueryMetricsResponse
+	(*MetricPoint)(nil),               // 10: ukama.node.health.v1.MetricPoint
+	(*MetricStat)(nil),                // 11: ukama.node.health.v1.MetricStat
+	(*ListEventsRequest)(nil),         // 12: ukama.node.health.v1.ListEventsRequest
+	(*ListEventsResponse)(nil),        // 13: ukama.node.health.v1.ListEventsResponse
+	(*NodeEvent)(nil),                 // 14: ukama.node.health.v1.NodeEvent
+	(*ListAlarmsRequest)(nil),         // 15: ukama.node.health.v1.ListAlarmsRequest
+	(*ListAlarmsResponse)(nil),        // 16: ukama.node.health.v1.ListAlarmsResponse
+	(*Alarm)(nil),                     // 17: ukama.node.health.v1.Alarm
+	(*HealthReport)(nil),              // 18: ukama.node.health.v1.HealthReport
+	(*App)(nil),                       // 19: ukama.node.health.v1.App
+	(*AppResource)(nil),               // 20: ukama.node.health.v1.AppResource
+	(*SwitchPolicy)(nil),              // 21: ukama.node.health.v1.SwitchPolicy
+	(*SwitchPort)(nil),                // 22: ukama.node.health.v1.SwitchPort
+	(*Interface)(nil),                 // 23: ukama.node.health.v1.Interface
+	(*CellularInterface)(nil),         // 24: ukama.node.health.v1.CellularInterface
+	(*RadioInterface)(nil),            // 25: ukama.node.health.v1.RadioInterface
+	(*GPSInterface)(nil),              // 26: ukama.node.health.v1.GPSInterface
+	(*BackhaulInterface)(nil),         // 27: ukama.node.health.v1.BackhaulInterface
+	(*FEMInterface)(nil),              // 28: ukama.node.health.v1.FEMInterface
+	(*FEMUnit)(nil),                   // 29: ukama.node.health.v1.FEMUnit
+	(*FEMGPIO)(nil),                   // 30: ukama.node.health.v1.FEMGPIO
+	(*SwitchInterface)(nil),           // 31: ukama.node.health.v1.SwitchInterface
+	(*SwitchInterfacePolicy)(nil),     // 32: ukama.node.health.v1.SwitchInterfacePolicy
+	(*NodeControllerInterface)(nil),   // 33: ukama.node.health.v1.NodeControllerInterface
+	(*ControllerSolarMetrics)(nil),    // 34: ukama.node.health.v1.ControllerSolarMetrics
+	(*ControllerBatteryMetrics)(nil),  // 35: ukama.node.health.v1.ControllerBatteryMetrics
+	(*ControllerLoadMetrics)(nil),     // 36: ukama.node.health.v1.ControllerLoadMetrics
+	nil,                               // 37: ukama.node.health.v1.MetricPoint.ValuesEntry
+	(ukama.FilterTimeframesType)(0),   // 38: ukama.common.v1.FilterTimeframesType
+	(*timestamppb.Timestamp)(nil),     // 39: google.protobuf.Timestamp
 }
 var file_health_proto_depIdxs = []int32{
-	9,  // 0: ukama.node.health.v1.ListAppsResponse.apps:type_name -> ukama.node.health.v1.App
-	13, // 1: ukama.node.health.v1.ListInterfacesResponse.interfaces:type_name -> ukama.node.health.v1.Interface
-	27, // 2: ukama.node.health.v1.ListReportsRequest.timeframe:type_name -> ukama.common.v1.FilterTimeframesType
-	8,  // 3: ukama.node.health.v1.ListReportsResponse.reports:type_name -> ukama.node.health.v1.HealthReport
-	28, // 4: ukama.node.health.v1.HealthReport.receivedAt:type_name -> google.protobuf.Timestamp
-	10, // 5: ukama.node.health.v1.App.resource:type_name -> ukama.node.health.v1.AppResource
-	12, // 6: ukama.node.health.v1.SwitchPolicy.ports:type_name -> ukama.node.health.v1.SwitchPort
-	14, // 7: ukama.node.health.v1.Interface.cellular:type_name -> ukama.node.health.v1.CellularInterface
-	15, // 8: ukama.node.health.v1.Interface.radio:type_name -> ukama.node.health.v1.RadioInterface
-	16, // 9: ukama.node.health.v1.Interface.gps:type_name -> ukama.node.health.v1.GPSInterface
-	17, // 10: ukama.node.health.v1.Interface.backhaul:type_name -> ukama.node.health.v1.BackhaulInterface
-	18, // 11: ukama.node.health.v1.Interface.fem:type_name -> ukama.node.health.v1.FEMInterface
-	21, // 12: ukama.node.health.v1.Interface.switch:type_name -> ukama.node.health.v1.SwitchInterface
-	23, // 13: ukama.node.health.v1.Interface.controller:type_name -> ukama.node.health.v1.NodeControllerInterface
-	19, // 14: ukama.node.health.v1.FEMInterface.fems:type_name -> ukama.node.health.v1.FEMUnit
-	20, // 15: ukama.node.health.v1.FEMUnit.gpio:type_name -> ukama.node.health.v1.FEMGPIO
-	22, // 16: ukama.node.health.v1.SwitchInterface.policy:type_name -> ukama.node.health.v1.SwitchInterfacePolicy
-	12, // 17: ukama.node.health.v1.SwitchInterface.ports:type_name -> ukama.node.health.v1.SwitchPort
-	24, // 18: ukama.node.health.v1.NodeControllerInterface.solar:type_name -> ukama.node.health.v1.ControllerSolarMetrics
-	25, // 19: ukama.node.health.v1.NodeControllerInterface.battery:type_name -> ukama.node.health.v1.ControllerBatteryMetrics
-	26, // 20: ukama.node.health.v1.NodeControllerInterface.load:type_name -> ukama.node.health.v1.ControllerLoadMetrics
-	4,  // 21: ukama.node.health.v1.HealthService.ListReports:input_type -> ukama.node.health.v1.ListReportsRequest
-	0,  // 22: ukama.node.health.v1.HealthService.ListApps:input_type -> ukama.node.health.v1.ListAppsRequest
-	2,  // 23: ukama.node.health.v1.HealthService.ListInterfaces:input_type -> ukama.node.health.v1.ListInterfacesRequest
-	6,  // 24: ukama.node.health.v1.HealthService.StoreHealthReport:input_type -> ukama.node.health.v1.StoreHealthReportRequest
-	5,  // 25: ukama.node.health.v1.HealthService.ListReports:output_type -> ukama.node.health.v1.ListReportsResponse
-	1,  // 26: ukama.node.health.v1.HealthService.ListApps:output_type -> ukama.node.health.v1.ListAppsResponse
-	3,  // 27: ukama.node.health.v1.HealthService.ListInterfaces:output_type -> ukama.node.health.v1.ListInterfacesResponse
-	7,  // 28: ukama.node.health.v1.HealthService.StoreHealthReport:output_type -> ukama.node.health.v1.StoreHealthReportResponse
-	25, // [25:29] is the sub-list for method output_type
-	21, // [21:25] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	19, // 0: ukama.node.health.v1.ListAppsResponse.apps:type_name -> ukama.node.health.v1.App
+	23, // 1: ukama.node.health.v1.ListInterfacesResponse.interfaces:type_name -> ukama.node.health.v1.Interface
+	38, // 2: ukama.node.health.v1.ListReportsRequest.timeframe:type_name -> ukama.common.v1.FilterTimeframesType
+	18, // 3: ukama.node.health.v1.ListReportsResponse.reports:type_name -> ukama.node.health.v1.HealthReport
+	10, // 4: ukama.node.health.v1.QueryMetricsResponse.points:type_name -> ukama.node.health.v1.MetricPoint
+	37, // 5: ukama.node.health.v1.MetricPoint.values:type_name -> ukama.node.health.v1.MetricPoint.ValuesEntry
+	14, // 6: ukama.node.health.v1.ListEventsResponse.events:type_name -> ukama.node.health.v1.NodeEvent
+	17, // 7: ukama.node.health.v1.ListAlarmsResponse.alarms:type_name -> ukama.node.health.v1.Alarm
+	39, // 8: ukama.node.health.v1.HealthReport.receivedAt:type_name -> google.protobuf.Timestamp
+	20, // 9: ukama.node.health.v1.App.resource:type_name -> ukama.node.health.v1.AppResource
+	22, // 10: ukama.node.health.v1.SwitchPolicy.ports:type_name -> ukama.node.health.v1.SwitchPort
+	24, // 11: ukama.node.health.v1.Interface.cellular:type_name -> ukama.node.health.v1.CellularInterface
+	25, // 12: ukama.node.health.v1.Interface.radio:type_name -> ukama.node.health.v1.RadioInterface
+	26, // 13: ukama.node.health.v1.Interface.gps:type_name -> ukama.node.health.v1.GPSInterface
+	27, // 14: ukama.node.health.v1.Interface.backhaul:type_name -> ukama.node.health.v1.BackhaulInterface
+	28, // 15: ukama.node.health.v1.Interface.fem:type_name -> ukama.node.health.v1.FEMInterface
+	31, // 16: ukama.node.health.v1.Interface.switch:type_name -> ukama.node.health.v1.SwitchInterface
+	33, // 17: ukama.node.health.v1.Interface.controller:type_name -> ukama.node.health.v1.NodeControllerInterface
+	29, // 18: ukama.node.health.v1.FEMInterface.fems:type_name -> ukama.node.health.v1.FEMUnit
+	30, // 19: ukama.node.health.v1.FEMUnit.gpio:type_name -> ukama.node.health.v1.FEMGPIO
+	32, // 20: ukama.node.health.v1.SwitchInterface.policy:type_name -> ukama.node.health.v1.SwitchInterfacePolicy
+	22, // 21: ukama.node.health.v1.SwitchInterface.ports:type_name -> ukama.node.health.v1.SwitchPort
+	34, // 22: ukama.node.health.v1.NodeControllerInterface.solar:type_name -> ukama.node.health.v1.ControllerSolarMetrics
+	35, // 23: ukama.node.health.v1.NodeControllerInterface.battery:type_name -> ukama.node.health.v1.ControllerBatteryMetrics
+	36, // 24: ukama.node.health.v1.NodeControllerInterface.load:type_name -> ukama.node.health.v1.ControllerLoadMetrics
+	11, // 25: ukama.node.health.v1.MetricPoint.ValuesEntry.value:type_name -> ukama.node.health.v1.MetricStat
+	4,  // 26: ukama.node.health.v1.HealthService.ListReports:input_type -> ukama.node.health.v1.ListReportsRequest
+	0,  // 27: ukama.node.health.v1.HealthService.ListApps:input_type -> ukama.node.health.v1.ListAppsRequest
+	2,  // 28: ukama.node.health.v1.HealthService.ListInterfaces:input_type -> ukama.node.health.v1.ListInterfacesRequest
+	6,  // 29: ukama.node.health.v1.HealthService.StoreHealthReport:input_type -> ukama.node.health.v1.StoreHealthReportRequest
+	8,  // 30: ukama.node.health.v1.HealthService.QueryMetrics:input_type -> ukama.node.health.v1.QueryMetricsRequest
+	12, // 31: ukama.node.health.v1.HealthService.ListEvents:input_type -> ukama.node.health.v1.ListEventsRequest
+	15, // 32: ukama.node.health.v1.HealthService.ListAlarms:input_type -> ukama.node.health.v1.ListAlarmsRequest
+	5,  // 33: ukama.node.health.v1.HealthService.ListReports:output_type -> ukama.node.health.v1.ListReportsResponse
+	1,  // 34: ukama.node.health.v1.HealthService.ListApps:output_type -> ukama.node.health.v1.ListAppsResponse
+	3,  // 35: ukama.node.health.v1.HealthService.ListInterfaces:output_type -> ukama.node.health.v1.ListInterfacesResponse
+	7,  // 36: ukama.node.health.v1.HealthService.StoreHealthReport:output_type -> ukama.node.health.v1.StoreHealthReportResponse
+	9,  // 37: ukama.node.health.v1.HealthService.QueryMetrics:output_type -> ukama.node.health.v1.QueryMetricsResponse
+	13, // 38: ukama.node.health.v1.HealthService.ListEvents:output_type -> ukama.node.health.v1.ListEventsResponse
+	16, // 39: ukama.node.health.v1.HealthService.ListAlarms:output_type -> ukama.node.health.v1.ListAlarmsResponse
+	33, // [33:40] is the sub-list for method output_type
+	26, // [26:33] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_health_proto_init() }
@@ -2324,339 +3005,13 @@ func file_health_proto_init() {
 	if File_health_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_health_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAppsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAppsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListInterfacesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListInterfacesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListReportsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListReportsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreHealthReportRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreHealthReportResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthReport); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*App); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppResource); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwitchPolicy); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwitchPort); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CellularInterface); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RadioInterface); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GPSInterface); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackhaulInterface); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FEMInterface); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FEMUnit); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FEMGPIO); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwitchInterface); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwitchInterfacePolicy); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeControllerInterface); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControllerSolarMetrics); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControllerBatteryMetrics); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_health_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControllerLoadMetrics); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_health_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_health_proto_rawDesc), len(file_health_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   27,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2665,7 +3020,6 @@ func file_health_proto_init() {
 		MessageInfos:      file_health_proto_msgTypes,
 	}.Build()
 	File_health_proto = out.File
-	file_health_proto_rawDesc = nil
 	file_health_proto_goTypes = nil
 	file_health_proto_depIdxs = nil
 }
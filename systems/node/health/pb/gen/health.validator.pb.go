@@ -64,6 +64,61 @@ func (this *StoreHealthReportRequest) Validate() error {
 func (this *StoreHealthReportResponse) Validate() error {
 	return nil
 }
+func (this *QueryMetricsRequest) Validate() error {
+	if this.NodeId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("NodeId", fmt.Errorf(`value '%v' must not be an empty string`, this.NodeId))
+	}
+	return nil
+}
+func (this *QueryMetricsResponse) Validate() error {
+	for _, item := range this.Points {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Points", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *MetricPoint) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *MetricStat) Validate() error {
+	return nil
+}
+func (this *ListEventsRequest) Validate() error {
+	return nil
+}
+func (this *ListEventsResponse) Validate() error {
+	for _, item := range this.Events {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Events", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *NodeEvent) Validate() error {
+	return nil
+}
+func (this *ListAlarmsRequest) Validate() error {
+	return nil
+}
+func (this *ListAlarmsResponse) Validate() error {
+	for _, item := range this.Alarms {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Alarms", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *Alarm) Validate() error {
+	return nil
+}
 func (this *HealthReport) Validate() error {
 	if this.ReceivedAt != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ReceivedAt); err != nil {
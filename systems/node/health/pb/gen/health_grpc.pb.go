@@ -1,6 +1,13 @@
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2023-present, Ukama Inc.
+
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.2.0
+// - protoc-gen-go-grpc v1.5.1
 // - protoc             v6.32.1
 // source: health.proto
 
@@ -15,8 +22,18 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.32.0 or later.
-const _ = grpc.SupportPackageIsVersion7
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	HealthService_ListReports_FullMethodName       = "/ukama.node.health.v1.HealthService/ListReports"
+	HealthService_ListApps_FullMethodName          = "/ukama.node.health.v1.HealthService/ListApps"
+	HealthService_ListInterfaces_FullMethodName    = "/ukama.node.health.v1.HealthService/ListInterfaces"
+	HealthService_StoreHealthReport_FullMethodName = "/ukama.node.health.v1.HealthService/StoreHealthReport"
+	HealthService_QueryMetrics_FullMethodName      = "/ukama.node.health.v1.HealthService/QueryMetrics"
+	HealthService_ListEvents_FullMethodName        = "/ukama.node.health.v1.HealthService/ListEvents"
+	HealthService_ListAlarms_FullMethodName        = "/ukama.node.health.v1.HealthService/ListAlarms"
+)
 
 // HealthServiceClient is the client API for HealthService service.
 //
@@ -26,6 +43,9 @@ type HealthServiceClient interface {
 	ListApps(ctx context.Context, in *ListAppsRequest, opts ...grpc.CallOption) (*ListAppsResponse, error)
 	ListInterfaces(ctx context.Context, in *ListInterfacesRequest, opts ...grpc.CallOption) (*ListInterfacesResponse, error)
 	StoreHealthReport(ctx context.Context, in *StoreHealthReportRequest, opts ...grpc.CallOption) (*StoreHealthReportResponse, error)
+	QueryMetrics(ctx context.Context, in *QueryMetricsRequest, opts ...grpc.CallOption) (*QueryMetricsResponse, error)
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	ListAlarms(ctx context.Context, in *ListAlarmsRequest, opts ...grpc.CallOption) (*ListAlarmsResponse, error)
 }
 
 type healthServiceClient struct {
@@ -37,8 +57,9 @@ func NewHealthServiceClient(cc grpc.ClientConnInterface) HealthServiceClient {
 }
 
 func (c *healthServiceClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListReportsResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.health.v1.HealthService/ListReports", in, out, opts...)
+	err := c.cc.Invoke(ctx, HealthService_ListReports_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -46,8 +67,9 @@ func (c *healthServiceClient) ListReports(ctx context.Context, in *ListReportsRe
 }
 
 func (c *healthServiceClient) ListApps(ctx context.Context, in *ListAppsRequest, opts ...grpc.CallOption) (*ListAppsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListAppsResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.health.v1.HealthService/ListApps", in, out, opts...)
+	err := c.cc.Invoke(ctx, HealthService_ListApps_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -55,8 +77,9 @@ func (c *healthServiceClient) ListApps(ctx context.Context, in *ListAppsRequest,
 }
 
 func (c *healthServiceClient) ListInterfaces(ctx context.Context, in *ListInterfacesRequest, opts ...grpc.CallOption) (*ListInterfacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListInterfacesResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.health.v1.HealthService/ListInterfaces", in, out, opts...)
+	err := c.cc.Invoke(ctx, HealthService_ListInterfaces_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -64,8 +87,39 @@ func (c *healthServiceClient) ListInterfaces(ctx context.Context, in *ListInterf
 }
 
 func (c *healthServiceClient) StoreHealthReport(ctx context.Context, in *StoreHealthReportRequest, opts ...grpc.CallOption) (*StoreHealthReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StoreHealthReportResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.health.v1.HealthService/StoreHealthReport", in, out, opts...)
+	err := c.cc.Invoke(ctx, HealthService_StoreHealthReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) QueryMetrics(ctx context.Context, in *QueryMetricsRequest, opts ...grpc.CallOption) (*QueryMetricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMetricsResponse)
+	err := c.cc.Invoke(ctx, HealthService_QueryMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, HealthService_ListEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) ListAlarms(ctx context.Context, in *ListAlarmsRequest, opts ...grpc.CallOption) (*ListAlarmsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlarmsResponse)
+	err := c.cc.Invoke(ctx, HealthService_ListAlarms_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,18 +128,24 @@ func (c *healthServiceClient) StoreHealthReport(ctx context.Context, in *StoreHe
 
 // HealthServiceServer is the server API for HealthService service.
 // All implementations must embed UnimplementedHealthServiceServer
-// for forward compatibility
+// for forward compatibility.
 type HealthServiceServer interface {
 	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
 	ListApps(context.Context, *ListAppsRequest) (*ListAppsResponse, error)
 	ListInterfaces(context.Context, *ListInterfacesRequest) (*ListInterfacesResponse, error)
 	StoreHealthReport(context.Context, *StoreHealthReportRequest) (*StoreHealthReportResponse, error)
+	QueryMetrics(context.Context, *QueryMetricsRequest) (*QueryMetricsResponse, error)
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	ListAlarms(context.Context, *ListAlarmsRequest) (*ListAlarmsResponse, error)
 	mustEmbedUnimplementedHealthServiceServer()
 }
 
-// UnimplementedHealthServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedHealthServiceServer struct {
-}
+// UnimplementedHealthServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHealthServiceServer struct{}
 
 func (UnimplementedHealthServiceServer) ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
@@ -99,7 +159,17 @@ func (UnimplementedHealthServiceServer) ListInterfaces(context.Context, *ListInt
 func (UnimplementedHealthServiceServer) StoreHealthReport(context.Context, *StoreHealthReportRequest) (*StoreHealthReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StoreHealthReport not implemented")
 }
+func (UnimplementedHealthServiceServer) QueryMetrics(context.Context, *QueryMetricsRequest) (*QueryMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMetrics not implemented")
+}
+func (UnimplementedHealthServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedHealthServiceServer) ListAlarms(context.Context, *ListAlarmsRequest) (*ListAlarmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlarms not implemented")
+}
 func (UnimplementedHealthServiceServer) mustEmbedUnimplementedHealthServiceServer() {}
+func (UnimplementedHealthServiceServer) testEmbeddedByValue()                       {}
 
 // UnsafeHealthServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to HealthServiceServer will
@@ -109,6 +179,13 @@ type UnsafeHealthServiceServer interface {
 }
 
 func RegisterHealthServiceServer(s grpc.ServiceRegistrar, srv HealthServiceServer) {
+	// If the following call pancis, it indicates UnimplementedHealthServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
 	s.RegisterService(&HealthService_ServiceDesc, srv)
 }
 
@@ -122,7 +199,7 @@ func _HealthService_ListReports_Handler(srv interface{}, ctx context.Context, de
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.health.v1.HealthService/ListReports",
+		FullMethod: HealthService_ListReports_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(HealthServiceServer).ListReports(ctx, req.(*ListReportsRequest))
@@ -140,7 +217,7 @@ func _HealthService_ListApps_Handler(srv interface{}, ctx context.Context, dec f
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.health.v1.HealthService/ListApps",
+		FullMethod: HealthService_ListApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(HealthServiceServer).ListApps(ctx, req.(*ListAppsRequest))
@@ -158,7 +235,7 @@ func _HealthService_ListInterfaces_Handler(srv interface{}, ctx context.Context,
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.health.v1.HealthService/ListInterfaces",
+		FullMethod: HealthService_ListInterfaces_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(HealthServiceServer).ListInterfaces(ctx, req.(*ListInterfacesRequest))
@@ -176,7 +253,7 @@ func _HealthService_StoreHealthReport_Handler(srv interface{}, ctx context.Conte
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.health.v1.HealthService/StoreHealthReport",
+		FullMethod: HealthService_StoreHealthReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(HealthServiceServer).StoreHealthReport(ctx, req.(*StoreHealthReportRequest))
@@ -184,6 +261,60 @@ func _HealthService_StoreHealthReport_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HealthService_QueryMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).QueryMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_QueryMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).QueryMetrics(ctx, req.(*QueryMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_ListAlarms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlarmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).ListAlarms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_ListAlarms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).ListAlarms(ctx, req.(*ListAlarmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HealthService_ServiceDesc is the grpc.ServiceDesc for HealthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +338,18 @@ var HealthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StoreHealthReport",
 			Handler:    _HealthService_StoreHealthReport_Handler,
 		},
+		{
+			MethodName: "QueryMetrics",
+			Handler:    _HealthService_QueryMetrics_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _HealthService_ListEvents_Handler,
+		},
+		{
+			MethodName: "ListAlarms",
+			Handler:    _HealthService_ListAlarms_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "health.proto",
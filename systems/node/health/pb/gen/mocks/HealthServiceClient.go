@@ -16,6 +16,43 @@ type HealthServiceClient struct {
 	mock.Mock
 }
 
+// ListAlarms provides a mock function with given fields: ctx, in, opts
+func (_m *HealthServiceClient) ListAlarms(ctx context.Context, in *gen.ListAlarmsRequest, opts ...grpc.CallOption) (*gen.ListAlarmsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAlarms")
+	}
+
+	var r0 *gen.ListAlarmsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListAlarmsRequest, ...grpc.CallOption) (*gen.ListAlarmsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListAlarmsRequest, ...grpc.CallOption) *gen.ListAlarmsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListAlarmsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListAlarmsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListApps provides a mock function with given fields: ctx, in, opts
 func (_m *HealthServiceClient) ListApps(ctx context.Context, in *gen.ListAppsRequest, opts ...grpc.CallOption) (*gen.ListAppsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -53,6 +90,43 @@ func (_m *HealthServiceClient) ListApps(ctx context.Context, in *gen.ListAppsReq
 	return r0, r1
 }
 
+// ListEvents provides a mock function with given fields: ctx, in, opts
+func (_m *HealthServiceClient) ListEvents(ctx context.Context, in *gen.ListEventsRequest, opts ...grpc.CallOption) (*gen.ListEventsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEvents")
+	}
+
+	var r0 *gen.ListEventsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListEventsRequest, ...grpc.CallOption) (*gen.ListEventsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListEventsRequest, ...grpc.CallOption) *gen.ListEventsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListEventsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListEventsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListInterfaces provides a mock function with given fields: ctx, in, opts
 func (_m *HealthServiceClient) ListInterfaces(ctx context.Context, in *gen.ListInterfacesRequest, opts ...grpc.CallOption) (*gen.ListInterfacesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -127,6 +201,43 @@ func (_m *HealthServiceClient) ListReports(ctx context.Context, in *gen.ListRepo
 	return r0, r1
 }
 
+// QueryMetrics provides a mock function with given fields: ctx, in, opts
+func (_m *HealthServiceClient) QueryMetrics(ctx context.Context, in *gen.QueryMetricsRequest, opts ...grpc.CallOption) (*gen.QueryMetricsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryMetrics")
+	}
+
+	var r0 *gen.QueryMetricsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.QueryMetricsRequest, ...grpc.CallOption) (*gen.QueryMetricsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.QueryMetricsRequest, ...grpc.CallOption) *gen.QueryMetricsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.QueryMetricsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.QueryMetricsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StoreHealthReport provides a mock function with given fields: ctx, in, opts
 func (_m *HealthServiceClient) StoreHealthReport(ctx context.Context, in *gen.StoreHealthReportRequest, opts ...grpc.CallOption) (*gen.StoreHealthReportResponse, error) {
 	_va := make([]interface{}, len(opts))
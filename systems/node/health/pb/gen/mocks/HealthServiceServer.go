@@ -14,6 +14,36 @@ type HealthServiceServer struct {
 	mock.Mock
 }
 
+// ListAlarms provides a mock function with given fields: _a0, _a1
+func (_m *HealthServiceServer) ListAlarms(_a0 context.Context, _a1 *gen.ListAlarmsRequest) (*gen.ListAlarmsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAlarms")
+	}
+
+	var r0 *gen.ListAlarmsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListAlarmsRequest) (*gen.ListAlarmsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListAlarmsRequest) *gen.ListAlarmsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListAlarmsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListAlarmsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListApps provides a mock function with given fields: _a0, _a1
 func (_m *HealthServiceServer) ListApps(_a0 context.Context, _a1 *gen.ListAppsRequest) (*gen.ListAppsResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -44,6 +74,36 @@ func (_m *HealthServiceServer) ListApps(_a0 context.Context, _a1 *gen.ListAppsRe
 	return r0, r1
 }
 
+// ListEvents provides a mock function with given fields: _a0, _a1
+func (_m *HealthServiceServer) ListEvents(_a0 context.Context, _a1 *gen.ListEventsRequest) (*gen.ListEventsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEvents")
+	}
+
+	var r0 *gen.ListEventsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListEventsRequest) (*gen.ListEventsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListEventsRequest) *gen.ListEventsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListEventsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListEventsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListInterfaces provides a mock function with given fields: _a0, _a1
 func (_m *HealthServiceServer) ListInterfaces(_a0 context.Context, _a1 *gen.ListInterfacesRequest) (*gen.ListInterfacesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -104,6 +164,36 @@ func (_m *HealthServiceServer) ListReports(_a0 context.Context, _a1 *gen.ListRep
 	return r0, r1
 }
 
+// QueryMetrics provides a mock function with given fields: _a0, _a1
+func (_m *HealthServiceServer) QueryMetrics(_a0 context.Context, _a1 *gen.QueryMetricsRequest) (*gen.QueryMetricsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryMetrics")
+	}
+
+	var r0 *gen.QueryMetricsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.QueryMetricsRequest) (*gen.QueryMetricsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.QueryMetricsRequest) *gen.QueryMetricsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.QueryMetricsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.QueryMetricsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StoreHealthReport provides a mock function with given fields: _a0, _a1
 func (_m *HealthServiceServer) StoreHealthReport(_a0 context.Context, _a1 *gen.StoreHealthReportRequest) (*gen.StoreHealthReportResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -0,0 +1,161 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package alarm
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ukama/ukama/systems/common/ukama"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/node/health/pkg"
+	"github.com/ukama/ukama/systems/node/health/pkg/db"
+	"github.com/ukama/ukama/systems/node/health/pkg/parser"
+)
+
+/* Transition is an alarm which got raised or cleared */
+type Transition struct {
+	Alarm  *db.Alarm
+	Raised bool
+}
+
+type Correlator struct {
+	cfg pkg.AlarmConfig
+}
+
+func NewCorrelator(cfg pkg.AlarmConfig) *Correlator {
+	if cfg.RaiseCount <= 0 {
+		cfg.RaiseCount = 1
+	}
+
+	return &Correlator{cfg: cfg}
+}
+
+/*
+ * Correlate folds the events of a report into the active alarms of the node.
+ * Returns the alarms to store and the ones which got raised or cleared.
+ * Temperature events are judged by their value: at or above TempRaiseC they count
+ * as an occurrence, at or below TempClearC they clear, in between the alarm keeps
+ * its state so it does not flap around a single threshold.
+ */
+func (c *Correlator) Correlate(nodeId string, nodeType ukama.NodeType, active []*db.Alarm,
+	events []parser.NodeEvent, now time.Time) ([]*db.Alarm, []Transition) {
+	byKey := make(map[string]*db.Alarm, len(active))
+	for _, a := range active {
+		byKey[key(parser.EventType(a.Type), a.Source)] = a
+	}
+
+	sorted := make([]parser.NodeEvent, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].At < sorted[j].At })
+
+	changed := map[*db.Alarm]bool{}
+	var transitions []Transition
+
+	for _, ev := range sorted {
+		k := key(ev.Type, ev.Source)
+		a := byKey[k]
+		at := time.Unix(ev.At, 0).UTC()
+
+		clear := ev.Cleared
+		if ev.Type == parser.EventTemperature && !ev.Cleared {
+			switch {
+			case ev.Value >= c.cfg.TempRaiseC:
+			case ev.Value <= c.cfg.TempClearC:
+				clear = true
+			default:
+				if a != nil {
+					a.LastSeenAt = at
+					a.Value = ev.Value
+					changed[a] = true
+				}
+				continue
+			}
+		}
+
+		if clear {
+			if a == nil {
+				continue
+			}
+
+			if a.State == db.AlarmRaised {
+				transitions = append(transitions, Transition{Alarm: a})
+			}
+			a.State = db.AlarmCleared
+			a.ClearedAt = &at
+			changed[a] = true
+			delete(byKey, k)
+			continue
+		}
+
+		if a == nil {
+			a = &db.Alarm{
+				ID:          uuid.NewV4(),
+				NodeID:      nodeId,
+				NodeType:    nodeType,
+				Type:        string(ev.Type),
+				Source:      ev.Source,
+				Severity:    string(ev.Severity),
+				State:       db.AlarmPending,
+				FirstSeenAt: at,
+			}
+			byKey[k] = a
+		} else if a.State == db.AlarmPending && at.Sub(a.FirstSeenAt) > c.cfg.RaiseWindow {
+			/* Too far apart to count together, start over */
+			a.FirstSeenAt = at
+			a.Count = 0
+		}
+
+		a.Count++
+		a.LastSeenAt = at
+		a.Value = ev.Value
+		a.Message = ev.Message
+		a.Severity = string(parser.MaxSeverity(parser.Severity(a.Severity), ev.Severity))
+		changed[a] = true
+
+		if a.State == db.AlarmPending &&
+			(a.Count >= uint32(c.cfg.RaiseCount) || ev.Severity == parser.SeverityCritical) {
+			a.State = db.AlarmRaised
+			a.RaisedAt = &at
+			transitions = append(transitions, Transition{Alarm: a, Raised: true})
+		}
+	}
+
+	/* Alarms of a condition the node stopped reporting */
+	if c.cfg.ClearAfter > 0 {
+		for k, a := range byKey {
+			if now.Sub(a.LastSeenAt) < c.cfg.ClearAfter {
+				continue
+			}
+
+			if a.State == db.AlarmRaised {
+				transitions = append(transitions, Transition{Alarm: a})
+			}
+			a.State = db.AlarmCleared
+			a.ClearedAt = &now
+			changed[a] = true
+			delete(byKey, k)
+		}
+	}
+
+	out := make([]*db.Alarm, 0, len(changed))
+	for a := range changed {
+		a.UpdatedAt = now
+		out = append(out, a)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return key(parser.EventType(out[i].Type), out[i].Source) < key(parser.EventType(out[j].Type), out[j].Source)
+	})
+
+	return out, transitions
+}
+
+func key(t parser.EventType, source string) string {
+	return string(t) + "/" + source
+}
@@ -0,0 +1,116 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package alarm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ukama/ukama/systems/common/ukama"
+	"github.com/ukama/ukama/systems/node/health/pkg"
+	"github.com/ukama/ukama/systems/node/health/pkg/db"
+	"github.com/ukama/ukama/systems/node/health/pkg/parser"
+)
+
+const testNode = "uk-sa2643-hnode-v0-aaaa"
+
+var cfg = pkg.AlarmConfig{
+	RaiseCount:  2,
+	RaiseWindow: 10 * time.Minute,
+	ClearAfter:  15 * time.Minute,
+	TempRaiseC:  75,
+	TempClearC:  70,
+}
+
+func poe(at time.Time) parser.NodeEvent {
+	return parser.NodeEvent{Type: parser.EventPoeOvercurrent, Severity: parser.SeverityMajor, Source: "port3", At: at.Unix()}
+}
+
+func temp(at time.Time, v float64) parser.NodeEvent {
+	return parser.NodeEvent{Type: parser.EventTemperature, Severity: parser.SeverityWarning, Source: "power", At: at.Unix(), Value: v}
+}
+
+func TestCorrelator_RaiseAfterCount(t *testing.T) {
+	c := NewCorrelator(cfg)
+	t0 := time.Date(2026, 5, 12, 10, 0, 0, 0, time.UTC)
+
+	changed, tr := c.Correlate(testNode, ukama.NODE_TYPE_HOMENODE, nil, []parser.NodeEvent{poe(t0)}, t0)
+	require.Len(t, changed, 1)
+	assert.Equal(t, db.AlarmPending, changed[0].State)
+	assert.Empty(t, tr)
+
+	changed, tr = c.Correlate(testNode, ukama.NODE_TYPE_HOMENODE, changed, []parser.NodeEvent{poe(t0.Add(time.Minute))}, t0.Add(time.Minute))
+	require.Len(t, tr, 1)
+	assert.True(t, tr[0].Raised)
+	assert.Equal(t, db.AlarmRaised, changed[0].State)
+	assert.Equal(t, uint32(2), changed[0].Count)
+
+	/* Quiet for longer than ClearAfter */
+	changed, tr = c.Correlate(testNode, ukama.NODE_TYPE_HOMENODE, changed, nil, t0.Add(20*time.Minute))
+	require.Len(t, tr, 1)
+	assert.False(t, tr[0].Raised)
+	assert.Equal(t, db.AlarmCleared, changed[0].State)
+}
+
+func TestCorrelator_PendingWindowRestarts(t *testing.T) {
+	c := NewCorrelator(pkg.AlarmConfig{RaiseCount: 2, RaiseWindow: 10 * time.Minute})
+	t0 := time.Date(2026, 5, 12, 10, 0, 0, 0, time.UTC)
+
+	active, _ := c.Correlate(testNode, ukama.NODE_TYPE_HOMENODE, nil, []parser.NodeEvent{poe(t0)}, t0)
+	active, tr := c.Correlate(testNode, ukama.NODE_TYPE_HOMENODE, active, []parser.NodeEvent{poe(t0.Add(30 * time.Minute))}, t0.Add(30*time.Minute))
+
+	assert.Empty(t, tr)
+	assert.Equal(t, uint32(1), active[0].Count)
+}
+
+func TestCorrelator_CriticalRaisesAtOnce(t *testing.T) {
+	c := NewCorrelator(cfg)
+	t0 := time.Date(2026, 5, 12, 10, 0, 0, 0, time.UTC)
+	ev := parser.NodeEvent{Type: parser.EventRadioFault, Severity: parser.SeverityCritical, Source: "fem1", At: t0.Unix()}
+
+	changed, tr := c.Correlate(testNode, ukama.NODE_TYPE_HOMENODE, nil, []parser.NodeEvent{ev}, t0)
+
+	require.Len(t, tr, 1)
+	assert.Equal(t, "critical", changed[0].Severity)
+
+	ev.Cleared = true
+	changed, tr = c.Correlate(testNode, ukama.NODE_TYPE_HOMENODE, changed, []parser.NodeEvent{ev}, t0)
+	require.Len(t, tr, 1)
+	assert.False(t, tr[0].Raised)
+	assert.NotNil(t, changed[0].ClearedAt)
+}
+
+func TestCorrelator_TemperatureHysteresis(t *testing.T) {
+	c := NewCorrelator(cfg)
+	t0 := time.Date(2026, 5, 12, 10, 0, 0, 0, time.UTC)
+
+	/* Below the raise threshold nothing happens */
+	active, tr := c.Correlate(testNode, ukama.NODE_TYPE_HOMENODE, nil, []parser.NodeEvent{temp(t0, 73)}, t0)
+	assert.Empty(t, active)
+	assert.Empty(t, tr)
+
+	active, _ = c.Correlate(testNode, ukama.NODE_TYPE_HOMENODE, nil, []parser.NodeEvent{temp(t0, 76), temp(t0.Add(time.Minute), 77)}, t0.Add(time.Minute))
+	require.Len(t, active, 1)
+	assert.Equal(t, db.AlarmRaised, active[0].State)
+
+	/* Between the thresholds the alarm stays raised, well past ClearAfter */
+	for i := 2; i < 40; i++ {
+		at := t0.Add(time.Duration(i) * time.Minute)
+		active, tr = c.Correlate(testNode, ukama.NODE_TYPE_HOMENODE, active, []parser.NodeEvent{temp(at, 72)}, at)
+		require.Empty(t, tr)
+		require.Equal(t, db.AlarmRaised, active[0].State)
+	}
+
+	at := t0.Add(41 * time.Minute)
+	active, tr = c.Correlate(testNode, ukama.NODE_TYPE_HOMENODE, active, []parser.NodeEvent{temp(at, 69)}, at)
+	require.Len(t, tr, 1)
+	assert.Equal(t, db.AlarmCleared, active[0].State)
+}
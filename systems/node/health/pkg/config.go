@@ -12,8 +12,24 @@ import (
 	"time"
 
 	uconf "github.com/ukama/ukama/systems/common/config"
+	metric "github.com/ukama/ukama/systems/common/metrics"
 )
 
+const (
+	NumberOfRejectedReports = "number_of_rejected_health_reports"
+	CounterType             = "counter"
+)
+
+/* Rejections are labelled with the reported schema version and why the report was rejected */
+var ReportMetrics = []metric.MetricConfig{
+	{
+		Name:   NumberOfRejectedReports,
+		Type:   CounterType,
+		Labels: map[string]string{"service": "health", "version": "", "reason": ""},
+		Value:  0,
+	},
+}
+
 type Config struct {
 	uconf.BaseConfig `mapstructure:",squash"`
 	DB               *uconf.Database  `default:"{}"`
@@ -23,6 +39,36 @@ type Config struct {
 	MsgClient        *uconf.MsgClient `default:"{}"`
 	OrgName          string           `default:"ukama"`
 	Service          *uconf.Service
+	Retention        RetentionConfig
+	Alarm            AlarmConfig
+	Pushgateway      string `default:"http://localhost:9091"`
+}
+
+/*
+ * Raw reports are kept for Raw, hourly rollups for Hourly and daily rollups for
+ * Daily, node events for Events. A zero duration keeps that tier forever.
+ */
+type RetentionConfig struct {
+	Raw      time.Duration `default:"168h"`
+	Hourly   time.Duration `default:"2160h"`
+	Daily    time.Duration `default:"17520h"`
+	Events   time.Duration `default:"2160h"`
+	Interval time.Duration `default:"15m"`
+	/* Hour buckets rolled up per run, bounds the work after a long outage */
+	MaxBuckets int `default:"168"`
+}
+
+/*
+ * An alarm is raised once RaiseCount events of a type and source are seen within
+ * RaiseWindow, critical events raise at once. It clears on a cleared event or after
+ * ClearAfter without events. Temperature raises at TempRaiseC and clears at TempClearC.
+ */
+type AlarmConfig struct {
+	RaiseCount  int           `default:"2"`
+	RaiseWindow time.Duration `default:"10m"`
+	ClearAfter  time.Duration `default:"15m"`
+	TempRaiseC  float64       `default:"75"`
+	TempClearC  float64       `default:"70"`
 }
 
 func NewConfig(name string) *Config {
@@ -0,0 +1,113 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"time"
+
+	"github.com/ukama/ukama/systems/common/sql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type EventRepo interface {
+	AddEvents(events []*NodeEvent) error
+	ListEvents(nodeID string, eventType string, from, to time.Time) ([]*NodeEvent, error)
+	DeleteEventsBefore(t time.Time) (int64, error)
+	ActiveAlarms(nodeID string) ([]*Alarm, error)
+	SaveAlarms(alarms []*Alarm) error
+	ListAlarms(nodeID string, activeOnly bool) ([]*Alarm, error)
+}
+
+type eventRepo struct {
+	Db sql.Db
+}
+
+func NewEventRepo(db sql.Db) EventRepo {
+	return &eventRepo{
+		Db: db,
+	}
+}
+
+func (r *eventRepo) AddEvents(events []*NodeEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	return r.Db.GetGormDb().Create(&events).Error
+}
+
+/* ListEvents lists events newest first, all nodes when nodeID is empty */
+func (r *eventRepo) ListEvents(nodeID string, eventType string, from, to time.Time) ([]*NodeEvent, error) {
+	q := r.Db.GetGormDb().Model(&NodeEvent{}).
+		Where(`"occurredAt" >= ? AND "occurredAt" < ?`, from, to).
+		Order(clause.OrderByColumn{Column: clause.Column{Name: "occurredAt"}, Desc: true})
+	if nodeID != "" {
+		q = q.Where(map[string]interface{}{"nodeId": nodeID})
+	}
+	if eventType != "" {
+		q = q.Where(map[string]interface{}{"type": eventType})
+	}
+
+	var events []*NodeEvent
+	err := q.Find(&events).Error
+
+	return events, err
+}
+
+func (r *eventRepo) DeleteEventsBefore(t time.Time) (int64, error) {
+	res := r.Db.GetGormDb().Where(`"occurredAt" < ?`, t).Delete(&NodeEvent{})
+
+	return res.RowsAffected, res.Error
+}
+
+func (r *eventRepo) ActiveAlarms(nodeID string) ([]*Alarm, error) {
+	var alarms []*Alarm
+	err := r.Db.GetGormDb().
+		Where(map[string]interface{}{"nodeId": nodeID}).
+		Where(`"state" IN ?`, []AlarmState{AlarmPending, AlarmRaised}).
+		Find(&alarms).Error
+
+	return alarms, err
+}
+
+func (r *eventRepo) SaveAlarms(alarms []*Alarm) error {
+	if len(alarms) == 0 {
+		return nil
+	}
+
+	return r.Db.GetGormDb().Transaction(func(tx *gorm.DB) error {
+		for _, a := range alarms {
+			if err := tx.Save(a).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+/* ListAlarms lists alarms raised or cleared, newest first. Pending alarms are not shown */
+func (r *eventRepo) ListAlarms(nodeID string, activeOnly bool) ([]*Alarm, error) {
+	q := r.Db.GetGormDb().Model(&Alarm{}).
+		Order(clause.OrderByColumn{Column: clause.Column{Name: "raisedAt"}, Desc: true})
+	if nodeID != "" {
+		q = q.Where(map[string]interface{}{"nodeId": nodeID})
+	}
+	if activeOnly {
+		q = q.Where(map[string]interface{}{"state": AlarmRaised})
+	} else {
+		q = q.Where(`"raisedAt" IS NOT NULL`)
+	}
+
+	var alarms []*Alarm
+	err := q.Find(&alarms).Error
+
+	return alarms, err
+}
@@ -21,6 +21,9 @@ import (
 type HealthRepo interface {
 	List(reportID, nodeID string, reportedAt *time.Time, timeframe ukama.FilterTimeframesType) ([]*HealthReport, error)
 	StoreHealthReport(report *HealthReport, receivedAt time.Time) error
+	ListRange(nodeID string, from, to time.Time, limit int) ([]*HealthReport, error)
+	FirstReportedAt(from time.Time) (*time.Time, error)
+	DeleteBefore(t time.Time) (int64, error)
 }
 
 type healthRepo struct {
@@ -163,3 +166,41 @@ func (r *healthRepo) StoreHealthReport(report *HealthReport, receivedAt time.Tim
 		return syncNodeLatestHealth(tx, report, receivedAt)
 	})
 }
+
+/* ListRange lists reports with from <= reportedAt < to oldest first, all nodes when nodeID is empty */
+func (r *healthRepo) ListRange(nodeID string, from, to time.Time, limit int) ([]*HealthReport, error) {
+	q := r.Db.GetGormDb().Model(&HealthReport{}).
+		Where(`"reportedAt" >= ? AND "reportedAt" < ?`, from, to).
+		Order(clause.OrderByColumn{Column: clause.Column{Name: "reportedAt"}})
+	if nodeID != "" {
+		q = q.Where(map[string]interface{}{"nodeId": nodeID})
+	}
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	var reports []*HealthReport
+	err := q.Find(&reports).Error
+
+	return reports, err
+}
+
+/* FirstReportedAt returns the oldest reportedAt at or after from, nil when there is none */
+func (r *healthRepo) FirstReportedAt(from time.Time) (*time.Time, error) {
+	var first *time.Time
+	err := r.Db.GetGormDb().Model(&HealthReport{}).
+		Where(`"reportedAt" >= ?`, from).
+		Select(`MIN("reportedAt")`).Scan(&first).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return first, nil
+}
+
+/* DeleteBefore purges raw reports, the latest report of every node is kept in NodeLatestHealth */
+func (r *healthRepo) DeleteBefore(t time.Time) (int64, error) {
+	res := r.Db.GetGormDb().Where(`"reportedAt" < ?`, t).Delete(&HealthReport{})
+
+	return res.RowsAffected, res.Error
+}
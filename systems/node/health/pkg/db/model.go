@@ -48,3 +48,67 @@ type NodeLatestHealth struct {
 	Payload       json.RawMessage `gorm:"column:payload;type:jsonb;not null" json:"payload"`
 	UpdatedAt     time.Time       `gorm:"column:updatedAt;not null" json:"updatedAt"`
 }
+
+/*
+ * HealthRollup keeps the metric stats of a node over an hour or a day once raw
+ * reports of that period are rolled up. Stats is a json map of field to rollup.Stat.
+ */
+type HealthRollup struct {
+	NodeID      string          `gorm:"column:nodeId;primaryKey;not null" json:"nodeId"`
+	Resolution  string          `gorm:"column:resolution;primaryKey;not null" json:"resolution"`
+	BucketStart time.Time       `gorm:"column:bucketStart;primaryKey;not null;index" json:"bucketStart"`
+	NodeType    ukama.NodeType  `gorm:"column:nodeType;not null" json:"nodeType"`
+	Samples     uint32          `gorm:"column:samples;not null" json:"samples"`
+	Stats       json.RawMessage `gorm:"column:stats;type:jsonb;not null" json:"stats"`
+	UpdatedAt   time.Time       `gorm:"column:updatedAt;not null" json:"updatedAt"`
+}
+
+/* NodeEvent is a typed event decoded from the events of a health report */
+type NodeEvent struct {
+	ID         uuid.UUID      `gorm:"column:id;type:uuid;primaryKey" json:"id"`
+	NodeID     string         `gorm:"column:nodeId;not null;index" json:"nodeId"`
+	NodeType   ukama.NodeType `gorm:"column:nodeType;not null" json:"nodeType"`
+	ReportID   uuid.UUID      `gorm:"column:reportId;type:uuid;not null" json:"reportId"`
+	Type       string         `gorm:"column:type;not null;index" json:"type"`
+	Severity   string         `gorm:"column:severity;not null" json:"severity"`
+	Source     string         `gorm:"column:source" json:"source"`
+	Value      float64        `gorm:"column:value" json:"value"`
+	Message    string         `gorm:"column:message" json:"message"`
+	Cleared    bool           `gorm:"column:cleared;not null;default:false" json:"cleared"`
+	OccurredAt time.Time      `gorm:"column:occurredAt;not null;index" json:"occurredAt"`
+	ReceivedAt time.Time      `gorm:"column:receivedAt;not null" json:"receivedAt"`
+}
+
+type AlarmState string
+
+const (
+	AlarmPending AlarmState = "pending" /* Seen, not yet past the raise threshold */
+	AlarmRaised  AlarmState = "raised"
+	AlarmCleared AlarmState = "cleared"
+)
+
+/*
+ * Alarm correlates the events of one type and source of a node. An alarm row
+ * lives from its first event until it clears, a later event opens a new one.
+ */
+type Alarm struct {
+	ID          uuid.UUID      `gorm:"column:id;type:uuid;primaryKey" json:"id"`
+	NodeID      string         `gorm:"column:nodeId;not null;index" json:"nodeId"`
+	NodeType    ukama.NodeType `gorm:"column:nodeType;not null" json:"nodeType"`
+	Type        string         `gorm:"column:type;not null" json:"type"`
+	Source      string         `gorm:"column:source" json:"source"`
+	Severity    string         `gorm:"column:severity;not null" json:"severity"`
+	State       AlarmState     `gorm:"column:state;not null;index" json:"state"`
+	Count       uint32         `gorm:"column:count;not null" json:"count"`
+	Value       float64        `gorm:"column:value" json:"value"`
+	Message     string         `gorm:"column:message" json:"message"`
+	FirstSeenAt time.Time      `gorm:"column:firstSeenAt;not null" json:"firstSeenAt"`
+	LastSeenAt  time.Time      `gorm:"column:lastSeenAt;not null" json:"lastSeenAt"`
+	RaisedAt    *time.Time     `gorm:"column:raisedAt" json:"raisedAt,omitempty"`
+	ClearedAt   *time.Time     `gorm:"column:clearedAt" json:"clearedAt,omitempty"`
+	UpdatedAt   time.Time      `gorm:"column:updatedAt;not null" json:"updatedAt"`
+}
+
+func (a *Alarm) Active() bool {
+	return a.State == AlarmPending || a.State == AlarmRaised
+}
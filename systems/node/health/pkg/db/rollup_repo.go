@@ -0,0 +1,96 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"time"
+
+	"github.com/ukama/ukama/systems/common/sql"
+	"gorm.io/gorm/clause"
+)
+
+type RollupRepo interface {
+	Upsert(rollups []*HealthRollup) error
+	List(nodeID string, resolution string, from, to time.Time) ([]*HealthRollup, error)
+	LatestBucket(resolution string) (*time.Time, error)
+	FirstBucket(resolution string, from time.Time) (*time.Time, error)
+	DeleteBefore(resolution string, t time.Time) (int64, error)
+}
+
+type rollupRepo struct {
+	Db sql.Db
+}
+
+func NewRollupRepo(db sql.Db) RollupRepo {
+	return &rollupRepo{
+		Db: db,
+	}
+}
+
+/* Upsert replaces the rollup of a node and bucket, so rolling a bucket up again is harmless */
+func (r *rollupRepo) Upsert(rollups []*HealthRollup) error {
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	return r.Db.GetGormDb().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "nodeId"}, {Name: "resolution"}, {Name: "bucketStart"}},
+		DoUpdates: clause.AssignmentColumns([]string{"nodeType", "samples", "stats", "updatedAt"}),
+	}).Create(&rollups).Error
+}
+
+/* List lists rollups with from <= bucketStart < to oldest first, all nodes when nodeID is empty */
+func (r *rollupRepo) List(nodeID string, resolution string, from, to time.Time) ([]*HealthRollup, error) {
+	q := r.Db.GetGormDb().Model(&HealthRollup{}).
+		Where(map[string]interface{}{"resolution": resolution}).
+		Where(`"bucketStart" >= ? AND "bucketStart" < ?`, from, to).
+		Order(clause.OrderByColumn{Column: clause.Column{Name: "bucketStart"}})
+	if nodeID != "" {
+		q = q.Where(map[string]interface{}{"nodeId": nodeID})
+	}
+
+	var rollups []*HealthRollup
+	err := q.Find(&rollups).Error
+
+	return rollups, err
+}
+
+func (r *rollupRepo) LatestBucket(resolution string) (*time.Time, error) {
+	var latest *time.Time
+	err := r.Db.GetGormDb().Model(&HealthRollup{}).
+		Where(map[string]interface{}{"resolution": resolution}).
+		Select(`MAX("bucketStart")`).Scan(&latest).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return latest, nil
+}
+
+func (r *rollupRepo) FirstBucket(resolution string, from time.Time) (*time.Time, error) {
+	var first *time.Time
+	err := r.Db.GetGormDb().Model(&HealthRollup{}).
+		Where(map[string]interface{}{"resolution": resolution}).
+		Where(`"bucketStart" >= ?`, from).
+		Select(`MIN("bucketStart")`).Scan(&first).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return first, nil
+}
+
+func (r *rollupRepo) DeleteBefore(resolution string, t time.Time) (int64, error) {
+	res := r.Db.GetGormDb().
+		Where(map[string]interface{}{"resolution": resolution}).
+		Where(`"bucketStart" < ?`, t).
+		Delete(&HealthRollup{})
+
+	return res.RowsAffected, res.Error
+}
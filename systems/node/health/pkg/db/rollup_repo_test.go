@@ -0,0 +1,91 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	int_db "github.com/ukama/ukama/systems/node/health/pkg/db"
+)
+
+func newRollupRepo(t *testing.T) (int_db.RollupRepo, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		DSN:                  "sqlmock_db_0",
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+	gdb, err := gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(t, err)
+
+	return int_db.NewRollupRepo(&UkamaDbMock{GormDb: gdb}), mock
+}
+
+func TestRollupRepo(t *testing.T) {
+	bucket := time.Date(2026, 5, 12, 13, 0, 0, 0, time.UTC)
+
+	t.Run("upsert", func(t *testing.T) {
+		r, mock := newRollupRepo(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO "health_rollups".*ON CONFLICT \("nodeId","resolution","bucketStart"\) DO UPDATE`).
+			WithArgs("uk-sa2643-hnode-v0-aaaa", "hour", bucket, "hnode", 3, []byte(`{}`), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		err := r.Upsert([]*int_db.HealthRollup{{
+			NodeID: "uk-sa2643-hnode-v0-aaaa", Resolution: "hour", BucketStart: bucket,
+			NodeType: "hnode", Samples: 3, Stats: []byte(`{}`), UpdatedAt: time.Now(),
+		}})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("latest bucket", func(t *testing.T) {
+		r, mock := newRollupRepo(t)
+
+		mock.ExpectQuery(`SELECT MAX\("bucketStart"\) FROM "health_rollups"`).
+			WithArgs("day").
+			WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(bucket))
+
+		latest, err := r.LatestBucket("day")
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, latest) {
+			assert.True(t, latest.Equal(bucket))
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("delete before", func(t *testing.T) {
+		r, mock := newRollupRepo(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM "health_rollups"`).
+			WithArgs("hour", bucket).
+			WillReturnResult(sqlmock.NewResult(0, 24))
+		mock.ExpectCommit()
+
+		n, err := r.DeleteBefore("hour", bucket)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(24), n)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,183 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type EventType string
+
+const (
+	EventRadioFault     EventType = "radio_fault"
+	EventPoeOvercurrent EventType = "poe_overcurrent"
+	EventTemperature    EventType = "temperature"
+	EventGpsLoss        EventType = "gps_loss"
+	EventBackhaulFlap   EventType = "backhaul_flap"
+)
+
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityMajor    Severity = "major"
+	SeverityCritical Severity = "critical"
+)
+
+var severityRank = map[Severity]int{
+	SeverityInfo:     0,
+	SeverityWarning:  1,
+	SeverityMajor:    2,
+	SeverityCritical: 3,
+}
+
+/* Severity of an event type when the node does not send one */
+var defaultSeverity = map[EventType]Severity{
+	EventRadioFault:     SeverityMajor,
+	EventPoeOvercurrent: SeverityMajor,
+	EventTemperature:    SeverityWarning,
+	EventGpsLoss:        SeverityWarning,
+	EventBackhaulFlap:   SeverityWarning,
+}
+
+func ParseSeverity(s string) (Severity, error) {
+	sev := Severity(strings.ToLower(strings.TrimSpace(s)))
+	if _, ok := severityRank[sev]; !ok {
+		return "", fmt.Errorf("invalid severity %q", s)
+	}
+
+	return sev, nil
+}
+
+/* AtLeast tells if s is as severe as o or more */
+func (s Severity) AtLeast(o Severity) bool {
+	return severityRank[s] >= severityRank[o]
+}
+
+func MaxSeverity(a, b Severity) Severity {
+	if a.AtLeast(b) {
+		return a
+	}
+
+	return b
+}
+
+/*
+ * NodeEvent is an entry of the events array of a health payload:
+ *   {"type": "poe_overcurrent", "severity": "major", "source": "port3",
+ *    "at": 1779534357, "value": 0.9, "message": "...", "cleared": false}
+ * Source tells apart instances of the same type (switch port, FEM unit..).
+ * A cleared event tells the condition is gone.
+ */
+type NodeEvent struct {
+	Type     EventType `json:"type"`
+	Severity Severity  `json:"severity"`
+	Source   string    `json:"source"`
+	At       int64     `json:"at"`
+	Value    float64   `json:"value"`
+	Message  string    `json:"message"`
+	Cleared  bool      `json:"cleared"`
+}
+
+func IsEventType(t EventType) bool {
+	_, ok := defaultSeverity[t]
+
+	return ok
+}
+
+/*
+ * DecodeEvents decodes the typed events of a payload. Events of unknown type or
+ * shape are returned as errors and skipped, they don't fail the report.
+ */
+func DecodeEvents(p *HealthPayload) ([]NodeEvent, []error) {
+	var events []NodeEvent
+	var errs []error
+
+	for i, raw := range p.Events {
+		var e struct {
+			NodeEvent
+			At json.RawMessage `json:"at"`
+		}
+		if err := json.Unmarshal(raw, &e); err != nil {
+			errs = append(errs, fmt.Errorf("event %d: %w", i, err))
+			continue
+		}
+
+		ev := e.NodeEvent
+		ev.Type = EventType(strings.ToLower(string(ev.Type)))
+		if !IsEventType(ev.Type) {
+			errs = append(errs, fmt.Errorf("event %d: unknown type %q", i, ev.Type))
+			continue
+		}
+
+		if ev.Severity == "" {
+			ev.Severity = defaultSeverity[ev.Type]
+		} else {
+			sev, err := ParseSeverity(string(ev.Severity))
+			if err != nil {
+				errs = append(errs, fmt.Errorf("event %d: %w", i, err))
+				continue
+			}
+			ev.Severity = sev
+		}
+
+		at, err := parseUnixTimestamp(e.At)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("event %d: %w", i, err))
+			continue
+		}
+		ev.At = at
+		if ev.At == 0 {
+			ev.At = p.ReportedAt
+		}
+
+		events = append(events, ev)
+	}
+
+	return events, errs
+}
+
+/* parseUnixTimestamp reads the time of an event, unix seconds sent as a number or a string */
+func parseUnixTimestamp(raw json.RawMessage) (int64, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return 0, nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err == nil {
+		sec, err := n.Int64()
+		if err != nil {
+			f, err := n.Float64()
+			if err != nil {
+				return 0, err
+			}
+			sec = int64(f)
+		}
+		return sec, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return 0, err
+	}
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("timestamp must be unix seconds: %w", err)
+	}
+	return sec, nil
+}
@@ -0,0 +1,41 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package parser
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeEvents(t *testing.T) {
+	p, err := ParseHealthPayload(json.RawMessage(`{
+		"schemaVersion": "1.0",
+		"reportedAt": "1779534357",
+		"events": [
+			{"type": "POE_OVERCURRENT", "source": "port3", "at": "1779534300", "value": 0.92},
+			{"type": "gps_loss", "severity": "major"},
+			{"type": "backhaul_flap", "severity": "catastrophic"},
+			{"type": "fan_stall"},
+			"not an object"
+		]
+	}`))
+	require.NoError(t, err)
+
+	events, errs := DecodeEvents(p)
+
+	assert.Len(t, errs, 3)
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, NodeEvent{Type: EventPoeOvercurrent, Severity: SeverityMajor, Source: "port3", At: 1779534300, Value: 0.92}, events[0])
+		assert.Equal(t, SeverityMajor, events[1].Severity)
+		assert.Equal(t, int64(1779534357), events[1].At)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package parser
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+/*
+ * ProjectPayload keeps only the given dotted paths of a health payload, e.g.
+ * "interfaces.controller.battery" or "system.power.totalWatts". Paths missing
+ * from the payload are left out of the result.
+ */
+func ProjectPayload(raw json.RawMessage, paths []string) (json.RawMessage, error) {
+	if len(paths) == 0 {
+		return raw, nil
+	}
+
+	var src map[string]interface{}
+	if err := json.Unmarshal(raw, &src); err != nil {
+		return nil, err
+	}
+
+	out := map[string]interface{}{}
+	for _, p := range paths {
+		keys := strings.Split(strings.Trim(p, "."), ".")
+		if v, ok := lookup(src, keys); ok {
+			set(out, keys, v)
+		}
+	}
+
+	return json.Marshal(out)
+}
+
+func lookup(m map[string]interface{}, keys []string) (interface{}, bool) {
+	v, ok := m[keys[0]]
+	if !ok {
+		return nil, false
+	}
+
+	if len(keys) == 1 {
+		return v, true
+	}
+
+	next, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	return lookup(next, keys[1:])
+}
+
+func set(m map[string]interface{}, keys []string, v interface{}) {
+	if len(keys) == 1 {
+		m[keys[0]] = v
+		return
+	}
+
+	next, ok := m[keys[0]].(map[string]interface{})
+	if !ok {
+		next = map[string]interface{}{}
+		m[keys[0]] = next
+	}
+
+	set(next, keys[1:], v)
+}
@@ -0,0 +1,34 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package parser
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectPayload(t *testing.T) {
+	raw := json.RawMessage(`{
+		"nodeType": "hnode",
+		"system": {"uptimeSec": 10, "power": {"totalWatts": 42}},
+		"interfaces": {"controller": {"battery": {"socPct": 80}, "solar": {"powerW": 3}}}
+	}`)
+
+	out, err := ProjectPayload(raw, []string{"interfaces.controller.battery", "system.power.totalWatts", "apps.missing"})
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"interfaces": {"controller": {"battery": {"socPct": 80}}}, "system": {"power": {"totalWatts": 42}}}`, string(out))
+
+	out, err = ProjectPayload(raw, nil)
+	require.NoError(t, err)
+	assert.Equal(t, string(raw), string(out))
+}
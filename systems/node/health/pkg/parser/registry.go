@@ -0,0 +1,147 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+/* Payloads without a schemaVersion predate versioning and are read as this version */
+const DefaultSchemaVersion = "0"
+
+/* Versioned is a payload decoded in the layout of its schema version */
+type Versioned interface {
+	Upgrade() (*HealthPayload, error)
+}
+
+/* payloadBody is what the layouts of every schema version so far share */
+type payloadBody struct {
+	SchemaVersion string            `json:"schemaVersion"`
+	NodeID        string            `json:"nodeId"`
+	NodeType      string            `json:"nodeType"`
+	Capabilities  []string          `json:"capabilities"`
+	System        HealthSystem      `json:"system"`
+	Interfaces    HealthInterfaces  `json:"interfaces"`
+	Apps          []HealthApp       `json:"apps"`
+	Events        []json.RawMessage `json:"events"`
+}
+
+func (b *payloadBody) canonical(reportedAt int64) *HealthPayload {
+	return &HealthPayload{
+		SchemaVersion: b.SchemaVersion,
+		NodeID:        b.NodeID,
+		NodeType:      b.NodeType,
+		ReportedAt:    reportedAt,
+		Capabilities:  b.Capabilities,
+		System:        b.System,
+		Interfaces:    b.Interfaces,
+		Apps:          b.Apps,
+		Events:        b.Events,
+	}
+}
+
+/* Decoder decodes a raw payload in the layout of one schema version */
+type Decoder func(raw json.RawMessage) (Versioned, error)
+
+type UnsupportedVersionError struct {
+	Version string
+}
+
+func (e *UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("unsupported schema version %q", e.Version)
+}
+
+/*
+ * Registry maps schema versions to their decoders. Versions are keyed by their
+ * major number, "1", "1.0" and "v1.2" all use the decoder registered for "1".
+ */
+type Registry struct {
+	decoders map[string]Decoder
+}
+
+func NewRegistry() *Registry {
+	return &Registry{decoders: map[string]Decoder{}}
+}
+
+func (r *Registry) Register(version string, d Decoder) {
+	r.decoders[MajorVersion(version)] = d
+}
+
+func (r *Registry) Versions() []string {
+	v := make([]string, 0, len(r.decoders))
+	for k := range r.decoders {
+		v = append(v, k)
+	}
+	sort.Strings(v)
+
+	return v
+}
+
+func (r *Registry) Supports(version string) bool {
+	_, ok := r.decoders[MajorVersion(version)]
+
+	return ok
+}
+
+/* Parse decodes the payload with the decoder of its schema version and upgrades it to HealthPayload */
+func (r *Registry) Parse(raw json.RawMessage) (*HealthPayload, error) {
+	if len(raw) == 0 {
+		raw = []byte("{}")
+	}
+
+	var header struct {
+		SchemaVersion string `json:"schemaVersion"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, err
+	}
+
+	d, ok := r.decoders[MajorVersion(header.SchemaVersion)]
+	if !ok {
+		return nil, &UnsupportedVersionError{Version: header.SchemaVersion}
+	}
+
+	v, err := d(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.Upgrade()
+}
+
+/* MajorVersion normalizes a schema version to its major number */
+func MajorVersion(version string) string {
+	v := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(version)), "v")
+	if v == "" {
+		return DefaultSchemaVersion
+	}
+
+	return strings.SplitN(v, ".", 2)[0]
+}
+
+var defaultRegistry = newDefaultRegistry()
+
+func newDefaultRegistry() *Registry {
+	r := NewRegistry()
+	r.Register("0", decodeV0)
+	r.Register("1", decodeV1)
+
+	return r
+}
+
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+func ParseHealthPayload(raw json.RawMessage) (*HealthPayload, error) {
+	return defaultRegistry.Parse(raw)
+}
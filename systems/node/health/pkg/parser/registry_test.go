@@ -0,0 +1,87 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package parser
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+/*
+ * Every testdata/v<major>/<name>.json is parsed and the canonical payload is
+ * compared with <name>.golden. Run with -update after an intended change.
+ */
+func TestParseHealthPayloadGolden(t *testing.T) {
+	for _, version := range DefaultRegistry().Versions() {
+		files, err := filepath.Glob(filepath.Join("testdata", "v"+version, "*.json"))
+		require.NoError(t, err)
+		require.NotEmpty(t, files, "no golden files for schema version %s", version)
+
+		for _, f := range files {
+			t.Run(version+"/"+filepath.Base(f), func(t *testing.T) {
+				raw, err := os.ReadFile(f)
+				require.NoError(t, err)
+
+				p, err := ParseHealthPayload(raw)
+				require.NoError(t, err)
+
+				got, err := json.MarshalIndent(p, "", "  ")
+				require.NoError(t, err)
+
+				golden := strings.TrimSuffix(f, ".json") + ".golden"
+				if *update {
+					require.NoError(t, os.WriteFile(golden, append(got, '\n'), 0644))
+				}
+
+				want, err := os.ReadFile(golden)
+				require.NoError(t, err)
+				assert.JSONEq(t, string(want), string(got))
+			})
+		}
+	}
+}
+
+func TestParseHealthPayloadUnsupportedVersion(t *testing.T) {
+	raw, err := os.ReadFile(filepath.Join("testdata", "unsupported", "v2.json"))
+	require.NoError(t, err)
+
+	_, err = ParseHealthPayload(raw)
+
+	var uerr *UnsupportedVersionError
+	if assert.True(t, errors.As(err, &uerr)) {
+		assert.Equal(t, "2.0", uerr.Version)
+	}
+}
+
+func TestMajorVersion(t *testing.T) {
+	assert.Equal(t, "0", MajorVersion(""))
+	assert.Equal(t, "1", MajorVersion("1.0"))
+	assert.Equal(t, "2", MajorVersion("V2.1"))
+	assert.True(t, DefaultRegistry().Supports("1.3"))
+	assert.False(t, DefaultRegistry().Supports("3"))
+}
+
+func TestParseHealthPayloadWrongShape(t *testing.T) {
+	/* lookoutd sends reportedAt as a string, the firmware before it as a number */
+	_, err := ParseHealthPayload([]byte(`{"schemaVersion": "1.0", "reportedAt": 1779534357}`))
+	assert.Error(t, err)
+
+	_, err = ParseHealthPayload([]byte(`{"reportedAt": "1779534357"}`))
+	assert.Error(t, err)
+}
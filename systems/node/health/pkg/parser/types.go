@@ -10,11 +10,9 @@ package parser
 
 import (
 	"encoding/json"
-	"fmt"
-	"strconv"
-	"strings"
 )
 
+/* HealthPayload is the canonical model every schema version is upgraded to */
 type HealthPayload struct {
 	SchemaVersion string            `json:"schemaVersion"`
 	NodeID        string            `json:"nodeId"`
@@ -27,80 +25,6 @@ type HealthPayload struct {
 	Events        []json.RawMessage `json:"events"`
 }
 
-func ParseHealthPayload(raw json.RawMessage) (*HealthPayload, error) {
-	if len(raw) == 0 {
-		raw = []byte("{}")
-	}
-
-	var reportedAt json.RawMessage
-	var envelope struct {
-		SchemaVersion string            `json:"schemaVersion"`
-		NodeID        string            `json:"nodeId"`
-		NodeType      string            `json:"nodeType"`
-		ReportedAt    json.RawMessage   `json:"reportedAt"`
-		Capabilities  []string          `json:"capabilities"`
-		System        HealthSystem      `json:"system"`
-		Interfaces    HealthInterfaces  `json:"interfaces"`
-		Apps          []HealthApp       `json:"apps"`
-		Events        []json.RawMessage `json:"events"`
-	}
-	if err := json.Unmarshal(raw, &envelope); err != nil {
-		return nil, err
-	}
-	reportedAt = envelope.ReportedAt
-
-	reportedSec, err := parseUnixTimestamp(reportedAt)
-	if err != nil {
-		return nil, err
-	}
-
-	return &HealthPayload{
-		SchemaVersion: envelope.SchemaVersion,
-		NodeID:        envelope.NodeID,
-		NodeType:      envelope.NodeType,
-		ReportedAt:    reportedSec,
-		Capabilities:  envelope.Capabilities,
-		System:        envelope.System,
-		Interfaces:    envelope.Interfaces,
-		Apps:          envelope.Apps,
-		Events:        envelope.Events,
-	}, nil
-}
-
-func parseUnixTimestamp(raw json.RawMessage) (int64, error) {
-	if len(raw) == 0 || string(raw) == "null" {
-		return 0, nil
-	}
-
-	var n json.Number
-	if err := json.Unmarshal(raw, &n); err == nil {
-		sec, err := n.Int64()
-		if err != nil {
-			f, err := n.Float64()
-			if err != nil {
-				return 0, err
-			}
-			sec = int64(f)
-		}
-		return sec, nil
-	}
-
-	var s string
-	if err := json.Unmarshal(raw, &s); err != nil {
-		return 0, err
-	}
-	s = strings.TrimSpace(s)
-	if s == "" {
-		return 0, nil
-	}
-
-	sec, err := strconv.ParseInt(s, 10, 64)
-	if err != nil {
-		return 0, fmt.Errorf("reportedAt must be unix seconds: %w", err)
-	}
-	return sec, nil
-}
-
 type HealthSystem struct {
 	UptimeSec int64           `json:"uptimeSec"`
 	Starter   StarterStatus   `json:"starter"`
@@ -26,7 +26,6 @@ func TestParseHealthPayloadUnixReportedAt(t *testing.T) {
 	assert.Equal(t, int64(1779534357), payload.ReportedAt)
 
 	payload, err = ParseHealthPayload(json.RawMessage(`{
-		"schemaVersion": "1",
 		"nodeType": "HomeNode",
 		"reportedAt": 1779534357
 	}`))
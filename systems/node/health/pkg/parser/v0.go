@@ -0,0 +1,36 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package parser
+
+import (
+	"encoding/json"
+)
+
+/*
+ * payloadV0 is the layout of the firmware predating lookoutd, which sends no
+ * schemaVersion. reportedAt is unix seconds sent as a number, fractional on
+ * some builds.
+ */
+type payloadV0 struct {
+	payloadBody
+	ReportedAt float64 `json:"reportedAt"`
+}
+
+func decodeV0(raw json.RawMessage) (Versioned, error) {
+	p := &payloadV0{}
+	if err := json.Unmarshal(raw, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (p *payloadV0) Upgrade() (*HealthPayload, error) {
+	return p.canonical(int64(p.ReportedAt)), nil
+}
@@ -0,0 +1,44 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/* payloadV1 is the layout sent by lookoutd 1.x, reportedAt is unix seconds sent as a string */
+type payloadV1 struct {
+	payloadBody
+	ReportedAt string `json:"reportedAt"`
+}
+
+func decodeV1(raw json.RawMessage) (Versioned, error) {
+	p := &payloadV1{}
+	if err := json.Unmarshal(raw, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (p *payloadV1) Upgrade() (*HealthPayload, error) {
+	var reportedSec int64
+	if s := strings.TrimSpace(p.ReportedAt); s != "" {
+		sec, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("reportedAt must be unix seconds: %w", err)
+		}
+		reportedSec = sec
+	}
+
+	return p.canonical(reportedSec), nil
+}
@@ -0,0 +1,225 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package rollup
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ukama/ukama/systems/node/health/pkg/parser"
+)
+
+type Resolution string
+
+const (
+	ResolutionRaw  Resolution = "raw"
+	ResolutionHour Resolution = "hour"
+	ResolutionDay  Resolution = "day"
+)
+
+func ParseResolution(s string) (Resolution, error) {
+	switch Resolution(strings.ToLower(s)) {
+	case ResolutionRaw:
+		return ResolutionRaw, nil
+	case ResolutionHour:
+		return ResolutionHour, nil
+	case ResolutionDay:
+		return ResolutionDay, nil
+	}
+
+	return "", fmt.Errorf("invalid resolution %q", s)
+}
+
+/* Bucket returns the start of the bucket t falls into, buckets are UTC aligned */
+func (r Resolution) Bucket(t time.Time) time.Time {
+	t = t.UTC()
+	switch r {
+	case ResolutionHour:
+		return t.Truncate(time.Hour)
+	case ResolutionDay:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	return t
+}
+
+func (r Resolution) Duration() time.Duration {
+	switch r {
+	case ResolutionHour:
+		return time.Hour
+	case ResolutionDay:
+		return 24 * time.Hour
+	}
+
+	return 0
+}
+
+/* Metric fields kept in rollups, named after the json path in the health payload */
+const (
+	BatteryVoltage     = "controller.battery.voltageV"
+	BatteryCurrent     = "controller.battery.currentA"
+	BatterySoc         = "controller.battery.socPct"
+	SolarVoltage       = "controller.solar.voltageV"
+	SolarCurrent       = "controller.solar.currentA"
+	SolarPower         = "controller.solar.powerW"
+	LoadCurrent        = "controller.load.currentA"
+	RadioAvailable     = "radio.available"
+	BackhaulAvailable  = "backhaul.available"
+	BackhaulConfidence = "backhaul.confidence"
+)
+
+var Fields = []string{
+	BatteryVoltage, BatteryCurrent, BatterySoc,
+	SolarVoltage, SolarCurrent, SolarPower,
+	LoadCurrent,
+	RadioAvailable,
+	BackhaulAvailable, BackhaulConfidence,
+}
+
+func IsField(f string) bool {
+	for _, k := range Fields {
+		if k == f {
+			return true
+		}
+	}
+
+	return false
+}
+
+/* Extract returns the rollup metrics present in a health payload */
+func Extract(p *parser.HealthPayload) map[string]float64 {
+	m := map[string]float64{}
+	i := p.Interfaces
+
+	if c := i.Controller; c != nil && c.Available {
+		m[BatteryVoltage] = c.Battery.VoltageV
+		m[BatteryCurrent] = c.Battery.CurrentA
+		m[BatterySoc] = float64(c.Battery.SocPct)
+		m[SolarVoltage] = c.Solar.VoltageV
+		m[SolarCurrent] = c.Solar.CurrentA
+		m[SolarPower] = c.Solar.PowerW
+		m[LoadCurrent] = c.Load.CurrentA
+	}
+
+	if r := i.Radio; r != nil {
+		m[RadioAvailable] = boolToFloat(r.Available)
+	}
+
+	if b := i.Backhaul; b != nil {
+		m[BackhaulAvailable] = boolToFloat(b.Available)
+		m[BackhaulConfidence] = b.Confidence
+	}
+
+	return m
+}
+
+/* Stat keeps what is needed to merge buckets, averages are derived on read */
+type Stat struct {
+	Count uint32  `json:"count"`
+	Sum   float64 `json:"sum"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+}
+
+func (s *Stat) Add(v float64) {
+	if s.Count == 0 {
+		s.Min, s.Max = v, v
+	} else {
+		s.Min = math.Min(s.Min, v)
+		s.Max = math.Max(s.Max, v)
+	}
+	s.Count++
+	s.Sum += v
+}
+
+func (s *Stat) Merge(o Stat) {
+	if o.Count == 0 {
+		return
+	}
+	if s.Count == 0 {
+		*s = o
+		return
+	}
+	s.Min = math.Min(s.Min, o.Min)
+	s.Max = math.Max(s.Max, o.Max)
+	s.Count += o.Count
+	s.Sum += o.Sum
+}
+
+func (s Stat) Avg() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+
+	return s.Sum / float64(s.Count)
+}
+
+/* Stats of all the fields of one node in one bucket */
+type Stats map[string]Stat
+
+func (st Stats) AddSample(m map[string]float64) {
+	for k, v := range m {
+		s := st[k]
+		s.Add(v)
+		st[k] = s
+	}
+}
+
+func (st Stats) Merge(o Stats) {
+	for k, v := range o {
+		s := st[k]
+		s.Merge(v)
+		st[k] = s
+	}
+}
+
+/* Project keeps only the requested fields, all fields when none are requested */
+func (st Stats) Project(fields []string) Stats {
+	if len(fields) == 0 {
+		return st
+	}
+
+	out := Stats{}
+	for _, f := range fields {
+		if s, ok := st[f]; ok {
+			out[f] = s
+		}
+	}
+
+	return out
+}
+
+/* ValidateFields rejects unknown fields so typos don't silently return nothing */
+func ValidateFields(fields []string) error {
+	var unknown []string
+	for _, f := range fields {
+		if !IsField(f) {
+			unknown = append(unknown, f)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown fields %s, expected any of %s",
+			strings.Join(unknown, ","), strings.Join(Fields, ","))
+	}
+
+	return nil
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,67 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package rollup
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ukama/ukama/systems/node/health/pkg/parser"
+)
+
+func TestExtractAndMerge(t *testing.T) {
+	p, err := parser.ParseHealthPayload(json.RawMessage(`{
+		"reportedAt": 1779534357,
+		"interfaces": {
+			"controller": {"available": true, "battery": {"voltageV": 12.5, "socPct": 80}, "solar": {"powerW": 120}},
+			"backhaul": {"available": true, "confidence": 0.9}
+		}
+	}`))
+	require.NoError(t, err)
+
+	m := Extract(p)
+	assert.Equal(t, 12.5, m[BatteryVoltage])
+	assert.Equal(t, float64(80), m[BatterySoc])
+	assert.Equal(t, float64(1), m[BackhaulAvailable])
+	_, ok := m[RadioAvailable]
+	assert.False(t, ok)
+
+	hour1 := Stats{}
+	hour1.AddSample(map[string]float64{BatterySoc: 80})
+	hour1.AddSample(map[string]float64{BatterySoc: 60})
+	hour2 := Stats{}
+	hour2.AddSample(map[string]float64{BatterySoc: 40, SolarPower: 10})
+
+	day := Stats{}
+	day.Merge(hour1)
+	day.Merge(hour2)
+
+	assert.Equal(t, Stat{Count: 3, Sum: 180, Min: 40, Max: 80}, day[BatterySoc])
+	assert.Equal(t, float64(60), day[BatterySoc].Avg())
+	assert.Len(t, day.Project([]string{SolarPower}), 1)
+}
+
+func TestResolution(t *testing.T) {
+	ts := time.Date(2026, 5, 12, 13, 45, 10, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2026, 5, 12, 13, 0, 0, 0, time.UTC), ResolutionHour.Bucket(ts))
+	assert.Equal(t, time.Date(2026, 5, 12, 0, 0, 0, 0, time.UTC), ResolutionDay.Bucket(ts))
+
+	r, err := ParseResolution("Hour")
+	assert.NoError(t, err)
+	assert.Equal(t, ResolutionHour, r)
+	_, err = ParseResolution("week")
+	assert.Error(t, err)
+
+	assert.NoError(t, ValidateFields([]string{BatterySoc}))
+	assert.Error(t, ValidateFields([]string{"battery.soc"}))
+}
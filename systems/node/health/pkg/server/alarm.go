@@ -0,0 +1,227 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/ukama/ukama/systems/common/grpc"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/node/health/pkg/alarm"
+	"github.com/ukama/ukama/systems/node/health/pkg/db"
+	"github.com/ukama/ukama/systems/node/health/pkg/parser"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	pb "github.com/ukama/ukama/systems/node/health/pb/gen"
+)
+
+/* Source of the temperature samples taken from system.power of every report */
+const powerBoardSource = "power"
+
+/*
+ * processEvents stores the typed events of a report and correlates them into the
+ * node's alarms. Best-effort: failures are logged and never fail the report.
+ */
+func (h *HealthServer) processEvents(report *db.HealthReport, parsed *parser.HealthPayload) {
+	if h.eRepo == nil {
+		return
+	}
+
+	events, errs := parser.DecodeEvents(parsed)
+	for _, err := range errs {
+		log.Warnf("Skipping event of report %s from node %s: %v", report.ID.String(), report.NodeID, err)
+	}
+
+	stored := make([]*db.NodeEvent, 0, len(events))
+	for _, e := range events {
+		stored = append(stored, &db.NodeEvent{
+			ID:         uuid.NewV4(),
+			NodeID:     report.NodeID,
+			NodeType:   report.NodeType,
+			ReportID:   report.ID,
+			Type:       string(e.Type),
+			Severity:   string(e.Severity),
+			Source:     e.Source,
+			Value:      e.Value,
+			Message:    e.Message,
+			Cleared:    e.Cleared,
+			OccurredAt: time.Unix(e.At, 0).UTC(),
+			ReceivedAt: report.ReceivedAt,
+		})
+	}
+
+	if err := h.eRepo.AddEvents(stored); err != nil {
+		log.Errorf("Failed to store events of report %s. Error: %v", report.ID.String(), err)
+	}
+
+	/* Board temperature is a reading, not an event, it only feeds the temperature alarm */
+	if parsed.System.Power.Available {
+		events = append(events, parser.NodeEvent{
+			Type:     parser.EventTemperature,
+			Severity: parser.SeverityWarning,
+			Source:   powerBoardSource,
+			At:       parsed.ReportedAt,
+			Value:    parsed.System.Power.TemperatureC,
+		})
+	}
+
+	active, err := h.eRepo.ActiveAlarms(report.NodeID)
+	if err != nil {
+		log.Errorf("Failed to read alarms of node %s. Error: %v", report.NodeID, err)
+		return
+	}
+
+	changed, transitions := h.correlator.Correlate(report.NodeID, report.NodeType, active, events, time.Now().UTC())
+	if err := h.eRepo.SaveAlarms(changed); err != nil {
+		log.Errorf("Failed to store alarms of node %s. Error: %v", report.NodeID, err)
+		return
+	}
+
+	for _, t := range transitions {
+		h.publishAlarm(t)
+	}
+}
+
+func (h *HealthServer) publishAlarm(t alarm.Transition) {
+	if h.msgbus == nil {
+		return
+	}
+
+	action := "clear"
+	if t.Raised {
+		action = "raise"
+	}
+
+	evt := alarmToEvent(t.Alarm)
+	route := h.healthRoutingKey.SetAction(action).SetObject("alarm").MustBuild()
+	log.Infof("Publishing alarm event %+v with key %+v", evt, route)
+	if err := h.msgbus.PublishRequest(route, evt); err != nil {
+		log.Errorf("Failed to publish alarm event %+v with key %+v. Errors %s", evt, route, err.Error())
+	}
+}
+
+func (h *HealthServer) ListEvents(ctx context.Context, req *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
+	log.Infof("ListEvents: %v", req)
+
+	from, to := timeRange(req.GetFrom(), req.GetTo())
+	if !from.Before(to) {
+		return nil, status.Errorf(codes.InvalidArgument, "from must be before to")
+	}
+
+	minSeverity := parser.SeverityInfo
+	if req.GetMinSeverity() != "" {
+		var err error
+		minSeverity, err = parser.ParseSeverity(req.GetMinSeverity())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+	}
+
+	events, err := h.eRepo.ListEvents(strings.ToLower(req.GetNodeId()), strings.ToLower(req.GetType()), from, to)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "event")
+	}
+
+	resp := &pb.ListEventsResponse{Events: make([]*pb.NodeEvent, 0, len(events))}
+	for _, e := range events {
+		if !parser.Severity(e.Severity).AtLeast(minSeverity) {
+			continue
+		}
+		resp.Events = append(resp.Events, nodeEventToPb(e))
+	}
+
+	return resp, nil
+}
+
+func (h *HealthServer) ListAlarms(ctx context.Context, req *pb.ListAlarmsRequest) (*pb.ListAlarmsResponse, error) {
+	log.Infof("ListAlarms: %v", req)
+
+	alarms, err := h.eRepo.ListAlarms(strings.ToLower(req.GetNodeId()), req.GetActiveOnly())
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "alarm")
+	}
+
+	resp := &pb.ListAlarmsResponse{Alarms: make([]*pb.Alarm, 0, len(alarms))}
+	for _, a := range alarms {
+		resp.Alarms = append(resp.Alarms, alarmToPb(a))
+	}
+
+	return resp, nil
+}
+
+func alarmToEvent(a *db.Alarm) *epb.HealthAlarmEvent {
+	evt := &epb.HealthAlarmEvent{
+		AlarmId:  a.ID.String(),
+		NodeId:   a.NodeID,
+		NodeType: a.NodeType.String(),
+		Type:     a.Type,
+		Source:   a.Source,
+		Severity: a.Severity,
+		State:    string(a.State),
+		Count:    a.Count,
+		Value:    a.Value,
+		Message:  a.Message,
+	}
+
+	if a.RaisedAt != nil {
+		evt.RaisedAt = a.RaisedAt.Unix()
+	}
+	if a.ClearedAt != nil {
+		evt.ClearedAt = a.ClearedAt.Unix()
+	}
+
+	return evt
+}
+
+func alarmToPb(a *db.Alarm) *pb.Alarm {
+	p := &pb.Alarm{
+		Id:          a.ID.String(),
+		NodeId:      a.NodeID,
+		NodeType:    a.NodeType.String(),
+		Type:        a.Type,
+		Source:      a.Source,
+		Severity:    a.Severity,
+		State:       string(a.State),
+		Count:       a.Count,
+		Value:       a.Value,
+		Message:     a.Message,
+		FirstSeenAt: a.FirstSeenAt.Unix(),
+		LastSeenAt:  a.LastSeenAt.Unix(),
+	}
+
+	if a.RaisedAt != nil {
+		p.RaisedAt = a.RaisedAt.Unix()
+	}
+	if a.ClearedAt != nil {
+		p.ClearedAt = a.ClearedAt.Unix()
+	}
+
+	return p
+}
+
+func nodeEventToPb(e *db.NodeEvent) *pb.NodeEvent {
+	return &pb.NodeEvent{
+		Id:         e.ID.String(),
+		NodeId:     e.NodeID,
+		NodeType:   e.NodeType.String(),
+		ReportId:   e.ReportID.String(),
+		Type:       e.Type,
+		Severity:   e.Severity,
+		Source:     e.Source,
+		Value:      e.Value,
+		Message:    e.Message,
+		Cleared:    e.Cleared,
+		OccurredAt: e.OccurredAt.Unix(),
+	}
+}
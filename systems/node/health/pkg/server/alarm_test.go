@@ -0,0 +1,107 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/ukama/ukama/systems/common/ukama"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/node/health/mocks"
+	"github.com/ukama/ukama/systems/node/health/pkg"
+	"github.com/ukama/ukama/systems/node/health/pkg/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mbmocks "github.com/ukama/ukama/systems/common/mocks"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	pb "github.com/ukama/ukama/systems/node/health/pb/gen"
+)
+
+func TestHealthServerStoreHealthReportRaisesAlarm(t *testing.T) {
+	node := testNode.StringLowercase()
+	hRepo := &mocks.HealthRepo{}
+	eRepo := &mocks.EventRepo{}
+	msgbus := &mbmocks.MsgBusServiceClient{}
+	s := NewHealthServer(testOrgName, hRepo, nil, eRepo, pkg.RetentionConfig{}, pkg.AlarmConfig{RaiseCount: 2}, "", false, msgbus)
+
+	reported := time.Now().UTC().Unix()
+	payload := []byte(`{"nodeType":"hnode","schemaVersion":"1","reportedAt":"` + jsonNumber(reported) + `",
+		"events":[{"type":"radio_fault","severity":"critical","source":"fem1"},{"type":"fan_stall"}]}`)
+
+	hRepo.On("List", "", node, mock.Anything, mock.Anything).Return([]*db.HealthReport{}, nil).Maybe()
+	hRepo.On("StoreHealthReport", mock.Anything, mock.Anything).Return(nil).Once()
+	eRepo.On("AddEvents", mock.MatchedBy(func(e []*db.NodeEvent) bool {
+		return len(e) == 1 && e[0].Type == "radio_fault" && e[0].NodeID == node && e[0].OccurredAt.Unix() == reported
+	})).Return(nil).Once()
+	eRepo.On("ActiveAlarms", node).Return([]*db.Alarm{}, nil).Once()
+	eRepo.On("SaveAlarms", mock.MatchedBy(func(a []*db.Alarm) bool {
+		return len(a) == 1 && a[0].State == db.AlarmRaised
+	})).Return(nil).Once()
+	msgbus.On("PublishRequest", "event.cloud.local.testorg.node.health.alarm.raise",
+		mock.MatchedBy(func(e *epb.HealthAlarmEvent) bool {
+			return e.NodeId == node && e.Type == "radio_fault" && e.Source == "fem1" && e.Severity == "critical"
+		})).Return(nil).Once()
+	/* Other events of the report (health stored, apps changed) */
+	msgbus.On("PublishRequest", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	_, err := s.StoreHealthReport(context.Background(), &pb.StoreHealthReportRequest{NodeId: node, Payload: payload})
+
+	assert.NoError(t, err)
+	eRepo.AssertExpectations(t)
+	msgbus.AssertExpectations(t)
+}
+
+func TestHealthServerListAlarms(t *testing.T) {
+	node := testNode.StringLowercase()
+	eRepo := &mocks.EventRepo{}
+	s := NewHealthServer(testOrgName, &mocks.HealthRepo{}, nil, eRepo, pkg.RetentionConfig{}, pkg.AlarmConfig{}, "", false, nil)
+
+	raised := time.Now().UTC().Truncate(time.Second)
+	eRepo.On("ListAlarms", node, true).Return([]*db.Alarm{{
+		ID: uuid.NewV4(), NodeID: node, NodeType: ukama.NODE_TYPE_HOMENODE, Type: "temperature", Source: "power",
+		Severity: "warning", State: db.AlarmRaised, Count: 2, Value: 78, RaisedAt: &raised,
+	}}, nil).Once()
+
+	resp, err := s.ListAlarms(context.Background(), &pb.ListAlarmsRequest{NodeId: testNode.String(), ActiveOnly: true})
+
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Alarms, 1) {
+		assert.Equal(t, "raised", resp.Alarms[0].State)
+		assert.Equal(t, raised.Unix(), resp.Alarms[0].RaisedAt)
+		assert.Zero(t, resp.Alarms[0].ClearedAt)
+	}
+}
+
+func TestHealthServerListEvents(t *testing.T) {
+	node := testNode.StringLowercase()
+	eRepo := &mocks.EventRepo{}
+	s := NewHealthServer(testOrgName, &mocks.HealthRepo{}, nil, eRepo, pkg.RetentionConfig{}, pkg.AlarmConfig{}, "", false, nil)
+
+	to := time.Now().UTC().Truncate(time.Second)
+	from := to.Add(-time.Hour)
+	eRepo.On("ListEvents", node, "", from, to).Return([]*db.NodeEvent{
+		{ID: uuid.NewV4(), NodeID: node, Type: "gps_loss", Severity: "warning", OccurredAt: from},
+		{ID: uuid.NewV4(), NodeID: node, Type: "radio_fault", Severity: "major", OccurredAt: to},
+	}, nil).Once()
+
+	resp, err := s.ListEvents(context.Background(), &pb.ListEventsRequest{NodeId: node, From: from.Unix(), To: to.Unix(), MinSeverity: "major"})
+
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Events, 1) {
+		assert.Equal(t, "radio_fault", resp.Events[0].Type)
+	}
+
+	_, err = s.ListEvents(context.Background(), &pb.ListEventsRequest{NodeId: node, MinSeverity: "loud"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
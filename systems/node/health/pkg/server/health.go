@@ -12,6 +12,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"sort"
 	"strconv"
 	"strings"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/cloudflare/cfssl/log"
 	"github.com/ukama/ukama/systems/common/grpc"
+	metric "github.com/ukama/ukama/systems/common/metrics"
 	mb "github.com/ukama/ukama/systems/common/msgBusServiceClient"
 	"github.com/ukama/ukama/systems/common/msgbus"
 	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
@@ -26,6 +28,7 @@ import (
 	"github.com/ukama/ukama/systems/common/uuid"
 	pb "github.com/ukama/ukama/systems/node/health/pb/gen"
 	"github.com/ukama/ukama/systems/node/health/pkg"
+	"github.com/ukama/ukama/systems/node/health/pkg/alarm"
 	"github.com/ukama/ukama/systems/node/health/pkg/db"
 	"github.com/ukama/ukama/systems/node/health/pkg/parser"
 	"google.golang.org/grpc/codes"
@@ -36,15 +39,27 @@ import (
 type HealthServer struct {
 	pb.UnimplementedHealthServiceServer
 	sRepo            db.HealthRepo
+	rRepo            db.RollupRepo
+	eRepo            db.EventRepo
+	correlator       *alarm.Correlator
+	retention        pkg.RetentionConfig
+	pushGateway      string
 	debug            bool
 	orgName          string
 	msgbus           mb.MsgBusServiceClient
 	healthRoutingKey msgbus.RoutingKeyBuilder
 }
 
-func NewHealthServer(orgName string, sRepo db.HealthRepo, debug bool, msgBus mb.MsgBusServiceClient) *HealthServer {
+func NewHealthServer(orgName string, sRepo db.HealthRepo, rRepo db.RollupRepo, eRepo db.EventRepo,
+	retention pkg.RetentionConfig, alarmCfg pkg.AlarmConfig, pushGateway string, debug bool,
+	msgBus mb.MsgBusServiceClient) *HealthServer {
 	return &HealthServer{
 		sRepo:            sRepo,
+		rRepo:            rRepo,
+		eRepo:            eRepo,
+		correlator:       alarm.NewCorrelator(alarmCfg),
+		retention:        retention,
+		pushGateway:      pushGateway,
 		orgName:          orgName,
 		debug:            debug,
 		msgbus:           msgBus,
@@ -67,12 +82,21 @@ func (h *HealthServer) StoreHealthReport(ctx context.Context, req *pb.StoreHealt
 	}
 	parsed, err := parser.ParseHealthPayload(raw)
 	if err != nil {
+		var uerr *parser.UnsupportedVersionError
+		if errors.As(err, &uerr) {
+			h.pushRejectMetric(uerr.Version, rejectUnsupportedVersion)
+			return nil, status.Errorf(codes.InvalidArgument, "%s, supported versions are %s",
+				err.Error(), strings.Join(parser.DefaultRegistry().Versions(), ","))
+		}
+		h.pushRejectMetric("", rejectInvalidPayload)
 		return nil, status.Errorf(codes.InvalidArgument, "payload is invalid: %v", err)
 	}
 	if parsed.NodeType == "" {
+		h.pushRejectMetric(parsed.SchemaVersion, rejectMissingField)
 		return nil, status.Errorf(codes.InvalidArgument, "nodeType is required in payload")
 	}
 	if parsed.ReportedAt == 0 {
+		h.pushRejectMetric(parsed.SchemaVersion, rejectMissingField)
 		return nil, status.Errorf(codes.InvalidArgument, "reportedAt is required in payload")
 	}
 
@@ -118,9 +142,29 @@ func (h *HealthServer) StoreHealthReport(ctx context.Context, req *pb.StoreHealt
 	// Additive, best-effort: notify subscribers only when the app inventory changed.
 	h.publishAppsChangedIfNeeded(nID.StringLowercase(), nodeType.String(), parsed.Apps, prevAppsFingerprint)
 
+	h.processEvents(report, parsed)
+
 	return &pb.StoreHealthReportResponse{ReportId: report.ID.String()}, nil
 }
 
+const (
+	rejectUnsupportedVersion = "unsupported_version"
+	rejectInvalidPayload     = "invalid_payload"
+	rejectMissingField       = "missing_field"
+)
+
+func (h *HealthServer) pushRejectMetric(version, reason string) {
+	if h.pushGateway == "" {
+		return
+	}
+
+	err := metric.CollectAndPushSystemMetrics(h.pushGateway, pkg.ReportMetrics, pkg.NumberOfRejectedReports, 1,
+		map[string]string{"version": version, "reason": reason}, pkg.SystemName+"-"+pkg.ServiceName)
+	if err != nil {
+		log.Errorf("Error while pushing rejected reports metric to pushgateway %s", err.Error())
+	}
+}
+
 // appsFingerprint returns a stable hash of the node's app inventory keyed on
 // name@version only, so routine metric/resource churn in periodic health
 // reports does not by itself trigger a change notification.
@@ -181,8 +225,23 @@ func (h *HealthServer) ListReports(ctx context.Context, req *pb.ListReportsReque
 		reportedAt = &t
 	}
 
-	timeframe := ukama.ParseFilterTimeframesType(strings.ToLower(req.GetTimeframe().String()))
-	reports, err := h.sRepo.List(req.GetReportId(), req.GetNodeId(), reportedAt, timeframe)
+	var reports []*db.HealthReport
+	var err error
+	if req.GetFrom() != 0 || req.GetTo() != 0 {
+		if req.GetNodeId() == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "nodeId is required for a time range")
+		}
+
+		from, to := timeRange(req.GetFrom(), req.GetTo())
+		if !from.Before(to) {
+			return nil, status.Errorf(codes.InvalidArgument, "from must be before to")
+		}
+
+		reports, err = h.sRepo.ListRange(req.GetNodeId(), from, to, int(req.GetLimit()))
+	} else {
+		timeframe := ukama.ParseFilterTimeframesType(strings.ToLower(req.GetTimeframe().String()))
+		reports, err = h.sRepo.List(req.GetReportId(), req.GetNodeId(), reportedAt, timeframe)
+	}
 	if err != nil {
 		return nil, grpc.SqlErrorToGrpc(err, "health")
 	}
@@ -190,6 +249,12 @@ func (h *HealthServer) ListReports(ctx context.Context, req *pb.ListReportsReque
 	out := make([]*pb.HealthReport, len(reports))
 	for i, r := range reports {
 		out[i] = healthReportToPb(r)
+		if len(req.GetFields()) > 0 {
+			out[i].Payload, err = parser.ProjectPayload(r.Payload, req.GetFields())
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "payload of report %s is invalid: %v", r.ID.String(), err)
+			}
+		}
 	}
 
 	return &pb.ListReportsResponse{Reports: out}, nil
@@ -20,6 +20,7 @@ import (
 	"github.com/ukama/ukama/systems/common/ukama"
 	"github.com/ukama/ukama/systems/common/uuid"
 	"github.com/ukama/ukama/systems/node/health/mocks"
+	"github.com/ukama/ukama/systems/node/health/pkg"
 	pb "github.com/ukama/ukama/systems/node/health/pb/gen"
 	"github.com/ukama/ukama/systems/node/health/pkg/db"
 	"google.golang.org/grpc/codes"
@@ -32,7 +33,7 @@ var testNode = ukama.NewVirtualNodeId("HomeNode")
 var testCNode = ukama.NewVirtualNodeId("ctrlnode")
 
 func newTestHealthServer(hRepo *mocks.HealthRepo) *HealthServer {
-	return NewHealthServer(testOrgName, hRepo, false, nil)
+	return NewHealthServer(testOrgName, hRepo, nil, nil, pkg.RetentionConfig{}, pkg.AlarmConfig{}, "", false, nil)
 }
 
 func TestHealthServerStoreHealthReport(t *testing.T) {
@@ -496,3 +497,20 @@ func jsonNumber(v int64) string {
 	b, _ := json.Marshal(v)
 	return string(b)
 }
+
+func TestHealthServerStoreHealthReportUnsupportedSchemaVersion(t *testing.T) {
+	hRepo := &mocks.HealthRepo{}
+	s := newTestHealthServer(hRepo)
+
+	resp, err := s.StoreHealthReport(context.Background(), &pb.StoreHealthReportRequest{
+		NodeId:  testNode.String(),
+		Payload: []byte(`{"nodeType":"hnode","schemaVersion":"7.0","reportedAt":1779534357}`),
+	})
+
+	assert.Nil(t, resp)
+	if assert.Error(t, err) {
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "unsupported schema version")
+	}
+	hRepo.AssertNotCalled(t, "StoreHealthReport", mock.Anything, mock.Anything)
+}
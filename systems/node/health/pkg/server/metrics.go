@@ -0,0 +1,137 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/ukama/ukama/systems/common/grpc"
+	"github.com/ukama/ukama/systems/node/health/pkg/parser"
+	"github.com/ukama/ukama/systems/node/health/pkg/rollup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ukama/ukama/systems/node/health/pb/gen"
+)
+
+/* Upper bound of raw points returned, larger ranges should ask for a rollup */
+const maxRawPoints = 10000
+
+func (h *HealthServer) QueryMetrics(ctx context.Context, req *pb.QueryMetricsRequest) (*pb.QueryMetricsResponse, error) {
+	log.Infof("QueryMetrics: %v", req)
+
+	nodeId := strings.ToLower(req.GetNodeId())
+	from, to := timeRange(req.GetFrom(), req.GetTo())
+	if !from.Before(to) {
+		return nil, status.Errorf(codes.InvalidArgument, "from must be before to")
+	}
+
+	if err := rollup.ValidateFields(req.GetFields()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+
+	res := h.resolutionFor(from, time.Now().UTC())
+	if req.GetResolution() != "" {
+		var err error
+		res, err = rollup.ParseResolution(req.GetResolution())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+	}
+
+	resp := &pb.QueryMetricsResponse{NodeId: nodeId, Resolution: string(res)}
+
+	if res == rollup.ResolutionRaw {
+		reports, err := h.sRepo.ListRange(nodeId, from, to, maxRawPoints)
+		if err != nil {
+			return nil, grpc.SqlErrorToGrpc(err, "health")
+		}
+
+		for _, r := range reports {
+			p, err := parser.ParseHealthPayload(r.Payload)
+			if err != nil {
+				log.Warnf("Skipping invalid payload of report %s: %v", r.ID.String(), err)
+				continue
+			}
+
+			stats := rollup.Stats{}
+			stats.AddSample(rollup.Extract(p))
+			resp.Points = append(resp.Points, metricPoint(r.ReportedAt, 1, stats.Project(req.GetFields())))
+		}
+
+		return resp, nil
+	}
+
+	rollups, err := h.rRepo.List(nodeId, string(res), res.Bucket(from), to)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "health")
+	}
+
+	for _, r := range rollups {
+		stats := rollup.Stats{}
+		if err := json.Unmarshal(r.Stats, &stats); err != nil {
+			log.Warnf("Skipping invalid %s rollup of node %s at %s: %v", res, r.NodeID, r.BucketStart, err)
+			continue
+		}
+		resp.Points = append(resp.Points, metricPoint(r.BucketStart, r.Samples, stats.Project(req.GetFields())))
+	}
+
+	return resp, nil
+}
+
+/* Picks the finest tier still holding data at from */
+func (h *HealthServer) resolutionFor(from time.Time, now time.Time) rollup.Resolution {
+	if h.retention.Raw == 0 || !from.Before(now.Add(-h.retention.Raw)) {
+		return rollup.ResolutionRaw
+	}
+
+	if h.retention.Hourly == 0 || !from.Before(now.Add(-h.retention.Hourly)) {
+		return rollup.ResolutionHour
+	}
+
+	return rollup.ResolutionDay
+}
+
+/* Unix seconds to a time range, to defaults to now and from to a day before to */
+func timeRange(from, to int64) (time.Time, time.Time) {
+	t := time.Now().UTC()
+	if to != 0 {
+		t = time.Unix(to, 0).UTC()
+	}
+
+	f := t.Add(-24 * time.Hour)
+	if from != 0 {
+		f = time.Unix(from, 0).UTC()
+	}
+
+	return f, t
+}
+
+func metricPoint(ts time.Time, samples uint32, stats rollup.Stats) *pb.MetricPoint {
+	p := &pb.MetricPoint{
+		Timestamp: ts.Unix(),
+		Samples:   samples,
+		Values:    make(map[string]*pb.MetricStat, len(stats)),
+	}
+
+	for k, s := range stats {
+		p.Values[k] = &pb.MetricStat{
+			Avg:   s.Avg(),
+			Min:   s.Min,
+			Max:   s.Max,
+			Count: s.Count,
+		}
+	}
+
+	return p
+}
@@ -0,0 +1,105 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ukama/ukama/systems/node/health/mocks"
+	"github.com/ukama/ukama/systems/node/health/pkg"
+	"github.com/ukama/ukama/systems/node/health/pkg/db"
+	"github.com/ukama/ukama/systems/node/health/pkg/rollup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ukama/ukama/systems/node/health/pb/gen"
+)
+
+func TestHealthServerQueryMetrics(t *testing.T) {
+	node := testNode.StringLowercase()
+	retention := pkg.RetentionConfig{Raw: 24 * time.Hour, Hourly: 720 * time.Hour}
+
+	t.Run("RecentRaw", func(t *testing.T) {
+		hRepo := &mocks.HealthRepo{}
+		s := NewHealthServer(testOrgName, hRepo, &mocks.RollupRepo{}, nil, retention, pkg.AlarmConfig{}, "", false, nil)
+
+		to := time.Now().UTC().Truncate(time.Second)
+		from := to.Add(-time.Hour)
+		hRepo.On("ListRange", node, from, to, maxRawPoints).Return([]*db.HealthReport{
+			batteryReport(node, from.Add(time.Minute), 75),
+		}, nil).Once()
+
+		resp, err := s.QueryMetrics(context.Background(), &pb.QueryMetricsRequest{
+			NodeId: node, From: from.Unix(), To: to.Unix(), Fields: []string{rollup.BatterySoc},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "raw", resp.Resolution)
+		if assert.Len(t, resp.Points, 1) {
+			assert.Len(t, resp.Points[0].Values, 1)
+			assert.Equal(t, float64(75), resp.Points[0].Values[rollup.BatterySoc].Avg)
+		}
+	})
+
+	t.Run("LastTuesdayHourly", func(t *testing.T) {
+		rRepo := &mocks.RollupRepo{}
+		s := NewHealthServer(testOrgName, &mocks.HealthRepo{}, rRepo, nil, retention, pkg.AlarmConfig{}, "", false, nil)
+
+		from := time.Now().UTC().Add(-7 * 24 * time.Hour).Truncate(time.Hour)
+		to := from.Add(24 * time.Hour)
+		st, _ := json.Marshal(rollup.Stats{rollup.BatteryVoltage: {Count: 60, Sum: 720, Min: 11.5, Max: 12.8}})
+		rRepo.On("List", node, "hour", from, to).Return([]*db.HealthRollup{
+			{NodeID: node, Resolution: "hour", BucketStart: from, Samples: 60, Stats: st},
+		}, nil).Once()
+
+		resp, err := s.QueryMetrics(context.Background(), &pb.QueryMetricsRequest{NodeId: node, From: from.Unix(), To: to.Unix()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "hour", resp.Resolution)
+		if assert.Len(t, resp.Points, 1) {
+			v := resp.Points[0].Values[rollup.BatteryVoltage]
+			assert.Equal(t, float64(12), v.Avg)
+			assert.Equal(t, 11.5, v.Min)
+			assert.Equal(t, uint32(60), resp.Points[0].Samples)
+		}
+	})
+
+	t.Run("UnknownField", func(t *testing.T) {
+		s := NewHealthServer(testOrgName, &mocks.HealthRepo{}, &mocks.RollupRepo{}, nil, retention, pkg.AlarmConfig{}, "", false, nil)
+
+		_, err := s.QueryMetrics(context.Background(), &pb.QueryMetricsRequest{NodeId: node, Fields: []string{"battery"}})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestHealthServerListReportsRange(t *testing.T) {
+	hRepo := &mocks.HealthRepo{}
+	s := newTestHealthServer(hRepo)
+
+	from := time.Date(2026, 5, 12, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+	hRepo.On("ListRange", testNode.String(), from, to, 10).Return([]*db.HealthReport{
+		batteryReport(testNode.String(), from.Add(time.Minute), 75),
+	}, nil).Once()
+
+	resp, err := s.ListReports(context.Background(), &pb.ListReportsRequest{
+		NodeId: testNode.String(), From: from.Unix(), To: to.Unix(), Limit: 10,
+		Fields: []string{"interfaces.controller.battery"},
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Reports, 1) {
+		assert.JSONEq(t, `{"interfaces": {"controller": {"battery": {"socPct": 75}}}}`, string(resp.Reports[0].Payload))
+	}
+}
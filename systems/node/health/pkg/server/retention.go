@@ -0,0 +1,263 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/ukama/ukama/systems/node/health/pkg/db"
+	"github.com/ukama/ukama/systems/node/health/pkg/parser"
+	"github.com/ukama/ukama/systems/node/health/pkg/rollup"
+)
+
+/*
+ * RunRetention periodically rolls raw reports up into hourly and hourly into daily
+ * rollups, then purges reports, rollups and events past their tier's retention. Runs until ctx is cancelled.
+ */
+func (h *HealthServer) RunRetention(ctx context.Context) {
+	interval := h.retention.Interval
+	if interval <= 0 {
+		interval = 15 * time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	log.Infof("Health retention running every %s (raw %s, hourly %s, daily %s)",
+		interval, h.retention.Raw, h.retention.Hourly, h.retention.Daily)
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof("Health retention stopped")
+			return
+		case <-ticker.C:
+			if err := h.ApplyRetention(time.Now().UTC()); err != nil {
+				log.Errorf("Health retention failed: %v", err)
+			}
+		}
+	}
+}
+
+func (h *HealthServer) ApplyRetention(now time.Time) error {
+	hourMark, err := h.rollupHours(now)
+	if err != nil {
+		return err
+	}
+
+	dayMark, err := h.rollupDays(now, hourMark)
+	if err != nil {
+		return err
+	}
+
+	/* Never purge what has not been rolled up yet */
+	if h.retention.Raw > 0 {
+		n, err := h.sRepo.DeleteBefore(earliest(now.Add(-h.retention.Raw), hourMark))
+		if err != nil {
+			return err
+		}
+		log.Infof("Purged %d raw health reports", n)
+	}
+
+	if h.retention.Hourly > 0 {
+		n, err := h.rRepo.DeleteBefore(string(rollup.ResolutionHour), earliest(now.Add(-h.retention.Hourly), dayMark))
+		if err != nil {
+			return err
+		}
+		log.Infof("Purged %d hourly health rollups", n)
+	}
+
+	if h.retention.Daily > 0 {
+		n, err := h.rRepo.DeleteBefore(string(rollup.ResolutionDay), now.Add(-h.retention.Daily))
+		if err != nil {
+			return err
+		}
+		log.Infof("Purged %d daily health rollups", n)
+	}
+
+	if h.retention.Events > 0 {
+		n, err := h.eRepo.DeleteEventsBefore(now.Add(-h.retention.Events))
+		if err != nil {
+			return err
+		}
+		log.Infof("Purged %d node events", n)
+	}
+
+	return nil
+}
+
+/* rollupHours rolls up completed hours and returns the end of the last rolled up hour */
+func (h *HealthServer) rollupHours(now time.Time) (time.Time, error) {
+	res := rollup.ResolutionHour
+	end := res.Bucket(now)
+
+	start, err := h.nextBucket(res, h.sRepo.FirstReportedAt)
+	if err != nil || start == nil {
+		return end, err
+	}
+
+	for i := 0; i < h.maxBuckets() && start.Before(end); i++ {
+		to := start.Add(res.Duration())
+
+		reports, err := h.sRepo.ListRange("", *start, to, 0)
+		if err != nil {
+			return *start, err
+		}
+
+		buckets := map[string]*db.HealthRollup{}
+		stats := map[string]rollup.Stats{}
+		for _, r := range reports {
+			p, err := parser.ParseHealthPayload(r.Payload)
+			if err != nil {
+				log.Warnf("Skipping invalid payload of report %s: %v", r.ID.String(), err)
+				continue
+			}
+
+			if _, ok := buckets[r.NodeID]; !ok {
+				buckets[r.NodeID] = &db.HealthRollup{NodeID: r.NodeID, NodeType: r.NodeType}
+				stats[r.NodeID] = rollup.Stats{}
+			}
+			buckets[r.NodeID].Samples++
+			stats[r.NodeID].AddSample(rollup.Extract(p))
+		}
+
+		if err := h.storeRollups(res, *start, buckets, stats, now); err != nil {
+			return *start, err
+		}
+
+		next, err := h.sRepo.FirstReportedAt(to)
+		if err != nil {
+			return to, err
+		}
+		if next == nil {
+			return to, nil
+		}
+		n := res.Bucket(*next)
+		start = &n
+	}
+
+	return earliest(*start, end), nil
+}
+
+/* rollupDays merges the hourly rollups of completed days and returns the end of the last rolled up day */
+func (h *HealthServer) rollupDays(now time.Time, hourMark time.Time) (time.Time, error) {
+	res := rollup.ResolutionDay
+	/* A day is complete once all of its hours are rolled up */
+	end := res.Bucket(earliest(now, hourMark))
+
+	start, err := h.nextBucket(res, func(from time.Time) (*time.Time, error) {
+		return h.rRepo.FirstBucket(string(rollup.ResolutionHour), from)
+	})
+	if err != nil || start == nil {
+		return end, err
+	}
+
+	for start.Before(end) {
+		to := start.Add(res.Duration())
+
+		hours, err := h.rRepo.List("", string(rollup.ResolutionHour), *start, to)
+		if err != nil {
+			return *start, err
+		}
+
+		buckets := map[string]*db.HealthRollup{}
+		stats := map[string]rollup.Stats{}
+		for _, r := range hours {
+			st := rollup.Stats{}
+			if err := json.Unmarshal(r.Stats, &st); err != nil {
+				log.Warnf("Skipping invalid hourly rollup of node %s at %s: %v", r.NodeID, r.BucketStart, err)
+				continue
+			}
+
+			if _, ok := buckets[r.NodeID]; !ok {
+				buckets[r.NodeID] = &db.HealthRollup{NodeID: r.NodeID, NodeType: r.NodeType}
+				stats[r.NodeID] = rollup.Stats{}
+			}
+			buckets[r.NodeID].Samples += r.Samples
+			stats[r.NodeID].Merge(st)
+		}
+
+		if err := h.storeRollups(res, *start, buckets, stats, now); err != nil {
+			return *start, err
+		}
+
+		next, err := h.rRepo.FirstBucket(string(rollup.ResolutionHour), to)
+		if err != nil {
+			return to, err
+		}
+		if next == nil {
+			return to, nil
+		}
+		n := res.Bucket(*next)
+		start = &n
+	}
+
+	return end, nil
+}
+
+/* First bucket after the latest rollup of res, first tells where the finer data starts */
+func (h *HealthServer) nextBucket(res rollup.Resolution, first func(from time.Time) (*time.Time, error)) (*time.Time, error) {
+	var from time.Time
+
+	latest, err := h.rRepo.LatestBucket(string(res))
+	if err != nil {
+		return nil, err
+	}
+	if latest != nil {
+		from = latest.Add(res.Duration())
+	}
+
+	next, err := first(from)
+	if err != nil || next == nil {
+		return nil, err
+	}
+
+	b := res.Bucket(*next)
+	if b.Before(from) {
+		b = from
+	}
+
+	return &b, nil
+}
+
+func (h *HealthServer) storeRollups(res rollup.Resolution, bucket time.Time, buckets map[string]*db.HealthRollup,
+	stats map[string]rollup.Stats, now time.Time) error {
+	rollups := make([]*db.HealthRollup, 0, len(buckets))
+	for nodeId, r := range buckets {
+		data, err := json.Marshal(stats[nodeId])
+		if err != nil {
+			return err
+		}
+
+		r.Resolution = string(res)
+		r.BucketStart = bucket
+		r.Stats = data
+		r.UpdatedAt = now
+		rollups = append(rollups, r)
+	}
+
+	return h.rRepo.Upsert(rollups)
+}
+
+func (h *HealthServer) maxBuckets() int {
+	if h.retention.MaxBuckets <= 0 {
+		return 168
+	}
+
+	return h.retention.MaxBuckets
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
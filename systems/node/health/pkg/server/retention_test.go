@@ -0,0 +1,113 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/ukama/ukama/systems/common/ukama"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/node/health/mocks"
+	"github.com/ukama/ukama/systems/node/health/pkg"
+	"github.com/ukama/ukama/systems/node/health/pkg/db"
+	"github.com/ukama/ukama/systems/node/health/pkg/rollup"
+)
+
+func batteryReport(nodeId string, at time.Time, soc int) *db.HealthReport {
+	return &db.HealthReport{
+		ID:         uuid.NewV4(),
+		NodeID:     nodeId,
+		NodeType:   ukama.NODE_TYPE_HOMENODE,
+		ReportedAt: at,
+		Payload: json.RawMessage(`{"reportedAt": ` + jsonNumber(at.Unix()) +
+			`, "interfaces": {"controller": {"available": true, "battery": {"socPct": ` + jsonNumber(int64(soc)) + `}}}}`),
+	}
+}
+
+func rollupStats(t *testing.T, r *db.HealthRollup) rollup.Stats {
+	st := rollup.Stats{}
+	assert.NoError(t, json.Unmarshal(r.Stats, &st))
+
+	return st
+}
+
+func TestHealthServerApplyRetention(t *testing.T) {
+	node := testNode.StringLowercase()
+	day := time.Date(2026, 5, 12, 0, 0, 0, 0, time.UTC)
+
+	t.Run("RollupHoursAndPurgeRaw", func(t *testing.T) {
+		hRepo := &mocks.HealthRepo{}
+		rRepo := &mocks.RollupRepo{}
+		now := day.Add(2*time.Hour + 30*time.Minute)
+		s := NewHealthServer(testOrgName, hRepo, rRepo, nil, pkg.RetentionConfig{Raw: 24 * time.Hour, Hourly: 720 * time.Hour}, pkg.AlarmConfig{}, "", false, nil)
+
+		rRepo.On("LatestBucket", "hour").Return(nil, nil).Once()
+		hRepo.On("FirstReportedAt", time.Time{}).Return(ptr(day.Add(10*time.Minute)), nil).Once()
+		hRepo.On("ListRange", "", day, day.Add(time.Hour), 0).Return([]*db.HealthReport{
+			batteryReport(node, day.Add(10*time.Minute), 80),
+			batteryReport(node, day.Add(40*time.Minute), 60),
+		}, nil).Once()
+		rRepo.On("Upsert", mock.MatchedBy(func(r []*db.HealthRollup) bool {
+			return len(r) == 1 && r[0].BucketStart.Equal(day) && r[0].Samples == 2 &&
+				rollupStats(t, r[0])[rollup.BatterySoc] == rollup.Stat{Count: 2, Sum: 140, Min: 60, Max: 80}
+		})).Return(nil).Once()
+		/* Nothing in the second hour, the third one is still running */
+		hRepo.On("FirstReportedAt", day.Add(time.Hour)).Return(ptr(day.Add(2*time.Hour+5*time.Minute)), nil).Once()
+
+		rRepo.On("LatestBucket", "day").Return(nil, nil).Once()
+		rRepo.On("FirstBucket", "hour", time.Time{}).Return(ptr(day), nil).Once()
+
+		hRepo.On("DeleteBefore", now.Add(-24*time.Hour)).Return(int64(10), nil).Once()
+		rRepo.On("DeleteBefore", "hour", now.Add(-720*time.Hour)).Return(int64(0), nil).Once()
+
+		assert.NoError(t, s.ApplyRetention(now))
+		hRepo.AssertExpectations(t)
+		rRepo.AssertExpectations(t)
+	})
+
+	t.Run("RollupDays", func(t *testing.T) {
+		hRepo := &mocks.HealthRepo{}
+		rRepo := &mocks.RollupRepo{}
+		now := day.Add(24*time.Hour + 30*time.Minute)
+		s := NewHealthServer(testOrgName, hRepo, rRepo, nil, pkg.RetentionConfig{}, pkg.AlarmConfig{}, "", false, nil)
+
+		h1 := rollup.Stats{rollup.BatterySoc: {Count: 2, Sum: 140, Min: 60, Max: 80}}
+		h2 := rollup.Stats{rollup.BatterySoc: {Count: 1, Sum: 40, Min: 40, Max: 40}}
+		d1, _ := json.Marshal(h1)
+		d2, _ := json.Marshal(h2)
+
+		rRepo.On("LatestBucket", "hour").Return(ptr(day.Add(23*time.Hour)), nil).Once()
+		hRepo.On("FirstReportedAt", day.Add(24*time.Hour)).Return(nil, nil).Once()
+
+		rRepo.On("LatestBucket", "day").Return(nil, nil).Once()
+		rRepo.On("FirstBucket", "hour", time.Time{}).Return(ptr(day), nil).Once()
+		rRepo.On("List", "", "hour", day, day.Add(24*time.Hour)).Return([]*db.HealthRollup{
+			{NodeID: node, Resolution: "hour", BucketStart: day, Samples: 2, Stats: d1},
+			{NodeID: node, Resolution: "hour", BucketStart: day.Add(5 * time.Hour), Samples: 1, Stats: d2},
+		}, nil).Once()
+		rRepo.On("Upsert", mock.MatchedBy(func(r []*db.HealthRollup) bool {
+			return len(r) == 1 && r[0].Resolution == "day" && r[0].Samples == 3 &&
+				rollupStats(t, r[0])[rollup.BatterySoc] == rollup.Stat{Count: 3, Sum: 180, Min: 40, Max: 80}
+		})).Return(nil).Once()
+		rRepo.On("FirstBucket", "hour", day.Add(24*time.Hour)).Return(nil, nil).Once()
+
+		assert.NoError(t, s.ApplyRetention(now))
+		hRepo.AssertExpectations(t)
+		rRepo.AssertExpectations(t)
+		hRepo.AssertNotCalled(t, "DeleteBefore", mock.Anything)
+	})
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
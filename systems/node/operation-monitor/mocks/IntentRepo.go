@@ -6,6 +6,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 	db "github.com/ukama/ukama/systems/node/operation-monitor/pkg/db"
 
+	rule "github.com/ukama/ukama/systems/node/operation-monitor/pkg/rule"
+
 	time "time"
 
 	uuid "github.com/ukama/ukama/systems/common/uuid"
@@ -172,6 +174,24 @@ func (_m *IntentRepo) MarkTerminal(operationId uuid.UUID, status db.IntentStatus
 	return r0, r1
 }
 
+// SaveRuleState provides a mock function with given fields: operationId, state
+func (_m *IntentRepo) SaveRuleState(operationId uuid.UUID, state rule.State) error {
+	ret := _m.Called(operationId, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveRuleState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, rule.State) error); ok {
+		r0 = rf(operationId, state)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewIntentRepo creates a new instance of IntentRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIntentRepo(t interface {
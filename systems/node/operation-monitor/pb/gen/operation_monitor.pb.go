@@ -7,8 +7,8 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
-// 	protoc        v3.14.0
+// 	protoc-gen-go v1.36.12
+// 	protoc        v6.32.1
 // source: operation_monitor.proto
 
 package gen
@@ -20,6 +20,7 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -85,10 +86,7 @@ func (IntentStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type MonitoredIntent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	OperationId    string                 `protobuf:"bytes,2,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	ResourceKey    string                 `protobuf:"bytes,3,opt,name=resourceKey,proto3" json:"resourceKey,omitempty"`
@@ -98,15 +96,18 @@ type MonitoredIntent struct {
 	Status         IntentStatus           `protobuf:"varint,7,opt,name=status,proto3,enum=ukama.node.operationmonitor.v1.IntentStatus" json:"status,omitempty"`
 	Deadline       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deadline,proto3" json:"deadline,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// Latest value of each field the completion rule looks at
+	Facts         map[string]string `protobuf:"bytes,10,rep,name=facts,proto3" json:"facts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Armed         bool              `protobuf:"varint,11,opt,name=armed,proto3" json:"armed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MonitoredIntent) Reset() {
 	*x = MonitoredIntent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_monitor_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_monitor_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MonitoredIntent) String() string {
@@ -117,7 +118,7 @@ func (*MonitoredIntent) ProtoMessage() {}
 
 func (x *MonitoredIntent) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_monitor_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -195,26 +196,44 @@ func (x *MonitoredIntent) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type RegisterIntentRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *MonitoredIntent) GetFacts() map[string]string {
+	if x != nil {
+		return x.Facts
+	}
+	return nil
+}
+
+func (x *MonitoredIntent) GetArmed() bool {
+	if x != nil {
+		return x.Armed
+	}
+	return false
+}
 
-	OperationId     string `protobuf:"bytes,1,opt,name=operationId,proto3" json:"operationId,omitempty"`
-	ResourceKey     string `protobuf:"bytes,2,opt,name=resourceKey,proto3" json:"resourceKey,omitempty"`
-	ActionType      string `protobuf:"bytes,3,opt,name=actionType,proto3" json:"actionType,omitempty"`
-	FencingToken    uint64 `protobuf:"varint,4,opt,name=fencingToken,proto3" json:"fencingToken,omitempty"`
+type RegisterIntentRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	OperationId  string                 `protobuf:"bytes,1,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	ResourceKey  string                 `protobuf:"bytes,2,opt,name=resourceKey,proto3" json:"resourceKey,omitempty"`
+	ActionType   string                 `protobuf:"bytes,3,opt,name=actionType,proto3" json:"actionType,omitempty"`
+	FencingToken uint64                 `protobuf:"varint,4,opt,name=fencingToken,proto3" json:"fencingToken,omitempty"`
+	// Boolean expression over node facts, e.g.
+	//   connectivity=online AND app.epc.version=1.4.2 WITHIN 10m
+	// Fields: state, substate (state transitions), connectivity, event,
+	// uptime_sec, starter.state, starter.update_in_progress,
+	// app.<name>.{version,tag,state} (health reports) and
+	// alarm.<type>.{state,severity}. See pkg/rule for the grammar. Empty
+	// uses the default rule of actionType.
 	CompletionRule  string `protobuf:"bytes,5,opt,name=completionRule,proto3" json:"completionRule,omitempty"`
 	DeadlineSeconds uint32 `protobuf:"varint,6,opt,name=deadlineSeconds,proto3" json:"deadlineSeconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *RegisterIntentRequest) Reset() {
 	*x = RegisterIntentRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_monitor_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_monitor_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RegisterIntentRequest) String() string {
@@ -225,7 +244,7 @@ func (*RegisterIntentRequest) ProtoMessage() {}
 
 func (x *RegisterIntentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_monitor_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -283,20 +302,17 @@ func (x *RegisterIntentRequest) GetDeadlineSeconds() uint32 {
 }
 
 type RegisterIntentResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Intent        *MonitoredIntent       `protobuf:"bytes,1,opt,name=intent,proto3" json:"intent,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Intent *MonitoredIntent `protobuf:"bytes,1,opt,name=intent,proto3" json:"intent,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RegisterIntentResponse) Reset() {
 	*x = RegisterIntentResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_monitor_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_monitor_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RegisterIntentResponse) String() string {
@@ -307,7 +323,7 @@ func (*RegisterIntentResponse) ProtoMessage() {}
 
 func (x *RegisterIntentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_monitor_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -330,20 +346,17 @@ func (x *RegisterIntentResponse) GetIntent() *MonitoredIntent {
 }
 
 type GetIntentRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OperationId string `protobuf:"bytes,1,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetIntentRequest) Reset() {
 	*x = GetIntentRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_monitor_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_monitor_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetIntentRequest) String() string {
@@ -354,7 +367,7 @@ func (*GetIntentRequest) ProtoMessage() {}
 
 func (x *GetIntentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_monitor_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -377,20 +390,17 @@ func (x *GetIntentRequest) GetOperationId() string {
 }
 
 type GetIntentResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Intent        *MonitoredIntent       `protobuf:"bytes,1,opt,name=intent,proto3" json:"intent,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Intent *MonitoredIntent `protobuf:"bytes,1,opt,name=intent,proto3" json:"intent,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetIntentResponse) Reset() {
 	*x = GetIntentResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_monitor_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_monitor_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetIntentResponse) String() string {
@@ -401,7 +411,7 @@ func (*GetIntentResponse) ProtoMessage() {}
 
 func (x *GetIntentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_monitor_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -424,20 +434,17 @@ func (x *GetIntentResponse) GetIntent() *MonitoredIntent {
 }
 
 type CancelIntentRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operationId,proto3" json:"operationId,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OperationId string `protobuf:"bytes,1,opt,name=operationId,proto3" json:"operationId,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CancelIntentRequest) Reset() {
 	*x = CancelIntentRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_monitor_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_monitor_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CancelIntentRequest) String() string {
@@ -448,7 +455,7 @@ func (*CancelIntentRequest) ProtoMessage() {}
 
 func (x *CancelIntentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_monitor_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -471,18 +478,16 @@ func (x *CancelIntentRequest) GetOperationId() string {
 }
 
 type CancelIntentResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CancelIntentResponse) Reset() {
 	*x = CancelIntentResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_monitor_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_monitor_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CancelIntentResponse) String() string {
@@ -493,7 +498,7 @@ func (*CancelIntentResponse) ProtoMessage() {}
 
 func (x *CancelIntentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_monitor_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -510,130 +515,73 @@ func (*CancelIntentResponse) Descriptor() ([]byte, []int) {
 
 var File_operation_monitor_proto protoreflect.FileDescriptor
 
-var file_operation_monitor_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x6e, 0x69,
-	0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1e, 0x75, 0x6b, 0x61, 0x6d, 0x61,
-	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d,
-	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89, 0x03, 0x0a, 0x0f,
-	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x20, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x65, 0x6e, 0x63, 0x69,
-	0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12,
-	0x44, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x2c, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x38, 0x0a,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x94, 0x02, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2b, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01,
-	0x04, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28,
-	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf,
-	0x1f, 0x02, 0x58, 0x01, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x2a, 0x0a, 0x0c, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x0c,
-	0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x0a, 0x0e,
-	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x75, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
-	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64,
-	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x61,
-	0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x69, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61,
-	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d,
-	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x22, 0x3f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05,
-	0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x22, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e,
-	0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x6f,
-	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
-	0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x22, 0x42, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf,
-	0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x53, 0x0a, 0x0c,
-	0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0c, 0x0a, 0x08,
-	0x57, 0x41, 0x54, 0x43, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f,
-	0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49,
-	0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44,
-	0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10,
-	0x04, 0x32, 0x87, 0x03, 0x0a, 0x17, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7f, 0x0a,
-	0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x35, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e,
-	0x6f, 0x64, 0x65, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x75, 0x6b,
-	0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
-	0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x79, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x33, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f,
-	0x64, 0x65, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x6f, 0x6e, 0x69,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3e, 0x5a, 0x3c, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f,
-	0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x6e, 0x6f,
-	0x64, 0x65, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x6d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x62, 0x2f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
+const file_operation_monitor_proto_rawDesc = "" +
+	"\n" +
+	"\x17operation_monitor.proto\x12\x1eukama.node.operationmonitor.v1\x1a\x0fvalidator.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xab\x04\n" +
+	"\x0fMonitoredIntent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\voperationId\x18\x02 \x01(\tR\voperationId\x12 \n" +
+	"\vresourceKey\x18\x03 \x01(\tR\vresourceKey\x12\x1e\n" +
+	"\n" +
+	"actionType\x18\x04 \x01(\tR\n" +
+	"actionType\x12\"\n" +
+	"\ffencingToken\x18\x05 \x01(\x04R\ffencingToken\x12&\n" +
+	"\x0ecompletionRule\x18\x06 \x01(\tR\x0ecompletionRule\x12D\n" +
+	"\x06status\x18\a \x01(\x0e2,.ukama.node.operationmonitor.v1.IntentStatusR\x06status\x126\n" +
+	"\bdeadline\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x128\n" +
+	"\tcreatedAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12P\n" +
+	"\x05facts\x18\n" +
+	" \x03(\v2:.ukama.node.operationmonitor.v1.MonitoredIntent.FactsEntryR\x05facts\x12\x14\n" +
+	"\x05armed\x18\v \x01(\bR\x05armed\x1a8\n" +
+	"\n" +
+	"FactsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x94\x02\n" +
+	"\x15RegisterIntentRequest\x12+\n" +
+	"\voperationId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\voperationId\x12(\n" +
+	"\vresourceKey\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\vresourceKey\x12&\n" +
+	"\n" +
+	"actionType\x18\x03 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\n" +
+	"actionType\x12*\n" +
+	"\ffencingToken\x18\x04 \x01(\x04B\x06\xe2\xdf\x1f\x02\x10\x00R\ffencingToken\x12&\n" +
+	"\x0ecompletionRule\x18\x05 \x01(\tR\x0ecompletionRule\x12(\n" +
+	"\x0fdeadlineSeconds\x18\x06 \x01(\rR\x0fdeadlineSeconds\"a\n" +
+	"\x16RegisterIntentResponse\x12G\n" +
+	"\x06intent\x18\x01 \x01(\v2/.ukama.node.operationmonitor.v1.MonitoredIntentR\x06intent\"?\n" +
+	"\x10GetIntentRequest\x12+\n" +
+	"\voperationId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\voperationId\"\\\n" +
+	"\x11GetIntentResponse\x12G\n" +
+	"\x06intent\x18\x01 \x01(\v2/.ukama.node.operationmonitor.v1.MonitoredIntentR\x06intent\"B\n" +
+	"\x13CancelIntentRequest\x12+\n" +
+	"\voperationId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\voperationId\"\x16\n" +
+	"\x14CancelIntentResponse*S\n" +
+	"\fIntentStatus\x12\f\n" +
+	"\bWATCHING\x10\x00\x12\r\n" +
+	"\tCOMPLETED\x10\x01\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x02\x12\v\n" +
+	"\aEXPIRED\x10\x03\x12\r\n" +
+	"\tCANCELLED\x10\x042\x87\x03\n" +
+	"\x17OperationMonitorService\x12\x7f\n" +
+	"\x0eRegisterIntent\x125.ukama.node.operationmonitor.v1.RegisterIntentRequest\x1a6.ukama.node.operationmonitor.v1.RegisterIntentResponse\x12p\n" +
+	"\tGetIntent\x120.ukama.node.operationmonitor.v1.GetIntentRequest\x1a1.ukama.node.operationmonitor.v1.GetIntentResponse\x12y\n" +
+	"\fCancelIntent\x123.ukama.node.operationmonitor.v1.CancelIntentRequest\x1a4.ukama.node.operationmonitor.v1.CancelIntentResponseB>Z<github.com/ukama/ukama/systems/node/operation-monitor/pb/genb\x06proto3"
 
 var (
 	file_operation_monitor_proto_rawDescOnce sync.Once
-	file_operation_monitor_proto_rawDescData = file_operation_monitor_proto_rawDesc
+	file_operation_monitor_proto_rawDescData []byte
 )
 
 func file_operation_monitor_proto_rawDescGZIP() []byte {
 	file_operation_monitor_proto_rawDescOnce.Do(func() {
-		file_operation_monitor_proto_rawDescData = protoimpl.X.CompressGZIP(file_operation_monitor_proto_rawDescData)
+		file_operation_monitor_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_operation_monitor_proto_rawDesc), len(file_operation_monitor_proto_rawDesc)))
 	})
 	return file_operation_monitor_proto_rawDescData
 }
 
 var file_operation_monitor_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_operation_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_operation_monitor_proto_goTypes = []interface{}{
+var file_operation_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_operation_monitor_proto_goTypes = []any{
 	(IntentStatus)(0),              // 0: ukama.node.operationmonitor.v1.IntentStatus
 	(*MonitoredIntent)(nil),        // 1: ukama.node.operationmonitor.v1.MonitoredIntent
 	(*RegisterIntentRequest)(nil),  // 2: ukama.node.operationmonitor.v1.RegisterIntentRequest
@@ -642,25 +590,27 @@ var file_operation_monitor_proto_goTypes = []interface{}{
 	(*GetIntentResponse)(nil),      // 5: ukama.node.operationmonitor.v1.GetIntentResponse
 	(*CancelIntentRequest)(nil),    // 6: ukama.node.operationmonitor.v1.CancelIntentRequest
 	(*CancelIntentResponse)(nil),   // 7: ukama.node.operationmonitor.v1.CancelIntentResponse
-	(*timestamppb.Timestamp)(nil),  // 8: google.protobuf.Timestamp
+	nil,                            // 8: ukama.node.operationmonitor.v1.MonitoredIntent.FactsEntry
+	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
 }
 var file_operation_monitor_proto_depIdxs = []int32{
 	0, // 0: ukama.node.operationmonitor.v1.MonitoredIntent.status:type_name -> ukama.node.operationmonitor.v1.IntentStatus
-	8, // 1: ukama.node.operationmonitor.v1.MonitoredIntent.deadline:type_name -> google.protobuf.Timestamp
-	8, // 2: ukama.node.operationmonitor.v1.MonitoredIntent.createdAt:type_name -> google.protobuf.Timestamp
-	1, // 3: ukama.node.operationmonitor.v1.RegisterIntentResponse.intent:type_name -> ukama.node.operationmonitor.v1.MonitoredIntent
-	1, // 4: ukama.node.operationmonitor.v1.GetIntentResponse.intent:type_name -> ukama.node.operationmonitor.v1.MonitoredIntent
-	2, // 5: ukama.node.operationmonitor.v1.OperationMonitorService.RegisterIntent:input_type -> ukama.node.operationmonitor.v1.RegisterIntentRequest
-	4, // 6: ukama.node.operationmonitor.v1.OperationMonitorService.GetIntent:input_type -> ukama.node.operationmonitor.v1.GetIntentRequest
-	6, // 7: ukama.node.operationmonitor.v1.OperationMonitorService.CancelIntent:input_type -> ukama.node.operationmonitor.v1.CancelIntentRequest
-	3, // 8: ukama.node.operationmonitor.v1.OperationMonitorService.RegisterIntent:output_type -> ukama.node.operationmonitor.v1.RegisterIntentResponse
-	5, // 9: ukama.node.operationmonitor.v1.OperationMonitorService.GetIntent:output_type -> ukama.node.operationmonitor.v1.GetIntentResponse
-	7, // 10: ukama.node.operationmonitor.v1.OperationMonitorService.CancelIntent:output_type -> ukama.node.operationmonitor.v1.CancelIntentResponse
-	8, // [8:11] is the sub-list for method output_type
-	5, // [5:8] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	9, // 1: ukama.node.operationmonitor.v1.MonitoredIntent.deadline:type_name -> google.protobuf.Timestamp
+	9, // 2: ukama.node.operationmonitor.v1.MonitoredIntent.createdAt:type_name -> google.protobuf.Timestamp
+	8, // 3: ukama.node.operationmonitor.v1.MonitoredIntent.facts:type_name -> ukama.node.operationmonitor.v1.MonitoredIntent.FactsEntry
+	1, // 4: ukama.node.operationmonitor.v1.RegisterIntentResponse.intent:type_name -> ukama.node.operationmonitor.v1.MonitoredIntent
+	1, // 5: ukama.node.operationmonitor.v1.GetIntentResponse.intent:type_name -> ukama.node.operationmonitor.v1.MonitoredIntent
+	2, // 6: ukama.node.operationmonitor.v1.OperationMonitorService.RegisterIntent:input_type -> ukama.node.operationmonitor.v1.RegisterIntentRequest
+	4, // 7: ukama.node.operationmonitor.v1.OperationMonitorService.GetIntent:input_type -> ukama.node.operationmonitor.v1.GetIntentRequest
+	6, // 8: ukama.node.operationmonitor.v1.OperationMonitorService.CancelIntent:input_type -> ukama.node.operationmonitor.v1.CancelIntentRequest
+	3, // 9: ukama.node.operationmonitor.v1.OperationMonitorService.RegisterIntent:output_type -> ukama.node.operationmonitor.v1.RegisterIntentResponse
+	5, // 10: ukama.node.operationmonitor.v1.OperationMonitorService.GetIntent:output_type -> ukama.node.operationmonitor.v1.GetIntentResponse
+	7, // 11: ukama.node.operationmonitor.v1.OperationMonitorService.CancelIntent:output_type -> ukama.node.operationmonitor.v1.CancelIntentResponse
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_operation_monitor_proto_init() }
@@ -668,99 +618,13 @@ func file_operation_monitor_proto_init() {
 	if File_operation_monitor_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_operation_monitor_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MonitoredIntent); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_monitor_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterIntentRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_monitor_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterIntentResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_monitor_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetIntentRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_monitor_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetIntentResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_monitor_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelIntentRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_monitor_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelIntentResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_operation_monitor_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_operation_monitor_proto_rawDesc), len(file_operation_monitor_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -770,7 +634,6 @@ func file_operation_monitor_proto_init() {
 		MessageInfos:      file_operation_monitor_proto_msgTypes,
 	}.Build()
 	File_operation_monitor_proto = out.File
-	file_operation_monitor_proto_rawDesc = nil
 	file_operation_monitor_proto_goTypes = nil
 	file_operation_monitor_proto_depIdxs = nil
 }
@@ -8,7 +8,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
-// - protoc             v3.14.0
+// - protoc             v6.32.1
 // source: operation_monitor.proto
 
 package gen
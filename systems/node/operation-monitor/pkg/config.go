@@ -37,6 +37,11 @@ func NewConfig(name string) *Config {
 			Timeout: 7 * time.Second,
 			ListenerRoutes: []string{
 				evt.EventRoutingKey[evt.EventNodeStateTransition],
+				evt.EventRoutingKey[evt.EventNodeOnline],
+				evt.EventRoutingKey[evt.EventNodeOffline],
+				evt.EventRoutingKey[evt.EventHealthReportStore],
+				evt.EventRoutingKey[evt.EventHealthAlarmRaise],
+				evt.EventRoutingKey[evt.EventHealthAlarmClear],
 			},
 		},
 	}
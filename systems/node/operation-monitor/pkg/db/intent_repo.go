@@ -9,11 +9,13 @@
 package db
 
 import (
+	"encoding/json"
 	"errors"
 	"time"
 
 	"github.com/ukama/ukama/systems/common/sql"
 	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/node/operation-monitor/pkg/rule"
 	"gorm.io/gorm"
 )
 
@@ -24,6 +26,7 @@ type IntentRepo interface {
 	MarkTerminal(operationId uuid.UUID, status IntentStatus) (*MonitoredIntent, error)
 	FindExpired(now time.Time, limit int) ([]MonitoredIntent, error)
 	Arm(operationId uuid.UUID) error
+	SaveRuleState(operationId uuid.UUID, state rule.State) error
 }
 
 type intentRepo struct {
@@ -61,6 +64,18 @@ func (r *intentRepo) Arm(operationId uuid.UUID) error {
 		Update("armed", true).Error
 }
 
+func (r *intentRepo) SaveRuleState(operationId uuid.UUID, state rule.State) error {
+	/* column updates bypass the field serializer */
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return r.db.GetGormDb().
+		Model(&MonitoredIntent{}).
+		Where("operation_id = ? AND status = ?", operationId, IntentWatching).
+		Update("rule_state", string(b)).Error
+}
+
 func (r *intentRepo) MarkTerminal(operationId uuid.UUID, status IntentStatus) (*MonitoredIntent, error) {
 	if !status.IsTerminal() {
 		return nil, errors.New("MarkTerminal: status must be terminal")
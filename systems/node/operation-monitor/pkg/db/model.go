@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/node/operation-monitor/pkg/rule"
 	"gorm.io/gorm"
 )
 
@@ -63,6 +64,9 @@ type MonitoredIntent struct {
 	// report (e.g. an online heartbeat before a reboot begins) can't
 	// prematurely complete a freshly registered intent.
 	Armed          bool           `gorm:"not null;default:false" json:"armed"`
+	// RuleState holds the facts and sequence progress the completion rule
+	// has observed so far, so composite rules can match across events.
+	RuleState      rule.State     `gorm:"serializer:json" json:"ruleState"`
 	Deadline       time.Time      `gorm:"not null;index" json:"deadline"`
 	CreatedAt      time.Time      `json:"createdAt"`
 	UpdatedAt      time.Time      `json:"updatedAt"`
@@ -0,0 +1,276 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package rule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tkEOF tokenKind = iota
+	tkWord
+	tkString
+	tkOp
+	tkAnd
+	tkOr
+	tkNot
+	tkSeq
+	tkWithin
+	tkLParen
+	tkRParen
+	tkSemi
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_.-:@/*?+", r)
+}
+
+func lex(src string) ([]token, error) {
+	var out []token
+	rs := []rune(src)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			out = append(out, token{tkLParen, "(", i})
+			i++
+		case r == ')':
+			out = append(out, token{tkRParen, ")", i})
+			i++
+		case r == ';':
+			out = append(out, token{tkSemi, ";", i})
+			i++
+		case r == ',':
+			out = append(out, token{tkAnd, ",", i})
+			i++
+		case r == '&' || r == '|':
+			if i+1 >= len(rs) || rs[i+1] != r {
+				return nil, fmt.Errorf("unexpected %q at %d", r, i)
+			}
+			kind := tkAnd
+			if r == '|' {
+				kind = tkOr
+			}
+			out = append(out, token{kind, string(rs[i : i+2]), i})
+			i += 2
+		case r == '!' || r == '<' || r == '>' || r == '=' || r == '~':
+			if i+1 < len(rs) && rs[i+1] == '=' && r != '=' && r != '~' {
+				out = append(out, token{tkOp, string(rs[i : i+2]), i})
+				i += 2
+			} else if r == '!' {
+				out = append(out, token{tkNot, "!", i})
+				i++
+			} else {
+				out = append(out, token{tkOp, string(r), i})
+				i++
+			}
+		case r == '"' || r == '\'':
+			j := i + 1
+			for j < len(rs) && rs[j] != r {
+				j++
+			}
+			if j >= len(rs) {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			out = append(out, token{tkString, string(rs[i+1 : j]), i})
+			i = j + 1
+		case isWordRune(r):
+			j := i
+			for j < len(rs) && isWordRune(rs[j]) {
+				j++
+			}
+			word := string(rs[i:j])
+			kind := tkWord
+			switch strings.ToUpper(word) {
+			case "AND":
+				kind = tkAnd
+			case "OR":
+				kind = tkOr
+			case "NOT":
+				kind = tkNot
+			case "SEQ":
+				kind = tkSeq
+			case "WITHIN":
+				kind = tkWithin
+			}
+			out = append(out, token{kind, word, i})
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected %q at %d", r, i)
+		}
+	}
+	return append(out, token{tkEOF, "", len(rs)}), nil
+}
+
+type parser struct {
+	toks   []token
+	pos    int
+	seqs   []*seqNode
+	fields map[string]bool
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tkEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(kind tokenKind, what string) (token, error) {
+	t := p.next()
+	if t.kind != kind {
+		return t, fmt.Errorf("expected %s at %d, got %q", what, t.pos, t.text)
+	}
+	return t, nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tkOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tkAnd {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	switch t := p.peek(); t.kind {
+	case tkNot:
+		p.next()
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{inner: inner}, nil
+	case tkLParen:
+		p.next()
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tkRParen, "')'"); err != nil {
+			return nil, err
+		}
+		return inner, nil
+	case tkSeq:
+		return p.parseSeq()
+	case tkWord:
+		return p.parseCond()
+	default:
+		return nil, fmt.Errorf("unexpected %q at %d", t.text, t.pos)
+	}
+}
+
+func (p *parser) parseSeq() (node, error) {
+	p.next()
+	if _, err := p.expect(tkLParen, "'(' after SEQ"); err != nil {
+		return nil, err
+	}
+
+	seq := &seqNode{}
+	for {
+		step, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		seq.steps = append(seq.steps, step)
+		if p.peek().kind != tkSemi {
+			break
+		}
+		p.next()
+	}
+	if _, err := p.expect(tkRParen, "')' closing SEQ"); err != nil {
+		return nil, err
+	}
+	/* registered after its steps so nested sequences advance first */
+	seq.idx = len(p.seqs)
+	p.seqs = append(p.seqs, seq)
+
+	if p.peek().kind == tkWithin {
+		d, err := p.parseWithin()
+		if err != nil {
+			return nil, err
+		}
+		seq.within = d
+	}
+	return seq, nil
+}
+
+func (p *parser) parseWithin() (time.Duration, error) {
+	p.next()
+	t, err := p.expect(tkWord, "duration after WITHIN")
+	if err != nil {
+		return 0, err
+	}
+	d, err := time.ParseDuration(t.text)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid duration %q at %d", t.text, t.pos)
+	}
+	return d, nil
+}
+
+func (p *parser) parseCond() (node, error) {
+	field := p.next()
+	op, err := p.expect(tkOp, "operator after "+field.text)
+	if err != nil {
+		return nil, err
+	}
+	value := p.next()
+	if value.kind != tkWord && value.kind != tkString {
+		return nil, fmt.Errorf("expected value at %d, got %q", value.pos, value.text)
+	}
+	if op.text == "~" {
+		if _, err := globMatch(value.text, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q at %d", value.text, value.pos)
+		}
+	}
+	p.fields[field.text] = true
+	return &condNode{field: field.text, op: op.text, value: value.text}, nil
+}
@@ -0,0 +1,220 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+// Package rule implements the completion rule language of operation-monitor.
+//
+// A rule is a boolean expression over facts, the latest value of each field
+// observed on the resource since the intent was registered:
+//
+//	substate=on
+//	connectivity=online AND app.epc.version=1.4.2 WITHIN 10m
+//	SEQ(connectivity=offline; connectivity=online) WITHIN 5m, !alarm.radio.state=raised
+//
+// Conditions compare a field with =, !=, ~ (glob), <, <=, > or >=. Ordering
+// compares integers and dotted versions part by part. Conditions combine with
+// AND (also && or ,), OR (also ||), NOT (also !) and parentheses.
+// SEQ(a; b; ...) holds once its steps became true in order on successive
+// events, optionally within a duration of the first step. A trailing WITHIN
+// on the whole rule is its deadline.
+//
+// The legacy key=value,key2=value2 form is a valid rule with the same meaning.
+package rule
+
+import (
+	"errors"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// State is what a rule remembers between events. It is stored with the
+// intent, so it must stay JSON friendly.
+type State struct {
+	Facts map[string]string `json:"facts,omitempty"`
+	Seqs  []SeqProgress     `json:"seqs,omitempty"`
+}
+
+type SeqProgress struct {
+	Step      int        `json:"step"`
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+}
+
+type Program struct {
+	// Within is the deadline of the whole rule, 0 when it has none
+	Within time.Duration
+	root   node
+	seqs   []*seqNode
+	fields map[string]bool
+}
+
+func Parse(src string) (*Program, error) {
+	if strings.TrimSpace(src) == "" {
+		return nil, errors.New("empty rule")
+	}
+	toks, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{toks: toks, fields: map[string]bool{}}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	prog := &Program{root: root, seqs: p.seqs, fields: p.fields}
+	if p.peek().kind == tkWithin {
+		if prog.Within, err = p.parseWithin(); err != nil {
+			return nil, err
+		}
+	}
+	if t := p.peek(); t.kind != tkEOF {
+		return nil, errors.New("unexpected " + strconv.Quote(t.text) + " at " + strconv.Itoa(t.pos))
+	}
+	return prog, nil
+}
+
+// Refers reports whether an event with these fields concerns the rule. Events
+// that set none of the fields the rule looks at neither advance nor arm it.
+func (p *Program) Refers(fields map[string]string) bool {
+	for k := range fields {
+		if p.fields[k] {
+			return true
+		}
+	}
+	return false
+}
+
+// Observe folds one event into st and reports whether the rule holds. Only
+// the fields the rule looks at are kept. Each sequence advances at most one
+// step per event.
+func (p *Program) Observe(st *State, fields map[string]string, now time.Time) bool {
+	if st.Facts == nil {
+		st.Facts = map[string]string{}
+	}
+	for k, v := range fields {
+		if p.fields[k] {
+			st.Facts[k] = v
+		}
+	}
+	if len(st.Seqs) != len(p.seqs) {
+		st.Seqs = make([]SeqProgress, len(p.seqs))
+	}
+
+	for _, seq := range p.seqs {
+		prog := &st.Seqs[seq.idx]
+		if prog.Step >= len(seq.steps) {
+			continue
+		}
+		if seq.within > 0 && prog.StartedAt != nil && now.Sub(*prog.StartedAt) > seq.within {
+			*prog = SeqProgress{}
+		}
+		if seq.steps[prog.Step].eval(st) {
+			if prog.Step == 0 {
+				at := now
+				prog.StartedAt = &at
+			}
+			prog.Step++
+		}
+	}
+
+	return p.root.eval(st)
+}
+
+type node interface {
+	eval(st *State) bool
+}
+
+type andNode struct{ left, right node }
+
+func (n *andNode) eval(st *State) bool { return n.left.eval(st) && n.right.eval(st) }
+
+type orNode struct{ left, right node }
+
+func (n *orNode) eval(st *State) bool { return n.left.eval(st) || n.right.eval(st) }
+
+type notNode struct{ inner node }
+
+func (n *notNode) eval(st *State) bool { return !n.inner.eval(st) }
+
+type seqNode struct {
+	idx    int
+	steps  []node
+	within time.Duration
+}
+
+func (n *seqNode) eval(st *State) bool {
+	return n.idx < len(st.Seqs) && st.Seqs[n.idx].Step >= len(n.steps)
+}
+
+type condNode struct {
+	field string
+	op    string
+	value string
+}
+
+func (n *condNode) eval(st *State) bool {
+	v, ok := st.Facts[n.field]
+	switch n.op {
+	case "=":
+		return ok && v == n.value
+	case "!=":
+		return !ok || v != n.value
+	case "~":
+		m, _ := globMatch(n.value, v)
+		return ok && m
+	}
+	if !ok {
+		return false
+	}
+
+	c := compare(v, n.value)
+	switch n.op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+func globMatch(pattern, v string) (bool, error) {
+	return path.Match(pattern, v)
+}
+
+// compare orders values as dotted versions (1.10.0 > 1.9.2, v2 > v1), so
+// integers compare numerically. Non-numeric parts compare as strings.
+func compare(a, b string) int {
+	pa := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	pb := strings.Split(strings.TrimPrefix(b, "v"), ".")
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		sa, sb := "0", "0"
+		if i < len(pa) {
+			sa = pa[i]
+		}
+		if i < len(pb) {
+			sb = pb[i]
+		}
+		ia, errA := strconv.Atoi(sa)
+		ib, errB := strconv.Atoi(sb)
+		switch {
+		case errA == nil && errB == nil && ia != ib:
+			if ia < ib {
+				return -1
+			}
+			return 1
+		case (errA != nil || errB != nil) && sa != sb:
+			return strings.Compare(sa, sb)
+		}
+	}
+	return 0
+}
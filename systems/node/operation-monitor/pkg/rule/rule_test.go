@@ -0,0 +1,112 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package rule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func matches(t *testing.T, src string, fields map[string]string) bool {
+	t.Helper()
+	p, err := Parse(src)
+	if !assert.NoError(t, err) {
+		return false
+	}
+	return p.Observe(&State{}, fields, time.Now())
+}
+
+func TestLegacyRules(t *testing.T) {
+	tr := map[string]string{"state": "Configured", "substate": "on"}
+
+	assert.True(t, matches(t, "substate=on", tr))
+	assert.True(t, matches(t, "state=Configured,substate=on", tr))
+	assert.False(t, matches(t, "state=Operational", tr))
+
+	_, err := Parse("")
+	assert.Error(t, err)
+	_, err = Parse("garbage")
+	assert.Error(t, err)
+}
+
+func TestExpressions(t *testing.T) {
+	f := map[string]string{
+		"connectivity":    "online",
+		"app.epc.version": "1.10.0",
+		"uptime_sec":      "120",
+		"starter.state":   "running",
+	}
+
+	assert.True(t, matches(t, "connectivity=online AND app.epc.version=1.10.0", f))
+	assert.True(t, matches(t, "connectivity=offline || app.epc.version>=1.9.2", f))
+	assert.False(t, matches(t, "connectivity=online && NOT app.epc.version=1.10.0", f))
+	assert.True(t, matches(t, "!(connectivity=offline OR starter.state!=running)", f))
+	assert.True(t, matches(t, "uptime_sec>60 AND uptime_sec<=120", f))
+	assert.True(t, matches(t, `starter.state~"run*"`, f))
+	assert.True(t, matches(t, "alarm.radio.state!=raised", f))
+	assert.False(t, matches(t, "alarm.radio.state=raised", f))
+	assert.True(t, matches(t, "app.epc.version>v1.4", f))
+
+	for _, bad := range []string{"a=b AND", "(a=b", "a=b WITHIN forever", "SEQ(a=b", "a=[", "a=b c=d", "a & b"} {
+		_, err := Parse(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestWithin(t *testing.T) {
+	p, err := Parse("connectivity=online AND app.epc.version=1.4.2 WITHIN 10m")
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, p.Within)
+
+	p, err = Parse("SEQ(substate=off; substate=on) WITHIN 1m")
+	assert.NoError(t, err)
+	assert.Zero(t, p.Within)
+}
+
+func TestFactsAccumulateAcrossEvents(t *testing.T) {
+	p, err := Parse("connectivity=online AND app.epc.version=1.4.2")
+	assert.NoError(t, err)
+
+	st := &State{}
+	now := time.Now()
+	assert.False(t, p.Observe(st, map[string]string{"connectivity": "online", "event": "online"}, now))
+	assert.False(t, p.Refers(map[string]string{"uptime_sec": "3"}))
+	assert.True(t, p.Observe(st, map[string]string{"app.epc.version": "1.4.2", "uptime_sec": "3"}, now))
+
+	assert.Equal(t, map[string]string{"connectivity": "online", "app.epc.version": "1.4.2"}, st.Facts)
+}
+
+func TestSequence(t *testing.T) {
+	p, err := Parse("SEQ(connectivity=offline; connectivity=online) WITHIN 5m")
+	assert.NoError(t, err)
+
+	st := &State{}
+	t0 := time.Now()
+	online := map[string]string{"connectivity": "online"}
+	offline := map[string]string{"connectivity": "offline"}
+
+	assert.False(t, p.Observe(st, online, t0), "online before offline is out of order")
+	assert.False(t, p.Observe(st, offline, t0.Add(time.Second)))
+	assert.True(t, p.Observe(st, online, t0.Add(time.Minute)))
+	assert.True(t, p.Observe(st, offline, t0.Add(2*time.Minute)), "a completed sequence stays complete")
+
+	st = &State{}
+	assert.False(t, p.Observe(st, offline, t0))
+	assert.False(t, p.Observe(st, online, t0.Add(6*time.Minute)), "sequence took longer than WITHIN")
+	assert.Equal(t, 0, st.Seqs[0].Step)
+}
+
+func TestCompare(t *testing.T) {
+	assert.Equal(t, 1, compare("1.10.0", "1.9.2"))
+	assert.Equal(t, 0, compare("v1.4", "1.4.0"))
+	assert.Equal(t, -1, compare("9", "10"))
+	assert.Equal(t, -1, compare("1.4.0-rc1", "1.4.0-rc2"))
+}
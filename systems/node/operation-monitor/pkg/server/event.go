@@ -10,8 +10,13 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"strings"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -21,6 +26,7 @@ import (
 	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
 
 	"github.com/ukama/ukama/systems/node/operation-monitor/pkg/db"
+	"github.com/ukama/ukama/systems/node/operation-monitor/pkg/rule"
 )
 
 type EventServer struct {
@@ -42,6 +48,31 @@ func (e *EventServer) EventNotification(ctx context.Context, event *epb.Event) (
 	switch event.RoutingKey {
 	case msgbus.PrepareRoute(e.orgName, evt.EventRoutingKey[evt.EventNodeStateTransition]):
 		return e.handleStateTransition(ctx, event)
+	case msgbus.PrepareRoute(e.orgName, evt.EventRoutingKey[evt.EventNodeOnline]):
+		msg, err := epb.UnmarshalNodeOnlineEvent(event.Msg, "NodeOnlineEvent")
+		if err != nil {
+			return nil, err
+		}
+		return e.observe(msg.NodeId, map[string]string{"event": "online", "connectivity": "online"})
+	case msgbus.PrepareRoute(e.orgName, evt.EventRoutingKey[evt.EventNodeOffline]):
+		msg, err := epb.UnmarshalNodeOfflineEvent(event.Msg, "NodeOfflineEvent")
+		if err != nil {
+			return nil, err
+		}
+		return e.observe(msg.NodeId, map[string]string{"event": "offline", "connectivity": "offline"})
+	case msgbus.PrepareRoute(e.orgName, evt.EventRoutingKey[evt.EventHealthReportStore]):
+		return e.handleHealthReport(ctx, event)
+	case msgbus.PrepareRoute(e.orgName, evt.EventRoutingKey[evt.EventHealthAlarmRaise]),
+		msgbus.PrepareRoute(e.orgName, evt.EventRoutingKey[evt.EventHealthAlarmClear]):
+		msg, err := epb.UnmarshalHealthAlarmEvent(event.Msg, "HealthAlarmEvent")
+		if err != nil {
+			return nil, err
+		}
+		return e.observe(msg.NodeId, map[string]string{
+			"event":                           "alarm",
+			"alarm." + msg.Type + ".state":    msg.State,
+			"alarm." + msg.Type + ".severity": msg.Severity,
+		})
 	default:
 		log.Warnf("operation-monitor: unhandled routing key %s", event.RoutingKey)
 		return &epb.EventResponse{}, nil
@@ -54,25 +85,89 @@ func (e *EventServer) handleStateTransition(_ context.Context, event *epb.Event)
 		return nil, err
 	}
 
-	resourceKey := "node:" + msg.NodeId
-	intents, err := e.monitor.repo.FindWatchingByResource(resourceKey)
+	return e.observe(msg.NodeId, map[string]string{
+		"event":    "state",
+		"state":    msg.State,
+		"substate": msg.Substate,
+		"node_id":  msg.NodeId,
+	})
+}
+
+// healthReport is the part of a node health report that completion rules see.
+type healthReport struct {
+	System struct {
+		UptimeSec int64 `json:"uptimeSec"`
+		Starter   struct {
+			State            string `json:"state"`
+			UpdateInProgress bool   `json:"updateInProgress"`
+		} `json:"starter"`
+	} `json:"system"`
+	Apps []struct {
+		Name    string `json:"name"`
+		Tag     string `json:"tag"`
+		Version string `json:"version"`
+		State   string `json:"state"`
+	} `json:"apps"`
+}
+
+func (e *EventServer) handleHealthReport(_ context.Context, event *epb.Event) (*epb.EventResponse, error) {
+	msg, err := epb.UnmarshalHealthReportEvent(event.Msg, "HealthReportEvent")
 	if err != nil {
-		log.Errorf("operation-monitor: lookup intents for %s: %v", resourceKey, err)
 		return nil, err
 	}
-	if len(intents) == 0 {
+
+	var report healthReport
+	if err := json.Unmarshal(msg.Payload, &report); err != nil {
+		log.Warnf("operation-monitor: unreadable health report %s of %s: %v", msg.Id, msg.NodeId, err)
 		return &epb.EventResponse{}, nil
 	}
 
-	transition := map[string]string{
-		"state":    msg.State,
-		"substate": msg.Substate,
-		"node_id":  msg.NodeId,
+	fields := map[string]string{
+		"event":                      "health",
+		"uptime_sec":                 strconv.FormatInt(report.System.UptimeSec, 10),
+		"starter.state":              report.System.Starter.State,
+		"starter.update_in_progress": strconv.FormatBool(report.System.Starter.UpdateInProgress),
+	}
+	for _, app := range report.Apps {
+		fields["app."+app.Name+".version"] = app.Version
+		fields["app."+app.Name+".tag"] = app.Tag
+		fields["app."+app.Name+".state"] = app.State
 	}
+	return e.observe(msg.NodeId, fields)
+}
 
+// observe folds an event of a node into the completion rules of the intents
+// watching it and completes those whose rule now holds.
+func (e *EventServer) observe(nodeId string, fields map[string]string) (*epb.EventResponse, error) {
+	resourceKey := "node:" + nodeId
+	intents, err := e.monitor.repo.FindWatchingByResource(resourceKey)
+	if err != nil {
+		log.Errorf("operation-monitor: lookup intents for %s: %v", resourceKey, err)
+		return nil, err
+	}
+
+	now := time.Now().UTC()
 	for i := range intents {
 		intent := &intents[i]
-		if !ruleMatches(intent.CompletionRule, transition) {
+		prog, err := rule.Parse(intent.CompletionRule)
+		if err != nil {
+			log.Errorf("operation-monitor: intent %s has invalid rule %q: %v", intent.OperationId, intent.CompletionRule, err)
+			continue
+		}
+		if !prog.Refers(fields) {
+			continue
+		}
+
+		before := intent.RuleState
+		state := rule.State{Facts: maps.Clone(before.Facts), Seqs: slices.Clone(before.Seqs)}
+		matched := prog.Observe(&state, fields, now)
+		if !reflect.DeepEqual(before, state) {
+			if err := e.monitor.repo.SaveRuleState(intent.OperationId, state); err != nil {
+				log.Errorf("operation-monitor: save rule state of %s: %v", intent.OperationId, err)
+			}
+		}
+
+		if !matched {
 			// The node is outside the rule's target state: arm the intent so
 			// the NEXT match completes it. Without this, a steady-state report
 			// (e.g. an online heartbeat arriving between lock acquisition and
@@ -82,14 +177,14 @@ func (e *EventServer) handleStateTransition(_ context.Context, event *epb.Event)
 				if err := e.monitor.repo.Arm(intent.OperationId); err != nil {
 					log.Errorf("operation-monitor: arm %s: %v", intent.OperationId, err)
 				} else {
-					log.Infof("operation-monitor: intent %s armed by %v", intent.OperationId, transition)
+					log.Infof("operation-monitor: intent %s armed by %v", intent.OperationId, fields)
 				}
 			}
 			continue
 		}
 		if !intent.Armed {
 			log.Infof("operation-monitor: intent %s matched %v but not armed yet (no departure observed), skipping",
-				intent.OperationId, transition)
+				intent.OperationId, fields)
 			continue
 		}
 		if _, err := e.monitor.repo.MarkTerminal(intent.OperationId, db.IntentCompleted); err != nil {
@@ -101,7 +196,7 @@ func (e *EventServer) handleStateTransition(_ context.Context, event *epb.Event)
 			continue
 		}
 		log.Infof("operation-monitor: intent %s satisfied (rule=%q matched %v)",
-			intent.OperationId, intent.CompletionRule, transition)
+			intent.OperationId, intent.CompletionRule, fields)
 	}
 	return &epb.EventResponse{}, nil
 }
@@ -115,26 +210,3 @@ func (e *EventServer) publishCompleted(intent *db.MonitoredIntent) error {
 		CompletedAt:  timestamppb.Now(),
 	})
 }
-
-// ruleMatches evaluates a key=value,key2=value2 rule against the transition map.
-// All k=v pairs must match. Empty rule must not match success.
-//
-// TODO: replace with a real expression parser if we ever need OR / NOT / globs.
-func ruleMatches(rule string, transition map[string]string) bool {
-	rule = strings.TrimSpace(rule)
-	if rule == "" {
-		return false
-	}
-	for _, pair := range strings.Split(rule, ",") {
-		kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
-		if len(kv) != 2 {
-			return false
-		}
-		k := strings.TrimSpace(kv[0])
-		v := strings.TrimSpace(kv[1])
-		if transition[k] != v {
-			return false
-		}
-	}
-	return true
-}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/ukama/ukama/systems/node/operation-monitor/mocks"
 	"github.com/ukama/ukama/systems/node/operation-monitor/pkg/db"
+	"github.com/ukama/ukama/systems/node/operation-monitor/pkg/rule"
 )
 
 const (
@@ -74,6 +75,7 @@ func TestHandleStateTransition_SteadyStateDoesNotComplete(t *testing.T) {
 
 	repo.On("FindWatchingByResource", "node:"+testNodeId).
 		Return([]db.MonitoredIntent{intent}, nil).Once()
+	repo.On("SaveRuleState", intent.OperationId, mock.Anything).Return(nil).Once()
 
 	s := newEventServer(repo, mb)
 	_, err := s.EventNotification(context.TODO(), transitionEvent(t, "Configured", "on"))
@@ -92,6 +94,9 @@ func TestHandleStateTransition_DepartureArmsIntent(t *testing.T) {
 
 	repo.On("FindWatchingByResource", "node:"+testNodeId).
 		Return([]db.MonitoredIntent{intent}, nil).Once()
+	repo.On("SaveRuleState", intent.OperationId, mock.MatchedBy(func(st rule.State) bool {
+		return st.Facts["substate"] == "reboot"
+	})).Return(nil).Once()
 	repo.On("Arm", intent.OperationId).Return(nil).Once()
 
 	s := newEventServer(repo, mb)
@@ -111,6 +116,7 @@ func TestHandleStateTransition_ArmedIntentCompletes(t *testing.T) {
 
 	repo.On("FindWatchingByResource", "node:"+testNodeId).
 		Return([]db.MonitoredIntent{intent}, nil).Once()
+	repo.On("SaveRuleState", intent.OperationId, mock.Anything).Return(nil).Once()
 	repo.On("MarkTerminal", intent.OperationId, db.IntentCompleted).
 		Return(&intent, nil).Once()
 	mb.On("PublishRequest", mock.MatchedBy(func(route string) bool {
@@ -126,12 +132,60 @@ func TestHandleStateTransition_ArmedIntentCompletes(t *testing.T) {
 	mb.AssertExpectations(t)
 }
 
-func TestRuleMatches(t *testing.T) {
-	tr := map[string]string{"state": "Configured", "substate": "on"}
+func healthEvent(t *testing.T, payload string) *epb.Event {
+	t.Helper()
+	msg, err := anypb.New(&epb.HealthReportEvent{NodeId: testNodeId, Payload: []byte(payload)})
+	if err != nil {
+		t.Fatalf("marshal event: %v", err)
+	}
+	return &epb.Event{
+		RoutingKey: msgbus.PrepareRoute(testOrg, evt.EventRoutingKey[evt.EventHealthReportStore]),
+		Msg:        msg,
+	}
+}
+
+// Events that set none of the rule's fields must not arm it: a periodic health
+// report says nothing about a reboot having started.
+func TestHandleHealthReport_UnrelatedRuleIgnored(t *testing.T) {
+	repo := &mocks.IntentRepo{}
+	mb := &mbmocks.MsgBusServiceClient{}
+	intent := watchingIntent(false)
+
+	repo.On("FindWatchingByResource", "node:"+testNodeId).
+		Return([]db.MonitoredIntent{intent}, nil).Once()
+
+	s := newEventServer(repo, mb)
+	_, err := s.EventNotification(context.TODO(), healthEvent(t, `{"apps":[{"name":"epc","version":"1.4.2"}]}`))
 
-	assert.True(t, ruleMatches("substate=on", tr))
-	assert.True(t, ruleMatches("state=Configured,substate=on", tr))
-	assert.False(t, ruleMatches("state=Operational", tr))
-	assert.False(t, ruleMatches("", tr))
-	assert.False(t, ruleMatches("garbage", tr))
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "Arm", mock.Anything)
+	repo.AssertNotCalled(t, "SaveRuleState", mock.Anything, mock.Anything)
+}
+
+// A composite rule completes once facts from different events together satisfy it.
+func TestHandleHealthReport_CompositeIntentCompletes(t *testing.T) {
+	repo := &mocks.IntentRepo{}
+	mb := &mbmocks.MsgBusServiceClient{}
+	intent := watchingIntent(true)
+	intent.ActionType = "UpdateSoftware"
+	intent.CompletionRule = "connectivity=online AND app.epc.version>=1.4.2 WITHIN 10m"
+	intent.RuleState = rule.State{Facts: map[string]string{"connectivity": "online", "app.epc.version": "1.3.0"}}
+
+	repo.On("FindWatchingByResource", "node:"+testNodeId).
+		Return([]db.MonitoredIntent{intent}, nil).Once()
+	repo.On("SaveRuleState", intent.OperationId, mock.MatchedBy(func(st rule.State) bool {
+		_, kept := st.Facts["uptime_sec"]
+		return st.Facts["app.epc.version"] == "1.10.0" && !kept
+	})).Return(nil).Once()
+	repo.On("MarkTerminal", intent.OperationId, db.IntentCompleted).Return(&intent, nil).Once()
+	mb.On("PublishRequest", mock.Anything, mock.Anything).Return(nil).Once()
+
+	s := newEventServer(repo, mb)
+	_, err := s.EventNotification(context.TODO(), healthEvent(t,
+		`{"system":{"uptimeSec":12},"apps":[{"name":"epc","version":"1.10.0"}]}`))
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+	mb.AssertExpectations(t)
 }
@@ -26,6 +26,7 @@ import (
 	pb "github.com/ukama/ukama/systems/node/operation-monitor/pb/gen"
 	"github.com/ukama/ukama/systems/node/operation-monitor/pkg"
 	"github.com/ukama/ukama/systems/node/operation-monitor/pkg/db"
+	"github.com/ukama/ukama/systems/node/operation-monitor/pkg/rule"
 )
 
 type MonitorServer struct {
@@ -60,17 +61,26 @@ func (s *MonitorServer) RegisterIntent(ctx context.Context, req *pb.RegisterInte
 		return nil, status.Errorf(codes.InvalidArgument, "invalid operation id: %v", err)
 	}
 
-	rule := req.CompletionRule
-	if rule == "" {
+	completionRule := req.CompletionRule
+	if completionRule == "" {
 		if defaultRule, ok := pkg.DefaultCompletionRule[req.ActionType]; ok {
-			rule = defaultRule
+			completionRule = defaultRule
 		} else {
 			return nil, status.Errorf(codes.InvalidArgument,
 				"completion_rule is empty and no default for action %q", req.ActionType)
 		}
 	}
 
-	deadline := time.Duration(req.DeadlineSeconds) * time.Second
+	prog, err := rule.Parse(completionRule)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid completion_rule %q: %v", completionRule, err)
+	}
+
+	/* a WITHIN on the rule is more specific than the caller's deadline */
+	deadline := prog.Within
+	if deadline == 0 {
+		deadline = time.Duration(req.DeadlineSeconds) * time.Second
+	}
 	if deadline == 0 {
 		deadline = pkg.DefaultDeadlineTTL
 	}
@@ -81,7 +91,7 @@ func (s *MonitorServer) RegisterIntent(ctx context.Context, req *pb.RegisterInte
 		ResourceKey:    req.ResourceKey,
 		ActionType:     req.ActionType,
 		FencingToken:   req.FencingToken,
-		CompletionRule: rule,
+		CompletionRule: completionRule,
 		Status:         db.IntentWatching,
 		Deadline:       time.Now().UTC().Add(deadline),
 	}
@@ -134,5 +144,7 @@ func toPb(i *db.MonitoredIntent) *pb.MonitoredIntent {
 		Status:         pb.IntentStatus(i.Status),
 		Deadline:       timestamppb.New(i.Deadline),
 		CreatedAt:      timestamppb.New(i.CreatedAt),
+		Facts:          i.RuleState.Facts,
+		Armed:          i.Armed,
 	}
 }
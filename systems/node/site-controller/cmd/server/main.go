@@ -61,7 +61,7 @@ func initConfig() {
 
 func initDb() sql.Db {
 	d := sql.NewDb(svcConf.DB, svcConf.DebugMode)
-	if err := d.Init(&db.Site{}, &db.SiteIntent{}, &db.SiteIntentFlight{}, &db.SiteState{}, &db.SiteComponent{}, &db.SitePortMap{}); err != nil {
+	if err := d.Init(&db.Site{}, &db.SiteIntent{}, &db.SiteIntentFlight{}, &db.SiteState{}, &db.SiteComponent{}, &db.SitePortMap{}, &db.SitePowerPolicy{}, &db.SiteSchedule{}, &db.SiteAuditEntry{}); err != nil {
 		log.Fatalf("Database initialization failed: %v", err)
 	}
 	return d
@@ -86,6 +86,9 @@ func runGrpcServer(gormdb sql.Db) {
 	componentRepo := db.NewComponentRepo(gormdb)
 	siteRepo := db.NewSiteRepo(gormdb)
 	flightRepo := db.NewIntentFlightRepo(gormdb)
+	powerPolicyRepo := db.NewPowerPolicyRepo(gormdb)
+	scheduleRepo := db.NewScheduleRepo(gormdb)
+	auditRepo := db.NewAuditRepo(gormdb)
 
 	dbStruct := db.InitDBStruct(siteRepo, intentRepo, flightRepo, stateRepo, componentRepo, portMapRepo)
 
@@ -97,6 +100,9 @@ func runGrpcServer(gormdb sql.Db) {
 		flightRepo,
 		portMapRepo,
 		componentRepo,
+		powerPolicyRepo,
+		scheduleRepo,
+		auditRepo,
 		controllerProvider,
 		adapters.NewTowerAdapter(controllerProvider),
 		adapters.NewAmplifierAdapter(controllerProvider),
@@ -0,0 +1,75 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	db "github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+)
+
+// AuditRepo is an autogenerated mock type for the AuditRepo type
+type AuditRepo struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: entry
+func (_m *AuditRepo) Add(entry *db.SiteAuditEntry) error {
+	ret := _m.Called(entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.SiteAuditEntry) error); ok {
+		r0 = rf(entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: filter
+func (_m *AuditRepo) List(filter db.AuditFilter) ([]db.SiteAuditEntry, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []db.SiteAuditEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.AuditFilter) ([]db.SiteAuditEntry, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(db.AuditFilter) []db.SiteAuditEntry); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.SiteAuditEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.AuditFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAuditRepo creates a new instance of AuditRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditRepo {
+	mock := &AuditRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
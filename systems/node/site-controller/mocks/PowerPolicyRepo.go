@@ -0,0 +1,75 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	db "github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+)
+
+// PowerPolicyRepo is an autogenerated mock type for the PowerPolicyRepo type
+type PowerPolicyRepo struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: siteID
+func (_m *PowerPolicyRepo) Get(siteID string) (*db.SitePowerPolicy, error) {
+	ret := _m.Called(siteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *db.SitePowerPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*db.SitePowerPolicy, error)); ok {
+		return rf(siteID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *db.SitePowerPolicy); ok {
+		r0 = rf(siteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.SitePowerPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(siteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: policy
+func (_m *PowerPolicyRepo) Upsert(policy *db.SitePowerPolicy) error {
+	ret := _m.Called(policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.SitePowerPolicy) error); ok {
+		r0 = rf(policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewPowerPolicyRepo creates a new instance of PowerPolicyRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPowerPolicyRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PowerPolicyRepo {
+	mock := &PowerPolicyRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
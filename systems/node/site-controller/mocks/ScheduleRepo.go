@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	db "github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+
+	uuid "github.com/ukama/ukama/systems/common/uuid"
+)
+
+// ScheduleRepo is an autogenerated mock type for the ScheduleRepo type
+type ScheduleRepo struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: schedule
+func (_m *ScheduleRepo) Add(schedule *db.SiteSchedule) error {
+	ret := _m.Called(schedule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.SiteSchedule) error); ok {
+		r0 = rf(schedule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *ScheduleRepo) Delete(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: id
+func (_m *ScheduleRepo) Get(id uuid.UUID) (*db.SiteSchedule, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *db.SiteSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (*db.SiteSchedule, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) *db.SiteSchedule); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.SiteSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBySite provides a mock function with given fields: siteID
+func (_m *ScheduleRepo) ListBySite(siteID string) ([]db.SiteSchedule, error) {
+	ret := _m.Called(siteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBySite")
+	}
+
+	var r0 []db.SiteSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]db.SiteSchedule, error)); ok {
+		return rf(siteID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []db.SiteSchedule); ok {
+		r0 = rf(siteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.SiteSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(siteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: schedule
+func (_m *ScheduleRepo) Update(schedule *db.SiteSchedule) error {
+	ret := _m.Called(schedule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.SiteSchedule) error); ok {
+		r0 = rf(schedule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewScheduleRepo creates a new instance of ScheduleRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewScheduleRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ScheduleRepo {
+	mock := &ScheduleRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
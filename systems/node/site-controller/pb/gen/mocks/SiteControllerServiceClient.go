@@ -16,6 +16,43 @@ type SiteControllerServiceClient struct {
 	mock.Mock
 }
 
+// AddSchedule provides a mock function with given fields: ctx, in, opts
+func (_m *SiteControllerServiceClient) AddSchedule(ctx context.Context, in *gen.AddScheduleRequest, opts ...grpc.CallOption) (*gen.AddScheduleResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSchedule")
+	}
+
+	var r0 *gen.AddScheduleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddScheduleRequest, ...grpc.CallOption) (*gen.AddScheduleResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddScheduleRequest, ...grpc.CallOption) *gen.AddScheduleResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.AddScheduleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AddScheduleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ApplySwitchPolicy provides a mock function with given fields: ctx, in, opts
 func (_m *SiteControllerServiceClient) ApplySwitchPolicy(ctx context.Context, in *gen.ApplySwitchPolicyRequest, opts ...grpc.CallOption) (*gen.ApplySwitchPolicyResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -53,6 +90,43 @@ func (_m *SiteControllerServiceClient) ApplySwitchPolicy(ctx context.Context, in
 	return r0, r1
 }
 
+// DeleteSchedule provides a mock function with given fields: ctx, in, opts
+func (_m *SiteControllerServiceClient) DeleteSchedule(ctx context.Context, in *gen.DeleteScheduleRequest, opts ...grpc.CallOption) (*gen.DeleteScheduleResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSchedule")
+	}
+
+	var r0 *gen.DeleteScheduleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteScheduleRequest, ...grpc.CallOption) (*gen.DeleteScheduleResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteScheduleRequest, ...grpc.CallOption) *gen.DeleteScheduleResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DeleteScheduleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteScheduleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPortMap provides a mock function with given fields: ctx, in, opts
 func (_m *SiteControllerServiceClient) GetPortMap(ctx context.Context, in *gen.GetPortMapRequest, opts ...grpc.CallOption) (*gen.GetPortMapResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -90,6 +164,43 @@ func (_m *SiteControllerServiceClient) GetPortMap(ctx context.Context, in *gen.G
 	return r0, r1
 }
 
+// GetPowerPolicy provides a mock function with given fields: ctx, in, opts
+func (_m *SiteControllerServiceClient) GetPowerPolicy(ctx context.Context, in *gen.GetPowerPolicyRequest, opts ...grpc.CallOption) (*gen.GetPowerPolicyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPowerPolicy")
+	}
+
+	var r0 *gen.GetPowerPolicyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetPowerPolicyRequest, ...grpc.CallOption) (*gen.GetPowerPolicyResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetPowerPolicyRequest, ...grpc.CallOption) *gen.GetPowerPolicyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetPowerPolicyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetPowerPolicyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSiteState provides a mock function with given fields: ctx, in, opts
 func (_m *SiteControllerServiceClient) GetSiteState(ctx context.Context, in *gen.GetSiteStateRequest, opts ...grpc.CallOption) (*gen.GetSiteStateResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -127,6 +238,117 @@ func (_m *SiteControllerServiceClient) GetSiteState(ctx context.Context, in *gen
 	return r0, r1
 }
 
+// ListAuditLog provides a mock function with given fields: ctx, in, opts
+func (_m *SiteControllerServiceClient) ListAuditLog(ctx context.Context, in *gen.ListAuditLogRequest, opts ...grpc.CallOption) (*gen.ListAuditLogResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditLog")
+	}
+
+	var r0 *gen.ListAuditLogResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListAuditLogRequest, ...grpc.CallOption) (*gen.ListAuditLogResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListAuditLogRequest, ...grpc.CallOption) *gen.ListAuditLogResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListAuditLogResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListAuditLogRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListScheduleTransitions provides a mock function with given fields: ctx, in, opts
+func (_m *SiteControllerServiceClient) ListScheduleTransitions(ctx context.Context, in *gen.ListScheduleTransitionsRequest, opts ...grpc.CallOption) (*gen.ListScheduleTransitionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListScheduleTransitions")
+	}
+
+	var r0 *gen.ListScheduleTransitionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListScheduleTransitionsRequest, ...grpc.CallOption) (*gen.ListScheduleTransitionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListScheduleTransitionsRequest, ...grpc.CallOption) *gen.ListScheduleTransitionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListScheduleTransitionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListScheduleTransitionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSchedules provides a mock function with given fields: ctx, in, opts
+func (_m *SiteControllerServiceClient) ListSchedules(ctx context.Context, in *gen.ListSchedulesRequest, opts ...grpc.CallOption) (*gen.ListSchedulesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSchedules")
+	}
+
+	var r0 *gen.ListSchedulesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListSchedulesRequest, ...grpc.CallOption) (*gen.ListSchedulesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListSchedulesRequest, ...grpc.CallOption) *gen.ListSchedulesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListSchedulesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListSchedulesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PowerCycleNode provides a mock function with given fields: ctx, in, opts
 func (_m *SiteControllerServiceClient) PowerCycleNode(ctx context.Context, in *gen.PowerCycleNodeRequest, opts ...grpc.CallOption) (*gen.PowerCycleNodeResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -201,6 +423,43 @@ func (_m *SiteControllerServiceClient) RestartSite(ctx context.Context, in *gen.
 	return r0, r1
 }
 
+// SetPowerPolicy provides a mock function with given fields: ctx, in, opts
+func (_m *SiteControllerServiceClient) SetPowerPolicy(ctx context.Context, in *gen.SetPowerPolicyRequest, opts ...grpc.CallOption) (*gen.SetPowerPolicyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPowerPolicy")
+	}
+
+	var r0 *gen.SetPowerPolicyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetPowerPolicyRequest, ...grpc.CallOption) (*gen.SetPowerPolicyResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetPowerPolicyRequest, ...grpc.CallOption) *gen.SetPowerPolicyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.SetPowerPolicyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.SetPowerPolicyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetRadio provides a mock function with given fields: ctx, in, opts
 func (_m *SiteControllerServiceClient) SetRadio(ctx context.Context, in *gen.SetRadioRequest, opts ...grpc.CallOption) (*gen.SetRadioResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -14,6 +14,36 @@ type SiteControllerServiceServer struct {
 	mock.Mock
 }
 
+// AddSchedule provides a mock function with given fields: _a0, _a1
+func (_m *SiteControllerServiceServer) AddSchedule(_a0 context.Context, _a1 *gen.AddScheduleRequest) (*gen.AddScheduleResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSchedule")
+	}
+
+	var r0 *gen.AddScheduleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddScheduleRequest) (*gen.AddScheduleResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddScheduleRequest) *gen.AddScheduleResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.AddScheduleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AddScheduleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ApplySwitchPolicy provides a mock function with given fields: _a0, _a1
 func (_m *SiteControllerServiceServer) ApplySwitchPolicy(_a0 context.Context, _a1 *gen.ApplySwitchPolicyRequest) (*gen.ApplySwitchPolicyResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -44,6 +74,36 @@ func (_m *SiteControllerServiceServer) ApplySwitchPolicy(_a0 context.Context, _a
 	return r0, r1
 }
 
+// DeleteSchedule provides a mock function with given fields: _a0, _a1
+func (_m *SiteControllerServiceServer) DeleteSchedule(_a0 context.Context, _a1 *gen.DeleteScheduleRequest) (*gen.DeleteScheduleResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSchedule")
+	}
+
+	var r0 *gen.DeleteScheduleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteScheduleRequest) (*gen.DeleteScheduleResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteScheduleRequest) *gen.DeleteScheduleResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DeleteScheduleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteScheduleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPortMap provides a mock function with given fields: _a0, _a1
 func (_m *SiteControllerServiceServer) GetPortMap(_a0 context.Context, _a1 *gen.GetPortMapRequest) (*gen.GetPortMapResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -74,6 +134,36 @@ func (_m *SiteControllerServiceServer) GetPortMap(_a0 context.Context, _a1 *gen.
 	return r0, r1
 }
 
+// GetPowerPolicy provides a mock function with given fields: _a0, _a1
+func (_m *SiteControllerServiceServer) GetPowerPolicy(_a0 context.Context, _a1 *gen.GetPowerPolicyRequest) (*gen.GetPowerPolicyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPowerPolicy")
+	}
+
+	var r0 *gen.GetPowerPolicyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetPowerPolicyRequest) (*gen.GetPowerPolicyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetPowerPolicyRequest) *gen.GetPowerPolicyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetPowerPolicyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetPowerPolicyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSiteState provides a mock function with given fields: _a0, _a1
 func (_m *SiteControllerServiceServer) GetSiteState(_a0 context.Context, _a1 *gen.GetSiteStateRequest) (*gen.GetSiteStateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -104,6 +194,96 @@ func (_m *SiteControllerServiceServer) GetSiteState(_a0 context.Context, _a1 *ge
 	return r0, r1
 }
 
+// ListAuditLog provides a mock function with given fields: _a0, _a1
+func (_m *SiteControllerServiceServer) ListAuditLog(_a0 context.Context, _a1 *gen.ListAuditLogRequest) (*gen.ListAuditLogResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditLog")
+	}
+
+	var r0 *gen.ListAuditLogResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListAuditLogRequest) (*gen.ListAuditLogResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListAuditLogRequest) *gen.ListAuditLogResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListAuditLogResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListAuditLogRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListScheduleTransitions provides a mock function with given fields: _a0, _a1
+func (_m *SiteControllerServiceServer) ListScheduleTransitions(_a0 context.Context, _a1 *gen.ListScheduleTransitionsRequest) (*gen.ListScheduleTransitionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListScheduleTransitions")
+	}
+
+	var r0 *gen.ListScheduleTransitionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListScheduleTransitionsRequest) (*gen.ListScheduleTransitionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListScheduleTransitionsRequest) *gen.ListScheduleTransitionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListScheduleTransitionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListScheduleTransitionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSchedules provides a mock function with given fields: _a0, _a1
+func (_m *SiteControllerServiceServer) ListSchedules(_a0 context.Context, _a1 *gen.ListSchedulesRequest) (*gen.ListSchedulesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSchedules")
+	}
+
+	var r0 *gen.ListSchedulesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListSchedulesRequest) (*gen.ListSchedulesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListSchedulesRequest) *gen.ListSchedulesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListSchedulesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListSchedulesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PowerCycleNode provides a mock function with given fields: _a0, _a1
 func (_m *SiteControllerServiceServer) PowerCycleNode(_a0 context.Context, _a1 *gen.PowerCycleNodeRequest) (*gen.PowerCycleNodeResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -164,6 +344,36 @@ func (_m *SiteControllerServiceServer) RestartSite(_a0 context.Context, _a1 *gen
 	return r0, r1
 }
 
+// SetPowerPolicy provides a mock function with given fields: _a0, _a1
+func (_m *SiteControllerServiceServer) SetPowerPolicy(_a0 context.Context, _a1 *gen.SetPowerPolicyRequest) (*gen.SetPowerPolicyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPowerPolicy")
+	}
+
+	var r0 *gen.SetPowerPolicyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetPowerPolicyRequest) (*gen.SetPowerPolicyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetPowerPolicyRequest) *gen.SetPowerPolicyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.SetPowerPolicyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.SetPowerPolicyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetRadio provides a mock function with given fields: _a0, _a1
 func (_m *SiteControllerServiceServer) SetRadio(_a0 context.Context, _a1 *gen.SetRadioRequest) (*gen.SetRadioResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -7,7 +7,7 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
+// 	protoc-gen-go v1.36.12
 // 	protoc        v6.32.1
 // source: site_controller.proto
 
@@ -17,8 +17,10 @@ import (
 	_ "github.com/mwitkow/go-proto-validators"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -29,25 +31,26 @@ const (
 )
 
 type SiteIntentMsg struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SiteId         string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	DesiredSite    string                 `protobuf:"bytes,2,opt,name=desired_site,json=desiredSite,proto3" json:"desired_site,omitempty"`
+	DesiredService string                 `protobuf:"bytes,3,opt,name=desired_service,json=desiredService,proto3" json:"desired_service,omitempty"`
+	DesiredRadio   string                 `protobuf:"bytes,4,opt,name=desired_radio,json=desiredRadio,proto3" json:"desired_radio,omitempty"`
+	Reason         string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestedBy    string                 `protobuf:"bytes,6,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	// Load shedding in effect: none, shed_ports, radio_reduced or radio_off
+	LoadShedLevel string  `protobuf:"bytes,7,opt,name=load_shed_level,json=loadShedLevel,proto3" json:"load_shed_level,omitempty"`
+	RadioPowerPct int32   `protobuf:"varint,8,opt,name=radio_power_pct,json=radioPowerPct,proto3" json:"radio_power_pct,omitempty"`
+	ShedPorts     []int32 `protobuf:"varint,9,rep,packed,name=shed_ports,json=shedPorts,proto3" json:"shed_ports,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SiteId         string `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	DesiredSite    string `protobuf:"bytes,2,opt,name=desired_site,json=desiredSite,proto3" json:"desired_site,omitempty"`
-	DesiredService string `protobuf:"bytes,3,opt,name=desired_service,json=desiredService,proto3" json:"desired_service,omitempty"`
-	DesiredRadio   string `protobuf:"bytes,4,opt,name=desired_radio,json=desiredRadio,proto3" json:"desired_radio,omitempty"`
-	Reason         string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	RequestedBy    string `protobuf:"bytes,6,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SiteIntentMsg) Reset() {
 	*x = SiteIntentMsg{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SiteIntentMsg) String() string {
@@ -58,7 +61,7 @@ func (*SiteIntentMsg) ProtoMessage() {}
 
 func (x *SiteIntentMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -115,30 +118,48 @@ func (x *SiteIntentMsg) GetRequestedBy() string {
 	return ""
 }
 
+func (x *SiteIntentMsg) GetLoadShedLevel() string {
+	if x != nil {
+		return x.LoadShedLevel
+	}
+	return ""
+}
+
+func (x *SiteIntentMsg) GetRadioPowerPct() int32 {
+	if x != nil {
+		return x.RadioPowerPct
+	}
+	return 0
+}
+
+func (x *SiteIntentMsg) GetShedPorts() []int32 {
+	if x != nil {
+		return x.ShedPorts
+	}
+	return nil
+}
+
 // DerivedState mirrors persisted SiteState (power/service/radio/access) plus desired fields for convenience.
 type DerivedStateMsg struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SiteId         string `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	DesiredSite    string `protobuf:"bytes,2,opt,name=desired_site,json=desiredSite,proto3" json:"desired_site,omitempty"`
-	DesiredService string `protobuf:"bytes,3,opt,name=desired_service,json=desiredService,proto3" json:"desired_service,omitempty"`
-	DesiredRadio   string `protobuf:"bytes,4,opt,name=desired_radio,json=desiredRadio,proto3" json:"desired_radio,omitempty"`
-	Power          string `protobuf:"bytes,5,opt,name=power,proto3" json:"power,omitempty"`
-	Service        string `protobuf:"bytes,6,opt,name=service,proto3" json:"service,omitempty"`
-	Radio          string `protobuf:"bytes,7,opt,name=radio,proto3" json:"radio,omitempty"`
-	Access         string `protobuf:"bytes,8,opt,name=access,proto3" json:"access,omitempty"`
-	Reason         string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SiteId         string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	DesiredSite    string                 `protobuf:"bytes,2,opt,name=desired_site,json=desiredSite,proto3" json:"desired_site,omitempty"`
+	DesiredService string                 `protobuf:"bytes,3,opt,name=desired_service,json=desiredService,proto3" json:"desired_service,omitempty"`
+	DesiredRadio   string                 `protobuf:"bytes,4,opt,name=desired_radio,json=desiredRadio,proto3" json:"desired_radio,omitempty"`
+	Power          string                 `protobuf:"bytes,5,opt,name=power,proto3" json:"power,omitempty"`
+	Service        string                 `protobuf:"bytes,6,opt,name=service,proto3" json:"service,omitempty"`
+	Radio          string                 `protobuf:"bytes,7,opt,name=radio,proto3" json:"radio,omitempty"`
+	Access         string                 `protobuf:"bytes,8,opt,name=access,proto3" json:"access,omitempty"`
+	Reason         string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DerivedStateMsg) Reset() {
 	*x = DerivedStateMsg{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DerivedStateMsg) String() string {
@@ -149,7 +170,7 @@ func (*DerivedStateMsg) ProtoMessage() {}
 
 func (x *DerivedStateMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -228,24 +249,21 @@ func (x *DerivedStateMsg) GetReason() string {
 }
 
 type SiteSnapshot struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Intent  *SiteIntentMsg   `protobuf:"bytes,1,opt,name=intent,proto3" json:"intent,omitempty"`
-	Derived *DerivedStateMsg `protobuf:"bytes,2,opt,name=derived,proto3" json:"derived,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Intent  *SiteIntentMsg         `protobuf:"bytes,1,opt,name=intent,proto3" json:"intent,omitempty"`
+	Derived *DerivedStateMsg       `protobuf:"bytes,2,opt,name=derived,proto3" json:"derived,omitempty"`
 	// Raw JSON blob from site_components.components
 	ComponentsJson string          `protobuf:"bytes,3,opt,name=components_json,json=componentsJson,proto3" json:"components_json,omitempty"`
 	Ports          []*PortMapEntry `protobuf:"bytes,4,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SiteSnapshot) Reset() {
 	*x = SiteSnapshot{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SiteSnapshot) String() string {
@@ -256,7 +274,7 @@ func (*SiteSnapshot) ProtoMessage() {}
 
 func (x *SiteSnapshot) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -300,26 +318,23 @@ func (x *SiteSnapshot) GetPorts() []*PortMapEntry {
 }
 
 type PortMapEntry struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Port   int32  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
-	Role   string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
-	NodeId string `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Class  string `protobuf:"bytes,4,opt,name=class,proto3" json:"class,omitempty"`
-	Policy string `protobuf:"bytes,5,opt,name=policy,proto3" json:"policy,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Port   int32                  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Role   string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	NodeId string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Class  string                 `protobuf:"bytes,4,opt,name=class,proto3" json:"class,omitempty"`
+	Policy string                 `protobuf:"bytes,5,opt,name=policy,proto3" json:"policy,omitempty"`
 	// Optional per-row cnode id (defaults from UpsertPortMapRequest.cnode_id when empty)
-	CnodeId string `protobuf:"bytes,6,opt,name=cnode_id,json=cnodeId,proto3" json:"cnode_id,omitempty"`
+	CnodeId       string `protobuf:"bytes,6,opt,name=cnode_id,json=cnodeId,proto3" json:"cnode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PortMapEntry) Reset() {
 	*x = PortMapEntry{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *PortMapEntry) String() string {
@@ -330,7 +345,7 @@ func (*PortMapEntry) ProtoMessage() {}
 
 func (x *PortMapEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -388,23 +403,20 @@ func (x *PortMapEntry) GetCnodeId() string {
 }
 
 type SetSiteRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SiteId      string `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	State       string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	Reason      string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	RequestedBy string `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SetSiteRequest) Reset() {
 	*x = SetSiteRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SetSiteRequest) String() string {
@@ -415,7 +427,7 @@ func (*SetSiteRequest) ProtoMessage() {}
 
 func (x *SetSiteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -459,20 +471,17 @@ func (x *SetSiteRequest) GetRequestedBy() string {
 }
 
 type SetSiteResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *DerivedStateMsg       `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	State *DerivedStateMsg `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SetSiteResponse) Reset() {
 	*x = SetSiteResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SetSiteResponse) String() string {
@@ -483,7 +492,7 @@ func (*SetSiteResponse) ProtoMessage() {}
 
 func (x *SetSiteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -506,21 +515,19 @@ func (x *SetSiteResponse) GetState() *DerivedStateMsg {
 }
 
 type SetServiceRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SiteId string `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	State  string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SetServiceRequest) Reset() {
 	*x = SetServiceRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SetServiceRequest) String() string {
@@ -531,7 +538,7 @@ func (*SetServiceRequest) ProtoMessage() {}
 
 func (x *SetServiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -560,19 +567,24 @@ func (x *SetServiceRequest) GetState() string {
 	return ""
 }
 
+func (x *SetServiceRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
 type SetServiceResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SetServiceResponse) Reset() {
 	*x = SetServiceResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SetServiceResponse) String() string {
@@ -583,7 +595,7 @@ func (*SetServiceResponse) ProtoMessage() {}
 
 func (x *SetServiceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -599,21 +611,19 @@ func (*SetServiceResponse) Descriptor() ([]byte, []int) {
 }
 
 type SetRadioRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SiteId string `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	State  string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SetRadioRequest) Reset() {
 	*x = SetRadioRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SetRadioRequest) String() string {
@@ -624,7 +634,7 @@ func (*SetRadioRequest) ProtoMessage() {}
 
 func (x *SetRadioRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -653,19 +663,24 @@ func (x *SetRadioRequest) GetState() string {
 	return ""
 }
 
+func (x *SetRadioRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
 type SetRadioResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SetRadioResponse) Reset() {
 	*x = SetRadioResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SetRadioResponse) String() string {
@@ -676,7 +691,7 @@ func (*SetRadioResponse) ProtoMessage() {}
 
 func (x *SetRadioResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -692,20 +707,17 @@ func (*SetRadioResponse) Descriptor() ([]byte, []int) {
 }
 
 type GetSiteStateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SiteId string `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetSiteStateRequest) Reset() {
 	*x = GetSiteStateRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetSiteStateRequest) String() string {
@@ -716,7 +728,7 @@ func (*GetSiteStateRequest) ProtoMessage() {}
 
 func (x *GetSiteStateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -739,20 +751,17 @@ func (x *GetSiteStateRequest) GetSiteId() string {
 }
 
 type GetSiteStateResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *SiteSnapshot          `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Snapshot *SiteSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetSiteStateResponse) Reset() {
 	*x = GetSiteStateResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetSiteStateResponse) String() string {
@@ -763,7 +772,7 @@ func (*GetSiteStateResponse) ProtoMessage() {}
 
 func (x *GetSiteStateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -786,22 +795,19 @@ func (x *GetSiteStateResponse) GetSnapshot() *SiteSnapshot {
 }
 
 type UpsertPortMapRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	CnodeId       string                 `protobuf:"bytes,2,opt,name=cnode_id,json=cnodeId,proto3" json:"cnode_id,omitempty"`
+	Ports         []*PortMapEntry        `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SiteId  string          `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	CnodeId string          `protobuf:"bytes,2,opt,name=cnode_id,json=cnodeId,proto3" json:"cnode_id,omitempty"`
-	Ports   []*PortMapEntry `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpsertPortMapRequest) Reset() {
 	*x = UpsertPortMapRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *UpsertPortMapRequest) String() string {
@@ -812,7 +818,7 @@ func (*UpsertPortMapRequest) ProtoMessage() {}
 
 func (x *UpsertPortMapRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -849,18 +855,16 @@ func (x *UpsertPortMapRequest) GetPorts() []*PortMapEntry {
 }
 
 type UpsertPortMapResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpsertPortMapResponse) Reset() {
 	*x = UpsertPortMapResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *UpsertPortMapResponse) String() string {
@@ -871,7 +875,7 @@ func (*UpsertPortMapResponse) ProtoMessage() {}
 
 func (x *UpsertPortMapResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -887,20 +891,17 @@ func (*UpsertPortMapResponse) Descriptor() ([]byte, []int) {
 }
 
 type GetPortMapRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SiteId string `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetPortMapRequest) Reset() {
 	*x = GetPortMapRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetPortMapRequest) String() string {
@@ -911,7 +912,7 @@ func (*GetPortMapRequest) ProtoMessage() {}
 
 func (x *GetPortMapRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -934,20 +935,17 @@ func (x *GetPortMapRequest) GetSiteId() string {
 }
 
 type GetPortMapResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ports         []*PortMapEntry        `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Ports []*PortMapEntry `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetPortMapResponse) Reset() {
 	*x = GetPortMapResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetPortMapResponse) String() string {
@@ -958,7 +956,7 @@ func (*GetPortMapResponse) ProtoMessage() {}
 
 func (x *GetPortMapResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -981,20 +979,17 @@ func (x *GetPortMapResponse) GetPorts() []*PortMapEntry {
 }
 
 type ApplySwitchPolicyRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SiteId string `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ApplySwitchPolicyRequest) Reset() {
 	*x = ApplySwitchPolicyRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ApplySwitchPolicyRequest) String() string {
@@ -1005,7 +1000,7 @@ func (*ApplySwitchPolicyRequest) ProtoMessage() {}
 
 func (x *ApplySwitchPolicyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1028,20 +1023,17 @@ func (x *ApplySwitchPolicyRequest) GetSiteId() string {
 }
 
 type ApplySwitchPolicyResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applied       bool                   `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Applied bool `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ApplySwitchPolicyResponse) Reset() {
 	*x = ApplySwitchPolicyResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ApplySwitchPolicyResponse) String() string {
@@ -1052,7 +1044,7 @@ func (*ApplySwitchPolicyResponse) ProtoMessage() {}
 
 func (x *ApplySwitchPolicyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1075,23 +1067,20 @@ func (x *ApplySwitchPolicyResponse) GetApplied() bool {
 }
 
 type PowerCycleNodeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SiteId      string `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	Role        string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
-	Reason      string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	RequestedBy string `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PowerCycleNodeRequest) Reset() {
 	*x = PowerCycleNodeRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *PowerCycleNodeRequest) String() string {
@@ -1102,7 +1091,7 @@ func (*PowerCycleNodeRequest) ProtoMessage() {}
 
 func (x *PowerCycleNodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1146,18 +1135,16 @@ func (x *PowerCycleNodeRequest) GetRequestedBy() string {
 }
 
 type PowerCycleNodeResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PowerCycleNodeResponse) Reset() {
 	*x = PowerCycleNodeResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *PowerCycleNodeResponse) String() string {
@@ -1168,7 +1155,7 @@ func (*PowerCycleNodeResponse) ProtoMessage() {}
 
 func (x *PowerCycleNodeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1184,20 +1171,18 @@ func (*PowerCycleNodeResponse) Descriptor() ([]byte, []int) {
 }
 
 type RestartSiteRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SiteId string `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RestartSiteRequest) Reset() {
 	*x = RestartSiteRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RestartSiteRequest) String() string {
@@ -1208,7 +1193,7 @@ func (*RestartSiteRequest) ProtoMessage() {}
 
 func (x *RestartSiteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1230,22 +1215,26 @@ func (x *RestartSiteRequest) GetSiteId() string {
 	return ""
 }
 
+func (x *RestartSiteRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
 type RestartSiteResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationIds  []string               `protobuf:"bytes,1,rep,name=operation_ids,json=operationIds,proto3" json:"operation_ids,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OperationIds []string `protobuf:"bytes,1,rep,name=operation_ids,json=operationIds,proto3" json:"operation_ids,omitempty"`
-	Status       string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RestartSiteResponse) Reset() {
 	*x = RestartSiteResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RestartSiteResponse) String() string {
@@ -1256,7 +1245,7 @@ func (*RestartSiteResponse) ProtoMessage() {}
 
 func (x *RestartSiteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1286,22 +1275,20 @@ func (x *RestartSiteResponse) GetStatus() string {
 }
 
 type ToggleInternetSwitchRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Port          int32                  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	SiteId string `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	Status bool   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	Port   int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ToggleInternetSwitchRequest) Reset() {
 	*x = ToggleInternetSwitchRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ToggleInternetSwitchRequest) String() string {
@@ -1312,7 +1299,7 @@ func (*ToggleInternetSwitchRequest) ProtoMessage() {}
 
 func (x *ToggleInternetSwitchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1348,23 +1335,27 @@ func (x *ToggleInternetSwitchRequest) GetPort() int32 {
 	return 0
 }
 
+func (x *ToggleInternetSwitchRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
 type ToggleInternetSwitchResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	ResourceKey   string                 `protobuf:"bytes,2,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
-	ResourceKey string `protobuf:"bytes,2,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
-	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ToggleInternetSwitchResponse) Reset() {
 	*x = ToggleInternetSwitchResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_site_controller_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_site_controller_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ToggleInternetSwitchResponse) String() string {
@@ -1375,7 +1366,7 @@ func (*ToggleInternetSwitchResponse) ProtoMessage() {}
 
 func (x *ToggleInternetSwitchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_controller_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1411,627 +1402,1566 @@ func (x *ToggleInternetSwitchResponse) GetStatus() string {
 	return ""
 }
 
-var File_site_controller_proto protoreflect.FileDescriptor
+// PowerPolicy sets the battery thresholds of each load-shedding step. A zero
+// threshold disables that trigger; runtime needs battery_capacity_ah.
+type PowerPolicy struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Enabled               bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	BatteryCapacityAh     float64                `protobuf:"fixed64,2,opt,name=battery_capacity_ah,json=batteryCapacityAh,proto3" json:"battery_capacity_ah,omitempty"`
+	ShedPortsSocPct       int32                  `protobuf:"varint,3,opt,name=shed_ports_soc_pct,json=shedPortsSocPct,proto3" json:"shed_ports_soc_pct,omitempty"`
+	ShedPortsRuntimeMin   int32                  `protobuf:"varint,4,opt,name=shed_ports_runtime_min,json=shedPortsRuntimeMin,proto3" json:"shed_ports_runtime_min,omitempty"`
+	ReduceRadioSocPct     int32                  `protobuf:"varint,5,opt,name=reduce_radio_soc_pct,json=reduceRadioSocPct,proto3" json:"reduce_radio_soc_pct,omitempty"`
+	ReduceRadioRuntimeMin int32                  `protobuf:"varint,6,opt,name=reduce_radio_runtime_min,json=reduceRadioRuntimeMin,proto3" json:"reduce_radio_runtime_min,omitempty"`
+	ReducedRadioPowerPct  int32                  `protobuf:"varint,7,opt,name=reduced_radio_power_pct,json=reducedRadioPowerPct,proto3" json:"reduced_radio_power_pct,omitempty"`
+	RadioOffSocPct        int32                  `protobuf:"varint,8,opt,name=radio_off_soc_pct,json=radioOffSocPct,proto3" json:"radio_off_soc_pct,omitempty"`
+	RadioOffRuntimeMin    int32                  `protobuf:"varint,9,opt,name=radio_off_runtime_min,json=radioOffRuntimeMin,proto3" json:"radio_off_runtime_min,omitempty"`
+	RestoreMarginPct      int32                  `protobuf:"varint,10,opt,name=restore_margin_pct,json=restoreMarginPct,proto3" json:"restore_margin_pct,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PowerPolicy) Reset() {
+	*x = PowerPolicy{}
+	mi := &file_site_controller_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_site_controller_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e,
-	0x6f, 0x64, 0x65, 0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd4, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x74, 0x65,
-	0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x69, 0x74,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65,
-	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x69,
-	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65,
-	0x64, 0x53, 0x69, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x52, 0x61,
-	0x64, 0x69, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x91,
-	0x02, 0x0a, 0x0f, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d,
-	0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64,
-	0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x53, 0x69, 0x74, 0x65, 0x12, 0x27,
-	0x0a, 0x0f, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x69, 0x72,
-	0x65, 0x64, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x12, 0x14, 0x0a, 0x05,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x77,
-	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x72, 0x61, 0x64, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x64,
-	0x69, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x22, 0x8a, 0x02, 0x0a, 0x0c, 0x53, 0x69, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
-	0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x73,
-	0x67, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x07, 0x64, 0x65, 0x72,
-	0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x75, 0x6b, 0x61,
-	0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76,
-	0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x07, 0x64, 0x65, 0x72, 0x69,
-	0x76, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x05,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x75, 0x6b,
-	0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74,
-	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22,
-	0x98, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
-	0x19, 0x0a, 0x08, 0x63, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x53,
-	0x65, 0x74, 0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x07, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
-	0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22,
-	0x57, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x44, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2e, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73,
-	0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x73,
-	0x67, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x4a, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x07, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
-	0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x0f, 0x53, 0x65,
-	0x74, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x07, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
-	0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x52, 0x61, 0x64, 0x69, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53,
-	0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x07, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64,
-	0x22, 0x5f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x75, 0x6b, 0x61,
-	0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x22, 0x95, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74,
-	0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x73, 0x69,
-	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
-	0x02, 0x58, 0x01, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f,
-	0x64, 0x65, 0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x73,
-	0x65, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x34, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x73, 0x69, 0x74, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01,
-	0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
-	0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
-	0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
-	0x72, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x22, 0x3b, 0x0a, 0x18, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x07, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
-	0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x22, 0x35,
-	0x0a, 0x19, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x65, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43,
-	0x79, 0x63, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x07, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x72, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22,
-	0x18, 0x0a, 0x16, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x12, 0x52, 0x65, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x07, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x06, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64,
-	0x22, 0x52, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x69, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x6a, 0x0a, 0x1b, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x06, 0x73, 0x69,
-	0x74, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
-	0x22, 0x7c, 0x0a, 0x1c, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x65, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xd9,
-	0x09, 0x0a, 0x15, 0x53, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x68, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x53,
-	0x69, 0x74, 0x65, 0x12, 0x2d, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
-	0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
-	0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x71, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x30, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x69,
-	0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
-	0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x52, 0x61, 0x64, 0x69,
-	0x6f, 0x12, 0x2e, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73,
-	0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2f, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73,
-	0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x77, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x32, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
-	0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e,
-	0x6f, 0x64, 0x65, 0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x74, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x0d, 0x55,
-	0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x33, 0x2e, 0x75,
-	0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73,
-	0x65, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x34, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73,
-	0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x72, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x30, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f,
-	0x64, 0x65, 0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e,
-	0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x4d,
-	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x11, 0x41,
-	0x70, 0x70, 0x6c, 0x79, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x12, 0x37, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x69,
-	0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x75, 0x6b, 0x61, 0x6d,
-	0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x0e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x79, 0x63, 0x6c,
-	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f,
-	0x64, 0x65, 0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x79, 0x63, 0x6c, 0x65,
-	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x75, 0x6b,
-	0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65,
-	0x72, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x74, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x69, 0x74,
-	0x65, 0x12, 0x31, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73,
-	0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64,
-	0x65, 0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x69, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x14, 0x54, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x12, 0x3a, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73,
-	0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x74,
-	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e,
-	0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x69, 0x74, 0x65, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x75,
-	0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x6e, 0x6f, 0x64,
-	0x65, 0x2f, 0x73, 0x69, 0x74, 0x65, 0x2d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x2f, 0x70, 0x62, 0x2f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (*PowerPolicy) ProtoMessage() {}
+
+func (x *PowerPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var (
-	file_site_controller_proto_rawDescOnce sync.Once
-	file_site_controller_proto_rawDescData = file_site_controller_proto_rawDesc
-)
+// Deprecated: Use PowerPolicy.ProtoReflect.Descriptor instead.
+func (*PowerPolicy) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{24}
+}
 
-func file_site_controller_proto_rawDescGZIP() []byte {
-	file_site_controller_proto_rawDescOnce.Do(func() {
-		file_site_controller_proto_rawDescData = protoimpl.X.CompressGZIP(file_site_controller_proto_rawDescData)
-	})
-	return file_site_controller_proto_rawDescData
+func (x *PowerPolicy) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
 }
 
-var file_site_controller_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
-var file_site_controller_proto_goTypes = []interface{}{
-	(*SiteIntentMsg)(nil),                // 0: ukama.node.site_controller.v1.SiteIntentMsg
-	(*DerivedStateMsg)(nil),              // 1: ukama.node.site_controller.v1.DerivedStateMsg
-	(*SiteSnapshot)(nil),                 // 2: ukama.node.site_controller.v1.SiteSnapshot
-	(*PortMapEntry)(nil),                 // 3: ukama.node.site_controller.v1.PortMapEntry
-	(*SetSiteRequest)(nil),               // 4: ukama.node.site_controller.v1.SetSiteRequest
-	(*SetSiteResponse)(nil),              // 5: ukama.node.site_controller.v1.SetSiteResponse
-	(*SetServiceRequest)(nil),            // 6: ukama.node.site_controller.v1.SetServiceRequest
-	(*SetServiceResponse)(nil),           // 7: ukama.node.site_controller.v1.SetServiceResponse
-	(*SetRadioRequest)(nil),              // 8: ukama.node.site_controller.v1.SetRadioRequest
-	(*SetRadioResponse)(nil),             // 9: ukama.node.site_controller.v1.SetRadioResponse
-	(*GetSiteStateRequest)(nil),          // 10: ukama.node.site_controller.v1.GetSiteStateRequest
-	(*GetSiteStateResponse)(nil),         // 11: ukama.node.site_controller.v1.GetSiteStateResponse
-	(*UpsertPortMapRequest)(nil),         // 12: ukama.node.site_controller.v1.UpsertPortMapRequest
-	(*UpsertPortMapResponse)(nil),        // 13: ukama.node.site_controller.v1.UpsertPortMapResponse
-	(*GetPortMapRequest)(nil),            // 14: ukama.node.site_controller.v1.GetPortMapRequest
-	(*GetPortMapResponse)(nil),           // 15: ukama.node.site_controller.v1.GetPortMapResponse
-	(*ApplySwitchPolicyRequest)(nil),     // 16: ukama.node.site_controller.v1.ApplySwitchPolicyRequest
-	(*ApplySwitchPolicyResponse)(nil),    // 17: ukama.node.site_controller.v1.ApplySwitchPolicyResponse
-	(*PowerCycleNodeRequest)(nil),        // 18: ukama.node.site_controller.v1.PowerCycleNodeRequest
-	(*PowerCycleNodeResponse)(nil),       // 19: ukama.node.site_controller.v1.PowerCycleNodeResponse
-	(*RestartSiteRequest)(nil),           // 20: ukama.node.site_controller.v1.RestartSiteRequest
-	(*RestartSiteResponse)(nil),          // 21: ukama.node.site_controller.v1.RestartSiteResponse
-	(*ToggleInternetSwitchRequest)(nil),  // 22: ukama.node.site_controller.v1.ToggleInternetSwitchRequest
-	(*ToggleInternetSwitchResponse)(nil), // 23: ukama.node.site_controller.v1.ToggleInternetSwitchResponse
+func (x *PowerPolicy) GetBatteryCapacityAh() float64 {
+	if x != nil {
+		return x.BatteryCapacityAh
+	}
+	return 0
 }
-var file_site_controller_proto_depIdxs = []int32{
-	0,  // 0: ukama.node.site_controller.v1.SiteSnapshot.intent:type_name -> ukama.node.site_controller.v1.SiteIntentMsg
-	1,  // 1: ukama.node.site_controller.v1.SiteSnapshot.derived:type_name -> ukama.node.site_controller.v1.DerivedStateMsg
-	3,  // 2: ukama.node.site_controller.v1.SiteSnapshot.ports:type_name -> ukama.node.site_controller.v1.PortMapEntry
-	1,  // 3: ukama.node.site_controller.v1.SetSiteResponse.state:type_name -> ukama.node.site_controller.v1.DerivedStateMsg
-	2,  // 4: ukama.node.site_controller.v1.GetSiteStateResponse.snapshot:type_name -> ukama.node.site_controller.v1.SiteSnapshot
-	3,  // 5: ukama.node.site_controller.v1.UpsertPortMapRequest.ports:type_name -> ukama.node.site_controller.v1.PortMapEntry
-	3,  // 6: ukama.node.site_controller.v1.GetPortMapResponse.ports:type_name -> ukama.node.site_controller.v1.PortMapEntry
-	4,  // 7: ukama.node.site_controller.v1.SiteControllerService.SetSite:input_type -> ukama.node.site_controller.v1.SetSiteRequest
-	6,  // 8: ukama.node.site_controller.v1.SiteControllerService.SetService:input_type -> ukama.node.site_controller.v1.SetServiceRequest
-	8,  // 9: ukama.node.site_controller.v1.SiteControllerService.SetRadio:input_type -> ukama.node.site_controller.v1.SetRadioRequest
-	10, // 10: ukama.node.site_controller.v1.SiteControllerService.GetSiteState:input_type -> ukama.node.site_controller.v1.GetSiteStateRequest
-	12, // 11: ukama.node.site_controller.v1.SiteControllerService.UpsertPortMap:input_type -> ukama.node.site_controller.v1.UpsertPortMapRequest
-	14, // 12: ukama.node.site_controller.v1.SiteControllerService.GetPortMap:input_type -> ukama.node.site_controller.v1.GetPortMapRequest
-	16, // 13: ukama.node.site_controller.v1.SiteControllerService.ApplySwitchPolicy:input_type -> ukama.node.site_controller.v1.ApplySwitchPolicyRequest
-	18, // 14: ukama.node.site_controller.v1.SiteControllerService.PowerCycleNode:input_type -> ukama.node.site_controller.v1.PowerCycleNodeRequest
-	20, // 15: ukama.node.site_controller.v1.SiteControllerService.RestartSite:input_type -> ukama.node.site_controller.v1.RestartSiteRequest
-	22, // 16: ukama.node.site_controller.v1.SiteControllerService.ToggleInternetSwitch:input_type -> ukama.node.site_controller.v1.ToggleInternetSwitchRequest
-	5,  // 17: ukama.node.site_controller.v1.SiteControllerService.SetSite:output_type -> ukama.node.site_controller.v1.SetSiteResponse
-	7,  // 18: ukama.node.site_controller.v1.SiteControllerService.SetService:output_type -> ukama.node.site_controller.v1.SetServiceResponse
-	9,  // 19: ukama.node.site_controller.v1.SiteControllerService.SetRadio:output_type -> ukama.node.site_controller.v1.SetRadioResponse
-	11, // 20: ukama.node.site_controller.v1.SiteControllerService.GetSiteState:output_type -> ukama.node.site_controller.v1.GetSiteStateResponse
-	13, // 21: ukama.node.site_controller.v1.SiteControllerService.UpsertPortMap:output_type -> ukama.node.site_controller.v1.UpsertPortMapResponse
-	15, // 22: ukama.node.site_controller.v1.SiteControllerService.GetPortMap:output_type -> ukama.node.site_controller.v1.GetPortMapResponse
-	17, // 23: ukama.node.site_controller.v1.SiteControllerService.ApplySwitchPolicy:output_type -> ukama.node.site_controller.v1.ApplySwitchPolicyResponse
-	19, // 24: ukama.node.site_controller.v1.SiteControllerService.PowerCycleNode:output_type -> ukama.node.site_controller.v1.PowerCycleNodeResponse
-	21, // 25: ukama.node.site_controller.v1.SiteControllerService.RestartSite:output_type -> ukama.node.site_controller.v1.RestartSiteResponse
-	23, // 26: ukama.node.site_controller.v1.SiteControllerService.ToggleInternetSwitch:output_type -> ukama.node.site_controller.v1.ToggleInternetSwitchResponse
-	17, // [17:27] is the sub-list for method output_type
-	7,  // [7:17] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+
+func (x *PowerPolicy) GetShedPortsSocPct() int32 {
+	if x != nil {
+		return x.ShedPortsSocPct
+	}
+	return 0
 }
 
-func init() { file_site_controller_proto_init() }
-func file_site_controller_proto_init() {
-	if File_site_controller_proto != nil {
-		return
+func (x *PowerPolicy) GetShedPortsRuntimeMin() int32 {
+	if x != nil {
+		return x.ShedPortsRuntimeMin
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_site_controller_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteIntentMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_controller_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DerivedStateMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_controller_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteSnapshot); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_controller_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PortMapEntry); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_controller_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetSiteRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_controller_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetSiteResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_controller_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetServiceRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_controller_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetServiceResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_controller_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetRadioRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_controller_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetRadioResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_controller_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSiteStateRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return 0
+}
+
+func (x *PowerPolicy) GetReduceRadioSocPct() int32 {
+	if x != nil {
+		return x.ReduceRadioSocPct
+	}
+	return 0
+}
+
+func (x *PowerPolicy) GetReduceRadioRuntimeMin() int32 {
+	if x != nil {
+		return x.ReduceRadioRuntimeMin
+	}
+	return 0
+}
+
+func (x *PowerPolicy) GetReducedRadioPowerPct() int32 {
+	if x != nil {
+		return x.ReducedRadioPowerPct
+	}
+	return 0
+}
+
+func (x *PowerPolicy) GetRadioOffSocPct() int32 {
+	if x != nil {
+		return x.RadioOffSocPct
+	}
+	return 0
+}
+
+func (x *PowerPolicy) GetRadioOffRuntimeMin() int32 {
+	if x != nil {
+		return x.RadioOffRuntimeMin
+	}
+	return 0
+}
+
+func (x *PowerPolicy) GetRestoreMarginPct() int32 {
+	if x != nil {
+		return x.RestoreMarginPct
+	}
+	return 0
+}
+
+type SetPowerPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Policy        *PowerPolicy           `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPowerPolicyRequest) Reset() {
+	*x = SetPowerPolicyRequest{}
+	mi := &file_site_controller_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPowerPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPowerPolicyRequest) ProtoMessage() {}
+
+func (x *SetPowerPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_controller_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSiteStateResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPowerPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetPowerPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetPowerPolicyRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SetPowerPolicyRequest) GetPolicy() *PowerPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetPowerPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *PowerPolicy           `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPowerPolicyResponse) Reset() {
+	*x = SetPowerPolicyResponse{}
+	mi := &file_site_controller_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPowerPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPowerPolicyResponse) ProtoMessage() {}
+
+func (x *SetPowerPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_controller_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpsertPortMapRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPowerPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetPowerPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetPowerPolicyResponse) GetPolicy() *PowerPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type GetPowerPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPowerPolicyRequest) Reset() {
+	*x = GetPowerPolicyRequest{}
+	mi := &file_site_controller_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPowerPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPowerPolicyRequest) ProtoMessage() {}
+
+func (x *GetPowerPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_controller_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpsertPortMapResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPowerPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetPowerPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetPowerPolicyRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type GetPowerPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *PowerPolicy           `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	LoadShedLevel string                 `protobuf:"bytes,2,opt,name=load_shed_level,json=loadShedLevel,proto3" json:"load_shed_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPowerPolicyResponse) Reset() {
+	*x = GetPowerPolicyResponse{}
+	mi := &file_site_controller_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPowerPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPowerPolicyResponse) ProtoMessage() {}
+
+func (x *GetPowerPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_controller_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPortMapRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPowerPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetPowerPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetPowerPolicyResponse) GetPolicy() *PowerPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *GetPowerPolicyResponse) GetLoadShedLevel() string {
+	if x != nil {
+		return x.LoadShedLevel
+	}
+	return ""
+}
+
+// Schedule sets target (service or radio) to state during its windows. A
+// window is either every cron run for duration_sec, in timezone, or the single
+// start_at to end_at range. The highest priority window wins on overlap.
+type Schedule struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SiteId      string                 `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Target      string                 `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	State       string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	Cron        string                 `protobuf:"bytes,6,opt,name=cron,proto3" json:"cron,omitempty"`
+	DurationSec int64                  `protobuf:"varint,7,opt,name=duration_sec,json=durationSec,proto3" json:"duration_sec,omitempty"`
+	StartAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Timezone    string                 `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Priority    int32                  `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Start of the window currently applied to the site, unset when idle
+	ActiveSince   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=active_since,json=activeSince,proto3" json:"active_since,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_site_controller_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_controller_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPortMapResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Schedule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Schedule) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *Schedule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Schedule) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Schedule) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Schedule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *Schedule) GetDurationSec() int64 {
+	if x != nil {
+		return x.DurationSec
+	}
+	return 0
+}
+
+func (x *Schedule) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *Schedule) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *Schedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Schedule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Schedule) GetActiveSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveSince
+	}
+	return nil
+}
+
+func (x *Schedule) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type AddScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Schedule      *Schedule              `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddScheduleRequest) Reset() {
+	*x = AddScheduleRequest{}
+	mi := &file_site_controller_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddScheduleRequest) ProtoMessage() {}
+
+func (x *AddScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_controller_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplySwitchPolicyRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddScheduleRequest.ProtoReflect.Descriptor instead.
+func (*AddScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddScheduleRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *AddScheduleRequest) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type AddScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddScheduleResponse) Reset() {
+	*x = AddScheduleResponse{}
+	mi := &file_site_controller_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddScheduleResponse) ProtoMessage() {}
+
+func (x *AddScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_controller_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplySwitchPolicyResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddScheduleResponse.ProtoReflect.Descriptor instead.
+func (*AddScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AddScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type ListSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_site_controller_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_controller_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PowerCycleNodeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListSchedulesRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type ListSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*Schedule            `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesResponse) Reset() {
+	*x = ListSchedulesResponse{}
+	mi := &file_site_controller_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_controller_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PowerCycleNodeResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type DeleteScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	ScheduleId    string                 `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleRequest) Reset() {
+	*x = DeleteScheduleRequest{}
+	mi := &file_site_controller_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_controller_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartSiteRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteScheduleRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *DeleteScheduleRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type DeleteScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleResponse) Reset() {
+	*x = DeleteScheduleResponse{}
+	mi := &file_site_controller_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleResponse) ProtoMessage() {}
+
+func (x *DeleteScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{35}
+}
+
+type ScheduleTransition struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Target     string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	State      string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	At         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=at,proto3" json:"at,omitempty"`
+	// true when the window starts, false when it ends
+	Start bool `protobuf:"varint,6,opt,name=start,proto3" json:"start,omitempty"`
+	// A higher priority schedule holds the target at that time
+	Overridden    bool `protobuf:"varint,7,opt,name=overridden,proto3" json:"overridden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleTransition) Reset() {
+	*x = ScheduleTransition{}
+	mi := &file_site_controller_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTransition) ProtoMessage() {}
+
+func (x *ScheduleTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTransition.ProtoReflect.Descriptor instead.
+func (*ScheduleTransition) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ScheduleTransition) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ScheduleTransition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScheduleTransition) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ScheduleTransition) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ScheduleTransition) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *ScheduleTransition) GetStart() bool {
+	if x != nil {
+		return x.Start
+	}
+	return false
+}
+
+func (x *ScheduleTransition) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+type ListScheduleTransitionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SiteId string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	// Defaults to now and now + 7 days
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         uint32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleTransitionsRequest) Reset() {
+	*x = ListScheduleTransitionsRequest{}
+	mi := &file_site_controller_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleTransitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleTransitionsRequest) ProtoMessage() {}
+
+func (x *ListScheduleTransitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleTransitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduleTransitionsRequest) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListScheduleTransitionsRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ListScheduleTransitionsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListScheduleTransitionsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListScheduleTransitionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListScheduleTransitionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transitions   []*ScheduleTransition  `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleTransitionsResponse) Reset() {
+	*x = ListScheduleTransitionsResponse{}
+	mi := &file_site_controller_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleTransitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleTransitionsResponse) ProtoMessage() {}
+
+func (x *ListScheduleTransitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_controller_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartSiteResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleTransitionsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduleTransitionsResponse) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListScheduleTransitionsResponse) GetTransitions() []*ScheduleTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+// AuditEntry records a site command, the intent it stored or an outcome of
+// reconciling that intent. Entries are never changed once written.
+type AuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SiteId        string                 `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Command       string                 `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
+	Detail        string                 `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,6,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	IntentId      string                 `protobuf:"bytes,8,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	OperationId   string                 `protobuf:"bytes,9,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	FlightStatus  string                 `protobuf:"bytes,10,opt,name=flight_status,json=flightStatus,proto3" json:"flight_status,omitempty"`
+	RetryCount    int32                  `protobuf:"varint,11,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	Error         string                 `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_site_controller_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_controller_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleInternetSwitchRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AuditEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetFlightStatus() string {
+	if x != nil {
+		return x.FlightStatus
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRetryCount() int32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// All filters are optional, entries come newest first
+type ListAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Command       string                 `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         uint32                 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogRequest) Reset() {
+	*x = ListAuditLogRequest{}
+	mi := &file_site_controller_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogRequest) ProtoMessage() {}
+
+func (x *ListAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_controller_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleInternetSwitchResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListAuditLogRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAuditLogRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAuditLogRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogResponse) Reset() {
+	*x = ListAuditLogResponse{}
+	mi := &file_site_controller_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogResponse) ProtoMessage() {}
+
+func (x *ListAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_controller_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_site_controller_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListAuditLogResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+var File_site_controller_proto protoreflect.FileDescriptor
+
+const file_site_controller_proto_rawDesc = "" +
+	"\n" +
+	"\x15site_controller.proto\x12\x1dukama.node.site_controller.v1\x1a\x0fvalidator.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x02\n" +
+	"\rSiteIntentMsg\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12!\n" +
+	"\fdesired_site\x18\x02 \x01(\tR\vdesiredSite\x12'\n" +
+	"\x0fdesired_service\x18\x03 \x01(\tR\x0edesiredService\x12#\n" +
+	"\rdesired_radio\x18\x04 \x01(\tR\fdesiredRadio\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12!\n" +
+	"\frequested_by\x18\x06 \x01(\tR\vrequestedBy\x12&\n" +
+	"\x0fload_shed_level\x18\a \x01(\tR\rloadShedLevel\x12&\n" +
+	"\x0fradio_power_pct\x18\b \x01(\x05R\rradioPowerPct\x12\x1d\n" +
+	"\n" +
+	"shed_ports\x18\t \x03(\x05R\tshedPorts\"\x91\x02\n" +
+	"\x0fDerivedStateMsg\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12!\n" +
+	"\fdesired_site\x18\x02 \x01(\tR\vdesiredSite\x12'\n" +
+	"\x0fdesired_service\x18\x03 \x01(\tR\x0edesiredService\x12#\n" +
+	"\rdesired_radio\x18\x04 \x01(\tR\fdesiredRadio\x12\x14\n" +
+	"\x05power\x18\x05 \x01(\tR\x05power\x12\x18\n" +
+	"\aservice\x18\x06 \x01(\tR\aservice\x12\x14\n" +
+	"\x05radio\x18\a \x01(\tR\x05radio\x12\x16\n" +
+	"\x06access\x18\b \x01(\tR\x06access\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\"\x8a\x02\n" +
+	"\fSiteSnapshot\x12D\n" +
+	"\x06intent\x18\x01 \x01(\v2,.ukama.node.site_controller.v1.SiteIntentMsgR\x06intent\x12H\n" +
+	"\aderived\x18\x02 \x01(\v2..ukama.node.site_controller.v1.DerivedStateMsgR\aderived\x12'\n" +
+	"\x0fcomponents_json\x18\x03 \x01(\tR\x0ecomponentsJson\x12A\n" +
+	"\x05ports\x18\x04 \x03(\v2+.ukama.node.site_controller.v1.PortMapEntryR\x05ports\"\x98\x01\n" +
+	"\fPortMapEntry\x12\x12\n" +
+	"\x04port\x18\x01 \x01(\x05R\x04port\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12\x14\n" +
+	"\x05class\x18\x04 \x01(\tR\x05class\x12\x16\n" +
+	"\x06policy\x18\x05 \x01(\tR\x06policy\x12\x19\n" +
+	"\bcnode_id\x18\x06 \x01(\tR\acnodeId\"\x82\x01\n" +
+	"\x0eSetSiteRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12!\n" +
+	"\frequested_by\x18\x04 \x01(\tR\vrequestedBy\"W\n" +
+	"\x0fSetSiteResponse\x12D\n" +
+	"\x05state\x18\x01 \x01(\v2..ukama.node.site_controller.v1.DerivedStateMsgR\x05state\"m\n" +
+	"\x11SetServiceRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\tR\vrequestedBy\"\x14\n" +
+	"\x12SetServiceResponse\"k\n" +
+	"\x0fSetRadioRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\tR\vrequestedBy\"\x12\n" +
+	"\x10SetRadioResponse\"6\n" +
+	"\x13GetSiteStateRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\"_\n" +
+	"\x14GetSiteStateResponse\x12G\n" +
+	"\bsnapshot\x18\x01 \x01(\v2+.ukama.node.site_controller.v1.SiteSnapshotR\bsnapshot\"\x95\x01\n" +
+	"\x14UpsertPortMapRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\x12\x19\n" +
+	"\bcnode_id\x18\x02 \x01(\tR\acnodeId\x12A\n" +
+	"\x05ports\x18\x03 \x03(\v2+.ukama.node.site_controller.v1.PortMapEntryR\x05ports\"\x17\n" +
+	"\x15UpsertPortMapResponse\"4\n" +
+	"\x11GetPortMapRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\"W\n" +
+	"\x12GetPortMapResponse\x12A\n" +
+	"\x05ports\x18\x01 \x03(\v2+.ukama.node.site_controller.v1.PortMapEntryR\x05ports\";\n" +
+	"\x18ApplySwitchPolicyRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\"5\n" +
+	"\x19ApplySwitchPolicyResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied\"\x87\x01\n" +
+	"\x15PowerCycleNodeRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12!\n" +
+	"\frequested_by\x18\x04 \x01(\tR\vrequestedBy\"\x18\n" +
+	"\x16PowerCycleNodeResponse\"X\n" +
+	"\x12RestartSiteRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\"R\n" +
+	"\x13RestartSiteResponse\x12#\n" +
+	"\roperation_ids\x18\x01 \x03(\tR\foperationIds\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\x8d\x01\n" +
+	"\x1bToggleInternetSwitchRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\bR\x06status\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x12!\n" +
+	"\frequested_by\x18\x04 \x01(\tR\vrequestedBy\"|\n" +
+	"\x1cToggleInternetSwitchResponse\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\x12!\n" +
+	"\fresource_key\x18\x02 \x01(\tR\vresourceKey\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"\xe6\x03\n" +
+	"\vPowerPolicy\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12.\n" +
+	"\x13battery_capacity_ah\x18\x02 \x01(\x01R\x11batteryCapacityAh\x12+\n" +
+	"\x12shed_ports_soc_pct\x18\x03 \x01(\x05R\x0fshedPortsSocPct\x123\n" +
+	"\x16shed_ports_runtime_min\x18\x04 \x01(\x05R\x13shedPortsRuntimeMin\x12/\n" +
+	"\x14reduce_radio_soc_pct\x18\x05 \x01(\x05R\x11reduceRadioSocPct\x127\n" +
+	"\x18reduce_radio_runtime_min\x18\x06 \x01(\x05R\x15reduceRadioRuntimeMin\x125\n" +
+	"\x17reduced_radio_power_pct\x18\a \x01(\x05R\x14reducedRadioPowerPct\x12)\n" +
+	"\x11radio_off_soc_pct\x18\b \x01(\x05R\x0eradioOffSocPct\x121\n" +
+	"\x15radio_off_runtime_min\x18\t \x01(\x05R\x12radioOffRuntimeMin\x12,\n" +
+	"\x12restore_margin_pct\x18\n" +
+	" \x01(\x05R\x10restoreMarginPct\"\x84\x01\n" +
+	"\x15SetPowerPolicyRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\x12J\n" +
+	"\x06policy\x18\x02 \x01(\v2*.ukama.node.site_controller.v1.PowerPolicyB\x06\xe2\xdf\x1f\x02 \x01R\x06policy\"\\\n" +
+	"\x16SetPowerPolicyResponse\x12B\n" +
+	"\x06policy\x18\x01 \x01(\v2*.ukama.node.site_controller.v1.PowerPolicyR\x06policy\"8\n" +
+	"\x15GetPowerPolicyRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\"\x84\x01\n" +
+	"\x16GetPowerPolicyResponse\x12B\n" +
+	"\x06policy\x18\x01 \x01(\v2*.ukama.node.site_controller.v1.PowerPolicyR\x06policy\x12&\n" +
+	"\x0fload_shed_level\x18\x02 \x01(\tR\rloadShedLevel\"\xac\x03\n" +
+	"\bSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\asite_id\x18\x02 \x01(\tR\x06siteId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06target\x18\x04 \x01(\tR\x06target\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\x12\x12\n" +
+	"\x04cron\x18\x06 \x01(\tR\x04cron\x12!\n" +
+	"\fduration_sec\x18\a \x01(\x03R\vdurationSec\x125\n" +
+	"\bstart_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06end_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\x12\x1a\n" +
+	"\btimezone\x18\n" +
+	" \x01(\tR\btimezone\x12\x1a\n" +
+	"\bpriority\x18\v \x01(\x05R\bpriority\x12=\n" +
+	"\factive_since\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vactiveSince\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\r \x01(\tR\tcreatedBy\"\x82\x01\n" +
+	"\x12AddScheduleRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\x12K\n" +
+	"\bschedule\x18\x02 \x01(\v2'.ukama.node.site_controller.v1.ScheduleB\x06\xe2\xdf\x1f\x02 \x01R\bschedule\"Z\n" +
+	"\x13AddScheduleResponse\x12C\n" +
+	"\bschedule\x18\x01 \x01(\v2'.ukama.node.site_controller.v1.ScheduleR\bschedule\"7\n" +
+	"\x14ListSchedulesRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\"^\n" +
+	"\x15ListSchedulesResponse\x12E\n" +
+	"\tschedules\x18\x01 \x03(\v2'.ukama.node.site_controller.v1.ScheduleR\tschedules\"d\n" +
+	"\x15DeleteScheduleRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\x12*\n" +
+	"\vschedule_id\x18\x02 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\n" +
+	"scheduleId\"\x18\n" +
+	"\x16DeleteScheduleResponse\"\xd9\x01\n" +
+	"\x12ScheduleTransition\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12*\n" +
+	"\x02at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x14\n" +
+	"\x05start\x18\x06 \x01(\bR\x05start\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\a \x01(\bR\n" +
+	"overridden\"\xb3\x01\n" +
+	"\x1eListScheduleTransitionsRequest\x12\x1f\n" +
+	"\asite_id\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06siteId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\rR\x05limit\"v\n" +
+	"\x1fListScheduleTransitionsResponse\x12S\n" +
+	"\vtransitions\x18\x01 \x03(\v21.ukama.node.site_controller.v1.ScheduleTransitionR\vtransitions\"\x92\x03\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\asite_id\x18\x02 \x01(\tR\x06siteId\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12\x18\n" +
+	"\acommand\x18\x04 \x01(\tR\acommand\x12\x16\n" +
+	"\x06detail\x18\x05 \x01(\tR\x06detail\x12!\n" +
+	"\frequested_by\x18\x06 \x01(\tR\vrequestedBy\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x1b\n" +
+	"\tintent_id\x18\b \x01(\tR\bintentId\x12!\n" +
+	"\foperation_id\x18\t \x01(\tR\voperationId\x12#\n" +
+	"\rflight_status\x18\n" +
+	" \x01(\tR\fflightStatus\x12\x1f\n" +
+	"\vretry_count\x18\v \x01(\x05R\n" +
+	"retryCount\x12\x14\n" +
+	"\x05error\x18\f \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf6\x01\n" +
+	"\x13ListAuditLogRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\tR\vrequestedBy\x12\x18\n" +
+	"\acommand\x18\x04 \x01(\tR\acommand\x12.\n" +
+	"\x04from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\a \x01(\rR\x05limit\"[\n" +
+	"\x14ListAuditLogResponse\x12C\n" +
+	"\aentries\x18\x01 \x03(\v2).ukama.node.site_controller.v1.AuditEntryR\aentries2\xdc\x10\n" +
+	"\x15SiteControllerService\x12h\n" +
+	"\aSetSite\x12-.ukama.node.site_controller.v1.SetSiteRequest\x1a..ukama.node.site_controller.v1.SetSiteResponse\x12q\n" +
+	"\n" +
+	"SetService\x120.ukama.node.site_controller.v1.SetServiceRequest\x1a1.ukama.node.site_controller.v1.SetServiceResponse\x12k\n" +
+	"\bSetRadio\x12..ukama.node.site_controller.v1.SetRadioRequest\x1a/.ukama.node.site_controller.v1.SetRadioResponse\x12w\n" +
+	"\fGetSiteState\x122.ukama.node.site_controller.v1.GetSiteStateRequest\x1a3.ukama.node.site_controller.v1.GetSiteStateResponse\x12z\n" +
+	"\rUpsertPortMap\x123.ukama.node.site_controller.v1.UpsertPortMapRequest\x1a4.ukama.node.site_controller.v1.UpsertPortMapResponse\x12q\n" +
+	"\n" +
+	"GetPortMap\x120.ukama.node.site_controller.v1.GetPortMapRequest\x1a1.ukama.node.site_controller.v1.GetPortMapResponse\x12\x86\x01\n" +
+	"\x11ApplySwitchPolicy\x127.ukama.node.site_controller.v1.ApplySwitchPolicyRequest\x1a8.ukama.node.site_controller.v1.ApplySwitchPolicyResponse\x12}\n" +
+	"\x0ePowerCycleNode\x124.ukama.node.site_controller.v1.PowerCycleNodeRequest\x1a5.ukama.node.site_controller.v1.PowerCycleNodeResponse\x12t\n" +
+	"\vRestartSite\x121.ukama.node.site_controller.v1.RestartSiteRequest\x1a2.ukama.node.site_controller.v1.RestartSiteResponse\x12\x8f\x01\n" +
+	"\x14ToggleInternetSwitch\x12:.ukama.node.site_controller.v1.ToggleInternetSwitchRequest\x1a;.ukama.node.site_controller.v1.ToggleInternetSwitchResponse\x12}\n" +
+	"\x0eSetPowerPolicy\x124.ukama.node.site_controller.v1.SetPowerPolicyRequest\x1a5.ukama.node.site_controller.v1.SetPowerPolicyResponse\x12}\n" +
+	"\x0eGetPowerPolicy\x124.ukama.node.site_controller.v1.GetPowerPolicyRequest\x1a5.ukama.node.site_controller.v1.GetPowerPolicyResponse\x12t\n" +
+	"\vAddSchedule\x121.ukama.node.site_controller.v1.AddScheduleRequest\x1a2.ukama.node.site_controller.v1.AddScheduleResponse\x12z\n" +
+	"\rListSchedules\x123.ukama.node.site_controller.v1.ListSchedulesRequest\x1a4.ukama.node.site_controller.v1.ListSchedulesResponse\x12}\n" +
+	"\x0eDeleteSchedule\x124.ukama.node.site_controller.v1.DeleteScheduleRequest\x1a5.ukama.node.site_controller.v1.DeleteScheduleResponse\x12\x98\x01\n" +
+	"\x17ListScheduleTransitions\x12=.ukama.node.site_controller.v1.ListScheduleTransitionsRequest\x1a>.ukama.node.site_controller.v1.ListScheduleTransitionsResponse\x12w\n" +
+	"\fListAuditLog\x122.ukama.node.site_controller.v1.ListAuditLogRequest\x1a3.ukama.node.site_controller.v1.ListAuditLogResponseB<Z:github.com/ukama/ukama/systems/node/site-controller/pb/genb\x06proto3"
+
+var (
+	file_site_controller_proto_rawDescOnce sync.Once
+	file_site_controller_proto_rawDescData []byte
+)
+
+func file_site_controller_proto_rawDescGZIP() []byte {
+	file_site_controller_proto_rawDescOnce.Do(func() {
+		file_site_controller_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_site_controller_proto_rawDesc), len(file_site_controller_proto_rawDesc)))
+	})
+	return file_site_controller_proto_rawDescData
+}
+
+var file_site_controller_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_site_controller_proto_goTypes = []any{
+	(*SiteIntentMsg)(nil),                   // 0: ukama.node.site_controller.v1.SiteIntentMsg
+	(*DerivedStateMsg)(nil),                 // 1: ukama.node.site_controller.v1.DerivedStateMsg
+	(*SiteSnapshot)(nil),                    // 2: ukama.node.site_controller.v1.SiteSnapshot
+	(*PortMapEntry)(nil),                    // 3: ukama.node.site_controller.v1.PortMapEntry
+	(*SetSiteRequest)(nil),                  // 4: ukama.node.site_controller.v1.SetSiteRequest
+	(*SetSiteResponse)(nil),                 // 5: ukama.node.site_controller.v1.SetSiteResponse
+	(*SetServiceRequest)(nil),               // 6: ukama.node.site_controller.v1.SetServiceRequest
+	(*SetServiceResponse)(nil),              // 7: ukama.node.site_controller.v1.SetServiceResponse
+	(*SetRadioRequest)(nil),                 // 8: ukama.node.site_controller.v1.SetRadioRequest
+	(*SetRadioResponse)(nil),                // 9: ukama.node.site_controller.v1.SetRadioResponse
+	(*GetSiteStateRequest)(nil),             // 10: ukama.node.site_controller.v1.GetSiteStateRequest
+	(*GetSiteStateResponse)(nil),            // 11: ukama.node.site_controller.v1.GetSiteStateResponse
+	(*UpsertPortMapRequest)(nil),            // 12: ukama.node.site_controller.v1.UpsertPortMapRequest
+	(*UpsertPortMapResponse)(nil),           // 13: ukama.node.site_controller.v1.UpsertPortMapResponse
+	(*GetPortMapRequest)(nil),               // 14: ukama.node.site_controller.v1.GetPortMapRequest
+	(*GetPortMapResponse)(nil),              // 15: ukama.node.site_controller.v1.GetPortMapResponse
+	(*ApplySwitchPolicyRequest)(nil),        // 16: ukama.node.site_controller.v1.ApplySwitchPolicyRequest
+	(*ApplySwitchPolicyResponse)(nil),       // 17: ukama.node.site_controller.v1.ApplySwitchPolicyResponse
+	(*PowerCycleNodeRequest)(nil),           // 18: ukama.node.site_controller.v1.PowerCycleNodeRequest
+	(*PowerCycleNodeResponse)(nil),          // 19: ukama.node.site_controller.v1.PowerCycleNodeResponse
+	(*RestartSiteRequest)(nil),              // 20: ukama.node.site_controller.v1.RestartSiteRequest
+	(*RestartSiteResponse)(nil),             // 21: ukama.node.site_controller.v1.RestartSiteResponse
+	(*ToggleInternetSwitchRequest)(nil),     // 22: ukama.node.site_controller.v1.ToggleInternetSwitchRequest
+	(*ToggleInternetSwitchResponse)(nil),    // 23: ukama.node.site_controller.v1.ToggleInternetSwitchResponse
+	(*PowerPolicy)(nil),                     // 24: ukama.node.site_controller.v1.PowerPolicy
+	(*SetPowerPolicyRequest)(nil),           // 25: ukama.node.site_controller.v1.SetPowerPolicyRequest
+	(*SetPowerPolicyResponse)(nil),          // 26: ukama.node.site_controller.v1.SetPowerPolicyResponse
+	(*GetPowerPolicyRequest)(nil),           // 27: ukama.node.site_controller.v1.GetPowerPolicyRequest
+	(*GetPowerPolicyResponse)(nil),          // 28: ukama.node.site_controller.v1.GetPowerPolicyResponse
+	(*Schedule)(nil),                        // 29: ukama.node.site_controller.v1.Schedule
+	(*AddScheduleRequest)(nil),              // 30: ukama.node.site_controller.v1.AddScheduleRequest
+	(*AddScheduleResponse)(nil),             // 31: ukama.node.site_controller.v1.AddScheduleResponse
+	(*ListSchedulesRequest)(nil),            // 32: ukama.node.site_controller.v1.ListSchedulesRequest
+	(*ListSchedulesResponse)(nil),           // 33: ukama.node.site_controller.v1.ListSchedulesResponse
+	(*DeleteScheduleRequest)(nil),           // 34: ukama.node.site_controller.v1.DeleteScheduleRequest
+	(*DeleteScheduleResponse)(nil),          // 35: ukama.node.site_controller.v1.DeleteScheduleResponse
+	(*ScheduleTransition)(nil),              // 36: ukama.node.site_controller.v1.ScheduleTransition
+	(*ListScheduleTransitionsRequest)(nil),  // 37: ukama.node.site_controller.v1.ListScheduleTransitionsRequest
+	(*ListScheduleTransitionsResponse)(nil), // 38: ukama.node.site_controller.v1.ListScheduleTransitionsResponse
+	(*AuditEntry)(nil),                      // 39: ukama.node.site_controller.v1.AuditEntry
+	(*ListAuditLogRequest)(nil),             // 40: ukama.node.site_controller.v1.ListAuditLogRequest
+	(*ListAuditLogResponse)(nil),            // 41: ukama.node.site_controller.v1.ListAuditLogResponse
+	(*timestamppb.Timestamp)(nil),           // 42: google.protobuf.Timestamp
+}
+var file_site_controller_proto_depIdxs = []int32{
+	0,  // 0: ukama.node.site_controller.v1.SiteSnapshot.intent:type_name -> ukama.node.site_controller.v1.SiteIntentMsg
+	1,  // 1: ukama.node.site_controller.v1.SiteSnapshot.derived:type_name -> ukama.node.site_controller.v1.DerivedStateMsg
+	3,  // 2: ukama.node.site_controller.v1.SiteSnapshot.ports:type_name -> ukama.node.site_controller.v1.PortMapEntry
+	1,  // 3: ukama.node.site_controller.v1.SetSiteResponse.state:type_name -> ukama.node.site_controller.v1.DerivedStateMsg
+	2,  // 4: ukama.node.site_controller.v1.GetSiteStateResponse.snapshot:type_name -> ukama.node.site_controller.v1.SiteSnapshot
+	3,  // 5: ukama.node.site_controller.v1.UpsertPortMapRequest.ports:type_name -> ukama.node.site_controller.v1.PortMapEntry
+	3,  // 6: ukama.node.site_controller.v1.GetPortMapResponse.ports:type_name -> ukama.node.site_controller.v1.PortMapEntry
+	24, // 7: ukama.node.site_controller.v1.SetPowerPolicyRequest.policy:type_name -> ukama.node.site_controller.v1.PowerPolicy
+	24, // 8: ukama.node.site_controller.v1.SetPowerPolicyResponse.policy:type_name -> ukama.node.site_controller.v1.PowerPolicy
+	24, // 9: ukama.node.site_controller.v1.GetPowerPolicyResponse.policy:type_name -> ukama.node.site_controller.v1.PowerPolicy
+	42, // 10: ukama.node.site_controller.v1.Schedule.start_at:type_name -> google.protobuf.Timestamp
+	42, // 11: ukama.node.site_controller.v1.Schedule.end_at:type_name -> google.protobuf.Timestamp
+	42, // 12: ukama.node.site_controller.v1.Schedule.active_since:type_name -> google.protobuf.Timestamp
+	29, // 13: ukama.node.site_controller.v1.AddScheduleRequest.schedule:type_name -> ukama.node.site_controller.v1.Schedule
+	29, // 14: ukama.node.site_controller.v1.AddScheduleResponse.schedule:type_name -> ukama.node.site_controller.v1.Schedule
+	29, // 15: ukama.node.site_controller.v1.ListSchedulesResponse.schedules:type_name -> ukama.node.site_controller.v1.Schedule
+	42, // 16: ukama.node.site_controller.v1.ScheduleTransition.at:type_name -> google.protobuf.Timestamp
+	42, // 17: ukama.node.site_controller.v1.ListScheduleTransitionsRequest.from:type_name -> google.protobuf.Timestamp
+	42, // 18: ukama.node.site_controller.v1.ListScheduleTransitionsRequest.to:type_name -> google.protobuf.Timestamp
+	36, // 19: ukama.node.site_controller.v1.ListScheduleTransitionsResponse.transitions:type_name -> ukama.node.site_controller.v1.ScheduleTransition
+	42, // 20: ukama.node.site_controller.v1.AuditEntry.created_at:type_name -> google.protobuf.Timestamp
+	42, // 21: ukama.node.site_controller.v1.ListAuditLogRequest.from:type_name -> google.protobuf.Timestamp
+	42, // 22: ukama.node.site_controller.v1.ListAuditLogRequest.to:type_name -> google.protobuf.Timestamp
+	39, // 23: ukama.node.site_controller.v1.ListAuditLogResponse.entries:type_name -> ukama.node.site_controller.v1.AuditEntry
+	4,  // 24: ukama.node.site_controller.v1.SiteControllerService.SetSite:input_type -> ukama.node.site_controller.v1.SetSiteRequest
+	6,  // 25: ukama.node.site_controller.v1.SiteControllerService.SetService:input_type -> ukama.node.site_controller.v1.SetServiceRequest
+	8,  // 26: ukama.node.site_controller.v1.SiteControllerService.SetRadio:input_type -> ukama.node.site_controller.v1.SetRadioRequest
+	10, // 27: ukama.node.site_controller.v1.SiteControllerService.GetSiteState:input_type -> ukama.node.site_controller.v1.GetSiteStateRequest
+	12, // 28: ukama.node.site_controller.v1.SiteControllerService.UpsertPortMap:input_type -> ukama.node.site_controller.v1.UpsertPortMapRequest
+	14, // 29: ukama.node.site_controller.v1.SiteControllerService.GetPortMap:input_type -> ukama.node.site_controller.v1.GetPortMapRequest
+	16, // 30: ukama.node.site_controller.v1.SiteControllerService.ApplySwitchPolicy:input_type -> ukama.node.site_controller.v1.ApplySwitchPolicyRequest
+	18, // 31: ukama.node.site_controller.v1.SiteControllerService.PowerCycleNode:input_type -> ukama.node.site_controller.v1.PowerCycleNodeRequest
+	20, // 32: ukama.node.site_controller.v1.SiteControllerService.RestartSite:input_type -> ukama.node.site_controller.v1.RestartSiteRequest
+	22, // 33: ukama.node.site_controller.v1.SiteControllerService.ToggleInternetSwitch:input_type -> ukama.node.site_controller.v1.ToggleInternetSwitchRequest
+	25, // 34: ukama.node.site_controller.v1.SiteControllerService.SetPowerPolicy:input_type -> ukama.node.site_controller.v1.SetPowerPolicyRequest
+	27, // 35: ukama.node.site_controller.v1.SiteControllerService.GetPowerPolicy:input_type -> ukama.node.site_controller.v1.GetPowerPolicyRequest
+	30, // 36: ukama.node.site_controller.v1.SiteControllerService.AddSchedule:input_type -> ukama.node.site_controller.v1.AddScheduleRequest
+	32, // 37: ukama.node.site_controller.v1.SiteControllerService.ListSchedules:input_type -> ukama.node.site_controller.v1.ListSchedulesRequest
+	34, // 38: ukama.node.site_controller.v1.SiteControllerService.DeleteSchedule:input_type -> ukama.node.site_controller.v1.DeleteScheduleRequest
+	37, // 39: ukama.node.site_controller.v1.SiteControllerService.ListScheduleTransitions:input_type -> ukama.node.site_controller.v1.ListScheduleTransitionsRequest
+	40, // 40: ukama.node.site_controller.v1.SiteControllerService.ListAuditLog:input_type -> ukama.node.site_controller.v1.ListAuditLogRequest
+	5,  // 41: ukama.node.site_controller.v1.SiteControllerService.SetSite:output_type -> ukama.node.site_controller.v1.SetSiteResponse
+	7,  // 42: ukama.node.site_controller.v1.SiteControllerService.SetService:output_type -> ukama.node.site_controller.v1.SetServiceResponse
+	9,  // 43: ukama.node.site_controller.v1.SiteControllerService.SetRadio:output_type -> ukama.node.site_controller.v1.SetRadioResponse
+	11, // 44: ukama.node.site_controller.v1.SiteControllerService.GetSiteState:output_type -> ukama.node.site_controller.v1.GetSiteStateResponse
+	13, // 45: ukama.node.site_controller.v1.SiteControllerService.UpsertPortMap:output_type -> ukama.node.site_controller.v1.UpsertPortMapResponse
+	15, // 46: ukama.node.site_controller.v1.SiteControllerService.GetPortMap:output_type -> ukama.node.site_controller.v1.GetPortMapResponse
+	17, // 47: ukama.node.site_controller.v1.SiteControllerService.ApplySwitchPolicy:output_type -> ukama.node.site_controller.v1.ApplySwitchPolicyResponse
+	19, // 48: ukama.node.site_controller.v1.SiteControllerService.PowerCycleNode:output_type -> ukama.node.site_controller.v1.PowerCycleNodeResponse
+	21, // 49: ukama.node.site_controller.v1.SiteControllerService.RestartSite:output_type -> ukama.node.site_controller.v1.RestartSiteResponse
+	23, // 50: ukama.node.site_controller.v1.SiteControllerService.ToggleInternetSwitch:output_type -> ukama.node.site_controller.v1.ToggleInternetSwitchResponse
+	26, // 51: ukama.node.site_controller.v1.SiteControllerService.SetPowerPolicy:output_type -> ukama.node.site_controller.v1.SetPowerPolicyResponse
+	28, // 52: ukama.node.site_controller.v1.SiteControllerService.GetPowerPolicy:output_type -> ukama.node.site_controller.v1.GetPowerPolicyResponse
+	31, // 53: ukama.node.site_controller.v1.SiteControllerService.AddSchedule:output_type -> ukama.node.site_controller.v1.AddScheduleResponse
+	33, // 54: ukama.node.site_controller.v1.SiteControllerService.ListSchedules:output_type -> ukama.node.site_controller.v1.ListSchedulesResponse
+	35, // 55: ukama.node.site_controller.v1.SiteControllerService.DeleteSchedule:output_type -> ukama.node.site_controller.v1.DeleteScheduleResponse
+	38, // 56: ukama.node.site_controller.v1.SiteControllerService.ListScheduleTransitions:output_type -> ukama.node.site_controller.v1.ListScheduleTransitionsResponse
+	41, // 57: ukama.node.site_controller.v1.SiteControllerService.ListAuditLog:output_type -> ukama.node.site_controller.v1.ListAuditLogResponse
+	41, // [41:58] is the sub-list for method output_type
+	24, // [24:41] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_site_controller_proto_init() }
+func file_site_controller_proto_init() {
+	if File_site_controller_proto != nil {
+		return
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_site_controller_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_site_controller_proto_rawDesc), len(file_site_controller_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2040,7 +2970,6 @@ func file_site_controller_proto_init() {
 		MessageInfos:      file_site_controller_proto_msgTypes,
 	}.Build()
 	File_site_controller_proto = out.File
-	file_site_controller_proto_rawDesc = nil
 	file_site_controller_proto_goTypes = nil
 	file_site_controller_proto_depIdxs = nil
 }
@@ -8,6 +8,8 @@ import (
 	math "math"
 	proto "github.com/golang/protobuf/proto"
 	_ "github.com/mwitkow/go-proto-validators"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	regexp "regexp"
 	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
 )
 
@@ -159,3 +161,181 @@ func (this *ToggleInternetSwitchRequest) Validate() error {
 func (this *ToggleInternetSwitchResponse) Validate() error {
 	return nil
 }
+func (this *PowerPolicy) Validate() error {
+	return nil
+}
+func (this *SetPowerPolicyRequest) Validate() error {
+	if this.SiteId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must not be an empty string`, this.SiteId))
+	}
+	if nil == this.Policy {
+		return github_com_mwitkow_go_proto_validators.FieldError("Policy", fmt.Errorf("message must exist"))
+	}
+	if this.Policy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Policy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Policy", err)
+		}
+	}
+	return nil
+}
+func (this *SetPowerPolicyResponse) Validate() error {
+	if this.Policy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Policy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Policy", err)
+		}
+	}
+	return nil
+}
+func (this *GetPowerPolicyRequest) Validate() error {
+	if this.SiteId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must not be an empty string`, this.SiteId))
+	}
+	return nil
+}
+func (this *GetPowerPolicyResponse) Validate() error {
+	if this.Policy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Policy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Policy", err)
+		}
+	}
+	return nil
+}
+func (this *Schedule) Validate() error {
+	if this.StartAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.StartAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("StartAt", err)
+		}
+	}
+	if this.EndAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.EndAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("EndAt", err)
+		}
+	}
+	if this.ActiveSince != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ActiveSince); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ActiveSince", err)
+		}
+	}
+	return nil
+}
+func (this *AddScheduleRequest) Validate() error {
+	if this.SiteId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must not be an empty string`, this.SiteId))
+	}
+	if nil == this.Schedule {
+		return github_com_mwitkow_go_proto_validators.FieldError("Schedule", fmt.Errorf("message must exist"))
+	}
+	if this.Schedule != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Schedule); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Schedule", err)
+		}
+	}
+	return nil
+}
+func (this *AddScheduleResponse) Validate() error {
+	if this.Schedule != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Schedule); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Schedule", err)
+		}
+	}
+	return nil
+}
+func (this *ListSchedulesRequest) Validate() error {
+	if this.SiteId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must not be an empty string`, this.SiteId))
+	}
+	return nil
+}
+func (this *ListSchedulesResponse) Validate() error {
+	for _, item := range this.Schedules {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Schedules", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_DeleteScheduleRequest_ScheduleId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *DeleteScheduleRequest) Validate() error {
+	if this.SiteId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must not be an empty string`, this.SiteId))
+	}
+	if !_regex_DeleteScheduleRequest_ScheduleId.MatchString(this.ScheduleId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("ScheduleId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.ScheduleId))
+	}
+	if this.ScheduleId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("ScheduleId", fmt.Errorf(`value '%v' must not be an empty string`, this.ScheduleId))
+	}
+	return nil
+}
+func (this *DeleteScheduleResponse) Validate() error {
+	return nil
+}
+func (this *ScheduleTransition) Validate() error {
+	if this.At != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.At); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("At", err)
+		}
+	}
+	return nil
+}
+func (this *ListScheduleTransitionsRequest) Validate() error {
+	if this.SiteId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must not be an empty string`, this.SiteId))
+	}
+	if this.From != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.From); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("From", err)
+		}
+	}
+	if this.To != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.To); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("To", err)
+		}
+	}
+	return nil
+}
+func (this *ListScheduleTransitionsResponse) Validate() error {
+	for _, item := range this.Transitions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Transitions", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *AuditEntry) Validate() error {
+	if this.CreatedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+		}
+	}
+	return nil
+}
+func (this *ListAuditLogRequest) Validate() error {
+	if this.From != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.From); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("From", err)
+		}
+	}
+	if this.To != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.To); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("To", err)
+		}
+	}
+	return nil
+}
+func (this *ListAuditLogResponse) Validate() error {
+	for _, item := range this.Entries {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Entries", err)
+			}
+		}
+	}
+	return nil
+}
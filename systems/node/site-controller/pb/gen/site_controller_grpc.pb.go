@@ -1,6 +1,13 @@
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2026-present, Ukama Inc.
+
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.2.0
+// - protoc-gen-go-grpc v1.5.1
 // - protoc             v6.32.1
 // source: site_controller.proto
 
@@ -15,8 +22,28 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.32.0 or later.
-const _ = grpc.SupportPackageIsVersion7
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SiteControllerService_SetSite_FullMethodName                 = "/ukama.node.site_controller.v1.SiteControllerService/SetSite"
+	SiteControllerService_SetService_FullMethodName              = "/ukama.node.site_controller.v1.SiteControllerService/SetService"
+	SiteControllerService_SetRadio_FullMethodName                = "/ukama.node.site_controller.v1.SiteControllerService/SetRadio"
+	SiteControllerService_GetSiteState_FullMethodName            = "/ukama.node.site_controller.v1.SiteControllerService/GetSiteState"
+	SiteControllerService_UpsertPortMap_FullMethodName           = "/ukama.node.site_controller.v1.SiteControllerService/UpsertPortMap"
+	SiteControllerService_GetPortMap_FullMethodName              = "/ukama.node.site_controller.v1.SiteControllerService/GetPortMap"
+	SiteControllerService_ApplySwitchPolicy_FullMethodName       = "/ukama.node.site_controller.v1.SiteControllerService/ApplySwitchPolicy"
+	SiteControllerService_PowerCycleNode_FullMethodName          = "/ukama.node.site_controller.v1.SiteControllerService/PowerCycleNode"
+	SiteControllerService_RestartSite_FullMethodName             = "/ukama.node.site_controller.v1.SiteControllerService/RestartSite"
+	SiteControllerService_ToggleInternetSwitch_FullMethodName    = "/ukama.node.site_controller.v1.SiteControllerService/ToggleInternetSwitch"
+	SiteControllerService_SetPowerPolicy_FullMethodName          = "/ukama.node.site_controller.v1.SiteControllerService/SetPowerPolicy"
+	SiteControllerService_GetPowerPolicy_FullMethodName          = "/ukama.node.site_controller.v1.SiteControllerService/GetPowerPolicy"
+	SiteControllerService_AddSchedule_FullMethodName             = "/ukama.node.site_controller.v1.SiteControllerService/AddSchedule"
+	SiteControllerService_ListSchedules_FullMethodName           = "/ukama.node.site_controller.v1.SiteControllerService/ListSchedules"
+	SiteControllerService_DeleteSchedule_FullMethodName          = "/ukama.node.site_controller.v1.SiteControllerService/DeleteSchedule"
+	SiteControllerService_ListScheduleTransitions_FullMethodName = "/ukama.node.site_controller.v1.SiteControllerService/ListScheduleTransitions"
+	SiteControllerService_ListAuditLog_FullMethodName            = "/ukama.node.site_controller.v1.SiteControllerService/ListAuditLog"
+)
 
 // SiteControllerServiceClient is the client API for SiteControllerService service.
 //
@@ -32,6 +59,13 @@ type SiteControllerServiceClient interface {
 	PowerCycleNode(ctx context.Context, in *PowerCycleNodeRequest, opts ...grpc.CallOption) (*PowerCycleNodeResponse, error)
 	RestartSite(ctx context.Context, in *RestartSiteRequest, opts ...grpc.CallOption) (*RestartSiteResponse, error)
 	ToggleInternetSwitch(ctx context.Context, in *ToggleInternetSwitchRequest, opts ...grpc.CallOption) (*ToggleInternetSwitchResponse, error)
+	SetPowerPolicy(ctx context.Context, in *SetPowerPolicyRequest, opts ...grpc.CallOption) (*SetPowerPolicyResponse, error)
+	GetPowerPolicy(ctx context.Context, in *GetPowerPolicyRequest, opts ...grpc.CallOption) (*GetPowerPolicyResponse, error)
+	AddSchedule(ctx context.Context, in *AddScheduleRequest, opts ...grpc.CallOption) (*AddScheduleResponse, error)
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error)
+	ListScheduleTransitions(ctx context.Context, in *ListScheduleTransitionsRequest, opts ...grpc.CallOption) (*ListScheduleTransitionsResponse, error)
+	ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error)
 }
 
 type siteControllerServiceClient struct {
@@ -43,8 +77,9 @@ func NewSiteControllerServiceClient(cc grpc.ClientConnInterface) SiteControllerS
 }
 
 func (c *siteControllerServiceClient) SetSite(ctx context.Context, in *SetSiteRequest, opts ...grpc.CallOption) (*SetSiteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SetSiteResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.site_controller.v1.SiteControllerService/SetSite", in, out, opts...)
+	err := c.cc.Invoke(ctx, SiteControllerService_SetSite_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -52,8 +87,9 @@ func (c *siteControllerServiceClient) SetSite(ctx context.Context, in *SetSiteRe
 }
 
 func (c *siteControllerServiceClient) SetService(ctx context.Context, in *SetServiceRequest, opts ...grpc.CallOption) (*SetServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SetServiceResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.site_controller.v1.SiteControllerService/SetService", in, out, opts...)
+	err := c.cc.Invoke(ctx, SiteControllerService_SetService_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -61,8 +97,9 @@ func (c *siteControllerServiceClient) SetService(ctx context.Context, in *SetSer
 }
 
 func (c *siteControllerServiceClient) SetRadio(ctx context.Context, in *SetRadioRequest, opts ...grpc.CallOption) (*SetRadioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SetRadioResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.site_controller.v1.SiteControllerService/SetRadio", in, out, opts...)
+	err := c.cc.Invoke(ctx, SiteControllerService_SetRadio_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -70,8 +107,9 @@ func (c *siteControllerServiceClient) SetRadio(ctx context.Context, in *SetRadio
 }
 
 func (c *siteControllerServiceClient) GetSiteState(ctx context.Context, in *GetSiteStateRequest, opts ...grpc.CallOption) (*GetSiteStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSiteStateResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.site_controller.v1.SiteControllerService/GetSiteState", in, out, opts...)
+	err := c.cc.Invoke(ctx, SiteControllerService_GetSiteState_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -79,8 +117,9 @@ func (c *siteControllerServiceClient) GetSiteState(ctx context.Context, in *GetS
 }
 
 func (c *siteControllerServiceClient) UpsertPortMap(ctx context.Context, in *UpsertPortMapRequest, opts ...grpc.CallOption) (*UpsertPortMapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpsertPortMapResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.site_controller.v1.SiteControllerService/UpsertPortMap", in, out, opts...)
+	err := c.cc.Invoke(ctx, SiteControllerService_UpsertPortMap_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -88,8 +127,9 @@ func (c *siteControllerServiceClient) UpsertPortMap(ctx context.Context, in *Ups
 }
 
 func (c *siteControllerServiceClient) GetPortMap(ctx context.Context, in *GetPortMapRequest, opts ...grpc.CallOption) (*GetPortMapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPortMapResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.site_controller.v1.SiteControllerService/GetPortMap", in, out, opts...)
+	err := c.cc.Invoke(ctx, SiteControllerService_GetPortMap_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -97,8 +137,9 @@ func (c *siteControllerServiceClient) GetPortMap(ctx context.Context, in *GetPor
 }
 
 func (c *siteControllerServiceClient) ApplySwitchPolicy(ctx context.Context, in *ApplySwitchPolicyRequest, opts ...grpc.CallOption) (*ApplySwitchPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApplySwitchPolicyResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.site_controller.v1.SiteControllerService/ApplySwitchPolicy", in, out, opts...)
+	err := c.cc.Invoke(ctx, SiteControllerService_ApplySwitchPolicy_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -106,8 +147,9 @@ func (c *siteControllerServiceClient) ApplySwitchPolicy(ctx context.Context, in
 }
 
 func (c *siteControllerServiceClient) PowerCycleNode(ctx context.Context, in *PowerCycleNodeRequest, opts ...grpc.CallOption) (*PowerCycleNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PowerCycleNodeResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.site_controller.v1.SiteControllerService/PowerCycleNode", in, out, opts...)
+	err := c.cc.Invoke(ctx, SiteControllerService_PowerCycleNode_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -115,8 +157,9 @@ func (c *siteControllerServiceClient) PowerCycleNode(ctx context.Context, in *Po
 }
 
 func (c *siteControllerServiceClient) RestartSite(ctx context.Context, in *RestartSiteRequest, opts ...grpc.CallOption) (*RestartSiteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RestartSiteResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.site_controller.v1.SiteControllerService/RestartSite", in, out, opts...)
+	err := c.cc.Invoke(ctx, SiteControllerService_RestartSite_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -124,8 +167,79 @@ func (c *siteControllerServiceClient) RestartSite(ctx context.Context, in *Resta
 }
 
 func (c *siteControllerServiceClient) ToggleInternetSwitch(ctx context.Context, in *ToggleInternetSwitchRequest, opts ...grpc.CallOption) (*ToggleInternetSwitchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ToggleInternetSwitchResponse)
-	err := c.cc.Invoke(ctx, "/ukama.node.site_controller.v1.SiteControllerService/ToggleInternetSwitch", in, out, opts...)
+	err := c.cc.Invoke(ctx, SiteControllerService_ToggleInternetSwitch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteControllerServiceClient) SetPowerPolicy(ctx context.Context, in *SetPowerPolicyRequest, opts ...grpc.CallOption) (*SetPowerPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPowerPolicyResponse)
+	err := c.cc.Invoke(ctx, SiteControllerService_SetPowerPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteControllerServiceClient) GetPowerPolicy(ctx context.Context, in *GetPowerPolicyRequest, opts ...grpc.CallOption) (*GetPowerPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPowerPolicyResponse)
+	err := c.cc.Invoke(ctx, SiteControllerService_GetPowerPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteControllerServiceClient) AddSchedule(ctx context.Context, in *AddScheduleRequest, opts ...grpc.CallOption) (*AddScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddScheduleResponse)
+	err := c.cc.Invoke(ctx, SiteControllerService_AddSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteControllerServiceClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, SiteControllerService_ListSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteControllerServiceClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteScheduleResponse)
+	err := c.cc.Invoke(ctx, SiteControllerService_DeleteSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteControllerServiceClient) ListScheduleTransitions(ctx context.Context, in *ListScheduleTransitionsRequest, opts ...grpc.CallOption) (*ListScheduleTransitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduleTransitionsResponse)
+	err := c.cc.Invoke(ctx, SiteControllerService_ListScheduleTransitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteControllerServiceClient) ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogResponse)
+	err := c.cc.Invoke(ctx, SiteControllerService_ListAuditLog_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +248,7 @@ func (c *siteControllerServiceClient) ToggleInternetSwitch(ctx context.Context,
 
 // SiteControllerServiceServer is the server API for SiteControllerService service.
 // All implementations must embed UnimplementedSiteControllerServiceServer
-// for forward compatibility
+// for forward compatibility.
 type SiteControllerServiceServer interface {
 	SetSite(context.Context, *SetSiteRequest) (*SetSiteResponse, error)
 	SetService(context.Context, *SetServiceRequest) (*SetServiceResponse, error)
@@ -146,12 +260,22 @@ type SiteControllerServiceServer interface {
 	PowerCycleNode(context.Context, *PowerCycleNodeRequest) (*PowerCycleNodeResponse, error)
 	RestartSite(context.Context, *RestartSiteRequest) (*RestartSiteResponse, error)
 	ToggleInternetSwitch(context.Context, *ToggleInternetSwitchRequest) (*ToggleInternetSwitchResponse, error)
+	SetPowerPolicy(context.Context, *SetPowerPolicyRequest) (*SetPowerPolicyResponse, error)
+	GetPowerPolicy(context.Context, *GetPowerPolicyRequest) (*GetPowerPolicyResponse, error)
+	AddSchedule(context.Context, *AddScheduleRequest) (*AddScheduleResponse, error)
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
+	ListScheduleTransitions(context.Context, *ListScheduleTransitionsRequest) (*ListScheduleTransitionsResponse, error)
+	ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error)
 	mustEmbedUnimplementedSiteControllerServiceServer()
 }
 
-// UnimplementedSiteControllerServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedSiteControllerServiceServer struct {
-}
+// UnimplementedSiteControllerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSiteControllerServiceServer struct{}
 
 func (UnimplementedSiteControllerServiceServer) SetSite(context.Context, *SetSiteRequest) (*SetSiteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSite not implemented")
@@ -183,7 +307,29 @@ func (UnimplementedSiteControllerServiceServer) RestartSite(context.Context, *Re
 func (UnimplementedSiteControllerServiceServer) ToggleInternetSwitch(context.Context, *ToggleInternetSwitchRequest) (*ToggleInternetSwitchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleInternetSwitch not implemented")
 }
+func (UnimplementedSiteControllerServiceServer) SetPowerPolicy(context.Context, *SetPowerPolicyRequest) (*SetPowerPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPowerPolicy not implemented")
+}
+func (UnimplementedSiteControllerServiceServer) GetPowerPolicy(context.Context, *GetPowerPolicyRequest) (*GetPowerPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPowerPolicy not implemented")
+}
+func (UnimplementedSiteControllerServiceServer) AddSchedule(context.Context, *AddScheduleRequest) (*AddScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSchedule not implemented")
+}
+func (UnimplementedSiteControllerServiceServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedSiteControllerServiceServer) DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (UnimplementedSiteControllerServiceServer) ListScheduleTransitions(context.Context, *ListScheduleTransitionsRequest) (*ListScheduleTransitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduleTransitions not implemented")
+}
+func (UnimplementedSiteControllerServiceServer) ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLog not implemented")
+}
 func (UnimplementedSiteControllerServiceServer) mustEmbedUnimplementedSiteControllerServiceServer() {}
+func (UnimplementedSiteControllerServiceServer) testEmbeddedByValue()                               {}
 
 // UnsafeSiteControllerServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to SiteControllerServiceServer will
@@ -193,6 +339,13 @@ type UnsafeSiteControllerServiceServer interface {
 }
 
 func RegisterSiteControllerServiceServer(s grpc.ServiceRegistrar, srv SiteControllerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSiteControllerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
 	s.RegisterService(&SiteControllerService_ServiceDesc, srv)
 }
 
@@ -206,7 +359,7 @@ func _SiteControllerService_SetSite_Handler(srv interface{}, ctx context.Context
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.site_controller.v1.SiteControllerService/SetSite",
+		FullMethod: SiteControllerService_SetSite_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SiteControllerServiceServer).SetSite(ctx, req.(*SetSiteRequest))
@@ -224,7 +377,7 @@ func _SiteControllerService_SetService_Handler(srv interface{}, ctx context.Cont
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.site_controller.v1.SiteControllerService/SetService",
+		FullMethod: SiteControllerService_SetService_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SiteControllerServiceServer).SetService(ctx, req.(*SetServiceRequest))
@@ -242,7 +395,7 @@ func _SiteControllerService_SetRadio_Handler(srv interface{}, ctx context.Contex
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.site_controller.v1.SiteControllerService/SetRadio",
+		FullMethod: SiteControllerService_SetRadio_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SiteControllerServiceServer).SetRadio(ctx, req.(*SetRadioRequest))
@@ -260,7 +413,7 @@ func _SiteControllerService_GetSiteState_Handler(srv interface{}, ctx context.Co
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.site_controller.v1.SiteControllerService/GetSiteState",
+		FullMethod: SiteControllerService_GetSiteState_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SiteControllerServiceServer).GetSiteState(ctx, req.(*GetSiteStateRequest))
@@ -278,7 +431,7 @@ func _SiteControllerService_UpsertPortMap_Handler(srv interface{}, ctx context.C
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.site_controller.v1.SiteControllerService/UpsertPortMap",
+		FullMethod: SiteControllerService_UpsertPortMap_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SiteControllerServiceServer).UpsertPortMap(ctx, req.(*UpsertPortMapRequest))
@@ -296,7 +449,7 @@ func _SiteControllerService_GetPortMap_Handler(srv interface{}, ctx context.Cont
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.site_controller.v1.SiteControllerService/GetPortMap",
+		FullMethod: SiteControllerService_GetPortMap_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SiteControllerServiceServer).GetPortMap(ctx, req.(*GetPortMapRequest))
@@ -314,7 +467,7 @@ func _SiteControllerService_ApplySwitchPolicy_Handler(srv interface{}, ctx conte
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.site_controller.v1.SiteControllerService/ApplySwitchPolicy",
+		FullMethod: SiteControllerService_ApplySwitchPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SiteControllerServiceServer).ApplySwitchPolicy(ctx, req.(*ApplySwitchPolicyRequest))
@@ -332,7 +485,7 @@ func _SiteControllerService_PowerCycleNode_Handler(srv interface{}, ctx context.
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.site_controller.v1.SiteControllerService/PowerCycleNode",
+		FullMethod: SiteControllerService_PowerCycleNode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SiteControllerServiceServer).PowerCycleNode(ctx, req.(*PowerCycleNodeRequest))
@@ -350,7 +503,7 @@ func _SiteControllerService_RestartSite_Handler(srv interface{}, ctx context.Con
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.site_controller.v1.SiteControllerService/RestartSite",
+		FullMethod: SiteControllerService_RestartSite_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SiteControllerServiceServer).RestartSite(ctx, req.(*RestartSiteRequest))
@@ -368,7 +521,7 @@ func _SiteControllerService_ToggleInternetSwitch_Handler(srv interface{}, ctx co
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ukama.node.site_controller.v1.SiteControllerService/ToggleInternetSwitch",
+		FullMethod: SiteControllerService_ToggleInternetSwitch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SiteControllerServiceServer).ToggleInternetSwitch(ctx, req.(*ToggleInternetSwitchRequest))
@@ -376,6 +529,132 @@ func _SiteControllerService_ToggleInternetSwitch_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SiteControllerService_SetPowerPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPowerPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteControllerServiceServer).SetPowerPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteControllerService_SetPowerPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteControllerServiceServer).SetPowerPolicy(ctx, req.(*SetPowerPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteControllerService_GetPowerPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPowerPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteControllerServiceServer).GetPowerPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteControllerService_GetPowerPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteControllerServiceServer).GetPowerPolicy(ctx, req.(*GetPowerPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteControllerService_AddSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteControllerServiceServer).AddSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteControllerService_AddSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteControllerServiceServer).AddSchedule(ctx, req.(*AddScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteControllerService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteControllerServiceServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteControllerService_ListSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteControllerServiceServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteControllerService_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteControllerServiceServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteControllerService_DeleteSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteControllerServiceServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteControllerService_ListScheduleTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduleTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteControllerServiceServer).ListScheduleTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteControllerService_ListScheduleTransitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteControllerServiceServer).ListScheduleTransitions(ctx, req.(*ListScheduleTransitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteControllerService_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteControllerServiceServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteControllerService_ListAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteControllerServiceServer).ListAuditLog(ctx, req.(*ListAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SiteControllerService_ServiceDesc is the grpc.ServiceDesc for SiteControllerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -423,6 +702,34 @@ var SiteControllerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleInternetSwitch",
 			Handler:    _SiteControllerService_ToggleInternetSwitch_Handler,
 		},
+		{
+			MethodName: "SetPowerPolicy",
+			Handler:    _SiteControllerService_SetPowerPolicy_Handler,
+		},
+		{
+			MethodName: "GetPowerPolicy",
+			Handler:    _SiteControllerService_GetPowerPolicy_Handler,
+		},
+		{
+			MethodName: "AddSchedule",
+			Handler:    _SiteControllerService_AddSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _SiteControllerService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _SiteControllerService_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "ListScheduleTransitions",
+			Handler:    _SiteControllerService_ListScheduleTransitions_Handler,
+		},
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _SiteControllerService_ListAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "site_controller.proto",
@@ -14,6 +14,7 @@ import (
 	"fmt"
 
 	crpc "github.com/ukama/ukama/systems/node/controller/pb/gen"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg"
 	"github.com/ukama/ukama/systems/node/site-controller/providers"
 )
 
@@ -30,7 +31,20 @@ func (a *AmplifierAdapter) SetRadio(ctx context.Context, nodeID, state string) e
 	if err != nil {
 		return fmt.Errorf("failed to get client: %w", err)
 	}
-	_, err = client.SendNodeCommand(ctx, &crpc.SendNodeCommandRequest{NodeId: nodeID, Method: "POST", Path: "/device/v1/radio", Body: b})
+	_, err = client.SendNodeCommand(ctx, &crpc.SendNodeCommandRequest{NodeId: nodeID, RequestedBy: pkg.ServiceName, Method: "POST", Path: "/device/v1/radio", Body: b, WaitSeconds: pkg.NodeCommandWaitSecs})
+	if err != nil {
+		return fmt.Errorf("failed to send node command: %w", err)
+	}
+	return nil
+}
+
+func (a *AmplifierAdapter) SetRadioPower(ctx context.Context, nodeID string, powerPct int) error {
+	b, _ := json.Marshal(map[string]int{"powerPct": powerPct})
+	client, err := a.cmd.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to get client: %w", err)
+	}
+	_, err = client.SendNodeCommand(ctx, &crpc.SendNodeCommandRequest{NodeId: nodeID, RequestedBy: pkg.ServiceName, Method: "POST", Path: "/device/v1/radio/power", Body: b, WaitSeconds: pkg.NodeCommandWaitSecs})
 	if err != nil {
 		return fmt.Errorf("failed to send node command: %w", err)
 	}
@@ -14,6 +14,7 @@ import (
 	"fmt"
 
 	crpc "github.com/ukama/ukama/systems/node/controller/pb/gen"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg"
 	"github.com/ukama/ukama/systems/node/site-controller/pkg/policy"
 	"github.com/ukama/ukama/systems/node/site-controller/providers"
 )
@@ -35,7 +36,7 @@ func (a *CNodeAdapter) ApplySwitchPolicy(ctx context.Context, nodeID string, p *
 	if err != nil {
 		return fmt.Errorf("failed to get client: %w", err)
 	}
-	_, err = client.SendNodeCommand(ctx, &crpc.SendNodeCommandRequest{NodeId: nodeID, Method: "PUT", Path: "/v1/ports/policy", Body: b})
+	_, err = client.SendNodeCommand(ctx, &crpc.SendNodeCommandRequest{NodeId: nodeID, RequestedBy: pkg.ServiceName, Method: "PUT", Path: "/v1/ports/policy", Body: b, WaitSeconds: pkg.NodeCommandWaitSecs})
 	if err != nil {
 		return fmt.Errorf("failed to send node command: %w", err)
 	}
@@ -48,7 +49,7 @@ func (a *CNodeAdapter) SetPortPoe(ctx context.Context, nodeID string, port int,
 	if err != nil {
 		return fmt.Errorf("failed to get client: %w", err)
 	}
-	_, err = client.SendNodeCommand(ctx, &crpc.SendNodeCommandRequest{NodeId: nodeID, Method: "POST", Path: fmt.Sprintf("/v1/ports/%d/poe", port), Body: b})
+	_, err = client.SendNodeCommand(ctx, &crpc.SendNodeCommandRequest{NodeId: nodeID, RequestedBy: pkg.ServiceName, Method: "POST", Path: fmt.Sprintf("/v1/ports/%d/poe", port), Body: b, WaitSeconds: pkg.NodeCommandWaitSecs})
 	if err != nil {
 		return fmt.Errorf("failed to send node command: %w", err)
 	}
@@ -61,7 +62,7 @@ func (a *CNodeAdapter) PowerCyclePort(ctx context.Context, nodeID string, port i
 	if err != nil {
 		return fmt.Errorf("failed to get client: %w", err)
 	}
-	_, err = client.SendNodeCommand(ctx, &crpc.SendNodeCommandRequest{NodeId: nodeID, Method: "POST", Path: fmt.Sprintf("/v1/ports/%d/poe/cycle", port), Body: b})
+	_, err = client.SendNodeCommand(ctx, &crpc.SendNodeCommandRequest{NodeId: nodeID, RequestedBy: pkg.ServiceName, Method: "POST", Path: fmt.Sprintf("/v1/ports/%d/poe/cycle", port), Body: b, WaitSeconds: pkg.NodeCommandWaitSecs})
 	if err != nil {
 		return fmt.Errorf("failed to send node command: %w", err)
 	}
@@ -14,6 +14,7 @@ import (
 	"fmt"
 
 	crpc "github.com/ukama/ukama/systems/node/controller/pb/gen"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg"
 	"github.com/ukama/ukama/systems/node/site-controller/providers"
 )
 
@@ -30,7 +31,7 @@ func (a *TowerAdapter) SetService(ctx context.Context, nodeID, state string) err
 	if err != nil {
 		return fmt.Errorf("failed to get client: %w", err)
 	}
-	_, err = client.SendNodeCommand(ctx, &crpc.SendNodeCommandRequest{NodeId: nodeID, Method: "POST", Path: "/device/v1/service", Body: b})
+	_, err = client.SendNodeCommand(ctx, &crpc.SendNodeCommandRequest{NodeId: nodeID, RequestedBy: pkg.ServiceName, Method: "POST", Path: "/device/v1/service", Body: b, WaitSeconds: pkg.NodeCommandWaitSecs})
 	if err != nil {
 		return fmt.Errorf("failed to send node command: %w", err)
 	}
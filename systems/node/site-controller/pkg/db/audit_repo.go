@@ -0,0 +1,79 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ukama/ukama/systems/common/sql"
+)
+
+const defaultAuditLimit = 100
+
+type AuditFilter struct {
+	SiteID      string
+	NodeID      string
+	RequestedBy string
+	Command     string
+	From        time.Time
+	To          time.Time
+	Limit       int
+}
+
+// AuditRepo only appends; entries are never updated or deleted.
+type AuditRepo interface {
+	Add(entry *SiteAuditEntry) error
+	List(filter AuditFilter) ([]SiteAuditEntry, error)
+}
+
+type auditRepo struct{ db sql.Db }
+
+func NewAuditRepo(db sql.Db) AuditRepo { return &auditRepo{db: db} }
+
+func (r *auditRepo) Add(m *SiteAuditEntry) error {
+	if m == nil {
+		return errors.New("audit entry is required")
+	}
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = time.Now().UTC()
+	}
+	return r.db.GetGormDb().Create(m).Error
+}
+
+// List returns the newest entries first.
+func (r *auditRepo) List(f AuditFilter) ([]SiteAuditEntry, error) {
+	tx := r.db.GetGormDb().Model(&SiteAuditEntry{})
+	if f.SiteID != "" {
+		tx = tx.Where("site_id = ?", f.SiteID)
+	}
+	if f.NodeID != "" {
+		tx = tx.Where("node_id = ?", f.NodeID)
+	}
+	if f.RequestedBy != "" {
+		tx = tx.Where("requested_by = ?", f.RequestedBy)
+	}
+	if f.Command != "" {
+		tx = tx.Where("command = ?", f.Command)
+	}
+	if !f.From.IsZero() {
+		tx = tx.Where("created_at >= ?", f.From)
+	}
+	if !f.To.IsZero() {
+		tx = tx.Where("created_at < ?", f.To)
+	}
+	limit := f.Limit
+	if limit <= 0 {
+		limit = defaultAuditLimit
+	}
+
+	var entries []SiteAuditEntry
+	err := tx.Order("created_at desc").Limit(limit).Find(&entries).Error
+	return entries, err
+}
@@ -52,6 +52,10 @@ func (r *intentRepo) Upsert(m *SiteIntent) error {
 		DesiredRadio:   m.DesiredRadio,
 		Reason:         m.Reason,
 		RequestedBy:    m.RequestedBy,
+		LoadShedLevel:  m.LoadShedLevel,
+		RadioPowerPct:  m.RadioPowerPct,
+		ShedPorts:      m.ShedPorts,
+		RadioShed:      m.RadioShed,
 		CreatedAt:      now,
 		UpdatedAt:      now,
 	}
@@ -20,6 +20,9 @@ type Site struct {
 	SiteID    string    `gorm:"primaryKey;column:site_id" json:"site_id"`
 	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
 	UpdatedAt time.Time `gorm:"column:updated_at" json:"updated_at"`
+	// ArchivedAt is set once the site was decommissioned in the registry.
+	// Archived sites are no longer reconciled.
+	ArchivedAt *time.Time `gorm:"column:archived_at;index" json:"archived_at"`
 }
 
 func (Site) TableName() string { return "sites" }
@@ -38,8 +41,13 @@ type SiteIntent struct {
 	DesiredRadio   string    `gorm:"column:desired_radio" json:"desired_radio" default:"off"`
 	Reason         string    `gorm:"column:reason" json:"reason" default:"unknown"`
 	RequestedBy    string    `gorm:"column:requested_by" json:"requested_by"`
-	CreatedAt      time.Time `gorm:"column:created_at" json:"created_at"`
-	UpdatedAt      time.Time `gorm:"column:updated_at" json:"updated_at"`
+	// Load shedding applied on top of the desired service/radio, see policy.ShedLevel*
+	LoadShedLevel string    `gorm:"column:load_shed_level" json:"load_shed_level"`
+	RadioPowerPct int       `gorm:"column:radio_power_pct" json:"radio_power_pct"`
+	ShedPorts     []int     `gorm:"column:shed_ports;type:text;serializer:json" json:"shed_ports"`
+	RadioShed     bool      `gorm:"column:radio_shed" json:"radio_shed"`
+	CreatedAt     time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt     time.Time `gorm:"column:updated_at" json:"updated_at"`
 }
 
 func (SiteIntent) TableName() string { return "site_intents" }
@@ -144,6 +152,98 @@ func (m *SitePortMap) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// SitePowerPolicy holds the load-shedding thresholds of a site. A zero SoC or
+// runtime threshold disables that trigger for the step.
+type SitePowerPolicy struct {
+	ID                    uuid.UUID `gorm:"type:uuid;uniqueIndex;not null;column:id" json:"id"`
+	SiteID                string    `gorm:"primaryKey;column:site_id" json:"site_id"`
+	Enabled               bool      `gorm:"column:enabled" json:"enabled"`
+	BatteryCapacityAh     float64   `gorm:"column:battery_capacity_ah" json:"battery_capacity_ah"`
+	ShedPortsSocPct       int       `gorm:"column:shed_ports_soc_pct" json:"shed_ports_soc_pct"`
+	ShedPortsRuntimeMin   int       `gorm:"column:shed_ports_runtime_min" json:"shed_ports_runtime_min"`
+	ReduceRadioSocPct     int       `gorm:"column:reduce_radio_soc_pct" json:"reduce_radio_soc_pct"`
+	ReduceRadioRuntimeMin int       `gorm:"column:reduce_radio_runtime_min" json:"reduce_radio_runtime_min"`
+	ReducedRadioPowerPct  int       `gorm:"column:reduced_radio_power_pct" json:"reduced_radio_power_pct"`
+	RadioOffSocPct        int       `gorm:"column:radio_off_soc_pct" json:"radio_off_soc_pct"`
+	RadioOffRuntimeMin    int       `gorm:"column:radio_off_runtime_min" json:"radio_off_runtime_min"`
+	RestoreMarginPct      int       `gorm:"column:restore_margin_pct" json:"restore_margin_pct"`
+	UpdatedAt             time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (SitePowerPolicy) TableName() string { return "site_power_policies" }
+
+func (m *SitePowerPolicy) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.NewV4()
+	}
+	return nil
+}
+
+const (
+	ScheduleTargetService = "service"
+	ScheduleTargetRadio   = "radio"
+)
+
+// SiteSchedule asserts State on Target during its windows. A window either
+// repeats, starting at each Cron time and lasting DurationSec, or is a single
+// calendar entry from StartAt to EndAt. Cron is evaluated in Timezone.
+// ActiveSince and PreviousState track the window being applied, so its end
+// can restore the state it replaced.
+type SiteSchedule struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primaryKey;column:id" json:"id"`
+	SiteID        string     `gorm:"column:site_id;not null;index" json:"site_id"`
+	Name          string     `gorm:"column:name" json:"name"`
+	Target        string     `gorm:"column:target;not null" json:"target"`
+	State         string     `gorm:"column:state;not null" json:"state"`
+	Cron          string     `gorm:"column:cron" json:"cron"`
+	DurationSec   int64      `gorm:"column:duration_sec" json:"duration_sec"`
+	StartAt       *time.Time `gorm:"column:start_at" json:"start_at"`
+	EndAt         *time.Time `gorm:"column:end_at" json:"end_at"`
+	Timezone      string     `gorm:"column:timezone" json:"timezone"`
+	Priority      int        `gorm:"column:priority" json:"priority"`
+	ActiveSince   *time.Time `gorm:"column:active_since" json:"active_since"`
+	PreviousState string     `gorm:"column:previous_state" json:"previous_state"`
+	CreatedBy     string     `gorm:"column:created_by" json:"created_by"`
+	CreatedAt     time.Time  `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (SiteSchedule) TableName() string { return "site_schedules" }
+
+func (m *SiteSchedule) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.NewV4()
+	}
+	return nil
+}
+
+// SiteAuditEntry is an append-only record of a site command, an intent it
+// stored or an outcome of reconciling that intent.
+type SiteAuditEntry struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey;column:id" json:"id"`
+	SiteID       string    `gorm:"column:site_id;not null;index" json:"site_id"`
+	NodeID       string    `gorm:"column:node_id;index" json:"node_id"`
+	Command      string    `gorm:"column:command;not null" json:"command"`
+	Detail       string    `gorm:"column:detail" json:"detail"`
+	RequestedBy  string    `gorm:"column:requested_by;index" json:"requested_by"`
+	Reason       string    `gorm:"column:reason" json:"reason"`
+	IntentID     uuid.UUID `gorm:"type:uuid;column:intent_id" json:"intent_id"`
+	OperationID  string    `gorm:"column:operation_id" json:"operation_id"`
+	FlightStatus string    `gorm:"column:flight_status" json:"flight_status"`
+	RetryCount   int       `gorm:"column:retry_count" json:"retry_count"`
+	Error        string    `gorm:"column:error" json:"error"`
+	CreatedAt    time.Time `gorm:"column:created_at;index" json:"created_at"`
+}
+
+func (SiteAuditEntry) TableName() string { return "site_audit_log" }
+
+func (m *SiteAuditEntry) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.NewV4()
+	}
+	return nil
+}
+
 type DBStruct struct {
 	Site SiteRepo
 	SiteIntent IntentRepo
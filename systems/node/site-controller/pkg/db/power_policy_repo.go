@@ -0,0 +1,73 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ukama/ukama/systems/common/sql"
+	uuid "github.com/ukama/ukama/systems/common/uuid"
+	"gorm.io/gorm"
+)
+
+type PowerPolicyRepo interface {
+	Get(siteID string) (*SitePowerPolicy, error)
+	Upsert(policy *SitePowerPolicy) error
+}
+
+type powerPolicyRepo struct{ db sql.Db }
+
+func NewPowerPolicyRepo(db sql.Db) PowerPolicyRepo { return &powerPolicyRepo{db: db} }
+
+func (r *powerPolicyRepo) Get(siteID string) (*SitePowerPolicy, error) {
+	var m SitePowerPolicy
+	err := r.db.GetGormDb().First(&m, "site_id = ?", siteID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *powerPolicyRepo) Upsert(m *SitePowerPolicy) error {
+	if m == nil {
+		return errors.New("power policy is required")
+	}
+	if m.SiteID == "" {
+		return errors.New("site_id is required")
+	}
+
+	gdb := r.db.GetGormDb()
+	if err := ensureSite(gdb, m.SiteID); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+
+	var existing SitePowerPolicy
+	err := gdb.First(&existing, "site_id = ?", m.SiteID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		row := *m
+		if row.ID == uuid.Nil {
+			row.ID = uuid.NewV4()
+		}
+		row.UpdatedAt = now
+		return gdb.Create(&row).Error
+	}
+	if err != nil {
+		return err
+	}
+
+	row := *m
+	row.ID = existing.ID
+	row.UpdatedAt = now
+	return gdb.Save(&row).Error
+}
@@ -0,0 +1,71 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ukama/ukama/systems/common/sql"
+	uuid "github.com/ukama/ukama/systems/common/uuid"
+	"gorm.io/gorm"
+)
+
+type ScheduleRepo interface {
+	Add(schedule *SiteSchedule) error
+	Get(id uuid.UUID) (*SiteSchedule, error)
+	ListBySite(siteID string) ([]SiteSchedule, error)
+	Update(schedule *SiteSchedule) error
+	Delete(id uuid.UUID) error
+}
+
+type scheduleRepo struct{ db sql.Db }
+
+func NewScheduleRepo(db sql.Db) ScheduleRepo { return &scheduleRepo{db: db} }
+
+func (r *scheduleRepo) Add(m *SiteSchedule) error {
+	if m == nil {
+		return errors.New("schedule is required")
+	}
+	gdb := r.db.GetGormDb()
+	if err := ensureSite(gdb, m.SiteID); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	m.CreatedAt = now
+	m.UpdatedAt = now
+	return gdb.Create(m).Error
+}
+
+func (r *scheduleRepo) Get(id uuid.UUID) (*SiteSchedule, error) {
+	var m SiteSchedule
+	err := r.db.GetGormDb().First(&m, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *scheduleRepo) ListBySite(siteID string) ([]SiteSchedule, error) {
+	var schedules []SiteSchedule
+	err := r.db.GetGormDb().Where("site_id = ?", siteID).Order("priority desc, created_at asc").Find(&schedules).Error
+	return schedules, err
+}
+
+func (r *scheduleRepo) Update(m *SiteSchedule) error {
+	m.UpdatedAt = time.Now().UTC()
+	return r.db.GetGormDb().Save(m).Error
+}
+
+func (r *scheduleRepo) Delete(id uuid.UUID) error {
+	return r.db.GetGormDb().Delete(&SiteSchedule{}, "id = ?", id).Error
+}
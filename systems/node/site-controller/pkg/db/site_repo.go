@@ -19,7 +19,11 @@ import (
 type SiteRepo interface {
 	Get(siteID string) (*Site, error)
 	Ensure(siteID string) error
+	// List returns the sites still reconciled, archived ones are left out
 	List() ([]Site, error)
+	// Archive stops reconciling a site. Its intents, states and audit log are
+	// kept; pending flights expire and its schedules and components are dropped.
+	Archive(siteID string) error
 }
 
 type siteRepo struct{ db sql.Db }
@@ -44,10 +48,37 @@ func (r *siteRepo) Ensure(siteID string) error {
 
 func (r *siteRepo) List() ([]Site, error) {
 	var sites []Site
-	err := r.db.GetGormDb().Find(&sites).Error
+	err := r.db.GetGormDb().Where("archived_at IS NULL").Find(&sites).Error
 	return sites, err
 }
 
+func (r *siteRepo) Archive(siteID string) error {
+	return r.db.GetGormDb().Transaction(func(tx *gorm.DB) error {
+		if err := ensureSite(tx, siteID); err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		err := tx.Model(&Site{}).Where("site_id = ?", siteID).
+			Updates(map[string]any{"archived_at": now, "updated_at": now}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&SiteIntentFlight{}).
+			Where("status = ? AND site_intent_id IN (?)", IntentFlightStatusPending,
+				tx.Model(&SiteIntent{}).Select("id").Where("site_id = ?", siteID)).
+			Updates(map[string]any{"status": IntentFlightStatusExpired, "updated_at": now}).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Where("site_id = ?", siteID).Delete(&SiteSchedule{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("site_id = ?", siteID).Delete(&SiteComponent{}).Error
+	})
+}
+
 // ensureSite ensures a registry row exists for siteID before inserting child rows.
 func ensureSite(tx *gorm.DB, siteID string) error {
 	if siteID == "" {
@@ -13,3 +13,6 @@ const ServiceName = "site_controller"
 
 var InstanceId = ""
 var IsDebugMode = false
+
+/* Site actions queue behind a busy node for this long instead of failing right away */
+const NodeCommandWaitSecs = 60
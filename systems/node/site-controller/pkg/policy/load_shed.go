@@ -0,0 +1,169 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package policy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+)
+
+// Load-shedding levels, from none to the deepest. Each level includes the
+// actions of the ones before it.
+const (
+	ShedLevelNone         = "none"
+	ShedLevelPorts        = "shed_ports"
+	ShedLevelRadioReduced = "radio_reduced"
+	ShedLevelRadioOff     = "radio_off"
+)
+
+const (
+	FullRadioPowerPct           = 100
+	defaultReducedRadioPowerPct = 50
+	defaultRestoreMarginPct     = 5
+)
+
+var shedLevels = []string{ShedLevelNone, ShedLevelPorts, ShedLevelRadioReduced, ShedLevelRadioOff}
+
+// PowerSample is the battery reading of a site's charge controller. Battery
+// current is positive while charging and negative while discharging.
+type PowerSample struct {
+	SocPct          int
+	BatteryCurrentA float64
+}
+
+// ShedRank orders levels, unknown and empty levels rank as none.
+func ShedRank(level string) int {
+	for i, l := range shedLevels {
+		if l == level {
+			return i
+		}
+	}
+	return 0
+}
+
+func ShedLevelAt(rank int) string {
+	if rank < 0 || rank >= len(shedLevels) {
+		return ShedLevelNone
+	}
+	return shedLevels[rank]
+}
+
+func ValidatePowerPolicy(p *db.SitePowerPolicy) error {
+	for _, v := range []int{p.ShedPortsSocPct, p.ReduceRadioSocPct, p.RadioOffSocPct, p.RestoreMarginPct} {
+		if v < 0 || v > 100 {
+			return fmt.Errorf("invalid percentage %d in power policy", v)
+		}
+	}
+	if p.ReducedRadioPowerPct < 0 || p.ReducedRadioPowerPct >= FullRadioPowerPct {
+		return fmt.Errorf("invalid reduced radio power %d%%", p.ReducedRadioPowerPct)
+	}
+	if p.ShedPortsRuntimeMin < 0 || p.ReduceRadioRuntimeMin < 0 || p.RadioOffRuntimeMin < 0 || p.BatteryCapacityAh < 0 {
+		return fmt.Errorf("invalid negative value in power policy")
+	}
+	if !ordered(p.ShedPortsSocPct, p.ReduceRadioSocPct, p.RadioOffSocPct) {
+		return fmt.Errorf("invalid power policy: soc thresholds must decrease from shed_ports to radio_off")
+	}
+	if !ordered(p.ShedPortsRuntimeMin, p.ReduceRadioRuntimeMin, p.RadioOffRuntimeMin) {
+		return fmt.Errorf("invalid power policy: runtime thresholds must decrease from shed_ports to radio_off")
+	}
+	return nil
+}
+
+// ordered checks the set thresholds decrease with the depth of the level.
+func ordered(thresholds ...int) bool {
+	last := -1
+	for _, t := range thresholds {
+		if t == 0 {
+			continue
+		}
+		if last != -1 && t > last {
+			return false
+		}
+		last = t
+	}
+	return true
+}
+
+func ReducedRadioPowerPct(p *db.SitePowerPolicy) int {
+	if p.ReducedRadioPowerPct == 0 {
+		return defaultReducedRadioPowerPct
+	}
+	return p.ReducedRadioPowerPct
+}
+
+// ProjectedRuntime is how long the battery lasts at the current discharge rate.
+// Unknown when the capacity is not configured or the battery is not discharging.
+func ProjectedRuntime(p *db.SitePowerPolicy, s PowerSample) (time.Duration, bool) {
+	if p.BatteryCapacityAh <= 0 || s.BatteryCurrentA >= 0 {
+		return 0, false
+	}
+	hours := float64(s.SocPct) / 100 * p.BatteryCapacityAh / -s.BatteryCurrentA
+	return time.Duration(hours * float64(time.Hour)), true
+}
+
+// NextShedLevel returns the level the site should be at after sample. Shedding
+// jumps to the deepest level whose threshold is crossed, restoring goes back one
+// level at a time and only once SoC is RestoreMarginPct above the threshold of
+// the current level, so a site does not flap around a threshold.
+func NextShedLevel(p *db.SitePowerPolicy, current string, s PowerSample) string {
+	rank := ShedRank(current)
+	if p == nil || !p.Enabled {
+		if rank == 0 {
+			return ShedLevelNone
+		}
+		return ShedLevelAt(rank - 1)
+	}
+
+	runtime, known := ProjectedRuntime(p, s)
+	target := 0
+	for r := len(shedLevels) - 1; r > 0; r-- {
+		soc, minutes := thresholds(p, r)
+		if (soc > 0 && s.SocPct < soc) || (minutes > 0 && known && runtime < time.Duration(minutes)*time.Minute) {
+			target = r
+			break
+		}
+	}
+
+	if target >= rank {
+		return ShedLevelAt(target)
+	}
+
+	margin := p.RestoreMarginPct
+	if margin == 0 {
+		margin = defaultRestoreMarginPct
+	}
+	soc, minutes := thresholds(p, rank)
+	if soc > 0 && s.SocPct < soc+margin {
+		return current
+	}
+	if minutes > 0 && known && runtime < time.Duration(minutes)*time.Minute {
+		return current
+	}
+	return ShedLevelAt(rank - 1)
+}
+
+func thresholds(p *db.SitePowerPolicy, rank int) (int, int) {
+	switch ShedLevelAt(rank) {
+	case ShedLevelPorts:
+		return p.ShedPortsSocPct, p.ShedPortsRuntimeMin
+	case ShedLevelRadioReduced:
+		return p.ReduceRadioSocPct, p.ReduceRadioRuntimeMin
+	case ShedLevelRadioOff:
+		return p.RadioOffSocPct, p.RadioOffRuntimeMin
+	}
+	return 0, 0
+}
+
+// Sheddable tells if a port can be turned off to save power. Only free ports
+// of non-critical roles are ever shed.
+func Sheddable(p db.SitePortMap) bool {
+	return p.Policy == PolicyFree && !criticalRole(p.Role)
+}
@@ -0,0 +1,81 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+)
+
+var testPowerPolicy = &db.SitePowerPolicy{
+	Enabled:            true,
+	BatteryCapacityAh:  200,
+	ShedPortsSocPct:    50,
+	ReduceRadioSocPct:  35,
+	RadioOffSocPct:     20,
+	RadioOffRuntimeMin: 120,
+	RestoreMarginPct:   10,
+}
+
+func TestNextShedLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		current string
+		sample  PowerSample
+		want    string
+	}{
+		{"Healthy", ShedLevelNone, PowerSample{SocPct: 80}, ShedLevelNone},
+		{"BelowPorts", ShedLevelNone, PowerSample{SocPct: 45}, ShedLevelPorts},
+		{"JumpsToDeepest", ShedLevelNone, PowerSample{SocPct: 15}, ShedLevelRadioOff},
+		// 60% of 200Ah at 120A lasts one hour, below the two hour radio off threshold
+		{"LowRuntime", ShedLevelPorts, PowerSample{SocPct: 60, BatteryCurrentA: -120}, ShedLevelRadioOff},
+		{"HoldsWithinMargin", ShedLevelRadioOff, PowerSample{SocPct: 25}, ShedLevelRadioOff},
+		{"RestoresOneStep", ShedLevelRadioOff, PowerSample{SocPct: 90}, ShedLevelRadioReduced},
+		{"RestoresPastMargin", ShedLevelPorts, PowerSample{SocPct: 60}, ShedLevelNone},
+		{"ChargingIgnoresRuntime", ShedLevelNone, PowerSample{SocPct: 60, BatteryCurrentA: 30}, ShedLevelNone},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, NextShedLevel(testPowerPolicy, tc.current, tc.sample))
+		})
+	}
+
+	t.Run("DisabledRestores", func(t *testing.T) {
+		p := *testPowerPolicy
+		p.Enabled = false
+		assert.Equal(t, ShedLevelPorts, NextShedLevel(&p, ShedLevelRadioReduced, PowerSample{SocPct: 5}))
+		assert.Equal(t, ShedLevelNone, NextShedLevel(nil, ShedLevelNone, PowerSample{SocPct: 5}))
+	})
+}
+
+func TestProjectedRuntime(t *testing.T) {
+	d, ok := ProjectedRuntime(testPowerPolicy, PowerSample{SocPct: 50, BatteryCurrentA: -25})
+	assert.True(t, ok)
+	assert.Equal(t, 4*time.Hour, d)
+
+	_, ok = ProjectedRuntime(&db.SitePowerPolicy{}, PowerSample{SocPct: 50, BatteryCurrentA: -25})
+	assert.False(t, ok)
+}
+
+func TestValidatePowerPolicy(t *testing.T) {
+	assert.NoError(t, ValidatePowerPolicy(testPowerPolicy))
+	assert.Error(t, ValidatePowerPolicy(&db.SitePowerPolicy{ShedPortsSocPct: 20, RadioOffSocPct: 40}))
+	assert.Error(t, ValidatePowerPolicy(&db.SitePowerPolicy{ReducedRadioPowerPct: 100}))
+	assert.Error(t, ValidatePowerPolicy(&db.SitePowerPolicy{ShedPortsSocPct: 120}))
+}
+
+func TestSheddable(t *testing.T) {
+	assert.True(t, Sheddable(db.SitePortMap{Role: RoleExternal, Policy: PolicyFree}))
+	assert.False(t, Sheddable(db.SitePortMap{Role: RoleExternal, Policy: PolicyProtected}))
+	assert.False(t, Sheddable(db.SitePortMap{Role: RoleTower, Policy: PolicyProtected}))
+}
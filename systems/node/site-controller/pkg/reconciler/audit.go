@@ -0,0 +1,99 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+)
+
+// Audit commands
+const (
+	AuditSetSite        = "set_site"
+	AuditSetService     = "set_service"
+	AuditSetRadio       = "set_radio"
+	AuditLoadShed       = "load_shed"
+	AuditSchedule       = "schedule"
+	AuditReconcile      = "reconcile"
+	AuditPowerCycle     = "power_cycle"
+	AuditSwitchPolicy   = "switch_policy"
+	AuditRestartNode    = "restart_node"
+	AuditInternetSwitch = "internet_switch"
+	AuditArchive        = "archive"
+	AuditRelocate       = "relocate"
+)
+
+const maxAuditLimit = 1000
+
+// Audit appends e to the audit log. A failure is only logged, it never fails
+// the command being audited.
+func (r *Reconciler) Audit(e *db.SiteAuditEntry) {
+	if r == nil || r.audit == nil {
+		return
+	}
+	if err := r.audit.Add(e); err != nil {
+		log.Warnf("site-controller: site %s audit %s: %v", e.SiteID, e.Command, err)
+	}
+}
+
+func (r *Reconciler) ListAudit(ctx context.Context, f db.AuditFilter) ([]db.SiteAuditEntry, error) {
+	if f.Limit > maxAuditLimit {
+		return nil, fmt.Errorf("invalid limit %d, at most %d", f.Limit, maxAuditLimit)
+	}
+	if !f.From.IsZero() && !f.To.IsZero() && !f.To.After(f.From) {
+		return nil, fmt.Errorf("invalid range: to must be after from")
+	}
+	return r.audit.List(f)
+}
+
+// upsertIntent stores intent as the new desired state and audits it.
+func (r *Reconciler) upsertIntent(intent *db.SiteIntent, command string) error {
+	if err := r.intents.Upsert(intent); err != nil {
+		return err
+	}
+	r.Audit(&db.SiteAuditEntry{
+		SiteID:       intent.SiteID,
+		Command:      command,
+		Detail:       intentDetail(intent),
+		RequestedBy:  intent.RequestedBy,
+		Reason:       intent.Reason,
+		IntentID:     intent.ID,
+		FlightStatus: db.IntentFlightStatusPending,
+	})
+	return nil
+}
+
+// auditFlight records a reconcile attempt or the final outcome of intent.
+func (r *Reconciler) auditFlight(intent *db.SiteIntent, status string, retries int, err error) {
+	e := &db.SiteAuditEntry{
+		SiteID:       intent.SiteID,
+		Command:      AuditReconcile,
+		Detail:       intentDetail(intent),
+		RequestedBy:  intent.RequestedBy,
+		Reason:       intent.Reason,
+		IntentID:     intent.ID,
+		FlightStatus: status,
+		RetryCount:   retries,
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	r.Audit(e)
+}
+
+func intentDetail(intent *db.SiteIntent) string {
+	d := fmt.Sprintf("service=%s radio=%s", intent.DesiredService, intent.DesiredRadio)
+	if intent.LoadShedLevel != "" {
+		d += " load_shed=" + intent.LoadShedLevel
+	}
+	return d
+}
@@ -45,12 +45,18 @@ func (r *Reconciler) markFlightStatus(intent *db.SiteIntent, status string) erro
 		expiresAt = flight.ExpiresAt
 		retryCount = flight.RetryCount
 	}
-	return r.flights.Upsert(&db.SiteIntentFlight{
+	if err := r.flights.Upsert(&db.SiteIntentFlight{
 		SiteIntentID: intent.ID,
 		Status:       status,
 		RetryCount:   retryCount,
 		ExpiresAt:    expiresAt,
-	})
+	}); err != nil {
+		return err
+	}
+	if flight == nil || flight.Status != status {
+		r.auditFlight(intent, status, retryCount, nil)
+	}
+	return nil
 }
 
 func (r *Reconciler) saveFlight(flight *db.SiteIntentFlight) error {
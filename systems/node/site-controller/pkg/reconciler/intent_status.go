@@ -28,12 +28,14 @@ func (r *Reconciler) recordFailedAttempt(intent *db.SiteIntent, flight *db.SiteI
 		if err := r.saveFlight(flight); err != nil {
 			return err
 		}
+		r.auditFlight(intent, flight.Status, flight.RetryCount, applyErr)
 		return applyErr
 	}
 	flight.Status = db.IntentFlightStatusPending
 	if err := r.saveFlight(flight); err != nil {
 		return err
 	}
+	r.auditFlight(intent, flight.Status, flight.RetryCount, applyErr)
 	if applyErr != nil {
 		return applyErr
 	}
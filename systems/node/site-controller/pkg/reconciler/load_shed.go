@@ -0,0 +1,166 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/policy"
+)
+
+const RequestedByLoadShed = "load-shed"
+
+func (r *Reconciler) GetPowerPolicy(ctx context.Context, siteID string) (*db.SitePowerPolicy, error) {
+	p, err := r.powerPolicies.Get(siteID)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, fmt.Errorf("power policy for site %s not found", siteID)
+	}
+	return p, nil
+}
+
+func (r *Reconciler) SetPowerPolicy(ctx context.Context, p *db.SitePowerPolicy) error {
+	if err := policy.ValidatePowerPolicy(p); err != nil {
+		return err
+	}
+	return r.powerPolicies.Upsert(p)
+}
+
+// ApplyPowerSample moves the site's load-shedding level after a battery reading.
+// Each step taken is applied to the devices and recorded as a new intent, so a
+// failure part way leaves the intent at the last level actually reached.
+func (r *Reconciler) ApplyPowerSample(ctx context.Context, siteID string, sample policy.PowerSample) error {
+	p, err := r.powerPolicies.Get(siteID)
+	if err != nil {
+		return err
+	}
+	intent, err := r.getIntent(siteID)
+	if err != nil {
+		return err
+	}
+	if p == nil && policy.ShedRank(intent.LoadShedLevel) == 0 {
+		return nil
+	}
+
+	current := policy.ShedRank(intent.LoadShedLevel)
+	next := policy.ShedRank(policy.NextShedLevel(p, intent.LoadShedLevel, sample))
+	if next == current {
+		return nil
+	}
+
+	radioChanged := false
+	for current != next {
+		// Shedding enters the next deeper level, restoring leaves the current one
+		step, shed := current+1, true
+		if next < current {
+			step, shed = current, false
+		}
+		if err := r.applyShedStep(ctx, intent, policy.ShedLevelAt(step), shed, p); err != nil {
+			return fmt.Errorf("load shed %s: %w", policy.ShedLevelAt(step), err)
+		}
+		if policy.ShedLevelAt(step) == policy.ShedLevelRadioOff {
+			radioChanged = true
+		}
+		if shed {
+			current++
+		} else {
+			current--
+		}
+
+		level := policy.ShedLevelAt(current)
+		intent.LoadShedLevel = level
+		intent.Reason = fmt.Sprintf("load_shed:%s soc=%d%%", level, sample.SocPct)
+		intent.RequestedBy = RequestedByLoadShed
+		if err := r.upsertIntent(intent, AuditLoadShed); err != nil {
+			return err
+		}
+		log.Infof("site-controller: site %s load shed level %s (soc %d%%)", siteID, level, sample.SocPct)
+	}
+
+	if radioChanged {
+		if err := r.resetIntentReconcile(intent); err != nil {
+			return err
+		}
+		if err := r.ReconcileSite(ctx, siteID, true); err != nil {
+			log.Warnf("site-controller: site %s reconcile after load shed: %v", siteID, err)
+		}
+	}
+	return nil
+}
+
+// applyShedStep enters (shed) or leaves (restore) level on the devices and
+// notes what it did on intent.
+func (r *Reconciler) applyShedStep(ctx context.Context, intent *db.SiteIntent, level string, shed bool, p *db.SitePowerPolicy) error {
+	siteID := intent.SiteID
+
+	switch level {
+	case policy.ShedLevelPorts:
+		ports, err := r.ports.GetBySite(siteID)
+		if err != nil {
+			return err
+		}
+		cnodePort, err := policy.FindRole(ports, policy.RoleCNode)
+		if err != nil {
+			return err
+		}
+		if shed {
+			intent.ShedPorts = nil
+			for _, port := range ports {
+				if !policy.Sheddable(port) {
+					continue
+				}
+				if err := r.cnode.SetPortPoe(ctx, cnodePort.NodeID, port.Port, false, "load_shed"); err != nil {
+					return err
+				}
+				intent.ShedPorts = append(intent.ShedPorts, port.Port)
+			}
+			return nil
+		}
+		for _, port := range intent.ShedPorts {
+			if err := r.cnode.SetPortPoe(ctx, cnodePort.NodeID, port, true, "load_restore"); err != nil {
+				return err
+			}
+		}
+		intent.ShedPorts = nil
+
+	case policy.ShedLevelRadioReduced:
+		nodeID, err := r.resolveSiteNode(siteID, policy.RoleAmplifier)
+		if err != nil {
+			return err
+		}
+		power := policy.FullRadioPowerPct
+		if shed {
+			power = policy.ReducedRadioPowerPct(p)
+		}
+		if err := r.amplifier.SetRadioPower(ctx, nodeID, power); err != nil {
+			return err
+		}
+		intent.RadioPowerPct = power
+
+	case policy.ShedLevelRadioOff:
+		// The radio itself is driven by the reconciler from the desired state
+		if shed {
+			if intent.DesiredRadio == StateOn {
+				intent.DesiredRadio = StateOff
+				intent.RadioShed = true
+			}
+			return nil
+		}
+		if intent.RadioShed {
+			intent.DesiredRadio = StateOn
+			intent.RadioShed = false
+		}
+	}
+	return nil
+}
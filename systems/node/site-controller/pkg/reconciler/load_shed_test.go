@@ -0,0 +1,127 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package reconciler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	contpb "github.com/ukama/ukama/systems/node/controller/pb/gen"
+	contmocks "github.com/ukama/ukama/systems/node/controller/pb/gen/mocks"
+	"github.com/ukama/ukama/systems/node/site-controller/mocks"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/adapters"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/policy"
+)
+
+const (
+	testSiteID  = "33333333-3333-3333-3333-333333333333"
+	testCNodeID = "uk-983794-cnode-78-7830"
+	testAmpID   = "uk-983794-anode-78-7830"
+)
+
+type fakeControllerProvider struct {
+	client contpb.ControllerServiceClient
+}
+
+func (f *fakeControllerProvider) GetClient() (contpb.ControllerServiceClient, error) {
+	return f.client, nil
+}
+
+var testPorts = []db.SitePortMap{
+	{Port: 1, Role: policy.RoleCNode, NodeID: testCNodeID, Class: policy.ClassCritical, Policy: policy.PolicyNeverOffRemote},
+	{Port: 2, Role: policy.RoleAmplifier, NodeID: testAmpID, Class: policy.ClassCritical, Policy: policy.PolicyProtected},
+	{Port: 5, Role: policy.RoleExternal, Class: policy.ClassExternal, Policy: policy.PolicyFree},
+	{Port: 6, Role: policy.RoleSpare, Class: policy.ClassSpare, Policy: policy.PolicyDisabled},
+}
+
+var testPolicy = &db.SitePowerPolicy{SiteID: testSiteID, Enabled: true, ShedPortsSocPct: 50, ReduceRadioSocPct: 30, ReducedRadioPowerPct: 40}
+
+func newTestReconciler(intents *mocks.IntentRepo, ports *mocks.PortMapRepo, policies *mocks.PowerPolicyRepo,
+	client *contmocks.ControllerServiceClient) *Reconciler {
+	provider := &fakeControllerProvider{client: client}
+	return New(intents, nil, nil, ports, nil, policies, nil, nil, provider, adapters.NewTowerAdapter(provider),
+		adapters.NewAmplifierAdapter(provider), adapters.NewCNodeAdapter(provider), 0, 0)
+}
+
+func TestApplyPowerSample_ShedsStepByStep(t *testing.T) {
+	intents := &mocks.IntentRepo{}
+	ports := &mocks.PortMapRepo{}
+	policies := &mocks.PowerPolicyRepo{}
+	client := &contmocks.ControllerServiceClient{}
+	r := newTestReconciler(intents, ports, policies, client)
+
+	policies.On("Get", testSiteID).Return(testPolicy, nil).Once()
+	intents.On("Get", testSiteID).Return(&db.SiteIntent{SiteID: testSiteID, DesiredService: StateOn, DesiredRadio: StateOn}, nil).Once()
+	ports.On("GetBySite", testSiteID).Return(testPorts, nil)
+
+	/* Only the free external port is shed */
+	client.On("SendNodeCommand", mock.Anything, mock.MatchedBy(func(req *contpb.SendNodeCommandRequest) bool {
+		return req.NodeId == testCNodeID && req.Path == "/v1/ports/5/poe" && string(req.Body) == `{"on":false,"reason":"load_shed","source":"site-controller"}`
+	})).Return(&contpb.SendNodeCommandResponse{}, nil).Once()
+	client.On("SendNodeCommand", mock.Anything, mock.MatchedBy(func(req *contpb.SendNodeCommandRequest) bool {
+		return req.NodeId == testAmpID && req.Path == "/device/v1/radio/power" && string(req.Body) == `{"powerPct":40}`
+	})).Return(&contpb.SendNodeCommandResponse{}, nil).Once()
+
+	var recorded []db.SiteIntent
+	intents.On("Upsert", mock.Anything).Run(func(args mock.Arguments) {
+		recorded = append(recorded, *args.Get(0).(*db.SiteIntent))
+	}).Return(nil).Twice()
+
+	err := r.ApplyPowerSample(context.TODO(), testSiteID, policy.PowerSample{SocPct: 25})
+
+	assert.NoError(t, err)
+	if assert.Len(t, recorded, 2) {
+		assert.Equal(t, policy.ShedLevelPorts, recorded[0].LoadShedLevel)
+		assert.Equal(t, []int{5}, recorded[0].ShedPorts)
+		assert.Equal(t, policy.ShedLevelRadioReduced, recorded[1].LoadShedLevel)
+		assert.Equal(t, 40, recorded[1].RadioPowerPct)
+		assert.Equal(t, RequestedByLoadShed, recorded[1].RequestedBy)
+		assert.Equal(t, StateOn, recorded[1].DesiredRadio)
+	}
+	client.AssertExpectations(t)
+}
+
+func TestApplyPowerSample_RestoresShedPorts(t *testing.T) {
+	intents := &mocks.IntentRepo{}
+	ports := &mocks.PortMapRepo{}
+	policies := &mocks.PowerPolicyRepo{}
+	client := &contmocks.ControllerServiceClient{}
+	r := newTestReconciler(intents, ports, policies, client)
+
+	policies.On("Get", testSiteID).Return(testPolicy, nil).Once()
+	intents.On("Get", testSiteID).Return(&db.SiteIntent{SiteID: testSiteID, LoadShedLevel: policy.ShedLevelPorts, ShedPorts: []int{5}}, nil).Once()
+	ports.On("GetBySite", testSiteID).Return(testPorts, nil)
+	client.On("SendNodeCommand", mock.Anything, mock.MatchedBy(func(req *contpb.SendNodeCommandRequest) bool {
+		return req.Path == "/v1/ports/5/poe" && string(req.Body) == `{"on":true,"reason":"load_restore","source":"site-controller"}`
+	})).Return(&contpb.SendNodeCommandResponse{}, nil).Once()
+	intents.On("Upsert", mock.MatchedBy(func(in *db.SiteIntent) bool {
+		return in.LoadShedLevel == policy.ShedLevelNone && len(in.ShedPorts) == 0
+	})).Return(nil).Once()
+
+	err := r.ApplyPowerSample(context.TODO(), testSiteID, policy.PowerSample{SocPct: 70})
+
+	assert.NoError(t, err)
+	intents.AssertExpectations(t)
+	client.AssertExpectations(t)
+}
+
+func TestApplyPowerSample_NoPolicy(t *testing.T) {
+	intents := &mocks.IntentRepo{}
+	policies := &mocks.PowerPolicyRepo{}
+	r := newTestReconciler(intents, nil, policies, nil)
+
+	policies.On("Get", testSiteID).Return(nil, nil).Once()
+	intents.On("Get", testSiteID).Return(&db.SiteIntent{SiteID: testSiteID}, nil).Once()
+
+	assert.NoError(t, r.ApplyPowerSample(context.TODO(), testSiteID, policy.PowerSample{SocPct: 5}))
+	intents.AssertNotCalled(t, "Upsert", mock.Anything)
+}
@@ -92,7 +92,9 @@ func (r *Reconciler) finishReconcileAttempt(siteID string, intent *db.SiteIntent
 	if r.flightRetriesExhausted(flight) {
 		return r.markFlightStatus(intent, db.IntentFlightStatusTimeout)
 	}
-	return fmt.Errorf("site %s intent/state still out of sync (retry %d/%d)", siteID, flight.RetryCount, r.maxRetries)
+	err = fmt.Errorf("site %s intent/state still out of sync (retry %d/%d)", siteID, flight.RetryCount, r.maxRetries)
+	r.auditFlight(intent, flight.Status, flight.RetryCount, err)
+	return err
 }
 
 func (r *Reconciler) applyIntentState(ctx context.Context, intent *db.SiteIntent, state *db.SiteState) error {
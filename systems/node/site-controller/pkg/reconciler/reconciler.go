@@ -16,6 +16,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	contpb "github.com/ukama/ukama/systems/node/controller/pb/gen"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg"
 	"github.com/ukama/ukama/systems/node/site-controller/pkg/adapters"
 	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
 	"github.com/ukama/ukama/systems/node/site-controller/pkg/policy"
@@ -28,6 +29,9 @@ type Reconciler struct {
 	flights            db.IntentFlightRepo
 	ports              db.PortMapRepo
 	components         db.ComponentRepo
+	powerPolicies      db.PowerPolicyRepo
+	schedules          db.ScheduleRepo
+	audit              db.AuditRepo
 	controllerProvider providers.ControllerClientProvider
 	tower              *adapters.TowerAdapter
 	amplifier          *adapters.AmplifierAdapter
@@ -43,6 +47,9 @@ func New(
 	flights db.IntentFlightRepo,
 	ports db.PortMapRepo,
 	components db.ComponentRepo,
+	powerPolicies db.PowerPolicyRepo,
+	schedules db.ScheduleRepo,
+	audit db.AuditRepo,
 	controllerProvider providers.ControllerClientProvider,
 	tower *adapters.TowerAdapter,
 	amp *adapters.AmplifierAdapter,
@@ -62,6 +69,9 @@ func New(
 		flights:            flights,
 		ports:              ports,
 		components:         components,
+		powerPolicies:      powerPolicies,
+		schedules:          schedules,
+		audit:              audit,
 		controllerProvider: controllerProvider,
 		tower:              tower,
 		amplifier:          amp,
@@ -153,7 +163,7 @@ func (r *Reconciler) SetSite(ctx context.Context, siteID, state, reason, request
 		intent.DesiredService = StateOff
 		intent.DesiredRadio = StateOff
 	}
-	if err := r.intents.Upsert(intent); err != nil {
+	if err := r.upsertIntent(intent, AuditSetSite); err != nil {
 		return nil, err
 	}
 	if err := r.resetIntentReconcile(intent); err != nil {
@@ -186,7 +196,7 @@ func (r *Reconciler) SetService(ctx context.Context, siteID, state, reason, requ
 	if requestedBy != "" {
 		intent.RequestedBy = requestedBy
 	}
-	if err := r.intents.Upsert(intent); err != nil {
+	if err := r.upsertIntent(intent, AuditSetService); err != nil {
 		return nil, err
 	}
 	if err := r.resetIntentReconcile(intent); err != nil {
@@ -210,7 +220,7 @@ func (r *Reconciler) SetRadio(ctx context.Context, siteID, state, reason, reques
 	if requestedBy != "" {
 		intent.RequestedBy = requestedBy
 	}
-	if err := r.intents.Upsert(intent); err != nil {
+	if err := r.upsertIntent(intent, AuditSetRadio); err != nil {
 		return nil, err
 	}
 	if err := r.resetIntentReconcile(intent); err != nil {
@@ -224,7 +234,7 @@ func (r *Reconciler) SetRadio(ctx context.Context, siteID, state, reason, reques
 
 // PowerCycleNode looks up the port for role and forwards PoE cycle to the CNode. It does not reject
 // the cnode role; switch.d on the node enforces never_off_remote and related policy.
-func (r *Reconciler) PowerCycleNode(ctx context.Context, siteID, role, reason string) error {
+func (r *Reconciler) PowerCycleNode(ctx context.Context, siteID, role, reason, requestedBy string) error {
 	ports, err := r.ports.GetBySite(siteID)
 	if err != nil {
 		return err
@@ -237,7 +247,16 @@ func (r *Reconciler) PowerCycleNode(ctx context.Context, siteID, role, reason st
 	if err != nil {
 		return err
 	}
-	return r.cnode.PowerCyclePort(ctx, cnodePort.NodeID, target.Port, reason)
+	err = r.cnode.PowerCyclePort(ctx, cnodePort.NodeID, target.Port, reason)
+	e := &db.SiteAuditEntry{
+		SiteID: siteID, NodeID: target.NodeID, Command: AuditPowerCycle, Detail: fmt.Sprintf("role=%s port=%d", role, target.Port),
+		RequestedBy: requestedBy, Reason: reason,
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	r.Audit(e)
+	return err
 }
 func (r *Reconciler) getIntent(siteID string) (*db.SiteIntent, error) {
 	intent, err := r.intents.Get(siteID)
@@ -296,7 +315,7 @@ func (r *Reconciler) applyService(ctx context.Context, siteID, state string) err
 	if err != nil {
 		return fmt.Errorf("get controller client: %w", err)
 	}
-	if _, err := client.ToggleService(ctx, &contpb.ToggleServiceRequest{NodeId: nodeID, State: state}); err != nil {
+	if _, err := client.ToggleService(ctx, &contpb.ToggleServiceRequest{NodeId: nodeID, State: state, RequestedBy: pkg.ServiceName, WaitSeconds: pkg.NodeCommandWaitSecs}); err != nil {
 		return fmt.Errorf("apply service: %w", err)
 	}
 	return nil
@@ -311,7 +330,7 @@ func (r *Reconciler) applyRadio(ctx context.Context, siteID, state string) error
 	if err != nil {
 		return fmt.Errorf("get controller client: %w", err)
 	}
-	if _, err := client.ToggleRadio(ctx, &contpb.ToggleRadioRequest{NodeId: nodeID, State: state}); err != nil {
+	if _, err := client.ToggleRadio(ctx, &contpb.ToggleRadioRequest{NodeId: nodeID, State: state, RequestedBy: pkg.ServiceName, WaitSeconds: pkg.NodeCommandWaitSecs}); err != nil {
 		return fmt.Errorf("apply radio: %w", err)
 	}
 	return nil
@@ -0,0 +1,195 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	uuid "github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/policy"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/schedule"
+)
+
+const requestedBySchedulePrefix = "schedule:"
+
+var scheduleTargets = []string{db.ScheduleTargetService, db.ScheduleTargetRadio}
+
+func (r *Reconciler) AddSchedule(ctx context.Context, s *db.SiteSchedule) error {
+	if err := schedule.Validate(s); err != nil {
+		return err
+	}
+	s.ActiveSince = nil
+	s.PreviousState = ""
+	if err := r.schedules.Add(s); err != nil {
+		return err
+	}
+	if err := r.ApplySchedules(ctx, s.SiteID, time.Now().UTC()); err != nil {
+		log.Warnf("site-controller: site %s apply schedules after add: %v", s.SiteID, err)
+	}
+	return nil
+}
+
+func (r *Reconciler) ListSchedules(ctx context.Context, siteID string) ([]db.SiteSchedule, error) {
+	return r.schedules.ListBySite(siteID)
+}
+
+// DeleteSchedule removes a schedule. A window being applied ends first, so the
+// state it replaced comes back.
+func (r *Reconciler) DeleteSchedule(ctx context.Context, siteID string, id uuid.UUID) error {
+	s, err := r.schedules.Get(id)
+	if err != nil {
+		return err
+	}
+	if s == nil || s.SiteID != siteID {
+		return fmt.Errorf("schedule %s not found for site %s", id, siteID)
+	}
+
+	if s.ActiveSince != nil {
+		intent, err := r.getIntent(siteID)
+		if err != nil {
+			return err
+		}
+		if endSchedule(intent, s) {
+			if err := r.applyScheduledIntent(ctx, intent); err != nil {
+				return err
+			}
+		}
+	}
+	return r.schedules.Delete(id)
+}
+
+func (r *Reconciler) UpcomingTransitions(ctx context.Context, siteID string, from, to time.Time, limit int) ([]schedule.Transition, error) {
+	schedules, err := r.schedules.ListBySite(siteID)
+	if err != nil {
+		return nil, err
+	}
+	return schedule.Upcoming(schedules, from, to, limit), nil
+}
+
+// ApplySchedules moves the site intent at window boundaries. Schedules only act
+// when the winning window of a target changes: a manual intent given during a
+// window holds until the window ends, and a window end restores the state it
+// replaced only if nobody changed that target since.
+func (r *Reconciler) ApplySchedules(ctx context.Context, siteID string, now time.Time) error {
+	schedules, err := r.schedules.ListBySite(siteID)
+	if err != nil || len(schedules) == 0 {
+		return err
+	}
+	intent, err := r.getIntent(siteID)
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	for _, target := range scheduleTargets {
+		var active *db.SiteSchedule
+		for i := range schedules {
+			if schedules[i].Target == target && schedules[i].ActiveSince != nil {
+				active = &schedules[i]
+				break
+			}
+		}
+
+		winner, window, ok := schedule.Winner(schedules, target, now)
+		if active != nil && ok && winner.ID == active.ID && active.ActiveSince.Equal(window.Start) {
+			continue
+		}
+
+		if active != nil {
+			if endSchedule(intent, active) {
+				changed = true
+			}
+			if err := r.schedules.Update(active); err != nil {
+				return err
+			}
+		}
+
+		if ok {
+			start := window.Start
+			winner.ActiveSince = &start
+			winner.PreviousState = desiredState(intent, target)
+			if winner.PreviousState != winner.State {
+				setDesiredState(intent, target, winner.State)
+				intent.Reason = "schedule:" + winner.Name
+				intent.RequestedBy = requestedBySchedulePrefix + winner.ID.String()
+				changed = true
+			}
+			if err := r.schedules.Update(winner); err != nil {
+				return err
+			}
+			log.Infof("site-controller: site %s schedule %s set %s %s until %s", siteID, winner.Name, target, winner.State, window.End)
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+	return r.applyScheduledIntent(ctx, intent)
+}
+
+func (r *Reconciler) applyScheduledIntent(ctx context.Context, intent *db.SiteIntent) error {
+	if err := r.upsertIntent(intent, AuditSchedule); err != nil {
+		return err
+	}
+	if err := r.resetIntentReconcile(intent); err != nil {
+		return err
+	}
+	if err := r.ReconcileSite(ctx, intent.SiteID, true); err != nil {
+		log.Warnf("site-controller: site %s reconcile after schedule: %v", intent.SiteID, err)
+	}
+	return nil
+}
+
+// endSchedule clears the window of s and restores the state it replaced if the
+// target still holds the scheduled state. Returns true if intent changed.
+func endSchedule(intent *db.SiteIntent, s *db.SiteSchedule) bool {
+	restore := s.PreviousState
+	s.ActiveSince = nil
+	s.PreviousState = ""
+
+	if restore == "" || restore == s.State || desiredState(intent, s.Target) != s.State {
+		return false
+	}
+	setDesiredState(intent, s.Target, restore)
+	intent.Reason = "schedule_end:" + s.Name
+	intent.RequestedBy = requestedBySchedulePrefix + s.ID.String()
+	return true
+}
+
+// desiredState is the state asked for target. While load shedding holds the
+// radio off, the radio state asked for is kept in RadioShed and applied when
+// shedding restores the radio.
+func desiredState(intent *db.SiteIntent, target string) string {
+	if target == db.ScheduleTargetService {
+		return intent.DesiredService
+	}
+	if intent.LoadShedLevel == policy.ShedLevelRadioOff {
+		if intent.RadioShed {
+			return StateOn
+		}
+		return StateOff
+	}
+	return intent.DesiredRadio
+}
+
+func setDesiredState(intent *db.SiteIntent, target, state string) {
+	if target == db.ScheduleTargetService {
+		intent.DesiredService = state
+		return
+	}
+	if intent.LoadShedLevel == policy.ShedLevelRadioOff {
+		intent.RadioShed = state == StateOn
+		return
+	}
+	intent.DesiredRadio = state
+}
@@ -0,0 +1,147 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package reconciler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	uuid "github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/node/site-controller/mocks"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/policy"
+)
+
+// 01:00-05:00 UTC every day
+func nightRadioOff() db.SiteSchedule {
+	return db.SiteSchedule{ID: uuid.NewV4(), SiteID: testSiteID, Name: "night", Target: db.ScheduleTargetRadio,
+		State: StateOff, Cron: "0 1 * * *", DurationSec: 4 * 3600}
+}
+
+var inNight = time.Date(2026, 3, 2, 2, 0, 0, 0, time.UTC)
+
+func newScheduleReconciler(intents *mocks.IntentRepo, schedules *mocks.ScheduleRepo) *Reconciler {
+	flights := &mocks.IntentFlightRepo{}
+	flights.On("Upsert", mock.Anything).Return(nil)
+	return New(intents, nil, flights, nil, nil, nil, schedules, nil, nil, nil, nil, nil, 0, 0)
+}
+
+func TestApplySchedules_WindowStart(t *testing.T) {
+	intents := &mocks.IntentRepo{}
+	schedules := &mocks.ScheduleRepo{}
+	r := newScheduleReconciler(intents, schedules)
+	night := nightRadioOff()
+
+	schedules.On("ListBySite", testSiteID).Return([]db.SiteSchedule{night}, nil).Once()
+	intents.On("Get", testSiteID).Return(&db.SiteIntent{SiteID: testSiteID, DesiredService: StateOn, DesiredRadio: StateOn}, nil)
+	schedules.On("Update", mock.MatchedBy(func(s *db.SiteSchedule) bool {
+		return s.ActiveSince != nil && s.ActiveSince.Equal(time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)) && s.PreviousState == StateOn
+	})).Return(nil).Once()
+	intents.On("Upsert", mock.MatchedBy(func(in *db.SiteIntent) bool {
+		return in.DesiredRadio == StateOff && in.DesiredService == StateOn && in.RequestedBy == "schedule:"+night.ID.String()
+	})).Return(nil).Once()
+
+	assert.NoError(t, r.ApplySchedules(context.TODO(), testSiteID, inNight))
+	intents.AssertExpectations(t)
+	schedules.AssertExpectations(t)
+}
+
+func TestApplySchedules_WindowAlreadyApplied(t *testing.T) {
+	intents := &mocks.IntentRepo{}
+	schedules := &mocks.ScheduleRepo{}
+	r := newScheduleReconciler(intents, schedules)
+	night := nightRadioOff()
+	since := time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)
+	night.ActiveSince, night.PreviousState = &since, StateOn
+
+	/* A manual radio on within the window holds */
+	schedules.On("ListBySite", testSiteID).Return([]db.SiteSchedule{night}, nil).Once()
+	intents.On("Get", testSiteID).Return(&db.SiteIntent{SiteID: testSiteID, DesiredRadio: StateOn}, nil).Once()
+
+	assert.NoError(t, r.ApplySchedules(context.TODO(), testSiteID, inNight))
+	intents.AssertNotCalled(t, "Upsert", mock.Anything)
+	schedules.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestApplySchedules_WindowEndRestores(t *testing.T) {
+	intents := &mocks.IntentRepo{}
+	schedules := &mocks.ScheduleRepo{}
+	r := newScheduleReconciler(intents, schedules)
+	night := nightRadioOff()
+	since := time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)
+	night.ActiveSince, night.PreviousState = &since, StateOn
+
+	schedules.On("ListBySite", testSiteID).Return([]db.SiteSchedule{night}, nil).Once()
+	intents.On("Get", testSiteID).Return(&db.SiteIntent{SiteID: testSiteID, DesiredRadio: StateOff}, nil)
+	schedules.On("Update", mock.MatchedBy(func(s *db.SiteSchedule) bool {
+		return s.ActiveSince == nil && s.PreviousState == ""
+	})).Return(nil).Once()
+	intents.On("Upsert", mock.MatchedBy(func(in *db.SiteIntent) bool {
+		return in.DesiredRadio == StateOn && in.Reason == "schedule_end:night"
+	})).Return(nil).Once()
+
+	assert.NoError(t, r.ApplySchedules(context.TODO(), testSiteID, inNight.Add(4*time.Hour)))
+	intents.AssertExpectations(t)
+	schedules.AssertExpectations(t)
+}
+
+func TestApplySchedules_WindowEndKeepsManualChange(t *testing.T) {
+	intents := &mocks.IntentRepo{}
+	schedules := &mocks.ScheduleRepo{}
+	r := newScheduleReconciler(intents, schedules)
+	service := db.SiteSchedule{ID: uuid.NewV4(), SiteID: testSiteID, Name: "market", Target: db.ScheduleTargetService,
+		State: StateOn, Cron: "0 8 * * 3", DurationSec: 10 * 3600}
+	since := time.Date(2026, 3, 4, 8, 0, 0, 0, time.UTC)
+	service.ActiveSince, service.PreviousState = &since, StateOff
+
+	/* Service was turned off by hand during the market day */
+	schedules.On("ListBySite", testSiteID).Return([]db.SiteSchedule{service}, nil).Once()
+	intents.On("Get", testSiteID).Return(&db.SiteIntent{SiteID: testSiteID, DesiredService: StateOff}, nil).Once()
+	schedules.On("Update", mock.Anything).Return(nil).Once()
+
+	assert.NoError(t, r.ApplySchedules(context.TODO(), testSiteID, since.Add(11*time.Hour)))
+	intents.AssertNotCalled(t, "Upsert", mock.Anything)
+	schedules.AssertExpectations(t)
+}
+
+func TestApplySchedules_RadioHeldOffByLoadShed(t *testing.T) {
+	intents := &mocks.IntentRepo{}
+	schedules := &mocks.ScheduleRepo{}
+	r := newScheduleReconciler(intents, schedules)
+
+	schedules.On("ListBySite", testSiteID).Return([]db.SiteSchedule{nightRadioOff()}, nil).Once()
+	intents.On("Get", testSiteID).Return(&db.SiteIntent{SiteID: testSiteID, DesiredRadio: StateOff,
+		LoadShedLevel: policy.ShedLevelRadioOff, RadioShed: true}, nil)
+	schedules.On("Update", mock.MatchedBy(func(s *db.SiteSchedule) bool { return s.PreviousState == StateOn })).Return(nil).Once()
+
+	/* The radio stays off once the battery recovers */
+	intents.On("Upsert", mock.MatchedBy(func(in *db.SiteIntent) bool {
+		return in.DesiredRadio == StateOff && !in.RadioShed
+	})).Return(nil).Once()
+
+	assert.NoError(t, r.ApplySchedules(context.TODO(), testSiteID, inNight))
+	intents.AssertExpectations(t)
+}
+
+func TestDeleteSchedule_OtherSite(t *testing.T) {
+	schedules := &mocks.ScheduleRepo{}
+	r := newScheduleReconciler(&mocks.IntentRepo{}, schedules)
+	night := nightRadioOff()
+	night.SiteID = "another-site"
+
+	schedules.On("Get", night.ID).Return(&night, nil).Once()
+
+	err := r.DeleteSchedule(context.TODO(), testSiteID, night.ID)
+
+	assert.ErrorContains(t, err, "not found")
+	schedules.AssertNotCalled(t, "Delete", mock.Anything)
+}
@@ -58,6 +58,9 @@ func (w *Worker) tick(ctx context.Context) {
 		return
 	}
 	for _, site := range sites {
+		if err := w.reconciler.ApplySchedules(ctx, site.SiteID, time.Now().UTC()); err != nil {
+			log.Warnf("site-controller: apply schedules for site %s: %v", site.SiteID, err)
+		}
 		if err := w.reconciler.ReconcileSite(ctx, site.SiteID, false); err != nil {
 			log.Warnf("site-controller: reconcile site %s: %v", site.SiteID, err)
 		}
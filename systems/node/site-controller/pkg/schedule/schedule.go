@@ -0,0 +1,213 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package schedule
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+)
+
+// Upper bound of windows expanded per schedule, guards against "* * * * *"
+const maxWindows = 1000
+
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+func (w Window) Contains(t time.Time) bool {
+	return !t.Before(w.Start) && t.Before(w.End)
+}
+
+// Transition is a schedule window starting or ending. Overridden tells a
+// higher priority schedule on the same target holds the site at that time.
+type Transition struct {
+	Schedule   *db.SiteSchedule
+	At         time.Time
+	Start      bool
+	State      string
+	Overridden bool
+}
+
+func Validate(s *db.SiteSchedule) error {
+	if s.Target != db.ScheduleTargetService && s.Target != db.ScheduleTargetRadio {
+		return fmt.Errorf("invalid schedule target %s", s.Target)
+	}
+	if s.State != "on" && s.State != "off" {
+		return fmt.Errorf("invalid schedule state %s", s.State)
+	}
+	if _, err := location(s); err != nil {
+		return fmt.Errorf("invalid schedule timezone %s", s.Timezone)
+	}
+
+	if s.Cron == "" {
+		if s.StartAt == nil || s.EndAt == nil {
+			return fmt.Errorf("invalid schedule: either cron or start and end are required")
+		}
+		if !s.EndAt.After(*s.StartAt) {
+			return fmt.Errorf("invalid schedule: end must be after start")
+		}
+		return nil
+	}
+
+	if strings.HasPrefix(s.Cron, "CRON_TZ=") || strings.HasPrefix(s.Cron, "TZ=") {
+		return fmt.Errorf("invalid schedule cron: use timezone instead of TZ")
+	}
+	sched, err := cron.ParseStandard(s.Cron)
+	if err != nil {
+		return fmt.Errorf("invalid schedule cron %q: %w", s.Cron, err)
+	}
+	if s.DurationSec <= 0 {
+		return fmt.Errorf("invalid schedule: duration is required with cron")
+	}
+
+	// Windows of the same schedule must not overlap
+	t := sched.Next(time.Now().UTC())
+	if t.IsZero() {
+		return fmt.Errorf("invalid schedule cron %q: never runs", s.Cron)
+	}
+	for i := 0; i < 64; i++ {
+		next := sched.Next(t)
+		if next.Sub(t) < duration(s) {
+			return fmt.Errorf("invalid schedule: duration is longer than the time between two cron runs")
+		}
+		t = next
+	}
+	return nil
+}
+
+// Active returns the window of s holding at t, if any.
+func Active(s *db.SiteSchedule, t time.Time) (Window, bool) {
+	if s.Cron == "" {
+		if s.StartAt == nil || s.EndAt == nil {
+			return Window{}, false
+		}
+		w := Window{Start: *s.StartAt, End: *s.EndAt}
+		return w, w.Contains(t)
+	}
+
+	sched, loc, err := parse(s)
+	if err != nil {
+		return Window{}, false
+	}
+	// The first start after t-duration is the only one whose window can hold t
+	start := sched.Next(t.Add(-duration(s)).In(loc)).UTC()
+	w := Window{Start: start, End: start.Add(duration(s))}
+	return w, w.Contains(t)
+}
+
+// Windows returns the windows of s overlapping [from, to).
+func Windows(s *db.SiteSchedule, from, to time.Time) []Window {
+	if s.Cron == "" {
+		if s.StartAt == nil || s.EndAt == nil || !s.StartAt.Before(to) || !s.EndAt.After(from) {
+			return nil
+		}
+		return []Window{{Start: *s.StartAt, End: *s.EndAt}}
+	}
+
+	sched, loc, err := parse(s)
+	if err != nil {
+		return nil
+	}
+	var out []Window
+	t := from.Add(-duration(s))
+	for i := 0; i < maxWindows; i++ {
+		start := sched.Next(t.In(loc)).UTC()
+		if !start.Before(to) {
+			break
+		}
+		out = append(out, Window{Start: start, End: start.Add(duration(s))})
+		t = start
+	}
+	return out
+}
+
+// Winner returns the schedule of target holding the site at t: the highest
+// priority one, then the one whose window started last.
+func Winner(schedules []db.SiteSchedule, target string, t time.Time) (*db.SiteSchedule, Window, bool) {
+	var winner *db.SiteSchedule
+	var window Window
+	for i := range schedules {
+		s := &schedules[i]
+		if s.Target != target {
+			continue
+		}
+		w, ok := Active(s, t)
+		if !ok {
+			continue
+		}
+		if winner == nil || s.Priority > winner.Priority ||
+			(s.Priority == winner.Priority && w.Start.After(window.Start)) {
+			winner, window = s, w
+		}
+	}
+	return winner, window, winner != nil
+}
+
+// Upcoming lists the window starts and ends of schedules within [from, to),
+// ordered by time.
+func Upcoming(schedules []db.SiteSchedule, from, to time.Time, limit int) []Transition {
+	var out []Transition
+	for i := range schedules {
+		s := &schedules[i]
+		for _, w := range Windows(s, from, to) {
+			if !w.Start.Before(from) {
+				out = append(out, Transition{Schedule: s, At: w.Start, Start: true, State: s.State})
+			}
+			if w.End.Before(to) {
+				out = append(out, Transition{Schedule: s, At: w.End, State: s.State})
+			}
+		}
+	}
+
+	for i := range out {
+		// A start is overridden when another schedule wins right after it, an end
+		// when the schedule was not the winner right before it
+		at := out[i].At
+		if !out[i].Start {
+			at = at.Add(-time.Nanosecond)
+		}
+		winner, _, ok := Winner(schedules, out[i].Schedule.Target, at)
+		out[i].Overridden = ok && winner.ID != out[i].Schedule.ID
+	}
+
+	sort.SliceStable(out, func(i, j int) bool { return out[i].At.Before(out[j].At) })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+func parse(s *db.SiteSchedule) (cron.Schedule, *time.Location, error) {
+	loc, err := location(s)
+	if err != nil {
+		return nil, nil, err
+	}
+	sched, err := cron.ParseStandard(s.Cron)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sched, loc, nil
+}
+
+func location(s *db.SiteSchedule) (*time.Location, error) {
+	if s.Timezone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(s.Timezone)
+}
+
+func duration(s *db.SiteSchedule) time.Duration {
+	return time.Duration(s.DurationSec) * time.Second
+}
@@ -0,0 +1,123 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	uuid "github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+)
+
+func nightlyRadioOff() db.SiteSchedule {
+	return db.SiteSchedule{
+		ID: uuid.NewV4(), Name: "night", Target: db.ScheduleTargetRadio, State: "off",
+		Cron: "0 1 * * *", DurationSec: 4 * 3600, Timezone: "Africa/Nairobi",
+	}
+}
+
+func TestValidate(t *testing.T) {
+	s := nightlyRadioOff()
+	assert.NoError(t, Validate(&s))
+
+	start := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	end := start.Add(10 * time.Hour)
+	oneOff := db.SiteSchedule{Target: db.ScheduleTargetService, State: "on", StartAt: &start, EndAt: &end}
+	assert.NoError(t, Validate(&oneOff))
+
+	for name, mutate := range map[string]func(s *db.SiteSchedule){
+		"target":   func(s *db.SiteSchedule) { s.Target = "site" },
+		"state":    func(s *db.SiteSchedule) { s.State = "maybe" },
+		"timezone": func(s *db.SiteSchedule) { s.Timezone = "Mars/Olympus" },
+		"cron":     func(s *db.SiteSchedule) { s.Cron = "61 * * * *" },
+		"cron tz":  func(s *db.SiteSchedule) { s.Cron = "CRON_TZ=UTC 0 1 * * *" },
+		"duration": func(s *db.SiteSchedule) { s.DurationSec = 0 },
+		"overlap":  func(s *db.SiteSchedule) { s.DurationSec = 25 * 3600 },
+		"no time":  func(s *db.SiteSchedule) { s.Cron = "" },
+	} {
+		s := nightlyRadioOff()
+		mutate(&s)
+		assert.Error(t, Validate(&s), name)
+	}
+}
+
+func TestActive_Timezone(t *testing.T) {
+	s := nightlyRadioOff()
+
+	/* 01:00-05:00 in Nairobi is 22:00-02:00 UTC */
+	w, ok := Active(&s, time.Date(2026, 3, 1, 23, 30, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 3, 1, 22, 0, 0, 0, time.UTC), w.Start)
+	assert.Equal(t, time.Date(2026, 3, 2, 2, 0, 0, 0, time.UTC), w.End)
+
+	_, ok = Active(&s, time.Date(2026, 3, 2, 2, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+	_, ok = Active(&s, time.Date(2026, 3, 1, 21, 59, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestWindows(t *testing.T) {
+	s := nightlyRadioOff()
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	ws := Windows(&s, from, from.Add(72*time.Hour))
+
+	/* The window running at from is included */
+	if assert.Len(t, ws, 4) {
+		assert.Equal(t, time.Date(2026, 2, 28, 22, 0, 0, 0, time.UTC), ws[0].Start)
+		assert.Equal(t, time.Date(2026, 3, 3, 22, 0, 0, 0, time.UTC), ws[3].Start)
+	}
+}
+
+func TestWinner_Priority(t *testing.T) {
+	night := nightlyRadioOff()
+	start := time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+	end := start.Add(4 * time.Hour)
+	event := db.SiteSchedule{ID: uuid.NewV4(), Name: "event", Target: db.ScheduleTargetRadio, State: "on",
+		StartAt: &start, EndAt: &end, Priority: 10}
+	schedules := []db.SiteSchedule{night, event}
+
+	w, _, ok := Winner(schedules, db.ScheduleTargetRadio, time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, event.ID, w.ID)
+
+	w, _, ok = Winner(schedules, db.ScheduleTargetRadio, time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, night.ID, w.ID)
+
+	_, _, ok = Winner(schedules, db.ScheduleTargetService, time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestUpcoming(t *testing.T) {
+	night := nightlyRadioOff()
+	start := time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+	end := start.Add(3 * time.Hour)
+	event := db.SiteSchedule{ID: uuid.NewV4(), Name: "event", Target: db.ScheduleTargetRadio, State: "on",
+		StartAt: &start, EndAt: &end, Priority: 10}
+	from := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tr := Upcoming([]db.SiteSchedule{night, event}, from, from.Add(24*time.Hour), 0)
+
+	if assert.Len(t, tr, 4) {
+		assert.Equal(t, event.ID, tr[0].Schedule.ID)
+		assert.True(t, tr[0].Start)
+		/* Night starts while the event holds the radio */
+		assert.Equal(t, night.ID, tr[1].Schedule.ID)
+		assert.True(t, tr[1].Overridden)
+		assert.False(t, tr[2].Start)
+		assert.False(t, tr[2].Overridden)
+		assert.Equal(t, time.Date(2026, 3, 2, 2, 0, 0, 0, time.UTC), tr[3].At)
+		assert.False(t, tr[3].Overridden)
+	}
+
+	assert.Len(t, Upcoming([]db.SiteSchedule{night, event}, from, from.Add(24*time.Hour), 2), 2)
+}
@@ -0,0 +1,89 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+
+	uuid "github.com/ukama/ukama/systems/common/uuid"
+	pb "github.com/ukama/ukama/systems/node/site-controller/pb/gen"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *SiteControllerServer) ListAuditLog(ctx context.Context, req *pb.ListAuditLogRequest) (*pb.ListAuditLogResponse, error) {
+	f := db.AuditFilter{
+		SiteID:      req.SiteId,
+		NodeID:      req.NodeId,
+		RequestedBy: req.RequestedBy,
+		Command:     req.Command,
+		Limit:       int(req.Limit),
+	}
+	if req.From != nil {
+		f.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		f.To = req.To.AsTime()
+	}
+	entries, err := s.reconciler.ListAudit(ctx, f)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	out := make([]*pb.AuditEntry, 0, len(entries))
+	for i := range entries {
+		out = append(out, auditToPB(&entries[i]))
+	}
+	return &pb.ListAuditLogResponse{Entries: out}, nil
+}
+
+// auditNodeCommand records a command forwarded to node-controller.
+func (s *SiteControllerServer) auditNodeCommand(siteID, nodeID, command, detail, requestedBy, operationID string, err error) {
+	e := &db.SiteAuditEntry{
+		SiteID:      siteID,
+		NodeID:      nodeID,
+		Command:     command,
+		Detail:      detail,
+		RequestedBy: requestedBy,
+		OperationID: operationID,
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	s.reconciler.Audit(e)
+}
+
+// requester is who node-controller records as asking for a forwarded command.
+func requester(requestedBy string) string {
+	if requestedBy == "" {
+		return pkg.ServiceName
+	}
+	return requestedBy
+}
+
+func auditToPB(e *db.SiteAuditEntry) *pb.AuditEntry {
+	out := &pb.AuditEntry{
+		Id:           e.ID.String(),
+		SiteId:       e.SiteID,
+		NodeId:       e.NodeID,
+		Command:      e.Command,
+		Detail:       e.Detail,
+		RequestedBy:  e.RequestedBy,
+		Reason:       e.Reason,
+		OperationId:  e.OperationID,
+		FlightStatus: e.FlightStatus,
+		RetryCount:   int32(e.RetryCount),
+		Error:        e.Error,
+		CreatedAt:    timestamppb.New(e.CreatedAt),
+	}
+	if e.IntentID != uuid.Nil {
+		out.IntentId = e.IntentID.String()
+	}
+	return out
+}
@@ -0,0 +1,114 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	mbmocks "github.com/ukama/ukama/systems/common/mocks"
+	registry "github.com/ukama/ukama/systems/common/rest/client/registry"
+	contpb "github.com/ukama/ukama/systems/node/controller/pb/gen"
+	contmocks "github.com/ukama/ukama/systems/node/controller/pb/gen/mocks"
+	"github.com/ukama/ukama/systems/node/site-controller/mocks"
+	pb "github.com/ukama/ukama/systems/node/site-controller/pb/gen"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/reconciler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newAuditServer(nodeClient *mbmocks.NodeClient, controllerClient contpb.ControllerServiceClient, audit *mocks.AuditRepo) *SiteControllerServer {
+	r := reconciler.New(nil, nil, nil, nil, nil, nil, nil, audit, nil, nil, nil, nil, 0, 0)
+	return NewSiteControllerServer(testOrgName, r, nil, nodeClient, nil, nil, &fakeControllerProvider{client: controllerClient}, nil)
+}
+
+func TestSetService_AuditsForwardedCommand(t *testing.T) {
+	siteID := "33333333-3333-3333-3333-333333333333"
+	nodeClient := &mbmocks.NodeClient{}
+	controllerClient := &contmocks.ControllerServiceClient{}
+	audit := &mocks.AuditRepo{}
+
+	nodeClient.On("GetNodesBySite", siteID).Return(&registry.NodesBySite{
+		Nodes: []registry.NodeInfo{{Id: testTowerID}},
+	}, nil).Once()
+	controllerClient.On("ToggleService", mock.Anything, mock.MatchedBy(func(req *contpb.ToggleServiceRequest) bool {
+		return req.RequestedBy == "ops@example.com"
+	})).Return(&contpb.ToggleServiceResponse{OperationId: "op-1"}, nil).Once()
+	audit.On("Add", mock.MatchedBy(func(e *db.SiteAuditEntry) bool {
+		return e.SiteID == siteID && e.NodeID == testTowerID && e.Command == reconciler.AuditSetService &&
+			e.RequestedBy == "ops@example.com" && e.OperationID == "op-1" && e.Error == ""
+	})).Return(nil).Once()
+
+	s := newAuditServer(nodeClient, controllerClient, audit)
+
+	_, err := s.SetService(context.TODO(), &pb.SetServiceRequest{SiteId: siteID, State: "on", RequestedBy: "ops@example.com"})
+
+	assert.NoError(t, err)
+	controllerClient.AssertExpectations(t)
+	audit.AssertExpectations(t)
+}
+
+func TestSetService_AuditsFailure(t *testing.T) {
+	siteID := "44444444-4444-4444-4444-444444444444"
+	nodeClient := &mbmocks.NodeClient{}
+	controllerClient := &contmocks.ControllerServiceClient{}
+	audit := &mocks.AuditRepo{}
+
+	nodeClient.On("GetNodesBySite", siteID).Return(&registry.NodesBySite{
+		Nodes: []registry.NodeInfo{{Id: testTowerID}},
+	}, nil).Once()
+	/* Without a requester the site-controller asks on its own behalf */
+	controllerClient.On("ToggleService", mock.Anything, mock.MatchedBy(func(req *contpb.ToggleServiceRequest) bool {
+		return req.RequestedBy == pkg.ServiceName
+	})).Return(nil, errors.New("node offline")).Once()
+	audit.On("Add", mock.MatchedBy(func(e *db.SiteAuditEntry) bool {
+		return e.Error == "node offline"
+	})).Return(errors.New("db down")).Once()
+
+	s := newAuditServer(nodeClient, controllerClient, audit)
+
+	_, err := s.SetService(context.TODO(), &pb.SetServiceRequest{SiteId: siteID, State: "off"})
+
+	assert.ErrorContains(t, err, "node offline")
+	audit.AssertExpectations(t)
+}
+
+func TestListAuditLog_InvalidRange(t *testing.T) {
+	audit := &mocks.AuditRepo{}
+	s := newAuditServer(nil, nil, audit)
+	from := timestamppb.Now()
+
+	_, err := s.ListAuditLog(context.TODO(), &pb.ListAuditLogRequest{From: from, To: from})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	audit.AssertNotCalled(t, "List", mock.Anything)
+}
+
+func TestListAuditLog_Filters(t *testing.T) {
+	audit := &mocks.AuditRepo{}
+	s := newAuditServer(nil, nil, audit)
+
+	audit.On("List", mock.MatchedBy(func(f db.AuditFilter) bool {
+		return f.SiteID == "site-1" && f.Command == reconciler.AuditReconcile && f.Limit == 10 && f.From.IsZero()
+	})).Return([]db.SiteAuditEntry{{SiteID: "site-1", Command: reconciler.AuditReconcile, FlightStatus: "failed", RetryCount: 3}}, nil).Once()
+
+	resp, err := s.ListAuditLog(context.TODO(), &pb.ListAuditLogRequest{SiteId: "site-1", Command: reconciler.AuditReconcile, Limit: 10})
+
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Entries, 1) {
+		assert.Equal(t, int32(3), resp.Entries[0].RetryCount)
+		assert.Empty(t, resp.Entries[0].IntentId)
+	}
+}
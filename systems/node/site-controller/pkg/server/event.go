@@ -23,6 +23,8 @@ import (
 	pb "github.com/ukama/ukama/systems/node/site-controller/pb/gen"
 	"github.com/ukama/ukama/systems/node/site-controller/pkg"
 	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/policy"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/reconciler"
 )
 
 var defaultSiteIntent = db.SiteIntent{
@@ -107,6 +109,31 @@ func (c *SiteControllerEventServer) EventNotification(ctx context.Context, e *ep
 
 		return &epb.EventResponse{}, nil
 
+	case msgbus.PrepareRoute(c.orgName, "event.cloud.local.{{ .Org}}.registry.site.site.decommission"):
+		cfg := evt.EventToEventConfig[evt.EventSiteDecommission]
+		msg, err := epb.UnmarshalEventDecommissionSite(e.Msg, cfg.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		err = c.handleDecommissionSite(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+
+		return &epb.EventResponse{}, nil
+
+	case msgbus.PrepareRoute(c.orgName, "event.cloud.local.{{ .Org}}.registry.site.site.relocate"):
+		cfg := evt.EventToEventConfig[evt.EventSiteRelocate]
+		msg, err := epb.UnmarshalEventRelocateSite(e.Msg, cfg.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		c.handleRelocateSite(ctx, msg)
+
+		return &epb.EventResponse{}, nil
+
 	default:
 		log.Errorf("No handler routing key %s", e.RoutingKey)
 	}
@@ -153,6 +180,13 @@ func (c *SiteControllerEventServer) handleHealthReport(ctx context.Context, msg
 		return fmt.Errorf("no interfaces found for node %s", nodeId)
 	}
 
+	if ctrl := report.Interfaces.Controller; ctrl != nil && ctrl.Available && ctrl.Battery != nil {
+		sample := policy.PowerSample{SocPct: int(ctrl.Battery.SocPct), BatteryCurrentA: ctrl.Battery.CurrentA}
+		if err := c.s.reconciler.ApplyPowerSample(ctx, siteId, sample); err != nil {
+			log.Warnf("site-controller: failed to apply power sample of node %s to site %s: %v", nodeId, siteId, err)
+		}
+	}
+
 	switch nodeType {
 	case ukama.NODE_ID_TYPE_TOWERNODE:
 		// TODO: Handle tower node health report
@@ -227,6 +261,38 @@ func (c *SiteControllerEventServer) handleAddSite(ctx context.Context, msg *epb.
 	return nil
 }
 
+func (c *SiteControllerEventServer) handleDecommissionSite(ctx context.Context, msg *epb.EventDecommissionSite) error {
+	log.Infof("Archiving decommissioned site %s. Reason: %s", msg.SiteId, msg.Reason)
+
+	if err := c.sites.Archive(msg.SiteId); err != nil {
+		return err
+	}
+
+	c.s.reconciler.Audit(&db.SiteAuditEntry{
+		SiteID:      msg.SiteId,
+		Command:     reconciler.AuditArchive,
+		Detail:      fmt.Sprintf("released nodes=%v", msg.ReleasedNodeIds),
+		RequestedBy: "registry",
+		Reason:      msg.Reason,
+	})
+	return nil
+}
+
+// handleRelocateSite resyncs the components of a relocated site, its nodes
+// may have changed with the move.
+func (c *SiteControllerEventServer) handleRelocateSite(ctx context.Context, msg *epb.EventRelocateSite) {
+	log.Infof("Site %s relocated to %s,%s", msg.SiteId, msg.Latitude, msg.Longitude)
+
+	c.s.reconciler.Audit(&db.SiteAuditEntry{
+		SiteID:      msg.SiteId,
+		Command:     reconciler.AuditRelocate,
+		Detail:      fmt.Sprintf("from=%s,%s to=%s,%s", msg.PreviousLatitude, msg.PreviousLongitude, msg.Latitude, msg.Longitude),
+		RequestedBy: "registry",
+		Reason:      msg.Reason,
+	})
+	c.scheduleSetSiteComponents(msg.SiteId)
+}
+
 func (c *SiteControllerEventServer) scheduleSetSiteComponents(siteID string) {
 	delay := c.componentSyncDelay
 	go func() {
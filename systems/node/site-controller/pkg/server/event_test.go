@@ -0,0 +1,54 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/ukama/ukama/systems/common/msgbus"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	"github.com/ukama/ukama/systems/node/site-controller/mocks"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/reconciler"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestEventNotification_DecommissionArchivesSite(t *testing.T) {
+	siteID := "11111111-1111-1111-1111-111111111111"
+	sites := &mocks.SiteRepo{}
+	audit := &mocks.AuditRepo{}
+
+	s := newAuditServer(nil, nil, audit)
+	es := NewSiteControllerEventServer(s, sites, nil, nil, nil, nil, &pkg.Config{OrgName: testOrgName})
+
+	sites.On("Archive", siteID).Return(nil).Once()
+	audit.On("Add", mock.MatchedBy(func(e *db.SiteAuditEntry) bool {
+		return e.SiteID == siteID && e.Command == reconciler.AuditArchive && e.Reason == "flooded"
+	})).Return(nil).Once()
+
+	msg, err := anypb.New(&epb.EventDecommissionSite{
+		SiteId:          siteID,
+		Reason:          "flooded",
+		ReleasedNodeIds: []string{testTowerID},
+	})
+	assert.NoError(t, err)
+
+	_, err = es.EventNotification(context.TODO(), &epb.Event{
+		RoutingKey: msgbus.PrepareRoute(testOrgName, "event.cloud.local.{{ .Org}}.registry.site.site.decommission"),
+		Msg:        msg,
+	})
+
+	assert.NoError(t, err)
+	sites.AssertExpectations(t)
+	audit.AssertExpectations(t)
+}
@@ -0,0 +1,139 @@
+/*
+* This Source Code Form is subject to the terms of the Mozilla Public
+* License, v. 2.0. If a copy of the MPL was not distributed with this
+* file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*
+* Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	uuid "github.com/ukama/ukama/systems/common/uuid"
+	pb "github.com/ukama/ukama/systems/node/site-controller/pb/gen"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/schedule"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Range of ListScheduleTransitions when no end is given
+const defaultTransitionsRange = 7 * 24 * time.Hour
+
+func (s *SiteControllerServer) AddSchedule(ctx context.Context, req *pb.AddScheduleRequest) (*pb.AddScheduleResponse, error) {
+	sc := scheduleFromPB(req.SiteId, req.Schedule)
+	if err := s.reconciler.AddSchedule(ctx, sc); err != nil {
+		return nil, mapErr(err)
+	}
+	return &pb.AddScheduleResponse{Schedule: scheduleToPB(sc)}, nil
+}
+
+func (s *SiteControllerServer) ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) (*pb.ListSchedulesResponse, error) {
+	schedules, err := s.reconciler.ListSchedules(ctx, req.SiteId)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	out := make([]*pb.Schedule, 0, len(schedules))
+	for i := range schedules {
+		out = append(out, scheduleToPB(&schedules[i]))
+	}
+	return &pb.ListSchedulesResponse{Schedules: out}, nil
+}
+
+func (s *SiteControllerServer) DeleteSchedule(ctx context.Context, req *pb.DeleteScheduleRequest) (*pb.DeleteScheduleResponse, error) {
+	id, err := uuid.FromString(req.ScheduleId)
+	if err != nil {
+		return nil, mapErr(fmt.Errorf("invalid schedule id %s", req.ScheduleId))
+	}
+	if err := s.reconciler.DeleteSchedule(ctx, req.SiteId, id); err != nil {
+		return nil, mapErr(err)
+	}
+	return &pb.DeleteScheduleResponse{}, nil
+}
+
+func (s *SiteControllerServer) ListScheduleTransitions(ctx context.Context, req *pb.ListScheduleTransitionsRequest) (*pb.ListScheduleTransitionsResponse, error) {
+	from := time.Now().UTC()
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	to := from.Add(defaultTransitionsRange)
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	if !to.After(from) {
+		return nil, mapErr(fmt.Errorf("invalid range: to must be after from"))
+	}
+
+	transitions, err := s.reconciler.UpcomingTransitions(ctx, req.SiteId, from, to, int(req.Limit))
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	out := make([]*pb.ScheduleTransition, 0, len(transitions))
+	for _, t := range transitions {
+		out = append(out, transitionToPB(t))
+	}
+	return &pb.ListScheduleTransitionsResponse{Transitions: out}, nil
+}
+
+func scheduleFromPB(siteID string, p *pb.Schedule) *db.SiteSchedule {
+	sc := &db.SiteSchedule{
+		SiteID:      siteID,
+		Name:        p.GetName(),
+		Target:      p.GetTarget(),
+		State:       p.GetState(),
+		Cron:        p.GetCron(),
+		DurationSec: p.GetDurationSec(),
+		Timezone:    p.GetTimezone(),
+		Priority:    int(p.GetPriority()),
+		CreatedBy:   p.GetCreatedBy(),
+	}
+	if p.GetStartAt() != nil {
+		t := p.GetStartAt().AsTime()
+		sc.StartAt = &t
+	}
+	if p.GetEndAt() != nil {
+		t := p.GetEndAt().AsTime()
+		sc.EndAt = &t
+	}
+	return sc
+}
+
+func scheduleToPB(sc *db.SiteSchedule) *pb.Schedule {
+	out := &pb.Schedule{
+		Id:          sc.ID.String(),
+		SiteId:      sc.SiteID,
+		Name:        sc.Name,
+		Target:      sc.Target,
+		State:       sc.State,
+		Cron:        sc.Cron,
+		DurationSec: sc.DurationSec,
+		Timezone:    sc.Timezone,
+		Priority:    int32(sc.Priority),
+		CreatedBy:   sc.CreatedBy,
+	}
+	if sc.StartAt != nil {
+		out.StartAt = timestamppb.New(*sc.StartAt)
+	}
+	if sc.EndAt != nil {
+		out.EndAt = timestamppb.New(*sc.EndAt)
+	}
+	if sc.ActiveSince != nil {
+		out.ActiveSince = timestamppb.New(*sc.ActiveSince)
+	}
+	return out
+}
+
+func transitionToPB(t schedule.Transition) *pb.ScheduleTransition {
+	return &pb.ScheduleTransition{
+		ScheduleId: t.Schedule.ID.String(),
+		Name:       t.Schedule.Name,
+		Target:     t.Schedule.Target,
+		State:      t.State,
+		At:         timestamppb.New(t.At),
+		Start:      t.Start,
+		Overridden: t.Overridden,
+	}
+}
@@ -10,6 +10,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -21,6 +22,7 @@ import (
 	pb "github.com/ukama/ukama/systems/node/site-controller/pb/gen"
 	"github.com/ukama/ukama/systems/node/site-controller/pkg"
 	"github.com/ukama/ukama/systems/node/site-controller/pkg/db"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg/policy"
 	"github.com/ukama/ukama/systems/node/site-controller/pkg/reconciler"
 	"github.com/ukama/ukama/systems/node/site-controller/providers"
 	"google.golang.org/grpc/codes"
@@ -66,7 +68,8 @@ func (s *SiteControllerServer) SetService(ctx context.Context, req *pb.SetServic
 	if err != nil {
 		return nil, status.Errorf(codes.Unavailable, "node-controller unavailable: %v", err)
 	}
-	resp, err := client.ToggleService(ctx, &contpb.ToggleServiceRequest{NodeId: nodeID, State: req.State})
+	resp, err := client.ToggleService(ctx, &contpb.ToggleServiceRequest{NodeId: nodeID, State: req.State, RequestedBy: requester(req.RequestedBy), WaitSeconds: pkg.NodeCommandWaitSecs})
+	s.auditNodeCommand(req.SiteId, nodeID, reconciler.AuditSetService, "service="+req.State, req.RequestedBy, resp.GetOperationId(), err)
 	if err != nil {
 		return nil, mapErr(err)
 	}
@@ -94,12 +97,14 @@ func (s *SiteControllerServer) SetRadio(ctx context.Context, req *pb.SetRadioReq
 		}
 	}
 
-	_, err = client.ToggleRadio(ctx, &contpb.ToggleRadioRequest{NodeId: tnode, State: req.State})
+	resp, err := client.ToggleRadio(ctx, &contpb.ToggleRadioRequest{NodeId: tnode, State: req.State, RequestedBy: requester(req.RequestedBy), WaitSeconds: pkg.NodeCommandWaitSecs})
+	s.auditNodeCommand(req.SiteId, tnode, reconciler.AuditSetRadio, "radio="+req.State, req.RequestedBy, resp.GetOperationId(), err)
 	if err != nil {
 		return nil, mapErr(err)
 	}
 
-	_, err = client.ToggleRadio(ctx, &contpb.ToggleRadioRequest{NodeId: anode, State: req.State})
+	resp, err = client.ToggleRadio(ctx, &contpb.ToggleRadioRequest{NodeId: anode, State: req.State, RequestedBy: requester(req.RequestedBy), WaitSeconds: pkg.NodeCommandWaitSecs})
+	s.auditNodeCommand(req.SiteId, anode, reconciler.AuditSetRadio, "radio="+req.State, req.RequestedBy, resp.GetOperationId(), err)
 	if err != nil {
 		return nil, mapErr(err)
 	}
@@ -120,7 +125,8 @@ func (s *SiteControllerServer) RestartSite(ctx context.Context, req *pb.RestartS
 	operationIds := make([]string, 0)
 	for _, node := range nodes.Nodes {
 		if node.Type != ukama.NODE_ID_TYPE_CNODE {
-			resp, err := client.RestartNode(ctx, &contpb.RestartNodeRequest{NodeId: node.Id})
+			resp, err := client.RestartNode(ctx, &contpb.RestartNodeRequest{NodeId: node.Id, RequestedBy: requester(req.RequestedBy), WaitSeconds: pkg.NodeCommandWaitSecs})
+			s.auditNodeCommand(req.SiteId, node.Id, reconciler.AuditRestartNode, "", req.RequestedBy, resp.GetOperationId(), err)
 			if err != nil {
 				return nil, mapErr(err)
 			}
@@ -155,7 +161,8 @@ func (s *SiteControllerServer) ToggleInternetSwitch(ctx context.Context, req *pb
 		return nil, status.Errorf(codes.NotFound, "no CNODE found for site %s", req.SiteId)
 	}
 
-	resp, err := client.ToggleSwitchPort(ctx, &contpb.ToggleSwitchPortRequest{NodeId: cnodeId, Status: req.Status, Port: req.Port})
+	resp, err := client.ToggleSwitchPort(ctx, &contpb.ToggleSwitchPortRequest{NodeId: cnodeId, Status: req.Status, Port: req.Port, RequestedBy: requester(req.RequestedBy), WaitSeconds: pkg.NodeCommandWaitSecs})
+	s.auditNodeCommand(req.SiteId, cnodeId, reconciler.AuditInternetSwitch, fmt.Sprintf("port=%d on=%t", req.Port, req.Status), req.RequestedBy, resp.GetOperationId(), err)
 	if err != nil {
 		return nil, mapErr(err)
 	}
@@ -223,19 +230,41 @@ func (s *SiteControllerServer) GetPortMap(ctx context.Context, req *pb.GetPortMa
 }
 
 func (s *SiteControllerServer) ApplySwitchPolicy(ctx context.Context, req *pb.ApplySwitchPolicyRequest) (*pb.ApplySwitchPolicyResponse, error) {
-	if err := s.reconciler.ApplySwitchPolicy(ctx, req.SiteId); err != nil {
+	err := s.reconciler.ApplySwitchPolicy(ctx, req.SiteId)
+	s.auditNodeCommand(req.SiteId, "", reconciler.AuditSwitchPolicy, "", "", "", err)
+	if err != nil {
 		return nil, mapErr(err)
 	}
 	return &pb.ApplySwitchPolicyResponse{Applied: true}, nil
 }
 
 func (s *SiteControllerServer) PowerCycleNode(ctx context.Context, req *pb.PowerCycleNodeRequest) (*pb.PowerCycleNodeResponse, error) {
-	if err := s.reconciler.PowerCycleNode(ctx, req.SiteId, req.Role, req.Reason); err != nil {
+	if err := s.reconciler.PowerCycleNode(ctx, req.SiteId, req.Role, req.Reason, req.RequestedBy); err != nil {
 		return nil, mapErr(err)
 	}
 	return &pb.PowerCycleNodeResponse{}, nil
 }
 
+func (s *SiteControllerServer) SetPowerPolicy(ctx context.Context, req *pb.SetPowerPolicyRequest) (*pb.SetPowerPolicyResponse, error) {
+	p := powerPolicyFromPB(req.SiteId, req.Policy)
+	if err := s.reconciler.SetPowerPolicy(ctx, p); err != nil {
+		return nil, mapErr(err)
+	}
+	return &pb.SetPowerPolicyResponse{Policy: powerPolicyToPB(p)}, nil
+}
+
+func (s *SiteControllerServer) GetPowerPolicy(ctx context.Context, req *pb.GetPowerPolicyRequest) (*pb.GetPowerPolicyResponse, error) {
+	p, err := s.reconciler.GetPowerPolicy(ctx, req.SiteId)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	intent, err := s.getIntent(ctx, req.SiteId)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	return &pb.GetPowerPolicyResponse{Policy: powerPolicyToPB(p), LoadShedLevel: loadShedLevel(intent)}, nil
+}
+
 func (s *SiteControllerServer) getIntent(ctx context.Context, siteID string) (*db.SiteIntent, error) {
 	_, intent, err := s.reconciler.GetState(ctx, siteID)
 	return intent, err
@@ -245,9 +274,55 @@ func intentToPB(in *db.SiteIntent) *pb.SiteIntentMsg {
 	if in == nil {
 		return nil
 	}
+	shedPorts := make([]int32, 0, len(in.ShedPorts))
+	for _, p := range in.ShedPorts {
+		shedPorts = append(shedPorts, int32(p))
+	}
 	return &pb.SiteIntentMsg{
 		SiteId: in.SiteID, DesiredService: in.DesiredService,
 		DesiredRadio: in.DesiredRadio, Reason: in.Reason, RequestedBy: in.RequestedBy,
+		LoadShedLevel: loadShedLevel(in), RadioPowerPct: int32(in.RadioPowerPct), ShedPorts: shedPorts,
+	}
+}
+
+func loadShedLevel(in *db.SiteIntent) string {
+	if in == nil || in.LoadShedLevel == "" {
+		return policy.ShedLevelNone
+	}
+	return in.LoadShedLevel
+}
+
+func powerPolicyFromPB(siteID string, p *pb.PowerPolicy) *db.SitePowerPolicy {
+	return &db.SitePowerPolicy{
+		SiteID:                siteID,
+		Enabled:               p.GetEnabled(),
+		BatteryCapacityAh:     p.GetBatteryCapacityAh(),
+		ShedPortsSocPct:       int(p.GetShedPortsSocPct()),
+		ShedPortsRuntimeMin:   int(p.GetShedPortsRuntimeMin()),
+		ReduceRadioSocPct:     int(p.GetReduceRadioSocPct()),
+		ReduceRadioRuntimeMin: int(p.GetReduceRadioRuntimeMin()),
+		ReducedRadioPowerPct:  int(p.GetReducedRadioPowerPct()),
+		RadioOffSocPct:        int(p.GetRadioOffSocPct()),
+		RadioOffRuntimeMin:    int(p.GetRadioOffRuntimeMin()),
+		RestoreMarginPct:      int(p.GetRestoreMarginPct()),
+	}
+}
+
+func powerPolicyToPB(p *db.SitePowerPolicy) *pb.PowerPolicy {
+	if p == nil {
+		return nil
+	}
+	return &pb.PowerPolicy{
+		Enabled:               p.Enabled,
+		BatteryCapacityAh:     p.BatteryCapacityAh,
+		ShedPortsSocPct:       int32(p.ShedPortsSocPct),
+		ShedPortsRuntimeMin:   int32(p.ShedPortsRuntimeMin),
+		ReduceRadioSocPct:     int32(p.ReduceRadioSocPct),
+		ReduceRadioRuntimeMin: int32(p.ReduceRadioRuntimeMin),
+		ReducedRadioPowerPct:  int32(p.ReducedRadioPowerPct),
+		RadioOffSocPct:        int32(p.RadioOffSocPct),
+		RadioOffRuntimeMin:    int32(p.RadioOffRuntimeMin),
+		RestoreMarginPct:      int32(p.RestoreMarginPct),
 	}
 }
 
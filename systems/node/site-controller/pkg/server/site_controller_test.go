@@ -20,6 +20,7 @@ import (
 	contpb "github.com/ukama/ukama/systems/node/controller/pb/gen"
 	contmocks "github.com/ukama/ukama/systems/node/controller/pb/gen/mocks"
 	pb "github.com/ukama/ukama/systems/node/site-controller/pb/gen"
+	"github.com/ukama/ukama/systems/node/site-controller/pkg"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -52,7 +53,7 @@ func TestSetService_ForwardsToTowerNode(t *testing.T) {
 	}, nil).Once()
 
 	controllerClient.On("ToggleService", mock.Anything, mock.MatchedBy(func(req *contpb.ToggleServiceRequest) bool {
-		return req.NodeId == testTowerID && req.State == "on"
+		return req.NodeId == testTowerID && req.State == "on" && req.WaitSeconds == pkg.NodeCommandWaitSecs
 	})).Return(&contpb.ToggleServiceResponse{OperationId: "op-1"}, nil).Once()
 
 	s := newTestServer(nodeClient, controllerClient)
@@ -132,7 +132,7 @@ func runGrpcServer(gormdb sql.Db) {
 		db.NewAppRepo(gormdb), db.NewNodeRepo(gormdb), releaseRepo, hub,
 		providers.NewHealthClientProvider(svcConf.Health),
 		mbClient, svcConf.DebugMode, svcConf.NodeGwIPs,
-		opMgr, opMon, svcConf.Operation.LeaseSecs, svcConf.Operation.DeadlineSecs)
+		opMgr, opMon, svcConf.Operation.LeaseSecs, svcConf.Operation.DeadlineSecs, svcConf.Operation.WaitSecs)
 	eventServer := server.NewSoftwareEventServer(svcConf.OrgName, softServer)
 
 	grpcServer := ugrpc.NewGrpcServer(*svcConf.Grpc, func(s *grpc.Server) {
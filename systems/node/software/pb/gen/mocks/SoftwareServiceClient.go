@@ -164,6 +164,43 @@ func (_m *SoftwareServiceClient) GetSoftwareList(ctx context.Context, in *gen.Ge
 	return r0, r1
 }
 
+// PlanRollout provides a mock function with given fields: ctx, in, opts
+func (_m *SoftwareServiceClient) PlanRollout(ctx context.Context, in *gen.PlanRolloutRequest, opts ...grpc.CallOption) (*gen.PlanRolloutResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanRollout")
+	}
+
+	var r0 *gen.PlanRolloutResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.PlanRolloutRequest, ...grpc.CallOption) (*gen.PlanRolloutResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.PlanRolloutRequest, ...grpc.CallOption) *gen.PlanRolloutResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PlanRolloutResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.PlanRolloutRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PromoteRelease provides a mock function with given fields: ctx, in, opts
 func (_m *SoftwareServiceClient) PromoteRelease(ctx context.Context, in *gen.PromoteReleaseRequest, opts ...grpc.CallOption) (*gen.PromoteReleaseResponse, error) {
 	_va := make([]interface{}, len(opts))
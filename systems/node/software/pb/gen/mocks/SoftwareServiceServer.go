@@ -134,6 +134,36 @@ func (_m *SoftwareServiceServer) GetSoftwareList(_a0 context.Context, _a1 *gen.G
 	return r0, r1
 }
 
+// PlanRollout provides a mock function with given fields: _a0, _a1
+func (_m *SoftwareServiceServer) PlanRollout(_a0 context.Context, _a1 *gen.PlanRolloutRequest) (*gen.PlanRolloutResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanRollout")
+	}
+
+	var r0 *gen.PlanRolloutResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.PlanRolloutRequest) (*gen.PlanRolloutResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.PlanRolloutRequest) *gen.PlanRolloutResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.PlanRolloutResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.PlanRolloutRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PromoteRelease provides a mock function with given fields: _a0, _a1
 func (_m *SoftwareServiceServer) PromoteRelease(_a0 context.Context, _a1 *gen.PromoteReleaseRequest) (*gen.PromoteReleaseResponse, error) {
 	ret := _m.Called(_a0, _a1)
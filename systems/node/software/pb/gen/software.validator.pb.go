@@ -40,6 +40,22 @@ func (this *GetReleaseCatalogResponse) Validate() error {
 func (this *Release) Validate() error {
 	return nil
 }
+func (this *PlanRolloutRequest) Validate() error {
+	return nil
+}
+func (this *PlanRolloutResponse) Validate() error {
+	for _, item := range this.Steps {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Steps", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *RolloutStep) Validate() error {
+	return nil
+}
 func (this *CreateAppRequest) Validate() error {
 	return nil
 }
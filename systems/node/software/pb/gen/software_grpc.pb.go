@@ -28,6 +28,7 @@ type SoftwareServiceClient interface {
 	UpdateSoftware(ctx context.Context, in *UpdateSoftwareRequest, opts ...grpc.CallOption) (*UpdateSoftwareResponse, error)
 	PromoteRelease(ctx context.Context, in *PromoteReleaseRequest, opts ...grpc.CallOption) (*PromoteReleaseResponse, error)
 	GetReleaseCatalog(ctx context.Context, in *GetReleaseCatalogRequest, opts ...grpc.CallOption) (*GetReleaseCatalogResponse, error)
+	PlanRollout(ctx context.Context, in *PlanRolloutRequest, opts ...grpc.CallOption) (*PlanRolloutResponse, error)
 }
 
 type softwareServiceClient struct {
@@ -92,6 +93,15 @@ func (c *softwareServiceClient) GetReleaseCatalog(ctx context.Context, in *GetRe
 	return out, nil
 }
 
+func (c *softwareServiceClient) PlanRollout(ctx context.Context, in *PlanRolloutRequest, opts ...grpc.CallOption) (*PlanRolloutResponse, error) {
+	out := new(PlanRolloutResponse)
+	err := c.cc.Invoke(ctx, "/ukama.node.software.v1.SoftwareService/PlanRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SoftwareServiceServer is the server API for SoftwareService service.
 // All implementations must embed UnimplementedSoftwareServiceServer
 // for forward compatibility
@@ -102,6 +112,7 @@ type SoftwareServiceServer interface {
 	UpdateSoftware(context.Context, *UpdateSoftwareRequest) (*UpdateSoftwareResponse, error)
 	PromoteRelease(context.Context, *PromoteReleaseRequest) (*PromoteReleaseResponse, error)
 	GetReleaseCatalog(context.Context, *GetReleaseCatalogRequest) (*GetReleaseCatalogResponse, error)
+	PlanRollout(context.Context, *PlanRolloutRequest) (*PlanRolloutResponse, error)
 	mustEmbedUnimplementedSoftwareServiceServer()
 }
 
@@ -127,6 +138,9 @@ func (UnimplementedSoftwareServiceServer) PromoteRelease(context.Context, *Promo
 func (UnimplementedSoftwareServiceServer) GetReleaseCatalog(context.Context, *GetReleaseCatalogRequest) (*GetReleaseCatalogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseCatalog not implemented")
 }
+func (UnimplementedSoftwareServiceServer) PlanRollout(context.Context, *PlanRolloutRequest) (*PlanRolloutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanRollout not implemented")
+}
 func (UnimplementedSoftwareServiceServer) mustEmbedUnimplementedSoftwareServiceServer() {}
 
 // UnsafeSoftwareServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -248,6 +262,24 @@ func _SoftwareService_GetReleaseCatalog_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SoftwareService_PlanRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SoftwareServiceServer).PlanRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ukama.node.software.v1.SoftwareService/PlanRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SoftwareServiceServer).PlanRollout(ctx, req.(*PlanRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SoftwareService_ServiceDesc is the grpc.ServiceDesc for SoftwareService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -279,6 +311,10 @@ var SoftwareService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReleaseCatalog",
 			Handler:    _SoftwareService_GetReleaseCatalog_Handler,
 		},
+		{
+			MethodName: "PlanRollout",
+			Handler:    _SoftwareService_PlanRollout_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "software.proto",
@@ -38,6 +38,7 @@ type OperationServices struct {
 	Timeout      time.Duration `default:"5s"`
 	LeaseSecs    uint32        `default:"1800"`
 	DeadlineSecs uint32        `default:"1800"`
+	WaitSecs     uint32        `default:"30"` /* How long an update queues behind a busy node, 0 fails right away */
 }
 
 // HttpServices holds direct HTTP endpoints. Hub is a global system, so its
@@ -0,0 +1,131 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/ukama/ukama/systems/common/ukama"
+	"github.com/ukama/ukama/systems/common/validation"
+	pb "github.com/ukama/ukama/systems/node/software/pb/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PlanRollout orders the nodes lagging behind a release by how much each has to
+// download, cheapest first, so metered sites can be updated within a byte budget.
+// Nodes on the same current version share one delta lookup against the Hub.
+func (s *SoftwareServer) PlanRollout(ctx context.Context, req *pb.PlanRolloutRequest) (*pb.PlanRolloutResponse, error) {
+	if s.hub == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "hub client not configured")
+	}
+
+	rtype := req.Type
+	if rtype == "" {
+		rtype = "app"
+	}
+
+	version := req.Version
+	if version == "" {
+		d, err := s.releaseRepo.GetDesired(req.Name, rtype)
+		if err != nil || d == nil {
+			return nil, status.Errorf(codes.NotFound, "no version given and no desired release for %s", req.Name)
+		}
+		version = d.DesiredVersion
+	}
+
+	log.Infof("PlanRollout app=%s version=%s type=%s budget=%d", req.Name, version, rtype, req.BudgetBytes)
+
+	wanted := map[string]bool{}
+	for _, n := range req.NodeIds {
+		nId, err := ukama.ValidateNodeId(n)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid node id %s: %s", n, err.Error())
+		}
+		wanted[nId.String()] = true
+	}
+
+	rows, err := s.sRepo.List("", ukama.Unknown, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list software: %v", err)
+	}
+
+	costByVersion := map[string]uint64{}
+	steps := []*pb.RolloutStep{}
+	for _, sw := range rows {
+		if len(wanted) > 0 && !wanted[sw.NodeId] {
+			continue
+		}
+		if !validation.IsVersionMismatch(sw.CurrentVersion, version) {
+			continue
+		}
+
+		cost, ok := costByVersion[sw.CurrentVersion]
+		if !ok {
+			cost, err = s.downloadCost(req.Name, rtype, sw.CurrentVersion, version)
+			if err != nil {
+				return nil, status.Errorf(codes.Unavailable, "failed to get delta for %s %s -> %s: %v",
+					req.Name, sw.CurrentVersion, version, err)
+			}
+			costByVersion[sw.CurrentVersion] = cost
+		}
+
+		steps = append(steps, &pb.RolloutStep{
+			NodeId:        sw.NodeId,
+			FromVersion:   sw.CurrentVersion,
+			DownloadBytes: cost,
+		})
+	}
+
+	sort.SliceStable(steps, func(i, j int) bool {
+		if steps[i].DownloadBytes != steps[j].DownloadBytes {
+			return steps[i].DownloadBytes < steps[j].DownloadBytes
+		}
+		return steps[i].NodeId < steps[j].NodeId
+	})
+
+	var total, planned uint64
+	for _, st := range steps {
+		total += st.DownloadBytes
+		st.CumulativeBytes = total
+		st.WithinBudget = req.BudgetBytes == 0 || total <= req.BudgetBytes
+		if st.WithinBudget {
+			planned = total
+		}
+	}
+
+	return &pb.PlanRolloutResponse{
+		Name:         req.Name,
+		Version:      version,
+		Steps:        steps,
+		TotalBytes:   total,
+		PlannedBytes: planned,
+	}, nil
+}
+
+// downloadCost falls back to a full download when the node's current version has
+// no chunk index in the Hub (e.g. it was side-loaded or never chunked).
+func (s *SoftwareServer) downloadCost(name, rtype, from, to string) (uint64, error) {
+	if from != "" {
+		d, err := s.hub.GetDelta(name, rtype, from, to)
+		if err == nil {
+			return uint64(d.NewBytes), nil
+		}
+		log.Warnf("delta %s %s -> %s unavailable, assuming full download: %v", name, from, to, err)
+	}
+
+	d, err := s.hub.GetDelta(name, rtype, "", to)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(d.NewBytes), nil
+}
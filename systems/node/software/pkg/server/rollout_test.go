@@ -0,0 +1,99 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cmocks "github.com/ukama/ukama/systems/common/mocks"
+	hubclient "github.com/ukama/ukama/systems/common/rest/client/hub"
+	"github.com/ukama/ukama/systems/common/ukama"
+	"github.com/ukama/ukama/systems/node/software/mocks"
+	pb "github.com/ukama/ukama/systems/node/software/pb/gen"
+	"github.com/ukama/ukama/systems/node/software/pkg/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testRolloutNodeA = "uk-sa2156-hnode-a1-aaaa"
+	testRolloutNodeB = "uk-sa2156-hnode-a1-bbbb"
+	testRolloutNodeC = "uk-sa2156-hnode-a1-cccc"
+)
+
+func newRolloutTestServer(sRepo *mocks.SoftwareRepo, releaseRepo *mocks.ReleaseRepo, hub *cmocks.HubClient) *SoftwareServer {
+	return NewSoftwareServer(testOrgName, sRepo, nil, nil, releaseRepo, hub, nil, nil, false, []string{testNodeGwIP},
+		nil, nil, 0, 0, 0)
+}
+
+func TestPlanRollout(t *testing.T) {
+	rows := []*db.Software{
+		{NodeId: testRolloutNodeA, CurrentVersion: "1.0.0"},
+		{NodeId: testRolloutNodeB, CurrentVersion: "1.1.0"},
+		{NodeId: testRolloutNodeC, CurrentVersion: "1.2.0"},
+	}
+
+	t.Run("OrdersCheapestFirstWithinBudget", func(t *testing.T) {
+		sRepo := &mocks.SoftwareRepo{}
+		hub := &cmocks.HubClient{}
+		sRepo.On("List", "", ukama.Unknown, testAppName).Return(rows, nil).Once()
+		hub.On("GetDelta", testAppName, "app", "1.0.0", "1.2.0").Return(&hubclient.Delta{NewBytes: 500}, nil).Once()
+		hub.On("GetDelta", testAppName, "app", "1.1.0", "1.2.0").Return(&hubclient.Delta{NewBytes: 100}, nil).Once()
+		s := newRolloutTestServer(sRepo, nil, hub)
+
+		resp, err := s.PlanRollout(context.Background(), &pb.PlanRolloutRequest{
+			Name: testAppName, Version: "1.2.0", BudgetBytes: 300,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Steps, 2)
+		assert.Equal(t, testRolloutNodeB, resp.Steps[0].NodeId)
+		assert.True(t, resp.Steps[0].WithinBudget)
+		assert.Equal(t, testRolloutNodeA, resp.Steps[1].NodeId)
+		assert.Equal(t, uint64(600), resp.Steps[1].CumulativeBytes)
+		assert.False(t, resp.Steps[1].WithinBudget)
+		assert.Equal(t, uint64(600), resp.TotalBytes)
+		assert.Equal(t, uint64(100), resp.PlannedBytes)
+		sRepo.AssertExpectations(t)
+		hub.AssertExpectations(t)
+	})
+
+	t.Run("FallsBackToFullDownload", func(t *testing.T) {
+		sRepo := &mocks.SoftwareRepo{}
+		hub := &cmocks.HubClient{}
+		releaseRepo := &mocks.ReleaseRepo{}
+		releaseRepo.On("GetDesired", testAppName, "app").Return(&db.AppDesiredRelease{DesiredVersion: "1.2.0"}, nil).Once()
+		sRepo.On("List", "", ukama.Unknown, testAppName).Return(rows[:1], nil).Once()
+		hub.On("GetDelta", testAppName, "app", "1.0.0", "1.2.0").Return(nil, errors.New("index missing")).Once()
+		hub.On("GetDelta", testAppName, "app", "", "1.2.0").Return(&hubclient.Delta{NewBytes: 900}, nil).Once()
+		s := newRolloutTestServer(sRepo, releaseRepo, hub)
+
+		resp, err := s.PlanRollout(context.Background(), &pb.PlanRolloutRequest{Name: testAppName})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Steps, 1)
+		assert.Equal(t, "1.2.0", resp.Version)
+		assert.Equal(t, uint64(900), resp.Steps[0].DownloadBytes)
+		assert.True(t, resp.Steps[0].WithinBudget)
+		hub.AssertExpectations(t)
+	})
+
+	t.Run("HubNotConfigured", func(t *testing.T) {
+		s := newRolloutTestServer(&mocks.SoftwareRepo{}, nil, nil)
+		s.hub = nil
+
+		_, err := s.PlanRollout(context.Background(), &pb.PlanRolloutRequest{Name: testAppName, Version: "1.2.0"})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
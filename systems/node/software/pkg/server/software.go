@@ -54,9 +54,10 @@ type SoftwareServer struct {
 	opMonitor            swclient.OperationMonitor
 	opLeaseSecs          uint32
 	opDeadlineSecs       uint32
+	opWaitSecs           uint32
 }
 
-func NewSoftwareServer(orgName string, sRepo db.SoftwareRepo, appRepo db.AppRepo, nodeRepo db.NodeRepo, releaseRepo db.ReleaseRepo, hub hubclient.HubClient, healthClient providers.HealthClientProvider, msgBus mb.MsgBusServiceClient, debug bool, nodeGwIP []string, opMgr copr.ManagerClient, opMon swclient.OperationMonitor, leaseSecs, deadlineSecs uint32) *SoftwareServer {
+func NewSoftwareServer(orgName string, sRepo db.SoftwareRepo, appRepo db.AppRepo, nodeRepo db.NodeRepo, releaseRepo db.ReleaseRepo, hub hubclient.HubClient, healthClient providers.HealthClientProvider, msgBus mb.MsgBusServiceClient, debug bool, nodeGwIP []string, opMgr copr.ManagerClient, opMon swclient.OperationMonitor, leaseSecs, deadlineSecs, waitSecs uint32) *SoftwareServer {
 	return &SoftwareServer{
 		sRepo:                sRepo,
 		debug:                debug,
@@ -73,6 +74,7 @@ func NewSoftwareServer(orgName string, sRepo db.SoftwareRepo, appRepo db.AppRepo
 		opMonitor:            opMon,
 		opLeaseSecs:          leaseSecs,
 		opDeadlineSecs:       deadlineSecs,
+		opWaitSecs:           waitSecs,
 	}
 }
 
@@ -229,29 +231,36 @@ func (s *SoftwareServer) GetReleaseCatalog(ctx context.Context, req *pb.GetRelea
 	return &pb.GetReleaseCatalogResponse{Releases: out}, nil
 }
 
-func (s *SoftwareServer) acquireAndRegister(actionType, resourceKey string) (*copr.OperationInfo, error) {
+func (s *SoftwareServer) acquireAndRegister(actionType, resourceKey, completionRule string) (*copr.OperationInfo, error) {
 	if s.opManager == nil || s.opMonitor == nil {
 		log.Warnf("%s running without operation manager/monitor for %s", actionType, resourceKey)
 		return &copr.OperationInfo{Id: "", ResourceKey: resourceKey}, nil
 	}
 
+	/* A busy node queues the update and the watch tells when it holds the lock */
 	startResp, err := s.opManager.Start(copr.StartRequest{
 		Type:         actionType,
 		System:       "node",
 		ResourceKey:  resourceKey,
 		RequestedBy:  pkg.ServiceName,
 		LeaseSeconds: s.opLeaseSecs,
+		Queue:        s.opWaitSecs > 0,
 	})
 	if err != nil {
 		log.Warnf("%s lock acquire for %s rejected: %v", actionType, resourceKey, err)
 		return nil, err
 	}
-	op := startResp.Operation
+	op, err := copr.AwaitLock(s.opManager, startResp.Operation, time.Duration(s.opWaitSecs)*time.Second, pkg.ServiceName)
+	if err != nil {
+		log.Warnf("%s lock acquire for %s failed: %v", actionType, resourceKey, err)
+		return nil, status.Errorf(codes.Aborted, "resource %s busy: %v", resourceKey, err)
+	}
 	if _, err := s.opMonitor.Register(&opmonpb.RegisterIntentRequest{
 		OperationId:     op.Id,
 		ResourceKey:     resourceKey,
 		ActionType:      actionType,
 		FencingToken:    op.FencingToken,
+		CompletionRule:  completionRule,
 		DeadlineSeconds: s.opDeadlineSecs,
 	}); err != nil {
 		log.Errorf("%s register intent for op %s failed: %v", actionType, op.Id, err)
@@ -395,7 +404,7 @@ func (s *SoftwareServer) UpdateSoftware(ctx context.Context, req *pb.UpdateSoftw
 		return nil, status.Errorf(codes.Internal, "failed to marshal update request: %v", err)
 	}
 
-	op, err := s.acquireAndRegister("UpdateSoftware", "node:"+nId.String())
+	op, err := s.acquireAndRegister("UpdateSoftware", "node:"+nId.String(), updateRule(req.Name, req.Tag))
 	if err != nil {
 		return nil, err
 	}
@@ -545,3 +554,10 @@ func (c *SoftwareServer) publishMessage(target string, method string, path strin
 	err := c.msgbus.PublishRequest(route, msg)
 	return err
 }
+
+// updateRule is done once the node's health report carries the new tag for
+// the app. Versions are bounded rather than compared with =, which is exact,
+// so that a node reporting v1.2.0 completes an update to 1.2.0.
+func updateRule(app, tag string) string {
+	return fmt.Sprintf("app.%[1]s.version>=%[2]q AND app.%[1]s.version<=%[2]q", app, tag)
+}
@@ -20,8 +20,10 @@ import (
 	"github.com/stretchr/testify/require"
 	mbmocks "github.com/ukama/ukama/systems/common/mocks"
 	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	copr "github.com/ukama/ukama/systems/common/rest/client/operation"
 	"github.com/ukama/ukama/systems/common/ukama"
 	uuid "github.com/ukama/ukama/systems/common/uuid"
+	opmonpb "github.com/ukama/ukama/systems/node/operation-monitor/pb/gen"
 	"github.com/ukama/ukama/systems/node/software/mocks"
 	pb "github.com/ukama/ukama/systems/node/software/pb/gen"
 	"github.com/ukama/ukama/systems/node/software/pkg/db"
@@ -69,6 +71,7 @@ func newTestServer(sRepo *mocks.SoftwareRepo, appRepo *mocks.AppRepo, nodeRepo *
 		nil,
 		0,
 		0,
+		0,
 	)
 }
 
@@ -496,3 +499,36 @@ func TestUpdateSoftware(t *testing.T) {
 		msgBus.AssertExpectations(t)
 	})
 }
+
+func TestAcquireAndRegister_QueuedBehindBusyNode(t *testing.T) {
+	opMgr := &mbmocks.ManagerClient{}
+	opMon := &mocks.OperationMonitor{}
+	resourceKey := "node:" + testNodeIdNormalized
+
+	s := NewSoftwareServer(testOrgName, nil, nil, nil, nil, nil, nil, nil, false, []string{testNodeGwIP},
+		opMgr, opMon, 1800, 1800, 30)
+
+	queued := &copr.OperationInfo{Id: "op-1", ResourceKey: resourceKey, Status: copr.StatusQueued}
+	opMgr.On("Start", mock.MatchedBy(func(req copr.StartRequest) bool {
+		return req.ResourceKey == resourceKey && req.Queue
+	})).Return(&copr.StartResponse{Operation: queued, QueuePosition: 2}, nil).Once()
+	opMgr.On("Watch", "op-1", 30*time.Second, mock.Anything).Run(func(args mock.Arguments) {
+		fn := args.Get(2).(func(*copr.WatchEvent) bool)
+		fn(&copr.WatchEvent{Operation: &copr.OperationInfo{Id: "op-1", FencingToken: 7, ResourceKey: resourceKey,
+			Status: copr.StatusPending}})
+	}).Return(nil).Once()
+	opMon.On("Register", mock.MatchedBy(func(req *opmonpb.RegisterIntentRequest) bool {
+		return req.OperationId == "op-1" && req.FencingToken == 7
+	})).Return(&opmonpb.RegisterIntentResponse{}, nil).Once()
+
+	op, err := s.acquireAndRegister("UpdateSoftware", resourceKey, "rule")
+
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), op.FencingToken)
+	opMgr.AssertExpectations(t)
+	opMon.AssertExpectations(t)
+}
+
+func TestUpdateRule(t *testing.T) {
+	assert.Equal(t, `app.epc.version>="1.2.0" AND app.epc.version<="1.2.0"`, updateRule("epc", testTagVersion))
+}
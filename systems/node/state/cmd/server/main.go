@@ -26,17 +26,23 @@ import (
 	egenerated "github.com/ukama/ukama/systems/common/pb/gen/events"
 	pb "github.com/ukama/ukama/systems/node/state/pb/gen"
 
+	"github.com/ukama/ukama/systems/node/state/pkg/analytics"
 	"github.com/ukama/ukama/systems/node/state/pkg/db"
 
 	log "github.com/sirupsen/logrus"
 	ccmd "github.com/ukama/ukama/systems/common/cmd"
 	ugrpc "github.com/ukama/ukama/systems/common/grpc"
+	"github.com/ukama/ukama/systems/common/rest/client"
+	ic "github.com/ukama/ukama/systems/common/rest/client/initclient"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
 	"github.com/ukama/ukama/systems/common/uuid"
 	"google.golang.org/grpc"
 )
 
 var svcConf *pkg.Config
 
+const registrySystemName = "registry"
+
 func main() {
 	ccmd.ProcessVersionArgument(pkg.ServiceName, os.Args, version.Version)
 	pkg.InstanceId = os.Getenv("POD_NAME")
@@ -80,9 +86,16 @@ func runGrpcServer(gormdb sql.Db) {
 	mbClient := mb.NewMsgBusClient(svcConf.MsgClient.Timeout, svcConf.OrgName, pkg.SystemName, pkg.ServiceName, instanceId, svcConf.Queue.Uri, svcConf.Service.Uri, svcConf.MsgClient.Host, svcConf.MsgClient.Exchange, svcConf.MsgClient.ListenQueue, svcConf.MsgClient.PublishQueue, svcConf.MsgClient.RetryCount, svcConf.MsgClient.ListenerRoutes)
 	log.Debugf("MessageBus Client is %+v", mbClient)
 
+	regUrl, err := ic.GetHostAddress(ic.NewInitClient(svcConf.Http.InitClient, client.WithDebug(svcConf.DebugMode)),
+		ic.CreateHostString(svcConf.OrgName, registrySystemName), &svcConf.OrgName)
+	if err != nil {
+		log.Errorf("Failed to resolve registry address: %v", err)
+	}
+
 	Server := server.NewStateServer(svcConf.OrgName, svcConf.OrgId, db.NewStateRepo(gormdb),
-		mbClient)
-	stateEventServer := server.NewStateEventServer(svcConf.OrgName, svcConf.OrgId, Server, svcConf.ConfigPath, mbClient)
+		mbClient, creg.NewNodeClient(regUrl.String()))
+	stateEventServer := server.NewStateEventServer(svcConf.OrgName, svcConf.OrgId, Server, svcConf.ConfigPath, mbClient,
+		analytics.FlapConfig{Window: svcConf.FlapWindow, Threshold: svcConf.FlapThreshold})
 
 	grpcServer := ugrpc.NewGrpcServer(*svcConf.Grpc, func(s *grpc.Server) {
 		pb.RegisterStateServiceServer(s, Server)
@@ -92,6 +105,9 @@ func runGrpcServer(gormdb sql.Db) {
 	grpcServer.RegisterDependency("db", true, ugrpc.DBCheck(gormdb))
 	grpcServer.RegisterDependency("msgclient", true, ugrpc.MsgClientCheck(svcConf.MsgClient.Host))
 
+	ic.StartHeartbeat(ic.NewInitClient(svcConf.Http.InitClient, client.WithDebug(svcConf.DebugMode)),
+		svcConf.OrgName, pkg.SystemName, version.Version, ic.DefaultHeartbeatInterval, nil)
+
 	go grpcServer.StartServer()
 
 	go msgBusListener(mbClient)
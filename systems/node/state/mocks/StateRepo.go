@@ -94,6 +94,36 @@ func (_m *StateRepo) GetNodeConfig(nodeId string) (*db.NodeConfig, error) {
 	return r0, r1
 }
 
+// GetStateBefore provides a mock function with given fields: nodeId, t
+func (_m *StateRepo) GetStateBefore(nodeId string, t time.Time) (*db.State, error) {
+	ret := _m.Called(nodeId, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStateBefore")
+	}
+
+	var r0 *db.State
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (*db.State, error)); ok {
+		return rf(nodeId, t)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) *db.State); ok {
+		r0 = rf(nodeId, t)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.State)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(nodeId, t)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStateById provides a mock function with given fields: id
 func (_m *StateRepo) GetStateById(id uuid.UUID) (*db.State, error) {
 	ret := _m.Called(id)
@@ -244,6 +274,36 @@ func (_m *StateRepo) ListLatestStates() ([]db.State, error) {
 	return r0, r1
 }
 
+// ListTransitions provides a mock function with given fields: nodeId, from, to
+func (_m *StateRepo) ListTransitions(nodeId string, from time.Time, to time.Time) ([]db.State, error) {
+	ret := _m.Called(nodeId, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTransitions")
+	}
+
+	var r0 []db.State
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time, time.Time) ([]db.State, error)); ok {
+		return rf(nodeId, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time, time.Time) []db.State); ok {
+		r0 = rf(nodeId, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.State)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time, time.Time) error); ok {
+		r1 = rf(nodeId, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetLatchedEvent provides a mock function with given fields: nodeId, event
 func (_m *StateRepo) SetLatchedEvent(nodeId string, event string) error {
 	ret := _m.Called(nodeId, event)
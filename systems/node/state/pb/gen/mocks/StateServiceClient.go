@@ -127,6 +127,80 @@ func (_m *StateServiceClient) GetLatestState(ctx context.Context, in *gen.GetLat
 	return r0, r1
 }
 
+// GetNodeStateAnalytics provides a mock function with given fields: ctx, in, opts
+func (_m *StateServiceClient) GetNodeStateAnalytics(ctx context.Context, in *gen.GetNodeStateAnalyticsRequest, opts ...grpc.CallOption) (*gen.GetNodeStateAnalyticsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNodeStateAnalytics")
+	}
+
+	var r0 *gen.GetNodeStateAnalyticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetNodeStateAnalyticsRequest, ...grpc.CallOption) (*gen.GetNodeStateAnalyticsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetNodeStateAnalyticsRequest, ...grpc.CallOption) *gen.GetNodeStateAnalyticsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetNodeStateAnalyticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetNodeStateAnalyticsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSiteStateAnalytics provides a mock function with given fields: ctx, in, opts
+func (_m *StateServiceClient) GetSiteStateAnalytics(ctx context.Context, in *gen.GetSiteStateAnalyticsRequest, opts ...grpc.CallOption) (*gen.GetSiteStateAnalyticsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSiteStateAnalytics")
+	}
+
+	var r0 *gen.GetSiteStateAnalyticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetSiteStateAnalyticsRequest, ...grpc.CallOption) (*gen.GetSiteStateAnalyticsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetSiteStateAnalyticsRequest, ...grpc.CallOption) *gen.GetSiteStateAnalyticsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetSiteStateAnalyticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetSiteStateAnalyticsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStateById provides a mock function with given fields: ctx, in, opts
 func (_m *StateServiceClient) GetStateById(ctx context.Context, in *gen.GetStateByIdRequest, opts ...grpc.CallOption) (*gen.GetStateByIdResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -104,6 +104,66 @@ func (_m *StateServiceServer) GetLatestState(_a0 context.Context, _a1 *gen.GetLa
 	return r0, r1
 }
 
+// GetNodeStateAnalytics provides a mock function with given fields: _a0, _a1
+func (_m *StateServiceServer) GetNodeStateAnalytics(_a0 context.Context, _a1 *gen.GetNodeStateAnalyticsRequest) (*gen.GetNodeStateAnalyticsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNodeStateAnalytics")
+	}
+
+	var r0 *gen.GetNodeStateAnalyticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetNodeStateAnalyticsRequest) (*gen.GetNodeStateAnalyticsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetNodeStateAnalyticsRequest) *gen.GetNodeStateAnalyticsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetNodeStateAnalyticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetNodeStateAnalyticsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSiteStateAnalytics provides a mock function with given fields: _a0, _a1
+func (_m *StateServiceServer) GetSiteStateAnalytics(_a0 context.Context, _a1 *gen.GetSiteStateAnalyticsRequest) (*gen.GetSiteStateAnalyticsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSiteStateAnalytics")
+	}
+
+	var r0 *gen.GetSiteStateAnalyticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetSiteStateAnalyticsRequest) (*gen.GetSiteStateAnalyticsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetSiteStateAnalyticsRequest) *gen.GetSiteStateAnalyticsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetSiteStateAnalyticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetSiteStateAnalyticsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStateById provides a mock function with given fields: _a0, _a1
 func (_m *StateServiceServer) GetStateById(_a0 context.Context, _a1 *gen.GetStateByIdRequest) (*gen.GetStateByIdResponse, error) {
 	ret := _m.Called(_a0, _a1)
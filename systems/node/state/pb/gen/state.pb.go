@@ -7,8 +7,8 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.35.1
-// 	protoc        v5.28.2
+// 	protoc-gen-go v1.36.12
+// 	protoc        v6.32.1
 // source: state.proto
 
 package gen
@@ -22,6 +22,7 @@ import (
 	_ "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -32,12 +33,11 @@ const (
 )
 
 type EnforceStateTransitionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	Event  string `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EnforceStateTransitionRequest) Reset() {
@@ -85,9 +85,9 @@ func (x *EnforceStateTransitionRequest) GetEvent() string {
 }
 
 type EnforceStateTransitionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EnforceStateTransitionResponse) Reset() {
@@ -121,15 +121,14 @@ func (*EnforceStateTransitionResponse) Descriptor() ([]byte, []int) {
 }
 
 type GetStatesHistoryRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	StartTime     string                 `protobuf:"bytes,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime       string                 `protobuf:"bytes,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageNumber    int32                  `protobuf:"varint,5,opt,name=PageNumber,proto3" json:"PageNumber,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId     string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	StartTime  string `protobuf:"bytes,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	EndTime    string `protobuf:"bytes,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
-	PageSize   int32  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
-	PageNumber int32  `protobuf:"varint,5,opt,name=PageNumber,proto3" json:"PageNumber,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStatesHistoryRequest) Reset() {
@@ -198,12 +197,11 @@ func (x *GetStatesHistoryRequest) GetPageNumber() int32 {
 }
 
 type GetStatesHistoryResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	States        []*State               `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+	NodeConfig    *NodeConfig            `protobuf:"bytes,2,opt,name=nodeConfig,proto3" json:"nodeConfig,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	States     []*State    `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
-	NodeConfig *NodeConfig `protobuf:"bytes,2,opt,name=nodeConfig,proto3" json:"nodeConfig,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStatesHistoryResponse) Reset() {
@@ -251,10 +249,7 @@ func (x *GetStatesHistoryResponse) GetNodeConfig() *NodeConfig {
 }
 
 type State struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	NodeId          string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
 	PreviousStateId string                 `protobuf:"bytes,3,opt,name=previousStateId,proto3" json:"previousStateId,omitempty"`
@@ -266,6 +261,8 @@ type State struct {
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 	DeletedAt       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *State) Reset() {
@@ -376,13 +373,12 @@ func (x *State) GetDeletedAt() *timestamppb.Timestamp {
 }
 
 type UpdateStateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	SubState      []string               `protobuf:"bytes,2,rep,name=subState,proto3" json:"subState,omitempty"`
+	Events        []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId   string   `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	SubState []string `protobuf:"bytes,2,rep,name=subState,proto3" json:"subState,omitempty"`
-	Events   []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateStateRequest) Reset() {
@@ -437,11 +433,10 @@ func (x *UpdateStateRequest) GetEvents() []string {
 }
 
 type UpdateStateResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedState  *State                 `protobuf:"bytes,1,opt,name=updatedState,proto3" json:"updatedState,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	UpdatedState *State `protobuf:"bytes,1,opt,name=updatedState,proto3" json:"updatedState,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateStateResponse) Reset() {
@@ -482,21 +477,20 @@ func (x *UpdateStateResponse) GetUpdatedState() *State {
 }
 
 type AddStateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	NodeId          string          `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	PreviousStateId string          `protobuf:"bytes,2,opt,name=previousStateId,proto3" json:"previousStateId,omitempty"`
-	CurrentState    ukama.NodeState `protobuf:"varint,3,opt,name=currentState,proto3,enum=ukama.common.v1.NodeState" json:"currentState,omitempty"`
-	SubState        []string        `protobuf:"bytes,4,rep,name=subState,proto3" json:"subState,omitempty"`
-	Events          []string        `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`
-	NodeIp          string          `protobuf:"bytes,6,opt,name=nodeIp,proto3" json:"nodeIp,omitempty"`
-	NodePort        int32           `protobuf:"varint,8,opt,name=nodePort,proto3" json:"nodePort,omitempty"`
-	MeshIp          string          `protobuf:"bytes,9,opt,name=meshIp,proto3" json:"meshIp,omitempty"`
-	MeshPort        int32           `protobuf:"varint,10,opt,name=meshPort,proto3" json:"meshPort,omitempty"`
-	MeshHostName    string          `protobuf:"bytes,11,opt,name=meshHostName,proto3" json:"meshHostName,omitempty"`
-	NodeType        string          `protobuf:"bytes,12,opt,name=nodeType,proto3" json:"nodeType,omitempty"`
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NodeId          string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	PreviousStateId string                 `protobuf:"bytes,2,opt,name=previousStateId,proto3" json:"previousStateId,omitempty"`
+	CurrentState    ukama.NodeState        `protobuf:"varint,3,opt,name=currentState,proto3,enum=ukama.common.v1.NodeState" json:"currentState,omitempty"`
+	SubState        []string               `protobuf:"bytes,4,rep,name=subState,proto3" json:"subState,omitempty"`
+	Events          []string               `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`
+	NodeIp          string                 `protobuf:"bytes,6,opt,name=nodeIp,proto3" json:"nodeIp,omitempty"`
+	NodePort        int32                  `protobuf:"varint,8,opt,name=nodePort,proto3" json:"nodePort,omitempty"`
+	MeshIp          string                 `protobuf:"bytes,9,opt,name=meshIp,proto3" json:"meshIp,omitempty"`
+	MeshPort        int32                  `protobuf:"varint,10,opt,name=meshPort,proto3" json:"meshPort,omitempty"`
+	MeshHostName    string                 `protobuf:"bytes,11,opt,name=meshHostName,proto3" json:"meshHostName,omitempty"`
+	NodeType        string                 `protobuf:"bytes,12,opt,name=nodeType,proto3" json:"nodeType,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AddStateRequest) Reset() {
@@ -607,11 +601,10 @@ func (x *AddStateRequest) GetNodeType() string {
 }
 
 type AddStateResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddStateResponse) Reset() {
@@ -652,11 +645,10 @@ func (x *AddStateResponse) GetId() string {
 }
 
 type GetStateByIdRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStateByIdRequest) Reset() {
@@ -697,11 +689,10 @@ func (x *GetStateByIdRequest) GetId() string {
 }
 
 type GetStateByIdResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *State                 `protobuf:"bytes,1,opt,name=State,proto3" json:"State,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	State *State `protobuf:"bytes,1,opt,name=State,proto3" json:"State,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStateByIdResponse) Reset() {
@@ -742,11 +733,10 @@ func (x *GetStateByIdResponse) GetState() *State {
 }
 
 type GetStateCurrentRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStateCurrentRequest) Reset() {
@@ -787,11 +777,10 @@ func (x *GetStateCurrentRequest) GetNodeId() string {
 }
 
 type GetCurrentStateResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *State                 `protobuf:"bytes,1,opt,name=State,proto3" json:"State,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	State *State `protobuf:"bytes,1,opt,name=State,proto3" json:"State,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetCurrentStateResponse) Reset() {
@@ -832,11 +821,10 @@ func (x *GetCurrentStateResponse) GetState() *State {
 }
 
 type GetStatesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStatesRequest) Reset() {
@@ -877,20 +865,19 @@ func (x *GetStatesRequest) GetNodeId() string {
 }
 
 type NodeConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	NodeIp        string                 `protobuf:"bytes,3,opt,name=nodeIp,proto3" json:"nodeIp,omitempty"`
+	NodePort      int32                  `protobuf:"varint,4,opt,name=nodePort,proto3" json:"nodePort,omitempty"`
+	MeshIp        string                 `protobuf:"bytes,5,opt,name=meshIp,proto3" json:"meshIp,omitempty"`
+	MeshPort      int32                  `protobuf:"varint,6,opt,name=meshPort,proto3" json:"meshPort,omitempty"`
+	MeshHostName  string                 `protobuf:"bytes,7,opt,name=meshHostName,proto3" json:"meshHostName,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	NodeId       string                 `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
-	NodeIp       string                 `protobuf:"bytes,3,opt,name=nodeIp,proto3" json:"nodeIp,omitempty"`
-	NodePort     int32                  `protobuf:"varint,4,opt,name=nodePort,proto3" json:"nodePort,omitempty"`
-	MeshIp       string                 `protobuf:"bytes,5,opt,name=meshIp,proto3" json:"meshIp,omitempty"`
-	MeshPort     int32                  `protobuf:"varint,6,opt,name=meshPort,proto3" json:"meshPort,omitempty"`
-	MeshHostName string                 `protobuf:"bytes,7,opt,name=meshHostName,proto3" json:"meshHostName,omitempty"`
-	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	DeletedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NodeConfig) Reset() {
@@ -994,12 +981,11 @@ func (x *NodeConfig) GetDeletedAt() *timestamppb.Timestamp {
 }
 
 type GetStatesResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	States        []*State               `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+	NodeConfig    *NodeConfig            `protobuf:"bytes,2,opt,name=nodeConfig,proto3" json:"nodeConfig,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	States     []*State    `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
-	NodeConfig *NodeConfig `protobuf:"bytes,2,opt,name=nodeConfig,proto3" json:"nodeConfig,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStatesResponse) Reset() {
@@ -1047,11 +1033,10 @@ func (x *GetStatesResponse) GetNodeConfig() *NodeConfig {
 }
 
 type GetLatestStateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	NodeId string `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetLatestStateRequest) Reset() {
@@ -1092,11 +1077,10 @@ func (x *GetLatestStateRequest) GetNodeId() string {
 }
 
 type GetLatestStateResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *State                 `protobuf:"bytes,1,opt,name=State,proto3" json:"State,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	State *State `protobuf:"bytes,1,opt,name=State,proto3" json:"State,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetLatestStateResponse) Reset() {
@@ -1137,9 +1121,9 @@ func (x *GetLatestStateResponse) GetState() *State {
 }
 
 type SubState struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubState) Reset() {
@@ -1172,235 +1156,618 @@ func (*SubState) Descriptor() ([]byte, []int) {
 	return file_state_proto_rawDescGZIP(), []int{18}
 }
 
-var File_state_proto protoreflect.FileDescriptor
+type StateDuration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         ukama.NodeState        `protobuf:"varint,1,opt,name=state,proto3,enum=ukama.common.v1.NodeState" json:"state,omitempty"`
+	Seconds       uint64                 `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StateDuration) Reset() {
+	*x = StateDuration{}
+	mi := &file_state_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateDuration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateDuration) ProtoMessage() {}
+
+func (x *StateDuration) ProtoReflect() protoreflect.Message {
+	mi := &file_state_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateDuration.ProtoReflect.Descriptor instead.
+func (*StateDuration) Descriptor() ([]byte, []int) {
+	return file_state_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StateDuration) GetState() ukama.NodeState {
+	if x != nil {
+		return x.State
+	}
+	return ukama.NodeState(0)
+}
+
+func (x *StateDuration) GetSeconds() uint64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+// A failure is a node entering Faulty. MTBF is the time spent Operational per
+// failure and MTTR the mean length of the faulty periods that recovered; both
+// are 0 when there is nothing to average.
+type StateAnalytics struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	NodeId                 string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	TimeInState            []*StateDuration       `protobuf:"bytes,2,rep,name=timeInState,proto3" json:"timeInState,omitempty"`
+	Transitions            uint32                 `protobuf:"varint,3,opt,name=transitions,proto3" json:"transitions,omitempty"`
+	Failures               uint32                 `protobuf:"varint,4,opt,name=failures,proto3" json:"failures,omitempty"`
+	Recoveries             uint32                 `protobuf:"varint,5,opt,name=recoveries,proto3" json:"recoveries,omitempty"`
+	MtbfSeconds            uint64                 `protobuf:"varint,6,opt,name=mtbfSeconds,proto3" json:"mtbfSeconds,omitempty"`
+	MttrSeconds            uint64                 `protobuf:"varint,7,opt,name=mttrSeconds,proto3" json:"mttrSeconds,omitempty"`
+	MaxTransitionsInWindow uint32                 `protobuf:"varint,8,opt,name=maxTransitionsInWindow,proto3" json:"maxTransitionsInWindow,omitempty"`
+	Flapping               bool                   `protobuf:"varint,9,opt,name=flapping,proto3" json:"flapping,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *StateAnalytics) Reset() {
+	*x = StateAnalytics{}
+	mi := &file_state_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateAnalytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateAnalytics) ProtoMessage() {}
+
+func (x *StateAnalytics) ProtoReflect() protoreflect.Message {
+	mi := &file_state_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateAnalytics.ProtoReflect.Descriptor instead.
+func (*StateAnalytics) Descriptor() ([]byte, []int) {
+	return file_state_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StateAnalytics) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *StateAnalytics) GetTimeInState() []*StateDuration {
+	if x != nil {
+		return x.TimeInState
+	}
+	return nil
+}
+
+func (x *StateAnalytics) GetTransitions() uint32 {
+	if x != nil {
+		return x.Transitions
+	}
+	return 0
+}
+
+func (x *StateAnalytics) GetFailures() uint32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *StateAnalytics) GetRecoveries() uint32 {
+	if x != nil {
+		return x.Recoveries
+	}
+	return 0
+}
+
+func (x *StateAnalytics) GetMtbfSeconds() uint64 {
+	if x != nil {
+		return x.MtbfSeconds
+	}
+	return 0
+}
+
+func (x *StateAnalytics) GetMttrSeconds() uint64 {
+	if x != nil {
+		return x.MttrSeconds
+	}
+	return 0
+}
+
+func (x *StateAnalytics) GetMaxTransitionsInWindow() uint32 {
+	if x != nil {
+		return x.MaxTransitionsInWindow
+	}
+	return 0
+}
+
+func (x *StateAnalytics) GetFlapping() bool {
+	if x != nil {
+		return x.Flapping
+	}
+	return false
+}
+
+// from defaults to 7 days before to, to defaults to now. A node flaps with
+// flapThreshold transitions within flapWindowSec.
+type GetNodeStateAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	FlapWindowSec uint32                 `protobuf:"varint,4,opt,name=flapWindowSec,proto3" json:"flapWindowSec,omitempty"`
+	FlapThreshold uint32                 `protobuf:"varint,5,opt,name=flapThreshold,proto3" json:"flapThreshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeStateAnalyticsRequest) Reset() {
+	*x = GetNodeStateAnalyticsRequest{}
+	mi := &file_state_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeStateAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeStateAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetNodeStateAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_state_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeStateAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeStateAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_state_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetNodeStateAnalyticsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *GetNodeStateAnalyticsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetNodeStateAnalyticsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetNodeStateAnalyticsRequest) GetFlapWindowSec() uint32 {
+	if x != nil {
+		return x.FlapWindowSec
+	}
+	return 0
+}
+
+func (x *GetNodeStateAnalyticsRequest) GetFlapThreshold() uint32 {
+	if x != nil {
+		return x.FlapThreshold
+	}
+	return 0
+}
+
+type GetNodeStateAnalyticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Analytics     *StateAnalytics        `protobuf:"bytes,1,opt,name=analytics,proto3" json:"analytics,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeStateAnalyticsResponse) Reset() {
+	*x = GetNodeStateAnalyticsResponse{}
+	mi := &file_state_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeStateAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeStateAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetNodeStateAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_state_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeStateAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeStateAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_state_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetNodeStateAnalyticsResponse) GetAnalytics() *StateAnalytics {
+	if x != nil {
+		return x.Analytics
+	}
+	return nil
+}
+
+func (x *GetNodeStateAnalyticsResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetNodeStateAnalyticsResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetSiteStateAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	FlapWindowSec uint32                 `protobuf:"varint,4,opt,name=flapWindowSec,proto3" json:"flapWindowSec,omitempty"`
+	FlapThreshold uint32                 `protobuf:"varint,5,opt,name=flapThreshold,proto3" json:"flapThreshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteStateAnalyticsRequest) Reset() {
+	*x = GetSiteStateAnalyticsRequest{}
+	mi := &file_state_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteStateAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteStateAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetSiteStateAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_state_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteStateAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetSiteStateAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_state_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetSiteStateAnalyticsRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *GetSiteStateAnalyticsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetSiteStateAnalyticsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
 
-var file_state_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x75,
-	0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
-	0x76, 0x31, 0x1a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x6e, 0x6f, 0x64, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4d, 0x0a, 0x1d, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x20, 0x0a, 0x1e, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64,
-	0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x50, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
-	0x8f, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75,
-	0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73,
-	0x12, 0x3f, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64,
-	0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x22, 0xd9, 0x03, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e,
-	0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64,
-	0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72,
-	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x40, 0x0a,
-	0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64,
-	0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x3e, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x60, 0x0a,
-	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
-	0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73,
-	0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22,
-	0x55, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75,
-	0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0xef, 0x02, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f,
-	0x64, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
-	0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x65,
-	0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0c,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65,
-	0x50, 0x6f, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65,
-	0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x49, 0x70, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x49, 0x70, 0x12, 0x1a, 0x0a, 0x08,
-	0x6d, 0x65, 0x73, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x6d, 0x65, 0x73, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x68,
-	0x48, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x6d, 0x65, 0x73, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x22, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x25, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42,
-	0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x6b, 0x61,
-	0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x30, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22,
-	0x4b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x6b, 0x61, 0x6d,
-	0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x2a, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0xee, 0x02, 0x0a, 0x0a, 0x4e, 0x6f, 0x64,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x50,
-	0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x50,
-	0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x49, 0x70, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x49, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
-	0x65, 0x73, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d,
-	0x65, 0x73, 0x68, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x68, 0x48,
-	0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d,
-	0x65, 0x73, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x38, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e,
-	0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x22, 0x2f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
-	0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65,
-	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x30, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x22, 0x0a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x32, 0xea, 0x05,
-	0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57,
-	0x0a, 0x08, 0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x75, 0x6b, 0x61,
-	0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x28, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e,
-	0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x29, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x09,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x75, 0x6b, 0x61, 0x6d,
-	0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c,
-	0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x75, 0x6b, 0x61,
-	0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e,
-	0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x27, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x75, 0x6b,
-	0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x75, 0x6b, 0x61, 0x6d,
-	0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e,
-	0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x16, 0x45, 0x6e, 0x66, 0x6f, 0x72,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x32, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6e, 0x6f,
-	0x64, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x66, 0x6f,
-	0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x75,
-	0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x6e, 0x6f, 0x64,
-	0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x67, 0x65, 0x6e, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *GetSiteStateAnalyticsRequest) GetFlapWindowSec() uint32 {
+	if x != nil {
+		return x.FlapWindowSec
+	}
+	return 0
+}
+
+func (x *GetSiteStateAnalyticsRequest) GetFlapThreshold() uint32 {
+	if x != nil {
+		return x.FlapThreshold
+	}
+	return 0
+}
+
+type GetSiteStateAnalyticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=siteId,proto3" json:"siteId,omitempty"`
+	Total         *StateAnalytics        `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	Nodes         []*StateAnalytics      `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	FlappingNodes []string               `protobuf:"bytes,4,rep,name=flappingNodes,proto3" json:"flappingNodes,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteStateAnalyticsResponse) Reset() {
+	*x = GetSiteStateAnalyticsResponse{}
+	mi := &file_state_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
+func (x *GetSiteStateAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteStateAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetSiteStateAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_state_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteStateAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetSiteStateAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_state_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetSiteStateAnalyticsResponse) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *GetSiteStateAnalyticsResponse) GetTotal() *StateAnalytics {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *GetSiteStateAnalyticsResponse) GetNodes() []*StateAnalytics {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetSiteStateAnalyticsResponse) GetFlappingNodes() []string {
+	if x != nil {
+		return x.FlappingNodes
+	}
+	return nil
+}
+
+func (x *GetSiteStateAnalyticsResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetSiteStateAnalyticsResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+var File_state_proto protoreflect.FileDescriptor
+
+const file_state_proto_rawDesc = "" +
+	"\n" +
+	"\vstate.proto\x12\x13ukama.node.state.v1\x1a\x0fvalidator.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x10ukama/node.proto\"M\n" +
+	"\x1dEnforceStateTransitionRequest\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\" \n" +
+	"\x1eEnforceStateTransitionResponse\"\xa5\x01\n" +
+	"\x17GetStatesHistoryRequest\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12\x1c\n" +
+	"\tstartTime\x18\x02 \x01(\tR\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x03 \x01(\tR\aendTime\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\x12\x1e\n" +
+	"\n" +
+	"PageNumber\x18\x05 \x01(\x05R\n" +
+	"PageNumber\"\x8f\x01\n" +
+	"\x18GetStatesHistoryResponse\x122\n" +
+	"\x06states\x18\x01 \x03(\v2\x1a.ukama.node.state.v1.StateR\x06states\x12?\n" +
+	"\n" +
+	"nodeConfig\x18\x02 \x01(\v2\x1f.ukama.node.state.v1.NodeConfigR\n" +
+	"nodeConfig\"\xd9\x03\n" +
+	"\x05State\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12(\n" +
+	"\x0fpreviousStateId\x18\x03 \x01(\tR\x0fpreviousStateId\x12@\n" +
+	"\rpreviousState\x18\x04 \x01(\v2\x1a.ukama.node.state.v1.StateR\rpreviousState\x12>\n" +
+	"\fcurrentState\x18\x05 \x01(\x0e2\x1a.ukama.common.v1.NodeStateR\fcurrentState\x12\x1a\n" +
+	"\bsubState\x18\x06 \x03(\tR\bsubState\x12\x16\n" +
+	"\x06events\x18\a \x03(\tR\x06events\x12\x1a\n" +
+	"\bnodeType\x18\t \x01(\tR\bnodeType\x128\n" +
+	"\tcreatedAt\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x128\n" +
+	"\tdeletedAt\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"`\n" +
+	"\x12UpdateStateRequest\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12\x1a\n" +
+	"\bsubState\x18\x02 \x03(\tR\bsubState\x12\x16\n" +
+	"\x06events\x18\x03 \x03(\tR\x06events\"U\n" +
+	"\x13UpdateStateResponse\x12>\n" +
+	"\fupdatedState\x18\x01 \x01(\v2\x1a.ukama.node.state.v1.StateR\fupdatedState\"\xef\x02\n" +
+	"\x0fAddStateRequest\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12(\n" +
+	"\x0fpreviousStateId\x18\x02 \x01(\tR\x0fpreviousStateId\x12>\n" +
+	"\fcurrentState\x18\x03 \x01(\x0e2\x1a.ukama.common.v1.NodeStateR\fcurrentState\x12\x1a\n" +
+	"\bsubState\x18\x04 \x03(\tR\bsubState\x12\x16\n" +
+	"\x06events\x18\x05 \x03(\tR\x06events\x12\x16\n" +
+	"\x06nodeIp\x18\x06 \x01(\tR\x06nodeIp\x12\x1a\n" +
+	"\bnodePort\x18\b \x01(\x05R\bnodePort\x12\x16\n" +
+	"\x06meshIp\x18\t \x01(\tR\x06meshIp\x12\x1a\n" +
+	"\bmeshPort\x18\n" +
+	" \x01(\x05R\bmeshPort\x12\"\n" +
+	"\fmeshHostName\x18\v \x01(\tR\fmeshHostName\x12\x1a\n" +
+	"\bnodeType\x18\f \x01(\tR\bnodeType\"\"\n" +
+	"\x10AddStateResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"%\n" +
+	"\x13GetStateByIdRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"\x14GetStateByIdResponse\x120\n" +
+	"\x05State\x18\x01 \x01(\v2\x1a.ukama.node.state.v1.StateR\x05State\"0\n" +
+	"\x16GetStateCurrentRequest\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\"K\n" +
+	"\x17GetCurrentStateResponse\x120\n" +
+	"\x05State\x18\x01 \x01(\v2\x1a.ukama.node.state.v1.StateR\x05State\"*\n" +
+	"\x10GetStatesRequest\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\"\xee\x02\n" +
+	"\n" +
+	"NodeConfig\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06nodeId\x18\x02 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06nodeIp\x18\x03 \x01(\tR\x06nodeIp\x12\x1a\n" +
+	"\bnodePort\x18\x04 \x01(\x05R\bnodePort\x12\x16\n" +
+	"\x06meshIp\x18\x05 \x01(\tR\x06meshIp\x12\x1a\n" +
+	"\bmeshPort\x18\x06 \x01(\x05R\bmeshPort\x12\"\n" +
+	"\fmeshHostName\x18\a \x01(\tR\fmeshHostName\x128\n" +
+	"\tcreatedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x128\n" +
+	"\tdeletedAt\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\x88\x01\n" +
+	"\x11GetStatesResponse\x122\n" +
+	"\x06states\x18\x01 \x03(\v2\x1a.ukama.node.state.v1.StateR\x06states\x12?\n" +
+	"\n" +
+	"nodeConfig\x18\x02 \x01(\v2\x1f.ukama.node.state.v1.NodeConfigR\n" +
+	"nodeConfig\"/\n" +
+	"\x15GetLatestStateRequest\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\"J\n" +
+	"\x16GetLatestStateResponse\x120\n" +
+	"\x05State\x18\x01 \x01(\v2\x1a.ukama.node.state.v1.StateR\x05State\"\n" +
+	"\n" +
+	"\bsubState\"[\n" +
+	"\rStateDuration\x120\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x1a.ukama.common.v1.NodeStateR\x05state\x12\x18\n" +
+	"\aseconds\x18\x02 \x01(\x04R\aseconds\"\xe4\x02\n" +
+	"\x0eStateAnalytics\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12D\n" +
+	"\vtimeInState\x18\x02 \x03(\v2\".ukama.node.state.v1.StateDurationR\vtimeInState\x12 \n" +
+	"\vtransitions\x18\x03 \x01(\rR\vtransitions\x12\x1a\n" +
+	"\bfailures\x18\x04 \x01(\rR\bfailures\x12\x1e\n" +
+	"\n" +
+	"recoveries\x18\x05 \x01(\rR\n" +
+	"recoveries\x12 \n" +
+	"\vmtbfSeconds\x18\x06 \x01(\x04R\vmtbfSeconds\x12 \n" +
+	"\vmttrSeconds\x18\a \x01(\x04R\vmttrSeconds\x126\n" +
+	"\x16maxTransitionsInWindow\x18\b \x01(\rR\x16maxTransitionsInWindow\x12\x1a\n" +
+	"\bflapping\x18\t \x01(\bR\bflapping\"\xde\x01\n" +
+	"\x1cGetNodeStateAnalyticsRequest\x12\x16\n" +
+	"\x06nodeId\x18\x01 \x01(\tR\x06nodeId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12$\n" +
+	"\rflapWindowSec\x18\x04 \x01(\rR\rflapWindowSec\x12$\n" +
+	"\rflapThreshold\x18\x05 \x01(\rR\rflapThreshold\"\xbe\x01\n" +
+	"\x1dGetNodeStateAnalyticsResponse\x12A\n" +
+	"\tanalytics\x18\x01 \x01(\v2#.ukama.node.state.v1.StateAnalyticsR\tanalytics\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xde\x01\n" +
+	"\x1cGetSiteStateAnalyticsRequest\x12\x16\n" +
+	"\x06siteId\x18\x01 \x01(\tR\x06siteId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12$\n" +
+	"\rflapWindowSec\x18\x04 \x01(\rR\rflapWindowSec\x12$\n" +
+	"\rflapThreshold\x18\x05 \x01(\rR\rflapThreshold\"\xaf\x02\n" +
+	"\x1dGetSiteStateAnalyticsResponse\x12\x16\n" +
+	"\x06siteId\x18\x01 \x01(\tR\x06siteId\x129\n" +
+	"\x05total\x18\x02 \x01(\v2#.ukama.node.state.v1.StateAnalyticsR\x05total\x129\n" +
+	"\x05nodes\x18\x03 \x03(\v2#.ukama.node.state.v1.StateAnalyticsR\x05nodes\x12$\n" +
+	"\rflappingNodes\x18\x04 \x03(\tR\rflappingNodes\x12.\n" +
+	"\x04from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x02to2\xea\a\n" +
+	"\fStateService\x12W\n" +
+	"\bAddState\x12$.ukama.node.state.v1.AddStateRequest\x1a%.ukama.node.state.v1.AddStateResponse\x12c\n" +
+	"\fGetStateById\x12(.ukama.node.state.v1.GetStateByIdRequest\x1a).ukama.node.state.v1.GetStateByIdResponse\x12Z\n" +
+	"\tGetStates\x12%.ukama.node.state.v1.GetStatesRequest\x1a&.ukama.node.state.v1.GetStatesResponse\x12i\n" +
+	"\x0eGetLatestState\x12*.ukama.node.state.v1.GetLatestStateRequest\x1a+.ukama.node.state.v1.GetLatestStateResponse\x12`\n" +
+	"\vUpdateState\x12'.ukama.node.state.v1.UpdateStateRequest\x1a(.ukama.node.state.v1.UpdateStateResponse\x12o\n" +
+	"\x10GetStatesHistory\x12,.ukama.node.state.v1.GetStatesHistoryRequest\x1a-.ukama.node.state.v1.GetStatesHistoryResponse\x12\x81\x01\n" +
+	"\x16EnforceStateTransition\x122.ukama.node.state.v1.EnforceStateTransitionRequest\x1a3.ukama.node.state.v1.EnforceStateTransitionResponse\x12~\n" +
+	"\x15GetNodeStateAnalytics\x121.ukama.node.state.v1.GetNodeStateAnalyticsRequest\x1a2.ukama.node.state.v1.GetNodeStateAnalyticsResponse\x12~\n" +
+	"\x15GetSiteStateAnalytics\x121.ukama.node.state.v1.GetSiteStateAnalyticsRequest\x1a2.ukama.node.state.v1.GetSiteStateAnalyticsResponseB2Z0github.com/ukama/ukama/systems/node/state/pb/genb\x06proto3"
+
 var (
 	file_state_proto_rawDescOnce sync.Once
-	file_state_proto_rawDescData = file_state_proto_rawDesc
+	file_state_proto_rawDescData []byte
 )
 
 func file_state_proto_rawDescGZIP() []byte {
 	file_state_proto_rawDescOnce.Do(func() {
-		file_state_proto_rawDescData = protoimpl.X.CompressGZIP(file_state_proto_rawDescData)
+		file_state_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_state_proto_rawDesc), len(file_state_proto_rawDesc)))
 	})
 	return file_state_proto_rawDescData
 }
 
-var file_state_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_state_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_state_proto_goTypes = []any{
 	(*EnforceStateTransitionRequest)(nil),  // 0: ukama.node.state.v1.EnforceStateTransitionRequest
 	(*EnforceStateTransitionResponse)(nil), // 1: ukama.node.state.v1.EnforceStateTransitionResponse
@@ -1421,46 +1788,69 @@ var file_state_proto_goTypes = []any{
 	(*GetLatestStateRequest)(nil),          // 16: ukama.node.state.v1.GetLatestStateRequest
 	(*GetLatestStateResponse)(nil),         // 17: ukama.node.state.v1.GetLatestStateResponse
 	(*SubState)(nil),                       // 18: ukama.node.state.v1.subState
-	(ukama.NodeState)(0),                   // 19: ukama.common.v1.NodeState
-	(*timestamppb.Timestamp)(nil),          // 20: google.protobuf.Timestamp
+	(*StateDuration)(nil),                  // 19: ukama.node.state.v1.StateDuration
+	(*StateAnalytics)(nil),                 // 20: ukama.node.state.v1.StateAnalytics
+	(*GetNodeStateAnalyticsRequest)(nil),   // 21: ukama.node.state.v1.GetNodeStateAnalyticsRequest
+	(*GetNodeStateAnalyticsResponse)(nil),  // 22: ukama.node.state.v1.GetNodeStateAnalyticsResponse
+	(*GetSiteStateAnalyticsRequest)(nil),   // 23: ukama.node.state.v1.GetSiteStateAnalyticsRequest
+	(*GetSiteStateAnalyticsResponse)(nil),  // 24: ukama.node.state.v1.GetSiteStateAnalyticsResponse
+	(ukama.NodeState)(0),                   // 25: ukama.common.v1.NodeState
+	(*timestamppb.Timestamp)(nil),          // 26: google.protobuf.Timestamp
 }
 var file_state_proto_depIdxs = []int32{
 	4,  // 0: ukama.node.state.v1.GetStatesHistoryResponse.states:type_name -> ukama.node.state.v1.State
 	14, // 1: ukama.node.state.v1.GetStatesHistoryResponse.nodeConfig:type_name -> ukama.node.state.v1.NodeConfig
 	4,  // 2: ukama.node.state.v1.State.previousState:type_name -> ukama.node.state.v1.State
-	19, // 3: ukama.node.state.v1.State.currentState:type_name -> ukama.common.v1.NodeState
-	20, // 4: ukama.node.state.v1.State.createdAt:type_name -> google.protobuf.Timestamp
-	20, // 5: ukama.node.state.v1.State.updatedAt:type_name -> google.protobuf.Timestamp
-	20, // 6: ukama.node.state.v1.State.deletedAt:type_name -> google.protobuf.Timestamp
+	25, // 3: ukama.node.state.v1.State.currentState:type_name -> ukama.common.v1.NodeState
+	26, // 4: ukama.node.state.v1.State.createdAt:type_name -> google.protobuf.Timestamp
+	26, // 5: ukama.node.state.v1.State.updatedAt:type_name -> google.protobuf.Timestamp
+	26, // 6: ukama.node.state.v1.State.deletedAt:type_name -> google.protobuf.Timestamp
 	4,  // 7: ukama.node.state.v1.UpdateStateResponse.updatedState:type_name -> ukama.node.state.v1.State
-	19, // 8: ukama.node.state.v1.AddStateRequest.currentState:type_name -> ukama.common.v1.NodeState
+	25, // 8: ukama.node.state.v1.AddStateRequest.currentState:type_name -> ukama.common.v1.NodeState
 	4,  // 9: ukama.node.state.v1.GetStateByIdResponse.State:type_name -> ukama.node.state.v1.State
 	4,  // 10: ukama.node.state.v1.GetCurrentStateResponse.State:type_name -> ukama.node.state.v1.State
-	20, // 11: ukama.node.state.v1.NodeConfig.createdAt:type_name -> google.protobuf.Timestamp
-	20, // 12: ukama.node.state.v1.NodeConfig.updatedAt:type_name -> google.protobuf.Timestamp
-	20, // 13: ukama.node.state.v1.NodeConfig.deletedAt:type_name -> google.protobuf.Timestamp
+	26, // 11: ukama.node.state.v1.NodeConfig.createdAt:type_name -> google.protobuf.Timestamp
+	26, // 12: ukama.node.state.v1.NodeConfig.updatedAt:type_name -> google.protobuf.Timestamp
+	26, // 13: ukama.node.state.v1.NodeConfig.deletedAt:type_name -> google.protobuf.Timestamp
 	4,  // 14: ukama.node.state.v1.GetStatesResponse.states:type_name -> ukama.node.state.v1.State
 	14, // 15: ukama.node.state.v1.GetStatesResponse.nodeConfig:type_name -> ukama.node.state.v1.NodeConfig
 	4,  // 16: ukama.node.state.v1.GetLatestStateResponse.State:type_name -> ukama.node.state.v1.State
-	7,  // 17: ukama.node.state.v1.StateService.AddState:input_type -> ukama.node.state.v1.AddStateRequest
-	9,  // 18: ukama.node.state.v1.StateService.GetStateById:input_type -> ukama.node.state.v1.GetStateByIdRequest
-	13, // 19: ukama.node.state.v1.StateService.GetStates:input_type -> ukama.node.state.v1.GetStatesRequest
-	16, // 20: ukama.node.state.v1.StateService.GetLatestState:input_type -> ukama.node.state.v1.GetLatestStateRequest
-	5,  // 21: ukama.node.state.v1.StateService.UpdateState:input_type -> ukama.node.state.v1.UpdateStateRequest
-	2,  // 22: ukama.node.state.v1.StateService.GetStatesHistory:input_type -> ukama.node.state.v1.GetStatesHistoryRequest
-	0,  // 23: ukama.node.state.v1.StateService.EnforceStateTransition:input_type -> ukama.node.state.v1.EnforceStateTransitionRequest
-	8,  // 24: ukama.node.state.v1.StateService.AddState:output_type -> ukama.node.state.v1.AddStateResponse
-	10, // 25: ukama.node.state.v1.StateService.GetStateById:output_type -> ukama.node.state.v1.GetStateByIdResponse
-	15, // 26: ukama.node.state.v1.StateService.GetStates:output_type -> ukama.node.state.v1.GetStatesResponse
-	17, // 27: ukama.node.state.v1.StateService.GetLatestState:output_type -> ukama.node.state.v1.GetLatestStateResponse
-	6,  // 28: ukama.node.state.v1.StateService.UpdateState:output_type -> ukama.node.state.v1.UpdateStateResponse
-	3,  // 29: ukama.node.state.v1.StateService.GetStatesHistory:output_type -> ukama.node.state.v1.GetStatesHistoryResponse
-	1,  // 30: ukama.node.state.v1.StateService.EnforceStateTransition:output_type -> ukama.node.state.v1.EnforceStateTransitionResponse
-	24, // [24:31] is the sub-list for method output_type
-	17, // [17:24] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	25, // 17: ukama.node.state.v1.StateDuration.state:type_name -> ukama.common.v1.NodeState
+	19, // 18: ukama.node.state.v1.StateAnalytics.timeInState:type_name -> ukama.node.state.v1.StateDuration
+	26, // 19: ukama.node.state.v1.GetNodeStateAnalyticsRequest.from:type_name -> google.protobuf.Timestamp
+	26, // 20: ukama.node.state.v1.GetNodeStateAnalyticsRequest.to:type_name -> google.protobuf.Timestamp
+	20, // 21: ukama.node.state.v1.GetNodeStateAnalyticsResponse.analytics:type_name -> ukama.node.state.v1.StateAnalytics
+	26, // 22: ukama.node.state.v1.GetNodeStateAnalyticsResponse.from:type_name -> google.protobuf.Timestamp
+	26, // 23: ukama.node.state.v1.GetNodeStateAnalyticsResponse.to:type_name -> google.protobuf.Timestamp
+	26, // 24: ukama.node.state.v1.GetSiteStateAnalyticsRequest.from:type_name -> google.protobuf.Timestamp
+	26, // 25: ukama.node.state.v1.GetSiteStateAnalyticsRequest.to:type_name -> google.protobuf.Timestamp
+	20, // 26: ukama.node.state.v1.GetSiteStateAnalyticsResponse.total:type_name -> ukama.node.state.v1.StateAnalytics
+	20, // 27: ukama.node.state.v1.GetSiteStateAnalyticsResponse.nodes:type_name -> ukama.node.state.v1.StateAnalytics
+	26, // 28: ukama.node.state.v1.GetSiteStateAnalyticsResponse.from:type_name -> google.protobuf.Timestamp
+	26, // 29: ukama.node.state.v1.GetSiteStateAnalyticsResponse.to:type_name -> google.protobuf.Timestamp
+	7,  // 30: ukama.node.state.v1.StateService.AddState:input_type -> ukama.node.state.v1.AddStateRequest
+	9,  // 31: ukama.node.state.v1.StateService.GetStateById:input_type -> ukama.node.state.v1.GetStateByIdRequest
+	13, // 32: ukama.node.state.v1.StateService.GetStates:input_type -> ukama.node.state.v1.GetStatesRequest
+	16, // 33: ukama.node.state.v1.StateService.GetLatestState:input_type -> ukama.node.state.v1.GetLatestStateRequest
+	5,  // 34: ukama.node.state.v1.StateService.UpdateState:input_type -> ukama.node.state.v1.UpdateStateRequest
+	2,  // 35: ukama.node.state.v1.StateService.GetStatesHistory:input_type -> ukama.node.state.v1.GetStatesHistoryRequest
+	0,  // 36: ukama.node.state.v1.StateService.EnforceStateTransition:input_type -> ukama.node.state.v1.EnforceStateTransitionRequest
+	21, // 37: ukama.node.state.v1.StateService.GetNodeStateAnalytics:input_type -> ukama.node.state.v1.GetNodeStateAnalyticsRequest
+	23, // 38: ukama.node.state.v1.StateService.GetSiteStateAnalytics:input_type -> ukama.node.state.v1.GetSiteStateAnalyticsRequest
+	8,  // 39: ukama.node.state.v1.StateService.AddState:output_type -> ukama.node.state.v1.AddStateResponse
+	10, // 40: ukama.node.state.v1.StateService.GetStateById:output_type -> ukama.node.state.v1.GetStateByIdResponse
+	15, // 41: ukama.node.state.v1.StateService.GetStates:output_type -> ukama.node.state.v1.GetStatesResponse
+	17, // 42: ukama.node.state.v1.StateService.GetLatestState:output_type -> ukama.node.state.v1.GetLatestStateResponse
+	6,  // 43: ukama.node.state.v1.StateService.UpdateState:output_type -> ukama.node.state.v1.UpdateStateResponse
+	3,  // 44: ukama.node.state.v1.StateService.GetStatesHistory:output_type -> ukama.node.state.v1.GetStatesHistoryResponse
+	1,  // 45: ukama.node.state.v1.StateService.EnforceStateTransition:output_type -> ukama.node.state.v1.EnforceStateTransitionResponse
+	22, // 46: ukama.node.state.v1.StateService.GetNodeStateAnalytics:output_type -> ukama.node.state.v1.GetNodeStateAnalyticsResponse
+	24, // 47: ukama.node.state.v1.StateService.GetSiteStateAnalytics:output_type -> ukama.node.state.v1.GetSiteStateAnalyticsResponse
+	39, // [39:48] is the sub-list for method output_type
+	30, // [30:39] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_state_proto_init() }
@@ -1472,9 +1862,9 @@ func file_state_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_state_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_state_proto_rawDesc), len(file_state_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1483,7 +1873,6 @@ func file_state_proto_init() {
 		MessageInfos:      file_state_proto_msgTypes,
 	}.Build()
 	File_state_proto = out.File
-	file_state_proto_rawDesc = nil
 	file_state_proto_goTypes = nil
 	file_state_proto_depIdxs = nil
 }
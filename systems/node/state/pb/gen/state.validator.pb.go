@@ -7,10 +7,10 @@ import (
 	fmt "fmt"
 	math "math"
 	proto "github.com/golang/protobuf/proto"
-	_ "github.com/mwitkow/go-proto-validators"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
 	_ "google.golang.org/protobuf/types/known/wrapperspb"
 	_ "github.com/ukama/ukama/systems/common/pb/gen/ukama"
+	_ "github.com/mwitkow/go-proto-validators"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
 )
 
@@ -155,3 +155,85 @@ func (this *GetLatestStateResponse) Validate() error {
 func (this *SubState) Validate() error {
 	return nil
 }
+func (this *StateDuration) Validate() error {
+	return nil
+}
+func (this *StateAnalytics) Validate() error {
+	for _, item := range this.TimeInState {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("TimeInState", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *GetNodeStateAnalyticsRequest) Validate() error {
+	if this.From != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.From); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("From", err)
+		}
+	}
+	if this.To != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.To); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("To", err)
+		}
+	}
+	return nil
+}
+func (this *GetNodeStateAnalyticsResponse) Validate() error {
+	if this.Analytics != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Analytics); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Analytics", err)
+		}
+	}
+	if this.From != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.From); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("From", err)
+		}
+	}
+	if this.To != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.To); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("To", err)
+		}
+	}
+	return nil
+}
+func (this *GetSiteStateAnalyticsRequest) Validate() error {
+	if this.From != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.From); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("From", err)
+		}
+	}
+	if this.To != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.To); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("To", err)
+		}
+	}
+	return nil
+}
+func (this *GetSiteStateAnalyticsResponse) Validate() error {
+	if this.Total != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Total); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Total", err)
+		}
+	}
+	for _, item := range this.Nodes {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Nodes", err)
+			}
+		}
+	}
+	if this.From != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.From); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("From", err)
+		}
+	}
+	if this.To != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.To); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("To", err)
+		}
+	}
+	return nil
+}
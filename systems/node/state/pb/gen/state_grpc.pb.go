@@ -8,7 +8,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
-// - protoc             v5.28.2
+// - protoc             v6.32.1
 // source: state.proto
 
 package gen
@@ -33,6 +33,8 @@ const (
 	StateService_UpdateState_FullMethodName            = "/ukama.node.state.v1.StateService/UpdateState"
 	StateService_GetStatesHistory_FullMethodName       = "/ukama.node.state.v1.StateService/GetStatesHistory"
 	StateService_EnforceStateTransition_FullMethodName = "/ukama.node.state.v1.StateService/EnforceStateTransition"
+	StateService_GetNodeStateAnalytics_FullMethodName  = "/ukama.node.state.v1.StateService/GetNodeStateAnalytics"
+	StateService_GetSiteStateAnalytics_FullMethodName  = "/ukama.node.state.v1.StateService/GetSiteStateAnalytics"
 )
 
 // StateServiceClient is the client API for StateService service.
@@ -46,6 +48,8 @@ type StateServiceClient interface {
 	UpdateState(ctx context.Context, in *UpdateStateRequest, opts ...grpc.CallOption) (*UpdateStateResponse, error)
 	GetStatesHistory(ctx context.Context, in *GetStatesHistoryRequest, opts ...grpc.CallOption) (*GetStatesHistoryResponse, error)
 	EnforceStateTransition(ctx context.Context, in *EnforceStateTransitionRequest, opts ...grpc.CallOption) (*EnforceStateTransitionResponse, error)
+	GetNodeStateAnalytics(ctx context.Context, in *GetNodeStateAnalyticsRequest, opts ...grpc.CallOption) (*GetNodeStateAnalyticsResponse, error)
+	GetSiteStateAnalytics(ctx context.Context, in *GetSiteStateAnalyticsRequest, opts ...grpc.CallOption) (*GetSiteStateAnalyticsResponse, error)
 }
 
 type stateServiceClient struct {
@@ -126,6 +130,26 @@ func (c *stateServiceClient) EnforceStateTransition(ctx context.Context, in *Enf
 	return out, nil
 }
 
+func (c *stateServiceClient) GetNodeStateAnalytics(ctx context.Context, in *GetNodeStateAnalyticsRequest, opts ...grpc.CallOption) (*GetNodeStateAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNodeStateAnalyticsResponse)
+	err := c.cc.Invoke(ctx, StateService_GetNodeStateAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stateServiceClient) GetSiteStateAnalytics(ctx context.Context, in *GetSiteStateAnalyticsRequest, opts ...grpc.CallOption) (*GetSiteStateAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSiteStateAnalyticsResponse)
+	err := c.cc.Invoke(ctx, StateService_GetSiteStateAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StateServiceServer is the server API for StateService service.
 // All implementations must embed UnimplementedStateServiceServer
 // for forward compatibility.
@@ -137,6 +161,8 @@ type StateServiceServer interface {
 	UpdateState(context.Context, *UpdateStateRequest) (*UpdateStateResponse, error)
 	GetStatesHistory(context.Context, *GetStatesHistoryRequest) (*GetStatesHistoryResponse, error)
 	EnforceStateTransition(context.Context, *EnforceStateTransitionRequest) (*EnforceStateTransitionResponse, error)
+	GetNodeStateAnalytics(context.Context, *GetNodeStateAnalyticsRequest) (*GetNodeStateAnalyticsResponse, error)
+	GetSiteStateAnalytics(context.Context, *GetSiteStateAnalyticsRequest) (*GetSiteStateAnalyticsResponse, error)
 	mustEmbedUnimplementedStateServiceServer()
 }
 
@@ -168,6 +194,12 @@ func (UnimplementedStateServiceServer) GetStatesHistory(context.Context, *GetSta
 func (UnimplementedStateServiceServer) EnforceStateTransition(context.Context, *EnforceStateTransitionRequest) (*EnforceStateTransitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnforceStateTransition not implemented")
 }
+func (UnimplementedStateServiceServer) GetNodeStateAnalytics(context.Context, *GetNodeStateAnalyticsRequest) (*GetNodeStateAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeStateAnalytics not implemented")
+}
+func (UnimplementedStateServiceServer) GetSiteStateAnalytics(context.Context, *GetSiteStateAnalyticsRequest) (*GetSiteStateAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSiteStateAnalytics not implemented")
+}
 func (UnimplementedStateServiceServer) mustEmbedUnimplementedStateServiceServer() {}
 func (UnimplementedStateServiceServer) testEmbeddedByValue()                      {}
 
@@ -315,6 +347,42 @@ func _StateService_EnforceStateTransition_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StateService_GetNodeStateAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeStateAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServiceServer).GetNodeStateAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StateService_GetNodeStateAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServiceServer).GetNodeStateAnalytics(ctx, req.(*GetNodeStateAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StateService_GetSiteStateAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSiteStateAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServiceServer).GetSiteStateAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StateService_GetSiteStateAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServiceServer).GetSiteStateAnalytics(ctx, req.(*GetSiteStateAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StateService_ServiceDesc is the grpc.ServiceDesc for StateService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -350,6 +418,14 @@ var StateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EnforceStateTransition",
 			Handler:    _StateService_EnforceStateTransition_Handler,
 		},
+		{
+			MethodName: "GetNodeStateAnalytics",
+			Handler:    _StateService_GetNodeStateAnalytics_Handler,
+		},
+		{
+			MethodName: "GetSiteStateAnalytics",
+			Handler:    _StateService_GetSiteStateAnalytics_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "state.proto",
@@ -0,0 +1,196 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package analytics
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ukama/ukama/systems/common/pb/gen/ukama"
+)
+
+const (
+	DefaultFlapWindow    = 10 * time.Minute
+	DefaultFlapThreshold = 5
+)
+
+// A failure is a node entering Faulty from any other state and a recovery is
+// a node leaving Faulty. MTBF is the time spent Operational per failure and
+// MTTR the mean length of the faulty periods that recovered.
+var (
+	failedState = ukama.NodeState_Faulty.String()
+	upState     = ukama.NodeState_Operational.String()
+)
+
+type Transition struct {
+	State string
+	At    time.Time
+}
+
+type FlapConfig struct {
+	Window    time.Duration
+	Threshold int
+}
+
+func (f FlapConfig) OrDefault() FlapConfig {
+	if f.Window <= 0 {
+		f.Window = DefaultFlapWindow
+	}
+	if f.Threshold <= 0 {
+		f.Threshold = DefaultFlapThreshold
+	}
+	return f
+}
+
+type Report struct {
+	From        time.Time
+	To          time.Time
+	TimeInState map[string]time.Duration
+	Transitions int
+	Failures    int
+	Recoveries  int
+	RepairTime  time.Duration
+	// Most transitions seen within one flap window
+	MaxTransitionsInWindow int
+	Flapping               bool
+}
+
+func (r *Report) MTBF() time.Duration {
+	if r.Failures == 0 {
+		return 0
+	}
+	return r.TimeInState[upState] / time.Duration(r.Failures)
+}
+
+func (r *Report) MTTR() time.Duration {
+	if r.Recoveries == 0 {
+		return 0
+	}
+	return r.RepairTime / time.Duration(r.Recoveries)
+}
+
+// Compute analyses the transitions of one node within [from, to). initial is
+// the last transition before from, nil if the node had no state yet; time
+// before the first known state is not counted.
+func Compute(initial *Transition, transitions []Transition, from, to time.Time, flap FlapConfig) Report {
+	flap = flap.OrDefault()
+	r := Report{From: from, To: to, TimeInState: map[string]time.Duration{}}
+
+	ts := make([]Transition, 0, len(transitions))
+	for _, t := range transitions {
+		if !t.At.Before(from) && t.At.Before(to) {
+			ts = append(ts, t)
+		}
+	}
+	sort.SliceStable(ts, func(i, j int) bool { return ts[i].At.Before(ts[j].At) })
+
+	var cur *Transition
+	var faultySince time.Time
+	if initial != nil {
+		cur = &Transition{State: initial.State, At: from}
+		if initial.State == failedState {
+			faultySince = initial.At
+		}
+	}
+
+	for i := range ts {
+		t := ts[i]
+		if cur != nil {
+			r.TimeInState[cur.State] += t.At.Sub(cur.At)
+			if cur.State == t.State {
+				cur.At = t.At
+				continue
+			}
+		}
+		r.Transitions++
+
+		prev := ""
+		if cur != nil {
+			prev = cur.State
+		}
+		if t.State == failedState && prev != failedState {
+			r.Failures++
+			faultySince = t.At
+		}
+		if prev == failedState && t.State != failedState && !faultySince.IsZero() {
+			r.Recoveries++
+			r.RepairTime += t.At.Sub(faultySince)
+			faultySince = time.Time{}
+		}
+		cur = &Transition{State: t.State, At: t.At}
+	}
+	if cur != nil {
+		r.TimeInState[cur.State] += to.Sub(cur.At)
+	}
+
+	r.MaxTransitionsInWindow = maxInWindow(changes(initial, ts), flap.Window)
+	r.Flapping = r.MaxTransitionsInWindow >= flap.Threshold
+	return r
+}
+
+// Merge sums node reports into one for a group of nodes such as a site.
+func Merge(from, to time.Time, reports []Report) Report {
+	out := Report{From: from, To: to, TimeInState: map[string]time.Duration{}}
+	for _, r := range reports {
+		for s, d := range r.TimeInState {
+			out.TimeInState[s] += d
+		}
+		out.Transitions += r.Transitions
+		out.Failures += r.Failures
+		out.Recoveries += r.Recoveries
+		out.RepairTime += r.RepairTime
+		if r.MaxTransitionsInWindow > out.MaxTransitionsInWindow {
+			out.MaxTransitionsInWindow = r.MaxTransitionsInWindow
+		}
+		out.Flapping = out.Flapping || r.Flapping
+	}
+	return out
+}
+
+// IsFlapping reports whether the changes ending at now reach the flap threshold.
+func IsFlapping(transitions []Transition, now time.Time, flap FlapConfig) (int, bool) {
+	flap = flap.OrDefault()
+	n := 0
+	for _, at := range changes(nil, transitions) {
+		if !at.Before(now.Add(-flap.Window)) && !at.After(now) {
+			n++
+		}
+	}
+	return n, n >= flap.Threshold
+}
+
+// changes returns the times the state actually changed, in order.
+func changes(initial *Transition, ts []Transition) []time.Time {
+	prev := ""
+	if initial != nil {
+		prev = initial.State
+	}
+	out := make([]time.Time, 0, len(ts))
+	for i, t := range ts {
+		if (i > 0 || initial != nil) && t.State == prev {
+			continue
+		}
+		out = append(out, t.At)
+		prev = t.State
+	}
+	return out
+}
+
+func maxInWindow(times []time.Time, window time.Duration) int {
+	best, start := 0, 0
+	for end := range times {
+		for times[end].Sub(times[start]) > window {
+			start++
+		}
+		if n := end - start + 1; n > best {
+			best = n
+		}
+	}
+	return best
+}
@@ -0,0 +1,112 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var t0 = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func at(h float64, state string) Transition {
+	return Transition{State: state, At: t0.Add(time.Duration(h * float64(time.Hour)))}
+}
+
+func TestCompute_MTBFAndMTTR(t *testing.T) {
+	initial := &Transition{State: "Operational", At: t0.Add(-48 * time.Hour)}
+	ts := []Transition{
+		at(10, "Faulty"),
+		at(12, "Operational"),
+		at(20, "Faulty"),
+		at(21, "Operational"),
+	}
+
+	r := Compute(initial, ts, t0, t0.Add(24*time.Hour), FlapConfig{})
+
+	assert.Equal(t, 4, r.Transitions)
+	assert.Equal(t, 2, r.Failures)
+	assert.Equal(t, 2, r.Recoveries)
+	assert.Equal(t, 21*time.Hour, r.TimeInState["Operational"])
+	assert.Equal(t, 3*time.Hour, r.TimeInState["Faulty"])
+	assert.Equal(t, 10*time.Hour+30*time.Minute, r.MTBF())
+	assert.Equal(t, 90*time.Minute, r.MTTR())
+	assert.False(t, r.Flapping)
+}
+
+func TestCompute_FaultyBeforeRange(t *testing.T) {
+	/* The repair started before the range and is measured in full */
+	initial := &Transition{State: "Faulty", At: t0.Add(-2 * time.Hour)}
+
+	r := Compute(initial, []Transition{at(1, "Operational")}, t0, t0.Add(4*time.Hour), FlapConfig{})
+
+	assert.Equal(t, 0, r.Failures)
+	assert.Equal(t, 1, r.Recoveries)
+	assert.Equal(t, 3*time.Hour, r.MTTR())
+	assert.Equal(t, time.Hour, r.TimeInState["Faulty"])
+	assert.Equal(t, time.Duration(0), r.MTBF())
+}
+
+func TestCompute_NoStateBeforeFirstTransition(t *testing.T) {
+	r := Compute(nil, []Transition{at(6, "Configuring"), at(7, "Operational")}, t0, t0.Add(10*time.Hour), FlapConfig{})
+
+	assert.Equal(t, 2, r.Transitions)
+	assert.Equal(t, time.Hour, r.TimeInState["Configuring"])
+	assert.Equal(t, 3*time.Hour, r.TimeInState["Operational"])
+}
+
+func TestCompute_Flapping(t *testing.T) {
+	initial := &Transition{State: "Operational", At: t0.Add(-time.Hour)}
+	ts := []Transition{}
+	for i := 0; i < 6; i++ {
+		s := "Faulty"
+		if i%2 == 1 {
+			s = "Operational"
+		}
+		ts = append(ts, Transition{State: s, At: t0.Add(time.Hour + time.Duration(i)*time.Minute)})
+	}
+
+	r := Compute(initial, ts, t0, t0.Add(2*time.Hour), FlapConfig{Window: 5 * time.Minute, Threshold: 6})
+
+	assert.Equal(t, 6, r.MaxTransitionsInWindow)
+	assert.True(t, r.Flapping)
+
+	r = Compute(initial, ts, t0, t0.Add(2*time.Hour), FlapConfig{Window: 4 * time.Minute, Threshold: 6})
+
+	assert.Equal(t, 5, r.MaxTransitionsInWindow)
+	assert.False(t, r.Flapping)
+}
+
+func TestMerge(t *testing.T) {
+	a := Report{TimeInState: map[string]time.Duration{"Operational": 10 * time.Hour}, Failures: 1, Recoveries: 1, RepairTime: time.Hour}
+	b := Report{TimeInState: map[string]time.Duration{"Operational": 20 * time.Hour}, Failures: 2, Recoveries: 1, RepairTime: 3 * time.Hour, Flapping: true}
+
+	m := Merge(t0, t0.Add(24*time.Hour), []Report{a, b})
+
+	assert.Equal(t, 10*time.Hour, m.MTBF())
+	assert.Equal(t, 2*time.Hour, m.MTTR())
+	assert.True(t, m.Flapping)
+}
+
+func TestIsFlapping(t *testing.T) {
+	now := t0.Add(time.Hour)
+	ts := []Transition{
+		{State: "Faulty", At: now.Add(-20 * time.Minute)},
+		{State: "Operational", At: now.Add(-8 * time.Minute)},
+		{State: "Faulty", At: now.Add(-5 * time.Minute)},
+		{State: "Operational", At: now.Add(-time.Minute)},
+	}
+
+	n, flapping := IsFlapping(ts, now, FlapConfig{Window: 10 * time.Minute, Threshold: 3})
+
+	assert.Equal(t, 3, n)
+	assert.True(t, flapping)
+}
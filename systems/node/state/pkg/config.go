@@ -27,6 +27,13 @@ type Config struct {
 	OrgId                     string
 	ConfigPath                string
 	StateTimeoutSweepInterval time.Duration `default:"15s"`
+	FlapWindow                time.Duration `default:"10m"`
+	FlapThreshold             int           `default:"5"`
+	Http                      HttpServices
+}
+
+type HttpServices struct {
+	InitClient string `default:"api-gateway-init:8080"`
 }
 
 func NewConfig(name string) *Config {
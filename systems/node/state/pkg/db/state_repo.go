@@ -31,6 +31,8 @@ type StateRepo interface {
 	SetLatchedEvent(nodeId, event string) error
 	TakeLatchedEvent(nodeId string) (string, error)
 	ListLatestStates() ([]State, error)
+	ListTransitions(nodeId string, from, to time.Time) ([]State, error)
+	GetStateBefore(nodeId string, t time.Time) (*State, error)
 }
 
 type stateRepo struct {
@@ -123,6 +125,37 @@ func (r *stateRepo) ListLatestStates() ([]State, error) {
 	return states, nil
 }
 
+func (r *stateRepo) ListTransitions(nodeId string, from, to time.Time) ([]State, error) {
+	var states []State
+
+	err := r.Db.GetGormDb().
+		Where("node_id = ? AND created_at >= ? AND created_at < ?", nodeId, from, to).
+		Order("created_at ASC").
+		Find(&states).Error
+	if err != nil {
+		return nil, fmt.Errorf("error listing state transitions: %w", err)
+	}
+
+	return states, nil
+}
+
+func (r *stateRepo) GetStateBefore(nodeId string, t time.Time) (*State, error) {
+	var state State
+
+	err := r.Db.GetGormDb().
+		Where("node_id = ? AND created_at < ?", nodeId, t).
+		Order("created_at DESC").
+		First(&state).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching state before %s: %w", t, err)
+	}
+
+	return &state, nil
+}
+
 func (r *stateRepo) AddState(newState *State, previousState *State) error {
 	return r.Db.GetGormDb().Transaction(func(tx *gorm.DB) error {
 		if previousState != nil {
@@ -192,3 +192,41 @@ func TestState_ListLatestStates(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestState_GetStateBefore(t *testing.T) {
+	t.Run("no state before time", func(t *testing.T) {
+		nid := ukama.NewVirtualNodeId(ukama.NODE_ID_TYPE_HOMENODE)
+		before := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+		var db *extsql.DB
+		var err error
+
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		mock.ExpectQuery(`^SELECT \* FROM "states" WHERE \(node_id = \$1 AND created_at < \$2\) AND "states"."deleted_at" IS NULL ORDER BY created_at DESC,"states"."id" LIMIT \$3`).
+			WithArgs(nid.String(), before, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"node_id", "created_at"}))
+
+		dialector := postgres.New(postgres.Config{
+			DSN:                  "sqlmock_db_0",
+			DriverName:           "postgres",
+			Conn:                 db,
+			PreferSimpleProtocol: true,
+		})
+		gdb, err := gorm.Open(dialector, &gorm.Config{})
+		assert.NoError(t, err)
+
+		r := NewStateRepo(&UkamaDbMock{
+			GormDb: gdb,
+		})
+
+		s, err := r.GetStateBefore(nid.String(), before)
+
+		assert.NoError(t, err)
+		assert.Nil(t, s)
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err)
+	})
+}
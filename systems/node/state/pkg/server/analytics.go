@@ -0,0 +1,178 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	npb "github.com/ukama/ukama/systems/common/pb/gen/ukama"
+	"github.com/ukama/ukama/systems/common/ukama"
+	pb "github.com/ukama/ukama/systems/node/state/pb/gen"
+	"github.com/ukama/ukama/systems/node/state/pkg/analytics"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultAnalyticsRange = 7 * 24 * time.Hour
+	maxAnalyticsRange     = 366 * 24 * time.Hour
+)
+
+func (s *StateServer) GetNodeStateAnalytics(ctx context.Context, req *pb.GetNodeStateAnalyticsRequest) (*pb.GetNodeStateAnalyticsResponse, error) {
+	nId, err := ukama.ValidateNodeId(req.NodeId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid format of node id: %s", err.Error())
+	}
+
+	from, to, err := analyticsRange(req.From, req.To, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	flap := analytics.FlapConfig{Window: time.Duration(req.FlapWindowSec) * time.Second, Threshold: int(req.FlapThreshold)}
+
+	report, err := s.nodeReport(nId.String(), from, to, flap)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute state analytics: %v", err)
+	}
+
+	return &pb.GetNodeStateAnalyticsResponse{
+		Analytics: reportToPb(nId.String(), report),
+		From:      timestamppb.New(from),
+		To:        timestamppb.New(to),
+	}, nil
+}
+
+func (s *StateServer) GetSiteStateAnalytics(ctx context.Context, req *pb.GetSiteStateAnalyticsRequest) (*pb.GetSiteStateAnalyticsResponse, error) {
+	if req.SiteId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "site id cannot be empty")
+	}
+	if s.nodeClient == nil {
+		return nil, status.Errorf(codes.Unavailable, "registry client not configured")
+	}
+
+	from, to, err := analyticsRange(req.From, req.To, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	site, err := s.nodeClient.GetNodesBySite(req.SiteId)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to get nodes of site %s: %v", req.SiteId, err)
+	}
+
+	flap := analytics.FlapConfig{Window: time.Duration(req.FlapWindowSec) * time.Second, Threshold: int(req.FlapThreshold)}
+
+	resp := &pb.GetSiteStateAnalyticsResponse{
+		SiteId: req.SiteId,
+		Nodes:  []*pb.StateAnalytics{},
+		From:   timestamppb.New(from),
+		To:     timestamppb.New(to),
+	}
+
+	reports := make([]analytics.Report, 0, len(site.Nodes))
+	for _, node := range site.Nodes {
+		nId, err := ukama.ValidateNodeId(node.Id)
+		if err != nil {
+			log.Warnf("Skipping node %s of site %s in state analytics: %v", node.Id, req.SiteId, err)
+			continue
+		}
+
+		report, err := s.nodeReport(nId.String(), from, to, flap)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to compute state analytics for node %s: %v", node.Id, err)
+		}
+
+		reports = append(reports, report)
+		resp.Nodes = append(resp.Nodes, reportToPb(nId.String(), report))
+		if report.Flapping {
+			resp.FlappingNodes = append(resp.FlappingNodes, nId.String())
+		}
+	}
+
+	resp.Total = reportToPb("", analytics.Merge(from, to, reports))
+
+	return resp, nil
+}
+
+func (s *StateServer) nodeReport(nodeId string, from, to time.Time, flap analytics.FlapConfig) (analytics.Report, error) {
+	before, err := s.sRepo.GetStateBefore(nodeId, from)
+	if err != nil {
+		return analytics.Report{}, err
+	}
+
+	states, err := s.sRepo.ListTransitions(nodeId, from, to)
+	if err != nil {
+		return analytics.Report{}, err
+	}
+
+	var initial *analytics.Transition
+	if before != nil {
+		initial = &analytics.Transition{State: before.CurrentState.String(), At: before.CreatedAt}
+	}
+
+	transitions := make([]analytics.Transition, 0, len(states))
+	for _, st := range states {
+		transitions = append(transitions, analytics.Transition{State: st.CurrentState.String(), At: st.CreatedAt})
+	}
+
+	return analytics.Compute(initial, transitions, from, to, flap), nil
+}
+
+func analyticsRange(fromTs, toTs *timestamppb.Timestamp, now time.Time) (time.Time, time.Time, error) {
+	to := now
+	if toTs != nil {
+		to = toTs.AsTime()
+	}
+
+	from := to.Add(-defaultAnalyticsRange)
+	if fromTs != nil {
+		from = fromTs.AsTime()
+	}
+
+	if !to.After(from) {
+		return from, to, status.Errorf(codes.InvalidArgument, "invalid range: to must be after from")
+	}
+	if to.Sub(from) > maxAnalyticsRange {
+		return from, to, status.Errorf(codes.InvalidArgument, "invalid range: at most %s", maxAnalyticsRange)
+	}
+
+	return from, to, nil
+}
+
+func reportToPb(nodeId string, r analytics.Report) *pb.StateAnalytics {
+	out := &pb.StateAnalytics{
+		NodeId:                 nodeId,
+		TimeInState:            make([]*pb.StateDuration, 0, len(r.TimeInState)),
+		Transitions:            uint32(r.Transitions),
+		Failures:               uint32(r.Failures),
+		Recoveries:             uint32(r.Recoveries),
+		MtbfSeconds:            uint64(r.MTBF().Seconds()),
+		MttrSeconds:            uint64(r.MTTR().Seconds()),
+		MaxTransitionsInWindow: uint32(r.MaxTransitionsInWindow),
+		Flapping:               r.Flapping,
+	}
+
+	for state, d := range r.TimeInState {
+		out.TimeInState = append(out.TimeInState, &pb.StateDuration{
+			State:   npb.NodeState(npb.NodeState_value[state]),
+			Seconds: uint64(d.Seconds()),
+		})
+	}
+
+	sort.Slice(out.TimeInState, func(i, j int) bool {
+		return out.TimeInState[i].State < out.TimeInState[j].State
+	})
+
+	return out
+}
@@ -0,0 +1,132 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	mbmocks "github.com/ukama/ukama/systems/common/mocks"
+	"github.com/ukama/ukama/systems/common/msgbus"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	cpb "github.com/ukama/ukama/systems/common/pb/gen/ukama"
+	registry "github.com/ukama/ukama/systems/common/rest/client/registry"
+	"github.com/ukama/ukama/systems/common/ukama"
+	"github.com/ukama/ukama/systems/node/state/mocks"
+	pb "github.com/ukama/ukama/systems/node/state/pb/gen"
+	"github.com/ukama/ukama/systems/node/state/pkg"
+	"github.com/ukama/ukama/systems/node/state/pkg/analytics"
+	"github.com/ukama/ukama/systems/node/state/pkg/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var analyticsFrom = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func TestStateServer_GetNodeStateAnalytics(t *testing.T) {
+	repo := &mocks.StateRepo{}
+	s := NewStateServer("test-org", "test-org-id", repo, nil, nil)
+	nodeId := ukama.NewVirtualNodeId(ukama.NODE_ID_TYPE_HOMENODE).String()
+	to := analyticsFrom.Add(10 * time.Hour)
+
+	repo.On("GetStateBefore", nodeId, analyticsFrom).Return(&db.State{CurrentState: cpb.NodeState_Operational, CreatedAt: analyticsFrom.Add(-time.Hour)}, nil).Once()
+	repo.On("ListTransitions", nodeId, analyticsFrom, to).Return([]db.State{
+		{CurrentState: cpb.NodeState_Faulty, CreatedAt: analyticsFrom.Add(4 * time.Hour)},
+		{CurrentState: cpb.NodeState_Operational, CreatedAt: analyticsFrom.Add(6 * time.Hour)},
+	}, nil).Once()
+
+	resp, err := s.GetNodeStateAnalytics(context.TODO(), &pb.GetNodeStateAnalyticsRequest{
+		NodeId: nodeId, From: timestamppb.New(analyticsFrom), To: timestamppb.New(to)})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), resp.Analytics.Failures)
+	assert.Equal(t, uint64(8*3600), resp.Analytics.MtbfSeconds)
+	assert.Equal(t, uint64(2*3600), resp.Analytics.MttrSeconds)
+	assert.Equal(t, cpb.NodeState_Operational, resp.Analytics.TimeInState[0].State)
+	repo.AssertExpectations(t)
+}
+
+func TestStateServer_GetNodeStateAnalytics_InvalidRange(t *testing.T) {
+	repo := &mocks.StateRepo{}
+	s := NewStateServer("test-org", "test-org-id", repo, nil, nil)
+
+	_, err := s.GetNodeStateAnalytics(context.TODO(), &pb.GetNodeStateAnalyticsRequest{
+		NodeId: ukama.NewVirtualNodeId(ukama.NODE_ID_TYPE_HOMENODE).String(),
+		From:   timestamppb.New(analyticsFrom), To: timestamppb.New(analyticsFrom)})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	repo.AssertNotCalled(t, "ListTransitions", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestStateServer_GetSiteStateAnalytics(t *testing.T) {
+	repo := &mocks.StateRepo{}
+	nodeClient := &mbmocks.NodeClient{}
+	s := NewStateServer("test-org", "test-org-id", repo, nil, nodeClient)
+	stable := ukama.NewVirtualNodeId(ukama.NODE_ID_TYPE_TOWERNODE).String()
+	flapping := ukama.NewVirtualNodeId(ukama.NODE_ID_TYPE_AMPNODE).String()
+	to := analyticsFrom.Add(time.Hour)
+
+	nodeClient.On("GetNodesBySite", "site-1").Return(&registry.NodesBySite{
+		Nodes: []registry.NodeInfo{{Id: stable}, {Id: flapping}},
+	}, nil).Once()
+	repo.On("GetStateBefore", mock.Anything, analyticsFrom).Return(&db.State{CurrentState: cpb.NodeState_Operational}, nil)
+	repo.On("ListTransitions", stable, analyticsFrom, to).Return([]db.State{}, nil).Once()
+
+	flaps := []db.State{}
+	for i := 0; i < 4; i++ {
+		st := cpb.NodeState_Faulty
+		if i%2 == 1 {
+			st = cpb.NodeState_Operational
+		}
+		flaps = append(flaps, db.State{CurrentState: st, CreatedAt: analyticsFrom.Add(time.Duration(10+i) * time.Minute)})
+	}
+	repo.On("ListTransitions", flapping, analyticsFrom, to).Return(flaps, nil).Once()
+
+	resp, err := s.GetSiteStateAnalytics(context.TODO(), &pb.GetSiteStateAnalyticsRequest{
+		SiteId: "site-1", From: timestamppb.New(analyticsFrom), To: timestamppb.New(to),
+		FlapWindowSec: 300, FlapThreshold: 4})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Nodes, 2)
+	assert.Equal(t, []string{flapping}, resp.FlappingNodes)
+	assert.Equal(t, uint32(2), resp.Total.Failures)
+	assert.Equal(t, uint32(2), resp.Total.Recoveries)
+	assert.True(t, resp.Total.Flapping)
+}
+
+func TestStateEventServer_DetectFlappingPublishesOnce(t *testing.T) {
+	repo := &mocks.StateRepo{}
+	msgBus := &mbmocks.MsgBusServiceClient{}
+	srv := NewStateEventServer("testorg", "test-org-id", NewStateServer("testorg", "test-org-id", repo, msgBus, nil), "", msgBus,
+		analytics.FlapConfig{Window: 10 * time.Minute, Threshold: 3})
+	nodeId := ukama.NewVirtualNodeId(ukama.NODE_ID_TYPE_HOMENODE).String()
+	now := analyticsFrom.Add(time.Hour)
+
+	repo.On("ListTransitions", nodeId, mock.Anything, mock.Anything).Return([]db.State{
+		{CurrentState: cpb.NodeState_Faulty, CreatedAt: now.Add(-6 * time.Minute)},
+		{CurrentState: cpb.NodeState_Operational, CreatedAt: now.Add(-3 * time.Minute)},
+		{CurrentState: cpb.NodeState_Faulty, CreatedAt: now},
+	}, nil)
+
+	route := msgbus.NewRoutingKeyBuilder().SetCloudSource().SetSystem(pkg.SystemName).SetOrgName("testorg").
+		SetService(pkg.ServiceName).SetAction("flapping").SetObject("node").MustBuild()
+	msgBus.On("PublishRequest", route, mock.MatchedBy(func(e *epb.NodeStateFlappingEvent) bool {
+		return e.NodeId == nodeId && e.Transitions == 3 && e.WindowSec == 600
+	})).Return(nil).Once()
+
+	srv.detectFlapping(nodeId, now)
+	/* Still flapping within the same window */
+	srv.detectFlapping(nodeId, now.Add(time.Minute))
+
+	msgBus.AssertExpectations(t)
+}
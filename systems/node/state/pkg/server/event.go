@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	evt "github.com/ukama/ukama/systems/common/events"
@@ -24,6 +25,7 @@ import (
 	stm "github.com/ukama/ukama/systems/common/stateMachine"
 	pb "github.com/ukama/ukama/systems/node/state/pb/gen"
 	"github.com/ukama/ukama/systems/node/state/pkg"
+	"github.com/ukama/ukama/systems/node/state/pkg/analytics"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -75,10 +77,13 @@ import (
 	 latchedHealth  map[string]string
 	 latchedMu      sync.Mutex
 	 processingMutex sync.Map
+	 flap           analytics.FlapConfig
+	 flappedAt      map[string]time.Time
+	 flapMu         sync.Mutex
  }
  
 
- func NewStateEventServer(orgName, orgId string, s *StateServer, configPath string, msgBus mb.MsgBusServiceClient) *StateEventServer {
+ func NewStateEventServer(orgName, orgId string, s *StateServer, configPath string, msgBus mb.MsgBusServiceClient, flap analytics.FlapConfig) *StateEventServer {
 	 server := &StateEventServer{
 		 orgName:        orgName,
 		 orgId:          orgId,
@@ -90,6 +95,8 @@ import (
 		 eventBuffer:    make(map[string][]string),
 		 latchedHealth:  make(map[string]string),
 		 processingMutex: sync.Map{},
+		 flap:           flap,
+		 flappedAt:      make(map[string]time.Time),
 	 }
  
 	 if configPath == "" {
@@ -519,6 +526,8 @@ import (
 		 return false, fmt.Errorf("failed to add new state for node %s: %w", nodeId, err)
 	 }
 
+	 n.detectFlapping(nodeId, time.Now().UTC())
+
 	 return true, nil
  }
 
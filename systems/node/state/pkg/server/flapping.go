@@ -0,0 +1,75 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	"github.com/ukama/ukama/systems/node/state/pkg/analytics"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// detectFlapping publishes a flapping event when the node reached the flap
+// threshold, at most once per flap window. A zero threshold disables it.
+func (n *StateEventServer) detectFlapping(nodeId string, now time.Time) {
+	if n.flap.Threshold <= 0 || n.flap.Window <= 0 {
+		return
+	}
+
+	since := now.Add(-n.flap.Window)
+
+	states, err := n.s.ListTransitions(nodeId, since, now.Add(time.Second))
+	if err != nil {
+		log.Warnf("Failed to list transitions of node %s for flap detection: %v", nodeId, err)
+
+		return
+	}
+
+	transitions := make([]analytics.Transition, 0, len(states))
+	for _, st := range states {
+		transitions = append(transitions, analytics.Transition{State: st.CurrentState.String(), At: st.CreatedAt})
+	}
+
+	count, flapping := analytics.IsFlapping(transitions, now, n.flap)
+	if !flapping {
+		return
+	}
+
+	n.flapMu.Lock()
+	last, seen := n.flappedAt[nodeId]
+	if seen && now.Sub(last) < n.flap.Window {
+		n.flapMu.Unlock()
+
+		return
+	}
+	n.flappedAt[nodeId] = now
+	n.flapMu.Unlock()
+
+	log.Warnf("Node %s is flapping: %d transitions within %s", nodeId, count, n.flap.Window)
+
+	if n.msgbus == nil {
+		return
+	}
+
+	route := n.baseRoutingKey.SetAction("flapping").SetObject("node").MustBuild()
+
+	evt := &epb.NodeStateFlappingEvent{
+		NodeId:      nodeId,
+		Transitions: uint32(count),
+		WindowSec:   uint32(n.flap.Window.Seconds()),
+		Since:       timestamppb.New(since),
+		Timestamp:   timestamppb.New(now),
+	}
+
+	if err := n.msgbus.PublishRequest(route, evt); err != nil {
+		log.Errorf("Failed to publish message %+v with key %+v. Error: %s", evt, route, err.Error())
+	}
+}
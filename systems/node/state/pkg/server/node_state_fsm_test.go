@@ -285,7 +285,7 @@ func TestNodeStateFsm_PlatformReadyBeforeNodeIsUpIsLatched(t *testing.T) {
 		configPath:    nodeStateConfigPath,
 		instances:     make(map[string]*stm.StateMachineInstance),
 		latchedHealth: make(map[string]string),
-		s:             NewStateServer("test-org", "test-org-id", repo, nil),
+		s:             NewStateServer("test-org", "test-org-id", repo, nil, nil),
 	}
 
 	nodeId := "test-node-ready-race"
@@ -325,7 +325,7 @@ func TestNodeStateFsm_LatchSurvivesRestart(t *testing.T) {
 		configPath:    nodeStateConfigPath,
 		instances:     make(map[string]*stm.StateMachineInstance),
 		latchedHealth: make(map[string]string),
-		s:             NewStateServer("test-org", "test-org-id", repo, nil),
+		s:             NewStateServer("test-org", "test-org-id", repo, nil, nil),
 	}
 
 	latched, ok := srv.takeLatchedHealthEvent("restarted-node")
@@ -343,7 +343,7 @@ func TestStateEventServer_applyEvent_SkipsWritesWhenNothingChanges(t *testing.T)
 		configPath:    nodeStateConfigPath,
 		instances:     make(map[string]*stm.StateMachineInstance),
 		latchedHealth: make(map[string]string),
-		s:             NewStateServer("test-org", "test-org-id", repo, nil),
+		s:             NewStateServer("test-org", "test-org-id", repo, nil, nil),
 	}
 
 	instance, err := srv.getOrCreateInstance("noop-node", "Offboarded", "off")
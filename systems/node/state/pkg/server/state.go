@@ -16,6 +16,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/ukama/ukama/systems/common/grpc"
 	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
 	"github.com/ukama/ukama/systems/common/ukama"
 	"github.com/ukama/ukama/systems/common/uuid"
 	"google.golang.org/grpc/codes"
@@ -37,6 +38,7 @@ type StateServer struct {
 	sRepo           db.StateRepo
 	StateRoutingKey msgbus.RoutingKeyBuilder
 	msgbus          mb.MsgBusServiceClient
+	nodeClient      creg.NodeClient
 }
 
 func (s *StateServer) SetLatchedEvent(nodeId, event string) error {
@@ -51,13 +53,18 @@ func (s *StateServer) ListLatestStates() ([]db.State, error) {
 	return s.sRepo.ListLatestStates()
 }
 
-func NewStateServer(orgName string, orgId string, sRepo db.StateRepo, msgBus mb.MsgBusServiceClient) *StateServer {
+func (s *StateServer) ListTransitions(nodeId string, from, to time.Time) ([]db.State, error) {
+	return s.sRepo.ListTransitions(nodeId, from, to)
+}
+
+func NewStateServer(orgName string, orgId string, sRepo db.StateRepo, msgBus mb.MsgBusServiceClient, nodeClient creg.NodeClient) *StateServer {
 
 	ns := &StateServer{
 		sRepo:           sRepo,
 		orgName:         orgName,
 		msgbus:          msgBus,
 		orgId:           orgId,
+		nodeClient:      nodeClient,
 		StateRoutingKey: msgbus.NewRoutingKeyBuilder().SetCloudSource().SetSystem(pkg.SystemName).SetOrgName(orgName).SetService(pkg.ServiceName),
 	}
 
@@ -29,7 +29,7 @@ func TestStateServer_AddNodeState(t *testing.T) {
 	mockStateRepo := &mocks.StateRepo{}
 	mockMsgBusClient := &mbmocks.MsgBusServiceClient{}
 
-	stateServer := NewStateServer("test-org", "test-org-id", mockStateRepo, mockMsgBusClient)
+	stateServer := NewStateServer("test-org", "test-org-id", mockStateRepo, mockMsgBusClient, nil)
     nodeId:=ukama.NewVirtualNodeId(ukama.NODE_ID_TYPE_HOMENODE).String()
 	testCases := []struct {
 		name    string
@@ -83,7 +83,7 @@ func TestStateServer_GetLatestState(t *testing.T) {
 	mockStateRepo := &mocks.StateRepo{}
 	mockMsgBusClient := &mbmocks.MsgBusServiceClient{}
 
-	stateServer := NewStateServer("test-org", "test-org-id", mockStateRepo, mockMsgBusClient)
+	stateServer := NewStateServer("test-org", "test-org-id", mockStateRepo, mockMsgBusClient, nil)
 	nodeId := ukama.NewVirtualNodeId(ukama.NODE_ID_TYPE_HOMENODE).String()
 
 	testCases := []struct {
@@ -139,7 +139,7 @@ func TestStateServer_GetStates(t *testing.T) {
 	mockStateRepo := &mocks.StateRepo{}
 	mockMsgBusClient := &mbmocks.MsgBusServiceClient{}
 
-	stateServer := NewStateServer("test-org", "test-org-id", mockStateRepo, mockMsgBusClient)
+	stateServer := NewStateServer("test-org", "test-org-id", mockStateRepo, mockMsgBusClient, nil)
 	nodeId := ukama.NewVirtualNodeId(ukama.NODE_ID_TYPE_HOMENODE).String()
 
 	testCases := []struct {
@@ -32,7 +32,7 @@ func newTimeoutServer(t *testing.T, repo *mocks.StateRepo) *StateEventServer {
 		configPath:    nodeStateConfigPath,
 		instances:     make(map[string]*stm.StateMachineInstance),
 		latchedHealth: make(map[string]string),
-		s:             NewStateServer("test-org", "test-org-id", repo, nil),
+		s:             NewStateServer("test-org", "test-org-id", repo, nil, nil),
 	}
 }
 
@@ -92,6 +92,9 @@ func runGrpcServer() {
 		generated.RegisterDistributorServiceServer(s, distributorServer)
 	})
 
+	ic.StartHeartbeat(ic.NewInitClient(serviceConfig.Http.InitClient, client.WithDebug(serviceConfig.DebugMode)),
+		serviceConfig.OrgName, pkg.SystemName, version.Version, ic.DefaultHeartbeatInterval, nil)
+
 	go grpcServer.StartServer()
 
 	waitForExit()
@@ -62,6 +62,54 @@ func (_m *NotificationRepo) Get(id uuid.UUID) (*db.Notification, error) {
 	return r0, r1
 }
 
+// List provides a mock function with given fields: orgId, subscriberId
+func (_m *NotificationRepo) List(orgId string, subscriberId string) ([]db.Notification, error) {
+	ret := _m.Called(orgId, subscriberId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []db.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]db.Notification, error)); ok {
+		return rf(orgId, subscriberId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []db.Notification); ok {
+		r0 = rf(orgId, subscriberId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(orgId, subscriberId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Purge provides a mock function with given fields: subscriberId
+func (_m *NotificationRepo) Purge(subscriberId string) error {
+	ret := _m.Called(subscriberId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(subscriberId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewNotificationRepo creates a new instance of NotificationRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNotificationRepo(t interface {
@@ -44,6 +44,8 @@ func NewConfig(name string) *Config {
 			Timeout: 7 * time.Second,
 			ListenerRoutes: []string{
 				evt.EventRoutingKey[evt.EventOrgAdd],
+				evt.EventRoutingKey[evt.EventDataRequestCreate],
+				evt.EventRoutingKey[evt.EventDataRequestComplete],
 				evt.EventRoutingKey[evt.EventUserAdd],
 				evt.EventRoutingKey[evt.EventUserDeactivate],
 				evt.EventRoutingKey[evt.EventUserDelete],
@@ -89,6 +91,8 @@ func NewConfig(name string) *Config {
 				evt.EventRoutingKey[evt.EventNodeStateTransition],
 				evt.EventRoutingKey[evt.EventOperationCompleted],
 				evt.EventRoutingKey[evt.EventOperationFailed],
+				evt.EventRoutingKey[evt.EventHealthAlarmRaise],
+				evt.EventRoutingKey[evt.EventHealthAlarmClear],
 			}},
 	}
 }
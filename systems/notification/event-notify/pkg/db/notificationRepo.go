@@ -9,6 +9,9 @@
 package db
 
 import (
+	"fmt"
+
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
 	"github.com/ukama/ukama/systems/common/sql"
@@ -18,6 +21,8 @@ import (
 type NotificationRepo interface {
 	Add(org *Notification) error
 	Get(id uuid.UUID) (*Notification, error)
+	List(orgId, subscriberId string) ([]Notification, error)
+	Purge(subscriberId string) error
 }
 
 type notificationRepo struct {
@@ -43,3 +48,50 @@ func (r *notificationRepo) Get(id uuid.UUID) (*Notification, error) {
 	}
 	return &notification, nil
 }
+
+func (r *notificationRepo) List(orgId, subscriberId string) ([]Notification, error) {
+	var notifications []Notification
+
+	tx := r.Db.GetGormDb().Preload(clause.Associations)
+
+	if orgId != "" {
+		tx = tx.Where("org_id = ?", orgId)
+	}
+
+	if subscriberId != "" {
+		tx = tx.Where("subscriber_id = ?", subscriberId)
+	}
+
+	result := tx.Order("created_at").Find(&notifications)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return notifications, nil
+}
+
+// Purge permanently removes the notifications of a subscriber along with its
+// users entries and their read states.
+func (r *notificationRepo) Purge(subscriberId string) error {
+	if subscriberId == "" || subscriberId == EmptyUUID {
+		return fmt.Errorf("invalid uuid %s", subscriberId)
+	}
+
+	return r.Db.GetGormDb().Transaction(func(tx *gorm.DB) error {
+		users := tx.Model(&Users{}).Unscoped().Select("id").Where("subscriber_id = ?", subscriberId)
+
+		result := tx.Unscoped().Where("user_id IN (?)", users).Delete(&UserNotification{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Unscoped().Where("subscriber_id = ?", subscriberId).Delete(&Users{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Unscoped().Where("subscriber_id = ?", subscriberId).Delete(&Notification{})
+
+		return result.Error
+	})
+}
@@ -124,3 +124,62 @@ func TestNotificationRepo_Update(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestNotificationRepo_Purge(t *testing.T) {
+	subscriberId := uuid.NewV4().String()
+
+	t.Run("Purge", func(t *testing.T) {
+		// Arrange
+		var db *extsql.DB
+
+		db, mock, err := sqlmock.New() // mock sql.DB
+		assert.NoError(t, err)
+
+		mock.ExpectBegin()
+
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "user_notifications"`)).
+			WithArgs(subscriberId).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "users"`)).
+			WithArgs(subscriberId).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "notifications"`)).
+			WithArgs(subscriberId).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		mock.ExpectCommit()
+
+		dialector := postgres.New(postgres.Config{
+			DSN:                  "sqlmock_db_0",
+			DriverName:           "postgres",
+			Conn:                 db,
+			PreferSimpleProtocol: true,
+		})
+
+		gdb, err := gorm.Open(dialector, &gorm.Config{})
+		assert.NoError(t, err)
+
+		r := int_db.NewNotificationRepo(&UkamaDbMock{
+			GormDb: gdb,
+		})
+
+		// Act
+		err = r.Purge(subscriberId)
+
+		// Assert
+		assert.NoError(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err)
+	})
+
+	t.Run("InvalidSubscriber", func(t *testing.T) {
+		r := int_db.NewNotificationRepo(&UkamaDbMock{})
+
+		err := r.Purge("")
+
+		assert.Error(t, err)
+	})
+}
@@ -11,12 +11,18 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
 
 	"github.com/ukama/ukama/systems/common/msgbus"
+	"github.com/ukama/ukama/systems/common/privacy"
 	"github.com/ukama/ukama/systems/common/roles"
 	"github.com/ukama/ukama/systems/common/ukama"
 	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/notification/event-notify/pkg"
 	"github.com/ukama/ukama/systems/notification/event-notify/pkg/db"
 
 	log "github.com/sirupsen/logrus"
@@ -375,6 +381,22 @@ func (es *EventToNotifyEventServer) EventNotification(ctx context.Context, e *ep
 		}
 		return handleEventOperationFailed(es, msg, &c)
 
+	case msgbus.PrepareRoute(es.orgName, evt.EventRoutingKey[evt.EventHealthAlarmRaise]):
+		c := evt.EventToEventConfig[evt.EventHealthAlarmRaise]
+		msg, err := epb.UnmarshalHealthAlarmEvent(e.Msg, c.Name)
+		if err != nil {
+			return nil, err
+		}
+		return handleEventHealthAlarm(es, msg, &c)
+
+	case msgbus.PrepareRoute(es.orgName, evt.EventRoutingKey[evt.EventHealthAlarmClear]):
+		c := evt.EventToEventConfig[evt.EventHealthAlarmClear]
+		msg, err := epb.UnmarshalHealthAlarmEvent(e.Msg, c.Name)
+		if err != nil {
+			return nil, err
+		}
+		return handleEventHealthAlarm(es, msg, &c)
+
 	case msgbus.PrepareRoute(es.orgName, evt.EventRoutingKey[evt.EventInvoiceGenerate]):
 		c := evt.EventToEventConfig[evt.EventInvoiceGenerate]
 		msg, err := epb.UnmarshalReport(e.Msg, c.Name)
@@ -383,6 +405,22 @@ func (es *EventToNotifyEventServer) EventNotification(ctx context.Context, e *ep
 		}
 		return handleEventInvoiceGenerate(es, msg, &c)
 
+	case msgbus.PrepareRoute(es.orgName, evt.EventRoutingKey[evt.EventDataRequestCreate]):
+		c := evt.EventToEventConfig[evt.EventDataRequestCreate]
+		msg, err := epb.UnmarshalEventDataRequest(e.Msg, c.Name)
+		if err != nil {
+			return nil, err
+		}
+		return handleEventDataRequestCreate(es, msg, &c)
+
+	case msgbus.PrepareRoute(es.orgName, evt.EventRoutingKey[evt.EventDataRequestComplete]):
+		c := evt.EventToEventConfig[evt.EventDataRequestComplete]
+		msg, err := epb.UnmarshalEventDataRequestCompleted(e.Msg, c.Name)
+		if err != nil {
+			return nil, err
+		}
+		return handleEventDataRequestComplete(es, msg, &c)
+
 	default:
 		log.Errorf("No handler routing key %s", e.RoutingKey)
 		return nil, fmt.Errorf("no handler for routing key %s", e.RoutingKey)
@@ -794,6 +832,43 @@ func handleEventNodeStateTransition(es *EventToNotifyEventServer, msg *epb.NodeS
 	return es.processEvent(&dynamicConfig, es.orgId, "", msg.NodeId, "", "", jmsg, msg.NodeId)
 }
 
+func handleEventHealthAlarm(es *EventToNotifyEventServer, msg *epb.HealthAlarmEvent, c *evt.EventConfig) (*epb.EventResponse, error) {
+	jmsg, err := json.Marshal(msg)
+	if err != nil {
+		log.Errorf("Failed to marshal message for %s to JSON. Error %+v", c.Name, err)
+		return nil, err
+	}
+
+	dynamicConfig := *c
+	shortNodeId := msg.NodeId
+	if len(msg.NodeId) > 6 {
+		shortNodeId = msg.NodeId[len(msg.NodeId)-6:]
+	}
+
+	alarm := strings.ReplaceAll(msg.Type, "_", " ")
+	if msg.State == "cleared" {
+		dynamicConfig.Title = fmt.Sprintf("Node %s: %s alarm cleared", shortNodeId, alarm)
+		dynamicConfig.Type = notif.TYPE_INFO
+	} else {
+		dynamicConfig.Title = fmt.Sprintf("Node %s: %s alarm raised", shortNodeId, alarm)
+		switch msg.Severity {
+		case "critical":
+			dynamicConfig.Type = notif.TYPE_CRITICAL
+		case "major":
+			dynamicConfig.Type = notif.TYPE_ERROR
+		default:
+			dynamicConfig.Type = notif.TYPE_WARNING
+		}
+	}
+
+	dynamicConfig.Description = fmt.Sprintf("Source: %s", msg.Source)
+	if msg.Message != "" {
+		dynamicConfig.Description = fmt.Sprintf("Source: %s. %s", msg.Source, msg.Message)
+	}
+
+	return es.processEvent(&dynamicConfig, es.orgId, "", msg.NodeId, "", "", jmsg, msg.NodeId)
+}
+
 func handleEventPaymentSuccess(es *EventToNotifyEventServer, msg *epb.Payment, c *evt.EventConfig) (*epb.EventResponse, error) {
 	jmsg, err := json.Marshal(msg)
 	if err != nil {
@@ -877,6 +952,91 @@ func handleEventInvoiceGenerate(es *EventToNotifyEventServer, msg *epb.Report, c
 	return es.processEvent(c, es.orgId, msg.NetworkId, "", "", "", jmsg, msg.Id)
 }
 
+// handleEventDataRequestCreate exports the notifications of the org or of a
+// subscriber, or removes those of a subscriber for an erasure, and answers
+// member with the outcome.
+func handleEventDataRequestCreate(es *EventToNotifyEventServer, msg *epb.EventDataRequest, c *evt.EventConfig) (*epb.EventResponse, error) {
+	log.Infof("Processing %s for %s %s", c.Name, msg.SubjectKind, msg.SubjectId)
+
+	subscriberId := ""
+	if msg.SubjectKind == privacy.SubjectSubscriber {
+		subscriberId = msg.SubjectId
+	}
+
+	var doc privacy.Document
+	var err error
+	if msg.Kind == privacy.KindErase {
+		err = es.n.notificationRepo.Purge(subscriberId)
+	} else {
+		doc, err = es.exportNotifications(subscriberId)
+	}
+
+	res := privacy.NewResult(pkg.SystemName+"."+pkg.ServiceName, msg, doc, err)
+
+	route := es.n.baseRoutingKey.SetAction("complete").SetObject("datarequest").MustBuild()
+
+	err = es.n.msgbus.PublishRequest(route, res)
+	if err != nil {
+		log.Errorf("Failed to publish message %+v with key %+v. Errors %s", res, route, err.Error())
+		return nil, err
+	}
+
+	return &epb.EventResponse{}, nil
+}
+
+func handleEventDataRequestComplete(es *EventToNotifyEventServer, msg *epb.EventDataRequestCompleted, c *evt.EventConfig) (*epb.EventResponse, error) {
+	jmsg, err := json.Marshal(msg)
+	if err != nil {
+		log.Errorf("Failed to marshal message for %s to JSON. Error %+v", c.Name, err)
+		return nil, err
+	}
+	return es.processEvent(c, es.orgId, "", "", "", "", jmsg, msg.Id)
+}
+
+func (es *EventToNotifyEventServer) exportNotifications(subscriberId string) (privacy.Document, error) {
+	orgId := es.orgId
+	if subscriberId != "" {
+		orgId = ""
+	}
+
+	notifications, err := es.n.notificationRepo.List(orgId, subscriberId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+
+	doc := privacy.Document{}
+	if err := doc.Add("notifications", notifications); err != nil {
+		return nil, err
+	}
+
+	if subscriberId == "" {
+		users, err := es.n.userRepo.GetAllUsers(es.orgId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list users: %w", err)
+		}
+
+		if err := doc.Add("users", users); err != nil {
+			return nil, err
+		}
+
+		return doc, nil
+	}
+
+	user, err := es.n.userRepo.GetSubscriber(subscriberId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return doc, nil
+		}
+		return nil, fmt.Errorf("failed to get subscriber user: %w", err)
+	}
+
+	if err := doc.Add("users", []*db.Users{user}); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
 func (es *EventToNotifyEventServer) processEvent(ec *evt.EventConfig, orgId, networkId, nodeId, subscriberId, userId string, msg []byte, rid string) (*epb.EventResponse, error) {
 	log.Debugf("Processing event OrgId %s NetworkId %s nodeId %s subscriberId %s userId %s", orgId, networkId, nodeId, subscriberId, userId)
 
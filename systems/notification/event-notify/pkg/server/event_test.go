@@ -11,6 +11,8 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -22,6 +24,7 @@ import (
 	evt "github.com/ukama/ukama/systems/common/events"
 	cmocks "github.com/ukama/ukama/systems/common/mocks"
 	"github.com/ukama/ukama/systems/common/msgbus"
+	notif "github.com/ukama/ukama/systems/common/notification"
 	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
 	"github.com/ukama/ukama/systems/common/roles"
 	"github.com/ukama/ukama/systems/common/uuid"
@@ -1956,6 +1959,61 @@ func TestEventNotification(t *testing.T) {
 		unRepo.AssertExpectations(t)
 	})
 
+	t.Run("EventHealthAlarmRaise_HealthAlarmEvent", func(t *testing.T) {
+		eventServer, nRepo, uRepo, emRepo, _, _, unRepo := createTestEventServer()
+
+		testEvent := createTestEventFromRaw(
+			msgbus.PrepareRoute(testOrgName, evt.EventRoutingKey[evt.EventHealthAlarmRaise]),
+			`{"alarmId":"a1","nodeId":"uk-sa2643-hnode-v0-4e86","type":"radio_fault","source":"fem1","severity":"critical","state":"raised"}`,
+			&epb.HealthAlarmEvent{},
+		)
+
+		emRepo.On("Add", mock.MatchedBy(func(event *db.EventMsg) bool {
+			return event.Key == evt.EventToEventConfig[evt.EventHealthAlarmRaise].Name
+		})).Return(uint(1), nil)
+		nRepo.On("Add", mock.MatchedBy(func(n *db.Notification) bool {
+			return n.Title == "Node 0-4e86: radio fault alarm raised" && n.Type == notif.TYPE_CRITICAL &&
+				n.NodeId == "uk-sa2643-hnode-v0-4e86"
+		})).Return(nil)
+		uRepo.On("GetUserWithRoles", mock.AnythingOfType("string"), mock.AnythingOfType("[]roles.RoleType")).Return([]*db.Users{
+			{Id: uuid.NewV4(), Role: roles.TYPE_OWNER},
+		}, nil)
+		uRepo.On("GetUser", mock.AnythingOfType("string")).Return(&db.Users{Id: uuid.NewV4(), Role: roles.TYPE_USERS}, nil).Maybe()
+		unRepo.On("Add", mock.Anything).Return(nil)
+
+		response, err := eventServer.EventNotification(context.Background(), testEvent)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		nRepo.AssertExpectations(t)
+	})
+
+	t.Run("EventHealthAlarmClear_HealthAlarmEvent", func(t *testing.T) {
+		eventServer, nRepo, uRepo, emRepo, _, _, unRepo := createTestEventServer()
+
+		testEvent := createTestEventFromRaw(
+			msgbus.PrepareRoute(testOrgName, evt.EventRoutingKey[evt.EventHealthAlarmClear]),
+			`{"alarmId":"a1","nodeId":"uk-sa2643-hnode-v0-4e86","type":"temperature","source":"power","severity":"warning","state":"cleared"}`,
+			&epb.HealthAlarmEvent{},
+		)
+
+		emRepo.On("Add", mock.Anything).Return(uint(1), nil)
+		nRepo.On("Add", mock.MatchedBy(func(n *db.Notification) bool {
+			return n.Title == "Node 0-4e86: temperature alarm cleared" && n.Type == notif.TYPE_INFO
+		})).Return(nil)
+		uRepo.On("GetUserWithRoles", mock.AnythingOfType("string"), mock.AnythingOfType("[]roles.RoleType")).Return([]*db.Users{
+			{Id: uuid.NewV4(), Role: roles.TYPE_OWNER},
+		}, nil)
+		uRepo.On("GetUser", mock.AnythingOfType("string")).Return(&db.Users{Id: uuid.NewV4(), Role: roles.TYPE_USERS}, nil).Maybe()
+		unRepo.On("Add", mock.Anything).Return(nil)
+
+		response, err := eventServer.EventNotification(context.Background(), testEvent)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		nRepo.AssertExpectations(t)
+	})
+
 	t.Run("EventPaymentSuccess_PaymentSuccessEvent", func(t *testing.T) {
 		eventServer, nRepo, uRepo, emRepo, _, _, unRepo := createTestEventServer()
 
@@ -2123,3 +2181,114 @@ func TestEventNotification(t *testing.T) {
 		unRepo.AssertExpectations(t)
 	})
 }
+
+func TestEventNotification_DataRequest(t *testing.T) {
+	createRoute := msgbus.PrepareRoute(testOrgName, evt.EventRoutingKey[evt.EventDataRequestCreate])
+	completeRoute := "event.cloud.local.testorg.notification.eventnotify.datarequest.complete"
+	subscriberId := uuid.NewV4().String()
+
+	t.Run("ExportSubscriber", func(t *testing.T) {
+		eventServer, nRepo, uRepo, _, _, msgclient, _ := createTestEventServer()
+
+		req := &epb.EventDataRequest{
+			Id:          uuid.NewV4().String(),
+			Kind:        "export",
+			SubjectKind: "subscriber",
+			SubjectId:   subscriberId,
+		}
+
+		n := db.Notification{Id: uuid.NewV4(), Title: "Title1", SubscriberId: subscriberId}
+		assert.NoError(t, n.EventMsg.Data.Set([]byte(`{"subscriberId":"`+subscriberId+`"}`)))
+
+		nRepo.On("List", "", subscriberId).Return([]db.Notification{n}, nil)
+		uRepo.On("GetSubscriber", subscriberId).Return(&db.Users{Id: uuid.NewV4(), SubscriberId: subscriberId}, nil)
+
+		msgclient.On("PublishRequest", completeRoute, mock.MatchedBy(func(r *epb.EventDataRequestResult) bool {
+			return r.Success && r.RequestId == req.Id && r.Service == "notification.eventnotify" &&
+				strings.Contains(string(r.Data), "Title1") && strings.Contains(string(r.Data), "users")
+		})).Return(nil).Once()
+
+		response, err := eventServer.EventNotification(context.Background(), createTestEvent(createRoute, req))
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		nRepo.AssertExpectations(t)
+		uRepo.AssertExpectations(t)
+		msgclient.AssertExpectations(t)
+	})
+
+	t.Run("EraseSubscriber", func(t *testing.T) {
+		eventServer, nRepo, _, _, _, msgclient, _ := createTestEventServer()
+
+		req := &epb.EventDataRequest{
+			Id:          uuid.NewV4().String(),
+			Kind:        "erase",
+			SubjectKind: "subscriber",
+			SubjectId:   subscriberId,
+		}
+
+		nRepo.On("Purge", subscriberId).Return(nil)
+
+		msgclient.On("PublishRequest", completeRoute, mock.MatchedBy(func(r *epb.EventDataRequestResult) bool {
+			return r.Success && len(r.Data) == 0
+		})).Return(nil).Once()
+
+		response, err := eventServer.EventNotification(context.Background(), createTestEvent(createRoute, req))
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		nRepo.AssertExpectations(t)
+		msgclient.AssertExpectations(t)
+	})
+
+	t.Run("ExportFailed", func(t *testing.T) {
+		eventServer, nRepo, _, _, _, msgclient, _ := createTestEventServer()
+
+		req := &epb.EventDataRequest{
+			Id:          uuid.NewV4().String(),
+			Kind:        "export",
+			SubjectKind: "org",
+			SubjectId:   testOrgUUID,
+		}
+
+		nRepo.On("List", testOrgUUID, "").Return(nil, errors.New("db down"))
+
+		msgclient.On("PublishRequest", completeRoute, mock.MatchedBy(func(r *epb.EventDataRequestResult) bool {
+			return !r.Success && strings.Contains(r.Error, "db down")
+		})).Return(nil).Once()
+
+		_, err := eventServer.EventNotification(context.Background(), createTestEvent(createRoute, req))
+
+		assert.NoError(t, err)
+		msgclient.AssertExpectations(t)
+	})
+
+	t.Run("Completed", func(t *testing.T) {
+		eventServer, nRepo, uRepo, emRepo, _, _, unRepo := createTestEventServer()
+
+		emRepo.On("Add", mock.MatchedBy(func(event *db.EventMsg) bool {
+			return event.Key == evt.EventToEventConfig[evt.EventDataRequestComplete].Name
+		})).Return(uint(1), nil)
+		nRepo.On("Add", mock.Anything).Return(nil)
+		uRepo.On("GetUserWithRoles", mock.AnythingOfType("string"), mock.AnythingOfType("[]roles.RoleType")).Return([]*db.Users{
+			{Id: uuid.NewV4(), Role: roles.TYPE_OWNER},
+		}, nil)
+		unRepo.On("Add", mock.Anything).Return(nil)
+
+		msg := &epb.EventDataRequestCompleted{
+			Id:          uuid.NewV4().String(),
+			Kind:        "export",
+			SubjectKind: "org",
+			SubjectId:   testOrgUUID,
+			Status:      "completed",
+		}
+
+		response, err := eventServer.EventNotification(context.Background(),
+			createTestEvent(msgbus.PrepareRoute(testOrgName, evt.EventRoutingKey[evt.EventDataRequestComplete]), msg))
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		emRepo.AssertExpectations(t)
+		nRepo.AssertExpectations(t)
+	})
+}
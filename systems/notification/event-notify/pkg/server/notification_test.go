@@ -848,6 +848,27 @@ func (m *mockMemberClient) AddMember(uuid string) (*creg.MemberInfoResponse, err
 	return args.Get(0).(*creg.MemberInfoResponse), args.Error(1)
 }
 
+func (m *mockMemberClient) AddMemberWithRole(uuid string, role string) (*creg.MemberInfoResponse, error) {
+	args := m.Called(uuid, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*creg.MemberInfoResponse), args.Error(1)
+}
+
+func (m *mockMemberClient) AddRoleBinding(userId string, binding creg.MemberBinding) error {
+	args := m.Called(userId, binding)
+	return args.Error(0)
+}
+
+func (m *mockMemberClient) GetAccess(userId string) (*creg.MemberAccess, error) {
+	args := m.Called(userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*creg.MemberAccess), args.Error(1)
+}
+
 type mockMsgBusServiceClient struct {
 	mock.Mock
 }
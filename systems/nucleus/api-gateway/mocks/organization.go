@@ -42,6 +42,66 @@ func (_m *organization) AddOrg(orgName string, owner string, certificate string,
 	return r0, r1
 }
 
+// AddServiceAccount provides a mock function with given fields: name, description, createdBy, bindings
+func (_m *organization) AddServiceAccount(name string, description string, createdBy string, bindings []*gen.RoleBinding) (*gen.ServiceAccountResponse, error) {
+	ret := _m.Called(name, description, createdBy, bindings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddServiceAccount")
+	}
+
+	var r0 *gen.ServiceAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, []*gen.RoleBinding) (*gen.ServiceAccountResponse, error)); ok {
+		return rf(name, description, createdBy, bindings)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, []*gen.RoleBinding) *gen.ServiceAccountResponse); ok {
+		r0 = rf(name, description, createdBy, bindings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ServiceAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, []*gen.RoleBinding) error); ok {
+		r1 = rf(name, description, createdBy, bindings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateApiKey provides a mock function with given fields: accountId, ttlDays
+func (_m *organization) CreateApiKey(accountId string, ttlDays uint32) (*gen.CreateApiKeyResponse, error) {
+	ret := _m.Called(accountId, ttlDays)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateApiKey")
+	}
+
+	var r0 *gen.CreateApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, uint32) (*gen.CreateApiKeyResponse, error)); ok {
+		return rf(accountId, ttlDays)
+	}
+	if rf, ok := ret.Get(0).(func(string, uint32) *gen.CreateApiKeyResponse); ok {
+		r0 = rf(accountId, ttlDays)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CreateApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, uint32) error); ok {
+		r1 = rf(accountId, ttlDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetOrg provides a mock function with given fields: orgName
 func (_m *organization) GetOrg(orgName string) (*gen.GetByNameResponse, error) {
 	ret := _m.Called(orgName)
@@ -102,6 +162,126 @@ func (_m *organization) GetOrgs(ownerUUID string) (*gen.GetByUserResponse, error
 	return r0, r1
 }
 
+// GetServiceAccount provides a mock function with given fields: id
+func (_m *organization) GetServiceAccount(id string) (*gen.ServiceAccountResponse, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServiceAccount")
+	}
+
+	var r0 *gen.ServiceAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*gen.ServiceAccountResponse, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gen.ServiceAccountResponse); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ServiceAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListApiKeyUsage provides a mock function with given fields: accountId, limit
+func (_m *organization) ListApiKeyUsage(accountId string, limit uint32) (*gen.ListApiKeyUsageResponse, error) {
+	ret := _m.Called(accountId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListApiKeyUsage")
+	}
+
+	var r0 *gen.ListApiKeyUsageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, uint32) (*gen.ListApiKeyUsageResponse, error)); ok {
+		return rf(accountId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, uint32) *gen.ListApiKeyUsageResponse); ok {
+		r0 = rf(accountId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListApiKeyUsageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, uint32) error); ok {
+		r1 = rf(accountId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListApiKeys provides a mock function with given fields: accountId
+func (_m *organization) ListApiKeys(accountId string) (*gen.ListApiKeysResponse, error) {
+	ret := _m.Called(accountId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListApiKeys")
+	}
+
+	var r0 *gen.ListApiKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*gen.ListApiKeysResponse, error)); ok {
+		return rf(accountId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gen.ListApiKeysResponse); ok {
+		r0 = rf(accountId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListApiKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(accountId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListServiceAccounts provides a mock function with no fields
+func (_m *organization) ListServiceAccounts() (*gen.ListServiceAccountsResponse, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListServiceAccounts")
+	}
+
+	var r0 *gen.ListServiceAccountsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*gen.ListServiceAccountsResponse, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *gen.ListServiceAccountsResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListServiceAccountsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveOrgForUser provides a mock function with given fields: orgId, userId
 func (_m *organization) RemoveOrgForUser(orgId string, userId string) (*gen.RemoveOrgForUserResponse, error) {
 	ret := _m.Called(orgId, userId)
@@ -132,6 +312,84 @@ func (_m *organization) RemoveOrgForUser(orgId string, userId string) (*gen.Remo
 	return r0, r1
 }
 
+// RevokeApiKey provides a mock function with given fields: keyId
+func (_m *organization) RevokeApiKey(keyId string) error {
+	ret := _m.Called(keyId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeApiKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(keyId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeServiceAccount provides a mock function with given fields: id
+func (_m *organization) RevokeServiceAccount(id string) (*gen.ServiceAccountResponse, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeServiceAccount")
+	}
+
+	var r0 *gen.ServiceAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*gen.ServiceAccountResponse, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gen.ServiceAccountResponse); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ServiceAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RotateApiKey provides a mock function with given fields: keyId, graceMinutes, ttlDays
+func (_m *organization) RotateApiKey(keyId string, graceMinutes uint32, ttlDays uint32) (*gen.CreateApiKeyResponse, error) {
+	ret := _m.Called(keyId, graceMinutes, ttlDays)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateApiKey")
+	}
+
+	var r0 *gen.CreateApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, uint32, uint32) (*gen.CreateApiKeyResponse, error)); ok {
+		return rf(keyId, graceMinutes, ttlDays)
+	}
+	if rf, ok := ret.Get(0).(func(string, uint32, uint32) *gen.CreateApiKeyResponse); ok {
+		r0 = rf(keyId, graceMinutes, ttlDays)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CreateApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, uint32, uint32) error); ok {
+		r1 = rf(keyId, graceMinutes, ttlDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateOrgToUser provides a mock function with given fields: orgId, userId
 func (_m *organization) UpdateOrgToUser(orgId string, userId string) (*gen.UpdateOrgForUserResponse, error) {
 	ret := _m.Called(orgId, userId)
@@ -162,6 +420,36 @@ func (_m *organization) UpdateOrgToUser(orgId string, userId string) (*gen.Updat
 	return r0, r1
 }
 
+// ValidateApiKey provides a mock function with given fields: key, orgId, method, path
+func (_m *organization) ValidateApiKey(key string, orgId string, method string, path string) (*gen.ValidateApiKeyResponse, error) {
+	ret := _m.Called(key, orgId, method, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateApiKey")
+	}
+
+	var r0 *gen.ValidateApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*gen.ValidateApiKeyResponse, error)); ok {
+		return rf(key, orgId, method, path)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *gen.ValidateApiKeyResponse); ok {
+		r0 = rf(key, orgId, method, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ValidateApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(key, orgId, method, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // newOrganization creates a new instance of organization. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newOrganization(t interface {
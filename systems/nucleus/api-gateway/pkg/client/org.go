@@ -101,3 +101,79 @@ func (o *OrgRegistry) RemoveOrgForUser(orgId string, userId string) (*orgpb.Remo
 		OrgId:  orgId,
 	})
 }
+
+func (o *OrgRegistry) AddServiceAccount(name, description, createdBy string, bindings []*orgpb.RoleBinding) (*orgpb.ServiceAccountResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), o.timeout)
+	defer cancel()
+
+	return o.orgClient.AddServiceAccount(ctx, &orgpb.AddServiceAccountRequest{
+		Name:        name,
+		Description: description,
+		CreatedBy:   createdBy,
+		Bindings:    bindings,
+	})
+}
+
+func (o *OrgRegistry) GetServiceAccount(id string) (*orgpb.ServiceAccountResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), o.timeout)
+	defer cancel()
+
+	return o.orgClient.GetServiceAccount(ctx, &orgpb.GetServiceAccountRequest{Id: id})
+}
+
+func (o *OrgRegistry) ListServiceAccounts() (*orgpb.ListServiceAccountsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), o.timeout)
+	defer cancel()
+
+	return o.orgClient.ListServiceAccounts(ctx, &orgpb.ListServiceAccountsRequest{})
+}
+
+func (o *OrgRegistry) RevokeServiceAccount(id string) (*orgpb.ServiceAccountResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), o.timeout)
+	defer cancel()
+
+	return o.orgClient.RevokeServiceAccount(ctx, &orgpb.RevokeServiceAccountRequest{Id: id})
+}
+
+func (o *OrgRegistry) CreateApiKey(accountId string, ttlDays uint32) (*orgpb.CreateApiKeyResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), o.timeout)
+	defer cancel()
+
+	return o.orgClient.CreateApiKey(ctx, &orgpb.CreateApiKeyRequest{AccountId: accountId, TtlDays: ttlDays})
+}
+
+func (o *OrgRegistry) RotateApiKey(keyId string, graceMinutes, ttlDays uint32) (*orgpb.CreateApiKeyResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), o.timeout)
+	defer cancel()
+
+	return o.orgClient.RotateApiKey(ctx, &orgpb.RotateApiKeyRequest{Id: keyId, GraceMinutes: graceMinutes, TtlDays: ttlDays})
+}
+
+func (o *OrgRegistry) RevokeApiKey(keyId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), o.timeout)
+	defer cancel()
+
+	_, err := o.orgClient.RevokeApiKey(ctx, &orgpb.RevokeApiKeyRequest{Id: keyId})
+	return err
+}
+
+func (o *OrgRegistry) ListApiKeys(accountId string) (*orgpb.ListApiKeysResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), o.timeout)
+	defer cancel()
+
+	return o.orgClient.ListApiKeys(ctx, &orgpb.ListApiKeysRequest{AccountId: accountId})
+}
+
+func (o *OrgRegistry) ValidateApiKey(key, orgId, method, path string) (*orgpb.ValidateApiKeyResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), o.timeout)
+	defer cancel()
+
+	return o.orgClient.ValidateApiKey(ctx, &orgpb.ValidateApiKeyRequest{Key: key, OrgId: orgId, Method: method, Path: path})
+}
+
+func (o *OrgRegistry) ListApiKeyUsage(accountId string, limit uint32) (*orgpb.ListApiKeyUsageResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), o.timeout)
+	defer cancel()
+
+	return o.orgClient.ListApiKeyUsage(ctx, &orgpb.ListApiKeyUsageRequest{AccountId: accountId, Limit: limit})
+}
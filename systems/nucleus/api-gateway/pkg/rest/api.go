@@ -57,6 +57,56 @@ type UpdateMemberRequest struct {
 	Role          string `example:"member" json:"role,omitempty"`
 }
 
+// Service accounts group
+
+type RoleBinding struct {
+	Role      string `example:"billing" json:"role" validate:"required"`
+	ScopeKind string `example:"org" json:"scope_kind"`
+	ScopeId   string `example:"" json:"scope_id"`
+}
+
+type AddServiceAccountRequest struct {
+	Name        string        `example:"billing-sync" json:"name" validate:"required"`
+	Description string        `example:"Billing integration" json:"description"`
+	Bindings    []RoleBinding `json:"bindings" validate:"required"`
+}
+
+type GetServiceAccountsRequest struct {
+}
+
+type ServiceAccountRequest struct {
+	AccountId string `example:"{{AccountId}}" path:"account_id" validate:"required"`
+}
+
+type CreateApiKeyRequest struct {
+	AccountId string `example:"{{AccountId}}" path:"account_id" validate:"required"`
+	TtlDays   uint32 `example:"90" json:"ttl_days"`
+}
+
+type RotateApiKeyRequest struct {
+	AccountId    string `example:"{{AccountId}}" path:"account_id" validate:"required"`
+	KeyId        string `example:"{{KeyId}}" path:"key_id" validate:"required"`
+	GraceMinutes uint32 `example:"60" json:"grace_minutes"`
+	TtlDays      uint32 `example:"90" json:"ttl_days"`
+}
+
+type ApiKeyRequest struct {
+	AccountId string `example:"{{AccountId}}" path:"account_id" validate:"required"`
+	KeyId     string `example:"{{KeyId}}" path:"key_id" validate:"required"`
+}
+
+type GetApiKeyUsageRequest struct {
+	AccountId string `example:"{{AccountId}}" path:"account_id" validate:"required"`
+	Limit     uint32 `example:"100" form:"limit" query:"limit"`
+}
+
+type ValidateApiKeyRequest struct {
+	Key    string `example:"uk_0011223344556677_secret" json:"key" validate:"required"`
+	OrgId  string `example:"{{OrgId}}" json:"org_id" validate:"required"`
+	Method string `example:"GET" json:"method"`
+	Path   string `example:"/v1/orgs" json:"path"`
+}
+
 // Users group
 
 type GetUserRequest struct {
@@ -20,6 +20,7 @@ import (
 
 	"github.com/ukama/ukama/systems/common/config"
 	"github.com/ukama/ukama/systems/common/rest"
+	"github.com/ukama/ukama/systems/common/roles"
 	"github.com/ukama/ukama/systems/nucleus/api-gateway/cmd/version"
 	"github.com/ukama/ukama/systems/nucleus/api-gateway/pkg"
 	"github.com/ukama/ukama/systems/nucleus/api-gateway/pkg/client"
@@ -36,9 +37,10 @@ const (
 )
 
 type Router struct {
-	f       *fizz.Fizz
-	clients *Clients
-	config  *RouterConfig
+	f        *fizz.Fizz
+	internal *fizz.Fizz
+	clients  *Clients
+	config   *RouterConfig
 }
 
 type RouterConfig struct {
@@ -62,6 +64,16 @@ type organization interface {
 	GetOrgs(ownerUUID string) (*orgpb.GetByUserResponse, error)
 	UpdateOrgToUser(orgId string, userId string) (*orgpb.UpdateOrgForUserResponse, error)
 	RemoveOrgForUser(orgId string, userId string) (*orgpb.RemoveOrgForUserResponse, error)
+	AddServiceAccount(name, description, createdBy string, bindings []*orgpb.RoleBinding) (*orgpb.ServiceAccountResponse, error)
+	GetServiceAccount(id string) (*orgpb.ServiceAccountResponse, error)
+	ListServiceAccounts() (*orgpb.ListServiceAccountsResponse, error)
+	RevokeServiceAccount(id string) (*orgpb.ServiceAccountResponse, error)
+	CreateApiKey(accountId string, ttlDays uint32) (*orgpb.CreateApiKeyResponse, error)
+	RotateApiKey(keyId string, graceMinutes, ttlDays uint32) (*orgpb.CreateApiKeyResponse, error)
+	RevokeApiKey(keyId string) error
+	ListApiKeys(accountId string) (*orgpb.ListApiKeysResponse, error)
+	ValidateApiKey(key, orgId, method, path string) (*orgpb.ValidateApiKeyResponse, error)
+	ListApiKeyUsage(accountId string, limit uint32) (*orgpb.ListApiKeyUsageResponse, error)
 }
 
 func NewClientsSet(endpoints *pkg.GrpcEndpoints) *Clients {
@@ -98,6 +110,8 @@ func NewRouterConfig(svcConf *pkg.Config) *RouterConfig {
 }
 
 func (rt *Router) Run() {
+	rest.RunInternal(rt.internal, rt.config.serverConf)
+
 	log.Info("Listening on port ", rt.config.serverConf.Port)
 	err := rt.f.Engine().Run(fmt.Sprint(":", rt.config.serverConf.Port))
 	if err != nil {
@@ -120,6 +134,12 @@ func (r *Router) init(f func(*gin.Context, string) error) {
 		}, r.config.grpcEndpoints.Timeout)
 	}
 
+	// API keys are validated while a request is being authenticated, so this
+	// is not authenticated itself and only served on the internal listener.
+	r.internal = rest.NewInternalFizzRouter(pkg.SystemName, version.Version)
+	authz := r.internal.Group("/authz/v1", "Authorization", "Lookups for the authentication of the gateways")
+	authz.POST("/service-accounts/keys/validate", formatDoc("Validate API Key", "Resolve an API key to its service account and audit its use"), tonic.Handler(r.validateApiKeyHandler, http.StatusOK))
+
 	auth := r.f.Group("/v1", "API gateway", "Registry system version v1", func(ctx *gin.Context) {
 		if r.config.auth.BypassAuthMode {
 			ctx.Set(USER_ID_KEY, DUMMY_AUTH_ID)
@@ -158,6 +178,19 @@ func (r *Router) init(f func(*gin.Context, string) error) {
 		// Deactivate user
 		// Delete user
 		// users.DELETE("/:user_id", formatDoc("Remove User", "Remove a user from the registry"), tonic.Handler(r.removeUserHandler, http.StatusOK))
+
+		// service account routes
+		const sa = "/service-accounts"
+		accounts := auth.Group(sa, "Service accounts", "Service accounts and their API keys")
+		accounts.GET("", formatDoc("Get Service Accounts", "Get all service accounts of the org"), tonic.Handler(r.getServiceAccountsHandler, http.StatusOK))
+		accounts.POST("", formatDoc("Add Service Account", "Add a service account bound to roles"), tonic.Handler(r.postServiceAccountHandler, http.StatusCreated))
+		accounts.GET("/:account_id", formatDoc("Get Service Account", "Get a service account"), tonic.Handler(r.getServiceAccountHandler, http.StatusOK))
+		accounts.DELETE("/:account_id", formatDoc("Revoke Service Account", "Revoke a service account and all of its keys"), tonic.Handler(r.revokeServiceAccountHandler, http.StatusOK))
+		accounts.GET("/:account_id/keys", formatDoc("Get API Keys", "Get the API keys of a service account"), tonic.Handler(r.getApiKeysHandler, http.StatusOK))
+		accounts.POST("/:account_id/keys", formatDoc("Create API Key", "Create an API key, its secret is only returned once"), tonic.Handler(r.postApiKeyHandler, http.StatusCreated))
+		accounts.POST("/:account_id/keys/:key_id/rotate", formatDoc("Rotate API Key", "Replace an API key, the old one works for a grace period"), tonic.Handler(r.rotateApiKeyHandler, http.StatusCreated))
+		accounts.DELETE("/:account_id/keys/:key_id", formatDoc("Revoke API Key", "Revoke an API key"), tonic.Handler(r.revokeApiKeyHandler, http.StatusOK))
+		accounts.GET("/:account_id/usage", formatDoc("Get API Key Usage", "Get the latest requests made with the keys of a service account"), tonic.Handler(r.getApiKeyUsageHandler, http.StatusOK))
 	}
 }
 
@@ -182,6 +215,87 @@ func (r *Router) removeUserFromOrgHandler(c *gin.Context, req *UserOrgRequest) (
 	return r.clients.Organization.RemoveOrgForUser(req.OrgId, req.UserId)
 }
 
+// Service account handlers
+func (r *Router) getServiceAccountsHandler(c *gin.Context, req *GetServiceAccountsRequest) (*orgpb.ListServiceAccountsResponse, error) {
+	return r.clients.Organization.ListServiceAccounts()
+}
+
+func (r *Router) postServiceAccountHandler(c *gin.Context, req *AddServiceAccountRequest) (*orgpb.ServiceAccountResponse, error) {
+	createdBy := c.GetHeader(roles.UserIdHeader)
+	if createdBy == "" {
+		createdBy = c.GetString(USER_ID_KEY)
+	}
+
+	bindings := make([]*orgpb.RoleBinding, 0, len(req.Bindings))
+	for _, b := range req.Bindings {
+		bindings = append(bindings, &orgpb.RoleBinding{Role: b.Role, ScopeKind: b.ScopeKind, ScopeId: b.ScopeId})
+	}
+
+	return r.clients.Organization.AddServiceAccount(req.Name, req.Description, createdBy, bindings)
+}
+
+func (r *Router) getServiceAccountHandler(c *gin.Context, req *ServiceAccountRequest) (*orgpb.ServiceAccountResponse, error) {
+	return r.clients.Organization.GetServiceAccount(req.AccountId)
+}
+
+func (r *Router) revokeServiceAccountHandler(c *gin.Context, req *ServiceAccountRequest) (*orgpb.ServiceAccountResponse, error) {
+	return r.clients.Organization.RevokeServiceAccount(req.AccountId)
+}
+
+func (r *Router) getApiKeysHandler(c *gin.Context, req *ServiceAccountRequest) (*orgpb.ListApiKeysResponse, error) {
+	return r.clients.Organization.ListApiKeys(req.AccountId)
+}
+
+func (r *Router) postApiKeyHandler(c *gin.Context, req *CreateApiKeyRequest) (*orgpb.CreateApiKeyResponse, error) {
+	return r.clients.Organization.CreateApiKey(req.AccountId, req.TtlDays)
+}
+
+func (r *Router) rotateApiKeyHandler(c *gin.Context, req *RotateApiKeyRequest) (*orgpb.CreateApiKeyResponse, error) {
+	err := r.checkApiKeyAccount(req.AccountId, req.KeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.clients.Organization.RotateApiKey(req.KeyId, req.GraceMinutes, req.TtlDays)
+}
+
+func (r *Router) revokeApiKeyHandler(c *gin.Context, req *ApiKeyRequest) error {
+	err := r.checkApiKeyAccount(req.AccountId, req.KeyId)
+	if err != nil {
+		return err
+	}
+
+	return r.clients.Organization.RevokeApiKey(req.KeyId)
+}
+
+// checkApiKeyAccount makes sure the key belongs to the service account of
+// the path, so a key can't be changed through another account.
+func (r *Router) checkApiKeyAccount(accountId, keyId string) error {
+	keys, err := r.clients.Organization.ListApiKeys(accountId)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys.GetKeys() {
+		if k.GetId() == keyId && k.GetAccountId() == accountId {
+			return nil
+		}
+	}
+
+	return rest.HttpError{
+		HttpCode: http.StatusNotFound,
+		Message:  fmt.Sprintf("api key %s not found for service account %s", keyId, accountId),
+	}
+}
+
+func (r *Router) getApiKeyUsageHandler(c *gin.Context, req *GetApiKeyUsageRequest) (*orgpb.ListApiKeyUsageResponse, error) {
+	return r.clients.Organization.ListApiKeyUsage(req.AccountId, req.Limit)
+}
+
+func (r *Router) validateApiKeyHandler(c *gin.Context, req *ValidateApiKeyRequest) (*orgpb.ValidateApiKeyResponse, error) {
+	return r.clients.Organization.ValidateApiKey(req.Key, req.OrgId, req.Method, req.Path)
+}
+
 func (r *Router) getUserByEmailHandler(c *gin.Context, req *GetByEmailRequest) (*userspb.GetResponse, error) {
 	return r.clients.User.GetByEmail(strings.ToLower(req.Email))
 }
@@ -22,6 +22,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/ukama/ukama/systems/common/rest"
+	"github.com/ukama/ukama/systems/common/uuid"
 	"github.com/ukama/ukama/systems/nucleus/api-gateway/pkg"
 	"github.com/ukama/ukama/systems/nucleus/api-gateway/pkg/client"
 
@@ -707,3 +708,143 @@ func TestPostUser_Error(t *testing.T) {
 	// assert
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 }
+
+func TestPostServiceAccount(t *testing.T) {
+	// arrange
+	w := httptest.NewRecorder()
+	body := `{"name": "billing-sync", "bindings": [{"role": "billing", "scope_kind": "org"}]}`
+	req, _ := http.NewRequest("POST", "/v1/service-accounts", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-id", testUserUUID)
+	o := &orgmocks.OrgServiceClient{}
+	u := &usermocks.UserServiceClient{}
+	arc := &cmocks.AuthClient{}
+
+	arc.On("AuthenticateUser", mock.Anything, mock.Anything).Return(nil)
+
+	o.On("AddServiceAccount", mock.Anything, mock.MatchedBy(func(r *orgpb.AddServiceAccountRequest) bool {
+		return r.Name == "billing-sync" && r.CreatedBy == testUserUUID && len(r.Bindings) == 1 && r.Bindings[0].Role == "billing"
+	})).Return(&orgpb.ServiceAccountResponse{Account: &orgpb.ServiceAccount{Name: "billing-sync"}}, nil)
+
+	r := NewRouter(&Clients{
+		Organization: client.NewOrgRegistryFromClient(o),
+		User:         client.NewUserRegistryFromClient(u),
+	}, routerConfig, arc.AuthenticateUser).f.Engine()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	assert.Equal(t, http.StatusCreated, w.Code)
+	o.AssertExpectations(t)
+}
+
+func TestRotateApiKey(t *testing.T) {
+	// arrange
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/v1/service-accounts/account-1/keys/key-1/rotate", strings.NewReader(`{"grace_minutes": 60}`))
+	req.Header.Set("Content-Type", "application/json")
+	o := &orgmocks.OrgServiceClient{}
+	u := &usermocks.UserServiceClient{}
+	arc := &cmocks.AuthClient{}
+
+	arc.On("AuthenticateUser", mock.Anything, mock.Anything).Return(nil)
+
+	o.On("ListApiKeys", mock.Anything, &orgpb.ListApiKeysRequest{AccountId: "account-1"}).Return(&orgpb.ListApiKeysResponse{
+		Keys: []*orgpb.ApiKey{{Id: "key-1", AccountId: "account-1"}},
+	}, nil)
+
+	o.On("RotateApiKey", mock.Anything, &orgpb.RotateApiKeyRequest{Id: "key-1", GraceMinutes: 60}).Return(&orgpb.CreateApiKeyResponse{
+		Key:    &orgpb.ApiKey{Id: "key-2"},
+		Secret: "uk_0011223344556677_secret",
+	}, nil)
+
+	r := NewRouter(&Clients{
+		Organization: client.NewOrgRegistryFromClient(o),
+		User:         client.NewUserRegistryFromClient(u),
+	}, routerConfig, arc.AuthenticateUser).f.Engine()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"secret":"uk_0011223344556677_secret"`)
+	o.AssertExpectations(t)
+}
+
+func TestRevokeApiKey_OtherAccount(t *testing.T) {
+	// arrange
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/v1/service-accounts/account-1/keys/key-9", nil)
+	o := &orgmocks.OrgServiceClient{}
+	u := &usermocks.UserServiceClient{}
+	arc := &cmocks.AuthClient{}
+
+	arc.On("AuthenticateUser", mock.Anything, mock.Anything).Return(nil)
+
+	o.On("ListApiKeys", mock.Anything, &orgpb.ListApiKeysRequest{AccountId: "account-1"}).Return(&orgpb.ListApiKeysResponse{
+		Keys: []*orgpb.ApiKey{{Id: "key-1", AccountId: "account-1"}},
+	}, nil)
+
+	r := NewRouter(&Clients{
+		Organization: client.NewOrgRegistryFromClient(o),
+		User:         client.NewUserRegistryFromClient(u),
+	}, routerConfig, arc.AuthenticateUser).f.Engine()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	o.AssertExpectations(t)
+	o.AssertNotCalled(t, "RevokeApiKey", mock.Anything, mock.Anything)
+}
+
+func TestValidateApiKey_Invalid(t *testing.T) {
+	// arrange
+	orgId := uuid.NewV4().String()
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/authz/v1/service-accounts/keys/validate",
+		strings.NewReader(`{"key": "uk_00_bad", "org_id": "`+orgId+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	o := &orgmocks.OrgServiceClient{}
+	u := &usermocks.UserServiceClient{}
+	arc := &cmocks.AuthClient{}
+
+	o.On("ValidateApiKey", mock.Anything, &orgpb.ValidateApiKeyRequest{Key: "uk_00_bad", OrgId: orgId}).
+		Return(nil, status.Error(codes.Unauthenticated, "invalid api key"))
+
+	r := NewRouter(&Clients{
+		Organization: client.NewOrgRegistryFromClient(o),
+		User:         client.NewUserRegistryFromClient(u),
+	}, routerConfig, arc.AuthenticateUser).internal.Engine()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	o.AssertExpectations(t)
+	arc.AssertNotCalled(t, "AuthenticateUser", mock.Anything, mock.Anything)
+}
+
+func TestValidateApiKey_NotPublic(t *testing.T) {
+	// arrange
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/authz/v1/service-accounts/keys/validate", strings.NewReader(`{"key": "uk_00_bad"}`))
+	req.Header.Set("Content-Type", "application/json")
+	o := &orgmocks.OrgServiceClient{}
+	arc := &cmocks.AuthClient{}
+
+	r := NewRouter(&Clients{
+		Organization: client.NewOrgRegistryFromClient(o),
+	}, routerConfig, arc.AuthenticateUser).f.Engine()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	o.AssertNotCalled(t, "ValidateApiKey", mock.Anything, mock.Anything)
+}
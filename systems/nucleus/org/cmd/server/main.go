@@ -73,7 +73,7 @@ func initDb() sql.Db {
 
 	d := sql.NewDb(svcConf.DB, svcConf.DebugMode)
 
-	err := d.Init(&db.Org{}, &db.User{})
+	err := d.Init(&db.Org{}, &db.User{}, &db.ServiceAccount{}, &db.ApiKey{}, &db.ApiKeyUsage{})
 	if err != nil {
 		log.Fatalf("Database initialization failed. Error: %v", err)
 	}
@@ -109,7 +109,7 @@ func runGrpcServer(gormdb sql.Db) {
 
 	log.Debugf("MessageBus Client is %+v", mbClient)
 	regServer := server.NewOrgServer(svcConf.OrgName, db.NewOrgRepo(gormdb),
-		db.NewUserRepo(gormdb), orch, memClient, mbClient, svcConf.Pushgateway, svcConf.DebugMode)
+		db.NewUserRepo(gormdb), db.NewServiceAccountRepo(gormdb), orch, memClient, mbClient, svcConf.Pushgateway, svcConf.DebugMode)
 
 	grpcServer := ugrpc.NewGrpcServer(*svcConf.Grpc, func(s *grpc.Server) {
 		pb.RegisterOrgServiceServer(s, regServer)
@@ -118,6 +118,9 @@ func runGrpcServer(gormdb sql.Db) {
 	grpcServer.RegisterDependency("db", true, ugrpc.DBCheck(gormdb))
 	grpcServer.RegisterDependency("msgclient", true, ugrpc.MsgClientCheck(svcConf.MsgClient.Host))
 
+	ic.StartHeartbeat(ic.NewInitClient(svcConf.Http.InitClient, client.WithDebug(svcConf.DebugMode)),
+		svcConf.OrgName, pkg.SystemName, version.Version, ic.DefaultHeartbeatInterval, nil)
+
 	go grpcServer.StartServer()
 
 	go msgBusListener(mbClient)
@@ -0,0 +1,321 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	db "github.com/ukama/ukama/systems/nucleus/org/pkg/db"
+	gorm "gorm.io/gorm"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/ukama/ukama/systems/common/uuid"
+)
+
+// ServiceAccountRepo is an autogenerated mock type for the ServiceAccountRepo type
+type ServiceAccountRepo struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: account, nestedFunc
+func (_m *ServiceAccountRepo) Add(account *db.ServiceAccount, nestedFunc func(*db.ServiceAccount, *gorm.DB) error) error {
+	ret := _m.Called(account, nestedFunc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.ServiceAccount, func(*db.ServiceAccount, *gorm.DB) error) error); ok {
+		r0 = rf(account, nestedFunc)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddKey provides a mock function with given fields: key
+func (_m *ServiceAccountRepo) AddKey(key *db.ApiKey) error {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.ApiKey) error); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpireKey provides a mock function with given fields: id, at
+func (_m *ServiceAccountRepo) ExpireKey(id uuid.UUID, at time.Time) error {
+	ret := _m.Called(id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) error); ok {
+		r0 = rf(id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: id
+func (_m *ServiceAccountRepo) Get(id uuid.UUID) (*db.ServiceAccount, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *db.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (*db.ServiceAccount, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) *db.ServiceAccount); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.ServiceAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKey provides a mock function with given fields: id
+func (_m *ServiceAccountRepo) GetKey(id uuid.UUID) (*db.ApiKey, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKey")
+	}
+
+	var r0 *db.ApiKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (*db.ApiKey, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) *db.ApiKey); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.ApiKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyByPrefix provides a mock function with given fields: prefix
+func (_m *ServiceAccountRepo) GetKeyByPrefix(prefix string) (*db.ApiKey, error) {
+	ret := _m.Called(prefix)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKeyByPrefix")
+	}
+
+	var r0 *db.ApiKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*db.ApiKey, error)); ok {
+		return rf(prefix)
+	}
+	if rf, ok := ret.Get(0).(func(string) *db.ApiKey); ok {
+		r0 = rf(prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.ApiKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with no fields
+func (_m *ServiceAccountRepo) List() ([]db.ServiceAccount, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []db.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]db.ServiceAccount, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []db.ServiceAccount); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ServiceAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListKeys provides a mock function with given fields: accountId
+func (_m *ServiceAccountRepo) ListKeys(accountId uuid.UUID) ([]db.ApiKey, error) {
+	ret := _m.Called(accountId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKeys")
+	}
+
+	var r0 []db.ApiKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]db.ApiKey, error)); ok {
+		return rf(accountId)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []db.ApiKey); ok {
+		r0 = rf(accountId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ApiKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(accountId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUsage provides a mock function with given fields: accountId, limit
+func (_m *ServiceAccountRepo) ListUsage(accountId uuid.UUID, limit int) ([]db.ApiKeyUsage, error) {
+	ret := _m.Called(accountId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsage")
+	}
+
+	var r0 []db.ApiKeyUsage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int) ([]db.ApiKeyUsage, error)); ok {
+		return rf(accountId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int) []db.ApiKeyUsage); ok {
+		r0 = rf(accountId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ApiKeyUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int) error); ok {
+		r1 = rf(accountId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordUsage provides a mock function with given fields: usage
+func (_m *ServiceAccountRepo) RecordUsage(usage *db.ApiKeyUsage) error {
+	ret := _m.Called(usage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordUsage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.ApiKeyUsage) error); ok {
+		r0 = rf(usage)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Revoke provides a mock function with given fields: id, at
+func (_m *ServiceAccountRepo) Revoke(id uuid.UUID, at time.Time) error {
+	ret := _m.Called(id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) error); ok {
+		r0 = rf(id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeKey provides a mock function with given fields: id, at
+func (_m *ServiceAccountRepo) RevokeKey(id uuid.UUID, at time.Time) error {
+	ret := _m.Called(id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) error); ok {
+		r0 = rf(id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewServiceAccountRepo creates a new instance of ServiceAccountRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceAccountRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceAccountRepo {
+	mock := &ServiceAccountRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -53,6 +53,80 @@ func (_m *OrgServiceClient) Add(ctx context.Context, in *gen.AddRequest, opts ..
 	return r0, r1
 }
 
+// AddServiceAccount provides a mock function with given fields: ctx, in, opts
+func (_m *OrgServiceClient) AddServiceAccount(ctx context.Context, in *gen.AddServiceAccountRequest, opts ...grpc.CallOption) (*gen.ServiceAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddServiceAccount")
+	}
+
+	var r0 *gen.ServiceAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddServiceAccountRequest, ...grpc.CallOption) (*gen.ServiceAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddServiceAccountRequest, ...grpc.CallOption) *gen.ServiceAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ServiceAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AddServiceAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateApiKey provides a mock function with given fields: ctx, in, opts
+func (_m *OrgServiceClient) CreateApiKey(ctx context.Context, in *gen.CreateApiKeyRequest, opts ...grpc.CallOption) (*gen.CreateApiKeyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateApiKey")
+	}
+
+	var r0 *gen.CreateApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CreateApiKeyRequest, ...grpc.CallOption) (*gen.CreateApiKeyResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CreateApiKeyRequest, ...grpc.CallOption) *gen.CreateApiKeyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CreateApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.CreateApiKeyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: ctx, in, opts
 func (_m *OrgServiceClient) Get(ctx context.Context, in *gen.GetRequest, opts ...grpc.CallOption) (*gen.GetResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -201,6 +275,154 @@ func (_m *OrgServiceClient) GetByUser(ctx context.Context, in *gen.GetByOwnerReq
 	return r0, r1
 }
 
+// GetServiceAccount provides a mock function with given fields: ctx, in, opts
+func (_m *OrgServiceClient) GetServiceAccount(ctx context.Context, in *gen.GetServiceAccountRequest, opts ...grpc.CallOption) (*gen.ServiceAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServiceAccount")
+	}
+
+	var r0 *gen.ServiceAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetServiceAccountRequest, ...grpc.CallOption) (*gen.ServiceAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetServiceAccountRequest, ...grpc.CallOption) *gen.ServiceAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ServiceAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetServiceAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListApiKeyUsage provides a mock function with given fields: ctx, in, opts
+func (_m *OrgServiceClient) ListApiKeyUsage(ctx context.Context, in *gen.ListApiKeyUsageRequest, opts ...grpc.CallOption) (*gen.ListApiKeyUsageResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListApiKeyUsage")
+	}
+
+	var r0 *gen.ListApiKeyUsageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListApiKeyUsageRequest, ...grpc.CallOption) (*gen.ListApiKeyUsageResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListApiKeyUsageRequest, ...grpc.CallOption) *gen.ListApiKeyUsageResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListApiKeyUsageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListApiKeyUsageRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListApiKeys provides a mock function with given fields: ctx, in, opts
+func (_m *OrgServiceClient) ListApiKeys(ctx context.Context, in *gen.ListApiKeysRequest, opts ...grpc.CallOption) (*gen.ListApiKeysResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListApiKeys")
+	}
+
+	var r0 *gen.ListApiKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListApiKeysRequest, ...grpc.CallOption) (*gen.ListApiKeysResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListApiKeysRequest, ...grpc.CallOption) *gen.ListApiKeysResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListApiKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListApiKeysRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListServiceAccounts provides a mock function with given fields: ctx, in, opts
+func (_m *OrgServiceClient) ListServiceAccounts(ctx context.Context, in *gen.ListServiceAccountsRequest, opts ...grpc.CallOption) (*gen.ListServiceAccountsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListServiceAccounts")
+	}
+
+	var r0 *gen.ListServiceAccountsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListServiceAccountsRequest, ...grpc.CallOption) (*gen.ListServiceAccountsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListServiceAccountsRequest, ...grpc.CallOption) *gen.ListServiceAccountsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListServiceAccountsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListServiceAccountsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RegisterUser provides a mock function with given fields: ctx, in, opts
 func (_m *OrgServiceClient) RegisterUser(ctx context.Context, in *gen.RegisterUserRequest, opts ...grpc.CallOption) (*gen.RegisterUserResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -275,6 +497,117 @@ func (_m *OrgServiceClient) RemoveOrgForUser(ctx context.Context, in *gen.Remove
 	return r0, r1
 }
 
+// RevokeApiKey provides a mock function with given fields: ctx, in, opts
+func (_m *OrgServiceClient) RevokeApiKey(ctx context.Context, in *gen.RevokeApiKeyRequest, opts ...grpc.CallOption) (*gen.RevokeApiKeyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeApiKey")
+	}
+
+	var r0 *gen.RevokeApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeApiKeyRequest, ...grpc.CallOption) (*gen.RevokeApiKeyResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeApiKeyRequest, ...grpc.CallOption) *gen.RevokeApiKeyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RevokeApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RevokeApiKeyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeServiceAccount provides a mock function with given fields: ctx, in, opts
+func (_m *OrgServiceClient) RevokeServiceAccount(ctx context.Context, in *gen.RevokeServiceAccountRequest, opts ...grpc.CallOption) (*gen.ServiceAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeServiceAccount")
+	}
+
+	var r0 *gen.ServiceAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeServiceAccountRequest, ...grpc.CallOption) (*gen.ServiceAccountResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeServiceAccountRequest, ...grpc.CallOption) *gen.ServiceAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ServiceAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RevokeServiceAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RotateApiKey provides a mock function with given fields: ctx, in, opts
+func (_m *OrgServiceClient) RotateApiKey(ctx context.Context, in *gen.RotateApiKeyRequest, opts ...grpc.CallOption) (*gen.CreateApiKeyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateApiKey")
+	}
+
+	var r0 *gen.CreateApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RotateApiKeyRequest, ...grpc.CallOption) (*gen.CreateApiKeyResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RotateApiKeyRequest, ...grpc.CallOption) *gen.CreateApiKeyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CreateApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RotateApiKeyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateOrgForUser provides a mock function with given fields: ctx, in, opts
 func (_m *OrgServiceClient) UpdateOrgForUser(ctx context.Context, in *gen.UpdateOrgForUserRequest, opts ...grpc.CallOption) (*gen.UpdateOrgForUserResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -349,6 +682,43 @@ func (_m *OrgServiceClient) UpdateUser(ctx context.Context, in *gen.UpdateUserRe
 	return r0, r1
 }
 
+// ValidateApiKey provides a mock function with given fields: ctx, in, opts
+func (_m *OrgServiceClient) ValidateApiKey(ctx context.Context, in *gen.ValidateApiKeyRequest, opts ...grpc.CallOption) (*gen.ValidateApiKeyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateApiKey")
+	}
+
+	var r0 *gen.ValidateApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ValidateApiKeyRequest, ...grpc.CallOption) (*gen.ValidateApiKeyResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ValidateApiKeyRequest, ...grpc.CallOption) *gen.ValidateApiKeyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ValidateApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ValidateApiKeyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewOrgServiceClient creates a new instance of OrgServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOrgServiceClient(t interface {
@@ -44,6 +44,66 @@ func (_m *OrgServiceServer) Add(_a0 context.Context, _a1 *gen.AddRequest) (*gen.
 	return r0, r1
 }
 
+// AddServiceAccount provides a mock function with given fields: _a0, _a1
+func (_m *OrgServiceServer) AddServiceAccount(_a0 context.Context, _a1 *gen.AddServiceAccountRequest) (*gen.ServiceAccountResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddServiceAccount")
+	}
+
+	var r0 *gen.ServiceAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddServiceAccountRequest) (*gen.ServiceAccountResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddServiceAccountRequest) *gen.ServiceAccountResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ServiceAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AddServiceAccountRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateApiKey provides a mock function with given fields: _a0, _a1
+func (_m *OrgServiceServer) CreateApiKey(_a0 context.Context, _a1 *gen.CreateApiKeyRequest) (*gen.CreateApiKeyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateApiKey")
+	}
+
+	var r0 *gen.CreateApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CreateApiKeyRequest) (*gen.CreateApiKeyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CreateApiKeyRequest) *gen.CreateApiKeyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CreateApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.CreateApiKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: _a0, _a1
 func (_m *OrgServiceServer) Get(_a0 context.Context, _a1 *gen.GetRequest) (*gen.GetResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -164,6 +224,126 @@ func (_m *OrgServiceServer) GetByUser(_a0 context.Context, _a1 *gen.GetByOwnerRe
 	return r0, r1
 }
 
+// GetServiceAccount provides a mock function with given fields: _a0, _a1
+func (_m *OrgServiceServer) GetServiceAccount(_a0 context.Context, _a1 *gen.GetServiceAccountRequest) (*gen.ServiceAccountResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServiceAccount")
+	}
+
+	var r0 *gen.ServiceAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetServiceAccountRequest) (*gen.ServiceAccountResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetServiceAccountRequest) *gen.ServiceAccountResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ServiceAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetServiceAccountRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListApiKeyUsage provides a mock function with given fields: _a0, _a1
+func (_m *OrgServiceServer) ListApiKeyUsage(_a0 context.Context, _a1 *gen.ListApiKeyUsageRequest) (*gen.ListApiKeyUsageResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListApiKeyUsage")
+	}
+
+	var r0 *gen.ListApiKeyUsageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListApiKeyUsageRequest) (*gen.ListApiKeyUsageResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListApiKeyUsageRequest) *gen.ListApiKeyUsageResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListApiKeyUsageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListApiKeyUsageRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListApiKeys provides a mock function with given fields: _a0, _a1
+func (_m *OrgServiceServer) ListApiKeys(_a0 context.Context, _a1 *gen.ListApiKeysRequest) (*gen.ListApiKeysResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListApiKeys")
+	}
+
+	var r0 *gen.ListApiKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListApiKeysRequest) (*gen.ListApiKeysResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListApiKeysRequest) *gen.ListApiKeysResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListApiKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListApiKeysRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListServiceAccounts provides a mock function with given fields: _a0, _a1
+func (_m *OrgServiceServer) ListServiceAccounts(_a0 context.Context, _a1 *gen.ListServiceAccountsRequest) (*gen.ListServiceAccountsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListServiceAccounts")
+	}
+
+	var r0 *gen.ListServiceAccountsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListServiceAccountsRequest) (*gen.ListServiceAccountsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListServiceAccountsRequest) *gen.ListServiceAccountsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListServiceAccountsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListServiceAccountsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RegisterUser provides a mock function with given fields: _a0, _a1
 func (_m *OrgServiceServer) RegisterUser(_a0 context.Context, _a1 *gen.RegisterUserRequest) (*gen.RegisterUserResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -224,6 +404,96 @@ func (_m *OrgServiceServer) RemoveOrgForUser(_a0 context.Context, _a1 *gen.Remov
 	return r0, r1
 }
 
+// RevokeApiKey provides a mock function with given fields: _a0, _a1
+func (_m *OrgServiceServer) RevokeApiKey(_a0 context.Context, _a1 *gen.RevokeApiKeyRequest) (*gen.RevokeApiKeyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeApiKey")
+	}
+
+	var r0 *gen.RevokeApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeApiKeyRequest) (*gen.RevokeApiKeyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeApiKeyRequest) *gen.RevokeApiKeyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RevokeApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RevokeApiKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeServiceAccount provides a mock function with given fields: _a0, _a1
+func (_m *OrgServiceServer) RevokeServiceAccount(_a0 context.Context, _a1 *gen.RevokeServiceAccountRequest) (*gen.ServiceAccountResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeServiceAccount")
+	}
+
+	var r0 *gen.ServiceAccountResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeServiceAccountRequest) (*gen.ServiceAccountResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RevokeServiceAccountRequest) *gen.ServiceAccountResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ServiceAccountResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RevokeServiceAccountRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RotateApiKey provides a mock function with given fields: _a0, _a1
+func (_m *OrgServiceServer) RotateApiKey(_a0 context.Context, _a1 *gen.RotateApiKeyRequest) (*gen.CreateApiKeyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateApiKey")
+	}
+
+	var r0 *gen.CreateApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RotateApiKeyRequest) (*gen.CreateApiKeyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RotateApiKeyRequest) *gen.CreateApiKeyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CreateApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RotateApiKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateOrgForUser provides a mock function with given fields: _a0, _a1
 func (_m *OrgServiceServer) UpdateOrgForUser(_a0 context.Context, _a1 *gen.UpdateOrgForUserRequest) (*gen.UpdateOrgForUserResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -284,6 +554,36 @@ func (_m *OrgServiceServer) UpdateUser(_a0 context.Context, _a1 *gen.UpdateUserR
 	return r0, r1
 }
 
+// ValidateApiKey provides a mock function with given fields: _a0, _a1
+func (_m *OrgServiceServer) ValidateApiKey(_a0 context.Context, _a1 *gen.ValidateApiKeyRequest) (*gen.ValidateApiKeyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateApiKey")
+	}
+
+	var r0 *gen.ValidateApiKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ValidateApiKeyRequest) (*gen.ValidateApiKeyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ValidateApiKeyRequest) *gen.ValidateApiKeyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ValidateApiKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ValidateApiKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // mustEmbedUnimplementedOrgServiceServer provides a mock function with no fields
 func (_m *OrgServiceServer) mustEmbedUnimplementedOrgServiceServer() {
 	_m.Called()
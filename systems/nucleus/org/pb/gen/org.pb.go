@@ -8,7 +8,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.36.11
-// 	protoc        v7.34.1
+// 	protoc        v6.32.1
 // source: org.proto
 
 package gen
@@ -1051,6 +1051,1157 @@ func (*RemoveOrgForUserResponse) Descriptor() ([]byte, []int) {
 	return file_org_proto_rawDescGZIP(), []int{20}
 }
 
+type ServiceAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=createdBy,json=created_by,proto3" json:"createdBy,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=revoked_at,proto3" json:"revoked_at,omitempty"`
+	OrgId         string                 `protobuf:"bytes,7,opt,name=orgId,json=org_id,proto3" json:"orgId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceAccount) Reset() {
+	*x = ServiceAccount{}
+	mi := &file_org_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAccount) ProtoMessage() {}
+
+func (x *ServiceAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceAccount.ProtoReflect.Descriptor instead.
+func (*ServiceAccount) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ServiceAccount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type RoleBinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	ScopeKind     string                 `protobuf:"bytes,2,opt,name=scopeKind,json=scope_kind,proto3" json:"scopeKind,omitempty"`
+	ScopeId       string                 `protobuf:"bytes,3,opt,name=scopeId,json=scope_id,proto3" json:"scopeId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleBinding) Reset() {
+	*x = RoleBinding{}
+	mi := &file_org_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBinding) ProtoMessage() {}
+
+func (x *RoleBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBinding.ProtoReflect.Descriptor instead.
+func (*RoleBinding) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RoleBinding) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetScopeKind() string {
+	if x != nil {
+		return x.ScopeKind
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+type AddServiceAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=createdBy,json=created_by,proto3" json:"createdBy,omitempty"`
+	Bindings      []*RoleBinding         `protobuf:"bytes,4,rep,name=bindings,proto3" json:"bindings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddServiceAccountRequest) Reset() {
+	*x = AddServiceAccountRequest{}
+	mi := &file_org_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddServiceAccountRequest) ProtoMessage() {}
+
+func (x *AddServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*AddServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AddServiceAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddServiceAccountRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddServiceAccountRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AddServiceAccountRequest) GetBindings() []*RoleBinding {
+	if x != nil {
+		return x.Bindings
+	}
+	return nil
+}
+
+type GetServiceAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceAccountRequest) Reset() {
+	*x = GetServiceAccountRequest{}
+	mi := &file_org_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceAccountRequest) ProtoMessage() {}
+
+func (x *GetServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetServiceAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ServiceAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *ServiceAccount        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceAccountResponse) Reset() {
+	*x = ServiceAccountResponse{}
+	mi := &file_org_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAccountResponse) ProtoMessage() {}
+
+func (x *ServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*ServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ServiceAccountResponse) GetAccount() *ServiceAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type ListServiceAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountsRequest) Reset() {
+	*x = ListServiceAccountsRequest{}
+	mi := &file_org_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountsRequest) ProtoMessage() {}
+
+func (x *ListServiceAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListServiceAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{26}
+}
+
+type ListServiceAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*ServiceAccount      `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountsResponse) Reset() {
+	*x = ListServiceAccountsResponse{}
+	mi := &file_org_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountsResponse) ProtoMessage() {}
+
+func (x *ListServiceAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListServiceAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListServiceAccountsResponse) GetAccounts() []*ServiceAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type RevokeServiceAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeServiceAccountRequest) Reset() {
+	*x = RevokeServiceAccountRequest{}
+	mi := &file_org_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeServiceAccountRequest) ProtoMessage() {}
+
+func (x *RevokeServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*RevokeServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RevokeServiceAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=accountId,json=account_id,proto3" json:"accountId,omitempty"`
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,proto3" json:"expires_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,proto3" json:"last_used_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=revoked_at,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_org_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ApiKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiKey) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ApiKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+type CreateApiKeyRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=accountId,json=account_id,proto3" json:"accountId,omitempty"`
+	// days until the key expires, it never does when 0
+	TtlDays       uint32 `protobuf:"varint,2,opt,name=ttlDays,json=ttl_days,proto3" json:"ttlDays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_org_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateApiKeyRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetTtlDays() uint32 {
+	if x != nil {
+		return x.TtlDays
+	}
+	return 0
+}
+
+// the secret is only ever returned here
+type CreateApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *ApiKey                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	mi := &file_org_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateApiKeyResponse) GetKey() *ApiKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type RotateApiKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// how long the rotated key keeps working, so clients can switch over
+	GraceMinutes  uint32 `protobuf:"varint,2,opt,name=graceMinutes,json=grace_minutes,proto3" json:"graceMinutes,omitempty"`
+	TtlDays       uint32 `protobuf:"varint,3,opt,name=ttlDays,json=ttl_days,proto3" json:"ttlDays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateApiKeyRequest) Reset() {
+	*x = RotateApiKeyRequest{}
+	mi := &file_org_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateApiKeyRequest) ProtoMessage() {}
+
+func (x *RotateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RotateApiKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RotateApiKeyRequest) GetGraceMinutes() uint32 {
+	if x != nil {
+		return x.GraceMinutes
+	}
+	return 0
+}
+
+func (x *RotateApiKeyRequest) GetTtlDays() uint32 {
+	if x != nil {
+		return x.TtlDays
+	}
+	return 0
+}
+
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_org_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RevokeApiKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyResponse) Reset() {
+	*x = RevokeApiKeyResponse{}
+	mi := &file_org_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyResponse) ProtoMessage() {}
+
+func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{34}
+}
+
+type ListApiKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=accountId,json=account_id,proto3" json:"accountId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	mi := &file_org_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysRequest) ProtoMessage() {}
+
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListApiKeysRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type ListApiKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*ApiKey              `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	mi := &file_org_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResponse) ProtoMessage() {}
+
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListApiKeysResponse) GetKeys() []*ApiKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type ValidateApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	OrgId         string                 `protobuf:"bytes,4,opt,name=orgId,json=org_id,proto3" json:"orgId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateApiKeyRequest) Reset() {
+	*x = ValidateApiKeyRequest{}
+	mi := &file_org_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateApiKeyRequest) ProtoMessage() {}
+
+func (x *ValidateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*ValidateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ValidateApiKeyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ValidateApiKeyRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ValidateApiKeyRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ValidateApiKeyRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type ValidateApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=accountId,json=account_id,proto3" json:"accountId,omitempty"`
+	KeyId         string                 `protobuf:"bytes,2,opt,name=keyId,json=key_id,proto3" json:"keyId,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateApiKeyResponse) Reset() {
+	*x = ValidateApiKeyResponse{}
+	mi := &file_org_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateApiKeyResponse) ProtoMessage() {}
+
+func (x *ValidateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*ValidateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ValidateApiKeyResponse) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ValidateApiKeyResponse) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ValidateApiKeyResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListApiKeyUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=accountId,json=account_id,proto3" json:"accountId,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeyUsageRequest) Reset() {
+	*x = ListApiKeyUsageRequest{}
+	mi := &file_org_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeyUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeyUsageRequest) ProtoMessage() {}
+
+func (x *ListApiKeyUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeyUsageRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeyUsageRequest) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListApiKeyUsageRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListApiKeyUsageRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ApiKeyUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=keyId,json=key_id,proto3" json:"keyId,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyUsage) Reset() {
+	*x = ApiKeyUsage{}
+	mi := &file_org_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyUsage) ProtoMessage() {}
+
+func (x *ApiKeyUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyUsage.ProtoReflect.Descriptor instead.
+func (*ApiKeyUsage) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ApiKeyUsage) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ApiKeyUsage) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ApiKeyUsage) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ApiKeyUsage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListApiKeyUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Usage         []*ApiKeyUsage         `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeyUsageResponse) Reset() {
+	*x = ListApiKeyUsageResponse{}
+	mi := &file_org_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeyUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeyUsageResponse) ProtoMessage() {}
+
+func (x *ListApiKeyUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_org_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeyUsageResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeyUsageResponse) Descriptor() ([]byte, []int) {
+	return file_org_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListApiKeyUsageResponse) GetUsage() []*ApiKeyUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
 var File_org_proto protoreflect.FileDescriptor
 
 const file_org_proto_rawDesc = "" +
@@ -1115,7 +2266,98 @@ const file_org_proto_rawDesc = "" +
 	"\x17RemoveOrgForUserRequest\x12!\n" +
 	"\x06userId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x06userId\x12\x1f\n" +
 	"\x05orgId\x18\x02 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x05orgId\"\x1a\n" +
-	"\x18RemoveOrgForUserResponse2\xf0\x06\n" +
+	"\x18RemoveOrgForUserResponse\"\x84\x02\n" +
+	"\x0eServiceAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\tcreatedBy\x18\x04 \x01(\tR\n" +
+	"created_by\x12:\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"revoked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"revoked_at\x12\x15\n" +
+	"\x05orgId\x18\a \x01(\tR\x06org_id\"c\n" +
+	"\vRoleBinding\x12\x1a\n" +
+	"\x04role\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x04role\x12\x1d\n" +
+	"\tscopeKind\x18\x02 \x01(\tR\n" +
+	"scope_kind\x12\x19\n" +
+	"\ascopeId\x18\x03 \x01(\tR\bscope_id\"\xe3\x01\n" +
+	"\x18AddServiceAccountRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe2\xdf\x1f\x1c\n" +
+	"\x1a^[a-z0-9][a-z0-9_-]{0,63}$R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12(\n" +
+	"\tcreatedBy\x18\x03 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\n" +
+	"created_by\x12E\n" +
+	"\bbindings\x18\x04 \x03(\v2!.ukama.nucleus.org.v1.RoleBindingB\x06\xe2\xdf\x1f\x02`\x01R\bbindings\"5\n" +
+	"\x18GetServiceAccountRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\"X\n" +
+	"\x16ServiceAccountResponse\x12>\n" +
+	"\aaccount\x18\x01 \x01(\v2$.ukama.nucleus.org.v1.ServiceAccountR\aaccount\"\x1c\n" +
+	"\x1aListServiceAccountsRequest\"_\n" +
+	"\x1bListServiceAccountsResponse\x12@\n" +
+	"\baccounts\x18\x01 \x03(\v2$.ukama.nucleus.org.v1.ServiceAccountR\baccounts\"8\n" +
+	"\x1bRevokeServiceAccountRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\"\xc3\x02\n" +
+	"\x06ApiKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\taccountId\x18\x02 \x01(\tR\n" +
+	"account_id\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12:\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expires_at\x12>\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\flast_used_at\x12:\n" +
+	"\n" +
+	"revoked_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"revoked_at\"Z\n" +
+	"\x13CreateApiKeyRequest\x12(\n" +
+	"\taccountId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\n" +
+	"account_id\x12\x19\n" +
+	"\attlDays\x18\x02 \x01(\rR\bttl_days\"^\n" +
+	"\x14CreateApiKeyResponse\x12.\n" +
+	"\x03key\x18\x01 \x01(\v2\x1c.ukama.nucleus.org.v1.ApiKeyR\x03key\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"p\n" +
+	"\x13RotateApiKeyRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\x12#\n" +
+	"\fgraceMinutes\x18\x02 \x01(\rR\rgrace_minutes\x12\x19\n" +
+	"\attlDays\x18\x03 \x01(\rR\bttl_days\"0\n" +
+	"\x13RevokeApiKeyRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\"\x16\n" +
+	"\x14RevokeApiKeyResponse\">\n" +
+	"\x12ListApiKeysRequest\x12(\n" +
+	"\taccountId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\n" +
+	"account_id\"G\n" +
+	"\x13ListApiKeysResponse\x120\n" +
+	"\x04keys\x18\x01 \x03(\v2\x1c.ukama.nucleus.org.v1.ApiKeyR\x04keys\"\x7f\n" +
+	"\x15ValidateApiKeyRequest\x12\x18\n" +
+	"\x03key\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x03key\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12 \n" +
+	"\x05orgId\x18\x04 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x06org_id\"b\n" +
+	"\x16ValidateApiKeyResponse\x12\x1d\n" +
+	"\taccountId\x18\x01 \x01(\tR\n" +
+	"account_id\x12\x15\n" +
+	"\x05keyId\x18\x02 \x01(\tR\x06key_id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"X\n" +
+	"\x16ListApiKeyUsageRequest\x12(\n" +
+	"\taccountId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\n" +
+	"account_id\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"\x8c\x01\n" +
+	"\vApiKeyUsage\x12\x15\n" +
+	"\x05keyId\x18\x01 \x01(\tR\x06key_id\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12:\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\"R\n" +
+	"\x17ListApiKeyUsageResponse\x127\n" +
+	"\x05usage\x18\x01 \x03(\v2!.ukama.nucleus.org.v1.ApiKeyUsageR\x05usage2\xc1\x0f\n" +
 	"\n" +
 	"OrgService\x12J\n" +
 	"\x03Add\x12 .ukama.nucleus.org.v1.AddRequest\x1a!.ukama.nucleus.org.v1.AddResponse\x12J\n" +
@@ -1128,7 +2370,17 @@ const file_org_proto_rawDesc = "" +
 	"\n" +
 	"UpdateUser\x12'.ukama.nucleus.org.v1.UpdateUserRequest\x1a(.ukama.nucleus.org.v1.UpdateUserResponse\x12q\n" +
 	"\x10UpdateOrgForUser\x12-.ukama.nucleus.org.v1.UpdateOrgForUserRequest\x1a..ukama.nucleus.org.v1.UpdateOrgForUserResponse\x12q\n" +
-	"\x10RemoveOrgForUser\x12-.ukama.nucleus.org.v1.RemoveOrgForUserRequest\x1a..ukama.nucleus.org.v1.RemoveOrgForUserResponseB3Z1github.com/ukama/ukama/systems/nucleus/org/pb/genb\x06proto3"
+	"\x10RemoveOrgForUser\x12-.ukama.nucleus.org.v1.RemoveOrgForUserRequest\x1a..ukama.nucleus.org.v1.RemoveOrgForUserResponse\x12q\n" +
+	"\x11AddServiceAccount\x12..ukama.nucleus.org.v1.AddServiceAccountRequest\x1a,.ukama.nucleus.org.v1.ServiceAccountResponse\x12q\n" +
+	"\x11GetServiceAccount\x12..ukama.nucleus.org.v1.GetServiceAccountRequest\x1a,.ukama.nucleus.org.v1.ServiceAccountResponse\x12z\n" +
+	"\x13ListServiceAccounts\x120.ukama.nucleus.org.v1.ListServiceAccountsRequest\x1a1.ukama.nucleus.org.v1.ListServiceAccountsResponse\x12w\n" +
+	"\x14RevokeServiceAccount\x121.ukama.nucleus.org.v1.RevokeServiceAccountRequest\x1a,.ukama.nucleus.org.v1.ServiceAccountResponse\x12e\n" +
+	"\fCreateApiKey\x12).ukama.nucleus.org.v1.CreateApiKeyRequest\x1a*.ukama.nucleus.org.v1.CreateApiKeyResponse\x12e\n" +
+	"\fRotateApiKey\x12).ukama.nucleus.org.v1.RotateApiKeyRequest\x1a*.ukama.nucleus.org.v1.CreateApiKeyResponse\x12e\n" +
+	"\fRevokeApiKey\x12).ukama.nucleus.org.v1.RevokeApiKeyRequest\x1a*.ukama.nucleus.org.v1.RevokeApiKeyResponse\x12b\n" +
+	"\vListApiKeys\x12(.ukama.nucleus.org.v1.ListApiKeysRequest\x1a).ukama.nucleus.org.v1.ListApiKeysResponse\x12k\n" +
+	"\x0eValidateApiKey\x12+.ukama.nucleus.org.v1.ValidateApiKeyRequest\x1a,.ukama.nucleus.org.v1.ValidateApiKeyResponse\x12n\n" +
+	"\x0fListApiKeyUsage\x12,.ukama.nucleus.org.v1.ListApiKeyUsageRequest\x1a-.ukama.nucleus.org.v1.ListApiKeyUsageResponseB3Z1github.com/ukama/ukama/systems/nucleus/org/pb/genb\x06proto3"
 
 var (
 	file_org_proto_rawDescOnce sync.Once
@@ -1142,30 +2394,51 @@ func file_org_proto_rawDescGZIP() []byte {
 	return file_org_proto_rawDescData
 }
 
-var file_org_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_org_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_org_proto_goTypes = []any{
-	(*AddRequest)(nil),               // 0: ukama.nucleus.org.v1.AddRequest
-	(*AddResponse)(nil),              // 1: ukama.nucleus.org.v1.AddResponse
-	(*GetRequest)(nil),               // 2: ukama.nucleus.org.v1.GetRequest
-	(*GetResponse)(nil),              // 3: ukama.nucleus.org.v1.GetResponse
-	(*GetByOwnerRequest)(nil),        // 4: ukama.nucleus.org.v1.GetByOwnerRequest
-	(*GetByOwnerResponse)(nil),       // 5: ukama.nucleus.org.v1.GetByOwnerResponse
-	(*GetByUserResponse)(nil),        // 6: ukama.nucleus.org.v1.GetByUserResponse
-	(*GetByNameRequest)(nil),         // 7: ukama.nucleus.org.v1.GetByNameRequest
-	(*GetByNameResponse)(nil),        // 8: ukama.nucleus.org.v1.GetByNameResponse
-	(*RegisterUserRequest)(nil),      // 9: ukama.nucleus.org.v1.RegisterUserRequest
-	(*RegisterUserResponse)(nil),     // 10: ukama.nucleus.org.v1.RegisterUserResponse
-	(*GetMembersRequest)(nil),        // 11: ukama.nucleus.org.v1.GetMembersRequest
-	(*UpdateUserRequest)(nil),        // 12: ukama.nucleus.org.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),       // 13: ukama.nucleus.org.v1.UpdateUserResponse
-	(*Organization)(nil),             // 14: ukama.nucleus.org.v1.Organization
-	(*UserAttributes)(nil),           // 15: ukama.nucleus.org.v1.UserAttributes
-	(*User)(nil),                     // 16: ukama.nucleus.org.v1.User
-	(*UpdateOrgForUserRequest)(nil),  // 17: ukama.nucleus.org.v1.UpdateOrgForUserRequest
-	(*UpdateOrgForUserResponse)(nil), // 18: ukama.nucleus.org.v1.UpdateOrgForUserResponse
-	(*RemoveOrgForUserRequest)(nil),  // 19: ukama.nucleus.org.v1.RemoveOrgForUserRequest
-	(*RemoveOrgForUserResponse)(nil), // 20: ukama.nucleus.org.v1.RemoveOrgForUserResponse
-	(*timestamppb.Timestamp)(nil),    // 21: google.protobuf.Timestamp
+	(*AddRequest)(nil),                  // 0: ukama.nucleus.org.v1.AddRequest
+	(*AddResponse)(nil),                 // 1: ukama.nucleus.org.v1.AddResponse
+	(*GetRequest)(nil),                  // 2: ukama.nucleus.org.v1.GetRequest
+	(*GetResponse)(nil),                 // 3: ukama.nucleus.org.v1.GetResponse
+	(*GetByOwnerRequest)(nil),           // 4: ukama.nucleus.org.v1.GetByOwnerRequest
+	(*GetByOwnerResponse)(nil),          // 5: ukama.nucleus.org.v1.GetByOwnerResponse
+	(*GetByUserResponse)(nil),           // 6: ukama.nucleus.org.v1.GetByUserResponse
+	(*GetByNameRequest)(nil),            // 7: ukama.nucleus.org.v1.GetByNameRequest
+	(*GetByNameResponse)(nil),           // 8: ukama.nucleus.org.v1.GetByNameResponse
+	(*RegisterUserRequest)(nil),         // 9: ukama.nucleus.org.v1.RegisterUserRequest
+	(*RegisterUserResponse)(nil),        // 10: ukama.nucleus.org.v1.RegisterUserResponse
+	(*GetMembersRequest)(nil),           // 11: ukama.nucleus.org.v1.GetMembersRequest
+	(*UpdateUserRequest)(nil),           // 12: ukama.nucleus.org.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),          // 13: ukama.nucleus.org.v1.UpdateUserResponse
+	(*Organization)(nil),                // 14: ukama.nucleus.org.v1.Organization
+	(*UserAttributes)(nil),              // 15: ukama.nucleus.org.v1.UserAttributes
+	(*User)(nil),                        // 16: ukama.nucleus.org.v1.User
+	(*UpdateOrgForUserRequest)(nil),     // 17: ukama.nucleus.org.v1.UpdateOrgForUserRequest
+	(*UpdateOrgForUserResponse)(nil),    // 18: ukama.nucleus.org.v1.UpdateOrgForUserResponse
+	(*RemoveOrgForUserRequest)(nil),     // 19: ukama.nucleus.org.v1.RemoveOrgForUserRequest
+	(*RemoveOrgForUserResponse)(nil),    // 20: ukama.nucleus.org.v1.RemoveOrgForUserResponse
+	(*ServiceAccount)(nil),              // 21: ukama.nucleus.org.v1.ServiceAccount
+	(*RoleBinding)(nil),                 // 22: ukama.nucleus.org.v1.RoleBinding
+	(*AddServiceAccountRequest)(nil),    // 23: ukama.nucleus.org.v1.AddServiceAccountRequest
+	(*GetServiceAccountRequest)(nil),    // 24: ukama.nucleus.org.v1.GetServiceAccountRequest
+	(*ServiceAccountResponse)(nil),      // 25: ukama.nucleus.org.v1.ServiceAccountResponse
+	(*ListServiceAccountsRequest)(nil),  // 26: ukama.nucleus.org.v1.ListServiceAccountsRequest
+	(*ListServiceAccountsResponse)(nil), // 27: ukama.nucleus.org.v1.ListServiceAccountsResponse
+	(*RevokeServiceAccountRequest)(nil), // 28: ukama.nucleus.org.v1.RevokeServiceAccountRequest
+	(*ApiKey)(nil),                      // 29: ukama.nucleus.org.v1.ApiKey
+	(*CreateApiKeyRequest)(nil),         // 30: ukama.nucleus.org.v1.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),        // 31: ukama.nucleus.org.v1.CreateApiKeyResponse
+	(*RotateApiKeyRequest)(nil),         // 32: ukama.nucleus.org.v1.RotateApiKeyRequest
+	(*RevokeApiKeyRequest)(nil),         // 33: ukama.nucleus.org.v1.RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),        // 34: ukama.nucleus.org.v1.RevokeApiKeyResponse
+	(*ListApiKeysRequest)(nil),          // 35: ukama.nucleus.org.v1.ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),         // 36: ukama.nucleus.org.v1.ListApiKeysResponse
+	(*ValidateApiKeyRequest)(nil),       // 37: ukama.nucleus.org.v1.ValidateApiKeyRequest
+	(*ValidateApiKeyResponse)(nil),      // 38: ukama.nucleus.org.v1.ValidateApiKeyResponse
+	(*ListApiKeyUsageRequest)(nil),      // 39: ukama.nucleus.org.v1.ListApiKeyUsageRequest
+	(*ApiKeyUsage)(nil),                 // 40: ukama.nucleus.org.v1.ApiKeyUsage
+	(*ListApiKeyUsageResponse)(nil),     // 41: ukama.nucleus.org.v1.ListApiKeyUsageResponse
+	(*timestamppb.Timestamp)(nil),       // 42: google.protobuf.Timestamp
 }
 var file_org_proto_depIdxs = []int32{
 	14, // 0: ukama.nucleus.org.v1.AddRequest.org:type_name -> ukama.nucleus.org.v1.Organization
@@ -1177,30 +2450,63 @@ var file_org_proto_depIdxs = []int32{
 	14, // 6: ukama.nucleus.org.v1.GetByNameResponse.org:type_name -> ukama.nucleus.org.v1.Organization
 	15, // 7: ukama.nucleus.org.v1.UpdateUserRequest.attributes:type_name -> ukama.nucleus.org.v1.UserAttributes
 	16, // 8: ukama.nucleus.org.v1.UpdateUserResponse.user:type_name -> ukama.nucleus.org.v1.User
-	21, // 9: ukama.nucleus.org.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 10: ukama.nucleus.org.v1.OrgService.Add:input_type -> ukama.nucleus.org.v1.AddRequest
-	2,  // 11: ukama.nucleus.org.v1.OrgService.Get:input_type -> ukama.nucleus.org.v1.GetRequest
-	7,  // 12: ukama.nucleus.org.v1.OrgService.GetByName:input_type -> ukama.nucleus.org.v1.GetByNameRequest
-	4,  // 13: ukama.nucleus.org.v1.OrgService.GetByOwner:input_type -> ukama.nucleus.org.v1.GetByOwnerRequest
-	4,  // 14: ukama.nucleus.org.v1.OrgService.GetByUser:input_type -> ukama.nucleus.org.v1.GetByOwnerRequest
-	9,  // 15: ukama.nucleus.org.v1.OrgService.RegisterUser:input_type -> ukama.nucleus.org.v1.RegisterUserRequest
-	12, // 16: ukama.nucleus.org.v1.OrgService.UpdateUser:input_type -> ukama.nucleus.org.v1.UpdateUserRequest
-	17, // 17: ukama.nucleus.org.v1.OrgService.UpdateOrgForUser:input_type -> ukama.nucleus.org.v1.UpdateOrgForUserRequest
-	19, // 18: ukama.nucleus.org.v1.OrgService.RemoveOrgForUser:input_type -> ukama.nucleus.org.v1.RemoveOrgForUserRequest
-	1,  // 19: ukama.nucleus.org.v1.OrgService.Add:output_type -> ukama.nucleus.org.v1.AddResponse
-	3,  // 20: ukama.nucleus.org.v1.OrgService.Get:output_type -> ukama.nucleus.org.v1.GetResponse
-	8,  // 21: ukama.nucleus.org.v1.OrgService.GetByName:output_type -> ukama.nucleus.org.v1.GetByNameResponse
-	5,  // 22: ukama.nucleus.org.v1.OrgService.GetByOwner:output_type -> ukama.nucleus.org.v1.GetByOwnerResponse
-	6,  // 23: ukama.nucleus.org.v1.OrgService.GetByUser:output_type -> ukama.nucleus.org.v1.GetByUserResponse
-	10, // 24: ukama.nucleus.org.v1.OrgService.RegisterUser:output_type -> ukama.nucleus.org.v1.RegisterUserResponse
-	13, // 25: ukama.nucleus.org.v1.OrgService.UpdateUser:output_type -> ukama.nucleus.org.v1.UpdateUserResponse
-	18, // 26: ukama.nucleus.org.v1.OrgService.UpdateOrgForUser:output_type -> ukama.nucleus.org.v1.UpdateOrgForUserResponse
-	20, // 27: ukama.nucleus.org.v1.OrgService.RemoveOrgForUser:output_type -> ukama.nucleus.org.v1.RemoveOrgForUserResponse
-	19, // [19:28] is the sub-list for method output_type
-	10, // [10:19] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	42, // 9: ukama.nucleus.org.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
+	42, // 10: ukama.nucleus.org.v1.ServiceAccount.created_at:type_name -> google.protobuf.Timestamp
+	42, // 11: ukama.nucleus.org.v1.ServiceAccount.revoked_at:type_name -> google.protobuf.Timestamp
+	22, // 12: ukama.nucleus.org.v1.AddServiceAccountRequest.bindings:type_name -> ukama.nucleus.org.v1.RoleBinding
+	21, // 13: ukama.nucleus.org.v1.ServiceAccountResponse.account:type_name -> ukama.nucleus.org.v1.ServiceAccount
+	21, // 14: ukama.nucleus.org.v1.ListServiceAccountsResponse.accounts:type_name -> ukama.nucleus.org.v1.ServiceAccount
+	42, // 15: ukama.nucleus.org.v1.ApiKey.created_at:type_name -> google.protobuf.Timestamp
+	42, // 16: ukama.nucleus.org.v1.ApiKey.expires_at:type_name -> google.protobuf.Timestamp
+	42, // 17: ukama.nucleus.org.v1.ApiKey.last_used_at:type_name -> google.protobuf.Timestamp
+	42, // 18: ukama.nucleus.org.v1.ApiKey.revoked_at:type_name -> google.protobuf.Timestamp
+	29, // 19: ukama.nucleus.org.v1.CreateApiKeyResponse.key:type_name -> ukama.nucleus.org.v1.ApiKey
+	29, // 20: ukama.nucleus.org.v1.ListApiKeysResponse.keys:type_name -> ukama.nucleus.org.v1.ApiKey
+	42, // 21: ukama.nucleus.org.v1.ApiKeyUsage.created_at:type_name -> google.protobuf.Timestamp
+	40, // 22: ukama.nucleus.org.v1.ListApiKeyUsageResponse.usage:type_name -> ukama.nucleus.org.v1.ApiKeyUsage
+	0,  // 23: ukama.nucleus.org.v1.OrgService.Add:input_type -> ukama.nucleus.org.v1.AddRequest
+	2,  // 24: ukama.nucleus.org.v1.OrgService.Get:input_type -> ukama.nucleus.org.v1.GetRequest
+	7,  // 25: ukama.nucleus.org.v1.OrgService.GetByName:input_type -> ukama.nucleus.org.v1.GetByNameRequest
+	4,  // 26: ukama.nucleus.org.v1.OrgService.GetByOwner:input_type -> ukama.nucleus.org.v1.GetByOwnerRequest
+	4,  // 27: ukama.nucleus.org.v1.OrgService.GetByUser:input_type -> ukama.nucleus.org.v1.GetByOwnerRequest
+	9,  // 28: ukama.nucleus.org.v1.OrgService.RegisterUser:input_type -> ukama.nucleus.org.v1.RegisterUserRequest
+	12, // 29: ukama.nucleus.org.v1.OrgService.UpdateUser:input_type -> ukama.nucleus.org.v1.UpdateUserRequest
+	17, // 30: ukama.nucleus.org.v1.OrgService.UpdateOrgForUser:input_type -> ukama.nucleus.org.v1.UpdateOrgForUserRequest
+	19, // 31: ukama.nucleus.org.v1.OrgService.RemoveOrgForUser:input_type -> ukama.nucleus.org.v1.RemoveOrgForUserRequest
+	23, // 32: ukama.nucleus.org.v1.OrgService.AddServiceAccount:input_type -> ukama.nucleus.org.v1.AddServiceAccountRequest
+	24, // 33: ukama.nucleus.org.v1.OrgService.GetServiceAccount:input_type -> ukama.nucleus.org.v1.GetServiceAccountRequest
+	26, // 34: ukama.nucleus.org.v1.OrgService.ListServiceAccounts:input_type -> ukama.nucleus.org.v1.ListServiceAccountsRequest
+	28, // 35: ukama.nucleus.org.v1.OrgService.RevokeServiceAccount:input_type -> ukama.nucleus.org.v1.RevokeServiceAccountRequest
+	30, // 36: ukama.nucleus.org.v1.OrgService.CreateApiKey:input_type -> ukama.nucleus.org.v1.CreateApiKeyRequest
+	32, // 37: ukama.nucleus.org.v1.OrgService.RotateApiKey:input_type -> ukama.nucleus.org.v1.RotateApiKeyRequest
+	33, // 38: ukama.nucleus.org.v1.OrgService.RevokeApiKey:input_type -> ukama.nucleus.org.v1.RevokeApiKeyRequest
+	35, // 39: ukama.nucleus.org.v1.OrgService.ListApiKeys:input_type -> ukama.nucleus.org.v1.ListApiKeysRequest
+	37, // 40: ukama.nucleus.org.v1.OrgService.ValidateApiKey:input_type -> ukama.nucleus.org.v1.ValidateApiKeyRequest
+	39, // 41: ukama.nucleus.org.v1.OrgService.ListApiKeyUsage:input_type -> ukama.nucleus.org.v1.ListApiKeyUsageRequest
+	1,  // 42: ukama.nucleus.org.v1.OrgService.Add:output_type -> ukama.nucleus.org.v1.AddResponse
+	3,  // 43: ukama.nucleus.org.v1.OrgService.Get:output_type -> ukama.nucleus.org.v1.GetResponse
+	8,  // 44: ukama.nucleus.org.v1.OrgService.GetByName:output_type -> ukama.nucleus.org.v1.GetByNameResponse
+	5,  // 45: ukama.nucleus.org.v1.OrgService.GetByOwner:output_type -> ukama.nucleus.org.v1.GetByOwnerResponse
+	6,  // 46: ukama.nucleus.org.v1.OrgService.GetByUser:output_type -> ukama.nucleus.org.v1.GetByUserResponse
+	10, // 47: ukama.nucleus.org.v1.OrgService.RegisterUser:output_type -> ukama.nucleus.org.v1.RegisterUserResponse
+	13, // 48: ukama.nucleus.org.v1.OrgService.UpdateUser:output_type -> ukama.nucleus.org.v1.UpdateUserResponse
+	18, // 49: ukama.nucleus.org.v1.OrgService.UpdateOrgForUser:output_type -> ukama.nucleus.org.v1.UpdateOrgForUserResponse
+	20, // 50: ukama.nucleus.org.v1.OrgService.RemoveOrgForUser:output_type -> ukama.nucleus.org.v1.RemoveOrgForUserResponse
+	25, // 51: ukama.nucleus.org.v1.OrgService.AddServiceAccount:output_type -> ukama.nucleus.org.v1.ServiceAccountResponse
+	25, // 52: ukama.nucleus.org.v1.OrgService.GetServiceAccount:output_type -> ukama.nucleus.org.v1.ServiceAccountResponse
+	27, // 53: ukama.nucleus.org.v1.OrgService.ListServiceAccounts:output_type -> ukama.nucleus.org.v1.ListServiceAccountsResponse
+	25, // 54: ukama.nucleus.org.v1.OrgService.RevokeServiceAccount:output_type -> ukama.nucleus.org.v1.ServiceAccountResponse
+	31, // 55: ukama.nucleus.org.v1.OrgService.CreateApiKey:output_type -> ukama.nucleus.org.v1.CreateApiKeyResponse
+	31, // 56: ukama.nucleus.org.v1.OrgService.RotateApiKey:output_type -> ukama.nucleus.org.v1.CreateApiKeyResponse
+	34, // 57: ukama.nucleus.org.v1.OrgService.RevokeApiKey:output_type -> ukama.nucleus.org.v1.RevokeApiKeyResponse
+	36, // 58: ukama.nucleus.org.v1.OrgService.ListApiKeys:output_type -> ukama.nucleus.org.v1.ListApiKeysResponse
+	38, // 59: ukama.nucleus.org.v1.OrgService.ValidateApiKey:output_type -> ukama.nucleus.org.v1.ValidateApiKeyResponse
+	41, // 60: ukama.nucleus.org.v1.OrgService.ListApiKeyUsage:output_type -> ukama.nucleus.org.v1.ListApiKeyUsageResponse
+	42, // [42:61] is the sub-list for method output_type
+	23, // [23:42] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_org_proto_init() }
@@ -1214,7 +2520,7 @@ func file_org_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_org_proto_rawDesc), len(file_org_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
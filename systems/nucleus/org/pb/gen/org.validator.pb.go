@@ -246,3 +246,233 @@ func (this *RemoveOrgForUserRequest) Validate() error {
 func (this *RemoveOrgForUserResponse) Validate() error {
 	return nil
 }
+func (this *ServiceAccount) Validate() error {
+	if this.CreatedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+		}
+	}
+	if this.RevokedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.RevokedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("RevokedAt", err)
+		}
+	}
+	return nil
+}
+func (this *RoleBinding) Validate() error {
+	if this.Role == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Role", fmt.Errorf(`value '%v' must not be an empty string`, this.Role))
+	}
+	return nil
+}
+
+var _regex_AddServiceAccountRequest_Name = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+var _regex_AddServiceAccountRequest_CreatedBy = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *AddServiceAccountRequest) Validate() error {
+	if !_regex_AddServiceAccountRequest_Name.MatchString(this.Name) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Name", fmt.Errorf(`value '%v' must be a string conforming to regex "^[a-z0-9][a-z0-9_-]{0,63}$"`, this.Name))
+	}
+	if !_regex_AddServiceAccountRequest_CreatedBy.MatchString(this.CreatedBy) {
+		return github_com_mwitkow_go_proto_validators.FieldError("CreatedBy", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.CreatedBy))
+	}
+	if this.CreatedBy == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("CreatedBy", fmt.Errorf(`value '%v' must not be an empty string`, this.CreatedBy))
+	}
+	if len(this.Bindings) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Bindings", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Bindings))
+	}
+	for _, item := range this.Bindings {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Bindings", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_GetServiceAccountRequest_Id = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *GetServiceAccountRequest) Validate() error {
+	if !_regex_GetServiceAccountRequest_Id.MatchString(this.Id) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.Id))
+	}
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	return nil
+}
+func (this *ServiceAccountResponse) Validate() error {
+	if this.Account != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Account); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Account", err)
+		}
+	}
+	return nil
+}
+func (this *ListServiceAccountsRequest) Validate() error {
+	return nil
+}
+func (this *ListServiceAccountsResponse) Validate() error {
+	for _, item := range this.Accounts {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Accounts", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_RevokeServiceAccountRequest_Id = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *RevokeServiceAccountRequest) Validate() error {
+	if !_regex_RevokeServiceAccountRequest_Id.MatchString(this.Id) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.Id))
+	}
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	return nil
+}
+func (this *ApiKey) Validate() error {
+	if this.CreatedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+		}
+	}
+	if this.ExpiresAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ExpiresAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ExpiresAt", err)
+		}
+	}
+	if this.LastUsedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LastUsedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LastUsedAt", err)
+		}
+	}
+	if this.RevokedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.RevokedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("RevokedAt", err)
+		}
+	}
+	return nil
+}
+
+var _regex_CreateApiKeyRequest_AccountId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *CreateApiKeyRequest) Validate() error {
+	if !_regex_CreateApiKeyRequest_AccountId.MatchString(this.AccountId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("AccountId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.AccountId))
+	}
+	if this.AccountId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("AccountId", fmt.Errorf(`value '%v' must not be an empty string`, this.AccountId))
+	}
+	return nil
+}
+func (this *CreateApiKeyResponse) Validate() error {
+	if this.Key != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Key); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Key", err)
+		}
+	}
+	return nil
+}
+
+var _regex_RotateApiKeyRequest_Id = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *RotateApiKeyRequest) Validate() error {
+	if !_regex_RotateApiKeyRequest_Id.MatchString(this.Id) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.Id))
+	}
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	return nil
+}
+
+var _regex_RevokeApiKeyRequest_Id = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *RevokeApiKeyRequest) Validate() error {
+	if !_regex_RevokeApiKeyRequest_Id.MatchString(this.Id) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.Id))
+	}
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	return nil
+}
+func (this *RevokeApiKeyResponse) Validate() error {
+	return nil
+}
+
+var _regex_ListApiKeysRequest_AccountId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *ListApiKeysRequest) Validate() error {
+	if !_regex_ListApiKeysRequest_AccountId.MatchString(this.AccountId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("AccountId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.AccountId))
+	}
+	if this.AccountId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("AccountId", fmt.Errorf(`value '%v' must not be an empty string`, this.AccountId))
+	}
+	return nil
+}
+func (this *ListApiKeysResponse) Validate() error {
+	for _, item := range this.Keys {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Keys", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_ValidateApiKeyRequest_OrgId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *ValidateApiKeyRequest) Validate() error {
+	if this.Key == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Key", fmt.Errorf(`value '%v' must not be an empty string`, this.Key))
+	}
+	if !_regex_ValidateApiKeyRequest_OrgId.MatchString(this.OrgId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("OrgId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.OrgId))
+	}
+	if this.OrgId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("OrgId", fmt.Errorf(`value '%v' must not be an empty string`, this.OrgId))
+	}
+	return nil
+}
+func (this *ValidateApiKeyResponse) Validate() error {
+	return nil
+}
+
+var _regex_ListApiKeyUsageRequest_AccountId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *ListApiKeyUsageRequest) Validate() error {
+	if !_regex_ListApiKeyUsageRequest_AccountId.MatchString(this.AccountId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("AccountId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.AccountId))
+	}
+	if this.AccountId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("AccountId", fmt.Errorf(`value '%v' must not be an empty string`, this.AccountId))
+	}
+	return nil
+}
+func (this *ApiKeyUsage) Validate() error {
+	if this.CreatedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+		}
+	}
+	return nil
+}
+func (this *ListApiKeyUsageResponse) Validate() error {
+	for _, item := range this.Usage {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Usage", err)
+			}
+		}
+	}
+	return nil
+}
@@ -8,7 +8,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.6.1
-// - protoc             v7.34.1
+// - protoc             v6.32.1
 // source: org.proto
 
 package gen
@@ -26,15 +26,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrgService_Add_FullMethodName              = "/ukama.nucleus.org.v1.OrgService/Add"
-	OrgService_Get_FullMethodName              = "/ukama.nucleus.org.v1.OrgService/Get"
-	OrgService_GetByName_FullMethodName        = "/ukama.nucleus.org.v1.OrgService/GetByName"
-	OrgService_GetByOwner_FullMethodName       = "/ukama.nucleus.org.v1.OrgService/GetByOwner"
-	OrgService_GetByUser_FullMethodName        = "/ukama.nucleus.org.v1.OrgService/GetByUser"
-	OrgService_RegisterUser_FullMethodName     = "/ukama.nucleus.org.v1.OrgService/RegisterUser"
-	OrgService_UpdateUser_FullMethodName       = "/ukama.nucleus.org.v1.OrgService/UpdateUser"
-	OrgService_UpdateOrgForUser_FullMethodName = "/ukama.nucleus.org.v1.OrgService/UpdateOrgForUser"
-	OrgService_RemoveOrgForUser_FullMethodName = "/ukama.nucleus.org.v1.OrgService/RemoveOrgForUser"
+	OrgService_Add_FullMethodName                  = "/ukama.nucleus.org.v1.OrgService/Add"
+	OrgService_Get_FullMethodName                  = "/ukama.nucleus.org.v1.OrgService/Get"
+	OrgService_GetByName_FullMethodName            = "/ukama.nucleus.org.v1.OrgService/GetByName"
+	OrgService_GetByOwner_FullMethodName           = "/ukama.nucleus.org.v1.OrgService/GetByOwner"
+	OrgService_GetByUser_FullMethodName            = "/ukama.nucleus.org.v1.OrgService/GetByUser"
+	OrgService_RegisterUser_FullMethodName         = "/ukama.nucleus.org.v1.OrgService/RegisterUser"
+	OrgService_UpdateUser_FullMethodName           = "/ukama.nucleus.org.v1.OrgService/UpdateUser"
+	OrgService_UpdateOrgForUser_FullMethodName     = "/ukama.nucleus.org.v1.OrgService/UpdateOrgForUser"
+	OrgService_RemoveOrgForUser_FullMethodName     = "/ukama.nucleus.org.v1.OrgService/RemoveOrgForUser"
+	OrgService_AddServiceAccount_FullMethodName    = "/ukama.nucleus.org.v1.OrgService/AddServiceAccount"
+	OrgService_GetServiceAccount_FullMethodName    = "/ukama.nucleus.org.v1.OrgService/GetServiceAccount"
+	OrgService_ListServiceAccounts_FullMethodName  = "/ukama.nucleus.org.v1.OrgService/ListServiceAccounts"
+	OrgService_RevokeServiceAccount_FullMethodName = "/ukama.nucleus.org.v1.OrgService/RevokeServiceAccount"
+	OrgService_CreateApiKey_FullMethodName         = "/ukama.nucleus.org.v1.OrgService/CreateApiKey"
+	OrgService_RotateApiKey_FullMethodName         = "/ukama.nucleus.org.v1.OrgService/RotateApiKey"
+	OrgService_RevokeApiKey_FullMethodName         = "/ukama.nucleus.org.v1.OrgService/RevokeApiKey"
+	OrgService_ListApiKeys_FullMethodName          = "/ukama.nucleus.org.v1.OrgService/ListApiKeys"
+	OrgService_ValidateApiKey_FullMethodName       = "/ukama.nucleus.org.v1.OrgService/ValidateApiKey"
+	OrgService_ListApiKeyUsage_FullMethodName      = "/ukama.nucleus.org.v1.OrgService/ListApiKeyUsage"
 )
 
 // OrgServiceClient is the client API for OrgService service.
@@ -52,6 +62,18 @@ type OrgServiceClient interface {
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 	UpdateOrgForUser(ctx context.Context, in *UpdateOrgForUserRequest, opts ...grpc.CallOption) (*UpdateOrgForUserResponse, error)
 	RemoveOrgForUser(ctx context.Context, in *RemoveOrgForUserRequest, opts ...grpc.CallOption) (*RemoveOrgForUserResponse, error)
+	// Service accounts
+	AddServiceAccount(ctx context.Context, in *AddServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountResponse, error)
+	GetServiceAccount(ctx context.Context, in *GetServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountResponse, error)
+	ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
+	RevokeServiceAccount(ctx context.Context, in *RevokeServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountResponse, error)
+	// API keys
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	ValidateApiKey(ctx context.Context, in *ValidateApiKeyRequest, opts ...grpc.CallOption) (*ValidateApiKeyResponse, error)
+	ListApiKeyUsage(ctx context.Context, in *ListApiKeyUsageRequest, opts ...grpc.CallOption) (*ListApiKeyUsageResponse, error)
 }
 
 type orgServiceClient struct {
@@ -152,6 +174,106 @@ func (c *orgServiceClient) RemoveOrgForUser(ctx context.Context, in *RemoveOrgFo
 	return out, nil
 }
 
+func (c *orgServiceClient) AddServiceAccount(ctx context.Context, in *AddServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceAccountResponse)
+	err := c.cc.Invoke(ctx, OrgService_AddServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgServiceClient) GetServiceAccount(ctx context.Context, in *GetServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceAccountResponse)
+	err := c.cc.Invoke(ctx, OrgService_GetServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgServiceClient) ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, OrgService_ListServiceAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgServiceClient) RevokeServiceAccount(ctx context.Context, in *RevokeServiceAccountRequest, opts ...grpc.CallOption) (*ServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceAccountResponse)
+	err := c.cc.Invoke(ctx, OrgService_RevokeServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, OrgService_CreateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgServiceClient) RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, OrgService_RotateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgServiceClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, OrgService_RevokeApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgServiceClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, OrgService_ListApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgServiceClient) ValidateApiKey(ctx context.Context, in *ValidateApiKeyRequest, opts ...grpc.CallOption) (*ValidateApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateApiKeyResponse)
+	err := c.cc.Invoke(ctx, OrgService_ValidateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgServiceClient) ListApiKeyUsage(ctx context.Context, in *ListApiKeyUsageRequest, opts ...grpc.CallOption) (*ListApiKeyUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiKeyUsageResponse)
+	err := c.cc.Invoke(ctx, OrgService_ListApiKeyUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrgServiceServer is the server API for OrgService service.
 // All implementations must embed UnimplementedOrgServiceServer
 // for forward compatibility.
@@ -167,6 +289,18 @@ type OrgServiceServer interface {
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
 	UpdateOrgForUser(context.Context, *UpdateOrgForUserRequest) (*UpdateOrgForUserResponse, error)
 	RemoveOrgForUser(context.Context, *RemoveOrgForUserRequest) (*RemoveOrgForUserResponse, error)
+	// Service accounts
+	AddServiceAccount(context.Context, *AddServiceAccountRequest) (*ServiceAccountResponse, error)
+	GetServiceAccount(context.Context, *GetServiceAccountRequest) (*ServiceAccountResponse, error)
+	ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error)
+	RevokeServiceAccount(context.Context, *RevokeServiceAccountRequest) (*ServiceAccountResponse, error)
+	// API keys
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	RotateApiKey(context.Context, *RotateApiKeyRequest) (*CreateApiKeyResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	ValidateApiKey(context.Context, *ValidateApiKeyRequest) (*ValidateApiKeyResponse, error)
+	ListApiKeyUsage(context.Context, *ListApiKeyUsageRequest) (*ListApiKeyUsageResponse, error)
 	mustEmbedUnimplementedOrgServiceServer()
 }
 
@@ -204,6 +338,36 @@ func (UnimplementedOrgServiceServer) UpdateOrgForUser(context.Context, *UpdateOr
 func (UnimplementedOrgServiceServer) RemoveOrgForUser(context.Context, *RemoveOrgForUserRequest) (*RemoveOrgForUserResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RemoveOrgForUser not implemented")
 }
+func (UnimplementedOrgServiceServer) AddServiceAccount(context.Context, *AddServiceAccountRequest) (*ServiceAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddServiceAccount not implemented")
+}
+func (UnimplementedOrgServiceServer) GetServiceAccount(context.Context, *GetServiceAccountRequest) (*ServiceAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetServiceAccount not implemented")
+}
+func (UnimplementedOrgServiceServer) ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedOrgServiceServer) RevokeServiceAccount(context.Context, *RevokeServiceAccountRequest) (*ServiceAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeServiceAccount not implemented")
+}
+func (UnimplementedOrgServiceServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedOrgServiceServer) RotateApiKey(context.Context, *RotateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateApiKey not implemented")
+}
+func (UnimplementedOrgServiceServer) RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedOrgServiceServer) ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedOrgServiceServer) ValidateApiKey(context.Context, *ValidateApiKeyRequest) (*ValidateApiKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateApiKey not implemented")
+}
+func (UnimplementedOrgServiceServer) ListApiKeyUsage(context.Context, *ListApiKeyUsageRequest) (*ListApiKeyUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListApiKeyUsage not implemented")
+}
 func (UnimplementedOrgServiceServer) mustEmbedUnimplementedOrgServiceServer() {}
 func (UnimplementedOrgServiceServer) testEmbeddedByValue()                    {}
 
@@ -387,6 +551,186 @@ func _OrgService_RemoveOrgForUser_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrgService_AddServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).AddServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_AddServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).AddServiceAccount(ctx, req.(*AddServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgService_GetServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).GetServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_GetServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).GetServiceAccount(ctx, req.(*GetServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgService_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).ListServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_ListServiceAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).ListServiceAccounts(ctx, req.(*ListServiceAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgService_RevokeServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).RevokeServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_RevokeServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).RevokeServiceAccount(ctx, req.(*RevokeServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_CreateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgService_RotateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).RotateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_RotateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).RotateApiKey(ctx, req.(*RotateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgService_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_RevokeApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgService_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_ListApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgService_ValidateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).ValidateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_ValidateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).ValidateApiKey(ctx, req.(*ValidateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgService_ListApiKeyUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeyUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).ListApiKeyUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_ListApiKeyUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).ListApiKeyUsage(ctx, req.(*ListApiKeyUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrgService_ServiceDesc is the grpc.ServiceDesc for OrgService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -430,6 +774,46 @@ var OrgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveOrgForUser",
 			Handler:    _OrgService_RemoveOrgForUser_Handler,
 		},
+		{
+			MethodName: "AddServiceAccount",
+			Handler:    _OrgService_AddServiceAccount_Handler,
+		},
+		{
+			MethodName: "GetServiceAccount",
+			Handler:    _OrgService_GetServiceAccount_Handler,
+		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _OrgService_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "RevokeServiceAccount",
+			Handler:    _OrgService_RevokeServiceAccount_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _OrgService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "RotateApiKey",
+			Handler:    _OrgService_RotateApiKey_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _OrgService_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _OrgService_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "ValidateApiKey",
+			Handler:    _OrgService_ValidateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeyUsage",
+			Handler:    _OrgService_ListApiKeyUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "org.proto",
@@ -38,3 +38,40 @@ type User struct {
 	Deactivated bool
 	DeletedAt   gorm.DeletedAt `gorm:"index"`
 }
+
+// ServiceAccount is a non human member of the org that automation
+// authenticates as, through its API keys. Its keys are only valid for
+// requests made to that org.
+type ServiceAccount struct {
+	Id          uuid.UUID `gorm:"primaryKey;type:uuid"`
+	OrgId       uuid.UUID `gorm:"type:uuid;index"`
+	Name        string    `gorm:"uniqueIndex;not null"`
+	Description string
+	CreatedBy   uuid.UUID `gorm:"type:uuid"`
+	RevokedAt   *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ApiKey is a key of a service account. Only the hash of the secret is
+// kept, the prefix finds the key when it is presented.
+type ApiKey struct {
+	Id         uuid.UUID `gorm:"primaryKey;type:uuid"`
+	AccountId  uuid.UUID `gorm:"type:uuid;not null;index"`
+	Prefix     string    `gorm:"uniqueIndex;not null"`
+	Hash       string    `gorm:"not null"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// ApiKeyUsage is the audit trail of the requests made with a key
+type ApiKeyUsage struct {
+	Id        uint      `gorm:"primaryKey"`
+	KeyId     uuid.UUID `gorm:"type:uuid;not null;index"`
+	AccountId uuid.UUID `gorm:"type:uuid;not null;index"`
+	Method    string
+	Path      string
+	CreatedAt time.Time `gorm:"index"`
+}
@@ -0,0 +1,162 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/ukama/ukama/systems/common/sql"
+	"github.com/ukama/ukama/systems/common/uuid"
+)
+
+type ServiceAccountRepo interface {
+	Add(account *ServiceAccount, nestedFunc func(*ServiceAccount, *gorm.DB) error) error
+	Get(id uuid.UUID) (*ServiceAccount, error)
+	List() ([]ServiceAccount, error)
+	// Revoke revokes the account and all of its keys
+	Revoke(id uuid.UUID, at time.Time) error
+
+	AddKey(key *ApiKey) error
+	GetKey(id uuid.UUID) (*ApiKey, error)
+	GetKeyByPrefix(prefix string) (*ApiKey, error)
+	ListKeys(accountId uuid.UUID) ([]ApiKey, error)
+	// ExpireKey brings the expiry of a key forward to at, if it is later
+	ExpireKey(id uuid.UUID, at time.Time) error
+	RevokeKey(id uuid.UUID, at time.Time) error
+
+	// RecordUsage marks the key used and adds the request to its audit trail
+	RecordUsage(usage *ApiKeyUsage) error
+	ListUsage(accountId uuid.UUID, limit int) ([]ApiKeyUsage, error)
+}
+
+type serviceAccountRepo struct {
+	Db sql.Db
+}
+
+func NewServiceAccountRepo(db sql.Db) ServiceAccountRepo {
+	return &serviceAccountRepo{
+		Db: db,
+	}
+}
+
+func (s *serviceAccountRepo) Add(account *ServiceAccount, nestedFunc func(*ServiceAccount, *gorm.DB) error) error {
+	return s.Db.GetGormDb().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(account).Error; err != nil {
+			return err
+		}
+
+		if nestedFunc != nil {
+			return nestedFunc(account, tx)
+		}
+		return nil
+	})
+}
+
+func (s *serviceAccountRepo) Get(id uuid.UUID) (*ServiceAccount, error) {
+	var account ServiceAccount
+	err := s.Db.GetGormDb().Where("id = ?", id).First(&account).Error
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+func (s *serviceAccountRepo) List() ([]ServiceAccount, error) {
+	var accounts []ServiceAccount
+	err := s.Db.GetGormDb().Order("created_at").Find(&accounts).Error
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+func (s *serviceAccountRepo) Revoke(id uuid.UUID, at time.Time) error {
+	return s.Db.GetGormDb().Transaction(func(tx *gorm.DB) error {
+		d := tx.Model(&ServiceAccount{}).Where("id = ? AND revoked_at IS NULL", id).Update("revoked_at", at)
+		if d.Error != nil {
+			return d.Error
+		}
+		if d.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Model(&ApiKey{}).Where("account_id = ? AND revoked_at IS NULL", id).
+			Update("revoked_at", at).Error
+	})
+}
+
+func (s *serviceAccountRepo) AddKey(key *ApiKey) error {
+	return s.Db.GetGormDb().Create(key).Error
+}
+
+func (s *serviceAccountRepo) GetKey(id uuid.UUID) (*ApiKey, error) {
+	var key ApiKey
+	err := s.Db.GetGormDb().Where("id = ?", id).First(&key).Error
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (s *serviceAccountRepo) GetKeyByPrefix(prefix string) (*ApiKey, error) {
+	var key ApiKey
+	err := s.Db.GetGormDb().Where("prefix = ?", prefix).First(&key).Error
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (s *serviceAccountRepo) ListKeys(accountId uuid.UUID) ([]ApiKey, error) {
+	var keys []ApiKey
+	err := s.Db.GetGormDb().Where("account_id = ?", accountId).Order("created_at").Find(&keys).Error
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (s *serviceAccountRepo) ExpireKey(id uuid.UUID, at time.Time) error {
+	d := s.Db.GetGormDb().Model(&ApiKey{}).
+		Where("id = ? AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", id, at).
+		Update("expires_at", at)
+	return d.Error
+}
+
+func (s *serviceAccountRepo) RevokeKey(id uuid.UUID, at time.Time) error {
+	d := s.Db.GetGormDb().Model(&ApiKey{}).Where("id = ? AND revoked_at IS NULL", id).Update("revoked_at", at)
+	if d.Error != nil {
+		return d.Error
+	}
+	if d.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (s *serviceAccountRepo) RecordUsage(usage *ApiKeyUsage) error {
+	return s.Db.GetGormDb().Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&ApiKey{}).Where("id = ?", usage.KeyId).Update("last_used_at", usage.CreatedAt).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(usage).Error
+	})
+}
+
+func (s *serviceAccountRepo) ListUsage(accountId uuid.UUID, limit int) ([]ApiKeyUsage, error) {
+	var usage []ApiKeyUsage
+	err := s.Db.GetGormDb().Where("account_id = ?", accountId).Order("created_at desc").Limit(limit).Find(&usage).Error
+	if err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
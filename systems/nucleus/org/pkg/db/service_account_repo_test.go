@@ -0,0 +1,103 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/tj/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/ukama/ukama/systems/common/uuid"
+
+	org_db "github.com/ukama/ukama/systems/nucleus/org/pkg/db"
+)
+
+func setupServiceAccountTestDB(t *testing.T) (sqlmock.Sqlmock, org_db.ServiceAccountRepo) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		DSN:                  testDSN,
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gdb, err := gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(t, err)
+
+	return mock, org_db.NewServiceAccountRepo(&UkamaDbMock{GormDb: gdb})
+}
+
+func Test_ServiceAccountRepo_Revoke(t *testing.T) {
+	t.Run("RevokesAccountAndKeys", func(t *testing.T) {
+		mock, r := setupServiceAccountTestDB(t)
+		id := uuid.NewV4()
+		at := time.Now()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "service_accounts" SET "revoked_at"=$1,"updated_at"=$2 WHERE id = $3 AND revoked_at IS NULL`)).
+			WithArgs(at, sqlmock.AnyArg(), id).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "api_keys" SET "revoked_at"=$1 WHERE account_id = $2 AND revoked_at IS NULL`)).
+			WithArgs(at, id).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		err := r.Revoke(id, at)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyRevoked", func(t *testing.T) {
+		mock, r := setupServiceAccountTestDB(t)
+		id := uuid.NewV4()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "service_accounts"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := r.Revoke(id, time.Now())
+
+		assert.Equal(t, gorm.ErrRecordNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func Test_ServiceAccountRepo_RecordUsage(t *testing.T) {
+	mock, r := setupServiceAccountTestDB(t)
+	usage := &org_db.ApiKeyUsage{
+		KeyId:     uuid.NewV4(),
+		AccountId: uuid.NewV4(),
+		Method:    "GET",
+		Path:      "/v1/bills",
+		CreatedAt: time.Now(),
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "api_keys" SET "last_used_at"=$1 WHERE id = $2`)).
+		WithArgs(usage.CreatedAt, usage.KeyId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "api_key_usages"`)).
+		WithArgs(usage.KeyId, usage.AccountId, "GET", "/v1/bills", usage.CreatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	err := r.RecordUsage(usage)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
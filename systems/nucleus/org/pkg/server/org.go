@@ -37,6 +37,7 @@ type OrgService struct {
 	pb.UnimplementedOrgServiceServer
 	orgRepo             db.OrgRepo
 	userRepo            db.UserRepo
+	saRepo              db.ServiceAccountRepo
 	orchestratorService providers.OrchestratorProvider
 	registrySystem      creg.MemberClient
 	orgName             string
@@ -46,10 +47,11 @@ type OrgService struct {
 	debug               bool
 }
 
-func NewOrgServer(orgName string, orgRepo db.OrgRepo, userRepo db.UserRepo, orch providers.OrchestratorProvider, registry creg.MemberClient, msgBus mb.MsgBusServiceClient, pushgateway string, debug bool) *OrgService {
+func NewOrgServer(orgName string, orgRepo db.OrgRepo, userRepo db.UserRepo, saRepo db.ServiceAccountRepo, orch providers.OrchestratorProvider, registry creg.MemberClient, msgBus mb.MsgBusServiceClient, pushgateway string, debug bool) *OrgService {
 	return &OrgService{
 		orgRepo:             orgRepo,
 		userRepo:            userRepo,
+		saRepo:              saRepo,
 		orchestratorService: orch,
 		registrySystem:      registry,
 		orgName:             orgName,
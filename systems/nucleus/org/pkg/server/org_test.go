@@ -60,7 +60,7 @@ func TestOrgServer_Add(t *testing.T) {
 
 	orgRepo.On("GetOrgCount").Return(int64(1), int64(0), nil).Once()
 
-	s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registryClient, msgclientRepo, "", true)
+	s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registryClient, msgclientRepo, "", true)
 
 	t.Run("AddValidOrg", func(tt *testing.T) {
 		// Act
@@ -128,7 +128,7 @@ func TestOrgServer_Add(t *testing.T) {
 		msgclientRepo.On("PublishRequest", mock.Anything, mock.Anything).Return(nil).Once()
 		orgRepo.On("GetOrgCount").Return(int64(1), int64(0), nil).Once()
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registryClient, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registryClient, msgclientRepo, "", true)
 
 		// Act
 		res, err := s.Add(context.TODO(), &pb.AddRequest{
@@ -171,7 +171,7 @@ func TestOrgServer_Add(t *testing.T) {
 		msgclientRepo.On("PublishRequest", mock.Anything, mock.Anything).Return(nil).Once()
 		orgRepo.On("GetOrgCount").Return(int64(1), int64(0), nil).Once()
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registryClient, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registryClient, msgclientRepo, "", true)
 
 		// Act
 		res, err := s.Add(context.TODO(), &pb.AddRequest{
@@ -207,7 +207,7 @@ func TestOrgServer_Add(t *testing.T) {
 
 		orgRepo.On("Add", org, mock.Anything).Return(gorm.ErrRecordNotFound).Once()
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registryClient, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registryClient, msgclientRepo, "", true)
 
 		// Act
 		orgResp, err := s.Add(context.TODO(), &pb.AddRequest{
@@ -242,7 +242,7 @@ func TestOrgServer_Add(t *testing.T) {
 
 		orgRepo.On("Add", org, mock.Anything).Return(errors.New("database connection error")).Once()
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registryClient, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registryClient, msgclientRepo, "", true)
 
 		// Act
 		orgResp, err := s.Add(context.TODO(), &pb.AddRequest{
@@ -280,7 +280,7 @@ func TestOrgServer_Add(t *testing.T) {
 		msgclientRepo.On("PublishRequest", mock.Anything, mock.Anything).Return(errors.New("message bus error")).Once()
 		orgRepo.On("GetOrgCount").Return(int64(1), int64(0), nil).Once()
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registryClient, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registryClient, msgclientRepo, "", true)
 
 		// Act
 		res, err := s.Add(context.TODO(), &pb.AddRequest{
@@ -321,7 +321,7 @@ func TestOrgServer_Add(t *testing.T) {
 		msgclientRepo.On("PublishRequest", mock.Anything, mock.Anything).Return(nil).Once()
 		orgRepo.On("GetOrgCount").Return(int64(0), int64(0), errors.New("metric error")).Once()
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registryClient, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registryClient, msgclientRepo, "", true)
 
 		// Act
 		res, err := s.Add(context.TODO(), &pb.AddRequest{
@@ -359,7 +359,7 @@ func TestOrgServer_Add(t *testing.T) {
 
 		orgRepo.On("Add", org, mock.Anything).Return(errors.New("orchestrator error")).Once()
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registryClient, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registryClient, msgclientRepo, "", true)
 
 		// Act
 		res, err := s.Add(context.TODO(), &pb.AddRequest{
@@ -386,7 +386,7 @@ func TestOrgServer_Add(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registryClient := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registryClient, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registryClient, msgclientRepo, "", true)
 
 		// Act
 		res, err := s.Add(context.TODO(), &pb.AddRequest{
@@ -413,7 +413,7 @@ func TestOrgServer_Get(t *testing.T) {
 	orchSystem := &mocks.OrchestratorProvider{}
 	registry := &cmocks.MemberClient{}
 
-	s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+	s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 	t.Run("OrgFound", func(tt *testing.T) {
 		orgRepo.On("Get", mock.Anything).Return(&db.Org{Id: orgId}, nil).Once()
@@ -472,7 +472,7 @@ func TestOrgServer_GetByName(t *testing.T) {
 	orchSystem := &mocks.OrchestratorProvider{}
 	registry := &cmocks.MemberClient{}
 
-	s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+	s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 	t.Run("OrgFound", func(tt *testing.T) {
 		orgRepo.On("GetByName", mock.Anything).Return(&db.Org{Name: orgName}, nil).Once()
@@ -506,7 +506,7 @@ func TestOrgServer_GetByOwner(t *testing.T) {
 	orchSystem := &mocks.OrchestratorProvider{}
 	registry := &cmocks.MemberClient{}
 
-	s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+	s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 	t.Run("OwnerFound", func(tt *testing.T) {
 		orgRepo.On("GetByOwner", mock.Anything).
@@ -571,7 +571,7 @@ func TestOrgServer_GetByUser(t *testing.T) {
 	orchSystem := &mocks.OrchestratorProvider{}
 	registry := &cmocks.MemberClient{}
 
-	s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+	s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 	t.Run("UserFoundOnOwnersAndMembers", func(tt *testing.T) {
 		userRepo.On("Get", userId).Return(&db.User{Id: 1, Uuid: userId}, nil).Once()
@@ -742,7 +742,7 @@ func TestOrgServer_UpdateUser(t *testing.T) {
 	orchSystem := &mocks.OrchestratorProvider{}
 	registry := &cmocks.MemberClient{}
 
-	s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+	s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 	t.Run("UpdateUserSuccess", func(tt *testing.T) {
 		// Arrange
@@ -818,7 +818,7 @@ func TestOrgServer_RegisterUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -856,7 +856,7 @@ func TestOrgServer_RegisterUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -885,7 +885,7 @@ func TestOrgServer_RegisterUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		orgRepo.On("GetByName", OrgName).Return(nil, gorm.ErrRecordNotFound).Once()
 
@@ -908,7 +908,7 @@ func TestOrgServer_RegisterUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -942,7 +942,7 @@ func TestOrgServer_RegisterUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -974,7 +974,7 @@ func TestOrgServer_RegisterUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1008,7 +1008,7 @@ func TestOrgServer_RegisterUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1039,7 +1039,7 @@ func TestOrgServer_RegisterUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1082,7 +1082,7 @@ func TestOrgServer_UpdateOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1120,7 +1120,7 @@ func TestOrgServer_UpdateOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		// Act
 		resp, err := s.UpdateOrgForUser(context.TODO(), &pb.UpdateOrgForUserRequest{
@@ -1142,7 +1142,7 @@ func TestOrgServer_UpdateOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1172,7 +1172,7 @@ func TestOrgServer_UpdateOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		orgRepo.On("Get", orgUUID).Return(nil, gorm.ErrRecordNotFound).Once()
 
@@ -1196,7 +1196,7 @@ func TestOrgServer_UpdateOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1229,7 +1229,7 @@ func TestOrgServer_UpdateOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1265,7 +1265,7 @@ func TestOrgServer_UpdateOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1303,7 +1303,7 @@ func TestOrgServer_UpdateOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1335,7 +1335,7 @@ func TestOrgServer_UpdateOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1378,7 +1378,7 @@ func TestOrgServer_RemoveOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1416,7 +1416,7 @@ func TestOrgServer_RemoveOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		// Act
 		resp, err := s.RemoveOrgForUser(context.TODO(), &pb.RemoveOrgForUserRequest{
@@ -1438,7 +1438,7 @@ func TestOrgServer_RemoveOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1468,7 +1468,7 @@ func TestOrgServer_RemoveOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		orgRepo.On("Get", orgUUID).Return(nil, gorm.ErrRecordNotFound).Once()
 
@@ -1492,7 +1492,7 @@ func TestOrgServer_RemoveOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1525,7 +1525,7 @@ func TestOrgServer_RemoveOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1561,7 +1561,7 @@ func TestOrgServer_RemoveOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1599,7 +1599,7 @@ func TestOrgServer_RemoveOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -1631,7 +1631,7 @@ func TestOrgServer_RemoveOrgForUser(t *testing.T) {
 		orchSystem := &mocks.OrchestratorProvider{}
 		registry := &cmocks.MemberClient{}
 
-		s := NewOrgServer(OrgName, orgRepo, userRepo, orchSystem, registry, msgclientRepo, "", true)
+		s := NewOrgServer(OrgName, orgRepo, userRepo, nil, orchSystem, registry, msgclientRepo, "", true)
 
 		org := &db.Org{
 			Id:   orgUUID,
@@ -0,0 +1,398 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"github.com/ukama/ukama/systems/common/grpc"
+	"github.com/ukama/ukama/systems/common/roles"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/nucleus/org/pkg/db"
+
+	log "github.com/sirupsen/logrus"
+	upb "github.com/ukama/ukama/systems/common/pb/gen/ukama"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
+	pb "github.com/ukama/ukama/systems/nucleus/org/pb/gen"
+)
+
+const (
+	apiKeyScheme      = "uk"
+	apiKeyPrefixBytes = 8
+	apiKeySecretBytes = 32
+	defaultUsageLimit = 100
+)
+
+var errInvalidApiKey = status.Error(codes.Unauthenticated, "invalid api key")
+
+func (o *OrgService) AddServiceAccount(ctx context.Context, req *pb.AddServiceAccountRequest) (*pb.ServiceAccountResponse, error) {
+	log.Infof("Adding service account %s", req.Name)
+
+	createdBy, err := uuid.FromString(req.CreatedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid format of user uuid. Error %s", err.Error())
+	}
+
+	bindings := make([]creg.MemberBinding, 0, len(req.Bindings))
+	for _, b := range req.Bindings {
+		scope, err := roles.ParseScope(b.ScopeKind, b.ScopeId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		bindings = append(bindings, creg.MemberBinding{Role: b.Role, ScopeKind: string(scope.Kind), ScopeId: scope.Id})
+	}
+
+	org, err := o.orgRepo.GetByName(o.orgName)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "org")
+	}
+
+	account := &db.ServiceAccount{
+		Id:          uuid.NewV4(),
+		OrgId:       org.Id,
+		Name:        req.Name,
+		Description: req.Description,
+		CreatedBy:   createdBy,
+	}
+
+	/* the account is a member without a role of its own, its bindings are
+	 * all it may do. A failure here leaves at most a member with no grants */
+	err = o.saRepo.Add(account, func(a *db.ServiceAccount, tx *gorm.DB) error {
+		if _, err := o.registrySystem.AddMemberWithRole(a.Id.String(), upb.RoleType_ROLE_INVALID.String()); err != nil {
+			return status.Errorf(codes.Internal, "failed to add service account to member registry: %v", err)
+		}
+
+		for _, b := range bindings {
+			if err := o.registrySystem.AddRoleBinding(a.Id.String(), b); err != nil {
+				return status.Errorf(codes.Internal, "failed to bind role %s to service account: %v", b.Role, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "service account")
+	}
+
+	return &pb.ServiceAccountResponse{Account: dbAccountToPbAccount(account)}, nil
+}
+
+func (o *OrgService) GetServiceAccount(ctx context.Context, req *pb.GetServiceAccountRequest) (*pb.ServiceAccountResponse, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid format of service account uuid. Error %s", err.Error())
+	}
+
+	account, err := o.saRepo.Get(id)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "service account")
+	}
+
+	return &pb.ServiceAccountResponse{Account: dbAccountToPbAccount(account)}, nil
+}
+
+func (o *OrgService) ListServiceAccounts(ctx context.Context, req *pb.ListServiceAccountsRequest) (*pb.ListServiceAccountsResponse, error) {
+	accounts, err := o.saRepo.List()
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "service account")
+	}
+
+	resp := &pb.ListServiceAccountsResponse{Accounts: []*pb.ServiceAccount{}}
+	for i := range accounts {
+		resp.Accounts = append(resp.Accounts, dbAccountToPbAccount(&accounts[i]))
+	}
+	return resp, nil
+}
+
+func (o *OrgService) RevokeServiceAccount(ctx context.Context, req *pb.RevokeServiceAccountRequest) (*pb.ServiceAccountResponse, error) {
+	log.Infof("Revoking service account %s", req.Id)
+
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid format of service account uuid. Error %s", err.Error())
+	}
+
+	if err := o.saRepo.Revoke(id, time.Now()); err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "service account")
+	}
+
+	account, err := o.saRepo.Get(id)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "service account")
+	}
+
+	return &pb.ServiceAccountResponse{Account: dbAccountToPbAccount(account)}, nil
+}
+
+func (o *OrgService) CreateApiKey(ctx context.Context, req *pb.CreateApiKeyRequest) (*pb.CreateApiKeyResponse, error) {
+	log.Infof("Creating api key for service account %s", req.AccountId)
+
+	accountId, err := uuid.FromString(req.AccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid format of service account uuid. Error %s", err.Error())
+	}
+
+	return o.createApiKey(accountId, req.TtlDays)
+}
+
+// RotateApiKey issues a new key for the account of the given key. The old
+// key keeps working for the grace period so clients can switch over.
+func (o *OrgService) RotateApiKey(ctx context.Context, req *pb.RotateApiKeyRequest) (*pb.CreateApiKeyResponse, error) {
+	log.Infof("Rotating api key %s", req.Id)
+
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid format of api key uuid. Error %s", err.Error())
+	}
+
+	old, err := o.saRepo.GetKey(id)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "api key")
+	}
+	if old.RevokedAt != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "api key is revoked")
+	}
+
+	resp, err := o.createApiKey(old.AccountId, req.TtlDays)
+	if err != nil {
+		return nil, err
+	}
+
+	grace := time.Now().Add(time.Duration(req.GraceMinutes) * time.Minute)
+	if err := o.saRepo.ExpireKey(old.Id, grace); err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "api key")
+	}
+
+	return resp, nil
+}
+
+func (o *OrgService) RevokeApiKey(ctx context.Context, req *pb.RevokeApiKeyRequest) (*pb.RevokeApiKeyResponse, error) {
+	log.Infof("Revoking api key %s", req.Id)
+
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid format of api key uuid. Error %s", err.Error())
+	}
+
+	if err := o.saRepo.RevokeKey(id, time.Now()); err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "api key")
+	}
+	return &pb.RevokeApiKeyResponse{}, nil
+}
+
+func (o *OrgService) ListApiKeys(ctx context.Context, req *pb.ListApiKeysRequest) (*pb.ListApiKeysResponse, error) {
+	accountId, err := uuid.FromString(req.AccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid format of service account uuid. Error %s", err.Error())
+	}
+
+	keys, err := o.saRepo.ListKeys(accountId)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "api key")
+	}
+
+	resp := &pb.ListApiKeysResponse{Keys: []*pb.ApiKey{}}
+	for i := range keys {
+		resp.Keys = append(resp.Keys, dbKeyToPbKey(&keys[i]))
+	}
+	return resp, nil
+}
+
+// ValidateApiKey resolves a presented key to its service account and records
+// the request in the audit trail of the key. Keys of accounts of another org
+// than the one of the request are invalid.
+func (o *OrgService) ValidateApiKey(ctx context.Context, req *pb.ValidateApiKeyRequest) (*pb.ValidateApiKeyResponse, error) {
+	orgId, err := uuid.FromString(req.OrgId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid format of org uuid. Error %s", err.Error())
+	}
+
+	prefix, secret, ok := parseApiKey(req.Key)
+	if !ok {
+		return nil, errInvalidApiKey
+	}
+
+	key, err := o.saRepo.GetKeyByPrefix(prefix)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errInvalidApiKey
+		}
+		return nil, grpc.SqlErrorToGrpc(err, "api key")
+	}
+
+	now := time.Now()
+	if subtle.ConstantTimeCompare([]byte(hashApiSecret(secret)), []byte(key.Hash)) != 1 ||
+		key.RevokedAt != nil || (key.ExpiresAt != nil && !now.Before(*key.ExpiresAt)) {
+		return nil, errInvalidApiKey
+	}
+
+	account, err := o.saRepo.Get(key.AccountId)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "service account")
+	}
+	if account.RevokedAt != nil || account.OrgId != orgId {
+		return nil, errInvalidApiKey
+	}
+
+	err = o.saRepo.RecordUsage(&db.ApiKeyUsage{
+		KeyId:     key.Id,
+		AccountId: key.AccountId,
+		Method:    req.Method,
+		Path:      req.Path,
+		CreatedAt: now,
+	})
+	if err != nil {
+		log.Errorf("Failed to record usage of api key %s. Error: %v", key.Id, err)
+	}
+
+	return &pb.ValidateApiKeyResponse{
+		AccountId: account.Id.String(),
+		KeyId:     key.Id.String(),
+		Name:      account.Name,
+	}, nil
+}
+
+func (o *OrgService) ListApiKeyUsage(ctx context.Context, req *pb.ListApiKeyUsageRequest) (*pb.ListApiKeyUsageResponse, error) {
+	accountId, err := uuid.FromString(req.AccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid format of service account uuid. Error %s", err.Error())
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultUsageLimit
+	}
+
+	usage, err := o.saRepo.ListUsage(accountId, limit)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "api key usage")
+	}
+
+	resp := &pb.ListApiKeyUsageResponse{Usage: []*pb.ApiKeyUsage{}}
+	for _, u := range usage {
+		resp.Usage = append(resp.Usage, &pb.ApiKeyUsage{
+			KeyId:     u.KeyId.String(),
+			Method:    u.Method,
+			Path:      u.Path,
+			CreatedAt: timestamppb.New(u.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (o *OrgService) createApiKey(accountId uuid.UUID, ttlDays uint32) (*pb.CreateApiKeyResponse, error) {
+	account, err := o.saRepo.Get(accountId)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "service account")
+	}
+	if account.RevokedAt != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "service account is revoked")
+	}
+
+	prefix, secret, err := newApiKey()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate api key: %v", err)
+	}
+
+	key := &db.ApiKey{
+		Id:        uuid.NewV4(),
+		AccountId: account.Id,
+		Prefix:    prefix,
+		Hash:      hashApiSecret(secret),
+	}
+	if ttlDays > 0 {
+		expiry := time.Now().AddDate(0, 0, int(ttlDays))
+		key.ExpiresAt = &expiry
+	}
+
+	if err := o.saRepo.AddKey(key); err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "api key")
+	}
+
+	return &pb.CreateApiKeyResponse{
+		Key:    dbKeyToPbKey(key),
+		Secret: strings.Join([]string{apiKeyScheme, prefix, secret}, "_"),
+	}, nil
+}
+
+func newApiKey() (string, string, error) {
+	b := make([]byte, apiKeyPrefixBytes+apiKeySecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	return hex.EncodeToString(b[:apiKeyPrefixBytes]), base64.RawURLEncoding.EncodeToString(b[apiKeyPrefixBytes:]), nil
+}
+
+// parseApiKey splits a key of the form uk_<prefix>_<secret>. The secret
+// may itself contain underscores.
+func parseApiKey(key string) (string, string, bool) {
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) != 3 || parts[0] != apiKeyScheme || parts[1] == "" || parts[2] == "" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+/* secrets are random, a plain digest is enough to keep them from the db */
+func hashApiSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func dbAccountToPbAccount(a *db.ServiceAccount) *pb.ServiceAccount {
+	return &pb.ServiceAccount{
+		Id:          a.Id.String(),
+		OrgId:       a.OrgId.String(),
+		Name:        a.Name,
+		Description: a.Description,
+		CreatedBy:   a.CreatedBy.String(),
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+		RevokedAt:   optionalTimestamp(a.RevokedAt),
+	}
+}
+
+func dbKeyToPbKey(k *db.ApiKey) *pb.ApiKey {
+	return &pb.ApiKey{
+		Id:         k.Id.String(),
+		AccountId:  k.AccountId.String(),
+		Prefix:     k.Prefix,
+		CreatedAt:  timestamppb.New(k.CreatedAt),
+		ExpiresAt:  optionalTimestamp(k.ExpiresAt),
+		LastUsedAt: optionalTimestamp(k.LastUsedAt),
+		RevokedAt:  optionalTimestamp(k.RevokedAt),
+	}
+}
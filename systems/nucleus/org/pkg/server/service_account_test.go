@@ -0,0 +1,146 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	cmocks "github.com/ukama/ukama/systems/common/mocks"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/nucleus/org/mocks"
+	pb "github.com/ukama/ukama/systems/nucleus/org/pb/gen"
+	"github.com/ukama/ukama/systems/nucleus/org/pkg/db"
+)
+
+func TestOrgServer_AddServiceAccount(t *testing.T) {
+	org := &db.Org{Id: uuid.NewV4(), Name: OrgName}
+	orgRepo := &mocks.OrgRepo{}
+	saRepo := &mocks.ServiceAccountRepo{}
+	registryClient := &cmocks.MemberClient{}
+	s := NewOrgServer(OrgName, orgRepo, nil, saRepo, nil, registryClient, nil, "", true)
+
+	orgRepo.On("GetByName", OrgName).Return(org, nil).Once()
+	saRepo.On("Add", mock.MatchedBy(func(a *db.ServiceAccount) bool {
+		return a.Name == "billing-sync" && a.OrgId == org.Id
+	}), mock.Anything).Run(func(args mock.Arguments) {
+		nested := args.Get(1).(func(*db.ServiceAccount, *gorm.DB) error)
+		assert.NoError(t, nested(args.Get(0).(*db.ServiceAccount), nil))
+	}).Return(nil).Once()
+	registryClient.On("AddMemberWithRole", mock.Anything, "ROLE_INVALID").Return(&creg.MemberInfoResponse{}, nil).Once()
+	registryClient.On("AddRoleBinding", mock.Anything, creg.MemberBinding{Role: "billing", ScopeKind: "org"}).Return(nil).Once()
+
+	res, err := s.AddServiceAccount(context.TODO(), &pb.AddServiceAccountRequest{
+		Name:      "billing-sync",
+		CreatedBy: uuid.NewV4().String(),
+		Bindings:  []*pb.RoleBinding{{Role: "billing"}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "billing-sync", res.Account.Name)
+	assert.Equal(t, org.Id.String(), res.Account.OrgId)
+	orgRepo.AssertExpectations(t)
+	saRepo.AssertExpectations(t)
+	registryClient.AssertExpectations(t)
+}
+
+func TestOrgServer_ApiKeys(t *testing.T) {
+	orgId := uuid.NewV4()
+	account := &db.ServiceAccount{Id: uuid.NewV4(), OrgId: orgId, Name: "billing-sync"}
+
+	saRepo := &mocks.ServiceAccountRepo{}
+	s := NewOrgServer(OrgName, nil, nil, saRepo, nil, nil, nil, "", true)
+
+	var stored *db.ApiKey
+	saRepo.On("Get", account.Id).Return(account, nil)
+	saRepo.On("AddKey", mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(0).(*db.ApiKey)
+	}).Return(nil).Once()
+
+	created, err := s.CreateApiKey(context.TODO(), &pb.CreateApiKeyRequest{AccountId: account.Id.String(), TtlDays: 30})
+	assert.NoError(t, err)
+	assert.NotContains(t, stored.Hash, created.Secret)
+	assert.NotNil(t, created.Key.ExpiresAt)
+
+	t.Run("Valid", func(t *testing.T) {
+		saRepo.On("GetKeyByPrefix", stored.Prefix).Return(stored, nil).Once()
+		saRepo.On("RecordUsage", mock.MatchedBy(func(u *db.ApiKeyUsage) bool {
+			return u.KeyId == stored.Id && u.Method == "GET" && u.Path == "/v1/bills"
+		})).Return(nil).Once()
+
+		res, err := s.ValidateApiKey(context.TODO(), &pb.ValidateApiKeyRequest{Key: created.Secret, OrgId: orgId.String(), Method: "GET", Path: "/v1/bills"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, account.Id.String(), res.AccountId)
+	})
+
+	t.Run("WrongSecret", func(t *testing.T) {
+		saRepo.On("GetKeyByPrefix", stored.Prefix).Return(stored, nil).Once()
+
+		_, err := s.ValidateApiKey(context.TODO(), &pb.ValidateApiKeyRequest{Key: "uk_" + stored.Prefix + "_nope", OrgId: orgId.String()})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		expired := *stored
+		past := time.Now().Add(-time.Minute)
+		expired.ExpiresAt = &past
+		saRepo.On("GetKeyByPrefix", stored.Prefix).Return(&expired, nil).Once()
+
+		_, err := s.ValidateApiKey(context.TODO(), &pb.ValidateApiKeyRequest{Key: created.Secret, OrgId: orgId.String()})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("OtherOrg", func(t *testing.T) {
+		saRepo.On("GetKeyByPrefix", stored.Prefix).Return(stored, nil).Once()
+
+		_, err := s.ValidateApiKey(context.TODO(), &pb.ValidateApiKeyRequest{Key: created.Secret, OrgId: uuid.NewV4().String()})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		_, err := s.ValidateApiKey(context.TODO(), &pb.ValidateApiKeyRequest{Key: "not-a-key", OrgId: orgId.String()})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	saRepo.AssertExpectations(t)
+}
+
+func TestOrgServer_RotateApiKey(t *testing.T) {
+	account := &db.ServiceAccount{Id: uuid.NewV4(), Name: "billing-sync"}
+	old := &db.ApiKey{Id: uuid.NewV4(), AccountId: account.Id, Prefix: "0011223344556677"}
+
+	saRepo := &mocks.ServiceAccountRepo{}
+	s := NewOrgServer(OrgName, nil, nil, saRepo, nil, nil, nil, "", true)
+
+	saRepo.On("GetKey", old.Id).Return(old, nil).Once()
+	saRepo.On("Get", account.Id).Return(account, nil).Once()
+	saRepo.On("AddKey", mock.Anything).Return(nil).Once()
+	saRepo.On("ExpireKey", old.Id, mock.MatchedBy(func(at time.Time) bool {
+		return at.After(time.Now().Add(59*time.Minute))
+	})).Return(nil).Once()
+
+	res, err := s.RotateApiKey(context.TODO(), &pb.RotateApiKeyRequest{Id: old.Id.String(), GraceMinutes: 60})
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, old.Prefix, res.Key.Prefix)
+	saRepo.AssertExpectations(t)
+}
@@ -10,6 +10,8 @@ package client
 
 import (
 	"context"
+	"errors"
+	"io"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -57,7 +59,8 @@ func (m *Manager) Close() {
 }
 
 func (m *Manager) Start(req *pb.StartOperationRequest) (*pb.StartOperationResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	/* a caller waiting for the lock is held for up to waitSeconds */
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout+time.Duration(req.WaitSeconds)*time.Second)
 	defer cancel()
 	return m.client.StartOperation(ctx, req)
 }
@@ -91,3 +94,30 @@ func (m *Manager) Complete(id, actor, reason string) (*pb.ForceUnlockResponse, e
 	defer cancel()
 	return m.client.CompleteOperation(ctx, &pb.ForceUnlockRequest{Id: id, Actor: actor, Reason: reason})
 }
+
+func (m *Manager) GetQueue(resourceKey string) (*pb.GetQueueResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+	return m.client.GetQueue(ctx, &pb.GetQueueRequest{ResourceKey: resourceKey})
+}
+
+// Watch calls fn for each update of the operation until it is terminal, fn
+// fails or ctx is done. It is not bound by the client timeout.
+func (m *Manager) Watch(ctx context.Context, id string, fn func(*pb.WatchOperationResponse) error) error {
+	stream, err := m.client.WatchOperation(ctx, &pb.WatchOperationRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(resp); err != nil {
+			return err
+		}
+	}
+}
@@ -17,6 +17,11 @@ type StartOperationRequest struct {
 	RequestedBy    string `json:"requested_by"`
 	IdempotencyKey string `json:"idempotency_key"`
 	LeaseSeconds   uint32 `json:"lease_seconds"`
+	Queue          bool   `json:"queue"`
+	Priority       int32  `json:"priority"`
+	WaitSeconds    uint32 `json:"wait_seconds"`
+	Preempt        bool   `json:"preempt"`
+	Preemptible    bool   `json:"preemptible"`
 }
 
 type GetOperationRequest struct {
@@ -27,6 +32,14 @@ type GetByResourceRequest struct {
 	ResourceKey string `json:"resource_key" query:"resource_key" validate:"required"`
 }
 
+type GetQueueRequest struct {
+	ResourceKey string `json:"resource_key" query:"resource_key" validate:"required"`
+}
+
+type WatchOperationRequest struct {
+	Id string `json:"id" path:"id" validate:"required,uuid"`
+}
+
 type MarkRunningRequest struct {
 	Id           string `json:"id" path:"id" validate:"required,uuid"`
 	FencingToken uint64 `json:"fencing_token" validate:"required"`
@@ -78,11 +91,15 @@ type Operation struct {
 	StartedAt      *time.Time `json:"started_at"`
 	TerminalAt     *time.Time `json:"terminal_at"`
 	CreatedAt      *time.Time `json:"created_at"`
+	Priority       int32      `json:"priority"`
+	Preemptible    bool       `json:"preemptible"`
+	QueueExpiresAt *time.Time `json:"queue_expires_at,omitempty"`
 }
 
 type StartOperationResponse struct {
 	Operation            *Operation `json:"operation"`
 	ConflictingOperation *Operation `json:"conflicting_operation,omitempty"`
+	QueuePosition        uint32     `json:"queue_position,omitempty"`
 }
 
 type GetOperationResponse struct {
@@ -94,6 +111,16 @@ type GetByResourceResponse struct {
 	Operation *Operation `json:"operation"`
 }
 
+type GetQueueResponse struct {
+	Holder *Operation   `json:"holder"`
+	Queued []*Operation `json:"queued"`
+}
+
+type WatchOperationEvent struct {
+	Operation     *Operation `json:"operation"`
+	QueuePosition uint32     `json:"queue_position,omitempty"`
+}
+
 type MarkRunningResponse struct {
 	Operation *Operation `json:"operation"`
 }
@@ -9,6 +9,7 @@
 package rest
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -22,6 +23,7 @@ import (
 
 	"github.com/ukama/ukama/systems/common/config"
 	"github.com/ukama/ukama/systems/common/rest"
+	"github.com/ukama/ukama/systems/common/uuid"
 
 	"github.com/ukama/ukama/systems/operation/api-gateway/cmd/version"
 	"github.com/ukama/ukama/systems/operation/api-gateway/pkg"
@@ -57,6 +59,8 @@ type manager interface {
 	MarkRunning(id string, fencingToken uint64) (*pb.MarkRunningResponse, error)
 	ForceUnlock(id, actor, reason string) (*pb.ForceUnlockResponse, error)
 	Complete(id, actor, reason string) (*pb.ForceUnlockResponse, error)
+	GetQueue(resourceKey string) (*pb.GetQueueResponse, error)
+	Watch(ctx context.Context, id string, fn func(*pb.WatchOperationResponse) error) error
 }
 
 func NewClientsSet(endpoints *pkg.GrpcEndpoints) *Clients {
@@ -121,9 +125,11 @@ func (r *Router) init(f func(*gin.Context, string) error) {
 	auth.Use()
 	{
 		ops := auth.Group("/operations", "Operations", "Lock + operation lifecycle")
-		ops.POST("", formatDoc("Start an operation", "Acquires a lock and creates an operation in pending state. Returns 409 if the resource is already locked."), tonic.Handler(r.postStartHandler, http.StatusCreated))
+		ops.POST("", formatDoc("Start an operation", "Acquires a lock and creates an operation in pending state. Returns 409 if the resource is already locked, unless queue, wait_seconds or preempt is set: queue keeps the operation in QUEUED state behind the holder, wait_seconds blocks up to that long for the lock and preempt cancels a lower priority holder that has not started or is preemptible."), tonic.Handler(r.postStartHandler, http.StatusCreated))
+		ops.GET("/queue", formatDoc("Get resource queue", "Returns the lock holder of resource_key and the operations queued behind it in promotion order."), tonic.Handler(r.getQueueHandler, http.StatusOK))
 		ops.GET("", formatDoc("Get operation by resource", "Returns explicit lock state for resource_key. When free: locked=false and operation=null. When locked: locked=true and operation is populated."), tonic.Handler(r.getByResourceHandler, http.StatusOK))
 		ops.GET("/:id", formatDoc("Get operation by id", "Returns the current state of an operation."), tonic.Handler(r.getOperationHandler, http.StatusOK))
+		ops.GET("/:id/watch", formatDoc("Watch an operation", "Server-sent events stream of the operation each time its state or queue position changes. The stream ends once the operation is terminal."), r.watchOperationHandler)
 		ops.POST("/:id/run", formatDoc("Mark operation running", "Transitions a pending operation to running. Caller must pass the fencing token."), tonic.Handler(r.postMarkRunningHandler, http.StatusOK))
 		ops.POST("/:id/force-unlock", formatDoc("Force-unlock an operation", "Privileged. Cancels the operation and releases its lock with audit reason."), tonic.Handler(r.postForceUnlockHandler, http.StatusOK))
 		ops.POST("/:id/complete", formatDoc("Complete an operation", "Marks a running operation successful and releases its lock."), tonic.Handler(r.postCompleteHandler, http.StatusOK))
@@ -138,6 +144,11 @@ func (r *Router) postStartHandler(c *gin.Context, req *StartOperationRequest) (*
 		RequestedBy:    req.RequestedBy,
 		IdempotencyKey: req.IdempotencyKey,
 		LeaseSeconds:   req.LeaseSeconds,
+		Queue:          req.Queue,
+		Priority:       req.Priority,
+		WaitSeconds:    req.WaitSeconds,
+		Preempt:        req.Preempt,
+		Preemptible:    req.Preemptible,
 	})
 	if err != nil {
 		return nil, err
@@ -146,9 +157,53 @@ func (r *Router) postStartHandler(c *gin.Context, req *StartOperationRequest) (*
 	return &StartOperationResponse{
 		Operation:            operationFromProto(resp.Operation),
 		ConflictingOperation: operationFromProto(resp.ConflictingOperation),
+		QueuePosition:        resp.QueuePosition,
 	}, nil
 }
 
+func (r *Router) getQueueHandler(c *gin.Context, req *GetQueueRequest) (*GetQueueResponse, error) {
+	resp, err := r.clients.Manager.GetQueue(req.ResourceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &GetQueueResponse{
+		Holder: operationFromProto(resp.Holder),
+		Queued: make([]*Operation, 0, len(resp.Queued)),
+	}
+	for _, op := range resp.Queued {
+		out.Queued = append(out.Queued, operationFromProto(op))
+	}
+	return out, nil
+}
+
+/* plain gin handler: tonic cannot stream, so this route has no generated schema */
+func (r *Router) watchOperationHandler(c *gin.Context) {
+	id := c.Param("id")
+	if _, err := uuid.FromString(id); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+
+	err := r.clients.Manager.Watch(c.Request.Context(), id, func(resp *pb.WatchOperationResponse) error {
+		c.SSEvent("operation", &WatchOperationEvent{
+			Operation:     operationFromProto(resp.Operation),
+			QueuePosition: resp.QueuePosition,
+		})
+		c.Writer.Flush()
+		return nil
+	})
+	if err != nil && c.Request.Context().Err() == nil {
+		log.Warnf("watch operation %s: %v", id, err)
+		c.SSEvent("error", gin.H{"error": err.Error()})
+		c.Writer.Flush()
+	}
+}
+
 func (r *Router) getOperationHandler(c *gin.Context, req *GetOperationRequest) (*GetOperationResponse, error) {
 	resp, err := r.clients.Manager.Get(req.Id)
 	if err != nil {
@@ -226,6 +281,9 @@ func operationFromProto(op *pb.Operation) *Operation {
 		StartedAt:      timestampAsTime(op.StartedAt),
 		TerminalAt:     timestampAsTime(op.TerminalAt),
 		CreatedAt:      timestampAsTime(op.CreatedAt),
+		Priority:       op.Priority,
+		Preemptible:    op.Preemptible,
+		QueueExpiresAt: timestampAsTime(op.QueueExpiresAt),
 	}
 }
 
@@ -9,7 +9,11 @@
 package rest
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -40,6 +44,9 @@ type fakeManager struct {
 	markRunningErr    error
 	forceUnlockResp   *pb.ForceUnlockResponse
 	forceUnlockErr    error
+	getQueueResp      *pb.GetQueueResponse
+	watchUpdates      []*pb.WatchOperationResponse
+	watchErr          error
 }
 
 func (f *fakeManager) Start(req *pb.StartOperationRequest) (*pb.StartOperationResponse, error) {
@@ -104,6 +111,24 @@ func (f *fakeManager) Complete(id, actor, reason string) (*pb.ForceUnlockRespons
 	return &pb.ForceUnlockResponse{Operation: &pb.Operation{Id: id}}, nil
 }
 
+func (f *fakeManager) GetQueue(resourceKey string) (*pb.GetQueueResponse, error) {
+	f.lastGetByResourceKey = resourceKey
+	if f.getQueueResp != nil {
+		return f.getQueueResp, nil
+	}
+	return &pb.GetQueueResponse{}, nil
+}
+
+func (f *fakeManager) Watch(ctx context.Context, id string, fn func(*pb.WatchOperationResponse) error) error {
+	f.lastGetId = id
+	for _, u := range f.watchUpdates {
+		if err := fn(u); err != nil {
+			return err
+		}
+	}
+	return f.watchErr
+}
+
 func newTestRouter(mgr *fakeManager) *Router {
 	return &Router{
 		clients: &Clients{
@@ -159,6 +184,9 @@ func TestPostStartHandler(t *testing.T) {
 		RequestedBy:    "user-1",
 		IdempotencyKey: "idem-1",
 		LeaseSeconds:   300,
+		Queue:          true,
+		Priority:       7,
+		WaitSeconds:    30,
 	}
 
 	t.Run("Success", func(t *testing.T) {
@@ -181,6 +209,9 @@ func TestPostStartHandler(t *testing.T) {
 		assert.Equal(t, req.RequestedBy, mgr.lastStartReq.RequestedBy)
 		assert.Equal(t, req.IdempotencyKey, mgr.lastStartReq.IdempotencyKey)
 		assert.Equal(t, req.LeaseSeconds, mgr.lastStartReq.LeaseSeconds)
+		assert.True(t, mgr.lastStartReq.Queue)
+		assert.Equal(t, req.Priority, mgr.lastStartReq.Priority)
+		assert.Equal(t, req.WaitSeconds, mgr.lastStartReq.WaitSeconds)
 		assertOperationMapped(t, op, resp.Operation)
 		assert.Equal(t, "conflict-op", resp.ConflictingOperation.Id)
 	})
@@ -195,6 +226,64 @@ func TestPostStartHandler(t *testing.T) {
 	})
 }
 
+func TestGetQueueHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	queued := sampleProtoOperation()
+	queued.Status = pb.OperationStatus_QUEUED
+	queued.Priority = 5
+	mgr := &fakeManager{getQueueResp: &pb.GetQueueResponse{
+		Holder: sampleProtoOperation(),
+		Queued: []*pb.Operation{queued},
+	}}
+	r := newTestRouter(mgr)
+
+	resp, err := r.getQueueHandler(&gin.Context{}, &GetQueueRequest{ResourceKey: "node:uk-sa2450-tnode-v0-4e86"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "node:uk-sa2450-tnode-v0-4e86", mgr.lastGetByResourceKey)
+	assert.NotNil(t, resp.Holder)
+	assert.Len(t, resp.Queued, 1)
+	assert.Equal(t, "QUEUED", resp.Queued[0].Status)
+	assert.Equal(t, int32(5), resp.Queued[0].Priority)
+}
+
+func TestWatchOperationHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	id := "8e13fa4b-a8a7-40aa-8c61-2891cd16dc7f"
+	queued := sampleProtoOperation()
+	queued.Status = pb.OperationStatus_QUEUED
+	mgr := &fakeManager{watchUpdates: []*pb.WatchOperationResponse{
+		{Operation: queued, QueuePosition: 1},
+		{Operation: sampleProtoOperation()},
+	}}
+	r := newTestRouter(mgr)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v1/operations/"+id+"/watch", nil)
+	c.Params = gin.Params{{Key: "id", Value: id}}
+
+	r.watchOperationHandler(c)
+
+	assert.Equal(t, id, mgr.lastGetId)
+	assert.Contains(t, w.Header().Get("Content-Type"), "text/event-stream")
+	body := w.Body.String()
+	assert.Equal(t, 2, strings.Count(body, "event:operation"))
+	assert.Contains(t, body, `"queue_position":1`)
+	assert.Contains(t, body, `"status":"RUNNING"`)
+
+	t.Run("InvalidId", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/v1/operations/nope/watch", nil)
+		c.Params = gin.Params{{Key: "id", Value: "nope"}}
+
+		r.watchOperationHandler(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
 func TestGetOperationHandler(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	req := &GetOperationRequest{Id: "8e13fa4b-a8a7-40aa-8c61-2891cd16dc7f"}
@@ -81,8 +81,9 @@ func runGrpcServer(gormDb sql.Db) {
 	log.Debugf("MessageBus Client is %+v", mbClient)
 
 	repo := db.NewOperationRepo(gormDb)
-	opServer := server.NewOperationServer(svcConf.OrgName, svcConf.OrgId, repo, mbClient)
-	eventServer := server.NewEventServer(svcConf.OrgName, repo)
+	watchers := server.NewWatchers()
+	opServer := server.NewOperationServer(svcConf.OrgName, svcConf.OrgId, repo, mbClient, watchers)
+	eventServer := server.NewEventServer(svcConf.OrgName, repo, watchers)
 
 	grpcSrv := ugrpc.NewGrpcServer(*svcConf.Grpc, func(s *grpc.Server) {
 		pb.RegisterOperationManagerServiceServer(s, opServer)
@@ -94,7 +95,7 @@ func runGrpcServer(gormDb sql.Db) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	go server.NewSweeper(repo).Run(ctx)
+	go server.NewSweeper(repo, watchers).Run(ctx)
 
 	go grpcSrv.StartServer()
 	go msgBusListener(mbClient)
@@ -1,12 +1,13 @@
-// Code generated by mockery. DO NOT EDIT.
+// Code generated by mockery v2.53.5. DO NOT EDIT.
 
 package mocks
 
 import (
-	time "time"
-
 	mock "github.com/stretchr/testify/mock"
 	db "github.com/ukama/ukama/systems/operation/manager/pkg/db"
+
+	time "time"
+
 	uuid "github.com/ukama/ukama/systems/common/uuid"
 )
 
@@ -15,10 +16,14 @@ type OperationRepo struct {
 	mock.Mock
 }
 
-// Start provides a mock function with given fields: op, lockTTL
-func (_m *OperationRepo) Start(op *db.Operation, lockTTL time.Duration) (*db.Operation, error) {
+// Enqueue provides a mock function with given fields: op, lockTTL
+func (_m *OperationRepo) Enqueue(op *db.Operation, lockTTL time.Duration) (*db.Operation, error) {
 	ret := _m.Called(op, lockTTL)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Enqueue")
+	}
+
 	var r0 *db.Operation
 	var r1 error
 	if rf, ok := ret.Get(0).(func(*db.Operation, time.Duration) (*db.Operation, error)); ok {
@@ -26,14 +31,78 @@ func (_m *OperationRepo) Start(op *db.Operation, lockTTL time.Duration) (*db.Ope
 	}
 	if rf, ok := ret.Get(0).(func(*db.Operation, time.Duration) *db.Operation); ok {
 		r0 = rf(op, lockTTL)
-	} else if ret.Get(0) != nil {
-		r0 = ret.Get(0).(*db.Operation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Operation)
+		}
 	}
+
 	if rf, ok := ret.Get(1).(func(*db.Operation, time.Duration) error); ok {
 		r1 = rf(op, lockTTL)
 	} else {
 		r1 = ret.Error(1)
 	}
+
+	return r0, r1
+}
+
+// FindExpired provides a mock function with given fields: now, limit
+func (_m *OperationRepo) FindExpired(now time.Time, limit int) ([]db.Operation, error) {
+	ret := _m.Called(now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExpired")
+	}
+
+	var r0 []db.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int) ([]db.Operation, error)); ok {
+		return rf(now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int) []db.Operation); ok {
+		r0 = rf(now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int) error); ok {
+		r1 = rf(now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindQueueExpired provides a mock function with given fields: now, limit
+func (_m *OperationRepo) FindQueueExpired(now time.Time, limit int) ([]db.Operation, error) {
+	ret := _m.Called(now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindQueueExpired")
+	}
+
+	var r0 []db.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int) ([]db.Operation, error)); ok {
+		return rf(now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int) []db.Operation); ok {
+		r0 = rf(now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int) error); ok {
+		r1 = rf(now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
 	return r0, r1
 }
 
@@ -41,6 +110,10 @@ func (_m *OperationRepo) Start(op *db.Operation, lockTTL time.Duration) (*db.Ope
 func (_m *OperationRepo) Get(id uuid.UUID) (*db.Operation, error) {
 	ret := _m.Called(id)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
 	var r0 *db.Operation
 	var r1 error
 	if rf, ok := ret.Get(0).(func(uuid.UUID) (*db.Operation, error)); ok {
@@ -48,14 +121,48 @@ func (_m *OperationRepo) Get(id uuid.UUID) (*db.Operation, error) {
 	}
 	if rf, ok := ret.Get(0).(func(uuid.UUID) *db.Operation); ok {
 		r0 = rf(id)
-	} else if ret.Get(0) != nil {
-		r0 = ret.Get(0).(*db.Operation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Operation)
+		}
 	}
+
 	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
 		r1 = rf(id)
 	} else {
 		r1 = ret.Error(1)
 	}
+
+	return r0, r1
+}
+
+// GetByIdempotencyKey provides a mock function with given fields: key
+func (_m *OperationRepo) GetByIdempotencyKey(key string) (*db.Operation, error) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIdempotencyKey")
+	}
+
+	var r0 *db.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*db.Operation, error)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) *db.Operation); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
 	return r0, r1
 }
 
@@ -63,6 +170,10 @@ func (_m *OperationRepo) Get(id uuid.UUID) (*db.Operation, error) {
 func (_m *OperationRepo) GetByResource(resourceKey string) (*db.Operation, error) {
 	ret := _m.Called(resourceKey)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetByResource")
+	}
+
 	var r0 *db.Operation
 	var r1 error
 	if rf, ok := ret.Get(0).(func(string) (*db.Operation, error)); ok {
@@ -70,36 +181,48 @@ func (_m *OperationRepo) GetByResource(resourceKey string) (*db.Operation, error
 	}
 	if rf, ok := ret.Get(0).(func(string) *db.Operation); ok {
 		r0 = rf(resourceKey)
-	} else if ret.Get(0) != nil {
-		r0 = ret.Get(0).(*db.Operation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Operation)
+		}
 	}
+
 	if rf, ok := ret.Get(1).(func(string) error); ok {
 		r1 = rf(resourceKey)
 	} else {
 		r1 = ret.Error(1)
 	}
+
 	return r0, r1
 }
 
-// GetByIdempotencyKey provides a mock function with given fields: key
-func (_m *OperationRepo) GetByIdempotencyKey(key string) (*db.Operation, error) {
-	ret := _m.Called(key)
+// ListQueue provides a mock function with given fields: resourceKey
+func (_m *OperationRepo) ListQueue(resourceKey string) ([]db.Operation, error) {
+	ret := _m.Called(resourceKey)
 
-	var r0 *db.Operation
+	if len(ret) == 0 {
+		panic("no return value specified for ListQueue")
+	}
+
+	var r0 []db.Operation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*db.Operation, error)); ok {
-		return rf(key)
+	if rf, ok := ret.Get(0).(func(string) ([]db.Operation, error)); ok {
+		return rf(resourceKey)
 	}
-	if rf, ok := ret.Get(0).(func(string) *db.Operation); ok {
-		r0 = rf(key)
-	} else if ret.Get(0) != nil {
-		r0 = ret.Get(0).(*db.Operation)
+	if rf, ok := ret.Get(0).(func(string) []db.Operation); ok {
+		r0 = rf(resourceKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Operation)
+		}
 	}
+
 	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(key)
+		r1 = rf(resourceKey)
 	} else {
 		r1 = ret.Error(1)
 	}
+
 	return r0, r1
 }
 
@@ -107,6 +230,10 @@ func (_m *OperationRepo) GetByIdempotencyKey(key string) (*db.Operation, error)
 func (_m *OperationRepo) MarkRunning(id uuid.UUID, fencingToken uint64) (*db.Operation, error) {
 	ret := _m.Called(id, fencingToken)
 
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRunning")
+	}
+
 	var r0 *db.Operation
 	var r1 error
 	if rf, ok := ret.Get(0).(func(uuid.UUID, uint64) (*db.Operation, error)); ok {
@@ -114,68 +241,91 @@ func (_m *OperationRepo) MarkRunning(id uuid.UUID, fencingToken uint64) (*db.Ope
 	}
 	if rf, ok := ret.Get(0).(func(uuid.UUID, uint64) *db.Operation); ok {
 		r0 = rf(id, fencingToken)
-	} else if ret.Get(0) != nil {
-		r0 = ret.Get(0).(*db.Operation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Operation)
+		}
 	}
+
 	if rf, ok := ret.Get(1).(func(uuid.UUID, uint64) error); ok {
 		r1 = rf(id, fencingToken)
 	} else {
 		r1 = ret.Error(1)
 	}
+
 	return r0, r1
 }
 
-// Terminate provides a mock function with given fields: id, fencingToken, status, audit, opErr
-func (_m *OperationRepo) Terminate(id uuid.UUID, fencingToken uint64, status db.OperationStatus, audit db.OperationAudit, opErr string) (*db.Operation, error) {
-	ret := _m.Called(id, fencingToken, status, audit, opErr)
+// Start provides a mock function with given fields: op, lockTTL
+func (_m *OperationRepo) Start(op *db.Operation, lockTTL time.Duration) (*db.Operation, error) {
+	ret := _m.Called(op, lockTTL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
 
 	var r0 *db.Operation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID, uint64, db.OperationStatus, db.OperationAudit, string) (*db.Operation, error)); ok {
-		return rf(id, fencingToken, status, audit, opErr)
+	if rf, ok := ret.Get(0).(func(*db.Operation, time.Duration) (*db.Operation, error)); ok {
+		return rf(op, lockTTL)
 	}
-	if rf, ok := ret.Get(0).(func(uuid.UUID, uint64, db.OperationStatus, db.OperationAudit, string) *db.Operation); ok {
-		r0 = rf(id, fencingToken, status, audit, opErr)
-	} else if ret.Get(0) != nil {
-		r0 = ret.Get(0).(*db.Operation)
+	if rf, ok := ret.Get(0).(func(*db.Operation, time.Duration) *db.Operation); ok {
+		r0 = rf(op, lockTTL)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Operation)
+		}
 	}
-	if rf, ok := ret.Get(1).(func(uuid.UUID, uint64, db.OperationStatus, db.OperationAudit, string) error); ok {
-		r1 = rf(id, fencingToken, status, audit, opErr)
+
+	if rf, ok := ret.Get(1).(func(*db.Operation, time.Duration) error); ok {
+		r1 = rf(op, lockTTL)
 	} else {
 		r1 = ret.Error(1)
 	}
+
 	return r0, r1
 }
 
-// FindExpired provides a mock function with given fields: now, limit
-func (_m *OperationRepo) FindExpired(now time.Time, limit int) ([]db.Operation, error) {
-	ret := _m.Called(now, limit)
+// Terminate provides a mock function with given fields: id, fencingToken, status, audit, opErr
+func (_m *OperationRepo) Terminate(id uuid.UUID, fencingToken uint64, status db.OperationStatus, audit db.OperationAudit, opErr string) (*db.Operation, error) {
+	ret := _m.Called(id, fencingToken, status, audit, opErr)
 
-	var r0 []db.Operation
+	if len(ret) == 0 {
+		panic("no return value specified for Terminate")
+	}
+
+	var r0 *db.Operation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(time.Time, int) ([]db.Operation, error)); ok {
-		return rf(now, limit)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uint64, db.OperationStatus, db.OperationAudit, string) (*db.Operation, error)); ok {
+		return rf(id, fencingToken, status, audit, opErr)
 	}
-	if rf, ok := ret.Get(0).(func(time.Time, int) []db.Operation); ok {
-		r0 = rf(now, limit)
-	} else if ret.Get(0) != nil {
-		r0 = ret.Get(0).([]db.Operation)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uint64, db.OperationStatus, db.OperationAudit, string) *db.Operation); ok {
+		r0 = rf(id, fencingToken, status, audit, opErr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Operation)
+		}
 	}
-	if rf, ok := ret.Get(1).(func(time.Time, int) error); ok {
-		r1 = rf(now, limit)
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uint64, db.OperationStatus, db.OperationAudit, string) error); ok {
+		r1 = rf(id, fencingToken, status, audit, opErr)
 	} else {
 		r1 = ret.Error(1)
 	}
+
 	return r0, r1
 }
 
-// NewOperationRepo creates a new instance of OperationRepo.
+// NewOperationRepo creates a new instance of OperationRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
 func NewOperationRepo(t interface {
 	mock.TestingT
 	Cleanup(func())
 }) *OperationRepo {
-	m := &OperationRepo{}
-	m.Mock.Test(t)
-	t.Cleanup(func() { m.AssertExpectations(t) })
-	return m
+	mock := &OperationRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
 }
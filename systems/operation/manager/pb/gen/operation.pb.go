@@ -7,8 +7,8 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
-// 	protoc        v3.14.0
+// 	protoc-gen-go v1.36.12
+// 	protoc        v6.32.1
 // source: operation.proto
 
 package gen
@@ -20,6 +20,7 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -38,6 +39,7 @@ const (
 	OperationStatus_FAILED    OperationStatus = 3
 	OperationStatus_TIMEOUT   OperationStatus = 4
 	OperationStatus_CANCELLED OperationStatus = 5
+	OperationStatus_QUEUED    OperationStatus = 6
 )
 
 // Enum value maps for OperationStatus.
@@ -49,6 +51,7 @@ var (
 		3: "FAILED",
 		4: "TIMEOUT",
 		5: "CANCELLED",
+		6: "QUEUED",
 	}
 	OperationStatus_value = map[string]int32{
 		"PENDING":   0,
@@ -57,6 +60,7 @@ var (
 		"FAILED":    3,
 		"TIMEOUT":   4,
 		"CANCELLED": 5,
+		"QUEUED":    6,
 	}
 )
 
@@ -88,10 +92,7 @@ func (OperationStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type Operation struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	System         string                 `protobuf:"bytes,3,opt,name=system,proto3" json:"system,omitempty"`
@@ -105,15 +106,18 @@ type Operation struct {
 	StartedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
 	TerminalAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=terminalAt,proto3" json:"terminalAt,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Priority       int32                  `protobuf:"varint,14,opt,name=priority,proto3" json:"priority,omitempty"`
+	Preemptible    bool                   `protobuf:"varint,15,opt,name=preemptible,proto3" json:"preemptible,omitempty"`
+	QueueExpiresAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=queueExpiresAt,proto3" json:"queueExpiresAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Operation) Reset() {
 	*x = Operation{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Operation) String() string {
@@ -124,7 +128,7 @@ func (*Operation) ProtoMessage() {}
 
 func (x *Operation) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -230,26 +234,57 @@ func (x *Operation) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Operation) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Operation) GetPreemptible() bool {
+	if x != nil {
+		return x.Preemptible
+	}
+	return false
+}
+
+func (x *Operation) GetQueueExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QueueExpiresAt
+	}
+	return nil
+}
+
 type StartOperationRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	System         string                 `protobuf:"bytes,2,opt,name=system,proto3" json:"system,omitempty"`
+	ResourceKey    string                 `protobuf:"bytes,3,opt,name=resourceKey,proto3" json:"resourceKey,omitempty"`
+	RequestedBy    string                 `protobuf:"bytes,4,opt,name=requestedBy,proto3" json:"requestedBy,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,5,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	LeaseSeconds   uint32                 `protobuf:"varint,6,opt,name=leaseSeconds,proto3" json:"leaseSeconds,omitempty"`
+	// Queue behind the current holder instead of failing when the resource
+	// is locked; the operation is returned QUEUED and promoted to PENDING,
+	// with a new fencing token, when the lock is handed over.
+	Queue bool `protobuf:"varint,7,opt,name=queue,proto3" json:"queue,omitempty"`
+	// Higher priorities are promoted first, ties in arrival order.
+	Priority int32 `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Block up to waitSeconds for the lock. Without queue, an operation still
+	// queued when the wait ends is withdrawn and the call fails.
+	WaitSeconds uint32 `protobuf:"varint,9,opt,name=waitSeconds,proto3" json:"waitSeconds,omitempty"`
+	// Take the lock from a lower priority holder that has not started yet or
+	// that was started as preemptible; the holder is cancelled.
+	Preempt       bool `protobuf:"varint,10,opt,name=preempt,proto3" json:"preempt,omitempty"`
+	Preemptible   bool `protobuf:"varint,11,opt,name=preemptible,proto3" json:"preemptible,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Type           string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	System         string `protobuf:"bytes,2,opt,name=system,proto3" json:"system,omitempty"`
-	ResourceKey    string `protobuf:"bytes,3,opt,name=resourceKey,proto3" json:"resourceKey,omitempty"`
-	RequestedBy    string `protobuf:"bytes,4,opt,name=requestedBy,proto3" json:"requestedBy,omitempty"`
-	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
-	LeaseSeconds   uint32 `protobuf:"varint,6,opt,name=leaseSeconds,proto3" json:"leaseSeconds,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StartOperationRequest) Reset() {
 	*x = StartOperationRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *StartOperationRequest) String() string {
@@ -260,7 +295,7 @@ func (*StartOperationRequest) ProtoMessage() {}
 
 func (x *StartOperationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -317,22 +352,56 @@ func (x *StartOperationRequest) GetLeaseSeconds() uint32 {
 	return 0
 }
 
+func (x *StartOperationRequest) GetQueue() bool {
+	if x != nil {
+		return x.Queue
+	}
+	return false
+}
+
+func (x *StartOperationRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *StartOperationRequest) GetWaitSeconds() uint32 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
+func (x *StartOperationRequest) GetPreempt() bool {
+	if x != nil {
+		return x.Preempt
+	}
+	return false
+}
+
+func (x *StartOperationRequest) GetPreemptible() bool {
+	if x != nil {
+		return x.Preemptible
+	}
+	return false
+}
+
 type StartOperationResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Operation            *Operation             `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	ConflictingOperation *Operation             `protobuf:"bytes,2,opt,name=conflictingOperation,proto3" json:"conflictingOperation,omitempty"`
+	// 1-based position in the resource queue while QUEUED
+	QueuePosition uint32 `protobuf:"varint,3,opt,name=queuePosition,proto3" json:"queuePosition,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Operation            *Operation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
-	ConflictingOperation *Operation `protobuf:"bytes,2,opt,name=conflictingOperation,proto3" json:"conflictingOperation,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StartOperationResponse) Reset() {
 	*x = StartOperationResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *StartOperationResponse) String() string {
@@ -343,7 +412,7 @@ func (*StartOperationResponse) ProtoMessage() {}
 
 func (x *StartOperationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -372,21 +441,25 @@ func (x *StartOperationResponse) GetConflictingOperation() *Operation {
 	return nil
 }
 
+func (x *StartOperationResponse) GetQueuePosition() uint32 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
+
 type GetOperationRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetOperationRequest) Reset() {
 	*x = GetOperationRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetOperationRequest) String() string {
@@ -397,7 +470,7 @@ func (*GetOperationRequest) ProtoMessage() {}
 
 func (x *GetOperationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -420,20 +493,17 @@ func (x *GetOperationRequest) GetId() string {
 }
 
 type GetOperationResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *Operation             `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Operation *Operation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetOperationResponse) Reset() {
 	*x = GetOperationResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetOperationResponse) String() string {
@@ -444,7 +514,7 @@ func (*GetOperationResponse) ProtoMessage() {}
 
 func (x *GetOperationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -467,20 +537,17 @@ func (x *GetOperationResponse) GetOperation() *Operation {
 }
 
 type GetByResourceRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceKey   string                 `protobuf:"bytes,1,opt,name=resourceKey,proto3" json:"resourceKey,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	ResourceKey string `protobuf:"bytes,1,opt,name=resourceKey,proto3" json:"resourceKey,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetByResourceRequest) Reset() {
 	*x = GetByResourceRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetByResourceRequest) String() string {
@@ -491,7 +558,7 @@ func (*GetByResourceRequest) ProtoMessage() {}
 
 func (x *GetByResourceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -514,20 +581,17 @@ func (x *GetByResourceRequest) GetResourceKey() string {
 }
 
 type GetByResourceResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *Operation             `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Operation *Operation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetByResourceResponse) Reset() {
 	*x = GetByResourceResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetByResourceResponse) String() string {
@@ -538,7 +602,7 @@ func (*GetByResourceResponse) ProtoMessage() {}
 
 func (x *GetByResourceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -561,21 +625,18 @@ func (x *GetByResourceResponse) GetOperation() *Operation {
 }
 
 type MarkRunningRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FencingToken  uint64                 `protobuf:"varint,2,opt,name=fencingToken,proto3" json:"fencingToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FencingToken uint64 `protobuf:"varint,2,opt,name=fencingToken,proto3" json:"fencingToken,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MarkRunningRequest) Reset() {
 	*x = MarkRunningRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MarkRunningRequest) String() string {
@@ -586,7 +647,7 @@ func (*MarkRunningRequest) ProtoMessage() {}
 
 func (x *MarkRunningRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -616,20 +677,17 @@ func (x *MarkRunningRequest) GetFencingToken() uint64 {
 }
 
 type MarkRunningResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *Operation             `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Operation *Operation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MarkRunningResponse) Reset() {
 	*x = MarkRunningResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MarkRunningResponse) String() string {
@@ -640,7 +698,7 @@ func (*MarkRunningResponse) ProtoMessage() {}
 
 func (x *MarkRunningResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -663,22 +721,19 @@ func (x *MarkRunningResponse) GetOperation() *Operation {
 }
 
 type ForceUnlockRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Actor  string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
-	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ForceUnlockRequest) Reset() {
 	*x = ForceUnlockRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ForceUnlockRequest) String() string {
@@ -689,7 +744,7 @@ func (*ForceUnlockRequest) ProtoMessage() {}
 
 func (x *ForceUnlockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -726,20 +781,17 @@ func (x *ForceUnlockRequest) GetReason() string {
 }
 
 type ForceUnlockResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *Operation             `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Operation *Operation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ForceUnlockResponse) Reset() {
 	*x = ForceUnlockResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operation_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_operation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ForceUnlockResponse) String() string {
@@ -750,7 +802,7 @@ func (*ForceUnlockResponse) ProtoMessage() {}
 
 func (x *ForceUnlockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_operation_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -772,199 +824,305 @@ func (x *ForceUnlockResponse) GetOperation() *Operation {
 	return nil
 }
 
-var File_operation_proto protoreflect.FileDescriptor
+type GetQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceKey   string                 `protobuf:"bytes,1,opt,name=resourceKey,proto3" json:"resourceKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueueRequest) Reset() {
+	*x = GetQueueRequest{}
+	mi := &file_operation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueRequest) ProtoMessage() {}
+
+func (x *GetQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueRequest.ProtoReflect.Descriptor instead.
+func (*GetQueueRequest) Descriptor() ([]byte, []int) {
+	return file_operation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetQueueRequest) GetResourceKey() string {
+	if x != nil {
+		return x.ResourceKey
+	}
+	return ""
+}
+
+type GetQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holder        *Operation             `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	Queued        []*Operation           `protobuf:"bytes,2,rep,name=queued,proto3" json:"queued,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueueResponse) Reset() {
+	*x = GetQueueResponse{}
+	mi := &file_operation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueResponse) ProtoMessage() {}
+
+func (x *GetQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_operation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueResponse.ProtoReflect.Descriptor instead.
+func (*GetQueueResponse) Descriptor() ([]byte, []int) {
+	return file_operation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetQueueResponse) GetHolder() *Operation {
+	if x != nil {
+		return x.Holder
+	}
+	return nil
+}
+
+func (x *GetQueueResponse) GetQueued() []*Operation {
+	if x != nil {
+		return x.Queued
+	}
+	return nil
+}
+
+type WatchOperationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOperationRequest) Reset() {
+	*x = WatchOperationRequest{}
+	mi := &file_operation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOperationRequest) ProtoMessage() {}
+
+func (x *WatchOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOperationRequest.ProtoReflect.Descriptor instead.
+func (*WatchOperationRequest) Descriptor() ([]byte, []int) {
+	return file_operation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WatchOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type WatchOperationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *Operation             `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	QueuePosition uint32                 `protobuf:"varint,2,opt,name=queuePosition,proto3" json:"queuePosition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOperationResponse) Reset() {
+	*x = WatchOperationResponse{}
+	mi := &file_operation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var file_operation_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x1a, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x0f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xa6, 0x04, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x75, 0x6b, 0x61, 0x6d,
-	0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22,
-	0x0a, 0x0c, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x65, 0x64, 0x42, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
-	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64,
-	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x42,
-	0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
-	0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x74,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x74, 0x12, 0x38,
-	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x15, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e,
-	0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
-	0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x28,
-	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64,
-	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
-	0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x43, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x59, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
-	0x63, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x63, 0x6f, 0x6e,
-	0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x30, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
-	0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x40, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2,
-	0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b,
-	0x65, 0x79, 0x22, 0x5c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
-	0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x53, 0x0a, 0x12, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5a, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x09,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x6d, 0x0a, 0x12, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x12, 0x1e, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x22, 0x5a, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6b, 0x61,
-	0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x60, 0x0a, 0x0f,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
-	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43,
-	0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
-	0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x12,
-	0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x32, 0xc3,
-	0x06, 0x0a, 0x17, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x77, 0x0a, 0x0e, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x75,
-	0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x32, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x30, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x75, 0x6b, 0x61, 0x6d,
-	0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x0b,
-	0x4d, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x2e, 0x75, 0x6b,
-	0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x75, 0x6b,
-	0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x11,
-	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x2e, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46,
-	0x6f, 0x72, 0x63, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2f, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46,
-	0x6f, 0x72, 0x63, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x70, 0x0a, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x6e, 0x6c,
-	0x6f, 0x63, 0x6b, 0x12, 0x2e, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x70, 0x62, 0x2f, 0x67, 0x65, 0x6e, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *WatchOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
+func (*WatchOperationResponse) ProtoMessage() {}
+
+func (x *WatchOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_operation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOperationResponse.ProtoReflect.Descriptor instead.
+func (*WatchOperationResponse) Descriptor() ([]byte, []int) {
+	return file_operation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchOperationResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *WatchOperationResponse) GetQueuePosition() uint32 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
+
+var File_operation_proto protoreflect.FileDescriptor
+
+const file_operation_proto_rawDesc = "" +
+	"\n" +
+	"\x0foperation.proto\x12\x1aukama.operation.manager.v1\x1a\x0fvalidator.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa8\x05\n" +
+	"\tOperation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06system\x18\x03 \x01(\tR\x06system\x12C\n" +
+	"\x06status\x18\x04 \x01(\x0e2+.ukama.operation.manager.v1.OperationStatusR\x06status\x12\"\n" +
+	"\ffencingToken\x18\x05 \x01(\x04R\ffencingToken\x12 \n" +
+	"\vrequestedBy\x18\x06 \x01(\tR\vrequestedBy\x12&\n" +
+	"\x0eidempotencyKey\x18\a \x01(\tR\x0eidempotencyKey\x12 \n" +
+	"\vresourceKey\x18\b \x01(\tR\vresourceKey\x12B\n" +
+	"\x0eleaseExpiresAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0eleaseExpiresAt\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x128\n" +
+	"\tstartedAt\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12:\n" +
+	"\n" +
+	"terminalAt\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"terminalAt\x128\n" +
+	"\tcreatedAt\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\bpriority\x18\x0e \x01(\x05R\bpriority\x12 \n" +
+	"\vpreemptible\x18\x0f \x01(\bR\vpreemptible\x12B\n" +
+	"\x0equeueExpiresAt\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x0equeueExpiresAt\"\xfb\x02\n" +
+	"\x15StartOperationRequest\x12\x1a\n" +
+	"\x04type\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x04type\x12\x1e\n" +
+	"\x06system\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06system\x12(\n" +
+	"\vresourceKey\x18\x03 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\vresourceKey\x12 \n" +
+	"\vrequestedBy\x18\x04 \x01(\tR\vrequestedBy\x12&\n" +
+	"\x0eidempotencyKey\x18\x05 \x01(\tR\x0eidempotencyKey\x12\"\n" +
+	"\fleaseSeconds\x18\x06 \x01(\rR\fleaseSeconds\x12\x14\n" +
+	"\x05queue\x18\a \x01(\bR\x05queue\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\x05R\bpriority\x12 \n" +
+	"\vwaitSeconds\x18\t \x01(\rR\vwaitSeconds\x12\x18\n" +
+	"\apreempt\x18\n" +
+	" \x01(\bR\apreempt\x12 \n" +
+	"\vpreemptible\x18\v \x01(\bR\vpreemptible\"\xde\x01\n" +
+	"\x16StartOperationResponse\x12C\n" +
+	"\toperation\x18\x01 \x01(\v2%.ukama.operation.manager.v1.OperationR\toperation\x12Y\n" +
+	"\x14conflictingOperation\x18\x02 \x01(\v2%.ukama.operation.manager.v1.OperationR\x14conflictingOperation\x12$\n" +
+	"\rqueuePosition\x18\x03 \x01(\rR\rqueuePosition\"0\n" +
+	"\x13GetOperationRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\"[\n" +
+	"\x14GetOperationResponse\x12C\n" +
+	"\toperation\x18\x01 \x01(\v2%.ukama.operation.manager.v1.OperationR\toperation\"@\n" +
+	"\x14GetByResourceRequest\x12(\n" +
+	"\vresourceKey\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\vresourceKey\"\\\n" +
+	"\x15GetByResourceResponse\x12C\n" +
+	"\toperation\x18\x01 \x01(\v2%.ukama.operation.manager.v1.OperationR\toperation\"S\n" +
+	"\x12MarkRunningRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\x12\"\n" +
+	"\ffencingToken\x18\x02 \x01(\x04R\ffencingToken\"Z\n" +
+	"\x13MarkRunningResponse\x12C\n" +
+	"\toperation\x18\x01 \x01(\v2%.ukama.operation.manager.v1.OperationR\toperation\"m\n" +
+	"\x12ForceUnlockRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\x12\x1c\n" +
+	"\x05actor\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x05actor\x12\x1e\n" +
+	"\x06reason\x18\x03 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x06reason\"Z\n" +
+	"\x13ForceUnlockResponse\x12C\n" +
+	"\toperation\x18\x01 \x01(\v2%.ukama.operation.manager.v1.OperationR\toperation\";\n" +
+	"\x0fGetQueueRequest\x12(\n" +
+	"\vresourceKey\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\vresourceKey\"\x90\x01\n" +
+	"\x10GetQueueResponse\x12=\n" +
+	"\x06holder\x18\x01 \x01(\v2%.ukama.operation.manager.v1.OperationR\x06holder\x12=\n" +
+	"\x06queued\x18\x02 \x03(\v2%.ukama.operation.manager.v1.OperationR\x06queued\"2\n" +
+	"\x15WatchOperationRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\"\x83\x01\n" +
+	"\x16WatchOperationResponse\x12C\n" +
+	"\toperation\x18\x01 \x01(\v2%.ukama.operation.manager.v1.OperationR\toperation\x12$\n" +
+	"\rqueuePosition\x18\x02 \x01(\rR\rqueuePosition*l\n" +
+	"\x0fOperationStatus\x12\v\n" +
+	"\aPENDING\x10\x00\x12\v\n" +
+	"\aRUNNING\x10\x01\x12\v\n" +
+	"\aSUCCESS\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03\x12\v\n" +
+	"\aTIMEOUT\x10\x04\x12\r\n" +
+	"\tCANCELLED\x10\x05\x12\n" +
+	"\n" +
+	"\x06QUEUED\x10\x062\xa5\b\n" +
+	"\x17OperationManagerService\x12w\n" +
+	"\x0eStartOperation\x121.ukama.operation.manager.v1.StartOperationRequest\x1a2.ukama.operation.manager.v1.StartOperationResponse\x12q\n" +
+	"\fGetOperation\x12/.ukama.operation.manager.v1.GetOperationRequest\x1a0.ukama.operation.manager.v1.GetOperationResponse\x12t\n" +
+	"\rGetByResource\x120.ukama.operation.manager.v1.GetByResourceRequest\x1a1.ukama.operation.manager.v1.GetByResourceResponse\x12n\n" +
+	"\vMarkRunning\x12..ukama.operation.manager.v1.MarkRunningRequest\x1a/.ukama.operation.manager.v1.MarkRunningResponse\x12t\n" +
+	"\x11CompleteOperation\x12..ukama.operation.manager.v1.ForceUnlockRequest\x1a/.ukama.operation.manager.v1.ForceUnlockResponse\x12p\n" +
+	"\rFailOperation\x12..ukama.operation.manager.v1.ForceUnlockRequest\x1a/.ukama.operation.manager.v1.ForceUnlockResponse\x12n\n" +
+	"\vForceUnlock\x12..ukama.operation.manager.v1.ForceUnlockRequest\x1a/.ukama.operation.manager.v1.ForceUnlockResponse\x12e\n" +
+	"\bGetQueue\x12+.ukama.operation.manager.v1.GetQueueRequest\x1a,.ukama.operation.manager.v1.GetQueueResponse\x12y\n" +
+	"\x0eWatchOperation\x121.ukama.operation.manager.v1.WatchOperationRequest\x1a2.ukama.operation.manager.v1.WatchOperationResponse0\x01B9Z7github.com/ukama/ukama/systems/operation/manager/pb/genb\x06proto3"
+
 var (
 	file_operation_proto_rawDescOnce sync.Once
-	file_operation_proto_rawDescData = file_operation_proto_rawDesc
+	file_operation_proto_rawDescData []byte
 )
 
 func file_operation_proto_rawDescGZIP() []byte {
 	file_operation_proto_rawDescOnce.Do(func() {
-		file_operation_proto_rawDescData = protoimpl.X.CompressGZIP(file_operation_proto_rawDescData)
+		file_operation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_operation_proto_rawDesc), len(file_operation_proto_rawDesc)))
 	})
 	return file_operation_proto_rawDescData
 }
 
 var file_operation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_operation_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_operation_proto_goTypes = []interface{}{
+var file_operation_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_operation_proto_goTypes = []any{
 	(OperationStatus)(0),           // 0: ukama.operation.manager.v1.OperationStatus
 	(*Operation)(nil),              // 1: ukama.operation.manager.v1.Operation
 	(*StartOperationRequest)(nil),  // 2: ukama.operation.manager.v1.StartOperationRequest
@@ -977,39 +1135,51 @@ var file_operation_proto_goTypes = []interface{}{
 	(*MarkRunningResponse)(nil),    // 9: ukama.operation.manager.v1.MarkRunningResponse
 	(*ForceUnlockRequest)(nil),     // 10: ukama.operation.manager.v1.ForceUnlockRequest
 	(*ForceUnlockResponse)(nil),    // 11: ukama.operation.manager.v1.ForceUnlockResponse
-	(*timestamppb.Timestamp)(nil),  // 12: google.protobuf.Timestamp
+	(*GetQueueRequest)(nil),        // 12: ukama.operation.manager.v1.GetQueueRequest
+	(*GetQueueResponse)(nil),       // 13: ukama.operation.manager.v1.GetQueueResponse
+	(*WatchOperationRequest)(nil),  // 14: ukama.operation.manager.v1.WatchOperationRequest
+	(*WatchOperationResponse)(nil), // 15: ukama.operation.manager.v1.WatchOperationResponse
+	(*timestamppb.Timestamp)(nil),  // 16: google.protobuf.Timestamp
 }
 var file_operation_proto_depIdxs = []int32{
 	0,  // 0: ukama.operation.manager.v1.Operation.status:type_name -> ukama.operation.manager.v1.OperationStatus
-	12, // 1: ukama.operation.manager.v1.Operation.leaseExpiresAt:type_name -> google.protobuf.Timestamp
-	12, // 2: ukama.operation.manager.v1.Operation.startedAt:type_name -> google.protobuf.Timestamp
-	12, // 3: ukama.operation.manager.v1.Operation.terminalAt:type_name -> google.protobuf.Timestamp
-	12, // 4: ukama.operation.manager.v1.Operation.createdAt:type_name -> google.protobuf.Timestamp
-	1,  // 5: ukama.operation.manager.v1.StartOperationResponse.operation:type_name -> ukama.operation.manager.v1.Operation
-	1,  // 6: ukama.operation.manager.v1.StartOperationResponse.conflictingOperation:type_name -> ukama.operation.manager.v1.Operation
-	1,  // 7: ukama.operation.manager.v1.GetOperationResponse.operation:type_name -> ukama.operation.manager.v1.Operation
-	1,  // 8: ukama.operation.manager.v1.GetByResourceResponse.operation:type_name -> ukama.operation.manager.v1.Operation
-	1,  // 9: ukama.operation.manager.v1.MarkRunningResponse.operation:type_name -> ukama.operation.manager.v1.Operation
-	1,  // 10: ukama.operation.manager.v1.ForceUnlockResponse.operation:type_name -> ukama.operation.manager.v1.Operation
-	2,  // 11: ukama.operation.manager.v1.OperationManagerService.StartOperation:input_type -> ukama.operation.manager.v1.StartOperationRequest
-	4,  // 12: ukama.operation.manager.v1.OperationManagerService.GetOperation:input_type -> ukama.operation.manager.v1.GetOperationRequest
-	6,  // 13: ukama.operation.manager.v1.OperationManagerService.GetByResource:input_type -> ukama.operation.manager.v1.GetByResourceRequest
-	8,  // 14: ukama.operation.manager.v1.OperationManagerService.MarkRunning:input_type -> ukama.operation.manager.v1.MarkRunningRequest
-	10, // 15: ukama.operation.manager.v1.OperationManagerService.CompleteOperation:input_type -> ukama.operation.manager.v1.ForceUnlockRequest
-	10, // 16: ukama.operation.manager.v1.OperationManagerService.FailOperation:input_type -> ukama.operation.manager.v1.ForceUnlockRequest
-	10, // 17: ukama.operation.manager.v1.OperationManagerService.ForceUnlock:input_type -> ukama.operation.manager.v1.ForceUnlockRequest
-	3,  // 18: ukama.operation.manager.v1.OperationManagerService.StartOperation:output_type -> ukama.operation.manager.v1.StartOperationResponse
-	5,  // 19: ukama.operation.manager.v1.OperationManagerService.GetOperation:output_type -> ukama.operation.manager.v1.GetOperationResponse
-	7,  // 20: ukama.operation.manager.v1.OperationManagerService.GetByResource:output_type -> ukama.operation.manager.v1.GetByResourceResponse
-	9,  // 21: ukama.operation.manager.v1.OperationManagerService.MarkRunning:output_type -> ukama.operation.manager.v1.MarkRunningResponse
-	11, // 22: ukama.operation.manager.v1.OperationManagerService.CompleteOperation:output_type -> ukama.operation.manager.v1.ForceUnlockResponse
-	11, // 23: ukama.operation.manager.v1.OperationManagerService.FailOperation:output_type -> ukama.operation.manager.v1.ForceUnlockResponse
-	11, // 24: ukama.operation.manager.v1.OperationManagerService.ForceUnlock:output_type -> ukama.operation.manager.v1.ForceUnlockResponse
-	18, // [18:25] is the sub-list for method output_type
-	11, // [11:18] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	16, // 1: ukama.operation.manager.v1.Operation.leaseExpiresAt:type_name -> google.protobuf.Timestamp
+	16, // 2: ukama.operation.manager.v1.Operation.startedAt:type_name -> google.protobuf.Timestamp
+	16, // 3: ukama.operation.manager.v1.Operation.terminalAt:type_name -> google.protobuf.Timestamp
+	16, // 4: ukama.operation.manager.v1.Operation.createdAt:type_name -> google.protobuf.Timestamp
+	16, // 5: ukama.operation.manager.v1.Operation.queueExpiresAt:type_name -> google.protobuf.Timestamp
+	1,  // 6: ukama.operation.manager.v1.StartOperationResponse.operation:type_name -> ukama.operation.manager.v1.Operation
+	1,  // 7: ukama.operation.manager.v1.StartOperationResponse.conflictingOperation:type_name -> ukama.operation.manager.v1.Operation
+	1,  // 8: ukama.operation.manager.v1.GetOperationResponse.operation:type_name -> ukama.operation.manager.v1.Operation
+	1,  // 9: ukama.operation.manager.v1.GetByResourceResponse.operation:type_name -> ukama.operation.manager.v1.Operation
+	1,  // 10: ukama.operation.manager.v1.MarkRunningResponse.operation:type_name -> ukama.operation.manager.v1.Operation
+	1,  // 11: ukama.operation.manager.v1.ForceUnlockResponse.operation:type_name -> ukama.operation.manager.v1.Operation
+	1,  // 12: ukama.operation.manager.v1.GetQueueResponse.holder:type_name -> ukama.operation.manager.v1.Operation
+	1,  // 13: ukama.operation.manager.v1.GetQueueResponse.queued:type_name -> ukama.operation.manager.v1.Operation
+	1,  // 14: ukama.operation.manager.v1.WatchOperationResponse.operation:type_name -> ukama.operation.manager.v1.Operation
+	2,  // 15: ukama.operation.manager.v1.OperationManagerService.StartOperation:input_type -> ukama.operation.manager.v1.StartOperationRequest
+	4,  // 16: ukama.operation.manager.v1.OperationManagerService.GetOperation:input_type -> ukama.operation.manager.v1.GetOperationRequest
+	6,  // 17: ukama.operation.manager.v1.OperationManagerService.GetByResource:input_type -> ukama.operation.manager.v1.GetByResourceRequest
+	8,  // 18: ukama.operation.manager.v1.OperationManagerService.MarkRunning:input_type -> ukama.operation.manager.v1.MarkRunningRequest
+	10, // 19: ukama.operation.manager.v1.OperationManagerService.CompleteOperation:input_type -> ukama.operation.manager.v1.ForceUnlockRequest
+	10, // 20: ukama.operation.manager.v1.OperationManagerService.FailOperation:input_type -> ukama.operation.manager.v1.ForceUnlockRequest
+	10, // 21: ukama.operation.manager.v1.OperationManagerService.ForceUnlock:input_type -> ukama.operation.manager.v1.ForceUnlockRequest
+	12, // 22: ukama.operation.manager.v1.OperationManagerService.GetQueue:input_type -> ukama.operation.manager.v1.GetQueueRequest
+	14, // 23: ukama.operation.manager.v1.OperationManagerService.WatchOperation:input_type -> ukama.operation.manager.v1.WatchOperationRequest
+	3,  // 24: ukama.operation.manager.v1.OperationManagerService.StartOperation:output_type -> ukama.operation.manager.v1.StartOperationResponse
+	5,  // 25: ukama.operation.manager.v1.OperationManagerService.GetOperation:output_type -> ukama.operation.manager.v1.GetOperationResponse
+	7,  // 26: ukama.operation.manager.v1.OperationManagerService.GetByResource:output_type -> ukama.operation.manager.v1.GetByResourceResponse
+	9,  // 27: ukama.operation.manager.v1.OperationManagerService.MarkRunning:output_type -> ukama.operation.manager.v1.MarkRunningResponse
+	11, // 28: ukama.operation.manager.v1.OperationManagerService.CompleteOperation:output_type -> ukama.operation.manager.v1.ForceUnlockResponse
+	11, // 29: ukama.operation.manager.v1.OperationManagerService.FailOperation:output_type -> ukama.operation.manager.v1.ForceUnlockResponse
+	11, // 30: ukama.operation.manager.v1.OperationManagerService.ForceUnlock:output_type -> ukama.operation.manager.v1.ForceUnlockResponse
+	13, // 31: ukama.operation.manager.v1.OperationManagerService.GetQueue:output_type -> ukama.operation.manager.v1.GetQueueResponse
+	15, // 32: ukama.operation.manager.v1.OperationManagerService.WatchOperation:output_type -> ukama.operation.manager.v1.WatchOperationResponse
+	24, // [24:33] is the sub-list for method output_type
+	15, // [15:24] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_operation_proto_init() }
@@ -1017,147 +1187,13 @@ func file_operation_proto_init() {
 	if File_operation_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_operation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Operation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartOperationRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartOperationResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOperationRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOperationResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetByResourceRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetByResourceResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarkRunningRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarkRunningResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ForceUnlockRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_operation_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ForceUnlockResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_operation_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_operation_proto_rawDesc), len(file_operation_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1167,7 +1203,6 @@ func file_operation_proto_init() {
 		MessageInfos:      file_operation_proto_msgTypes,
 	}.Build()
 	File_operation_proto = out.File
-	file_operation_proto_rawDesc = nil
 	file_operation_proto_goTypes = nil
 	file_operation_proto_depIdxs = nil
 }
@@ -39,6 +39,11 @@ func (this *Operation) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
 		}
 	}
+	if this.QueueExpiresAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.QueueExpiresAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("QueueExpiresAt", err)
+		}
+	}
 	return nil
 }
 func (this *StartOperationRequest) Validate() error {
@@ -146,3 +151,44 @@ func (this *ForceUnlockResponse) Validate() error {
 	}
 	return nil
 }
+func (this *GetQueueRequest) Validate() error {
+	if this.ResourceKey == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("ResourceKey", fmt.Errorf(`value '%v' must not be an empty string`, this.ResourceKey))
+	}
+	return nil
+}
+func (this *GetQueueResponse) Validate() error {
+	if this.Holder != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Holder); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Holder", err)
+		}
+	}
+	for _, item := range this.Queued {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Queued", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_WatchOperationRequest_Id = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *WatchOperationRequest) Validate() error {
+	if !_regex_WatchOperationRequest_Id.MatchString(this.Id) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.Id))
+	}
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	return nil
+}
+func (this *WatchOperationResponse) Validate() error {
+	if this.Operation != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Operation); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Operation", err)
+		}
+	}
+	return nil
+}
@@ -8,7 +8,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
-// - protoc             v3.14.0
+// - protoc             v6.32.1
 // source: operation.proto
 
 package gen
@@ -33,6 +33,8 @@ const (
 	OperationManagerService_CompleteOperation_FullMethodName = "/ukama.operation.manager.v1.OperationManagerService/CompleteOperation"
 	OperationManagerService_FailOperation_FullMethodName     = "/ukama.operation.manager.v1.OperationManagerService/FailOperation"
 	OperationManagerService_ForceUnlock_FullMethodName       = "/ukama.operation.manager.v1.OperationManagerService/ForceUnlock"
+	OperationManagerService_GetQueue_FullMethodName          = "/ukama.operation.manager.v1.OperationManagerService/GetQueue"
+	OperationManagerService_WatchOperation_FullMethodName    = "/ukama.operation.manager.v1.OperationManagerService/WatchOperation"
 )
 
 // OperationManagerServiceClient is the client API for OperationManagerService service.
@@ -48,6 +50,9 @@ type OperationManagerServiceClient interface {
 	CompleteOperation(ctx context.Context, in *ForceUnlockRequest, opts ...grpc.CallOption) (*ForceUnlockResponse, error)
 	FailOperation(ctx context.Context, in *ForceUnlockRequest, opts ...grpc.CallOption) (*ForceUnlockResponse, error)
 	ForceUnlock(ctx context.Context, in *ForceUnlockRequest, opts ...grpc.CallOption) (*ForceUnlockResponse, error)
+	GetQueue(ctx context.Context, in *GetQueueRequest, opts ...grpc.CallOption) (*GetQueueResponse, error)
+	// Streams the operation on every change until it is terminal.
+	WatchOperation(ctx context.Context, in *WatchOperationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchOperationResponse], error)
 }
 
 type operationManagerServiceClient struct {
@@ -128,6 +133,35 @@ func (c *operationManagerServiceClient) ForceUnlock(ctx context.Context, in *For
 	return out, nil
 }
 
+func (c *operationManagerServiceClient) GetQueue(ctx context.Context, in *GetQueueRequest, opts ...grpc.CallOption) (*GetQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQueueResponse)
+	err := c.cc.Invoke(ctx, OperationManagerService_GetQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationManagerServiceClient) WatchOperation(ctx context.Context, in *WatchOperationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchOperationResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OperationManagerService_ServiceDesc.Streams[0], OperationManagerService_WatchOperation_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchOperationRequest, WatchOperationResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OperationManagerService_WatchOperationClient = grpc.ServerStreamingClient[WatchOperationResponse]
+
 // OperationManagerServiceServer is the server API for OperationManagerService service.
 // All implementations must embed UnimplementedOperationManagerServiceServer
 // for forward compatibility.
@@ -141,6 +175,9 @@ type OperationManagerServiceServer interface {
 	CompleteOperation(context.Context, *ForceUnlockRequest) (*ForceUnlockResponse, error)
 	FailOperation(context.Context, *ForceUnlockRequest) (*ForceUnlockResponse, error)
 	ForceUnlock(context.Context, *ForceUnlockRequest) (*ForceUnlockResponse, error)
+	GetQueue(context.Context, *GetQueueRequest) (*GetQueueResponse, error)
+	// Streams the operation on every change until it is terminal.
+	WatchOperation(*WatchOperationRequest, grpc.ServerStreamingServer[WatchOperationResponse]) error
 	mustEmbedUnimplementedOperationManagerServiceServer()
 }
 
@@ -172,6 +209,12 @@ func (UnimplementedOperationManagerServiceServer) FailOperation(context.Context,
 func (UnimplementedOperationManagerServiceServer) ForceUnlock(context.Context, *ForceUnlockRequest) (*ForceUnlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnlock not implemented")
 }
+func (UnimplementedOperationManagerServiceServer) GetQueue(context.Context, *GetQueueRequest) (*GetQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueue not implemented")
+}
+func (UnimplementedOperationManagerServiceServer) WatchOperation(*WatchOperationRequest, grpc.ServerStreamingServer[WatchOperationResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOperation not implemented")
+}
 func (UnimplementedOperationManagerServiceServer) mustEmbedUnimplementedOperationManagerServiceServer() {
 }
 func (UnimplementedOperationManagerServiceServer) testEmbeddedByValue() {}
@@ -320,6 +363,35 @@ func _OperationManagerService_ForceUnlock_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OperationManagerService_GetQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationManagerServiceServer).GetQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperationManagerService_GetQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationManagerServiceServer).GetQueue(ctx, req.(*GetQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperationManagerService_WatchOperation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOperationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OperationManagerServiceServer).WatchOperation(m, &grpc.GenericServerStream[WatchOperationRequest, WatchOperationResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OperationManagerService_WatchOperationServer = grpc.ServerStreamingServer[WatchOperationResponse]
+
 // OperationManagerService_ServiceDesc is the grpc.ServiceDesc for OperationManagerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -355,7 +427,17 @@ var OperationManagerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnlock",
 			Handler:    _OperationManagerService_ForceUnlock_Handler,
 		},
+		{
+			MethodName: "GetQueue",
+			Handler:    _OperationManagerService_GetQueue_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOperation",
+			Handler:       _OperationManagerService_WatchOperation_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "operation.proto",
 }
@@ -26,12 +26,15 @@ const (
 	OperationFailed
 	OperationTimeout
 	OperationCancelled
+	// Queued operations wait for the resource lock, see OperationRepo.Enqueue
+	OperationQueued
 )
 
 func (s OperationStatus) String() string {
 	return map[OperationStatus]string{
 		0: "pending", 1: "running", 2: "success",
 		3: "failed", 4: "timeout", 5: "cancelled",
+		6: "queued",
 	}[s]
 }
 
@@ -85,6 +88,10 @@ type Operation struct {
 	ResourceKey    string          `gorm:"not null;index" json:"resourceKey"`
 	Intent         JSONMap         `gorm:"type:jsonb" json:"intent,omitempty"`
 	LeaseExpiresAt time.Time       `gorm:"not null;index" json:"leaseExpiresAt"`
+	LeaseSeconds   uint32          `gorm:"not null;default:0" json:"leaseSeconds"`
+	Priority       int32           `gorm:"not null;default:0;index" json:"priority"`
+	Preemptible    bool            `gorm:"not null;default:false" json:"preemptible"`
+	QueueExpiresAt *time.Time      `gorm:"index" json:"queueExpiresAt,omitempty"`
 	Error          string          `gorm:"" json:"error,omitempty"`
 	StartedAt      *time.Time      `json:"startedAt,omitempty"`
 	TerminalAt     *time.Time      `json:"terminalAt,omitempty"`
@@ -16,6 +16,7 @@ import (
 	"github.com/ukama/ukama/systems/common/sql"
 	"github.com/ukama/ukama/systems/common/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var ErrLockConflict = errors.New("resource_locked")
@@ -29,6 +30,9 @@ type OperationRepo interface {
 	Terminate(id uuid.UUID, fencingToken uint64, status OperationStatus,
 		audit OperationAudit, opErr string) (*Operation, error)
 	FindExpired(now time.Time, limit int) ([]Operation, error)
+	Enqueue(op *Operation, lockTTL time.Duration) (*Operation, error)
+	ListQueue(resourceKey string) ([]Operation, error)
+	FindQueueExpired(now time.Time, limit int) ([]Operation, error)
 }
 
 type operationRepo struct {
@@ -170,16 +174,24 @@ func (r *operationRepo) Terminate(id uuid.UUID, fencingToken uint64,
 			return err
 		}
 
-		if err := tx.Where("resource_key = ? AND operation_id = ?",
-			op.ResourceKey, op.Id).Delete(&ResourceLock{}).Error; err != nil {
-			return err
+		released := tx.Where("resource_key = ? AND operation_id = ?",
+			op.ResourceKey, op.Id).Delete(&ResourceLock{})
+		if released.Error != nil {
+			return released.Error
 		}
 
 		audit.Id = uuid.NewV4()
 		audit.OperationId = op.Id
 		audit.ResourceKey = op.ResourceKey
 		audit.At = now
-		return tx.Create(&audit).Error
+		if err := tx.Create(&audit).Error; err != nil {
+			return err
+		}
+
+		if released.RowsAffected == 0 {
+			return nil
+		}
+		return promoteNext(tx, op.ResourceKey, now)
 	})
 	if err != nil {
 		return nil, err
@@ -195,3 +207,120 @@ func (r *operationRepo) FindExpired(now time.Time, limit int) ([]Operation, erro
 		Find(&ops).Error
 	return ops, err
 }
+
+// Enqueue takes the resource lock for op when it is free and stores op as
+// queued otherwise. Queued operations are promoted by Terminate when the lock
+// is released: highest priority first, then oldest.
+func (r *operationRepo) Enqueue(op *Operation, lockTTL time.Duration) (*Operation, error) {
+	err := r.db.GetGormDb().Transaction(func(tx *gorm.DB) error {
+		op.Status = OperationPending
+		if err := tx.Create(op).Error; err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		lock := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&ResourceLock{
+			ResourceKey:  op.ResourceKey,
+			OperationId:  op.Id,
+			FencingToken: op.FencingToken,
+			AcquiredAt:   now,
+			ExpiresAt:    now.Add(lockTTL),
+		})
+		if lock.Error != nil {
+			return lock.Error
+		}
+
+		event := "lock_acquired"
+		if lock.RowsAffected == 0 {
+			event = "queued"
+			if err := tx.Model(op).Update("status", OperationQueued).Error; err != nil {
+				return err
+			}
+		} else if op.QueueExpiresAt != nil {
+			op.QueueExpiresAt = nil
+			if err := tx.Model(op).Update("queue_expires_at", nil).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Create(&OperationAudit{
+			Id:          uuid.NewV4(),
+			OperationId: op.Id,
+			ResourceKey: op.ResourceKey,
+			Event:       event,
+			Actor:       op.RequestedBy,
+			At:          now,
+		}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("enqueue operation: %w", err)
+	}
+	return op, nil
+}
+
+/* ListQueue returns the queued operations of a resource in promotion order */
+func (r *operationRepo) ListQueue(resourceKey string) ([]Operation, error) {
+	var ops []Operation
+	err := r.db.GetGormDb().
+		Where("resource_key = ? AND status = ?", resourceKey, OperationQueued).
+		Order("priority desc, created_at asc").
+		Find(&ops).Error
+	return ops, err
+}
+
+func (r *operationRepo) FindQueueExpired(now time.Time, limit int) ([]Operation, error) {
+	var ops []Operation
+	err := r.db.GetGormDb().
+		Where("queue_expires_at < ? AND status = ?", now, OperationQueued).
+		Limit(limit).
+		Find(&ops).Error
+	return ops, err
+}
+
+// promoteNext hands a released lock to the next queued operation. It gets a
+// fresh fencing token so tokens keep increasing in lock order even when
+// priorities reorder the queue, and its lease starts now.
+func promoteNext(tx *gorm.DB, resourceKey string, now time.Time) error {
+	var next Operation
+	err := tx.Where("resource_key = ? AND status = ?", resourceKey, OperationQueued).
+		Order("priority desc, created_at asc").
+		First(&next).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	lease := time.Duration(next.LeaseSeconds) * time.Second
+	if err := tx.Model(&next).Updates(map[string]interface{}{
+		"status":           OperationPending,
+		"fencing_token":    gorm.Expr("nextval(pg_get_serial_sequence('operations', 'fencing_token'))"),
+		"lease_expires_at": now.Add(lease),
+		"queue_expires_at": nil,
+	}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("id = ?", next.Id).First(&next).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Create(&ResourceLock{
+		ResourceKey:  resourceKey,
+		OperationId:  next.Id,
+		FencingToken: next.FencingToken,
+		AcquiredAt:   now,
+		ExpiresAt:    now.Add(lease),
+	}).Error; err != nil {
+		return err
+	}
+
+	return tx.Create(&OperationAudit{
+		Id:          uuid.NewV4(),
+		OperationId: next.Id,
+		ResourceKey: resourceKey,
+		Event:       "dequeued",
+		Actor:       next.RequestedBy,
+		At:          now,
+	}).Error
+}
@@ -156,6 +156,69 @@ func Test_Start(t *testing.T) {
 	})
 }
 
+func Test_Enqueue(t *testing.T) {
+	t.Run("AcquiresFreeLock", func(t *testing.T) {
+		mock, repo := setupTestDB(t)
+
+		expires := time.Now().Add(time.Minute)
+		op := &Operation{
+			Id:             uuid.NewV4(),
+			Type:           "RestartNode",
+			ResourceKey:    "node:free",
+			LeaseExpiresAt: time.Now().Add(5 * time.Minute),
+			QueueExpiresAt: &expires,
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "operations"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "fencing_token"}).AddRow(op.Id, 3))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "resource_locks"`)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "operations" SET "queue_expires_at"`)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "operation_audits"`)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		out, err := repo.Enqueue(op, 5*time.Minute)
+
+		assert.NoError(t, err)
+		assert.Equal(t, OperationPending, out.Status)
+		assert.Nil(t, out.QueueExpiresAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("QueuesBehindHolder", func(t *testing.T) {
+		mock, repo := setupTestDB(t)
+
+		op := &Operation{
+			Id:             uuid.NewV4(),
+			Type:           "RestartNode",
+			ResourceKey:    "node:busy",
+			Priority:       10,
+			LeaseExpiresAt: time.Now().Add(5 * time.Minute),
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "operations"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "fencing_token"}).AddRow(op.Id, 4))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "resource_locks"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "operations" SET "status"`)).
+			WithArgs(OperationQueued, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "operation_audits"`)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		out, err := repo.Enqueue(op, 5*time.Minute)
+
+		assert.NoError(t, err)
+		assert.Equal(t, OperationQueued, out.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func Test_MarkRunning(t *testing.T) {
 	t.Run("TransitionsPendingToRunning", func(t *testing.T) {
 		mock, repo := setupTestDB(t)
@@ -208,6 +271,10 @@ func Test_Terminate(t *testing.T) {
 			WillReturnResult(sqlmock.NewResult(0, 1))
 		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "operation_audits"`)).
 			WillReturnResult(sqlmock.NewResult(1, 1))
+		// nothing queued behind it
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "operations"`)).
+			WithArgs(op.ResourceKey, OperationQueued, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
 		mock.ExpectCommit()
 
 		out, err := repo.Terminate(op.Id, op.FencingToken, OperationSuccess,
@@ -218,6 +285,57 @@ func Test_Terminate(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("PromotesNextQueued", func(t *testing.T) {
+		mock, repo := setupTestDB(t)
+
+		op := &Operation{
+			Id:           uuid.NewV4(),
+			Status:       OperationRunning,
+			FencingToken: 5,
+			ResourceKey:  "node:abc",
+		}
+		next := &Operation{
+			Id:           uuid.NewV4(),
+			Status:       OperationQueued,
+			FencingToken: 6,
+			ResourceKey:  op.ResourceKey,
+		}
+		promoted := *next
+		promoted.Status = OperationPending
+		promoted.FencingToken = 11
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "operations"`)).
+			WillReturnRows(operationRow(op))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "operations"`)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "resource_locks"`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "operation_audits"`)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "operations"`)).
+			WithArgs(op.ResourceKey, OperationQueued, sqlmock.AnyArg()).
+			WillReturnRows(operationRow(next))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "operations" SET "fencing_token"=nextval(`)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "operations"`)).
+			WithArgs(next.Id, next.Id, sqlmock.AnyArg()).
+			WillReturnRows(operationRow(&promoted))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "resource_locks"`)).
+			WithArgs(op.ResourceKey, next.Id, uint64(11), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "operation_audits"`)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		out, err := repo.Terminate(op.Id, op.FencingToken, OperationCancelled,
+			OperationAudit{Event: "preempted"}, "preempted")
+
+		assert.NoError(t, err)
+		assert.Equal(t, OperationCancelled, out.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("RejectsStaleFencingToken", func(t *testing.T) {
 		mock, repo := setupTestDB(t)
 
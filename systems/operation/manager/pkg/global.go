@@ -18,6 +18,13 @@ const (
 const (
 	DefaultLeaseTTL = 5 * time.Minute
 	SweeperInterval = 30 * time.Second
+	// How long a queued operation waits for the lock unless the caller asked
+	// for a shorter blocking wait, and the longest wait a caller may block.
+	DefaultQueueTTL = 30 * time.Minute
+	MaxLockWait     = 5 * time.Minute
+	// Watchers are woken on local changes and re-read at this interval to
+	// pick up changes made by other replicas.
+	WatchPollInterval = 5 * time.Second
 )
 
 var (
@@ -24,12 +24,13 @@ import (
 
 type EventServer struct {
 	epb.UnimplementedEventNotificationServiceServer
-	orgName string
-	repo    db.OperationRepo
+	orgName  string
+	repo     db.OperationRepo
+	watchers *Watchers
 }
 
-func NewEventServer(orgName string, repo db.OperationRepo) *EventServer {
-	return &EventServer{orgName: orgName, repo: repo}
+func NewEventServer(orgName string, repo db.OperationRepo, watchers *Watchers) *EventServer {
+	return &EventServer{orgName: orgName, repo: repo, watchers: watchers}
 }
 
 func (e *EventServer) EventNotification(ctx context.Context, event *epb.Event) (*epb.EventResponse, error) {
@@ -58,11 +59,13 @@ func (e *EventServer) handleCompleted(_ context.Context, event *epb.Event) (*epb
 	if err != nil {
 		return nil, fmt.Errorf("invalid operation id %q: %w", msg.OperationId, err)
 	}
-	if _, err := e.repo.Terminate(opId, msg.FencingToken, db.OperationSuccess,
-		db.OperationAudit{Event: "completed"}, ""); err != nil {
+	op, err := e.repo.Terminate(opId, msg.FencingToken, db.OperationSuccess,
+		db.OperationAudit{Event: "completed"}, "")
+	if err != nil {
 		log.Errorf("operation/manager: terminate(success) failed for op %s: %v", opId, err)
 		return nil, err
 	}
+	e.watchers.Notify(op.ResourceKey)
 	log.Infof("operation %s → success (lock released)", opId)
 	return &epb.EventResponse{}, nil
 }
@@ -76,11 +79,13 @@ func (e *EventServer) handleFailed(_ context.Context, event *epb.Event) (*epb.Ev
 	if err != nil {
 		return nil, fmt.Errorf("invalid operation id %q: %w", msg.OperationId, err)
 	}
-	if _, err := e.repo.Terminate(opId, msg.FencingToken, db.OperationFailed,
-		db.OperationAudit{Event: "failed", Reason: msg.Reason}, msg.Reason); err != nil {
+	op, err := e.repo.Terminate(opId, msg.FencingToken, db.OperationFailed,
+		db.OperationAudit{Event: "failed", Reason: msg.Reason}, msg.Reason)
+	if err != nil {
 		log.Errorf("operation/manager: terminate(failed) failed for op %s: %v", opId, err)
 		return nil, err
 	}
+	e.watchers.Notify(op.ResourceKey)
 	log.Infof("operation %s → failed (lock released): %s", opId, msg.Reason)
 	return &epb.EventResponse{}, nil
 }
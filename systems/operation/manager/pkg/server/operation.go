@@ -34,15 +34,17 @@ type OperationServer struct {
 	orgId      string
 	repo       db.OperationRepo
 	msgbus     mb.MsgBusServiceClient
+	watchers   *Watchers
 	routingKey msgbus.RoutingKeyBuilder
 }
 
-func NewOperationServer(orgName, orgId string, repo db.OperationRepo, msgBus mb.MsgBusServiceClient) *OperationServer {
+func NewOperationServer(orgName, orgId string, repo db.OperationRepo, msgBus mb.MsgBusServiceClient, watchers *Watchers) *OperationServer {
 	return &OperationServer{
-		orgName: orgName,
-		orgId:   orgId,
-		repo:    repo,
-		msgbus:  msgBus,
+		orgName:  orgName,
+		orgId:    orgId,
+		repo:     repo,
+		msgbus:   msgBus,
+		watchers: watchers,
 		routingKey: msgbus.NewRoutingKeyBuilder().
 			SetCloudSource().
 			SetGlobalScope().
@@ -76,12 +78,19 @@ func (s *OperationServer) StartOperation(ctx context.Context, req *pb.StartOpera
 		RequestedBy:    req.RequestedBy,
 		ResourceKey:    req.ResourceKey,
 		LeaseExpiresAt: time.Now().UTC().Add(lease),
+		LeaseSeconds:   uint32(lease / time.Second),
+		Priority:       req.Priority,
+		Preemptible:    req.Preemptible,
 	}
 	if req.IdempotencyKey != "" {
 		k := req.IdempotencyKey
 		op.IdempotencyKey = &k
 	}
 
+	if req.Queue || req.WaitSeconds > 0 || req.Preempt {
+		return s.enqueue(ctx, req, op, lease)
+	}
+
 	op, err := s.repo.Start(op, lease)
 	if errors.Is(err, db.ErrLockConflict) {
 		resp := &pb.StartOperationResponse{}
@@ -158,6 +167,8 @@ func (s *OperationServer) CompleteOperation(ctx context.Context, req *pb.ForceUn
 		return nil, status.Errorf(codes.Internal, "complete operation: %v", err)
 	}
 
+	s.watchers.Notify(op.ResourceKey)
+
 	log.Infof("operation %s completed by %s: %s", op.Id, req.Actor, req.Reason)
 	return &pb.ForceUnlockResponse{Operation: toPb(op)}, nil
 }
@@ -184,6 +195,8 @@ func (s *OperationServer) FailOperation(ctx context.Context, req *pb.ForceUnlock
 		return nil, status.Errorf(codes.Internal, "fail operation: %v", err)
 	}
 
+	s.watchers.Notify(op.ResourceKey)
+
 	log.Warnf("operation %s failed by %s: %s", op.Id, req.Actor, req.Reason)
 	return &pb.ForceUnlockResponse{Operation: toPb(op)}, nil
 }
@@ -210,6 +223,8 @@ func (s *OperationServer) ForceUnlock(ctx context.Context, req *pb.ForceUnlockRe
 		return nil, status.Errorf(codes.Internal, "force unlock: %v", err)
 	}
 
+	s.watchers.Notify(op.ResourceKey)
+
 	log.Warnf("operation %s force-unlocked by %s: %s", op.Id, req.Actor, req.Reason)
 	return &pb.ForceUnlockResponse{Operation: toPb(op)}, nil
 }
@@ -229,6 +244,8 @@ func toPb(o *db.Operation) *pb.Operation {
 		LeaseExpiresAt: timestamppb.New(o.LeaseExpiresAt),
 		Error:          o.Error,
 		CreatedAt:      timestamppb.New(o.CreatedAt),
+		Priority:       o.Priority,
+		Preemptible:    o.Preemptible,
 	}
 	if o.IdempotencyKey != nil {
 		out.IdempotencyKey = *o.IdempotencyKey
@@ -239,5 +256,8 @@ func toPb(o *db.Operation) *pb.Operation {
 	if o.TerminalAt != nil {
 		out.TerminalAt = timestamppb.New(*o.TerminalAt)
 	}
+	if o.QueueExpiresAt != nil {
+		out.QueueExpiresAt = timestamppb.New(*o.QueueExpiresAt)
+	}
 	return out
 }
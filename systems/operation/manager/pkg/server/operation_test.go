@@ -29,7 +29,7 @@ const orgName = "ukama"
 func TestStartOperation(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		repo := &mocks.OperationRepo{}
-		s := NewOperationServer(orgName, "", repo, nil)
+		s := NewOperationServer(orgName, "", repo, nil, nil)
 
 		repo.On("GetByIdempotencyKey", mock.Anything).Return(nil, nil).Maybe()
 		repo.On("Start", mock.AnythingOfType("*db.Operation"), mock.AnythingOfType("time.Duration")).
@@ -52,7 +52,7 @@ func TestStartOperation(t *testing.T) {
 
 	t.Run("ConflictReturnsAlreadyExists", func(t *testing.T) {
 		repo := &mocks.OperationRepo{}
-		s := NewOperationServer(orgName, "", repo, nil)
+		s := NewOperationServer(orgName, "", repo, nil, nil)
 
 		holder := &db.Operation{
 			Id:           uuid.NewV4(),
@@ -82,7 +82,7 @@ func TestStartOperation(t *testing.T) {
 
 	t.Run("IdempotencyShortCircuits", func(t *testing.T) {
 		repo := &mocks.OperationRepo{}
-		s := NewOperationServer(orgName, "", repo, nil)
+		s := NewOperationServer(orgName, "", repo, nil, nil)
 
 		existing := &db.Operation{
 			Id:           uuid.NewV4(),
@@ -111,7 +111,7 @@ func TestStartOperation(t *testing.T) {
 func TestGetByResource(t *testing.T) {
 	t.Run("ReturnsEmptyWhenFree", func(t *testing.T) {
 		repo := &mocks.OperationRepo{}
-		s := NewOperationServer(orgName, "", repo, nil)
+		s := NewOperationServer(orgName, "", repo, nil, nil)
 
 		repo.On("GetByResource", "node:free").Return(nil, nil)
 
@@ -124,7 +124,7 @@ func TestGetByResource(t *testing.T) {
 
 	t.Run("ReturnsHolderWhenLocked", func(t *testing.T) {
 		repo := &mocks.OperationRepo{}
-		s := NewOperationServer(orgName, "", repo, nil)
+		s := NewOperationServer(orgName, "", repo, nil, nil)
 
 		holder := &db.Operation{Id: uuid.NewV4(), ResourceKey: "node:abc", FencingToken: 1}
 		repo.On("GetByResource", "node:abc").Return(holder, nil)
@@ -140,7 +140,7 @@ func TestGetByResource(t *testing.T) {
 func TestForceUnlock(t *testing.T) {
 	t.Run("CancelsAndReleases", func(t *testing.T) {
 		repo := &mocks.OperationRepo{}
-		s := NewOperationServer(orgName, "", repo, nil)
+		s := NewOperationServer(orgName, "", repo, nil, nil)
 
 		id := uuid.NewV4()
 		current := &db.Operation{Id: id, ResourceKey: "node:abc", FencingToken: 9, Status: db.OperationRunning}
@@ -165,7 +165,7 @@ func TestForceUnlock(t *testing.T) {
 func TestMarkRunning(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		repo := &mocks.OperationRepo{}
-		s := NewOperationServer(orgName, "", repo, nil)
+		s := NewOperationServer(orgName, "", repo, nil, nil)
 
 		id := uuid.NewV4()
 		running := &db.Operation{Id: id, ResourceKey: "node:abc", FencingToken: 3, Status: db.OperationRunning}
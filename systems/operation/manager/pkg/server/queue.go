@@ -0,0 +1,268 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+
+	"github.com/ukama/ukama/systems/common/uuid"
+
+	pb "github.com/ukama/ukama/systems/operation/manager/pb/gen"
+	"github.com/ukama/ukama/systems/operation/manager/pkg"
+	"github.com/ukama/ukama/systems/operation/manager/pkg/db"
+)
+
+// enqueue is the StartOperation path for callers that asked to queue, wait or
+// preempt instead of failing on a held lock.
+func (s *OperationServer) enqueue(ctx context.Context, req *pb.StartOperationRequest,
+	op *db.Operation, lease time.Duration) (*pb.StartOperationResponse, error) {
+
+	wait := time.Duration(req.WaitSeconds) * time.Second
+	if wait > pkg.MaxLockWait {
+		wait = pkg.MaxLockWait
+	}
+
+	expires := time.Now().UTC().Add(pkg.DefaultQueueTTL)
+	if !req.Queue {
+		expires = time.Now().UTC().Add(wait)
+	}
+	op.QueueExpiresAt = &expires
+
+	op, err := s.repo.Enqueue(op, lease)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "start operation: %v", err)
+	}
+	s.watchers.Notify(op.ResourceKey)
+
+	if op.Status == db.OperationQueued && req.Preempt {
+		op, err = s.preempt(op)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if op.Status == db.OperationQueued && wait > 0 {
+		op, err = s.waitForLock(ctx, op, wait)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if op.Status == db.OperationQueued && !req.Queue {
+		return s.withdraw(op)
+	}
+
+	resp := &pb.StartOperationResponse{Operation: toPb(op)}
+	if op.Status == db.OperationQueued {
+		resp.QueuePosition = s.queuePosition(op)
+		holder, err := s.repo.GetByResource(op.ResourceKey)
+		if err == nil && holder != nil {
+			resp.ConflictingOperation = toPb(holder)
+		}
+		log.Infof("operation %s queued: type=%s resource=%s position=%d", op.Id, op.Type, op.ResourceKey, resp.QueuePosition)
+	} else {
+		log.Infof("operation %s started: type=%s resource=%s token=%d", op.Id, op.Type, op.ResourceKey, op.FencingToken)
+	}
+	return resp, nil
+}
+
+// preempt cancels the current holder when op outranks it. Releasing the lock
+// promotes the highest priority queued operation, which is op unless an even
+// more urgent one is waiting.
+func (s *OperationServer) preempt(op *db.Operation) (*db.Operation, error) {
+	holder, err := s.repo.GetByResource(op.ResourceKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get lock holder: %v", err)
+	}
+	if holder == nil || !canPreempt(op, holder) {
+		return op, nil
+	}
+
+	reason := fmt.Sprintf("preempted by operation %s", op.Id)
+	if _, err := s.repo.Terminate(holder.Id, holder.FencingToken, db.OperationCancelled, db.OperationAudit{
+		Event:  "preempted",
+		Actor:  op.RequestedBy,
+		Reason: reason,
+	}, reason); err != nil {
+		return nil, status.Errorf(codes.Internal, "preempt operation %s: %v", holder.Id, err)
+	}
+	s.watchers.Notify(op.ResourceKey)
+	log.Warnf("operation %s preempted by %s on %s", holder.Id, op.Id, op.ResourceKey)
+
+	return s.reload(op.Id)
+}
+
+/* A running holder is only preempted when it was started as preemptible */
+func canPreempt(op, holder *db.Operation) bool {
+	if op.Priority <= holder.Priority {
+		return false
+	}
+	return holder.Status == db.OperationPending || holder.Preemptible
+}
+
+func (s *OperationServer) waitForLock(ctx context.Context, op *db.Operation, wait time.Duration) (*db.Operation, error) {
+	wake, cancel := s.watchers.Subscribe(op.ResourceKey)
+	defer cancel()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	poll := time.NewTicker(pkg.WatchPollInterval)
+	defer poll.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return op, nil
+		case <-timer.C:
+			return op, nil
+		case <-wake:
+		case <-poll.C:
+		}
+
+		current, err := s.reload(op.Id)
+		if err != nil {
+			return nil, err
+		}
+		if current.Status != db.OperationQueued {
+			return current, nil
+		}
+	}
+}
+
+// withdraw cancels an operation whose caller gave up waiting. The lock may have
+// been handed over in the meantime, in which case the caller gets it after all.
+func (s *OperationServer) withdraw(op *db.Operation) (*pb.StartOperationResponse, error) {
+	current, err := s.reload(op.Id)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status != db.OperationQueued {
+		return &pb.StartOperationResponse{Operation: toPb(current)}, nil
+	}
+
+	if _, err := s.repo.Terminate(current.Id, current.FencingToken, db.OperationCancelled, db.OperationAudit{
+		Event:  "withdrawn",
+		Actor:  current.RequestedBy,
+		Reason: "lock wait timed out",
+	}, "lock wait timed out"); err != nil {
+		return nil, status.Errorf(codes.Internal, "withdraw operation: %v", err)
+	}
+	s.watchers.Notify(current.ResourceKey)
+
+	resp := &pb.StartOperationResponse{}
+	holder, err := s.repo.GetByResource(current.ResourceKey)
+	if err == nil && holder != nil {
+		resp.ConflictingOperation = toPb(holder)
+	}
+	return resp, status.Error(codes.AlreadyExists, "resource is locked by an active operation")
+}
+
+func (s *OperationServer) reload(id uuid.UUID) (*db.Operation, error) {
+	op, err := s.repo.Get(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "operation not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get operation: %v", err)
+	}
+	return op, nil
+}
+
+/* queuePosition is 1-based and 0 when op is not queued */
+func (s *OperationServer) queuePosition(op *db.Operation) uint32 {
+	if op.Status != db.OperationQueued {
+		return 0
+	}
+	queue, err := s.repo.ListQueue(op.ResourceKey)
+	if err != nil {
+		log.Warnf("queue position for %s: %v", op.Id, err)
+		return 0
+	}
+	for i := range queue {
+		if queue[i].Id == op.Id {
+			return uint32(i + 1)
+		}
+	}
+	return 0
+}
+
+func (s *OperationServer) GetQueue(ctx context.Context, req *pb.GetQueueRequest) (*pb.GetQueueResponse, error) {
+	holder, err := s.repo.GetByResource(req.ResourceKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get by resource: %v", err)
+	}
+	queue, err := s.repo.ListQueue(req.ResourceKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list queue: %v", err)
+	}
+
+	resp := &pb.GetQueueResponse{Holder: toPb(holder)}
+	for i := range queue {
+		resp.Queued = append(resp.Queued, toPb(&queue[i]))
+	}
+	return resp, nil
+}
+
+// WatchOperation streams the operation each time it or its queue position
+// changes and ends once it is terminal.
+func (s *OperationServer) WatchOperation(req *pb.WatchOperationRequest, stream grpc.ServerStreamingServer[pb.WatchOperationResponse]) error {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	op, err := s.reload(id)
+	if err != nil {
+		return err
+	}
+
+	wake, cancel := s.watchers.Subscribe(op.ResourceKey)
+	defer cancel()
+	poll := time.NewTicker(pkg.WatchPollInterval)
+	defer poll.Stop()
+
+	var last *pb.WatchOperationResponse
+	for {
+		resp := &pb.WatchOperationResponse{Operation: toPb(op), QueuePosition: s.queuePosition(op)}
+		if last == nil || watchChanged(last, resp) {
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+			last = resp
+		}
+		if op.Status.IsTerminal() {
+			return nil
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-wake:
+		case <-poll.C:
+		}
+
+		if op, err = s.reload(id); err != nil {
+			return err
+		}
+	}
+}
+
+func watchChanged(last, next *pb.WatchOperationResponse) bool {
+	return last.QueuePosition != next.QueuePosition ||
+		!proto.Equal(last.Operation, next.Operation)
+}
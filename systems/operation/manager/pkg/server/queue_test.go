@@ -0,0 +1,222 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/tj/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/operation/manager/mocks"
+	pb "github.com/ukama/ukama/systems/operation/manager/pb/gen"
+	"github.com/ukama/ukama/systems/operation/manager/pkg/db"
+)
+
+func queuedAs(status db.OperationStatus) func(*db.Operation, time.Duration) *db.Operation {
+	return func(op *db.Operation, _ time.Duration) *db.Operation {
+		op.Status = status
+		op.FencingToken = 8
+		return op
+	}
+}
+
+func TestStartOperation_Queue(t *testing.T) {
+	t.Run("QueuesBehindHolder", func(t *testing.T) {
+		repo := &mocks.OperationRepo{}
+		s := NewOperationServer(orgName, "", repo, nil, NewWatchers())
+
+		holder := &db.Operation{Id: uuid.NewV4(), Status: db.OperationRunning, ResourceKey: "node:abc"}
+		ahead := db.Operation{Id: uuid.NewV4(), Status: db.OperationQueued, ResourceKey: "node:abc"}
+
+		var queued *db.Operation
+		repo.On("Enqueue", mock.AnythingOfType("*db.Operation"), mock.AnythingOfType("time.Duration")).
+			Return(queuedAs(db.OperationQueued), nil).
+			Run(func(args mock.Arguments) { queued = args.Get(0).(*db.Operation) }).Once()
+		repo.On("ListQueue", "node:abc").Return(func(string) []db.Operation {
+			return []db.Operation{ahead, *queued}
+		}, nil).Once()
+		repo.On("GetByResource", "node:abc").Return(holder, nil).Once()
+
+		resp, err := s.StartOperation(context.Background(), &pb.StartOperationRequest{
+			Type:        "RestartNode",
+			ResourceKey: "node:abc",
+			Queue:       true,
+			Priority:    5,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, pb.OperationStatus_QUEUED, resp.Operation.Status)
+		assert.Equal(t, int32(5), resp.Operation.Priority)
+		assert.Equal(t, uint32(2), resp.QueuePosition)
+		assert.Equal(t, holder.Id.String(), resp.ConflictingOperation.Id)
+		assert.NotNil(t, resp.Operation.QueueExpiresAt)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("PreemptsLowerPriorityPendingHolder", func(t *testing.T) {
+		repo := &mocks.OperationRepo{}
+		s := NewOperationServer(orgName, "", repo, nil, NewWatchers())
+
+		holder := &db.Operation{Id: uuid.NewV4(), Status: db.OperationPending, FencingToken: 7, ResourceKey: "node:abc"}
+
+		var queued *db.Operation
+		repo.On("Enqueue", mock.AnythingOfType("*db.Operation"), mock.AnythingOfType("time.Duration")).
+			Return(queuedAs(db.OperationQueued), nil).
+			Run(func(args mock.Arguments) { queued = args.Get(0).(*db.Operation) }).Once()
+		repo.On("GetByResource", "node:abc").Return(holder, nil).Once()
+		repo.On("Terminate", holder.Id, uint64(7), db.OperationCancelled,
+			mock.MatchedBy(func(a db.OperationAudit) bool { return a.Event == "preempted" }), mock.Anything).
+			Return(holder, nil).Once()
+		repo.On("Get", mock.AnythingOfType("uuid.UUID")).Return(func(id uuid.UUID) *db.Operation {
+			promoted := *queued
+			promoted.Status = db.OperationPending
+			promoted.FencingToken = 9
+			return &promoted
+		}, nil).Once()
+
+		resp, err := s.StartOperation(context.Background(), &pb.StartOperationRequest{
+			Type:        "RestartNode",
+			ResourceKey: "node:abc",
+			Priority:    10,
+			Preempt:     true,
+			WaitSeconds: 1,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, pb.OperationStatus_PENDING, resp.Operation.Status)
+		assert.Equal(t, uint64(9), resp.Operation.FencingToken)
+		assert.Nil(t, resp.ConflictingOperation)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("DoesNotPreemptRunningHolder", func(t *testing.T) {
+		holder := &db.Operation{Status: db.OperationRunning, Priority: 1}
+		urgent := &db.Operation{Priority: 10}
+
+		assert.False(t, canPreempt(urgent, holder))
+		holder.Preemptible = true
+		assert.True(t, canPreempt(urgent, holder))
+		holder.Priority = 10
+		assert.False(t, canPreempt(urgent, holder))
+	})
+
+	t.Run("WaitWakesOnRelease", func(t *testing.T) {
+		repo := &mocks.OperationRepo{}
+		w := NewWatchers()
+		s := NewOperationServer(orgName, "", repo, nil, w)
+
+		var queued *db.Operation
+		repo.On("Enqueue", mock.AnythingOfType("*db.Operation"), mock.AnythingOfType("time.Duration")).
+			Return(queuedAs(db.OperationQueued), nil).
+			Run(func(args mock.Arguments) {
+				queued = args.Get(0).(*db.Operation)
+				go func() {
+					time.Sleep(20 * time.Millisecond)
+					w.Notify("node:abc")
+				}()
+			}).Once()
+		repo.On("Get", mock.AnythingOfType("uuid.UUID")).Return(func(id uuid.UUID) *db.Operation {
+			promoted := *queued
+			promoted.Status = db.OperationPending
+			return &promoted
+		}, nil).Once()
+
+		resp, err := s.StartOperation(context.Background(), &pb.StartOperationRequest{
+			Type:        "RestartNode",
+			ResourceKey: "node:abc",
+			WaitSeconds: 10,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, pb.OperationStatus_PENDING, resp.Operation.Status)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("WaitTimeoutWithdraws", func(t *testing.T) {
+		repo := &mocks.OperationRepo{}
+		s := NewOperationServer(orgName, "", repo, nil, NewWatchers())
+
+		holder := &db.Operation{Id: uuid.NewV4(), Status: db.OperationRunning, ResourceKey: "node:abc"}
+
+		var queued *db.Operation
+		repo.On("Enqueue", mock.AnythingOfType("*db.Operation"), mock.AnythingOfType("time.Duration")).
+			Return(queuedAs(db.OperationQueued), nil).
+			Run(func(args mock.Arguments) { queued = args.Get(0).(*db.Operation) }).Once()
+		repo.On("Get", mock.AnythingOfType("uuid.UUID")).Return(func(uuid.UUID) *db.Operation {
+			return queued
+		}, nil).Once()
+		repo.On("Terminate", mock.AnythingOfType("uuid.UUID"), uint64(8), db.OperationCancelled,
+			mock.MatchedBy(func(a db.OperationAudit) bool { return a.Event == "withdrawn" }), "lock wait timed out").
+			Return(&db.Operation{}, nil).Once()
+		repo.On("GetByResource", "node:abc").Return(holder, nil).Once()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		resp, err := s.StartOperation(ctx, &pb.StartOperationRequest{
+			Type:        "RestartNode",
+			ResourceKey: "node:abc",
+			WaitSeconds: 10,
+		})
+
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		assert.Equal(t, holder.Id.String(), resp.ConflictingOperation.Id)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestGetQueue(t *testing.T) {
+	repo := &mocks.OperationRepo{}
+	s := NewOperationServer(orgName, "", repo, nil, nil)
+
+	holder := &db.Operation{Id: uuid.NewV4(), Status: db.OperationRunning, ResourceKey: "node:abc"}
+	repo.On("GetByResource", "node:abc").Return(holder, nil).Once()
+	repo.On("ListQueue", "node:abc").Return([]db.Operation{
+		{Id: uuid.NewV4(), Status: db.OperationQueued, Priority: 9},
+		{Id: uuid.NewV4(), Status: db.OperationQueued},
+	}, nil).Once()
+
+	resp, err := s.GetQueue(context.Background(), &pb.GetQueueRequest{ResourceKey: "node:abc"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, holder.Id.String(), resp.Holder.Id)
+	assert.Len(t, resp.Queued, 2)
+	assert.Equal(t, int32(9), resp.Queued[0].Priority)
+}
+
+func TestWatchers(t *testing.T) {
+	w := NewWatchers()
+	wake, cancel := w.Subscribe("node:abc")
+
+	w.Notify("node:other")
+	w.Notify("node:abc")
+	w.Notify("node:abc")
+
+	select {
+	case <-wake:
+	default:
+		t.Fatal("subscriber was not woken")
+	}
+	select {
+	case <-wake:
+		t.Fatal("notifications were not coalesced")
+	default:
+	}
+
+	cancel()
+	assert.Empty(t, w.subs)
+
+	var nilWatchers *Watchers
+	nilWatchers.Notify("node:abc")
+}
@@ -20,13 +20,15 @@ import (
 
 type Sweeper struct {
 	repo     db.OperationRepo
+	watchers *Watchers
 	interval time.Duration
 	batch    int
 }
 
-func NewSweeper(repo db.OperationRepo) *Sweeper {
+func NewSweeper(repo db.OperationRepo, watchers *Watchers) *Sweeper {
 	return &Sweeper{
 		repo:     repo,
+		watchers: watchers,
 		interval: pkg.SweeperInterval,
 		batch:    100,
 	}
@@ -48,6 +50,11 @@ func (s *Sweeper) Run(ctx context.Context) {
 }
 
 func (s *Sweeper) sweepOnce() {
+	s.sweepLeases()
+	s.sweepQueue()
+}
+
+func (s *Sweeper) sweepLeases() {
 	expired, err := s.repo.FindExpired(time.Now().UTC(), s.batch)
 	if err != nil {
 		log.Errorf("sweeper: FindExpired error: %v", err)
@@ -65,6 +72,32 @@ func (s *Sweeper) sweepOnce() {
 		}, "lease expired")
 		if err != nil {
 			log.Warnf("sweeper: terminate(timeout) for %s failed: %v", op.Id, err)
+			continue
+		}
+		s.watchers.Notify(op.ResourceKey)
+	}
+}
+
+func (s *Sweeper) sweepQueue() {
+	expired, err := s.repo.FindQueueExpired(time.Now().UTC(), s.batch)
+	if err != nil {
+		log.Errorf("sweeper: FindQueueExpired error: %v", err)
+		return
+	}
+	if len(expired) == 0 {
+		return
+	}
+	log.Infof("sweeper: cancelling %d operation(s) that waited too long in queue", len(expired))
+	for i := range expired {
+		op := &expired[i]
+		_, err := s.repo.Terminate(op.Id, op.FencingToken, db.OperationCancelled, db.OperationAudit{
+			Event:  "queue_expired",
+			Reason: "queue wait expired",
+		}, "queue wait expired")
+		if err != nil {
+			log.Warnf("sweeper: terminate(queue_expired) for %s failed: %v", op.Id, err)
+			continue
 		}
+		s.watchers.Notify(op.ResourceKey)
 	}
 }
@@ -0,0 +1,61 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import "sync"
+
+// Watchers wakes local waiters when an operation on a resource changes. A
+// wake-up only says "re-read", the database stays the source of truth, so a
+// missed or coalesced notification costs at most one poll interval.
+type Watchers struct {
+	mu   sync.Mutex
+	subs map[string]map[chan struct{}]struct{}
+}
+
+func NewWatchers() *Watchers {
+	return &Watchers{subs: map[string]map[chan struct{}]struct{}{}}
+}
+
+func (w *Watchers) Subscribe(resourceKey string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	if w == nil {
+		return ch, func() {}
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.subs[resourceKey] == nil {
+		w.subs[resourceKey] = map[chan struct{}]struct{}{}
+	}
+	w.subs[resourceKey][ch] = struct{}{}
+
+	return ch, func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		delete(w.subs[resourceKey], ch)
+		if len(w.subs[resourceKey]) == 0 {
+			delete(w.subs, resourceKey)
+		}
+	}
+}
+
+func (w *Watchers) Notify(resourceKey string) {
+	if w == nil {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for ch := range w.subs[resourceKey] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
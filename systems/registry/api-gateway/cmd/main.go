@@ -9,17 +9,20 @@
 package main
 
 import (
+	"net/http"
 	"os"
 
 	"github.com/ukama/ukama/systems/common/config"
 	"github.com/ukama/ukama/systems/common/metrics"
 	"github.com/ukama/ukama/systems/common/rest/client"
 	"github.com/ukama/ukama/systems/common/rest/client/auth"
+	"github.com/ukama/ukama/systems/common/roles"
 	"github.com/ukama/ukama/systems/registry/api-gateway/cmd/version"
 	"github.com/ukama/ukama/systems/registry/api-gateway/pkg"
 	"github.com/ukama/ukama/systems/registry/api-gateway/pkg/rest"
 
 	ccmd "github.com/ukama/ukama/systems/common/cmd"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
 )
 
 var svcConf = pkg.NewConfig()
@@ -31,8 +34,17 @@ func main() {
 	clientSet := rest.NewClientsSet(&svcConf.Services)
 	metrics.StartMetricsServer(&svcConf.Metrics)
 
+	/* data requests carry the personal data of the whole org, so no registry
+	 * wide role grants them, only owners and admins */
+	dataRequests := roles.NewPermission("privacy", "data-requests", "manage")
+
 	r := rest.NewRouter(clientSet, rest.NewRouterConfig(svcConf),
-		auth.NewAuthClient(svcConf.Auth.AuthServerUrl, client.WithDebug(svcConf.DebugMode)).AuthenticateUser)
+		creg.NewAuthFunc(pkg.SystemName, svcConf.Auth,
+			auth.NewAuthClient(svcConf.Auth.AuthServerUrl, client.WithDebug(svcConf.DebugMode)).AuthenticateUser,
+			roles.WithRoute(http.MethodGet, "/v1/data-requests", dataRequests),
+			roles.WithRoute(http.MethodPost, "/v1/data-requests", dataRequests),
+			roles.WithRoute(http.MethodGet, "/v1/data-requests/:request_id", dataRequests),
+			roles.WithRoute(http.MethodGet, "/v1/data-requests/:request_id/bundle", dataRequests)))
 	r.Run()
 }
 
@@ -12,6 +12,36 @@ type invitation struct {
 	mock.Mock
 }
 
+// AddBulkInvitations provides a mock function with given fields: csv, expiryHours
+func (_m *invitation) AddBulkInvitations(csv string, expiryHours uint32) (*gen.AddBulkResponse, error) {
+	ret := _m.Called(csv, expiryHours)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBulkInvitations")
+	}
+
+	var r0 *gen.AddBulkResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, uint32) (*gen.AddBulkResponse, error)); ok {
+		return rf(csv, expiryHours)
+	}
+	if rf, ok := ret.Get(0).(func(string, uint32) *gen.AddBulkResponse); ok {
+		r0 = rf(csv, expiryHours)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.AddBulkResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, uint32) error); ok {
+		r1 = rf(csv, expiryHours)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddInvitation provides a mock function with given fields: name, email, role
 func (_m *invitation) AddInvitation(name string, email string, role string) (*gen.AddResponse, error) {
 	ret := _m.Called(name, email, role)
@@ -42,6 +72,36 @@ func (_m *invitation) AddInvitation(name string, email string, role string) (*ge
 	return r0, r1
 }
 
+// AddScopedInvitation provides a mock function with given fields: name, email, role, expiryHours, scopes
+func (_m *invitation) AddScopedInvitation(name string, email string, role string, expiryHours uint32, scopes []*gen.InvitationScope) (*gen.AddResponse, error) {
+	ret := _m.Called(name, email, role, expiryHours, scopes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddScopedInvitation")
+	}
+
+	var r0 *gen.AddResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, uint32, []*gen.InvitationScope) (*gen.AddResponse, error)); ok {
+		return rf(name, email, role, expiryHours, scopes)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, uint32, []*gen.InvitationScope) *gen.AddResponse); ok {
+		r0 = rf(name, email, role, expiryHours, scopes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.AddResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, uint32, []*gen.InvitationScope) error); ok {
+		r1 = rf(name, email, role, expiryHours, scopes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllInvitations provides a mock function with no fields
 func (_m *invitation) GetAllInvitations() (*gen.GetAllResponse, error) {
 	ret := _m.Called()
@@ -162,6 +222,36 @@ func (_m *invitation) RemoveInvitation(invitationId string) (*gen.DeleteResponse
 	return r0, r1
 }
 
+// ResendInvitation provides a mock function with given fields: invitationId, expiryHours
+func (_m *invitation) ResendInvitation(invitationId string, expiryHours uint32) (*gen.ResendResponse, error) {
+	ret := _m.Called(invitationId, expiryHours)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendInvitation")
+	}
+
+	var r0 *gen.ResendResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, uint32) (*gen.ResendResponse, error)); ok {
+		return rf(invitationId, expiryHours)
+	}
+	if rf, ok := ret.Get(0).(func(string, uint32) *gen.ResendResponse); ok {
+		r0 = rf(invitationId, expiryHours)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ResendResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, uint32) error); ok {
+		r1 = rf(invitationId, expiryHours)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateInvitation provides a mock function with given fields: invitationId, status, email
 func (_m *invitation) UpdateInvitation(invitationId string, status string, email string) (*gen.UpdateStatusResponse, error) {
 	ret := _m.Called(invitationId, status, email)
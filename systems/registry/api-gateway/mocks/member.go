@@ -12,6 +12,36 @@ type member struct {
 	mock.Mock
 }
 
+// AddDataRequest provides a mock function with given fields: kind, subjectKind, subjectId, requestedBy
+func (_m *member) AddDataRequest(kind string, subjectKind string, subjectId string, requestedBy string) (*gen.DataRequestResponse, error) {
+	ret := _m.Called(kind, subjectKind, subjectId, requestedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDataRequest")
+	}
+
+	var r0 *gen.DataRequestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*gen.DataRequestResponse, error)); ok {
+		return rf(kind, subjectKind, subjectId, requestedBy)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *gen.DataRequestResponse); ok {
+		r0 = rf(kind, subjectKind, subjectId, requestedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DataRequestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(kind, subjectKind, subjectId, requestedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddMember provides a mock function with given fields: userUUID, role
 func (_m *member) AddMember(userUUID string, role string) (*gen.MemberResponse, error) {
 	ret := _m.Called(userUUID, role)
@@ -42,6 +72,174 @@ func (_m *member) AddMember(userUUID string, role string) (*gen.MemberResponse,
 	return r0, r1
 }
 
+// AddRole provides a mock function with given fields: name, description, permissions
+func (_m *member) AddRole(name string, description string, permissions []string) (*gen.RoleResponse, error) {
+	ret := _m.Called(name, description, permissions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRole")
+	}
+
+	var r0 *gen.RoleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, []string) (*gen.RoleResponse, error)); ok {
+		return rf(name, description, permissions)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, []string) *gen.RoleResponse); ok {
+		r0 = rf(name, description, permissions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RoleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, []string) error); ok {
+		r1 = rf(name, description, permissions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddRoleBinding provides a mock function with given fields: userId, role, scopeKind, scopeId
+func (_m *member) AddRoleBinding(userId string, role string, scopeKind string, scopeId string) (*gen.RoleBindingResponse, error) {
+	ret := _m.Called(userId, role, scopeKind, scopeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRoleBinding")
+	}
+
+	var r0 *gen.RoleBindingResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*gen.RoleBindingResponse, error)); ok {
+		return rf(userId, role, scopeKind, scopeId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *gen.RoleBindingResponse); ok {
+		r0 = rf(userId, role, scopeKind, scopeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RoleBindingResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(userId, role, scopeKind, scopeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRole provides a mock function with given fields: name
+func (_m *member) DeleteRole(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAccess provides a mock function with given fields: userId
+func (_m *member) GetAccess(userId string) (*gen.GetAccessResponse, error) {
+	ret := _m.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccess")
+	}
+
+	var r0 *gen.GetAccessResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*gen.GetAccessResponse, error)); ok {
+		return rf(userId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gen.GetAccessResponse); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetAccessResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDataRequest provides a mock function with given fields: id
+func (_m *member) GetDataRequest(id string) (*gen.DataRequestResponse, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDataRequest")
+	}
+
+	var r0 *gen.DataRequestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*gen.DataRequestResponse, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gen.DataRequestResponse); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DataRequestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDataRequestBundle provides a mock function with given fields: id, format
+func (_m *member) GetDataRequestBundle(id string, format string) (*gen.GetDataRequestBundleResponse, error) {
+	ret := _m.Called(id, format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDataRequestBundle")
+	}
+
+	var r0 *gen.GetDataRequestBundleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*gen.GetDataRequestBundleResponse, error)); ok {
+		return rf(id, format)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *gen.GetDataRequestBundleResponse); ok {
+		r0 = rf(id, format)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetDataRequestBundleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(id, format)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMember provides a mock function with given fields: userUUID
 func (_m *member) GetMember(userUUID string) (*gen.MemberResponse, error) {
 	ret := _m.Called(userUUID)
@@ -132,6 +330,96 @@ func (_m *member) GetMembers() (*gen.GetMembersResponse, error) {
 	return r0, r1
 }
 
+// ListDataRequests provides a mock function with no fields
+func (_m *member) ListDataRequests() (*gen.ListDataRequestsResponse, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDataRequests")
+	}
+
+	var r0 *gen.ListDataRequestsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*gen.ListDataRequestsResponse, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *gen.ListDataRequestsResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListDataRequestsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoleBindings provides a mock function with given fields: userId
+func (_m *member) ListRoleBindings(userId string) (*gen.ListRoleBindingsResponse, error) {
+	ret := _m.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoleBindings")
+	}
+
+	var r0 *gen.ListRoleBindingsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*gen.ListRoleBindingsResponse, error)); ok {
+		return rf(userId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gen.ListRoleBindingsResponse); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListRoleBindingsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoles provides a mock function with no fields
+func (_m *member) ListRoles() (*gen.ListRolesResponse, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoles")
+	}
+
+	var r0 *gen.ListRolesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*gen.ListRolesResponse, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *gen.ListRolesResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListRolesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveMember provides a mock function with given fields: userUUID
 func (_m *member) RemoveMember(userUUID string) error {
 	ret := _m.Called(userUUID)
@@ -150,6 +438,24 @@ func (_m *member) RemoveMember(userUUID string) error {
 	return r0
 }
 
+// RemoveRoleBinding provides a mock function with given fields: bindingId
+func (_m *member) RemoveRoleBinding(bindingId string) error {
+	ret := _m.Called(bindingId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveRoleBinding")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(bindingId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateMember provides a mock function with given fields: userUUID, isDeactivated, role
 func (_m *member) UpdateMember(userUUID string, isDeactivated bool, role string) error {
 	ret := _m.Called(userUUID, isDeactivated, role)
@@ -168,6 +474,36 @@ func (_m *member) UpdateMember(userUUID string, isDeactivated bool, role string)
 	return r0
 }
 
+// UpdateRole provides a mock function with given fields: name, description, permissions
+func (_m *member) UpdateRole(name string, description string, permissions []string) (*gen.RoleResponse, error) {
+	ret := _m.Called(name, description, permissions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 *gen.RoleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, []string) (*gen.RoleResponse, error)); ok {
+		return rf(name, description, permissions)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, []string) *gen.RoleResponse); ok {
+		r0 = rf(name, description, permissions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RoleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, []string) error); ok {
+		r1 = rf(name, description, permissions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // newMember creates a new instance of member. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newMember(t interface {
@@ -42,6 +42,276 @@ func (_m *site) AddSite(networkId string, name string, backhaulId string, powerI
 	return r0, r1
 }
 
+// CheckDecommission provides a mock function with given fields: siteId
+func (_m *site) CheckDecommission(siteId string) (*gen.CheckDecommissionResponse, error) {
+	ret := _m.Called(siteId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckDecommission")
+	}
+
+	var r0 *gen.CheckDecommissionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*gen.CheckDecommissionResponse, error)); ok {
+		return rf(siteId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gen.CheckDecommissionResponse); ok {
+		r0 = rf(siteId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CheckDecommissionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(siteId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Decommission provides a mock function with given fields: siteId, reason, force
+func (_m *site) Decommission(siteId string, reason string, force bool) (*gen.DecommissionResponse, error) {
+	ret := _m.Called(siteId, reason, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Decommission")
+	}
+
+	var r0 *gen.DecommissionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, bool) (*gen.DecommissionResponse, error)); ok {
+		return rf(siteId, reason, force)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, bool) *gen.DecommissionResponse); ok {
+		r0 = rf(siteId, reason, force)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DecommissionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, bool) error); ok {
+		r1 = rf(siteId, reason, force)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportNetwork provides a mock function with given fields: networkId
+func (_m *site) ExportNetwork(networkId string) (*gen.ExportNetworkResponse, error) {
+	ret := _m.Called(networkId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportNetwork")
+	}
+
+	var r0 *gen.ExportNetworkResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*gen.ExportNetworkResponse, error)); ok {
+		return rf(networkId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gen.ExportNetworkResponse); ok {
+		r0 = rf(networkId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ExportNetworkResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(networkId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindInBounds provides a mock function with given fields: networkId, minLatitude, minLongitude, maxLatitude, maxLongitude
+func (_m *site) FindInBounds(networkId string, minLatitude float64, minLongitude float64, maxLatitude float64, maxLongitude float64) (*gen.FindSitesResponse, error) {
+	ret := _m.Called(networkId, minLatitude, minLongitude, maxLatitude, maxLongitude)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindInBounds")
+	}
+
+	var r0 *gen.FindSitesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, float64, float64, float64, float64) (*gen.FindSitesResponse, error)); ok {
+		return rf(networkId, minLatitude, minLongitude, maxLatitude, maxLongitude)
+	}
+	if rf, ok := ret.Get(0).(func(string, float64, float64, float64, float64) *gen.FindSitesResponse); ok {
+		r0 = rf(networkId, minLatitude, minLongitude, maxLatitude, maxLongitude)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.FindSitesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, float64, float64, float64, float64) error); ok {
+		r1 = rf(networkId, minLatitude, minLongitude, maxLatitude, maxLongitude)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindInRadius provides a mock function with given fields: networkId, latitude, longitude, radiusMeters
+func (_m *site) FindInRadius(networkId string, latitude float64, longitude float64, radiusMeters float64) (*gen.FindSitesResponse, error) {
+	ret := _m.Called(networkId, latitude, longitude, radiusMeters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindInRadius")
+	}
+
+	var r0 *gen.FindSitesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, float64, float64, float64) (*gen.FindSitesResponse, error)); ok {
+		return rf(networkId, latitude, longitude, radiusMeters)
+	}
+	if rf, ok := ret.Get(0).(func(string, float64, float64, float64) *gen.FindSitesResponse); ok {
+		r0 = rf(networkId, latitude, longitude, radiusMeters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.FindSitesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, float64, float64, float64) error); ok {
+		r1 = rf(networkId, latitude, longitude, radiusMeters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindNearest provides a mock function with given fields: networkId, latitude, longitude, limit
+func (_m *site) FindNearest(networkId string, latitude float64, longitude float64, limit uint32) (*gen.FindSitesResponse, error) {
+	ret := _m.Called(networkId, latitude, longitude, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindNearest")
+	}
+
+	var r0 *gen.FindSitesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, float64, float64, uint32) (*gen.FindSitesResponse, error)); ok {
+		return rf(networkId, latitude, longitude, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, float64, float64, uint32) *gen.FindSitesResponse); ok {
+		r0 = rf(networkId, latitude, longitude, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.FindSitesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, float64, float64, uint32) error); ok {
+		r1 = rf(networkId, latitude, longitude, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindServing provides a mock function with given fields: networkId, latitude, longitude
+func (_m *site) FindServing(networkId string, latitude float64, longitude float64) (*gen.FindSitesResponse, error) {
+	ret := _m.Called(networkId, latitude, longitude)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindServing")
+	}
+
+	var r0 *gen.FindSitesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, float64, float64) (*gen.FindSitesResponse, error)); ok {
+		return rf(networkId, latitude, longitude)
+	}
+	if rf, ok := ret.Get(0).(func(string, float64, float64) *gen.FindSitesResponse); ok {
+		r0 = rf(networkId, latitude, longitude)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.FindSitesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, float64, float64) error); ok {
+		r1 = rf(networkId, latitude, longitude)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCoverage provides a mock function with given fields: siteId
+func (_m *site) GetCoverage(siteId string) (*gen.GetCoverageResponse, error) {
+	ret := _m.Called(siteId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCoverage")
+	}
+
+	var r0 *gen.GetCoverageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*gen.GetCoverageResponse, error)); ok {
+		return rf(siteId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gen.GetCoverageResponse); ok {
+		r0 = rf(siteId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetCoverageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(siteId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHistory provides a mock function with given fields: siteId
+func (_m *site) GetHistory(siteId string) (*gen.GetHistoryResponse, error) {
+	ret := _m.Called(siteId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 *gen.GetHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*gen.GetHistoryResponse, error)); ok {
+		return rf(siteId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gen.GetHistoryResponse); ok {
+		r0 = rf(siteId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(siteId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSite provides a mock function with given fields: siteId
 func (_m *site) GetSite(siteId string) (*gen.GetResponse, error) {
 	ret := _m.Called(siteId)
@@ -102,6 +372,36 @@ func (_m *site) List(networkId string, isDeactivate bool) (*gen.ListResponse, er
 	return r0, r1
 }
 
+// Relocate provides a mock function with given fields: siteId, latitude, longitude, location, backhaulId, reason
+func (_m *site) Relocate(siteId string, latitude string, longitude string, location string, backhaulId string, reason string) (*gen.RelocateResponse, error) {
+	ret := _m.Called(siteId, latitude, longitude, location, backhaulId, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Relocate")
+	}
+
+	var r0 *gen.RelocateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string, string) (*gen.RelocateResponse, error)); ok {
+		return rf(siteId, latitude, longitude, location, backhaulId, reason)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string, string) *gen.RelocateResponse); ok {
+		r0 = rf(siteId, latitude, longitude, location, backhaulId, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RelocateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, string, string) error); ok {
+		r1 = rf(siteId, latitude, longitude, location, backhaulId, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveSite provides a mock function with given fields: siteId
 func (_m *site) RemoveSite(siteId string) (*gen.DeleteResponse, error) {
 	ret := _m.Called(siteId)
@@ -132,6 +432,36 @@ func (_m *site) RemoveSite(siteId string) (*gen.DeleteResponse, error) {
 	return r0, r1
 }
 
+// SetCoverage provides a mock function with given fields: siteId, geojson
+func (_m *site) SetCoverage(siteId string, geojson string) (*gen.SetCoverageResponse, error) {
+	ret := _m.Called(siteId, geojson)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCoverage")
+	}
+
+	var r0 *gen.SetCoverageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*gen.SetCoverageResponse, error)); ok {
+		return rf(siteId, geojson)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *gen.SetCoverageResponse); ok {
+		r0 = rf(siteId, geojson)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.SetCoverageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(siteId, geojson)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateSite provides a mock function with given fields: siteId, name
 func (_m *site) UpdateSite(siteId string, name string) (*gen.UpdateResponse, error) {
 	ret := _m.Called(siteId, name)
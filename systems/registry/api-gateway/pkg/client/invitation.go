@@ -74,13 +74,40 @@ func (i *InvitationRegistry) GetInvitationById(id string) (*pb.GetResponse, erro
 }
 
 func (i *InvitationRegistry) AddInvitation(name, email, role string) (*pb.AddResponse, error) {
+	return i.AddScopedInvitation(name, email, role, 0, nil)
+}
+
+func (i *InvitationRegistry) AddScopedInvitation(name, email, role string, expiryHours uint32,
+	scopes []*pb.InvitationScope) (*pb.AddResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), i.timeout)
 	defer cancel()
 
 	return i.client.Add(ctx, &pb.AddRequest{
-		Name:  name,
-		Email: email,
-		Role:  uType.RoleType(uType.RoleType_value[role]),
+		Name:        name,
+		Email:       email,
+		Role:        uType.RoleType(uType.RoleType_value[role]),
+		ExpiryHours: expiryHours,
+		Scopes:      scopes,
+	})
+}
+
+func (i *InvitationRegistry) AddBulkInvitations(csv string, expiryHours uint32) (*pb.AddBulkResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), i.timeout)
+	defer cancel()
+
+	return i.client.AddBulk(ctx, &pb.AddBulkRequest{
+		Csv:         csv,
+		ExpiryHours: expiryHours,
+	})
+}
+
+func (i *InvitationRegistry) ResendInvitation(id string, expiryHours uint32) (*pb.ResendResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), i.timeout)
+	defer cancel()
+
+	return i.client.Resend(ctx, &pb.ResendRequest{
+		Id:          id,
+		ExpiryHours: expiryHours,
 	})
 }
 
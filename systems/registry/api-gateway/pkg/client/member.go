@@ -109,3 +109,101 @@ func (m *MemberRegistry) RemoveMember(memberId string) error {
 
 	return err
 }
+
+func (m *MemberRegistry) AddRole(name, description string, permissions []string) (*pb.RoleResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	return m.client.AddRole(ctx, &pb.AddRoleRequest{Name: name, Description: description, Permissions: permissions})
+}
+
+func (m *MemberRegistry) UpdateRole(name, description string, permissions []string) (*pb.RoleResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	return m.client.UpdateRole(ctx, &pb.UpdateRoleRequest{Name: name, Description: description, Permissions: permissions})
+}
+
+func (m *MemberRegistry) ListRoles() (*pb.ListRolesResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	return m.client.ListRoles(ctx, &pb.ListRolesRequest{})
+}
+
+func (m *MemberRegistry) DeleteRole(name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	_, err := m.client.DeleteRole(ctx, &pb.DeleteRoleRequest{Name: name})
+
+	return err
+}
+
+func (m *MemberRegistry) AddRoleBinding(userId, role, scopeKind, scopeId string) (*pb.RoleBindingResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	return m.client.AddRoleBinding(ctx, &pb.AddRoleBindingRequest{
+		UserId:    userId,
+		Role:      role,
+		ScopeKind: scopeKind,
+		ScopeId:   scopeId,
+	})
+}
+
+func (m *MemberRegistry) RemoveRoleBinding(bindingId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	_, err := m.client.RemoveRoleBinding(ctx, &pb.RemoveRoleBindingRequest{Id: bindingId})
+
+	return err
+}
+
+func (m *MemberRegistry) ListRoleBindings(userId string) (*pb.ListRoleBindingsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	return m.client.ListRoleBindings(ctx, &pb.ListRoleBindingsRequest{UserId: userId})
+}
+
+func (m *MemberRegistry) GetAccess(userId string) (*pb.GetAccessResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	return m.client.GetAccess(ctx, &pb.GetAccessRequest{UserId: userId})
+}
+
+func (m *MemberRegistry) AddDataRequest(kind, subjectKind, subjectId, requestedBy string) (*pb.DataRequestResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	return m.client.AddDataRequest(ctx, &pb.AddDataRequestRequest{
+		Kind:        kind,
+		SubjectKind: subjectKind,
+		SubjectId:   subjectId,
+		RequestedBy: requestedBy,
+	})
+}
+
+func (m *MemberRegistry) GetDataRequest(id string) (*pb.DataRequestResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	return m.client.GetDataRequest(ctx, &pb.GetDataRequestRequest{Id: id})
+}
+
+func (m *MemberRegistry) ListDataRequests() (*pb.ListDataRequestsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	return m.client.ListDataRequests(ctx, &pb.ListDataRequestsRequest{})
+}
+
+func (m *MemberRegistry) GetDataRequestBundle(id, format string) (*pb.GetDataRequestBundleResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	return m.client.GetDataRequestBundle(ctx, &pb.GetDataRequestBundleRequest{Id: id, Format: format})
+}
@@ -109,3 +109,107 @@ func (i *SiteRegistry) RemoveSite(siteId string) (*pb.DeleteResponse, error) {
 
 	return i.client.Delete(ctx, &pb.DeleteRequest{SiteId: siteId})
 }
+
+func (i *SiteRegistry) FindInRadius(networkId string, latitude, longitude, radiusMeters float64) (*pb.FindSitesResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), i.timeout)
+	defer cancel()
+
+	return i.client.FindInRadius(ctx, &pb.FindInRadiusRequest{
+		NetworkId:    networkId,
+		Latitude:     latitude,
+		Longitude:    longitude,
+		RadiusMeters: radiusMeters,
+	})
+}
+
+func (i *SiteRegistry) FindInBounds(networkId string, minLatitude, minLongitude, maxLatitude, maxLongitude float64) (*pb.FindSitesResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), i.timeout)
+	defer cancel()
+
+	return i.client.FindInBounds(ctx, &pb.FindInBoundsRequest{
+		NetworkId:    networkId,
+		MinLatitude:  minLatitude,
+		MinLongitude: minLongitude,
+		MaxLatitude:  maxLatitude,
+		MaxLongitude: maxLongitude,
+	})
+}
+
+func (i *SiteRegistry) FindNearest(networkId string, latitude, longitude float64, limit uint32) (*pb.FindSitesResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), i.timeout)
+	defer cancel()
+
+	return i.client.FindNearest(ctx, &pb.FindNearestRequest{
+		NetworkId: networkId,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Limit:     limit,
+	})
+}
+
+func (i *SiteRegistry) FindServing(networkId string, latitude, longitude float64) (*pb.FindSitesResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), i.timeout)
+	defer cancel()
+
+	return i.client.FindServing(ctx, &pb.FindServingRequest{
+		NetworkId: networkId,
+		Latitude:  latitude,
+		Longitude: longitude,
+	})
+}
+
+func (i *SiteRegistry) SetCoverage(siteId, geojson string) (*pb.SetCoverageResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), i.timeout)
+	defer cancel()
+
+	return i.client.SetCoverage(ctx, &pb.SetCoverageRequest{SiteId: siteId, Geojson: geojson})
+}
+
+func (i *SiteRegistry) GetCoverage(siteId string) (*pb.GetCoverageResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), i.timeout)
+	defer cancel()
+
+	return i.client.GetCoverage(ctx, &pb.GetCoverageRequest{SiteId: siteId})
+}
+
+func (i *SiteRegistry) ExportNetwork(networkId string) (*pb.ExportNetworkResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), i.timeout)
+	defer cancel()
+
+	return i.client.ExportNetwork(ctx, &pb.ExportNetworkRequest{NetworkId: networkId})
+}
+
+func (i *SiteRegistry) CheckDecommission(siteId string) (*pb.CheckDecommissionResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), i.timeout)
+	defer cancel()
+
+	return i.client.CheckDecommission(ctx, &pb.CheckDecommissionRequest{SiteId: siteId})
+}
+
+func (i *SiteRegistry) Decommission(siteId, reason string, force bool) (*pb.DecommissionResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), i.timeout)
+	defer cancel()
+
+	return i.client.Decommission(ctx, &pb.DecommissionRequest{SiteId: siteId, Reason: reason, Force: force})
+}
+
+func (i *SiteRegistry) Relocate(siteId, latitude, longitude, location, backhaulId, reason string) (*pb.RelocateResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), i.timeout)
+	defer cancel()
+
+	return i.client.Relocate(ctx, &pb.RelocateRequest{
+		SiteId:     siteId,
+		Latitude:   latitude,
+		Longitude:  longitude,
+		Location:   location,
+		BackhaulId: backhaulId,
+		Reason:     reason,
+	})
+}
+
+func (i *SiteRegistry) GetHistory(siteId string) (*pb.GetHistoryResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), i.timeout)
+	defer cancel()
+
+	return i.client.GetHistory(ctx, &pb.GetHistoryRequest{SiteId: siteId})
+}
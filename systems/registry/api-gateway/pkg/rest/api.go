@@ -8,6 +8,8 @@
 
 package rest
 
+import "encoding/json"
+
 type MemberRequest struct {
 	UserUuid string `example:"{{UserUUID}}" json:"user_uuid" validate:"required"`
 	Role     string `example:"member" json:"role" validate:"required"`
@@ -37,6 +39,56 @@ type UpdateMemberRequest struct {
 	Role          string `example:"member" json:"role,omitempty"`
 }
 
+type RoleRequest struct {
+	Name        string   `example:"field_tech" json:"name" validate:"required"`
+	Description string   `example:"Field technician" json:"description"`
+	Permissions []string `example:"node.nodes.write" json:"permissions" validate:"required"`
+}
+
+type ListRolesRequest struct {
+}
+
+type UpdateRoleRequest struct {
+	Name        string   `example:"field_tech" path:"role" validate:"required"`
+	Description string   `example:"Field technician" json:"description"`
+	Permissions []string `example:"node.nodes.write" json:"permissions" validate:"required"`
+}
+
+type DeleteRoleRequest struct {
+	Name string `example:"field_tech" path:"role" validate:"required"`
+}
+
+type RoleBindingRequest struct {
+	UserId    string `example:"{{UserId}}" path:"user_id" validate:"required"`
+	Role      string `example:"field_tech" json:"role" validate:"required"`
+	ScopeKind string `example:"site" json:"scope_kind"`
+	ScopeId   string `example:"{{SiteUUID}}" json:"scope_id"`
+}
+
+type RemoveRoleBindingRequest struct {
+	UserId    string `example:"{{UserId}}" path:"user_id" validate:"required"`
+	BindingId string `example:"{{BindingId}}" path:"binding_id" validate:"required"`
+}
+
+type GetDataRequestsRequest struct {
+}
+
+type AddDataRequestRequest struct {
+	// export or erase, only subscribers can be erased
+	Kind        string `example:"export" json:"kind" validate:"required"`
+	SubjectKind string `example:"subscriber" json:"subject_kind" validate:"required"`
+	SubjectId   string `example:"{{SubscriberId}}" json:"subject_id"`
+}
+
+type GetDataRequestRequest struct {
+	RequestId string `example:"{{DataRequestId}}" path:"request_id" validate:"required"`
+}
+
+type GetDataRequestBundleRequest struct {
+	RequestId string `example:"{{DataRequestId}}" path:"request_id" validate:"required"`
+	Format    string `example:"csv" query:"format"`
+}
+
 type GetNetworkRequest struct {
 	NetworkId string `example:"{{NetworkUUID}}" path:"net_id" validate:"required"`
 }
@@ -64,6 +116,60 @@ type UpdateSiteRequest struct {
 	Name   string `json:"name" validate:"required"`
 }
 
+type FindSitesInRadiusRequest struct {
+	NetworkId    string  `example:"{{NetworkUUID}}" json:"network_id" query:"network_id"`
+	Latitude     float64 `example:"-1.2921" json:"latitude" query:"latitude" validate:"gte=-90,lte=90"`
+	Longitude    float64 `example:"36.8219" json:"longitude" query:"longitude" validate:"gte=-180,lte=180"`
+	RadiusMeters float64 `example:"5000" json:"radius_meters" query:"radius_meters" validate:"gt=0"`
+}
+
+type FindSitesInBoundsRequest struct {
+	NetworkId    string  `example:"{{NetworkUUID}}" json:"network_id" query:"network_id"`
+	MinLatitude  float64 `json:"min_latitude" query:"min_latitude" validate:"gte=-90,lte=90"`
+	MinLongitude float64 `json:"min_longitude" query:"min_longitude" validate:"gte=-180,lte=180"`
+	MaxLatitude  float64 `json:"max_latitude" query:"max_latitude" validate:"gte=-90,lte=90"`
+	MaxLongitude float64 `json:"max_longitude" query:"max_longitude" validate:"gte=-180,lte=180"`
+}
+
+type FindNearestSitesRequest struct {
+	NetworkId string  `example:"{{NetworkUUID}}" json:"network_id" query:"network_id"`
+	Latitude  float64 `example:"-1.2921" json:"latitude" query:"latitude" validate:"gte=-90,lte=90"`
+	Longitude float64 `example:"36.8219" json:"longitude" query:"longitude" validate:"gte=-180,lte=180"`
+	Limit     uint32  `json:"limit" query:"limit" default:"5"`
+}
+
+type FindServingSitesRequest struct {
+	NetworkId string  `example:"{{NetworkUUID}}" json:"network_id" query:"network_id"`
+	Latitude  float64 `example:"-1.2921" json:"latitude" query:"latitude" validate:"gte=-90,lte=90"`
+	Longitude float64 `example:"36.8219" json:"longitude" query:"longitude" validate:"gte=-180,lte=180"`
+}
+
+type ExportNetworkSitesRequest struct {
+	NetworkId string `example:"{{NetworkUUID}}" json:"network_id" query:"network_id" validate:"required"`
+}
+
+type SetSiteCoverageRequest struct {
+	SiteId string `example:"{{SiteUUID}}" path:"site_id" validate:"required"`
+	// GeoJSON Polygon or MultiPolygon, bare or as a Feature
+	Geojson json.RawMessage `json:"geojson" validate:"required"`
+}
+
+type DecommissionSiteRequest struct {
+	SiteId string `example:"{{SiteUUID}}" path:"site_id" validate:"required"`
+	Reason string `example:"lease ended" json:"reason"`
+	// Decommission even if nodes of the site are online
+	Force bool `example:"false" json:"force"`
+}
+
+type RelocateSiteRequest struct {
+	SiteId     string `example:"{{SiteUUID}}" path:"site_id" validate:"required"`
+	Latitude   string `example:"-1.2921" json:"latitude" validate:"required"`
+	Longitude  string `example:"36.8219" json:"longitude" validate:"required"`
+	Location   string `example:"Nairobi" json:"location"`
+	BackhaulId string `example:"{{BackhaulUUID}}" json:"backhaul_id"`
+	Reason     string `example:"road works" json:"reason"`
+}
+
 type AddSiteRequest struct {
 	NetworkId     string  `example:"{{NetworkUUID}}" json:"network_id" validate:"required"`
 	Name          string  `example:"s1-site" json:"site" validate:"required"`
@@ -156,6 +262,27 @@ type AddInvitationRequest struct {
 	Name  string `json:"name" validate:"required"`
 	Email string `json:"email" validate:"required"`
 	Role  string `json:"role" validate:"required"`
+	// Overrides the configured expiry when set
+	ExpiryHours uint32 `example:"72" json:"expiry_hours"`
+	// Restricts the invitee to roles on networks or sites
+	Scopes []InvitationScope `json:"scopes"`
+}
+
+type InvitationScope struct {
+	Role      string `example:"field_tech" json:"role"`
+	ScopeKind string `example:"site" json:"scope_kind" validate:"required"`
+	ScopeId   string `example:"{{SiteUUID}}" json:"scope_id"`
+}
+
+type AddBulkInvitationsRequest struct {
+	// Header row with email, name, role, network_id, site_id and expiry_hours
+	Csv         string `example:"email,name,role" json:"csv" validate:"required"`
+	ExpiryHours uint32 `example:"72" json:"expiry_hours"`
+}
+
+type ResendInvitationRequest struct {
+	InvitationId string `json:"invitation_id" path:"invitation_id" validate:"required"`
+	ExpiryHours  uint32 `example:"72" json:"expiry_hours"`
 }
 
 type GetInvitationRequest struct {
@@ -9,6 +9,7 @@
 package rest
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -16,6 +17,8 @@ import (
 	"github.com/ukama/ukama/systems/common/config"
 	cpb "github.com/ukama/ukama/systems/common/pb/gen/ukama"
 	"github.com/ukama/ukama/systems/common/rest"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
+	"github.com/ukama/ukama/systems/common/roles"
 	"github.com/ukama/ukama/systems/common/ukama"
 	"github.com/ukama/ukama/systems/registry/api-gateway/cmd/version"
 	"github.com/ukama/ukama/systems/registry/api-gateway/pkg"
@@ -39,8 +42,9 @@ const (
 )
 
 type Router struct {
-	f       *fizz.Fizz
-	clients *Clients
+	f        *fizz.Fizz
+	internal *fizz.Fizz
+	clients  *Clients
 	config  *RouterConfig
 }
 
@@ -77,10 +81,24 @@ type site interface {
 	List(networkId string, isDeactivate bool) (*sitepb.ListResponse, error)
 	UpdateSite(siteId, name string) (*sitepb.UpdateResponse, error)
 	RemoveSite(siteId string) (*sitepb.DeleteResponse, error)
+	FindInRadius(networkId string, latitude, longitude, radiusMeters float64) (*sitepb.FindSitesResponse, error)
+	FindInBounds(networkId string, minLatitude, minLongitude, maxLatitude, maxLongitude float64) (*sitepb.FindSitesResponse, error)
+	FindNearest(networkId string, latitude, longitude float64, limit uint32) (*sitepb.FindSitesResponse, error)
+	FindServing(networkId string, latitude, longitude float64) (*sitepb.FindSitesResponse, error)
+	SetCoverage(siteId, geojson string) (*sitepb.SetCoverageResponse, error)
+	GetCoverage(siteId string) (*sitepb.GetCoverageResponse, error)
+	ExportNetwork(networkId string) (*sitepb.ExportNetworkResponse, error)
+	CheckDecommission(siteId string) (*sitepb.CheckDecommissionResponse, error)
+	Decommission(siteId, reason string, force bool) (*sitepb.DecommissionResponse, error)
+	Relocate(siteId, latitude, longitude, location, backhaulId, reason string) (*sitepb.RelocateResponse, error)
+	GetHistory(siteId string) (*sitepb.GetHistoryResponse, error)
 }
 
 type invitation interface {
 	AddInvitation(name, email, role string) (*invpb.AddResponse, error)
+	AddScopedInvitation(name, email, role string, expiryHours uint32, scopes []*invpb.InvitationScope) (*invpb.AddResponse, error)
+	AddBulkInvitations(csv string, expiryHours uint32) (*invpb.AddBulkResponse, error)
+	ResendInvitation(invitationId string, expiryHours uint32) (*invpb.ResendResponse, error)
 	GetInvitationById(invitationId string) (*invpb.GetResponse, error)
 	UpdateInvitation(invitationId string, status string, email string) (*invpb.UpdateStatusResponse, error)
 	RemoveInvitation(invitationId string) (*invpb.DeleteResponse, error)
@@ -95,6 +113,18 @@ type member interface {
 	AddMember(userUUID string, role string) (*mpb.MemberResponse, error)
 	UpdateMember(userUUID string, isDeactivated bool, role string) error
 	RemoveMember(userUUID string) error
+	AddRole(name, description string, permissions []string) (*mpb.RoleResponse, error)
+	UpdateRole(name, description string, permissions []string) (*mpb.RoleResponse, error)
+	ListRoles() (*mpb.ListRolesResponse, error)
+	DeleteRole(name string) error
+	AddRoleBinding(userId, role, scopeKind, scopeId string) (*mpb.RoleBindingResponse, error)
+	RemoveRoleBinding(bindingId string) error
+	ListRoleBindings(userId string) (*mpb.ListRoleBindingsResponse, error)
+	GetAccess(userId string) (*mpb.GetAccessResponse, error)
+	AddDataRequest(kind, subjectKind, subjectId, requestedBy string) (*mpb.DataRequestResponse, error)
+	GetDataRequest(id string) (*mpb.DataRequestResponse, error)
+	ListDataRequests() (*mpb.ListDataRequestsResponse, error)
+	GetDataRequestBundle(id, format string) (*mpb.GetDataRequestBundleResponse, error)
 }
 
 type node interface {
@@ -152,6 +182,8 @@ func NewRouterConfig(svcConf *pkg.Config) *RouterConfig {
 }
 
 func (rt *Router) Run() {
+	rest.RunInternal(rt.internal, rt.config.serverConf)
+
 	log.Info("Listening on port ", rt.config.serverConf.Port)
 	err := rt.f.Engine().Run(fmt.Sprint(":", rt.config.serverConf.Port))
 	if err != nil {
@@ -177,6 +209,15 @@ func (r *Router) init(f func(*gin.Context, string) error) {
 		}, r.config.grpcEndpoints.Timeout)
 	}
 
+	// Lookups of the role based authorization of the gateways. They are made
+	// while a request is being authorized, without the caller credentials, so
+	// they are only served on the internal listener.
+	r.internal = rest.NewInternalFizzRouter(pkg.SystemName, version.Version)
+	authz := r.internal.Group(creg.AuthzPrefix+"/v1", "Authorization", "Lookups for the authorization of the gateways")
+	authz.GET("/members/user/:user_id/access", append(formatDoc("Get Access", "Get the permissions granted to a member and where they apply"), fizz.ID("authzGetAccess")), tonic.Handler(r.getAccessHandler, http.StatusOK))
+	authz.GET("/sites/:site_id", append(formatDoc("Get Site", "Get the network of a site"), fizz.ID("authzGetSite")), tonic.Handler(r.getSiteHandler, http.StatusOK))
+	authz.GET("/nodes/:node_id", append(formatDoc("Get Node", "Get the site of a node"), fizz.ID("authzGetNode")), tonic.Handler(r.getNodeHandler, http.StatusOK))
+
 	auth := r.f.Group("/v1", "API gateway", "Registry system version v1", func(ctx *gin.Context) {
 		if r.config.auth.BypassAuthMode {
 			log.Info("Bypassing auth")
@@ -199,11 +240,31 @@ func (r *Router) init(f func(*gin.Context, string) error) {
 		member.GET("/:member_id", formatDoc("Get Member", "Get a member of an organization"), tonic.Handler(r.getMemberHandler, http.StatusOK))
 		member.PATCH("/:member_id", formatDoc("Update Member", "Update a member of an organization"), tonic.Handler(r.patchMemberHandler, http.StatusOK))
 		member.DELETE("/:member_id", formatDoc("Remove Member", "Remove a member from an organization"), tonic.Handler(r.removeMemberHandler, http.StatusOK))
+		member.GET("/user/:user_id/bindings", formatDoc("Get Role Bindings", "Get the role bindings of a member"), tonic.Handler(r.getRoleBindingsHandler, http.StatusOK))
+		member.POST("/user/:user_id/bindings", formatDoc("Add Role Binding", "Bind a role to a member on the org, a network or a site"), tonic.Handler(r.postRoleBindingHandler, http.StatusCreated))
+		member.DELETE("/user/:user_id/bindings/:binding_id", formatDoc("Remove Role Binding", "Remove a role binding of a member"), tonic.Handler(r.removeRoleBindingHandler, http.StatusOK))
+		member.GET("/user/:user_id/access", formatDoc("Get Access", "Get the permissions granted to a member and where they apply"), tonic.Handler(r.getAccessHandler, http.StatusOK))
+
+		const rol = "/roles"
+		role := auth.Group(rol, "Roles", desc.Member)
+		role.GET("", formatDoc("Get Roles", "Get builtin and custom roles"), tonic.Handler(r.getRolesHandler, http.StatusOK))
+		role.POST("", formatDoc("Add Role", "Add a custom role composed of permissions"), tonic.Handler(r.postRoleHandler, http.StatusCreated))
+		role.PUT("/:role", formatDoc("Update Role", "Update the permissions of a custom role"), tonic.Handler(r.putRoleHandler, http.StatusOK))
+		role.DELETE("/:role", formatDoc("Delete Role", "Delete a custom role no longer bound to members"), tonic.Handler(r.deleteRoleHandler, http.StatusOK))
+
+		const dr = "/data-requests"
+		dataRequests := auth.Group(dr, "Data Requests", desc.Member)
+		dataRequests.GET("", formatDoc("Get Data Requests", "Get the data export and erasure requests of an organization"), tonic.Handler(r.getDataRequestsHandler, http.StatusOK))
+		dataRequests.POST("", formatDoc("Add Data Request", "Export the data of the organization or a subscriber, or erase a subscriber"), tonic.Handler(r.postDataRequestHandler, http.StatusCreated))
+		dataRequests.GET("/:request_id", formatDoc("Get Data Request", "Get a data request and the progress of each service"), tonic.Handler(r.getDataRequestHandler, http.StatusOK))
+		dataRequests.GET("/:request_id/bundle", formatDoc("Download Data Export", "Download a completed export as JSON or as a zip of CSV files"), tonic.Handler(r.getDataRequestBundleHandler, http.StatusOK))
 
 		// Invitation routes
 		const inv = "/invitations"
 		invitations := auth.Group(inv, "Invitations", desc.Invitation)
 		invitations.POST("", formatDoc("Add Invitation", "Add a new invitation to an organization"), tonic.Handler(r.postInvitationHandler, http.StatusCreated))
+		invitations.POST("/bulk", formatDoc("Add Invitations in bulk", "Add invitations from a CSV document"), tonic.Handler(r.postBulkInvitationsHandler, http.StatusCreated))
+		invitations.POST("/:invitation_id/resend", formatDoc("Resend Invitation", "Resend an invitation with a fresh link"), tonic.Handler(r.resendInvitationHandler, http.StatusOK))
 		invitations.GET("/:invitation_id", formatDoc("Get Invitation", "Get a specific invitation"), tonic.Handler(r.getInvitationHandler, http.StatusOK))
 		invitations.PATCH("/:invitation_id", formatDoc("Update Invitation", "Update a specific invitation"), tonic.Handler(r.patchInvitationHandler, http.StatusOK))
 		invitations.DELETE("/:invitation_id", formatDoc("Remove Invitation", "Remove a invitation from an organization"), tonic.Handler(r.removeInvitationHandler, http.StatusOK))
@@ -232,6 +293,18 @@ func (r *Router) init(f func(*gin.Context, string) error) {
 		sites.GET("/:site_id", formatDoc("Get Site", "Get a site of a network"), tonic.Handler(r.getSiteHandler, http.StatusOK))
 		sites.PATCH("/:site_id", formatDoc("Update Site", "Update a site of a network"), tonic.Handler(r.updateSiteHandler, http.StatusOK))
 		sites.DELETE("/:site_id", formatDoc("Remove Site", "Remove a site of a network"), tonic.Handler(r.removeSiteHandler, http.StatusOK))
+		sites.GET("/nearby", formatDoc("Find Sites In Radius", "Get active sites within a radius of a point, nearest first"), tonic.Handler(r.getSitesInRadiusHandler, http.StatusOK))
+		sites.GET("/within", formatDoc("Find Sites In Bounds", "Get active sites inside a bounding box"), tonic.Handler(r.getSitesInBoundsHandler, http.StatusOK))
+		sites.GET("/nearest", formatDoc("Find Nearest Sites", "Get the active sites nearest to a point"), tonic.Handler(r.getNearestSitesHandler, http.StatusOK))
+		sites.GET("/serving", formatDoc("Find Serving Sites", "Get active sites whose coverage area contains a point"), tonic.Handler(r.getServingSitesHandler, http.StatusOK))
+		sites.GET("/geojson", formatDoc("Export Network Sites", "Get the sites and coverage areas of a network as a GeoJSON FeatureCollection"), tonic.Handler(r.exportNetworkSitesHandler, http.StatusOK))
+		sites.GET("/:site_id/coverage", formatDoc("Get Site Coverage", "Get the coverage area of a site as a GeoJSON Feature"), tonic.Handler(r.getSiteCoverageHandler, http.StatusOK))
+		sites.PUT("/:site_id/coverage", formatDoc("Set Site Coverage", "Import the coverage area of a site from GeoJSON"), tonic.Handler(r.putSiteCoverageHandler, http.StatusOK))
+		sites.DELETE("/:site_id/coverage", formatDoc("Remove Site Coverage", "Remove the coverage area of a site"), tonic.Handler(r.deleteSiteCoverageHandler, http.StatusOK))
+		sites.GET("/:site_id/decommission", formatDoc("Check Site Decommission", "Get the nodes and components decommissioning a site would release and what blocks it"), tonic.Handler(r.checkSiteDecommissionHandler, http.StatusOK))
+		sites.POST("/:site_id/decommission", formatDoc("Decommission Site", "Release the nodes and components of a site and keep it for history"), tonic.Handler(r.postSiteDecommissionHandler, http.StatusOK))
+		sites.POST("/:site_id/relocate", formatDoc("Relocate Site", "Move a site keeping its identity and history"), tonic.Handler(r.postSiteRelocateHandler, http.StatusOK))
+		sites.GET("/:site_id/history", formatDoc("Get Site History", "Get the snapshots taken before a site was decommissioned or relocated"), tonic.Handler(r.getSiteHistoryHandler, http.StatusOK))
 
 		// Node routes
 		const node = "/nodes"
@@ -352,6 +425,65 @@ func (r *Router) removeMemberHandler(c *gin.Context, req *RemoveMemberRequest) e
 	return r.clients.Member.RemoveMember(req.MemberId)
 }
 
+func (r *Router) getRoleBindingsHandler(c *gin.Context, req *GetMemberByUserRequest) (*mpb.ListRoleBindingsResponse, error) {
+	return r.clients.Member.ListRoleBindings(req.UserId)
+}
+
+func (r *Router) postRoleBindingHandler(c *gin.Context, req *RoleBindingRequest) (*mpb.RoleBindingResponse, error) {
+	return r.clients.Member.AddRoleBinding(req.UserId, req.Role, req.ScopeKind, req.ScopeId)
+}
+
+func (r *Router) removeRoleBindingHandler(c *gin.Context, req *RemoveRoleBindingRequest) error {
+	return r.clients.Member.RemoveRoleBinding(req.BindingId)
+}
+
+func (r *Router) getAccessHandler(c *gin.Context, req *GetMemberByUserRequest) (*mpb.GetAccessResponse, error) {
+	return r.clients.Member.GetAccess(req.UserId)
+}
+
+/* Data requests */
+func (r *Router) getDataRequestsHandler(c *gin.Context, req *GetDataRequestsRequest) (*mpb.ListDataRequestsResponse, error) {
+	return r.clients.Member.ListDataRequests()
+}
+
+func (r *Router) postDataRequestHandler(c *gin.Context, req *AddDataRequestRequest) (*mpb.DataRequestResponse, error) {
+	return r.clients.Member.AddDataRequest(req.Kind, req.SubjectKind, req.SubjectId, c.Request.Header.Get(roles.UserIdHeader))
+}
+
+func (r *Router) getDataRequestHandler(c *gin.Context, req *GetDataRequestRequest) (*mpb.DataRequestResponse, error) {
+	return r.clients.Member.GetDataRequest(req.RequestId)
+}
+
+func (r *Router) getDataRequestBundleHandler(c *gin.Context, req *GetDataRequestBundleRequest) error {
+	resp, err := r.clients.Member.GetDataRequestBundle(req.RequestId, req.Format)
+	if err != nil {
+		return err
+	}
+
+	c.Header("Content-Type", resp.ContentType)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", resp.FileName))
+	_, err = c.Writer.Write(resp.Data)
+
+	return err
+}
+
+/* Role */
+func (r *Router) getRolesHandler(c *gin.Context, req *ListRolesRequest) (*mpb.ListRolesResponse, error) {
+	return r.clients.Member.ListRoles()
+}
+
+func (r *Router) postRoleHandler(c *gin.Context, req *RoleRequest) (*mpb.RoleResponse, error) {
+	return r.clients.Member.AddRole(req.Name, req.Description, req.Permissions)
+}
+
+func (r *Router) putRoleHandler(c *gin.Context, req *UpdateRoleRequest) (*mpb.RoleResponse, error) {
+	return r.clients.Member.UpdateRole(req.Name, req.Description, req.Permissions)
+}
+
+func (r *Router) deleteRoleHandler(c *gin.Context, req *DeleteRoleRequest) error {
+	return r.clients.Member.DeleteRole(req.Name)
+}
+
 // Network handlers
 
 func (r *Router) setNetworkDefaultHandler(c *gin.Context, req *GetNetworkRequest) (*netpb.SetDefaultResponse, error) {
@@ -401,6 +533,63 @@ func (r *Router) removeSiteHandler(c *gin.Context, req *GetSiteRequest) (*sitepb
 	return r.clients.Site.RemoveSite(req.SiteId)
 }
 
+func (r *Router) getSitesInRadiusHandler(c *gin.Context, req *FindSitesInRadiusRequest) (*sitepb.FindSitesResponse, error) {
+	return r.clients.Site.FindInRadius(req.NetworkId, req.Latitude, req.Longitude, req.RadiusMeters)
+}
+
+func (r *Router) getSitesInBoundsHandler(c *gin.Context, req *FindSitesInBoundsRequest) (*sitepb.FindSitesResponse, error) {
+	return r.clients.Site.FindInBounds(req.NetworkId, req.MinLatitude, req.MinLongitude, req.MaxLatitude, req.MaxLongitude)
+}
+
+func (r *Router) getNearestSitesHandler(c *gin.Context, req *FindNearestSitesRequest) (*sitepb.FindSitesResponse, error) {
+	return r.clients.Site.FindNearest(req.NetworkId, req.Latitude, req.Longitude, req.Limit)
+}
+
+func (r *Router) getServingSitesHandler(c *gin.Context, req *FindServingSitesRequest) (*sitepb.FindSitesResponse, error) {
+	return r.clients.Site.FindServing(req.NetworkId, req.Latitude, req.Longitude)
+}
+
+// GeoJSON is returned as is so mapping tools can consume the response directly.
+func (r *Router) exportNetworkSitesHandler(c *gin.Context, req *ExportNetworkSitesRequest) (json.RawMessage, error) {
+	resp, err := r.clients.Site.ExportNetwork(req.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(resp.Geojson), nil
+}
+
+func (r *Router) getSiteCoverageHandler(c *gin.Context, req *GetSiteRequest) (json.RawMessage, error) {
+	resp, err := r.clients.Site.GetCoverage(req.SiteId)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(resp.Geojson), nil
+}
+
+func (r *Router) putSiteCoverageHandler(c *gin.Context, req *SetSiteCoverageRequest) (*sitepb.SetCoverageResponse, error) {
+	return r.clients.Site.SetCoverage(req.SiteId, string(req.Geojson))
+}
+
+func (r *Router) deleteSiteCoverageHandler(c *gin.Context, req *GetSiteRequest) (*sitepb.SetCoverageResponse, error) {
+	return r.clients.Site.SetCoverage(req.SiteId, "")
+}
+
+func (r *Router) checkSiteDecommissionHandler(c *gin.Context, req *GetSiteRequest) (*sitepb.CheckDecommissionResponse, error) {
+	return r.clients.Site.CheckDecommission(req.SiteId)
+}
+
+func (r *Router) postSiteDecommissionHandler(c *gin.Context, req *DecommissionSiteRequest) (*sitepb.DecommissionResponse, error) {
+	return r.clients.Site.Decommission(req.SiteId, req.Reason, req.Force)
+}
+
+func (r *Router) postSiteRelocateHandler(c *gin.Context, req *RelocateSiteRequest) (*sitepb.RelocateResponse, error) {
+	return r.clients.Site.Relocate(req.SiteId, req.Latitude, req.Longitude, req.Location, req.BackhaulId, req.Reason)
+}
+
+func (r *Router) getSiteHistoryHandler(c *gin.Context, req *GetSiteRequest) (*sitepb.GetHistoryResponse, error) {
+	return r.clients.Site.GetHistory(req.SiteId)
+}
+
 func (r *Router) postSiteHandler(c *gin.Context, req *AddSiteRequest) (*sitepb.AddResponse, error) {
 
 	return r.clients.Site.AddSite(
@@ -420,7 +609,24 @@ func (r *Router) postSiteHandler(c *gin.Context, req *AddSiteRequest) (*sitepb.A
 }
 
 func (r *Router) postInvitationHandler(c *gin.Context, req *AddInvitationRequest) (*invpb.AddResponse, error) {
-	return r.clients.Invitation.AddInvitation(req.Name, strings.ToLower(req.Email), req.Role)
+	scopes := make([]*invpb.InvitationScope, len(req.Scopes))
+	for i, s := range req.Scopes {
+		scopes[i] = &invpb.InvitationScope{
+			Role:      s.Role,
+			ScopeKind: s.ScopeKind,
+			ScopeId:   s.ScopeId,
+		}
+	}
+
+	return r.clients.Invitation.AddScopedInvitation(req.Name, strings.ToLower(req.Email), req.Role, req.ExpiryHours, scopes)
+}
+
+func (r *Router) postBulkInvitationsHandler(c *gin.Context, req *AddBulkInvitationsRequest) (*invpb.AddBulkResponse, error) {
+	return r.clients.Invitation.AddBulkInvitations(req.Csv, req.ExpiryHours)
+}
+
+func (r *Router) resendInvitationHandler(c *gin.Context, req *ResendInvitationRequest) (*invpb.ResendResponse, error) {
+	return r.clients.Invitation.ResendInvitation(req.InvitationId, req.ExpiryHours)
 }
 
 func (r *Router) getInvitationHandler(c *gin.Context, req *GetInvitationRequest) (*invpb.GetResponse, error) {
@@ -105,6 +105,14 @@ func (m *TestMocks) SetupAuth() {
 }
 
 func (m *TestMocks) CreateTestRouter() *gin.Engine {
+	return m.newTestRouter().f.Engine()
+}
+
+func (m *TestMocks) CreateTestInternalRouter() *gin.Engine {
+	return m.newTestRouter().internal.Engine()
+}
+
+func (m *TestMocks) newTestRouter() *Router {
 	clients := &Clients{
 		Node:       client.NewNodeFromClient(m.Node),
 		Member:     client.NewRegistryFromClient(m.Member),
@@ -112,7 +120,7 @@ func (m *TestMocks) CreateTestRouter() *gin.Engine {
 		Site:       client.NewSiteRegistryFromClient(m.Site),
 		Invitation: client.NewInvitationRegistryFromClient(m.Invitation),
 	}
-	return NewRouter(clients, routerConfig, m.Auth.AuthenticateUser).f.Engine()
+	return NewRouter(clients, routerConfig, m.Auth.AuthenticateUser)
 }
 
 func (m *TestMocks) AssertAllExpectations(t *testing.T) {
@@ -1000,6 +1008,116 @@ func TestRemoveSite(t *testing.T) {
 	site.AssertExpectations(t)
 }
 
+func TestGetSitesNearby(t *testing.T) {
+	// arrange
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/sites/nearby?network_id="+TestNetworkId.String()+
+		"&latitude=-1.2921&longitude=36.8219&radius_meters=5000", nil)
+	arc := &cmocks.AuthClient{}
+	site := &sitmocks.SiteServiceClient{}
+
+	arc.On("AuthenticateUser", mock.Anything, mock.Anything).Return(nil)
+
+	site.On("FindInRadius", mock.Anything, &sitepb.FindInRadiusRequest{
+		NetworkId:    TestNetworkId.String(),
+		Latitude:     -1.2921,
+		Longitude:    36.8219,
+		RadiusMeters: 5000,
+	}).Return(&sitepb.FindSitesResponse{}, nil)
+
+	r := NewRouter(&Clients{
+		Node:    client.NewNodeFromClient(&nmocks.NodeServiceClient{}),
+		Member:  client.NewRegistryFromClient(&mmocks.MemberServiceClient{}),
+		Network: client.NewNetworkRegistryFromClient(&netmocks.NetworkServiceClient{}),
+		Site:    client.NewSiteRegistryFromClient(site),
+	}, routerConfig, arc.AuthenticateUser).f.Engine()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	site.AssertExpectations(t)
+}
+
+func TestSiteCoverageGeoJSON(t *testing.T) {
+	siteId := uuid.NewV4()
+	polygon := `{"type":"Polygon","coordinates":[[[36.8,-1.3],[36.9,-1.3],[36.9,-1.2],[36.8,-1.3]]]}`
+	arc := &cmocks.AuthClient{}
+	site := &sitmocks.SiteServiceClient{}
+
+	arc.On("AuthenticateUser", mock.Anything, mock.Anything).Return(nil)
+
+	site.On("SetCoverage", mock.Anything, &sitepb.SetCoverageRequest{
+		SiteId:  siteId.String(),
+		Geojson: polygon,
+	}).Return(&sitepb.SetCoverageResponse{Site: &sitepb.Site{Id: siteId.String(), HasCoverage: true}}, nil)
+	site.On("ExportNetwork", mock.Anything, &sitepb.ExportNetworkRequest{NetworkId: TestNetworkId.String()}).
+		Return(&sitepb.ExportNetworkResponse{Geojson: `{"type":"FeatureCollection","features":[]}`}, nil)
+
+	r := NewRouter(&Clients{
+		Node:    client.NewNodeFromClient(&nmocks.NodeServiceClient{}),
+		Member:  client.NewRegistryFromClient(&mmocks.MemberServiceClient{}),
+		Network: client.NewNetworkRegistryFromClient(&netmocks.NetworkServiceClient{}),
+		Site:    client.NewSiteRegistryFromClient(site),
+	}, routerConfig, arc.AuthenticateUser).f.Engine()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/v1/sites/"+siteId.String()+"/coverage",
+		strings.NewReader(`{"geojson":`+polygon+`}`))
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/v1/sites/geojson?network_id="+TestNetworkId.String(), nil)
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"type":"FeatureCollection","features":[]}`, w.Body.String())
+
+	site.AssertExpectations(t)
+}
+
+func TestSiteDecommissionAndRelocate(t *testing.T) {
+	siteId := uuid.NewV4()
+	arc := &cmocks.AuthClient{}
+	site := &sitmocks.SiteServiceClient{}
+
+	arc.On("AuthenticateUser", mock.Anything, mock.Anything).Return(nil)
+
+	site.On("Decommission", mock.Anything, &sitepb.DecommissionRequest{
+		SiteId: siteId.String(),
+		Reason: "lease ended",
+		Force:  true,
+	}).Return(&sitepb.DecommissionResponse{Site: &sitepb.Site{Id: siteId.String()}}, nil)
+	site.On("Relocate", mock.Anything, &sitepb.RelocateRequest{
+		SiteId:    siteId.String(),
+		Latitude:  "-1.2921",
+		Longitude: "36.8219",
+		Location:  "Nairobi",
+	}).Return(&sitepb.RelocateResponse{Site: &sitepb.Site{Id: siteId.String()}}, nil)
+
+	r := NewRouter(&Clients{
+		Node:    client.NewNodeFromClient(&nmocks.NodeServiceClient{}),
+		Member:  client.NewRegistryFromClient(&mmocks.MemberServiceClient{}),
+		Network: client.NewNetworkRegistryFromClient(&netmocks.NetworkServiceClient{}),
+		Site:    client.NewSiteRegistryFromClient(site),
+	}, routerConfig, arc.AuthenticateUser).f.Engine()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/v1/sites/"+siteId.String()+"/decommission",
+		strings.NewReader(`{"reason":"lease ended","force":true}`))
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/v1/sites/"+siteId.String()+"/relocate",
+		strings.NewReader(`{"latitude":"-1.2921","longitude":"36.8219","location":"Nairobi"}`))
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	site.AssertExpectations(t)
+}
+
 // ===== NODE ENDPOINT TESTS =====
 
 func TestGetNodes(t *testing.T) {
@@ -1444,3 +1562,242 @@ func TestDeleteNodeFromSite(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 	node.AssertExpectations(t)
 }
+
+func TestPostRole(t *testing.T) {
+	// arrange
+	w := httptest.NewRecorder()
+	reqBody := `{"name": "field_tech", "permissions": ["node.nodes.write", "registry.sites.read"]}`
+	req, _ := http.NewRequest("POST", "/v1/roles", strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	mocks := NewTestMocks()
+	mocks.SetupAuth()
+
+	mocks.Member.On("AddRole", mock.Anything, mock.MatchedBy(func(r *mpb.AddRoleRequest) bool {
+		return r.Name == "field_tech" && len(r.Permissions) == 2
+	})).Return(&mpb.RoleResponse{Role: &mpb.Role{Name: "field_tech"}}, nil)
+
+	r := mocks.CreateTestRouter()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	AssertHTTPStatus(t, w, http.StatusCreated)
+	mocks.Member.AssertExpectations(t)
+}
+
+func TestPostRoleBinding(t *testing.T) {
+	// arrange
+	siteId := uuid.NewV4()
+	w := httptest.NewRecorder()
+	reqBody := fmt.Sprintf(`{"role": "field_tech", "scope_kind": "site", "scope_id": "%s"}`, siteId)
+	req, _ := http.NewRequest("POST", "/v1/members/user/"+TestUserId.String()+"/bindings", strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	mocks := NewTestMocks()
+	mocks.SetupAuth()
+
+	mocks.Member.On("AddRoleBinding", mock.Anything, mock.MatchedBy(func(r *mpb.AddRoleBindingRequest) bool {
+		return r.UserId == TestUserId.String() && r.ScopeKind == "site" && r.ScopeId == siteId.String()
+	})).Return(&mpb.RoleBindingResponse{Binding: &mpb.RoleBinding{Role: "field_tech"}}, nil)
+
+	r := mocks.CreateTestRouter()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	AssertHTTPStatus(t, w, http.StatusCreated)
+	mocks.Member.AssertExpectations(t)
+}
+
+func TestGetAccess(t *testing.T) {
+	// arrange
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/members/user/"+TestUserId.String()+"/access", nil)
+	mocks := NewTestMocks()
+	mocks.SetupAuth()
+
+	mocks.Member.On("GetAccess", mock.Anything, &mpb.GetAccessRequest{UserId: TestUserId.String()}).Return(&mpb.GetAccessResponse{
+		Grants: []*mpb.Grant{{Role: "users", Permissions: []string{"*.*.read"}, ScopeKind: "org"}},
+	}, nil)
+
+	r := mocks.CreateTestRouter()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	AssertHTTPStatus(t, w, http.StatusOK)
+	assert.Contains(t, w.Body.String(), `"scope_kind":"org"`)
+	mocks.Member.AssertExpectations(t)
+}
+
+func TestGetAccess_Authz(t *testing.T) {
+	// arrange
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/authz/v1/members/user/"+TestUserId.String()+"/access", nil)
+	mocks := NewTestMocks()
+
+	mocks.Member.On("GetAccess", mock.Anything, &mpb.GetAccessRequest{UserId: TestUserId.String()}).Return(&mpb.GetAccessResponse{
+		Grants: []*mpb.Grant{{Role: "users", Permissions: []string{"*.*.read"}, ScopeKind: "org"}},
+	}, nil)
+
+	r := mocks.CreateTestInternalRouter()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	AssertHTTPStatus(t, w, http.StatusOK)
+	assert.Contains(t, w.Body.String(), `"scope_kind":"org"`)
+	mocks.Member.AssertExpectations(t)
+	mocks.Auth.AssertNotCalled(t, "AuthenticateUser", mock.Anything, mock.Anything)
+}
+
+func TestAuthz_NotPublic(t *testing.T) {
+	mocks := NewTestMocks()
+	r := mocks.CreateTestRouter()
+
+	for _, path := range []string{
+		"/authz/v1/members/user/" + TestUserId.String() + "/access",
+		"/authz/v1/sites/" + TestUserId.String(),
+		"/authz/v1/nodes/uk-sa2341-hnode-v0-a1a0",
+	} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", path, nil)
+		r.ServeHTTP(w, req)
+		AssertHTTPStatus(t, w, http.StatusNotFound)
+	}
+	mocks.AssertAllExpectations(t)
+}
+
+func TestPostBulkInvitations(t *testing.T) {
+	// arrange
+	w := httptest.NewRecorder()
+	reqBody := `{"csv": "email,role\nuser1@example.com,admin\n", "expiry_hours": 48}`
+	req, _ := http.NewRequest("POST", "/v1/invitations/bulk", strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	mocks := NewTestMocks()
+	mocks.SetupAuth()
+
+	mocks.Invitation.On("AddBulk", mock.Anything, &invpb.AddBulkRequest{
+		Csv:         "email,role\nuser1@example.com,admin\n",
+		ExpiryHours: 48,
+	}).Return(&invpb.AddBulkResponse{
+		Invitations: []*invpb.Invitation{{Email: "user1@example.com"}},
+		Failures:    []*invpb.BulkFailure{},
+	}, nil)
+
+	r := mocks.CreateTestRouter()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	AssertHTTPStatus(t, w, http.StatusCreated)
+	mocks.Invitation.AssertExpectations(t)
+}
+
+func TestResendInvitation(t *testing.T) {
+	// arrange
+	invitationId := uuid.NewV4().String()
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/v1/invitations/"+invitationId+"/resend", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	mocks := NewTestMocks()
+	mocks.SetupAuth()
+
+	mocks.Invitation.On("Resend", mock.Anything, &invpb.ResendRequest{Id: invitationId}).Return(&invpb.ResendResponse{
+		Invitation: &invpb.Invitation{Id: invitationId},
+	}, nil)
+
+	r := mocks.CreateTestRouter()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	AssertHTTPStatus(t, w, http.StatusOK)
+	mocks.Invitation.AssertExpectations(t)
+}
+
+func TestPostScopedInvitation(t *testing.T) {
+	// arrange
+	siteId := uuid.NewV4().String()
+	w := httptest.NewRecorder()
+	reqBody := fmt.Sprintf(`{"name": "John", "email": "John@example.com", "role": "ROLE_NETWORK_OWNER",
+		"expiry_hours": 72, "scopes": [{"scope_kind": "site", "scope_id": "%s"}]}`, siteId)
+	req, _ := http.NewRequest("POST", "/v1/invitations", strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	mocks := NewTestMocks()
+	mocks.SetupAuth()
+
+	mocks.Invitation.On("Add", mock.Anything, mock.MatchedBy(func(r *invpb.AddRequest) bool {
+		return r.Email == "john@example.com" && r.ExpiryHours == 72 && len(r.Scopes) == 1 &&
+			r.Scopes[0].ScopeKind == "site" && r.Scopes[0].ScopeId == siteId
+	})).Return(&invpb.AddResponse{Invitation: &invpb.Invitation{}}, nil)
+
+	r := mocks.CreateTestRouter()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	AssertHTTPStatus(t, w, http.StatusCreated)
+	mocks.Invitation.AssertExpectations(t)
+}
+
+func TestPostDataRequest(t *testing.T) {
+	// arrange
+	w := httptest.NewRecorder()
+	subscriberId := uuid.NewV4().String()
+	reqBody := `{"kind": "erase", "subject_kind": "subscriber", "subject_id": "` + subscriberId + `"}`
+	req, _ := http.NewRequest("POST", "/v1/data-requests", strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	mocks := NewTestMocks()
+	mocks.SetupAuth()
+
+	mocks.Member.On("AddDataRequest", mock.Anything, mock.MatchedBy(func(r *mpb.AddDataRequestRequest) bool {
+		return r.Kind == "erase" && r.SubjectKind == "subscriber" && r.SubjectId == subscriberId
+	})).Return(&mpb.DataRequestResponse{
+		Request: &mpb.DataRequest{Id: "dr-1", Kind: "erase", Status: "pending"},
+	}, nil)
+
+	r := mocks.CreateTestRouter()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	AssertHTTPStatus(t, w, http.StatusCreated)
+	assert.Contains(t, w.Body.String(), `"status":"pending"`)
+	mocks.Member.AssertExpectations(t)
+}
+
+func TestGetDataRequestBundle(t *testing.T) {
+	// arrange
+	w := httptest.NewRecorder()
+	requestId := uuid.NewV4().String()
+	req, _ := http.NewRequest("GET", "/v1/data-requests/"+requestId+"/bundle?format=csv", nil)
+	mocks := NewTestMocks()
+	mocks.SetupAuth()
+
+	mocks.Member.On("GetDataRequestBundle", mock.Anything, &mpb.GetDataRequestBundleRequest{Id: requestId, Format: "csv"}).
+		Return(&mpb.GetDataRequestBundleResponse{
+			Data:        []byte("zip"),
+			ContentType: "application/zip",
+			FileName:    "export-org-" + requestId + ".zip",
+		}, nil)
+
+	r := mocks.CreateTestRouter()
+
+	// act
+	r.ServeHTTP(w, req)
+
+	// assert
+	AssertHTTPStatus(t, w, http.StatusOK)
+	assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+	assert.Equal(t, "attachment; filename=export-org-"+requestId+".zip", w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "zip", w.Body.String())
+	mocks.Member.AssertExpectations(t)
+}
@@ -10,6 +10,7 @@ package main
 
 import (
 	"os"
+	"time"
 
 	"github.com/num30/config"
 	"google.golang.org/grpc"
@@ -92,6 +93,8 @@ func runGrpcServer(gormdb sql.Db) {
 
 	go msgBusListener(mbClient)
 
+	go expirySweeper(invitationServer, serviceConfig.ExpirySweepInterval)
+
 	grpcServer.StartServer()
 }
 
@@ -103,3 +106,14 @@ func msgBusListener(m mb.MsgBusServiceClient) {
 		log.Fatalf("Failed to start Message Client Service routine for service %s. Error %s", pkg.ServiceName, err.Error())
 	}
 }
+
+func expirySweeper(s *server.InvitationServer, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for range t.C {
+		if err := s.ExpireInvitations(); err != nil {
+			log.Errorf("Failed to expire invitations. Error %s", err.Error())
+		}
+	}
+}
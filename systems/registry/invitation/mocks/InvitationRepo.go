@@ -8,6 +8,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/ukama/ukama/systems/common/uuid"
 )
 
@@ -52,6 +54,36 @@ func (_m *InvitationRepo) Delete(id uuid.UUID, nestedFunc func(string, string) e
 	return r0
 }
 
+// Expire provides a mock function with given fields: before
+func (_m *InvitationRepo) Expire(before time.Time) ([]*db.Invitation, error) {
+	ret := _m.Called(before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Expire")
+	}
+
+	var r0 []*db.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]*db.Invitation, error)); ok {
+		return rf(before)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []*db.Invitation); ok {
+		r0 = rf(before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*db.Invitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: id
 func (_m *InvitationRepo) Get(id uuid.UUID) (*db.Invitation, error) {
 	ret := _m.Called(id)
@@ -142,6 +174,24 @@ func (_m *InvitationRepo) GetByEmail(email string) (*db.Invitation, error) {
 	return r0, r1
 }
 
+// Renew provides a mock function with given fields: id, link, expiresAt
+func (_m *InvitationRepo) Renew(id uuid.UUID, link string, expiresAt time.Time) error {
+	ret := _m.Called(id, link, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Renew")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, time.Time) error); ok {
+		r0 = rf(id, link, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateStatus provides a mock function with given fields: id, status
 func (_m *InvitationRepo) UpdateStatus(id uuid.UUID, status uint8) error {
 	ret := _m.Called(id, status)
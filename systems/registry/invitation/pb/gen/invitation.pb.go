@@ -7,8 +7,8 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.35.2
-// 	protoc        v5.28.3
+// 	protoc-gen-go v1.36.12
+// 	protoc        v6.32.1
 // source: invitation.proto
 
 package gen
@@ -22,6 +22,7 @@ import (
 	_ "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -32,11 +33,10 @@ const (
 )
 
 type GetByEmailRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetByEmailRequest) Reset() {
@@ -77,11 +77,10 @@ func (x *GetByEmailRequest) GetEmail() string {
 }
 
 type GetByEmailResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Invitation *Invitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetByEmailResponse) Reset() {
@@ -122,13 +121,15 @@ func (x *GetByEmailResponse) GetInvitation() *Invitation {
 }
 
 type AddRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Email string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Role  ukama.RoleType         `protobuf:"varint,5,opt,name=role,proto3,enum=ukama.common.v1.RoleType" json:"role,omitempty"`
+	// overrides the configured expiry when set
+	ExpiryHours   uint32             `protobuf:"varint,6,opt,name=expiryHours,json=expiry_hours,proto3" json:"expiryHours,omitempty"`
+	Scopes        []*InvitationScope `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Name  string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Email string         `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Role  ukama.RoleType `protobuf:"varint,5,opt,name=role,proto3,enum=ukama.common.v1.RoleType" json:"role,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddRequest) Reset() {
@@ -182,15 +183,352 @@ func (x *AddRequest) GetRole() ukama.RoleType {
 	return ukama.RoleType(0)
 }
 
-type GetAllRequest struct {
-	state         protoimpl.MessageState
+func (x *AddRequest) GetExpiryHours() uint32 {
+	if x != nil {
+		return x.ExpiryHours
+	}
+	return 0
+}
+
+func (x *AddRequest) GetScopes() []*InvitationScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// restricts the invited user to a role on a network or a site
+type InvitationScope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	ScopeKind     string                 `protobuf:"bytes,2,opt,name=scopeKind,json=scope_kind,proto3" json:"scopeKind,omitempty"`
+	ScopeId       string                 `protobuf:"bytes,3,opt,name=scopeId,json=scope_id,proto3" json:"scopeId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvitationScope) Reset() {
+	*x = InvitationScope{}
+	mi := &file_invitation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvitationScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationScope) ProtoMessage() {}
+
+func (x *InvitationScope) ProtoReflect() protoreflect.Message {
+	mi := &file_invitation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationScope.ProtoReflect.Descriptor instead.
+func (*InvitationScope) Descriptor() ([]byte, []int) {
+	return file_invitation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InvitationScope) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *InvitationScope) GetScopeKind() string {
+	if x != nil {
+		return x.ScopeKind
+	}
+	return ""
+}
+
+func (x *InvitationScope) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+// csv has a header row with the columns email, name, role, network_id,
+// site_id and expiry_hours. Only email is required.
+type AddBulkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Csv           string                 `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+	ExpiryHours   uint32                 `protobuf:"varint,2,opt,name=expiryHours,json=expiry_hours,proto3" json:"expiryHours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBulkRequest) Reset() {
+	*x = AddBulkRequest{}
+	mi := &file_invitation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBulkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBulkRequest) ProtoMessage() {}
+
+func (x *AddBulkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invitation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBulkRequest.ProtoReflect.Descriptor instead.
+func (*AddBulkRequest) Descriptor() ([]byte, []int) {
+	return file_invitation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddBulkRequest) GetCsv() string {
+	if x != nil {
+		return x.Csv
+	}
+	return ""
+}
+
+func (x *AddBulkRequest) GetExpiryHours() uint32 {
+	if x != nil {
+		return x.ExpiryHours
+	}
+	return 0
+}
+
+type BulkFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          uint32                 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkFailure) Reset() {
+	*x = BulkFailure{}
+	mi := &file_invitation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkFailure) ProtoMessage() {}
+
+func (x *BulkFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_invitation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkFailure.ProtoReflect.Descriptor instead.
+func (*BulkFailure) Descriptor() ([]byte, []int) {
+	return file_invitation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BulkFailure) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *BulkFailure) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BulkFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AddBulkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*Invitation          `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	Failures      []*BulkFailure         `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBulkResponse) Reset() {
+	*x = AddBulkResponse{}
+	mi := &file_invitation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBulkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBulkResponse) ProtoMessage() {}
+
+func (x *AddBulkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invitation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBulkResponse.ProtoReflect.Descriptor instead.
+func (*AddBulkResponse) Descriptor() ([]byte, []int) {
+	return file_invitation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AddBulkResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+func (x *AddBulkResponse) GetFailures() []*BulkFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type ResendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpiryHours   uint32                 `protobuf:"varint,2,opt,name=expiryHours,json=expiry_hours,proto3" json:"expiryHours,omitempty"`
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendRequest) Reset() {
+	*x = ResendRequest{}
+	mi := &file_invitation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendRequest) ProtoMessage() {}
+
+func (x *ResendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invitation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendRequest.ProtoReflect.Descriptor instead.
+func (*ResendRequest) Descriptor() ([]byte, []int) {
+	return file_invitation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResendRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResendRequest) GetExpiryHours() uint32 {
+	if x != nil {
+		return x.ExpiryHours
+	}
+	return 0
+}
+
+type ResendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendResponse) Reset() {
+	*x = ResendResponse{}
+	mi := &file_invitation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendResponse) ProtoMessage() {}
+
+func (x *ResendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invitation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendResponse.ProtoReflect.Descriptor instead.
+func (*ResendResponse) Descriptor() ([]byte, []int) {
+	return file_invitation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResendResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type GetAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAllRequest) Reset() {
 	*x = GetAllRequest{}
-	mi := &file_invitation_proto_msgTypes[3]
+	mi := &file_invitation_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -202,7 +540,7 @@ func (x *GetAllRequest) String() string {
 func (*GetAllRequest) ProtoMessage() {}
 
 func (x *GetAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invitation_proto_msgTypes[3]
+	mi := &file_invitation_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,20 +553,19 @@ func (x *GetAllRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllRequest.ProtoReflect.Descriptor instead.
 func (*GetAllRequest) Descriptor() ([]byte, []int) {
-	return file_invitation_proto_rawDescGZIP(), []int{3}
+	return file_invitation_proto_rawDescGZIP(), []int{9}
 }
 
 type GetAllResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*Invitation          `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Invitations []*Invitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAllResponse) Reset() {
 	*x = GetAllResponse{}
-	mi := &file_invitation_proto_msgTypes[4]
+	mi := &file_invitation_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -240,7 +577,7 @@ func (x *GetAllResponse) String() string {
 func (*GetAllResponse) ProtoMessage() {}
 
 func (x *GetAllResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invitation_proto_msgTypes[4]
+	mi := &file_invitation_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,7 +590,7 @@ func (x *GetAllResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllResponse.ProtoReflect.Descriptor instead.
 func (*GetAllResponse) Descriptor() ([]byte, []int) {
-	return file_invitation_proto_rawDescGZIP(), []int{4}
+	return file_invitation_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetAllResponse) GetInvitations() []*Invitation {
@@ -264,16 +601,15 @@ func (x *GetAllResponse) GetInvitations() []*Invitation {
 }
 
 type AddResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Invitation *Invitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddResponse) Reset() {
 	*x = AddResponse{}
-	mi := &file_invitation_proto_msgTypes[5]
+	mi := &file_invitation_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -285,7 +621,7 @@ func (x *AddResponse) String() string {
 func (*AddResponse) ProtoMessage() {}
 
 func (x *AddResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invitation_proto_msgTypes[5]
+	mi := &file_invitation_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +634,7 @@ func (x *AddResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddResponse.ProtoReflect.Descriptor instead.
 func (*AddResponse) Descriptor() ([]byte, []int) {
-	return file_invitation_proto_rawDescGZIP(), []int{5}
+	return file_invitation_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AddResponse) GetInvitation() *Invitation {
@@ -309,16 +645,15 @@ func (x *AddResponse) GetInvitation() *Invitation {
 }
 
 type GetRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetRequest) Reset() {
 	*x = GetRequest{}
-	mi := &file_invitation_proto_msgTypes[6]
+	mi := &file_invitation_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -330,7 +665,7 @@ func (x *GetRequest) String() string {
 func (*GetRequest) ProtoMessage() {}
 
 func (x *GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invitation_proto_msgTypes[6]
+	mi := &file_invitation_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +678,7 @@ func (x *GetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return file_invitation_proto_rawDescGZIP(), []int{6}
+	return file_invitation_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetRequest) GetId() string {
@@ -354,16 +689,15 @@ func (x *GetRequest) GetId() string {
 }
 
 type GetResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Invitation *Invitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetResponse) Reset() {
 	*x = GetResponse{}
-	mi := &file_invitation_proto_msgTypes[7]
+	mi := &file_invitation_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -375,7 +709,7 @@ func (x *GetResponse) String() string {
 func (*GetResponse) ProtoMessage() {}
 
 func (x *GetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invitation_proto_msgTypes[7]
+	mi := &file_invitation_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -388,7 +722,7 @@ func (x *GetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return file_invitation_proto_rawDescGZIP(), []int{7}
+	return file_invitation_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetResponse) GetInvitation() *Invitation {
@@ -399,16 +733,15 @@ func (x *GetResponse) GetInvitation() *Invitation {
 }
 
 type DeleteRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteRequest) Reset() {
 	*x = DeleteRequest{}
-	mi := &file_invitation_proto_msgTypes[8]
+	mi := &file_invitation_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -420,7 +753,7 @@ func (x *DeleteRequest) String() string {
 func (*DeleteRequest) ProtoMessage() {}
 
 func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invitation_proto_msgTypes[8]
+	mi := &file_invitation_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,7 +766,7 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_invitation_proto_rawDescGZIP(), []int{8}
+	return file_invitation_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteRequest) GetId() string {
@@ -444,16 +777,15 @@ func (x *DeleteRequest) GetId() string {
 }
 
 type DeleteResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteResponse) Reset() {
 	*x = DeleteResponse{}
-	mi := &file_invitation_proto_msgTypes[9]
+	mi := &file_invitation_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -465,7 +797,7 @@ func (x *DeleteResponse) String() string {
 func (*DeleteResponse) ProtoMessage() {}
 
 func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invitation_proto_msgTypes[9]
+	mi := &file_invitation_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +810,7 @@ func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_invitation_proto_rawDescGZIP(), []int{9}
+	return file_invitation_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeleteResponse) GetId() string {
@@ -489,18 +821,17 @@ func (x *DeleteResponse) GetId() string {
 }
 
 type UpdateStatusRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Status        ukama.InvitationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=ukama.common.v1.InvitationStatus" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email  string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Status ukama.InvitationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=ukama.common.v1.InvitationStatus" json:"status,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateStatusRequest) Reset() {
 	*x = UpdateStatusRequest{}
-	mi := &file_invitation_proto_msgTypes[10]
+	mi := &file_invitation_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -512,7 +843,7 @@ func (x *UpdateStatusRequest) String() string {
 func (*UpdateStatusRequest) ProtoMessage() {}
 
 func (x *UpdateStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_invitation_proto_msgTypes[10]
+	mi := &file_invitation_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +856,7 @@ func (x *UpdateStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateStatusRequest) Descriptor() ([]byte, []int) {
-	return file_invitation_proto_rawDescGZIP(), []int{10}
+	return file_invitation_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdateStatusRequest) GetId() string {
@@ -550,17 +881,16 @@ func (x *UpdateStatusRequest) GetStatus() ukama.InvitationStatus {
 }
 
 type UpdateStatusResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        ukama.InvitationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ukama.common.v1.InvitationStatus" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status ukama.InvitationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ukama.common.v1.InvitationStatus" json:"status,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateStatusResponse) Reset() {
 	*x = UpdateStatusResponse{}
-	mi := &file_invitation_proto_msgTypes[11]
+	mi := &file_invitation_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -572,7 +902,7 @@ func (x *UpdateStatusResponse) String() string {
 func (*UpdateStatusResponse) ProtoMessage() {}
 
 func (x *UpdateStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_invitation_proto_msgTypes[11]
+	mi := &file_invitation_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,7 +915,7 @@ func (x *UpdateStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdateStatusResponse) Descriptor() ([]byte, []int) {
-	return file_invitation_proto_rawDescGZIP(), []int{11}
+	return file_invitation_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UpdateStatusResponse) GetId() string {
@@ -603,23 +933,23 @@ func (x *UpdateStatusResponse) GetStatus() ukama.InvitationStatus {
 }
 
 type Invitation struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Link          string                 `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Role          ukama.RoleType         `protobuf:"varint,6,opt,name=role,proto3,enum=ukama.common.v1.RoleType" json:"role,omitempty"`
+	Status        ukama.InvitationStatus `protobuf:"varint,7,opt,name=status,proto3,enum=ukama.common.v1.InvitationStatus" json:"status,omitempty"`
+	UserId        string                 `protobuf:"bytes,8,opt,name=userId,json=user_id,proto3" json:"userId,omitempty"`
+	ExpireAt      string                 `protobuf:"bytes,9,opt,name=expireAt,json=expire_at,proto3" json:"expireAt,omitempty"`
+	Scopes        []*InvitationScope     `protobuf:"bytes,10,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Link     string                 `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
-	Name     string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Email    string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
-	Role     ukama.RoleType         `protobuf:"varint,6,opt,name=role,proto3,enum=ukama.common.v1.RoleType" json:"role,omitempty"`
-	Status   ukama.InvitationStatus `protobuf:"varint,7,opt,name=status,proto3,enum=ukama.common.v1.InvitationStatus" json:"status,omitempty"`
-	UserId   string                 `protobuf:"bytes,8,opt,name=userId,json=user_id,proto3" json:"userId,omitempty"`
-	ExpireAt string                 `protobuf:"bytes,9,opt,name=expireAt,json=expire_at,proto3" json:"expireAt,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Invitation) Reset() {
 	*x = Invitation{}
-	mi := &file_invitation_proto_msgTypes[12]
+	mi := &file_invitation_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -631,7 +961,7 @@ func (x *Invitation) String() string {
 func (*Invitation) ProtoMessage() {}
 
 func (x *Invitation) ProtoReflect() protoreflect.Message {
-	mi := &file_invitation_proto_msgTypes[12]
+	mi := &file_invitation_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,7 +974,7 @@ func (x *Invitation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
 func (*Invitation) Descriptor() ([]byte, []int) {
-	return file_invitation_proto_rawDescGZIP(), []int{12}
+	return file_invitation_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Invitation) GetId() string {
@@ -703,202 +1033,174 @@ func (x *Invitation) GetExpireAt() string {
 	return ""
 }
 
+func (x *Invitation) GetScopes() []*InvitationScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 var File_invitation_proto protoreflect.FileDescriptor
 
-var file_invitation_proto_rawDesc = []byte{
-	0x0a, 0x10, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x1c, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x1a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x11, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x77, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x79, 0x45, 0x6d, 0x61,
-	0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4c, 0xe2, 0xdf, 0x1f, 0x48, 0x0a, 0x2d,
-	0x5e, 0x24, 0x7c, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x25, 0x2b, 0x5c,
-	0x2d, 0x5d, 0x2b, 0x40, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x5c, 0x2d, 0x5d, 0x2b,
-	0x5c, 0x2e, 0x5b, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x2c, 0x34, 0x7d, 0x24, 0x2a, 0x17, 0x6d,
-	0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x5e, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e,
-	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x65, 0x0a,
-	0x0a, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x72, 0x6f, 0x6c, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75,
-	0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x22, 0x57, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x57, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61,
-	0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a,
-	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x19, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f,
-	0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x0e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01,
-	0x90, 0x01, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x19, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f,
-	0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x12, 0x41, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x21, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x61, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x75, 0x6b,
-	0x61, 0x6d, 0x61, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x85, 0x02, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x90, 0x01, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2d,
-	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x75,
-	0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x6f, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x39, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
-	0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x12, 0x1b, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x32, 0xfd,
-	0x04, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x03, 0x41, 0x64, 0x64, 0x12, 0x28, 0x2e, 0x75, 0x6b,
-	0x61, 0x6d, 0x61, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5a, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x28, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e,
-	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x29, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x0c,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x2e, 0x75,
-	0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x32, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
-	0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2b, 0x2e,
-	0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x69,
-	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x75, 0x6b, 0x61,
-	0x6d, 0x61, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x12, 0x2b, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x79, 0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2c, 0x2e, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
-	0x2e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2f, 0x2e, 0x75, 0x6b,
-	0x61, 0x6d, 0x61, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x75,
-	0x6b, 0x61, 0x6d, 0x61, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3b,
-	0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x6b, 0x61,
-	0x6d, 0x61, 0x2f, 0x75, 0x6b, 0x61, 0x6d, 0x61, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73,
-	0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x2f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
+const file_invitation_proto_rawDesc = "" +
+	"\n" +
+	"\x10invitation.proto\x12\x1cukama.registry.invitation.v1\x1a\x0fvalidator.proto\x1a\x11ukama/roles.proto\x1a\x1dukama/invitation-status.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"w\n" +
+	"\x11GetByEmailRequest\x12b\n" +
+	"\x05email\x18\x01 \x01(\tBL\xe2\xdf\x1fH\n" +
+	"-^$|^[a-z0-9._%+\\-]+@[a-z0-9.\\-]+\\.[a-z]{2,4}$*\x17must be an email formatR\x05email\"^\n" +
+	"\x12GetByEmailResponse\x12H\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2(.ukama.registry.invitation.v1.InvitationR\n" +
+	"invitation\"\xcf\x01\n" +
+	"\n" +
+	"AddRequest\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12-\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x19.ukama.common.v1.RoleTypeR\x04role\x12!\n" +
+	"\vexpiryHours\x18\x06 \x01(\rR\fexpiry_hours\x12E\n" +
+	"\x06scopes\x18\a \x03(\v2-.ukama.registry.invitation.v1.InvitationScopeR\x06scopes\"g\n" +
+	"\x0fInvitationScope\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12%\n" +
+	"\tscopeKind\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\n" +
+	"scope_kind\x12\x19\n" +
+	"\ascopeId\x18\x03 \x01(\tR\bscope_id\"M\n" +
+	"\x0eAddBulkRequest\x12\x18\n" +
+	"\x03csv\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x03csv\x12!\n" +
+	"\vexpiryHours\x18\x02 \x01(\rR\fexpiry_hours\"M\n" +
+	"\vBulkFailure\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\rR\x04line\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xa4\x01\n" +
+	"\x0fAddBulkResponse\x12J\n" +
+	"\vinvitations\x18\x01 \x03(\v2(.ukama.registry.invitation.v1.InvitationR\vinvitations\x12E\n" +
+	"\bfailures\x18\x02 \x03(\v2).ukama.registry.invitation.v1.BulkFailureR\bfailures\"M\n" +
+	"\rResendRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\x12!\n" +
+	"\vexpiryHours\x18\x02 \x01(\rR\fexpiry_hours\"Z\n" +
+	"\x0eResendResponse\x12H\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2(.ukama.registry.invitation.v1.InvitationR\n" +
+	"invitation\"\x0f\n" +
+	"\rGetAllRequest\"\\\n" +
+	"\x0eGetAllResponse\x12J\n" +
+	"\vinvitations\x18\x01 \x03(\v2(.ukama.registry.invitation.v1.InvitationR\vinvitations\"W\n" +
+	"\vAddResponse\x12H\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2(.ukama.registry.invitation.v1.InvitationR\n" +
+	"invitation\"'\n" +
+	"\n" +
+	"GetRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\"W\n" +
+	"\vGetResponse\x12H\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2(.ukama.registry.invitation.v1.InvitationR\n" +
+	"invitation\"*\n" +
+	"\rDeleteRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\"+\n" +
+	"\x0eDeleteResponse\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\"\x89\x01\n" +
+	"\x13UpdateStatusRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12A\n" +
+	"\x06status\x18\x03 \x01(\x0e2!.ukama.common.v1.InvitationStatusB\x06\xe2\xdf\x1f\x02X\x01R\x06status\"a\n" +
+	"\x14UpdateStatusResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\x06status\x18\x02 \x01(\x0e2!.ukama.common.v1.InvitationStatusR\x06status\"\xcc\x02\n" +
+	"\n" +
+	"Invitation\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\x12\x12\n" +
+	"\x04link\x18\x03 \x01(\tR\x04link\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12-\n" +
+	"\x04role\x18\x06 \x01(\x0e2\x19.ukama.common.v1.RoleTypeR\x04role\x129\n" +
+	"\x06status\x18\a \x01(\x0e2!.ukama.common.v1.InvitationStatusR\x06status\x12\x17\n" +
+	"\x06userId\x18\b \x01(\tR\auser_id\x12\x1b\n" +
+	"\bexpireAt\x18\t \x01(\tR\texpire_at\x12E\n" +
+	"\x06scopes\x18\n" +
+	" \x03(\v2-.ukama.registry.invitation.v1.InvitationScopeR\x06scopes2\xca\x06\n" +
+	"\x11InvitationService\x12Z\n" +
+	"\x03Add\x12(.ukama.registry.invitation.v1.AddRequest\x1a).ukama.registry.invitation.v1.AddResponse\x12Z\n" +
+	"\x03Get\x12(.ukama.registry.invitation.v1.GetRequest\x1a).ukama.registry.invitation.v1.GetResponse\x12u\n" +
+	"\fUpdateStatus\x121.ukama.registry.invitation.v1.UpdateStatusRequest\x1a2.ukama.registry.invitation.v1.UpdateStatusResponse\x12c\n" +
+	"\x06Delete\x12+.ukama.registry.invitation.v1.DeleteRequest\x1a,.ukama.registry.invitation.v1.DeleteResponse\x12c\n" +
+	"\x06GetAll\x12+.ukama.registry.invitation.v1.GetAllRequest\x1a,.ukama.registry.invitation.v1.GetAllResponse\x12o\n" +
+	"\n" +
+	"GetByEmail\x12/.ukama.registry.invitation.v1.GetByEmailRequest\x1a0.ukama.registry.invitation.v1.GetByEmailResponse\x12f\n" +
+	"\aAddBulk\x12,.ukama.registry.invitation.v1.AddBulkRequest\x1a-.ukama.registry.invitation.v1.AddBulkResponse\x12c\n" +
+	"\x06Resend\x12+.ukama.registry.invitation.v1.ResendRequest\x1a,.ukama.registry.invitation.v1.ResendResponseB;Z9github.com/ukama/ukama/systems/registry/invitation/pb/genb\x06proto3"
 
 var (
 	file_invitation_proto_rawDescOnce sync.Once
-	file_invitation_proto_rawDescData = file_invitation_proto_rawDesc
+	file_invitation_proto_rawDescData []byte
 )
 
 func file_invitation_proto_rawDescGZIP() []byte {
 	file_invitation_proto_rawDescOnce.Do(func() {
-		file_invitation_proto_rawDescData = protoimpl.X.CompressGZIP(file_invitation_proto_rawDescData)
+		file_invitation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_invitation_proto_rawDesc), len(file_invitation_proto_rawDesc)))
 	})
 	return file_invitation_proto_rawDescData
 }
 
-var file_invitation_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_invitation_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_invitation_proto_goTypes = []any{
 	(*GetByEmailRequest)(nil),    // 0: ukama.registry.invitation.v1.GetByEmailRequest
 	(*GetByEmailResponse)(nil),   // 1: ukama.registry.invitation.v1.GetByEmailResponse
 	(*AddRequest)(nil),           // 2: ukama.registry.invitation.v1.AddRequest
-	(*GetAllRequest)(nil),        // 3: ukama.registry.invitation.v1.GetAllRequest
-	(*GetAllResponse)(nil),       // 4: ukama.registry.invitation.v1.GetAllResponse
-	(*AddResponse)(nil),          // 5: ukama.registry.invitation.v1.AddResponse
-	(*GetRequest)(nil),           // 6: ukama.registry.invitation.v1.GetRequest
-	(*GetResponse)(nil),          // 7: ukama.registry.invitation.v1.GetResponse
-	(*DeleteRequest)(nil),        // 8: ukama.registry.invitation.v1.DeleteRequest
-	(*DeleteResponse)(nil),       // 9: ukama.registry.invitation.v1.DeleteResponse
-	(*UpdateStatusRequest)(nil),  // 10: ukama.registry.invitation.v1.UpdateStatusRequest
-	(*UpdateStatusResponse)(nil), // 11: ukama.registry.invitation.v1.UpdateStatusResponse
-	(*Invitation)(nil),           // 12: ukama.registry.invitation.v1.Invitation
-	(ukama.RoleType)(0),          // 13: ukama.common.v1.RoleType
-	(ukama.InvitationStatus)(0),  // 14: ukama.common.v1.InvitationStatus
+	(*InvitationScope)(nil),      // 3: ukama.registry.invitation.v1.InvitationScope
+	(*AddBulkRequest)(nil),       // 4: ukama.registry.invitation.v1.AddBulkRequest
+	(*BulkFailure)(nil),          // 5: ukama.registry.invitation.v1.BulkFailure
+	(*AddBulkResponse)(nil),      // 6: ukama.registry.invitation.v1.AddBulkResponse
+	(*ResendRequest)(nil),        // 7: ukama.registry.invitation.v1.ResendRequest
+	(*ResendResponse)(nil),       // 8: ukama.registry.invitation.v1.ResendResponse
+	(*GetAllRequest)(nil),        // 9: ukama.registry.invitation.v1.GetAllRequest
+	(*GetAllResponse)(nil),       // 10: ukama.registry.invitation.v1.GetAllResponse
+	(*AddResponse)(nil),          // 11: ukama.registry.invitation.v1.AddResponse
+	(*GetRequest)(nil),           // 12: ukama.registry.invitation.v1.GetRequest
+	(*GetResponse)(nil),          // 13: ukama.registry.invitation.v1.GetResponse
+	(*DeleteRequest)(nil),        // 14: ukama.registry.invitation.v1.DeleteRequest
+	(*DeleteResponse)(nil),       // 15: ukama.registry.invitation.v1.DeleteResponse
+	(*UpdateStatusRequest)(nil),  // 16: ukama.registry.invitation.v1.UpdateStatusRequest
+	(*UpdateStatusResponse)(nil), // 17: ukama.registry.invitation.v1.UpdateStatusResponse
+	(*Invitation)(nil),           // 18: ukama.registry.invitation.v1.Invitation
+	(ukama.RoleType)(0),          // 19: ukama.common.v1.RoleType
+	(ukama.InvitationStatus)(0),  // 20: ukama.common.v1.InvitationStatus
 }
 var file_invitation_proto_depIdxs = []int32{
-	12, // 0: ukama.registry.invitation.v1.GetByEmailResponse.invitation:type_name -> ukama.registry.invitation.v1.Invitation
-	13, // 1: ukama.registry.invitation.v1.AddRequest.role:type_name -> ukama.common.v1.RoleType
-	12, // 2: ukama.registry.invitation.v1.GetAllResponse.invitations:type_name -> ukama.registry.invitation.v1.Invitation
-	12, // 3: ukama.registry.invitation.v1.AddResponse.invitation:type_name -> ukama.registry.invitation.v1.Invitation
-	12, // 4: ukama.registry.invitation.v1.GetResponse.invitation:type_name -> ukama.registry.invitation.v1.Invitation
-	14, // 5: ukama.registry.invitation.v1.UpdateStatusRequest.status:type_name -> ukama.common.v1.InvitationStatus
-	14, // 6: ukama.registry.invitation.v1.UpdateStatusResponse.status:type_name -> ukama.common.v1.InvitationStatus
-	13, // 7: ukama.registry.invitation.v1.Invitation.role:type_name -> ukama.common.v1.RoleType
-	14, // 8: ukama.registry.invitation.v1.Invitation.status:type_name -> ukama.common.v1.InvitationStatus
-	2,  // 9: ukama.registry.invitation.v1.InvitationService.Add:input_type -> ukama.registry.invitation.v1.AddRequest
-	6,  // 10: ukama.registry.invitation.v1.InvitationService.Get:input_type -> ukama.registry.invitation.v1.GetRequest
-	10, // 11: ukama.registry.invitation.v1.InvitationService.UpdateStatus:input_type -> ukama.registry.invitation.v1.UpdateStatusRequest
-	8,  // 12: ukama.registry.invitation.v1.InvitationService.Delete:input_type -> ukama.registry.invitation.v1.DeleteRequest
-	3,  // 13: ukama.registry.invitation.v1.InvitationService.GetAll:input_type -> ukama.registry.invitation.v1.GetAllRequest
-	0,  // 14: ukama.registry.invitation.v1.InvitationService.GetByEmail:input_type -> ukama.registry.invitation.v1.GetByEmailRequest
-	5,  // 15: ukama.registry.invitation.v1.InvitationService.Add:output_type -> ukama.registry.invitation.v1.AddResponse
-	7,  // 16: ukama.registry.invitation.v1.InvitationService.Get:output_type -> ukama.registry.invitation.v1.GetResponse
-	11, // 17: ukama.registry.invitation.v1.InvitationService.UpdateStatus:output_type -> ukama.registry.invitation.v1.UpdateStatusResponse
-	9,  // 18: ukama.registry.invitation.v1.InvitationService.Delete:output_type -> ukama.registry.invitation.v1.DeleteResponse
-	4,  // 19: ukama.registry.invitation.v1.InvitationService.GetAll:output_type -> ukama.registry.invitation.v1.GetAllResponse
-	1,  // 20: ukama.registry.invitation.v1.InvitationService.GetByEmail:output_type -> ukama.registry.invitation.v1.GetByEmailResponse
-	15, // [15:21] is the sub-list for method output_type
-	9,  // [9:15] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	18, // 0: ukama.registry.invitation.v1.GetByEmailResponse.invitation:type_name -> ukama.registry.invitation.v1.Invitation
+	19, // 1: ukama.registry.invitation.v1.AddRequest.role:type_name -> ukama.common.v1.RoleType
+	3,  // 2: ukama.registry.invitation.v1.AddRequest.scopes:type_name -> ukama.registry.invitation.v1.InvitationScope
+	18, // 3: ukama.registry.invitation.v1.AddBulkResponse.invitations:type_name -> ukama.registry.invitation.v1.Invitation
+	5,  // 4: ukama.registry.invitation.v1.AddBulkResponse.failures:type_name -> ukama.registry.invitation.v1.BulkFailure
+	18, // 5: ukama.registry.invitation.v1.ResendResponse.invitation:type_name -> ukama.registry.invitation.v1.Invitation
+	18, // 6: ukama.registry.invitation.v1.GetAllResponse.invitations:type_name -> ukama.registry.invitation.v1.Invitation
+	18, // 7: ukama.registry.invitation.v1.AddResponse.invitation:type_name -> ukama.registry.invitation.v1.Invitation
+	18, // 8: ukama.registry.invitation.v1.GetResponse.invitation:type_name -> ukama.registry.invitation.v1.Invitation
+	20, // 9: ukama.registry.invitation.v1.UpdateStatusRequest.status:type_name -> ukama.common.v1.InvitationStatus
+	20, // 10: ukama.registry.invitation.v1.UpdateStatusResponse.status:type_name -> ukama.common.v1.InvitationStatus
+	19, // 11: ukama.registry.invitation.v1.Invitation.role:type_name -> ukama.common.v1.RoleType
+	20, // 12: ukama.registry.invitation.v1.Invitation.status:type_name -> ukama.common.v1.InvitationStatus
+	3,  // 13: ukama.registry.invitation.v1.Invitation.scopes:type_name -> ukama.registry.invitation.v1.InvitationScope
+	2,  // 14: ukama.registry.invitation.v1.InvitationService.Add:input_type -> ukama.registry.invitation.v1.AddRequest
+	12, // 15: ukama.registry.invitation.v1.InvitationService.Get:input_type -> ukama.registry.invitation.v1.GetRequest
+	16, // 16: ukama.registry.invitation.v1.InvitationService.UpdateStatus:input_type -> ukama.registry.invitation.v1.UpdateStatusRequest
+	14, // 17: ukama.registry.invitation.v1.InvitationService.Delete:input_type -> ukama.registry.invitation.v1.DeleteRequest
+	9,  // 18: ukama.registry.invitation.v1.InvitationService.GetAll:input_type -> ukama.registry.invitation.v1.GetAllRequest
+	0,  // 19: ukama.registry.invitation.v1.InvitationService.GetByEmail:input_type -> ukama.registry.invitation.v1.GetByEmailRequest
+	4,  // 20: ukama.registry.invitation.v1.InvitationService.AddBulk:input_type -> ukama.registry.invitation.v1.AddBulkRequest
+	7,  // 21: ukama.registry.invitation.v1.InvitationService.Resend:input_type -> ukama.registry.invitation.v1.ResendRequest
+	11, // 22: ukama.registry.invitation.v1.InvitationService.Add:output_type -> ukama.registry.invitation.v1.AddResponse
+	13, // 23: ukama.registry.invitation.v1.InvitationService.Get:output_type -> ukama.registry.invitation.v1.GetResponse
+	17, // 24: ukama.registry.invitation.v1.InvitationService.UpdateStatus:output_type -> ukama.registry.invitation.v1.UpdateStatusResponse
+	15, // 25: ukama.registry.invitation.v1.InvitationService.Delete:output_type -> ukama.registry.invitation.v1.DeleteResponse
+	10, // 26: ukama.registry.invitation.v1.InvitationService.GetAll:output_type -> ukama.registry.invitation.v1.GetAllResponse
+	1,  // 27: ukama.registry.invitation.v1.InvitationService.GetByEmail:output_type -> ukama.registry.invitation.v1.GetByEmailResponse
+	6,  // 28: ukama.registry.invitation.v1.InvitationService.AddBulk:output_type -> ukama.registry.invitation.v1.AddBulkResponse
+	8,  // 29: ukama.registry.invitation.v1.InvitationService.Resend:output_type -> ukama.registry.invitation.v1.ResendResponse
+	22, // [22:30] is the sub-list for method output_type
+	14, // [14:22] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_invitation_proto_init() }
@@ -910,9 +1212,9 @@ func file_invitation_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_invitation_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_invitation_proto_rawDesc), len(file_invitation_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -921,7 +1223,6 @@ func file_invitation_proto_init() {
 		MessageInfos:      file_invitation_proto_msgTypes,
 	}.Build()
 	File_invitation_proto = out.File
-	file_invitation_proto_rawDesc = nil
 	file_invitation_proto_goTypes = nil
 	file_invitation_proto_depIdxs = nil
 }
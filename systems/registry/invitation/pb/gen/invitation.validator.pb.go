@@ -7,10 +7,10 @@ import (
 	fmt "fmt"
 	math "math"
 	proto "github.com/golang/protobuf/proto"
+	_ "github.com/mwitkow/go-proto-validators"
 	_ "github.com/ukama/ukama/systems/common/pb/gen/ukama"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	_ "google.golang.org/protobuf/types/known/wrapperspb"
-	_ "github.com/mwitkow/go-proto-validators"
 	regexp "regexp"
 	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
 )
@@ -37,6 +37,65 @@ func (this *GetByEmailResponse) Validate() error {
 	return nil
 }
 func (this *AddRequest) Validate() error {
+	for _, item := range this.Scopes {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Scopes", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *InvitationScope) Validate() error {
+	if this.ScopeKind == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("ScopeKind", fmt.Errorf(`value '%v' must not be an empty string`, this.ScopeKind))
+	}
+	return nil
+}
+func (this *AddBulkRequest) Validate() error {
+	if this.Csv == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Csv", fmt.Errorf(`value '%v' must not be an empty string`, this.Csv))
+	}
+	return nil
+}
+func (this *BulkFailure) Validate() error {
+	return nil
+}
+func (this *AddBulkResponse) Validate() error {
+	for _, item := range this.Invitations {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Invitations", err)
+			}
+		}
+	}
+	for _, item := range this.Failures {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Failures", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_ResendRequest_Id = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *ResendRequest) Validate() error {
+	if !_regex_ResendRequest_Id.MatchString(this.Id) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.Id))
+	}
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	return nil
+}
+func (this *ResendResponse) Validate() error {
+	if this.Invitation != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Invitation); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Invitation", err)
+		}
+	}
 	return nil
 }
 func (this *GetAllRequest) Validate() error {
@@ -129,5 +188,12 @@ func (this *Invitation) Validate() error {
 	if this.Id == "" {
 		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
 	}
+	for _, item := range this.Scopes {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Scopes", err)
+			}
+		}
+	}
 	return nil
 }
@@ -8,7 +8,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
-// - protoc             v5.28.3
+// - protoc             v6.32.1
 // source: invitation.proto
 
 package gen
@@ -32,6 +32,8 @@ const (
 	InvitationService_Delete_FullMethodName       = "/ukama.registry.invitation.v1.InvitationService/Delete"
 	InvitationService_GetAll_FullMethodName       = "/ukama.registry.invitation.v1.InvitationService/GetAll"
 	InvitationService_GetByEmail_FullMethodName   = "/ukama.registry.invitation.v1.InvitationService/GetByEmail"
+	InvitationService_AddBulk_FullMethodName      = "/ukama.registry.invitation.v1.InvitationService/AddBulk"
+	InvitationService_Resend_FullMethodName       = "/ukama.registry.invitation.v1.InvitationService/Resend"
 )
 
 // InvitationServiceClient is the client API for InvitationService service.
@@ -45,6 +47,8 @@ type InvitationServiceClient interface {
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
 	GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*GetAllResponse, error)
 	GetByEmail(ctx context.Context, in *GetByEmailRequest, opts ...grpc.CallOption) (*GetByEmailResponse, error)
+	AddBulk(ctx context.Context, in *AddBulkRequest, opts ...grpc.CallOption) (*AddBulkResponse, error)
+	Resend(ctx context.Context, in *ResendRequest, opts ...grpc.CallOption) (*ResendResponse, error)
 }
 
 type invitationServiceClient struct {
@@ -115,6 +119,26 @@ func (c *invitationServiceClient) GetByEmail(ctx context.Context, in *GetByEmail
 	return out, nil
 }
 
+func (c *invitationServiceClient) AddBulk(ctx context.Context, in *AddBulkRequest, opts ...grpc.CallOption) (*AddBulkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBulkResponse)
+	err := c.cc.Invoke(ctx, InvitationService_AddBulk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invitationServiceClient) Resend(ctx context.Context, in *ResendRequest, opts ...grpc.CallOption) (*ResendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResendResponse)
+	err := c.cc.Invoke(ctx, InvitationService_Resend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InvitationServiceServer is the server API for InvitationService service.
 // All implementations must embed UnimplementedInvitationServiceServer
 // for forward compatibility.
@@ -126,6 +150,8 @@ type InvitationServiceServer interface {
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
 	GetAll(context.Context, *GetAllRequest) (*GetAllResponse, error)
 	GetByEmail(context.Context, *GetByEmailRequest) (*GetByEmailResponse, error)
+	AddBulk(context.Context, *AddBulkRequest) (*AddBulkResponse, error)
+	Resend(context.Context, *ResendRequest) (*ResendResponse, error)
 	mustEmbedUnimplementedInvitationServiceServer()
 }
 
@@ -154,6 +180,12 @@ func (UnimplementedInvitationServiceServer) GetAll(context.Context, *GetAllReque
 func (UnimplementedInvitationServiceServer) GetByEmail(context.Context, *GetByEmailRequest) (*GetByEmailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByEmail not implemented")
 }
+func (UnimplementedInvitationServiceServer) AddBulk(context.Context, *AddBulkRequest) (*AddBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBulk not implemented")
+}
+func (UnimplementedInvitationServiceServer) Resend(context.Context, *ResendRequest) (*ResendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resend not implemented")
+}
 func (UnimplementedInvitationServiceServer) mustEmbedUnimplementedInvitationServiceServer() {}
 func (UnimplementedInvitationServiceServer) testEmbeddedByValue()                           {}
 
@@ -283,6 +315,42 @@ func _InvitationService_GetByEmail_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InvitationService_AddBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvitationServiceServer).AddBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvitationService_AddBulk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvitationServiceServer).AddBulk(ctx, req.(*AddBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvitationService_Resend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvitationServiceServer).Resend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvitationService_Resend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvitationServiceServer).Resend(ctx, req.(*ResendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InvitationService_ServiceDesc is the grpc.ServiceDesc for InvitationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -314,6 +382,14 @@ var InvitationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByEmail",
 			Handler:    _InvitationService_GetByEmail_Handler,
 		},
+		{
+			MethodName: "AddBulk",
+			Handler:    _InvitationService_AddBulk_Handler,
+		},
+		{
+			MethodName: "Resend",
+			Handler:    _InvitationService_Resend_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "invitation.proto",
@@ -53,6 +53,43 @@ func (_m *InvitationServiceClient) Add(ctx context.Context, in *gen.AddRequest,
 	return r0, r1
 }
 
+// AddBulk provides a mock function with given fields: ctx, in, opts
+func (_m *InvitationServiceClient) AddBulk(ctx context.Context, in *gen.AddBulkRequest, opts ...grpc.CallOption) (*gen.AddBulkResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBulk")
+	}
+
+	var r0 *gen.AddBulkResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddBulkRequest, ...grpc.CallOption) (*gen.AddBulkResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddBulkRequest, ...grpc.CallOption) *gen.AddBulkResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.AddBulkResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AddBulkRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: ctx, in, opts
 func (_m *InvitationServiceClient) Delete(ctx context.Context, in *gen.DeleteRequest, opts ...grpc.CallOption) (*gen.DeleteResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -201,6 +238,43 @@ func (_m *InvitationServiceClient) GetByEmail(ctx context.Context, in *gen.GetBy
 	return r0, r1
 }
 
+// Resend provides a mock function with given fields: ctx, in, opts
+func (_m *InvitationServiceClient) Resend(ctx context.Context, in *gen.ResendRequest, opts ...grpc.CallOption) (*gen.ResendResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resend")
+	}
+
+	var r0 *gen.ResendResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ResendRequest, ...grpc.CallOption) (*gen.ResendResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ResendRequest, ...grpc.CallOption) *gen.ResendResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ResendResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ResendRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateStatus provides a mock function with given fields: ctx, in, opts
 func (_m *InvitationServiceClient) UpdateStatus(ctx context.Context, in *gen.UpdateStatusRequest, opts ...grpc.CallOption) (*gen.UpdateStatusResponse, error) {
 	_va := make([]interface{}, len(opts))
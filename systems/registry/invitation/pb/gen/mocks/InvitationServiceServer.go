@@ -44,6 +44,36 @@ func (_m *InvitationServiceServer) Add(_a0 context.Context, _a1 *gen.AddRequest)
 	return r0, r1
 }
 
+// AddBulk provides a mock function with given fields: _a0, _a1
+func (_m *InvitationServiceServer) AddBulk(_a0 context.Context, _a1 *gen.AddBulkRequest) (*gen.AddBulkResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBulk")
+	}
+
+	var r0 *gen.AddBulkResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddBulkRequest) (*gen.AddBulkResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddBulkRequest) *gen.AddBulkResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.AddBulkResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AddBulkRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: _a0, _a1
 func (_m *InvitationServiceServer) Delete(_a0 context.Context, _a1 *gen.DeleteRequest) (*gen.DeleteResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -164,6 +194,36 @@ func (_m *InvitationServiceServer) GetByEmail(_a0 context.Context, _a1 *gen.GetB
 	return r0, r1
 }
 
+// Resend provides a mock function with given fields: _a0, _a1
+func (_m *InvitationServiceServer) Resend(_a0 context.Context, _a1 *gen.ResendRequest) (*gen.ResendResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resend")
+	}
+
+	var r0 *gen.ResendResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ResendRequest) (*gen.ResendResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ResendRequest) *gen.ResendResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ResendResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ResendRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateStatus provides a mock function with given fields: _a0, _a1
 func (_m *InvitationServiceServer) UpdateStatus(_a0 context.Context, _a1 *gen.UpdateStatusRequest) (*gen.UpdateStatusResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -23,6 +23,7 @@ type Config struct {
 	MsgClient            *uconf.MsgClient `default:"{}"`
 	AuthLoginbaseURL     string           `default:"http://localhost:4455/auth/login"`
 	InvitationExpiryTime uint             `default:"24"`
+	ExpirySweepInterval  time.Duration    `default:"10m"`
 	OrgName              string
 	Service              *uconf.Service
 	Http                 HttpServices
@@ -9,6 +9,9 @@
 package db
 
 import (
+	"time"
+
+	"github.com/ukama/ukama/systems/common/pb/gen/ukama"
 	"github.com/ukama/ukama/systems/common/sql"
 	"github.com/ukama/ukama/systems/common/uuid"
 	"gorm.io/gorm"
@@ -22,6 +25,10 @@ type InvitationRepo interface {
 	UpdateUserId(id uuid.UUID, userId uuid.UUID) error
 	Delete(id uuid.UUID, nestedFunc func(string, string) error) error
 	GetByEmail(email string) (*Invitation, error)
+	Renew(id uuid.UUID, link string, expiresAt time.Time) error
+	// Expire marks pending invitations that expired before the given time
+	// as expired and returns them.
+	Expire(before time.Time) ([]*Invitation, error)
 }
 
 type invitationRepo struct {
@@ -122,3 +129,49 @@ func (r *invitationRepo) UpdateUserId(id uuid.UUID, userId uuid.UUID) error {
 
 	return err
 }
+
+func (r *invitationRepo) Renew(id uuid.UUID, link string, expiresAt time.Time) error {
+	result := r.Db.GetGormDb().Model(&Invitation{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"link":       link,
+		"expires_at": expiresAt,
+		"status":     ukama.InvitationStatus_INVITE_PENDING,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *invitationRepo) Expire(before time.Time) ([]*Invitation, error) {
+	var invitations []*Invitation
+
+	err := r.Db.GetGormDb().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("status = ? AND expires_at < ?", ukama.InvitationStatus_INVITE_PENDING, before).
+			Find(&invitations).Error; err != nil {
+			return err
+		}
+
+		if len(invitations) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(invitations))
+		for i, inv := range invitations {
+			ids[i] = inv.Id
+			inv.Status = ukama.InvitationStatus_INVITE_EXPIRED
+		}
+
+		return tx.Model(&Invitation{}).Where("id IN ?", ids).
+			Update("status", ukama.InvitationStatus_INVITE_EXPIRED).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return invitations, nil
+}
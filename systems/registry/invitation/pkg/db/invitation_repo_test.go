@@ -173,7 +173,8 @@ func TestInvitationRepo_AddInvitation(t *testing.T) {
 
 		mock.ExpectExec(regexp.QuoteMeta(insertQueryPattern)).
 			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
-				sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+				sqlmock.AnyArg()).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
 		mock.ExpectCommit()
@@ -203,7 +204,8 @@ func TestInvitationRepo_AddInvitation(t *testing.T) {
 
 		mock.ExpectExec(regexp.QuoteMeta(insertQueryPattern)).
 			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
-				sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+				sqlmock.AnyArg()).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
 		mock.ExpectCommit()
@@ -250,7 +252,8 @@ func TestInvitationRepo_AddInvitation(t *testing.T) {
 
 		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "invitations"`)).
 			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
-				sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+				sqlmock.AnyArg()).
 			WillReturnError(expectedError)
 
 		mock.ExpectRollback()
@@ -766,3 +769,109 @@ func TestInvitationRepo_UpdateUserId(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestInvitationRepo_Renew(t *testing.T) {
+	t.Run("RenewSuccess", func(t *testing.T) {
+		// Arrange
+		invitation := createDefaultTestInvitation()
+		mock, _, r := setupTestDB(t)
+		expiry := time.Now().Add(time.Hour * 48)
+
+		mock.ExpectBegin()
+
+		mock.ExpectExec(regexp.QuoteMeta(updateQueryPattern)).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), invitation.Id).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectCommit()
+
+		// Act
+		err := r.Renew(invitation.Id, testLinkBase+"renewed", expiry)
+
+		// Assert
+		assert.NoError(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err)
+	})
+
+	t.Run("RenewNotFound", func(t *testing.T) {
+		// Arrange
+		mock, _, r := setupTestDB(t)
+
+		mock.ExpectBegin()
+
+		mock.ExpectExec(regexp.QuoteMeta(updateQueryPattern)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		mock.ExpectCommit()
+
+		// Act
+		err := r.Renew(uuid.NewV4(), testLinkBase+"renewed", time.Now())
+
+		// Assert
+		assert.Equal(t, gorm.ErrRecordNotFound, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err)
+	})
+}
+
+func TestInvitationRepo_Expire(t *testing.T) {
+	t.Run("ExpireSuccess", func(t *testing.T) {
+		// Arrange
+		invitation := createDefaultTestInvitation()
+		mock, _, r := setupTestDB(t)
+		now := time.Now()
+
+		rows := sqlmock.NewRows(dbColumns).
+			AddRow(invitation.Id, invitation.Name, invitation.Email, invitation.Role, invitation.Status,
+				invitation.UserId, invitation.ExpiresAt, invitation.Link, invitation.CreatedAt, invitation.UpdatedAt, invitation.DeletedAt)
+
+		mock.ExpectBegin()
+
+		mock.ExpectQuery(selectQueryPattern).
+			WithArgs(int32(ukama.InvitationStatus_INVITE_PENDING), now).
+			WillReturnRows(rows)
+
+		mock.ExpectExec(regexp.QuoteMeta(updateQueryPattern)).
+			WithArgs(int32(ukama.InvitationStatus_INVITE_EXPIRED), sqlmock.AnyArg(), invitation.Id).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectCommit()
+
+		// Act
+		expired, err := r.Expire(now)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, expired, 1)
+		assert.Equal(t, invitation.Id, expired[0].Id)
+		assert.Equal(t, ukama.InvitationStatus_INVITE_EXPIRED, expired[0].Status)
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err)
+	})
+
+	t.Run("ExpireNothingPending", func(t *testing.T) {
+		// Arrange
+		mock, _, r := setupTestDB(t)
+
+		mock.ExpectBegin()
+
+		mock.ExpectQuery(selectQueryPattern).
+			WillReturnRows(sqlmock.NewRows(dbColumns))
+
+		mock.ExpectCommit()
+
+		// Act
+		expired, err := r.Expire(time.Now())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, expired)
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err)
+	})
+}
@@ -28,5 +28,15 @@ type Invitation struct {
 	Role      roles.RoleType         `gorm:"type:uint;not null;default:4"`
 	Status    ukama.InvitationStatus `gorm:"type:uint;not null;default:0"`
 	UserId    string                 `gorm:"type:uuid"`
+	Scopes    []InvitationScope      `gorm:"serializer:json"`
 	DeletedAt gorm.DeletedAt         `gorm:"index"`
 }
+
+// InvitationScope is a role the invited user is bound to on a network or a
+// site once the invitation is accepted. Scoped invitations don't grant their
+// role on the whole org.
+type InvitationScope struct {
+	Role      string `json:"role"`
+	ScopeKind string `json:"scope_kind"`
+	ScopeId   string `json:"scope_id,omitempty"`
+}
@@ -0,0 +1,131 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ukama/ukama/systems/common/roles"
+
+	upb "github.com/ukama/ukama/systems/common/pb/gen/ukama"
+	pb "github.com/ukama/ukama/systems/registry/invitation/pb/gen"
+)
+
+const (
+	maxBulkInvitations       = 500
+	maxInvitationExpiryHours = 30 * 24
+)
+
+const (
+	csvEmail       = "email"
+	csvName        = "name"
+	csvRole        = "role"
+	csvNetworkId   = "network_id"
+	csvSiteId      = "site_id"
+	csvExpiryHours = "expiry_hours"
+)
+
+// csvInvitation is a row of a bulk invite. Rows that can't be parsed keep
+// the error so that they are reported with the rest of the failures.
+type csvInvitation struct {
+	line int
+	req  *pb.AddRequest
+	err  error
+}
+
+func parseInvitationsCsv(data string) ([]csvInvitation, error) {
+	r := csv.NewReader(strings.NewReader(data))
+	r.TrimLeadingSpace = true
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	cols := map[string]int{}
+	for i, h := range header {
+		cols[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+
+	if _, ok := cols[csvEmail]; !ok {
+		return nil, errors.New("header has no email column")
+	}
+
+	rows := []csvInvitation{}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		line, _ := r.FieldPos(0)
+
+		field := func(name string) string {
+			if i, ok := cols[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		req := &pb.AddRequest{
+			Email: strings.ToLower(field(csvEmail)),
+			Name:  field(csvName),
+		}
+		row := csvInvitation{line: line, req: req}
+
+		req.Role, row.err = parseRole(field(csvRole))
+
+		if id := field(csvNetworkId); id != "" {
+			req.Scopes = append(req.Scopes, &pb.InvitationScope{ScopeKind: string(roles.ScopeNetwork), ScopeId: id})
+		}
+
+		if id := field(csvSiteId); id != "" {
+			req.Scopes = append(req.Scopes, &pb.InvitationScope{ScopeKind: string(roles.ScopeSite), ScopeId: id})
+		}
+
+		if h := field(csvExpiryHours); h != "" && row.err == nil {
+			hours, err := strconv.ParseUint(h, 10, 32)
+			if err != nil {
+				row.err = fmt.Errorf("invalid expiry hours %q", h)
+			}
+			req.ExpiryHours = uint32(hours)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// parseRole accepts the proto name of a role, such as ROLE_ADMIN, or the
+// name role bindings use, such as admin. Invitees are users by default.
+func parseRole(s string) (upb.RoleType, error) {
+	if s == "" {
+		return upb.RoleType_ROLE_USER, nil
+	}
+
+	if r, ok := upb.RoleType_value[strings.ToUpper(s)]; ok {
+		return upb.RoleType(r), nil
+	}
+
+	if r, ok := roles.BuiltinRole(strings.ToLower(s)); ok {
+		return upb.RoleType(r), nil
+	}
+
+	return upb.RoleType_ROLE_INVALID, fmt.Errorf("invalid role %q", s)
+}
@@ -63,25 +63,180 @@ func NewInvitationServer(iRepo db.InvitationRepo, invitationExpiryTime uint, aut
 
 func (i *InvitationServer) Add(ctx context.Context, req *pb.AddRequest) (*pb.AddResponse, error) {
 	log.Infof("Adding invitation %v", req)
-	invitationId := uuid.NewV4()
 
 	if i.orgName == "" || req.GetEmail() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "OrgName, Email, and Name are required")
 	}
 
-	expiry := time.Now().Add(time.Hour * time.Duration(i.invitationExpiryTime))
+	orgInfo, orgOwnerInfo, err := i.getOrgAndOwner()
+	if err != nil {
+		return nil, err
+	}
+
+	invite, err := i.addInvitation(req, orgInfo.Name, orgOwnerInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddResponse{
+		Invitation: dbInvitationToPbInvitation(invite),
+	}, nil
+}
+
+func (i *InvitationServer) AddBulk(ctx context.Context, req *pb.AddBulkRequest) (*pb.AddBulkResponse, error) {
+	log.Infof("Adding invitations in bulk")
+
+	if i.orgName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "OrgName is required")
+	}
+
+	rows, err := parseInvitationsCsv(req.GetCsv())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitations csv. Error %s", err.Error())
+	}
+
+	if len(rows) > maxBulkInvitations {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"too many invitations, at most %d can be added at once", maxBulkInvitations)
+	}
+
+	orgInfo, orgOwnerInfo, err := i.getOrgAndOwner()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.AddBulkResponse{
+		Invitations: []*pb.Invitation{},
+		Failures:    []*pb.BulkFailure{},
+	}
+
+	for _, row := range rows {
+		if row.err == nil && row.req.ExpiryHours == 0 {
+			row.req.ExpiryHours = req.GetExpiryHours()
+		}
+
+		var invite *db.Invitation
+		err := row.err
+		if err == nil {
+			invite, err = i.addInvitation(row.req, orgInfo.Name, orgOwnerInfo.Name)
+		}
+
+		if err != nil {
+			log.Warnf("Failed to add invitation on line %d of csv. Error %s", row.line, err.Error())
+			resp.Failures = append(resp.Failures, &pb.BulkFailure{
+				Line:  uint32(row.line),
+				Email: row.req.GetEmail(),
+				Error: err.Error(),
+			})
+
+			continue
+		}
+
+		resp.Invitations = append(resp.Invitations, dbInvitationToPbInvitation(invite))
+	}
+
+	return resp, nil
+}
+
+func (i *InvitationServer) Resend(ctx context.Context, req *pb.ResendRequest) (*pb.ResendResponse, error) {
+	log.Infof("Resending invitation %v", req)
+
+	iuuid, err := uuid.FromString(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid format of invitation uuid. Error %s", err.Error())
+	}
+
+	invite, err := i.iRepo.Get(iuuid)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "invitation")
+	}
+
+	if invite.Status == upb.InvitationStatus_INVITE_ACCEPTED {
+		return nil, status.Errorf(codes.FailedPrecondition, "invitation is already accepted")
+	}
+
+	expiry, err := i.expiryTime(req.GetExpiryHours())
+	if err != nil {
+		return nil, err
+	}
 
 	link, err := generateInvitationLink(i.authLoginbaseURL, uuid.NewV4().String(), expiry)
 	if err != nil {
 		return nil, err
 	}
 
-	orgInfo, err := i.orgClient.Get(i.orgName)
+	orgInfo, orgOwnerInfo, err := i.getOrgAndOwner()
 	if err != nil {
 		return nil, err
 	}
 
-	orgOwnerInfo, err := i.userClient.GetById(orgInfo.Owner)
+	err = i.iRepo.Renew(iuuid, link, expiry)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "invitation")
+	}
+
+	invite.Link = link
+	invite.ExpiresAt = expiry
+	invite.Status = upb.InvitationStatus_INVITE_PENDING
+
+	/* the mailer sends the invitation again on create */
+	i.publishInvitationCreated(invite, orgInfo.Name, orgOwnerInfo.Name)
+
+	return &pb.ResendResponse{
+		Invitation: dbInvitationToPbInvitation(invite),
+	}, nil
+}
+
+// ExpireInvitations marks the pending invitations past their expiry as
+// expired and publishes an event for each of them.
+func (i *InvitationServer) ExpireInvitations() error {
+	invitations, err := i.iRepo.Expire(time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, invite := range invitations {
+		log.Infof("Invitation %s for %s expired", invite.Id, invite.Email)
+
+		if i.msgbus == nil {
+			continue
+		}
+
+		route := i.baseRoutingKey.SetAction("expire").SetObject("invitation").MustBuild()
+		evt := &epb.EventInvitationExpired{
+			Id:        invite.Id.String(),
+			Email:     invite.Email,
+			Name:      invite.Name,
+			Role:      upb.RoleType(invite.Role),
+			UserId:    invite.UserId,
+			ExpiresAt: invite.ExpiresAt.String(),
+		}
+		err = i.msgbus.PublishRequest(route, evt)
+		if err != nil {
+			log.Errorf("Failed to publish message %+v with key %+v. Errors %s", evt, route, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (i *InvitationServer) addInvitation(req *pb.AddRequest, orgName, ownerName string) (*db.Invitation, error) {
+	if req.GetEmail() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Email is required")
+	}
+
+	scopes, err := pbScopesToDbScopes(req.GetRole(), req.GetScopes())
+	if err != nil {
+		return nil, err
+	}
+
+	expiry, err := i.expiryTime(req.GetExpiryHours())
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := generateInvitationLink(i.authLoginbaseURL, uuid.NewV4().String(), expiry)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +252,7 @@ func (i *InvitationServer) Add(ctx context.Context, req *pb.AddRequest) (*pb.Add
 	}
 
 	invite := &db.Invitation{
-		Id:        invitationId,
+		Id:        uuid.NewV4(),
 		Name:      req.GetName(),
 		Link:      link,
 		Email:     strings.ToLower(req.GetEmail()),
@@ -105,6 +260,7 @@ func (i *InvitationServer) Add(ctx context.Context, req *pb.AddRequest) (*pb.Add
 		ExpiresAt: expiry,
 		Status:    upb.InvitationStatus_INVITE_PENDING,
 		UserId:    userId,
+		Scopes:    scopes,
 	}
 
 	err = i.iRepo.Add(invite, func(*db.Invitation, *gorm.DB) error {
@@ -115,29 +271,60 @@ func (i *InvitationServer) Add(ctx context.Context, req *pb.AddRequest) (*pb.Add
 		return nil, grpc.SqlErrorToGrpc(err, "invitation")
 	}
 
-	if i.msgbus != nil {
-		route := i.baseRoutingKey.SetActionCreate().SetObject("invitation").MustBuild()
-		evt := &epb.EventInvitationCreated{
-			Id:        invite.Id.String(),
-			Link:      invite.Link,
-			Email:     strings.ToLower(req.GetEmail()),
-			Name:      invite.Name,
-			Role:      upb.RoleType(invite.Role),
-			Status:    upb.InvitationStatus(invite.Status),
-			UserId:    invite.UserId,
-			ExpiresAt: invite.ExpiresAt.String(),
-			OrgName:   orgInfo.Name,
-			OwnerName: orgOwnerInfo.Name,
-		}
-		err = i.msgbus.PublishRequest(route, evt)
-		if err != nil {
-			log.Errorf("Failed to publish message %+v with key %+v. Errors %s", evt, route, err.Error())
-		}
+	i.publishInvitationCreated(invite, orgName, ownerName)
+
+	return invite, nil
+}
+
+func (i *InvitationServer) publishInvitationCreated(invite *db.Invitation, orgName, ownerName string) {
+	if i.msgbus == nil {
+		return
 	}
 
-	return &pb.AddResponse{
-		Invitation: dbInvitationToPbInvitation(invite),
-	}, nil
+	route := i.baseRoutingKey.SetActionCreate().SetObject("invitation").MustBuild()
+	evt := &epb.EventInvitationCreated{
+		Id:        invite.Id.String(),
+		Link:      invite.Link,
+		Email:     invite.Email,
+		Name:      invite.Name,
+		Role:      upb.RoleType(invite.Role),
+		Status:    upb.InvitationStatus(invite.Status),
+		UserId:    invite.UserId,
+		ExpiresAt: invite.ExpiresAt.String(),
+		OrgName:   orgName,
+		OwnerName: ownerName,
+	}
+	err := i.msgbus.PublishRequest(route, evt)
+	if err != nil {
+		log.Errorf("Failed to publish message %+v with key %+v. Errors %s", evt, route, err.Error())
+	}
+}
+
+func (i *InvitationServer) getOrgAndOwner() (*cnucl.OrgInfo, *cnucl.UserInfo, error) {
+	orgInfo, err := i.orgClient.Get(i.orgName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	orgOwnerInfo, err := i.userClient.GetById(orgInfo.Owner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return orgInfo, orgOwnerInfo, nil
+}
+
+func (i *InvitationServer) expiryTime(hours uint32) (time.Time, error) {
+	if hours == 0 {
+		return time.Now().Add(time.Hour * time.Duration(i.invitationExpiryTime)), nil
+	}
+
+	if hours > maxInvitationExpiryHours {
+		return time.Time{}, status.Errorf(codes.InvalidArgument,
+			"invitation expiry can't be more than %d hours", maxInvitationExpiryHours)
+	}
+
+	return time.Now().Add(time.Hour * time.Duration(hours)), nil
 }
 
 func (i *InvitationServer) Delete(ctx context.Context, req *pb.DeleteRequest) (*pb.DeleteResponse, error) {
@@ -199,6 +386,17 @@ func (i *InvitationServer) UpdateStatus(ctx context.Context, req *pb.UpdateStatu
 			"invalid format of invitation uuid. Error %s", err.Error())
 	}
 
+	if req.GetStatus() == upb.InvitationStatus_INVITE_ACCEPTED {
+		invite, err := i.iRepo.Get(iuuid)
+		if err != nil {
+			return nil, grpc.SqlErrorToGrpc(err, "invitation")
+		}
+
+		if invite.Status == upb.InvitationStatus_INVITE_EXPIRED || time.Now().After(invite.ExpiresAt) {
+			return nil, status.Errorf(codes.FailedPrecondition, "invitation has expired")
+		}
+	}
+
 	err = i.iRepo.UpdateUserId(iuuid, uuuid)
 	if err != nil {
 		return nil, grpc.SqlErrorToGrpc(err, "invitation")
@@ -226,6 +424,7 @@ func (i *InvitationServer) UpdateStatus(ctx context.Context, req *pb.UpdateStatu
 			Status:    upb.InvitationStatus(invite.Status),
 			UserId:    userInfo.Id,
 			ExpiresAt: invite.ExpiresAt.String(),
+			Scopes:    dbScopesToEventScopes(invite.Scopes),
 		}
 		err = i.msgbus.PublishRequest(route, evt)
 		if err != nil {
@@ -297,6 +496,7 @@ func dbInvitationToPbInvitation(invitation *db.Invitation) *pb.Invitation {
 		Status:   upb.InvitationStatus(invitation.Status),
 		UserId:   invitation.UserId,
 		ExpireAt: invitation.ExpiresAt.String(),
+		Scopes:   dbScopesToPbScopes(invitation.Scopes),
 	}
 }
 
@@ -310,6 +510,67 @@ func dbInvitationsToPbInvitations(invitations []*db.Invitation) []*pb.Invitation
 	return res
 }
 
+func pbScopesToDbScopes(role upb.RoleType, scopes []*pb.InvitationScope) ([]db.InvitationScope, error) {
+	var res []db.InvitationScope
+
+	for _, s := range scopes {
+		scope, err := roles.ParseScope(s.ScopeKind, s.ScopeId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+
+		/* scopes without a role restrict the invited role */
+		name := s.Role
+		if name == "" {
+			name = roles.RoleName(roles.RoleType(role))
+		}
+
+		if name == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "role is required for %s scope", scope.Kind)
+		}
+
+		if rt, ok := roles.BuiltinRole(name); ok && rt == roles.TYPE_OWNER {
+			return nil, status.Errorf(codes.InvalidArgument, "owner role can't be scoped")
+		}
+
+		res = append(res, db.InvitationScope{
+			Role:      name,
+			ScopeKind: string(scope.Kind),
+			ScopeId:   scope.Id,
+		})
+	}
+
+	return res, nil
+}
+
+func dbScopesToPbScopes(scopes []db.InvitationScope) []*pb.InvitationScope {
+	res := []*pb.InvitationScope{}
+
+	for _, s := range scopes {
+		res = append(res, &pb.InvitationScope{
+			Role:      s.Role,
+			ScopeKind: s.ScopeKind,
+			ScopeId:   s.ScopeId,
+		})
+	}
+
+	return res
+}
+
+func dbScopesToEventScopes(scopes []db.InvitationScope) []*epb.InvitationScope {
+	res := []*epb.InvitationScope{}
+
+	for _, s := range scopes {
+		res = append(res, &epb.InvitationScope{
+			Role:      s.Role,
+			ScopeKind: s.ScopeKind,
+			ScopeId:   s.ScopeId,
+		})
+	}
+
+	return res
+}
+
 func generateInvitationLink(authLoginbaseURL string, linkID string, expirationTime time.Time) (string, error) {
 	link := fmt.Sprintf("%s?linkId=%s", authLoginbaseURL, linkID)
 
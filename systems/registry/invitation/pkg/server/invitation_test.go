@@ -17,6 +17,8 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"github.com/tj/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 
 	"github.com/ukama/ukama/systems/common/roles"
@@ -87,6 +89,15 @@ func contains(s, substr string) bool {
 	return strings.Contains(s, substr)
 }
 
+func pendingInvitation(id uuid.UUID) *db.Invitation {
+	return &db.Invitation{
+		Id:        id,
+		Email:     TestInvitationEmail1,
+		Status:    upb.InvitationStatus_INVITE_PENDING,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
 func TestInvitationServer_Add(t *testing.T) {
 	t.Run("invitationSuccess", func(t *testing.T) {
 		// Arrange
@@ -785,6 +796,7 @@ func TestInvitationServer_UpdateStatus(t *testing.T) {
 		}
 
 		userClient.On("GetByEmail", email).Return(userInfo, nil).Once()
+		invitationRepo.On("Get", invitationId).Return(pendingInvitation(invitationId), nil).Once()
 		invitationRepo.On("UpdateUserId", invitationId, mock.AnythingOfType("uuid.UUID")).Return(nil).Once()
 		invitationRepo.On("UpdateStatus", invitationId, uint8(newStatus.Number())).Return(nil).Once()
 		invitationRepo.On("Get", invitationId).Return(updatedInvitation, nil).Once()
@@ -923,6 +935,7 @@ func TestInvitationServer_UpdateStatus(t *testing.T) {
 		}
 
 		userClient.On("GetByEmail", email).Return(userInfo, nil).Once()
+		invitationRepo.On("Get", invitationId).Return(pendingInvitation(invitationId), nil).Once()
 		invitationRepo.On("UpdateUserId", invitationId, mock.AnythingOfType("uuid.UUID")).Return(gorm.ErrInvalidDB).Once()
 
 		s := NewInvitationServer(invitationRepo, TestExpiryTime, TestAuthLoginBaseURL, orgClient, userClient, msgbusClient, orgName)
@@ -963,6 +976,7 @@ func TestInvitationServer_UpdateStatus(t *testing.T) {
 		}
 
 		userClient.On("GetByEmail", email).Return(userInfo, nil).Once()
+		invitationRepo.On("Get", invitationId).Return(pendingInvitation(invitationId), nil).Once()
 		invitationRepo.On("UpdateUserId", invitationId, mock.AnythingOfType("uuid.UUID")).Return(nil).Once()
 		invitationRepo.On("UpdateStatus", invitationId, uint8(newStatus.Number())).Return(gorm.ErrInvalidDB).Once()
 
@@ -1004,6 +1018,7 @@ func TestInvitationServer_UpdateStatus(t *testing.T) {
 		}
 
 		userClient.On("GetByEmail", email).Return(userInfo, nil).Once()
+		invitationRepo.On("Get", invitationId).Return(pendingInvitation(invitationId), nil).Once()
 		invitationRepo.On("UpdateUserId", invitationId, mock.AnythingOfType("uuid.UUID")).Return(nil).Once()
 		invitationRepo.On("UpdateStatus", invitationId, uint8(newStatus.Number())).Return(nil).Once()
 		invitationRepo.On("Get", invitationId).Return(nil, gorm.ErrInvalidDB).Once()
@@ -1058,6 +1073,7 @@ func TestInvitationServer_UpdateStatus(t *testing.T) {
 		}
 
 		userClient.On("GetByEmail", email).Return(userInfo, nil).Once()
+		invitationRepo.On("Get", invitationId).Return(pendingInvitation(invitationId), nil).Once()
 		invitationRepo.On("UpdateUserId", invitationId, mock.AnythingOfType("uuid.UUID")).Return(nil).Once()
 		invitationRepo.On("UpdateStatus", invitationId, uint8(newStatus.Number())).Return(nil).Once()
 		invitationRepo.On("Get", invitationId).Return(updatedInvitation, nil).Once()
@@ -1115,6 +1131,7 @@ func TestInvitationServer_UpdateStatus(t *testing.T) {
 		}
 
 		userClient.On("GetByEmail", email).Return(userInfo, nil).Once()
+		invitationRepo.On("Get", invitationId).Return(pendingInvitation(invitationId), nil).Once()
 		invitationRepo.On("UpdateUserId", invitationId, mock.AnythingOfType("uuid.UUID")).Return(nil).Once()
 		invitationRepo.On("UpdateStatus", invitationId, uint8(newStatus.Number())).Return(nil).Once()
 		invitationRepo.On("Get", invitationId).Return(updatedInvitation, nil).Once()
@@ -1487,3 +1504,263 @@ func TestInvitationServer_GetAll(t *testing.T) {
 		invitationRepo.AssertExpectations(t)
 	})
 }
+
+func TestInvitationServer_AddScoped(t *testing.T) {
+	orgInfo := &cnucl.OrgInfo{Id: uuid.NewV4().String(), Name: TestOrgName, Owner: TestOwnerId}
+	ownerInfo := &cnucl.UserInfo{Id: TestOwnerId, Name: "Org Owner"}
+	siteId := uuid.NewV4().String()
+
+	t.Run("scopeWithInvitedRole", func(t *testing.T) {
+		invitationRepo := &mocks.InvitationRepo{}
+		orgClient := &cmocks.OrgClient{}
+		userClient := &cmocks.UserClient{}
+
+		orgClient.On("Get", TestOrgName).Return(orgInfo, nil).Once()
+		userClient.On("GetById", TestOwnerId).Return(ownerInfo, nil).Once()
+		userClient.On("GetByEmail", TestInvitationEmail1).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		var added *db.Invitation
+		invitationRepo.On("Add", mock.AnythingOfType("*db.Invitation"), mock.Anything).Return(nil).Once().
+			Run(func(args mock.Arguments) {
+				added = args.Get(0).(*db.Invitation)
+			})
+
+		s := NewInvitationServer(invitationRepo, TestExpiryTime, TestAuthLoginBaseURL, orgClient, userClient, nil, TestOrgName)
+
+		res, err := s.Add(context.TODO(), &pb.AddRequest{
+			Email:       TestInvitationEmail1,
+			Role:        upb.RoleType_ROLE_NETWORK_OWNER,
+			ExpiryHours: 72,
+			Scopes:      []*pb.InvitationScope{{ScopeKind: "site", ScopeId: siteId}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []db.InvitationScope{{Role: "network_owner", ScopeKind: "site", ScopeId: siteId}}, added.Scopes)
+		assert.WithinDuration(t, time.Now().Add(72*time.Hour), added.ExpiresAt, time.Minute)
+		assert.Len(t, res.Invitation.Scopes, 1)
+		assert.Equal(t, "network_owner", res.Invitation.Scopes[0].Role)
+		invitationRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalidScope", func(t *testing.T) {
+		orgClient := &cmocks.OrgClient{}
+		userClient := &cmocks.UserClient{}
+
+		orgClient.On("Get", TestOrgName).Return(orgInfo, nil).Once()
+		userClient.On("GetById", TestOwnerId).Return(ownerInfo, nil).Once()
+
+		s := NewInvitationServer(&mocks.InvitationRepo{}, TestExpiryTime, TestAuthLoginBaseURL, orgClient, userClient, nil, TestOrgName)
+
+		_, err := s.Add(context.TODO(), &pb.AddRequest{
+			Email:  TestInvitationEmail1,
+			Role:   upb.RoleType_ROLE_USER,
+			Scopes: []*pb.InvitationScope{{ScopeKind: "network", ScopeId: "not-a-uuid"}},
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ownerScope", func(t *testing.T) {
+		orgClient := &cmocks.OrgClient{}
+		userClient := &cmocks.UserClient{}
+
+		orgClient.On("Get", TestOrgName).Return(orgInfo, nil).Once()
+		userClient.On("GetById", TestOwnerId).Return(ownerInfo, nil).Once()
+
+		s := NewInvitationServer(&mocks.InvitationRepo{}, TestExpiryTime, TestAuthLoginBaseURL, orgClient, userClient, nil, TestOrgName)
+
+		_, err := s.Add(context.TODO(), &pb.AddRequest{
+			Email:  TestInvitationEmail1,
+			Role:   upb.RoleType_ROLE_OWNER,
+			Scopes: []*pb.InvitationScope{{ScopeKind: "site", ScopeId: siteId}},
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("expiryTooLong", func(t *testing.T) {
+		orgClient := &cmocks.OrgClient{}
+		userClient := &cmocks.UserClient{}
+
+		orgClient.On("Get", TestOrgName).Return(orgInfo, nil).Once()
+		userClient.On("GetById", TestOwnerId).Return(ownerInfo, nil).Once()
+
+		s := NewInvitationServer(&mocks.InvitationRepo{}, TestExpiryTime, TestAuthLoginBaseURL, orgClient, userClient, nil, TestOrgName)
+
+		_, err := s.Add(context.TODO(), &pb.AddRequest{
+			Email:       TestInvitationEmail1,
+			ExpiryHours: maxInvitationExpiryHours + 1,
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestInvitationServer_AddBulk(t *testing.T) {
+	orgInfo := &cnucl.OrgInfo{Id: uuid.NewV4().String(), Name: TestOrgName, Owner: TestOwnerId}
+	ownerInfo := &cnucl.UserInfo{Id: TestOwnerId, Name: "Org Owner"}
+	networkId := uuid.NewV4().String()
+
+	t.Run("partialFailure", func(t *testing.T) {
+		invitationRepo := &mocks.InvitationRepo{}
+		orgClient := &cmocks.OrgClient{}
+		userClient := &cmocks.UserClient{}
+		msgbusClient := &cmocks.MsgBusServiceClient{}
+
+		csv := "email,name,role,network_id,expiry_hours\n" +
+			TestInvitationEmail1 + ",John Doe,admin,,\n" +
+			"bad@example.com,Bad Role,superuser,,\n" +
+			TestInvitationEmail2 + ",Jane Smith,ROLE_NETWORK_OWNER," + networkId + ",48\n" +
+			"taken@example.com,Taken,,,\n"
+
+		orgClient.On("Get", TestOrgName).Return(orgInfo, nil).Once()
+		userClient.On("GetById", TestOwnerId).Return(ownerInfo, nil).Once()
+		userClient.On("GetByEmail", mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+
+		added := map[string]*db.Invitation{}
+		invitationRepo.On("Add", mock.MatchedBy(func(i *db.Invitation) bool {
+			return i.Email != "taken@example.com"
+		}), mock.Anything).Return(nil).Twice().Run(func(args mock.Arguments) {
+			i := args.Get(0).(*db.Invitation)
+			added[i.Email] = i
+		})
+		invitationRepo.On("Add", mock.Anything, mock.Anything).Return(gorm.ErrDuplicatedKey).Once()
+		msgbusClient.On("PublishRequest", mock.Anything, mock.AnythingOfType("*events.EventInvitationCreated")).Return(nil).Twice()
+
+		s := NewInvitationServer(invitationRepo, TestExpiryTime, TestAuthLoginBaseURL, orgClient, userClient, msgbusClient, TestOrgName)
+
+		res, err := s.AddBulk(context.TODO(), &pb.AddBulkRequest{Csv: csv, ExpiryHours: 12})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Invitations, 2)
+		assert.Len(t, res.Failures, 2)
+		assert.Equal(t, uint32(3), res.Failures[0].Line)
+		assert.Equal(t, "bad@example.com", res.Failures[0].Email)
+		assert.Equal(t, uint32(5), res.Failures[1].Line)
+
+		assert.Equal(t, roles.TYPE_ADMIN, added[TestInvitationEmail1].Role)
+		assert.WithinDuration(t, time.Now().Add(12*time.Hour), added[TestInvitationEmail1].ExpiresAt, time.Minute)
+		assert.Equal(t, roles.TYPE_NETWORK_OWNER, added[TestInvitationEmail2].Role)
+		assert.WithinDuration(t, time.Now().Add(48*time.Hour), added[TestInvitationEmail2].ExpiresAt, time.Minute)
+		assert.Equal(t, []db.InvitationScope{{Role: "network_owner", ScopeKind: "network", ScopeId: networkId}},
+			added[TestInvitationEmail2].Scopes)
+		invitationRepo.AssertExpectations(t)
+		msgbusClient.AssertExpectations(t)
+	})
+
+	t.Run("missingEmailColumn", func(t *testing.T) {
+		s := NewInvitationServer(&mocks.InvitationRepo{}, TestExpiryTime, TestAuthLoginBaseURL,
+			&cmocks.OrgClient{}, &cmocks.UserClient{}, nil, TestOrgName)
+
+		_, err := s.AddBulk(context.TODO(), &pb.AddBulkRequest{Csv: "name,role\nJohn,admin\n"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestInvitationServer_Resend(t *testing.T) {
+	orgInfo := &cnucl.OrgInfo{Id: uuid.NewV4().String(), Name: TestOrgName, Owner: TestOwnerId}
+	ownerInfo := &cnucl.UserInfo{Id: TestOwnerId, Name: "Org Owner"}
+
+	t.Run("resendExpired", func(t *testing.T) {
+		invitationRepo := &mocks.InvitationRepo{}
+		orgClient := &cmocks.OrgClient{}
+		userClient := &cmocks.UserClient{}
+		msgbusClient := &cmocks.MsgBusServiceClient{}
+
+		invitationId := uuid.NewV4()
+		invite := pendingInvitation(invitationId)
+		invite.Link = TestAuthLoginBaseURL + "?linkId=old"
+		invite.Status = upb.InvitationStatus_INVITE_EXPIRED
+		invite.ExpiresAt = time.Now().Add(-time.Hour)
+
+		invitationRepo.On("Get", invitationId).Return(invite, nil).Once()
+		orgClient.On("Get", TestOrgName).Return(orgInfo, nil).Once()
+		userClient.On("GetById", TestOwnerId).Return(ownerInfo, nil).Once()
+		invitationRepo.On("Renew", invitationId, mock.MatchedBy(func(link string) bool {
+			return link != TestAuthLoginBaseURL+"?linkId=old"
+		}), mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+		var evt *epb.EventInvitationCreated
+		msgbusClient.On("PublishRequest", mock.Anything, mock.Anything).Return(nil).Once().
+			Run(func(args mock.Arguments) {
+				evt = args.Get(1).(*epb.EventInvitationCreated)
+			})
+
+		s := NewInvitationServer(invitationRepo, TestExpiryTime, TestAuthLoginBaseURL, orgClient, userClient, msgbusClient, TestOrgName)
+
+		res, err := s.Resend(context.TODO(), &pb.ResendRequest{Id: invitationId.String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, upb.InvitationStatus_INVITE_PENDING, res.Invitation.Status)
+		assert.NotEqual(t, TestAuthLoginBaseURL+"?linkId=old", res.Invitation.Link)
+		assert.Equal(t, res.Invitation.Link, evt.Link)
+		assert.Equal(t, ownerInfo.Name, evt.OwnerName)
+		invitationRepo.AssertExpectations(t)
+		msgbusClient.AssertExpectations(t)
+	})
+
+	t.Run("alreadyAccepted", func(t *testing.T) {
+		invitationRepo := &mocks.InvitationRepo{}
+		invitationId := uuid.NewV4()
+		invite := pendingInvitation(invitationId)
+		invite.Status = upb.InvitationStatus_INVITE_ACCEPTED
+
+		invitationRepo.On("Get", invitationId).Return(invite, nil).Once()
+
+		s := NewInvitationServer(invitationRepo, TestExpiryTime, TestAuthLoginBaseURL,
+			&cmocks.OrgClient{}, &cmocks.UserClient{}, nil, TestOrgName)
+
+		_, err := s.Resend(context.TODO(), &pb.ResendRequest{Id: invitationId.String()})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestInvitationServer_ExpireInvitations(t *testing.T) {
+	invitationRepo := &mocks.InvitationRepo{}
+	msgbusClient := &cmocks.MsgBusServiceClient{}
+
+	expired := pendingInvitation(uuid.NewV4())
+	expired.Status = upb.InvitationStatus_INVITE_EXPIRED
+
+	invitationRepo.On("Expire", mock.AnythingOfType("time.Time")).Return([]*db.Invitation{expired}, nil).Once()
+	msgbusClient.On("PublishRequest", "event.cloud.local.testorg.registry.invitation.invitation.expire",
+		mock.MatchedBy(func(e *epb.EventInvitationExpired) bool {
+			return e.Id == expired.Id.String() && e.Email == expired.Email
+		})).Return(nil).Once()
+
+	s := NewInvitationServer(invitationRepo, TestExpiryTime, TestAuthLoginBaseURL,
+		&cmocks.OrgClient{}, &cmocks.UserClient{}, msgbusClient, TestOrgName)
+
+	err := s.ExpireInvitations()
+
+	assert.NoError(t, err)
+	invitationRepo.AssertExpectations(t)
+	msgbusClient.AssertExpectations(t)
+}
+
+func TestInvitationServer_UpdateStatusExpired(t *testing.T) {
+	invitationRepo := &mocks.InvitationRepo{}
+	userClient := &cmocks.UserClient{}
+
+	invitationId := uuid.NewV4()
+	invite := pendingInvitation(invitationId)
+	invite.ExpiresAt = time.Now().Add(-time.Minute)
+
+	userClient.On("GetByEmail", TestInvitationEmail1).Return(&cnucl.UserInfo{Id: TestUserId1}, nil).Once()
+	invitationRepo.On("Get", invitationId).Return(invite, nil).Once()
+
+	s := NewInvitationServer(invitationRepo, TestExpiryTime, TestAuthLoginBaseURL,
+		&cmocks.OrgClient{}, userClient, nil, TestOrgName)
+
+	_, err := s.UpdateStatus(context.TODO(), &pb.UpdateStatusRequest{
+		Id:     invitationId.String(),
+		Email:  TestInvitationEmail1,
+		Status: upb.InvitationStatus_INVITE_ACCEPTED,
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	invitationRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+}
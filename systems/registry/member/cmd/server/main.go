@@ -60,7 +60,7 @@ func initConfig() {
 func initDb() sql.Db {
 	log.Infof("Initializing Database")
 	d := sql.NewDb(serviceConfig.DB, serviceConfig.DebugMode)
-	err := d.Init(&db.Member{})
+	err := d.Init(&db.Member{}, &db.Role{}, &db.RoleBinding{}, &db.DataRequest{}, &db.DataRequestTask{})
 	if err != nil {
 		log.Fatalf("Database initialization failed. Error: %v", err)
 	}
@@ -89,7 +89,7 @@ func runGrpcServer(gormdb sql.Db) {
 
 	log.Debugf("MessageBus Client is %+v", mbClient)
 	memberServer := server.NewMemberServer(serviceConfig.OrgName, db.NewMemberRepo(gormdb),
-		orgClient, userClient, mbClient, serviceConfig.PushGateway, id)
+		db.NewRoleRepo(gormdb), db.NewDataRequestRepo(gormdb), orgClient, userClient, mbClient, serviceConfig.PushGateway, id)
 
 	memberEventServer := server.NewPackageEventServer(serviceConfig.OrgName, memberServer, serviceConfig.MasterOrgName)
 
@@ -0,0 +1,137 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	db "github.com/ukama/ukama/systems/registry/member/pkg/db"
+
+	uuid "github.com/ukama/ukama/systems/common/uuid"
+)
+
+// DataRequestRepo is an autogenerated mock type for the DataRequestRepo type
+type DataRequestRepo struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: req
+func (_m *DataRequestRepo) Add(req *db.DataRequest) error {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.DataRequest) error); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: id
+func (_m *DataRequestRepo) Get(id uuid.UUID) (*db.DataRequest, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *db.DataRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (*db.DataRequest, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) *db.DataRequest); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.DataRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with no fields
+func (_m *DataRequestRepo) List() ([]db.DataRequest, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []db.DataRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]db.DataRequest, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []db.DataRequest); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.DataRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTask provides a mock function with given fields: task
+func (_m *DataRequestRepo) UpdateTask(task *db.DataRequestTask) (*db.DataRequest, error) {
+	ret := _m.Called(task)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTask")
+	}
+
+	var r0 *db.DataRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*db.DataRequestTask) (*db.DataRequest, error)); ok {
+		return rf(task)
+	}
+	if rf, ok := ret.Get(0).(func(*db.DataRequestTask) *db.DataRequest); ok {
+		r0 = rf(task)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.DataRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*db.DataRequestTask) error); ok {
+		r1 = rf(task)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewDataRequestRepo creates a new instance of DataRequestRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDataRequestRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DataRequestRepo {
+	mock := &DataRequestRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
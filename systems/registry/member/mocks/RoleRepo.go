@@ -0,0 +1,209 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	db "github.com/ukama/ukama/systems/registry/member/pkg/db"
+
+	uuid "github.com/ukama/ukama/systems/common/uuid"
+)
+
+// RoleRepo is an autogenerated mock type for the RoleRepo type
+type RoleRepo struct {
+	mock.Mock
+}
+
+// AddBinding provides a mock function with given fields: binding
+func (_m *RoleRepo) AddBinding(binding *db.RoleBinding) error {
+	ret := _m.Called(binding)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBinding")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.RoleBinding) error); ok {
+		r0 = rf(binding)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddRole provides a mock function with given fields: role
+func (_m *RoleRepo) AddRole(role *db.Role) error {
+	ret := _m.Called(role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.Role) error); ok {
+		r0 = rf(role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRole provides a mock function with given fields: name
+func (_m *RoleRepo) DeleteRole(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetRole provides a mock function with given fields: name
+func (_m *RoleRepo) GetRole(name string) (*db.Role, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRole")
+	}
+
+	var r0 *db.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*db.Role, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) *db.Role); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Role)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBindings provides a mock function with given fields: userId
+func (_m *RoleRepo) ListBindings(userId uuid.UUID) ([]db.RoleBinding, error) {
+	ret := _m.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBindings")
+	}
+
+	var r0 []db.RoleBinding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]db.RoleBinding, error)); ok {
+		return rf(userId)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []db.RoleBinding); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.RoleBinding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoles provides a mock function with no fields
+func (_m *RoleRepo) ListRoles() ([]db.Role, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoles")
+	}
+
+	var r0 []db.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]db.Role, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []db.Role); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Role)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveBinding provides a mock function with given fields: id
+func (_m *RoleRepo) RemoveBinding(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveBinding")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateRole provides a mock function with given fields: role
+func (_m *RoleRepo) UpdateRole(role *db.Role) error {
+	ret := _m.Called(role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*db.Role) error); ok {
+		r0 = rf(role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRoleRepo creates a new instance of RoleRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRoleRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RoleRepo {
+	mock := &RoleRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
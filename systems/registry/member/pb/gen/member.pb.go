@@ -7,8 +7,8 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.11
-// 	protoc        v7.34.1
+// 	protoc-gen-go v1.36.12
+// 	protoc        v6.32.1
 // source: member.proto
 
 package gen
@@ -468,6 +468,1450 @@ func (x *Member) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+type Role struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Builtin       bool                   `protobuf:"varint,4,opt,name=builtin,proto3" json:"builtin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_member_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Role) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *Role) GetBuiltin() bool {
+	if x != nil {
+		return x.Builtin
+	}
+	return false
+}
+
+type AddRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRoleRequest) Reset() {
+	*x = AddRoleRequest{}
+	mi := &file_member_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRoleRequest) ProtoMessage() {}
+
+func (x *AddRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRoleRequest.ProtoReflect.Descriptor instead.
+func (*AddRoleRequest) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddRoleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddRoleRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type UpdateRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	mi := &file_member_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleRequest) ProtoMessage() {}
+
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type RoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleResponse) Reset() {
+	*x = RoleResponse{}
+	mi := &file_member_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleResponse) ProtoMessage() {}
+
+func (x *RoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleResponse.ProtoReflect.Descriptor instead.
+func (*RoleResponse) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type ListRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	mi := &file_member_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesRequest) ProtoMessage() {}
+
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{13}
+}
+
+type ListRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	mi := &file_member_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesResponse) ProtoMessage() {}
+
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type DeleteRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_member_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleRequest) ProtoMessage() {}
+
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleResponse) Reset() {
+	*x = DeleteRoleResponse{}
+	mi := &file_member_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleResponse) ProtoMessage() {}
+
+func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{16}
+}
+
+type RoleBinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,json=user_id,proto3" json:"userId,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	ScopeKind     string                 `protobuf:"bytes,4,opt,name=scopeKind,json=scope_kind,proto3" json:"scopeKind,omitempty"`
+	ScopeId       string                 `protobuf:"bytes,5,opt,name=scopeId,json=scope_id,proto3" json:"scopeId,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleBinding) Reset() {
+	*x = RoleBinding{}
+	mi := &file_member_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBinding) ProtoMessage() {}
+
+func (x *RoleBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBinding.ProtoReflect.Descriptor instead.
+func (*RoleBinding) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RoleBinding) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetScopeKind() string {
+	if x != nil {
+		return x.ScopeKind
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AddRoleBindingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,json=user_id,proto3" json:"userId,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	ScopeKind     string                 `protobuf:"bytes,3,opt,name=scopeKind,json=scope_kind,proto3" json:"scopeKind,omitempty"`
+	ScopeId       string                 `protobuf:"bytes,4,opt,name=scopeId,json=scope_id,proto3" json:"scopeId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRoleBindingRequest) Reset() {
+	*x = AddRoleBindingRequest{}
+	mi := &file_member_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRoleBindingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRoleBindingRequest) ProtoMessage() {}
+
+func (x *AddRoleBindingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRoleBindingRequest.ProtoReflect.Descriptor instead.
+func (*AddRoleBindingRequest) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddRoleBindingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddRoleBindingRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AddRoleBindingRequest) GetScopeKind() string {
+	if x != nil {
+		return x.ScopeKind
+	}
+	return ""
+}
+
+func (x *AddRoleBindingRequest) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+type RoleBindingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Binding       *RoleBinding           `protobuf:"bytes,1,opt,name=binding,proto3" json:"binding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleBindingResponse) Reset() {
+	*x = RoleBindingResponse{}
+	mi := &file_member_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleBindingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBindingResponse) ProtoMessage() {}
+
+func (x *RoleBindingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBindingResponse.ProtoReflect.Descriptor instead.
+func (*RoleBindingResponse) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RoleBindingResponse) GetBinding() *RoleBinding {
+	if x != nil {
+		return x.Binding
+	}
+	return nil
+}
+
+type RemoveRoleBindingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRoleBindingRequest) Reset() {
+	*x = RemoveRoleBindingRequest{}
+	mi := &file_member_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRoleBindingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRoleBindingRequest) ProtoMessage() {}
+
+func (x *RemoveRoleBindingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRoleBindingRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRoleBindingRequest) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RemoveRoleBindingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveRoleBindingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRoleBindingResponse) Reset() {
+	*x = RemoveRoleBindingResponse{}
+	mi := &file_member_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRoleBindingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRoleBindingResponse) ProtoMessage() {}
+
+func (x *RemoveRoleBindingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRoleBindingResponse.ProtoReflect.Descriptor instead.
+func (*RemoveRoleBindingResponse) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{21}
+}
+
+type ListRoleBindingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,json=user_id,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoleBindingsRequest) Reset() {
+	*x = ListRoleBindingsRequest{}
+	mi := &file_member_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleBindingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleBindingsRequest) ProtoMessage() {}
+
+func (x *ListRoleBindingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleBindingsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoleBindingsRequest) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListRoleBindingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListRoleBindingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bindings      []*RoleBinding         `protobuf:"bytes,1,rep,name=bindings,proto3" json:"bindings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoleBindingsResponse) Reset() {
+	*x = ListRoleBindingsResponse{}
+	mi := &file_member_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleBindingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleBindingsResponse) ProtoMessage() {}
+
+func (x *ListRoleBindingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleBindingsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoleBindingsResponse) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListRoleBindingsResponse) GetBindings() []*RoleBinding {
+	if x != nil {
+		return x.Bindings
+	}
+	return nil
+}
+
+type GetAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,json=user_id,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessRequest) Reset() {
+	*x = GetAccessRequest{}
+	mi := &file_member_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessRequest) ProtoMessage() {}
+
+func (x *GetAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessRequest.ProtoReflect.Descriptor instead.
+func (*GetAccessRequest) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetAccessRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type Grant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Permissions   []string               `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	ScopeKind     string                 `protobuf:"bytes,3,opt,name=scopeKind,json=scope_kind,proto3" json:"scopeKind,omitempty"`
+	ScopeId       string                 `protobuf:"bytes,4,opt,name=scopeId,json=scope_id,proto3" json:"scopeId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Grant) Reset() {
+	*x = Grant{}
+	mi := &file_member_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Grant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grant) ProtoMessage() {}
+
+func (x *Grant) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grant.ProtoReflect.Descriptor instead.
+func (*Grant) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Grant) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Grant) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *Grant) GetScopeKind() string {
+	if x != nil {
+		return x.ScopeKind
+	}
+	return ""
+}
+
+func (x *Grant) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+type GetAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grants        []*Grant               `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessResponse) Reset() {
+	*x = GetAccessResponse{}
+	mi := &file_member_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessResponse) ProtoMessage() {}
+
+func (x *GetAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessResponse.ProtoReflect.Descriptor instead.
+func (*GetAccessResponse) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetAccessResponse) GetGrants() []*Grant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+type AddDataRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	SubjectKind   string                 `protobuf:"bytes,2,opt,name=subjectKind,json=subject_kind,proto3" json:"subjectKind,omitempty"`
+	SubjectId     string                 `protobuf:"bytes,3,opt,name=subjectId,json=subject_id,proto3" json:"subjectId,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,4,opt,name=requestedBy,json=requested_by,proto3" json:"requestedBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDataRequestRequest) Reset() {
+	*x = AddDataRequestRequest{}
+	mi := &file_member_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDataRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDataRequestRequest) ProtoMessage() {}
+
+func (x *AddDataRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDataRequestRequest.ProtoReflect.Descriptor instead.
+func (*AddDataRequestRequest) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AddDataRequestRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AddDataRequestRequest) GetSubjectKind() string {
+	if x != nil {
+		return x.SubjectKind
+	}
+	return ""
+}
+
+func (x *AddDataRequestRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *AddDataRequestRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type DataRequestTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataRequestTask) Reset() {
+	*x = DataRequestTask{}
+	mi := &file_member_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataRequestTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataRequestTask) ProtoMessage() {}
+
+func (x *DataRequestTask) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataRequestTask.ProtoReflect.Descriptor instead.
+func (*DataRequestTask) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DataRequestTask) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *DataRequestTask) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataRequestTask) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DataRequestTask) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *DataRequestTask) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type DataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	SubjectKind   string                 `protobuf:"bytes,3,opt,name=subjectKind,json=subject_kind,proto3" json:"subjectKind,omitempty"`
+	SubjectId     string                 `protobuf:"bytes,4,opt,name=subjectId,json=subject_id,proto3" json:"subjectId,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,6,opt,name=requestedBy,json=requested_by,proto3" json:"requestedBy,omitempty"`
+	Tasks         []*DataRequestTask     `protobuf:"bytes,7,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataRequest) Reset() {
+	*x = DataRequest{}
+	mi := &file_member_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataRequest) ProtoMessage() {}
+
+func (x *DataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataRequest.ProtoReflect.Descriptor instead.
+func (*DataRequest) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DataRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DataRequest) GetSubjectKind() string {
+	if x != nil {
+		return x.SubjectKind
+	}
+	return ""
+}
+
+func (x *DataRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *DataRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *DataRequest) GetTasks() []*DataRequestTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *DataRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DataRequest) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type DataRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *DataRequest           `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataRequestResponse) Reset() {
+	*x = DataRequestResponse{}
+	mi := &file_member_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataRequestResponse) ProtoMessage() {}
+
+func (x *DataRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataRequestResponse.ProtoReflect.Descriptor instead.
+func (*DataRequestResponse) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DataRequestResponse) GetRequest() *DataRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type GetDataRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataRequestRequest) Reset() {
+	*x = GetDataRequestRequest{}
+	mi := &file_member_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataRequestRequest) ProtoMessage() {}
+
+func (x *GetDataRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetDataRequestRequest) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetDataRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDataRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDataRequestsRequest) Reset() {
+	*x = ListDataRequestsRequest{}
+	mi := &file_member_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDataRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDataRequestsRequest) ProtoMessage() {}
+
+func (x *ListDataRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDataRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListDataRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{32}
+}
+
+type ListDataRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*DataRequest         `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDataRequestsResponse) Reset() {
+	*x = ListDataRequestsResponse{}
+	mi := &file_member_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDataRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDataRequestsResponse) ProtoMessage() {}
+
+func (x *ListDataRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDataRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListDataRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListDataRequestsResponse) GetRequests() []*DataRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type GetDataRequestBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataRequestBundleRequest) Reset() {
+	*x = GetDataRequestBundleRequest{}
+	mi := &file_member_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataRequestBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataRequestBundleRequest) ProtoMessage() {}
+
+func (x *GetDataRequestBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataRequestBundleRequest.ProtoReflect.Descriptor instead.
+func (*GetDataRequestBundleRequest) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetDataRequestBundleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetDataRequestBundleRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type GetDataRequestBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=contentType,json=content_type,proto3" json:"contentType,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=fileName,json=file_name,proto3" json:"fileName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataRequestBundleResponse) Reset() {
+	*x = GetDataRequestBundleResponse{}
+	mi := &file_member_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataRequestBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataRequestBundleResponse) ProtoMessage() {}
+
+func (x *GetDataRequestBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_member_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataRequestBundleResponse.ProtoReflect.Descriptor instead.
+func (*GetDataRequestBundleResponse) Descriptor() ([]byte, []int) {
+	return file_member_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetDataRequestBundleResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetDataRequestBundleResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetDataRequestBundleResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
 var File_member_proto protoreflect.FileDescriptor
 
 const file_member_proto_rawDesc = "" +
@@ -496,7 +1940,105 @@ const file_member_proto_rawDesc = "" +
 	"\x04role\x18\x03 \x01(\x0e2\x19.ukama.common.v1.RoleTypeR\x04role\x12%\n" +
 	"\risDeactivated\x18\x04 \x01(\bR\x0eis_deactivated\x12<\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fmember_since2\x85\x05\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fmember_since\"x\n" +
+	"\x04Role\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\x12\x18\n" +
+	"\abuiltin\x18\x04 \x01(\bR\abuiltin\"\x8a\x01\n" +
+	"\x0eAddRoleRequest\x12,\n" +
+	"\x04name\x18\x01 \x01(\tB\x18\xe2\xdf\x1f\x14\n" +
+	"\x12^[a-z0-9_-]{1,64}$R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12(\n" +
+	"\vpermissions\x18\x03 \x03(\tB\x06\xe2\xdf\x1f\x02`\x01R\vpermissions\"{\n" +
+	"\x11UpdateRoleRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12(\n" +
+	"\vpermissions\x18\x03 \x03(\tB\x06\xe2\xdf\x1f\x02`\x01R\vpermissions\"B\n" +
+	"\fRoleResponse\x122\n" +
+	"\x04role\x18\x01 \x01(\v2\x1e.ukama.registry.member.v1.RoleR\x04role\"\x12\n" +
+	"\x10ListRolesRequest\"I\n" +
+	"\x11ListRolesResponse\x124\n" +
+	"\x05roles\x18\x01 \x03(\v2\x1e.ukama.registry.member.v1.RoleR\x05roles\"/\n" +
+	"\x11DeleteRoleRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x04name\"\x14\n" +
+	"\x12DeleteRoleResponse\"\xc0\x01\n" +
+	"\vRoleBinding\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x06userId\x18\x02 \x01(\tR\auser_id\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1d\n" +
+	"\tscopeKind\x18\x04 \x01(\tR\n" +
+	"scope_kind\x12\x19\n" +
+	"\ascopeId\x18\x05 \x01(\tR\bscope_id\x12:\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\"\x91\x01\n" +
+	"\x15AddRoleBindingRequest\x12\"\n" +
+	"\x06userId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\auser_id\x12\x1a\n" +
+	"\x04role\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x04role\x12\x1d\n" +
+	"\tscopeKind\x18\x03 \x01(\tR\n" +
+	"scope_kind\x12\x19\n" +
+	"\ascopeId\x18\x04 \x01(\tR\bscope_id\"V\n" +
+	"\x13RoleBindingResponse\x12?\n" +
+	"\abinding\x18\x01 \x01(\v2%.ukama.registry.member.v1.RoleBindingR\abinding\"5\n" +
+	"\x18RemoveRoleBindingRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\"\x1b\n" +
+	"\x19RemoveRoleBindingResponse\"=\n" +
+	"\x17ListRoleBindingsRequest\x12\"\n" +
+	"\x06userId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\auser_id\"]\n" +
+	"\x18ListRoleBindingsResponse\x12A\n" +
+	"\bbindings\x18\x01 \x03(\v2%.ukama.registry.member.v1.RoleBindingR\bbindings\"6\n" +
+	"\x10GetAccessRequest\x12\"\n" +
+	"\x06userId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\auser_id\"w\n" +
+	"\x05Grant\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12 \n" +
+	"\vpermissions\x18\x02 \x03(\tR\vpermissions\x12\x1d\n" +
+	"\tscopeKind\x18\x03 \x01(\tR\n" +
+	"scope_kind\x12\x19\n" +
+	"\ascopeId\x18\x04 \x01(\tR\bscope_id\"L\n" +
+	"\x11GetAccessResponse\x127\n" +
+	"\x06grants\x18\x01 \x03(\v2\x1f.ukama.registry.member.v1.GrantR\x06grants\"\xa0\x01\n" +
+	"\x15AddDataRequestRequest\x12\x1a\n" +
+	"\x04kind\x18\x01 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\x04kind\x12)\n" +
+	"\vsubjectKind\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\fsubject_kind\x12\x1d\n" +
+	"\tsubjectId\x18\x03 \x01(\tR\n" +
+	"subject_id\x12!\n" +
+	"\vrequestedBy\x18\x04 \x01(\tR\frequested_by\"\xa9\x01\n" +
+	"\x0fDataRequestTask\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12:\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"\xeb\x02\n" +
+	"\vDataRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12!\n" +
+	"\vsubjectKind\x18\x03 \x01(\tR\fsubject_kind\x12\x1d\n" +
+	"\tsubjectId\x18\x04 \x01(\tR\n" +
+	"subject_id\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12!\n" +
+	"\vrequestedBy\x18\x06 \x01(\tR\frequested_by\x12?\n" +
+	"\x05tasks\x18\a \x03(\v2).ukama.registry.member.v1.DataRequestTaskR\x05tasks\x12:\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12>\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\fcompleted_at\"V\n" +
+	"\x13DataRequestResponse\x12?\n" +
+	"\arequest\x18\x01 \x01(\v2%.ukama.registry.member.v1.DataRequestR\arequest\"2\n" +
+	"\x15GetDataRequestRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\"\x19\n" +
+	"\x17ListDataRequestsRequest\"]\n" +
+	"\x18ListDataRequestsResponse\x12A\n" +
+	"\brequests\x18\x01 \x03(\v2%.ukama.registry.member.v1.DataRequestR\brequests\"P\n" +
+	"\x1bGetDataRequestBundleRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"r\n" +
+	"\x1cGetDataRequestBundleResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\vcontentType\x18\x02 \x01(\tR\fcontent_type\x12\x1b\n" +
+	"\bfileName\x18\x03 \x01(\tR\tfile_name2\xcc\x0f\n" +
 	"\rMemberService\x12a\n" +
 	"\tAddMember\x12*.ukama.registry.member.v1.AddMemberRequest\x1a(.ukama.registry.member.v1.MemberResponse\x12^\n" +
 	"\tGetMember\x12'.ukama.registry.member.v1.MemberRequest\x1a(.ukama.registry.member.v1.MemberResponse\x12|\n" +
@@ -504,7 +2046,21 @@ const file_member_proto_rawDesc = "" +
 	"\n" +
 	"GetMembers\x12+.ukama.registry.member.v1.GetMembersRequest\x1a,.ukama.registry.member.v1.GetMembersResponse\x12g\n" +
 	"\fUpdateMember\x12-.ukama.registry.member.v1.UpdateMemberRequest\x1a(.ukama.registry.member.v1.MemberResponse\x12a\n" +
-	"\fRemoveMember\x12'.ukama.registry.member.v1.MemberRequest\x1a(.ukama.registry.member.v1.MemberResponseB7Z5github.com/ukama/ukama/systems/registry/member/pb/genb\x06proto3"
+	"\fRemoveMember\x12'.ukama.registry.member.v1.MemberRequest\x1a(.ukama.registry.member.v1.MemberResponse\x12[\n" +
+	"\aAddRole\x12(.ukama.registry.member.v1.AddRoleRequest\x1a&.ukama.registry.member.v1.RoleResponse\x12a\n" +
+	"\n" +
+	"UpdateRole\x12+.ukama.registry.member.v1.UpdateRoleRequest\x1a&.ukama.registry.member.v1.RoleResponse\x12d\n" +
+	"\tListRoles\x12*.ukama.registry.member.v1.ListRolesRequest\x1a+.ukama.registry.member.v1.ListRolesResponse\x12g\n" +
+	"\n" +
+	"DeleteRole\x12+.ukama.registry.member.v1.DeleteRoleRequest\x1a,.ukama.registry.member.v1.DeleteRoleResponse\x12p\n" +
+	"\x0eAddRoleBinding\x12/.ukama.registry.member.v1.AddRoleBindingRequest\x1a-.ukama.registry.member.v1.RoleBindingResponse\x12|\n" +
+	"\x11RemoveRoleBinding\x122.ukama.registry.member.v1.RemoveRoleBindingRequest\x1a3.ukama.registry.member.v1.RemoveRoleBindingResponse\x12y\n" +
+	"\x10ListRoleBindings\x121.ukama.registry.member.v1.ListRoleBindingsRequest\x1a2.ukama.registry.member.v1.ListRoleBindingsResponse\x12d\n" +
+	"\tGetAccess\x12*.ukama.registry.member.v1.GetAccessRequest\x1a+.ukama.registry.member.v1.GetAccessResponse\x12p\n" +
+	"\x0eAddDataRequest\x12/.ukama.registry.member.v1.AddDataRequestRequest\x1a-.ukama.registry.member.v1.DataRequestResponse\x12p\n" +
+	"\x0eGetDataRequest\x12/.ukama.registry.member.v1.GetDataRequestRequest\x1a-.ukama.registry.member.v1.DataRequestResponse\x12y\n" +
+	"\x10ListDataRequests\x121.ukama.registry.member.v1.ListDataRequestsRequest\x1a2.ukama.registry.member.v1.ListDataRequestsResponse\x12\x85\x01\n" +
+	"\x14GetDataRequestBundle\x125.ukama.registry.member.v1.GetDataRequestBundleRequest\x1a6.ukama.registry.member.v1.GetDataRequestBundleResponseB7Z5github.com/ukama/ukama/systems/registry/member/pb/genb\x06proto3"
 
 var (
 	file_member_proto_rawDescOnce sync.Once
@@ -518,44 +2074,107 @@ func file_member_proto_rawDescGZIP() []byte {
 	return file_member_proto_rawDescData
 }
 
-var file_member_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_member_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_member_proto_goTypes = []any{
-	(*GetMemberByUserIdRequest)(nil),  // 0: ukama.registry.member.v1.GetMemberByUserIdRequest
-	(*GetMemberByUserIdResponse)(nil), // 1: ukama.registry.member.v1.GetMemberByUserIdResponse
-	(*AddMemberRequest)(nil),          // 2: ukama.registry.member.v1.AddMemberRequest
-	(*MemberRequest)(nil),             // 3: ukama.registry.member.v1.MemberRequest
-	(*MemberResponse)(nil),            // 4: ukama.registry.member.v1.MemberResponse
-	(*GetMembersRequest)(nil),         // 5: ukama.registry.member.v1.GetMembersRequest
-	(*GetMembersResponse)(nil),        // 6: ukama.registry.member.v1.GetMembersResponse
-	(*UpdateMemberRequest)(nil),       // 7: ukama.registry.member.v1.UpdateMemberRequest
-	(*Member)(nil),                    // 8: ukama.registry.member.v1.Member
-	(ukama.RoleType)(0),               // 9: ukama.common.v1.RoleType
-	(*timestamppb.Timestamp)(nil),     // 10: google.protobuf.Timestamp
+	(*GetMemberByUserIdRequest)(nil),     // 0: ukama.registry.member.v1.GetMemberByUserIdRequest
+	(*GetMemberByUserIdResponse)(nil),    // 1: ukama.registry.member.v1.GetMemberByUserIdResponse
+	(*AddMemberRequest)(nil),             // 2: ukama.registry.member.v1.AddMemberRequest
+	(*MemberRequest)(nil),                // 3: ukama.registry.member.v1.MemberRequest
+	(*MemberResponse)(nil),               // 4: ukama.registry.member.v1.MemberResponse
+	(*GetMembersRequest)(nil),            // 5: ukama.registry.member.v1.GetMembersRequest
+	(*GetMembersResponse)(nil),           // 6: ukama.registry.member.v1.GetMembersResponse
+	(*UpdateMemberRequest)(nil),          // 7: ukama.registry.member.v1.UpdateMemberRequest
+	(*Member)(nil),                       // 8: ukama.registry.member.v1.Member
+	(*Role)(nil),                         // 9: ukama.registry.member.v1.Role
+	(*AddRoleRequest)(nil),               // 10: ukama.registry.member.v1.AddRoleRequest
+	(*UpdateRoleRequest)(nil),            // 11: ukama.registry.member.v1.UpdateRoleRequest
+	(*RoleResponse)(nil),                 // 12: ukama.registry.member.v1.RoleResponse
+	(*ListRolesRequest)(nil),             // 13: ukama.registry.member.v1.ListRolesRequest
+	(*ListRolesResponse)(nil),            // 14: ukama.registry.member.v1.ListRolesResponse
+	(*DeleteRoleRequest)(nil),            // 15: ukama.registry.member.v1.DeleteRoleRequest
+	(*DeleteRoleResponse)(nil),           // 16: ukama.registry.member.v1.DeleteRoleResponse
+	(*RoleBinding)(nil),                  // 17: ukama.registry.member.v1.RoleBinding
+	(*AddRoleBindingRequest)(nil),        // 18: ukama.registry.member.v1.AddRoleBindingRequest
+	(*RoleBindingResponse)(nil),          // 19: ukama.registry.member.v1.RoleBindingResponse
+	(*RemoveRoleBindingRequest)(nil),     // 20: ukama.registry.member.v1.RemoveRoleBindingRequest
+	(*RemoveRoleBindingResponse)(nil),    // 21: ukama.registry.member.v1.RemoveRoleBindingResponse
+	(*ListRoleBindingsRequest)(nil),      // 22: ukama.registry.member.v1.ListRoleBindingsRequest
+	(*ListRoleBindingsResponse)(nil),     // 23: ukama.registry.member.v1.ListRoleBindingsResponse
+	(*GetAccessRequest)(nil),             // 24: ukama.registry.member.v1.GetAccessRequest
+	(*Grant)(nil),                        // 25: ukama.registry.member.v1.Grant
+	(*GetAccessResponse)(nil),            // 26: ukama.registry.member.v1.GetAccessResponse
+	(*AddDataRequestRequest)(nil),        // 27: ukama.registry.member.v1.AddDataRequestRequest
+	(*DataRequestTask)(nil),              // 28: ukama.registry.member.v1.DataRequestTask
+	(*DataRequest)(nil),                  // 29: ukama.registry.member.v1.DataRequest
+	(*DataRequestResponse)(nil),          // 30: ukama.registry.member.v1.DataRequestResponse
+	(*GetDataRequestRequest)(nil),        // 31: ukama.registry.member.v1.GetDataRequestRequest
+	(*ListDataRequestsRequest)(nil),      // 32: ukama.registry.member.v1.ListDataRequestsRequest
+	(*ListDataRequestsResponse)(nil),     // 33: ukama.registry.member.v1.ListDataRequestsResponse
+	(*GetDataRequestBundleRequest)(nil),  // 34: ukama.registry.member.v1.GetDataRequestBundleRequest
+	(*GetDataRequestBundleResponse)(nil), // 35: ukama.registry.member.v1.GetDataRequestBundleResponse
+	(ukama.RoleType)(0),                  // 36: ukama.common.v1.RoleType
+	(*timestamppb.Timestamp)(nil),        // 37: google.protobuf.Timestamp
 }
 var file_member_proto_depIdxs = []int32{
 	8,  // 0: ukama.registry.member.v1.GetMemberByUserIdResponse.member:type_name -> ukama.registry.member.v1.Member
-	9,  // 1: ukama.registry.member.v1.AddMemberRequest.role:type_name -> ukama.common.v1.RoleType
+	36, // 1: ukama.registry.member.v1.AddMemberRequest.role:type_name -> ukama.common.v1.RoleType
 	8,  // 2: ukama.registry.member.v1.MemberResponse.member:type_name -> ukama.registry.member.v1.Member
 	8,  // 3: ukama.registry.member.v1.GetMembersResponse.members:type_name -> ukama.registry.member.v1.Member
-	9,  // 4: ukama.registry.member.v1.Member.role:type_name -> ukama.common.v1.RoleType
-	10, // 5: ukama.registry.member.v1.Member.created_at:type_name -> google.protobuf.Timestamp
-	2,  // 6: ukama.registry.member.v1.MemberService.AddMember:input_type -> ukama.registry.member.v1.AddMemberRequest
-	3,  // 7: ukama.registry.member.v1.MemberService.GetMember:input_type -> ukama.registry.member.v1.MemberRequest
-	0,  // 8: ukama.registry.member.v1.MemberService.GetMemberByUserId:input_type -> ukama.registry.member.v1.GetMemberByUserIdRequest
-	5,  // 9: ukama.registry.member.v1.MemberService.GetMembers:input_type -> ukama.registry.member.v1.GetMembersRequest
-	7,  // 10: ukama.registry.member.v1.MemberService.UpdateMember:input_type -> ukama.registry.member.v1.UpdateMemberRequest
-	3,  // 11: ukama.registry.member.v1.MemberService.RemoveMember:input_type -> ukama.registry.member.v1.MemberRequest
-	4,  // 12: ukama.registry.member.v1.MemberService.AddMember:output_type -> ukama.registry.member.v1.MemberResponse
-	4,  // 13: ukama.registry.member.v1.MemberService.GetMember:output_type -> ukama.registry.member.v1.MemberResponse
-	1,  // 14: ukama.registry.member.v1.MemberService.GetMemberByUserId:output_type -> ukama.registry.member.v1.GetMemberByUserIdResponse
-	6,  // 15: ukama.registry.member.v1.MemberService.GetMembers:output_type -> ukama.registry.member.v1.GetMembersResponse
-	4,  // 16: ukama.registry.member.v1.MemberService.UpdateMember:output_type -> ukama.registry.member.v1.MemberResponse
-	4,  // 17: ukama.registry.member.v1.MemberService.RemoveMember:output_type -> ukama.registry.member.v1.MemberResponse
-	12, // [12:18] is the sub-list for method output_type
-	6,  // [6:12] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	36, // 4: ukama.registry.member.v1.Member.role:type_name -> ukama.common.v1.RoleType
+	37, // 5: ukama.registry.member.v1.Member.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 6: ukama.registry.member.v1.RoleResponse.role:type_name -> ukama.registry.member.v1.Role
+	9,  // 7: ukama.registry.member.v1.ListRolesResponse.roles:type_name -> ukama.registry.member.v1.Role
+	37, // 8: ukama.registry.member.v1.RoleBinding.created_at:type_name -> google.protobuf.Timestamp
+	17, // 9: ukama.registry.member.v1.RoleBindingResponse.binding:type_name -> ukama.registry.member.v1.RoleBinding
+	17, // 10: ukama.registry.member.v1.ListRoleBindingsResponse.bindings:type_name -> ukama.registry.member.v1.RoleBinding
+	25, // 11: ukama.registry.member.v1.GetAccessResponse.grants:type_name -> ukama.registry.member.v1.Grant
+	37, // 12: ukama.registry.member.v1.DataRequestTask.updated_at:type_name -> google.protobuf.Timestamp
+	28, // 13: ukama.registry.member.v1.DataRequest.tasks:type_name -> ukama.registry.member.v1.DataRequestTask
+	37, // 14: ukama.registry.member.v1.DataRequest.created_at:type_name -> google.protobuf.Timestamp
+	37, // 15: ukama.registry.member.v1.DataRequest.completed_at:type_name -> google.protobuf.Timestamp
+	29, // 16: ukama.registry.member.v1.DataRequestResponse.request:type_name -> ukama.registry.member.v1.DataRequest
+	29, // 17: ukama.registry.member.v1.ListDataRequestsResponse.requests:type_name -> ukama.registry.member.v1.DataRequest
+	2,  // 18: ukama.registry.member.v1.MemberService.AddMember:input_type -> ukama.registry.member.v1.AddMemberRequest
+	3,  // 19: ukama.registry.member.v1.MemberService.GetMember:input_type -> ukama.registry.member.v1.MemberRequest
+	0,  // 20: ukama.registry.member.v1.MemberService.GetMemberByUserId:input_type -> ukama.registry.member.v1.GetMemberByUserIdRequest
+	5,  // 21: ukama.registry.member.v1.MemberService.GetMembers:input_type -> ukama.registry.member.v1.GetMembersRequest
+	7,  // 22: ukama.registry.member.v1.MemberService.UpdateMember:input_type -> ukama.registry.member.v1.UpdateMemberRequest
+	3,  // 23: ukama.registry.member.v1.MemberService.RemoveMember:input_type -> ukama.registry.member.v1.MemberRequest
+	10, // 24: ukama.registry.member.v1.MemberService.AddRole:input_type -> ukama.registry.member.v1.AddRoleRequest
+	11, // 25: ukama.registry.member.v1.MemberService.UpdateRole:input_type -> ukama.registry.member.v1.UpdateRoleRequest
+	13, // 26: ukama.registry.member.v1.MemberService.ListRoles:input_type -> ukama.registry.member.v1.ListRolesRequest
+	15, // 27: ukama.registry.member.v1.MemberService.DeleteRole:input_type -> ukama.registry.member.v1.DeleteRoleRequest
+	18, // 28: ukama.registry.member.v1.MemberService.AddRoleBinding:input_type -> ukama.registry.member.v1.AddRoleBindingRequest
+	20, // 29: ukama.registry.member.v1.MemberService.RemoveRoleBinding:input_type -> ukama.registry.member.v1.RemoveRoleBindingRequest
+	22, // 30: ukama.registry.member.v1.MemberService.ListRoleBindings:input_type -> ukama.registry.member.v1.ListRoleBindingsRequest
+	24, // 31: ukama.registry.member.v1.MemberService.GetAccess:input_type -> ukama.registry.member.v1.GetAccessRequest
+	27, // 32: ukama.registry.member.v1.MemberService.AddDataRequest:input_type -> ukama.registry.member.v1.AddDataRequestRequest
+	31, // 33: ukama.registry.member.v1.MemberService.GetDataRequest:input_type -> ukama.registry.member.v1.GetDataRequestRequest
+	32, // 34: ukama.registry.member.v1.MemberService.ListDataRequests:input_type -> ukama.registry.member.v1.ListDataRequestsRequest
+	34, // 35: ukama.registry.member.v1.MemberService.GetDataRequestBundle:input_type -> ukama.registry.member.v1.GetDataRequestBundleRequest
+	4,  // 36: ukama.registry.member.v1.MemberService.AddMember:output_type -> ukama.registry.member.v1.MemberResponse
+	4,  // 37: ukama.registry.member.v1.MemberService.GetMember:output_type -> ukama.registry.member.v1.MemberResponse
+	1,  // 38: ukama.registry.member.v1.MemberService.GetMemberByUserId:output_type -> ukama.registry.member.v1.GetMemberByUserIdResponse
+	6,  // 39: ukama.registry.member.v1.MemberService.GetMembers:output_type -> ukama.registry.member.v1.GetMembersResponse
+	4,  // 40: ukama.registry.member.v1.MemberService.UpdateMember:output_type -> ukama.registry.member.v1.MemberResponse
+	4,  // 41: ukama.registry.member.v1.MemberService.RemoveMember:output_type -> ukama.registry.member.v1.MemberResponse
+	12, // 42: ukama.registry.member.v1.MemberService.AddRole:output_type -> ukama.registry.member.v1.RoleResponse
+	12, // 43: ukama.registry.member.v1.MemberService.UpdateRole:output_type -> ukama.registry.member.v1.RoleResponse
+	14, // 44: ukama.registry.member.v1.MemberService.ListRoles:output_type -> ukama.registry.member.v1.ListRolesResponse
+	16, // 45: ukama.registry.member.v1.MemberService.DeleteRole:output_type -> ukama.registry.member.v1.DeleteRoleResponse
+	19, // 46: ukama.registry.member.v1.MemberService.AddRoleBinding:output_type -> ukama.registry.member.v1.RoleBindingResponse
+	21, // 47: ukama.registry.member.v1.MemberService.RemoveRoleBinding:output_type -> ukama.registry.member.v1.RemoveRoleBindingResponse
+	23, // 48: ukama.registry.member.v1.MemberService.ListRoleBindings:output_type -> ukama.registry.member.v1.ListRoleBindingsResponse
+	26, // 49: ukama.registry.member.v1.MemberService.GetAccess:output_type -> ukama.registry.member.v1.GetAccessResponse
+	30, // 50: ukama.registry.member.v1.MemberService.AddDataRequest:output_type -> ukama.registry.member.v1.DataRequestResponse
+	30, // 51: ukama.registry.member.v1.MemberService.GetDataRequest:output_type -> ukama.registry.member.v1.DataRequestResponse
+	33, // 52: ukama.registry.member.v1.MemberService.ListDataRequests:output_type -> ukama.registry.member.v1.ListDataRequestsResponse
+	35, // 53: ukama.registry.member.v1.MemberService.GetDataRequestBundle:output_type -> ukama.registry.member.v1.GetDataRequestBundleResponse
+	36, // [36:54] is the sub-list for method output_type
+	18, // [18:36] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_member_proto_init() }
@@ -569,7 +2188,7 @@ func file_member_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_member_proto_rawDesc), len(file_member_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -7,10 +7,10 @@ import (
 	fmt "fmt"
 	math "math"
 	proto "github.com/golang/protobuf/proto"
+	_ "github.com/mwitkow/go-proto-validators"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	_ "google.golang.org/protobuf/types/known/wrapperspb"
 	_ "github.com/ukama/ukama/systems/common/pb/gen/ukama"
-	_ "github.com/mwitkow/go-proto-validators"
 	regexp "regexp"
 	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
 )
@@ -120,3 +120,235 @@ func (this *Member) Validate() error {
 	}
 	return nil
 }
+func (this *Role) Validate() error {
+	return nil
+}
+
+var _regex_AddRoleRequest_Name = regexp.MustCompile(`^[a-z0-9_-]{1,64}$`)
+
+func (this *AddRoleRequest) Validate() error {
+	if !_regex_AddRoleRequest_Name.MatchString(this.Name) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Name", fmt.Errorf(`value '%v' must be a string conforming to regex "^[a-z0-9_-]{1,64}$"`, this.Name))
+	}
+	if len(this.Permissions) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Permissions", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Permissions))
+	}
+	return nil
+}
+func (this *UpdateRoleRequest) Validate() error {
+	if this.Name == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Name", fmt.Errorf(`value '%v' must not be an empty string`, this.Name))
+	}
+	if len(this.Permissions) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Permissions", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Permissions))
+	}
+	return nil
+}
+func (this *RoleResponse) Validate() error {
+	if this.Role != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Role); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Role", err)
+		}
+	}
+	return nil
+}
+func (this *ListRolesRequest) Validate() error {
+	return nil
+}
+func (this *ListRolesResponse) Validate() error {
+	for _, item := range this.Roles {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Roles", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *DeleteRoleRequest) Validate() error {
+	if this.Name == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Name", fmt.Errorf(`value '%v' must not be an empty string`, this.Name))
+	}
+	return nil
+}
+func (this *DeleteRoleResponse) Validate() error {
+	return nil
+}
+func (this *RoleBinding) Validate() error {
+	if this.CreatedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+		}
+	}
+	return nil
+}
+
+var _regex_AddRoleBindingRequest_UserId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *AddRoleBindingRequest) Validate() error {
+	if !_regex_AddRoleBindingRequest_UserId.MatchString(this.UserId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("UserId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.UserId))
+	}
+	if this.UserId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("UserId", fmt.Errorf(`value '%v' must not be an empty string`, this.UserId))
+	}
+	if this.Role == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Role", fmt.Errorf(`value '%v' must not be an empty string`, this.Role))
+	}
+	return nil
+}
+func (this *RoleBindingResponse) Validate() error {
+	if this.Binding != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Binding); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Binding", err)
+		}
+	}
+	return nil
+}
+
+var _regex_RemoveRoleBindingRequest_Id = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *RemoveRoleBindingRequest) Validate() error {
+	if !_regex_RemoveRoleBindingRequest_Id.MatchString(this.Id) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.Id))
+	}
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	return nil
+}
+func (this *RemoveRoleBindingResponse) Validate() error {
+	return nil
+}
+
+var _regex_ListRoleBindingsRequest_UserId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *ListRoleBindingsRequest) Validate() error {
+	if !_regex_ListRoleBindingsRequest_UserId.MatchString(this.UserId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("UserId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.UserId))
+	}
+	if this.UserId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("UserId", fmt.Errorf(`value '%v' must not be an empty string`, this.UserId))
+	}
+	return nil
+}
+func (this *ListRoleBindingsResponse) Validate() error {
+	for _, item := range this.Bindings {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Bindings", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_GetAccessRequest_UserId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *GetAccessRequest) Validate() error {
+	if !_regex_GetAccessRequest_UserId.MatchString(this.UserId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("UserId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.UserId))
+	}
+	if this.UserId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("UserId", fmt.Errorf(`value '%v' must not be an empty string`, this.UserId))
+	}
+	return nil
+}
+func (this *Grant) Validate() error {
+	return nil
+}
+func (this *GetAccessResponse) Validate() error {
+	for _, item := range this.Grants {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Grants", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *AddDataRequestRequest) Validate() error {
+	if this.Kind == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Kind", fmt.Errorf(`value '%v' must not be an empty string`, this.Kind))
+	}
+	if this.SubjectKind == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SubjectKind", fmt.Errorf(`value '%v' must not be an empty string`, this.SubjectKind))
+	}
+	return nil
+}
+func (this *DataRequestTask) Validate() error {
+	if this.UpdatedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.UpdatedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("UpdatedAt", err)
+		}
+	}
+	return nil
+}
+func (this *DataRequest) Validate() error {
+	for _, item := range this.Tasks {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Tasks", err)
+			}
+		}
+	}
+	if this.CreatedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+		}
+	}
+	if this.CompletedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CompletedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CompletedAt", err)
+		}
+	}
+	return nil
+}
+func (this *DataRequestResponse) Validate() error {
+	if this.Request != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Request); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Request", err)
+		}
+	}
+	return nil
+}
+
+var _regex_GetDataRequestRequest_Id = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *GetDataRequestRequest) Validate() error {
+	if !_regex_GetDataRequestRequest_Id.MatchString(this.Id) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.Id))
+	}
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	return nil
+}
+func (this *ListDataRequestsRequest) Validate() error {
+	return nil
+}
+func (this *ListDataRequestsResponse) Validate() error {
+	for _, item := range this.Requests {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Requests", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_GetDataRequestBundleRequest_Id = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *GetDataRequestBundleRequest) Validate() error {
+	if !_regex_GetDataRequestBundleRequest_Id.MatchString(this.Id) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.Id))
+	}
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	return nil
+}
+func (this *GetDataRequestBundleResponse) Validate() error {
+	return nil
+}
@@ -7,8 +7,8 @@
 
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.6.1
-// - protoc             v7.34.1
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
 // source: member.proto
 
 package gen
@@ -26,12 +26,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MemberService_AddMember_FullMethodName         = "/ukama.registry.member.v1.MemberService/AddMember"
-	MemberService_GetMember_FullMethodName         = "/ukama.registry.member.v1.MemberService/GetMember"
-	MemberService_GetMemberByUserId_FullMethodName = "/ukama.registry.member.v1.MemberService/GetMemberByUserId"
-	MemberService_GetMembers_FullMethodName        = "/ukama.registry.member.v1.MemberService/GetMembers"
-	MemberService_UpdateMember_FullMethodName      = "/ukama.registry.member.v1.MemberService/UpdateMember"
-	MemberService_RemoveMember_FullMethodName      = "/ukama.registry.member.v1.MemberService/RemoveMember"
+	MemberService_AddMember_FullMethodName            = "/ukama.registry.member.v1.MemberService/AddMember"
+	MemberService_GetMember_FullMethodName            = "/ukama.registry.member.v1.MemberService/GetMember"
+	MemberService_GetMemberByUserId_FullMethodName    = "/ukama.registry.member.v1.MemberService/GetMemberByUserId"
+	MemberService_GetMembers_FullMethodName           = "/ukama.registry.member.v1.MemberService/GetMembers"
+	MemberService_UpdateMember_FullMethodName         = "/ukama.registry.member.v1.MemberService/UpdateMember"
+	MemberService_RemoveMember_FullMethodName         = "/ukama.registry.member.v1.MemberService/RemoveMember"
+	MemberService_AddRole_FullMethodName              = "/ukama.registry.member.v1.MemberService/AddRole"
+	MemberService_UpdateRole_FullMethodName           = "/ukama.registry.member.v1.MemberService/UpdateRole"
+	MemberService_ListRoles_FullMethodName            = "/ukama.registry.member.v1.MemberService/ListRoles"
+	MemberService_DeleteRole_FullMethodName           = "/ukama.registry.member.v1.MemberService/DeleteRole"
+	MemberService_AddRoleBinding_FullMethodName       = "/ukama.registry.member.v1.MemberService/AddRoleBinding"
+	MemberService_RemoveRoleBinding_FullMethodName    = "/ukama.registry.member.v1.MemberService/RemoveRoleBinding"
+	MemberService_ListRoleBindings_FullMethodName     = "/ukama.registry.member.v1.MemberService/ListRoleBindings"
+	MemberService_GetAccess_FullMethodName            = "/ukama.registry.member.v1.MemberService/GetAccess"
+	MemberService_AddDataRequest_FullMethodName       = "/ukama.registry.member.v1.MemberService/AddDataRequest"
+	MemberService_GetDataRequest_FullMethodName       = "/ukama.registry.member.v1.MemberService/GetDataRequest"
+	MemberService_ListDataRequests_FullMethodName     = "/ukama.registry.member.v1.MemberService/ListDataRequests"
+	MemberService_GetDataRequestBundle_FullMethodName = "/ukama.registry.member.v1.MemberService/GetDataRequestBundle"
 )
 
 // MemberServiceClient is the client API for MemberService service.
@@ -45,6 +57,21 @@ type MemberServiceClient interface {
 	GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error)
 	UpdateMember(ctx context.Context, in *UpdateMemberRequest, opts ...grpc.CallOption) (*MemberResponse, error)
 	RemoveMember(ctx context.Context, in *MemberRequest, opts ...grpc.CallOption) (*MemberResponse, error)
+	// Roles
+	AddRole(ctx context.Context, in *AddRoleRequest, opts ...grpc.CallOption) (*RoleResponse, error)
+	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*RoleResponse, error)
+	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
+	// Role bindings
+	AddRoleBinding(ctx context.Context, in *AddRoleBindingRequest, opts ...grpc.CallOption) (*RoleBindingResponse, error)
+	RemoveRoleBinding(ctx context.Context, in *RemoveRoleBindingRequest, opts ...grpc.CallOption) (*RemoveRoleBindingResponse, error)
+	ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest, opts ...grpc.CallOption) (*ListRoleBindingsResponse, error)
+	GetAccess(ctx context.Context, in *GetAccessRequest, opts ...grpc.CallOption) (*GetAccessResponse, error)
+	// Data requests
+	AddDataRequest(ctx context.Context, in *AddDataRequestRequest, opts ...grpc.CallOption) (*DataRequestResponse, error)
+	GetDataRequest(ctx context.Context, in *GetDataRequestRequest, opts ...grpc.CallOption) (*DataRequestResponse, error)
+	ListDataRequests(ctx context.Context, in *ListDataRequestsRequest, opts ...grpc.CallOption) (*ListDataRequestsResponse, error)
+	GetDataRequestBundle(ctx context.Context, in *GetDataRequestBundleRequest, opts ...grpc.CallOption) (*GetDataRequestBundleResponse, error)
 }
 
 type memberServiceClient struct {
@@ -115,6 +142,126 @@ func (c *memberServiceClient) RemoveMember(ctx context.Context, in *MemberReques
 	return out, nil
 }
 
+func (c *memberServiceClient) AddRole(ctx context.Context, in *AddRoleRequest, opts ...grpc.CallOption) (*RoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleResponse)
+	err := c.cc.Invoke(ctx, MemberService_AddRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*RoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleResponse)
+	err := c.cc.Invoke(ctx, MemberService_UpdateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRolesResponse)
+	err := c.cc.Invoke(ctx, MemberService_ListRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRoleResponse)
+	err := c.cc.Invoke(ctx, MemberService_DeleteRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) AddRoleBinding(ctx context.Context, in *AddRoleBindingRequest, opts ...grpc.CallOption) (*RoleBindingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleBindingResponse)
+	err := c.cc.Invoke(ctx, MemberService_AddRoleBinding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) RemoveRoleBinding(ctx context.Context, in *RemoveRoleBindingRequest, opts ...grpc.CallOption) (*RemoveRoleBindingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveRoleBindingResponse)
+	err := c.cc.Invoke(ctx, MemberService_RemoveRoleBinding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest, opts ...grpc.CallOption) (*ListRoleBindingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoleBindingsResponse)
+	err := c.cc.Invoke(ctx, MemberService_ListRoleBindings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) GetAccess(ctx context.Context, in *GetAccessRequest, opts ...grpc.CallOption) (*GetAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccessResponse)
+	err := c.cc.Invoke(ctx, MemberService_GetAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) AddDataRequest(ctx context.Context, in *AddDataRequestRequest, opts ...grpc.CallOption) (*DataRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DataRequestResponse)
+	err := c.cc.Invoke(ctx, MemberService_AddDataRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) GetDataRequest(ctx context.Context, in *GetDataRequestRequest, opts ...grpc.CallOption) (*DataRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DataRequestResponse)
+	err := c.cc.Invoke(ctx, MemberService_GetDataRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) ListDataRequests(ctx context.Context, in *ListDataRequestsRequest, opts ...grpc.CallOption) (*ListDataRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDataRequestsResponse)
+	err := c.cc.Invoke(ctx, MemberService_ListDataRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) GetDataRequestBundle(ctx context.Context, in *GetDataRequestBundleRequest, opts ...grpc.CallOption) (*GetDataRequestBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataRequestBundleResponse)
+	err := c.cc.Invoke(ctx, MemberService_GetDataRequestBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MemberServiceServer is the server API for MemberService service.
 // All implementations must embed UnimplementedMemberServiceServer
 // for forward compatibility.
@@ -126,6 +273,21 @@ type MemberServiceServer interface {
 	GetMembers(context.Context, *GetMembersRequest) (*GetMembersResponse, error)
 	UpdateMember(context.Context, *UpdateMemberRequest) (*MemberResponse, error)
 	RemoveMember(context.Context, *MemberRequest) (*MemberResponse, error)
+	// Roles
+	AddRole(context.Context, *AddRoleRequest) (*RoleResponse, error)
+	UpdateRole(context.Context, *UpdateRoleRequest) (*RoleResponse, error)
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
+	// Role bindings
+	AddRoleBinding(context.Context, *AddRoleBindingRequest) (*RoleBindingResponse, error)
+	RemoveRoleBinding(context.Context, *RemoveRoleBindingRequest) (*RemoveRoleBindingResponse, error)
+	ListRoleBindings(context.Context, *ListRoleBindingsRequest) (*ListRoleBindingsResponse, error)
+	GetAccess(context.Context, *GetAccessRequest) (*GetAccessResponse, error)
+	// Data requests
+	AddDataRequest(context.Context, *AddDataRequestRequest) (*DataRequestResponse, error)
+	GetDataRequest(context.Context, *GetDataRequestRequest) (*DataRequestResponse, error)
+	ListDataRequests(context.Context, *ListDataRequestsRequest) (*ListDataRequestsResponse, error)
+	GetDataRequestBundle(context.Context, *GetDataRequestBundleRequest) (*GetDataRequestBundleResponse, error)
 	mustEmbedUnimplementedMemberServiceServer()
 }
 
@@ -137,22 +299,58 @@ type MemberServiceServer interface {
 type UnimplementedMemberServiceServer struct{}
 
 func (UnimplementedMemberServiceServer) AddMember(context.Context, *AddMemberRequest) (*MemberResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method AddMember not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method AddMember not implemented")
 }
 func (UnimplementedMemberServiceServer) GetMember(context.Context, *MemberRequest) (*MemberResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetMember not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method GetMember not implemented")
 }
 func (UnimplementedMemberServiceServer) GetMemberByUserId(context.Context, *GetMemberByUserIdRequest) (*GetMemberByUserIdResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetMemberByUserId not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemberByUserId not implemented")
 }
 func (UnimplementedMemberServiceServer) GetMembers(context.Context, *GetMembersRequest) (*GetMembersResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetMembers not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method GetMembers not implemented")
 }
 func (UnimplementedMemberServiceServer) UpdateMember(context.Context, *UpdateMemberRequest) (*MemberResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateMember not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMember not implemented")
 }
 func (UnimplementedMemberServiceServer) RemoveMember(context.Context, *MemberRequest) (*MemberResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RemoveMember not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedMemberServiceServer) AddRole(context.Context, *AddRoleRequest) (*RoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRole not implemented")
+}
+func (UnimplementedMemberServiceServer) UpdateRole(context.Context, *UpdateRoleRequest) (*RoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedMemberServiceServer) ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedMemberServiceServer) DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (UnimplementedMemberServiceServer) AddRoleBinding(context.Context, *AddRoleBindingRequest) (*RoleBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRoleBinding not implemented")
+}
+func (UnimplementedMemberServiceServer) RemoveRoleBinding(context.Context, *RemoveRoleBindingRequest) (*RemoveRoleBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoleBinding not implemented")
+}
+func (UnimplementedMemberServiceServer) ListRoleBindings(context.Context, *ListRoleBindingsRequest) (*ListRoleBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleBindings not implemented")
+}
+func (UnimplementedMemberServiceServer) GetAccess(context.Context, *GetAccessRequest) (*GetAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccess not implemented")
+}
+func (UnimplementedMemberServiceServer) AddDataRequest(context.Context, *AddDataRequestRequest) (*DataRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDataRequest not implemented")
+}
+func (UnimplementedMemberServiceServer) GetDataRequest(context.Context, *GetDataRequestRequest) (*DataRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataRequest not implemented")
+}
+func (UnimplementedMemberServiceServer) ListDataRequests(context.Context, *ListDataRequestsRequest) (*ListDataRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDataRequests not implemented")
+}
+func (UnimplementedMemberServiceServer) GetDataRequestBundle(context.Context, *GetDataRequestBundleRequest) (*GetDataRequestBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataRequestBundle not implemented")
 }
 func (UnimplementedMemberServiceServer) mustEmbedUnimplementedMemberServiceServer() {}
 func (UnimplementedMemberServiceServer) testEmbeddedByValue()                       {}
@@ -165,7 +363,7 @@ type UnsafeMemberServiceServer interface {
 }
 
 func RegisterMemberServiceServer(s grpc.ServiceRegistrar, srv MemberServiceServer) {
-	// If the following call panics, it indicates UnimplementedMemberServiceServer was
+	// If the following call pancis, it indicates UnimplementedMemberServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
@@ -283,6 +481,222 @@ func _MemberService_RemoveMember_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemberService_AddRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).AddRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_AddRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).AddRole(ctx, req.(*AddRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_UpdateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_ListRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).ListRoles(ctx, req.(*ListRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_DeleteRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_AddRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRoleBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).AddRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_AddRoleBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).AddRoleBinding(ctx, req.(*AddRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_RemoveRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRoleBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).RemoveRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_RemoveRoleBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).RemoveRoleBinding(ctx, req.(*RemoveRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ListRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).ListRoleBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_ListRoleBindings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).ListRoleBindings(ctx, req.(*ListRoleBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_GetAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).GetAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_GetAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).GetAccess(ctx, req.(*GetAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_AddDataRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDataRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).AddDataRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_AddDataRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).AddDataRequest(ctx, req.(*AddDataRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_GetDataRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).GetDataRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_GetDataRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).GetDataRequest(ctx, req.(*GetDataRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ListDataRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDataRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).ListDataRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_ListDataRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).ListDataRequests(ctx, req.(*ListDataRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_GetDataRequestBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataRequestBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).GetDataRequestBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_GetDataRequestBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).GetDataRequestBundle(ctx, req.(*GetDataRequestBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MemberService_ServiceDesc is the grpc.ServiceDesc for MemberService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -314,6 +728,54 @@ var MemberService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveMember",
 			Handler:    _MemberService_RemoveMember_Handler,
 		},
+		{
+			MethodName: "AddRole",
+			Handler:    _MemberService_AddRole_Handler,
+		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _MemberService_UpdateRole_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _MemberService_ListRoles_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _MemberService_DeleteRole_Handler,
+		},
+		{
+			MethodName: "AddRoleBinding",
+			Handler:    _MemberService_AddRoleBinding_Handler,
+		},
+		{
+			MethodName: "RemoveRoleBinding",
+			Handler:    _MemberService_RemoveRoleBinding_Handler,
+		},
+		{
+			MethodName: "ListRoleBindings",
+			Handler:    _MemberService_ListRoleBindings_Handler,
+		},
+		{
+			MethodName: "GetAccess",
+			Handler:    _MemberService_GetAccess_Handler,
+		},
+		{
+			MethodName: "AddDataRequest",
+			Handler:    _MemberService_AddDataRequest_Handler,
+		},
+		{
+			MethodName: "GetDataRequest",
+			Handler:    _MemberService_GetDataRequest_Handler,
+		},
+		{
+			MethodName: "ListDataRequests",
+			Handler:    _MemberService_ListDataRequests_Handler,
+		},
+		{
+			MethodName: "GetDataRequestBundle",
+			Handler:    _MemberService_GetDataRequestBundle_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "member.proto",
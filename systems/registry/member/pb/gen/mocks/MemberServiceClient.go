@@ -16,6 +16,43 @@ type MemberServiceClient struct {
 	mock.Mock
 }
 
+// AddDataRequest provides a mock function with given fields: ctx, in, opts
+func (_m *MemberServiceClient) AddDataRequest(ctx context.Context, in *gen.AddDataRequestRequest, opts ...grpc.CallOption) (*gen.DataRequestResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDataRequest")
+	}
+
+	var r0 *gen.DataRequestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddDataRequestRequest, ...grpc.CallOption) (*gen.DataRequestResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddDataRequestRequest, ...grpc.CallOption) *gen.DataRequestResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DataRequestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AddDataRequestRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddMember provides a mock function with given fields: ctx, in, opts
 func (_m *MemberServiceClient) AddMember(ctx context.Context, in *gen.AddMemberRequest, opts ...grpc.CallOption) (*gen.MemberResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -53,6 +90,228 @@ func (_m *MemberServiceClient) AddMember(ctx context.Context, in *gen.AddMemberR
 	return r0, r1
 }
 
+// AddRole provides a mock function with given fields: ctx, in, opts
+func (_m *MemberServiceClient) AddRole(ctx context.Context, in *gen.AddRoleRequest, opts ...grpc.CallOption) (*gen.RoleResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRole")
+	}
+
+	var r0 *gen.RoleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddRoleRequest, ...grpc.CallOption) (*gen.RoleResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddRoleRequest, ...grpc.CallOption) *gen.RoleResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RoleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AddRoleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddRoleBinding provides a mock function with given fields: ctx, in, opts
+func (_m *MemberServiceClient) AddRoleBinding(ctx context.Context, in *gen.AddRoleBindingRequest, opts ...grpc.CallOption) (*gen.RoleBindingResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRoleBinding")
+	}
+
+	var r0 *gen.RoleBindingResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddRoleBindingRequest, ...grpc.CallOption) (*gen.RoleBindingResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddRoleBindingRequest, ...grpc.CallOption) *gen.RoleBindingResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RoleBindingResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AddRoleBindingRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRole provides a mock function with given fields: ctx, in, opts
+func (_m *MemberServiceClient) DeleteRole(ctx context.Context, in *gen.DeleteRoleRequest, opts ...grpc.CallOption) (*gen.DeleteRoleResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRole")
+	}
+
+	var r0 *gen.DeleteRoleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteRoleRequest, ...grpc.CallOption) (*gen.DeleteRoleResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteRoleRequest, ...grpc.CallOption) *gen.DeleteRoleResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DeleteRoleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteRoleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccess provides a mock function with given fields: ctx, in, opts
+func (_m *MemberServiceClient) GetAccess(ctx context.Context, in *gen.GetAccessRequest, opts ...grpc.CallOption) (*gen.GetAccessResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccess")
+	}
+
+	var r0 *gen.GetAccessResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetAccessRequest, ...grpc.CallOption) (*gen.GetAccessResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetAccessRequest, ...grpc.CallOption) *gen.GetAccessResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetAccessResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetAccessRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDataRequest provides a mock function with given fields: ctx, in, opts
+func (_m *MemberServiceClient) GetDataRequest(ctx context.Context, in *gen.GetDataRequestRequest, opts ...grpc.CallOption) (*gen.DataRequestResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDataRequest")
+	}
+
+	var r0 *gen.DataRequestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDataRequestRequest, ...grpc.CallOption) (*gen.DataRequestResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDataRequestRequest, ...grpc.CallOption) *gen.DataRequestResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DataRequestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetDataRequestRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDataRequestBundle provides a mock function with given fields: ctx, in, opts
+func (_m *MemberServiceClient) GetDataRequestBundle(ctx context.Context, in *gen.GetDataRequestBundleRequest, opts ...grpc.CallOption) (*gen.GetDataRequestBundleResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDataRequestBundle")
+	}
+
+	var r0 *gen.GetDataRequestBundleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDataRequestBundleRequest, ...grpc.CallOption) (*gen.GetDataRequestBundleResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDataRequestBundleRequest, ...grpc.CallOption) *gen.GetDataRequestBundleResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetDataRequestBundleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetDataRequestBundleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMember provides a mock function with given fields: ctx, in, opts
 func (_m *MemberServiceClient) GetMember(ctx context.Context, in *gen.MemberRequest, opts ...grpc.CallOption) (*gen.MemberResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -164,6 +423,117 @@ func (_m *MemberServiceClient) GetMembers(ctx context.Context, in *gen.GetMember
 	return r0, r1
 }
 
+// ListDataRequests provides a mock function with given fields: ctx, in, opts
+func (_m *MemberServiceClient) ListDataRequests(ctx context.Context, in *gen.ListDataRequestsRequest, opts ...grpc.CallOption) (*gen.ListDataRequestsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDataRequests")
+	}
+
+	var r0 *gen.ListDataRequestsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListDataRequestsRequest, ...grpc.CallOption) (*gen.ListDataRequestsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListDataRequestsRequest, ...grpc.CallOption) *gen.ListDataRequestsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListDataRequestsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListDataRequestsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoleBindings provides a mock function with given fields: ctx, in, opts
+func (_m *MemberServiceClient) ListRoleBindings(ctx context.Context, in *gen.ListRoleBindingsRequest, opts ...grpc.CallOption) (*gen.ListRoleBindingsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoleBindings")
+	}
+
+	var r0 *gen.ListRoleBindingsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListRoleBindingsRequest, ...grpc.CallOption) (*gen.ListRoleBindingsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListRoleBindingsRequest, ...grpc.CallOption) *gen.ListRoleBindingsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListRoleBindingsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListRoleBindingsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoles provides a mock function with given fields: ctx, in, opts
+func (_m *MemberServiceClient) ListRoles(ctx context.Context, in *gen.ListRolesRequest, opts ...grpc.CallOption) (*gen.ListRolesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoles")
+	}
+
+	var r0 *gen.ListRolesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListRolesRequest, ...grpc.CallOption) (*gen.ListRolesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListRolesRequest, ...grpc.CallOption) *gen.ListRolesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListRolesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListRolesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveMember provides a mock function with given fields: ctx, in, opts
 func (_m *MemberServiceClient) RemoveMember(ctx context.Context, in *gen.MemberRequest, opts ...grpc.CallOption) (*gen.MemberResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -201,6 +571,43 @@ func (_m *MemberServiceClient) RemoveMember(ctx context.Context, in *gen.MemberR
 	return r0, r1
 }
 
+// RemoveRoleBinding provides a mock function with given fields: ctx, in, opts
+func (_m *MemberServiceClient) RemoveRoleBinding(ctx context.Context, in *gen.RemoveRoleBindingRequest, opts ...grpc.CallOption) (*gen.RemoveRoleBindingResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveRoleBinding")
+	}
+
+	var r0 *gen.RemoveRoleBindingResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RemoveRoleBindingRequest, ...grpc.CallOption) (*gen.RemoveRoleBindingResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RemoveRoleBindingRequest, ...grpc.CallOption) *gen.RemoveRoleBindingResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RemoveRoleBindingResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RemoveRoleBindingRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateMember provides a mock function with given fields: ctx, in, opts
 func (_m *MemberServiceClient) UpdateMember(ctx context.Context, in *gen.UpdateMemberRequest, opts ...grpc.CallOption) (*gen.MemberResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -238,6 +645,43 @@ func (_m *MemberServiceClient) UpdateMember(ctx context.Context, in *gen.UpdateM
 	return r0, r1
 }
 
+// UpdateRole provides a mock function with given fields: ctx, in, opts
+func (_m *MemberServiceClient) UpdateRole(ctx context.Context, in *gen.UpdateRoleRequest, opts ...grpc.CallOption) (*gen.RoleResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 *gen.RoleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpdateRoleRequest, ...grpc.CallOption) (*gen.RoleResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpdateRoleRequest, ...grpc.CallOption) *gen.RoleResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RoleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpdateRoleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewMemberServiceClient creates a new instance of MemberServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMemberServiceClient(t interface {
@@ -14,6 +14,36 @@ type MemberServiceServer struct {
 	mock.Mock
 }
 
+// AddDataRequest provides a mock function with given fields: _a0, _a1
+func (_m *MemberServiceServer) AddDataRequest(_a0 context.Context, _a1 *gen.AddDataRequestRequest) (*gen.DataRequestResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDataRequest")
+	}
+
+	var r0 *gen.DataRequestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddDataRequestRequest) (*gen.DataRequestResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddDataRequestRequest) *gen.DataRequestResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DataRequestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AddDataRequestRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddMember provides a mock function with given fields: _a0, _a1
 func (_m *MemberServiceServer) AddMember(_a0 context.Context, _a1 *gen.AddMemberRequest) (*gen.MemberResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -44,6 +74,186 @@ func (_m *MemberServiceServer) AddMember(_a0 context.Context, _a1 *gen.AddMember
 	return r0, r1
 }
 
+// AddRole provides a mock function with given fields: _a0, _a1
+func (_m *MemberServiceServer) AddRole(_a0 context.Context, _a1 *gen.AddRoleRequest) (*gen.RoleResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRole")
+	}
+
+	var r0 *gen.RoleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddRoleRequest) (*gen.RoleResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddRoleRequest) *gen.RoleResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RoleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AddRoleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddRoleBinding provides a mock function with given fields: _a0, _a1
+func (_m *MemberServiceServer) AddRoleBinding(_a0 context.Context, _a1 *gen.AddRoleBindingRequest) (*gen.RoleBindingResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRoleBinding")
+	}
+
+	var r0 *gen.RoleBindingResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddRoleBindingRequest) (*gen.RoleBindingResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.AddRoleBindingRequest) *gen.RoleBindingResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RoleBindingResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.AddRoleBindingRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRole provides a mock function with given fields: _a0, _a1
+func (_m *MemberServiceServer) DeleteRole(_a0 context.Context, _a1 *gen.DeleteRoleRequest) (*gen.DeleteRoleResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRole")
+	}
+
+	var r0 *gen.DeleteRoleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteRoleRequest) (*gen.DeleteRoleResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DeleteRoleRequest) *gen.DeleteRoleResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DeleteRoleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DeleteRoleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccess provides a mock function with given fields: _a0, _a1
+func (_m *MemberServiceServer) GetAccess(_a0 context.Context, _a1 *gen.GetAccessRequest) (*gen.GetAccessResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccess")
+	}
+
+	var r0 *gen.GetAccessResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetAccessRequest) (*gen.GetAccessResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetAccessRequest) *gen.GetAccessResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetAccessResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetAccessRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDataRequest provides a mock function with given fields: _a0, _a1
+func (_m *MemberServiceServer) GetDataRequest(_a0 context.Context, _a1 *gen.GetDataRequestRequest) (*gen.DataRequestResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDataRequest")
+	}
+
+	var r0 *gen.DataRequestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDataRequestRequest) (*gen.DataRequestResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDataRequestRequest) *gen.DataRequestResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DataRequestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetDataRequestRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDataRequestBundle provides a mock function with given fields: _a0, _a1
+func (_m *MemberServiceServer) GetDataRequestBundle(_a0 context.Context, _a1 *gen.GetDataRequestBundleRequest) (*gen.GetDataRequestBundleResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDataRequestBundle")
+	}
+
+	var r0 *gen.GetDataRequestBundleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDataRequestBundleRequest) (*gen.GetDataRequestBundleResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetDataRequestBundleRequest) *gen.GetDataRequestBundleResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetDataRequestBundleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetDataRequestBundleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMember provides a mock function with given fields: _a0, _a1
 func (_m *MemberServiceServer) GetMember(_a0 context.Context, _a1 *gen.MemberRequest) (*gen.MemberResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -134,6 +344,96 @@ func (_m *MemberServiceServer) GetMembers(_a0 context.Context, _a1 *gen.GetMembe
 	return r0, r1
 }
 
+// ListDataRequests provides a mock function with given fields: _a0, _a1
+func (_m *MemberServiceServer) ListDataRequests(_a0 context.Context, _a1 *gen.ListDataRequestsRequest) (*gen.ListDataRequestsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDataRequests")
+	}
+
+	var r0 *gen.ListDataRequestsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListDataRequestsRequest) (*gen.ListDataRequestsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListDataRequestsRequest) *gen.ListDataRequestsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListDataRequestsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListDataRequestsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoleBindings provides a mock function with given fields: _a0, _a1
+func (_m *MemberServiceServer) ListRoleBindings(_a0 context.Context, _a1 *gen.ListRoleBindingsRequest) (*gen.ListRoleBindingsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoleBindings")
+	}
+
+	var r0 *gen.ListRoleBindingsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListRoleBindingsRequest) (*gen.ListRoleBindingsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListRoleBindingsRequest) *gen.ListRoleBindingsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListRoleBindingsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListRoleBindingsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoles provides a mock function with given fields: _a0, _a1
+func (_m *MemberServiceServer) ListRoles(_a0 context.Context, _a1 *gen.ListRolesRequest) (*gen.ListRolesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoles")
+	}
+
+	var r0 *gen.ListRolesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListRolesRequest) (*gen.ListRolesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ListRolesRequest) *gen.ListRolesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ListRolesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ListRolesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveMember provides a mock function with given fields: _a0, _a1
 func (_m *MemberServiceServer) RemoveMember(_a0 context.Context, _a1 *gen.MemberRequest) (*gen.MemberResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -164,6 +464,36 @@ func (_m *MemberServiceServer) RemoveMember(_a0 context.Context, _a1 *gen.Member
 	return r0, r1
 }
 
+// RemoveRoleBinding provides a mock function with given fields: _a0, _a1
+func (_m *MemberServiceServer) RemoveRoleBinding(_a0 context.Context, _a1 *gen.RemoveRoleBindingRequest) (*gen.RemoveRoleBindingResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveRoleBinding")
+	}
+
+	var r0 *gen.RemoveRoleBindingResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RemoveRoleBindingRequest) (*gen.RemoveRoleBindingResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RemoveRoleBindingRequest) *gen.RemoveRoleBindingResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RemoveRoleBindingResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RemoveRoleBindingRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateMember provides a mock function with given fields: _a0, _a1
 func (_m *MemberServiceServer) UpdateMember(_a0 context.Context, _a1 *gen.UpdateMemberRequest) (*gen.MemberResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -194,6 +524,36 @@ func (_m *MemberServiceServer) UpdateMember(_a0 context.Context, _a1 *gen.Update
 	return r0, r1
 }
 
+// UpdateRole provides a mock function with given fields: _a0, _a1
+func (_m *MemberServiceServer) UpdateRole(_a0 context.Context, _a1 *gen.UpdateRoleRequest) (*gen.RoleResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 *gen.RoleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpdateRoleRequest) (*gen.RoleResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.UpdateRoleRequest) *gen.RoleResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RoleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.UpdateRoleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // mustEmbedUnimplementedMemberServiceServer provides a mock function with no fields
 func (_m *MemberServiceServer) mustEmbedUnimplementedMemberServiceServer() {
 	_m.Called()
@@ -70,8 +70,15 @@ func NewConfig(name string) *Config {
 		},
 		Service: uconf.LoadServiceHostConfig(name),
 		MsgClient: &uconf.MsgClient{
-			Timeout:        5 * time.Second,
-			ListenerRoutes: []string{"event.cloud.local.{{ .Org}}.registry.invitation.invitation.update"},
+			Timeout: 5 * time.Second,
+			ListenerRoutes: []string{
+				"event.cloud.local.{{ .Org}}.registry.invitation.invitation.update",
+				"event.cloud.local.{{ .Org}}.subscriber.registry.datarequest.complete",
+				"event.cloud.local.{{ .Org}}.subscriber.simmanager.datarequest.complete",
+				"event.cloud.local.{{ .Org}}.ukamaagent.cdr.datarequest.complete",
+				"event.cloud.local.{{ .Org}}.billing.report.datarequest.complete",
+				"event.cloud.local.{{ .Org}}.notification.eventnotify.datarequest.complete",
+			},
 		},
 	}
 }
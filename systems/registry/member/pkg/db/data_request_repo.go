@@ -0,0 +1,103 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ukama/ukama/systems/common/sql"
+	"github.com/ukama/ukama/systems/common/uuid"
+)
+
+type DataRequestRepo interface {
+	Add(req *DataRequest) error
+	Get(id uuid.UUID) (*DataRequest, error)
+	List() ([]DataRequest, error)
+	// UpdateTask records the answer of a service to a pending task. Once no
+	// task is pending the request is completed, or failed if any task
+	// failed. It returns the request as updated.
+	UpdateTask(task *DataRequestTask) (*DataRequest, error)
+}
+
+type dataRequestRepo struct {
+	Db sql.Db
+}
+
+func NewDataRequestRepo(db sql.Db) DataRequestRepo {
+	return &dataRequestRepo{
+		Db: db,
+	}
+}
+
+func (r *dataRequestRepo) Add(req *DataRequest) error {
+	return r.Db.GetGormDb().Create(req).Error
+}
+
+func (r *dataRequestRepo) Get(id uuid.UUID) (*DataRequest, error) {
+	var req DataRequest
+	err := r.Db.GetGormDb().Preload(clause.Associations).Where("id = ?", id).First(&req).Error
+	if err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+func (r *dataRequestRepo) List() ([]DataRequest, error) {
+	var reqs []DataRequest
+	err := r.Db.GetGormDb().Preload(clause.Associations).Order("created_at desc").Find(&reqs).Error
+	if err != nil {
+		return nil, err
+	}
+	return reqs, nil
+}
+
+func (r *dataRequestRepo) UpdateTask(task *DataRequestTask) (*DataRequest, error) {
+	var req DataRequest
+
+	err := r.Db.GetGormDb().Transaction(func(tx *gorm.DB) error {
+		d := tx.Model(&DataRequestTask{}).
+			Where("request_id = ? and service = ? and status = ?", task.RequestId, task.Service, DataRequestPending).
+			Select("status", "error", "note", "data", "updated_at").Updates(task)
+		if d.Error != nil {
+			return d.Error
+		}
+		if d.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Preload(clause.Associations).Where("id = ?", task.RequestId).First(&req).Error; err != nil {
+			return err
+		}
+
+		status := DataRequestCompleted
+		for _, t := range req.Tasks {
+			switch t.Status {
+			case DataRequestPending:
+				return nil
+			case DataRequestFailed:
+				status = DataRequestFailed
+			}
+		}
+
+		now := time.Now()
+		req.Status = status
+		req.CompletedAt = &now
+
+		return tx.Model(&DataRequest{}).Where("id = ?", req.Id).
+			Updates(map[string]interface{}{"status": status, "completed_at": now}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
@@ -0,0 +1,82 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/tj/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/ukama/ukama/systems/common/uuid"
+)
+
+func setupDataRequestTestDB(t *testing.T) (sqlmock.Sqlmock, DataRequestRepo) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		DSN:                  "sqlmock_db_0",
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gdb, err := gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(t, err)
+
+	return mock, NewDataRequestRepo(&UkamaDbMock{GormDb: gdb})
+}
+
+func Test_UpdateTask(t *testing.T) {
+	requestId := uuid.NewV4()
+
+	t.Run("NoPendingTask", func(t *testing.T) {
+		mock, repo := setupDataRequestTestDB(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "data_request_tasks"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err := repo.UpdateTask(&DataRequestTask{RequestId: requestId, Service: "billing.report", Status: DataRequestCompleted})
+
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("LastTaskCompletesRequest", func(t *testing.T) {
+		mock, repo := setupDataRequestTestDB(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "data_request_tasks"`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "data_requests"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "kind", "status"}).
+				AddRow(requestId, "export", DataRequestPending))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "data_request_tasks"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"request_id", "service", "status"}).
+				AddRow(requestId, "billing.report", DataRequestCompleted).
+				AddRow(requestId, "subscriber.registry", DataRequestFailed))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "data_requests"`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		dr, err := repo.UpdateTask(&DataRequestTask{RequestId: requestId, Service: "billing.report", Status: DataRequestCompleted})
+
+		assert.NoError(t, err)
+		assert.Equal(t, DataRequestFailed, dr.Status)
+		assert.NotNil(t, dr.CompletedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
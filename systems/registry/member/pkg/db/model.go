@@ -9,6 +9,8 @@
 package db
 
 import (
+	"time"
+
 	"github.com/ukama/ukama/systems/common/roles"
 	uuid "github.com/ukama/ukama/systems/common/uuid"
 	"gorm.io/gorm"
@@ -21,3 +23,58 @@ type Member struct {
 	Deactivated bool           `gorm:"default:false"`
 	Role        roles.RoleType `gorm:"type:uint;not null"` // Set the default value to Member
 }
+
+// Role is a custom role, a named set of permissions such as
+// "node.nodes.write" that role bindings grant to members.
+type Role struct {
+	Name        string `gorm:"primaryKey"`
+	Description string
+	Permissions []string `gorm:"serializer:json"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// RoleBinding grants a built-in or custom role to a member on the org, a
+// network or a site. ScopeId is empty on the org.
+type RoleBinding struct {
+	Id        uuid.UUID `gorm:"primaryKey;type:uuid"`
+	UserId    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_role_binding"`
+	Role      string    `gorm:"not null;index;uniqueIndex:idx_role_binding"`
+	ScopeKind string    `gorm:"not null;uniqueIndex:idx_role_binding"`
+	ScopeId   string    `gorm:"uniqueIndex:idx_role_binding"`
+	CreatedAt time.Time
+}
+
+const (
+	DataRequestPending   = "pending"
+	DataRequestCompleted = "completed"
+	DataRequestFailed    = "failed"
+)
+
+// DataRequest is an export or erasure of the data of the org or of one of
+// its subscribers, carried out by the services holding it. Each of them has
+// a task, completed once it has answered.
+type DataRequest struct {
+	Id          uuid.UUID `gorm:"primaryKey;type:uuid"`
+	Kind        string    `gorm:"not null"`
+	SubjectKind string    `gorm:"not null"`
+	SubjectId   string    `gorm:"not null"`
+	Status      string    `gorm:"not null;index"`
+	RequestedBy string
+	Tasks       []DataRequestTask `gorm:"foreignKey:RequestId"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// DataRequestTask is the part of a service in a data request. Data holds
+// the export document of the service.
+type DataRequestTask struct {
+	RequestId uuid.UUID `gorm:"primaryKey;type:uuid"`
+	Service   string    `gorm:"primaryKey"`
+	Status    string    `gorm:"not null"`
+	Error     string
+	Note      string
+	Data      []byte
+	UpdatedAt time.Time
+}
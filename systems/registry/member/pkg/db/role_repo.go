@@ -0,0 +1,122 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/ukama/ukama/systems/common/sql"
+	"github.com/ukama/ukama/systems/common/uuid"
+)
+
+var ErrRoleInUse = errors.New("role is still bound to members")
+
+type RoleRepo interface {
+	AddRole(role *Role) error
+	GetRole(name string) (*Role, error)
+	ListRoles() ([]Role, error)
+	UpdateRole(role *Role) error
+	// DeleteRole fails with ErrRoleInUse while bindings refer to the role
+	DeleteRole(name string) error
+
+	AddBinding(binding *RoleBinding) error
+	RemoveBinding(id uuid.UUID) error
+	ListBindings(userId uuid.UUID) ([]RoleBinding, error)
+}
+
+type roleRepo struct {
+	Db sql.Db
+}
+
+func NewRoleRepo(db sql.Db) RoleRepo {
+	return &roleRepo{
+		Db: db,
+	}
+}
+
+func (r *roleRepo) AddRole(role *Role) error {
+	return r.Db.GetGormDb().Create(role).Error
+}
+
+func (r *roleRepo) GetRole(name string) (*Role, error) {
+	var role Role
+	err := r.Db.GetGormDb().Where("name = ?", name).First(&role).Error
+	if err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+func (r *roleRepo) ListRoles() ([]Role, error) {
+	var roles []Role
+	err := r.Db.GetGormDb().Order("name").Find(&roles).Error
+	if err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+func (r *roleRepo) UpdateRole(role *Role) error {
+	d := r.Db.GetGormDb().Model(&Role{}).Where("name = ?", role.Name).
+		Select("description", "permissions", "updated_at").Updates(role)
+	if d.Error != nil {
+		return d.Error
+	}
+	if d.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *roleRepo) DeleteRole(name string) error {
+	return r.Db.GetGormDb().Transaction(func(tx *gorm.DB) error {
+		var bound int64
+		if err := tx.Model(&RoleBinding{}).Where("role = ?", name).Count(&bound).Error; err != nil {
+			return err
+		}
+		if bound > 0 {
+			return ErrRoleInUse
+		}
+
+		d := tx.Where("name = ?", name).Delete(&Role{})
+		if d.Error != nil {
+			return d.Error
+		}
+		if d.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+func (r *roleRepo) AddBinding(binding *RoleBinding) error {
+	return r.Db.GetGormDb().Create(binding).Error
+}
+
+func (r *roleRepo) RemoveBinding(id uuid.UUID) error {
+	d := r.Db.GetGormDb().Where("id = ?", id).Delete(&RoleBinding{})
+	if d.Error != nil {
+		return d.Error
+	}
+	if d.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *roleRepo) ListBindings(userId uuid.UUID) ([]RoleBinding, error) {
+	var bindings []RoleBinding
+	err := r.Db.GetGormDb().Where("user_id = ?", userId).Order("created_at").Find(&bindings).Error
+	if err != nil {
+		return nil, err
+	}
+	return bindings, nil
+}
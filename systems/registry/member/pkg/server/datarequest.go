@@ -0,0 +1,330 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"github.com/ukama/ukama/systems/common/grpc"
+	"github.com/ukama/ukama/systems/common/privacy"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/registry/member/pkg"
+	"github.com/ukama/ukama/systems/registry/member/pkg/db"
+
+	log "github.com/sirupsen/logrus"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	pb "github.com/ukama/ukama/systems/registry/member/pb/gen"
+)
+
+// DataRequestServices are the services, as <system>.<service>, holding data
+// of the org and of its subscribers. Each one answers a data request on
+// event.cloud.local.<org>.<system>.<service>.datarequest.complete.
+var DataRequestServices = []string{
+	"subscriber.registry",
+	"subscriber.simmanager",
+	"ukamaagent.cdr",
+	"billing.report",
+	"notification.eventnotify",
+}
+
+// memberService exports the members, roles and role bindings of the org
+// itself, as the request is created.
+const memberService = pkg.SystemName + "." + pkg.ServiceName
+
+func (m *MemberServer) AddDataRequest(ctx context.Context, req *pb.AddDataRequestRequest) (*pb.DataRequestResponse, error) {
+	log.Infof("Adding %s data request for %s %s", req.Kind, req.SubjectKind, req.SubjectId)
+
+	if req.Kind != privacy.KindExport && req.Kind != privacy.KindErase {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid data request kind %q", req.Kind)
+	}
+
+	subjectId := req.SubjectId
+	switch req.SubjectKind {
+	case privacy.SubjectOrg:
+		/* the org record itself is owned by nucleus and deleted there */
+		if req.Kind == privacy.KindErase {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"org data can only be exported, erase its subscribers and delete the org instead")
+		}
+		subjectId = m.OrgId.String()
+	case privacy.SubjectSubscriber:
+		if _, err := uuid.FromString(subjectId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid subscriber id %q: %v", subjectId, err)
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid data request subject %q", req.SubjectKind)
+	}
+
+	dr := &db.DataRequest{
+		Id:          uuid.NewV4(),
+		Kind:        req.Kind,
+		SubjectKind: req.SubjectKind,
+		SubjectId:   subjectId,
+		Status:      db.DataRequestPending,
+		RequestedBy: req.RequestedBy,
+	}
+	for _, s := range DataRequestServices {
+		dr.Tasks = append(dr.Tasks, db.DataRequestTask{Service: s, Status: db.DataRequestPending})
+	}
+
+	if dr.SubjectKind == privacy.SubjectOrg {
+		dr.Tasks = append(dr.Tasks, m.exportOrgMembers())
+	}
+
+	if err := m.dRepo.Add(dr); err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "data request")
+	}
+
+	if m.msgbus != nil {
+		route := m.baseRoutingKey.SetActionCreate().SetObject("datarequest").MustBuild()
+		evt := &epb.EventDataRequest{
+			Id:          dr.Id.String(),
+			Kind:        dr.Kind,
+			SubjectKind: dr.SubjectKind,
+			SubjectId:   dr.SubjectId,
+		}
+		err := m.msgbus.PublishRequest(route, evt)
+		if err != nil {
+			log.Errorf("Failed to publish message %+v with key %+v. Errors %s", evt, route, err.Error())
+		}
+	}
+
+	return &pb.DataRequestResponse{Request: dbDataRequestToPbDataRequest(dr)}, nil
+}
+
+func (m *MemberServer) GetDataRequest(ctx context.Context, req *pb.GetDataRequestRequest) (*pb.DataRequestResponse, error) {
+	dr, err := m.getDataRequest(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DataRequestResponse{Request: dbDataRequestToPbDataRequest(dr)}, nil
+}
+
+func (m *MemberServer) ListDataRequests(ctx context.Context, req *pb.ListDataRequestsRequest) (*pb.ListDataRequestsResponse, error) {
+	drs, err := m.dRepo.List()
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "data request")
+	}
+
+	resp := &pb.ListDataRequestsResponse{}
+	for i := range drs {
+		resp.Requests = append(resp.Requests, dbDataRequestToPbDataRequest(&drs[i]))
+	}
+
+	return resp, nil
+}
+
+// GetDataRequestBundle joins the documents exported by the services once
+// all of them have answered. Services that failed are left out, the
+// request tells which.
+func (m *MemberServer) GetDataRequestBundle(ctx context.Context, req *pb.GetDataRequestBundleRequest) (*pb.GetDataRequestBundleResponse, error) {
+	dr, err := m.getDataRequest(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if dr.Kind != privacy.KindExport {
+		return nil, status.Errorf(codes.FailedPrecondition, "data request %s is not an export", req.Id)
+	}
+
+	if dr.Status == db.DataRequestPending {
+		return nil, status.Errorf(codes.FailedPrecondition, "data request %s is still pending", req.Id)
+	}
+
+	parts := map[string]privacy.Document{}
+	for _, t := range dr.Tasks {
+		if t.Status != db.DataRequestCompleted {
+			continue
+		}
+
+		doc, err := privacy.ParseDocument(t.Data)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "export of %s: %v", t.Service, err)
+		}
+		parts[t.Service] = doc
+	}
+
+	name := "export-" + dr.SubjectKind + "-" + dr.Id.String()
+	resp := &pb.GetDataRequestBundleResponse{}
+
+	switch req.Format {
+	case "", privacy.FormatJson:
+		resp.Data, err = privacy.BundleJson(parts)
+		resp.ContentType = "application/json"
+		resp.FileName = name + ".json"
+	case privacy.FormatCsv:
+		resp.Data, err = privacy.BundleCsv(parts)
+		resp.ContentType = "application/zip"
+		resp.FileName = name + ".zip"
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bundle format %q", req.Format)
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to bundle data request %s: %v", req.Id, err)
+	}
+
+	return resp, nil
+}
+
+// HandleDataRequestResult records the answer of a service and announces the
+// request as complete once every service has answered.
+func (m *MemberServer) HandleDataRequestResult(res *epb.EventDataRequestResult) error {
+	id, err := uuid.FromString(res.RequestId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid data request id %q: %v", res.RequestId, err)
+	}
+
+	task := &db.DataRequestTask{
+		RequestId: id,
+		Service:   res.Service,
+		Status:    db.DataRequestCompleted,
+		Note:      res.Note,
+		Data:      res.Data,
+	}
+	if !res.Success {
+		task.Status = db.DataRequestFailed
+		task.Error = res.Error
+	}
+
+	dr, err := m.dRepo.UpdateTask(task)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Warnf("No pending task of %s in data request %s", res.Service, res.RequestId)
+			return nil
+		}
+		return err
+	}
+
+	if dr.Status == db.DataRequestPending || m.msgbus == nil {
+		return nil
+	}
+
+	route := m.baseRoutingKey.SetAction("complete").SetObject("datarequest").MustBuild()
+	evt := &epb.EventDataRequestCompleted{
+		Id:          dr.Id.String(),
+		Kind:        dr.Kind,
+		SubjectKind: dr.SubjectKind,
+		SubjectId:   dr.SubjectId,
+		Status:      dr.Status,
+	}
+	if err := m.msgbus.PublishRequest(route, evt); err != nil {
+		log.Errorf("Failed to publish message %+v with key %+v. Errors %s", evt, route, err.Error())
+	}
+
+	return nil
+}
+
+func (m *MemberServer) getDataRequest(id string) (*db.DataRequest, error) {
+	uid, err := uuid.FromString(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid data request id %q: %v", id, err)
+	}
+
+	dr, err := m.dRepo.Get(uid)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "data request")
+	}
+
+	return dr, nil
+}
+
+func (m *MemberServer) exportOrgMembers() db.DataRequestTask {
+	task := db.DataRequestTask{Service: memberService, Status: db.DataRequestCompleted}
+
+	doc, err := m.orgMembersDocument()
+	if err == nil {
+		task.Data, err = json.Marshal(doc)
+	}
+
+	if err != nil {
+		log.Errorf("Failed to export members of org %s: %v", m.OrgName, err)
+		task.Status = db.DataRequestFailed
+		task.Error = err.Error()
+	}
+
+	return task
+}
+
+func (m *MemberServer) orgMembersDocument() (privacy.Document, error) {
+	members, err := m.mRepo.GetMembers()
+	if err != nil {
+		return nil, err
+	}
+
+	customRoles, err := m.rRepo.ListRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	doc := privacy.Document{}
+	if err := doc.Add("members", dbMembersToPbMembers(members)); err != nil {
+		return nil, err
+	}
+
+	pbRoles := []*pb.Role{}
+	for i := range customRoles {
+		pbRoles = append(pbRoles, dbRoleToPbRole(&customRoles[i]))
+	}
+	if err := doc.Add("roles", pbRoles); err != nil {
+		return nil, err
+	}
+
+	bindings := []*pb.RoleBinding{}
+	for _, mem := range members {
+		bs, err := m.rRepo.ListBindings(mem.UserId)
+		if err != nil {
+			return nil, err
+		}
+		for i := range bs {
+			bindings = append(bindings, dbBindingToPbBinding(&bs[i]))
+		}
+	}
+	if err := doc.Add("role_bindings", bindings); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+func dbDataRequestToPbDataRequest(dr *db.DataRequest) *pb.DataRequest {
+	r := &pb.DataRequest{
+		Id:          dr.Id.String(),
+		Kind:        dr.Kind,
+		SubjectKind: dr.SubjectKind,
+		SubjectId:   dr.SubjectId,
+		Status:      dr.Status,
+		RequestedBy: dr.RequestedBy,
+		CreatedAt:   timestamppb.New(dr.CreatedAt),
+	}
+
+	if dr.CompletedAt != nil {
+		r.CompletedAt = timestamppb.New(*dr.CompletedAt)
+	}
+
+	for _, t := range dr.Tasks {
+		r.Tasks = append(r.Tasks, &pb.DataRequestTask{
+			Service:   t.Service,
+			Status:    t.Status,
+			Error:     t.Error,
+			Note:      t.Note,
+			UpdatedAt: timestamppb.New(t.UpdatedAt),
+		})
+	}
+
+	return r
+}
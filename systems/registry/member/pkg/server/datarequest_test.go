@@ -0,0 +1,223 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"github.com/ukama/ukama/systems/common/privacy"
+	"github.com/ukama/ukama/systems/common/roles"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/registry/member/mocks"
+	"github.com/ukama/ukama/systems/registry/member/pkg/db"
+
+	cmocks "github.com/ukama/ukama/systems/common/mocks"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	pb "github.com/ukama/ukama/systems/registry/member/pb/gen"
+)
+
+func TestMemberServer_AddDataRequest(t *testing.T) {
+	t.Run("SubscriberErase", func(t *testing.T) {
+		dRepo := &mocks.DataRequestRepo{}
+		msgbus := &cmocks.MsgBusServiceClient{}
+		s := NewMemberServer(testOrgName, nil, nil, dRepo, nil, nil, msgbus, testPushGateway, orgId)
+		subscriberId := uuid.NewV4().String()
+
+		dRepo.On("Add", mock.MatchedBy(func(dr *db.DataRequest) bool {
+			return dr.Kind == privacy.KindErase && dr.SubjectId == subscriberId &&
+				len(dr.Tasks) == len(DataRequestServices) && dr.Status == db.DataRequestPending
+		})).Return(nil).Once()
+		msgbus.On("PublishRequest", "event.cloud.local.testorg.registry.member.datarequest.create",
+			mock.MatchedBy(func(e *epb.EventDataRequest) bool {
+				return e.Kind == privacy.KindErase && e.SubjectId == subscriberId
+			})).Return(nil).Once()
+
+		resp, err := s.AddDataRequest(context.TODO(), &pb.AddDataRequestRequest{
+			Kind:        privacy.KindErase,
+			SubjectKind: privacy.SubjectSubscriber,
+			SubjectId:   subscriberId,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, db.DataRequestPending, resp.Request.Status)
+		dRepo.AssertExpectations(t)
+		msgbus.AssertExpectations(t)
+	})
+
+	t.Run("OrgExportAddsMembers", func(t *testing.T) {
+		mRepo := &mocks.MemberRepo{}
+		rRepo := &mocks.RoleRepo{}
+		dRepo := &mocks.DataRequestRepo{}
+		s := NewMemberServer(testOrgName, mRepo, rRepo, dRepo, nil, nil, nil, testPushGateway, orgId)
+
+		mRepo.On("GetMembers").Return([]db.Member{{MemberId: uuid.NewV4(), UserId: testUserId1, Role: roles.TYPE_OWNER}}, nil).Once()
+		rRepo.On("ListRoles").Return([]db.Role{*fieldTechRole()}, nil).Once()
+		rRepo.On("ListBindings", testUserId1).Return([]db.RoleBinding{{UserId: testUserId1, Role: "field_tech", ScopeKind: "org"}}, nil).Once()
+		dRepo.On("Add", mock.Anything).Return(nil).Once()
+
+		resp, err := s.AddDataRequest(context.TODO(), &pb.AddDataRequestRequest{
+			Kind:        privacy.KindExport,
+			SubjectKind: privacy.SubjectOrg,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, orgId.String(), resp.Request.SubjectId)
+
+		dr := dRepo.Calls[0].Arguments.Get(0).(*db.DataRequest)
+		task := dr.Tasks[len(dr.Tasks)-1]
+		assert.Equal(t, memberService, task.Service)
+		assert.Equal(t, db.DataRequestCompleted, task.Status)
+
+		doc, err := privacy.ParseDocument(task.Data)
+		assert.NoError(t, err)
+		assert.Len(t, doc["members"], 1)
+		assert.Len(t, doc["roles"], 1)
+		assert.Len(t, doc["role_bindings"], 1)
+	})
+
+	t.Run("OrgEraseRejected", func(t *testing.T) {
+		s := NewMemberServer(testOrgName, nil, nil, &mocks.DataRequestRepo{}, nil, nil, nil, testPushGateway, orgId)
+
+		_, err := s.AddDataRequest(context.TODO(), &pb.AddDataRequestRequest{
+			Kind:        privacy.KindErase,
+			SubjectKind: privacy.SubjectOrg,
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("InvalidSubscriberId", func(t *testing.T) {
+		s := NewMemberServer(testOrgName, nil, nil, &mocks.DataRequestRepo{}, nil, nil, nil, testPushGateway, orgId)
+
+		_, err := s.AddDataRequest(context.TODO(), &pb.AddDataRequestRequest{
+			Kind:        privacy.KindExport,
+			SubjectKind: privacy.SubjectSubscriber,
+			SubjectId:   "jane",
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestMemberServer_HandleDataRequestResult(t *testing.T) {
+	requestId := uuid.NewV4()
+
+	t.Run("LastTaskCompletesRequest", func(t *testing.T) {
+		dRepo := &mocks.DataRequestRepo{}
+		msgbus := &cmocks.MsgBusServiceClient{}
+		s := NewMemberServer(testOrgName, nil, nil, dRepo, nil, nil, msgbus, testPushGateway, orgId)
+
+		now := time.Now()
+		dRepo.On("UpdateTask", mock.MatchedBy(func(t *db.DataRequestTask) bool {
+			return t.RequestId == requestId && t.Service == "billing.report" &&
+				t.Status == db.DataRequestFailed && t.Error == "db down"
+		})).Return(&db.DataRequest{Id: requestId, Kind: privacy.KindErase, Status: db.DataRequestFailed, CompletedAt: &now}, nil).Once()
+		msgbus.On("PublishRequest", "event.cloud.local.testorg.registry.member.datarequest.complete",
+			mock.MatchedBy(func(e *epb.EventDataRequestCompleted) bool {
+				return e.Id == requestId.String() && e.Status == db.DataRequestFailed
+			})).Return(nil).Once()
+
+		err := s.HandleDataRequestResult(&epb.EventDataRequestResult{
+			RequestId: requestId.String(),
+			Service:   "billing.report",
+			Error:     "db down",
+		})
+
+		assert.NoError(t, err)
+		dRepo.AssertExpectations(t)
+		msgbus.AssertExpectations(t)
+	})
+
+	t.Run("TasksStillPending", func(t *testing.T) {
+		dRepo := &mocks.DataRequestRepo{}
+		msgbus := &cmocks.MsgBusServiceClient{}
+		s := NewMemberServer(testOrgName, nil, nil, dRepo, nil, nil, msgbus, testPushGateway, orgId)
+
+		dRepo.On("UpdateTask", mock.Anything).Return(&db.DataRequest{Id: requestId, Status: db.DataRequestPending}, nil).Once()
+
+		err := s.HandleDataRequestResult(&epb.EventDataRequestResult{
+			RequestId: requestId.String(),
+			Service:   "subscriber.registry",
+			Success:   true,
+		})
+
+		assert.NoError(t, err)
+		msgbus.AssertNotCalled(t, "PublishRequest", mock.Anything, mock.Anything)
+	})
+
+	t.Run("DuplicateAnswer", func(t *testing.T) {
+		dRepo := &mocks.DataRequestRepo{}
+		s := NewMemberServer(testOrgName, nil, nil, dRepo, nil, nil, nil, testPushGateway, orgId)
+
+		dRepo.On("UpdateTask", mock.Anything).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		err := s.HandleDataRequestResult(&epb.EventDataRequestResult{
+			RequestId: requestId.String(),
+			Service:   "subscriber.registry",
+			Success:   true,
+		})
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestMemberServer_GetDataRequestBundle(t *testing.T) {
+	requestId := uuid.NewV4()
+
+	export := func(status string) *db.DataRequest {
+		data, _ := json.Marshal(privacy.Document{"subscribers": {{"id": "1", "name": "Jane"}}})
+		return &db.DataRequest{
+			Id:          requestId,
+			Kind:        privacy.KindExport,
+			SubjectKind: privacy.SubjectOrg,
+			Status:      status,
+			Tasks: []db.DataRequestTask{
+				{Service: "subscriber.registry", Status: db.DataRequestCompleted, Data: data},
+				{Service: "billing.report", Status: db.DataRequestFailed, Error: "db down"},
+			},
+		}
+	}
+
+	t.Run("Json", func(t *testing.T) {
+		dRepo := &mocks.DataRequestRepo{}
+		s := NewMemberServer(testOrgName, nil, nil, dRepo, nil, nil, nil, testPushGateway, orgId)
+
+		dRepo.On("Get", requestId).Return(export(db.DataRequestFailed), nil).Once()
+
+		resp, err := s.GetDataRequestBundle(context.TODO(), &pb.GetDataRequestBundleRequest{Id: requestId.String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "application/json", resp.ContentType)
+
+		bundle := map[string]privacy.Document{}
+		assert.NoError(t, json.Unmarshal(resp.Data, &bundle))
+		assert.Equal(t, "Jane", bundle["subscriber.registry"]["subscribers"][0]["name"])
+		assert.NotContains(t, bundle, "billing.report")
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		dRepo := &mocks.DataRequestRepo{}
+		s := NewMemberServer(testOrgName, nil, nil, dRepo, nil, nil, nil, testPushGateway, orgId)
+
+		dRepo.On("Get", requestId).Return(export(db.DataRequestPending), nil).Once()
+
+		_, err := s.GetDataRequestBundle(context.TODO(), &pb.GetDataRequestBundleRequest{Id: requestId.String(), Format: privacy.FormatCsv})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
@@ -45,14 +45,54 @@ func (p *MemberEventServer) EventNotification(ctx context.Context, e *epb.Event)
 			return &epb.EventResponse{}, err
 		}
 		if msg.Status == uType.InvitationStatus_INVITE_ACCEPTED && p.orgName != p.masterOrgName {
-			_, err := p.m.AddMember(ctx, &pb.AddMemberRequest{
+			/* scoped invitations only hold their role on their networks or sites */
+			role := uType.RoleType(msg.Role)
+			if len(msg.Scopes) > 0 {
+				role = uType.RoleType_ROLE_INVALID
+			}
+
+			member, err := p.m.AddMember(ctx, &pb.AddMemberRequest{
 				UserUuid: msg.UserId,
-				Role:     uType.RoleType(msg.Role),
+				Role:     role,
 			})
 			if err != nil {
 				log.Errorf("Failed to add member with error %s", err.Error())
 				return &epb.EventResponse{}, err
 			}
+
+			for _, s := range msg.Scopes {
+				_, err := p.m.AddRoleBinding(ctx, &pb.AddRoleBindingRequest{
+					UserId:    msg.UserId,
+					Role:      s.Role,
+					ScopeKind: s.ScopeKind,
+					ScopeId:   s.ScopeId,
+				})
+				if err != nil {
+					log.Errorf("Failed to bind role %s on %s %s to member %s with error %s",
+						s.Role, s.ScopeKind, s.ScopeId, msg.UserId, err.Error())
+
+					/* a member without its scoped roles has no access at all,
+					so it is removed and the invitation is handled again */
+					p.removeMember(ctx, member.Member.MemberId)
+
+					return &epb.EventResponse{}, err
+				}
+			}
+		}
+	case msgbus.PrepareRoute(p.orgName, "event.cloud.local.{{ .Org }}.subscriber.registry.datarequest.complete"),
+		msgbus.PrepareRoute(p.orgName, "event.cloud.local.{{ .Org }}.subscriber.simmanager.datarequest.complete"),
+		msgbus.PrepareRoute(p.orgName, "event.cloud.local.{{ .Org }}.ukamaagent.cdr.datarequest.complete"),
+		msgbus.PrepareRoute(p.orgName, "event.cloud.local.{{ .Org }}.billing.report.datarequest.complete"),
+		msgbus.PrepareRoute(p.orgName, "event.cloud.local.{{ .Org }}.notification.eventnotify.datarequest.complete"):
+		msg, err := epb.UnmarshalEventDataRequestResult(e.Msg, "EventDataRequestResult")
+		if err != nil {
+			log.Errorf("Failed to unmarshal DataRequestResult message with error %s", err.Error())
+			return &epb.EventResponse{}, err
+		}
+
+		if err := p.m.HandleDataRequestResult(msg); err != nil {
+			log.Errorf("Failed to record %s answer to data request %s with error %s", msg.Service, msg.RequestId, err.Error())
+			return &epb.EventResponse{}, err
 		}
 	default:
 		log.Errorf("No handler routing key %s", e.RoutingKey)
@@ -60,3 +100,17 @@ func (p *MemberEventServer) EventNotification(ctx context.Context, e *epb.Event)
 
 	return &epb.EventResponse{}, nil
 }
+
+func (p *MemberEventServer) removeMember(ctx context.Context, memberId string) {
+	_, err := p.m.UpdateMember(ctx, &pb.UpdateMemberRequest{
+		MemberId:      memberId,
+		IsDeactivated: true,
+	})
+	if err == nil {
+		_, err = p.m.RemoveMember(ctx, &pb.MemberRequest{MemberId: memberId})
+	}
+
+	if err != nil {
+		log.Errorf("Failed to remove member %s with error %s", memberId, err.Error())
+	}
+}
@@ -18,8 +18,10 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/ukama/ukama/systems/common/msgbus"
+	"github.com/ukama/ukama/systems/common/roles"
 	"github.com/ukama/ukama/systems/common/uuid"
 	"github.com/ukama/ukama/systems/registry/member/mocks"
+	"github.com/ukama/ukama/systems/registry/member/pkg/db"
 	"github.com/ukama/ukama/systems/registry/member/pkg/server"
 
 	cmocks "github.com/ukama/ukama/systems/common/mocks"
@@ -52,7 +54,7 @@ func TestMemberEventServer_EventNotification(t *testing.T) {
 		memberRepo.On("GetMemberCount").Return(int64(1), int64(0), nil).Once()
 		msgbusClient.On("PublishRequest", mock.Anything, mock.Anything).Return(nil).Once()
 
-		memberServer := server.NewMemberServer(testOrgName, memberRepo, orgClient, userClient, msgbusClient, "", uuid.NewV4())
+		memberServer := server.NewMemberServer(testOrgName, memberRepo, nil, nil, orgClient, userClient, msgbusClient, "", uuid.NewV4())
 		eventServer := server.NewPackageEventServer(testOrgName, memberServer, testMasterOrgName)
 
 		invitationUpdate := &epb.EventInvitationUpdated{
@@ -79,6 +81,95 @@ func TestMemberEventServer_EventNotification(t *testing.T) {
 		msgbusClient.AssertExpectations(t)
 	})
 
+	t.Run("ScopedInvitationAccepted_BindsScopes", func(t *testing.T) {
+		// Arrange
+		memberRepo := &mocks.MemberRepo{}
+		roleRepo := &mocks.RoleRepo{}
+		siteId := uuid.NewV4().String()
+
+		memberRepo.On("AddMember", mock.MatchedBy(func(m *db.Member) bool {
+			return m.Role == roles.RoleType(uType.RoleType_ROLE_INVALID)
+		}), mock.Anything, mock.Anything).Return(nil).Once()
+		memberRepo.On("GetMemberCount").Return(int64(1), int64(0), nil).Once()
+		memberRepo.On("GetMemberByUserId", testUserId).Return(&db.Member{UserId: testUserId}, nil).Once()
+		roleRepo.On("AddBinding", mock.MatchedBy(func(b *db.RoleBinding) bool {
+			return b.Role == "network_owner" && b.ScopeKind == "site" && b.ScopeId == siteId
+		})).Return(nil).Once()
+
+		memberServer := server.NewMemberServer(testOrgName, memberRepo, roleRepo, nil, &cmocks.OrgClient{}, &cmocks.UserClient{}, nil, "", uuid.NewV4())
+		eventServer := server.NewPackageEventServer(testOrgName, memberServer, testMasterOrgName)
+
+		invitationUpdate := &epb.EventInvitationUpdated{
+			UserId: testUserId.String(),
+			Status: uType.InvitationStatus_INVITE_ACCEPTED,
+			Role:   uType.RoleType_ROLE_NETWORK_OWNER,
+			Scopes: []*epb.InvitationScope{{Role: "network_owner", ScopeKind: "site", ScopeId: siteId}},
+		}
+
+		anyE, err := anypb.New(invitationUpdate)
+		assert.NoError(t, err)
+
+		// Act
+		resp, err := eventServer.EventNotification(context.TODO(), &epb.Event{
+			RoutingKey: routingKey,
+			Msg:        anyE,
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		memberRepo.AssertExpectations(t)
+		roleRepo.AssertExpectations(t)
+	})
+
+	t.Run("ScopedInvitationAccepted_BindingErrorRemovesMember", func(t *testing.T) {
+		// Arrange
+		memberRepo := &mocks.MemberRepo{}
+		roleRepo := &mocks.RoleRepo{}
+		siteId := uuid.NewV4().String()
+
+		var memberId uuid.UUID
+		memberRepo.On("AddMember", mock.MatchedBy(func(m *db.Member) bool {
+			memberId = m.MemberId
+			return true
+		}), mock.Anything, mock.Anything).Return(nil).Once()
+		memberRepo.On("GetMemberCount").Return(int64(1), int64(0), nil)
+		memberRepo.On("GetMemberByUserId", testUserId).Return(&db.Member{UserId: testUserId}, nil).Once()
+		roleRepo.On("AddBinding", mock.Anything).Return(errors.New("failed to add binding")).Once()
+		memberRepo.On("UpdateMember", mock.MatchedBy(func(m *db.Member) bool {
+			return m.MemberId == memberId && m.Deactivated
+		})).Return(nil).Once()
+		memberRepo.On("GetMember", mock.Anything).Return(&db.Member{Deactivated: true}, nil).Once()
+		memberRepo.On("RemoveMember", mock.MatchedBy(func(id uuid.UUID) bool {
+			return id == memberId
+		}), mock.Anything, mock.Anything).Return(nil).Once()
+
+		memberServer := server.NewMemberServer(testOrgName, memberRepo, roleRepo, nil, &cmocks.OrgClient{}, &cmocks.UserClient{}, nil, "", uuid.NewV4())
+		eventServer := server.NewPackageEventServer(testOrgName, memberServer, testMasterOrgName)
+
+		invitationUpdate := &epb.EventInvitationUpdated{
+			UserId: testUserId.String(),
+			Status: uType.InvitationStatus_INVITE_ACCEPTED,
+			Role:   uType.RoleType_ROLE_NETWORK_OWNER,
+			Scopes: []*epb.InvitationScope{{Role: "network_owner", ScopeKind: "site", ScopeId: siteId}},
+		}
+
+		anyE, err := anypb.New(invitationUpdate)
+		assert.NoError(t, err)
+
+		// Act
+		resp, err := eventServer.EventNotification(context.TODO(), &epb.Event{
+			RoutingKey: routingKey,
+			Msg:        anyE,
+		})
+
+		// Assert
+		assert.Error(t, err)
+		assert.NotNil(t, resp)
+		memberRepo.AssertExpectations(t)
+		roleRepo.AssertExpectations(t)
+	})
+
 	t.Run("InvitationRejected_NoMemberAdded", func(t *testing.T) {
 		// Arrange
 		memberServer := &server.MemberServer{}
@@ -167,7 +258,7 @@ func TestMemberEventServer_EventNotification(t *testing.T) {
 		msgbusClient := &cmocks.MsgBusServiceClient{}
 
 		// Create a proper MemberServer with mocked dependencies
-		memberServer := server.NewMemberServer(testOrgName, memberRepo, orgClient, userClient, msgbusClient, "", uuid.NewV4())
+		memberServer := server.NewMemberServer(testOrgName, memberRepo, nil, nil, orgClient, userClient, msgbusClient, "", uuid.NewV4())
 
 		// Mock AddMember to return error
 		memberRepo.On("AddMember", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("failed to add member")).Once()
@@ -278,7 +369,7 @@ func TestMemberEventServer_EventNotification(t *testing.T) {
 		userClient := &cmocks.UserClient{}
 		msgbusClient := &cmocks.MsgBusServiceClient{}
 
-		memberServer := server.NewMemberServer(testOrgName, memberRepo, orgClient, userClient, msgbusClient, "", uuid.NewV4())
+		memberServer := server.NewMemberServer(testOrgName, memberRepo, nil, nil, orgClient, userClient, msgbusClient, "", uuid.NewV4())
 		eventServer := server.NewPackageEventServer(testOrgName, memberServer, testMasterOrgName)
 
 		invitationUpdate := &epb.EventInvitationUpdated{
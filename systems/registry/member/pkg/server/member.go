@@ -35,6 +35,8 @@ import (
 type MemberServer struct {
 	pb.UnimplementedMemberServiceServer
 	mRepo          db.MemberRepo
+	rRepo          db.RoleRepo
+	dRepo          db.DataRequestRepo
 	orgClient      cnucl.OrgClient
 	userClient     cnucl.UserClient
 	msgbus         mb.MsgBusServiceClient
@@ -44,11 +46,13 @@ type MemberServer struct {
 	OrgName        string
 }
 
-func NewMemberServer(orgName string, mRepo db.MemberRepo, orgClient cnucl.OrgClient, userClient cnucl.UserClient,
+func NewMemberServer(orgName string, mRepo db.MemberRepo, rRepo db.RoleRepo, dRepo db.DataRequestRepo, orgClient cnucl.OrgClient, userClient cnucl.UserClient,
 	msgBus mb.MsgBusServiceClient, pushGateway string, id uuid.UUID) *MemberServer {
 
 	return &MemberServer{
 		mRepo:          mRepo,
+		rRepo:          rRepo,
+		dRepo:          dRepo,
 		orgClient:      orgClient,
 		userClient:     userClient,
 		msgbus:         msgBus,
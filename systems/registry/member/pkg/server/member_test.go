@@ -80,7 +80,7 @@ func TestMemberServer_AddMember(t *testing.T) {
 			return r.Role == req.GetRole()
 		})).Return(nil).Once()
 		mRepo.On("GetMemberCount").Return(testActiveCount, testInactiveCount, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.AddMember(context.TODO(), req)
@@ -107,7 +107,7 @@ func TestMemberServer_AddMember(t *testing.T) {
 			Role:     upb.RoleType(testRole),
 		}
 
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.AddMember(context.TODO(), req)
@@ -134,7 +134,7 @@ func TestMemberServer_AddMember(t *testing.T) {
 		}
 
 		mRepo.On("AddMember", mock.Anything, orgId.String(), mock.Anything).Return(errTestDB).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.AddMember(context.TODO(), req)
@@ -160,7 +160,7 @@ func TestMemberServer_AddMember(t *testing.T) {
 		mRepo.On("AddMember", mock.Anything, orgId.String(), mock.Anything).Return(nil).Once()
 		msgclientRepo.On("PublishRequest", "event.cloud.local.testorg.registry.member.member.create", mock.Anything).Return(errors.New("message bus error")).Once()
 		mRepo.On("GetMemberCount").Return(testActiveCount, testInactiveCount, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.AddMember(context.TODO(), req)
@@ -185,7 +185,7 @@ func TestMemberServer_AddMember(t *testing.T) {
 
 		mRepo.On("AddMember", mock.Anything, orgId.String(), mock.Anything).Return(nil).Once()
 		mRepo.On("GetMemberCount").Return(testActiveCount, testInactiveCount, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, nil, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, nil, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.AddMember(context.TODO(), req)
@@ -214,7 +214,7 @@ func TestMemberServer_GetMember(t *testing.T) {
 		}
 
 		mRepo.On("GetMember", member.MemberId).Return(&member, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.GetMember(context.TODO(), &pb.MemberRequest{
@@ -239,7 +239,7 @@ func TestMemberServer_GetMember(t *testing.T) {
 		orgClient := &cmocks.OrgClient{}
 		userClient := &cmocks.UserClient{}
 
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.GetMember(context.TODO(), &pb.MemberRequest{
@@ -263,7 +263,7 @@ func TestMemberServer_GetMember(t *testing.T) {
 		userClient := &cmocks.UserClient{}
 
 		mRepo.On("GetMember", testMemberId2).Return(nil, testRecordNotFound).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.GetMember(context.TODO(), &pb.MemberRequest{
@@ -284,7 +284,7 @@ func TestMemberServer_GetMember(t *testing.T) {
 		userClient := &cmocks.UserClient{}
 
 		mRepo.On("GetMember", testMemberId3).Return(nil, errTestDB).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.GetMember(context.TODO(), &pb.MemberRequest{
@@ -315,7 +315,7 @@ func TestMemberServer_GetMemberByUserId(t *testing.T) {
 		}
 
 		mRepo.On("GetMemberByUserId", member.UserId).Return(&member, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.GetMemberByUserId(context.TODO(), &pb.GetMemberByUserIdRequest{
@@ -340,7 +340,7 @@ func TestMemberServer_GetMemberByUserId(t *testing.T) {
 		orgClient := &cmocks.OrgClient{}
 		userClient := &cmocks.UserClient{}
 
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.GetMemberByUserId(context.TODO(), &pb.GetMemberByUserIdRequest{
@@ -364,7 +364,7 @@ func TestMemberServer_GetMemberByUserId(t *testing.T) {
 		userClient := &cmocks.UserClient{}
 
 		mRepo.On("GetMemberByUserId", testUserId2).Return(nil, testRecordNotFound).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.GetMemberByUserId(context.TODO(), &pb.GetMemberByUserIdRequest{
@@ -385,7 +385,7 @@ func TestMemberServer_GetMemberByUserId(t *testing.T) {
 		userClient := &cmocks.UserClient{}
 
 		mRepo.On("GetMemberByUserId", testUserId3).Return(nil, errTestDB).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.GetMemberByUserId(context.TODO(), &pb.GetMemberByUserIdRequest{
@@ -425,7 +425,7 @@ func TestMemberServer_GetMembers(t *testing.T) {
 		}
 
 		mRepo.On("GetMembers").Return(members, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.GetMembers(context.TODO(), &pb.GetMembersRequest{})
@@ -448,7 +448,7 @@ func TestMemberServer_GetMembers(t *testing.T) {
 		userClient := &cmocks.UserClient{}
 
 		mRepo.On("GetMembers").Return(nil, errTestDB).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.GetMembers(context.TODO(), &pb.GetMembersRequest{})
@@ -468,7 +468,7 @@ func TestMemberServer_GetMembers(t *testing.T) {
 
 		members := []db.Member{}
 		mRepo.On("GetMembers").Return(members, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.GetMembers(context.TODO(), &pb.GetMembersRequest{})
@@ -501,7 +501,7 @@ func TestMemberServer_UpdateMember(t *testing.T) {
 			return r.MemberId == testMemberId1.String() && r.IsDeactivated == true
 		})).Return(nil).Once()
 		mRepo.On("GetMemberCount").Return(testActiveCount, testInactiveCount, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.UpdateMember(context.TODO(), req)
@@ -528,7 +528,7 @@ func TestMemberServer_UpdateMember(t *testing.T) {
 			IsDeactivated: true,
 		}
 
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.UpdateMember(context.TODO(), req)
@@ -557,7 +557,7 @@ func TestMemberServer_UpdateMember(t *testing.T) {
 		mRepo.On("UpdateMember", mock.MatchedBy(func(m *db.Member) bool {
 			return m.MemberId == testMemberId2 && m.Deactivated == false
 		})).Return(errTestDB).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.UpdateMember(context.TODO(), req)
@@ -583,7 +583,7 @@ func TestMemberServer_UpdateMember(t *testing.T) {
 		mRepo.On("UpdateMember", mock.MatchedBy(func(m *db.Member) bool {
 			return m.MemberId == testMemberId3 && m.Deactivated == true
 		})).Return(testRecordNotFound).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.UpdateMember(context.TODO(), req)
@@ -611,7 +611,7 @@ func TestMemberServer_UpdateMember(t *testing.T) {
 		})).Return(nil).Once()
 		msgclientRepo.On("PublishRequest", "event.cloud.local.testorg.registry.member.member.update", mock.Anything).Return(errors.New("message bus error")).Once()
 		mRepo.On("GetMemberCount").Return(testActiveCount, testInactiveCount, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.UpdateMember(context.TODO(), req)
@@ -638,7 +638,7 @@ func TestMemberServer_UpdateMember(t *testing.T) {
 			return m.MemberId == testMemberId1 && m.Deactivated == true
 		})).Return(nil).Once()
 		mRepo.On("GetMemberCount").Return(testActiveCount, testInactiveCount, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, nil, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, nil, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.UpdateMember(context.TODO(), req)
@@ -673,7 +673,7 @@ func TestMemberServer_RemoveMember(t *testing.T) {
 			return a.MemberId == member.MemberId.String()
 		})).Return(nil).Once()
 		mRepo.On("GetMemberCount").Return(testActiveCount, testInactiveCount, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.RemoveMember(context.TODO(), &pb.MemberRequest{
@@ -694,7 +694,7 @@ func TestMemberServer_RemoveMember(t *testing.T) {
 		orgClient := &cmocks.OrgClient{}
 		userClient := &cmocks.UserClient{}
 
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.RemoveMember(context.TODO(), &pb.MemberRequest{
@@ -718,7 +718,7 @@ func TestMemberServer_RemoveMember(t *testing.T) {
 		userClient := &cmocks.UserClient{}
 
 		mRepo.On("GetMember", testMemberId2).Return(nil, testRecordNotFound).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.RemoveMember(context.TODO(), &pb.MemberRequest{
@@ -739,7 +739,7 @@ func TestMemberServer_RemoveMember(t *testing.T) {
 		userClient := &cmocks.UserClient{}
 
 		mRepo.On("GetMember", testMemberId3).Return(nil, errTestDB).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.RemoveMember(context.TODO(), &pb.MemberRequest{
@@ -769,7 +769,7 @@ func TestMemberServer_RemoveMember(t *testing.T) {
 		}
 
 		mRepo.On("GetMember", member.MemberId).Return(&member, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.RemoveMember(context.TODO(), &pb.MemberRequest{
@@ -804,7 +804,7 @@ func TestMemberServer_RemoveMember(t *testing.T) {
 
 		mRepo.On("GetMember", member.MemberId).Return(&member, nil).Once()
 		mRepo.On("RemoveMember", member.MemberId, orgId.String(), mock.Anything).Return(errTestDB).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.RemoveMember(context.TODO(), &pb.MemberRequest{
@@ -836,7 +836,7 @@ func TestMemberServer_RemoveMember(t *testing.T) {
 		mRepo.On("GetMember", member.MemberId).Return(&member, nil).Once()
 		// Simulate a transaction error during RemoveMember
 		mRepo.On("RemoveMember", member.MemberId, orgId.String(), mock.Anything).Return(errors.New("transaction failed")).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.RemoveMember(context.TODO(), &pb.MemberRequest{
@@ -878,7 +878,7 @@ func TestMemberServer_RemoveMember(t *testing.T) {
 			return a.MemberId == member.MemberId.String()
 		})).Return(nil).Once()
 		mRepo.On("GetMemberCount").Return(testActiveCount, testInactiveCount, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.RemoveMember(context.TODO(), &pb.MemberRequest{
@@ -918,7 +918,7 @@ func TestMemberServer_RemoveMember(t *testing.T) {
 			err := fn(orgId.String(), member.UserId.String())
 			return err != nil
 		})).Return(errors.New("org client error")).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.RemoveMember(context.TODO(), &pb.MemberRequest{
@@ -952,7 +952,7 @@ func TestMemberServer_RemoveMember(t *testing.T) {
 		mRepo.On("RemoveMember", member.MemberId, orgId.String(), mock.Anything).Return(nil).Once()
 		msgclientRepo.On("PublishRequest", "event.cloud.local.testorg.registry.member.member.delete", mock.Anything).Return(errors.New("message bus error")).Once()
 		mRepo.On("GetMemberCount").Return(testActiveCount, testInactiveCount, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, msgclientRepo, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.RemoveMember(context.TODO(), &pb.MemberRequest{
@@ -984,7 +984,7 @@ func TestMemberServer_RemoveMember(t *testing.T) {
 		mRepo.On("GetMember", member.MemberId).Return(&member, nil).Once()
 		mRepo.On("RemoveMember", member.MemberId, orgId.String(), mock.Anything).Return(nil).Once()
 		mRepo.On("GetMemberCount").Return(testActiveCount, testInactiveCount, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, nil, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, nil, testPushGateway, orgId)
 
 		// Act
 		resp, err := s.RemoveMember(context.TODO(), &pb.MemberRequest{
@@ -1006,7 +1006,7 @@ func TestMemberServer_PushOrgMemberCountMetric(t *testing.T) {
 		userClient := &cmocks.UserClient{}
 
 		mRepo.On("GetMemberCount").Return(testActiveCount, testInactiveCount, nil).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, nil, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, nil, testPushGateway, orgId)
 
 		// Act
 		err := s.PushOrgMemberCountMetric(orgId)
@@ -1023,7 +1023,7 @@ func TestMemberServer_PushOrgMemberCountMetric(t *testing.T) {
 		userClient := &cmocks.UserClient{}
 
 		mRepo.On("GetMemberCount").Return(int64(0), int64(0), errTestDB).Once()
-		s := NewMemberServer(testOrgName, mRepo, orgClient, userClient, nil, testPushGateway, orgId)
+		s := NewMemberServer(testOrgName, mRepo, nil, nil, orgClient, userClient, nil, testPushGateway, orgId)
 
 		// Act
 		err := s.PushOrgMemberCountMetric(orgId)
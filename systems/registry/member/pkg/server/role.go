@@ -0,0 +1,282 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ukama/ukama/systems/common/grpc"
+	"github.com/ukama/ukama/systems/common/roles"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/registry/member/pkg/db"
+
+	log "github.com/sirupsen/logrus"
+	pb "github.com/ukama/ukama/systems/registry/member/pb/gen"
+)
+
+func (m *MemberServer) AddRole(ctx context.Context, req *pb.AddRoleRequest) (*pb.RoleResponse, error) {
+	log.Infof("Adding role %s", req.Name)
+
+	if _, ok := roles.BuiltinRole(req.Name); ok {
+		return nil, status.Errorf(codes.AlreadyExists, "role %s is a builtin role", req.Name)
+	}
+	if err := validatePermissions(req.Permissions); err != nil {
+		return nil, err
+	}
+
+	role := &db.Role{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	}
+	if err := m.rRepo.AddRole(role); err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "role")
+	}
+
+	return &pb.RoleResponse{Role: dbRoleToPbRole(role)}, nil
+}
+
+func (m *MemberServer) UpdateRole(ctx context.Context, req *pb.UpdateRoleRequest) (*pb.RoleResponse, error) {
+	log.Infof("Updating role %s", req.Name)
+
+	if _, ok := roles.BuiltinRole(req.Name); ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "builtin role %s can't be changed", req.Name)
+	}
+	if err := validatePermissions(req.Permissions); err != nil {
+		return nil, err
+	}
+
+	role := &db.Role{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	}
+	if err := m.rRepo.UpdateRole(role); err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "role")
+	}
+
+	return &pb.RoleResponse{Role: dbRoleToPbRole(role)}, nil
+}
+
+func (m *MemberServer) ListRoles(ctx context.Context, req *pb.ListRolesRequest) (*pb.ListRolesResponse, error) {
+	custom, err := m.rRepo.ListRoles()
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "role")
+	}
+
+	resp := &pb.ListRolesResponse{Roles: builtinPbRoles()}
+	for i := range custom {
+		resp.Roles = append(resp.Roles, dbRoleToPbRole(&custom[i]))
+	}
+	return resp, nil
+}
+
+func (m *MemberServer) DeleteRole(ctx context.Context, req *pb.DeleteRoleRequest) (*pb.DeleteRoleResponse, error) {
+	log.Infof("Deleting role %s", req.Name)
+
+	if _, ok := roles.BuiltinRole(req.Name); ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "builtin role %s can't be deleted", req.Name)
+	}
+
+	if err := m.rRepo.DeleteRole(req.Name); err != nil {
+		if errors.Is(err, db.ErrRoleInUse) {
+			return nil, status.Errorf(codes.FailedPrecondition, "role %s is still bound to members", req.Name)
+		}
+		return nil, grpc.SqlErrorToGrpc(err, "role")
+	}
+	return &pb.DeleteRoleResponse{}, nil
+}
+
+func (m *MemberServer) AddRoleBinding(ctx context.Context, req *pb.AddRoleBindingRequest) (*pb.RoleBindingResponse, error) {
+	log.Infof("Binding role %s to user %s on %s %s", req.Role, req.UserId, req.ScopeKind, req.ScopeId)
+
+	userId, err := uuid.FromString(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid format of user uuid. Error %s", err.Error())
+	}
+
+	scope, err := roles.ParseScope(req.ScopeKind, req.ScopeId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+
+	/* ownership moves with the org, it is never handed out by a binding */
+	if rt, ok := roles.BuiltinRole(req.Role); ok && rt == roles.TYPE_OWNER {
+		return nil, status.Errorf(codes.InvalidArgument, "owner role can't be bound")
+	} else if !ok {
+		if _, err := m.rRepo.GetRole(req.Role); err != nil {
+			return nil, grpc.SqlErrorToGrpc(err, "role")
+		}
+	}
+
+	if _, err := m.mRepo.GetMemberByUserId(userId); err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "member")
+	}
+
+	binding := &db.RoleBinding{
+		Id:        uuid.NewV4(),
+		UserId:    userId,
+		Role:      req.Role,
+		ScopeKind: string(scope.Kind),
+		ScopeId:   scope.Id,
+	}
+	if err := m.rRepo.AddBinding(binding); err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "role binding")
+	}
+
+	return &pb.RoleBindingResponse{Binding: dbBindingToPbBinding(binding)}, nil
+}
+
+func (m *MemberServer) RemoveRoleBinding(ctx context.Context, req *pb.RemoveRoleBindingRequest) (*pb.RemoveRoleBindingResponse, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid format of binding uuid. Error %s", err.Error())
+	}
+
+	if err := m.rRepo.RemoveBinding(id); err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "role binding")
+	}
+	return &pb.RemoveRoleBindingResponse{}, nil
+}
+
+func (m *MemberServer) ListRoleBindings(ctx context.Context, req *pb.ListRoleBindingsRequest) (*pb.ListRoleBindingsResponse, error) {
+	userId, err := uuid.FromString(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid format of user uuid. Error %s", err.Error())
+	}
+
+	bindings, err := m.rRepo.ListBindings(userId)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "role binding")
+	}
+
+	resp := &pb.ListRoleBindingsResponse{Bindings: []*pb.RoleBinding{}}
+	for i := range bindings {
+		resp.Bindings = append(resp.Bindings, dbBindingToPbBinding(&bindings[i]))
+	}
+	return resp, nil
+}
+
+// GetAccess resolves everything a user may do: the member role on the whole
+// org plus the role bindings. Deactivated members get nothing.
+func (m *MemberServer) GetAccess(ctx context.Context, req *pb.GetAccessRequest) (*pb.GetAccessResponse, error) {
+	userId, err := uuid.FromString(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid format of user uuid. Error %s", err.Error())
+	}
+
+	member, err := m.mRepo.GetMemberByUserId(userId)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "member")
+	}
+
+	resp := &pb.GetAccessResponse{Grants: []*pb.Grant{}}
+	if member.Deactivated {
+		return resp, nil
+	}
+
+	/* service accounts have no member role, only their bindings */
+	if perms, ok := roles.BuiltinRoles[member.Role]; ok {
+		resp.Grants = append(resp.Grants, &pb.Grant{
+			Role:        roles.RoleName(member.Role),
+			Permissions: permissionStrings(perms),
+			ScopeKind:   string(roles.ScopeOrg),
+		})
+	}
+
+	bindings, err := m.rRepo.ListBindings(userId)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "role binding")
+	}
+
+	for _, b := range bindings {
+		var perms []string
+		if rt, ok := roles.BuiltinRole(b.Role); ok {
+			perms = permissionStrings(roles.BuiltinRoles[rt])
+		} else {
+			role, err := m.rRepo.GetRole(b.Role)
+			if err != nil {
+				return nil, grpc.SqlErrorToGrpc(err, "role")
+			}
+			perms = role.Permissions
+		}
+
+		resp.Grants = append(resp.Grants, &pb.Grant{
+			Role:        b.Role,
+			Permissions: perms,
+			ScopeKind:   b.ScopeKind,
+			ScopeId:     b.ScopeId,
+		})
+	}
+	return resp, nil
+}
+
+func validatePermissions(perms []string) error {
+	for _, p := range perms {
+		if err := roles.Permission(p).Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+	}
+	return nil
+}
+
+func permissionStrings(perms []roles.Permission) []string {
+	res := make([]string, 0, len(perms))
+	for _, p := range perms {
+		res = append(res, string(p))
+	}
+	return res
+}
+
+func builtinPbRoles() []*pb.Role {
+	types := make([]roles.RoleType, 0, len(roles.BuiltinRoles))
+	for rt := range roles.BuiltinRoles {
+		types = append(types, rt)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+	res := make([]*pb.Role, 0, len(types))
+	for _, rt := range types {
+		res = append(res, &pb.Role{
+			Name:        roles.RoleName(rt),
+			Permissions: permissionStrings(roles.BuiltinRoles[rt]),
+			Builtin:     true,
+		})
+	}
+	return res
+}
+
+func dbRoleToPbRole(role *db.Role) *pb.Role {
+	return &pb.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+	}
+}
+
+func dbBindingToPbBinding(b *db.RoleBinding) *pb.RoleBinding {
+	return &pb.RoleBinding{
+		Id:        b.Id.String(),
+		UserId:    b.UserId.String(),
+		Role:      b.Role,
+		ScopeKind: b.ScopeKind,
+		ScopeId:   b.ScopeId,
+		CreatedAt: timestamppb.New(b.CreatedAt),
+	}
+}
@@ -0,0 +1,190 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ukama/ukama/systems/common/roles"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/registry/member/mocks"
+	"github.com/ukama/ukama/systems/registry/member/pkg/db"
+
+	pb "github.com/ukama/ukama/systems/registry/member/pb/gen"
+)
+
+var testSiteId = uuid.NewV4()
+
+func fieldTechRole() *db.Role {
+	return &db.Role{
+		Name:        "field_tech",
+		Permissions: []string{"node.nodes.write", "registry.sites.read"},
+	}
+}
+
+func TestMemberServer_AddRole(t *testing.T) {
+	t.Run("AddRole_Success", func(t *testing.T) {
+		rRepo := &mocks.RoleRepo{}
+		s := NewMemberServer(testOrgName, nil, rRepo, nil, nil, nil, nil, testPushGateway, orgId)
+
+		rRepo.On("AddRole", mock.MatchedBy(func(r *db.Role) bool {
+			return r.Name == "field_tech" && len(r.Permissions) == 2
+		})).Return(nil).Once()
+
+		resp, err := s.AddRole(context.TODO(), &pb.AddRoleRequest{
+			Name:        "field_tech",
+			Permissions: fieldTechRole().Permissions,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "field_tech", resp.Role.Name)
+		rRepo.AssertExpectations(t)
+	})
+
+	t.Run("AddRole_Builtin", func(t *testing.T) {
+		s := NewMemberServer(testOrgName, nil, &mocks.RoleRepo{}, nil, nil, nil, nil, testPushGateway, orgId)
+
+		_, err := s.AddRole(context.TODO(), &pb.AddRoleRequest{Name: "admin", Permissions: []string{"*"}})
+
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("AddRole_InvalidPermission", func(t *testing.T) {
+		s := NewMemberServer(testOrgName, nil, &mocks.RoleRepo{}, nil, nil, nil, nil, testPushGateway, orgId)
+
+		_, err := s.AddRole(context.TODO(), &pb.AddRoleRequest{Name: "field_tech", Permissions: []string{"node.write"}})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestMemberServer_DeleteRole(t *testing.T) {
+	rRepo := &mocks.RoleRepo{}
+	s := NewMemberServer(testOrgName, nil, rRepo, nil, nil, nil, nil, testPushGateway, orgId)
+
+	rRepo.On("DeleteRole", "field_tech").Return(db.ErrRoleInUse).Once()
+
+	_, err := s.DeleteRole(context.TODO(), &pb.DeleteRoleRequest{Name: "field_tech"})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	rRepo.AssertExpectations(t)
+}
+
+func TestMemberServer_AddRoleBinding(t *testing.T) {
+	t.Run("AddRoleBinding_Success", func(t *testing.T) {
+		mRepo := &mocks.MemberRepo{}
+		rRepo := &mocks.RoleRepo{}
+		s := NewMemberServer(testOrgName, mRepo, rRepo, nil, nil, nil, nil, testPushGateway, orgId)
+
+		rRepo.On("GetRole", "field_tech").Return(fieldTechRole(), nil).Once()
+		mRepo.On("GetMemberByUserId", testUserId1).Return(&db.Member{UserId: testUserId1}, nil).Once()
+		rRepo.On("AddBinding", mock.MatchedBy(func(b *db.RoleBinding) bool {
+			return b.UserId == testUserId1 && b.ScopeKind == "site" && b.ScopeId == testSiteId.String()
+		})).Return(nil).Once()
+
+		resp, err := s.AddRoleBinding(context.TODO(), &pb.AddRoleBindingRequest{
+			UserId:    testUserId1.String(),
+			Role:      "field_tech",
+			ScopeKind: "site",
+			ScopeId:   testSiteId.String(),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "field_tech", resp.Binding.Role)
+		mRepo.AssertExpectations(t)
+		rRepo.AssertExpectations(t)
+	})
+
+	t.Run("AddRoleBinding_Owner", func(t *testing.T) {
+		s := NewMemberServer(testOrgName, &mocks.MemberRepo{}, &mocks.RoleRepo{}, nil, nil, nil, nil, testPushGateway, orgId)
+
+		_, err := s.AddRoleBinding(context.TODO(), &pb.AddRoleBindingRequest{
+			UserId: testUserId1.String(),
+			Role:   "owner",
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("AddRoleBinding_InvalidScope", func(t *testing.T) {
+		s := NewMemberServer(testOrgName, &mocks.MemberRepo{}, &mocks.RoleRepo{}, nil, nil, nil, nil, testPushGateway, orgId)
+
+		_, err := s.AddRoleBinding(context.TODO(), &pb.AddRoleBindingRequest{
+			UserId:    testUserId1.String(),
+			Role:      "field_tech",
+			ScopeKind: "site",
+			ScopeId:   invalidUUID,
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestMemberServer_GetAccess(t *testing.T) {
+	t.Run("GetAccess_Success", func(t *testing.T) {
+		mRepo := &mocks.MemberRepo{}
+		rRepo := &mocks.RoleRepo{}
+		s := NewMemberServer(testOrgName, mRepo, rRepo, nil, nil, nil, nil, testPushGateway, orgId)
+
+		mRepo.On("GetMemberByUserId", testUserId1).Return(&db.Member{UserId: testUserId1, Role: roles.TYPE_USERS}, nil).Once()
+		rRepo.On("ListBindings", testUserId1).Return([]db.RoleBinding{
+			{UserId: testUserId1, Role: "field_tech", ScopeKind: "site", ScopeId: testSiteId.String()},
+		}, nil).Once()
+		rRepo.On("GetRole", "field_tech").Return(fieldTechRole(), nil).Once()
+
+		resp, err := s.GetAccess(context.TODO(), &pb.GetAccessRequest{UserId: testUserId1.String()})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Grants, 2)
+		assert.Equal(t, "users", resp.Grants[0].Role)
+		assert.Equal(t, "org", resp.Grants[0].ScopeKind)
+		assert.Equal(t, fieldTechRole().Permissions, resp.Grants[1].Permissions)
+		assert.Equal(t, testSiteId.String(), resp.Grants[1].ScopeId)
+		mRepo.AssertExpectations(t)
+		rRepo.AssertExpectations(t)
+	})
+
+	t.Run("GetAccess_Deactivated", func(t *testing.T) {
+		mRepo := &mocks.MemberRepo{}
+		rRepo := &mocks.RoleRepo{}
+		s := NewMemberServer(testOrgName, mRepo, rRepo, nil, nil, nil, nil, testPushGateway, orgId)
+
+		mRepo.On("GetMemberByUserId", testUserId1).Return(&db.Member{UserId: testUserId1, Deactivated: true}, nil).Once()
+
+		resp, err := s.GetAccess(context.TODO(), &pb.GetAccessRequest{UserId: testUserId1.String()})
+
+		assert.NoError(t, err)
+		assert.Empty(t, resp.Grants)
+		rRepo.AssertNotCalled(t, "ListBindings", mock.Anything)
+	})
+	t.Run("GetAccess_NoMemberRole", func(t *testing.T) {
+		mRepo := &mocks.MemberRepo{}
+		rRepo := &mocks.RoleRepo{}
+		s := NewMemberServer(testOrgName, mRepo, rRepo, nil, nil, nil, nil, testPushGateway, orgId)
+
+		mRepo.On("GetMemberByUserId", testUserId2).Return(&db.Member{UserId: testUserId2, Role: roles.TYPE_INVALID}, nil).Once()
+		rRepo.On("ListBindings", testUserId2).Return([]db.RoleBinding{
+			{UserId: testUserId2, Role: "vendor", ScopeKind: "org"},
+		}, nil).Once()
+
+		resp, err := s.GetAccess(context.TODO(), &pb.GetAccessRequest{UserId: testUserId2.String()})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Grants, 1)
+		assert.Equal(t, "vendor", resp.Grants[0].Role)
+		mRepo.AssertExpectations(t)
+		rRepo.AssertExpectations(t)
+	})
+}
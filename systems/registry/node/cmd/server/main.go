@@ -118,6 +118,9 @@ func runGrpcServer(gormdb sql.Db) {
 
 	go msgBusListener(mbClient)
 
+	ic.StartHeartbeat(ic.NewInitClient(serviceConfig.Http.InitClient, client.WithDebug(serviceConfig.DebugMode)),
+		serviceConfig.OrgName, pkg.SystemName, version.Version, ic.DefaultHeartbeatInterval, nil)
+
 	go grpcServer.StartServer()
 
 	waitForExit()
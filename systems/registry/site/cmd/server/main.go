@@ -28,6 +28,7 @@ import (
 	ugrpc "github.com/ukama/ukama/systems/common/grpc"
 	mb "github.com/ukama/ukama/systems/common/msgBusServiceClient"
 	cinvent "github.com/ukama/ukama/systems/common/rest/client/inventory"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
 	generated "github.com/ukama/ukama/systems/registry/site/pb/gen"
 )
 
@@ -56,7 +57,16 @@ func initConfig() {
 func initDb() sql.Db {
 	log.Infof("Initializing Database")
 	d := sql.NewDb(serviceConfig.DB, serviceConfig.DebugMode)
-	err := d.Init(&db.Site{})
+	/* a fresh database has nothing to convert and is created by Init */
+	if d.Connect() == nil {
+		if err := db.MigrateCoordinates(d.GetGormDb()); err != nil {
+			log.Fatalf("Database migration failed. Error: %v", err)
+		}
+		if conn, err := d.GetGormDb().DB(); err == nil {
+			_ = conn.Close()
+		}
+	}
+	err := d.Init(&db.Site{}, &db.SiteHistory{})
 	if err != nil {
 		log.Fatalf("Database initialization failed. Error: %v", err)
 	}
@@ -72,6 +82,7 @@ func runGrpcServer(gormdb sql.Db) {
 	}
 
 	invClient := cinvent.NewComponentClient(serviceConfig.Http.InventoryClient)
+	nodeClient := creg.NewNodeClient(serviceConfig.Http.NodeClient)
 
 	mbClient := mb.NewMsgBusClient(serviceConfig.MsgClient.Timeout,
 		serviceConfig.OrgName, pkg.SystemName, pkg.ServiceName, instanceId, serviceConfig.Queue.Uri,
@@ -80,7 +91,7 @@ func runGrpcServer(gormdb sql.Db) {
 		serviceConfig.MsgClient.RetryCount, serviceConfig.MsgClient.ListenerRoutes)
 
 	siteServer := server.NewSiteServer(serviceConfig.OrgName, db.NewSiteRepo(gormdb),
-		mbClient, providers.NewNetworkClientProvider(serviceConfig.Network), serviceConfig.PushGateway, invClient)
+		mbClient, providers.NewNetworkClientProvider(serviceConfig.Network), serviceConfig.PushGateway, invClient, nodeClient)
 
 	log.Debugf("MessageBus Client is %+v", mbClient)
 
@@ -4,6 +4,8 @@ package mocks
 
 import (
 	db "github.com/ukama/ukama/systems/registry/site/pkg/db"
+	geo "github.com/ukama/ukama/systems/registry/site/pkg/geo"
+
 	gorm "gorm.io/gorm"
 
 	mock "github.com/stretchr/testify/mock"
@@ -34,6 +36,36 @@ func (_m *SiteRepo) Add(site *db.Site, nestedFunc func(*db.Site, *gorm.DB) error
 	return r0
 }
 
+// Decommission provides a mock function with given fields: siteId, reason, nodeIds
+func (_m *SiteRepo) Decommission(siteId uuid.UUID, reason string, nodeIds []string) (*db.Site, error) {
+	ret := _m.Called(siteId, reason, nodeIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Decommission")
+	}
+
+	var r0 *db.Site
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, []string) (*db.Site, error)); ok {
+		return rf(siteId, reason, nodeIds)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string, []string) *db.Site); ok {
+		r0 = rf(siteId, reason, nodeIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Site)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, string, []string) error); ok {
+		r1 = rf(siteId, reason, nodeIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: siteId
 func (_m *SiteRepo) Delete(siteId uuid.UUID) (*db.Site, error) {
 	ret := _m.Called(siteId)
@@ -182,6 +214,126 @@ func (_m *SiteRepo) List(networkId *uuid.UUID, isDeactivated bool) ([]db.Site, e
 	return r0, r1
 }
 
+// ListHistory provides a mock function with given fields: siteId
+func (_m *SiteRepo) ListHistory(siteId uuid.UUID) ([]db.SiteHistory, error) {
+	ret := _m.Called(siteId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHistory")
+	}
+
+	var r0 []db.SiteHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]db.SiteHistory, error)); ok {
+		return rf(siteId)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []db.SiteHistory); ok {
+		r0 = rf(siteId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.SiteHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(siteId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListInBounds provides a mock function with given fields: networkId, box
+func (_m *SiteRepo) ListInBounds(networkId *uuid.UUID, box geo.BBox) ([]db.Site, error) {
+	ret := _m.Called(networkId, box)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInBounds")
+	}
+
+	var r0 []db.Site
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, geo.BBox) ([]db.Site, error)); ok {
+		return rf(networkId, box)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, geo.BBox) []db.Site); ok {
+		r0 = rf(networkId, box)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Site)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, geo.BBox) error); ok {
+		r1 = rf(networkId, box)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Relocate provides a mock function with given fields: siteId, to, location, backhaulId, reason
+func (_m *SiteRepo) Relocate(siteId uuid.UUID, to geo.Point, location string, backhaulId *uuid.UUID, reason string) (*db.Site, error) {
+	ret := _m.Called(siteId, to, location, backhaulId, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Relocate")
+	}
+
+	var r0 *db.Site
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, geo.Point, string, *uuid.UUID, string) (*db.Site, error)); ok {
+		return rf(siteId, to, location, backhaulId, reason)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, geo.Point, string, *uuid.UUID, string) *db.Site); ok {
+		r0 = rf(siteId, to, location, backhaulId, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Site)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, geo.Point, string, *uuid.UUID, string) error); ok {
+		r1 = rf(siteId, to, location, backhaulId, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetCoverage provides a mock function with given fields: siteId, area
+func (_m *SiteRepo) SetCoverage(siteId uuid.UUID, area *geo.Area) (*db.Site, error) {
+	ret := _m.Called(siteId, area)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCoverage")
+	}
+
+	var r0 *db.Site
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, *geo.Area) (*db.Site, error)); ok {
+		return rf(siteId, area)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, *geo.Area) *db.Site); ok {
+		r0 = rf(siteId, area)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Site)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, *geo.Area) error); ok {
+		r1 = rf(siteId, area)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: site
 func (_m *SiteRepo) Update(site *db.Site) error {
 	ret := _m.Called(site)
@@ -53,6 +53,80 @@ func (_m *SiteServiceClient) Add(ctx context.Context, in *gen.AddRequest, opts .
 	return r0, r1
 }
 
+// CheckDecommission provides a mock function with given fields: ctx, in, opts
+func (_m *SiteServiceClient) CheckDecommission(ctx context.Context, in *gen.CheckDecommissionRequest, opts ...grpc.CallOption) (*gen.CheckDecommissionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckDecommission")
+	}
+
+	var r0 *gen.CheckDecommissionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CheckDecommissionRequest, ...grpc.CallOption) (*gen.CheckDecommissionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CheckDecommissionRequest, ...grpc.CallOption) *gen.CheckDecommissionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CheckDecommissionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.CheckDecommissionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Decommission provides a mock function with given fields: ctx, in, opts
+func (_m *SiteServiceClient) Decommission(ctx context.Context, in *gen.DecommissionRequest, opts ...grpc.CallOption) (*gen.DecommissionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Decommission")
+	}
+
+	var r0 *gen.DecommissionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DecommissionRequest, ...grpc.CallOption) (*gen.DecommissionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DecommissionRequest, ...grpc.CallOption) *gen.DecommissionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DecommissionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DecommissionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: ctx, in, opts
 func (_m *SiteServiceClient) Delete(ctx context.Context, in *gen.DeleteRequest, opts ...grpc.CallOption) (*gen.DeleteResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -90,6 +164,191 @@ func (_m *SiteServiceClient) Delete(ctx context.Context, in *gen.DeleteRequest,
 	return r0, r1
 }
 
+// ExportNetwork provides a mock function with given fields: ctx, in, opts
+func (_m *SiteServiceClient) ExportNetwork(ctx context.Context, in *gen.ExportNetworkRequest, opts ...grpc.CallOption) (*gen.ExportNetworkResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportNetwork")
+	}
+
+	var r0 *gen.ExportNetworkResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ExportNetworkRequest, ...grpc.CallOption) (*gen.ExportNetworkResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ExportNetworkRequest, ...grpc.CallOption) *gen.ExportNetworkResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ExportNetworkResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ExportNetworkRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindInBounds provides a mock function with given fields: ctx, in, opts
+func (_m *SiteServiceClient) FindInBounds(ctx context.Context, in *gen.FindInBoundsRequest, opts ...grpc.CallOption) (*gen.FindSitesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindInBounds")
+	}
+
+	var r0 *gen.FindSitesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindInBoundsRequest, ...grpc.CallOption) (*gen.FindSitesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindInBoundsRequest, ...grpc.CallOption) *gen.FindSitesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.FindSitesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.FindInBoundsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindInRadius provides a mock function with given fields: ctx, in, opts
+func (_m *SiteServiceClient) FindInRadius(ctx context.Context, in *gen.FindInRadiusRequest, opts ...grpc.CallOption) (*gen.FindSitesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindInRadius")
+	}
+
+	var r0 *gen.FindSitesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindInRadiusRequest, ...grpc.CallOption) (*gen.FindSitesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindInRadiusRequest, ...grpc.CallOption) *gen.FindSitesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.FindSitesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.FindInRadiusRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindNearest provides a mock function with given fields: ctx, in, opts
+func (_m *SiteServiceClient) FindNearest(ctx context.Context, in *gen.FindNearestRequest, opts ...grpc.CallOption) (*gen.FindSitesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindNearest")
+	}
+
+	var r0 *gen.FindSitesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindNearestRequest, ...grpc.CallOption) (*gen.FindSitesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindNearestRequest, ...grpc.CallOption) *gen.FindSitesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.FindSitesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.FindNearestRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindServing provides a mock function with given fields: ctx, in, opts
+func (_m *SiteServiceClient) FindServing(ctx context.Context, in *gen.FindServingRequest, opts ...grpc.CallOption) (*gen.FindSitesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindServing")
+	}
+
+	var r0 *gen.FindSitesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindServingRequest, ...grpc.CallOption) (*gen.FindSitesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindServingRequest, ...grpc.CallOption) *gen.FindSitesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.FindSitesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.FindServingRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: ctx, in, opts
 func (_m *SiteServiceClient) Get(ctx context.Context, in *gen.GetRequest, opts ...grpc.CallOption) (*gen.GetResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -127,6 +386,80 @@ func (_m *SiteServiceClient) Get(ctx context.Context, in *gen.GetRequest, opts .
 	return r0, r1
 }
 
+// GetCoverage provides a mock function with given fields: ctx, in, opts
+func (_m *SiteServiceClient) GetCoverage(ctx context.Context, in *gen.GetCoverageRequest, opts ...grpc.CallOption) (*gen.GetCoverageResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCoverage")
+	}
+
+	var r0 *gen.GetCoverageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetCoverageRequest, ...grpc.CallOption) (*gen.GetCoverageResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetCoverageRequest, ...grpc.CallOption) *gen.GetCoverageResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetCoverageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetCoverageRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHistory provides a mock function with given fields: ctx, in, opts
+func (_m *SiteServiceClient) GetHistory(ctx context.Context, in *gen.GetHistoryRequest, opts ...grpc.CallOption) (*gen.GetHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 *gen.GetHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetHistoryRequest, ...grpc.CallOption) (*gen.GetHistoryResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetHistoryRequest, ...grpc.CallOption) *gen.GetHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx, in, opts
 func (_m *SiteServiceClient) List(ctx context.Context, in *gen.ListRequest, opts ...grpc.CallOption) (*gen.ListResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -164,6 +497,80 @@ func (_m *SiteServiceClient) List(ctx context.Context, in *gen.ListRequest, opts
 	return r0, r1
 }
 
+// Relocate provides a mock function with given fields: ctx, in, opts
+func (_m *SiteServiceClient) Relocate(ctx context.Context, in *gen.RelocateRequest, opts ...grpc.CallOption) (*gen.RelocateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Relocate")
+	}
+
+	var r0 *gen.RelocateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RelocateRequest, ...grpc.CallOption) (*gen.RelocateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RelocateRequest, ...grpc.CallOption) *gen.RelocateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RelocateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RelocateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetCoverage provides a mock function with given fields: ctx, in, opts
+func (_m *SiteServiceClient) SetCoverage(ctx context.Context, in *gen.SetCoverageRequest, opts ...grpc.CallOption) (*gen.SetCoverageResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCoverage")
+	}
+
+	var r0 *gen.SetCoverageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetCoverageRequest, ...grpc.CallOption) (*gen.SetCoverageResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetCoverageRequest, ...grpc.CallOption) *gen.SetCoverageResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.SetCoverageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.SetCoverageRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, in, opts
 func (_m *SiteServiceClient) Update(ctx context.Context, in *gen.UpdateRequest, opts ...grpc.CallOption) (*gen.UpdateResponse, error) {
 	_va := make([]interface{}, len(opts))
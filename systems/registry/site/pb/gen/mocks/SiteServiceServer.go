@@ -44,6 +44,66 @@ func (_m *SiteServiceServer) Add(_a0 context.Context, _a1 *gen.AddRequest) (*gen
 	return r0, r1
 }
 
+// CheckDecommission provides a mock function with given fields: _a0, _a1
+func (_m *SiteServiceServer) CheckDecommission(_a0 context.Context, _a1 *gen.CheckDecommissionRequest) (*gen.CheckDecommissionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckDecommission")
+	}
+
+	var r0 *gen.CheckDecommissionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CheckDecommissionRequest) (*gen.CheckDecommissionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.CheckDecommissionRequest) *gen.CheckDecommissionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.CheckDecommissionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.CheckDecommissionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Decommission provides a mock function with given fields: _a0, _a1
+func (_m *SiteServiceServer) Decommission(_a0 context.Context, _a1 *gen.DecommissionRequest) (*gen.DecommissionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Decommission")
+	}
+
+	var r0 *gen.DecommissionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DecommissionRequest) (*gen.DecommissionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.DecommissionRequest) *gen.DecommissionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.DecommissionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.DecommissionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: _a0, _a1
 func (_m *SiteServiceServer) Delete(_a0 context.Context, _a1 *gen.DeleteRequest) (*gen.DeleteResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -74,6 +134,156 @@ func (_m *SiteServiceServer) Delete(_a0 context.Context, _a1 *gen.DeleteRequest)
 	return r0, r1
 }
 
+// ExportNetwork provides a mock function with given fields: _a0, _a1
+func (_m *SiteServiceServer) ExportNetwork(_a0 context.Context, _a1 *gen.ExportNetworkRequest) (*gen.ExportNetworkResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportNetwork")
+	}
+
+	var r0 *gen.ExportNetworkResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ExportNetworkRequest) (*gen.ExportNetworkResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.ExportNetworkRequest) *gen.ExportNetworkResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ExportNetworkResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.ExportNetworkRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindInBounds provides a mock function with given fields: _a0, _a1
+func (_m *SiteServiceServer) FindInBounds(_a0 context.Context, _a1 *gen.FindInBoundsRequest) (*gen.FindSitesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindInBounds")
+	}
+
+	var r0 *gen.FindSitesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindInBoundsRequest) (*gen.FindSitesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindInBoundsRequest) *gen.FindSitesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.FindSitesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.FindInBoundsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindInRadius provides a mock function with given fields: _a0, _a1
+func (_m *SiteServiceServer) FindInRadius(_a0 context.Context, _a1 *gen.FindInRadiusRequest) (*gen.FindSitesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindInRadius")
+	}
+
+	var r0 *gen.FindSitesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindInRadiusRequest) (*gen.FindSitesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindInRadiusRequest) *gen.FindSitesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.FindSitesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.FindInRadiusRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindNearest provides a mock function with given fields: _a0, _a1
+func (_m *SiteServiceServer) FindNearest(_a0 context.Context, _a1 *gen.FindNearestRequest) (*gen.FindSitesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindNearest")
+	}
+
+	var r0 *gen.FindSitesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindNearestRequest) (*gen.FindSitesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindNearestRequest) *gen.FindSitesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.FindSitesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.FindNearestRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindServing provides a mock function with given fields: _a0, _a1
+func (_m *SiteServiceServer) FindServing(_a0 context.Context, _a1 *gen.FindServingRequest) (*gen.FindSitesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindServing")
+	}
+
+	var r0 *gen.FindSitesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindServingRequest) (*gen.FindSitesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.FindServingRequest) *gen.FindSitesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.FindSitesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.FindServingRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: _a0, _a1
 func (_m *SiteServiceServer) Get(_a0 context.Context, _a1 *gen.GetRequest) (*gen.GetResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -104,6 +314,66 @@ func (_m *SiteServiceServer) Get(_a0 context.Context, _a1 *gen.GetRequest) (*gen
 	return r0, r1
 }
 
+// GetCoverage provides a mock function with given fields: _a0, _a1
+func (_m *SiteServiceServer) GetCoverage(_a0 context.Context, _a1 *gen.GetCoverageRequest) (*gen.GetCoverageResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCoverage")
+	}
+
+	var r0 *gen.GetCoverageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetCoverageRequest) (*gen.GetCoverageResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetCoverageRequest) *gen.GetCoverageResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetCoverageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetCoverageRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHistory provides a mock function with given fields: _a0, _a1
+func (_m *SiteServiceServer) GetHistory(_a0 context.Context, _a1 *gen.GetHistoryRequest) (*gen.GetHistoryResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 *gen.GetHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetHistoryRequest) (*gen.GetHistoryResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.GetHistoryRequest) *gen.GetHistoryResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GetHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.GetHistoryRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: _a0, _a1
 func (_m *SiteServiceServer) List(_a0 context.Context, _a1 *gen.ListRequest) (*gen.ListResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -134,6 +404,66 @@ func (_m *SiteServiceServer) List(_a0 context.Context, _a1 *gen.ListRequest) (*g
 	return r0, r1
 }
 
+// Relocate provides a mock function with given fields: _a0, _a1
+func (_m *SiteServiceServer) Relocate(_a0 context.Context, _a1 *gen.RelocateRequest) (*gen.RelocateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Relocate")
+	}
+
+	var r0 *gen.RelocateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RelocateRequest) (*gen.RelocateResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.RelocateRequest) *gen.RelocateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RelocateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.RelocateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetCoverage provides a mock function with given fields: _a0, _a1
+func (_m *SiteServiceServer) SetCoverage(_a0 context.Context, _a1 *gen.SetCoverageRequest) (*gen.SetCoverageResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCoverage")
+	}
+
+	var r0 *gen.SetCoverageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetCoverageRequest) (*gen.SetCoverageResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gen.SetCoverageRequest) *gen.SetCoverageResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.SetCoverageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gen.SetCoverageRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: _a0, _a1
 func (_m *SiteServiceServer) Update(_a0 context.Context, _a1 *gen.UpdateRequest) (*gen.UpdateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -7,8 +7,8 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.11
-// 	protoc        v7.34.1
+// 	protoc-gen-go v1.36.12
+// 	protoc        v6.32.1
 // source: site.proto
 
 package gen
@@ -390,23 +390,25 @@ func (x *ListResponse) GetSites() []*Site {
 }
 
 type Site struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	NetworkId     string                 `protobuf:"bytes,3,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
-	BackhaulId    string                 `protobuf:"bytes,4,opt,name=backhaulId,json=backhaul_id,proto3" json:"backhaulId,omitempty"`
-	PowerId       string                 `protobuf:"bytes,5,opt,name=powerId,json=power_id,proto3" json:"powerId,omitempty"`
-	AccessId      string                 `protobuf:"bytes,6,opt,name=accessId,json=access_id,proto3" json:"accessId,omitempty"`
-	SwitchId      string                 `protobuf:"bytes,7,opt,name=switchId,json=switch_id,proto3" json:"switchId,omitempty"`
-	SpectrumId    string                 `protobuf:"bytes,8,opt,name=spectrumId,json=spectrum_id,proto3" json:"spectrumId,omitempty"`
-	IsDeactivated bool                   `protobuf:"varint,9,opt,name=isDeactivated,json=is_deactivated,proto3" json:"isDeactivated,omitempty"`
-	Latitude      string                 `protobuf:"bytes,10,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude     string                 `protobuf:"bytes,11,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	InstallDate   string                 `protobuf:"bytes,12,opt,name=installDate,json=install_date,proto3" json:"installDate,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,13,opt,name=createdAt,json=created_at,proto3" json:"createdAt,omitempty"`
-	Location      string                 `protobuf:"bytes,14,opt,name=location,proto3" json:"location,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	NetworkId        string                 `protobuf:"bytes,3,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
+	BackhaulId       string                 `protobuf:"bytes,4,opt,name=backhaulId,json=backhaul_id,proto3" json:"backhaulId,omitempty"`
+	PowerId          string                 `protobuf:"bytes,5,opt,name=powerId,json=power_id,proto3" json:"powerId,omitempty"`
+	AccessId         string                 `protobuf:"bytes,6,opt,name=accessId,json=access_id,proto3" json:"accessId,omitempty"`
+	SwitchId         string                 `protobuf:"bytes,7,opt,name=switchId,json=switch_id,proto3" json:"switchId,omitempty"`
+	SpectrumId       string                 `protobuf:"bytes,8,opt,name=spectrumId,json=spectrum_id,proto3" json:"spectrumId,omitempty"`
+	IsDeactivated    bool                   `protobuf:"varint,9,opt,name=isDeactivated,json=is_deactivated,proto3" json:"isDeactivated,omitempty"`
+	Latitude         string                 `protobuf:"bytes,10,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude        string                 `protobuf:"bytes,11,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	InstallDate      string                 `protobuf:"bytes,12,opt,name=installDate,json=install_date,proto3" json:"installDate,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,13,opt,name=createdAt,json=created_at,proto3" json:"createdAt,omitempty"`
+	Location         string                 `protobuf:"bytes,14,opt,name=location,proto3" json:"location,omitempty"`
+	HasCoverage      bool                   `protobuf:"varint,15,opt,name=hasCoverage,json=has_coverage,proto3" json:"hasCoverage,omitempty"`
+	DecommissionedAt string                 `protobuf:"bytes,16,opt,name=decommissionedAt,json=decommissioned_at,proto3" json:"decommissionedAt,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Site) Reset() {
@@ -537,6 +539,20 @@ func (x *Site) GetLocation() string {
 	return ""
 }
 
+func (x *Site) GetHasCoverage() bool {
+	if x != nil {
+		return x.HasCoverage
+	}
+	return false
+}
+
+func (x *Site) GetDecommissionedAt() string {
+	if x != nil {
+		return x.DecommissionedAt
+	}
+	return ""
+}
+
 type UpdateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SiteId        string                 `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
@@ -713,77 +729,1408 @@ func (*DeleteResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{10}
 }
 
-var File_site_proto protoreflect.FileDescriptor
+type FindInRadiusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusMeters  float64                `protobuf:"fixed64,4,opt,name=radiusMeters,json=radius_meters,proto3" json:"radiusMeters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_site_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"site.proto\x12\x16ukama.registry.site.v1\x1a\x0fvalidator.proto\"\xb8\x03\n" +
-	"\n" +
-	"AddRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12(\n" +
-	"\tnetworkId\x18\x02 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\n" +
-	"network_id\x12*\n" +
-	"\n" +
-	"backhaulId\x18\x03 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\vbackhaul_id\x12$\n" +
-	"\apowerId\x18\x04 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\bpower_id\x12&\n" +
-	"\baccessId\x18\x05 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\taccess_id\x12&\n" +
-	"\bswitchId\x18\x06 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\tswitch_id\x12*\n" +
-	"\n" +
-	"spectrumId\x18\a \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\vspectrum_id\x12%\n" +
-	"\risDeactivated\x18\b \x01(\bR\x0eis_deactivated\x12\x1a\n" +
-	"\blatitude\x18\t \x01(\tR\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\n" +
-	" \x01(\tR\tlongitude\x12\x1a\n" +
-	"\blocation\x18\v \x01(\tR\blocation\x12!\n" +
-	"\vinstallDate\x18\f \x01(\tR\finstall_date\"?\n" +
-	"\vAddResponse\x120\n" +
-	"\x04site\x18\x01 \x01(\v2\x1c.ukama.registry.site.v1.SiteR\x04site\"0\n" +
-	"\n" +
-	"GetRequest\x12\"\n" +
-	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\"?\n" +
-	"\vGetResponse\x120\n" +
-	"\x04site\x18\x01 \x01(\v2\x1c.ukama.registry.site.v1.SiteR\x04site\"S\n" +
-	"\vListRequest\x12\x1d\n" +
-	"\tnetworkId\x18\x01 \x01(\tR\n" +
-	"network_id\x12%\n" +
-	"\risDeactivated\x18\x02 \x01(\bR\x0eis_deactivated\"B\n" +
-	"\fListResponse\x122\n" +
-	"\x05sites\x18\x01 \x03(\v2\x1c.ukama.registry.site.v1.SiteR\x05sites\"\xec\x03\n" +
-	"\x04Site\x12\x19\n" +
-	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12(\n" +
-	"\tnetworkId\x18\x03 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\n" +
-	"network_id\x12*\n" +
-	"\n" +
-	"backhaulId\x18\x04 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\vbackhaul_id\x12$\n" +
-	"\apowerId\x18\x05 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\bpower_id\x12&\n" +
-	"\baccessId\x18\x06 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\taccess_id\x12&\n" +
-	"\bswitchId\x18\a \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\tswitch_id\x12*\n" +
-	"\n" +
-	"spectrumId\x18\b \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\vspectrum_id\x12%\n" +
-	"\risDeactivated\x18\t \x01(\bR\x0eis_deactivated\x12\x1a\n" +
-	"\blatitude\x18\n" +
-	" \x01(\tR\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\v \x01(\tR\tlongitude\x12!\n" +
-	"\vinstallDate\x18\f \x01(\tR\finstall_date\x12\x1d\n" +
-	"\tcreatedAt\x18\r \x01(\tR\n" +
-	"created_at\x12\x1a\n" +
-	"\blocation\x18\x0e \x01(\tR\blocation\"G\n" +
-	"\rUpdateRequest\x12\"\n" +
-	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"B\n" +
-	"\x0eUpdateResponse\x120\n" +
-	"\x04site\x18\x01 \x01(\v2\x1c.ukama.registry.site.v1.SiteR\x04site\"3\n" +
-	"\rDeleteRequest\x12\"\n" +
-	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\"\x10\n" +
-	"\x0eDeleteResponse2\xb2\x03\n" +
-	"\vSiteService\x12N\n" +
-	"\x03Add\x12\".ukama.registry.site.v1.AddRequest\x1a#.ukama.registry.site.v1.AddResponse\x12N\n" +
-	"\x03Get\x12\".ukama.registry.site.v1.GetRequest\x1a#.ukama.registry.site.v1.GetResponse\x12W\n" +
-	"\x06Update\x12%.ukama.registry.site.v1.UpdateRequest\x1a&.ukama.registry.site.v1.UpdateResponse\x12Q\n" +
-	"\x04List\x12#.ukama.registry.site.v1.ListRequest\x1a$.ukama.registry.site.v1.ListResponse\x12W\n" +
-	"\x06Delete\x12%.ukama.registry.site.v1.DeleteRequest\x1a&.ukama.registry.site.v1.DeleteResponseB5Z3github.com/ukama/ukama/systems/registry/site/pb/genb\x06proto3"
+func (x *FindInRadiusRequest) Reset() {
+	*x = FindInRadiusRequest{}
+	mi := &file_site_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindInRadiusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindInRadiusRequest) ProtoMessage() {}
+
+func (x *FindInRadiusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindInRadiusRequest.ProtoReflect.Descriptor instead.
+func (*FindInRadiusRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FindInRadiusRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *FindInRadiusRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *FindInRadiusRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *FindInRadiusRequest) GetRadiusMeters() float64 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+type FindInBoundsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
+	MinLatitude   float64                `protobuf:"fixed64,2,opt,name=minLatitude,json=min_latitude,proto3" json:"minLatitude,omitempty"`
+	MinLongitude  float64                `protobuf:"fixed64,3,opt,name=minLongitude,json=min_longitude,proto3" json:"minLongitude,omitempty"`
+	MaxLatitude   float64                `protobuf:"fixed64,4,opt,name=maxLatitude,json=max_latitude,proto3" json:"maxLatitude,omitempty"`
+	MaxLongitude  float64                `protobuf:"fixed64,5,opt,name=maxLongitude,json=max_longitude,proto3" json:"maxLongitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindInBoundsRequest) Reset() {
+	*x = FindInBoundsRequest{}
+	mi := &file_site_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindInBoundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindInBoundsRequest) ProtoMessage() {}
+
+func (x *FindInBoundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindInBoundsRequest.ProtoReflect.Descriptor instead.
+func (*FindInBoundsRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FindInBoundsRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *FindInBoundsRequest) GetMinLatitude() float64 {
+	if x != nil {
+		return x.MinLatitude
+	}
+	return 0
+}
+
+func (x *FindInBoundsRequest) GetMinLongitude() float64 {
+	if x != nil {
+		return x.MinLongitude
+	}
+	return 0
+}
+
+func (x *FindInBoundsRequest) GetMaxLatitude() float64 {
+	if x != nil {
+		return x.MaxLatitude
+	}
+	return 0
+}
+
+func (x *FindInBoundsRequest) GetMaxLongitude() float64 {
+	if x != nil {
+		return x.MaxLongitude
+	}
+	return 0
+}
+
+type FindNearestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Limit         uint32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindNearestRequest) Reset() {
+	*x = FindNearestRequest{}
+	mi := &file_site_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNearestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNearestRequest) ProtoMessage() {}
+
+func (x *FindNearestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNearestRequest.ProtoReflect.Descriptor instead.
+func (*FindNearestRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FindNearestRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *FindNearestRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *FindNearestRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *FindNearestRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Sites whose coverage area contains the point
+type FindServingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindServingRequest) Reset() {
+	*x = FindServingRequest{}
+	mi := &file_site_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindServingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindServingRequest) ProtoMessage() {}
+
+func (x *FindServingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindServingRequest.ProtoReflect.Descriptor instead.
+func (*FindServingRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FindServingRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *FindServingRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *FindServingRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type SiteDistance struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Site           *Site                  `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"`
+	DistanceMeters float64                `protobuf:"fixed64,2,opt,name=distanceMeters,json=distance_meters,proto3" json:"distanceMeters,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SiteDistance) Reset() {
+	*x = SiteDistance{}
+	mi := &file_site_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteDistance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteDistance) ProtoMessage() {}
+
+func (x *SiteDistance) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteDistance.ProtoReflect.Descriptor instead.
+func (*SiteDistance) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SiteDistance) GetSite() *Site {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+func (x *SiteDistance) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+// Sorted by distance from the query point, nearest first
+type FindSitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sites         []*SiteDistance        `protobuf:"bytes,1,rep,name=sites,proto3" json:"sites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindSitesResponse) Reset() {
+	*x = FindSitesResponse{}
+	mi := &file_site_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindSitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSitesResponse) ProtoMessage() {}
+
+func (x *FindSitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSitesResponse.ProtoReflect.Descriptor instead.
+func (*FindSitesResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FindSitesResponse) GetSites() []*SiteDistance {
+	if x != nil {
+		return x.Sites
+	}
+	return nil
+}
+
+// An empty geojson clears the coverage area
+type SetCoverageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
+	Geojson       string                 `protobuf:"bytes,2,opt,name=geojson,proto3" json:"geojson,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCoverageRequest) Reset() {
+	*x = SetCoverageRequest{}
+	mi := &file_site_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCoverageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCoverageRequest) ProtoMessage() {}
+
+func (x *SetCoverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCoverageRequest.ProtoReflect.Descriptor instead.
+func (*SetCoverageRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetCoverageRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SetCoverageRequest) GetGeojson() string {
+	if x != nil {
+		return x.Geojson
+	}
+	return ""
+}
+
+type SetCoverageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Site          *Site                  `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCoverageResponse) Reset() {
+	*x = SetCoverageResponse{}
+	mi := &file_site_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCoverageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCoverageResponse) ProtoMessage() {}
+
+func (x *SetCoverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCoverageResponse.ProtoReflect.Descriptor instead.
+func (*SetCoverageResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetCoverageResponse) GetSite() *Site {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+type GetCoverageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoverageRequest) Reset() {
+	*x = GetCoverageRequest{}
+	mi := &file_site_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoverageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoverageRequest) ProtoMessage() {}
+
+func (x *GetCoverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoverageRequest.ProtoReflect.Descriptor instead.
+func (*GetCoverageRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetCoverageRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+// A GeoJSON Feature with the coverage polygon and the site as properties
+type GetCoverageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Geojson       string                 `protobuf:"bytes,1,opt,name=geojson,proto3" json:"geojson,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoverageResponse) Reset() {
+	*x = GetCoverageResponse{}
+	mi := &file_site_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoverageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoverageResponse) ProtoMessage() {}
+
+func (x *GetCoverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoverageResponse.ProtoReflect.Descriptor instead.
+func (*GetCoverageResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCoverageResponse) GetGeojson() string {
+	if x != nil {
+		return x.Geojson
+	}
+	return ""
+}
+
+type ExportNetworkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=networkId,json=network_id,proto3" json:"networkId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportNetworkRequest) Reset() {
+	*x = ExportNetworkRequest{}
+	mi := &file_site_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportNetworkRequest) ProtoMessage() {}
+
+func (x *ExportNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportNetworkRequest.ProtoReflect.Descriptor instead.
+func (*ExportNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExportNetworkRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+// A GeoJSON FeatureCollection with a Point per site and a feature per coverage area
+type ExportNetworkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Geojson       string                 `protobuf:"bytes,1,opt,name=geojson,proto3" json:"geojson,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportNetworkResponse) Reset() {
+	*x = ExportNetworkResponse{}
+	mi := &file_site_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportNetworkResponse) ProtoMessage() {}
+
+func (x *ExportNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportNetworkResponse.ProtoReflect.Descriptor instead.
+func (*ExportNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExportNetworkResponse) GetGeojson() string {
+	if x != nil {
+		return x.Geojson
+	}
+	return ""
+}
+
+type CheckDecommissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDecommissionRequest) Reset() {
+	*x = CheckDecommissionRequest{}
+	mi := &file_site_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDecommissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDecommissionRequest) ProtoMessage() {}
+
+func (x *CheckDecommissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDecommissionRequest.ProtoReflect.Descriptor instead.
+func (*CheckDecommissionRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CheckDecommissionRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type CheckDecommissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeIds       []string               `protobuf:"bytes,1,rep,name=nodeIds,json=node_ids,proto3" json:"nodeIds,omitempty"`
+	OnlineNodeIds []string               `protobuf:"bytes,2,rep,name=onlineNodeIds,json=online_node_ids,proto3" json:"onlineNodeIds,omitempty"`
+	ComponentIds  []string               `protobuf:"bytes,3,rep,name=componentIds,json=component_ids,proto3" json:"componentIds,omitempty"`
+	// Reasons the site can't be decommissioned without force
+	Blockers      []string `protobuf:"bytes,4,rep,name=blockers,proto3" json:"blockers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDecommissionResponse) Reset() {
+	*x = CheckDecommissionResponse{}
+	mi := &file_site_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDecommissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDecommissionResponse) ProtoMessage() {}
+
+func (x *CheckDecommissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDecommissionResponse.ProtoReflect.Descriptor instead.
+func (*CheckDecommissionResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CheckDecommissionResponse) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *CheckDecommissionResponse) GetOnlineNodeIds() []string {
+	if x != nil {
+		return x.OnlineNodeIds
+	}
+	return nil
+}
+
+func (x *CheckDecommissionResponse) GetComponentIds() []string {
+	if x != nil {
+		return x.ComponentIds
+	}
+	return nil
+}
+
+func (x *CheckDecommissionResponse) GetBlockers() []string {
+	if x != nil {
+		return x.Blockers
+	}
+	return nil
+}
+
+type DecommissionRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SiteId string                 `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
+	Reason string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Decommission even if nodes of the site are online
+	Force         bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecommissionRequest) Reset() {
+	*x = DecommissionRequest{}
+	mi := &file_site_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecommissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecommissionRequest) ProtoMessage() {}
+
+func (x *DecommissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecommissionRequest.ProtoReflect.Descriptor instead.
+func (*DecommissionRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DecommissionRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *DecommissionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DecommissionRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DecommissionResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Site                 *Site                  `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"`
+	ReleasedNodeIds      []string               `protobuf:"bytes,2,rep,name=releasedNodeIds,json=released_node_ids,proto3" json:"releasedNodeIds,omitempty"`
+	ReleasedComponentIds []string               `protobuf:"bytes,3,rep,name=releasedComponentIds,json=released_component_ids,proto3" json:"releasedComponentIds,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DecommissionResponse) Reset() {
+	*x = DecommissionResponse{}
+	mi := &file_site_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecommissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecommissionResponse) ProtoMessage() {}
+
+func (x *DecommissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecommissionResponse.ProtoReflect.Descriptor instead.
+func (*DecommissionResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DecommissionResponse) GetSite() *Site {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+func (x *DecommissionResponse) GetReleasedNodeIds() []string {
+	if x != nil {
+		return x.ReleasedNodeIds
+	}
+	return nil
+}
+
+func (x *DecommissionResponse) GetReleasedComponentIds() []string {
+	if x != nil {
+		return x.ReleasedComponentIds
+	}
+	return nil
+}
+
+type RelocateRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SiteId    string                 `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
+	Latitude  string                 `protobuf:"bytes,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude string                 `protobuf:"bytes,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Empty keeps the current location and backhaul
+	Location      string `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	BackhaulId    string `protobuf:"bytes,5,opt,name=backhaulId,json=backhaul_id,proto3" json:"backhaulId,omitempty"`
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelocateRequest) Reset() {
+	*x = RelocateRequest{}
+	mi := &file_site_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelocateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelocateRequest) ProtoMessage() {}
+
+func (x *RelocateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelocateRequest.ProtoReflect.Descriptor instead.
+func (*RelocateRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RelocateRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *RelocateRequest) GetLatitude() string {
+	if x != nil {
+		return x.Latitude
+	}
+	return ""
+}
+
+func (x *RelocateRequest) GetLongitude() string {
+	if x != nil {
+		return x.Longitude
+	}
+	return ""
+}
+
+func (x *RelocateRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *RelocateRequest) GetBackhaulId() string {
+	if x != nil {
+		return x.BackhaulId
+	}
+	return ""
+}
+
+func (x *RelocateRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RelocateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Site          *Site                  `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelocateResponse) Reset() {
+	*x = RelocateResponse{}
+	mi := &file_site_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelocateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelocateResponse) ProtoMessage() {}
+
+func (x *RelocateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelocateResponse.ProtoReflect.Descriptor instead.
+func (*RelocateResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RelocateResponse) GetSite() *Site {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+type GetHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=siteId,json=site_id,proto3" json:"siteId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryRequest) Reset() {
+	*x = GetHistoryRequest{}
+	mi := &file_site_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryRequest) ProtoMessage() {}
+
+func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetHistoryRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type SiteHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Latitude      string                 `protobuf:"bytes,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     string                 `protobuf:"bytes,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Location      string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	BackhaulId    string                 `protobuf:"bytes,6,opt,name=backhaulId,json=backhaul_id,proto3" json:"backhaulId,omitempty"`
+	SpectrumId    string                 `protobuf:"bytes,7,opt,name=spectrumId,json=spectrum_id,proto3" json:"spectrumId,omitempty"`
+	PowerId       string                 `protobuf:"bytes,8,opt,name=powerId,json=power_id,proto3" json:"powerId,omitempty"`
+	AccessId      string                 `protobuf:"bytes,9,opt,name=accessId,json=access_id,proto3" json:"accessId,omitempty"`
+	SwitchId      string                 `protobuf:"bytes,10,opt,name=switchId,json=switch_id,proto3" json:"switchId,omitempty"`
+	NodeIds       []string               `protobuf:"bytes,11,rep,name=nodeIds,json=node_ids,proto3" json:"nodeIds,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,12,opt,name=createdAt,json=created_at,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SiteHistory) Reset() {
+	*x = SiteHistory{}
+	mi := &file_site_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteHistory) ProtoMessage() {}
+
+func (x *SiteHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteHistory.ProtoReflect.Descriptor instead.
+func (*SiteHistory) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SiteHistory) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SiteHistory) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SiteHistory) GetLatitude() string {
+	if x != nil {
+		return x.Latitude
+	}
+	return ""
+}
+
+func (x *SiteHistory) GetLongitude() string {
+	if x != nil {
+		return x.Longitude
+	}
+	return ""
+}
+
+func (x *SiteHistory) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *SiteHistory) GetBackhaulId() string {
+	if x != nil {
+		return x.BackhaulId
+	}
+	return ""
+}
+
+func (x *SiteHistory) GetSpectrumId() string {
+	if x != nil {
+		return x.SpectrumId
+	}
+	return ""
+}
+
+func (x *SiteHistory) GetPowerId() string {
+	if x != nil {
+		return x.PowerId
+	}
+	return ""
+}
+
+func (x *SiteHistory) GetAccessId() string {
+	if x != nil {
+		return x.AccessId
+	}
+	return ""
+}
+
+func (x *SiteHistory) GetSwitchId() string {
+	if x != nil {
+		return x.SwitchId
+	}
+	return ""
+}
+
+func (x *SiteHistory) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *SiteHistory) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       []*SiteHistory         `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryResponse) Reset() {
+	*x = GetHistoryResponse{}
+	mi := &file_site_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryResponse) ProtoMessage() {}
+
+func (x *GetHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetHistoryResponse) GetHistory() []*SiteHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+var File_site_proto protoreflect.FileDescriptor
+
+const file_site_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"site.proto\x12\x16ukama.registry.site.v1\x1a\x0fvalidator.proto\"\xb8\x03\n" +
+	"\n" +
+	"AddRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12(\n" +
+	"\tnetworkId\x18\x02 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\n" +
+	"network_id\x12*\n" +
+	"\n" +
+	"backhaulId\x18\x03 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\vbackhaul_id\x12$\n" +
+	"\apowerId\x18\x04 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\bpower_id\x12&\n" +
+	"\baccessId\x18\x05 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\taccess_id\x12&\n" +
+	"\bswitchId\x18\x06 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\tswitch_id\x12*\n" +
+	"\n" +
+	"spectrumId\x18\a \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\vspectrum_id\x12%\n" +
+	"\risDeactivated\x18\b \x01(\bR\x0eis_deactivated\x12\x1a\n" +
+	"\blatitude\x18\t \x01(\tR\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\n" +
+	" \x01(\tR\tlongitude\x12\x1a\n" +
+	"\blocation\x18\v \x01(\tR\blocation\x12!\n" +
+	"\vinstallDate\x18\f \x01(\tR\finstall_date\"?\n" +
+	"\vAddResponse\x120\n" +
+	"\x04site\x18\x01 \x01(\v2\x1c.ukama.registry.site.v1.SiteR\x04site\"0\n" +
+	"\n" +
+	"GetRequest\x12\"\n" +
+	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\"?\n" +
+	"\vGetResponse\x120\n" +
+	"\x04site\x18\x01 \x01(\v2\x1c.ukama.registry.site.v1.SiteR\x04site\"S\n" +
+	"\vListRequest\x12\x1d\n" +
+	"\tnetworkId\x18\x01 \x01(\tR\n" +
+	"network_id\x12%\n" +
+	"\risDeactivated\x18\x02 \x01(\bR\x0eis_deactivated\"B\n" +
+	"\fListResponse\x122\n" +
+	"\x05sites\x18\x01 \x03(\v2\x1c.ukama.registry.site.v1.SiteR\x05sites\"\xbc\x04\n" +
+	"\x04Site\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12(\n" +
+	"\tnetworkId\x18\x03 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\n" +
+	"network_id\x12*\n" +
+	"\n" +
+	"backhaulId\x18\x04 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\vbackhaul_id\x12$\n" +
+	"\apowerId\x18\x05 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\bpower_id\x12&\n" +
+	"\baccessId\x18\x06 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\taccess_id\x12&\n" +
+	"\bswitchId\x18\a \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\tswitch_id\x12*\n" +
+	"\n" +
+	"spectrumId\x18\b \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\vspectrum_id\x12%\n" +
+	"\risDeactivated\x18\t \x01(\bR\x0eis_deactivated\x12\x1a\n" +
+	"\blatitude\x18\n" +
+	" \x01(\tR\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\v \x01(\tR\tlongitude\x12!\n" +
+	"\vinstallDate\x18\f \x01(\tR\finstall_date\x12\x1d\n" +
+	"\tcreatedAt\x18\r \x01(\tR\n" +
+	"created_at\x12\x1a\n" +
+	"\blocation\x18\x0e \x01(\tR\blocation\x12!\n" +
+	"\vhasCoverage\x18\x0f \x01(\bR\fhas_coverage\x12+\n" +
+	"\x10decommissionedAt\x18\x10 \x01(\tR\x11decommissioned_at\"G\n" +
+	"\rUpdateRequest\x12\"\n" +
+	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"B\n" +
+	"\x0eUpdateResponse\x120\n" +
+	"\x04site\x18\x01 \x01(\v2\x1c.ukama.registry.site.v1.SiteR\x04site\"3\n" +
+	"\rDeleteRequest\x12\"\n" +
+	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\"\x10\n" +
+	"\x0eDeleteResponse\"\xd2\x01\n" +
+	"\x13FindInRadiusRequest\x12\x1d\n" +
+	"\tnetworkId\x18\x01 \x01(\tR\n" +
+	"network_id\x122\n" +
+	"\blatitude\x18\x02 \x01(\x01B\x16\xe2\xdf\x1f\x12I\x00\x00\x00\x00\x00\x80V\xc0Q\x00\x00\x00\x00\x00\x80V@R\blatitude\x124\n" +
+	"\tlongitude\x18\x03 \x01(\x01B\x16\xe2\xdf\x1f\x12I\x00\x00\x00\x00\x00\x80f\xc0Q\x00\x00\x00\x00\x00\x80f@R\tlongitude\x122\n" +
+	"\fradiusMeters\x18\x04 \x01(\x01B\r\xe2\xdf\x1f\t1\x00\x00\x00\x00\x00\x00\x00\x00R\rradius_meters\"\xa4\x02\n" +
+	"\x13FindInBoundsRequest\x12\x1d\n" +
+	"\tnetworkId\x18\x01 \x01(\tR\n" +
+	"network_id\x129\n" +
+	"\vminLatitude\x18\x02 \x01(\x01B\x16\xe2\xdf\x1f\x12I\x00\x00\x00\x00\x00\x80V\xc0Q\x00\x00\x00\x00\x00\x80V@R\fmin_latitude\x12;\n" +
+	"\fminLongitude\x18\x03 \x01(\x01B\x16\xe2\xdf\x1f\x12I\x00\x00\x00\x00\x00\x80f\xc0Q\x00\x00\x00\x00\x00\x80f@R\rmin_longitude\x129\n" +
+	"\vmaxLatitude\x18\x04 \x01(\x01B\x16\xe2\xdf\x1f\x12I\x00\x00\x00\x00\x00\x80V\xc0Q\x00\x00\x00\x00\x00\x80V@R\fmax_latitude\x12;\n" +
+	"\fmaxLongitude\x18\x05 \x01(\x01B\x16\xe2\xdf\x1f\x12I\x00\x00\x00\x00\x00\x80f\xc0Q\x00\x00\x00\x00\x00\x80f@R\rmax_longitude\"\xb3\x01\n" +
+	"\x12FindNearestRequest\x12\x1d\n" +
+	"\tnetworkId\x18\x01 \x01(\tR\n" +
+	"network_id\x122\n" +
+	"\blatitude\x18\x02 \x01(\x01B\x16\xe2\xdf\x1f\x12I\x00\x00\x00\x00\x00\x80V\xc0Q\x00\x00\x00\x00\x00\x80V@R\blatitude\x124\n" +
+	"\tlongitude\x18\x03 \x01(\x01B\x16\xe2\xdf\x1f\x12I\x00\x00\x00\x00\x00\x80f\xc0Q\x00\x00\x00\x00\x00\x80f@R\tlongitude\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\rR\x05limit\"\x9d\x01\n" +
+	"\x12FindServingRequest\x12\x1d\n" +
+	"\tnetworkId\x18\x01 \x01(\tR\n" +
+	"network_id\x122\n" +
+	"\blatitude\x18\x02 \x01(\x01B\x16\xe2\xdf\x1f\x12I\x00\x00\x00\x00\x00\x80V\xc0Q\x00\x00\x00\x00\x00\x80V@R\blatitude\x124\n" +
+	"\tlongitude\x18\x03 \x01(\x01B\x16\xe2\xdf\x1f\x12I\x00\x00\x00\x00\x00\x80f\xc0Q\x00\x00\x00\x00\x00\x80f@R\tlongitude\"i\n" +
+	"\fSiteDistance\x120\n" +
+	"\x04site\x18\x01 \x01(\v2\x1c.ukama.registry.site.v1.SiteR\x04site\x12'\n" +
+	"\x0edistanceMeters\x18\x02 \x01(\x01R\x0fdistance_meters\"O\n" +
+	"\x11FindSitesResponse\x12:\n" +
+	"\x05sites\x18\x01 \x03(\v2$.ukama.registry.site.v1.SiteDistanceR\x05sites\"R\n" +
+	"\x12SetCoverageRequest\x12\"\n" +
+	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\x12\x18\n" +
+	"\ageojson\x18\x02 \x01(\tR\ageojson\"G\n" +
+	"\x13SetCoverageResponse\x120\n" +
+	"\x04site\x18\x01 \x01(\v2\x1c.ukama.registry.site.v1.SiteR\x04site\"8\n" +
+	"\x12GetCoverageRequest\x12\"\n" +
+	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\"/\n" +
+	"\x13GetCoverageResponse\x12\x18\n" +
+	"\ageojson\x18\x01 \x01(\tR\ageojson\"@\n" +
+	"\x14ExportNetworkRequest\x12(\n" +
+	"\tnetworkId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\n" +
+	"network_id\"1\n" +
+	"\x15ExportNetworkResponse\x12\x18\n" +
+	"\ageojson\x18\x01 \x01(\tR\ageojson\">\n" +
+	"\x18CheckDecommissionRequest\x12\"\n" +
+	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\"\x9f\x01\n" +
+	"\x19CheckDecommissionResponse\x12\x19\n" +
+	"\anodeIds\x18\x01 \x03(\tR\bnode_ids\x12&\n" +
+	"\ronlineNodeIds\x18\x02 \x03(\tR\x0fonline_node_ids\x12#\n" +
+	"\fcomponentIds\x18\x03 \x03(\tR\rcomponent_ids\x12\x1a\n" +
+	"\bblockers\x18\x04 \x03(\tR\bblockers\"g\n" +
+	"\x13DecommissionRequest\x12\"\n" +
+	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"\xaa\x01\n" +
+	"\x14DecommissionResponse\x120\n" +
+	"\x04site\x18\x01 \x01(\v2\x1c.ukama.registry.site.v1.SiteR\x04site\x12*\n" +
+	"\x0freleasedNodeIds\x18\x02 \x03(\tR\x11released_node_ids\x124\n" +
+	"\x14releasedComponentIds\x18\x03 \x03(\tR\x16released_component_ids\"\xd4\x01\n" +
+	"\x0fRelocateRequest\x12\"\n" +
+	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\x12\"\n" +
+	"\blatitude\x18\x02 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\blatitude\x12$\n" +
+	"\tlongitude\x18\x03 \x01(\tB\x06\xe2\xdf\x1f\x02X\x01R\tlongitude\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\x12\x1f\n" +
+	"\n" +
+	"backhaulId\x18\x05 \x01(\tR\vbackhaul_id\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"D\n" +
+	"\x10RelocateResponse\x120\n" +
+	"\x04site\x18\x01 \x01(\v2\x1c.ukama.registry.site.v1.SiteR\x04site\"7\n" +
+	"\x11GetHistoryRequest\x12\"\n" +
+	"\x06siteId\x18\x01 \x01(\tB\t\xe2\xdf\x1f\x05X\x01\x90\x01\x04R\asite_id\"\xe4\x02\n" +
+	"\vSiteHistory\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\tR\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\tR\tlongitude\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12\x1f\n" +
+	"\n" +
+	"backhaulId\x18\x06 \x01(\tR\vbackhaul_id\x12\x1f\n" +
+	"\n" +
+	"spectrumId\x18\a \x01(\tR\vspectrum_id\x12\x19\n" +
+	"\apowerId\x18\b \x01(\tR\bpower_id\x12\x1b\n" +
+	"\baccessId\x18\t \x01(\tR\taccess_id\x12\x1b\n" +
+	"\bswitchId\x18\n" +
+	" \x01(\tR\tswitch_id\x12\x19\n" +
+	"\anodeIds\x18\v \x03(\tR\bnode_ids\x12\x1d\n" +
+	"\tcreatedAt\x18\f \x01(\tR\n" +
+	"created_at\"S\n" +
+	"\x12GetHistoryResponse\x12=\n" +
+	"\ahistory\x18\x01 \x03(\v2#.ukama.registry.site.v1.SiteHistoryR\ahistory2\xb5\f\n" +
+	"\vSiteService\x12N\n" +
+	"\x03Add\x12\".ukama.registry.site.v1.AddRequest\x1a#.ukama.registry.site.v1.AddResponse\x12N\n" +
+	"\x03Get\x12\".ukama.registry.site.v1.GetRequest\x1a#.ukama.registry.site.v1.GetResponse\x12W\n" +
+	"\x06Update\x12%.ukama.registry.site.v1.UpdateRequest\x1a&.ukama.registry.site.v1.UpdateResponse\x12Q\n" +
+	"\x04List\x12#.ukama.registry.site.v1.ListRequest\x1a$.ukama.registry.site.v1.ListResponse\x12W\n" +
+	"\x06Delete\x12%.ukama.registry.site.v1.DeleteRequest\x1a&.ukama.registry.site.v1.DeleteResponse\x12f\n" +
+	"\fFindInRadius\x12+.ukama.registry.site.v1.FindInRadiusRequest\x1a).ukama.registry.site.v1.FindSitesResponse\x12f\n" +
+	"\fFindInBounds\x12+.ukama.registry.site.v1.FindInBoundsRequest\x1a).ukama.registry.site.v1.FindSitesResponse\x12d\n" +
+	"\vFindNearest\x12*.ukama.registry.site.v1.FindNearestRequest\x1a).ukama.registry.site.v1.FindSitesResponse\x12d\n" +
+	"\vFindServing\x12*.ukama.registry.site.v1.FindServingRequest\x1a).ukama.registry.site.v1.FindSitesResponse\x12f\n" +
+	"\vSetCoverage\x12*.ukama.registry.site.v1.SetCoverageRequest\x1a+.ukama.registry.site.v1.SetCoverageResponse\x12f\n" +
+	"\vGetCoverage\x12*.ukama.registry.site.v1.GetCoverageRequest\x1a+.ukama.registry.site.v1.GetCoverageResponse\x12l\n" +
+	"\rExportNetwork\x12,.ukama.registry.site.v1.ExportNetworkRequest\x1a-.ukama.registry.site.v1.ExportNetworkResponse\x12x\n" +
+	"\x11CheckDecommission\x120.ukama.registry.site.v1.CheckDecommissionRequest\x1a1.ukama.registry.site.v1.CheckDecommissionResponse\x12i\n" +
+	"\fDecommission\x12+.ukama.registry.site.v1.DecommissionRequest\x1a,.ukama.registry.site.v1.DecommissionResponse\x12]\n" +
+	"\bRelocate\x12'.ukama.registry.site.v1.RelocateRequest\x1a(.ukama.registry.site.v1.RelocateResponse\x12c\n" +
+	"\n" +
+	"GetHistory\x12).ukama.registry.site.v1.GetHistoryRequest\x1a*.ukama.registry.site.v1.GetHistoryResponseB5Z3github.com/ukama/ukama/systems/registry/site/pb/genb\x06proto3"
 
 var (
 	file_site_proto_rawDescOnce sync.Once
@@ -797,40 +2144,89 @@ func file_site_proto_rawDescGZIP() []byte {
 	return file_site_proto_rawDescData
 }
 
-var file_site_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_site_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_site_proto_goTypes = []any{
-	(*AddRequest)(nil),     // 0: ukama.registry.site.v1.AddRequest
-	(*AddResponse)(nil),    // 1: ukama.registry.site.v1.AddResponse
-	(*GetRequest)(nil),     // 2: ukama.registry.site.v1.GetRequest
-	(*GetResponse)(nil),    // 3: ukama.registry.site.v1.GetResponse
-	(*ListRequest)(nil),    // 4: ukama.registry.site.v1.ListRequest
-	(*ListResponse)(nil),   // 5: ukama.registry.site.v1.ListResponse
-	(*Site)(nil),           // 6: ukama.registry.site.v1.Site
-	(*UpdateRequest)(nil),  // 7: ukama.registry.site.v1.UpdateRequest
-	(*UpdateResponse)(nil), // 8: ukama.registry.site.v1.UpdateResponse
-	(*DeleteRequest)(nil),  // 9: ukama.registry.site.v1.DeleteRequest
-	(*DeleteResponse)(nil), // 10: ukama.registry.site.v1.DeleteResponse
+	(*AddRequest)(nil),                // 0: ukama.registry.site.v1.AddRequest
+	(*AddResponse)(nil),               // 1: ukama.registry.site.v1.AddResponse
+	(*GetRequest)(nil),                // 2: ukama.registry.site.v1.GetRequest
+	(*GetResponse)(nil),               // 3: ukama.registry.site.v1.GetResponse
+	(*ListRequest)(nil),               // 4: ukama.registry.site.v1.ListRequest
+	(*ListResponse)(nil),              // 5: ukama.registry.site.v1.ListResponse
+	(*Site)(nil),                      // 6: ukama.registry.site.v1.Site
+	(*UpdateRequest)(nil),             // 7: ukama.registry.site.v1.UpdateRequest
+	(*UpdateResponse)(nil),            // 8: ukama.registry.site.v1.UpdateResponse
+	(*DeleteRequest)(nil),             // 9: ukama.registry.site.v1.DeleteRequest
+	(*DeleteResponse)(nil),            // 10: ukama.registry.site.v1.DeleteResponse
+	(*FindInRadiusRequest)(nil),       // 11: ukama.registry.site.v1.FindInRadiusRequest
+	(*FindInBoundsRequest)(nil),       // 12: ukama.registry.site.v1.FindInBoundsRequest
+	(*FindNearestRequest)(nil),        // 13: ukama.registry.site.v1.FindNearestRequest
+	(*FindServingRequest)(nil),        // 14: ukama.registry.site.v1.FindServingRequest
+	(*SiteDistance)(nil),              // 15: ukama.registry.site.v1.SiteDistance
+	(*FindSitesResponse)(nil),         // 16: ukama.registry.site.v1.FindSitesResponse
+	(*SetCoverageRequest)(nil),        // 17: ukama.registry.site.v1.SetCoverageRequest
+	(*SetCoverageResponse)(nil),       // 18: ukama.registry.site.v1.SetCoverageResponse
+	(*GetCoverageRequest)(nil),        // 19: ukama.registry.site.v1.GetCoverageRequest
+	(*GetCoverageResponse)(nil),       // 20: ukama.registry.site.v1.GetCoverageResponse
+	(*ExportNetworkRequest)(nil),      // 21: ukama.registry.site.v1.ExportNetworkRequest
+	(*ExportNetworkResponse)(nil),     // 22: ukama.registry.site.v1.ExportNetworkResponse
+	(*CheckDecommissionRequest)(nil),  // 23: ukama.registry.site.v1.CheckDecommissionRequest
+	(*CheckDecommissionResponse)(nil), // 24: ukama.registry.site.v1.CheckDecommissionResponse
+	(*DecommissionRequest)(nil),       // 25: ukama.registry.site.v1.DecommissionRequest
+	(*DecommissionResponse)(nil),      // 26: ukama.registry.site.v1.DecommissionResponse
+	(*RelocateRequest)(nil),           // 27: ukama.registry.site.v1.RelocateRequest
+	(*RelocateResponse)(nil),          // 28: ukama.registry.site.v1.RelocateResponse
+	(*GetHistoryRequest)(nil),         // 29: ukama.registry.site.v1.GetHistoryRequest
+	(*SiteHistory)(nil),               // 30: ukama.registry.site.v1.SiteHistory
+	(*GetHistoryResponse)(nil),        // 31: ukama.registry.site.v1.GetHistoryResponse
 }
 var file_site_proto_depIdxs = []int32{
 	6,  // 0: ukama.registry.site.v1.AddResponse.site:type_name -> ukama.registry.site.v1.Site
 	6,  // 1: ukama.registry.site.v1.GetResponse.site:type_name -> ukama.registry.site.v1.Site
 	6,  // 2: ukama.registry.site.v1.ListResponse.sites:type_name -> ukama.registry.site.v1.Site
 	6,  // 3: ukama.registry.site.v1.UpdateResponse.site:type_name -> ukama.registry.site.v1.Site
-	0,  // 4: ukama.registry.site.v1.SiteService.Add:input_type -> ukama.registry.site.v1.AddRequest
-	2,  // 5: ukama.registry.site.v1.SiteService.Get:input_type -> ukama.registry.site.v1.GetRequest
-	7,  // 6: ukama.registry.site.v1.SiteService.Update:input_type -> ukama.registry.site.v1.UpdateRequest
-	4,  // 7: ukama.registry.site.v1.SiteService.List:input_type -> ukama.registry.site.v1.ListRequest
-	9,  // 8: ukama.registry.site.v1.SiteService.Delete:input_type -> ukama.registry.site.v1.DeleteRequest
-	1,  // 9: ukama.registry.site.v1.SiteService.Add:output_type -> ukama.registry.site.v1.AddResponse
-	3,  // 10: ukama.registry.site.v1.SiteService.Get:output_type -> ukama.registry.site.v1.GetResponse
-	8,  // 11: ukama.registry.site.v1.SiteService.Update:output_type -> ukama.registry.site.v1.UpdateResponse
-	5,  // 12: ukama.registry.site.v1.SiteService.List:output_type -> ukama.registry.site.v1.ListResponse
-	10, // 13: ukama.registry.site.v1.SiteService.Delete:output_type -> ukama.registry.site.v1.DeleteResponse
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	6,  // 4: ukama.registry.site.v1.SiteDistance.site:type_name -> ukama.registry.site.v1.Site
+	15, // 5: ukama.registry.site.v1.FindSitesResponse.sites:type_name -> ukama.registry.site.v1.SiteDistance
+	6,  // 6: ukama.registry.site.v1.SetCoverageResponse.site:type_name -> ukama.registry.site.v1.Site
+	6,  // 7: ukama.registry.site.v1.DecommissionResponse.site:type_name -> ukama.registry.site.v1.Site
+	6,  // 8: ukama.registry.site.v1.RelocateResponse.site:type_name -> ukama.registry.site.v1.Site
+	30, // 9: ukama.registry.site.v1.GetHistoryResponse.history:type_name -> ukama.registry.site.v1.SiteHistory
+	0,  // 10: ukama.registry.site.v1.SiteService.Add:input_type -> ukama.registry.site.v1.AddRequest
+	2,  // 11: ukama.registry.site.v1.SiteService.Get:input_type -> ukama.registry.site.v1.GetRequest
+	7,  // 12: ukama.registry.site.v1.SiteService.Update:input_type -> ukama.registry.site.v1.UpdateRequest
+	4,  // 13: ukama.registry.site.v1.SiteService.List:input_type -> ukama.registry.site.v1.ListRequest
+	9,  // 14: ukama.registry.site.v1.SiteService.Delete:input_type -> ukama.registry.site.v1.DeleteRequest
+	11, // 15: ukama.registry.site.v1.SiteService.FindInRadius:input_type -> ukama.registry.site.v1.FindInRadiusRequest
+	12, // 16: ukama.registry.site.v1.SiteService.FindInBounds:input_type -> ukama.registry.site.v1.FindInBoundsRequest
+	13, // 17: ukama.registry.site.v1.SiteService.FindNearest:input_type -> ukama.registry.site.v1.FindNearestRequest
+	14, // 18: ukama.registry.site.v1.SiteService.FindServing:input_type -> ukama.registry.site.v1.FindServingRequest
+	17, // 19: ukama.registry.site.v1.SiteService.SetCoverage:input_type -> ukama.registry.site.v1.SetCoverageRequest
+	19, // 20: ukama.registry.site.v1.SiteService.GetCoverage:input_type -> ukama.registry.site.v1.GetCoverageRequest
+	21, // 21: ukama.registry.site.v1.SiteService.ExportNetwork:input_type -> ukama.registry.site.v1.ExportNetworkRequest
+	23, // 22: ukama.registry.site.v1.SiteService.CheckDecommission:input_type -> ukama.registry.site.v1.CheckDecommissionRequest
+	25, // 23: ukama.registry.site.v1.SiteService.Decommission:input_type -> ukama.registry.site.v1.DecommissionRequest
+	27, // 24: ukama.registry.site.v1.SiteService.Relocate:input_type -> ukama.registry.site.v1.RelocateRequest
+	29, // 25: ukama.registry.site.v1.SiteService.GetHistory:input_type -> ukama.registry.site.v1.GetHistoryRequest
+	1,  // 26: ukama.registry.site.v1.SiteService.Add:output_type -> ukama.registry.site.v1.AddResponse
+	3,  // 27: ukama.registry.site.v1.SiteService.Get:output_type -> ukama.registry.site.v1.GetResponse
+	8,  // 28: ukama.registry.site.v1.SiteService.Update:output_type -> ukama.registry.site.v1.UpdateResponse
+	5,  // 29: ukama.registry.site.v1.SiteService.List:output_type -> ukama.registry.site.v1.ListResponse
+	10, // 30: ukama.registry.site.v1.SiteService.Delete:output_type -> ukama.registry.site.v1.DeleteResponse
+	16, // 31: ukama.registry.site.v1.SiteService.FindInRadius:output_type -> ukama.registry.site.v1.FindSitesResponse
+	16, // 32: ukama.registry.site.v1.SiteService.FindInBounds:output_type -> ukama.registry.site.v1.FindSitesResponse
+	16, // 33: ukama.registry.site.v1.SiteService.FindNearest:output_type -> ukama.registry.site.v1.FindSitesResponse
+	16, // 34: ukama.registry.site.v1.SiteService.FindServing:output_type -> ukama.registry.site.v1.FindSitesResponse
+	18, // 35: ukama.registry.site.v1.SiteService.SetCoverage:output_type -> ukama.registry.site.v1.SetCoverageResponse
+	20, // 36: ukama.registry.site.v1.SiteService.GetCoverage:output_type -> ukama.registry.site.v1.GetCoverageResponse
+	22, // 37: ukama.registry.site.v1.SiteService.ExportNetwork:output_type -> ukama.registry.site.v1.ExportNetworkResponse
+	24, // 38: ukama.registry.site.v1.SiteService.CheckDecommission:output_type -> ukama.registry.site.v1.CheckDecommissionResponse
+	26, // 39: ukama.registry.site.v1.SiteService.Decommission:output_type -> ukama.registry.site.v1.DecommissionResponse
+	28, // 40: ukama.registry.site.v1.SiteService.Relocate:output_type -> ukama.registry.site.v1.RelocateResponse
+	31, // 41: ukama.registry.site.v1.SiteService.GetHistory:output_type -> ukama.registry.site.v1.GetHistoryResponse
+	26, // [26:42] is the sub-list for method output_type
+	10, // [10:26] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_site_proto_init() }
@@ -844,7 +2240,7 @@ func file_site_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_site_proto_rawDesc), len(file_site_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -193,3 +193,232 @@ func (this *DeleteRequest) Validate() error {
 func (this *DeleteResponse) Validate() error {
 	return nil
 }
+func (this *FindInRadiusRequest) Validate() error {
+	if !(this.Latitude >= -90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Latitude", fmt.Errorf(`value '%v' must be greater than or equal to '-90'`, this.Latitude))
+	}
+	if !(this.Latitude <= 90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Latitude", fmt.Errorf(`value '%v' must be lower than or equal to '90'`, this.Latitude))
+	}
+	if !(this.Longitude >= -180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Longitude", fmt.Errorf(`value '%v' must be greater than or equal to '-180'`, this.Longitude))
+	}
+	if !(this.Longitude <= 180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Longitude", fmt.Errorf(`value '%v' must be lower than or equal to '180'`, this.Longitude))
+	}
+	if !(this.RadiusMeters > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("RadiusMeters", fmt.Errorf(`value '%v' must be strictly greater than '0'`, this.RadiusMeters))
+	}
+	return nil
+}
+func (this *FindInBoundsRequest) Validate() error {
+	if !(this.MinLatitude >= -90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MinLatitude", fmt.Errorf(`value '%v' must be greater than or equal to '-90'`, this.MinLatitude))
+	}
+	if !(this.MinLatitude <= 90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MinLatitude", fmt.Errorf(`value '%v' must be lower than or equal to '90'`, this.MinLatitude))
+	}
+	if !(this.MinLongitude >= -180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MinLongitude", fmt.Errorf(`value '%v' must be greater than or equal to '-180'`, this.MinLongitude))
+	}
+	if !(this.MinLongitude <= 180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MinLongitude", fmt.Errorf(`value '%v' must be lower than or equal to '180'`, this.MinLongitude))
+	}
+	if !(this.MaxLatitude >= -90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxLatitude", fmt.Errorf(`value '%v' must be greater than or equal to '-90'`, this.MaxLatitude))
+	}
+	if !(this.MaxLatitude <= 90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxLatitude", fmt.Errorf(`value '%v' must be lower than or equal to '90'`, this.MaxLatitude))
+	}
+	if !(this.MaxLongitude >= -180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxLongitude", fmt.Errorf(`value '%v' must be greater than or equal to '-180'`, this.MaxLongitude))
+	}
+	if !(this.MaxLongitude <= 180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxLongitude", fmt.Errorf(`value '%v' must be lower than or equal to '180'`, this.MaxLongitude))
+	}
+	return nil
+}
+func (this *FindNearestRequest) Validate() error {
+	if !(this.Latitude >= -90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Latitude", fmt.Errorf(`value '%v' must be greater than or equal to '-90'`, this.Latitude))
+	}
+	if !(this.Latitude <= 90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Latitude", fmt.Errorf(`value '%v' must be lower than or equal to '90'`, this.Latitude))
+	}
+	if !(this.Longitude >= -180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Longitude", fmt.Errorf(`value '%v' must be greater than or equal to '-180'`, this.Longitude))
+	}
+	if !(this.Longitude <= 180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Longitude", fmt.Errorf(`value '%v' must be lower than or equal to '180'`, this.Longitude))
+	}
+	return nil
+}
+func (this *FindServingRequest) Validate() error {
+	if !(this.Latitude >= -90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Latitude", fmt.Errorf(`value '%v' must be greater than or equal to '-90'`, this.Latitude))
+	}
+	if !(this.Latitude <= 90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Latitude", fmt.Errorf(`value '%v' must be lower than or equal to '90'`, this.Latitude))
+	}
+	if !(this.Longitude >= -180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Longitude", fmt.Errorf(`value '%v' must be greater than or equal to '-180'`, this.Longitude))
+	}
+	if !(this.Longitude <= 180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Longitude", fmt.Errorf(`value '%v' must be lower than or equal to '180'`, this.Longitude))
+	}
+	return nil
+}
+func (this *SiteDistance) Validate() error {
+	if this.Site != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Site); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Site", err)
+		}
+	}
+	return nil
+}
+func (this *FindSitesResponse) Validate() error {
+	for _, item := range this.Sites {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Sites", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_SetCoverageRequest_SiteId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *SetCoverageRequest) Validate() error {
+	if !_regex_SetCoverageRequest_SiteId.MatchString(this.SiteId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.SiteId))
+	}
+	if this.SiteId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must not be an empty string`, this.SiteId))
+	}
+	return nil
+}
+func (this *SetCoverageResponse) Validate() error {
+	if this.Site != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Site); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Site", err)
+		}
+	}
+	return nil
+}
+
+var _regex_GetCoverageRequest_SiteId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *GetCoverageRequest) Validate() error {
+	if !_regex_GetCoverageRequest_SiteId.MatchString(this.SiteId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.SiteId))
+	}
+	if this.SiteId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must not be an empty string`, this.SiteId))
+	}
+	return nil
+}
+func (this *GetCoverageResponse) Validate() error {
+	return nil
+}
+
+var _regex_ExportNetworkRequest_NetworkId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *ExportNetworkRequest) Validate() error {
+	if !_regex_ExportNetworkRequest_NetworkId.MatchString(this.NetworkId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("NetworkId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.NetworkId))
+	}
+	if this.NetworkId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("NetworkId", fmt.Errorf(`value '%v' must not be an empty string`, this.NetworkId))
+	}
+	return nil
+}
+func (this *ExportNetworkResponse) Validate() error {
+	return nil
+}
+
+var _regex_CheckDecommissionRequest_SiteId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *CheckDecommissionRequest) Validate() error {
+	if !_regex_CheckDecommissionRequest_SiteId.MatchString(this.SiteId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.SiteId))
+	}
+	if this.SiteId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must not be an empty string`, this.SiteId))
+	}
+	return nil
+}
+func (this *CheckDecommissionResponse) Validate() error {
+	return nil
+}
+
+var _regex_DecommissionRequest_SiteId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *DecommissionRequest) Validate() error {
+	if !_regex_DecommissionRequest_SiteId.MatchString(this.SiteId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.SiteId))
+	}
+	if this.SiteId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must not be an empty string`, this.SiteId))
+	}
+	return nil
+}
+func (this *DecommissionResponse) Validate() error {
+	if this.Site != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Site); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Site", err)
+		}
+	}
+	return nil
+}
+
+var _regex_RelocateRequest_SiteId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *RelocateRequest) Validate() error {
+	if !_regex_RelocateRequest_SiteId.MatchString(this.SiteId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.SiteId))
+	}
+	if this.SiteId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must not be an empty string`, this.SiteId))
+	}
+	if this.Latitude == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Latitude", fmt.Errorf(`value '%v' must not be an empty string`, this.Latitude))
+	}
+	if this.Longitude == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Longitude", fmt.Errorf(`value '%v' must not be an empty string`, this.Longitude))
+	}
+	return nil
+}
+func (this *RelocateResponse) Validate() error {
+	if this.Site != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Site); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Site", err)
+		}
+	}
+	return nil
+}
+
+var _regex_GetHistoryRequest_SiteId = regexp.MustCompile(`^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$`)
+
+func (this *GetHistoryRequest) Validate() error {
+	if !_regex_GetHistoryRequest_SiteId.MatchString(this.SiteId) {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[4][a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12})?$"`, this.SiteId))
+	}
+	if this.SiteId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("SiteId", fmt.Errorf(`value '%v' must not be an empty string`, this.SiteId))
+	}
+	return nil
+}
+func (this *SiteHistory) Validate() error {
+	return nil
+}
+func (this *GetHistoryResponse) Validate() error {
+	for _, item := range this.History {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("History", err)
+			}
+		}
+	}
+	return nil
+}
@@ -7,8 +7,8 @@
 
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.6.1
-// - protoc             v7.34.1
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
 // source: site.proto
 
 package gen
@@ -26,11 +26,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SiteService_Add_FullMethodName    = "/ukama.registry.site.v1.SiteService/Add"
-	SiteService_Get_FullMethodName    = "/ukama.registry.site.v1.SiteService/Get"
-	SiteService_Update_FullMethodName = "/ukama.registry.site.v1.SiteService/Update"
-	SiteService_List_FullMethodName   = "/ukama.registry.site.v1.SiteService/List"
-	SiteService_Delete_FullMethodName = "/ukama.registry.site.v1.SiteService/Delete"
+	SiteService_Add_FullMethodName               = "/ukama.registry.site.v1.SiteService/Add"
+	SiteService_Get_FullMethodName               = "/ukama.registry.site.v1.SiteService/Get"
+	SiteService_Update_FullMethodName            = "/ukama.registry.site.v1.SiteService/Update"
+	SiteService_List_FullMethodName              = "/ukama.registry.site.v1.SiteService/List"
+	SiteService_Delete_FullMethodName            = "/ukama.registry.site.v1.SiteService/Delete"
+	SiteService_FindInRadius_FullMethodName      = "/ukama.registry.site.v1.SiteService/FindInRadius"
+	SiteService_FindInBounds_FullMethodName      = "/ukama.registry.site.v1.SiteService/FindInBounds"
+	SiteService_FindNearest_FullMethodName       = "/ukama.registry.site.v1.SiteService/FindNearest"
+	SiteService_FindServing_FullMethodName       = "/ukama.registry.site.v1.SiteService/FindServing"
+	SiteService_SetCoverage_FullMethodName       = "/ukama.registry.site.v1.SiteService/SetCoverage"
+	SiteService_GetCoverage_FullMethodName       = "/ukama.registry.site.v1.SiteService/GetCoverage"
+	SiteService_ExportNetwork_FullMethodName     = "/ukama.registry.site.v1.SiteService/ExportNetwork"
+	SiteService_CheckDecommission_FullMethodName = "/ukama.registry.site.v1.SiteService/CheckDecommission"
+	SiteService_Decommission_FullMethodName      = "/ukama.registry.site.v1.SiteService/Decommission"
+	SiteService_Relocate_FullMethodName          = "/ukama.registry.site.v1.SiteService/Relocate"
+	SiteService_GetHistory_FullMethodName        = "/ukama.registry.site.v1.SiteService/GetHistory"
 )
 
 // SiteServiceClient is the client API for SiteService service.
@@ -42,6 +53,19 @@ type SiteServiceClient interface {
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Geospatial queries only consider active sites
+	FindInRadius(ctx context.Context, in *FindInRadiusRequest, opts ...grpc.CallOption) (*FindSitesResponse, error)
+	FindInBounds(ctx context.Context, in *FindInBoundsRequest, opts ...grpc.CallOption) (*FindSitesResponse, error)
+	FindNearest(ctx context.Context, in *FindNearestRequest, opts ...grpc.CallOption) (*FindSitesResponse, error)
+	FindServing(ctx context.Context, in *FindServingRequest, opts ...grpc.CallOption) (*FindSitesResponse, error)
+	SetCoverage(ctx context.Context, in *SetCoverageRequest, opts ...grpc.CallOption) (*SetCoverageResponse, error)
+	GetCoverage(ctx context.Context, in *GetCoverageRequest, opts ...grpc.CallOption) (*GetCoverageResponse, error)
+	ExportNetwork(ctx context.Context, in *ExportNetworkRequest, opts ...grpc.CallOption) (*ExportNetworkResponse, error)
+	// Decommissioned sites are kept for history, Delete removes them
+	CheckDecommission(ctx context.Context, in *CheckDecommissionRequest, opts ...grpc.CallOption) (*CheckDecommissionResponse, error)
+	Decommission(ctx context.Context, in *DecommissionRequest, opts ...grpc.CallOption) (*DecommissionResponse, error)
+	Relocate(ctx context.Context, in *RelocateRequest, opts ...grpc.CallOption) (*RelocateResponse, error)
+	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error)
 }
 
 type siteServiceClient struct {
@@ -102,6 +126,116 @@ func (c *siteServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts
 	return out, nil
 }
 
+func (c *siteServiceClient) FindInRadius(ctx context.Context, in *FindInRadiusRequest, opts ...grpc.CallOption) (*FindSitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindSitesResponse)
+	err := c.cc.Invoke(ctx, SiteService_FindInRadius_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteServiceClient) FindInBounds(ctx context.Context, in *FindInBoundsRequest, opts ...grpc.CallOption) (*FindSitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindSitesResponse)
+	err := c.cc.Invoke(ctx, SiteService_FindInBounds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteServiceClient) FindNearest(ctx context.Context, in *FindNearestRequest, opts ...grpc.CallOption) (*FindSitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindSitesResponse)
+	err := c.cc.Invoke(ctx, SiteService_FindNearest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteServiceClient) FindServing(ctx context.Context, in *FindServingRequest, opts ...grpc.CallOption) (*FindSitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindSitesResponse)
+	err := c.cc.Invoke(ctx, SiteService_FindServing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteServiceClient) SetCoverage(ctx context.Context, in *SetCoverageRequest, opts ...grpc.CallOption) (*SetCoverageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCoverageResponse)
+	err := c.cc.Invoke(ctx, SiteService_SetCoverage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteServiceClient) GetCoverage(ctx context.Context, in *GetCoverageRequest, opts ...grpc.CallOption) (*GetCoverageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoverageResponse)
+	err := c.cc.Invoke(ctx, SiteService_GetCoverage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteServiceClient) ExportNetwork(ctx context.Context, in *ExportNetworkRequest, opts ...grpc.CallOption) (*ExportNetworkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportNetworkResponse)
+	err := c.cc.Invoke(ctx, SiteService_ExportNetwork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteServiceClient) CheckDecommission(ctx context.Context, in *CheckDecommissionRequest, opts ...grpc.CallOption) (*CheckDecommissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckDecommissionResponse)
+	err := c.cc.Invoke(ctx, SiteService_CheckDecommission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteServiceClient) Decommission(ctx context.Context, in *DecommissionRequest, opts ...grpc.CallOption) (*DecommissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecommissionResponse)
+	err := c.cc.Invoke(ctx, SiteService_Decommission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteServiceClient) Relocate(ctx context.Context, in *RelocateRequest, opts ...grpc.CallOption) (*RelocateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RelocateResponse)
+	err := c.cc.Invoke(ctx, SiteService_Relocate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteServiceClient) GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHistoryResponse)
+	err := c.cc.Invoke(ctx, SiteService_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SiteServiceServer is the server API for SiteService service.
 // All implementations must embed UnimplementedSiteServiceServer
 // for forward compatibility.
@@ -111,6 +245,19 @@ type SiteServiceServer interface {
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
 	List(context.Context, *ListRequest) (*ListResponse, error)
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// Geospatial queries only consider active sites
+	FindInRadius(context.Context, *FindInRadiusRequest) (*FindSitesResponse, error)
+	FindInBounds(context.Context, *FindInBoundsRequest) (*FindSitesResponse, error)
+	FindNearest(context.Context, *FindNearestRequest) (*FindSitesResponse, error)
+	FindServing(context.Context, *FindServingRequest) (*FindSitesResponse, error)
+	SetCoverage(context.Context, *SetCoverageRequest) (*SetCoverageResponse, error)
+	GetCoverage(context.Context, *GetCoverageRequest) (*GetCoverageResponse, error)
+	ExportNetwork(context.Context, *ExportNetworkRequest) (*ExportNetworkResponse, error)
+	// Decommissioned sites are kept for history, Delete removes them
+	CheckDecommission(context.Context, *CheckDecommissionRequest) (*CheckDecommissionResponse, error)
+	Decommission(context.Context, *DecommissionRequest) (*DecommissionResponse, error)
+	Relocate(context.Context, *RelocateRequest) (*RelocateResponse, error)
+	GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error)
 	mustEmbedUnimplementedSiteServiceServer()
 }
 
@@ -122,19 +269,52 @@ type SiteServiceServer interface {
 type UnimplementedSiteServiceServer struct{}
 
 func (UnimplementedSiteServiceServer) Add(context.Context, *AddRequest) (*AddResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Add not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
 }
 func (UnimplementedSiteServiceServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
 func (UnimplementedSiteServiceServer) Update(context.Context, *UpdateRequest) (*UpdateResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Update not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
 func (UnimplementedSiteServiceServer) List(context.Context, *ListRequest) (*ListResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method List not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
 func (UnimplementedSiteServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedSiteServiceServer) FindInRadius(context.Context, *FindInRadiusRequest) (*FindSitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindInRadius not implemented")
+}
+func (UnimplementedSiteServiceServer) FindInBounds(context.Context, *FindInBoundsRequest) (*FindSitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindInBounds not implemented")
+}
+func (UnimplementedSiteServiceServer) FindNearest(context.Context, *FindNearestRequest) (*FindSitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNearest not implemented")
+}
+func (UnimplementedSiteServiceServer) FindServing(context.Context, *FindServingRequest) (*FindSitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindServing not implemented")
+}
+func (UnimplementedSiteServiceServer) SetCoverage(context.Context, *SetCoverageRequest) (*SetCoverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCoverage not implemented")
+}
+func (UnimplementedSiteServiceServer) GetCoverage(context.Context, *GetCoverageRequest) (*GetCoverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoverage not implemented")
+}
+func (UnimplementedSiteServiceServer) ExportNetwork(context.Context, *ExportNetworkRequest) (*ExportNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportNetwork not implemented")
+}
+func (UnimplementedSiteServiceServer) CheckDecommission(context.Context, *CheckDecommissionRequest) (*CheckDecommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckDecommission not implemented")
+}
+func (UnimplementedSiteServiceServer) Decommission(context.Context, *DecommissionRequest) (*DecommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decommission not implemented")
+}
+func (UnimplementedSiteServiceServer) Relocate(context.Context, *RelocateRequest) (*RelocateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Relocate not implemented")
+}
+func (UnimplementedSiteServiceServer) GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
 }
 func (UnimplementedSiteServiceServer) mustEmbedUnimplementedSiteServiceServer() {}
 func (UnimplementedSiteServiceServer) testEmbeddedByValue()                     {}
@@ -147,7 +327,7 @@ type UnsafeSiteServiceServer interface {
 }
 
 func RegisterSiteServiceServer(s grpc.ServiceRegistrar, srv SiteServiceServer) {
-	// If the following call panics, it indicates UnimplementedSiteServiceServer was
+	// If the following call pancis, it indicates UnimplementedSiteServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
@@ -247,6 +427,204 @@ func _SiteService_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SiteService_FindInRadius_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindInRadiusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServiceServer).FindInRadius(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteService_FindInRadius_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServiceServer).FindInRadius(ctx, req.(*FindInRadiusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteService_FindInBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindInBoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServiceServer).FindInBounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteService_FindInBounds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServiceServer).FindInBounds(ctx, req.(*FindInBoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteService_FindNearest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNearestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServiceServer).FindNearest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteService_FindNearest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServiceServer).FindNearest(ctx, req.(*FindNearestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteService_FindServing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindServingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServiceServer).FindServing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteService_FindServing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServiceServer).FindServing(ctx, req.(*FindServingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteService_SetCoverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCoverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServiceServer).SetCoverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteService_SetCoverage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServiceServer).SetCoverage(ctx, req.(*SetCoverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteService_GetCoverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServiceServer).GetCoverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteService_GetCoverage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServiceServer).GetCoverage(ctx, req.(*GetCoverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteService_ExportNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServiceServer).ExportNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteService_ExportNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServiceServer).ExportNetwork(ctx, req.(*ExportNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteService_CheckDecommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckDecommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServiceServer).CheckDecommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteService_CheckDecommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServiceServer).CheckDecommission(ctx, req.(*CheckDecommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteService_Decommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServiceServer).Decommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteService_Decommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServiceServer).Decommission(ctx, req.(*DecommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteService_Relocate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelocateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServiceServer).Relocate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteService_Relocate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServiceServer).Relocate(ctx, req.(*RelocateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SiteService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SiteService_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServiceServer).GetHistory(ctx, req.(*GetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SiteService_ServiceDesc is the grpc.ServiceDesc for SiteService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -274,6 +652,50 @@ var SiteService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _SiteService_Delete_Handler,
 		},
+		{
+			MethodName: "FindInRadius",
+			Handler:    _SiteService_FindInRadius_Handler,
+		},
+		{
+			MethodName: "FindInBounds",
+			Handler:    _SiteService_FindInBounds_Handler,
+		},
+		{
+			MethodName: "FindNearest",
+			Handler:    _SiteService_FindNearest_Handler,
+		},
+		{
+			MethodName: "FindServing",
+			Handler:    _SiteService_FindServing_Handler,
+		},
+		{
+			MethodName: "SetCoverage",
+			Handler:    _SiteService_SetCoverage_Handler,
+		},
+		{
+			MethodName: "GetCoverage",
+			Handler:    _SiteService_GetCoverage_Handler,
+		},
+		{
+			MethodName: "ExportNetwork",
+			Handler:    _SiteService_ExportNetwork_Handler,
+		},
+		{
+			MethodName: "CheckDecommission",
+			Handler:    _SiteService_CheckDecommission_Handler,
+		},
+		{
+			MethodName: "Decommission",
+			Handler:    _SiteService_Decommission_Handler,
+		},
+		{
+			MethodName: "Relocate",
+			Handler:    _SiteService_Relocate_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _SiteService_GetHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "site.proto",
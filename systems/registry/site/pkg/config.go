@@ -36,6 +36,7 @@ type Config struct {
 
 type HttpServices struct {
 	InventoryClient string `default:"http://api-gateway-inventory:8080"`
+	NodeClient      string `default:"http://api-gateway-registry:8080"`
 }
 
 var SiteMetric = []metric.MetricConfig{
@@ -0,0 +1,51 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// MigrateCoordinates converts latitude and longitude from the text columns
+// earlier releases used to double precision. It must run before AutoMigrate,
+// which cannot cast the existing values. Values that are not plain decimal
+// degrees become 0.
+func MigrateCoordinates(gdb *gorm.DB) error {
+	m := gdb.Migrator()
+	if !m.HasTable(&Site{}) {
+		return nil
+	}
+	cols, err := m.ColumnTypes(&Site{})
+	if err != nil {
+		return err
+	}
+
+	for _, c := range cols {
+		if c.Name() != "latitude" && c.Name() != "longitude" {
+			continue
+		}
+		t := strings.ToLower(c.DatabaseTypeName())
+		if !strings.Contains(t, "text") && !strings.Contains(t, "char") {
+			continue
+		}
+
+		log.Infof("Converting sites.%s from %s to double precision", c.Name(), t)
+		err := gdb.Exec(fmt.Sprintf(`ALTER TABLE sites ALTER COLUMN %[1]s TYPE double precision USING
+			(CASE WHEN trim(%[1]s) ~ '^[-+]?([0-9]+\.?[0-9]*|\.[0-9]+)$' THEN trim(%[1]s)::double precision ELSE 0 END)`,
+			c.Name())).Error
+		if err != nil {
+			return fmt.Errorf("convert sites.%s: %w", c.Name(), err)
+		}
+	}
+	return nil
+}
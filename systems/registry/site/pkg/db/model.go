@@ -6,6 +6,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/registry/site/pkg/geo"
 )
 
 // Site model
@@ -20,10 +21,60 @@ type Site struct {
 	AccessId      uuid.UUID `gorm:"type:uuid"`
 	SwitchId      uuid.UUID `gorm:"type:uuid"`
 	IsDeactivated bool
-	Latitude      string
-	Longitude     string
+	Latitude      float64   `gorm:"type:double precision;index:idx_site_coordinates"`
+	Longitude     float64   `gorm:"type:double precision;index:idx_site_coordinates"`
+	Coverage      *geo.Area `gorm:"serializer:json"` // nil until a coverage area is imported
 	InstallDate   string
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	DeletedAt     gorm.DeletedAt `gorm:"index"`
+	// DecommissionedAt is set once the site was decommissioned. The row is
+	// kept so usage and history recorded against the site still resolve.
+	DecommissionedAt   *time.Time
+	DecommissionReason string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	DeletedAt          gorm.DeletedAt `gorm:"index"`
+}
+
+func (s *Site) Point() geo.Point {
+	return geo.Point{Lat: s.Latitude, Lng: s.Longitude}
+}
+
+const (
+	HistoryDecommission = "decommission"
+	HistoryRelocate     = "relocate"
+)
+
+// SiteHistory is a snapshot of a site taken before a decommission or a
+// relocation changed it.
+type SiteHistory struct {
+	Id         uint      `gorm:"primaryKey"`
+	SiteId     uuid.UUID `gorm:"type:uuid;index"`
+	Action     string
+	Reason     string
+	Latitude   float64 `gorm:"type:double precision"`
+	Longitude  float64 `gorm:"type:double precision"`
+	Location   string
+	BackhaulId uuid.UUID `gorm:"type:uuid"`
+	SpectrumId uuid.UUID `gorm:"type:uuid"`
+	PowerId    uuid.UUID `gorm:"type:uuid"`
+	AccessId   uuid.UUID `gorm:"type:uuid"`
+	SwitchId   uuid.UUID `gorm:"type:uuid"`
+	NodeIds    []string  `gorm:"serializer:json"`
+	CreatedAt  time.Time
+}
+
+func newSiteHistory(s *Site, action, reason string, nodeIds []string) *SiteHistory {
+	return &SiteHistory{
+		SiteId:     s.Id,
+		Action:     action,
+		Reason:     reason,
+		Latitude:   s.Latitude,
+		Longitude:  s.Longitude,
+		Location:   s.Location,
+		BackhaulId: s.BackhaulId,
+		SpectrumId: s.SpectrumId,
+		PowerId:    s.PowerId,
+		AccessId:   s.AccessId,
+		SwitchId:   s.SwitchId,
+		NodeIds:    nodeIds,
+	}
 }
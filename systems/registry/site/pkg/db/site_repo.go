@@ -9,17 +9,23 @@
 package db
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ukama/ukama/systems/common/sql"
 	uuid "github.com/ukama/ukama/systems/common/uuid"
 	"github.com/ukama/ukama/systems/common/validation"
+	"github.com/ukama/ukama/systems/registry/site/pkg/geo"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
 var (
 	nullUUID = "00000000-0000-0000-0000-000000000000"
+
+	ErrSiteDecommissioned = errors.New("site is decommissioned")
 )
 
 type SiteRepo interface {
@@ -30,6 +36,17 @@ type SiteRepo interface {
 	Delete(siteId uuid.UUID) (*Site, error)
 	GetSiteCount(networkId uuid.UUID) (int64, error)
 	List(networkId *uuid.UUID, isDeactivated bool) ([]Site, error)
+	// ListInBounds returns the active sites located inside box
+	ListInBounds(networkId *uuid.UUID, box geo.BBox) ([]Site, error)
+	// SetCoverage replaces the coverage area of a site, nil clears it
+	SetCoverage(siteId uuid.UUID, area *geo.Area) (*Site, error)
+	// Decommission deactivates a site for good and returns its components
+	// to inventory. nodeIds are the nodes released from it, kept in history.
+	Decommission(siteId uuid.UUID, reason string, nodeIds []string) (*Site, error)
+	// Relocate moves a site keeping its identity. A nil backhaulId or an
+	// empty location keeps the current one.
+	Relocate(siteId uuid.UUID, to geo.Point, location string, backhaulId *uuid.UUID, reason string) (*Site, error)
+	ListHistory(siteId uuid.UUID) ([]SiteHistory, error)
 }
 
 type siteRepo struct {
@@ -147,3 +164,133 @@ func (s siteRepo) GetSiteCount(networkId uuid.UUID) (int64, error) {
 	}
 	return count, nil
 }
+
+func (s siteRepo) ListInBounds(networkId *uuid.UUID, box geo.BBox) ([]Site, error) {
+	sites := []Site{}
+
+	tx := s.Db.GetGormDb().Where("is_deactivated = ?", false).
+		Where("latitude BETWEEN ? AND ?", box.MinLat, box.MaxLat)
+	if networkId != nil && networkId.String() != nullUUID {
+		tx = tx.Where("network_id = ?", networkId)
+	}
+	if box.WrapsAntimeridian() {
+		tx = tx.Where("(longitude >= ? OR longitude <= ?)", box.MinLng, box.MaxLng)
+	} else {
+		tx = tx.Where("longitude BETWEEN ? AND ?", box.MinLng, box.MaxLng)
+	}
+
+	if err := tx.Find(&sites).Error; err != nil {
+		return nil, err
+	}
+	return sites, nil
+}
+
+func (s siteRepo) SetCoverage(siteId uuid.UUID, area *geo.Area) (*Site, error) {
+	var coverage any
+	if area != nil {
+		/* column updates bypass the field serializer */
+		b, err := json.Marshal(area)
+		if err != nil {
+			return nil, err
+		}
+		coverage = string(b)
+	}
+
+	var site Site
+	err := s.Db.GetGormDb().Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Site{}).Where("id = ?", siteId).Update("coverage", coverage)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.First(&site, siteId).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &site, nil
+}
+
+func (s siteRepo) Decommission(siteId uuid.UUID, reason string, nodeIds []string) (*Site, error) {
+	var site Site
+	err := s.Db.GetGormDb().Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&site, siteId).Error; err != nil {
+			return err
+		}
+		if site.DecommissionedAt != nil {
+			return ErrSiteDecommissioned
+		}
+
+		if err := tx.Create(newSiteHistory(&site, HistoryDecommission, reason, nodeIds)).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		err := tx.Model(&site).Updates(map[string]any{
+			"is_deactivated":      true,
+			"decommissioned_at":   now,
+			"decommission_reason": reason,
+			"backhaul_id":         uuid.Nil,
+			"spectrum_id":         uuid.Nil,
+			"power_id":            uuid.Nil,
+			"access_id":           uuid.Nil,
+			"switch_id":           uuid.Nil,
+		}).Error
+		if err != nil {
+			return err
+		}
+		return tx.First(&site, siteId).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &site, nil
+}
+
+func (s siteRepo) Relocate(siteId uuid.UUID, to geo.Point, location string, backhaulId *uuid.UUID, reason string) (*Site, error) {
+	var site Site
+	err := s.Db.GetGormDb().Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&site, siteId).Error; err != nil {
+			return err
+		}
+		if site.DecommissionedAt != nil {
+			return ErrSiteDecommissioned
+		}
+
+		if err := tx.Create(newSiteHistory(&site, HistoryRelocate, reason, nil)).Error; err != nil {
+			return err
+		}
+
+		/* the old coverage area describes the old location */
+		updates := map[string]any{
+			"latitude":  to.Lat,
+			"longitude": to.Lng,
+			"coverage":  nil,
+		}
+		if location != "" {
+			updates["location"] = location
+		}
+		if backhaulId != nil {
+			updates["backhaul_id"] = *backhaulId
+		}
+		if err := tx.Model(&site).Updates(updates).Error; err != nil {
+			return err
+		}
+		return tx.First(&site, siteId).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &site, nil
+}
+
+func (s siteRepo) ListHistory(siteId uuid.UUID) ([]SiteHistory, error) {
+	history := []SiteHistory{}
+	err := s.Db.GetGormDb().Where("site_id = ?", siteId).Order("id").Find(&history).Error
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/ukama/ukama/systems/common/uuid"
 	db_site "github.com/ukama/ukama/systems/registry/site/pkg/db"
+	"github.com/ukama/ukama/systems/registry/site/pkg/geo"
 
 	"github.com/DATA-DOG/go-sqlmock"
 
@@ -36,10 +37,10 @@ var (
 	testSwitchId           = uuid.NewV4()
 	testInstallDate        = "07-03-2023"
 	testLocation           = "Test Location"
-	testLatitude           = "40.7128"
-	testLongitude          = "-74.0060"
-	testUpdatedLatitude    = "42.3601"
-	testUpdatedLongitude   = "-71.0589"
+	testLatitude           = 40.7128
+	testLongitude          = -74.006
+	testUpdatedLatitude    = 42.3601
+	testUpdatedLongitude   = -71.0589
 	testUpdatedInstallDate = "15-06-2023"
 	testUpdatedLocation    = "Updated Location"
 )
@@ -341,7 +342,7 @@ func TestSiteRepo_Add(t *testing.T) {
 			WithArgs(
 				site.Id, site.Name, site.Location, site.NetworkId, site.BackhaulId,
 				site.SpectrumId, site.PowerId, site.AccessId, site.SwitchId, site.IsDeactivated,
-				site.Latitude, site.Longitude, site.InstallDate,
+				site.Latitude, site.Longitude, nil, site.InstallDate, nil, "",
 				sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit()
@@ -417,7 +418,7 @@ func TestSiteRepo_Add(t *testing.T) {
 			WithArgs(
 				site.Id, site.Name, site.Location, site.NetworkId, site.BackhaulId,
 				site.SpectrumId, site.PowerId, site.AccessId, site.SwitchId, site.IsDeactivated,
-				site.Latitude, site.Longitude, site.InstallDate,
+				site.Latitude, site.Longitude, nil, site.InstallDate, nil, "",
 				sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 			WillReturnError(fmt.Errorf("database constraint violation"))
 		mock.ExpectRollback()
@@ -461,7 +462,7 @@ func TestSiteRepo_Add(t *testing.T) {
 			WithArgs(
 				site.Id, site.Name, site.Location, site.NetworkId, site.BackhaulId,
 				site.SpectrumId, site.PowerId, site.AccessId, site.SwitchId, site.IsDeactivated,
-				site.Latitude, site.Longitude, site.InstallDate,
+				site.Latitude, site.Longitude, nil, site.InstallDate, nil, "",
 				sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit().WillReturnError(fmt.Errorf("transaction commit failed"))
@@ -782,3 +783,143 @@ func Test_SiteRepo_Delete(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
+
+func TestSiteRepo_ListInBounds(t *testing.T) {
+	t.Run("AcrossAntimeridian", func(t *testing.T) {
+		mock, r, err := createMockDBAndRepo(t)
+		assert.NoError(t, err)
+
+		box := geo.BBox{MinLat: -20, MinLng: 170, MaxLat: -10, MaxLng: -170}
+		rows := sqlmock.NewRows([]string{"id", "name", "latitude", "longitude", "coverage"}).
+			AddRow(testSiteId1, "fiji", -17.7, 178.1, `{"type":"Polygon","coordinates":[[[178,-18],[179,-18],[179,-17],[178,-18]]]}`)
+
+		mock.ExpectQuery(`^SELECT \* FROM "sites" WHERE is_deactivated = \$1 AND \(latitude BETWEEN \$2 AND \$3\) AND network_id = \$4 AND \(\(longitude >= \$5 OR longitude <= \$6\)\)`).
+			WithArgs(false, box.MinLat, box.MaxLat, testNetworkId, box.MinLng, box.MaxLng).
+			WillReturnRows(rows)
+
+		sites, err := r.ListInBounds(&testNetworkId, box)
+
+		assert.NoError(t, err)
+		assert.Len(t, sites, 1)
+		assert.Equal(t, 178.1, sites[0].Longitude)
+		assert.True(t, sites[0].Coverage.Contains(geo.Point{Lat: -17.9, Lng: 178.5}))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSiteRepo_SetCoverage(t *testing.T) {
+	area, err := geo.ParseArea([]byte(`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`))
+	assert.NoError(t, err)
+
+	t.Run("Stored", func(t *testing.T) {
+		mock, r, err := createMockDBAndRepo(t)
+		assert.NoError(t, err)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`^UPDATE "sites" SET "coverage"=\$1`).
+			WithArgs(`{"coordinates":[[[0,0],[1,0],[1,1],[0,0]]],"type":"Polygon"}`, sqlmock.AnyArg(), testSiteId1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`^SELECT.*sites.*`).
+			WithArgs(testSiteId1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "coverage"}).
+				AddRow(testSiteId1, `{"coordinates":[[[0,0],[1,0],[1,1],[0,0]]],"type":"Polygon"}`))
+		mock.ExpectCommit()
+
+		site, err := r.SetCoverage(testSiteId1, area)
+
+		assert.NoError(t, err)
+		assert.Equal(t, area, site.Coverage)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SiteNotFound", func(t *testing.T) {
+		mock, r, err := createMockDBAndRepo(t)
+		assert.NoError(t, err)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`^UPDATE "sites" SET "coverage"=\$1`).
+			WithArgs(nil, sqlmock.AnyArg(), testSiteId1).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err = r.SetCoverage(testSiteId1, nil)
+
+		assert.Equal(t, gorm.ErrRecordNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSiteRepo_Decommission(t *testing.T) {
+	t.Run("Decommissioned", func(t *testing.T) {
+		mock, r, err := createMockDBAndRepo(t)
+		assert.NoError(t, err)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`^SELECT.*sites.*`).
+			WithArgs(testSiteId1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "network_id", "backhaul_id"}).
+				AddRow(testSiteId1, testNetworkId, testBackhaulId))
+		mock.ExpectQuery(`^INSERT INTO "site_histories"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectExec(`^UPDATE "sites" SET .*"backhaul_id"=.*"decommission_reason"=.*"is_deactivated"=`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`^SELECT.*sites.*`).
+			WithArgs(testSiteId1, testSiteId1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "network_id", "is_deactivated", "decommissioned_at", "decommission_reason"}).
+				AddRow(testSiteId1, testNetworkId, true, time.Now(), "flooded"))
+		mock.ExpectCommit()
+
+		site, err := r.Decommission(testSiteId1, "flooded", []string{"node-1"})
+
+		assert.NoError(t, err)
+		assert.True(t, site.IsDeactivated)
+		assert.NotNil(t, site.DecommissionedAt)
+		assert.Equal(t, uuid.Nil, site.BackhaulId)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyDecommissioned", func(t *testing.T) {
+		mock, r, err := createMockDBAndRepo(t)
+		assert.NoError(t, err)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`^SELECT.*sites.*`).
+			WithArgs(testSiteId1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "decommissioned_at"}).
+				AddRow(testSiteId1, time.Now()))
+		mock.ExpectRollback()
+
+		_, err = r.Decommission(testSiteId1, "", nil)
+
+		assert.Equal(t, db_site.ErrSiteDecommissioned, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSiteRepo_Relocate(t *testing.T) {
+	mock, r, err := createMockDBAndRepo(t)
+	assert.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`^SELECT.*sites.*`).
+		WithArgs(testSiteId1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "latitude", "longitude", "location"}).
+			AddRow(testSiteId1, 1.5, 2.5, "old"))
+	mock.ExpectQuery(`^INSERT INTO "site_histories"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(`^UPDATE "sites" SET "coverage"=\$1,"latitude"=\$2,"location"=\$3,"longitude"=\$4`).
+		WithArgs(nil, 10.0, "new", 20.0, sqlmock.AnyArg(), testSiteId1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`^SELECT.*sites.*`).
+		WithArgs(testSiteId1, testSiteId1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "latitude", "longitude", "location"}).
+			AddRow(testSiteId1, 10.0, 20.0, "new"))
+	mock.ExpectCommit()
+
+	site, err := r.Relocate(testSiteId1, geo.Point{Lat: 10, Lng: 20}, "new", nil, "moved")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "new", site.Location)
+	assert.Equal(t, 10.0, site.Latitude)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
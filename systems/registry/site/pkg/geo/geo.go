@@ -0,0 +1,144 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+// Package geo holds the small amount of spherical geometry the site registry
+// needs: distances, bounding boxes and point-in-polygon tests on WGS84
+// coordinates. It is accurate enough for site planning, not for surveying.
+package geo
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const EarthRadiusMeters = 6371008.8
+
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+func (p Point) Validate() error {
+	if math.IsNaN(p.Lat) || p.Lat < -90 || p.Lat > 90 {
+		return fmt.Errorf("latitude %v out of range [-90, 90]", p.Lat)
+	}
+	if math.IsNaN(p.Lng) || p.Lng < -180 || p.Lng > 180 {
+		return fmt.Errorf("longitude %v out of range [-180, 180]", p.Lng)
+	}
+	return nil
+}
+
+// ParsePoint parses decimal degrees as sent by clients and checks the range.
+func ParsePoint(lat, lng string) (Point, error) {
+	la, err := strconv.ParseFloat(strings.TrimSpace(lat), 64)
+	if err != nil {
+		return Point{}, fmt.Errorf("invalid latitude %q", lat)
+	}
+	lo, err := strconv.ParseFloat(strings.TrimSpace(lng), 64)
+	if err != nil {
+		return Point{}, fmt.Errorf("invalid longitude %q", lng)
+	}
+	p := Point{Lat: la, Lng: lo}
+	return p, p.Validate()
+}
+
+func FormatDegrees(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// Distance is the great-circle distance between a and b in meters.
+func Distance(a, b Point) float64 {
+	la1, la2 := radians(a.Lat), radians(b.Lat)
+	dLat := la2 - la1
+	dLng := radians(b.Lng - a.Lng)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(la1)*math.Cos(la2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// BBox is a latitude/longitude rectangle. MinLng > MaxLng means the box
+// crosses the antimeridian.
+type BBox struct {
+	MinLat float64
+	MinLng float64
+	MaxLat float64
+	MaxLng float64
+}
+
+func (b BBox) Validate() error {
+	if err := (Point{Lat: b.MinLat, Lng: b.MinLng}).Validate(); err != nil {
+		return err
+	}
+	if err := (Point{Lat: b.MaxLat, Lng: b.MaxLng}).Validate(); err != nil {
+		return err
+	}
+	if b.MinLat > b.MaxLat {
+		return fmt.Errorf("min latitude %v above max latitude %v", b.MinLat, b.MaxLat)
+	}
+	return nil
+}
+
+func (b BBox) WrapsAntimeridian() bool {
+	return b.MinLng > b.MaxLng
+}
+
+func (b BBox) Contains(p Point) bool {
+	if p.Lat < b.MinLat || p.Lat > b.MaxLat {
+		return false
+	}
+	if b.WrapsAntimeridian() {
+		return p.Lng >= b.MinLng || p.Lng <= b.MaxLng
+	}
+	return p.Lng >= b.MinLng && p.Lng <= b.MaxLng
+}
+
+// Around returns a box that contains every point within radius meters of c.
+// It is only a prefilter; callers still check Distance.
+func Around(c Point, radius float64) BBox {
+	dLat := degrees(radius / EarthRadiusMeters)
+	b := BBox{MinLat: c.Lat - dLat, MaxLat: c.Lat + dLat, MinLng: -180, MaxLng: 180}
+	if b.MinLat <= -90 || b.MaxLat >= 90 {
+		/* the circle covers a pole, so every longitude is in range */
+		b.MinLat = math.Max(b.MinLat, -90)
+		b.MaxLat = math.Min(b.MaxLat, 90)
+		return b
+	}
+
+	dLng := degrees(math.Asin(math.Min(1, math.Sin(radius/EarthRadiusMeters)/math.Cos(radians(c.Lat)))))
+	if dLng >= 180 {
+		return b
+	}
+	b.MinLng = normalizeLng(c.Lng - dLng)
+	b.MaxLng = normalizeLng(c.Lng + dLng)
+	return b
+}
+
+func normalizeLng(lng float64) float64 {
+	for lng < -180 {
+		lng += 360
+	}
+	for lng > 180 {
+		lng -= 360
+	}
+	return lng
+}
+
+func radians(d float64) float64 { return d * math.Pi / 180 }
+
+func degrees(r float64) float64 { return r * 180 / math.Pi }
+
+// Center is the midpoint of the box in degrees.
+func (b BBox) Center() Point {
+	width := b.MaxLng - b.MinLng
+	if b.WrapsAntimeridian() {
+		width += 360
+	}
+	return Point{Lat: (b.MinLat + b.MaxLat) / 2, Lng: normalizeLng(b.MinLng + width/2)}
+}
@@ -0,0 +1,99 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package geo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/tj/assert"
+)
+
+var (
+	newYork = Point{Lat: 40.7128, Lng: -74.006}
+	london  = Point{Lat: 51.5074, Lng: -0.1278}
+)
+
+func TestParsePoint(t *testing.T) {
+	p, err := ParsePoint(" 40.7128", "-74.006 ")
+	assert.NoError(t, err)
+	assert.Equal(t, newYork, p)
+
+	for _, c := range [][2]string{{"", "0"}, {"north", "0"}, {"91", "0"}, {"0", "-180.5"}, {"NaN", "0"}} {
+		_, err := ParsePoint(c[0], c[1])
+		assert.Error(t, err, c)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	assert.InDelta(t, 5570e3, Distance(newYork, london), 10e3)
+	assert.Zero(t, Distance(london, london))
+	assert.InDelta(t, 111.2e3, Distance(Point{0, 179.5}, Point{0, -179.5}), 1e3)
+}
+
+func TestAround(t *testing.T) {
+	b := Around(newYork, 10e3)
+	assert.False(t, b.WrapsAntimeridian())
+	assert.True(t, b.Contains(Point{Lat: 40.78, Lng: -73.97}))
+	assert.False(t, b.Contains(london))
+
+	b = Around(Point{Lat: -17.7, Lng: 179.9}, 50e3)
+	assert.True(t, b.WrapsAntimeridian())
+	assert.True(t, b.Contains(Point{Lat: -17.7, Lng: -179.8}))
+	assert.InDelta(t, 179.9, b.Center().Lng, 1e-9)
+
+	b = Around(Point{Lat: 89.9, Lng: 10}, 50e3)
+	assert.Equal(t, BBox{MinLat: b.MinLat, MinLng: -180, MaxLat: 90, MaxLng: 180}, b)
+}
+
+func TestArea(t *testing.T) {
+	/* a 2x2 degree square with a 1x1 hole in the middle */
+	a, err := ParseArea([]byte(`{"type":"Feature","properties":{},"geometry":{"type":"Polygon","coordinates":[
+		[[30,-2],[32,-2],[32,0],[30,0]],
+		[[30.5,-1.5],[31.5,-1.5],[31.5,-0.5],[30.5,-0.5],[30.5,-1.5]]]}}`))
+	assert.NoError(t, err)
+	assert.Len(t, a.Polygons[0][0], 5, "outer ring is closed")
+
+	assert.True(t, a.Contains(Point{Lat: -1.8, Lng: 30.2}))
+	assert.False(t, a.Contains(Point{Lat: -1, Lng: 31}), "inside the hole")
+	assert.False(t, a.Contains(Point{Lat: 1, Lng: 31}))
+
+	var nilArea *Area
+	assert.False(t, nilArea.Contains(Point{}))
+
+	b, err := json.Marshal(a)
+	assert.NoError(t, err)
+	var back Area
+	assert.NoError(t, json.Unmarshal(b, &back))
+	assert.Equal(t, *a, back)
+}
+
+func TestParseArea_MultiPolygon(t *testing.T) {
+	a, err := ParseArea([]byte(`{"type":"MultiPolygon","coordinates":[
+		[[[0,0],[1,0],[1,1],[0,0]]],
+		[[[10,10],[11,10],[11,11],[10,10]]]]}`))
+	assert.NoError(t, err)
+	assert.True(t, a.Contains(Point{Lat: 10.2, Lng: 10.8}))
+	assert.Equal(t, "MultiPolygon", a.Geometry()["type"])
+}
+
+func TestParseArea_Invalid(t *testing.T) {
+	for _, src := range []string{
+		`not json`,
+		`{"type":"Point","coordinates":[0,0]}`,
+		`{"type":"Feature","geometry":null}`,
+		`{"type":"Polygon","coordinates":[]}`,
+		`{"type":"Polygon","coordinates":[[[0,0],[1,1],[0,0]]]}`,
+		`{"type":"Polygon","coordinates":[[[0,0],[1,0],[200,1],[0,0]]]}`,
+		`{"type":"Polygon","coordinates":"[[0,0]]"}`,
+	} {
+		_, err := ParseArea([]byte(src))
+		assert.Error(t, err, src)
+	}
+}
@@ -0,0 +1,207 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package geo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const MaxAreaVertices = 10000
+
+// Polygon is a list of linear rings: the outer boundary first, then holes.
+// Rings are stored closed, the last point repeating the first.
+type Polygon [][]Point
+
+// Area is a site coverage area, one or more polygons. It marshals to and
+// from a GeoJSON Polygon or MultiPolygon geometry.
+type Area struct {
+	Polygons []Polygon
+}
+
+// Contains reports whether p falls inside the area. Edges are treated as
+// straight lines in degrees, which is fine at coverage scale but means an
+// area must not cross the antimeridian.
+func (a *Area) Contains(p Point) bool {
+	if a == nil {
+		return false
+	}
+	for _, poly := range a.Polygons {
+		if len(poly) == 0 || !ringContains(poly[0], p) {
+			continue
+		}
+		inHole := false
+		for _, hole := range poly[1:] {
+			if ringContains(hole, p) {
+				inHole = true
+				break
+			}
+		}
+		if !inHole {
+			return true
+		}
+	}
+	return false
+}
+
+func ringContains(ring []Point, p Point) bool {
+	in := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) &&
+			p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			in = !in
+		}
+	}
+	return in
+}
+
+type Geometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+type Feature struct {
+	Type       string         `json:"type"`
+	Id         string         `json:"id,omitempty"`
+	Geometry   any            `json:"geometry"`
+	Properties map[string]any `json:"properties"`
+}
+
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+func NewFeature(id string, geometry any, properties map[string]any) Feature {
+	if properties == nil {
+		properties = map[string]any{}
+	}
+	return Feature{Type: "Feature", Id: id, Geometry: geometry, Properties: properties}
+}
+
+func NewFeatureCollection(features []Feature) FeatureCollection {
+	if features == nil {
+		features = []Feature{}
+	}
+	return FeatureCollection{Type: "FeatureCollection", Features: features}
+}
+
+// PointGeometry is a GeoJSON Point, [lng, lat] as the spec orders it.
+func PointGeometry(p Point) map[string]any {
+	return map[string]any{"type": "Point", "coordinates": []float64{p.Lng, p.Lat}}
+}
+
+// ParseArea accepts a GeoJSON Polygon or MultiPolygon, either bare or as the
+// geometry of a Feature. Unclosed rings are closed.
+func ParseArea(data []byte) (*Area, error) {
+	var probe struct {
+		Type     string          `json:"type"`
+		Geometry json.RawMessage `json:"geometry"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+	if probe.Type == "Feature" {
+		if len(probe.Geometry) == 0 || string(probe.Geometry) == "null" {
+			return nil, errors.New("feature has no geometry")
+		}
+		return ParseArea(probe.Geometry)
+	}
+
+	var g Geometry
+	if err := json.Unmarshal(data, &g); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+
+	var raw [][][][2]float64
+	switch g.Type {
+	case "Polygon":
+		var poly [][][2]float64
+		if err := json.Unmarshal(g.Coordinates, &poly); err != nil {
+			return nil, fmt.Errorf("invalid Polygon coordinates: %w", err)
+		}
+		raw = [][][][2]float64{poly}
+	case "MultiPolygon":
+		if err := json.Unmarshal(g.Coordinates, &raw); err != nil {
+			return nil, fmt.Errorf("invalid MultiPolygon coordinates: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported geometry type %q, expected Polygon or MultiPolygon", g.Type)
+	}
+
+	a := &Area{}
+	vertices := 0
+	for _, rp := range raw {
+		if len(rp) == 0 {
+			return nil, errors.New("polygon has no rings")
+		}
+		var poly Polygon
+		for _, rr := range rp {
+			ring := make([]Point, 0, len(rr)+1)
+			for _, pos := range rr {
+				pt := Point{Lat: pos[1], Lng: pos[0]}
+				if err := pt.Validate(); err != nil {
+					return nil, err
+				}
+				ring = append(ring, pt)
+			}
+			if len(ring) > 0 && ring[0] != ring[len(ring)-1] {
+				ring = append(ring, ring[0])
+			}
+			if len(ring) < 4 {
+				return nil, errors.New("ring needs at least three distinct positions")
+			}
+			vertices += len(ring)
+			poly = append(poly, ring)
+		}
+		a.Polygons = append(a.Polygons, poly)
+	}
+	if len(a.Polygons) == 0 {
+		return nil, errors.New("area has no polygons")
+	}
+	if vertices > MaxAreaVertices {
+		return nil, fmt.Errorf("area has %d vertices, at most %d allowed", vertices, MaxAreaVertices)
+	}
+	return a, nil
+}
+
+// Geometry returns the area as a GeoJSON geometry object.
+func (a *Area) Geometry() map[string]any {
+	polys := make([][][][2]float64, 0, len(a.Polygons))
+	for _, poly := range a.Polygons {
+		rings := make([][][2]float64, 0, len(poly))
+		for _, ring := range poly {
+			pos := make([][2]float64, 0, len(ring))
+			for _, pt := range ring {
+				pos = append(pos, [2]float64{pt.Lng, pt.Lat})
+			}
+			rings = append(rings, pos)
+		}
+		polys = append(polys, rings)
+	}
+	if len(polys) == 1 {
+		return map[string]any{"type": "Polygon", "coordinates": polys[0]}
+	}
+	return map[string]any{"type": "MultiPolygon", "coordinates": polys}
+}
+
+func (a Area) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Geometry())
+}
+
+func (a *Area) UnmarshalJSON(data []byte) error {
+	parsed, err := ParseArea(data)
+	if err != nil {
+		return err
+	}
+	*a = *parsed
+	return nil
+}
@@ -11,5 +11,9 @@ package pkg
 const ServiceName = "site"
 const SystemName = "registry"
 
+// FindNearest returns this many sites unless asked for more, up to the max.
+const DefaultNearestLimit = 5
+const MaxNearestLimit = 100
+
 
 var IsDebugMode = false
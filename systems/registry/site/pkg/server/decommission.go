@@ -0,0 +1,258 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ukama/ukama/systems/common/grpc"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/registry/site/pkg/db"
+	"github.com/ukama/ukama/systems/registry/site/pkg/geo"
+
+	log "github.com/sirupsen/logrus"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	pb "github.com/ukama/ukama/systems/registry/site/pb/gen"
+)
+
+const nodeOnline = "online"
+
+type decommissionPlan struct {
+	nodeIds       []string
+	onlineNodeIds []string
+	componentIds  []string
+	blockers      []string
+}
+
+func (s *SiteServer) CheckDecommission(ctx context.Context, req *pb.CheckDecommissionRequest) (*pb.CheckDecommissionResponse, error) {
+	log.Infof("Checking decommission of site %s", req.SiteId)
+
+	site, err := s.getActiveSite(req.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := s.planDecommission(site)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CheckDecommissionResponse{
+		NodeIds:       plan.nodeIds,
+		OnlineNodeIds: plan.onlineNodeIds,
+		ComponentIds:  plan.componentIds,
+		Blockers:      plan.blockers,
+	}, nil
+}
+
+func (s *SiteServer) Decommission(ctx context.Context, req *pb.DecommissionRequest) (*pb.DecommissionResponse, error) {
+	log.Infof("Decommissioning site %s. Force: %v", req.SiteId, req.Force)
+
+	site, err := s.getActiveSite(req.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := s.planDecommission(site)
+	if err != nil {
+		return nil, err
+	}
+	if len(plan.blockers) > 0 && !req.Force {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"site can't be decommissioned: %s", strings.Join(plan.blockers, "; "))
+	}
+
+	/* nodes released before a failure stay released, a retry picks up the rest */
+	for _, nodeId := range plan.nodeIds {
+		if err := s.nodeClient.RemoveFromSite(nodeId); err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"failed to release node %s from site: %v", nodeId, err)
+		}
+	}
+
+	site, err = s.siteRepo.Decommission(site.Id, req.Reason, plan.nodeIds)
+	if err != nil {
+		return nil, siteStateErrorToGrpc(err)
+	}
+
+	if s.msgbus != nil {
+		route := s.baseRoutingKey.SetAction("decommission").SetObject("site").MustBuild()
+		evt := &epb.EventDecommissionSite{
+			SiteId:               site.Id.String(),
+			NetworkId:            site.NetworkId.String(),
+			Reason:               req.Reason,
+			ReleasedNodeIds:      plan.nodeIds,
+			ReleasedComponentIds: plan.componentIds,
+		}
+
+		err = s.msgbus.PublishRequest(route, evt)
+		if err != nil {
+			log.Errorf("Failed to publish message %+v with key %+v. Errors %s", evt, route, err.Error())
+		}
+	}
+
+	s.pushSiteCount(site.NetworkId)
+
+	return &pb.DecommissionResponse{
+		Site:                 dbSiteToPbSite(site),
+		ReleasedNodeIds:      plan.nodeIds,
+		ReleasedComponentIds: plan.componentIds,
+	}, nil
+}
+
+func (s *SiteServer) Relocate(ctx context.Context, req *pb.RelocateRequest) (*pb.RelocateResponse, error) {
+	log.Infof("Relocating site %s", req.SiteId)
+
+	point, err := geo.ParsePoint(req.Latitude, req.Longitude)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+
+	var backhaulId *uuid.UUID
+	if req.BackhaulId != "" {
+		id, err := uuid.FromString(req.BackhaulId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, uuidParsingError)
+		}
+		if _, err := s.inventoryClient.Get(id.String()); err != nil {
+			return nil, err
+		}
+		backhaulId = &id
+	}
+
+	prev, err := s.getActiveSite(req.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	site, err := s.siteRepo.Relocate(prev.Id, point, req.Location, backhaulId, req.Reason)
+	if err != nil {
+		return nil, siteStateErrorToGrpc(err)
+	}
+
+	if s.msgbus != nil {
+		route := s.baseRoutingKey.SetAction("relocate").SetObject("site").MustBuild()
+		evt := &epb.EventRelocateSite{
+			SiteId:             site.Id.String(),
+			NetworkId:          site.NetworkId.String(),
+			Reason:             req.Reason,
+			Latitude:           geo.FormatDegrees(site.Latitude),
+			Longitude:          geo.FormatDegrees(site.Longitude),
+			Location:           site.Location,
+			BackhaulId:         site.BackhaulId.String(),
+			PreviousLatitude:   geo.FormatDegrees(prev.Latitude),
+			PreviousLongitude:  geo.FormatDegrees(prev.Longitude),
+			PreviousLocation:   prev.Location,
+			PreviousBackhaulId: prev.BackhaulId.String(),
+		}
+
+		err = s.msgbus.PublishRequest(route, evt)
+		if err != nil {
+			log.Errorf("Failed to publish message %+v with key %+v. Errors %s", evt, route, err.Error())
+		}
+	}
+
+	return &pb.RelocateResponse{
+		Site: dbSiteToPbSite(site),
+	}, nil
+}
+
+func (s *SiteServer) GetHistory(ctx context.Context, req *pb.GetHistoryRequest) (*pb.GetHistoryResponse, error) {
+	siteId, err := uuid.FromString(req.SiteId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, uuidParsingError)
+	}
+
+	if _, err := s.siteRepo.Get(siteId); err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "site")
+	}
+
+	history, err := s.siteRepo.ListHistory(siteId)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "site")
+	}
+
+	resp := &pb.GetHistoryResponse{History: []*pb.SiteHistory{}}
+	for _, h := range history {
+		resp.History = append(resp.History, &pb.SiteHistory{
+			Action:     h.Action,
+			Reason:     h.Reason,
+			Latitude:   geo.FormatDegrees(h.Latitude),
+			Longitude:  geo.FormatDegrees(h.Longitude),
+			Location:   h.Location,
+			BackhaulId: h.BackhaulId.String(),
+			SpectrumId: h.SpectrumId.String(),
+			PowerId:    h.PowerId.String(),
+			AccessId:   h.AccessId.String(),
+			SwitchId:   h.SwitchId.String(),
+			NodeIds:    h.NodeIds,
+			CreatedAt:  h.CreatedAt.String(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *SiteServer) getActiveSite(id string) (*db.Site, error) {
+	siteId, err := uuid.FromString(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, uuidParsingError)
+	}
+
+	site, err := s.siteRepo.Get(siteId)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "site")
+	}
+	if site.DecommissionedAt != nil {
+		return nil, siteStateErrorToGrpc(db.ErrSiteDecommissioned)
+	}
+	return site, nil
+}
+
+// planDecommission lists what decommissioning the site releases. Online
+// nodes block it since they are still serving subscribers.
+func (s *SiteServer) planDecommission(site *db.Site) (*decommissionPlan, error) {
+	nodes, err := s.nodeClient.GetNodesBySite(site.Id.String())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get nodes of site: %v", err)
+	}
+
+	plan := &decommissionPlan{
+		nodeIds:       []string{},
+		onlineNodeIds: []string{},
+		componentIds:  []string{},
+		blockers:      []string{},
+	}
+	for _, n := range nodes.Nodes {
+		plan.nodeIds = append(plan.nodeIds, n.Id)
+		if strings.EqualFold(n.Status.Connectivity, nodeOnline) {
+			plan.onlineNodeIds = append(plan.onlineNodeIds, n.Id)
+			plan.blockers = append(plan.blockers, fmt.Sprintf("node %s is online", n.Id))
+		}
+	}
+
+	for _, id := range []uuid.UUID{site.BackhaulId, site.PowerId, site.AccessId, site.SwitchId, site.SpectrumId} {
+		if id != uuid.Nil {
+			plan.componentIds = append(plan.componentIds, id.String())
+		}
+	}
+	return plan, nil
+}
+
+func siteStateErrorToGrpc(err error) error {
+	if errors.Is(err, db.ErrSiteDecommissioned) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return grpc.SqlErrorToGrpc(err, "site")
+}
@@ -0,0 +1,185 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/tj/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cmocks "github.com/ukama/ukama/systems/common/mocks"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	"github.com/ukama/ukama/systems/common/rest/client/inventory"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/registry/site/mocks"
+	pb "github.com/ukama/ukama/systems/registry/site/pb/gen"
+	"github.com/ukama/ukama/systems/registry/site/pkg/db"
+	"github.com/ukama/ukama/systems/registry/site/pkg/geo"
+)
+
+func siteNodes(connectivity ...string) *creg.NodesBySite {
+	nodes := &creg.NodesBySite{SiteId: testSiteId.String()}
+	for i, c := range connectivity {
+		nodes.Nodes = append(nodes.Nodes, creg.NodeInfo{
+			Id:     []string{"uk-sa0001-tnode-a1-0001", "uk-sa0001-anode-a1-0002"}[i],
+			Status: creg.NodeStatusInfo{Connectivity: c},
+		})
+	}
+	return nodes
+}
+
+func TestSiteServer_CheckDecommission(t *testing.T) {
+	siteRepo := &mocks.SiteRepo{}
+	nodeClient := &cmocks.NodeClient{}
+	s := NewSiteServer(OrgName, siteRepo, nil, nil, "", nil, nodeClient)
+
+	siteRepo.On("Get", testSiteId).Return(createMockSite(), nil).Once()
+	nodeClient.On("GetNodesBySite", testSiteId.String()).Return(siteNodes("Online", "offline"), nil).Once()
+
+	res, err := s.CheckDecommission(context.Background(), &pb.CheckDecommissionRequest{SiteId: testSiteId.String()})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.NodeIds, 2)
+	assert.Equal(t, []string{"uk-sa0001-tnode-a1-0001"}, res.OnlineNodeIds)
+	assert.Len(t, res.ComponentIds, 5)
+	assert.Len(t, res.Blockers, 1)
+	siteRepo.AssertExpectations(t)
+	nodeClient.AssertExpectations(t)
+}
+
+func TestSiteServer_Decommission(t *testing.T) {
+	t.Run("BlockedByOnlineNode", func(t *testing.T) {
+		siteRepo := &mocks.SiteRepo{}
+		nodeClient := &cmocks.NodeClient{}
+		s := NewSiteServer(OrgName, siteRepo, nil, nil, "", nil, nodeClient)
+
+		siteRepo.On("Get", testSiteId).Return(createMockSite(), nil).Once()
+		nodeClient.On("GetNodesBySite", testSiteId.String()).Return(siteNodes("online"), nil).Once()
+
+		_, err := s.Decommission(context.Background(), &pb.DecommissionRequest{SiteId: testSiteId.String()})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		nodeClient.AssertNotCalled(t, "RemoveFromSite", mock.Anything)
+		siteRepo.AssertNotCalled(t, "Decommission", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Forced", func(t *testing.T) {
+		siteRepo := &mocks.SiteRepo{}
+		nodeClient := &cmocks.NodeClient{}
+		msgbus := &cmocks.MsgBusServiceClient{}
+		s := NewSiteServer(OrgName, siteRepo, msgbus, nil, "", nil, nodeClient)
+
+		now := time.Now()
+		decommissioned := createMockSite()
+		decommissioned.IsDeactivated = true
+		decommissioned.DecommissionedAt = &now
+		nodeIds := []string{"uk-sa0001-tnode-a1-0001", "uk-sa0001-anode-a1-0002"}
+
+		siteRepo.On("Get", testSiteId).Return(createMockSite(), nil).Once()
+		nodeClient.On("GetNodesBySite", testSiteId.String()).Return(siteNodes("online", "offline"), nil).Once()
+		nodeClient.On("RemoveFromSite", nodeIds[0]).Return(nil).Once()
+		nodeClient.On("RemoveFromSite", nodeIds[1]).Return(nil).Once()
+		siteRepo.On("Decommission", testSiteId, "flooded", nodeIds).Return(decommissioned, nil).Once()
+		siteRepo.On("GetSiteCount", testNetworkId).Return(int64(0), nil).Once()
+		msgbus.On("PublishRequest", "event.cloud.local.ukama.registry.site.site.decommission",
+			mock.MatchedBy(func(e *epb.EventDecommissionSite) bool {
+				return e.SiteId == testSiteId.String() && len(e.ReleasedNodeIds) == 2 && len(e.ReleasedComponentIds) == 5
+			})).Return(nil).Once()
+
+		res, err := s.Decommission(context.Background(), &pb.DecommissionRequest{
+			SiteId: testSiteId.String(),
+			Reason: "flooded",
+			Force:  true,
+		})
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Site.DecommissionedAt)
+		assert.Equal(t, nodeIds, res.ReleasedNodeIds)
+		siteRepo.AssertExpectations(t)
+		nodeClient.AssertExpectations(t)
+		msgbus.AssertExpectations(t)
+	})
+
+	t.Run("AlreadyDecommissioned", func(t *testing.T) {
+		siteRepo := &mocks.SiteRepo{}
+		s := NewSiteServer(OrgName, siteRepo, nil, nil, "", nil, nil)
+
+		now := time.Now()
+		site := createMockSite()
+		site.DecommissionedAt = &now
+		siteRepo.On("Get", testSiteId).Return(site, nil).Once()
+
+		_, err := s.Decommission(context.Background(), &pb.DecommissionRequest{SiteId: testSiteId.String()})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestSiteServer_Relocate(t *testing.T) {
+	siteRepo := &mocks.SiteRepo{}
+	inventoryClient := &cmocks.ComponentClient{}
+	msgbus := &cmocks.MsgBusServiceClient{}
+	s := NewSiteServer(OrgName, siteRepo, msgbus, nil, "", inventoryClient, nil)
+
+	newBackhaul := uuid.NewV4()
+	moved := createMockSite()
+	moved.Latitude, moved.Longitude = -1.25, 36.85
+	moved.BackhaulId = newBackhaul
+
+	_, err := s.Relocate(context.Background(), &pb.RelocateRequest{SiteId: testSiteId.String(), Latitude: "91", Longitude: "0"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	inventoryClient.On("Get", newBackhaul.String()).Return(&inventory.ComponentInfo{Id: newBackhaul}, nil).Once()
+	siteRepo.On("Get", testSiteId).Return(createMockSite(), nil).Once()
+	siteRepo.On("Relocate", testSiteId, geo.Point{Lat: -1.25, Lng: 36.85}, "", &newBackhaul, "road works").
+		Return(moved, nil).Once()
+	msgbus.On("PublishRequest", "event.cloud.local.ukama.registry.site.site.relocate",
+		mock.MatchedBy(func(e *epb.EventRelocateSite) bool {
+			return e.Latitude == "-1.25" && e.PreviousLatitude == testLatitude && e.BackhaulId == newBackhaul.String()
+		})).Return(nil).Once()
+
+	res, err := s.Relocate(context.Background(), &pb.RelocateRequest{
+		SiteId:     testSiteId.String(),
+		Latitude:   "-1.25",
+		Longitude:  "36.85",
+		BackhaulId: newBackhaul.String(),
+		Reason:     "road works",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, testSiteId.String(), res.Site.Id)
+	assert.Equal(t, newBackhaul.String(), res.Site.BackhaulId)
+	siteRepo.AssertExpectations(t)
+	inventoryClient.AssertExpectations(t)
+	msgbus.AssertExpectations(t)
+}
+
+func TestSiteServer_GetHistory(t *testing.T) {
+	siteRepo := &mocks.SiteRepo{}
+	s := NewSiteServer(OrgName, siteRepo, nil, nil, "", nil, nil)
+
+	siteRepo.On("Get", testSiteId).Return(createMockSite(), nil).Once()
+	siteRepo.On("ListHistory", testSiteId).Return([]db.SiteHistory{
+		{SiteId: testSiteId, Action: db.HistoryRelocate, Latitude: testLat, Longitude: testLng},
+	}, nil).Once()
+
+	res, err := s.GetHistory(context.Background(), &pb.GetHistoryRequest{SiteId: testSiteId.String()})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.History, 1)
+	assert.Equal(t, db.HistoryRelocate, res.History[0].Action)
+	assert.Equal(t, testLatitude, res.History[0].Latitude)
+	siteRepo.AssertExpectations(t)
+}
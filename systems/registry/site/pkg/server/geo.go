@@ -0,0 +1,224 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ukama/ukama/systems/common/grpc"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/registry/site/pkg"
+	"github.com/ukama/ukama/systems/registry/site/pkg/db"
+	"github.com/ukama/ukama/systems/registry/site/pkg/geo"
+
+	log "github.com/sirupsen/logrus"
+	pb "github.com/ukama/ukama/systems/registry/site/pb/gen"
+)
+
+func (s *SiteServer) FindInRadius(ctx context.Context, req *pb.FindInRadiusRequest) (*pb.FindSitesResponse, error) {
+	networkId, err := optionalNetworkId(req.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+	center := geo.Point{Lat: req.Latitude, Lng: req.Longitude}
+
+	sites, err := s.siteRepo.ListInBounds(networkId, geo.Around(center, req.RadiusMeters))
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "site")
+	}
+
+	found := byDistance(sites, center, func(_ *db.Site, d float64) bool {
+		return d <= req.RadiusMeters
+	})
+	return &pb.FindSitesResponse{Sites: found}, nil
+}
+
+func (s *SiteServer) FindInBounds(ctx context.Context, req *pb.FindInBoundsRequest) (*pb.FindSitesResponse, error) {
+	networkId, err := optionalNetworkId(req.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+	box := geo.BBox{
+		MinLat: req.MinLatitude,
+		MinLng: req.MinLongitude,
+		MaxLat: req.MaxLatitude,
+		MaxLng: req.MaxLongitude,
+	}
+	if err := box.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+
+	sites, err := s.siteRepo.ListInBounds(networkId, box)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "site")
+	}
+
+	return &pb.FindSitesResponse{Sites: byDistance(sites, box.Center(), nil)}, nil
+}
+
+func (s *SiteServer) FindNearest(ctx context.Context, req *pb.FindNearestRequest) (*pb.FindSitesResponse, error) {
+	networkId, err := optionalNetworkId(req.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = pkg.DefaultNearestLimit
+	}
+	if limit > pkg.MaxNearestLimit {
+		limit = pkg.MaxNearestLimit
+	}
+
+	sites, err := s.siteRepo.List(networkId, false)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "site")
+	}
+
+	found := byDistance(sites, geo.Point{Lat: req.Latitude, Lng: req.Longitude}, nil)
+	if len(found) > limit {
+		found = found[:limit]
+	}
+	return &pb.FindSitesResponse{Sites: found}, nil
+}
+
+func (s *SiteServer) FindServing(ctx context.Context, req *pb.FindServingRequest) (*pb.FindSitesResponse, error) {
+	networkId, err := optionalNetworkId(req.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+	point := geo.Point{Lat: req.Latitude, Lng: req.Longitude}
+
+	sites, err := s.siteRepo.List(networkId, false)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "site")
+	}
+
+	found := byDistance(sites, point, func(site *db.Site, _ float64) bool {
+		return site.Coverage.Contains(point)
+	})
+	return &pb.FindSitesResponse{Sites: found}, nil
+}
+
+func (s *SiteServer) SetCoverage(ctx context.Context, req *pb.SetCoverageRequest) (*pb.SetCoverageResponse, error) {
+	log.Infof("Setting coverage of site %s", req.SiteId)
+
+	siteId, err := uuid.FromString(req.SiteId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, uuidParsingError)
+	}
+
+	var area *geo.Area
+	if req.Geojson != "" {
+		area, err = geo.ParseArea([]byte(req.Geojson))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid coverage: %v", err)
+		}
+	}
+
+	site, err := s.siteRepo.SetCoverage(siteId, area)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "site")
+	}
+
+	return &pb.SetCoverageResponse{Site: dbSiteToPbSite(site)}, nil
+}
+
+func (s *SiteServer) GetCoverage(ctx context.Context, req *pb.GetCoverageRequest) (*pb.GetCoverageResponse, error) {
+	siteId, err := uuid.FromString(req.SiteId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, uuidParsingError)
+	}
+
+	site, err := s.siteRepo.Get(siteId)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "site")
+	}
+	if site.Coverage == nil {
+		return nil, status.Errorf(codes.NotFound, "site %s has no coverage area", req.SiteId)
+	}
+
+	b, err := json.Marshal(geo.NewFeature(site.Id.String(), site.Coverage.Geometry(), siteProperties(site, "coverage")))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode coverage: %v", err)
+	}
+	return &pb.GetCoverageResponse{Geojson: string(b)}, nil
+}
+
+func (s *SiteServer) ExportNetwork(ctx context.Context, req *pb.ExportNetworkRequest) (*pb.ExportNetworkResponse, error) {
+	networkId, err := uuid.FromString(req.NetworkId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid network ID: %v", err)
+	}
+
+	sites, err := s.siteRepo.GetSites(networkId)
+	if err != nil {
+		return nil, grpc.SqlErrorToGrpc(err, "site")
+	}
+
+	features := make([]geo.Feature, 0, len(sites))
+	for i := range sites {
+		site := &sites[i]
+		features = append(features, geo.NewFeature(site.Id.String(),
+			geo.PointGeometry(site.Point()), siteProperties(site, "site")))
+		if site.Coverage != nil {
+			features = append(features, geo.NewFeature(site.Id.String()+"/coverage",
+				site.Coverage.Geometry(), siteProperties(site, "coverage")))
+		}
+	}
+
+	b, err := json.Marshal(geo.NewFeatureCollection(features))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode network: %v", err)
+	}
+	return &pb.ExportNetworkResponse{Geojson: string(b)}, nil
+}
+
+func optionalNetworkId(id string) (*uuid.UUID, error) {
+	if id == "" {
+		return nil, nil
+	}
+	networkId, err := uuid.FromString(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid network ID: %v", err)
+	}
+	return &networkId, nil
+}
+
+// byDistance keeps the sites accepted by keep, nearest to p first. A nil
+// keep accepts every site.
+func byDistance(sites []db.Site, p geo.Point, keep func(*db.Site, float64) bool) []*pb.SiteDistance {
+	res := []*pb.SiteDistance{}
+	for i := range sites {
+		d := geo.Distance(p, sites[i].Point())
+		if keep != nil && !keep(&sites[i], d) {
+			continue
+		}
+		res = append(res, &pb.SiteDistance{Site: dbSiteToPbSite(&sites[i]), DistanceMeters: d})
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].DistanceMeters < res[j].DistanceMeters
+	})
+	return res
+}
+
+func siteProperties(site *db.Site, kind string) map[string]any {
+	return map[string]any{
+		"kind":           kind,
+		"site_id":        site.Id.String(),
+		"name":           site.Name,
+		"location":       site.Location,
+		"network_id":     site.NetworkId.String(),
+		"is_deactivated": site.IsDeactivated,
+	}
+}
@@ -0,0 +1,215 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/tj/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/registry/site/mocks"
+	pb "github.com/ukama/ukama/systems/registry/site/pb/gen"
+	"github.com/ukama/ukama/systems/registry/site/pkg/db"
+	"github.com/ukama/ukama/systems/registry/site/pkg/geo"
+)
+
+const villageSquare = `{"type":"Polygon","coordinates":[[[36.8,-1.3],[36.9,-1.3],[36.9,-1.2],[36.8,-1.2],[36.8,-1.3]]]}`
+
+func geoSites(t *testing.T) []db.Site {
+	area, err := geo.ParseArea([]byte(villageSquare))
+	assert.NoError(t, err)
+
+	return []db.Site{
+		{Id: uuid.NewV4(), Name: "far", NetworkId: testNetworkId, Latitude: -1.0, Longitude: 37.2},
+		{Id: uuid.NewV4(), Name: "covering", NetworkId: testNetworkId, Latitude: -1.25, Longitude: 36.85, Coverage: area},
+		{Id: uuid.NewV4(), Name: "near", NetworkId: testNetworkId, Latitude: -1.26, Longitude: 36.86},
+	}
+}
+
+func names(res *pb.FindSitesResponse) []string {
+	out := []string{}
+	for _, s := range res.Sites {
+		out = append(out, s.Site.Name)
+	}
+	return out
+}
+
+func TestSiteServer_FindInRadius(t *testing.T) {
+	siteRepo := &mocks.SiteRepo{}
+	s := NewSiteServer(OrgName, siteRepo, nil, nil, "", nil, nil)
+
+	siteRepo.On("ListInBounds", &testNetworkId, mock.AnythingOfType("geo.BBox")).Return(geoSites(t), nil).Once()
+
+	res, err := s.FindInRadius(context.Background(), &pb.FindInRadiusRequest{
+		NetworkId:    testNetworkId.String(),
+		Latitude:     -1.25,
+		Longitude:    36.85,
+		RadiusMeters: 5000,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"covering", "near"}, names(res))
+	assert.Zero(t, res.Sites[0].DistanceMeters)
+	assert.InDelta(t, 1570, res.Sites[1].DistanceMeters, 10)
+	assert.True(t, res.Sites[0].Site.HasCoverage)
+	siteRepo.AssertExpectations(t)
+}
+
+func TestSiteServer_FindInBounds(t *testing.T) {
+	siteRepo := &mocks.SiteRepo{}
+	s := NewSiteServer(OrgName, siteRepo, nil, nil, "", nil, nil)
+
+	_, err := s.FindInBounds(context.Background(), &pb.FindInBoundsRequest{MinLatitude: 2, MaxLatitude: 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	box := geo.BBox{MinLat: -2, MinLng: 36, MaxLat: 0, MaxLng: 38}
+	siteRepo.On("ListInBounds", (*uuid.UUID)(nil), box).Return(geoSites(t), nil).Once()
+
+	res, err := s.FindInBounds(context.Background(), &pb.FindInBoundsRequest{
+		MinLatitude: -2, MinLongitude: 36, MaxLatitude: 0, MaxLongitude: 38,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"far", "covering", "near"}, names(res))
+	siteRepo.AssertExpectations(t)
+}
+
+func TestSiteServer_FindNearest(t *testing.T) {
+	siteRepo := &mocks.SiteRepo{}
+	s := NewSiteServer(OrgName, siteRepo, nil, nil, "", nil, nil)
+
+	siteRepo.On("List", &testNetworkId, false).Return(geoSites(t), nil).Once()
+
+	res, err := s.FindNearest(context.Background(), &pb.FindNearestRequest{
+		NetworkId: testNetworkId.String(),
+		Latitude:  -1.27,
+		Longitude: 36.87,
+		Limit:     2,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"near", "covering"}, names(res))
+
+	_, err = s.FindNearest(context.Background(), &pb.FindNearestRequest{NetworkId: "bad"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	siteRepo.AssertExpectations(t)
+}
+
+func TestSiteServer_FindServing(t *testing.T) {
+	siteRepo := &mocks.SiteRepo{}
+	s := NewSiteServer(OrgName, siteRepo, nil, nil, "", nil, nil)
+
+	siteRepo.On("List", (*uuid.UUID)(nil), false).Return(geoSites(t), nil).Twice()
+
+	res, err := s.FindServing(context.Background(), &pb.FindServingRequest{Latitude: -1.29, Longitude: 36.81})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"covering"}, names(res))
+
+	res, err = s.FindServing(context.Background(), &pb.FindServingRequest{Latitude: -1.0, Longitude: 37.2})
+	assert.NoError(t, err)
+	assert.Empty(t, res.Sites, "a site does not serve its own location without a coverage area")
+	siteRepo.AssertExpectations(t)
+}
+
+func TestSiteServer_Coverage(t *testing.T) {
+	t.Run("SetInvalid", func(t *testing.T) {
+		s := NewSiteServer(OrgName, &mocks.SiteRepo{}, nil, nil, "", nil, nil)
+
+		_, err := s.SetCoverage(context.Background(), &pb.SetCoverageRequest{
+			SiteId:  testSiteId.String(),
+			Geojson: `{"type":"LineString","coordinates":[[0,0],[1,1]]}`,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("SetAndClear", func(t *testing.T) {
+		siteRepo := &mocks.SiteRepo{}
+		s := NewSiteServer(OrgName, siteRepo, nil, nil, "", nil, nil)
+		site := geoSites(t)[1]
+
+		siteRepo.On("SetCoverage", testSiteId, mock.MatchedBy(func(a *geo.Area) bool {
+			return a != nil && a.Contains(geo.Point{Lat: -1.25, Lng: 36.85})
+		})).Return(&site, nil).Once()
+		siteRepo.On("SetCoverage", testSiteId, (*geo.Area)(nil)).Return(&db.Site{Id: testSiteId}, nil).Once()
+
+		res, err := s.SetCoverage(context.Background(), &pb.SetCoverageRequest{SiteId: testSiteId.String(), Geojson: villageSquare})
+		assert.NoError(t, err)
+		assert.True(t, res.Site.HasCoverage)
+
+		res, err = s.SetCoverage(context.Background(), &pb.SetCoverageRequest{SiteId: testSiteId.String()})
+		assert.NoError(t, err)
+		assert.False(t, res.Site.HasCoverage)
+		siteRepo.AssertExpectations(t)
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		siteRepo := &mocks.SiteRepo{}
+		s := NewSiteServer(OrgName, siteRepo, nil, nil, "", nil, nil)
+		site := geoSites(t)[1]
+
+		siteRepo.On("Get", site.Id).Return(&site, nil).Once()
+		siteRepo.On("Get", testSiteId).Return(&db.Site{Id: testSiteId}, nil).Once()
+
+		res, err := s.GetCoverage(context.Background(), &pb.GetCoverageRequest{SiteId: site.Id.String()})
+		assert.NoError(t, err)
+
+		var f struct {
+			Type       string
+			Id         string
+			Geometry   json.RawMessage
+			Properties map[string]any
+		}
+		assert.NoError(t, json.Unmarshal([]byte(res.Geojson), &f))
+		assert.Equal(t, "Feature", f.Type)
+		assert.Equal(t, "covering", f.Properties["name"])
+		area, err := geo.ParseArea(f.Geometry)
+		assert.NoError(t, err)
+		assert.Equal(t, site.Coverage, area)
+
+		_, err = s.GetCoverage(context.Background(), &pb.GetCoverageRequest{SiteId: testSiteId.String()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		siteRepo.AssertExpectations(t)
+	})
+}
+
+func TestSiteServer_ExportNetwork(t *testing.T) {
+	siteRepo := &mocks.SiteRepo{}
+	s := NewSiteServer(OrgName, siteRepo, nil, nil, "", nil, nil)
+
+	siteRepo.On("GetSites", testNetworkId).Return(geoSites(t), nil).Once()
+
+	res, err := s.ExportNetwork(context.Background(), &pb.ExportNetworkRequest{NetworkId: testNetworkId.String()})
+	assert.NoError(t, err)
+
+	var fc struct {
+		Type     string
+		Features []struct {
+			Id       string
+			Geometry struct {
+				Type        string
+				Coordinates json.RawMessage
+			}
+			Properties map[string]any
+		}
+	}
+	assert.NoError(t, json.Unmarshal([]byte(res.Geojson), &fc))
+	assert.Equal(t, "FeatureCollection", fc.Type)
+	assert.Len(t, fc.Features, 4)
+	assert.Equal(t, "Point", fc.Features[0].Geometry.Type)
+	assert.JSONEq(t, "[37.2,-1]", string(fc.Features[0].Geometry.Coordinates), "GeoJSON orders longitude first")
+	assert.Equal(t, "Polygon", fc.Features[2].Geometry.Type)
+	assert.Equal(t, "coverage", fc.Features[2].Properties["kind"])
+	siteRepo.AssertExpectations(t)
+}
@@ -20,12 +20,14 @@ import (
 	"github.com/ukama/ukama/systems/common/uuid"
 	"github.com/ukama/ukama/systems/registry/site/pkg"
 	"github.com/ukama/ukama/systems/registry/site/pkg/db"
+	"github.com/ukama/ukama/systems/registry/site/pkg/geo"
 
 	log "github.com/sirupsen/logrus"
 	metric "github.com/ukama/ukama/systems/common/metrics"
 	mb "github.com/ukama/ukama/systems/common/msgBusServiceClient"
 	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
 	cinvent "github.com/ukama/ukama/systems/common/rest/client/inventory"
+	creg "github.com/ukama/ukama/systems/common/rest/client/registry"
 	ukama "github.com/ukama/ukama/systems/common/validation"
 	npb "github.com/ukama/ukama/systems/registry/network/pb/gen"
 	pb "github.com/ukama/ukama/systems/registry/site/pb/gen"
@@ -42,10 +44,11 @@ type SiteServer struct {
 	baseRoutingKey  msgbus.RoutingKeyBuilder
 	networkService  providers.NetworkClientProvider
 	inventoryClient cinvent.ComponentClient
+	nodeClient      creg.NodeClient
 	pushGateway     string
 }
 
-func NewSiteServer(orgName string, siteRepo db.SiteRepo, msgBus mb.MsgBusServiceClient, networkService providers.NetworkClientProvider, pushGateway string, inventoryClientProvider cinvent.ComponentClient) *SiteServer {
+func NewSiteServer(orgName string, siteRepo db.SiteRepo, msgBus mb.MsgBusServiceClient, networkService providers.NetworkClientProvider, pushGateway string, inventoryClientProvider cinvent.ComponentClient, nodeClient creg.NodeClient) *SiteServer {
 	return &SiteServer{
 		orgName:         orgName,
 		siteRepo:        siteRepo,
@@ -54,6 +57,7 @@ func NewSiteServer(orgName string, siteRepo db.SiteRepo, msgBus mb.MsgBusService
 		networkService:  networkService,
 		pushGateway:     pushGateway,
 		inventoryClient: inventoryClientProvider,
+		nodeClient:      nodeClient,
 	}
 }
 
@@ -92,6 +96,11 @@ func (s *SiteServer) Add(ctx context.Context, req *pb.AddRequest) (*pb.AddRespon
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
 	}
 
+	point, err := geo.ParsePoint(req.Latitude, req.Longitude)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+
 	for _, componentIdStr := range []string{
 		backhaulId.String(),
 		powerId.String(),
@@ -128,8 +137,8 @@ func (s *SiteServer) Add(ctx context.Context, req *pb.AddRequest) (*pb.AddRespon
 		SwitchId:      switchId,
 		SpectrumId:    spectrumId,
 		IsDeactivated: req.IsDeactivated,
-		Latitude:      req.Latitude,
-		Longitude:     req.Longitude,
+		Latitude:      point.Lat,
+		Longitude:     point.Lng,
 		InstallDate:   instDate,
 	}
 
@@ -152,8 +161,8 @@ func (s *SiteServer) Add(ctx context.Context, req *pb.AddRequest) (*pb.AddRespon
 			PowerId:       site.PowerId.String(),
 			AccessId:      site.AccessId.String(),
 			SwitchId:      site.SwitchId.String(),
-			Latitude:      site.Latitude,
-			Longitude:     site.Longitude,
+			Latitude:      geo.FormatDegrees(site.Latitude),
+			Longitude:     geo.FormatDegrees(site.Longitude),
 			InstallDate:   site.InstallDate,
 		}
 
@@ -282,7 +291,7 @@ func (s *SiteServer) Delete(ctx context.Context, req *pb.DeleteRequest) (*pb.Del
 
 func dbSiteToPbSite(site *db.Site) *pb.Site {
 
-	return &pb.Site{
+	pbSite := &pb.Site{
 		Id:            site.Id.String(),
 		Name:          site.Name,
 		Location:      site.Location,
@@ -293,11 +302,16 @@ func dbSiteToPbSite(site *db.Site) *pb.Site {
 		AccessId:      site.AccessId.String(),
 		SwitchId:      site.SwitchId.String(),
 		SpectrumId:    site.SpectrumId.String(),
-		Latitude:      site.Latitude,
-		Longitude:     site.Longitude,
+		Latitude:      geo.FormatDegrees(site.Latitude),
+		Longitude:     geo.FormatDegrees(site.Longitude),
 		InstallDate:   site.InstallDate,
 		CreatedAt:     site.CreatedAt.String(),
+		HasCoverage:   site.Coverage != nil,
+	}
+	if site.DecommissionedAt != nil {
+		pbSite.DecommissionedAt = site.DecommissionedAt.String()
 	}
+	return pbSite
 }
 
 func dbSitesToPbSites(sites []db.Site) []*pb.Site {
@@ -22,6 +22,7 @@ import (
 	"github.com/ukama/ukama/systems/registry/site/mocks"
 	pb "github.com/ukama/ukama/systems/registry/site/pb/gen"
 	"github.com/ukama/ukama/systems/registry/site/pkg/db"
+	"github.com/ukama/ukama/systems/registry/site/pkg/geo"
 	"gorm.io/gorm"
 )
 
@@ -42,9 +43,13 @@ const (
 
 	// Coordinates
 	testLatitude         = "40.7128"
-	testLongitude        = "-74.0060"
-	testExtremeLatitude  = "90.0"  // North Pole
-	testExtremeLongitude = "180.0" // International Date Line
+	testLongitude        = "-74.006"
+	testExtremeLatitude  = "90"  // North Pole
+	testExtremeLongitude = "180" // International Date Line
+	testLat              = 40.7128
+	testLng              = -74.006
+	testExtremeLat       = 90.0
+	testExtremeLng       = 180.0
 
 	// Site names for list tests
 	testSite1Name               = "Site1"
@@ -76,8 +81,8 @@ func createMockSite() *db.Site {
 		SwitchId:      testSwitchId,
 		SpectrumId:    testSpectrumId,
 		IsDeactivated: false,
-		Latitude:      testLatitude,
-		Longitude:     testLongitude,
+		Latitude:      testLat,
+		Longitude:     testLng,
 		InstallDate:   testValidInstallDate,
 	}
 }
@@ -117,8 +122,8 @@ func verifySiteFieldMappings(t *testing.T, expected *db.Site, actual *pb.Site) {
 		{"SwitchId", expected.SwitchId.String(), actual.SwitchId},
 		{"SpectrumId", expected.SpectrumId.String(), actual.SpectrumId},
 		{"IsDeactivated", expected.IsDeactivated, actual.IsDeactivated},
-		{"Latitude", expected.Latitude, actual.Latitude},
-		{"Longitude", expected.Longitude, actual.Longitude},
+		{"Latitude", geo.FormatDegrees(expected.Latitude), actual.Latitude},
+		{"Longitude", geo.FormatDegrees(expected.Longitude), actual.Longitude},
 		{"InstallDate", expected.InstallDate, actual.InstallDate},
 	}
 
@@ -153,7 +158,7 @@ func TestSiteService_Get(t *testing.T) {
 	msgclientRepo := &cmocks.MsgBusServiceClient{}
 	netRepo := &mocks.NetworkClientProvider{}
 
-	s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", nil)
+	s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", nil, nil)
 
 	t.Run("SiteFound", func(t *testing.T) {
 		mockSite := createMockSite()
@@ -218,7 +223,7 @@ func TestSiteService_List(t *testing.T) {
 	msgclientRepo := &cmocks.MsgBusServiceClient{}
 	netRepo := &mocks.NetworkClientProvider{}
 
-	s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", nil)
+	s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", nil, nil)
 
 	t.Run("ValidRequestWithMultipleSites", func(t *testing.T) {
 		siteRepo.ExpectedCalls = nil
@@ -235,8 +240,8 @@ func TestSiteService_List(t *testing.T) {
 				SwitchId:      testSwitchId,
 				SpectrumId:    testSpectrumId,
 				IsDeactivated: false,
-				Latitude:      testLatitude,
-				Longitude:     testLongitude,
+				Latitude:      testLat,
+				Longitude:     testLng,
 				InstallDate:   testValidInstallDate,
 			},
 			{
@@ -250,8 +255,8 @@ func TestSiteService_List(t *testing.T) {
 				SwitchId:      testSwitchId,
 				SpectrumId:    testSpectrumId,
 				IsDeactivated: false,
-				Latitude:      testExtremeLatitude,
-				Longitude:     testExtremeLongitude,
+				Latitude:      testExtremeLat,
+				Longitude:     testExtremeLng,
 				InstallDate:   testValidInstallDate,
 			},
 		}
@@ -297,8 +302,8 @@ func TestSiteService_List(t *testing.T) {
 				SwitchId:      testSwitchId,
 				SpectrumId:    testSpectrumId,
 				IsDeactivated: false,
-				Latitude:      testLatitude,
-				Longitude:     testLongitude,
+				Latitude:      testLat,
+				Longitude:     testLng,
 				InstallDate:   testValidInstallDate,
 			},
 		}
@@ -338,8 +343,8 @@ func TestSiteService_List(t *testing.T) {
 				SwitchId:      testSwitchId,
 				SpectrumId:    testSpectrumId,
 				IsDeactivated: true,
-				Latitude:      testLatitude,
-				Longitude:     testLongitude,
+				Latitude:      testLat,
+				Longitude:     testLng,
 				InstallDate:   testValidInstallDate,
 			},
 		}
@@ -392,7 +397,7 @@ func TestSiteService_Update(t *testing.T) {
 	msgclientRepo := &cmocks.MsgBusServiceClient{}
 	netRepo := &mocks.NetworkClientProvider{}
 
-	s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", nil)
+	s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", nil, nil)
 
 	t.Run("Success", func(t *testing.T) {
 		// Mock the site repository update
@@ -511,7 +516,7 @@ func TestSiteService_Update(t *testing.T) {
 
 	t.Run("NilMessageBus", func(t *testing.T) {
 		// Create server without message bus
-		sNoMsgBus := NewSiteServer(OrgName, siteRepo, nil, netRepo, "", nil)
+		sNoMsgBus := NewSiteServer(OrgName, siteRepo, nil, netRepo, "", nil, nil)
 
 		// Mock the site repository update
 		siteRepo.On("Update", mock.AnythingOfType("*db.Site")).Return(nil).Once()
@@ -561,7 +566,7 @@ func TestSiteService_Add(t *testing.T) {
 	netRepo := &mocks.NetworkClientProvider{}
 	inventoryClient := &cmocks.ComponentClient{}
 
-	s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", inventoryClient)
+	s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", inventoryClient, nil)
 
 	validRequest := createValidAddRequest()
 
@@ -739,7 +744,7 @@ func TestSiteService_Add(t *testing.T) {
 		msgclientRepo := &cmocks.MsgBusServiceClient{}
 		netRepo := &mocks.NetworkClientProvider{}
 		inventoryClient := &cmocks.ComponentClient{}
-		s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", inventoryClient)
+		s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", inventoryClient, nil)
 
 		// Mock inventory client calls for all components
 		for _, componentId := range []string{
@@ -774,7 +779,7 @@ func TestSiteService_Add(t *testing.T) {
 		msgclientRepo := &cmocks.MsgBusServiceClient{}
 		netRepo := &mocks.NetworkClientProvider{}
 		inventoryClient := &cmocks.ComponentClient{}
-		s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", inventoryClient)
+		s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", inventoryClient, nil)
 
 		// Mock inventory client calls for all components
 		for _, componentId := range []string{
@@ -805,7 +810,7 @@ func TestSiteService_Add(t *testing.T) {
 		msgclientRepo := &cmocks.MsgBusServiceClient{}
 		netRepo := &mocks.NetworkClientProvider{}
 		inventoryClient := &cmocks.ComponentClient{}
-		s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", inventoryClient)
+		s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", inventoryClient, nil)
 
 		// Mock inventory client calls for all components
 		for _, componentId := range []string{
@@ -924,7 +929,7 @@ func TestSiteService_Add(t *testing.T) {
 
 	t.Run("NilMessageBus", func(t *testing.T) {
 		// Create server without message bus
-		sNoMsgBus := NewSiteServer(OrgName, siteRepo, nil, netRepo, "", inventoryClient)
+		sNoMsgBus := NewSiteServer(OrgName, siteRepo, nil, netRepo, "", inventoryClient, nil)
 
 		// Mock network client
 		mockNetworkClient := &netmocks.NetworkServiceClient{}
@@ -1136,7 +1141,7 @@ func TestSiteService_Delete(t *testing.T) {
 	msgclientRepo := &cmocks.MsgBusServiceClient{}
 	netRepo := &mocks.NetworkClientProvider{}
 
-	s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", nil)
+	s := NewSiteServer(OrgName, siteRepo, msgclientRepo, netRepo, "", nil, nil)
 
 	t.Run("SiteExist", func(t *testing.T) {
 		mockSite := createMockSite()
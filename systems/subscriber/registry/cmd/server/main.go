@@ -26,6 +26,7 @@ import (
 	ccmd "github.com/ukama/ukama/systems/common/cmd"
 	ugrpc "github.com/ukama/ukama/systems/common/grpc"
 	mb "github.com/ukama/ukama/systems/common/msgBusServiceClient"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
 	cclient "github.com/ukama/ukama/systems/common/rest/client"
 	ic "github.com/ukama/ukama/systems/common/rest/client/initclient"
 	cnucl "github.com/ukama/ukama/systems/common/rest/client/nucleus"
@@ -101,8 +102,11 @@ func runGrpcServer(gormdb sql.Db) {
 
 	srv := server.NewSubscriberServer(serviceConfig.OrgName, db.NewSubscriberRepo(gormdb), mbClient, simMClient, serviceConfig.OrgId, orgClient, networkClient)
 
+	eventServer := server.NewSubscriberEventServer(serviceConfig.OrgName, db.NewSubscriberRepo(gormdb), mbClient)
+
 	grpcServer := ugrpc.NewGrpcServer(*serviceConfig.Grpc, func(s *grpc.Server) {
 		pb.RegisterRegistryServiceServer(s, srv)
+		epb.RegisterEventNotificationServiceServer(s, eventServer)
 	})
 
 	grpcServer.RegisterDependency("db", true, ugrpc.DBCheck(gormdb))
@@ -110,6 +114,9 @@ func runGrpcServer(gormdb sql.Db) {
 
 	go msgBusListener(mbClient)
 
+	ic.StartHeartbeat(ic.NewInitClient(serviceConfig.Http.InitClient, cclient.WithDebug(serviceConfig.DebugMode)),
+		serviceConfig.OrgName, pkg.SystemName, version.Version, ic.DefaultHeartbeatInterval, nil)
+
 	grpcServer.StartServer()
 }
 
@@ -34,6 +34,24 @@ func (_m *SubscriberRepo) Add(subscriber *db.Subscriber, nestedFunc func(*db.Sub
 	return r0
 }
 
+// Anonymize provides a mock function with given fields: subscriberId
+func (_m *SubscriberRepo) Anonymize(subscriberId uuid.UUID) error {
+	ret := _m.Called(subscriberId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Anonymize")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(subscriberId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Delete provides a mock function with given fields: subscriberId
 func (_m *SubscriberRepo) Delete(subscriberId uuid.UUID) error {
 	ret := _m.Called(subscriberId)
@@ -9,6 +9,7 @@
 package db
 
 import (
+	"github.com/ukama/ukama/systems/common/privacy"
 	"github.com/ukama/ukama/systems/common/sql"
 	uuid "github.com/ukama/ukama/systems/common/uuid"
 	"gorm.io/gorm"
@@ -22,6 +23,9 @@ type SubscriberRepo interface {
 	Update(subscriberId uuid.UUID, sub Subscriber) error
 	GetByNetwork(networkId uuid.UUID) ([]Subscriber, error)
 	ListSubscribers() ([]Subscriber, error)
+	// Anonymize replaces the personal data of a subscriber, deleted or not,
+	// keeping the record for the sims and invoices referring to it.
+	Anonymize(subscriberId uuid.UUID) error
 }
 
 type subscriberRepo struct {
@@ -116,3 +120,25 @@ func (s *subscriberRepo) GetByNetwork(networkId uuid.UUID) ([]Subscriber, error)
 	}
 	return subscribers, nil
 }
+
+func (s *subscriberRepo) Anonymize(subscriberId uuid.UUID) error {
+	result := s.Db.GetGormDb().Unscoped().Model(&Subscriber{}).Where("subscriber_id = ?", subscriberId).
+		Updates(map[string]interface{}{
+			"name":                    privacy.Erased,
+			"email":                   privacy.ErasedEmail(subscriberId.String()),
+			"phone_number":            "",
+			"gender":                  "",
+			"dob":                     "",
+			"proof_of_identification": "",
+			"id_serial":               "",
+			"address":                 "",
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
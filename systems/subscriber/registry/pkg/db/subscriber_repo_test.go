@@ -778,3 +778,41 @@ func TestSubscriber_Update(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
+
+func TestSubscriber_Anonymize(t *testing.T) {
+	t.Run("SubscriberAnonymized", func(t *testing.T) {
+		// Arrange
+		var subID = uuid.NewV4()
+		mock, repo := setupTestDB(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`^UPDATE "subscribers" SET .*"email"=.*"name"=.*WHERE subscriber_id = .*`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		// Act
+		err := repo.Anonymize(subID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SubscriberNotFound", func(t *testing.T) {
+		// Arrange
+		var subID = uuid.NewV4()
+		mock, repo := setupTestDB(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`^UPDATE "subscribers"`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		// Act
+		err := repo.Anonymize(subID)
+
+		// Assert
+		assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
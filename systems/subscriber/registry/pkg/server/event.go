@@ -0,0 +1,139 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ukama/ukama/systems/common/msgbus"
+	"github.com/ukama/ukama/systems/common/privacy"
+	"github.com/ukama/ukama/systems/subscriber/registry/pkg"
+	"github.com/ukama/ukama/systems/subscriber/registry/pkg/db"
+
+	log "github.com/sirupsen/logrus"
+	mb "github.com/ukama/ukama/systems/common/msgBusServiceClient"
+	cpb "github.com/ukama/ukama/systems/common/pb/events"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	uuid "github.com/ukama/ukama/systems/common/uuid"
+)
+
+type SubscriberEventServer struct {
+	orgName        string
+	subscriberRepo db.SubscriberRepo
+	msgbus         mb.MsgBusServiceClient
+	baseRoutingKey msgbus.RoutingKeyBuilder
+	epb.UnimplementedEventNotificationServiceServer
+}
+
+func NewSubscriberEventServer(orgName string, subscriberRepo db.SubscriberRepo, msgBus mb.MsgBusServiceClient) *SubscriberEventServer {
+	return &SubscriberEventServer{
+		orgName:        orgName,
+		subscriberRepo: subscriberRepo,
+		msgbus:         msgBus,
+		baseRoutingKey: msgbus.NewRoutingKeyBuilder().SetCloudSource().SetSystem(pkg.SystemName).
+			SetOrgName(orgName).SetService(pkg.ServiceName),
+	}
+}
+
+func (es *SubscriberEventServer) EventNotification(ctx context.Context, e *epb.Event) (*epb.EventResponse, error) {
+	log.Infof("Received a message with Routing key %s and Message %+v", e.RoutingKey, e.Msg)
+
+	switch e.RoutingKey {
+	case msgbus.PrepareRoute(es.orgName, "event.cloud.local.{{ .Org}}.registry.member.datarequest.create"):
+		msg, err := cpb.UnmarshalProtoEvent[epb.EventDataRequest](e.Msg)
+		if err != nil {
+			return nil, err
+		}
+
+		err = es.handleDataRequestCreateEvent(e.RoutingKey, msg)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		log.Errorf("No handler routing key %s", e.RoutingKey)
+	}
+
+	return &epb.EventResponse{}, nil
+}
+
+// handleDataRequestCreateEvent exports the subscribers of the org or one
+// subscriber, or anonymizes a subscriber on erasure.
+func (es *SubscriberEventServer) handleDataRequestCreateEvent(key string, req *epb.EventDataRequest) error {
+	log.Infof("Keys %s and Proto is: %+v", key, req)
+
+	var doc privacy.Document
+	var err error
+
+	switch req.Kind {
+	case privacy.KindExport:
+		doc, err = es.exportSubscribers(req)
+	case privacy.KindErase:
+		err = es.eraseSubscriber(req)
+	default:
+		err = fmt.Errorf("invalid data request kind %q", req.Kind)
+	}
+
+	res := privacy.NewResult(pkg.SystemName+"."+pkg.ServiceName, req, doc, err)
+	route := es.baseRoutingKey.SetAction("complete").SetObject("datarequest").MustBuild()
+
+	err = es.msgbus.PublishRequest(route, res)
+	if err != nil {
+		log.Errorf("Failed to publish message %+v with key %+v. Errors %s", res, route, err.Error())
+	}
+
+	return err
+}
+
+func (es *SubscriberEventServer) exportSubscribers(req *epb.EventDataRequest) (privacy.Document, error) {
+	var subscribers []db.Subscriber
+
+	if req.SubjectKind == privacy.SubjectSubscriber {
+		id, err := uuid.FromString(req.SubjectId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subscriber id %q: %w", req.SubjectId, err)
+		}
+
+		sub, err := es.subscriberRepo.Get(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get subscriber %s: %w", id, err)
+		}
+		subscribers = append(subscribers, *sub)
+	} else {
+		var err error
+		subscribers, err = es.subscriberRepo.ListSubscribers()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list subscribers: %w", err)
+		}
+	}
+
+	doc := privacy.Document{}
+	if err := doc.Add("subscribers", subscribers); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+func (es *SubscriberEventServer) eraseSubscriber(req *epb.EventDataRequest) error {
+	if req.SubjectKind != privacy.SubjectSubscriber {
+		return fmt.Errorf("can't erase %s data", req.SubjectKind)
+	}
+
+	id, err := uuid.FromString(req.SubjectId)
+	if err != nil {
+		return fmt.Errorf("invalid subscriber id %q: %w", req.SubjectId, err)
+	}
+
+	if err := es.subscriberRepo.Anonymize(id); err != nil {
+		return fmt.Errorf("failed to anonymize subscriber %s: %w", id, err)
+	}
+
+	return nil
+}
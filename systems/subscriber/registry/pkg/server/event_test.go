@@ -0,0 +1,95 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ukama/ukama/systems/common/msgbus"
+	"github.com/ukama/ukama/systems/common/uuid"
+	"github.com/ukama/ukama/systems/subscriber/registry/mocks"
+	"github.com/ukama/ukama/systems/subscriber/registry/pkg/db"
+
+	cmocks "github.com/ukama/ukama/systems/common/mocks"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+)
+
+func TestSubscriberEventServer_HandleDataRequestCreateEvent(t *testing.T) {
+	routingKey := msgbus.PrepareRoute(OrgName, "event.cloud.local.{{ .Org}}.registry.member.datarequest.create")
+	resultKey := "event.cloud.local." + OrgName + ".subscriber.registry.datarequest.complete"
+	subscriberId := uuid.NewV4()
+
+	event := func(kind, subjectKind string) *epb.Event {
+		anyE, err := anypb.New(&epb.EventDataRequest{
+			Id:          uuid.NewV4().String(),
+			Kind:        kind,
+			SubjectKind: subjectKind,
+			SubjectId:   subscriberId.String(),
+		})
+		assert.NoError(t, err)
+
+		return &epb.Event{RoutingKey: routingKey, Msg: anyE}
+	}
+
+	t.Run("ExportOrg", func(t *testing.T) {
+		repo := &mocks.SubscriberRepo{}
+		msgbusClient := &cmocks.MsgBusServiceClient{}
+
+		repo.On("ListSubscribers").Return([]db.Subscriber{{SubscriberId: subscriberId, Name: "Jane"}}, nil).Once()
+		msgbusClient.On("PublishRequest", resultKey, mock.MatchedBy(func(r *epb.EventDataRequestResult) bool {
+			return r.Success && r.Service == "subscriber.registry" && len(r.Data) > 0
+		})).Return(nil).Once()
+
+		s := NewSubscriberEventServer(OrgName, repo, msgbusClient)
+		_, err := s.EventNotification(context.TODO(), event("export", "org"))
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		msgbusClient.AssertExpectations(t)
+	})
+
+	t.Run("EraseSubscriber", func(t *testing.T) {
+		repo := &mocks.SubscriberRepo{}
+		msgbusClient := &cmocks.MsgBusServiceClient{}
+
+		repo.On("Anonymize", subscriberId).Return(nil).Once()
+		msgbusClient.On("PublishRequest", resultKey, mock.MatchedBy(func(r *epb.EventDataRequestResult) bool {
+			return r.Success && len(r.Data) == 0
+		})).Return(nil).Once()
+
+		s := NewSubscriberEventServer(OrgName, repo, msgbusClient)
+		_, err := s.EventNotification(context.TODO(), event("erase", "subscriber"))
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		msgbusClient.AssertExpectations(t)
+	})
+
+	t.Run("EraseFailureReported", func(t *testing.T) {
+		repo := &mocks.SubscriberRepo{}
+		msgbusClient := &cmocks.MsgBusServiceClient{}
+
+		repo.On("Anonymize", subscriberId).Return(errors.New("db down")).Once()
+		msgbusClient.On("PublishRequest", resultKey, mock.MatchedBy(func(r *epb.EventDataRequestResult) bool {
+			return !r.Success && r.Error != ""
+		})).Return(nil).Once()
+
+		s := NewSubscriberEventServer(OrgName, repo, msgbusClient)
+		_, err := s.EventNotification(context.TODO(), event("erase", "subscriber"))
+
+		assert.NoError(t, err)
+		msgbusClient.AssertExpectations(t)
+	})
+}
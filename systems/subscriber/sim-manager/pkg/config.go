@@ -68,6 +68,7 @@ func NewConfig(name string) *Config {
 				"event.cloud.local.{{ .Org}}.ukamaagent.asr.activesubscriber.delete",
 				"event.cloud.local.{{ .Org}}.ukamaagent.cdr.cdr.create",
 				"event.cloud.local.{{ .Org}}.operator.cdr.cdr.create",
+				"event.cloud.local.{{ .Org}}.registry.member.datarequest.create",
 			},
 		},
 	}
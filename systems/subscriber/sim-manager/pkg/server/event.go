@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/ukama/ukama/systems/common/msgbus"
+	"github.com/ukama/ukama/systems/common/privacy"
 	"github.com/ukama/ukama/systems/common/ukama"
 	"github.com/ukama/ukama/systems/subscriber/sim-manager/pkg"
 	"github.com/ukama/ukama/systems/subscriber/sim-manager/pkg/clients/adapters"
@@ -131,6 +132,17 @@ func (es *SimManagerEventServer) EventNotification(ctx context.Context, e *epb.E
 		if err != nil {
 			return nil, err
 		}
+
+	case msgbus.PrepareRoute(es.orgName, "event.cloud.local.{{ .Org}}.registry.member.datarequest.create"):
+		msg, err := cpb.UnmarshalProtoEvent[epb.EventDataRequest](e.Msg)
+		if err != nil {
+			return nil, err
+		}
+
+		err = es.handleDataRequestCreateEvent(e.RoutingKey, msg)
+		if err != nil {
+			return nil, err
+		}
 	default:
 		log.Errorf("No handler routing key %s", e.RoutingKey)
 	}
@@ -383,6 +395,73 @@ func (es *SimManagerEventServer) handleUkamaAgentAsrProfileDeleteEvent(key strin
 	return nil
 }
 
+// handleDataRequestCreateEvent exports the sims and packages of the org or
+// of a subscriber. Sims carry no personal data besides their subscriber and
+// are kept, like their packages, as records of what was sold, so erasure
+// leaves them as they are. Either way the IMSIs of the subject go along the
+// answer for the services keeping usage by IMSI.
+func (es *SimManagerEventServer) handleDataRequestCreateEvent(key string, req *epb.EventDataRequest) error {
+	log.Infof("Keys %s and Proto is: %+v", key, req)
+
+	subscriberId := ""
+	if req.SubjectKind == privacy.SubjectSubscriber {
+		subscriberId = req.SubjectId
+	}
+
+	var imsis []string
+	doc, err := es.exportSims(subscriberId, &imsis)
+	if req.Kind == privacy.KindErase {
+		doc = nil
+	}
+
+	res := privacy.NewResult(pkg.SystemName+"."+pkg.ServiceName, req, doc, err)
+	res.Imsis = imsis
+	if req.Kind == privacy.KindErase && res.Success {
+		res.Note = "sims and packages retained as sale records"
+	}
+
+	route := es.baseRoutingKey.SetAction("complete").SetObject("datarequest").MustBuild()
+
+	err = es.msgbus.PublishRequest(route, res)
+	if err != nil {
+		log.Errorf("Failed to publish message %+v with key %+v. Errors %s", res, route, err.Error())
+	}
+
+	return err
+}
+
+func (es *SimManagerEventServer) exportSims(subscriberId string, imsis *[]string) (privacy.Document, error) {
+	simList, err := es.simRepo.List("", "", subscriberId, "", ukama.SimTypeUnknown, ukama.SimStatusUnknown,
+		0, false, 0, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sims: %w", err)
+	}
+
+	packages := []sims.Package{}
+	for _, sim := range simList {
+		if sim.Imsi != "" {
+			*imsis = append(*imsis, sim.Imsi)
+		}
+
+		p, err := es.packageRepo.List(sim.Id.String(), "", "", "", "", "", false, false, 0, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list packages of sim %s: %w", sim.Id, err)
+		}
+		packages = append(packages, p...)
+	}
+
+	doc := privacy.Document{}
+	if err := doc.Add("sims", simList); err != nil {
+		return nil, err
+	}
+
+	if err := doc.Add("packages", packages); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
 func (es *SimManagerEventServer) getSimFromIccidOrImsi(iccid, imsi string) (*sims.Sim, error) {
 	ukamaSims, err := es.simRepo.List(iccid, imsi, "", "", ukama.SimTypeUnknown, ukama.SimStatusUnknown, 0, false, 0, false)
 	if err != nil {
@@ -1461,3 +1461,67 @@ func TestSimManagerEventServer_HandleUkamaAgentAsrProfileDeleteEvent(t *testing.
 		assert.Error(t, err)
 	})
 }
+
+func TestSimManagerEventServer_HandleDataRequestCreateEvent(t *testing.T) {
+	routingKey := msgbus.PrepareRoute(OrgName,
+		"event.cloud.local.{{ .Org}}.registry.member.datarequest.create")
+	subscriberId := uuid.NewV4()
+	simId := uuid.NewV4()
+
+	setup := func() (*mocks.SimRepo, *mocks.PackageRepo) {
+		simRepo := &mocks.SimRepo{}
+		packageRepo := &mocks.PackageRepo{}
+
+		simRepo.On("List", "", "", subscriberId.String(), "", ukama.SimTypeUnknown, ukama.SimStatusUnknown,
+			uint32(0), false, uint32(0), false).
+			Return([]sims.Sim{{Id: simId, SubscriberId: subscriberId, Imsi: "001010123456789"}}, nil).Once()
+		packageRepo.On("List", simId.String(), "", "", "", "", "", false, false, uint32(0), false).
+			Return([]sims.Package{{Id: uuid.NewV4(), SimId: simId}}, nil).Once()
+
+		return simRepo, packageRepo
+	}
+
+	event := func(kind string) *epb.Event {
+		anyE, err := anypb.New(&epb.EventDataRequest{
+			Id:          uuid.NewV4().String(),
+			Kind:        kind,
+			SubjectKind: "subscriber",
+			SubjectId:   subscriberId.String(),
+		})
+		assert.NoError(t, err)
+
+		return &epb.Event{RoutingKey: routingKey, Msg: anyE}
+	}
+
+	t.Run("Export", func(t *testing.T) {
+		simRepo, packageRepo := setup()
+		msgbusClient := &cmocks.MsgBusServiceClient{}
+		msgbusClient.On("PublishRequest", "event.cloud.local."+OrgName+".subscriber.simmanager.datarequest.complete",
+			mock.MatchedBy(func(r *epb.EventDataRequestResult) bool {
+				return r.Success && r.Service == "subscriber.simmanager" && len(r.Data) > 0 &&
+					len(r.Imsis) == 1 && r.Imsis[0] == "001010123456789"
+			})).Return(nil).Once()
+
+		s := server.NewSimManagerEventServer(OrgName, orgId, simRepo, packageRepo, nil, nil, nil, nil, nil, nil, msgbusClient, "")
+		_, err := s.EventNotification(context.TODO(), event("export"))
+
+		assert.NoError(t, err)
+		msgbusClient.AssertExpectations(t)
+	})
+
+	t.Run("EraseKeepsSims", func(t *testing.T) {
+		simRepo, packageRepo := setup()
+		msgbusClient := &cmocks.MsgBusServiceClient{}
+		msgbusClient.On("PublishRequest", mock.Anything,
+			mock.MatchedBy(func(r *epb.EventDataRequestResult) bool {
+				return r.Success && len(r.Data) == 0 && len(r.Imsis) == 1 && r.Note != ""
+			})).Return(nil).Once()
+
+		s := server.NewSimManagerEventServer(OrgName, orgId, simRepo, packageRepo, nil, nil, nil, nil, nil, nil, msgbusClient, "")
+		_, err := s.EventNotification(context.TODO(), event("erase"))
+
+		assert.NoError(t, err)
+		msgbusClient.AssertExpectations(t)
+		simRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
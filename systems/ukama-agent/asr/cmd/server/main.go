@@ -173,6 +173,9 @@ func runGrpcServer(gormdb sql.Db) {
 		go msgBusListener(mbClient)
 	}
 
+	ic.StartHeartbeat(ic.NewInitClient(serviceConfig.Http.InitClient, cclient.WithDebug(serviceConfig.DebugMode)),
+		serviceConfig.OrgName, pkg.SystemName, version.Version, ic.DefaultHeartbeatInterval, nil)
+
 	rpcServer.StartServer()
 }
 
@@ -59,6 +59,7 @@ func NewConfig(name string) *Config {
 			ListenerRoutes: []string{
 				"event.cloud.local.{{ .Org}}.ukamaagent.asr.activesubscriber.create",
 				"event.cloud.local.{{ .Org}}.ukamaagent.asr.activesubscriber.update",
+				"event.cloud.local.{{ .Org}}.subscriber.simmanager.datarequest.complete",
 			},
 		},
 	}
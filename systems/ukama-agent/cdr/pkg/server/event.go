@@ -10,8 +10,15 @@ package server
 
 import (
 	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
 
 	"github.com/ukama/ukama/systems/common/msgbus"
+	"github.com/ukama/ukama/systems/common/privacy"
+	"github.com/ukama/ukama/systems/ukama-agent/cdr/pkg"
+	"github.com/ukama/ukama/systems/ukama-agent/cdr/pkg/db"
 
 	log "github.com/sirupsen/logrus"
 	cpb "github.com/ukama/ukama/systems/common/pb/events"
@@ -54,6 +61,16 @@ func (n *CDREventServer) EventNotification(ctx context.Context, e *epb.Event) (*
 		if err != nil {
 			return nil, err
 		}
+	case msgbus.PrepareRoute(n.orgName, "event.cloud.local.{{ .Org}}.subscriber.simmanager.datarequest.complete"):
+		msg, err := cpb.UnmarshalProtoEvent[epb.EventDataRequestResult](e.Msg)
+		if err != nil {
+			return nil, err
+		}
+
+		err = n.handleEventSimManagerDataRequestComplete(e.RoutingKey, msg)
+		if err != nil {
+			return nil, err
+		}
 	default:
 		log.Errorf("No handler routing key %s", e.RoutingKey)
 	}
@@ -82,3 +99,77 @@ func (n *CDREventServer) handleEventActiveSubscriberUpdate(key string, msg *epb.
 
 	return nil
 }
+
+// handleEventSimManagerDataRequestComplete takes part in a data request once
+// sim manager has answered it, as CDRs and usage are only known by IMSI.
+// They hold no personal data and are kept on erasure for billing.
+func (n *CDREventServer) handleEventSimManagerDataRequestComplete(key string, msg *epb.EventDataRequestResult) error {
+	log.Infof("Keys %s and Proto is: %+v", key, msg)
+
+	req := &epb.EventDataRequest{
+		Id:          msg.RequestId,
+		Kind:        msg.Kind,
+		SubjectKind: msg.SubjectKind,
+		SubjectId:   msg.SubjectId,
+	}
+
+	var doc privacy.Document
+	var err error
+
+	switch {
+	case !msg.Success:
+		err = fmt.Errorf("sims of %s %s are unknown: %s", msg.SubjectKind, msg.SubjectId, msg.Error)
+	case msg.Kind == privacy.KindExport:
+		doc, err = n.exportUsage(msg.Imsis)
+	}
+
+	res := privacy.NewResult(pkg.SystemName+"."+pkg.ServiceName, req, doc, err)
+	if msg.Kind == privacy.KindErase && res.Success {
+		res.Note = "cdrs and usage retained as billing records"
+	}
+
+	if n.s.msgbus == nil {
+		return nil
+	}
+
+	route := n.s.baseRoutingKey.SetAction("complete").SetObject("datarequest").MustBuild()
+
+	err = n.s.msgbus.PublishRequest(route, res)
+	if err != nil {
+		log.Errorf("Failed to publish message %+v with key %+v. Errors %s", res, route, err.Error())
+	}
+
+	return err
+}
+
+func (n *CDREventServer) exportUsage(imsis []string) (privacy.Document, error) {
+	cdrs := []db.CDR{}
+	usages := []db.Usage{}
+
+	for _, imsi := range imsis {
+		c, err := n.s.cdrRepo.GetByImsi(imsi)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cdrs of imsi %s: %w", imsi, err)
+		}
+		cdrs = append(cdrs, *c...)
+
+		u, err := n.s.usageRepo.Get(imsi)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("failed to get usage of imsi %s: %w", imsi, err)
+		}
+		if err == nil && u.Imsi != "" {
+			usages = append(usages, *u)
+		}
+	}
+
+	doc := privacy.Document{}
+	if err := doc.Add("cdrs", cdrs); err != nil {
+		return nil, err
+	}
+
+	if err := doc.Add("usages", usages); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
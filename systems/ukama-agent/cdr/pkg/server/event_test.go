@@ -0,0 +1,90 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/anypb"
+	"gorm.io/gorm"
+
+	"github.com/ukama/ukama/systems/common/msgbus"
+	"github.com/ukama/ukama/systems/common/privacy"
+	"github.com/ukama/ukama/systems/ukama-agent/cdr/pkg/db"
+
+	cmocks "github.com/ukama/ukama/systems/common/mocks"
+	epb "github.com/ukama/ukama/systems/common/pb/gen/events"
+	mocks "github.com/ukama/ukama/systems/ukama-agent/cdr/mocks"
+)
+
+func TestCDREventServer_SimManagerDataRequestComplete(t *testing.T) {
+	routingKey := msgbus.PrepareRoute(OrgName, "event.cloud.local.{{ .Org}}.subscriber.simmanager.datarequest.complete")
+	resultKey := "event.cloud.local." + OrgName + ".ukamaagent.cdr.datarequest.complete"
+
+	event := func(res *epb.EventDataRequestResult) *epb.Event {
+		anyE, err := anypb.New(res)
+		assert.NoError(t, err)
+
+		return &epb.Event{RoutingKey: routingKey, Msg: anyE}
+	}
+
+	t.Run("ExportByImsi", func(t *testing.T) {
+		cdrRepo := &mocks.CDRRepo{}
+		usageRepo := &mocks.UsageRepo{}
+		mbC := &cmocks.MsgBusServiceClient{}
+
+		s, err := NewCDRServer(cdrRepo, usageRepo, &cmocks.NodeClient{}, OrgId, OrgName, "", &mocks.AsrService{}, mbC)
+		assert.NoError(t, err)
+
+		cdrRepo.On("GetByImsi", imsi).Return(&[]db.CDR{{Imsi: imsi, Session: session}}, nil).Once()
+		usageRepo.On("Get", imsi).Return(nil, gorm.ErrRecordNotFound).Once()
+		mbC.On("PublishRequest", resultKey, mock.MatchedBy(func(r *epb.EventDataRequestResult) bool {
+			doc, err := privacy.ParseDocument(r.Data)
+			return r.Success && r.RequestId == "dr-1" && err == nil && len(doc["cdrs"]) == 1 && len(doc["usages"]) == 0
+		})).Return(nil).Once()
+
+		_, err = NewCDREventServer(s, OrgName).EventNotification(context.TODO(), event(&epb.EventDataRequestResult{
+			RequestId:   "dr-1",
+			Kind:        privacy.KindExport,
+			SubjectKind: privacy.SubjectSubscriber,
+			Service:     "subscriber.simmanager",
+			Success:     true,
+			Imsis:       []string{imsi},
+		}))
+
+		assert.NoError(t, err)
+		cdrRepo.AssertExpectations(t)
+		mbC.AssertExpectations(t)
+	})
+
+	t.Run("SimManagerFailed", func(t *testing.T) {
+		cdrRepo := &mocks.CDRRepo{}
+		mbC := &cmocks.MsgBusServiceClient{}
+
+		s, err := NewCDRServer(cdrRepo, &mocks.UsageRepo{}, &cmocks.NodeClient{}, OrgId, OrgName, "", &mocks.AsrService{}, mbC)
+		assert.NoError(t, err)
+
+		mbC.On("PublishRequest", resultKey, mock.MatchedBy(func(r *epb.EventDataRequestResult) bool {
+			return !r.Success && r.Error != ""
+		})).Return(nil).Once()
+
+		_, err = NewCDREventServer(s, OrgName).EventNotification(context.TODO(), event(&epb.EventDataRequestResult{
+			RequestId: "dr-1",
+			Kind:      privacy.KindErase,
+			Error:     "db down",
+		}))
+
+		assert.NoError(t, err)
+		cdrRepo.AssertNotCalled(t, "GetByImsi", mock.Anything)
+		mbC.AssertExpectations(t)
+	})
+}
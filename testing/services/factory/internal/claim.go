@@ -0,0 +1,34 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ *
+ * Copyright (c) 2026-present, Ukama Inc.
+ */
+
+package internal
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base32"
+	"strings"
+)
+
+// ClaimCode derives the claim code printed on the label of a node. It is
+// keyed by the factory secret so codes can be verified without storing them.
+func ClaimCode(secret string, nodeId string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strings.ToLower(nodeId)))
+
+	code := base32.StdEncoding.EncodeToString(mac.Sum(nil))[:8]
+
+	return code[:4] + "-" + code[4:]
+}
+
+// VerifyClaimCode reports whether code is the claim code of the node.
+func VerifyClaimCode(secret string, nodeId string, code string) bool {
+	expected := ClaimCode(secret, nodeId)
+
+	return hmac.Equal([]byte(expected), []byte(strings.ToUpper(strings.TrimSpace(code))))
+}
@@ -41,6 +41,7 @@ type Config struct {
 	CmRef                 string
 	AwsKey                string
 	AwsSecret             string
+	ClaimSecret           string `default:"ukama-factory-claim"`
 }
 
 var ServiceConfig *Config
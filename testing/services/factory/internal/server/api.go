@@ -26,7 +26,13 @@ type ReqBuildNode struct {
 }
 
 type RespBuildNode struct {
-	NodeIDList []string `json:"NodeID"`
+	NodeIDList []string          `json:"NodeID"`
+	ClaimCodes map[string]string `json:"claimCodes"`
+}
+
+type ReqVerifyClaim struct {
+	NodeID    string `path:"id" validate:"required"`
+	ClaimCode string `json:"claimCode" validate:"required"`
 }
 
 type ReqDeleteNode struct {
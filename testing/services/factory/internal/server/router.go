@@ -24,13 +24,15 @@ import (
 )
 
 const (
-	NodePath = "/node"
+	NodePath        = "/node"
+	NodeFactoryPath = "/v1/nodefactory"
 )
 
 type Router struct {
-	fizz *fizz.Fizz
-	port int
-	w    *worker.Worker
+	fizz        *fizz.Fizz
+	port        int
+	w           *worker.Worker
+	claimSecret string
 }
 
 func (r *Router) Run(close chan error) {
@@ -47,7 +49,8 @@ func NewRouter(config *internal.Config, svcR *sr.ServiceRouter) *Router {
 	f := rest.NewFizzRouter(&config.Server, internal.ServiceName, version.Version, internal.IsDebugMode, "")
 
 	r := &Router{fizz: f,
-		port: config.Server.Port,
+		port:        config.Server.Port,
+		claimSecret: config.ClaimSecret,
 	}
 
 	if svcR != nil {
@@ -63,6 +66,9 @@ func NewRouter(config *internal.Config, svcR *sr.ServiceRouter) *Router {
 func (r *Router) init() {
 	node := r.fizz.Group(NodePath, "Node", "Node related operations")
 	node.PUT("", nil, tonic.Handler(r.PostBuildNode, http.StatusAccepted))
+
+	factory := r.fizz.Group(NodeFactoryPath, "Node factory", "Node factory operations")
+	factory.POST(NodePath+"/:id/claim", nil, tonic.Handler(r.PostVerifyClaim, http.StatusOK))
 }
 
 func (r *Router) PostBuildNode(c *gin.Context, req *ReqBuildNode) (*RespBuildNode, error) {
@@ -72,6 +78,7 @@ func (r *Router) PostBuildNode(c *gin.Context, req *ReqBuildNode) (*RespBuildNod
 	list := []string{}
 	resp := &RespBuildNode{
 		NodeIDList: list,
+		ClaimCodes: map[string]string{},
 	}
 	if r.w == nil {
 		err = fmt.Errorf("factory worker not initialized")
@@ -80,6 +87,10 @@ func (r *Router) PostBuildNode(c *gin.Context, req *ReqBuildNode) (*RespBuildNod
 		resp.NodeIDList = list
 	}
 
+	for _, id := range resp.NodeIDList {
+		resp.ClaimCodes[id] = internal.ClaimCode(r.claimSecret, id)
+	}
+
 	if err != nil {
 		return nil, rest.HttpError{
 			HttpCode: http.StatusInternalServerError,
@@ -89,3 +100,16 @@ func (r *Router) PostBuildNode(c *gin.Context, req *ReqBuildNode) (*RespBuildNod
 
 	return resp, nil
 }
+
+func (r *Router) PostVerifyClaim(c *gin.Context, req *ReqVerifyClaim) error {
+	logrus.Debugf("Handling claim code verification of node %s.", req.NodeID)
+
+	if !internal.VerifyClaimCode(r.claimSecret, req.NodeID, req.ClaimCode) {
+		return rest.HttpError{
+			HttpCode: http.StatusForbidden,
+			Message:  "invalid claim code",
+		}
+	}
+
+	return nil
+}
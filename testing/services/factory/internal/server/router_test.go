@@ -11,6 +11,7 @@ package server
 import (
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-contrib/cors"
@@ -102,3 +103,36 @@ func Test_PostBuildNodeWorkerInitFail(t *testing.T) {
 	}
 
 }
+
+func Test_PostVerifyClaim(t *testing.T) {
+	nodeId := "uk-sa2341-hnode-v0-a1a0"
+	code := internal.ClaimCode("secret", nodeId)
+	conf := *defaultConfig
+	conf.ClaimSecret = "secret"
+
+	t.Run("Valid", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/v1/nodefactory/node/"+nodeId+"/claim",
+			strings.NewReader(`{"claimCode":"`+strings.ToLower(code)+`"}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		r := NewRouter(&conf, nil).fizz.Engine()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 200, w.Code)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/v1/nodefactory/node/"+nodeId+"/claim",
+			strings.NewReader(`{"claimCode":"AAAA-AAAA"}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		r := NewRouter(&conf, nil).fizz.Engine()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 403, w.Code)
+	})
+}